@@ -19,6 +19,7 @@ var (
 	fd_MsgMimir_key    protoreflect.FieldDescriptor
 	fd_MsgMimir_value  protoreflect.FieldDescriptor
 	fd_MsgMimir_signer protoreflect.FieldDescriptor
+	fd_MsgMimir_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -27,6 +28,7 @@ func init() {
 	fd_MsgMimir_key = md_MsgMimir.Fields().ByName("key")
 	fd_MsgMimir_value = md_MsgMimir.Fields().ByName("value")
 	fd_MsgMimir_signer = md_MsgMimir.Fields().ByName("signer")
+	fd_MsgMimir_height = md_MsgMimir.Fields().ByName("height")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgMimir)(nil)
@@ -112,6 +114,12 @@ func (x *fastReflection_MsgMimir) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_MsgMimir_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -133,6 +141,8 @@ func (x *fastReflection_MsgMimir) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Value != int64(0)
 	case "types.MsgMimir.signer":
 		return len(x.Signer) != 0
+	case "types.MsgMimir.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgMimir"))
@@ -155,6 +165,8 @@ func (x *fastReflection_MsgMimir) Clear(fd protoreflect.FieldDescriptor) {
 		x.Value = int64(0)
 	case "types.MsgMimir.signer":
 		x.Signer = nil
+	case "types.MsgMimir.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgMimir"))
@@ -180,6 +192,9 @@ func (x *fastReflection_MsgMimir) Get(descriptor protoreflect.FieldDescriptor) p
 	case "types.MsgMimir.signer":
 		value := x.Signer
 		return protoreflect.ValueOfBytes(value)
+	case "types.MsgMimir.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgMimir"))
@@ -206,6 +221,8 @@ func (x *fastReflection_MsgMimir) Set(fd protoreflect.FieldDescriptor, value pro
 		x.Value = value.Int()
 	case "types.MsgMimir.signer":
 		x.Signer = value.Bytes()
+	case "types.MsgMimir.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgMimir"))
@@ -232,6 +249,8 @@ func (x *fastReflection_MsgMimir) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field value of message types.MsgMimir is not mutable"))
 	case "types.MsgMimir.signer":
 		panic(fmt.Errorf("field signer of message types.MsgMimir is not mutable"))
+	case "types.MsgMimir.height":
+		panic(fmt.Errorf("field height of message types.MsgMimir is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgMimir"))
@@ -251,6 +270,8 @@ func (x *fastReflection_MsgMimir) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfInt64(int64(0))
 	case "types.MsgMimir.signer":
 		return protoreflect.ValueOfBytes(nil)
+	case "types.MsgMimir.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgMimir"))
@@ -331,6 +352,9 @@ func (x *fastReflection_MsgMimir) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -360,6 +384,11 @@ func (x *fastReflection_MsgMimir) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.Signer) > 0 {
 			i -= len(x.Signer)
 			copy(dAtA[i:], x.Signer)
@@ -513,6 +542,25 @@ func (x *fastReflection_MsgMimir) ProtoMethods() *protoiface.Methods {
 					x.Signer = []byte{}
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -569,6 +617,8 @@ type MsgMimir struct {
 	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value  int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 	Signer []byte `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the block height the value takes effect, 0 applies it immediately
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (x *MsgMimir) Reset() {
@@ -612,6 +662,13 @@ func (x *MsgMimir) GetSigner() []byte {
 	return nil
 }
 
+func (x *MsgMimir) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 var File_types_msg_mimir_proto protoreflect.FileDescriptor
 
 var file_types_msg_mimir_proto_rawDesc = []byte{
@@ -619,7 +676,7 @@ var file_types_msg_mimir_proto_rawDesc = []byte{
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x01, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x4d,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x4d,
 	0x69, 0x6d, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x54, 0x0a, 0x06,
@@ -628,16 +685,18 @@ var file_types_msg_mimir_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
 	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x9a,
 	0xe7, 0xb0, 0x2a, 0x06, 0x62, 0x65, 0x63, 0x68, 0x33, 0x32, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x3a, 0x17, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x42, 0x7a, 0x0a, 0x09, 0x63,
-	0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0d, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6d,
-	0x69, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x17, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x12, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x4d, 0x73, 0x67, 0x4d, 0x69,
+	0x6d, 0x69, 0x72, 0x42, 0x7a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x42, 0x0d, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
+	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03,
+	0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14,6 +14,149 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_MsgSwap_15_list)(nil)
+
+type _MsgSwap_15_list struct {
+	list *[]string
+}
+
+func (x *_MsgSwap_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSwap_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSwap_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSwap_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSwap_15_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSwap at list field Destinations as it is not of Message kind"))
+}
+
+func (x *_MsgSwap_15_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSwap_15_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSwap_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSwap_16_list)(nil)
+
+type _MsgSwap_16_list struct {
+	list *[]string
+}
+
+func (x *_MsgSwap_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSwap_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSwap_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSwap_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSwap_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSwap at list field DestinationsBasisPoints as it is not of Message kind"))
+}
+
+func (x *_MsgSwap_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSwap_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSwap_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSwap_17_list)(nil)
+
+type _MsgSwap_17_list struct {
+	list *[]*common.Asset
+}
+
+func (x *_MsgSwap_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSwap_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSwap_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*common.Asset)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSwap_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*common.Asset)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSwap_17_list) AppendMutable() protoreflect.Value {
+	v := new(common.Asset)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSwap_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSwap_17_list) NewElement() protoreflect.Value {
+	v := new(common.Asset)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSwap_17_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_MsgSwap                           protoreflect.MessageDescriptor
 	fd_MsgSwap_tx                        protoreflect.FieldDescriptor
@@ -29,6 +172,10 @@ var (
 	fd_MsgSwap_swap_type                 protoreflect.FieldDescriptor
 	fd_MsgSwap_stream_quantity           protoreflect.FieldDescriptor
 	fd_MsgSwap_stream_interval           protoreflect.FieldDescriptor
+	fd_MsgSwap_expiry                    protoreflect.FieldDescriptor
+	fd_MsgSwap_destinations              protoreflect.FieldDescriptor
+	fd_MsgSwap_destinations_basis_points protoreflect.FieldDescriptor
+	fd_MsgSwap_route                     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -47,6 +194,10 @@ func init() {
 	fd_MsgSwap_swap_type = md_MsgSwap.Fields().ByName("swap_type")
 	fd_MsgSwap_stream_quantity = md_MsgSwap.Fields().ByName("stream_quantity")
 	fd_MsgSwap_stream_interval = md_MsgSwap.Fields().ByName("stream_interval")
+	fd_MsgSwap_expiry = md_MsgSwap.Fields().ByName("expiry")
+	fd_MsgSwap_destinations = md_MsgSwap.Fields().ByName("destinations")
+	fd_MsgSwap_destinations_basis_points = md_MsgSwap.Fields().ByName("destinations_basis_points")
+	fd_MsgSwap_route = md_MsgSwap.Fields().ByName("route")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgSwap)(nil)
@@ -192,6 +343,30 @@ func (x *fastReflection_MsgSwap) Range(f func(protoreflect.FieldDescriptor, prot
 			return
 		}
 	}
+	if x.Expiry != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Expiry)
+		if !f(fd_MsgSwap_expiry, value) {
+			return
+		}
+	}
+	if len(x.Destinations) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSwap_15_list{list: &x.Destinations})
+		if !f(fd_MsgSwap_destinations, value) {
+			return
+		}
+	}
+	if len(x.DestinationsBasisPoints) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSwap_16_list{list: &x.DestinationsBasisPoints})
+		if !f(fd_MsgSwap_destinations_basis_points, value) {
+			return
+		}
+	}
+	if len(x.Route) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSwap_17_list{list: &x.Route})
+		if !f(fd_MsgSwap_route, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -233,6 +408,14 @@ func (x *fastReflection_MsgSwap) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.StreamQuantity != uint64(0)
 	case "types.MsgSwap.stream_interval":
 		return x.StreamInterval != uint64(0)
+	case "types.MsgSwap.expiry":
+		return x.Expiry != int64(0)
+	case "types.MsgSwap.destinations":
+		return len(x.Destinations) != 0
+	case "types.MsgSwap.destinations_basis_points":
+		return len(x.DestinationsBasisPoints) != 0
+	case "types.MsgSwap.route":
+		return len(x.Route) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgSwap"))
@@ -275,6 +458,14 @@ func (x *fastReflection_MsgSwap) Clear(fd protoreflect.FieldDescriptor) {
 		x.StreamQuantity = uint64(0)
 	case "types.MsgSwap.stream_interval":
 		x.StreamInterval = uint64(0)
+	case "types.MsgSwap.expiry":
+		x.Expiry = int64(0)
+	case "types.MsgSwap.destinations":
+		x.Destinations = nil
+	case "types.MsgSwap.destinations_basis_points":
+		x.DestinationsBasisPoints = nil
+	case "types.MsgSwap.route":
+		x.Route = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgSwap"))
@@ -330,6 +521,27 @@ func (x *fastReflection_MsgSwap) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "types.MsgSwap.stream_interval":
 		value := x.StreamInterval
 		return protoreflect.ValueOfUint64(value)
+	case "types.MsgSwap.expiry":
+		value := x.Expiry
+		return protoreflect.ValueOfInt64(value)
+	case "types.MsgSwap.destinations":
+		if len(x.Destinations) == 0 {
+			return protoreflect.ValueOfList(&_MsgSwap_15_list{})
+		}
+		listValue := &_MsgSwap_15_list{list: &x.Destinations}
+		return protoreflect.ValueOfList(listValue)
+	case "types.MsgSwap.destinations_basis_points":
+		if len(x.DestinationsBasisPoints) == 0 {
+			return protoreflect.ValueOfList(&_MsgSwap_16_list{})
+		}
+		listValue := &_MsgSwap_16_list{list: &x.DestinationsBasisPoints}
+		return protoreflect.ValueOfList(listValue)
+	case "types.MsgSwap.route":
+		if len(x.Route) == 0 {
+			return protoreflect.ValueOfList(&_MsgSwap_17_list{})
+		}
+		listValue := &_MsgSwap_17_list{list: &x.Route}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgSwap"))
@@ -376,6 +588,20 @@ func (x *fastReflection_MsgSwap) Set(fd protoreflect.FieldDescriptor, value prot
 		x.StreamQuantity = value.Uint()
 	case "types.MsgSwap.stream_interval":
 		x.StreamInterval = value.Uint()
+	case "types.MsgSwap.expiry":
+		x.Expiry = value.Int()
+	case "types.MsgSwap.destinations":
+		lv := value.List()
+		clv := lv.(*_MsgSwap_15_list)
+		x.Destinations = *clv.list
+	case "types.MsgSwap.destinations_basis_points":
+		lv := value.List()
+		clv := lv.(*_MsgSwap_16_list)
+		x.DestinationsBasisPoints = *clv.list
+	case "types.MsgSwap.route":
+		lv := value.List()
+		clv := lv.(*_MsgSwap_17_list)
+		x.Route = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgSwap"))
@@ -406,6 +632,24 @@ func (x *fastReflection_MsgSwap) Mutable(fd protoreflect.FieldDescriptor) protor
 			x.TargetAsset = new(common.Asset)
 		}
 		return protoreflect.ValueOfMessage(x.TargetAsset.ProtoReflect())
+	case "types.MsgSwap.destinations":
+		if x.Destinations == nil {
+			x.Destinations = []string{}
+		}
+		value := &_MsgSwap_15_list{list: &x.Destinations}
+		return protoreflect.ValueOfList(value)
+	case "types.MsgSwap.destinations_basis_points":
+		if x.DestinationsBasisPoints == nil {
+			x.DestinationsBasisPoints = []string{}
+		}
+		value := &_MsgSwap_16_list{list: &x.DestinationsBasisPoints}
+		return protoreflect.ValueOfList(value)
+	case "types.MsgSwap.route":
+		if x.Route == nil {
+			x.Route = []*common.Asset{}
+		}
+		value := &_MsgSwap_17_list{list: &x.Route}
+		return protoreflect.ValueOfList(value)
 	case "types.MsgSwap.destination":
 		panic(fmt.Errorf("field destination of message types.MsgSwap is not mutable"))
 	case "types.MsgSwap.trade_target":
@@ -428,6 +672,8 @@ func (x *fastReflection_MsgSwap) Mutable(fd protoreflect.FieldDescriptor) protor
 		panic(fmt.Errorf("field stream_quantity of message types.MsgSwap is not mutable"))
 	case "types.MsgSwap.stream_interval":
 		panic(fmt.Errorf("field stream_interval of message types.MsgSwap is not mutable"))
+	case "types.MsgSwap.expiry":
+		panic(fmt.Errorf("field expiry of message types.MsgSwap is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgSwap"))
@@ -469,6 +715,17 @@ func (x *fastReflection_MsgSwap) NewField(fd protoreflect.FieldDescriptor) proto
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "types.MsgSwap.stream_interval":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "types.MsgSwap.expiry":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.MsgSwap.destinations":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSwap_15_list{list: &list})
+	case "types.MsgSwap.destinations_basis_points":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSwap_16_list{list: &list})
+	case "types.MsgSwap.route":
+		list := []*common.Asset{}
+		return protoreflect.ValueOfList(&_MsgSwap_17_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgSwap"))
@@ -587,6 +844,27 @@ func (x *fastReflection_MsgSwap) ProtoMethods() *protoiface.Methods {
 		if x.StreamInterval != 0 {
 			n += 1 + runtime.Sov(uint64(x.StreamInterval))
 		}
+		if x.Expiry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Expiry))
+		}
+		if len(x.Destinations) > 0 {
+			for _, s := range x.Destinations {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DestinationsBasisPoints) > 0 {
+			for _, s := range x.DestinationsBasisPoints {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Route) > 0 {
+			for _, e := range x.Route {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -616,6 +894,49 @@ func (x *fastReflection_MsgSwap) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Route) > 0 {
+			for iNdEx := len(x.Route) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Route[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.DestinationsBasisPoints) > 0 {
+			for iNdEx := len(x.DestinationsBasisPoints) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DestinationsBasisPoints[iNdEx])
+				copy(dAtA[i:], x.DestinationsBasisPoints[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationsBasisPoints[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.Destinations) > 0 {
+			for iNdEx := len(x.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Destinations[iNdEx])
+				copy(dAtA[i:], x.Destinations[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destinations[iNdEx])))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.Expiry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Expiry))
+			i--
+			dAtA[i] = 0x70
+		}
 		if x.StreamInterval != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamInterval))
 			i--
@@ -1151,6 +1472,123 @@ func (x *fastReflection_MsgSwap) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				}
+				x.Expiry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Expiry |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Destinations = append(x.Destinations, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationsBasisPoints", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationsBasisPoints = append(x.DestinationsBasisPoints, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Route = append(x.Route, &common.Asset{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Route[len(x.Route)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1202,8 +1640,10 @@ const (
 type SwapType int32
 
 const (
-	SwapType_market SwapType = 0
-	SwapType_limit  SwapType = 1
+	SwapType_market      SwapType = 0
+	SwapType_limit       SwapType = 1
+	SwapType_stop_loss   SwapType = 2
+	SwapType_take_profit SwapType = 3
 )
 
 // Enum value maps for SwapType.
@@ -1211,10 +1651,14 @@ var (
 	SwapType_name = map[int32]string{
 		0: "market",
 		1: "limit",
+		2: "stop_loss",
+		3: "take_profit",
 	}
 	SwapType_value = map[string]int32{
-		"market": 0,
-		"limit":  1,
+		"market":      0,
+		"limit":       1,
+		"stop_loss":   2,
+		"take_profit": 3,
 	}
 )
 
@@ -1250,19 +1694,23 @@ type MsgSwap struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx                      *common.Tx    `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
-	TargetAsset             *common.Asset `protobuf:"bytes,2,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset,omitempty"`
-	Destination             string        `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	TradeTarget             string        `protobuf:"bytes,4,opt,name=trade_target,json=tradeTarget,proto3" json:"trade_target,omitempty"`
-	AffiliateAddress        string        `protobuf:"bytes,5,opt,name=affiliate_address,json=affiliateAddress,proto3" json:"affiliate_address,omitempty"`
-	AffiliateBasisPoints    string        `protobuf:"bytes,6,opt,name=affiliate_basis_points,json=affiliateBasisPoints,proto3" json:"affiliate_basis_points,omitempty"`
-	Signer                  []byte        `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
-	Aggregator              string        `protobuf:"bytes,8,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
-	AggregatorTargetAddress string        `protobuf:"bytes,9,opt,name=aggregator_target_address,json=aggregatorTargetAddress,proto3" json:"aggregator_target_address,omitempty"`
-	AggregatorTargetLimit   string        `protobuf:"bytes,10,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3" json:"aggregator_target_limit,omitempty"`
-	SwapType                SwapType      `protobuf:"varint,11,opt,name=swap_type,json=swapType,proto3,enum=types.SwapType" json:"swap_type,omitempty"`
-	StreamQuantity          uint64        `protobuf:"varint,12,opt,name=stream_quantity,json=streamQuantity,proto3" json:"stream_quantity,omitempty"`
-	StreamInterval          uint64        `protobuf:"varint,13,opt,name=stream_interval,json=streamInterval,proto3" json:"stream_interval,omitempty"`
+	Tx                      *common.Tx      `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	TargetAsset             *common.Asset   `protobuf:"bytes,2,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset,omitempty"`
+	Destination             string          `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	TradeTarget             string          `protobuf:"bytes,4,opt,name=trade_target,json=tradeTarget,proto3" json:"trade_target,omitempty"`
+	AffiliateAddress        string          `protobuf:"bytes,5,opt,name=affiliate_address,json=affiliateAddress,proto3" json:"affiliate_address,omitempty"`
+	AffiliateBasisPoints    string          `protobuf:"bytes,6,opt,name=affiliate_basis_points,json=affiliateBasisPoints,proto3" json:"affiliate_basis_points,omitempty"`
+	Signer                  []byte          `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+	Aggregator              string          `protobuf:"bytes,8,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
+	AggregatorTargetAddress string          `protobuf:"bytes,9,opt,name=aggregator_target_address,json=aggregatorTargetAddress,proto3" json:"aggregator_target_address,omitempty"`
+	AggregatorTargetLimit   string          `protobuf:"bytes,10,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3" json:"aggregator_target_limit,omitempty"`
+	SwapType                SwapType        `protobuf:"varint,11,opt,name=swap_type,json=swapType,proto3,enum=types.SwapType" json:"swap_type,omitempty"`
+	StreamQuantity          uint64          `protobuf:"varint,12,opt,name=stream_quantity,json=streamQuantity,proto3" json:"stream_quantity,omitempty"`
+	StreamInterval          uint64          `protobuf:"varint,13,opt,name=stream_interval,json=streamInterval,proto3" json:"stream_interval,omitempty"`
+	Expiry                  int64           `protobuf:"varint,14,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Destinations            []string        `protobuf:"bytes,15,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	DestinationsBasisPoints []string        `protobuf:"bytes,16,rep,name=destinations_basis_points,json=destinationsBasisPoints,proto3" json:"destinations_basis_points,omitempty"`
+	Route                   []*common.Asset `protobuf:"bytes,17,rep,name=route,proto3" json:"route,omitempty"`
 }
 
 func (x *MsgSwap) Reset() {
@@ -1376,6 +1824,34 @@ func (x *MsgSwap) GetStreamInterval() uint64 {
 	return 0
 }
 
+func (x *MsgSwap) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *MsgSwap) GetDestinations() []string {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *MsgSwap) GetDestinationsBasisPoints() []string {
+	if x != nil {
+		return x.DestinationsBasisPoints
+	}
+	return nil
+}
+
+func (x *MsgSwap) GetRoute() []*common.Asset {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
 var File_types_msg_swap_proto protoreflect.FileDescriptor
 
 var file_types_msg_swap_proto_rawDesc = []byte{
@@ -1383,7 +1859,7 @@ var file_types_msg_swap_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x13, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x07, 0x0a, 0x07, 0x4d, 0x73, 0x67,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x0a, 0x0a, 0x07, 0x4d, 0x73, 0x67,
 	0x53, 0x77, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x0a, 0xc8, 0xde,
 	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x02, 0x74, 0x78, 0x52, 0x02, 0x74, 0x78, 0x12, 0x77, 0x0a, 0x0c,
@@ -1442,17 +1918,41 @@ var file_types_msg_swap_proto_rawDesc = []byte{
 	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x65, 0x61,
 	0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x0e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x2a, 0x21, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x10, 0x01, 0x42, 0x79, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x42, 0x0c, 0x4d, 0x73, 0x67, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54,
-	0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x57, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x42, 0x33,
+	0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x81, 0x01, 0x0a, 0x19, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x09, 0x42, 0x45, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x55, 0x69, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x23, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x17, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x6d, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x42, 0x48, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0xea, 0xde, 0x1f, 0x0f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x2a, 0x41, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x6c, 0x6f, 0x73, 0x73, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x74, 0x10, 0x03, 0x42, 0x79, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x42, 0x0c, 0x4d, 0x73, 0x67, 0x53, 0x77, 0x61, 0x70, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02,
+	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1479,11 +1979,12 @@ var file_types_msg_swap_proto_depIdxs = []int32{
 	2, // 0: types.MsgSwap.tx:type_name -> common.Tx
 	3, // 1: types.MsgSwap.target_asset:type_name -> common.Asset
 	0, // 2: types.MsgSwap.swap_type:type_name -> types.SwapType
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 3: types.MsgSwap.route:type_name -> common.Asset
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_types_msg_swap_proto_init() }
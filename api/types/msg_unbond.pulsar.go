@@ -22,6 +22,7 @@ var (
 	fd_MsgUnBond_amount                protoreflect.FieldDescriptor
 	fd_MsgUnBond_signer                protoreflect.FieldDescriptor
 	fd_MsgUnBond_bond_provider_address protoreflect.FieldDescriptor
+	fd_MsgUnBond_cancel                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -33,6 +34,7 @@ func init() {
 	fd_MsgUnBond_amount = md_MsgUnBond.Fields().ByName("amount")
 	fd_MsgUnBond_signer = md_MsgUnBond.Fields().ByName("signer")
 	fd_MsgUnBond_bond_provider_address = md_MsgUnBond.Fields().ByName("bond_provider_address")
+	fd_MsgUnBond_cancel = md_MsgUnBond.Fields().ByName("cancel")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgUnBond)(nil)
@@ -136,6 +138,12 @@ func (x *fastReflection_MsgUnBond) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.Cancel != false {
+		value := protoreflect.ValueOfBool(x.Cancel)
+		if !f(fd_MsgUnBond_cancel, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -163,6 +171,8 @@ func (x *fastReflection_MsgUnBond) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Signer) != 0
 	case "types.MsgUnBond.bond_provider_address":
 		return len(x.BondProviderAddress) != 0
+	case "types.MsgUnBond.cancel":
+		return x.Cancel != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgUnBond"))
@@ -191,6 +201,8 @@ func (x *fastReflection_MsgUnBond) Clear(fd protoreflect.FieldDescriptor) {
 		x.Signer = nil
 	case "types.MsgUnBond.bond_provider_address":
 		x.BondProviderAddress = nil
+	case "types.MsgUnBond.cancel":
+		x.Cancel = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgUnBond"))
@@ -225,6 +237,9 @@ func (x *fastReflection_MsgUnBond) Get(descriptor protoreflect.FieldDescriptor)
 	case "types.MsgUnBond.bond_provider_address":
 		value := x.BondProviderAddress
 		return protoreflect.ValueOfBytes(value)
+	case "types.MsgUnBond.cancel":
+		value := x.Cancel
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgUnBond"))
@@ -257,6 +272,8 @@ func (x *fastReflection_MsgUnBond) Set(fd protoreflect.FieldDescriptor, value pr
 		x.Signer = value.Bytes()
 	case "types.MsgUnBond.bond_provider_address":
 		x.BondProviderAddress = value.Bytes()
+	case "types.MsgUnBond.cancel":
+		x.Cancel = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgUnBond"))
@@ -292,6 +309,8 @@ func (x *fastReflection_MsgUnBond) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field signer of message types.MsgUnBond is not mutable"))
 	case "types.MsgUnBond.bond_provider_address":
 		panic(fmt.Errorf("field bond_provider_address of message types.MsgUnBond is not mutable"))
+	case "types.MsgUnBond.cancel":
+		panic(fmt.Errorf("field cancel of message types.MsgUnBond is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgUnBond"))
@@ -318,6 +337,8 @@ func (x *fastReflection_MsgUnBond) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfBytes(nil)
 	case "types.MsgUnBond.bond_provider_address":
 		return protoreflect.ValueOfBytes(nil)
+	case "types.MsgUnBond.cancel":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.MsgUnBond"))
@@ -411,6 +432,9 @@ func (x *fastReflection_MsgUnBond) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Cancel {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -440,6 +464,16 @@ func (x *fastReflection_MsgUnBond) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Cancel {
+			i--
+			if x.Cancel {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
 		if len(x.BondProviderAddress) > 0 {
 			i -= len(x.BondProviderAddress)
 			copy(dAtA[i:], x.BondProviderAddress)
@@ -740,6 +774,26 @@ func (x *fastReflection_MsgUnBond) ProtoMethods() *protoiface.Methods {
 					x.BondProviderAddress = []byte{}
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Cancel = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -799,6 +853,7 @@ type MsgUnBond struct {
 	Amount              string     `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	Signer              []byte     `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
 	BondProviderAddress []byte     `protobuf:"bytes,8,opt,name=bond_provider_address,json=bondProviderAddress,proto3" json:"bond_provider_address,omitempty"`
+	Cancel              bool       `protobuf:"varint,9,opt,name=cancel,proto3" json:"cancel,omitempty"`
 }
 
 func (x *MsgUnBond) Reset() {
@@ -863,6 +918,13 @@ func (x *MsgUnBond) GetBondProviderAddress() []byte {
 	return nil
 }
 
+func (x *MsgUnBond) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
 var File_types_msg_unbond_proto protoreflect.FileDescriptor
 
 var file_types_msg_unbond_proto_rawDesc = []byte{
@@ -870,7 +932,7 @@ var file_types_msg_unbond_proto_rawDesc = []byte{
 	0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a,
 	0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xca, 0x03, 0x0a, 0x09, 0x4d,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x03, 0x0a, 0x09, 0x4d,
 	0x73, 0x67, 0x55, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x6e, 0x12,
@@ -899,15 +961,17 @@ var file_types_msg_unbond_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
 	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x52, 0x13, 0x62, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x7b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x42, 0x0e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42,
+	0x7b, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0e, 0x4d, 0x73,
+	0x67, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58,
+	0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
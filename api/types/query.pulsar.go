@@ -44,666 +44,746 @@ var file_types_query_proto_rawDesc = []byte{
 	0x1f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x72, 0x61,
 	0x64, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x16, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x6f,
-	0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x5f, 0x76,
-	0x6f, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x5f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x19, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x2e,
+	0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x73, 0x6c, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x74, 0x77, 0x61,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x62,
+	0x61, 0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x67, 0x6e,
+	0x61, 0x72, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x21, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x68, 0x6f,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x21, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x16, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x75,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x74, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x74, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x20, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
-	0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1c, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x77, 0x61,
-	0x70, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x5f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x5f,
-	0x63, 0x6c, 0x6f, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x75, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x15, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74,
-	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x5f, 0x74, 0x73, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x19, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x75,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x16, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62,
-	0x61, 0x6e, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b,
-	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d,
-	0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f,
-	0x65, 0x69, 0x70, 0x37, 0x31, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xda, 0x48, 0x0a,
-	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x64, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x67, 0x0a, 0x08,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x62, 0x61,
-	0x6e, 0x6b, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x5a, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x12, 0x5a, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x56, 0x0a,
-	0x05, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f,
-	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x70, 0x0a, 0x0b, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64,
-	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
-	0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x6c, 0x0a, 0x0c, 0x44, 0x65, 0x72, 0x69, 0x76,
-	0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x72, 0x79, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x63, 0x79, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x63, 0x79, 0x5f, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x65, 0x69, 0x70, 0x37, 0x31, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x32, 0xd5, 0x51, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x64, 0x0a, 0x07, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0x67, 0x0a, 0x08, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
+	0x18, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x2f,
+	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x5a, 0x0a, 0x06, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x13, 0x12, 0x11, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5a, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x17, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x7d, 0x12, 0x56, 0x0a, 0x05, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x70, 0x0a, 0x0b, 0x44, 0x65, 0x72,
+	0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x12, 0x11, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64,
-	0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x79,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x64, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x6c, 0x0a, 0x0c, 0x44,
+	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x64, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x50, 0x6f, 0x6f,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x6b, 0x0a, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x54, 0x57, 0x41, 0x50, 0x12, 0x1b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x54, 0x57, 0x41, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x54, 0x57, 0x41,
+	0x50, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x74, 0x77, 0x61, 0x70, 0x12,
+	0x9e, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36,
-	0x12, 0x34, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f,
-	0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x6d, 0x0a, 0x05, 0x53, 0x61, 0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d,
-	0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x12, 0x67, 0x0a, 0x06, 0x53, 0x61, 0x76, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x73, 0x12, 0x79, 0x0a, 0x08, 0x42, 0x6f, 0x72,
-	0x72, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x7d, 0x2f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x7d, 0x12, 0x73, 0x0a, 0x09, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72,
-	0x73, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
-	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72,
-	0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f,
-	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x6f, 0x0a, 0x09, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x75, 0x6e,
-	0x69, 0x74, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x6b, 0x0a, 0x0a, 0x54, 0x72,
-	0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
-	0x16, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x7e, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x7f, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x7a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x7b, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x7d, 0x12, 0x76, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73,
-	0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x5c, 0x0a, 0x04,
-	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
-	0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x56, 0x0a, 0x05, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
-	0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x12, 0x67, 0x0a, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x1b,
+	0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x12, 0x34, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0x98, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f, 0x6c, 0x2f,
+	0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x6d, 0x0a, 0x05, 0x53,
+	0x61, 0x76, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x12, 0x27, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72,
+	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x67, 0x0a, 0x06, 0x53, 0x61,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x61, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x61, 0x76,
+	0x65, 0x72, 0x73, 0x12, 0x79, 0x0a, 0x08, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x12,
+	0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70,
+	0x6f, 0x6f, 0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x62, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x73,
+	0x0a, 0x09, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x12, 0x21, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x2f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x12, 0x6f, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x75, 0x6e, 0x69, 0x74, 0x2f, 0x7b, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x7d, 0x12, 0x6b, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x12, 0x7e, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x7d, 0x12, 0x7f, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
+	0x21, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x7d, 0x12, 0x7a, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x64, 0x61, 0x73, 0x73, 0x65, 0x74, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x76,
+	0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12,
+	0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x5c, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
+	0x12, 0x2b, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x56, 0x0a,
+	0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69,
+	0x70, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
-	0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79,
+	0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x73, 0x6c, 0x69, 0x70, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x62,
+	0x0a, 0x09, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x79,
 	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69,
-	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73,
-	0x6c, 0x69, 0x70, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x62, 0x0a, 0x09, 0x50,
-	0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x69, 0x70, 0x73, 0x12,
-	0x78, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x12, 0x1e,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62,
-	0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65,
-	0x65, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x73, 0x0a, 0x0c, 0x4f, 0x75, 0x74,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46,
-	0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x12, 0x7f,
-	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12,
-	0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x74,
-	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0x7b, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
-	0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x6c, 0x69,
+	0x70, 0x73, 0x12, 0x78, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65,
+	0x65, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x66, 0x65, 0x65, 0x2f, 0x7b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x7d, 0x12, 0x73, 0x0a, 0x0c,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x12, 0x7f, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
+	0x21, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x77, 0x61, 0x70,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
-	0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x77, 0x61,
-	0x70, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a, 0x03,
-	0x42, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x20, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x62, 0x61, 0x6e, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x62,
-	0x0a, 0x08, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x61, 0x67, 0x6e, 0x61, 0x72,
-	0x6f, 0x6b, 0x12, 0x62, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x1b,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x15, 0x12, 0x13, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x75,
-	0x6e, 0x65, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x7d, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x73, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x7f, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x7b, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a,
+	0x03, 0x42, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x61, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x62, 0x61, 0x6e, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12,
+	0x62, 0x0a, 0x08, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x61, 0x67, 0x6e, 0x61, 0x72, 0x6f, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x61, 0x67, 0x6e, 0x61,
+	0x72, 0x6f, 0x6b, 0x12, 0x62, 0x0a, 0x08, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
+	0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72,
+	0x75, 0x6e, 0x65, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x7d, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x24, 0x12, 0x22, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72, 0x75,
-	0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x77, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x68,
-	0x0a, 0x0b, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x75, 0x0a, 0x0c, 0x4d, 0x69, 0x6d, 0x69,
-	0x72, 0x57, 0x69, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74, 0x68, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74, 0x68,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6b, 0x65, 0x79, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12,
-	0x7d, 0x0a, 0x10, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x8a,
-	0x01, 0x0a, 0x13, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c,
-	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x24, 0x12, 0x22, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x72,
+	0x75, 0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x77, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x68, 0x0a, 0x0b, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1e,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69,
-	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12,
-	0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69,
-	0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x7d, 0x0a, 0x10, 0x4d,
-	0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
-	0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d,
-	0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x18, 0x12, 0x16, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d,
-	0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x4d,
-	0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x22,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69,
-	0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12,
-	0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69,
-	0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x12, 0x83, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x14, 0x12, 0x12, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x08, 0x54, 0x68, 0x6f, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x68, 0x6f,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
-	0x7d, 0x12, 0x6d, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1c,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x7d,
-	0x12, 0x6a, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1d,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72,
-	0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x07,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x7e, 0x0a, 0x0d,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x67, 0x0a, 0x09,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65,
-	0x2f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x88, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53,
-	0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61,
-	0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
-	0x12, 0x1e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f,
-	0x74, 0x65, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x8c, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65,
-	0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
-	0x78, 0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e,
-	0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f,
-	0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x7c, 0x0a, 0x0e, 0x51, 0x75, 0x6f,
-	0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f,
-	0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74,
-	0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x6c, 0x6f, 0x61,
-	0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x67,
-	0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x12, 0x15, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x7d, 0x0a, 0x0b, 0x53, 0x77, 0x61, 0x70, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
-	0x25, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2f, 0x7b,
-	0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x7b, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6c, 0x61, 0x73,
-	0x74, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x60,
-	0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d,
-	0x12, 0x73, 0x0a, 0x0c, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73,
-	0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73,
-	0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x61,
-	0x73, 0x67, 0x61, 0x72, 0x64, 0x12, 0x77, 0x0a, 0x0d, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50,
-	0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x6b,
-	0x0a, 0x08, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x74, 0x61,
-	0x67, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6b, 0x0a, 0x08, 0x54,
-	0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x52, 0x0a, 0x02, 0x54, 0x78, 0x12, 0x15,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6b, 0x0a, 0x08,
-	0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x56, 0x6f, 0x74,
-	0x65, 0x72, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x0b, 0x54, 0x78, 0x56,
-	0x6f, 0x74, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x56, 0x6f, 0x74,
-	0x65, 0x72, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x66, 0x0a, 0x05, 0x43, 0x6c, 0x6f,
-	0x75, 0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x77, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
-	0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6c, 0x6f,
-	0x75, 0x74, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x7d, 0x12, 0x56, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
 	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x7b, 0x0a, 0x11, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x24,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x76, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x56,
-	0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x54, 0x73, 0x73, 0x4b, 0x65,
-	0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65,
-	0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4b,
-	0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x6b,
-	0x65, 0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12,
-	0x64, 0x0a, 0x09, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x14, 0x12, 0x12, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e,
-	0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65,
-	0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6b, 0x65,
-	0x79, 0x73, 0x69, 0x67, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12, 0x7d,
-	0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
-	0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79,
-	0x73, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b,
-	0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x6d, 0x0a,
-	0x06, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x83, 0x01, 0x0a,
-	0x10, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x75, 0x0a, 0x0c, 0x4d, 0x69, 0x6d,
+	0x69, 0x72, 0x57, 0x69, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74, 0x68,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x57, 0x69, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6b, 0x65, 0x79, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d,
+	0x12, 0x7d, 0x0a, 0x10, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12,
+	0x7b, 0x0a, 0x0e, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x69, 0x6d, 0x69, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
+	0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d,
+	0x69, 0x72, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x77, 0x0a, 0x0d,
+	0x4d, 0x69, 0x6d, 0x69, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x20, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d,
+	0x69, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x79, 0x0a, 0x0c, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x12, 0x1e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d,
+	0x69, 0x72, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d,
+	0x12, 0x8a, 0x01, 0x0a, 0x13, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41,
+	0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x41, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69,
+	0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x41, 0x6c, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69,
+	0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x7d, 0x0a,
+	0x10, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7a, 0x0a, 0x0c, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73,
-	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x71, 0x0a, 0x09, 0x54, 0x43, 0x59, 0x53, 0x74,
-	0x61, 0x6b, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72,
-	0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6b, 0x0a, 0x0a, 0x54, 0x43,
-	0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x83, 0x01, 0x0a,
+	0x0f, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69,
+	0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x69,
+	0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x6d, 0x69, 0x6d, 0x69, 0x72, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x83, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x08, 0x54, 0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x68, 0x6f, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x12, 0x6d, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x69,
+	0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x2f, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x7d, 0x12,
+	0x6a, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x69, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x07, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x7e, 0x0a, 0x0d, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2f, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x67, 0x0a, 0x09, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x12, 0x88, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61,
+	0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76,
+	0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12,
+	0x1e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x2f, 0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x8c, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x25, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x53, 0x61, 0x76, 0x65, 0x72, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f,
+	0x73, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x78,
+	0x0a, 0x0d, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x12,
+	0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x7c, 0x0a, 0x0e, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61,
+	0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x4c, 0x6f, 0x61, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x67, 0x0a,
+	0x09, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
+	0x15, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x7d, 0x0a, 0x0b, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2f, 0x7b, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x0a, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x7b, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6c, 0x61, 0x73, 0x74,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x7b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x12, 0x60, 0x0a,
+	0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x76, 0x61, 0x75, 0x6c, 0x74, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12,
+	0x73, 0x0a, 0x0c, 0x41, 0x73, 0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x67,
+	0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73,
+	0x67, 0x61, 0x72, 0x64, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x61, 0x73,
+	0x67, 0x61, 0x72, 0x64, 0x12, 0x77, 0x0a, 0x0d, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x6b, 0x0a,
+	0x08, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6b, 0x0a, 0x08, 0x54, 0x78,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f,
+	0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x52, 0x0a, 0x02, 0x54, 0x78, 0x12, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6b, 0x0a, 0x08, 0x54,
+	0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x0b, 0x54, 0x78, 0x56, 0x6f,
+	0x74, 0x65, 0x72, 0x73, 0x4f, 0x6c, 0x64, 0x12, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x66, 0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x75,
+	0x74, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x43, 0x6c, 0x6f, 0x75, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12,
+	0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x63, 0x6c, 0x6f, 0x75,
+	0x74, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0x56, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x7b, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x24, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x76, 0x0a, 0x0f, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x56, 0x0a,
+	0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4b, 0x65,
+	0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x2f, 0x6b, 0x65,
+	0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x64,
+	0x0a, 0x09, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x12, 0x12, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x67, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x12,
+	0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79,
+	0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x12, 0x1b, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x6b, 0x65, 0x79,
+	0x73, 0x69, 0x67, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12, 0x7d, 0x0a,
+	0x0d, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x20,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x73,
+	0x69, 0x67, 0x6e, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b, 0x65,
+	0x79, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x6d, 0x0a, 0x06,
+	0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4b,
+	0x65, 0x79, 0x67, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x7d, 0x2f, 0x7b, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x83, 0x01, 0x0a, 0x10,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x12, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7a, 0x0a, 0x0c, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x71, 0x0a, 0x09, 0x54, 0x43, 0x59, 0x53, 0x74, 0x61,
+	0x6b, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x2f,
+	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6b, 0x0a, 0x0a, 0x54, 0x43, 0x59,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
-	0x16, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f,
-	0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x75, 0x0a, 0x0a, 0x54, 0x43, 0x59, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x74,
-	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61,
-	0x69, 0x6d, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6f,
-	0x0a, 0x0b, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x43, 0x6c,
-	0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12,
-	0x78, 0x0a, 0x0f, 0x45, 0x69, 0x70, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x45, 0x69, 0x70, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x45, 0x69, 0x70, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x65, 0x69, 0x70, 0x37, 0x31, 0x35, 0x2f, 0x74, 0x78, 0x42, 0x7b, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02,
-	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0xc8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x75, 0x0a, 0x0a, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x65, 0x72, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x6f, 0x0a,
+	0x0b, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x63, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x78,
+	0x0a, 0x0f, 0x45, 0x69, 0x70, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x22, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
+	0x69, 0x70, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x69, 0x70, 0x37, 0x31, 0x32, 0x54, 0x79, 0x70, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65,
+	0x69, 0x70, 0x37, 0x31, 0x35, 0x2f, 0x74, 0x78, 0x42, 0x7b, 0xc8, 0xe2, 0x1e, 0x01, 0x0a, 0x09,
+	0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_types_query_proto_goTypes = []interface{}{
@@ -714,153 +794,170 @@ var file_types_query_proto_goTypes = []interface{}{
 	(*QueryPoolsRequest)(nil),                // 4: types.QueryPoolsRequest
 	(*QueryDerivedPoolRequest)(nil),          // 5: types.QueryDerivedPoolRequest
 	(*QueryDerivedPoolsRequest)(nil),         // 6: types.QueryDerivedPoolsRequest
-	(*QueryLiquidityProviderRequest)(nil),    // 7: types.QueryLiquidityProviderRequest
-	(*QueryLiquidityProvidersRequest)(nil),   // 8: types.QueryLiquidityProvidersRequest
-	(*QuerySaverRequest)(nil),                // 9: types.QuerySaverRequest
-	(*QuerySaversRequest)(nil),               // 10: types.QuerySaversRequest
-	(*QueryBorrowerRequest)(nil),             // 11: types.QueryBorrowerRequest
-	(*QueryBorrowersRequest)(nil),            // 12: types.QueryBorrowersRequest
-	(*QueryTradeUnitRequest)(nil),            // 13: types.QueryTradeUnitRequest
-	(*QueryTradeUnitsRequest)(nil),           // 14: types.QueryTradeUnitsRequest
-	(*QueryTradeAccountRequest)(nil),         // 15: types.QueryTradeAccountRequest
-	(*QueryTradeAccountsRequest)(nil),        // 16: types.QueryTradeAccountsRequest
-	(*QuerySecuredAssetRequest)(nil),         // 17: types.QuerySecuredAssetRequest
-	(*QuerySecuredAssetsRequest)(nil),        // 18: types.QuerySecuredAssetsRequest
-	(*QueryNodeRequest)(nil),                 // 19: types.QueryNodeRequest
-	(*QueryNodesRequest)(nil),                // 20: types.QueryNodesRequest
-	(*QueryPoolSlipRequest)(nil),             // 21: types.QueryPoolSlipRequest
-	(*QueryPoolSlipsRequest)(nil),            // 22: types.QueryPoolSlipsRequest
-	(*QueryOutboundFeeRequest)(nil),          // 23: types.QueryOutboundFeeRequest
-	(*QueryOutboundFeesRequest)(nil),         // 24: types.QueryOutboundFeesRequest
-	(*QueryStreamingSwapRequest)(nil),        // 25: types.QueryStreamingSwapRequest
-	(*QueryStreamingSwapsRequest)(nil),       // 26: types.QueryStreamingSwapsRequest
-	(*QueryBanRequest)(nil),                  // 27: types.QueryBanRequest
-	(*QueryRagnarokRequest)(nil),             // 28: types.QueryRagnarokRequest
-	(*QueryRunePoolRequest)(nil),             // 29: types.QueryRunePoolRequest
-	(*QueryRuneProviderRequest)(nil),         // 30: types.QueryRuneProviderRequest
-	(*QueryRuneProvidersRequest)(nil),        // 31: types.QueryRuneProvidersRequest
-	(*QueryMimirValuesRequest)(nil),          // 32: types.QueryMimirValuesRequest
-	(*QueryMimirWithKeyRequest)(nil),         // 33: types.QueryMimirWithKeyRequest
-	(*QueryMimirAdminValuesRequest)(nil),     // 34: types.QueryMimirAdminValuesRequest
-	(*QueryMimirNodesAllValuesRequest)(nil),  // 35: types.QueryMimirNodesAllValuesRequest
-	(*QueryMimirNodesValuesRequest)(nil),     // 36: types.QueryMimirNodesValuesRequest
-	(*QueryMimirNodeValuesRequest)(nil),      // 37: types.QueryMimirNodeValuesRequest
-	(*QueryInboundAddressesRequest)(nil),     // 38: types.QueryInboundAddressesRequest
-	(*QueryVersionRequest)(nil),              // 39: types.QueryVersionRequest
-	(*QueryThornameRequest)(nil),             // 40: types.QueryThornameRequest
-	(*QueryInvariantRequest)(nil),            // 41: types.QueryInvariantRequest
-	(*QueryInvariantsRequest)(nil),           // 42: types.QueryInvariantsRequest
-	(*QueryNetworkRequest)(nil),              // 43: types.QueryNetworkRequest
-	(*QueryBalanceModuleRequest)(nil),        // 44: types.QueryBalanceModuleRequest
-	(*QueryQuoteSwapRequest)(nil),            // 45: types.QueryQuoteSwapRequest
-	(*QueryQuoteSaverDepositRequest)(nil),    // 46: types.QueryQuoteSaverDepositRequest
-	(*QueryQuoteSaverWithdrawRequest)(nil),   // 47: types.QueryQuoteSaverWithdrawRequest
-	(*QueryQuoteLoanOpenRequest)(nil),        // 48: types.QueryQuoteLoanOpenRequest
-	(*QueryQuoteLoanCloseRequest)(nil),       // 49: types.QueryQuoteLoanCloseRequest
-	(*QueryConstantValuesRequest)(nil),       // 50: types.QueryConstantValuesRequest
-	(*QuerySwapQueueRequest)(nil),            // 51: types.QuerySwapQueueRequest
-	(*QuerySwapDetailsRequest)(nil),          // 52: types.QuerySwapDetailsRequest
-	(*QueryLastBlocksRequest)(nil),           // 53: types.QueryLastBlocksRequest
-	(*QueryChainsLastBlockRequest)(nil),      // 54: types.QueryChainsLastBlockRequest
-	(*QueryVaultRequest)(nil),                // 55: types.QueryVaultRequest
-	(*QueryAsgardVaultsRequest)(nil),         // 56: types.QueryAsgardVaultsRequest
-	(*QueryVaultsPubkeysRequest)(nil),        // 57: types.QueryVaultsPubkeysRequest
-	(*QueryTxStagesRequest)(nil),             // 58: types.QueryTxStagesRequest
-	(*QueryTxStatusRequest)(nil),             // 59: types.QueryTxStatusRequest
-	(*QueryTxRequest)(nil),                   // 60: types.QueryTxRequest
-	(*QueryTxVotersRequest)(nil),             // 61: types.QueryTxVotersRequest
-	(*QuerySwapperCloutRequest)(nil),         // 62: types.QuerySwapperCloutRequest
-	(*QueryQueueRequest)(nil),                // 63: types.QueryQueueRequest
-	(*QueryScheduledOutboundRequest)(nil),    // 64: types.QueryScheduledOutboundRequest
-	(*QueryPendingOutboundRequest)(nil),      // 65: types.QueryPendingOutboundRequest
-	(*QueryBlockRequest)(nil),                // 66: types.QueryBlockRequest
-	(*QueryTssKeygenMetricRequest)(nil),      // 67: types.QueryTssKeygenMetricRequest
-	(*QueryTssMetricRequest)(nil),            // 68: types.QueryTssMetricRequest
-	(*QueryKeysignRequest)(nil),              // 69: types.QueryKeysignRequest
-	(*QueryKeysignPubkeyRequest)(nil),        // 70: types.QueryKeysignPubkeyRequest
-	(*QueryKeygenRequest)(nil),               // 71: types.QueryKeygenRequest
-	(*QueryUpgradeProposalsRequest)(nil),     // 72: types.QueryUpgradeProposalsRequest
-	(*QueryUpgradeProposalRequest)(nil),      // 73: types.QueryUpgradeProposalRequest
-	(*QueryUpgradeVotesRequest)(nil),         // 74: types.QueryUpgradeVotesRequest
-	(*QueryTCYStakerRequest)(nil),            // 75: types.QueryTCYStakerRequest
-	(*QueryTCYStakersRequest)(nil),           // 76: types.QueryTCYStakersRequest
-	(*QueryTCYClaimerRequest)(nil),           // 77: types.QueryTCYClaimerRequest
-	(*QueryTCYClaimersRequest)(nil),          // 78: types.QueryTCYClaimersRequest
-	(*QueryEip712TypedDataRequest)(nil),      // 79: types.QueryEip712TypedDataRequest
-	(*QueryAccountResponse)(nil),             // 80: types.QueryAccountResponse
-	(*QueryBalancesResponse)(nil),            // 81: types.QueryBalancesResponse
-	(*QueryExportResponse)(nil),              // 82: types.QueryExportResponse
-	(*QueryPoolResponse)(nil),                // 83: types.QueryPoolResponse
-	(*QueryPoolsResponse)(nil),               // 84: types.QueryPoolsResponse
-	(*QueryDerivedPoolResponse)(nil),         // 85: types.QueryDerivedPoolResponse
-	(*QueryDerivedPoolsResponse)(nil),        // 86: types.QueryDerivedPoolsResponse
-	(*QueryLiquidityProviderResponse)(nil),   // 87: types.QueryLiquidityProviderResponse
-	(*QueryLiquidityProvidersResponse)(nil),  // 88: types.QueryLiquidityProvidersResponse
-	(*QuerySaverResponse)(nil),               // 89: types.QuerySaverResponse
-	(*QuerySaversResponse)(nil),              // 90: types.QuerySaversResponse
-	(*QueryBorrowerResponse)(nil),            // 91: types.QueryBorrowerResponse
-	(*QueryBorrowersResponse)(nil),           // 92: types.QueryBorrowersResponse
-	(*QueryTradeUnitResponse)(nil),           // 93: types.QueryTradeUnitResponse
-	(*QueryTradeUnitsResponse)(nil),          // 94: types.QueryTradeUnitsResponse
-	(*QueryTradeAccountsResponse)(nil),       // 95: types.QueryTradeAccountsResponse
-	(*QuerySecuredAssetResponse)(nil),        // 96: types.QuerySecuredAssetResponse
-	(*QuerySecuredAssetsResponse)(nil),       // 97: types.QuerySecuredAssetsResponse
-	(*QueryNodeResponse)(nil),                // 98: types.QueryNodeResponse
-	(*QueryNodesResponse)(nil),               // 99: types.QueryNodesResponse
-	(*QueryPoolSlipsResponse)(nil),           // 100: types.QueryPoolSlipsResponse
-	(*QueryOutboundFeesResponse)(nil),        // 101: types.QueryOutboundFeesResponse
-	(*QueryStreamingSwapResponse)(nil),       // 102: types.QueryStreamingSwapResponse
-	(*QueryStreamingSwapsResponse)(nil),      // 103: types.QueryStreamingSwapsResponse
-	(*BanVoter)(nil),                         // 104: types.BanVoter
-	(*QueryRagnarokResponse)(nil),            // 105: types.QueryRagnarokResponse
-	(*QueryRunePoolResponse)(nil),            // 106: types.QueryRunePoolResponse
-	(*QueryRuneProviderResponse)(nil),        // 107: types.QueryRuneProviderResponse
-	(*QueryRuneProvidersResponse)(nil),       // 108: types.QueryRuneProvidersResponse
-	(*QueryMimirValuesResponse)(nil),         // 109: types.QueryMimirValuesResponse
-	(*QueryMimirWithKeyResponse)(nil),        // 110: types.QueryMimirWithKeyResponse
-	(*QueryMimirAdminValuesResponse)(nil),    // 111: types.QueryMimirAdminValuesResponse
-	(*QueryMimirNodesAllValuesResponse)(nil), // 112: types.QueryMimirNodesAllValuesResponse
-	(*QueryMimirNodesValuesResponse)(nil),    // 113: types.QueryMimirNodesValuesResponse
-	(*QueryMimirNodeValuesResponse)(nil),     // 114: types.QueryMimirNodeValuesResponse
-	(*QueryInboundAddressesResponse)(nil),    // 115: types.QueryInboundAddressesResponse
-	(*QueryVersionResponse)(nil),             // 116: types.QueryVersionResponse
-	(*QueryThornameResponse)(nil),            // 117: types.QueryThornameResponse
-	(*QueryInvariantResponse)(nil),           // 118: types.QueryInvariantResponse
-	(*QueryInvariantsResponse)(nil),          // 119: types.QueryInvariantsResponse
-	(*QueryNetworkResponse)(nil),             // 120: types.QueryNetworkResponse
-	(*QueryBalanceModuleResponse)(nil),       // 121: types.QueryBalanceModuleResponse
-	(*QueryQuoteSwapResponse)(nil),           // 122: types.QueryQuoteSwapResponse
-	(*QueryQuoteSaverDepositResponse)(nil),   // 123: types.QueryQuoteSaverDepositResponse
-	(*QueryQuoteSaverWithdrawResponse)(nil),  // 124: types.QueryQuoteSaverWithdrawResponse
-	(*QueryQuoteLoanOpenResponse)(nil),       // 125: types.QueryQuoteLoanOpenResponse
-	(*QueryQuoteLoanCloseResponse)(nil),      // 126: types.QueryQuoteLoanCloseResponse
-	(*QueryConstantValuesResponse)(nil),      // 127: types.QueryConstantValuesResponse
-	(*QuerySwapQueueResponse)(nil),           // 128: types.QuerySwapQueueResponse
-	(*QuerySwapDetailsResponse)(nil),         // 129: types.QuerySwapDetailsResponse
-	(*QueryLastBlocksResponse)(nil),          // 130: types.QueryLastBlocksResponse
-	(*QueryVaultResponse)(nil),               // 131: types.QueryVaultResponse
-	(*QueryAsgardVaultsResponse)(nil),        // 132: types.QueryAsgardVaultsResponse
-	(*QueryVaultsPubkeysResponse)(nil),       // 133: types.QueryVaultsPubkeysResponse
-	(*QueryTxStagesResponse)(nil),            // 134: types.QueryTxStagesResponse
-	(*QueryTxStatusResponse)(nil),            // 135: types.QueryTxStatusResponse
-	(*QueryTxResponse)(nil),                  // 136: types.QueryTxResponse
-	(*QueryObservedTxVoter)(nil),             // 137: types.QueryObservedTxVoter
-	(*SwapperClout)(nil),                     // 138: types.SwapperClout
-	(*QueryQueueResponse)(nil),               // 139: types.QueryQueueResponse
-	(*QueryOutboundResponse)(nil),            // 140: types.QueryOutboundResponse
-	(*QueryBlockResponse)(nil),               // 141: types.QueryBlockResponse
-	(*QueryTssKeygenMetricResponse)(nil),     // 142: types.QueryTssKeygenMetricResponse
-	(*QueryTssMetricResponse)(nil),           // 143: types.QueryTssMetricResponse
-	(*QueryKeysignResponse)(nil),             // 144: types.QueryKeysignResponse
-	(*QueryKeygenResponse)(nil),              // 145: types.QueryKeygenResponse
-	(*QueryUpgradeProposalsResponse)(nil),    // 146: types.QueryUpgradeProposalsResponse
-	(*QueryUpgradeProposalResponse)(nil),     // 147: types.QueryUpgradeProposalResponse
-	(*QueryUpgradeVotesResponse)(nil),        // 148: types.QueryUpgradeVotesResponse
-	(*QueryTCYStakerResponse)(nil),           // 149: types.QueryTCYStakerResponse
-	(*QueryTCYStakersResponse)(nil),          // 150: types.QueryTCYStakersResponse
-	(*QueryTCYClaimerResponse)(nil),          // 151: types.QueryTCYClaimerResponse
-	(*QueryTCYClaimersResponse)(nil),         // 152: types.QueryTCYClaimersResponse
-	(*QueryEip712TypedDataResponse)(nil),     // 153: types.QueryEip712TypedDataResponse
+	(*QueryPoolHistoryRequest)(nil),          // 7: types.QueryPoolHistoryRequest
+	(*QueryPoolTWAPRequest)(nil),             // 8: types.QueryPoolTWAPRequest
+	(*QueryLiquidityProviderRequest)(nil),    // 9: types.QueryLiquidityProviderRequest
+	(*QueryLiquidityProvidersRequest)(nil),   // 10: types.QueryLiquidityProvidersRequest
+	(*QuerySaverRequest)(nil),                // 11: types.QuerySaverRequest
+	(*QuerySaversRequest)(nil),               // 12: types.QuerySaversRequest
+	(*QueryBorrowerRequest)(nil),             // 13: types.QueryBorrowerRequest
+	(*QueryBorrowersRequest)(nil),            // 14: types.QueryBorrowersRequest
+	(*QueryTradeUnitRequest)(nil),            // 15: types.QueryTradeUnitRequest
+	(*QueryTradeUnitsRequest)(nil),           // 16: types.QueryTradeUnitsRequest
+	(*QueryTradeAccountRequest)(nil),         // 17: types.QueryTradeAccountRequest
+	(*QueryTradeAccountsRequest)(nil),        // 18: types.QueryTradeAccountsRequest
+	(*QuerySecuredAssetRequest)(nil),         // 19: types.QuerySecuredAssetRequest
+	(*QuerySecuredAssetsRequest)(nil),        // 20: types.QuerySecuredAssetsRequest
+	(*QueryNodeRequest)(nil),                 // 21: types.QueryNodeRequest
+	(*QueryObservationStatsRequest)(nil),     // 22: types.QueryObservationStatsRequest
+	(*QueryNodesRequest)(nil),                // 23: types.QueryNodesRequest
+	(*QueryPoolSlipRequest)(nil),             // 24: types.QueryPoolSlipRequest
+	(*QueryPoolSlipsRequest)(nil),            // 25: types.QueryPoolSlipsRequest
+	(*QueryOutboundFeeRequest)(nil),          // 26: types.QueryOutboundFeeRequest
+	(*QueryOutboundFeesRequest)(nil),         // 27: types.QueryOutboundFeesRequest
+	(*QueryStreamingSwapRequest)(nil),        // 28: types.QueryStreamingSwapRequest
+	(*QueryStreamingSwapsRequest)(nil),       // 29: types.QueryStreamingSwapsRequest
+	(*QueryRecurringSwapRequest)(nil),        // 30: types.QueryRecurringSwapRequest
+	(*QueryRecurringSwapsRequest)(nil),       // 31: types.QueryRecurringSwapsRequest
+	(*QueryBanRequest)(nil),                  // 32: types.QueryBanRequest
+	(*QueryRagnarokRequest)(nil),             // 33: types.QueryRagnarokRequest
+	(*QueryRunePoolRequest)(nil),             // 34: types.QueryRunePoolRequest
+	(*QueryRuneProviderRequest)(nil),         // 35: types.QueryRuneProviderRequest
+	(*QueryRuneProvidersRequest)(nil),        // 36: types.QueryRuneProvidersRequest
+	(*QueryMimirValuesRequest)(nil),          // 37: types.QueryMimirValuesRequest
+	(*QueryMimirWithKeyRequest)(nil),         // 38: types.QueryMimirWithKeyRequest
+	(*QueryMimirAdminValuesRequest)(nil),     // 39: types.QueryMimirAdminValuesRequest
+	(*QueryMimirScheduledRequest)(nil),       // 40: types.QueryMimirScheduledRequest
+	(*QueryMimirRegistryRequest)(nil),        // 41: types.QueryMimirRegistryRequest
+	(*QueryMimirHistoryRequest)(nil),         // 42: types.QueryMimirHistoryRequest
+	(*QueryMimirNodesAllValuesRequest)(nil),  // 43: types.QueryMimirNodesAllValuesRequest
+	(*QueryMimirNodesValuesRequest)(nil),     // 44: types.QueryMimirNodesValuesRequest
+	(*QueryMimirNodeValuesRequest)(nil),      // 45: types.QueryMimirNodeValuesRequest
+	(*QueryMimirNodeHistoryRequest)(nil),     // 46: types.QueryMimirNodeHistoryRequest
+	(*QueryInboundAddressesRequest)(nil),     // 47: types.QueryInboundAddressesRequest
+	(*QueryVersionRequest)(nil),              // 48: types.QueryVersionRequest
+	(*QueryThornameRequest)(nil),             // 49: types.QueryThornameRequest
+	(*QueryInvariantRequest)(nil),            // 50: types.QueryInvariantRequest
+	(*QueryInvariantsRequest)(nil),           // 51: types.QueryInvariantsRequest
+	(*QueryNetworkRequest)(nil),              // 52: types.QueryNetworkRequest
+	(*QueryBalanceModuleRequest)(nil),        // 53: types.QueryBalanceModuleRequest
+	(*QueryQuoteSwapRequest)(nil),            // 54: types.QueryQuoteSwapRequest
+	(*QueryQuoteSaverDepositRequest)(nil),    // 55: types.QueryQuoteSaverDepositRequest
+	(*QueryQuoteSaverWithdrawRequest)(nil),   // 56: types.QueryQuoteSaverWithdrawRequest
+	(*QueryQuoteLoanOpenRequest)(nil),        // 57: types.QueryQuoteLoanOpenRequest
+	(*QueryQuoteLoanCloseRequest)(nil),       // 58: types.QueryQuoteLoanCloseRequest
+	(*QueryConstantValuesRequest)(nil),       // 59: types.QueryConstantValuesRequest
+	(*QuerySwapQueueRequest)(nil),            // 60: types.QuerySwapQueueRequest
+	(*QuerySwapDetailsRequest)(nil),          // 61: types.QuerySwapDetailsRequest
+	(*QueryLastBlocksRequest)(nil),           // 62: types.QueryLastBlocksRequest
+	(*QueryChainsLastBlockRequest)(nil),      // 63: types.QueryChainsLastBlockRequest
+	(*QueryVaultRequest)(nil),                // 64: types.QueryVaultRequest
+	(*QueryAsgardVaultsRequest)(nil),         // 65: types.QueryAsgardVaultsRequest
+	(*QueryVaultsPubkeysRequest)(nil),        // 66: types.QueryVaultsPubkeysRequest
+	(*QueryTxStagesRequest)(nil),             // 67: types.QueryTxStagesRequest
+	(*QueryTxStatusRequest)(nil),             // 68: types.QueryTxStatusRequest
+	(*QueryTxRequest)(nil),                   // 69: types.QueryTxRequest
+	(*QueryTxVotersRequest)(nil),             // 70: types.QueryTxVotersRequest
+	(*QuerySwapperCloutRequest)(nil),         // 71: types.QuerySwapperCloutRequest
+	(*QueryQueueRequest)(nil),                // 72: types.QueryQueueRequest
+	(*QueryScheduledOutboundRequest)(nil),    // 73: types.QueryScheduledOutboundRequest
+	(*QueryPendingOutboundRequest)(nil),      // 74: types.QueryPendingOutboundRequest
+	(*QueryBlockRequest)(nil),                // 75: types.QueryBlockRequest
+	(*QueryTssKeygenMetricRequest)(nil),      // 76: types.QueryTssKeygenMetricRequest
+	(*QueryTssMetricRequest)(nil),            // 77: types.QueryTssMetricRequest
+	(*QueryKeysignRequest)(nil),              // 78: types.QueryKeysignRequest
+	(*QueryKeysignPubkeyRequest)(nil),        // 79: types.QueryKeysignPubkeyRequest
+	(*QueryKeygenRequest)(nil),               // 80: types.QueryKeygenRequest
+	(*QueryUpgradeProposalsRequest)(nil),     // 81: types.QueryUpgradeProposalsRequest
+	(*QueryUpgradeProposalRequest)(nil),      // 82: types.QueryUpgradeProposalRequest
+	(*QueryUpgradeVotesRequest)(nil),         // 83: types.QueryUpgradeVotesRequest
+	(*QueryTCYStakerRequest)(nil),            // 84: types.QueryTCYStakerRequest
+	(*QueryTCYStakersRequest)(nil),           // 85: types.QueryTCYStakersRequest
+	(*QueryTCYClaimerRequest)(nil),           // 86: types.QueryTCYClaimerRequest
+	(*QueryTCYClaimersRequest)(nil),          // 87: types.QueryTCYClaimersRequest
+	(*QueryEip712TypedDataRequest)(nil),      // 88: types.QueryEip712TypedDataRequest
+	(*QueryAccountResponse)(nil),             // 89: types.QueryAccountResponse
+	(*QueryBalancesResponse)(nil),            // 90: types.QueryBalancesResponse
+	(*QueryExportResponse)(nil),              // 91: types.QueryExportResponse
+	(*QueryPoolResponse)(nil),                // 92: types.QueryPoolResponse
+	(*QueryPoolsResponse)(nil),               // 93: types.QueryPoolsResponse
+	(*QueryDerivedPoolResponse)(nil),         // 94: types.QueryDerivedPoolResponse
+	(*QueryDerivedPoolsResponse)(nil),        // 95: types.QueryDerivedPoolsResponse
+	(*QueryPoolHistoryResponse)(nil),         // 96: types.QueryPoolHistoryResponse
+	(*QueryPoolTWAPResponse)(nil),            // 97: types.QueryPoolTWAPResponse
+	(*QueryLiquidityProviderResponse)(nil),   // 98: types.QueryLiquidityProviderResponse
+	(*QueryLiquidityProvidersResponse)(nil),  // 99: types.QueryLiquidityProvidersResponse
+	(*QuerySaverResponse)(nil),               // 100: types.QuerySaverResponse
+	(*QuerySaversResponse)(nil),              // 101: types.QuerySaversResponse
+	(*QueryBorrowerResponse)(nil),            // 102: types.QueryBorrowerResponse
+	(*QueryBorrowersResponse)(nil),           // 103: types.QueryBorrowersResponse
+	(*QueryTradeUnitResponse)(nil),           // 104: types.QueryTradeUnitResponse
+	(*QueryTradeUnitsResponse)(nil),          // 105: types.QueryTradeUnitsResponse
+	(*QueryTradeAccountsResponse)(nil),       // 106: types.QueryTradeAccountsResponse
+	(*QuerySecuredAssetResponse)(nil),        // 107: types.QuerySecuredAssetResponse
+	(*QuerySecuredAssetsResponse)(nil),       // 108: types.QuerySecuredAssetsResponse
+	(*QueryNodeResponse)(nil),                // 109: types.QueryNodeResponse
+	(*QueryObservationStatsResponse)(nil),    // 110: types.QueryObservationStatsResponse
+	(*QueryNodesResponse)(nil),               // 111: types.QueryNodesResponse
+	(*QueryPoolSlipsResponse)(nil),           // 112: types.QueryPoolSlipsResponse
+	(*QueryOutboundFeesResponse)(nil),        // 113: types.QueryOutboundFeesResponse
+	(*QueryStreamingSwapResponse)(nil),       // 114: types.QueryStreamingSwapResponse
+	(*QueryStreamingSwapsResponse)(nil),      // 115: types.QueryStreamingSwapsResponse
+	(*QueryRecurringSwapResponse)(nil),       // 116: types.QueryRecurringSwapResponse
+	(*QueryRecurringSwapsResponse)(nil),      // 117: types.QueryRecurringSwapsResponse
+	(*BanVoter)(nil),                         // 118: types.BanVoter
+	(*QueryRagnarokResponse)(nil),            // 119: types.QueryRagnarokResponse
+	(*QueryRunePoolResponse)(nil),            // 120: types.QueryRunePoolResponse
+	(*QueryRuneProviderResponse)(nil),        // 121: types.QueryRuneProviderResponse
+	(*QueryRuneProvidersResponse)(nil),       // 122: types.QueryRuneProvidersResponse
+	(*QueryMimirValuesResponse)(nil),         // 123: types.QueryMimirValuesResponse
+	(*QueryMimirWithKeyResponse)(nil),        // 124: types.QueryMimirWithKeyResponse
+	(*QueryMimirAdminValuesResponse)(nil),    // 125: types.QueryMimirAdminValuesResponse
+	(*QueryMimirScheduledResponse)(nil),      // 126: types.QueryMimirScheduledResponse
+	(*QueryMimirRegistryResponse)(nil),       // 127: types.QueryMimirRegistryResponse
+	(*QueryMimirHistoryResponse)(nil),        // 128: types.QueryMimirHistoryResponse
+	(*QueryMimirNodesAllValuesResponse)(nil), // 129: types.QueryMimirNodesAllValuesResponse
+	(*QueryMimirNodesValuesResponse)(nil),    // 130: types.QueryMimirNodesValuesResponse
+	(*QueryMimirNodeValuesResponse)(nil),     // 131: types.QueryMimirNodeValuesResponse
+	(*QueryInboundAddressesResponse)(nil),    // 132: types.QueryInboundAddressesResponse
+	(*QueryVersionResponse)(nil),             // 133: types.QueryVersionResponse
+	(*QueryThornameResponse)(nil),            // 134: types.QueryThornameResponse
+	(*QueryInvariantResponse)(nil),           // 135: types.QueryInvariantResponse
+	(*QueryInvariantsResponse)(nil),          // 136: types.QueryInvariantsResponse
+	(*QueryNetworkResponse)(nil),             // 137: types.QueryNetworkResponse
+	(*QueryBalanceModuleResponse)(nil),       // 138: types.QueryBalanceModuleResponse
+	(*QueryQuoteSwapResponse)(nil),           // 139: types.QueryQuoteSwapResponse
+	(*QueryQuoteSaverDepositResponse)(nil),   // 140: types.QueryQuoteSaverDepositResponse
+	(*QueryQuoteSaverWithdrawResponse)(nil),  // 141: types.QueryQuoteSaverWithdrawResponse
+	(*QueryQuoteLoanOpenResponse)(nil),       // 142: types.QueryQuoteLoanOpenResponse
+	(*QueryQuoteLoanCloseResponse)(nil),      // 143: types.QueryQuoteLoanCloseResponse
+	(*QueryConstantValuesResponse)(nil),      // 144: types.QueryConstantValuesResponse
+	(*QuerySwapQueueResponse)(nil),           // 145: types.QuerySwapQueueResponse
+	(*QuerySwapDetailsResponse)(nil),         // 146: types.QuerySwapDetailsResponse
+	(*QueryLastBlocksResponse)(nil),          // 147: types.QueryLastBlocksResponse
+	(*QueryVaultResponse)(nil),               // 148: types.QueryVaultResponse
+	(*QueryAsgardVaultsResponse)(nil),        // 149: types.QueryAsgardVaultsResponse
+	(*QueryVaultsPubkeysResponse)(nil),       // 150: types.QueryVaultsPubkeysResponse
+	(*QueryTxStagesResponse)(nil),            // 151: types.QueryTxStagesResponse
+	(*QueryTxStatusResponse)(nil),            // 152: types.QueryTxStatusResponse
+	(*QueryTxResponse)(nil),                  // 153: types.QueryTxResponse
+	(*QueryObservedTxVoter)(nil),             // 154: types.QueryObservedTxVoter
+	(*SwapperClout)(nil),                     // 155: types.SwapperClout
+	(*QueryQueueResponse)(nil),               // 156: types.QueryQueueResponse
+	(*QueryOutboundResponse)(nil),            // 157: types.QueryOutboundResponse
+	(*QueryBlockResponse)(nil),               // 158: types.QueryBlockResponse
+	(*QueryTssKeygenMetricResponse)(nil),     // 159: types.QueryTssKeygenMetricResponse
+	(*QueryTssMetricResponse)(nil),           // 160: types.QueryTssMetricResponse
+	(*QueryKeysignResponse)(nil),             // 161: types.QueryKeysignResponse
+	(*QueryKeygenResponse)(nil),              // 162: types.QueryKeygenResponse
+	(*QueryUpgradeProposalsResponse)(nil),    // 163: types.QueryUpgradeProposalsResponse
+	(*QueryUpgradeProposalResponse)(nil),     // 164: types.QueryUpgradeProposalResponse
+	(*QueryUpgradeVotesResponse)(nil),        // 165: types.QueryUpgradeVotesResponse
+	(*QueryTCYStakerResponse)(nil),           // 166: types.QueryTCYStakerResponse
+	(*QueryTCYStakersResponse)(nil),          // 167: types.QueryTCYStakersResponse
+	(*QueryTCYClaimerResponse)(nil),          // 168: types.QueryTCYClaimerResponse
+	(*QueryTCYClaimersResponse)(nil),         // 169: types.QueryTCYClaimersResponse
+	(*QueryEip712TypedDataResponse)(nil),     // 170: types.QueryEip712TypedDataResponse
 }
 var file_types_query_proto_depIdxs = []int32{
 	0,   // 0: types.Query.Account:input_type -> types.QueryAccountRequest
@@ -870,163 +967,181 @@ var file_types_query_proto_depIdxs = []int32{
 	4,   // 4: types.Query.Pools:input_type -> types.QueryPoolsRequest
 	5,   // 5: types.Query.DerivedPool:input_type -> types.QueryDerivedPoolRequest
 	6,   // 6: types.Query.DerivedPools:input_type -> types.QueryDerivedPoolsRequest
-	7,   // 7: types.Query.LiquidityProvider:input_type -> types.QueryLiquidityProviderRequest
-	8,   // 8: types.Query.LiquidityProviders:input_type -> types.QueryLiquidityProvidersRequest
-	9,   // 9: types.Query.Saver:input_type -> types.QuerySaverRequest
-	10,  // 10: types.Query.Savers:input_type -> types.QuerySaversRequest
-	11,  // 11: types.Query.Borrower:input_type -> types.QueryBorrowerRequest
-	12,  // 12: types.Query.Borrowers:input_type -> types.QueryBorrowersRequest
-	13,  // 13: types.Query.TradeUnit:input_type -> types.QueryTradeUnitRequest
-	14,  // 14: types.Query.TradeUnits:input_type -> types.QueryTradeUnitsRequest
-	15,  // 15: types.Query.TradeAccount:input_type -> types.QueryTradeAccountRequest
-	16,  // 16: types.Query.TradeAccounts:input_type -> types.QueryTradeAccountsRequest
-	17,  // 17: types.Query.SecuredAsset:input_type -> types.QuerySecuredAssetRequest
-	18,  // 18: types.Query.SecuredAssets:input_type -> types.QuerySecuredAssetsRequest
-	19,  // 19: types.Query.Node:input_type -> types.QueryNodeRequest
-	20,  // 20: types.Query.Nodes:input_type -> types.QueryNodesRequest
-	21,  // 21: types.Query.PoolSlip:input_type -> types.QueryPoolSlipRequest
-	22,  // 22: types.Query.PoolSlips:input_type -> types.QueryPoolSlipsRequest
-	23,  // 23: types.Query.OutboundFee:input_type -> types.QueryOutboundFeeRequest
-	24,  // 24: types.Query.OutboundFees:input_type -> types.QueryOutboundFeesRequest
-	25,  // 25: types.Query.StreamingSwap:input_type -> types.QueryStreamingSwapRequest
-	26,  // 26: types.Query.StreamingSwaps:input_type -> types.QueryStreamingSwapsRequest
-	27,  // 27: types.Query.Ban:input_type -> types.QueryBanRequest
-	28,  // 28: types.Query.Ragnarok:input_type -> types.QueryRagnarokRequest
-	29,  // 29: types.Query.RunePool:input_type -> types.QueryRunePoolRequest
-	30,  // 30: types.Query.RuneProvider:input_type -> types.QueryRuneProviderRequest
-	31,  // 31: types.Query.RuneProviders:input_type -> types.QueryRuneProvidersRequest
-	32,  // 32: types.Query.MimirValues:input_type -> types.QueryMimirValuesRequest
-	33,  // 33: types.Query.MimirWithKey:input_type -> types.QueryMimirWithKeyRequest
-	34,  // 34: types.Query.MimirAdminValues:input_type -> types.QueryMimirAdminValuesRequest
-	35,  // 35: types.Query.MimirNodesAllValues:input_type -> types.QueryMimirNodesAllValuesRequest
-	36,  // 36: types.Query.MimirNodesValues:input_type -> types.QueryMimirNodesValuesRequest
-	37,  // 37: types.Query.MimirNodeValues:input_type -> types.QueryMimirNodeValuesRequest
-	38,  // 38: types.Query.InboundAddresses:input_type -> types.QueryInboundAddressesRequest
-	39,  // 39: types.Query.Version:input_type -> types.QueryVersionRequest
-	40,  // 40: types.Query.Thorname:input_type -> types.QueryThornameRequest
-	41,  // 41: types.Query.Invariant:input_type -> types.QueryInvariantRequest
-	42,  // 42: types.Query.Invariants:input_type -> types.QueryInvariantsRequest
-	43,  // 43: types.Query.Network:input_type -> types.QueryNetworkRequest
-	44,  // 44: types.Query.BalanceModule:input_type -> types.QueryBalanceModuleRequest
-	45,  // 45: types.Query.QuoteSwap:input_type -> types.QueryQuoteSwapRequest
-	46,  // 46: types.Query.QuoteSaverDeposit:input_type -> types.QueryQuoteSaverDepositRequest
-	47,  // 47: types.Query.QuoteSaverWithdraw:input_type -> types.QueryQuoteSaverWithdrawRequest
-	48,  // 48: types.Query.QuoteLoanOpen:input_type -> types.QueryQuoteLoanOpenRequest
-	49,  // 49: types.Query.QuoteLoanClose:input_type -> types.QueryQuoteLoanCloseRequest
-	50,  // 50: types.Query.ConstantValues:input_type -> types.QueryConstantValuesRequest
-	51,  // 51: types.Query.SwapQueue:input_type -> types.QuerySwapQueueRequest
-	52,  // 52: types.Query.SwapDetails:input_type -> types.QuerySwapDetailsRequest
-	53,  // 53: types.Query.LastBlocks:input_type -> types.QueryLastBlocksRequest
-	54,  // 54: types.Query.ChainsLastBlock:input_type -> types.QueryChainsLastBlockRequest
-	55,  // 55: types.Query.Vault:input_type -> types.QueryVaultRequest
-	56,  // 56: types.Query.AsgardVaults:input_type -> types.QueryAsgardVaultsRequest
-	57,  // 57: types.Query.VaultsPubkeys:input_type -> types.QueryVaultsPubkeysRequest
-	58,  // 58: types.Query.TxStages:input_type -> types.QueryTxStagesRequest
-	59,  // 59: types.Query.TxStatus:input_type -> types.QueryTxStatusRequest
-	60,  // 60: types.Query.Tx:input_type -> types.QueryTxRequest
-	61,  // 61: types.Query.TxVoters:input_type -> types.QueryTxVotersRequest
-	61,  // 62: types.Query.TxVotersOld:input_type -> types.QueryTxVotersRequest
-	62,  // 63: types.Query.Clout:input_type -> types.QuerySwapperCloutRequest
-	63,  // 64: types.Query.Queue:input_type -> types.QueryQueueRequest
-	64,  // 65: types.Query.ScheduledOutbound:input_type -> types.QueryScheduledOutboundRequest
-	65,  // 66: types.Query.PendingOutbound:input_type -> types.QueryPendingOutboundRequest
-	66,  // 67: types.Query.Block:input_type -> types.QueryBlockRequest
-	67,  // 68: types.Query.TssKeygenMetric:input_type -> types.QueryTssKeygenMetricRequest
-	68,  // 69: types.Query.TssMetric:input_type -> types.QueryTssMetricRequest
-	69,  // 70: types.Query.Keysign:input_type -> types.QueryKeysignRequest
-	70,  // 71: types.Query.KeysignPubkey:input_type -> types.QueryKeysignPubkeyRequest
-	71,  // 72: types.Query.Keygen:input_type -> types.QueryKeygenRequest
-	72,  // 73: types.Query.UpgradeProposals:input_type -> types.QueryUpgradeProposalsRequest
-	73,  // 74: types.Query.UpgradeProposal:input_type -> types.QueryUpgradeProposalRequest
-	74,  // 75: types.Query.UpgradeVotes:input_type -> types.QueryUpgradeVotesRequest
-	75,  // 76: types.Query.TCYStaker:input_type -> types.QueryTCYStakerRequest
-	76,  // 77: types.Query.TCYStakers:input_type -> types.QueryTCYStakersRequest
-	77,  // 78: types.Query.TCYClaimer:input_type -> types.QueryTCYClaimerRequest
-	78,  // 79: types.Query.TCYClaimers:input_type -> types.QueryTCYClaimersRequest
-	79,  // 80: types.Query.Eip712TypedData:input_type -> types.QueryEip712TypedDataRequest
-	80,  // 81: types.Query.Account:output_type -> types.QueryAccountResponse
-	81,  // 82: types.Query.Balances:output_type -> types.QueryBalancesResponse
-	82,  // 83: types.Query.Export:output_type -> types.QueryExportResponse
-	83,  // 84: types.Query.Pool:output_type -> types.QueryPoolResponse
-	84,  // 85: types.Query.Pools:output_type -> types.QueryPoolsResponse
-	85,  // 86: types.Query.DerivedPool:output_type -> types.QueryDerivedPoolResponse
-	86,  // 87: types.Query.DerivedPools:output_type -> types.QueryDerivedPoolsResponse
-	87,  // 88: types.Query.LiquidityProvider:output_type -> types.QueryLiquidityProviderResponse
-	88,  // 89: types.Query.LiquidityProviders:output_type -> types.QueryLiquidityProvidersResponse
-	89,  // 90: types.Query.Saver:output_type -> types.QuerySaverResponse
-	90,  // 91: types.Query.Savers:output_type -> types.QuerySaversResponse
-	91,  // 92: types.Query.Borrower:output_type -> types.QueryBorrowerResponse
-	92,  // 93: types.Query.Borrowers:output_type -> types.QueryBorrowersResponse
-	93,  // 94: types.Query.TradeUnit:output_type -> types.QueryTradeUnitResponse
-	94,  // 95: types.Query.TradeUnits:output_type -> types.QueryTradeUnitsResponse
-	95,  // 96: types.Query.TradeAccount:output_type -> types.QueryTradeAccountsResponse
-	95,  // 97: types.Query.TradeAccounts:output_type -> types.QueryTradeAccountsResponse
-	96,  // 98: types.Query.SecuredAsset:output_type -> types.QuerySecuredAssetResponse
-	97,  // 99: types.Query.SecuredAssets:output_type -> types.QuerySecuredAssetsResponse
-	98,  // 100: types.Query.Node:output_type -> types.QueryNodeResponse
-	99,  // 101: types.Query.Nodes:output_type -> types.QueryNodesResponse
-	100, // 102: types.Query.PoolSlip:output_type -> types.QueryPoolSlipsResponse
-	100, // 103: types.Query.PoolSlips:output_type -> types.QueryPoolSlipsResponse
-	101, // 104: types.Query.OutboundFee:output_type -> types.QueryOutboundFeesResponse
-	101, // 105: types.Query.OutboundFees:output_type -> types.QueryOutboundFeesResponse
-	102, // 106: types.Query.StreamingSwap:output_type -> types.QueryStreamingSwapResponse
-	103, // 107: types.Query.StreamingSwaps:output_type -> types.QueryStreamingSwapsResponse
-	104, // 108: types.Query.Ban:output_type -> types.BanVoter
-	105, // 109: types.Query.Ragnarok:output_type -> types.QueryRagnarokResponse
-	106, // 110: types.Query.RunePool:output_type -> types.QueryRunePoolResponse
-	107, // 111: types.Query.RuneProvider:output_type -> types.QueryRuneProviderResponse
-	108, // 112: types.Query.RuneProviders:output_type -> types.QueryRuneProvidersResponse
-	109, // 113: types.Query.MimirValues:output_type -> types.QueryMimirValuesResponse
-	110, // 114: types.Query.MimirWithKey:output_type -> types.QueryMimirWithKeyResponse
-	111, // 115: types.Query.MimirAdminValues:output_type -> types.QueryMimirAdminValuesResponse
-	112, // 116: types.Query.MimirNodesAllValues:output_type -> types.QueryMimirNodesAllValuesResponse
-	113, // 117: types.Query.MimirNodesValues:output_type -> types.QueryMimirNodesValuesResponse
-	114, // 118: types.Query.MimirNodeValues:output_type -> types.QueryMimirNodeValuesResponse
-	115, // 119: types.Query.InboundAddresses:output_type -> types.QueryInboundAddressesResponse
-	116, // 120: types.Query.Version:output_type -> types.QueryVersionResponse
-	117, // 121: types.Query.Thorname:output_type -> types.QueryThornameResponse
-	118, // 122: types.Query.Invariant:output_type -> types.QueryInvariantResponse
-	119, // 123: types.Query.Invariants:output_type -> types.QueryInvariantsResponse
-	120, // 124: types.Query.Network:output_type -> types.QueryNetworkResponse
-	121, // 125: types.Query.BalanceModule:output_type -> types.QueryBalanceModuleResponse
-	122, // 126: types.Query.QuoteSwap:output_type -> types.QueryQuoteSwapResponse
-	123, // 127: types.Query.QuoteSaverDeposit:output_type -> types.QueryQuoteSaverDepositResponse
-	124, // 128: types.Query.QuoteSaverWithdraw:output_type -> types.QueryQuoteSaverWithdrawResponse
-	125, // 129: types.Query.QuoteLoanOpen:output_type -> types.QueryQuoteLoanOpenResponse
-	126, // 130: types.Query.QuoteLoanClose:output_type -> types.QueryQuoteLoanCloseResponse
-	127, // 131: types.Query.ConstantValues:output_type -> types.QueryConstantValuesResponse
-	128, // 132: types.Query.SwapQueue:output_type -> types.QuerySwapQueueResponse
-	129, // 133: types.Query.SwapDetails:output_type -> types.QuerySwapDetailsResponse
-	130, // 134: types.Query.LastBlocks:output_type -> types.QueryLastBlocksResponse
-	130, // 135: types.Query.ChainsLastBlock:output_type -> types.QueryLastBlocksResponse
-	131, // 136: types.Query.Vault:output_type -> types.QueryVaultResponse
-	132, // 137: types.Query.AsgardVaults:output_type -> types.QueryAsgardVaultsResponse
-	133, // 138: types.Query.VaultsPubkeys:output_type -> types.QueryVaultsPubkeysResponse
-	134, // 139: types.Query.TxStages:output_type -> types.QueryTxStagesResponse
-	135, // 140: types.Query.TxStatus:output_type -> types.QueryTxStatusResponse
-	136, // 141: types.Query.Tx:output_type -> types.QueryTxResponse
-	137, // 142: types.Query.TxVoters:output_type -> types.QueryObservedTxVoter
-	137, // 143: types.Query.TxVotersOld:output_type -> types.QueryObservedTxVoter
-	138, // 144: types.Query.Clout:output_type -> types.SwapperClout
-	139, // 145: types.Query.Queue:output_type -> types.QueryQueueResponse
-	140, // 146: types.Query.ScheduledOutbound:output_type -> types.QueryOutboundResponse
-	140, // 147: types.Query.PendingOutbound:output_type -> types.QueryOutboundResponse
-	141, // 148: types.Query.Block:output_type -> types.QueryBlockResponse
-	142, // 149: types.Query.TssKeygenMetric:output_type -> types.QueryTssKeygenMetricResponse
-	143, // 150: types.Query.TssMetric:output_type -> types.QueryTssMetricResponse
-	144, // 151: types.Query.Keysign:output_type -> types.QueryKeysignResponse
-	144, // 152: types.Query.KeysignPubkey:output_type -> types.QueryKeysignResponse
-	145, // 153: types.Query.Keygen:output_type -> types.QueryKeygenResponse
-	146, // 154: types.Query.UpgradeProposals:output_type -> types.QueryUpgradeProposalsResponse
-	147, // 155: types.Query.UpgradeProposal:output_type -> types.QueryUpgradeProposalResponse
-	148, // 156: types.Query.UpgradeVotes:output_type -> types.QueryUpgradeVotesResponse
-	149, // 157: types.Query.TCYStaker:output_type -> types.QueryTCYStakerResponse
-	150, // 158: types.Query.TCYStakers:output_type -> types.QueryTCYStakersResponse
-	151, // 159: types.Query.TCYClaimer:output_type -> types.QueryTCYClaimerResponse
-	152, // 160: types.Query.TCYClaimers:output_type -> types.QueryTCYClaimersResponse
-	153, // 161: types.Query.Eip712TypedData:output_type -> types.QueryEip712TypedDataResponse
-	81,  // [81:162] is the sub-list for method output_type
-	0,   // [0:81] is the sub-list for method input_type
+	7,   // 7: types.Query.PoolHistory:input_type -> types.QueryPoolHistoryRequest
+	8,   // 8: types.Query.PoolTWAP:input_type -> types.QueryPoolTWAPRequest
+	9,   // 9: types.Query.LiquidityProvider:input_type -> types.QueryLiquidityProviderRequest
+	10,  // 10: types.Query.LiquidityProviders:input_type -> types.QueryLiquidityProvidersRequest
+	11,  // 11: types.Query.Saver:input_type -> types.QuerySaverRequest
+	12,  // 12: types.Query.Savers:input_type -> types.QuerySaversRequest
+	13,  // 13: types.Query.Borrower:input_type -> types.QueryBorrowerRequest
+	14,  // 14: types.Query.Borrowers:input_type -> types.QueryBorrowersRequest
+	15,  // 15: types.Query.TradeUnit:input_type -> types.QueryTradeUnitRequest
+	16,  // 16: types.Query.TradeUnits:input_type -> types.QueryTradeUnitsRequest
+	17,  // 17: types.Query.TradeAccount:input_type -> types.QueryTradeAccountRequest
+	18,  // 18: types.Query.TradeAccounts:input_type -> types.QueryTradeAccountsRequest
+	19,  // 19: types.Query.SecuredAsset:input_type -> types.QuerySecuredAssetRequest
+	20,  // 20: types.Query.SecuredAssets:input_type -> types.QuerySecuredAssetsRequest
+	21,  // 21: types.Query.Node:input_type -> types.QueryNodeRequest
+	22,  // 22: types.Query.NodeObservationStats:input_type -> types.QueryObservationStatsRequest
+	23,  // 23: types.Query.Nodes:input_type -> types.QueryNodesRequest
+	24,  // 24: types.Query.PoolSlip:input_type -> types.QueryPoolSlipRequest
+	25,  // 25: types.Query.PoolSlips:input_type -> types.QueryPoolSlipsRequest
+	26,  // 26: types.Query.OutboundFee:input_type -> types.QueryOutboundFeeRequest
+	27,  // 27: types.Query.OutboundFees:input_type -> types.QueryOutboundFeesRequest
+	28,  // 28: types.Query.StreamingSwap:input_type -> types.QueryStreamingSwapRequest
+	29,  // 29: types.Query.StreamingSwaps:input_type -> types.QueryStreamingSwapsRequest
+	30,  // 30: types.Query.RecurringSwap:input_type -> types.QueryRecurringSwapRequest
+	31,  // 31: types.Query.RecurringSwaps:input_type -> types.QueryRecurringSwapsRequest
+	32,  // 32: types.Query.Ban:input_type -> types.QueryBanRequest
+	33,  // 33: types.Query.Ragnarok:input_type -> types.QueryRagnarokRequest
+	34,  // 34: types.Query.RunePool:input_type -> types.QueryRunePoolRequest
+	35,  // 35: types.Query.RuneProvider:input_type -> types.QueryRuneProviderRequest
+	36,  // 36: types.Query.RuneProviders:input_type -> types.QueryRuneProvidersRequest
+	37,  // 37: types.Query.MimirValues:input_type -> types.QueryMimirValuesRequest
+	38,  // 38: types.Query.MimirWithKey:input_type -> types.QueryMimirWithKeyRequest
+	39,  // 39: types.Query.MimirAdminValues:input_type -> types.QueryMimirAdminValuesRequest
+	40,  // 40: types.Query.MimirScheduled:input_type -> types.QueryMimirScheduledRequest
+	41,  // 41: types.Query.MimirRegistry:input_type -> types.QueryMimirRegistryRequest
+	42,  // 42: types.Query.MimirHistory:input_type -> types.QueryMimirHistoryRequest
+	43,  // 43: types.Query.MimirNodesAllValues:input_type -> types.QueryMimirNodesAllValuesRequest
+	44,  // 44: types.Query.MimirNodesValues:input_type -> types.QueryMimirNodesValuesRequest
+	45,  // 45: types.Query.MimirNodeValues:input_type -> types.QueryMimirNodeValuesRequest
+	46,  // 46: types.Query.MimirNodeHistory:input_type -> types.QueryMimirNodeHistoryRequest
+	47,  // 47: types.Query.InboundAddresses:input_type -> types.QueryInboundAddressesRequest
+	48,  // 48: types.Query.Version:input_type -> types.QueryVersionRequest
+	49,  // 49: types.Query.Thorname:input_type -> types.QueryThornameRequest
+	50,  // 50: types.Query.Invariant:input_type -> types.QueryInvariantRequest
+	51,  // 51: types.Query.Invariants:input_type -> types.QueryInvariantsRequest
+	52,  // 52: types.Query.Network:input_type -> types.QueryNetworkRequest
+	53,  // 53: types.Query.BalanceModule:input_type -> types.QueryBalanceModuleRequest
+	54,  // 54: types.Query.QuoteSwap:input_type -> types.QueryQuoteSwapRequest
+	55,  // 55: types.Query.QuoteSaverDeposit:input_type -> types.QueryQuoteSaverDepositRequest
+	56,  // 56: types.Query.QuoteSaverWithdraw:input_type -> types.QueryQuoteSaverWithdrawRequest
+	57,  // 57: types.Query.QuoteLoanOpen:input_type -> types.QueryQuoteLoanOpenRequest
+	58,  // 58: types.Query.QuoteLoanClose:input_type -> types.QueryQuoteLoanCloseRequest
+	59,  // 59: types.Query.ConstantValues:input_type -> types.QueryConstantValuesRequest
+	60,  // 60: types.Query.SwapQueue:input_type -> types.QuerySwapQueueRequest
+	61,  // 61: types.Query.SwapDetails:input_type -> types.QuerySwapDetailsRequest
+	62,  // 62: types.Query.LastBlocks:input_type -> types.QueryLastBlocksRequest
+	63,  // 63: types.Query.ChainsLastBlock:input_type -> types.QueryChainsLastBlockRequest
+	64,  // 64: types.Query.Vault:input_type -> types.QueryVaultRequest
+	65,  // 65: types.Query.AsgardVaults:input_type -> types.QueryAsgardVaultsRequest
+	66,  // 66: types.Query.VaultsPubkeys:input_type -> types.QueryVaultsPubkeysRequest
+	67,  // 67: types.Query.TxStages:input_type -> types.QueryTxStagesRequest
+	68,  // 68: types.Query.TxStatus:input_type -> types.QueryTxStatusRequest
+	69,  // 69: types.Query.Tx:input_type -> types.QueryTxRequest
+	70,  // 70: types.Query.TxVoters:input_type -> types.QueryTxVotersRequest
+	70,  // 71: types.Query.TxVotersOld:input_type -> types.QueryTxVotersRequest
+	71,  // 72: types.Query.Clout:input_type -> types.QuerySwapperCloutRequest
+	72,  // 73: types.Query.Queue:input_type -> types.QueryQueueRequest
+	73,  // 74: types.Query.ScheduledOutbound:input_type -> types.QueryScheduledOutboundRequest
+	74,  // 75: types.Query.PendingOutbound:input_type -> types.QueryPendingOutboundRequest
+	75,  // 76: types.Query.Block:input_type -> types.QueryBlockRequest
+	76,  // 77: types.Query.TssKeygenMetric:input_type -> types.QueryTssKeygenMetricRequest
+	77,  // 78: types.Query.TssMetric:input_type -> types.QueryTssMetricRequest
+	78,  // 79: types.Query.Keysign:input_type -> types.QueryKeysignRequest
+	79,  // 80: types.Query.KeysignPubkey:input_type -> types.QueryKeysignPubkeyRequest
+	80,  // 81: types.Query.Keygen:input_type -> types.QueryKeygenRequest
+	81,  // 82: types.Query.UpgradeProposals:input_type -> types.QueryUpgradeProposalsRequest
+	82,  // 83: types.Query.UpgradeProposal:input_type -> types.QueryUpgradeProposalRequest
+	83,  // 84: types.Query.UpgradeVotes:input_type -> types.QueryUpgradeVotesRequest
+	84,  // 85: types.Query.TCYStaker:input_type -> types.QueryTCYStakerRequest
+	85,  // 86: types.Query.TCYStakers:input_type -> types.QueryTCYStakersRequest
+	86,  // 87: types.Query.TCYClaimer:input_type -> types.QueryTCYClaimerRequest
+	87,  // 88: types.Query.TCYClaimers:input_type -> types.QueryTCYClaimersRequest
+	88,  // 89: types.Query.Eip712TypedData:input_type -> types.QueryEip712TypedDataRequest
+	89,  // 90: types.Query.Account:output_type -> types.QueryAccountResponse
+	90,  // 91: types.Query.Balances:output_type -> types.QueryBalancesResponse
+	91,  // 92: types.Query.Export:output_type -> types.QueryExportResponse
+	92,  // 93: types.Query.Pool:output_type -> types.QueryPoolResponse
+	93,  // 94: types.Query.Pools:output_type -> types.QueryPoolsResponse
+	94,  // 95: types.Query.DerivedPool:output_type -> types.QueryDerivedPoolResponse
+	95,  // 96: types.Query.DerivedPools:output_type -> types.QueryDerivedPoolsResponse
+	96,  // 97: types.Query.PoolHistory:output_type -> types.QueryPoolHistoryResponse
+	97,  // 98: types.Query.PoolTWAP:output_type -> types.QueryPoolTWAPResponse
+	98,  // 99: types.Query.LiquidityProvider:output_type -> types.QueryLiquidityProviderResponse
+	99,  // 100: types.Query.LiquidityProviders:output_type -> types.QueryLiquidityProvidersResponse
+	100, // 101: types.Query.Saver:output_type -> types.QuerySaverResponse
+	101, // 102: types.Query.Savers:output_type -> types.QuerySaversResponse
+	102, // 103: types.Query.Borrower:output_type -> types.QueryBorrowerResponse
+	103, // 104: types.Query.Borrowers:output_type -> types.QueryBorrowersResponse
+	104, // 105: types.Query.TradeUnit:output_type -> types.QueryTradeUnitResponse
+	105, // 106: types.Query.TradeUnits:output_type -> types.QueryTradeUnitsResponse
+	106, // 107: types.Query.TradeAccount:output_type -> types.QueryTradeAccountsResponse
+	106, // 108: types.Query.TradeAccounts:output_type -> types.QueryTradeAccountsResponse
+	107, // 109: types.Query.SecuredAsset:output_type -> types.QuerySecuredAssetResponse
+	108, // 110: types.Query.SecuredAssets:output_type -> types.QuerySecuredAssetsResponse
+	109, // 111: types.Query.Node:output_type -> types.QueryNodeResponse
+	110, // 112: types.Query.NodeObservationStats:output_type -> types.QueryObservationStatsResponse
+	111, // 113: types.Query.Nodes:output_type -> types.QueryNodesResponse
+	112, // 114: types.Query.PoolSlip:output_type -> types.QueryPoolSlipsResponse
+	112, // 115: types.Query.PoolSlips:output_type -> types.QueryPoolSlipsResponse
+	113, // 116: types.Query.OutboundFee:output_type -> types.QueryOutboundFeesResponse
+	113, // 117: types.Query.OutboundFees:output_type -> types.QueryOutboundFeesResponse
+	114, // 118: types.Query.StreamingSwap:output_type -> types.QueryStreamingSwapResponse
+	115, // 119: types.Query.StreamingSwaps:output_type -> types.QueryStreamingSwapsResponse
+	116, // 120: types.Query.RecurringSwap:output_type -> types.QueryRecurringSwapResponse
+	117, // 121: types.Query.RecurringSwaps:output_type -> types.QueryRecurringSwapsResponse
+	118, // 122: types.Query.Ban:output_type -> types.BanVoter
+	119, // 123: types.Query.Ragnarok:output_type -> types.QueryRagnarokResponse
+	120, // 124: types.Query.RunePool:output_type -> types.QueryRunePoolResponse
+	121, // 125: types.Query.RuneProvider:output_type -> types.QueryRuneProviderResponse
+	122, // 126: types.Query.RuneProviders:output_type -> types.QueryRuneProvidersResponse
+	123, // 127: types.Query.MimirValues:output_type -> types.QueryMimirValuesResponse
+	124, // 128: types.Query.MimirWithKey:output_type -> types.QueryMimirWithKeyResponse
+	125, // 129: types.Query.MimirAdminValues:output_type -> types.QueryMimirAdminValuesResponse
+	126, // 130: types.Query.MimirScheduled:output_type -> types.QueryMimirScheduledResponse
+	127, // 131: types.Query.MimirRegistry:output_type -> types.QueryMimirRegistryResponse
+	128, // 132: types.Query.MimirHistory:output_type -> types.QueryMimirHistoryResponse
+	129, // 133: types.Query.MimirNodesAllValues:output_type -> types.QueryMimirNodesAllValuesResponse
+	130, // 134: types.Query.MimirNodesValues:output_type -> types.QueryMimirNodesValuesResponse
+	131, // 135: types.Query.MimirNodeValues:output_type -> types.QueryMimirNodeValuesResponse
+	128, // 136: types.Query.MimirNodeHistory:output_type -> types.QueryMimirHistoryResponse
+	132, // 137: types.Query.InboundAddresses:output_type -> types.QueryInboundAddressesResponse
+	133, // 138: types.Query.Version:output_type -> types.QueryVersionResponse
+	134, // 139: types.Query.Thorname:output_type -> types.QueryThornameResponse
+	135, // 140: types.Query.Invariant:output_type -> types.QueryInvariantResponse
+	136, // 141: types.Query.Invariants:output_type -> types.QueryInvariantsResponse
+	137, // 142: types.Query.Network:output_type -> types.QueryNetworkResponse
+	138, // 143: types.Query.BalanceModule:output_type -> types.QueryBalanceModuleResponse
+	139, // 144: types.Query.QuoteSwap:output_type -> types.QueryQuoteSwapResponse
+	140, // 145: types.Query.QuoteSaverDeposit:output_type -> types.QueryQuoteSaverDepositResponse
+	141, // 146: types.Query.QuoteSaverWithdraw:output_type -> types.QueryQuoteSaverWithdrawResponse
+	142, // 147: types.Query.QuoteLoanOpen:output_type -> types.QueryQuoteLoanOpenResponse
+	143, // 148: types.Query.QuoteLoanClose:output_type -> types.QueryQuoteLoanCloseResponse
+	144, // 149: types.Query.ConstantValues:output_type -> types.QueryConstantValuesResponse
+	145, // 150: types.Query.SwapQueue:output_type -> types.QuerySwapQueueResponse
+	146, // 151: types.Query.SwapDetails:output_type -> types.QuerySwapDetailsResponse
+	147, // 152: types.Query.LastBlocks:output_type -> types.QueryLastBlocksResponse
+	147, // 153: types.Query.ChainsLastBlock:output_type -> types.QueryLastBlocksResponse
+	148, // 154: types.Query.Vault:output_type -> types.QueryVaultResponse
+	149, // 155: types.Query.AsgardVaults:output_type -> types.QueryAsgardVaultsResponse
+	150, // 156: types.Query.VaultsPubkeys:output_type -> types.QueryVaultsPubkeysResponse
+	151, // 157: types.Query.TxStages:output_type -> types.QueryTxStagesResponse
+	152, // 158: types.Query.TxStatus:output_type -> types.QueryTxStatusResponse
+	153, // 159: types.Query.Tx:output_type -> types.QueryTxResponse
+	154, // 160: types.Query.TxVoters:output_type -> types.QueryObservedTxVoter
+	154, // 161: types.Query.TxVotersOld:output_type -> types.QueryObservedTxVoter
+	155, // 162: types.Query.Clout:output_type -> types.SwapperClout
+	156, // 163: types.Query.Queue:output_type -> types.QueryQueueResponse
+	157, // 164: types.Query.ScheduledOutbound:output_type -> types.QueryOutboundResponse
+	157, // 165: types.Query.PendingOutbound:output_type -> types.QueryOutboundResponse
+	158, // 166: types.Query.Block:output_type -> types.QueryBlockResponse
+	159, // 167: types.Query.TssKeygenMetric:output_type -> types.QueryTssKeygenMetricResponse
+	160, // 168: types.Query.TssMetric:output_type -> types.QueryTssMetricResponse
+	161, // 169: types.Query.Keysign:output_type -> types.QueryKeysignResponse
+	161, // 170: types.Query.KeysignPubkey:output_type -> types.QueryKeysignResponse
+	162, // 171: types.Query.Keygen:output_type -> types.QueryKeygenResponse
+	163, // 172: types.Query.UpgradeProposals:output_type -> types.QueryUpgradeProposalsResponse
+	164, // 173: types.Query.UpgradeProposal:output_type -> types.QueryUpgradeProposalResponse
+	165, // 174: types.Query.UpgradeVotes:output_type -> types.QueryUpgradeVotesResponse
+	166, // 175: types.Query.TCYStaker:output_type -> types.QueryTCYStakerResponse
+	167, // 176: types.Query.TCYStakers:output_type -> types.QueryTCYStakersResponse
+	168, // 177: types.Query.TCYClaimer:output_type -> types.QueryTCYClaimerResponse
+	169, // 178: types.Query.TCYClaimers:output_type -> types.QueryTCYClaimersResponse
+	170, // 179: types.Query.Eip712TypedData:output_type -> types.QueryEip712TypedDataResponse
+	90,  // [90:180] is the sub-list for method output_type
+	0,   // [0:90] is the sub-list for method input_type
 	0,   // [0:0] is the sub-list for extension type_name
 	0,   // [0:0] is the sub-list for extension extendee
 	0,   // [0:0] is the sub-list for field type_name
@@ -1045,9 +1160,13 @@ func file_types_query_proto_init() {
 	file_types_query_trade_unit_proto_init()
 	file_types_query_trade_account_proto_init()
 	file_types_query_node_proto_init()
+	file_types_query_observation_stats_proto_init()
 	file_types_query_pool_slip_proto_init()
+	file_types_query_pool_history_proto_init()
+	file_types_query_pool_twap_proto_init()
 	file_types_query_outbound_fee_proto_init()
 	file_types_query_streaming_swap_proto_init()
+	file_types_query_recurring_swap_proto_init()
 	file_types_query_ban_proto_init()
 	file_types_type_ban_voter_proto_init()
 	file_types_query_ragnarok_proto_init()
@@ -14,9 +14,10 @@ import (
 )
 
 var (
-	md_QueryDerivedPoolRequest        protoreflect.MessageDescriptor
-	fd_QueryDerivedPoolRequest_asset  protoreflect.FieldDescriptor
-	fd_QueryDerivedPoolRequest_height protoreflect.FieldDescriptor
+	md_QueryDerivedPoolRequest             protoreflect.MessageDescriptor
+	fd_QueryDerivedPoolRequest_asset       protoreflect.FieldDescriptor
+	fd_QueryDerivedPoolRequest_height      protoreflect.FieldDescriptor
+	fd_QueryDerivedPoolRequest_twap_blocks protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +25,7 @@ func init() {
 	md_QueryDerivedPoolRequest = File_types_query_derived_pool_proto.Messages().ByName("QueryDerivedPoolRequest")
 	fd_QueryDerivedPoolRequest_asset = md_QueryDerivedPoolRequest.Fields().ByName("asset")
 	fd_QueryDerivedPoolRequest_height = md_QueryDerivedPoolRequest.Fields().ByName("height")
+	fd_QueryDerivedPoolRequest_twap_blocks = md_QueryDerivedPoolRequest.Fields().ByName("twap_blocks")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryDerivedPoolRequest)(nil)
@@ -103,6 +105,12 @@ func (x *fastReflection_QueryDerivedPoolRequest) Range(f func(protoreflect.Field
 			return
 		}
 	}
+	if x.TwapBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TwapBlocks)
+		if !f(fd_QueryDerivedPoolRequest_twap_blocks, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +130,8 @@ func (x *fastReflection_QueryDerivedPoolRequest) Has(fd protoreflect.FieldDescri
 		return x.Asset != ""
 	case "types.QueryDerivedPoolRequest.height":
 		return x.Height != ""
+	case "types.QueryDerivedPoolRequest.twap_blocks":
+		return x.TwapBlocks != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolRequest"))
@@ -142,6 +152,8 @@ func (x *fastReflection_QueryDerivedPoolRequest) Clear(fd protoreflect.FieldDesc
 		x.Asset = ""
 	case "types.QueryDerivedPoolRequest.height":
 		x.Height = ""
+	case "types.QueryDerivedPoolRequest.twap_blocks":
+		x.TwapBlocks = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolRequest"))
@@ -164,6 +176,9 @@ func (x *fastReflection_QueryDerivedPoolRequest) Get(descriptor protoreflect.Fie
 	case "types.QueryDerivedPoolRequest.height":
 		value := x.Height
 		return protoreflect.ValueOfString(value)
+	case "types.QueryDerivedPoolRequest.twap_blocks":
+		value := x.TwapBlocks
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolRequest"))
@@ -188,6 +203,8 @@ func (x *fastReflection_QueryDerivedPoolRequest) Set(fd protoreflect.FieldDescri
 		x.Asset = value.Interface().(string)
 	case "types.QueryDerivedPoolRequest.height":
 		x.Height = value.Interface().(string)
+	case "types.QueryDerivedPoolRequest.twap_blocks":
+		x.TwapBlocks = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolRequest"))
@@ -212,6 +229,8 @@ func (x *fastReflection_QueryDerivedPoolRequest) Mutable(fd protoreflect.FieldDe
 		panic(fmt.Errorf("field asset of message types.QueryDerivedPoolRequest is not mutable"))
 	case "types.QueryDerivedPoolRequest.height":
 		panic(fmt.Errorf("field height of message types.QueryDerivedPoolRequest is not mutable"))
+	case "types.QueryDerivedPoolRequest.twap_blocks":
+		panic(fmt.Errorf("field twap_blocks of message types.QueryDerivedPoolRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolRequest"))
@@ -229,6 +248,8 @@ func (x *fastReflection_QueryDerivedPoolRequest) NewField(fd protoreflect.FieldD
 		return protoreflect.ValueOfString("")
 	case "types.QueryDerivedPoolRequest.height":
 		return protoreflect.ValueOfString("")
+	case "types.QueryDerivedPoolRequest.twap_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolRequest"))
@@ -306,6 +327,9 @@ func (x *fastReflection_QueryDerivedPoolRequest) ProtoMethods() *protoiface.Meth
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TwapBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.TwapBlocks))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -335,6 +359,11 @@ func (x *fastReflection_QueryDerivedPoolRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.TwapBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TwapBlocks))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Height) > 0 {
 			i -= len(x.Height)
 			copy(dAtA[i:], x.Height)
@@ -462,6 +491,25 @@ func (x *fastReflection_QueryDerivedPoolRequest) ProtoMethods() *protoiface.Meth
 				}
 				x.Height = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TwapBlocks", wireType)
+				}
+				x.TwapBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TwapBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -505,6 +553,7 @@ var (
 	fd_QueryDerivedPoolResponse_balance_asset     protoreflect.FieldDescriptor
 	fd_QueryDerivedPoolResponse_balance_rune      protoreflect.FieldDescriptor
 	fd_QueryDerivedPoolResponse_derived_depth_bps protoreflect.FieldDescriptor
+	fd_QueryDerivedPoolResponse_anchor_twap_price protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -516,6 +565,7 @@ func init() {
 	fd_QueryDerivedPoolResponse_balance_asset = md_QueryDerivedPoolResponse.Fields().ByName("balance_asset")
 	fd_QueryDerivedPoolResponse_balance_rune = md_QueryDerivedPoolResponse.Fields().ByName("balance_rune")
 	fd_QueryDerivedPoolResponse_derived_depth_bps = md_QueryDerivedPoolResponse.Fields().ByName("derived_depth_bps")
+	fd_QueryDerivedPoolResponse_anchor_twap_price = md_QueryDerivedPoolResponse.Fields().ByName("anchor_twap_price")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryDerivedPoolResponse)(nil)
@@ -619,6 +669,12 @@ func (x *fastReflection_QueryDerivedPoolResponse) Range(f func(protoreflect.Fiel
 			return
 		}
 	}
+	if x.AnchorTwapPrice != "" {
+		value := protoreflect.ValueOfString(x.AnchorTwapPrice)
+		if !f(fd_QueryDerivedPoolResponse_anchor_twap_price, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -646,6 +702,8 @@ func (x *fastReflection_QueryDerivedPoolResponse) Has(fd protoreflect.FieldDescr
 		return x.BalanceRune != ""
 	case "types.QueryDerivedPoolResponse.derived_depth_bps":
 		return x.DerivedDepthBps != ""
+	case "types.QueryDerivedPoolResponse.anchor_twap_price":
+		return x.AnchorTwapPrice != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolResponse"))
@@ -674,6 +732,8 @@ func (x *fastReflection_QueryDerivedPoolResponse) Clear(fd protoreflect.FieldDes
 		x.BalanceRune = ""
 	case "types.QueryDerivedPoolResponse.derived_depth_bps":
 		x.DerivedDepthBps = ""
+	case "types.QueryDerivedPoolResponse.anchor_twap_price":
+		x.AnchorTwapPrice = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolResponse"))
@@ -708,6 +768,9 @@ func (x *fastReflection_QueryDerivedPoolResponse) Get(descriptor protoreflect.Fi
 	case "types.QueryDerivedPoolResponse.derived_depth_bps":
 		value := x.DerivedDepthBps
 		return protoreflect.ValueOfString(value)
+	case "types.QueryDerivedPoolResponse.anchor_twap_price":
+		value := x.AnchorTwapPrice
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolResponse"))
@@ -740,6 +803,8 @@ func (x *fastReflection_QueryDerivedPoolResponse) Set(fd protoreflect.FieldDescr
 		x.BalanceRune = value.Interface().(string)
 	case "types.QueryDerivedPoolResponse.derived_depth_bps":
 		x.DerivedDepthBps = value.Interface().(string)
+	case "types.QueryDerivedPoolResponse.anchor_twap_price":
+		x.AnchorTwapPrice = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolResponse"))
@@ -772,6 +837,8 @@ func (x *fastReflection_QueryDerivedPoolResponse) Mutable(fd protoreflect.FieldD
 		panic(fmt.Errorf("field balance_rune of message types.QueryDerivedPoolResponse is not mutable"))
 	case "types.QueryDerivedPoolResponse.derived_depth_bps":
 		panic(fmt.Errorf("field derived_depth_bps of message types.QueryDerivedPoolResponse is not mutable"))
+	case "types.QueryDerivedPoolResponse.anchor_twap_price":
+		panic(fmt.Errorf("field anchor_twap_price of message types.QueryDerivedPoolResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolResponse"))
@@ -797,6 +864,8 @@ func (x *fastReflection_QueryDerivedPoolResponse) NewField(fd protoreflect.Field
 		return protoreflect.ValueOfString("")
 	case "types.QueryDerivedPoolResponse.derived_depth_bps":
 		return protoreflect.ValueOfString("")
+	case "types.QueryDerivedPoolResponse.anchor_twap_price":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryDerivedPoolResponse"))
@@ -889,6 +958,10 @@ func (x *fastReflection_QueryDerivedPoolResponse) ProtoMethods() *protoiface.Met
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.AnchorTwapPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -918,6 +991,13 @@ func (x *fastReflection_QueryDerivedPoolResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AnchorTwapPrice) > 0 {
+			i -= len(x.AnchorTwapPrice)
+			copy(dAtA[i:], x.AnchorTwapPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnchorTwapPrice)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if len(x.DerivedDepthBps) > 0 {
 			i -= len(x.DerivedDepthBps)
 			copy(dAtA[i:], x.DerivedDepthBps)
@@ -1186,6 +1266,38 @@ func (x *fastReflection_QueryDerivedPoolResponse) ProtoMethods() *protoiface.Met
 				}
 				x.DerivedDepthBps = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnchorTwapPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnchorTwapPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2153,8 +2265,9 @@ type QueryDerivedPoolRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset      string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Height     string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	TwapBlocks int64  `protobuf:"varint,3,opt,name=twap_blocks,json=twapBlocks,proto3" json:"twap_blocks,omitempty"`
 }
 
 func (x *QueryDerivedPoolRequest) Reset() {
@@ -2191,6 +2304,13 @@ func (x *QueryDerivedPoolRequest) GetHeight() string {
 	return ""
 }
 
+func (x *QueryDerivedPoolRequest) GetTwapBlocks() int64 {
+	if x != nil {
+		return x.TwapBlocks
+	}
+	return 0
+}
+
 type QueryDerivedPoolResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2203,6 +2323,8 @@ type QueryDerivedPoolResponse struct {
 	BalanceRune  string `protobuf:"bytes,5,opt,name=balance_rune,json=balanceRune,proto3" json:"balance_rune,omitempty"`
 	// the depth of the derived virtual pool relative to L1 pool (in basis points)
 	DerivedDepthBps string `protobuf:"bytes,6,opt,name=derived_depth_bps,json=derivedDepthBps,proto3" json:"derived_depth_bps,omitempty"`
+	// the median time weighted average price of the anchor pools over twap_blocks, in asset per RUNE (1e8)
+	AnchorTwapPrice string `protobuf:"bytes,7,opt,name=anchor_twap_price,json=anchorTwapPrice,proto3" json:"anchor_twap_price,omitempty"`
 }
 
 func (x *QueryDerivedPoolResponse) Reset() {
@@ -2267,6 +2389,13 @@ func (x *QueryDerivedPoolResponse) GetDerivedDepthBps() string {
 	return ""
 }
 
+func (x *QueryDerivedPoolResponse) GetAnchorTwapPrice() string {
+	if x != nil {
+		return x.AnchorTwapPrice
+	}
+	return ""
+}
+
 type QueryDerivedPoolsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2343,48 +2472,53 @@ var file_types_query_derived_pool_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x64, 0x65,
 	0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68, 0x0a,
 	0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f,
 	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
 	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16,
 	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xab, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
-	0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
-	0x6d, 0x61, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xea, 0xde, 0x1f,
-	0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0c,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a, 0x0c,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x72, 0x75, 0x6e, 0x65, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6e,
-	0x65, 0x12, 0x41, 0x0a, 0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70,
-	0x74, 0x68, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xea, 0xde,
-	0x1f, 0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x5f,
-	0x62, 0x70, 0x73, 0x52, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x44, 0x65, 0x70, 0x74,
-	0x68, 0x42, 0x70, 0x73, 0x22, 0x32, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72,
-	0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x86, 0x01, 0x0a,
-	0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x15, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f,
-	0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2,
-	0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0xc8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x77, 0x61,
+	0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xd7, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0xea, 0xde,
+	0x1f, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x33, 0x0a,
+	0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75,
+	0x6e, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xea,
+	0xde, 0x1f, 0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x5f, 0x62, 0x70, 0x73, 0x52, 0x0f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x42, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x74, 0x77, 0x61, 0x70, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x54, 0x77, 0x61, 0x70, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0x32, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x86, 0x01, 0xc8, 0xe2, 0x1e, 0x01,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x15, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02,
+	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
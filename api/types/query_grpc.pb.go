@@ -19,87 +19,96 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Account_FullMethodName             = "/types.Query/Account"
-	Query_Balances_FullMethodName            = "/types.Query/Balances"
-	Query_Export_FullMethodName              = "/types.Query/Export"
-	Query_Pool_FullMethodName                = "/types.Query/Pool"
-	Query_Pools_FullMethodName               = "/types.Query/Pools"
-	Query_DerivedPool_FullMethodName         = "/types.Query/DerivedPool"
-	Query_DerivedPools_FullMethodName        = "/types.Query/DerivedPools"
-	Query_LiquidityProvider_FullMethodName   = "/types.Query/LiquidityProvider"
-	Query_LiquidityProviders_FullMethodName  = "/types.Query/LiquidityProviders"
-	Query_Saver_FullMethodName               = "/types.Query/Saver"
-	Query_Savers_FullMethodName              = "/types.Query/Savers"
-	Query_Borrower_FullMethodName            = "/types.Query/Borrower"
-	Query_Borrowers_FullMethodName           = "/types.Query/Borrowers"
-	Query_TradeUnit_FullMethodName           = "/types.Query/TradeUnit"
-	Query_TradeUnits_FullMethodName          = "/types.Query/TradeUnits"
-	Query_TradeAccount_FullMethodName        = "/types.Query/TradeAccount"
-	Query_TradeAccounts_FullMethodName       = "/types.Query/TradeAccounts"
-	Query_SecuredAsset_FullMethodName        = "/types.Query/SecuredAsset"
-	Query_SecuredAssets_FullMethodName       = "/types.Query/SecuredAssets"
-	Query_Node_FullMethodName                = "/types.Query/Node"
-	Query_Nodes_FullMethodName               = "/types.Query/Nodes"
-	Query_PoolSlip_FullMethodName            = "/types.Query/PoolSlip"
-	Query_PoolSlips_FullMethodName           = "/types.Query/PoolSlips"
-	Query_OutboundFee_FullMethodName         = "/types.Query/OutboundFee"
-	Query_OutboundFees_FullMethodName        = "/types.Query/OutboundFees"
-	Query_StreamingSwap_FullMethodName       = "/types.Query/StreamingSwap"
-	Query_StreamingSwaps_FullMethodName      = "/types.Query/StreamingSwaps"
-	Query_Ban_FullMethodName                 = "/types.Query/Ban"
-	Query_Ragnarok_FullMethodName            = "/types.Query/Ragnarok"
-	Query_RunePool_FullMethodName            = "/types.Query/RunePool"
-	Query_RuneProvider_FullMethodName        = "/types.Query/RuneProvider"
-	Query_RuneProviders_FullMethodName       = "/types.Query/RuneProviders"
-	Query_MimirValues_FullMethodName         = "/types.Query/MimirValues"
-	Query_MimirWithKey_FullMethodName        = "/types.Query/MimirWithKey"
-	Query_MimirAdminValues_FullMethodName    = "/types.Query/MimirAdminValues"
-	Query_MimirNodesAllValues_FullMethodName = "/types.Query/MimirNodesAllValues"
-	Query_MimirNodesValues_FullMethodName    = "/types.Query/MimirNodesValues"
-	Query_MimirNodeValues_FullMethodName     = "/types.Query/MimirNodeValues"
-	Query_InboundAddresses_FullMethodName    = "/types.Query/InboundAddresses"
-	Query_Version_FullMethodName             = "/types.Query/Version"
-	Query_Thorname_FullMethodName            = "/types.Query/Thorname"
-	Query_Invariant_FullMethodName           = "/types.Query/Invariant"
-	Query_Invariants_FullMethodName          = "/types.Query/Invariants"
-	Query_Network_FullMethodName             = "/types.Query/Network"
-	Query_BalanceModule_FullMethodName       = "/types.Query/BalanceModule"
-	Query_QuoteSwap_FullMethodName           = "/types.Query/QuoteSwap"
-	Query_QuoteSaverDeposit_FullMethodName   = "/types.Query/QuoteSaverDeposit"
-	Query_QuoteSaverWithdraw_FullMethodName  = "/types.Query/QuoteSaverWithdraw"
-	Query_QuoteLoanOpen_FullMethodName       = "/types.Query/QuoteLoanOpen"
-	Query_QuoteLoanClose_FullMethodName      = "/types.Query/QuoteLoanClose"
-	Query_ConstantValues_FullMethodName      = "/types.Query/ConstantValues"
-	Query_SwapQueue_FullMethodName           = "/types.Query/SwapQueue"
-	Query_SwapDetails_FullMethodName         = "/types.Query/SwapDetails"
-	Query_LastBlocks_FullMethodName          = "/types.Query/LastBlocks"
-	Query_ChainsLastBlock_FullMethodName     = "/types.Query/ChainsLastBlock"
-	Query_Vault_FullMethodName               = "/types.Query/Vault"
-	Query_AsgardVaults_FullMethodName        = "/types.Query/AsgardVaults"
-	Query_VaultsPubkeys_FullMethodName       = "/types.Query/VaultsPubkeys"
-	Query_TxStages_FullMethodName            = "/types.Query/TxStages"
-	Query_TxStatus_FullMethodName            = "/types.Query/TxStatus"
-	Query_Tx_FullMethodName                  = "/types.Query/Tx"
-	Query_TxVoters_FullMethodName            = "/types.Query/TxVoters"
-	Query_TxVotersOld_FullMethodName         = "/types.Query/TxVotersOld"
-	Query_Clout_FullMethodName               = "/types.Query/Clout"
-	Query_Queue_FullMethodName               = "/types.Query/Queue"
-	Query_ScheduledOutbound_FullMethodName   = "/types.Query/ScheduledOutbound"
-	Query_PendingOutbound_FullMethodName     = "/types.Query/PendingOutbound"
-	Query_Block_FullMethodName               = "/types.Query/Block"
-	Query_TssKeygenMetric_FullMethodName     = "/types.Query/TssKeygenMetric"
-	Query_TssMetric_FullMethodName           = "/types.Query/TssMetric"
-	Query_Keysign_FullMethodName             = "/types.Query/Keysign"
-	Query_KeysignPubkey_FullMethodName       = "/types.Query/KeysignPubkey"
-	Query_Keygen_FullMethodName              = "/types.Query/Keygen"
-	Query_UpgradeProposals_FullMethodName    = "/types.Query/UpgradeProposals"
-	Query_UpgradeProposal_FullMethodName     = "/types.Query/UpgradeProposal"
-	Query_UpgradeVotes_FullMethodName        = "/types.Query/UpgradeVotes"
-	Query_TCYStaker_FullMethodName           = "/types.Query/TCYStaker"
-	Query_TCYStakers_FullMethodName          = "/types.Query/TCYStakers"
-	Query_TCYClaimer_FullMethodName          = "/types.Query/TCYClaimer"
-	Query_TCYClaimers_FullMethodName         = "/types.Query/TCYClaimers"
-	Query_Eip712TypedData_FullMethodName     = "/types.Query/Eip712TypedData"
+	Query_Account_FullMethodName              = "/types.Query/Account"
+	Query_Balances_FullMethodName             = "/types.Query/Balances"
+	Query_Export_FullMethodName               = "/types.Query/Export"
+	Query_Pool_FullMethodName                 = "/types.Query/Pool"
+	Query_Pools_FullMethodName                = "/types.Query/Pools"
+	Query_DerivedPool_FullMethodName          = "/types.Query/DerivedPool"
+	Query_DerivedPools_FullMethodName         = "/types.Query/DerivedPools"
+	Query_PoolHistory_FullMethodName          = "/types.Query/PoolHistory"
+	Query_PoolTWAP_FullMethodName             = "/types.Query/PoolTWAP"
+	Query_LiquidityProvider_FullMethodName    = "/types.Query/LiquidityProvider"
+	Query_LiquidityProviders_FullMethodName   = "/types.Query/LiquidityProviders"
+	Query_Saver_FullMethodName                = "/types.Query/Saver"
+	Query_Savers_FullMethodName               = "/types.Query/Savers"
+	Query_Borrower_FullMethodName             = "/types.Query/Borrower"
+	Query_Borrowers_FullMethodName            = "/types.Query/Borrowers"
+	Query_TradeUnit_FullMethodName            = "/types.Query/TradeUnit"
+	Query_TradeUnits_FullMethodName           = "/types.Query/TradeUnits"
+	Query_TradeAccount_FullMethodName         = "/types.Query/TradeAccount"
+	Query_TradeAccounts_FullMethodName        = "/types.Query/TradeAccounts"
+	Query_SecuredAsset_FullMethodName         = "/types.Query/SecuredAsset"
+	Query_SecuredAssets_FullMethodName        = "/types.Query/SecuredAssets"
+	Query_Node_FullMethodName                 = "/types.Query/Node"
+	Query_NodeObservationStats_FullMethodName = "/types.Query/NodeObservationStats"
+	Query_Nodes_FullMethodName                = "/types.Query/Nodes"
+	Query_PoolSlip_FullMethodName             = "/types.Query/PoolSlip"
+	Query_PoolSlips_FullMethodName            = "/types.Query/PoolSlips"
+	Query_OutboundFee_FullMethodName          = "/types.Query/OutboundFee"
+	Query_OutboundFees_FullMethodName         = "/types.Query/OutboundFees"
+	Query_StreamingSwap_FullMethodName        = "/types.Query/StreamingSwap"
+	Query_StreamingSwaps_FullMethodName       = "/types.Query/StreamingSwaps"
+	Query_RecurringSwap_FullMethodName        = "/types.Query/RecurringSwap"
+	Query_RecurringSwaps_FullMethodName       = "/types.Query/RecurringSwaps"
+	Query_Ban_FullMethodName                  = "/types.Query/Ban"
+	Query_Ragnarok_FullMethodName             = "/types.Query/Ragnarok"
+	Query_RunePool_FullMethodName             = "/types.Query/RunePool"
+	Query_RuneProvider_FullMethodName         = "/types.Query/RuneProvider"
+	Query_RuneProviders_FullMethodName        = "/types.Query/RuneProviders"
+	Query_MimirValues_FullMethodName          = "/types.Query/MimirValues"
+	Query_MimirWithKey_FullMethodName         = "/types.Query/MimirWithKey"
+	Query_MimirAdminValues_FullMethodName     = "/types.Query/MimirAdminValues"
+	Query_MimirScheduled_FullMethodName       = "/types.Query/MimirScheduled"
+	Query_MimirRegistry_FullMethodName        = "/types.Query/MimirRegistry"
+	Query_MimirHistory_FullMethodName         = "/types.Query/MimirHistory"
+	Query_MimirNodesAllValues_FullMethodName  = "/types.Query/MimirNodesAllValues"
+	Query_MimirNodesValues_FullMethodName     = "/types.Query/MimirNodesValues"
+	Query_MimirNodeValues_FullMethodName      = "/types.Query/MimirNodeValues"
+	Query_MimirNodeHistory_FullMethodName     = "/types.Query/MimirNodeHistory"
+	Query_InboundAddresses_FullMethodName     = "/types.Query/InboundAddresses"
+	Query_Version_FullMethodName              = "/types.Query/Version"
+	Query_Thorname_FullMethodName             = "/types.Query/Thorname"
+	Query_Invariant_FullMethodName            = "/types.Query/Invariant"
+	Query_Invariants_FullMethodName           = "/types.Query/Invariants"
+	Query_Network_FullMethodName              = "/types.Query/Network"
+	Query_BalanceModule_FullMethodName        = "/types.Query/BalanceModule"
+	Query_QuoteSwap_FullMethodName            = "/types.Query/QuoteSwap"
+	Query_QuoteSaverDeposit_FullMethodName    = "/types.Query/QuoteSaverDeposit"
+	Query_QuoteSaverWithdraw_FullMethodName   = "/types.Query/QuoteSaverWithdraw"
+	Query_QuoteLoanOpen_FullMethodName        = "/types.Query/QuoteLoanOpen"
+	Query_QuoteLoanClose_FullMethodName       = "/types.Query/QuoteLoanClose"
+	Query_ConstantValues_FullMethodName       = "/types.Query/ConstantValues"
+	Query_SwapQueue_FullMethodName            = "/types.Query/SwapQueue"
+	Query_SwapDetails_FullMethodName          = "/types.Query/SwapDetails"
+	Query_LastBlocks_FullMethodName           = "/types.Query/LastBlocks"
+	Query_ChainsLastBlock_FullMethodName      = "/types.Query/ChainsLastBlock"
+	Query_Vault_FullMethodName                = "/types.Query/Vault"
+	Query_AsgardVaults_FullMethodName         = "/types.Query/AsgardVaults"
+	Query_VaultsPubkeys_FullMethodName        = "/types.Query/VaultsPubkeys"
+	Query_TxStages_FullMethodName             = "/types.Query/TxStages"
+	Query_TxStatus_FullMethodName             = "/types.Query/TxStatus"
+	Query_Tx_FullMethodName                   = "/types.Query/Tx"
+	Query_TxVoters_FullMethodName             = "/types.Query/TxVoters"
+	Query_TxVotersOld_FullMethodName          = "/types.Query/TxVotersOld"
+	Query_Clout_FullMethodName                = "/types.Query/Clout"
+	Query_Queue_FullMethodName                = "/types.Query/Queue"
+	Query_ScheduledOutbound_FullMethodName    = "/types.Query/ScheduledOutbound"
+	Query_PendingOutbound_FullMethodName      = "/types.Query/PendingOutbound"
+	Query_Block_FullMethodName                = "/types.Query/Block"
+	Query_TssKeygenMetric_FullMethodName      = "/types.Query/TssKeygenMetric"
+	Query_TssMetric_FullMethodName            = "/types.Query/TssMetric"
+	Query_Keysign_FullMethodName              = "/types.Query/Keysign"
+	Query_KeysignPubkey_FullMethodName        = "/types.Query/KeysignPubkey"
+	Query_Keygen_FullMethodName               = "/types.Query/Keygen"
+	Query_UpgradeProposals_FullMethodName     = "/types.Query/UpgradeProposals"
+	Query_UpgradeProposal_FullMethodName      = "/types.Query/UpgradeProposal"
+	Query_UpgradeVotes_FullMethodName         = "/types.Query/UpgradeVotes"
+	Query_TCYStaker_FullMethodName            = "/types.Query/TCYStaker"
+	Query_TCYStakers_FullMethodName           = "/types.Query/TCYStakers"
+	Query_TCYClaimer_FullMethodName           = "/types.Query/TCYClaimer"
+	Query_TCYClaimers_FullMethodName          = "/types.Query/TCYClaimers"
+	Query_Eip712TypedData_FullMethodName      = "/types.Query/Eip712TypedData"
 )
 
 // QueryClient is the client API for Query service.
@@ -122,6 +131,10 @@ type QueryClient interface {
 	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
 	DerivedPool(ctx context.Context, in *QueryDerivedPoolRequest, opts ...grpc.CallOption) (*QueryDerivedPoolResponse, error)
 	DerivedPools(ctx context.Context, in *QueryDerivedPoolsRequest, opts ...grpc.CallOption) (*QueryDerivedPoolsResponse, error)
+	// Returns the liquidity fee, reward, synth yield and POL history of a pool.
+	PoolHistory(ctx context.Context, in *QueryPoolHistoryRequest, opts ...grpc.CallOption) (*QueryPoolHistoryResponse, error)
+	// Returns the time weighted average price of a pool over the given number of blocks.
+	PoolTWAP(ctx context.Context, in *QueryPoolTWAPRequest, opts ...grpc.CallOption) (*QueryPoolTWAPResponse, error)
 	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error)
 	Saver(ctx context.Context, in *QuerySaverRequest, opts ...grpc.CallOption) (*QuerySaverResponse, error)
@@ -135,6 +148,7 @@ type QueryClient interface {
 	SecuredAsset(ctx context.Context, in *QuerySecuredAssetRequest, opts ...grpc.CallOption) (*QuerySecuredAssetResponse, error)
 	SecuredAssets(ctx context.Context, in *QuerySecuredAssetsRequest, opts ...grpc.CallOption) (*QuerySecuredAssetsResponse, error)
 	Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error)
+	NodeObservationStats(ctx context.Context, in *QueryObservationStatsRequest, opts ...grpc.CallOption) (*QueryObservationStatsResponse, error)
 	Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error)
 	PoolSlip(ctx context.Context, in *QueryPoolSlipRequest, opts ...grpc.CallOption) (*QueryPoolSlipsResponse, error)
 	PoolSlips(ctx context.Context, in *QueryPoolSlipsRequest, opts ...grpc.CallOption) (*QueryPoolSlipsResponse, error)
@@ -142,6 +156,8 @@ type QueryClient interface {
 	OutboundFees(ctx context.Context, in *QueryOutboundFeesRequest, opts ...grpc.CallOption) (*QueryOutboundFeesResponse, error)
 	StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (*QueryStreamingSwapsResponse, error)
+	RecurringSwap(ctx context.Context, in *QueryRecurringSwapRequest, opts ...grpc.CallOption) (*QueryRecurringSwapResponse, error)
+	RecurringSwaps(ctx context.Context, in *QueryRecurringSwapsRequest, opts ...grpc.CallOption) (*QueryRecurringSwapsResponse, error)
 	Ban(ctx context.Context, in *QueryBanRequest, opts ...grpc.CallOption) (*BanVoter, error)
 	Ragnarok(ctx context.Context, in *QueryRagnarokRequest, opts ...grpc.CallOption) (*QueryRagnarokResponse, error)
 	RunePool(ctx context.Context, in *QueryRunePoolRequest, opts ...grpc.CallOption) (*QueryRunePoolResponse, error)
@@ -150,9 +166,13 @@ type QueryClient interface {
 	MimirValues(ctx context.Context, in *QueryMimirValuesRequest, opts ...grpc.CallOption) (*QueryMimirValuesResponse, error)
 	MimirWithKey(ctx context.Context, in *QueryMimirWithKeyRequest, opts ...grpc.CallOption) (*QueryMimirWithKeyResponse, error)
 	MimirAdminValues(ctx context.Context, in *QueryMimirAdminValuesRequest, opts ...grpc.CallOption) (*QueryMimirAdminValuesResponse, error)
+	MimirScheduled(ctx context.Context, in *QueryMimirScheduledRequest, opts ...grpc.CallOption) (*QueryMimirScheduledResponse, error)
+	MimirRegistry(ctx context.Context, in *QueryMimirRegistryRequest, opts ...grpc.CallOption) (*QueryMimirRegistryResponse, error)
+	MimirHistory(ctx context.Context, in *QueryMimirHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error)
 	MimirNodesAllValues(ctx context.Context, in *QueryMimirNodesAllValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodesAllValuesResponse, error)
 	MimirNodesValues(ctx context.Context, in *QueryMimirNodesValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodesValuesResponse, error)
 	MimirNodeValues(ctx context.Context, in *QueryMimirNodeValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodeValuesResponse, error)
+	MimirNodeHistory(ctx context.Context, in *QueryMimirNodeHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error)
 	InboundAddresses(ctx context.Context, in *QueryInboundAddressesRequest, opts ...grpc.CallOption) (*QueryInboundAddressesResponse, error)
 	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
 	Thorname(ctx context.Context, in *QueryThornameRequest, opts ...grpc.CallOption) (*QueryThornameResponse, error)
@@ -269,6 +289,24 @@ func (c *queryClient) DerivedPools(ctx context.Context, in *QueryDerivedPoolsReq
 	return out, nil
 }
 
+func (c *queryClient) PoolHistory(ctx context.Context, in *QueryPoolHistoryRequest, opts ...grpc.CallOption) (*QueryPoolHistoryResponse, error) {
+	out := new(QueryPoolHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_PoolHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolTWAP(ctx context.Context, in *QueryPoolTWAPRequest, opts ...grpc.CallOption) (*QueryPoolTWAPResponse, error) {
+	out := new(QueryPoolTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_PoolTWAP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error) {
 	out := new(QueryLiquidityProviderResponse)
 	err := c.cc.Invoke(ctx, Query_LiquidityProvider_FullMethodName, in, out, opts...)
@@ -386,6 +424,15 @@ func (c *queryClient) Node(ctx context.Context, in *QueryNodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) NodeObservationStats(ctx context.Context, in *QueryObservationStatsRequest, opts ...grpc.CallOption) (*QueryObservationStatsResponse, error) {
+	out := new(QueryObservationStatsResponse)
+	err := c.cc.Invoke(ctx, Query_NodeObservationStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error) {
 	out := new(QueryNodesResponse)
 	err := c.cc.Invoke(ctx, Query_Nodes_FullMethodName, in, out, opts...)
@@ -449,6 +496,24 @@ func (c *queryClient) StreamingSwaps(ctx context.Context, in *QueryStreamingSwap
 	return out, nil
 }
 
+func (c *queryClient) RecurringSwap(ctx context.Context, in *QueryRecurringSwapRequest, opts ...grpc.CallOption) (*QueryRecurringSwapResponse, error) {
+	out := new(QueryRecurringSwapResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringSwaps(ctx context.Context, in *QueryRecurringSwapsRequest, opts ...grpc.CallOption) (*QueryRecurringSwapsResponse, error) {
+	out := new(QueryRecurringSwapsResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringSwaps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Ban(ctx context.Context, in *QueryBanRequest, opts ...grpc.CallOption) (*BanVoter, error) {
 	out := new(BanVoter)
 	err := c.cc.Invoke(ctx, Query_Ban_FullMethodName, in, out, opts...)
@@ -521,6 +586,33 @@ func (c *queryClient) MimirAdminValues(ctx context.Context, in *QueryMimirAdminV
 	return out, nil
 }
 
+func (c *queryClient) MimirScheduled(ctx context.Context, in *QueryMimirScheduledRequest, opts ...grpc.CallOption) (*QueryMimirScheduledResponse, error) {
+	out := new(QueryMimirScheduledResponse)
+	err := c.cc.Invoke(ctx, Query_MimirScheduled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MimirRegistry(ctx context.Context, in *QueryMimirRegistryRequest, opts ...grpc.CallOption) (*QueryMimirRegistryResponse, error) {
+	out := new(QueryMimirRegistryResponse)
+	err := c.cc.Invoke(ctx, Query_MimirRegistry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MimirHistory(ctx context.Context, in *QueryMimirHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error) {
+	out := new(QueryMimirHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_MimirHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) MimirNodesAllValues(ctx context.Context, in *QueryMimirNodesAllValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodesAllValuesResponse, error) {
 	out := new(QueryMimirNodesAllValuesResponse)
 	err := c.cc.Invoke(ctx, Query_MimirNodesAllValues_FullMethodName, in, out, opts...)
@@ -548,6 +640,15 @@ func (c *queryClient) MimirNodeValues(ctx context.Context, in *QueryMimirNodeVal
 	return out, nil
 }
 
+func (c *queryClient) MimirNodeHistory(ctx context.Context, in *QueryMimirNodeHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error) {
+	out := new(QueryMimirHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_MimirNodeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) InboundAddresses(ctx context.Context, in *QueryInboundAddressesRequest, opts ...grpc.CallOption) (*QueryInboundAddressesResponse, error) {
 	out := new(QueryInboundAddressesResponse)
 	err := c.cc.Invoke(ctx, Query_InboundAddresses_FullMethodName, in, out, opts...)
@@ -955,6 +1056,10 @@ type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
 	DerivedPool(context.Context, *QueryDerivedPoolRequest) (*QueryDerivedPoolResponse, error)
 	DerivedPools(context.Context, *QueryDerivedPoolsRequest) (*QueryDerivedPoolsResponse, error)
+	// Returns the liquidity fee, reward, synth yield and POL history of a pool.
+	PoolHistory(context.Context, *QueryPoolHistoryRequest) (*QueryPoolHistoryResponse, error)
+	// Returns the time weighted average price of a pool over the given number of blocks.
+	PoolTWAP(context.Context, *QueryPoolTWAPRequest) (*QueryPoolTWAPResponse, error)
 	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error)
 	Saver(context.Context, *QuerySaverRequest) (*QuerySaverResponse, error)
@@ -968,6 +1073,7 @@ type QueryServer interface {
 	SecuredAsset(context.Context, *QuerySecuredAssetRequest) (*QuerySecuredAssetResponse, error)
 	SecuredAssets(context.Context, *QuerySecuredAssetsRequest) (*QuerySecuredAssetsResponse, error)
 	Node(context.Context, *QueryNodeRequest) (*QueryNodeResponse, error)
+	NodeObservationStats(context.Context, *QueryObservationStatsRequest) (*QueryObservationStatsResponse, error)
 	Nodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error)
 	PoolSlip(context.Context, *QueryPoolSlipRequest) (*QueryPoolSlipsResponse, error)
 	PoolSlips(context.Context, *QueryPoolSlipsRequest) (*QueryPoolSlipsResponse, error)
@@ -975,6 +1081,8 @@ type QueryServer interface {
 	OutboundFees(context.Context, *QueryOutboundFeesRequest) (*QueryOutboundFeesResponse, error)
 	StreamingSwap(context.Context, *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(context.Context, *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error)
+	RecurringSwap(context.Context, *QueryRecurringSwapRequest) (*QueryRecurringSwapResponse, error)
+	RecurringSwaps(context.Context, *QueryRecurringSwapsRequest) (*QueryRecurringSwapsResponse, error)
 	Ban(context.Context, *QueryBanRequest) (*BanVoter, error)
 	Ragnarok(context.Context, *QueryRagnarokRequest) (*QueryRagnarokResponse, error)
 	RunePool(context.Context, *QueryRunePoolRequest) (*QueryRunePoolResponse, error)
@@ -983,9 +1091,13 @@ type QueryServer interface {
 	MimirValues(context.Context, *QueryMimirValuesRequest) (*QueryMimirValuesResponse, error)
 	MimirWithKey(context.Context, *QueryMimirWithKeyRequest) (*QueryMimirWithKeyResponse, error)
 	MimirAdminValues(context.Context, *QueryMimirAdminValuesRequest) (*QueryMimirAdminValuesResponse, error)
+	MimirScheduled(context.Context, *QueryMimirScheduledRequest) (*QueryMimirScheduledResponse, error)
+	MimirRegistry(context.Context, *QueryMimirRegistryRequest) (*QueryMimirRegistryResponse, error)
+	MimirHistory(context.Context, *QueryMimirHistoryRequest) (*QueryMimirHistoryResponse, error)
 	MimirNodesAllValues(context.Context, *QueryMimirNodesAllValuesRequest) (*QueryMimirNodesAllValuesResponse, error)
 	MimirNodesValues(context.Context, *QueryMimirNodesValuesRequest) (*QueryMimirNodesValuesResponse, error)
 	MimirNodeValues(context.Context, *QueryMimirNodeValuesRequest) (*QueryMimirNodeValuesResponse, error)
+	MimirNodeHistory(context.Context, *QueryMimirNodeHistoryRequest) (*QueryMimirHistoryResponse, error)
 	InboundAddresses(context.Context, *QueryInboundAddressesRequest) (*QueryInboundAddressesResponse, error)
 	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
 	Thorname(context.Context, *QueryThornameRequest) (*QueryThornameResponse, error)
@@ -1057,6 +1169,12 @@ func (UnimplementedQueryServer) DerivedPool(context.Context, *QueryDerivedPoolRe
 func (UnimplementedQueryServer) DerivedPools(context.Context, *QueryDerivedPoolsRequest) (*QueryDerivedPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DerivedPools not implemented")
 }
+func (UnimplementedQueryServer) PoolHistory(context.Context, *QueryPoolHistoryRequest) (*QueryPoolHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolHistory not implemented")
+}
+func (UnimplementedQueryServer) PoolTWAP(context.Context, *QueryPoolTWAPRequest) (*QueryPoolTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolTWAP not implemented")
+}
 func (UnimplementedQueryServer) LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvider not implemented")
 }
@@ -1096,6 +1214,9 @@ func (UnimplementedQueryServer) SecuredAssets(context.Context, *QuerySecuredAsse
 func (UnimplementedQueryServer) Node(context.Context, *QueryNodeRequest) (*QueryNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Node not implemented")
 }
+func (UnimplementedQueryServer) NodeObservationStats(context.Context, *QueryObservationStatsRequest) (*QueryObservationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeObservationStats not implemented")
+}
 func (UnimplementedQueryServer) Nodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Nodes not implemented")
 }
@@ -1117,6 +1238,12 @@ func (UnimplementedQueryServer) StreamingSwap(context.Context, *QueryStreamingSw
 func (UnimplementedQueryServer) StreamingSwaps(context.Context, *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StreamingSwaps not implemented")
 }
+func (UnimplementedQueryServer) RecurringSwap(context.Context, *QueryRecurringSwapRequest) (*QueryRecurringSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringSwap not implemented")
+}
+func (UnimplementedQueryServer) RecurringSwaps(context.Context, *QueryRecurringSwapsRequest) (*QueryRecurringSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringSwaps not implemented")
+}
 func (UnimplementedQueryServer) Ban(context.Context, *QueryBanRequest) (*BanVoter, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ban not implemented")
 }
@@ -1141,6 +1268,15 @@ func (UnimplementedQueryServer) MimirWithKey(context.Context, *QueryMimirWithKey
 func (UnimplementedQueryServer) MimirAdminValues(context.Context, *QueryMimirAdminValuesRequest) (*QueryMimirAdminValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MimirAdminValues not implemented")
 }
+func (UnimplementedQueryServer) MimirScheduled(context.Context, *QueryMimirScheduledRequest) (*QueryMimirScheduledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirScheduled not implemented")
+}
+func (UnimplementedQueryServer) MimirRegistry(context.Context, *QueryMimirRegistryRequest) (*QueryMimirRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirRegistry not implemented")
+}
+func (UnimplementedQueryServer) MimirHistory(context.Context, *QueryMimirHistoryRequest) (*QueryMimirHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirHistory not implemented")
+}
 func (UnimplementedQueryServer) MimirNodesAllValues(context.Context, *QueryMimirNodesAllValuesRequest) (*QueryMimirNodesAllValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MimirNodesAllValues not implemented")
 }
@@ -1150,6 +1286,9 @@ func (UnimplementedQueryServer) MimirNodesValues(context.Context, *QueryMimirNod
 func (UnimplementedQueryServer) MimirNodeValues(context.Context, *QueryMimirNodeValuesRequest) (*QueryMimirNodeValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MimirNodeValues not implemented")
 }
+func (UnimplementedQueryServer) MimirNodeHistory(context.Context, *QueryMimirNodeHistoryRequest) (*QueryMimirHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirNodeHistory not implemented")
+}
 func (UnimplementedQueryServer) InboundAddresses(context.Context, *QueryInboundAddressesRequest) (*QueryInboundAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InboundAddresses not implemented")
 }
@@ -1418,6 +1557,42 @@ func _Query_DerivedPools_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolHistory(ctx, req.(*QueryPoolHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolTWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolTWAP(ctx, req.(*QueryPoolTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLiquidityProviderRequest)
 	if err := dec(in); err != nil {
@@ -1652,6 +1827,24 @@ func _Query_Node_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NodeObservationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObservationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeObservationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NodeObservationStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeObservationStats(ctx, req.(*QueryObservationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Nodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNodesRequest)
 	if err := dec(in); err != nil {
@@ -1778,6 +1971,42 @@ func _Query_StreamingSwaps_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecurringSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringSwap(ctx, req.(*QueryRecurringSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringSwaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringSwaps(ctx, req.(*QueryRecurringSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Ban_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBanRequest)
 	if err := dec(in); err != nil {
@@ -1922,6 +2151,60 @@ func _Query_MimirAdminValues_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MimirScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MimirScheduled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirScheduled(ctx, req.(*QueryMimirScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MimirRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MimirRegistry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirRegistry(ctx, req.(*QueryMimirRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MimirHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MimirHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirHistory(ctx, req.(*QueryMimirHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_MimirNodesAllValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryMimirNodesAllValuesRequest)
 	if err := dec(in); err != nil {
@@ -1976,6 +2259,24 @@ func _Query_MimirNodeValues_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MimirNodeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirNodeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirNodeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MimirNodeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirNodeHistory(ctx, req.(*QueryMimirNodeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_InboundAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryInboundAddressesRequest)
 	if err := dec(in); err != nil {
@@ -2785,6 +3086,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DerivedPools",
 			Handler:    _Query_DerivedPools_Handler,
 		},
+		{
+			MethodName: "PoolHistory",
+			Handler:    _Query_PoolHistory_Handler,
+		},
+		{
+			MethodName: "PoolTWAP",
+			Handler:    _Query_PoolTWAP_Handler,
+		},
 		{
 			MethodName: "LiquidityProvider",
 			Handler:    _Query_LiquidityProvider_Handler,
@@ -2837,6 +3146,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Node",
 			Handler:    _Query_Node_Handler,
 		},
+		{
+			MethodName: "NodeObservationStats",
+			Handler:    _Query_NodeObservationStats_Handler,
+		},
 		{
 			MethodName: "Nodes",
 			Handler:    _Query_Nodes_Handler,
@@ -2865,6 +3178,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StreamingSwaps",
 			Handler:    _Query_StreamingSwaps_Handler,
 		},
+		{
+			MethodName: "RecurringSwap",
+			Handler:    _Query_RecurringSwap_Handler,
+		},
+		{
+			MethodName: "RecurringSwaps",
+			Handler:    _Query_RecurringSwaps_Handler,
+		},
 		{
 			MethodName: "Ban",
 			Handler:    _Query_Ban_Handler,
@@ -2897,6 +3218,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MimirAdminValues",
 			Handler:    _Query_MimirAdminValues_Handler,
 		},
+		{
+			MethodName: "MimirScheduled",
+			Handler:    _Query_MimirScheduled_Handler,
+		},
+		{
+			MethodName: "MimirRegistry",
+			Handler:    _Query_MimirRegistry_Handler,
+		},
+		{
+			MethodName: "MimirHistory",
+			Handler:    _Query_MimirHistory_Handler,
+		},
 		{
 			MethodName: "MimirNodesAllValues",
 			Handler:    _Query_MimirNodesAllValues_Handler,
@@ -2909,6 +3242,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MimirNodeValues",
 			Handler:    _Query_MimirNodeValues_Handler,
 		},
+		{
+			MethodName: "MimirNodeHistory",
+			Handler:    _Query_MimirNodeHistory_Handler,
+		},
 		{
 			MethodName: "InboundAddresses",
 			Handler:    _Query_InboundAddresses_Handler,
@@ -3392,9 +3392,11 @@ func (x *_NodeBondProviders_2_list) IsValid() bool {
 }
 
 var (
-	md_NodeBondProviders                   protoreflect.MessageDescriptor
-	fd_NodeBondProviders_node_operator_fee protoreflect.FieldDescriptor
-	fd_NodeBondProviders_providers         protoreflect.FieldDescriptor
+	md_NodeBondProviders                                  protoreflect.MessageDescriptor
+	fd_NodeBondProviders_node_operator_fee                protoreflect.FieldDescriptor
+	fd_NodeBondProviders_providers                        protoreflect.FieldDescriptor
+	fd_NodeBondProviders_pending_node_operator_fee        protoreflect.FieldDescriptor
+	fd_NodeBondProviders_pending_node_operator_fee_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3402,6 +3404,8 @@ func init() {
 	md_NodeBondProviders = File_types_query_node_proto.Messages().ByName("NodeBondProviders")
 	fd_NodeBondProviders_node_operator_fee = md_NodeBondProviders.Fields().ByName("node_operator_fee")
 	fd_NodeBondProviders_providers = md_NodeBondProviders.Fields().ByName("providers")
+	fd_NodeBondProviders_pending_node_operator_fee = md_NodeBondProviders.Fields().ByName("pending_node_operator_fee")
+	fd_NodeBondProviders_pending_node_operator_fee_height = md_NodeBondProviders.Fields().ByName("pending_node_operator_fee_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_NodeBondProviders)(nil)
@@ -3481,6 +3485,18 @@ func (x *fastReflection_NodeBondProviders) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.PendingNodeOperatorFee != "" {
+		value := protoreflect.ValueOfString(x.PendingNodeOperatorFee)
+		if !f(fd_NodeBondProviders_pending_node_operator_fee, value) {
+			return
+		}
+	}
+	if x.PendingNodeOperatorFeeHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PendingNodeOperatorFeeHeight)
+		if !f(fd_NodeBondProviders_pending_node_operator_fee_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3500,6 +3516,10 @@ func (x *fastReflection_NodeBondProviders) Has(fd protoreflect.FieldDescriptor)
 		return x.NodeOperatorFee != ""
 	case "types.NodeBondProviders.providers":
 		return len(x.Providers) != 0
+	case "types.NodeBondProviders.pending_node_operator_fee":
+		return x.PendingNodeOperatorFee != ""
+	case "types.NodeBondProviders.pending_node_operator_fee_height":
+		return x.PendingNodeOperatorFeeHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProviders"))
@@ -3520,6 +3540,10 @@ func (x *fastReflection_NodeBondProviders) Clear(fd protoreflect.FieldDescriptor
 		x.NodeOperatorFee = ""
 	case "types.NodeBondProviders.providers":
 		x.Providers = nil
+	case "types.NodeBondProviders.pending_node_operator_fee":
+		x.PendingNodeOperatorFee = ""
+	case "types.NodeBondProviders.pending_node_operator_fee_height":
+		x.PendingNodeOperatorFeeHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProviders"))
@@ -3545,6 +3569,12 @@ func (x *fastReflection_NodeBondProviders) Get(descriptor protoreflect.FieldDesc
 		}
 		listValue := &_NodeBondProviders_2_list{list: &x.Providers}
 		return protoreflect.ValueOfList(listValue)
+	case "types.NodeBondProviders.pending_node_operator_fee":
+		value := x.PendingNodeOperatorFee
+		return protoreflect.ValueOfString(value)
+	case "types.NodeBondProviders.pending_node_operator_fee_height":
+		value := x.PendingNodeOperatorFeeHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProviders"))
@@ -3571,6 +3601,10 @@ func (x *fastReflection_NodeBondProviders) Set(fd protoreflect.FieldDescriptor,
 		lv := value.List()
 		clv := lv.(*_NodeBondProviders_2_list)
 		x.Providers = *clv.list
+	case "types.NodeBondProviders.pending_node_operator_fee":
+		x.PendingNodeOperatorFee = value.Interface().(string)
+	case "types.NodeBondProviders.pending_node_operator_fee_height":
+		x.PendingNodeOperatorFeeHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProviders"))
@@ -3599,6 +3633,10 @@ func (x *fastReflection_NodeBondProviders) Mutable(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfList(value)
 	case "types.NodeBondProviders.node_operator_fee":
 		panic(fmt.Errorf("field node_operator_fee of message types.NodeBondProviders is not mutable"))
+	case "types.NodeBondProviders.pending_node_operator_fee":
+		panic(fmt.Errorf("field pending_node_operator_fee of message types.NodeBondProviders is not mutable"))
+	case "types.NodeBondProviders.pending_node_operator_fee_height":
+		panic(fmt.Errorf("field pending_node_operator_fee_height of message types.NodeBondProviders is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProviders"))
@@ -3617,6 +3655,10 @@ func (x *fastReflection_NodeBondProviders) NewField(fd protoreflect.FieldDescrip
 	case "types.NodeBondProviders.providers":
 		list := []*NodeBondProvider{}
 		return protoreflect.ValueOfList(&_NodeBondProviders_2_list{list: &list})
+	case "types.NodeBondProviders.pending_node_operator_fee":
+		return protoreflect.ValueOfString("")
+	case "types.NodeBondProviders.pending_node_operator_fee_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProviders"))
@@ -3696,6 +3738,13 @@ func (x *fastReflection_NodeBondProviders) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.PendingNodeOperatorFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingNodeOperatorFeeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingNodeOperatorFeeHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3725,6 +3774,18 @@ func (x *fastReflection_NodeBondProviders) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PendingNodeOperatorFeeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingNodeOperatorFeeHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PendingNodeOperatorFee) > 0 {
+			i -= len(x.PendingNodeOperatorFee)
+			copy(dAtA[i:], x.PendingNodeOperatorFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingNodeOperatorFee)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Providers) > 0 {
 			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Providers[iNdEx])
@@ -3863,6 +3924,57 @@ func (x *fastReflection_NodeBondProviders) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingNodeOperatorFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFeeHeight", wireType)
+				}
+				x.PendingNodeOperatorFeeHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingNodeOperatorFeeHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3899,9 +4011,11 @@ func (x *fastReflection_NodeBondProviders) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_NodeBondProvider              protoreflect.MessageDescriptor
-	fd_NodeBondProvider_bond_address protoreflect.FieldDescriptor
-	fd_NodeBondProvider_bond         protoreflect.FieldDescriptor
+	md_NodeBondProvider               protoreflect.MessageDescriptor
+	fd_NodeBondProvider_bond_address  protoreflect.FieldDescriptor
+	fd_NodeBondProvider_bond          protoreflect.FieldDescriptor
+	fd_NodeBondProvider_unbond_amount protoreflect.FieldDescriptor
+	fd_NodeBondProvider_unbond_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3909,6 +4023,8 @@ func init() {
 	md_NodeBondProvider = File_types_query_node_proto.Messages().ByName("NodeBondProvider")
 	fd_NodeBondProvider_bond_address = md_NodeBondProvider.Fields().ByName("bond_address")
 	fd_NodeBondProvider_bond = md_NodeBondProvider.Fields().ByName("bond")
+	fd_NodeBondProvider_unbond_amount = md_NodeBondProvider.Fields().ByName("unbond_amount")
+	fd_NodeBondProvider_unbond_height = md_NodeBondProvider.Fields().ByName("unbond_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_NodeBondProvider)(nil)
@@ -3988,6 +4104,18 @@ func (x *fastReflection_NodeBondProvider) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if x.UnbondAmount != "" {
+		value := protoreflect.ValueOfString(x.UnbondAmount)
+		if !f(fd_NodeBondProvider_unbond_amount, value) {
+			return
+		}
+	}
+	if x.UnbondHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondHeight)
+		if !f(fd_NodeBondProvider_unbond_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4007,6 +4135,10 @@ func (x *fastReflection_NodeBondProvider) Has(fd protoreflect.FieldDescriptor) b
 		return x.BondAddress != ""
 	case "types.NodeBondProvider.bond":
 		return x.Bond != ""
+	case "types.NodeBondProvider.unbond_amount":
+		return x.UnbondAmount != ""
+	case "types.NodeBondProvider.unbond_height":
+		return x.UnbondHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProvider"))
@@ -4027,6 +4159,10 @@ func (x *fastReflection_NodeBondProvider) Clear(fd protoreflect.FieldDescriptor)
 		x.BondAddress = ""
 	case "types.NodeBondProvider.bond":
 		x.Bond = ""
+	case "types.NodeBondProvider.unbond_amount":
+		x.UnbondAmount = ""
+	case "types.NodeBondProvider.unbond_height":
+		x.UnbondHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProvider"))
@@ -4049,6 +4185,12 @@ func (x *fastReflection_NodeBondProvider) Get(descriptor protoreflect.FieldDescr
 	case "types.NodeBondProvider.bond":
 		value := x.Bond
 		return protoreflect.ValueOfString(value)
+	case "types.NodeBondProvider.unbond_amount":
+		value := x.UnbondAmount
+		return protoreflect.ValueOfString(value)
+	case "types.NodeBondProvider.unbond_height":
+		value := x.UnbondHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProvider"))
@@ -4073,6 +4215,10 @@ func (x *fastReflection_NodeBondProvider) Set(fd protoreflect.FieldDescriptor, v
 		x.BondAddress = value.Interface().(string)
 	case "types.NodeBondProvider.bond":
 		x.Bond = value.Interface().(string)
+	case "types.NodeBondProvider.unbond_amount":
+		x.UnbondAmount = value.Interface().(string)
+	case "types.NodeBondProvider.unbond_height":
+		x.UnbondHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProvider"))
@@ -4097,6 +4243,10 @@ func (x *fastReflection_NodeBondProvider) Mutable(fd protoreflect.FieldDescripto
 		panic(fmt.Errorf("field bond_address of message types.NodeBondProvider is not mutable"))
 	case "types.NodeBondProvider.bond":
 		panic(fmt.Errorf("field bond of message types.NodeBondProvider is not mutable"))
+	case "types.NodeBondProvider.unbond_amount":
+		panic(fmt.Errorf("field unbond_amount of message types.NodeBondProvider is not mutable"))
+	case "types.NodeBondProvider.unbond_height":
+		panic(fmt.Errorf("field unbond_height of message types.NodeBondProvider is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProvider"))
@@ -4114,6 +4264,10 @@ func (x *fastReflection_NodeBondProvider) NewField(fd protoreflect.FieldDescript
 		return protoreflect.ValueOfString("")
 	case "types.NodeBondProvider.bond":
 		return protoreflect.ValueOfString("")
+	case "types.NodeBondProvider.unbond_amount":
+		return protoreflect.ValueOfString("")
+	case "types.NodeBondProvider.unbond_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.NodeBondProvider"))
@@ -4191,6 +4345,13 @@ func (x *fastReflection_NodeBondProvider) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.UnbondAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnbondHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4220,6 +4381,18 @@ func (x *fastReflection_NodeBondProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.UnbondHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.UnbondAmount) > 0 {
+			i -= len(x.UnbondAmount)
+			copy(dAtA[i:], x.UnbondAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnbondAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Bond) > 0 {
 			i -= len(x.Bond)
 			copy(dAtA[i:], x.Bond)
@@ -4347,6 +4520,57 @@ func (x *fastReflection_NodeBondProvider) ProtoMethods() *protoiface.Methods {
 				}
 				x.Bond = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondHeight", wireType)
+				}
+				x.UnbondHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6205,6 +6429,10 @@ type NodeBondProviders struct {
 
 	NodeOperatorFee string              `protobuf:"bytes,1,opt,name=node_operator_fee,json=nodeOperatorFee,proto3" json:"node_operator_fee,omitempty"`
 	Providers       []*NodeBondProvider `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers,omitempty"`
+	// a scheduled node operator fee increase, unset if there is none
+	PendingNodeOperatorFee string `protobuf:"bytes,3,opt,name=pending_node_operator_fee,json=pendingNodeOperatorFee,proto3" json:"pending_node_operator_fee,omitempty"`
+	// the height the scheduled node operator fee takes effect, unset if there is none
+	PendingNodeOperatorFeeHeight int64 `protobuf:"varint,4,opt,name=pending_node_operator_fee_height,json=pendingNodeOperatorFeeHeight,proto3" json:"pending_node_operator_fee_height,omitempty"`
 }
 
 func (x *NodeBondProviders) Reset() {
@@ -6241,6 +6469,20 @@ func (x *NodeBondProviders) GetProviders() []*NodeBondProvider {
 	return nil
 }
 
+func (x *NodeBondProviders) GetPendingNodeOperatorFee() string {
+	if x != nil {
+		return x.PendingNodeOperatorFee
+	}
+	return ""
+}
+
+func (x *NodeBondProviders) GetPendingNodeOperatorFeeHeight() int64 {
+	if x != nil {
+		return x.PendingNodeOperatorFeeHeight
+	}
+	return 0
+}
+
 type NodeBondProvider struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -6248,6 +6490,10 @@ type NodeBondProvider struct {
 
 	BondAddress string `protobuf:"bytes,1,opt,name=bond_address,json=bondAddress,proto3" json:"bond_address,omitempty"`
 	Bond        string `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond,omitempty"`
+	// the amount of a pending unbond request, 0 unbonds the full bond
+	UnbondAmount string `protobuf:"bytes,3,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount,omitempty"`
+	// the height a pending unbond request becomes executable, unset if there is none
+	UnbondHeight int64 `protobuf:"varint,4,opt,name=unbond_height,json=unbondHeight,proto3" json:"unbond_height,omitempty"`
 }
 
 func (x *NodeBondProvider) Reset() {
@@ -6284,6 +6530,20 @@ func (x *NodeBondProvider) GetBond() string {
 	return ""
 }
 
+func (x *NodeBondProvider) GetUnbondAmount() string {
+	if x != nil {
+		return x.UnbondAmount
+	}
+	return ""
+}
+
+func (x *NodeBondProvider) GetUnbondHeight() int64 {
+	if x != nil {
+		return x.UnbondHeight
+	}
+	return 0
+}
+
 type NodeJail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -6530,7 +6790,7 @@ var file_types_query_node_proto_rawDesc = []byte{
 	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x6e, 0x6f,
 	0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
 	0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a, 0x11, 0x4e,
+	0x6e, 0x73, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x11, 0x4e,
 	0x6f, 0x64, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
 	0x12, 0x41, 0x0a, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
 	0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xea, 0xde, 0x1f,
@@ -6540,38 +6800,51 @@ var file_types_query_node_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e,
 	0x6f, 0x64, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42,
 	0x0d, 0xea, 0xde, 0x1f, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x49, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
-	0x65, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a,
-	0x0c, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x62, 0x6f, 0x6e, 0x64, 0x22, 0x49, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4a, 0x61, 0x69, 0x6c,
-	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x52, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f,
-	0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea,
-	0xde, 0x1f, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12,
-	0x22, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x0a, 0xea, 0xde, 0x1f, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x7b, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x65, 0x66, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22,
-	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
-	0xea, 0xde, 0x1f, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x08, 0xea, 0xde, 0x1f, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x42, 0x7f, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
-	0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58,
-	0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xc8, 0xe2, 0x1e,
-	0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x46, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1c,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x93, 0x01, 0x0a,
+	0x10, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x49, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0a,
+	0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xea, 0xde, 0x1f,
+	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x22, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea,
+	0xde, 0x1f, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x22, 0x7b, 0x0a, 0x13, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xea, 0xde, 0x1f, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xea, 0xde,
+	0x1f, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08,
+	0xea, 0xde, 0x1f, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x7f,
+	0xc8, 0xe2, 0x1e, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42,
+	0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
+	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03,
+	0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,2281 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package types
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_QueryObservationStatsRequest         protoreflect.MessageDescriptor
+	fd_QueryObservationStatsRequest_address protoreflect.FieldDescriptor
+	fd_QueryObservationStatsRequest_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_observation_stats_proto_init()
+	md_QueryObservationStatsRequest = File_types_query_observation_stats_proto.Messages().ByName("QueryObservationStatsRequest")
+	fd_QueryObservationStatsRequest_address = md_QueryObservationStatsRequest.Fields().ByName("address")
+	fd_QueryObservationStatsRequest_height = md_QueryObservationStatsRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryObservationStatsRequest)(nil)
+
+type fastReflection_QueryObservationStatsRequest QueryObservationStatsRequest
+
+func (x *QueryObservationStatsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryObservationStatsRequest)(x)
+}
+
+func (x *QueryObservationStatsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_observation_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryObservationStatsRequest_messageType fastReflection_QueryObservationStatsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryObservationStatsRequest_messageType{}
+
+type fastReflection_QueryObservationStatsRequest_messageType struct{}
+
+func (x fastReflection_QueryObservationStatsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryObservationStatsRequest)(nil)
+}
+func (x fastReflection_QueryObservationStatsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryObservationStatsRequest)
+}
+func (x fastReflection_QueryObservationStatsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryObservationStatsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryObservationStatsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryObservationStatsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryObservationStatsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryObservationStatsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryObservationStatsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryObservationStatsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryObservationStatsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryObservationStatsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryObservationStatsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryObservationStatsRequest_address, value) {
+			return
+		}
+	}
+	if x.Height != "" {
+		value := protoreflect.ValueOfString(x.Height)
+		if !f(fd_QueryObservationStatsRequest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryObservationStatsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsRequest.address":
+		return x.Address != ""
+	case "types.QueryObservationStatsRequest.height":
+		return x.Height != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsRequest.address":
+		x.Address = ""
+	case "types.QueryObservationStatsRequest.height":
+		x.Height = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryObservationStatsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryObservationStatsRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "types.QueryObservationStatsRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsRequest.address":
+		x.Address = value.Interface().(string)
+	case "types.QueryObservationStatsRequest.height":
+		x.Height = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsRequest.address":
+		panic(fmt.Errorf("field address of message types.QueryObservationStatsRequest is not mutable"))
+	case "types.QueryObservationStatsRequest.height":
+		panic(fmt.Errorf("field height of message types.QueryObservationStatsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryObservationStatsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsRequest.address":
+		return protoreflect.ValueOfString("")
+	case "types.QueryObservationStatsRequest.height":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryObservationStatsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryObservationStatsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryObservationStatsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryObservationStatsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryObservationStatsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryObservationStatsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Height)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryObservationStatsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Height) > 0 {
+			i -= len(x.Height)
+			copy(dAtA[i:], x.Height)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryObservationStatsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryObservationStatsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryObservationStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Height = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryObservationStatsResponse_2_list)(nil)
+
+type _QueryObservationStatsResponse_2_list struct {
+	list *[]*QueryChainObservationStats
+}
+
+func (x *_QueryObservationStatsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryObservationStatsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryObservationStatsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryChainObservationStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryObservationStatsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryChainObservationStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryObservationStatsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(QueryChainObservationStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryObservationStatsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryObservationStatsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(QueryChainObservationStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryObservationStatsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryObservationStatsResponse              protoreflect.MessageDescriptor
+	fd_QueryObservationStatsResponse_node_address protoreflect.FieldDescriptor
+	fd_QueryObservationStatsResponse_chains       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_observation_stats_proto_init()
+	md_QueryObservationStatsResponse = File_types_query_observation_stats_proto.Messages().ByName("QueryObservationStatsResponse")
+	fd_QueryObservationStatsResponse_node_address = md_QueryObservationStatsResponse.Fields().ByName("node_address")
+	fd_QueryObservationStatsResponse_chains = md_QueryObservationStatsResponse.Fields().ByName("chains")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryObservationStatsResponse)(nil)
+
+type fastReflection_QueryObservationStatsResponse QueryObservationStatsResponse
+
+func (x *QueryObservationStatsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryObservationStatsResponse)(x)
+}
+
+func (x *QueryObservationStatsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_observation_stats_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryObservationStatsResponse_messageType fastReflection_QueryObservationStatsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryObservationStatsResponse_messageType{}
+
+type fastReflection_QueryObservationStatsResponse_messageType struct{}
+
+func (x fastReflection_QueryObservationStatsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryObservationStatsResponse)(nil)
+}
+func (x fastReflection_QueryObservationStatsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryObservationStatsResponse)
+}
+func (x fastReflection_QueryObservationStatsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryObservationStatsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryObservationStatsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryObservationStatsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryObservationStatsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryObservationStatsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryObservationStatsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryObservationStatsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryObservationStatsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryObservationStatsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryObservationStatsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NodeAddress != "" {
+		value := protoreflect.ValueOfString(x.NodeAddress)
+		if !f(fd_QueryObservationStatsResponse_node_address, value) {
+			return
+		}
+	}
+	if len(x.Chains) != 0 {
+		value := protoreflect.ValueOfList(&_QueryObservationStatsResponse_2_list{list: &x.Chains})
+		if !f(fd_QueryObservationStatsResponse_chains, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryObservationStatsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsResponse.node_address":
+		return x.NodeAddress != ""
+	case "types.QueryObservationStatsResponse.chains":
+		return len(x.Chains) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsResponse.node_address":
+		x.NodeAddress = ""
+	case "types.QueryObservationStatsResponse.chains":
+		x.Chains = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryObservationStatsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryObservationStatsResponse.node_address":
+		value := x.NodeAddress
+		return protoreflect.ValueOfString(value)
+	case "types.QueryObservationStatsResponse.chains":
+		if len(x.Chains) == 0 {
+			return protoreflect.ValueOfList(&_QueryObservationStatsResponse_2_list{})
+		}
+		listValue := &_QueryObservationStatsResponse_2_list{list: &x.Chains}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsResponse.node_address":
+		x.NodeAddress = value.Interface().(string)
+	case "types.QueryObservationStatsResponse.chains":
+		lv := value.List()
+		clv := lv.(*_QueryObservationStatsResponse_2_list)
+		x.Chains = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsResponse.chains":
+		if x.Chains == nil {
+			x.Chains = []*QueryChainObservationStats{}
+		}
+		value := &_QueryObservationStatsResponse_2_list{list: &x.Chains}
+		return protoreflect.ValueOfList(value)
+	case "types.QueryObservationStatsResponse.node_address":
+		panic(fmt.Errorf("field node_address of message types.QueryObservationStatsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryObservationStatsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryObservationStatsResponse.node_address":
+		return protoreflect.ValueOfString("")
+	case "types.QueryObservationStatsResponse.chains":
+		list := []*QueryChainObservationStats{}
+		return protoreflect.ValueOfList(&_QueryObservationStatsResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryObservationStatsResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryObservationStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryObservationStatsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryObservationStatsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryObservationStatsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryObservationStatsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryObservationStatsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryObservationStatsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryObservationStatsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.NodeAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Chains) > 0 {
+			for _, e := range x.Chains {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryObservationStatsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Chains) > 0 {
+			for iNdEx := len(x.Chains) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Chains[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.NodeAddress) > 0 {
+			i -= len(x.NodeAddress)
+			copy(dAtA[i:], x.NodeAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NodeAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryObservationStatsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryObservationStatsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryObservationStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NodeAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chains = append(x.Chains, &QueryChainObservationStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Chains[len(x.Chains)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryChainObservationStats                     protoreflect.MessageDescriptor
+	fd_QueryChainObservationStats_chain               protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_observations        protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_median_lag          protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_max_lag             protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_missed              protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_late                protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_network_fee_votes   protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_network_fee_missed  protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_network_fee_late    protoreflect.FieldDescriptor
+	fd_QueryChainObservationStats_last_updated_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_observation_stats_proto_init()
+	md_QueryChainObservationStats = File_types_query_observation_stats_proto.Messages().ByName("QueryChainObservationStats")
+	fd_QueryChainObservationStats_chain = md_QueryChainObservationStats.Fields().ByName("chain")
+	fd_QueryChainObservationStats_observations = md_QueryChainObservationStats.Fields().ByName("observations")
+	fd_QueryChainObservationStats_median_lag = md_QueryChainObservationStats.Fields().ByName("median_lag")
+	fd_QueryChainObservationStats_max_lag = md_QueryChainObservationStats.Fields().ByName("max_lag")
+	fd_QueryChainObservationStats_missed = md_QueryChainObservationStats.Fields().ByName("missed")
+	fd_QueryChainObservationStats_late = md_QueryChainObservationStats.Fields().ByName("late")
+	fd_QueryChainObservationStats_network_fee_votes = md_QueryChainObservationStats.Fields().ByName("network_fee_votes")
+	fd_QueryChainObservationStats_network_fee_missed = md_QueryChainObservationStats.Fields().ByName("network_fee_missed")
+	fd_QueryChainObservationStats_network_fee_late = md_QueryChainObservationStats.Fields().ByName("network_fee_late")
+	fd_QueryChainObservationStats_last_updated_height = md_QueryChainObservationStats.Fields().ByName("last_updated_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryChainObservationStats)(nil)
+
+type fastReflection_QueryChainObservationStats QueryChainObservationStats
+
+func (x *QueryChainObservationStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryChainObservationStats)(x)
+}
+
+func (x *QueryChainObservationStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_observation_stats_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryChainObservationStats_messageType fastReflection_QueryChainObservationStats_messageType
+var _ protoreflect.MessageType = fastReflection_QueryChainObservationStats_messageType{}
+
+type fastReflection_QueryChainObservationStats_messageType struct{}
+
+func (x fastReflection_QueryChainObservationStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryChainObservationStats)(nil)
+}
+func (x fastReflection_QueryChainObservationStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryChainObservationStats)
+}
+func (x fastReflection_QueryChainObservationStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryChainObservationStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryChainObservationStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryChainObservationStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryChainObservationStats) Type() protoreflect.MessageType {
+	return _fastReflection_QueryChainObservationStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryChainObservationStats) New() protoreflect.Message {
+	return new(fastReflection_QueryChainObservationStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryChainObservationStats) Interface() protoreflect.ProtoMessage {
+	return (*QueryChainObservationStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryChainObservationStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Chain != "" {
+		value := protoreflect.ValueOfString(x.Chain)
+		if !f(fd_QueryChainObservationStats_chain, value) {
+			return
+		}
+	}
+	if x.Observations != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Observations)
+		if !f(fd_QueryChainObservationStats_observations, value) {
+			return
+		}
+	}
+	if x.MedianLag != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MedianLag)
+		if !f(fd_QueryChainObservationStats_median_lag, value) {
+			return
+		}
+	}
+	if x.MaxLag != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxLag)
+		if !f(fd_QueryChainObservationStats_max_lag, value) {
+			return
+		}
+	}
+	if x.Missed != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Missed)
+		if !f(fd_QueryChainObservationStats_missed, value) {
+			return
+		}
+	}
+	if x.Late != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Late)
+		if !f(fd_QueryChainObservationStats_late, value) {
+			return
+		}
+	}
+	if x.NetworkFeeVotes != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NetworkFeeVotes)
+		if !f(fd_QueryChainObservationStats_network_fee_votes, value) {
+			return
+		}
+	}
+	if x.NetworkFeeMissed != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NetworkFeeMissed)
+		if !f(fd_QueryChainObservationStats_network_fee_missed, value) {
+			return
+		}
+	}
+	if x.NetworkFeeLate != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NetworkFeeLate)
+		if !f(fd_QueryChainObservationStats_network_fee_late, value) {
+			return
+		}
+	}
+	if x.LastUpdatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdatedHeight)
+		if !f(fd_QueryChainObservationStats_last_updated_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryChainObservationStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryChainObservationStats.chain":
+		return x.Chain != ""
+	case "types.QueryChainObservationStats.observations":
+		return x.Observations != int64(0)
+	case "types.QueryChainObservationStats.median_lag":
+		return x.MedianLag != int64(0)
+	case "types.QueryChainObservationStats.max_lag":
+		return x.MaxLag != int64(0)
+	case "types.QueryChainObservationStats.missed":
+		return x.Missed != int64(0)
+	case "types.QueryChainObservationStats.late":
+		return x.Late != int64(0)
+	case "types.QueryChainObservationStats.network_fee_votes":
+		return x.NetworkFeeVotes != int64(0)
+	case "types.QueryChainObservationStats.network_fee_missed":
+		return x.NetworkFeeMissed != int64(0)
+	case "types.QueryChainObservationStats.network_fee_late":
+		return x.NetworkFeeLate != int64(0)
+	case "types.QueryChainObservationStats.last_updated_height":
+		return x.LastUpdatedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryChainObservationStats"))
+		}
+		panic(fmt.Errorf("message types.QueryChainObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChainObservationStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryChainObservationStats.chain":
+		x.Chain = ""
+	case "types.QueryChainObservationStats.observations":
+		x.Observations = int64(0)
+	case "types.QueryChainObservationStats.median_lag":
+		x.MedianLag = int64(0)
+	case "types.QueryChainObservationStats.max_lag":
+		x.MaxLag = int64(0)
+	case "types.QueryChainObservationStats.missed":
+		x.Missed = int64(0)
+	case "types.QueryChainObservationStats.late":
+		x.Late = int64(0)
+	case "types.QueryChainObservationStats.network_fee_votes":
+		x.NetworkFeeVotes = int64(0)
+	case "types.QueryChainObservationStats.network_fee_missed":
+		x.NetworkFeeMissed = int64(0)
+	case "types.QueryChainObservationStats.network_fee_late":
+		x.NetworkFeeLate = int64(0)
+	case "types.QueryChainObservationStats.last_updated_height":
+		x.LastUpdatedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryChainObservationStats"))
+		}
+		panic(fmt.Errorf("message types.QueryChainObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryChainObservationStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryChainObservationStats.chain":
+		value := x.Chain
+		return protoreflect.ValueOfString(value)
+	case "types.QueryChainObservationStats.observations":
+		value := x.Observations
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.median_lag":
+		value := x.MedianLag
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.max_lag":
+		value := x.MaxLag
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.missed":
+		value := x.Missed
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.late":
+		value := x.Late
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.network_fee_votes":
+		value := x.NetworkFeeVotes
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.network_fee_missed":
+		value := x.NetworkFeeMissed
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.network_fee_late":
+		value := x.NetworkFeeLate
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryChainObservationStats.last_updated_height":
+		value := x.LastUpdatedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryChainObservationStats"))
+		}
+		panic(fmt.Errorf("message types.QueryChainObservationStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChainObservationStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryChainObservationStats.chain":
+		x.Chain = value.Interface().(string)
+	case "types.QueryChainObservationStats.observations":
+		x.Observations = value.Int()
+	case "types.QueryChainObservationStats.median_lag":
+		x.MedianLag = value.Int()
+	case "types.QueryChainObservationStats.max_lag":
+		x.MaxLag = value.Int()
+	case "types.QueryChainObservationStats.missed":
+		x.Missed = value.Int()
+	case "types.QueryChainObservationStats.late":
+		x.Late = value.Int()
+	case "types.QueryChainObservationStats.network_fee_votes":
+		x.NetworkFeeVotes = value.Int()
+	case "types.QueryChainObservationStats.network_fee_missed":
+		x.NetworkFeeMissed = value.Int()
+	case "types.QueryChainObservationStats.network_fee_late":
+		x.NetworkFeeLate = value.Int()
+	case "types.QueryChainObservationStats.last_updated_height":
+		x.LastUpdatedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryChainObservationStats"))
+		}
+		panic(fmt.Errorf("message types.QueryChainObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChainObservationStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryChainObservationStats.chain":
+		panic(fmt.Errorf("field chain of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.observations":
+		panic(fmt.Errorf("field observations of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.median_lag":
+		panic(fmt.Errorf("field median_lag of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.max_lag":
+		panic(fmt.Errorf("field max_lag of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.missed":
+		panic(fmt.Errorf("field missed of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.late":
+		panic(fmt.Errorf("field late of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.network_fee_votes":
+		panic(fmt.Errorf("field network_fee_votes of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.network_fee_missed":
+		panic(fmt.Errorf("field network_fee_missed of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.network_fee_late":
+		panic(fmt.Errorf("field network_fee_late of message types.QueryChainObservationStats is not mutable"))
+	case "types.QueryChainObservationStats.last_updated_height":
+		panic(fmt.Errorf("field last_updated_height of message types.QueryChainObservationStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryChainObservationStats"))
+		}
+		panic(fmt.Errorf("message types.QueryChainObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryChainObservationStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryChainObservationStats.chain":
+		return protoreflect.ValueOfString("")
+	case "types.QueryChainObservationStats.observations":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.median_lag":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.max_lag":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.missed":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.late":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.network_fee_votes":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.network_fee_missed":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.network_fee_late":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryChainObservationStats.last_updated_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryChainObservationStats"))
+		}
+		panic(fmt.Errorf("message types.QueryChainObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryChainObservationStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryChainObservationStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryChainObservationStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryChainObservationStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryChainObservationStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryChainObservationStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryChainObservationStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Chain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Observations != 0 {
+			n += 1 + runtime.Sov(uint64(x.Observations))
+		}
+		if x.MedianLag != 0 {
+			n += 1 + runtime.Sov(uint64(x.MedianLag))
+		}
+		if x.MaxLag != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLag))
+		}
+		if x.Missed != 0 {
+			n += 1 + runtime.Sov(uint64(x.Missed))
+		}
+		if x.Late != 0 {
+			n += 1 + runtime.Sov(uint64(x.Late))
+		}
+		if x.NetworkFeeVotes != 0 {
+			n += 1 + runtime.Sov(uint64(x.NetworkFeeVotes))
+		}
+		if x.NetworkFeeMissed != 0 {
+			n += 1 + runtime.Sov(uint64(x.NetworkFeeMissed))
+		}
+		if x.NetworkFeeLate != 0 {
+			n += 1 + runtime.Sov(uint64(x.NetworkFeeLate))
+		}
+		if x.LastUpdatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdatedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryChainObservationStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastUpdatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdatedHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.NetworkFeeLate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NetworkFeeLate))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.NetworkFeeMissed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NetworkFeeMissed))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.NetworkFeeVotes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NetworkFeeVotes))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Late != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Late))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Missed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Missed))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxLag != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLag))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MedianLag != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MedianLag))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Observations != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Observations))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Chain) > 0 {
+			i -= len(x.Chain)
+			copy(dAtA[i:], x.Chain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chain)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryChainObservationStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryChainObservationStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryChainObservationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+				}
+				x.Observations = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Observations |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MedianLag", wireType)
+				}
+				x.MedianLag = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MedianLag |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLag", wireType)
+				}
+				x.MaxLag = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLag |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+				}
+				x.Missed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Missed |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Late", wireType)
+				}
+				x.Late = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Late |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeVotes", wireType)
+				}
+				x.NetworkFeeVotes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NetworkFeeVotes |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeMissed", wireType)
+				}
+				x.NetworkFeeMissed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NetworkFeeMissed |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeLate", wireType)
+				}
+				x.NetworkFeeLate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NetworkFeeLate |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+				}
+				x.LastUpdatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: types/query_observation_stats.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QueryObservationStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryObservationStatsRequest) Reset() {
+	*x = QueryObservationStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_observation_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryObservationStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryObservationStatsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryObservationStatsRequest.ProtoReflect.Descriptor instead.
+func (*QueryObservationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_types_query_observation_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryObservationStatsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryObservationStatsRequest) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+type QueryObservationStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAddress string                        `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	Chains      []*QueryChainObservationStats `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (x *QueryObservationStatsResponse) Reset() {
+	*x = QueryObservationStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_observation_stats_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryObservationStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryObservationStatsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryObservationStatsResponse.ProtoReflect.Descriptor instead.
+func (*QueryObservationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_types_query_observation_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryObservationStatsResponse) GetNodeAddress() string {
+	if x != nil {
+		return x.NodeAddress
+	}
+	return ""
+}
+
+func (x *QueryObservationStatsResponse) GetChains() []*QueryChainObservationStats {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+type QueryChainObservationStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	// number of recent observations tracked
+	Observations int64 `protobuf:"varint,2,opt,name=observations,proto3" json:"observations,omitempty"`
+	// median number of blocks the node observed behind quorum
+	MedianLag int64 `protobuf:"varint,3,opt,name=median_lag,json=medianLag,proto3" json:"median_lag,omitempty"`
+	// maximum number of blocks the node observed behind quorum
+	MaxLag int64 `protobuf:"varint,4,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+	// number of observations reaching quorum the node did not observe in time
+	Missed int64 `protobuf:"varint,5,opt,name=missed,proto3" json:"missed,omitempty"`
+	// number of observations the node made after quorum was reached
+	Late int64 `protobuf:"varint,6,opt,name=late,proto3" json:"late,omitempty"`
+	// number of recent network fee votes tracked
+	NetworkFeeVotes int64 `protobuf:"varint,7,opt,name=network_fee_votes,json=networkFeeVotes,proto3" json:"network_fee_votes,omitempty"`
+	// number of network fees reaching quorum the node did not vote for in time
+	NetworkFeeMissed int64 `protobuf:"varint,8,opt,name=network_fee_missed,json=networkFeeMissed,proto3" json:"network_fee_missed,omitempty"`
+	// number of network fee votes the node made after quorum was reached
+	NetworkFeeLate int64 `protobuf:"varint,9,opt,name=network_fee_late,json=networkFeeLate,proto3" json:"network_fee_late,omitempty"`
+	// the block height the stats were last updated
+	LastUpdatedHeight int64 `protobuf:"varint,10,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height,omitempty"`
+}
+
+func (x *QueryChainObservationStats) Reset() {
+	*x = QueryChainObservationStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_observation_stats_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryChainObservationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryChainObservationStats) ProtoMessage() {}
+
+// Deprecated: Use QueryChainObservationStats.ProtoReflect.Descriptor instead.
+func (*QueryChainObservationStats) Descriptor() ([]byte, []int) {
+	return file_types_query_observation_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryChainObservationStats) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *QueryChainObservationStats) GetObservations() int64 {
+	if x != nil {
+		return x.Observations
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetMedianLag() int64 {
+	if x != nil {
+		return x.MedianLag
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetMaxLag() int64 {
+	if x != nil {
+		return x.MaxLag
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetMissed() int64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetLate() int64 {
+	if x != nil {
+		return x.Late
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetNetworkFeeVotes() int64 {
+	if x != nil {
+		return x.NetworkFeeVotes
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetNetworkFeeMissed() int64 {
+	if x != nil {
+		return x.NetworkFeeMissed
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetNetworkFeeLate() int64 {
+	if x != nil {
+		return x.NetworkFeeLate
+	}
+	return 0
+}
+
+func (x *QueryChainObservationStats) GetLastUpdatedHeight() int64 {
+	if x != nil {
+		return x.LastUpdatedHeight
+	}
+	return 0
+}
+
+var File_types_query_observation_stats_proto protoreflect.FileDescriptor
+
+var file_types_query_observation_stats_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x50, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xea, 0xde,
+	0x1f, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b,
+	0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x0a,
+	0xea, 0xde, 0x1f, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x22, 0x9c, 0x04, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xea, 0xde, 0x1f, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x34, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xea, 0xde,
+	0x1f, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x6c, 0x61, 0x67, 0x52, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x4c, 0x61, 0x67, 0x12, 0x24, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x61, 0x67, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x12, 0x22, 0x0a,
+	0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xea,
+	0xde, 0x1f, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x08, 0xea, 0xde, 0x1f, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x41, 0x0a, 0x11, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x76,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xea, 0xde, 0x1f, 0x11,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x16,
+	0xea, 0xde, 0x1f, 0x12, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46,
+	0x65, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x14, 0xea, 0xde, 0x1f, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x46, 0x65, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x8b, 0x01, 0xc8, 0xe2, 0x1e, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x42, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
+	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03,
+	0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_query_observation_stats_proto_rawDescOnce sync.Once
+	file_types_query_observation_stats_proto_rawDescData = file_types_query_observation_stats_proto_rawDesc
+)
+
+func file_types_query_observation_stats_proto_rawDescGZIP() []byte {
+	file_types_query_observation_stats_proto_rawDescOnce.Do(func() {
+		file_types_query_observation_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_query_observation_stats_proto_rawDescData)
+	})
+	return file_types_query_observation_stats_proto_rawDescData
+}
+
+var file_types_query_observation_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_types_query_observation_stats_proto_goTypes = []interface{}{
+	(*QueryObservationStatsRequest)(nil),  // 0: types.QueryObservationStatsRequest
+	(*QueryObservationStatsResponse)(nil), // 1: types.QueryObservationStatsResponse
+	(*QueryChainObservationStats)(nil),    // 2: types.QueryChainObservationStats
+}
+var file_types_query_observation_stats_proto_depIdxs = []int32{
+	2, // 0: types.QueryObservationStatsResponse.chains:type_name -> types.QueryChainObservationStats
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_types_query_observation_stats_proto_init() }
+func file_types_query_observation_stats_proto_init() {
+	if File_types_query_observation_stats_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_query_observation_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryObservationStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_observation_stats_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryObservationStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_observation_stats_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryChainObservationStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_query_observation_stats_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_query_observation_stats_proto_goTypes,
+		DependencyIndexes: file_types_query_observation_stats_proto_depIdxs,
+		MessageInfos:      file_types_query_observation_stats_proto_msgTypes,
+	}.Build()
+	File_types_query_observation_stats_proto = out.File
+	file_types_query_observation_stats_proto_rawDesc = nil
+	file_types_query_observation_stats_proto_goTypes = nil
+	file_types_query_observation_stats_proto_depIdxs = nil
+}
@@ -0,0 +1,2437 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package types
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_QueryPoolHistoryRequest          protoreflect.MessageDescriptor
+	fd_QueryPoolHistoryRequest_asset    protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryRequest_interval protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryRequest_height   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_pool_history_proto_init()
+	md_QueryPoolHistoryRequest = File_types_query_pool_history_proto.Messages().ByName("QueryPoolHistoryRequest")
+	fd_QueryPoolHistoryRequest_asset = md_QueryPoolHistoryRequest.Fields().ByName("asset")
+	fd_QueryPoolHistoryRequest_interval = md_QueryPoolHistoryRequest.Fields().ByName("interval")
+	fd_QueryPoolHistoryRequest_height = md_QueryPoolHistoryRequest.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolHistoryRequest)(nil)
+
+type fastReflection_QueryPoolHistoryRequest QueryPoolHistoryRequest
+
+func (x *QueryPoolHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolHistoryRequest)(x)
+}
+
+func (x *QueryPoolHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_pool_history_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolHistoryRequest_messageType fastReflection_QueryPoolHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolHistoryRequest_messageType{}
+
+type fastReflection_QueryPoolHistoryRequest_messageType struct{}
+
+func (x fastReflection_QueryPoolHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolHistoryRequest)(nil)
+}
+func (x fastReflection_QueryPoolHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolHistoryRequest)
+}
+func (x fastReflection_QueryPoolHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolHistoryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolHistoryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolHistoryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolHistoryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolHistoryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != "" {
+		value := protoreflect.ValueOfString(x.Asset)
+		if !f(fd_QueryPoolHistoryRequest_asset, value) {
+			return
+		}
+	}
+	if x.Interval != "" {
+		value := protoreflect.ValueOfString(x.Interval)
+		if !f(fd_QueryPoolHistoryRequest_interval, value) {
+			return
+		}
+	}
+	if x.Height != "" {
+		value := protoreflect.ValueOfString(x.Height)
+		if !f(fd_QueryPoolHistoryRequest_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryRequest.asset":
+		return x.Asset != ""
+	case "types.QueryPoolHistoryRequest.interval":
+		return x.Interval != ""
+	case "types.QueryPoolHistoryRequest.height":
+		return x.Height != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryRequest.asset":
+		x.Asset = ""
+	case "types.QueryPoolHistoryRequest.interval":
+		x.Interval = ""
+	case "types.QueryPoolHistoryRequest.height":
+		x.Height = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryPoolHistoryRequest.asset":
+		value := x.Asset
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryRequest.interval":
+		value := x.Interval
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryRequest.asset":
+		x.Asset = value.Interface().(string)
+	case "types.QueryPoolHistoryRequest.interval":
+		x.Interval = value.Interface().(string)
+	case "types.QueryPoolHistoryRequest.height":
+		x.Height = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryRequest.asset":
+		panic(fmt.Errorf("field asset of message types.QueryPoolHistoryRequest is not mutable"))
+	case "types.QueryPoolHistoryRequest.interval":
+		panic(fmt.Errorf("field interval of message types.QueryPoolHistoryRequest is not mutable"))
+	case "types.QueryPoolHistoryRequest.height":
+		panic(fmt.Errorf("field height of message types.QueryPoolHistoryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryRequest.asset":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryRequest.interval":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryRequest.height":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryRequest"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryPoolHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Asset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Interval)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Height)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Height) > 0 {
+			i -= len(x.Height)
+			copy(dAtA[i:], x.Height)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Height)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Interval) > 0 {
+			i -= len(x.Interval)
+			copy(dAtA[i:], x.Interval)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Interval)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Asset) > 0 {
+			i -= len(x.Asset)
+			copy(dAtA[i:], x.Asset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Asset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Interval = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Height = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPoolHistoryResponse_5_list)(nil)
+
+type _QueryPoolHistoryResponse_5_list struct {
+	list *[]*QueryPoolHistoryInterval
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryPoolHistoryInterval)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueryPoolHistoryInterval)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) AppendMutable() protoreflect.Value {
+	v := new(QueryPoolHistoryInterval)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) NewElement() protoreflect.Value {
+	v := new(QueryPoolHistoryInterval)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPoolHistoryResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPoolHistoryResponse                   protoreflect.MessageDescriptor
+	fd_QueryPoolHistoryResponse_asset             protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryResponse_interval          protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryResponse_liquidity_apy_bps protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryResponse_savers_apy_bps    protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryResponse_intervals         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_pool_history_proto_init()
+	md_QueryPoolHistoryResponse = File_types_query_pool_history_proto.Messages().ByName("QueryPoolHistoryResponse")
+	fd_QueryPoolHistoryResponse_asset = md_QueryPoolHistoryResponse.Fields().ByName("asset")
+	fd_QueryPoolHistoryResponse_interval = md_QueryPoolHistoryResponse.Fields().ByName("interval")
+	fd_QueryPoolHistoryResponse_liquidity_apy_bps = md_QueryPoolHistoryResponse.Fields().ByName("liquidity_apy_bps")
+	fd_QueryPoolHistoryResponse_savers_apy_bps = md_QueryPoolHistoryResponse.Fields().ByName("savers_apy_bps")
+	fd_QueryPoolHistoryResponse_intervals = md_QueryPoolHistoryResponse.Fields().ByName("intervals")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolHistoryResponse)(nil)
+
+type fastReflection_QueryPoolHistoryResponse QueryPoolHistoryResponse
+
+func (x *QueryPoolHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolHistoryResponse)(x)
+}
+
+func (x *QueryPoolHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_pool_history_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolHistoryResponse_messageType fastReflection_QueryPoolHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolHistoryResponse_messageType{}
+
+type fastReflection_QueryPoolHistoryResponse_messageType struct{}
+
+func (x fastReflection_QueryPoolHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolHistoryResponse)(nil)
+}
+func (x fastReflection_QueryPoolHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolHistoryResponse)
+}
+func (x fastReflection_QueryPoolHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Asset != "" {
+		value := protoreflect.ValueOfString(x.Asset)
+		if !f(fd_QueryPoolHistoryResponse_asset, value) {
+			return
+		}
+	}
+	if x.Interval != "" {
+		value := protoreflect.ValueOfString(x.Interval)
+		if !f(fd_QueryPoolHistoryResponse_interval, value) {
+			return
+		}
+	}
+	if x.LiquidityApyBps != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LiquidityApyBps)
+		if !f(fd_QueryPoolHistoryResponse_liquidity_apy_bps, value) {
+			return
+		}
+	}
+	if x.SaversApyBps != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SaversApyBps)
+		if !f(fd_QueryPoolHistoryResponse_savers_apy_bps, value) {
+			return
+		}
+	}
+	if len(x.Intervals) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPoolHistoryResponse_5_list{list: &x.Intervals})
+		if !f(fd_QueryPoolHistoryResponse_intervals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryResponse.asset":
+		return x.Asset != ""
+	case "types.QueryPoolHistoryResponse.interval":
+		return x.Interval != ""
+	case "types.QueryPoolHistoryResponse.liquidity_apy_bps":
+		return x.LiquidityApyBps != int64(0)
+	case "types.QueryPoolHistoryResponse.savers_apy_bps":
+		return x.SaversApyBps != int64(0)
+	case "types.QueryPoolHistoryResponse.intervals":
+		return len(x.Intervals) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryResponse.asset":
+		x.Asset = ""
+	case "types.QueryPoolHistoryResponse.interval":
+		x.Interval = ""
+	case "types.QueryPoolHistoryResponse.liquidity_apy_bps":
+		x.LiquidityApyBps = int64(0)
+	case "types.QueryPoolHistoryResponse.savers_apy_bps":
+		x.SaversApyBps = int64(0)
+	case "types.QueryPoolHistoryResponse.intervals":
+		x.Intervals = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryPoolHistoryResponse.asset":
+		value := x.Asset
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryResponse.interval":
+		value := x.Interval
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryResponse.liquidity_apy_bps":
+		value := x.LiquidityApyBps
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryPoolHistoryResponse.savers_apy_bps":
+		value := x.SaversApyBps
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryPoolHistoryResponse.intervals":
+		if len(x.Intervals) == 0 {
+			return protoreflect.ValueOfList(&_QueryPoolHistoryResponse_5_list{})
+		}
+		listValue := &_QueryPoolHistoryResponse_5_list{list: &x.Intervals}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryResponse.asset":
+		x.Asset = value.Interface().(string)
+	case "types.QueryPoolHistoryResponse.interval":
+		x.Interval = value.Interface().(string)
+	case "types.QueryPoolHistoryResponse.liquidity_apy_bps":
+		x.LiquidityApyBps = value.Int()
+	case "types.QueryPoolHistoryResponse.savers_apy_bps":
+		x.SaversApyBps = value.Int()
+	case "types.QueryPoolHistoryResponse.intervals":
+		lv := value.List()
+		clv := lv.(*_QueryPoolHistoryResponse_5_list)
+		x.Intervals = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryResponse.intervals":
+		if x.Intervals == nil {
+			x.Intervals = []*QueryPoolHistoryInterval{}
+		}
+		value := &_QueryPoolHistoryResponse_5_list{list: &x.Intervals}
+		return protoreflect.ValueOfList(value)
+	case "types.QueryPoolHistoryResponse.asset":
+		panic(fmt.Errorf("field asset of message types.QueryPoolHistoryResponse is not mutable"))
+	case "types.QueryPoolHistoryResponse.interval":
+		panic(fmt.Errorf("field interval of message types.QueryPoolHistoryResponse is not mutable"))
+	case "types.QueryPoolHistoryResponse.liquidity_apy_bps":
+		panic(fmt.Errorf("field liquidity_apy_bps of message types.QueryPoolHistoryResponse is not mutable"))
+	case "types.QueryPoolHistoryResponse.savers_apy_bps":
+		panic(fmt.Errorf("field savers_apy_bps of message types.QueryPoolHistoryResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryResponse.asset":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryResponse.interval":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryResponse.liquidity_apy_bps":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryPoolHistoryResponse.savers_apy_bps":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryPoolHistoryResponse.intervals":
+		list := []*QueryPoolHistoryInterval{}
+		return protoreflect.ValueOfList(&_QueryPoolHistoryResponse_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryResponse"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryPoolHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Asset)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Interval)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LiquidityApyBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.LiquidityApyBps))
+		}
+		if x.SaversApyBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.SaversApyBps))
+		}
+		if len(x.Intervals) > 0 {
+			for _, e := range x.Intervals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Intervals) > 0 {
+			for iNdEx := len(x.Intervals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Intervals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.SaversApyBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SaversApyBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LiquidityApyBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LiquidityApyBps))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Interval) > 0 {
+			i -= len(x.Interval)
+			copy(dAtA[i:], x.Interval)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Interval)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Asset) > 0 {
+			i -= len(x.Asset)
+			copy(dAtA[i:], x.Asset)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Asset)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Asset = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Interval = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidityApyBps", wireType)
+				}
+				x.LiquidityApyBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LiquidityApyBps |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SaversApyBps", wireType)
+				}
+				x.SaversApyBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SaversApyBps |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Intervals = append(x.Intervals, &QueryPoolHistoryInterval{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Intervals[len(x.Intervals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPoolHistoryInterval                    protoreflect.MessageDescriptor
+	fd_QueryPoolHistoryInterval_start_height       protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryInterval_end_height         protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryInterval_liquidity_fees     protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryInterval_rewards            protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryInterval_synth_yield        protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryInterval_pol_rune_deposited protoreflect.FieldDescriptor
+	fd_QueryPoolHistoryInterval_pol_rune_withdrawn protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_query_pool_history_proto_init()
+	md_QueryPoolHistoryInterval = File_types_query_pool_history_proto.Messages().ByName("QueryPoolHistoryInterval")
+	fd_QueryPoolHistoryInterval_start_height = md_QueryPoolHistoryInterval.Fields().ByName("start_height")
+	fd_QueryPoolHistoryInterval_end_height = md_QueryPoolHistoryInterval.Fields().ByName("end_height")
+	fd_QueryPoolHistoryInterval_liquidity_fees = md_QueryPoolHistoryInterval.Fields().ByName("liquidity_fees")
+	fd_QueryPoolHistoryInterval_rewards = md_QueryPoolHistoryInterval.Fields().ByName("rewards")
+	fd_QueryPoolHistoryInterval_synth_yield = md_QueryPoolHistoryInterval.Fields().ByName("synth_yield")
+	fd_QueryPoolHistoryInterval_pol_rune_deposited = md_QueryPoolHistoryInterval.Fields().ByName("pol_rune_deposited")
+	fd_QueryPoolHistoryInterval_pol_rune_withdrawn = md_QueryPoolHistoryInterval.Fields().ByName("pol_rune_withdrawn")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPoolHistoryInterval)(nil)
+
+type fastReflection_QueryPoolHistoryInterval QueryPoolHistoryInterval
+
+func (x *QueryPoolHistoryInterval) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPoolHistoryInterval)(x)
+}
+
+func (x *QueryPoolHistoryInterval) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_query_pool_history_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPoolHistoryInterval_messageType fastReflection_QueryPoolHistoryInterval_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPoolHistoryInterval_messageType{}
+
+type fastReflection_QueryPoolHistoryInterval_messageType struct{}
+
+func (x fastReflection_QueryPoolHistoryInterval_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPoolHistoryInterval)(nil)
+}
+func (x fastReflection_QueryPoolHistoryInterval_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolHistoryInterval)
+}
+func (x fastReflection_QueryPoolHistoryInterval_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolHistoryInterval
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPoolHistoryInterval) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPoolHistoryInterval
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPoolHistoryInterval) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPoolHistoryInterval_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPoolHistoryInterval) New() protoreflect.Message {
+	return new(fastReflection_QueryPoolHistoryInterval)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPoolHistoryInterval) Interface() protoreflect.ProtoMessage {
+	return (*QueryPoolHistoryInterval)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPoolHistoryInterval) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartHeight)
+		if !f(fd_QueryPoolHistoryInterval_start_height, value) {
+			return
+		}
+	}
+	if x.EndHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndHeight)
+		if !f(fd_QueryPoolHistoryInterval_end_height, value) {
+			return
+		}
+	}
+	if x.LiquidityFees != "" {
+		value := protoreflect.ValueOfString(x.LiquidityFees)
+		if !f(fd_QueryPoolHistoryInterval_liquidity_fees, value) {
+			return
+		}
+	}
+	if x.Rewards != "" {
+		value := protoreflect.ValueOfString(x.Rewards)
+		if !f(fd_QueryPoolHistoryInterval_rewards, value) {
+			return
+		}
+	}
+	if x.SynthYield != "" {
+		value := protoreflect.ValueOfString(x.SynthYield)
+		if !f(fd_QueryPoolHistoryInterval_synth_yield, value) {
+			return
+		}
+	}
+	if x.PolRuneDeposited != "" {
+		value := protoreflect.ValueOfString(x.PolRuneDeposited)
+		if !f(fd_QueryPoolHistoryInterval_pol_rune_deposited, value) {
+			return
+		}
+	}
+	if x.PolRuneWithdrawn != "" {
+		value := protoreflect.ValueOfString(x.PolRuneWithdrawn)
+		if !f(fd_QueryPoolHistoryInterval_pol_rune_withdrawn, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPoolHistoryInterval) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryInterval.start_height":
+		return x.StartHeight != int64(0)
+	case "types.QueryPoolHistoryInterval.end_height":
+		return x.EndHeight != int64(0)
+	case "types.QueryPoolHistoryInterval.liquidity_fees":
+		return x.LiquidityFees != ""
+	case "types.QueryPoolHistoryInterval.rewards":
+		return x.Rewards != ""
+	case "types.QueryPoolHistoryInterval.synth_yield":
+		return x.SynthYield != ""
+	case "types.QueryPoolHistoryInterval.pol_rune_deposited":
+		return x.PolRuneDeposited != ""
+	case "types.QueryPoolHistoryInterval.pol_rune_withdrawn":
+		return x.PolRuneWithdrawn != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryInterval"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryInterval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryInterval) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryInterval.start_height":
+		x.StartHeight = int64(0)
+	case "types.QueryPoolHistoryInterval.end_height":
+		x.EndHeight = int64(0)
+	case "types.QueryPoolHistoryInterval.liquidity_fees":
+		x.LiquidityFees = ""
+	case "types.QueryPoolHistoryInterval.rewards":
+		x.Rewards = ""
+	case "types.QueryPoolHistoryInterval.synth_yield":
+		x.SynthYield = ""
+	case "types.QueryPoolHistoryInterval.pol_rune_deposited":
+		x.PolRuneDeposited = ""
+	case "types.QueryPoolHistoryInterval.pol_rune_withdrawn":
+		x.PolRuneWithdrawn = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryInterval"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryInterval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPoolHistoryInterval) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.QueryPoolHistoryInterval.start_height":
+		value := x.StartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryPoolHistoryInterval.end_height":
+		value := x.EndHeight
+		return protoreflect.ValueOfInt64(value)
+	case "types.QueryPoolHistoryInterval.liquidity_fees":
+		value := x.LiquidityFees
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryInterval.rewards":
+		value := x.Rewards
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryInterval.synth_yield":
+		value := x.SynthYield
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryInterval.pol_rune_deposited":
+		value := x.PolRuneDeposited
+		return protoreflect.ValueOfString(value)
+	case "types.QueryPoolHistoryInterval.pol_rune_withdrawn":
+		value := x.PolRuneWithdrawn
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryInterval"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryInterval does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryInterval) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryInterval.start_height":
+		x.StartHeight = value.Int()
+	case "types.QueryPoolHistoryInterval.end_height":
+		x.EndHeight = value.Int()
+	case "types.QueryPoolHistoryInterval.liquidity_fees":
+		x.LiquidityFees = value.Interface().(string)
+	case "types.QueryPoolHistoryInterval.rewards":
+		x.Rewards = value.Interface().(string)
+	case "types.QueryPoolHistoryInterval.synth_yield":
+		x.SynthYield = value.Interface().(string)
+	case "types.QueryPoolHistoryInterval.pol_rune_deposited":
+		x.PolRuneDeposited = value.Interface().(string)
+	case "types.QueryPoolHistoryInterval.pol_rune_withdrawn":
+		x.PolRuneWithdrawn = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryInterval"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryInterval does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryInterval) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryInterval.start_height":
+		panic(fmt.Errorf("field start_height of message types.QueryPoolHistoryInterval is not mutable"))
+	case "types.QueryPoolHistoryInterval.end_height":
+		panic(fmt.Errorf("field end_height of message types.QueryPoolHistoryInterval is not mutable"))
+	case "types.QueryPoolHistoryInterval.liquidity_fees":
+		panic(fmt.Errorf("field liquidity_fees of message types.QueryPoolHistoryInterval is not mutable"))
+	case "types.QueryPoolHistoryInterval.rewards":
+		panic(fmt.Errorf("field rewards of message types.QueryPoolHistoryInterval is not mutable"))
+	case "types.QueryPoolHistoryInterval.synth_yield":
+		panic(fmt.Errorf("field synth_yield of message types.QueryPoolHistoryInterval is not mutable"))
+	case "types.QueryPoolHistoryInterval.pol_rune_deposited":
+		panic(fmt.Errorf("field pol_rune_deposited of message types.QueryPoolHistoryInterval is not mutable"))
+	case "types.QueryPoolHistoryInterval.pol_rune_withdrawn":
+		panic(fmt.Errorf("field pol_rune_withdrawn of message types.QueryPoolHistoryInterval is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryInterval"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryInterval does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPoolHistoryInterval) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.QueryPoolHistoryInterval.start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryPoolHistoryInterval.end_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.QueryPoolHistoryInterval.liquidity_fees":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryInterval.rewards":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryInterval.synth_yield":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryInterval.pol_rune_deposited":
+		return protoreflect.ValueOfString("")
+	case "types.QueryPoolHistoryInterval.pol_rune_withdrawn":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.QueryPoolHistoryInterval"))
+		}
+		panic(fmt.Errorf("message types.QueryPoolHistoryInterval does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPoolHistoryInterval) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.QueryPoolHistoryInterval", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPoolHistoryInterval) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPoolHistoryInterval) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPoolHistoryInterval) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPoolHistoryInterval) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPoolHistoryInterval)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartHeight))
+		}
+		if x.EndHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndHeight))
+		}
+		l = len(x.LiquidityFees)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Rewards)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SynthYield)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PolRuneDeposited)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PolRuneWithdrawn)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolHistoryInterval)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PolRuneWithdrawn) > 0 {
+			i -= len(x.PolRuneWithdrawn)
+			copy(dAtA[i:], x.PolRuneWithdrawn)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolRuneWithdrawn)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.PolRuneDeposited) > 0 {
+			i -= len(x.PolRuneDeposited)
+			copy(dAtA[i:], x.PolRuneDeposited)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PolRuneDeposited)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SynthYield) > 0 {
+			i -= len(x.SynthYield)
+			copy(dAtA[i:], x.SynthYield)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SynthYield)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Rewards) > 0 {
+			i -= len(x.Rewards)
+			copy(dAtA[i:], x.Rewards)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rewards)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.LiquidityFees) > 0 {
+			i -= len(x.LiquidityFees)
+			copy(dAtA[i:], x.LiquidityFees)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidityFees)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.EndHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.StartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartHeight))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPoolHistoryInterval)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolHistoryInterval: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPoolHistoryInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				}
+				x.StartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				}
+				x.EndHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidityFees", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidityFees = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SynthYield", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SynthYield = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolRuneDeposited", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolRuneDeposited = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolRuneWithdrawn", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolRuneWithdrawn = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: types/query_pool_history.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QueryPoolHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset    string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Height   string `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *QueryPoolHistoryRequest) Reset() {
+	*x = QueryPoolHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_pool_history_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolHistoryRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryPoolHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_types_query_pool_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryPoolHistoryRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryRequest) GetHeight() string {
+	if x != nil {
+		return x.Height
+	}
+	return ""
+}
+
+type QueryPoolHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	// the length of each returned interval (day, week)
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// liquidity fees and block rewards over the retained history annualised against the current pool depth, in basis points
+	LiquidityApyBps int64 `protobuf:"varint,3,opt,name=liquidity_apy_bps,json=liquidityApyBps,proto3" json:"liquidity_apy_bps,omitempty"`
+	// synth yield over the retained history annualised against the current savers depth, in basis points
+	SaversApyBps int64 `protobuf:"varint,4,opt,name=savers_apy_bps,json=saversApyBps,proto3" json:"savers_apy_bps,omitempty"`
+	// the pool history, most recent interval first
+	Intervals []*QueryPoolHistoryInterval `protobuf:"bytes,5,rep,name=intervals,proto3" json:"intervals,omitempty"`
+}
+
+func (x *QueryPoolHistoryResponse) Reset() {
+	*x = QueryPoolHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_pool_history_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolHistoryResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryPoolHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_types_query_pool_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryPoolHistoryResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryResponse) GetLiquidityApyBps() int64 {
+	if x != nil {
+		return x.LiquidityApyBps
+	}
+	return 0
+}
+
+func (x *QueryPoolHistoryResponse) GetSaversApyBps() int64 {
+	if x != nil {
+		return x.SaversApyBps
+	}
+	return 0
+}
+
+func (x *QueryPoolHistoryResponse) GetIntervals() []*QueryPoolHistoryInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+type QueryPoolHistoryInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the first block height of the interval
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the last block height of the interval
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// liquidity fees collected by the pool in RUNE
+	LiquidityFees string `protobuf:"bytes,3,opt,name=liquidity_fees,json=liquidityFees,proto3" json:"liquidity_fees,omitempty"`
+	// block rewards paid to the pool in RUNE
+	Rewards string `protobuf:"bytes,4,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	// synths minted as yield to the savers of the pool
+	SynthYield string `protobuf:"bytes,5,opt,name=synth_yield,json=synthYield,proto3" json:"synth_yield,omitempty"`
+	// RUNE deposited into the pool by protocol owned liquidity
+	PolRuneDeposited string `protobuf:"bytes,6,opt,name=pol_rune_deposited,json=polRuneDeposited,proto3" json:"pol_rune_deposited,omitempty"`
+	// RUNE withdrawn from the pool by protocol owned liquidity
+	PolRuneWithdrawn string `protobuf:"bytes,7,opt,name=pol_rune_withdrawn,json=polRuneWithdrawn,proto3" json:"pol_rune_withdrawn,omitempty"`
+}
+
+func (x *QueryPoolHistoryInterval) Reset() {
+	*x = QueryPoolHistoryInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_query_pool_history_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPoolHistoryInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPoolHistoryInterval) ProtoMessage() {}
+
+// Deprecated: Use QueryPoolHistoryInterval.ProtoReflect.Descriptor instead.
+func (*QueryPoolHistoryInterval) Descriptor() ([]byte, []int) {
+	return file_types_query_pool_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryPoolHistoryInterval) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *QueryPoolHistoryInterval) GetEndHeight() int64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *QueryPoolHistoryInterval) GetLiquidityFees() string {
+	if x != nil {
+		return x.LiquidityFees
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryInterval) GetRewards() string {
+	if x != nil {
+		return x.Rewards
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryInterval) GetSynthYield() string {
+	if x != nil {
+		return x.SynthYield
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryInterval) GetPolRuneDeposited() string {
+	if x != nil {
+		return x.PolRuneDeposited
+	}
+	return ""
+}
+
+func (x *QueryPoolHistoryInterval) GetPolRuneWithdrawn() string {
+	if x != nil {
+		return x.PolRuneWithdrawn
+	}
+	return ""
+}
+
+var File_types_query_pool_history_proto protoreflect.FileDescriptor
+
+var file_types_query_pool_history_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a,
+	0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xb0, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xea, 0xde, 0x1f, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x28, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xea, 0xde, 0x1f, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x11, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x79, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x15, 0xea, 0xde, 0x1f, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x70, 0x79, 0x5f, 0x62, 0x70, 0x73, 0x52, 0x0f, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x41, 0x70, 0x79, 0x42, 0x70, 0x73, 0x12, 0x38, 0x0a,
+	0x0e, 0x73, 0x61, 0x76, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x70, 0x79, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x12, 0xea, 0xde, 0x1f, 0x0e, 0x73, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x5f, 0x61, 0x70, 0x79, 0x5f, 0x62, 0x70, 0x73, 0x52, 0x0c, 0x73, 0x61, 0x76, 0x65, 0x72,
+	0x73, 0x41, 0x70, 0x79, 0x42, 0x70, 0x73, 0x12, 0x4c, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0xea, 0xde, 0x1f,
+	0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x10, 0xea, 0xde, 0x1f, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xea, 0xde, 0x1f,
+	0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x0e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
+	0xea, 0xde, 0x1f, 0x0e, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65,
+	0x65, 0x73, 0x52, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0xea, 0xde, 0x1f, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x74,
+	0x68, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xea,
+	0xde, 0x1f, 0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x5f, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a,
+	0x73, 0x79, 0x6e, 0x74, 0x68, 0x59, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x44, 0x0a, 0x12, 0x70, 0x6f,
+	0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde, 0x1f, 0x12, 0x70, 0x6f, 0x6c, 0x5f,
+	0x72, 0x75, 0x6e, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x52, 0x10,
+	0x70, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64,
+	0x12, 0x44, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xea, 0xde,
+	0x1f, 0x12, 0x70, 0x6f, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x6e, 0x52, 0x10, 0x70, 0x6f, 0x6c, 0x52, 0x75, 0x6e, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x42, 0x86, 0x01, 0xc8, 0xe2, 0x1e, 0x01, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
+	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03,
+	0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_types_query_pool_history_proto_rawDescOnce sync.Once
+	file_types_query_pool_history_proto_rawDescData = file_types_query_pool_history_proto_rawDesc
+)
+
+func file_types_query_pool_history_proto_rawDescGZIP() []byte {
+	file_types_query_pool_history_proto_rawDescOnce.Do(func() {
+		file_types_query_pool_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_query_pool_history_proto_rawDescData)
+	})
+	return file_types_query_pool_history_proto_rawDescData
+}
+
+var file_types_query_pool_history_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_types_query_pool_history_proto_goTypes = []interface{}{
+	(*QueryPoolHistoryRequest)(nil),  // 0: types.QueryPoolHistoryRequest
+	(*QueryPoolHistoryResponse)(nil), // 1: types.QueryPoolHistoryResponse
+	(*QueryPoolHistoryInterval)(nil), // 2: types.QueryPoolHistoryInterval
+}
+var file_types_query_pool_history_proto_depIdxs = []int32{
+	2, // 0: types.QueryPoolHistoryResponse.intervals:type_name -> types.QueryPoolHistoryInterval
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_types_query_pool_history_proto_init() }
+func file_types_query_pool_history_proto_init() {
+	if File_types_query_pool_history_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_query_pool_history_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_pool_history_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_query_pool_history_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPoolHistoryInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_query_pool_history_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_query_pool_history_proto_goTypes,
+		DependencyIndexes: file_types_query_pool_history_proto_depIdxs,
+		MessageInfos:      file_types_query_pool_history_proto_msgTypes,
+	}.Build()
+	File_types_query_pool_history_proto = out.File
+	file_types_query_pool_history_proto_rawDesc = nil
+	file_types_query_pool_history_proto_goTypes = nil
+	file_types_query_pool_history_proto_depIdxs = nil
+}
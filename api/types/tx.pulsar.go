@@ -449,147 +449,154 @@ var file_types_tx_proto_rawDesc = []byte{
 	0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x5f, 0x6d,
 	0x6f, 0x64, 0x69, 0x66, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
-	0x2f, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x0a, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0xa0, 0x10,
-	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x25, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x0d, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x07,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x45,
-	0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e,
-	0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x25, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x6d, 0x73,
+	0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
+	0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x77, 0x61, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0a, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x32, 0xe7, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x25, 0x0a, 0x03,
+	0x42, 0x61, 0x6e, 0x12, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x42,
+	0x61, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x11,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x12, 0x12,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61,
+	0x54, 0x78, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x51,
+	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x29, 0x0a, 0x05, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0f, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x19,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x77, 0x61, 0x70, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x13, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61,
+	0x70, 0x12, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
+	0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x33, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x12,
+	0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x46, 0x65, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x46, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65,
+	0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x54, 0x78, 0x49, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x49, 0x6e, 0x1a, 0x0f, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a,
+	0x0d, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x12, 0x17,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
 	0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x4d, 0x69, 0x6d,
-	0x69, 0x72, 0x12, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69,
-	0x6d, 0x69, 0x72, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x77,
-	0x61, 0x70, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65,
-	0x65, 0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46,
-	0x65, 0x65, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x4e, 0x6f, 0x64,
-	0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x54, 0x78, 0x49, 0x6e, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x49, 0x6e, 0x1a, 0x0f,
+	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x08, 0x54, 0x68, 0x6f,
+	0x72, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x49, 0x50, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x65, 0x74, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x0f,
 	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x39, 0x0a, 0x0d, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x75, 0x74,
-	0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x4f, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x1a,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x54, 0x78, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x08, 0x54,
-	0x68, 0x6f, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x53, 0x65, 0x6e, 0x64, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x49,
-	0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x35, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x15,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6f,
+	0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x6e, 0x63, 0x79, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x6f,
+	0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69,
+	0x67, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x73, 0x67, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x46, 0x61, 0x69, 0x6c,
 	0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x35, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73,
-	0x12, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x53, 0x6f, 0x6c, 0x76,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x53, 0x6f, 0x6c,
-	0x76, 0x65, 0x6e, 0x63, 0x79, 0x51, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x18, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x6e, 0x63, 0x79, 0x51,
-	0x75, 0x6f, 0x72, 0x75, 0x6d, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73,
-	0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79,
-	0x73, 0x69, 0x67, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x46, 0x61,
-	0x69, 0x6c, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x2d, 0x0a, 0x07, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x11,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f,
-	0x6c, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x39, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x09,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x71, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77,
-	0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x12, 0x29, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x24, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77,
-	0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x65, 0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e,
-	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73,
+	0x79, 0x12, 0x2d, 0x0a, 0x07, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x11, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x1a,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x33, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x0f,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x39, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x09, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61,
+	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61,
+	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x71, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x74, 0x0a, 0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x53, 0x75, 0x64, 0x6f,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77,
-	0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x64, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f,
+	0x67, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x32, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
+	0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x32,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f,
 	0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x53, 0x75, 0x64, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
+	0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x65, 0x0a, 0x0f, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61,
+	0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77,
+	0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0c, 0x53, 0x75, 0x64, 0x6f, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73,
+	0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x64,
+	0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x64, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77,
+	0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d,
 	0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61,
-	0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x56, 0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12,
-	0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
-	0x42, 0x74, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x07, 0x54,
-	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
-	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x0a, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1f, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x77, 0x61, 0x73, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x74,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x07, 0x54, 0x78, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -613,39 +620,40 @@ var file_types_tx_proto_goTypes = []interface{}{
 	(*MsgErrataTxQuorum)(nil),                     // 4: types.MsgErrataTxQuorum
 	(*MsgMimir)(nil),                              // 5: types.MsgMimir
 	(*MsgModifyLimitSwap)(nil),                    // 6: types.MsgModifyLimitSwap
-	(*MsgNetworkFee)(nil),                         // 7: types.MsgNetworkFee
-	(*MsgNetworkFeeQuorum)(nil),                   // 8: types.MsgNetworkFeeQuorum
-	(*MsgNodePauseChain)(nil),                     // 9: types.MsgNodePauseChain
-	(*MsgObservedTxIn)(nil),                       // 10: types.MsgObservedTxIn
-	(*MsgObservedTxOut)(nil),                      // 11: types.MsgObservedTxOut
-	(*MsgObservedTxQuorum)(nil),                   // 12: types.MsgObservedTxQuorum
-	(*MsgSend)(nil),                               // 13: types.MsgSend
-	(*MsgSetIPAddress)(nil),                       // 14: types.MsgSetIPAddress
-	(*MsgSetNodeKeys)(nil),                        // 15: types.MsgSetNodeKeys
-	(*MsgSolvency)(nil),                           // 16: types.MsgSolvency
-	(*MsgSolvencyQuorum)(nil),                     // 17: types.MsgSolvencyQuorum
-	(*MsgTssKeysignFail)(nil),                     // 18: types.MsgTssKeysignFail
-	(*MsgTssPool)(nil),                            // 19: types.MsgTssPool
-	(*MsgSetVersion)(nil),                         // 20: types.MsgSetVersion
-	(*MsgProposeUpgrade)(nil),                     // 21: types.MsgProposeUpgrade
-	(*MsgApproveUpgrade)(nil),                     // 22: types.MsgApproveUpgrade
-	(*MsgRejectUpgrade)(nil),                      // 23: types.MsgRejectUpgrade
-	(*types.MsgStoreCode)(nil),                    // 24: cosmwasm.wasm.v1.MsgStoreCode
-	(*types.MsgInstantiateContract)(nil),          // 25: cosmwasm.wasm.v1.MsgInstantiateContract
-	(*types.MsgInstantiateContract2)(nil),         // 26: cosmwasm.wasm.v1.MsgInstantiateContract2
-	(*types.MsgExecuteContract)(nil),              // 27: cosmwasm.wasm.v1.MsgExecuteContract
-	(*types.MsgMigrateContract)(nil),              // 28: cosmwasm.wasm.v1.MsgMigrateContract
-	(*types.MsgSudoContract)(nil),                 // 29: cosmwasm.wasm.v1.MsgSudoContract
-	(*types.MsgUpdateAdmin)(nil),                  // 30: cosmwasm.wasm.v1.MsgUpdateAdmin
-	(*types.MsgClearAdmin)(nil),                   // 31: cosmwasm.wasm.v1.MsgClearAdmin
-	(*types.MsgStoreCodeResponse)(nil),            // 32: cosmwasm.wasm.v1.MsgStoreCodeResponse
-	(*types.MsgInstantiateContractResponse)(nil),  // 33: cosmwasm.wasm.v1.MsgInstantiateContractResponse
-	(*types.MsgInstantiateContract2Response)(nil), // 34: cosmwasm.wasm.v1.MsgInstantiateContract2Response
-	(*types.MsgExecuteContractResponse)(nil),      // 35: cosmwasm.wasm.v1.MsgExecuteContractResponse
-	(*types.MsgMigrateContractResponse)(nil),      // 36: cosmwasm.wasm.v1.MsgMigrateContractResponse
-	(*types.MsgSudoContractResponse)(nil),         // 37: cosmwasm.wasm.v1.MsgSudoContractResponse
-	(*types.MsgUpdateAdminResponse)(nil),          // 38: cosmwasm.wasm.v1.MsgUpdateAdminResponse
-	(*types.MsgClearAdminResponse)(nil),           // 39: cosmwasm.wasm.v1.MsgClearAdminResponse
+	(*MsgModifyStreamingSwap)(nil),                // 7: types.MsgModifyStreamingSwap
+	(*MsgNetworkFee)(nil),                         // 8: types.MsgNetworkFee
+	(*MsgNetworkFeeQuorum)(nil),                   // 9: types.MsgNetworkFeeQuorum
+	(*MsgNodePauseChain)(nil),                     // 10: types.MsgNodePauseChain
+	(*MsgObservedTxIn)(nil),                       // 11: types.MsgObservedTxIn
+	(*MsgObservedTxOut)(nil),                      // 12: types.MsgObservedTxOut
+	(*MsgObservedTxQuorum)(nil),                   // 13: types.MsgObservedTxQuorum
+	(*MsgSend)(nil),                               // 14: types.MsgSend
+	(*MsgSetIPAddress)(nil),                       // 15: types.MsgSetIPAddress
+	(*MsgSetNodeKeys)(nil),                        // 16: types.MsgSetNodeKeys
+	(*MsgSolvency)(nil),                           // 17: types.MsgSolvency
+	(*MsgSolvencyQuorum)(nil),                     // 18: types.MsgSolvencyQuorum
+	(*MsgTssKeysignFail)(nil),                     // 19: types.MsgTssKeysignFail
+	(*MsgTssPool)(nil),                            // 20: types.MsgTssPool
+	(*MsgSetVersion)(nil),                         // 21: types.MsgSetVersion
+	(*MsgProposeUpgrade)(nil),                     // 22: types.MsgProposeUpgrade
+	(*MsgApproveUpgrade)(nil),                     // 23: types.MsgApproveUpgrade
+	(*MsgRejectUpgrade)(nil),                      // 24: types.MsgRejectUpgrade
+	(*types.MsgStoreCode)(nil),                    // 25: cosmwasm.wasm.v1.MsgStoreCode
+	(*types.MsgInstantiateContract)(nil),          // 26: cosmwasm.wasm.v1.MsgInstantiateContract
+	(*types.MsgInstantiateContract2)(nil),         // 27: cosmwasm.wasm.v1.MsgInstantiateContract2
+	(*types.MsgExecuteContract)(nil),              // 28: cosmwasm.wasm.v1.MsgExecuteContract
+	(*types.MsgMigrateContract)(nil),              // 29: cosmwasm.wasm.v1.MsgMigrateContract
+	(*types.MsgSudoContract)(nil),                 // 30: cosmwasm.wasm.v1.MsgSudoContract
+	(*types.MsgUpdateAdmin)(nil),                  // 31: cosmwasm.wasm.v1.MsgUpdateAdmin
+	(*types.MsgClearAdmin)(nil),                   // 32: cosmwasm.wasm.v1.MsgClearAdmin
+	(*types.MsgStoreCodeResponse)(nil),            // 33: cosmwasm.wasm.v1.MsgStoreCodeResponse
+	(*types.MsgInstantiateContractResponse)(nil),  // 34: cosmwasm.wasm.v1.MsgInstantiateContractResponse
+	(*types.MsgInstantiateContract2Response)(nil), // 35: cosmwasm.wasm.v1.MsgInstantiateContract2Response
+	(*types.MsgExecuteContractResponse)(nil),      // 36: cosmwasm.wasm.v1.MsgExecuteContractResponse
+	(*types.MsgMigrateContractResponse)(nil),      // 37: cosmwasm.wasm.v1.MsgMigrateContractResponse
+	(*types.MsgSudoContractResponse)(nil),         // 38: cosmwasm.wasm.v1.MsgSudoContractResponse
+	(*types.MsgUpdateAdminResponse)(nil),          // 39: cosmwasm.wasm.v1.MsgUpdateAdminResponse
+	(*types.MsgClearAdminResponse)(nil),           // 40: cosmwasm.wasm.v1.MsgClearAdminResponse
 }
 var file_types_tx_proto_depIdxs = []int32{
 	1,  // 0: types.Msg.Ban:input_type -> types.MsgBan
@@ -654,64 +662,66 @@ var file_types_tx_proto_depIdxs = []int32{
 	4,  // 3: types.Msg.ErrataTxQuorum:input_type -> types.MsgErrataTxQuorum
 	5,  // 4: types.Msg.Mimir:input_type -> types.MsgMimir
 	6,  // 5: types.Msg.ModifyLimitSwap:input_type -> types.MsgModifyLimitSwap
-	7,  // 6: types.Msg.NetworkFee:input_type -> types.MsgNetworkFee
-	8,  // 7: types.Msg.NetworkFeeQuorum:input_type -> types.MsgNetworkFeeQuorum
-	9,  // 8: types.Msg.NodePauseChain:input_type -> types.MsgNodePauseChain
-	10, // 9: types.Msg.ObservedTxIn:input_type -> types.MsgObservedTxIn
-	11, // 10: types.Msg.ObservedTxOut:input_type -> types.MsgObservedTxOut
-	12, // 11: types.Msg.ObservedTxQuorum:input_type -> types.MsgObservedTxQuorum
-	13, // 12: types.Msg.ThorSend:input_type -> types.MsgSend
-	14, // 13: types.Msg.SetIPAddress:input_type -> types.MsgSetIPAddress
-	15, // 14: types.Msg.SetNodeKeys:input_type -> types.MsgSetNodeKeys
-	16, // 15: types.Msg.Solvency:input_type -> types.MsgSolvency
-	17, // 16: types.Msg.SolvencyQuorum:input_type -> types.MsgSolvencyQuorum
-	18, // 17: types.Msg.TssKeysignFail:input_type -> types.MsgTssKeysignFail
-	19, // 18: types.Msg.TssPool:input_type -> types.MsgTssPool
-	20, // 19: types.Msg.SetVersion:input_type -> types.MsgSetVersion
-	21, // 20: types.Msg.ProposeUpgrade:input_type -> types.MsgProposeUpgrade
-	22, // 21: types.Msg.ApproveUpgrade:input_type -> types.MsgApproveUpgrade
-	23, // 22: types.Msg.RejectUpgrade:input_type -> types.MsgRejectUpgrade
-	24, // 23: types.Msg.StoreCode:input_type -> cosmwasm.wasm.v1.MsgStoreCode
-	25, // 24: types.Msg.InstantiateContract:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract
-	26, // 25: types.Msg.InstantiateContract2:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract2
-	27, // 26: types.Msg.ExecuteContract:input_type -> cosmwasm.wasm.v1.MsgExecuteContract
-	28, // 27: types.Msg.MigrateContract:input_type -> cosmwasm.wasm.v1.MsgMigrateContract
-	29, // 28: types.Msg.SudoContract:input_type -> cosmwasm.wasm.v1.MsgSudoContract
-	30, // 29: types.Msg.UpdateAdmin:input_type -> cosmwasm.wasm.v1.MsgUpdateAdmin
-	31, // 30: types.Msg.ClearAdmin:input_type -> cosmwasm.wasm.v1.MsgClearAdmin
-	0,  // 31: types.Msg.Ban:output_type -> types.MsgEmpty
-	0,  // 32: types.Msg.Deposit:output_type -> types.MsgEmpty
-	0,  // 33: types.Msg.ErrataTx:output_type -> types.MsgEmpty
-	0,  // 34: types.Msg.ErrataTxQuorum:output_type -> types.MsgEmpty
-	0,  // 35: types.Msg.Mimir:output_type -> types.MsgEmpty
-	0,  // 36: types.Msg.ModifyLimitSwap:output_type -> types.MsgEmpty
-	0,  // 37: types.Msg.NetworkFee:output_type -> types.MsgEmpty
-	0,  // 38: types.Msg.NetworkFeeQuorum:output_type -> types.MsgEmpty
-	0,  // 39: types.Msg.NodePauseChain:output_type -> types.MsgEmpty
-	0,  // 40: types.Msg.ObservedTxIn:output_type -> types.MsgEmpty
-	0,  // 41: types.Msg.ObservedTxOut:output_type -> types.MsgEmpty
-	0,  // 42: types.Msg.ObservedTxQuorum:output_type -> types.MsgEmpty
-	0,  // 43: types.Msg.ThorSend:output_type -> types.MsgEmpty
-	0,  // 44: types.Msg.SetIPAddress:output_type -> types.MsgEmpty
-	0,  // 45: types.Msg.SetNodeKeys:output_type -> types.MsgEmpty
-	0,  // 46: types.Msg.Solvency:output_type -> types.MsgEmpty
-	0,  // 47: types.Msg.SolvencyQuorum:output_type -> types.MsgEmpty
-	0,  // 48: types.Msg.TssKeysignFail:output_type -> types.MsgEmpty
-	0,  // 49: types.Msg.TssPool:output_type -> types.MsgEmpty
-	0,  // 50: types.Msg.SetVersion:output_type -> types.MsgEmpty
-	0,  // 51: types.Msg.ProposeUpgrade:output_type -> types.MsgEmpty
-	0,  // 52: types.Msg.ApproveUpgrade:output_type -> types.MsgEmpty
-	0,  // 53: types.Msg.RejectUpgrade:output_type -> types.MsgEmpty
-	32, // 54: types.Msg.StoreCode:output_type -> cosmwasm.wasm.v1.MsgStoreCodeResponse
-	33, // 55: types.Msg.InstantiateContract:output_type -> cosmwasm.wasm.v1.MsgInstantiateContractResponse
-	34, // 56: types.Msg.InstantiateContract2:output_type -> cosmwasm.wasm.v1.MsgInstantiateContract2Response
-	35, // 57: types.Msg.ExecuteContract:output_type -> cosmwasm.wasm.v1.MsgExecuteContractResponse
-	36, // 58: types.Msg.MigrateContract:output_type -> cosmwasm.wasm.v1.MsgMigrateContractResponse
-	37, // 59: types.Msg.SudoContract:output_type -> cosmwasm.wasm.v1.MsgSudoContractResponse
-	38, // 60: types.Msg.UpdateAdmin:output_type -> cosmwasm.wasm.v1.MsgUpdateAdminResponse
-	39, // 61: types.Msg.ClearAdmin:output_type -> cosmwasm.wasm.v1.MsgClearAdminResponse
-	31, // [31:62] is the sub-list for method output_type
-	0,  // [0:31] is the sub-list for method input_type
+	7,  // 6: types.Msg.ModifyStreamingSwap:input_type -> types.MsgModifyStreamingSwap
+	8,  // 7: types.Msg.NetworkFee:input_type -> types.MsgNetworkFee
+	9,  // 8: types.Msg.NetworkFeeQuorum:input_type -> types.MsgNetworkFeeQuorum
+	10, // 9: types.Msg.NodePauseChain:input_type -> types.MsgNodePauseChain
+	11, // 10: types.Msg.ObservedTxIn:input_type -> types.MsgObservedTxIn
+	12, // 11: types.Msg.ObservedTxOut:input_type -> types.MsgObservedTxOut
+	13, // 12: types.Msg.ObservedTxQuorum:input_type -> types.MsgObservedTxQuorum
+	14, // 13: types.Msg.ThorSend:input_type -> types.MsgSend
+	15, // 14: types.Msg.SetIPAddress:input_type -> types.MsgSetIPAddress
+	16, // 15: types.Msg.SetNodeKeys:input_type -> types.MsgSetNodeKeys
+	17, // 16: types.Msg.Solvency:input_type -> types.MsgSolvency
+	18, // 17: types.Msg.SolvencyQuorum:input_type -> types.MsgSolvencyQuorum
+	19, // 18: types.Msg.TssKeysignFail:input_type -> types.MsgTssKeysignFail
+	20, // 19: types.Msg.TssPool:input_type -> types.MsgTssPool
+	21, // 20: types.Msg.SetVersion:input_type -> types.MsgSetVersion
+	22, // 21: types.Msg.ProposeUpgrade:input_type -> types.MsgProposeUpgrade
+	23, // 22: types.Msg.ApproveUpgrade:input_type -> types.MsgApproveUpgrade
+	24, // 23: types.Msg.RejectUpgrade:input_type -> types.MsgRejectUpgrade
+	25, // 24: types.Msg.StoreCode:input_type -> cosmwasm.wasm.v1.MsgStoreCode
+	26, // 25: types.Msg.InstantiateContract:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract
+	27, // 26: types.Msg.InstantiateContract2:input_type -> cosmwasm.wasm.v1.MsgInstantiateContract2
+	28, // 27: types.Msg.ExecuteContract:input_type -> cosmwasm.wasm.v1.MsgExecuteContract
+	29, // 28: types.Msg.MigrateContract:input_type -> cosmwasm.wasm.v1.MsgMigrateContract
+	30, // 29: types.Msg.SudoContract:input_type -> cosmwasm.wasm.v1.MsgSudoContract
+	31, // 30: types.Msg.UpdateAdmin:input_type -> cosmwasm.wasm.v1.MsgUpdateAdmin
+	32, // 31: types.Msg.ClearAdmin:input_type -> cosmwasm.wasm.v1.MsgClearAdmin
+	0,  // 32: types.Msg.Ban:output_type -> types.MsgEmpty
+	0,  // 33: types.Msg.Deposit:output_type -> types.MsgEmpty
+	0,  // 34: types.Msg.ErrataTx:output_type -> types.MsgEmpty
+	0,  // 35: types.Msg.ErrataTxQuorum:output_type -> types.MsgEmpty
+	0,  // 36: types.Msg.Mimir:output_type -> types.MsgEmpty
+	0,  // 37: types.Msg.ModifyLimitSwap:output_type -> types.MsgEmpty
+	0,  // 38: types.Msg.ModifyStreamingSwap:output_type -> types.MsgEmpty
+	0,  // 39: types.Msg.NetworkFee:output_type -> types.MsgEmpty
+	0,  // 40: types.Msg.NetworkFeeQuorum:output_type -> types.MsgEmpty
+	0,  // 41: types.Msg.NodePauseChain:output_type -> types.MsgEmpty
+	0,  // 42: types.Msg.ObservedTxIn:output_type -> types.MsgEmpty
+	0,  // 43: types.Msg.ObservedTxOut:output_type -> types.MsgEmpty
+	0,  // 44: types.Msg.ObservedTxQuorum:output_type -> types.MsgEmpty
+	0,  // 45: types.Msg.ThorSend:output_type -> types.MsgEmpty
+	0,  // 46: types.Msg.SetIPAddress:output_type -> types.MsgEmpty
+	0,  // 47: types.Msg.SetNodeKeys:output_type -> types.MsgEmpty
+	0,  // 48: types.Msg.Solvency:output_type -> types.MsgEmpty
+	0,  // 49: types.Msg.SolvencyQuorum:output_type -> types.MsgEmpty
+	0,  // 50: types.Msg.TssKeysignFail:output_type -> types.MsgEmpty
+	0,  // 51: types.Msg.TssPool:output_type -> types.MsgEmpty
+	0,  // 52: types.Msg.SetVersion:output_type -> types.MsgEmpty
+	0,  // 53: types.Msg.ProposeUpgrade:output_type -> types.MsgEmpty
+	0,  // 54: types.Msg.ApproveUpgrade:output_type -> types.MsgEmpty
+	0,  // 55: types.Msg.RejectUpgrade:output_type -> types.MsgEmpty
+	33, // 56: types.Msg.StoreCode:output_type -> cosmwasm.wasm.v1.MsgStoreCodeResponse
+	34, // 57: types.Msg.InstantiateContract:output_type -> cosmwasm.wasm.v1.MsgInstantiateContractResponse
+	35, // 58: types.Msg.InstantiateContract2:output_type -> cosmwasm.wasm.v1.MsgInstantiateContract2Response
+	36, // 59: types.Msg.ExecuteContract:output_type -> cosmwasm.wasm.v1.MsgExecuteContractResponse
+	37, // 60: types.Msg.MigrateContract:output_type -> cosmwasm.wasm.v1.MsgMigrateContractResponse
+	38, // 61: types.Msg.SudoContract:output_type -> cosmwasm.wasm.v1.MsgSudoContractResponse
+	39, // 62: types.Msg.UpdateAdmin:output_type -> cosmwasm.wasm.v1.MsgUpdateAdminResponse
+	40, // 63: types.Msg.ClearAdmin:output_type -> cosmwasm.wasm.v1.MsgClearAdminResponse
+	32, // [32:64] is the sub-list for method output_type
+	0,  // [0:32] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -741,6 +751,7 @@ func file_types_tx_proto_init() {
 	file_types_msg_upgrade_proto_init()
 	file_types_msg_maint_proto_init()
 	file_types_msg_modify_limit_swap_proto_init()
+	file_types_msg_modify_streaming_swap_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_types_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgEmpty); i {
@@ -26,6 +26,7 @@ const (
 	Msg_ErrataTxQuorum_FullMethodName       = "/types.Msg/ErrataTxQuorum"
 	Msg_Mimir_FullMethodName                = "/types.Msg/Mimir"
 	Msg_ModifyLimitSwap_FullMethodName      = "/types.Msg/ModifyLimitSwap"
+	Msg_ModifyStreamingSwap_FullMethodName  = "/types.Msg/ModifyStreamingSwap"
 	Msg_NetworkFee_FullMethodName           = "/types.Msg/NetworkFee"
 	Msg_NetworkFeeQuorum_FullMethodName     = "/types.Msg/NetworkFeeQuorum"
 	Msg_NodePauseChain_FullMethodName       = "/types.Msg/NodePauseChain"
@@ -63,6 +64,7 @@ type MsgClient interface {
 	ErrataTxQuorum(ctx context.Context, in *MsgErrataTxQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	Mimir(ctx context.Context, in *MsgMimir, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ModifyLimitSwap(ctx context.Context, in *MsgModifyLimitSwap, opts ...grpc.CallOption) (*MsgEmpty, error)
+	ModifyStreamingSwap(ctx context.Context, in *MsgModifyStreamingSwap, opts ...grpc.CallOption) (*MsgEmpty, error)
 	NetworkFee(ctx context.Context, in *MsgNetworkFee, opts ...grpc.CallOption) (*MsgEmpty, error)
 	NetworkFeeQuorum(ctx context.Context, in *MsgNetworkFeeQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	NodePauseChain(ctx context.Context, in *MsgNodePauseChain, opts ...grpc.CallOption) (*MsgEmpty, error)
@@ -153,6 +155,15 @@ func (c *msgClient) ModifyLimitSwap(ctx context.Context, in *MsgModifyLimitSwap,
 	return out, nil
 }
 
+func (c *msgClient) ModifyStreamingSwap(ctx context.Context, in *MsgModifyStreamingSwap, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, Msg_ModifyStreamingSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) NetworkFee(ctx context.Context, in *MsgNetworkFee, opts ...grpc.CallOption) (*MsgEmpty, error) {
 	out := new(MsgEmpty)
 	err := c.cc.Invoke(ctx, Msg_NetworkFee_FullMethodName, in, out, opts...)
@@ -388,6 +399,7 @@ type MsgServer interface {
 	ErrataTxQuorum(context.Context, *MsgErrataTxQuorum) (*MsgEmpty, error)
 	Mimir(context.Context, *MsgMimir) (*MsgEmpty, error)
 	ModifyLimitSwap(context.Context, *MsgModifyLimitSwap) (*MsgEmpty, error)
+	ModifyStreamingSwap(context.Context, *MsgModifyStreamingSwap) (*MsgEmpty, error)
 	NetworkFee(context.Context, *MsgNetworkFee) (*MsgEmpty, error)
 	NetworkFeeQuorum(context.Context, *MsgNetworkFeeQuorum) (*MsgEmpty, error)
 	NodePauseChain(context.Context, *MsgNodePauseChain) (*MsgEmpty, error)
@@ -439,6 +451,9 @@ func (UnimplementedMsgServer) Mimir(context.Context, *MsgMimir) (*MsgEmpty, erro
 func (UnimplementedMsgServer) ModifyLimitSwap(context.Context, *MsgModifyLimitSwap) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyLimitSwap not implemented")
 }
+func (UnimplementedMsgServer) ModifyStreamingSwap(context.Context, *MsgModifyStreamingSwap) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyStreamingSwap not implemented")
+}
 func (UnimplementedMsgServer) NetworkFee(context.Context, *MsgNetworkFee) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkFee not implemented")
 }
@@ -635,6 +650,24 @@ func _Msg_ModifyLimitSwap_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModifyStreamingSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyStreamingSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyStreamingSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ModifyStreamingSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyStreamingSwap(ctx, req.(*MsgModifyStreamingSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_NetworkFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgNetworkFee)
 	if err := dec(in); err != nil {
@@ -1116,6 +1149,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyLimitSwap",
 			Handler:    _Msg_ModifyLimitSwap_Handler,
 		},
+		{
+			MethodName: "ModifyStreamingSwap",
+			Handler:    _Msg_ModifyStreamingSwap_Handler,
+		},
 		{
 			MethodName: "NetworkFee",
 			Handler:    _Msg_NetworkFee_Handler,
@@ -1905,6 +1905,586 @@ func (x *fastReflection_EventModifyLimitSwap) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var (
+	md_EventModifyStreamingSwap          protoreflect.MessageDescriptor
+	fd_EventModifyStreamingSwap_from     protoreflect.FieldDescriptor
+	fd_EventModifyStreamingSwap_tx_id    protoreflect.FieldDescriptor
+	fd_EventModifyStreamingSwap_interval protoreflect.FieldDescriptor
+	fd_EventModifyStreamingSwap_quantity protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_type_events_proto_init()
+	md_EventModifyStreamingSwap = File_types_type_events_proto.Messages().ByName("EventModifyStreamingSwap")
+	fd_EventModifyStreamingSwap_from = md_EventModifyStreamingSwap.Fields().ByName("from")
+	fd_EventModifyStreamingSwap_tx_id = md_EventModifyStreamingSwap.Fields().ByName("tx_id")
+	fd_EventModifyStreamingSwap_interval = md_EventModifyStreamingSwap.Fields().ByName("interval")
+	fd_EventModifyStreamingSwap_quantity = md_EventModifyStreamingSwap.Fields().ByName("quantity")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventModifyStreamingSwap)(nil)
+
+type fastReflection_EventModifyStreamingSwap EventModifyStreamingSwap
+
+func (x *EventModifyStreamingSwap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventModifyStreamingSwap)(x)
+}
+
+func (x *EventModifyStreamingSwap) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventModifyStreamingSwap_messageType fastReflection_EventModifyStreamingSwap_messageType
+var _ protoreflect.MessageType = fastReflection_EventModifyStreamingSwap_messageType{}
+
+type fastReflection_EventModifyStreamingSwap_messageType struct{}
+
+func (x fastReflection_EventModifyStreamingSwap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventModifyStreamingSwap)(nil)
+}
+func (x fastReflection_EventModifyStreamingSwap_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventModifyStreamingSwap)
+}
+func (x fastReflection_EventModifyStreamingSwap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventModifyStreamingSwap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventModifyStreamingSwap) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventModifyStreamingSwap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventModifyStreamingSwap) Type() protoreflect.MessageType {
+	return _fastReflection_EventModifyStreamingSwap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventModifyStreamingSwap) New() protoreflect.Message {
+	return new(fastReflection_EventModifyStreamingSwap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventModifyStreamingSwap) Interface() protoreflect.ProtoMessage {
+	return (*EventModifyStreamingSwap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventModifyStreamingSwap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_EventModifyStreamingSwap_from, value) {
+			return
+		}
+	}
+	if x.TxId != "" {
+		value := protoreflect.ValueOfString(x.TxId)
+		if !f(fd_EventModifyStreamingSwap_tx_id, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_EventModifyStreamingSwap_interval, value) {
+			return
+		}
+	}
+	if x.Quantity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Quantity)
+		if !f(fd_EventModifyStreamingSwap_quantity, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventModifyStreamingSwap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.EventModifyStreamingSwap.from":
+		return x.From != ""
+	case "types.EventModifyStreamingSwap.tx_id":
+		return x.TxId != ""
+	case "types.EventModifyStreamingSwap.interval":
+		return x.Interval != uint64(0)
+	case "types.EventModifyStreamingSwap.quantity":
+		return x.Quantity != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventModifyStreamingSwap"))
+		}
+		panic(fmt.Errorf("message types.EventModifyStreamingSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventModifyStreamingSwap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.EventModifyStreamingSwap.from":
+		x.From = ""
+	case "types.EventModifyStreamingSwap.tx_id":
+		x.TxId = ""
+	case "types.EventModifyStreamingSwap.interval":
+		x.Interval = uint64(0)
+	case "types.EventModifyStreamingSwap.quantity":
+		x.Quantity = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventModifyStreamingSwap"))
+		}
+		panic(fmt.Errorf("message types.EventModifyStreamingSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventModifyStreamingSwap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.EventModifyStreamingSwap.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	case "types.EventModifyStreamingSwap.tx_id":
+		value := x.TxId
+		return protoreflect.ValueOfString(value)
+	case "types.EventModifyStreamingSwap.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "types.EventModifyStreamingSwap.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventModifyStreamingSwap"))
+		}
+		panic(fmt.Errorf("message types.EventModifyStreamingSwap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventModifyStreamingSwap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.EventModifyStreamingSwap.from":
+		x.From = value.Interface().(string)
+	case "types.EventModifyStreamingSwap.tx_id":
+		x.TxId = value.Interface().(string)
+	case "types.EventModifyStreamingSwap.interval":
+		x.Interval = value.Uint()
+	case "types.EventModifyStreamingSwap.quantity":
+		x.Quantity = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventModifyStreamingSwap"))
+		}
+		panic(fmt.Errorf("message types.EventModifyStreamingSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventModifyStreamingSwap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventModifyStreamingSwap.from":
+		panic(fmt.Errorf("field from of message types.EventModifyStreamingSwap is not mutable"))
+	case "types.EventModifyStreamingSwap.tx_id":
+		panic(fmt.Errorf("field tx_id of message types.EventModifyStreamingSwap is not mutable"))
+	case "types.EventModifyStreamingSwap.interval":
+		panic(fmt.Errorf("field interval of message types.EventModifyStreamingSwap is not mutable"))
+	case "types.EventModifyStreamingSwap.quantity":
+		panic(fmt.Errorf("field quantity of message types.EventModifyStreamingSwap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventModifyStreamingSwap"))
+		}
+		panic(fmt.Errorf("message types.EventModifyStreamingSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventModifyStreamingSwap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventModifyStreamingSwap.from":
+		return protoreflect.ValueOfString("")
+	case "types.EventModifyStreamingSwap.tx_id":
+		return protoreflect.ValueOfString("")
+	case "types.EventModifyStreamingSwap.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "types.EventModifyStreamingSwap.quantity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventModifyStreamingSwap"))
+		}
+		panic(fmt.Errorf("message types.EventModifyStreamingSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventModifyStreamingSwap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.EventModifyStreamingSwap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventModifyStreamingSwap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventModifyStreamingSwap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventModifyStreamingSwap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventModifyStreamingSwap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventModifyStreamingSwap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TxId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.Quantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quantity))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventModifyStreamingSwap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Quantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quantity))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.TxId) > 0 {
+			i -= len(x.TxId)
+			copy(dAtA[i:], x.TxId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventModifyStreamingSwap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventModifyStreamingSwap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventModifyStreamingSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				x.Quantity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Quantity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var _ protoreflect.List = (*_EventStreamingSwap_10_list)(nil)
 
 type _EventStreamingSwap_10_list struct {
@@ -2037,7 +2617,7 @@ func (x *EventStreamingSwap) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventStreamingSwap) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[3]
+	mi := &file_types_type_events_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3122,6 +3702,1050 @@ func (x *fastReflection_EventStreamingSwap) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_EventRecurringSwap              protoreflect.MessageDescriptor
+	fd_EventRecurringSwap_tx_id        protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_owner        protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_source_asset protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_target_asset protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_destination  protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_amount       protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_interval     protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_quantity     protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_count        protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_status       protoreflect.FieldDescriptor
+	fd_EventRecurringSwap_reason       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_type_events_proto_init()
+	md_EventRecurringSwap = File_types_type_events_proto.Messages().ByName("EventRecurringSwap")
+	fd_EventRecurringSwap_tx_id = md_EventRecurringSwap.Fields().ByName("tx_id")
+	fd_EventRecurringSwap_owner = md_EventRecurringSwap.Fields().ByName("owner")
+	fd_EventRecurringSwap_source_asset = md_EventRecurringSwap.Fields().ByName("source_asset")
+	fd_EventRecurringSwap_target_asset = md_EventRecurringSwap.Fields().ByName("target_asset")
+	fd_EventRecurringSwap_destination = md_EventRecurringSwap.Fields().ByName("destination")
+	fd_EventRecurringSwap_amount = md_EventRecurringSwap.Fields().ByName("amount")
+	fd_EventRecurringSwap_interval = md_EventRecurringSwap.Fields().ByName("interval")
+	fd_EventRecurringSwap_quantity = md_EventRecurringSwap.Fields().ByName("quantity")
+	fd_EventRecurringSwap_count = md_EventRecurringSwap.Fields().ByName("count")
+	fd_EventRecurringSwap_status = md_EventRecurringSwap.Fields().ByName("status")
+	fd_EventRecurringSwap_reason = md_EventRecurringSwap.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventRecurringSwap)(nil)
+
+type fastReflection_EventRecurringSwap EventRecurringSwap
+
+func (x *EventRecurringSwap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRecurringSwap)(x)
+}
+
+func (x *EventRecurringSwap) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventRecurringSwap_messageType fastReflection_EventRecurringSwap_messageType
+var _ protoreflect.MessageType = fastReflection_EventRecurringSwap_messageType{}
+
+type fastReflection_EventRecurringSwap_messageType struct{}
+
+func (x fastReflection_EventRecurringSwap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRecurringSwap)(nil)
+}
+func (x fastReflection_EventRecurringSwap_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRecurringSwap)
+}
+func (x fastReflection_EventRecurringSwap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRecurringSwap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRecurringSwap) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRecurringSwap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRecurringSwap) Type() protoreflect.MessageType {
+	return _fastReflection_EventRecurringSwap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRecurringSwap) New() protoreflect.Message {
+	return new(fastReflection_EventRecurringSwap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRecurringSwap) Interface() protoreflect.ProtoMessage {
+	return (*EventRecurringSwap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventRecurringSwap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TxId != "" {
+		value := protoreflect.ValueOfString(x.TxId)
+		if !f(fd_EventRecurringSwap_tx_id, value) {
+			return
+		}
+	}
+	if len(x.Owner) != 0 {
+		value := protoreflect.ValueOfBytes(x.Owner)
+		if !f(fd_EventRecurringSwap_owner, value) {
+			return
+		}
+	}
+	if x.SourceAsset != nil {
+		value := protoreflect.ValueOfMessage(x.SourceAsset.ProtoReflect())
+		if !f(fd_EventRecurringSwap_source_asset, value) {
+			return
+		}
+	}
+	if x.TargetAsset != nil {
+		value := protoreflect.ValueOfMessage(x.TargetAsset.ProtoReflect())
+		if !f(fd_EventRecurringSwap_target_asset, value) {
+			return
+		}
+	}
+	if x.Destination != "" {
+		value := protoreflect.ValueOfString(x.Destination)
+		if !f(fd_EventRecurringSwap_destination, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventRecurringSwap_amount, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_EventRecurringSwap_interval, value) {
+			return
+		}
+	}
+	if x.Quantity != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Quantity)
+		if !f(fd_EventRecurringSwap_quantity, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_EventRecurringSwap_count, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_EventRecurringSwap_status, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventRecurringSwap_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventRecurringSwap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.EventRecurringSwap.tx_id":
+		return x.TxId != ""
+	case "types.EventRecurringSwap.owner":
+		return len(x.Owner) != 0
+	case "types.EventRecurringSwap.source_asset":
+		return x.SourceAsset != nil
+	case "types.EventRecurringSwap.target_asset":
+		return x.TargetAsset != nil
+	case "types.EventRecurringSwap.destination":
+		return x.Destination != ""
+	case "types.EventRecurringSwap.amount":
+		return x.Amount != ""
+	case "types.EventRecurringSwap.interval":
+		return x.Interval != uint64(0)
+	case "types.EventRecurringSwap.quantity":
+		return x.Quantity != uint64(0)
+	case "types.EventRecurringSwap.count":
+		return x.Count != uint64(0)
+	case "types.EventRecurringSwap.status":
+		return x.Status != ""
+	case "types.EventRecurringSwap.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventRecurringSwap"))
+		}
+		panic(fmt.Errorf("message types.EventRecurringSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRecurringSwap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.EventRecurringSwap.tx_id":
+		x.TxId = ""
+	case "types.EventRecurringSwap.owner":
+		x.Owner = nil
+	case "types.EventRecurringSwap.source_asset":
+		x.SourceAsset = nil
+	case "types.EventRecurringSwap.target_asset":
+		x.TargetAsset = nil
+	case "types.EventRecurringSwap.destination":
+		x.Destination = ""
+	case "types.EventRecurringSwap.amount":
+		x.Amount = ""
+	case "types.EventRecurringSwap.interval":
+		x.Interval = uint64(0)
+	case "types.EventRecurringSwap.quantity":
+		x.Quantity = uint64(0)
+	case "types.EventRecurringSwap.count":
+		x.Count = uint64(0)
+	case "types.EventRecurringSwap.status":
+		x.Status = ""
+	case "types.EventRecurringSwap.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventRecurringSwap"))
+		}
+		panic(fmt.Errorf("message types.EventRecurringSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventRecurringSwap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.EventRecurringSwap.tx_id":
+		value := x.TxId
+		return protoreflect.ValueOfString(value)
+	case "types.EventRecurringSwap.owner":
+		value := x.Owner
+		return protoreflect.ValueOfBytes(value)
+	case "types.EventRecurringSwap.source_asset":
+		value := x.SourceAsset
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.EventRecurringSwap.target_asset":
+		value := x.TargetAsset
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.EventRecurringSwap.destination":
+		value := x.Destination
+		return protoreflect.ValueOfString(value)
+	case "types.EventRecurringSwap.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "types.EventRecurringSwap.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "types.EventRecurringSwap.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfUint64(value)
+	case "types.EventRecurringSwap.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	case "types.EventRecurringSwap.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "types.EventRecurringSwap.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventRecurringSwap"))
+		}
+		panic(fmt.Errorf("message types.EventRecurringSwap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRecurringSwap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.EventRecurringSwap.tx_id":
+		x.TxId = value.Interface().(string)
+	case "types.EventRecurringSwap.owner":
+		x.Owner = value.Bytes()
+	case "types.EventRecurringSwap.source_asset":
+		x.SourceAsset = value.Message().Interface().(*common.Asset)
+	case "types.EventRecurringSwap.target_asset":
+		x.TargetAsset = value.Message().Interface().(*common.Asset)
+	case "types.EventRecurringSwap.destination":
+		x.Destination = value.Interface().(string)
+	case "types.EventRecurringSwap.amount":
+		x.Amount = value.Interface().(string)
+	case "types.EventRecurringSwap.interval":
+		x.Interval = value.Uint()
+	case "types.EventRecurringSwap.quantity":
+		x.Quantity = value.Uint()
+	case "types.EventRecurringSwap.count":
+		x.Count = value.Uint()
+	case "types.EventRecurringSwap.status":
+		x.Status = value.Interface().(string)
+	case "types.EventRecurringSwap.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventRecurringSwap"))
+		}
+		panic(fmt.Errorf("message types.EventRecurringSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRecurringSwap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventRecurringSwap.source_asset":
+		if x.SourceAsset == nil {
+			x.SourceAsset = new(common.Asset)
+		}
+		return protoreflect.ValueOfMessage(x.SourceAsset.ProtoReflect())
+	case "types.EventRecurringSwap.target_asset":
+		if x.TargetAsset == nil {
+			x.TargetAsset = new(common.Asset)
+		}
+		return protoreflect.ValueOfMessage(x.TargetAsset.ProtoReflect())
+	case "types.EventRecurringSwap.tx_id":
+		panic(fmt.Errorf("field tx_id of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.owner":
+		panic(fmt.Errorf("field owner of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.destination":
+		panic(fmt.Errorf("field destination of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.amount":
+		panic(fmt.Errorf("field amount of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.interval":
+		panic(fmt.Errorf("field interval of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.quantity":
+		panic(fmt.Errorf("field quantity of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.count":
+		panic(fmt.Errorf("field count of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.status":
+		panic(fmt.Errorf("field status of message types.EventRecurringSwap is not mutable"))
+	case "types.EventRecurringSwap.reason":
+		panic(fmt.Errorf("field reason of message types.EventRecurringSwap is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventRecurringSwap"))
+		}
+		panic(fmt.Errorf("message types.EventRecurringSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventRecurringSwap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventRecurringSwap.tx_id":
+		return protoreflect.ValueOfString("")
+	case "types.EventRecurringSwap.owner":
+		return protoreflect.ValueOfBytes(nil)
+	case "types.EventRecurringSwap.source_asset":
+		m := new(common.Asset)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.EventRecurringSwap.target_asset":
+		m := new(common.Asset)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.EventRecurringSwap.destination":
+		return protoreflect.ValueOfString("")
+	case "types.EventRecurringSwap.amount":
+		return protoreflect.ValueOfString("")
+	case "types.EventRecurringSwap.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "types.EventRecurringSwap.quantity":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "types.EventRecurringSwap.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "types.EventRecurringSwap.status":
+		return protoreflect.ValueOfString("")
+	case "types.EventRecurringSwap.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventRecurringSwap"))
+		}
+		panic(fmt.Errorf("message types.EventRecurringSwap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventRecurringSwap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.EventRecurringSwap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventRecurringSwap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRecurringSwap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventRecurringSwap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventRecurringSwap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventRecurringSwap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SourceAsset != nil {
+			l = options.Size(x.SourceAsset)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TargetAsset != nil {
+			l = options.Size(x.TargetAsset)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Destination)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.Quantity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Quantity))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventRecurringSwap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Quantity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Quantity))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Destination) > 0 {
+			i -= len(x.Destination)
+			copy(dAtA[i:], x.Destination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.TargetAsset != nil {
+			encoded, err := options.Marshal(x.TargetAsset)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.SourceAsset != nil {
+			encoded, err := options.Marshal(x.SourceAsset)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.TxId) > 0 {
+			i -= len(x.TxId)
+			copy(dAtA[i:], x.TxId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventRecurringSwap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRecurringSwap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRecurringSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = append(x.Owner[:0], dAtA[iNdEx:postIndex]...)
+				if x.Owner == nil {
+					x.Owner = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SourceAsset == nil {
+					x.SourceAsset = &common.Asset{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SourceAsset); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TargetAsset == nil {
+					x.TargetAsset = &common.Asset{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TargetAsset); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Destination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				x.Quantity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Quantity |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_EventSwap                         protoreflect.MessageDescriptor
 	fd_EventSwap_pool                    protoreflect.FieldDescriptor
@@ -3164,7 +4788,7 @@ func (x *EventSwap) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSwap) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[4]
+	mi := &file_types_type_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4308,7 +5932,7 @@ func (x *EventAffiliateFee) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventAffiliateFee) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[5]
+	mi := &file_types_type_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5175,7 +6799,7 @@ func (x *EventAddLiquidity) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventAddLiquidity) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[6]
+	mi := &file_types_type_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6056,7 +7680,7 @@ func (x *EventWithdraw) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventWithdraw) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[7]
+	mi := &file_types_type_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6878,7 +8502,7 @@ func (x *EventPendingLiquidity) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventPendingLiquidity) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[8]
+	mi := &file_types_type_events_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7733,7 +9357,7 @@ func (x *EventDonate) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventDonate) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[9]
+	mi := &file_types_type_events_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8247,7 +9871,7 @@ func (x *EventPool) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventPool) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[10]
+	mi := &file_types_type_events_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8730,7 +10354,7 @@ func (x *PoolAmt) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PoolAmt) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[11]
+	mi := &file_types_type_events_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9270,7 +10894,7 @@ func (x *EventRewards) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRewards) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[12]
+	mi := &file_types_type_events_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9967,7 +11591,7 @@ func (x *EventRefund) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRefund) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[13]
+	mi := &file_types_type_events_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10595,7 +12219,7 @@ func (x *EventBond) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventBond) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[14]
+	mi := &file_types_type_events_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11274,7 +12898,7 @@ func (x *EventReBond) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventReBond) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[15]
+	mi := &file_types_type_events_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11969,7 +13593,7 @@ func (x *GasPool) ProtoReflect() protoreflect.Message {
 }
 
 func (x *GasPool) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[16]
+	mi := &file_types_type_events_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12625,7 +14249,7 @@ func (x *EventGas) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventGas) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[17]
+	mi := &file_types_type_events_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13070,7 +14694,7 @@ func (x *EventReserve) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventReserve) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[18]
+	mi := &file_types_type_events_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13582,7 +15206,7 @@ func (x *EventScheduledOutbound) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventScheduledOutbound) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[19]
+	mi := &file_types_type_events_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14019,7 +15643,7 @@ func (x *EventSecurity) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSecurity) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[20]
+	mi := &file_types_type_events_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14569,7 +16193,7 @@ func (x *EventSlash) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSlash) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[21]
+	mi := &file_types_type_events_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15142,7 +16766,7 @@ func (x *EventErrata) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventErrata) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[22]
+	mi := &file_types_type_events_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15651,7 +17275,7 @@ func (x *EventFee) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventFee) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[23]
+	mi := &file_types_type_events_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16212,7 +17836,7 @@ func (x *EventOutbound) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventOutbound) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[24]
+	mi := &file_types_type_events_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -16759,7 +18383,7 @@ func (x *EventTssKeygenSuccess) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventTssKeygenSuccess) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[25]
+	mi := &file_types_type_events_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17355,7 +18979,7 @@ func (x *EventTssKeygenFailure) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventTssKeygenFailure) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[26]
+	mi := &file_types_type_events_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -17992,28 +19616,496 @@ func (x *fastReflection_EventTssKeygenFailure) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_EventTssKeygenMetric                    protoreflect.MessageDescriptor
-	fd_EventTssKeygenMetric_pub_key            protoreflect.FieldDescriptor
-	fd_EventTssKeygenMetric_median_duration_ms protoreflect.FieldDescriptor
+	md_EventTssKeygenMetric                    protoreflect.MessageDescriptor
+	fd_EventTssKeygenMetric_pub_key            protoreflect.FieldDescriptor
+	fd_EventTssKeygenMetric_median_duration_ms protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_type_events_proto_init()
+	md_EventTssKeygenMetric = File_types_type_events_proto.Messages().ByName("EventTssKeygenMetric")
+	fd_EventTssKeygenMetric_pub_key = md_EventTssKeygenMetric.Fields().ByName("pub_key")
+	fd_EventTssKeygenMetric_median_duration_ms = md_EventTssKeygenMetric.Fields().ByName("median_duration_ms")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTssKeygenMetric)(nil)
+
+type fastReflection_EventTssKeygenMetric EventTssKeygenMetric
+
+func (x *EventTssKeygenMetric) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTssKeygenMetric)(x)
+}
+
+func (x *EventTssKeygenMetric) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTssKeygenMetric_messageType fastReflection_EventTssKeygenMetric_messageType
+var _ protoreflect.MessageType = fastReflection_EventTssKeygenMetric_messageType{}
+
+type fastReflection_EventTssKeygenMetric_messageType struct{}
+
+func (x fastReflection_EventTssKeygenMetric_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTssKeygenMetric)(nil)
+}
+func (x fastReflection_EventTssKeygenMetric_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTssKeygenMetric)
+}
+func (x fastReflection_EventTssKeygenMetric_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTssKeygenMetric
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTssKeygenMetric) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTssKeygenMetric
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTssKeygenMetric) Type() protoreflect.MessageType {
+	return _fastReflection_EventTssKeygenMetric_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTssKeygenMetric) New() protoreflect.Message {
+	return new(fastReflection_EventTssKeygenMetric)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTssKeygenMetric) Interface() protoreflect.ProtoMessage {
+	return (*EventTssKeygenMetric)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTssKeygenMetric) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PubKey != "" {
+		value := protoreflect.ValueOfString(x.PubKey)
+		if !f(fd_EventTssKeygenMetric_pub_key, value) {
+			return
+		}
+	}
+	if x.MedianDurationMs != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MedianDurationMs)
+		if !f(fd_EventTssKeygenMetric_median_duration_ms, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTssKeygenMetric) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.EventTssKeygenMetric.pub_key":
+		return x.PubKey != ""
+	case "types.EventTssKeygenMetric.median_duration_ms":
+		return x.MedianDurationMs != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+		}
+		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTssKeygenMetric) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.EventTssKeygenMetric.pub_key":
+		x.PubKey = ""
+	case "types.EventTssKeygenMetric.median_duration_ms":
+		x.MedianDurationMs = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+		}
+		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTssKeygenMetric) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.EventTssKeygenMetric.pub_key":
+		value := x.PubKey
+		return protoreflect.ValueOfString(value)
+	case "types.EventTssKeygenMetric.median_duration_ms":
+		value := x.MedianDurationMs
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+		}
+		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTssKeygenMetric) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.EventTssKeygenMetric.pub_key":
+		x.PubKey = value.Interface().(string)
+	case "types.EventTssKeygenMetric.median_duration_ms":
+		x.MedianDurationMs = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+		}
+		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTssKeygenMetric) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventTssKeygenMetric.pub_key":
+		panic(fmt.Errorf("field pub_key of message types.EventTssKeygenMetric is not mutable"))
+	case "types.EventTssKeygenMetric.median_duration_ms":
+		panic(fmt.Errorf("field median_duration_ms of message types.EventTssKeygenMetric is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+		}
+		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTssKeygenMetric) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventTssKeygenMetric.pub_key":
+		return protoreflect.ValueOfString("")
+	case "types.EventTssKeygenMetric.median_duration_ms":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+		}
+		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTssKeygenMetric) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.EventTssKeygenMetric", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTssKeygenMetric) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTssKeygenMetric) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTssKeygenMetric) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTssKeygenMetric)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MedianDurationMs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MedianDurationMs))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTssKeygenMetric)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MedianDurationMs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MedianDurationMs))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.PubKey) > 0 {
+			i -= len(x.PubKey)
+			copy(dAtA[i:], x.PubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTssKeygenMetric)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeygenMetric: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeygenMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MedianDurationMs", wireType)
+				}
+				x.MedianDurationMs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MedianDurationMs |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTssKeysignMetric                    protoreflect.MessageDescriptor
+	fd_EventTssKeysignMetric_tx_id              protoreflect.FieldDescriptor
+	fd_EventTssKeysignMetric_median_duration_ms protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventTssKeygenMetric = File_types_type_events_proto.Messages().ByName("EventTssKeygenMetric")
-	fd_EventTssKeygenMetric_pub_key = md_EventTssKeygenMetric.Fields().ByName("pub_key")
-	fd_EventTssKeygenMetric_median_duration_ms = md_EventTssKeygenMetric.Fields().ByName("median_duration_ms")
+	md_EventTssKeysignMetric = File_types_type_events_proto.Messages().ByName("EventTssKeysignMetric")
+	fd_EventTssKeysignMetric_tx_id = md_EventTssKeysignMetric.Fields().ByName("tx_id")
+	fd_EventTssKeysignMetric_median_duration_ms = md_EventTssKeysignMetric.Fields().ByName("median_duration_ms")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventTssKeygenMetric)(nil)
+var _ protoreflect.Message = (*fastReflection_EventTssKeysignMetric)(nil)
 
-type fastReflection_EventTssKeygenMetric EventTssKeygenMetric
+type fastReflection_EventTssKeysignMetric EventTssKeysignMetric
 
-func (x *EventTssKeygenMetric) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventTssKeygenMetric)(x)
+func (x *EventTssKeysignMetric) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTssKeysignMetric)(x)
 }
 
-func (x *EventTssKeygenMetric) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[27]
+func (x *EventTssKeysignMetric) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18024,43 +20116,43 @@ func (x *EventTssKeygenMetric) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventTssKeygenMetric_messageType fastReflection_EventTssKeygenMetric_messageType
-var _ protoreflect.MessageType = fastReflection_EventTssKeygenMetric_messageType{}
+var _fastReflection_EventTssKeysignMetric_messageType fastReflection_EventTssKeysignMetric_messageType
+var _ protoreflect.MessageType = fastReflection_EventTssKeysignMetric_messageType{}
 
-type fastReflection_EventTssKeygenMetric_messageType struct{}
+type fastReflection_EventTssKeysignMetric_messageType struct{}
 
-func (x fastReflection_EventTssKeygenMetric_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventTssKeygenMetric)(nil)
+func (x fastReflection_EventTssKeysignMetric_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTssKeysignMetric)(nil)
 }
-func (x fastReflection_EventTssKeygenMetric_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventTssKeygenMetric)
+func (x fastReflection_EventTssKeysignMetric_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTssKeysignMetric)
 }
-func (x fastReflection_EventTssKeygenMetric_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTssKeygenMetric
+func (x fastReflection_EventTssKeysignMetric_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTssKeysignMetric
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventTssKeygenMetric) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTssKeygenMetric
+func (x *fastReflection_EventTssKeysignMetric) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTssKeysignMetric
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventTssKeygenMetric) Type() protoreflect.MessageType {
-	return _fastReflection_EventTssKeygenMetric_messageType
+func (x *fastReflection_EventTssKeysignMetric) Type() protoreflect.MessageType {
+	return _fastReflection_EventTssKeysignMetric_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventTssKeygenMetric) New() protoreflect.Message {
-	return new(fastReflection_EventTssKeygenMetric)
+func (x *fastReflection_EventTssKeysignMetric) New() protoreflect.Message {
+	return new(fastReflection_EventTssKeysignMetric)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventTssKeygenMetric) Interface() protoreflect.ProtoMessage {
-	return (*EventTssKeygenMetric)(x)
+func (x *fastReflection_EventTssKeysignMetric) Interface() protoreflect.ProtoMessage {
+	return (*EventTssKeysignMetric)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -18068,16 +20160,16 @@ func (x *fastReflection_EventTssKeygenMetric) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventTssKeygenMetric) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PubKey != "" {
-		value := protoreflect.ValueOfString(x.PubKey)
-		if !f(fd_EventTssKeygenMetric_pub_key, value) {
+func (x *fastReflection_EventTssKeysignMetric) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TxId != "" {
+		value := protoreflect.ValueOfString(x.TxId)
+		if !f(fd_EventTssKeysignMetric_tx_id, value) {
 			return
 		}
 	}
 	if x.MedianDurationMs != int64(0) {
 		value := protoreflect.ValueOfInt64(x.MedianDurationMs)
-		if !f(fd_EventTssKeygenMetric_median_duration_ms, value) {
+		if !f(fd_EventTssKeysignMetric_median_duration_ms, value) {
 			return
 		}
 	}
@@ -18094,17 +20186,17 @@ func (x *fastReflection_EventTssKeygenMetric) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventTssKeygenMetric) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventTssKeysignMetric) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventTssKeygenMetric.pub_key":
-		return x.PubKey != ""
-	case "types.EventTssKeygenMetric.median_duration_ms":
+	case "types.EventTssKeysignMetric.tx_id":
+		return x.TxId != ""
+	case "types.EventTssKeysignMetric.median_duration_ms":
 		return x.MedianDurationMs != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -18114,17 +20206,17 @@ func (x *fastReflection_EventTssKeygenMetric) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeygenMetric) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventTssKeysignMetric) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventTssKeygenMetric.pub_key":
-		x.PubKey = ""
-	case "types.EventTssKeygenMetric.median_duration_ms":
+	case "types.EventTssKeysignMetric.tx_id":
+		x.TxId = ""
+	case "types.EventTssKeysignMetric.median_duration_ms":
 		x.MedianDurationMs = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -18134,19 +20226,19 @@ func (x *fastReflection_EventTssKeygenMetric) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventTssKeygenMetric) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTssKeysignMetric) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventTssKeygenMetric.pub_key":
-		value := x.PubKey
+	case "types.EventTssKeysignMetric.tx_id":
+		value := x.TxId
 		return protoreflect.ValueOfString(value)
-	case "types.EventTssKeygenMetric.median_duration_ms":
+	case "types.EventTssKeysignMetric.median_duration_ms":
 		value := x.MedianDurationMs
 		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -18160,17 +20252,17 @@ func (x *fastReflection_EventTssKeygenMetric) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeygenMetric) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventTssKeysignMetric) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventTssKeygenMetric.pub_key":
-		x.PubKey = value.Interface().(string)
-	case "types.EventTssKeygenMetric.median_duration_ms":
+	case "types.EventTssKeysignMetric.tx_id":
+		x.TxId = value.Interface().(string)
+	case "types.EventTssKeysignMetric.median_duration_ms":
 		x.MedianDurationMs = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -18184,44 +20276,44 @@ func (x *fastReflection_EventTssKeygenMetric) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeygenMetric) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTssKeysignMetric) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTssKeygenMetric.pub_key":
-		panic(fmt.Errorf("field pub_key of message types.EventTssKeygenMetric is not mutable"))
-	case "types.EventTssKeygenMetric.median_duration_ms":
-		panic(fmt.Errorf("field median_duration_ms of message types.EventTssKeygenMetric is not mutable"))
+	case "types.EventTssKeysignMetric.tx_id":
+		panic(fmt.Errorf("field tx_id of message types.EventTssKeysignMetric is not mutable"))
+	case "types.EventTssKeysignMetric.median_duration_ms":
+		panic(fmt.Errorf("field median_duration_ms of message types.EventTssKeysignMetric is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventTssKeygenMetric) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTssKeysignMetric) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTssKeygenMetric.pub_key":
+	case "types.EventTssKeysignMetric.tx_id":
 		return protoreflect.ValueOfString("")
-	case "types.EventTssKeygenMetric.median_duration_ms":
+	case "types.EventTssKeysignMetric.median_duration_ms":
 		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeygenMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeygenMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventTssKeygenMetric) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventTssKeysignMetric) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventTssKeygenMetric", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventTssKeysignMetric", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -18229,7 +20321,7 @@ func (x *fastReflection_EventTssKeygenMetric) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventTssKeygenMetric) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventTssKeysignMetric) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -18240,7 +20332,7 @@ func (x *fastReflection_EventTssKeygenMetric) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeygenMetric) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventTssKeysignMetric) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -18252,7 +20344,7 @@ func (x *fastReflection_EventTssKeygenMetric) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventTssKeygenMetric) IsValid() bool {
+func (x *fastReflection_EventTssKeysignMetric) IsValid() bool {
 	return x != nil
 }
 
@@ -18262,9 +20354,9 @@ func (x *fastReflection_EventTssKeygenMetric) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventTssKeygenMetric)
+		x := input.Message.Interface().(*EventTssKeysignMetric)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -18276,7 +20368,7 @@ func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.PubKey)
+		l = len(x.TxId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -18293,7 +20385,7 @@ func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventTssKeygenMetric)
+		x := input.Message.Interface().(*EventTssKeysignMetric)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -18317,10 +20409,10 @@ func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.PubKey) > 0 {
-			i -= len(x.PubKey)
-			copy(dAtA[i:], x.PubKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKey)))
+		if len(x.TxId) > 0 {
+			i -= len(x.TxId)
+			copy(dAtA[i:], x.TxId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -18335,7 +20427,7 @@ func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventTssKeygenMetric)
+		x := input.Message.Interface().(*EventTssKeysignMetric)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -18367,15 +20459,15 @@ func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeygenMetric: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeysignMetric: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeygenMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeysignMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -18403,7 +20495,7 @@ func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PubKey = string(dAtA[iNdEx:postIndex])
+				x.TxId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
@@ -18460,28 +20552,30 @@ func (x *fastReflection_EventTssKeygenMetric) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_EventTssKeysignMetric                    protoreflect.MessageDescriptor
-	fd_EventTssKeysignMetric_tx_id              protoreflect.FieldDescriptor
-	fd_EventTssKeysignMetric_median_duration_ms protoreflect.FieldDescriptor
+	md_EventSlashPoint              protoreflect.MessageDescriptor
+	fd_EventSlashPoint_node_address protoreflect.FieldDescriptor
+	fd_EventSlashPoint_slash_points protoreflect.FieldDescriptor
+	fd_EventSlashPoint_reason       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventTssKeysignMetric = File_types_type_events_proto.Messages().ByName("EventTssKeysignMetric")
-	fd_EventTssKeysignMetric_tx_id = md_EventTssKeysignMetric.Fields().ByName("tx_id")
-	fd_EventTssKeysignMetric_median_duration_ms = md_EventTssKeysignMetric.Fields().ByName("median_duration_ms")
+	md_EventSlashPoint = File_types_type_events_proto.Messages().ByName("EventSlashPoint")
+	fd_EventSlashPoint_node_address = md_EventSlashPoint.Fields().ByName("node_address")
+	fd_EventSlashPoint_slash_points = md_EventSlashPoint.Fields().ByName("slash_points")
+	fd_EventSlashPoint_reason = md_EventSlashPoint.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventTssKeysignMetric)(nil)
+var _ protoreflect.Message = (*fastReflection_EventSlashPoint)(nil)
 
-type fastReflection_EventTssKeysignMetric EventTssKeysignMetric
+type fastReflection_EventSlashPoint EventSlashPoint
 
-func (x *EventTssKeysignMetric) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventTssKeysignMetric)(x)
+func (x *EventSlashPoint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSlashPoint)(x)
 }
 
-func (x *EventTssKeysignMetric) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[28]
+func (x *EventSlashPoint) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18492,43 +20586,43 @@ func (x *EventTssKeysignMetric) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventTssKeysignMetric_messageType fastReflection_EventTssKeysignMetric_messageType
-var _ protoreflect.MessageType = fastReflection_EventTssKeysignMetric_messageType{}
+var _fastReflection_EventSlashPoint_messageType fastReflection_EventSlashPoint_messageType
+var _ protoreflect.MessageType = fastReflection_EventSlashPoint_messageType{}
 
-type fastReflection_EventTssKeysignMetric_messageType struct{}
+type fastReflection_EventSlashPoint_messageType struct{}
 
-func (x fastReflection_EventTssKeysignMetric_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventTssKeysignMetric)(nil)
+func (x fastReflection_EventSlashPoint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSlashPoint)(nil)
 }
-func (x fastReflection_EventTssKeysignMetric_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventTssKeysignMetric)
+func (x fastReflection_EventSlashPoint_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSlashPoint)
 }
-func (x fastReflection_EventTssKeysignMetric_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTssKeysignMetric
+func (x fastReflection_EventSlashPoint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSlashPoint
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventTssKeysignMetric) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTssKeysignMetric
+func (x *fastReflection_EventSlashPoint) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSlashPoint
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventTssKeysignMetric) Type() protoreflect.MessageType {
-	return _fastReflection_EventTssKeysignMetric_messageType
+func (x *fastReflection_EventSlashPoint) Type() protoreflect.MessageType {
+	return _fastReflection_EventSlashPoint_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventTssKeysignMetric) New() protoreflect.Message {
-	return new(fastReflection_EventTssKeysignMetric)
+func (x *fastReflection_EventSlashPoint) New() protoreflect.Message {
+	return new(fastReflection_EventSlashPoint)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventTssKeysignMetric) Interface() protoreflect.ProtoMessage {
-	return (*EventTssKeysignMetric)(x)
+func (x *fastReflection_EventSlashPoint) Interface() protoreflect.ProtoMessage {
+	return (*EventSlashPoint)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -18536,16 +20630,22 @@ func (x *fastReflection_EventTssKeysignMetric) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventTssKeysignMetric) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.TxId != "" {
-		value := protoreflect.ValueOfString(x.TxId)
-		if !f(fd_EventTssKeysignMetric_tx_id, value) {
+func (x *fastReflection_EventSlashPoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.NodeAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.NodeAddress)
+		if !f(fd_EventSlashPoint_node_address, value) {
 			return
 		}
 	}
-	if x.MedianDurationMs != int64(0) {
-		value := protoreflect.ValueOfInt64(x.MedianDurationMs)
-		if !f(fd_EventTssKeysignMetric_median_duration_ms, value) {
+	if x.SlashPoints != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SlashPoints)
+		if !f(fd_EventSlashPoint_slash_points, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventSlashPoint_reason, value) {
 			return
 		}
 	}
@@ -18562,17 +20662,19 @@ func (x *fastReflection_EventTssKeysignMetric) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventTssKeysignMetric) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventSlashPoint) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventTssKeysignMetric.tx_id":
-		return x.TxId != ""
-	case "types.EventTssKeysignMetric.median_duration_ms":
-		return x.MedianDurationMs != int64(0)
+	case "types.EventSlashPoint.node_address":
+		return len(x.NodeAddress) != 0
+	case "types.EventSlashPoint.slash_points":
+		return x.SlashPoints != int64(0)
+	case "types.EventSlashPoint.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -18582,17 +20684,19 @@ func (x *fastReflection_EventTssKeysignMetric) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeysignMetric) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventSlashPoint) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventTssKeysignMetric.tx_id":
-		x.TxId = ""
-	case "types.EventTssKeysignMetric.median_duration_ms":
-		x.MedianDurationMs = int64(0)
+	case "types.EventSlashPoint.node_address":
+		x.NodeAddress = nil
+	case "types.EventSlashPoint.slash_points":
+		x.SlashPoints = int64(0)
+	case "types.EventSlashPoint.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -18602,19 +20706,22 @@ func (x *fastReflection_EventTssKeysignMetric) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventTssKeysignMetric) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSlashPoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventTssKeysignMetric.tx_id":
-		value := x.TxId
-		return protoreflect.ValueOfString(value)
-	case "types.EventTssKeysignMetric.median_duration_ms":
-		value := x.MedianDurationMs
+	case "types.EventSlashPoint.node_address":
+		value := x.NodeAddress
+		return protoreflect.ValueOfBytes(value)
+	case "types.EventSlashPoint.slash_points":
+		value := x.SlashPoints
 		return protoreflect.ValueOfInt64(value)
+	case "types.EventSlashPoint.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -18628,17 +20735,19 @@ func (x *fastReflection_EventTssKeysignMetric) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeysignMetric) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventSlashPoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventTssKeysignMetric.tx_id":
-		x.TxId = value.Interface().(string)
-	case "types.EventTssKeysignMetric.median_duration_ms":
-		x.MedianDurationMs = value.Int()
+	case "types.EventSlashPoint.node_address":
+		x.NodeAddress = value.Bytes()
+	case "types.EventSlashPoint.slash_points":
+		x.SlashPoints = value.Int()
+	case "types.EventSlashPoint.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -18652,44 +20761,48 @@ func (x *fastReflection_EventTssKeysignMetric) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeysignMetric) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSlashPoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTssKeysignMetric.tx_id":
-		panic(fmt.Errorf("field tx_id of message types.EventTssKeysignMetric is not mutable"))
-	case "types.EventTssKeysignMetric.median_duration_ms":
-		panic(fmt.Errorf("field median_duration_ms of message types.EventTssKeysignMetric is not mutable"))
+	case "types.EventSlashPoint.node_address":
+		panic(fmt.Errorf("field node_address of message types.EventSlashPoint is not mutable"))
+	case "types.EventSlashPoint.slash_points":
+		panic(fmt.Errorf("field slash_points of message types.EventSlashPoint is not mutable"))
+	case "types.EventSlashPoint.reason":
+		panic(fmt.Errorf("field reason of message types.EventSlashPoint is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventTssKeysignMetric) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventSlashPoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTssKeysignMetric.tx_id":
-		return protoreflect.ValueOfString("")
-	case "types.EventTssKeysignMetric.median_duration_ms":
+	case "types.EventSlashPoint.node_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "types.EventSlashPoint.slash_points":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "types.EventSlashPoint.reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTssKeysignMetric"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
 		}
-		panic(fmt.Errorf("message types.EventTssKeysignMetric does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventTssKeysignMetric) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventSlashPoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventTssKeysignMetric", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventSlashPoint", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -18697,7 +20810,7 @@ func (x *fastReflection_EventTssKeysignMetric) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventTssKeysignMetric) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventSlashPoint) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -18708,7 +20821,7 @@ func (x *fastReflection_EventTssKeysignMetric) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTssKeysignMetric) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventSlashPoint) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -18720,7 +20833,7 @@ func (x *fastReflection_EventTssKeysignMetric) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventTssKeysignMetric) IsValid() bool {
+func (x *fastReflection_EventSlashPoint) IsValid() bool {
 	return x != nil
 }
 
@@ -18730,9 +20843,9 @@ func (x *fastReflection_EventTssKeysignMetric) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventTssKeysignMetric)
+		x := input.Message.Interface().(*EventSlashPoint)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -18744,12 +20857,16 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.TxId)
+		l = len(x.NodeAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MedianDurationMs != 0 {
-			n += 1 + runtime.Sov(uint64(x.MedianDurationMs))
+		if x.SlashPoints != 0 {
+			n += 1 + runtime.Sov(uint64(x.SlashPoints))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -18761,7 +20878,7 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventTssKeysignMetric)
+		x := input.Message.Interface().(*EventSlashPoint)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -18780,15 +20897,22 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MedianDurationMs != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MedianDurationMs))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SlashPoints != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashPoints))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.TxId) > 0 {
-			i -= len(x.TxId)
-			copy(dAtA[i:], x.TxId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxId)))
+		if len(x.NodeAddress) > 0 {
+			i -= len(x.NodeAddress)
+			copy(dAtA[i:], x.NodeAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NodeAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -18803,7 +20927,7 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventTssKeysignMetric)
+		x := input.Message.Interface().(*EventSlashPoint)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -18835,17 +20959,17 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeysignMetric: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSlashPoint: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTssKeysignMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSlashPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -18855,29 +20979,31 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxId = string(dAtA[iNdEx:postIndex])
+				x.NodeAddress = append(x.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.NodeAddress == nil {
+					x.NodeAddress = []byte{}
+				}
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MedianDurationMs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashPoints", wireType)
 				}
-				x.MedianDurationMs = 0
+				x.SlashPoints = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -18887,11 +21013,43 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MedianDurationMs |= int64(b&0x7F) << shift
+					x.SlashPoints |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -18928,30 +21086,28 @@ func (x *fastReflection_EventTssKeysignMetric) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_EventSlashPoint              protoreflect.MessageDescriptor
-	fd_EventSlashPoint_node_address protoreflect.FieldDescriptor
-	fd_EventSlashPoint_slash_points protoreflect.FieldDescriptor
-	fd_EventSlashPoint_reason       protoreflect.FieldDescriptor
+	md_EventPoolBalanceChanged             protoreflect.MessageDescriptor
+	fd_EventPoolBalanceChanged_pool_change protoreflect.FieldDescriptor
+	fd_EventPoolBalanceChanged_reason      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventSlashPoint = File_types_type_events_proto.Messages().ByName("EventSlashPoint")
-	fd_EventSlashPoint_node_address = md_EventSlashPoint.Fields().ByName("node_address")
-	fd_EventSlashPoint_slash_points = md_EventSlashPoint.Fields().ByName("slash_points")
-	fd_EventSlashPoint_reason = md_EventSlashPoint.Fields().ByName("reason")
+	md_EventPoolBalanceChanged = File_types_type_events_proto.Messages().ByName("EventPoolBalanceChanged")
+	fd_EventPoolBalanceChanged_pool_change = md_EventPoolBalanceChanged.Fields().ByName("pool_change")
+	fd_EventPoolBalanceChanged_reason = md_EventPoolBalanceChanged.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventSlashPoint)(nil)
+var _ protoreflect.Message = (*fastReflection_EventPoolBalanceChanged)(nil)
 
-type fastReflection_EventSlashPoint EventSlashPoint
+type fastReflection_EventPoolBalanceChanged EventPoolBalanceChanged
 
-func (x *EventSlashPoint) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventSlashPoint)(x)
+func (x *EventPoolBalanceChanged) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventPoolBalanceChanged)(x)
 }
 
-func (x *EventSlashPoint) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[29]
+func (x *EventPoolBalanceChanged) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -18962,43 +21118,43 @@ func (x *EventSlashPoint) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventSlashPoint_messageType fastReflection_EventSlashPoint_messageType
-var _ protoreflect.MessageType = fastReflection_EventSlashPoint_messageType{}
+var _fastReflection_EventPoolBalanceChanged_messageType fastReflection_EventPoolBalanceChanged_messageType
+var _ protoreflect.MessageType = fastReflection_EventPoolBalanceChanged_messageType{}
 
-type fastReflection_EventSlashPoint_messageType struct{}
+type fastReflection_EventPoolBalanceChanged_messageType struct{}
 
-func (x fastReflection_EventSlashPoint_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventSlashPoint)(nil)
+func (x fastReflection_EventPoolBalanceChanged_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventPoolBalanceChanged)(nil)
 }
-func (x fastReflection_EventSlashPoint_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventSlashPoint)
+func (x fastReflection_EventPoolBalanceChanged_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventPoolBalanceChanged)
 }
-func (x fastReflection_EventSlashPoint_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSlashPoint
+func (x fastReflection_EventPoolBalanceChanged_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPoolBalanceChanged
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventSlashPoint) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSlashPoint
+func (x *fastReflection_EventPoolBalanceChanged) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPoolBalanceChanged
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventSlashPoint) Type() protoreflect.MessageType {
-	return _fastReflection_EventSlashPoint_messageType
+func (x *fastReflection_EventPoolBalanceChanged) Type() protoreflect.MessageType {
+	return _fastReflection_EventPoolBalanceChanged_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventSlashPoint) New() protoreflect.Message {
-	return new(fastReflection_EventSlashPoint)
+func (x *fastReflection_EventPoolBalanceChanged) New() protoreflect.Message {
+	return new(fastReflection_EventPoolBalanceChanged)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventSlashPoint) Interface() protoreflect.ProtoMessage {
-	return (*EventSlashPoint)(x)
+func (x *fastReflection_EventPoolBalanceChanged) Interface() protoreflect.ProtoMessage {
+	return (*EventPoolBalanceChanged)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -19006,22 +21162,16 @@ func (x *fastReflection_EventSlashPoint) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventSlashPoint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.NodeAddress) != 0 {
-		value := protoreflect.ValueOfBytes(x.NodeAddress)
-		if !f(fd_EventSlashPoint_node_address, value) {
-			return
-		}
-	}
-	if x.SlashPoints != int64(0) {
-		value := protoreflect.ValueOfInt64(x.SlashPoints)
-		if !f(fd_EventSlashPoint_slash_points, value) {
+func (x *fastReflection_EventPoolBalanceChanged) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PoolChange != nil {
+		value := protoreflect.ValueOfMessage(x.PoolChange.ProtoReflect())
+		if !f(fd_EventPoolBalanceChanged_pool_change, value) {
 			return
 		}
 	}
 	if x.Reason != "" {
 		value := protoreflect.ValueOfString(x.Reason)
-		if !f(fd_EventSlashPoint_reason, value) {
+		if !f(fd_EventPoolBalanceChanged_reason, value) {
 			return
 		}
 	}
@@ -19038,19 +21188,17 @@ func (x *fastReflection_EventSlashPoint) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventSlashPoint) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventPoolBalanceChanged) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventSlashPoint.node_address":
-		return len(x.NodeAddress) != 0
-	case "types.EventSlashPoint.slash_points":
-		return x.SlashPoints != int64(0)
-	case "types.EventSlashPoint.reason":
+	case "types.EventPoolBalanceChanged.pool_change":
+		return x.PoolChange != nil
+	case "types.EventPoolBalanceChanged.reason":
 		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
 		}
-		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -19060,19 +21208,17 @@ func (x *fastReflection_EventSlashPoint) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSlashPoint) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventPoolBalanceChanged) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventSlashPoint.node_address":
-		x.NodeAddress = nil
-	case "types.EventSlashPoint.slash_points":
-		x.SlashPoints = int64(0)
-	case "types.EventSlashPoint.reason":
+	case "types.EventPoolBalanceChanged.pool_change":
+		x.PoolChange = nil
+	case "types.EventPoolBalanceChanged.reason":
 		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
 		}
-		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -19082,22 +21228,19 @@ func (x *fastReflection_EventSlashPoint) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventSlashPoint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventPoolBalanceChanged) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventSlashPoint.node_address":
-		value := x.NodeAddress
-		return protoreflect.ValueOfBytes(value)
-	case "types.EventSlashPoint.slash_points":
-		value := x.SlashPoints
-		return protoreflect.ValueOfInt64(value)
-	case "types.EventSlashPoint.reason":
+	case "types.EventPoolBalanceChanged.pool_change":
+		value := x.PoolChange
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.EventPoolBalanceChanged.reason":
 		value := x.Reason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
 		}
-		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -19111,19 +21254,17 @@ func (x *fastReflection_EventSlashPoint) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSlashPoint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventPoolBalanceChanged) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventSlashPoint.node_address":
-		x.NodeAddress = value.Bytes()
-	case "types.EventSlashPoint.slash_points":
-		x.SlashPoints = value.Int()
-	case "types.EventSlashPoint.reason":
+	case "types.EventPoolBalanceChanged.pool_change":
+		x.PoolChange = value.Message().Interface().(*PoolMod)
+	case "types.EventPoolBalanceChanged.reason":
 		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
 		}
-		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -19137,48 +21278,48 @@ func (x *fastReflection_EventSlashPoint) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSlashPoint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventPoolBalanceChanged) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventSlashPoint.node_address":
-		panic(fmt.Errorf("field node_address of message types.EventSlashPoint is not mutable"))
-	case "types.EventSlashPoint.slash_points":
-		panic(fmt.Errorf("field slash_points of message types.EventSlashPoint is not mutable"))
-	case "types.EventSlashPoint.reason":
-		panic(fmt.Errorf("field reason of message types.EventSlashPoint is not mutable"))
+	case "types.EventPoolBalanceChanged.pool_change":
+		if x.PoolChange == nil {
+			x.PoolChange = new(PoolMod)
+		}
+		return protoreflect.ValueOfMessage(x.PoolChange.ProtoReflect())
+	case "types.EventPoolBalanceChanged.reason":
+		panic(fmt.Errorf("field reason of message types.EventPoolBalanceChanged is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
 		}
-		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventSlashPoint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventPoolBalanceChanged) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventSlashPoint.node_address":
-		return protoreflect.ValueOfBytes(nil)
-	case "types.EventSlashPoint.slash_points":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "types.EventSlashPoint.reason":
+	case "types.EventPoolBalanceChanged.pool_change":
+		m := new(PoolMod)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.EventPoolBalanceChanged.reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventSlashPoint"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
 		}
-		panic(fmt.Errorf("message types.EventSlashPoint does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventSlashPoint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventPoolBalanceChanged) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventSlashPoint", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventPoolBalanceChanged", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -19186,7 +21327,7 @@ func (x *fastReflection_EventSlashPoint) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventSlashPoint) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventPoolBalanceChanged) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -19197,7 +21338,7 @@ func (x *fastReflection_EventSlashPoint) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSlashPoint) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventPoolBalanceChanged) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -19209,7 +21350,7 @@ func (x *fastReflection_EventSlashPoint) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventSlashPoint) IsValid() bool {
+func (x *fastReflection_EventPoolBalanceChanged) IsValid() bool {
 	return x != nil
 }
 
@@ -19219,9 +21360,9 @@ func (x *fastReflection_EventSlashPoint) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventSlashPoint)
+		x := input.Message.Interface().(*EventPoolBalanceChanged)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -19233,13 +21374,10 @@ func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.NodeAddress)
-		if l > 0 {
+		if x.PoolChange != nil {
+			l = options.Size(x.PoolChange)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SlashPoints != 0 {
-			n += 1 + runtime.Sov(uint64(x.SlashPoints))
-		}
 		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -19254,7 +21392,7 @@ func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventSlashPoint)
+		x := input.Message.Interface().(*EventPoolBalanceChanged)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -19278,17 +21416,19 @@ func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.Reason)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if x.SlashPoints != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SlashPoints))
-			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.NodeAddress) > 0 {
-			i -= len(x.NodeAddress)
-			copy(dAtA[i:], x.NodeAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NodeAddress)))
+		if x.PoolChange != nil {
+			encoded, err := options.Marshal(x.PoolChange)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -19303,7 +21443,7 @@ func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventSlashPoint)
+		x := input.Message.Interface().(*EventPoolBalanceChanged)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -19335,17 +21475,17 @@ func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSlashPoint: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPoolBalanceChanged: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSlashPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPoolBalanceChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolChange", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -19355,46 +21495,29 @@ func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NodeAddress = append(x.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
-				if x.NodeAddress == nil {
-					x.NodeAddress = []byte{}
+				if x.PoolChange == nil {
+					x.PoolChange = &PoolMod{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PoolChange); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashPoints", wireType)
-				}
-				x.SlashPoints = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.SlashPoints |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
@@ -19462,28 +21585,32 @@ func (x *fastReflection_EventSlashPoint) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventPoolBalanceChanged             protoreflect.MessageDescriptor
-	fd_EventPoolBalanceChanged_pool_change protoreflect.FieldDescriptor
-	fd_EventPoolBalanceChanged_reason      protoreflect.FieldDescriptor
+	md_EventMintBurn        protoreflect.MessageDescriptor
+	fd_EventMintBurn_supply protoreflect.FieldDescriptor
+	fd_EventMintBurn_denom  protoreflect.FieldDescriptor
+	fd_EventMintBurn_amount protoreflect.FieldDescriptor
+	fd_EventMintBurn_reason protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventPoolBalanceChanged = File_types_type_events_proto.Messages().ByName("EventPoolBalanceChanged")
-	fd_EventPoolBalanceChanged_pool_change = md_EventPoolBalanceChanged.Fields().ByName("pool_change")
-	fd_EventPoolBalanceChanged_reason = md_EventPoolBalanceChanged.Fields().ByName("reason")
+	md_EventMintBurn = File_types_type_events_proto.Messages().ByName("EventMintBurn")
+	fd_EventMintBurn_supply = md_EventMintBurn.Fields().ByName("supply")
+	fd_EventMintBurn_denom = md_EventMintBurn.Fields().ByName("denom")
+	fd_EventMintBurn_amount = md_EventMintBurn.Fields().ByName("amount")
+	fd_EventMintBurn_reason = md_EventMintBurn.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventPoolBalanceChanged)(nil)
+var _ protoreflect.Message = (*fastReflection_EventMintBurn)(nil)
 
-type fastReflection_EventPoolBalanceChanged EventPoolBalanceChanged
+type fastReflection_EventMintBurn EventMintBurn
 
-func (x *EventPoolBalanceChanged) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventPoolBalanceChanged)(x)
+func (x *EventMintBurn) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventMintBurn)(x)
 }
 
-func (x *EventPoolBalanceChanged) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[30]
+func (x *EventMintBurn) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19494,43 +21621,43 @@ func (x *EventPoolBalanceChanged) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventPoolBalanceChanged_messageType fastReflection_EventPoolBalanceChanged_messageType
-var _ protoreflect.MessageType = fastReflection_EventPoolBalanceChanged_messageType{}
+var _fastReflection_EventMintBurn_messageType fastReflection_EventMintBurn_messageType
+var _ protoreflect.MessageType = fastReflection_EventMintBurn_messageType{}
 
-type fastReflection_EventPoolBalanceChanged_messageType struct{}
+type fastReflection_EventMintBurn_messageType struct{}
 
-func (x fastReflection_EventPoolBalanceChanged_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventPoolBalanceChanged)(nil)
+func (x fastReflection_EventMintBurn_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventMintBurn)(nil)
 }
-func (x fastReflection_EventPoolBalanceChanged_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventPoolBalanceChanged)
+func (x fastReflection_EventMintBurn_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventMintBurn)
 }
-func (x fastReflection_EventPoolBalanceChanged_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventPoolBalanceChanged
+func (x fastReflection_EventMintBurn_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMintBurn
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventPoolBalanceChanged) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventPoolBalanceChanged
+func (x *fastReflection_EventMintBurn) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMintBurn
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventPoolBalanceChanged) Type() protoreflect.MessageType {
-	return _fastReflection_EventPoolBalanceChanged_messageType
+func (x *fastReflection_EventMintBurn) Type() protoreflect.MessageType {
+	return _fastReflection_EventMintBurn_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventPoolBalanceChanged) New() protoreflect.Message {
-	return new(fastReflection_EventPoolBalanceChanged)
+func (x *fastReflection_EventMintBurn) New() protoreflect.Message {
+	return new(fastReflection_EventMintBurn)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventPoolBalanceChanged) Interface() protoreflect.ProtoMessage {
-	return (*EventPoolBalanceChanged)(x)
+func (x *fastReflection_EventMintBurn) Interface() protoreflect.ProtoMessage {
+	return (*EventMintBurn)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -19538,16 +21665,28 @@ func (x *fastReflection_EventPoolBalanceChanged) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventPoolBalanceChanged) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PoolChange != nil {
-		value := protoreflect.ValueOfMessage(x.PoolChange.ProtoReflect())
-		if !f(fd_EventPoolBalanceChanged_pool_change, value) {
+func (x *fastReflection_EventMintBurn) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Supply != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Supply))
+		if !f(fd_EventMintBurn_supply, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_EventMintBurn_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventMintBurn_amount, value) {
 			return
 		}
 	}
 	if x.Reason != "" {
 		value := protoreflect.ValueOfString(x.Reason)
-		if !f(fd_EventPoolBalanceChanged_reason, value) {
+		if !f(fd_EventMintBurn_reason, value) {
 			return
 		}
 	}
@@ -19564,17 +21703,21 @@ func (x *fastReflection_EventPoolBalanceChanged) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventPoolBalanceChanged) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventMintBurn) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventPoolBalanceChanged.pool_change":
-		return x.PoolChange != nil
-	case "types.EventPoolBalanceChanged.reason":
+	case "types.EventMintBurn.supply":
+		return x.Supply != 0
+	case "types.EventMintBurn.denom":
+		return x.Denom != ""
+	case "types.EventMintBurn.amount":
+		return x.Amount != ""
+	case "types.EventMintBurn.reason":
 		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
 		}
-		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -19584,17 +21727,21 @@ func (x *fastReflection_EventPoolBalanceChanged) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPoolBalanceChanged) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventMintBurn) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventPoolBalanceChanged.pool_change":
-		x.PoolChange = nil
-	case "types.EventPoolBalanceChanged.reason":
+	case "types.EventMintBurn.supply":
+		x.Supply = 0
+	case "types.EventMintBurn.denom":
+		x.Denom = ""
+	case "types.EventMintBurn.amount":
+		x.Amount = ""
+	case "types.EventMintBurn.reason":
 		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
 		}
-		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -19604,19 +21751,25 @@ func (x *fastReflection_EventPoolBalanceChanged) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventPoolBalanceChanged) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventMintBurn) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventPoolBalanceChanged.pool_change":
-		value := x.PoolChange
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "types.EventPoolBalanceChanged.reason":
+	case "types.EventMintBurn.supply":
+		value := x.Supply
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "types.EventMintBurn.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "types.EventMintBurn.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "types.EventMintBurn.reason":
 		value := x.Reason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
 		}
-		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -19630,17 +21783,21 @@ func (x *fastReflection_EventPoolBalanceChanged) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPoolBalanceChanged) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventMintBurn) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventPoolBalanceChanged.pool_change":
-		x.PoolChange = value.Message().Interface().(*PoolMod)
-	case "types.EventPoolBalanceChanged.reason":
+	case "types.EventMintBurn.supply":
+		x.Supply = (MintBurnSupplyType)(value.Enum())
+	case "types.EventMintBurn.denom":
+		x.Denom = value.Interface().(string)
+	case "types.EventMintBurn.amount":
+		x.Amount = value.Interface().(string)
+	case "types.EventMintBurn.reason":
 		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
 		}
-		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -19654,48 +21811,52 @@ func (x *fastReflection_EventPoolBalanceChanged) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPoolBalanceChanged) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventMintBurn) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventPoolBalanceChanged.pool_change":
-		if x.PoolChange == nil {
-			x.PoolChange = new(PoolMod)
-		}
-		return protoreflect.ValueOfMessage(x.PoolChange.ProtoReflect())
-	case "types.EventPoolBalanceChanged.reason":
-		panic(fmt.Errorf("field reason of message types.EventPoolBalanceChanged is not mutable"))
+	case "types.EventMintBurn.supply":
+		panic(fmt.Errorf("field supply of message types.EventMintBurn is not mutable"))
+	case "types.EventMintBurn.denom":
+		panic(fmt.Errorf("field denom of message types.EventMintBurn is not mutable"))
+	case "types.EventMintBurn.amount":
+		panic(fmt.Errorf("field amount of message types.EventMintBurn is not mutable"))
+	case "types.EventMintBurn.reason":
+		panic(fmt.Errorf("field reason of message types.EventMintBurn is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
 		}
-		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventPoolBalanceChanged) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventMintBurn) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventPoolBalanceChanged.pool_change":
-		m := new(PoolMod)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "types.EventPoolBalanceChanged.reason":
+	case "types.EventMintBurn.supply":
+		return protoreflect.ValueOfEnum(0)
+	case "types.EventMintBurn.denom":
+		return protoreflect.ValueOfString("")
+	case "types.EventMintBurn.amount":
+		return protoreflect.ValueOfString("")
+	case "types.EventMintBurn.reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPoolBalanceChanged"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
 		}
-		panic(fmt.Errorf("message types.EventPoolBalanceChanged does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventPoolBalanceChanged) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventMintBurn) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventPoolBalanceChanged", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventMintBurn", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -19703,7 +21864,7 @@ func (x *fastReflection_EventPoolBalanceChanged) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventPoolBalanceChanged) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventMintBurn) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -19714,7 +21875,7 @@ func (x *fastReflection_EventPoolBalanceChanged) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventPoolBalanceChanged) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventMintBurn) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -19726,7 +21887,7 @@ func (x *fastReflection_EventPoolBalanceChanged) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventPoolBalanceChanged) IsValid() bool {
+func (x *fastReflection_EventMintBurn) IsValid() bool {
 	return x != nil
 }
 
@@ -19736,9 +21897,9 @@ func (x *fastReflection_EventPoolBalanceChanged) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventPoolBalanceChanged)
+		x := input.Message.Interface().(*EventMintBurn)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -19750,8 +21911,15 @@ func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.PoolChange != nil {
-			l = options.Size(x.PoolChange)
+		if x.Supply != 0 {
+			n += 1 + runtime.Sov(uint64(x.Supply))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Reason)
@@ -19768,7 +21936,7 @@ func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventPoolBalanceChanged)
+		x := input.Message.Interface().(*EventMintBurn)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -19792,21 +21960,26 @@ func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Meth
 			copy(dAtA[i:], x.Reason)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if x.PoolChange != nil {
-			encoded, err := options.Marshal(x.PoolChange)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.Supply != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Supply))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -19819,7 +21992,7 @@ func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventPoolBalanceChanged)
+		x := input.Message.Interface().(*EventMintBurn)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -19851,17 +22024,36 @@ func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPoolBalanceChanged: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMintBurn: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPoolBalanceChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMintBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				}
+				x.Supply = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Supply |= MintBurnSupplyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PoolChange", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -19871,29 +22063,57 @@ func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.PoolChange == nil {
-					x.PoolChange = &PoolMod{}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PoolChange); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
@@ -19961,32 +22181,34 @@ func (x *fastReflection_EventPoolBalanceChanged) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_EventMintBurn        protoreflect.MessageDescriptor
-	fd_EventMintBurn_supply protoreflect.FieldDescriptor
-	fd_EventMintBurn_denom  protoreflect.FieldDescriptor
-	fd_EventMintBurn_amount protoreflect.FieldDescriptor
-	fd_EventMintBurn_reason protoreflect.FieldDescriptor
+	md_EventTradeAccountDeposit               protoreflect.MessageDescriptor
+	fd_EventTradeAccountDeposit_amount        protoreflect.FieldDescriptor
+	fd_EventTradeAccountDeposit_asset         protoreflect.FieldDescriptor
+	fd_EventTradeAccountDeposit_asset_address protoreflect.FieldDescriptor
+	fd_EventTradeAccountDeposit_rune_address  protoreflect.FieldDescriptor
+	fd_EventTradeAccountDeposit_tx_id         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventMintBurn = File_types_type_events_proto.Messages().ByName("EventMintBurn")
-	fd_EventMintBurn_supply = md_EventMintBurn.Fields().ByName("supply")
-	fd_EventMintBurn_denom = md_EventMintBurn.Fields().ByName("denom")
-	fd_EventMintBurn_amount = md_EventMintBurn.Fields().ByName("amount")
-	fd_EventMintBurn_reason = md_EventMintBurn.Fields().ByName("reason")
+	md_EventTradeAccountDeposit = File_types_type_events_proto.Messages().ByName("EventTradeAccountDeposit")
+	fd_EventTradeAccountDeposit_amount = md_EventTradeAccountDeposit.Fields().ByName("amount")
+	fd_EventTradeAccountDeposit_asset = md_EventTradeAccountDeposit.Fields().ByName("asset")
+	fd_EventTradeAccountDeposit_asset_address = md_EventTradeAccountDeposit.Fields().ByName("asset_address")
+	fd_EventTradeAccountDeposit_rune_address = md_EventTradeAccountDeposit.Fields().ByName("rune_address")
+	fd_EventTradeAccountDeposit_tx_id = md_EventTradeAccountDeposit.Fields().ByName("tx_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventMintBurn)(nil)
+var _ protoreflect.Message = (*fastReflection_EventTradeAccountDeposit)(nil)
 
-type fastReflection_EventMintBurn EventMintBurn
+type fastReflection_EventTradeAccountDeposit EventTradeAccountDeposit
 
-func (x *EventMintBurn) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventMintBurn)(x)
+func (x *EventTradeAccountDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeAccountDeposit)(x)
 }
 
-func (x *EventMintBurn) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[31]
+func (x *EventTradeAccountDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -19997,43 +22219,43 @@ func (x *EventMintBurn) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventMintBurn_messageType fastReflection_EventMintBurn_messageType
-var _ protoreflect.MessageType = fastReflection_EventMintBurn_messageType{}
+var _fastReflection_EventTradeAccountDeposit_messageType fastReflection_EventTradeAccountDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeAccountDeposit_messageType{}
 
-type fastReflection_EventMintBurn_messageType struct{}
+type fastReflection_EventTradeAccountDeposit_messageType struct{}
 
-func (x fastReflection_EventMintBurn_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventMintBurn)(nil)
+func (x fastReflection_EventTradeAccountDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeAccountDeposit)(nil)
 }
-func (x fastReflection_EventMintBurn_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventMintBurn)
+func (x fastReflection_EventTradeAccountDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAccountDeposit)
 }
-func (x fastReflection_EventMintBurn_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventMintBurn
+func (x fastReflection_EventTradeAccountDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAccountDeposit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventMintBurn) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventMintBurn
+func (x *fastReflection_EventTradeAccountDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAccountDeposit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventMintBurn) Type() protoreflect.MessageType {
-	return _fastReflection_EventMintBurn_messageType
+func (x *fastReflection_EventTradeAccountDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeAccountDeposit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventMintBurn) New() protoreflect.Message {
-	return new(fastReflection_EventMintBurn)
+func (x *fastReflection_EventTradeAccountDeposit) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAccountDeposit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventMintBurn) Interface() protoreflect.ProtoMessage {
-	return (*EventMintBurn)(x)
+func (x *fastReflection_EventTradeAccountDeposit) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeAccountDeposit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -20041,28 +22263,34 @@ func (x *fastReflection_EventMintBurn) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventMintBurn) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Supply != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Supply))
-		if !f(fd_EventMintBurn_supply, value) {
+func (x *fastReflection_EventTradeAccountDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventTradeAccountDeposit_amount, value) {
 			return
 		}
 	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_EventMintBurn_denom, value) {
+	if x.Asset != nil {
+		value := protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
+		if !f(fd_EventTradeAccountDeposit_asset, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventMintBurn_amount, value) {
+	if x.AssetAddress != "" {
+		value := protoreflect.ValueOfString(x.AssetAddress)
+		if !f(fd_EventTradeAccountDeposit_asset_address, value) {
 			return
 		}
 	}
-	if x.Reason != "" {
-		value := protoreflect.ValueOfString(x.Reason)
-		if !f(fd_EventMintBurn_reason, value) {
+	if x.RuneAddress != "" {
+		value := protoreflect.ValueOfString(x.RuneAddress)
+		if !f(fd_EventTradeAccountDeposit_rune_address, value) {
+			return
+		}
+	}
+	if x.TxId != "" {
+		value := protoreflect.ValueOfString(x.TxId)
+		if !f(fd_EventTradeAccountDeposit_tx_id, value) {
 			return
 		}
 	}
@@ -20079,21 +22307,23 @@ func (x *fastReflection_EventMintBurn) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventMintBurn) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventTradeAccountDeposit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventMintBurn.supply":
-		return x.Supply != 0
-	case "types.EventMintBurn.denom":
-		return x.Denom != ""
-	case "types.EventMintBurn.amount":
+	case "types.EventTradeAccountDeposit.amount":
 		return x.Amount != ""
-	case "types.EventMintBurn.reason":
-		return x.Reason != ""
+	case "types.EventTradeAccountDeposit.asset":
+		return x.Asset != nil
+	case "types.EventTradeAccountDeposit.asset_address":
+		return x.AssetAddress != ""
+	case "types.EventTradeAccountDeposit.rune_address":
+		return x.RuneAddress != ""
+	case "types.EventTradeAccountDeposit.tx_id":
+		return x.TxId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
 		}
-		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -20103,21 +22333,23 @@ func (x *fastReflection_EventMintBurn) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventMintBurn) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventTradeAccountDeposit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventMintBurn.supply":
-		x.Supply = 0
-	case "types.EventMintBurn.denom":
-		x.Denom = ""
-	case "types.EventMintBurn.amount":
+	case "types.EventTradeAccountDeposit.amount":
 		x.Amount = ""
-	case "types.EventMintBurn.reason":
-		x.Reason = ""
+	case "types.EventTradeAccountDeposit.asset":
+		x.Asset = nil
+	case "types.EventTradeAccountDeposit.asset_address":
+		x.AssetAddress = ""
+	case "types.EventTradeAccountDeposit.rune_address":
+		x.RuneAddress = ""
+	case "types.EventTradeAccountDeposit.tx_id":
+		x.TxId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
 		}
-		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -20127,25 +22359,28 @@ func (x *fastReflection_EventMintBurn) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventMintBurn) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventMintBurn.supply":
-		value := x.Supply
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "types.EventMintBurn.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "types.EventMintBurn.amount":
+	case "types.EventTradeAccountDeposit.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
-	case "types.EventMintBurn.reason":
-		value := x.Reason
+	case "types.EventTradeAccountDeposit.asset":
+		value := x.Asset
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.EventTradeAccountDeposit.asset_address":
+		value := x.AssetAddress
+		return protoreflect.ValueOfString(value)
+	case "types.EventTradeAccountDeposit.rune_address":
+		value := x.RuneAddress
+		return protoreflect.ValueOfString(value)
+	case "types.EventTradeAccountDeposit.tx_id":
+		value := x.TxId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
 		}
-		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -20159,21 +22394,23 @@ func (x *fastReflection_EventMintBurn) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventMintBurn) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventTradeAccountDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventMintBurn.supply":
-		x.Supply = (MintBurnSupplyType)(value.Enum())
-	case "types.EventMintBurn.denom":
-		x.Denom = value.Interface().(string)
-	case "types.EventMintBurn.amount":
+	case "types.EventTradeAccountDeposit.amount":
 		x.Amount = value.Interface().(string)
-	case "types.EventMintBurn.reason":
-		x.Reason = value.Interface().(string)
+	case "types.EventTradeAccountDeposit.asset":
+		x.Asset = value.Message().Interface().(*common.Asset)
+	case "types.EventTradeAccountDeposit.asset_address":
+		x.AssetAddress = value.Interface().(string)
+	case "types.EventTradeAccountDeposit.rune_address":
+		x.RuneAddress = value.Interface().(string)
+	case "types.EventTradeAccountDeposit.tx_id":
+		x.TxId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
 		}
-		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -20187,52 +22424,60 @@ func (x *fastReflection_EventMintBurn) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventMintBurn) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventMintBurn.supply":
-		panic(fmt.Errorf("field supply of message types.EventMintBurn is not mutable"))
-	case "types.EventMintBurn.denom":
-		panic(fmt.Errorf("field denom of message types.EventMintBurn is not mutable"))
-	case "types.EventMintBurn.amount":
-		panic(fmt.Errorf("field amount of message types.EventMintBurn is not mutable"))
-	case "types.EventMintBurn.reason":
-		panic(fmt.Errorf("field reason of message types.EventMintBurn is not mutable"))
+	case "types.EventTradeAccountDeposit.asset":
+		if x.Asset == nil {
+			x.Asset = new(common.Asset)
+		}
+		return protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
+	case "types.EventTradeAccountDeposit.amount":
+		panic(fmt.Errorf("field amount of message types.EventTradeAccountDeposit is not mutable"))
+	case "types.EventTradeAccountDeposit.asset_address":
+		panic(fmt.Errorf("field asset_address of message types.EventTradeAccountDeposit is not mutable"))
+	case "types.EventTradeAccountDeposit.rune_address":
+		panic(fmt.Errorf("field rune_address of message types.EventTradeAccountDeposit is not mutable"))
+	case "types.EventTradeAccountDeposit.tx_id":
+		panic(fmt.Errorf("field tx_id of message types.EventTradeAccountDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
 		}
-		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventMintBurn) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventMintBurn.supply":
-		return protoreflect.ValueOfEnum(0)
-	case "types.EventMintBurn.denom":
+	case "types.EventTradeAccountDeposit.amount":
 		return protoreflect.ValueOfString("")
-	case "types.EventMintBurn.amount":
+	case "types.EventTradeAccountDeposit.asset":
+		m := new(common.Asset)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.EventTradeAccountDeposit.asset_address":
 		return protoreflect.ValueOfString("")
-	case "types.EventMintBurn.reason":
+	case "types.EventTradeAccountDeposit.rune_address":
+		return protoreflect.ValueOfString("")
+	case "types.EventTradeAccountDeposit.tx_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventMintBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
 		}
-		panic(fmt.Errorf("message types.EventMintBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventMintBurn) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventTradeAccountDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventMintBurn", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventTradeAccountDeposit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -20240,7 +22485,7 @@ func (x *fastReflection_EventMintBurn) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventMintBurn) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventTradeAccountDeposit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -20251,7 +22496,7 @@ func (x *fastReflection_EventMintBurn) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventMintBurn) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventTradeAccountDeposit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -20263,7 +22508,7 @@ func (x *fastReflection_EventMintBurn) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventMintBurn) IsValid() bool {
+func (x *fastReflection_EventTradeAccountDeposit) IsValid() bool {
 	return x != nil
 }
 
@@ -20273,9 +22518,9 @@ func (x *fastReflection_EventMintBurn) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventTradeAccountDeposit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventMintBurn)
+		x := input.Message.Interface().(*EventTradeAccountDeposit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -20287,18 +22532,23 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Supply != 0 {
-			n += 1 + runtime.Sov(uint64(x.Supply))
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Denom)
+		if x.Asset != nil {
+			l = options.Size(x.Asset)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AssetAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
+		l = len(x.RuneAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Reason)
+		l = len(x.TxId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -20312,7 +22562,7 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventMintBurn)
+		x := input.Message.Interface().(*EventTradeAccountDeposit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -20331,31 +22581,47 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Reason) > 0 {
-			i -= len(x.Reason)
-			copy(dAtA[i:], x.Reason)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+		if len(x.TxId) > 0 {
+			i -= len(x.TxId)
+			copy(dAtA[i:], x.TxId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxId)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.RuneAddress) > 0 {
+			i -= len(x.RuneAddress)
+			copy(dAtA[i:], x.RuneAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RuneAddress)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		if len(x.AssetAddress) > 0 {
+			i -= len(x.AssetAddress)
+			copy(dAtA[i:], x.AssetAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssetAddress)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if x.Asset != nil {
+			encoded, err := options.Marshal(x.Asset)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Supply != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Supply))
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -20368,7 +22634,7 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventMintBurn)
+		x := input.Message.Interface().(*EventTradeAccountDeposit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -20400,17 +22666,17 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMintBurn: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountDeposit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMintBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				x.Supply = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -20420,14 +22686,63 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Supply |= MintBurnSupplyType(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Asset == nil {
+					x.Asset = &common.Asset{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Asset); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -20455,11 +22770,11 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.AssetAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -20487,11 +22802,11 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.RuneAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -20519,7 +22834,7 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reason = string(dAtA[iNdEx:postIndex])
+				x.TxId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -20557,34 +22872,34 @@ func (x *fastReflection_EventMintBurn) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventTradeAccountDeposit               protoreflect.MessageDescriptor
-	fd_EventTradeAccountDeposit_amount        protoreflect.FieldDescriptor
-	fd_EventTradeAccountDeposit_asset         protoreflect.FieldDescriptor
-	fd_EventTradeAccountDeposit_asset_address protoreflect.FieldDescriptor
-	fd_EventTradeAccountDeposit_rune_address  protoreflect.FieldDescriptor
-	fd_EventTradeAccountDeposit_tx_id         protoreflect.FieldDescriptor
+	md_EventTradeAccountWithdraw               protoreflect.MessageDescriptor
+	fd_EventTradeAccountWithdraw_amount        protoreflect.FieldDescriptor
+	fd_EventTradeAccountWithdraw_asset         protoreflect.FieldDescriptor
+	fd_EventTradeAccountWithdraw_asset_address protoreflect.FieldDescriptor
+	fd_EventTradeAccountWithdraw_rune_address  protoreflect.FieldDescriptor
+	fd_EventTradeAccountWithdraw_tx_id         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventTradeAccountDeposit = File_types_type_events_proto.Messages().ByName("EventTradeAccountDeposit")
-	fd_EventTradeAccountDeposit_amount = md_EventTradeAccountDeposit.Fields().ByName("amount")
-	fd_EventTradeAccountDeposit_asset = md_EventTradeAccountDeposit.Fields().ByName("asset")
-	fd_EventTradeAccountDeposit_asset_address = md_EventTradeAccountDeposit.Fields().ByName("asset_address")
-	fd_EventTradeAccountDeposit_rune_address = md_EventTradeAccountDeposit.Fields().ByName("rune_address")
-	fd_EventTradeAccountDeposit_tx_id = md_EventTradeAccountDeposit.Fields().ByName("tx_id")
+	md_EventTradeAccountWithdraw = File_types_type_events_proto.Messages().ByName("EventTradeAccountWithdraw")
+	fd_EventTradeAccountWithdraw_amount = md_EventTradeAccountWithdraw.Fields().ByName("amount")
+	fd_EventTradeAccountWithdraw_asset = md_EventTradeAccountWithdraw.Fields().ByName("asset")
+	fd_EventTradeAccountWithdraw_asset_address = md_EventTradeAccountWithdraw.Fields().ByName("asset_address")
+	fd_EventTradeAccountWithdraw_rune_address = md_EventTradeAccountWithdraw.Fields().ByName("rune_address")
+	fd_EventTradeAccountWithdraw_tx_id = md_EventTradeAccountWithdraw.Fields().ByName("tx_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventTradeAccountDeposit)(nil)
+var _ protoreflect.Message = (*fastReflection_EventTradeAccountWithdraw)(nil)
 
-type fastReflection_EventTradeAccountDeposit EventTradeAccountDeposit
+type fastReflection_EventTradeAccountWithdraw EventTradeAccountWithdraw
 
-func (x *EventTradeAccountDeposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventTradeAccountDeposit)(x)
+func (x *EventTradeAccountWithdraw) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeAccountWithdraw)(x)
 }
 
-func (x *EventTradeAccountDeposit) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[32]
+func (x *EventTradeAccountWithdraw) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -20595,43 +22910,43 @@ func (x *EventTradeAccountDeposit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventTradeAccountDeposit_messageType fastReflection_EventTradeAccountDeposit_messageType
-var _ protoreflect.MessageType = fastReflection_EventTradeAccountDeposit_messageType{}
+var _fastReflection_EventTradeAccountWithdraw_messageType fastReflection_EventTradeAccountWithdraw_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeAccountWithdraw_messageType{}
 
-type fastReflection_EventTradeAccountDeposit_messageType struct{}
+type fastReflection_EventTradeAccountWithdraw_messageType struct{}
 
-func (x fastReflection_EventTradeAccountDeposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventTradeAccountDeposit)(nil)
+func (x fastReflection_EventTradeAccountWithdraw_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeAccountWithdraw)(nil)
 }
-func (x fastReflection_EventTradeAccountDeposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventTradeAccountDeposit)
+func (x fastReflection_EventTradeAccountWithdraw_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAccountWithdraw)
 }
-func (x fastReflection_EventTradeAccountDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTradeAccountDeposit
+func (x fastReflection_EventTradeAccountWithdraw_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAccountWithdraw
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventTradeAccountDeposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTradeAccountDeposit
+func (x *fastReflection_EventTradeAccountWithdraw) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAccountWithdraw
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventTradeAccountDeposit) Type() protoreflect.MessageType {
-	return _fastReflection_EventTradeAccountDeposit_messageType
+func (x *fastReflection_EventTradeAccountWithdraw) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeAccountWithdraw_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventTradeAccountDeposit) New() protoreflect.Message {
-	return new(fastReflection_EventTradeAccountDeposit)
+func (x *fastReflection_EventTradeAccountWithdraw) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAccountWithdraw)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventTradeAccountDeposit) Interface() protoreflect.ProtoMessage {
-	return (*EventTradeAccountDeposit)(x)
+func (x *fastReflection_EventTradeAccountWithdraw) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeAccountWithdraw)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -20639,34 +22954,34 @@ func (x *fastReflection_EventTradeAccountDeposit) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventTradeAccountDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventTradeAccountWithdraw) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Amount != "" {
 		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventTradeAccountDeposit_amount, value) {
+		if !f(fd_EventTradeAccountWithdraw_amount, value) {
 			return
 		}
 	}
 	if x.Asset != nil {
 		value := protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
-		if !f(fd_EventTradeAccountDeposit_asset, value) {
+		if !f(fd_EventTradeAccountWithdraw_asset, value) {
 			return
 		}
 	}
 	if x.AssetAddress != "" {
 		value := protoreflect.ValueOfString(x.AssetAddress)
-		if !f(fd_EventTradeAccountDeposit_asset_address, value) {
+		if !f(fd_EventTradeAccountWithdraw_asset_address, value) {
 			return
 		}
 	}
 	if x.RuneAddress != "" {
 		value := protoreflect.ValueOfString(x.RuneAddress)
-		if !f(fd_EventTradeAccountDeposit_rune_address, value) {
+		if !f(fd_EventTradeAccountWithdraw_rune_address, value) {
 			return
 		}
 	}
 	if x.TxId != "" {
 		value := protoreflect.ValueOfString(x.TxId)
-		if !f(fd_EventTradeAccountDeposit_tx_id, value) {
+		if !f(fd_EventTradeAccountWithdraw_tx_id, value) {
 			return
 		}
 	}
@@ -20683,23 +22998,23 @@ func (x *fastReflection_EventTradeAccountDeposit) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventTradeAccountDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventTradeAccountWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventTradeAccountDeposit.amount":
+	case "types.EventTradeAccountWithdraw.amount":
 		return x.Amount != ""
-	case "types.EventTradeAccountDeposit.asset":
+	case "types.EventTradeAccountWithdraw.asset":
 		return x.Asset != nil
-	case "types.EventTradeAccountDeposit.asset_address":
+	case "types.EventTradeAccountWithdraw.asset_address":
 		return x.AssetAddress != ""
-	case "types.EventTradeAccountDeposit.rune_address":
+	case "types.EventTradeAccountWithdraw.rune_address":
 		return x.RuneAddress != ""
-	case "types.EventTradeAccountDeposit.tx_id":
+	case "types.EventTradeAccountWithdraw.tx_id":
 		return x.TxId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -20709,23 +23024,23 @@ func (x *fastReflection_EventTradeAccountDeposit) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountDeposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventTradeAccountWithdraw) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventTradeAccountDeposit.amount":
+	case "types.EventTradeAccountWithdraw.amount":
 		x.Amount = ""
-	case "types.EventTradeAccountDeposit.asset":
+	case "types.EventTradeAccountWithdraw.asset":
 		x.Asset = nil
-	case "types.EventTradeAccountDeposit.asset_address":
+	case "types.EventTradeAccountWithdraw.asset_address":
 		x.AssetAddress = ""
-	case "types.EventTradeAccountDeposit.rune_address":
+	case "types.EventTradeAccountWithdraw.rune_address":
 		x.RuneAddress = ""
-	case "types.EventTradeAccountDeposit.tx_id":
+	case "types.EventTradeAccountWithdraw.tx_id":
 		x.TxId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -20735,28 +23050,28 @@ func (x *fastReflection_EventTradeAccountDeposit) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventTradeAccountDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountWithdraw) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventTradeAccountDeposit.amount":
+	case "types.EventTradeAccountWithdraw.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
-	case "types.EventTradeAccountDeposit.asset":
+	case "types.EventTradeAccountWithdraw.asset":
 		value := x.Asset
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "types.EventTradeAccountDeposit.asset_address":
+	case "types.EventTradeAccountWithdraw.asset_address":
 		value := x.AssetAddress
 		return protoreflect.ValueOfString(value)
-	case "types.EventTradeAccountDeposit.rune_address":
+	case "types.EventTradeAccountWithdraw.rune_address":
 		value := x.RuneAddress
 		return protoreflect.ValueOfString(value)
-	case "types.EventTradeAccountDeposit.tx_id":
+	case "types.EventTradeAccountWithdraw.tx_id":
 		value := x.TxId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -20770,23 +23085,23 @@ func (x *fastReflection_EventTradeAccountDeposit) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventTradeAccountWithdraw) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventTradeAccountDeposit.amount":
+	case "types.EventTradeAccountWithdraw.amount":
 		x.Amount = value.Interface().(string)
-	case "types.EventTradeAccountDeposit.asset":
+	case "types.EventTradeAccountWithdraw.asset":
 		x.Asset = value.Message().Interface().(*common.Asset)
-	case "types.EventTradeAccountDeposit.asset_address":
+	case "types.EventTradeAccountWithdraw.asset_address":
 		x.AssetAddress = value.Interface().(string)
-	case "types.EventTradeAccountDeposit.rune_address":
+	case "types.EventTradeAccountWithdraw.rune_address":
 		x.RuneAddress = value.Interface().(string)
-	case "types.EventTradeAccountDeposit.tx_id":
+	case "types.EventTradeAccountWithdraw.tx_id":
 		x.TxId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -20800,60 +23115,60 @@ func (x *fastReflection_EventTradeAccountDeposit) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountWithdraw) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTradeAccountDeposit.asset":
+	case "types.EventTradeAccountWithdraw.asset":
 		if x.Asset == nil {
 			x.Asset = new(common.Asset)
 		}
 		return protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
-	case "types.EventTradeAccountDeposit.amount":
-		panic(fmt.Errorf("field amount of message types.EventTradeAccountDeposit is not mutable"))
-	case "types.EventTradeAccountDeposit.asset_address":
-		panic(fmt.Errorf("field asset_address of message types.EventTradeAccountDeposit is not mutable"))
-	case "types.EventTradeAccountDeposit.rune_address":
-		panic(fmt.Errorf("field rune_address of message types.EventTradeAccountDeposit is not mutable"))
-	case "types.EventTradeAccountDeposit.tx_id":
-		panic(fmt.Errorf("field tx_id of message types.EventTradeAccountDeposit is not mutable"))
+	case "types.EventTradeAccountWithdraw.amount":
+		panic(fmt.Errorf("field amount of message types.EventTradeAccountWithdraw is not mutable"))
+	case "types.EventTradeAccountWithdraw.asset_address":
+		panic(fmt.Errorf("field asset_address of message types.EventTradeAccountWithdraw is not mutable"))
+	case "types.EventTradeAccountWithdraw.rune_address":
+		panic(fmt.Errorf("field rune_address of message types.EventTradeAccountWithdraw is not mutable"))
+	case "types.EventTradeAccountWithdraw.tx_id":
+		panic(fmt.Errorf("field tx_id of message types.EventTradeAccountWithdraw is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventTradeAccountDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountWithdraw) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTradeAccountDeposit.amount":
+	case "types.EventTradeAccountWithdraw.amount":
 		return protoreflect.ValueOfString("")
-	case "types.EventTradeAccountDeposit.asset":
+	case "types.EventTradeAccountWithdraw.asset":
 		m := new(common.Asset)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "types.EventTradeAccountDeposit.asset_address":
+	case "types.EventTradeAccountWithdraw.asset_address":
 		return protoreflect.ValueOfString("")
-	case "types.EventTradeAccountDeposit.rune_address":
+	case "types.EventTradeAccountWithdraw.rune_address":
 		return protoreflect.ValueOfString("")
-	case "types.EventTradeAccountDeposit.tx_id":
+	case "types.EventTradeAccountWithdraw.tx_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventTradeAccountDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventTradeAccountWithdraw) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventTradeAccountDeposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventTradeAccountWithdraw", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -20861,7 +23176,7 @@ func (x *fastReflection_EventTradeAccountDeposit) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventTradeAccountDeposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventTradeAccountWithdraw) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -20872,7 +23187,7 @@ func (x *fastReflection_EventTradeAccountDeposit) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountDeposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventTradeAccountWithdraw) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -20884,7 +23199,7 @@ func (x *fastReflection_EventTradeAccountDeposit) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventTradeAccountDeposit) IsValid() bool {
+func (x *fastReflection_EventTradeAccountWithdraw) IsValid() bool {
 	return x != nil
 }
 
@@ -20894,9 +23209,9 @@ func (x *fastReflection_EventTradeAccountDeposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventTradeAccountDeposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventTradeAccountDeposit)
+		x := input.Message.Interface().(*EventTradeAccountWithdraw)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -20938,7 +23253,7 @@ func (x *fastReflection_EventTradeAccountDeposit) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventTradeAccountDeposit)
+		x := input.Message.Interface().(*EventTradeAccountWithdraw)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -21010,7 +23325,7 @@ func (x *fastReflection_EventTradeAccountDeposit) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventTradeAccountDeposit)
+		x := input.Message.Interface().(*EventTradeAccountWithdraw)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -21042,10 +23357,10 @@ func (x *fastReflection_EventTradeAccountDeposit) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountDeposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountWithdraw: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -21248,34 +23563,34 @@ func (x *fastReflection_EventTradeAccountDeposit) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_EventTradeAccountWithdraw               protoreflect.MessageDescriptor
-	fd_EventTradeAccountWithdraw_amount        protoreflect.FieldDescriptor
-	fd_EventTradeAccountWithdraw_asset         protoreflect.FieldDescriptor
-	fd_EventTradeAccountWithdraw_asset_address protoreflect.FieldDescriptor
-	fd_EventTradeAccountWithdraw_rune_address  protoreflect.FieldDescriptor
-	fd_EventTradeAccountWithdraw_tx_id         protoreflect.FieldDescriptor
+	md_EventTradeAccountTransfer              protoreflect.MessageDescriptor
+	fd_EventTradeAccountTransfer_amount       protoreflect.FieldDescriptor
+	fd_EventTradeAccountTransfer_asset        protoreflect.FieldDescriptor
+	fd_EventTradeAccountTransfer_from_address protoreflect.FieldDescriptor
+	fd_EventTradeAccountTransfer_to_address   protoreflect.FieldDescriptor
+	fd_EventTradeAccountTransfer_tx_id        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventTradeAccountWithdraw = File_types_type_events_proto.Messages().ByName("EventTradeAccountWithdraw")
-	fd_EventTradeAccountWithdraw_amount = md_EventTradeAccountWithdraw.Fields().ByName("amount")
-	fd_EventTradeAccountWithdraw_asset = md_EventTradeAccountWithdraw.Fields().ByName("asset")
-	fd_EventTradeAccountWithdraw_asset_address = md_EventTradeAccountWithdraw.Fields().ByName("asset_address")
-	fd_EventTradeAccountWithdraw_rune_address = md_EventTradeAccountWithdraw.Fields().ByName("rune_address")
-	fd_EventTradeAccountWithdraw_tx_id = md_EventTradeAccountWithdraw.Fields().ByName("tx_id")
+	md_EventTradeAccountTransfer = File_types_type_events_proto.Messages().ByName("EventTradeAccountTransfer")
+	fd_EventTradeAccountTransfer_amount = md_EventTradeAccountTransfer.Fields().ByName("amount")
+	fd_EventTradeAccountTransfer_asset = md_EventTradeAccountTransfer.Fields().ByName("asset")
+	fd_EventTradeAccountTransfer_from_address = md_EventTradeAccountTransfer.Fields().ByName("from_address")
+	fd_EventTradeAccountTransfer_to_address = md_EventTradeAccountTransfer.Fields().ByName("to_address")
+	fd_EventTradeAccountTransfer_tx_id = md_EventTradeAccountTransfer.Fields().ByName("tx_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventTradeAccountWithdraw)(nil)
+var _ protoreflect.Message = (*fastReflection_EventTradeAccountTransfer)(nil)
 
-type fastReflection_EventTradeAccountWithdraw EventTradeAccountWithdraw
+type fastReflection_EventTradeAccountTransfer EventTradeAccountTransfer
 
-func (x *EventTradeAccountWithdraw) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventTradeAccountWithdraw)(x)
+func (x *EventTradeAccountTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeAccountTransfer)(x)
 }
 
-func (x *EventTradeAccountWithdraw) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[33]
+func (x *EventTradeAccountTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -21286,43 +23601,43 @@ func (x *EventTradeAccountWithdraw) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventTradeAccountWithdraw_messageType fastReflection_EventTradeAccountWithdraw_messageType
-var _ protoreflect.MessageType = fastReflection_EventTradeAccountWithdraw_messageType{}
+var _fastReflection_EventTradeAccountTransfer_messageType fastReflection_EventTradeAccountTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeAccountTransfer_messageType{}
 
-type fastReflection_EventTradeAccountWithdraw_messageType struct{}
+type fastReflection_EventTradeAccountTransfer_messageType struct{}
 
-func (x fastReflection_EventTradeAccountWithdraw_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventTradeAccountWithdraw)(nil)
+func (x fastReflection_EventTradeAccountTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeAccountTransfer)(nil)
 }
-func (x fastReflection_EventTradeAccountWithdraw_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventTradeAccountWithdraw)
+func (x fastReflection_EventTradeAccountTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAccountTransfer)
 }
-func (x fastReflection_EventTradeAccountWithdraw_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTradeAccountWithdraw
+func (x fastReflection_EventTradeAccountTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAccountTransfer
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventTradeAccountWithdraw) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTradeAccountWithdraw
+func (x *fastReflection_EventTradeAccountTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAccountTransfer
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventTradeAccountWithdraw) Type() protoreflect.MessageType {
-	return _fastReflection_EventTradeAccountWithdraw_messageType
+func (x *fastReflection_EventTradeAccountTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeAccountTransfer_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventTradeAccountWithdraw) New() protoreflect.Message {
-	return new(fastReflection_EventTradeAccountWithdraw)
+func (x *fastReflection_EventTradeAccountTransfer) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAccountTransfer)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventTradeAccountWithdraw) Interface() protoreflect.ProtoMessage {
-	return (*EventTradeAccountWithdraw)(x)
+func (x *fastReflection_EventTradeAccountTransfer) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeAccountTransfer)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -21330,34 +23645,34 @@ func (x *fastReflection_EventTradeAccountWithdraw) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventTradeAccountWithdraw) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventTradeAccountTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Amount != "" {
 		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventTradeAccountWithdraw_amount, value) {
+		if !f(fd_EventTradeAccountTransfer_amount, value) {
 			return
 		}
 	}
 	if x.Asset != nil {
 		value := protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
-		if !f(fd_EventTradeAccountWithdraw_asset, value) {
+		if !f(fd_EventTradeAccountTransfer_asset, value) {
 			return
 		}
 	}
-	if x.AssetAddress != "" {
-		value := protoreflect.ValueOfString(x.AssetAddress)
-		if !f(fd_EventTradeAccountWithdraw_asset_address, value) {
+	if x.FromAddress != "" {
+		value := protoreflect.ValueOfString(x.FromAddress)
+		if !f(fd_EventTradeAccountTransfer_from_address, value) {
 			return
 		}
 	}
-	if x.RuneAddress != "" {
-		value := protoreflect.ValueOfString(x.RuneAddress)
-		if !f(fd_EventTradeAccountWithdraw_rune_address, value) {
+	if x.ToAddress != "" {
+		value := protoreflect.ValueOfString(x.ToAddress)
+		if !f(fd_EventTradeAccountTransfer_to_address, value) {
 			return
 		}
 	}
 	if x.TxId != "" {
 		value := protoreflect.ValueOfString(x.TxId)
-		if !f(fd_EventTradeAccountWithdraw_tx_id, value) {
+		if !f(fd_EventTradeAccountTransfer_tx_id, value) {
 			return
 		}
 	}
@@ -21374,23 +23689,23 @@ func (x *fastReflection_EventTradeAccountWithdraw) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventTradeAccountWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventTradeAccountTransfer) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventTradeAccountWithdraw.amount":
+	case "types.EventTradeAccountTransfer.amount":
 		return x.Amount != ""
-	case "types.EventTradeAccountWithdraw.asset":
+	case "types.EventTradeAccountTransfer.asset":
 		return x.Asset != nil
-	case "types.EventTradeAccountWithdraw.asset_address":
-		return x.AssetAddress != ""
-	case "types.EventTradeAccountWithdraw.rune_address":
-		return x.RuneAddress != ""
-	case "types.EventTradeAccountWithdraw.tx_id":
+	case "types.EventTradeAccountTransfer.from_address":
+		return x.FromAddress != ""
+	case "types.EventTradeAccountTransfer.to_address":
+		return x.ToAddress != ""
+	case "types.EventTradeAccountTransfer.tx_id":
 		return x.TxId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountTransfer"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountTransfer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -21400,23 +23715,23 @@ func (x *fastReflection_EventTradeAccountWithdraw) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountWithdraw) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventTradeAccountTransfer) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventTradeAccountWithdraw.amount":
+	case "types.EventTradeAccountTransfer.amount":
 		x.Amount = ""
-	case "types.EventTradeAccountWithdraw.asset":
+	case "types.EventTradeAccountTransfer.asset":
 		x.Asset = nil
-	case "types.EventTradeAccountWithdraw.asset_address":
-		x.AssetAddress = ""
-	case "types.EventTradeAccountWithdraw.rune_address":
-		x.RuneAddress = ""
-	case "types.EventTradeAccountWithdraw.tx_id":
+	case "types.EventTradeAccountTransfer.from_address":
+		x.FromAddress = ""
+	case "types.EventTradeAccountTransfer.to_address":
+		x.ToAddress = ""
+	case "types.EventTradeAccountTransfer.tx_id":
 		x.TxId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountTransfer"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountTransfer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -21426,28 +23741,28 @@ func (x *fastReflection_EventTradeAccountWithdraw) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventTradeAccountWithdraw) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventTradeAccountWithdraw.amount":
+	case "types.EventTradeAccountTransfer.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
-	case "types.EventTradeAccountWithdraw.asset":
+	case "types.EventTradeAccountTransfer.asset":
 		value := x.Asset
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "types.EventTradeAccountWithdraw.asset_address":
-		value := x.AssetAddress
+	case "types.EventTradeAccountTransfer.from_address":
+		value := x.FromAddress
 		return protoreflect.ValueOfString(value)
-	case "types.EventTradeAccountWithdraw.rune_address":
-		value := x.RuneAddress
+	case "types.EventTradeAccountTransfer.to_address":
+		value := x.ToAddress
 		return protoreflect.ValueOfString(value)
-	case "types.EventTradeAccountWithdraw.tx_id":
+	case "types.EventTradeAccountTransfer.tx_id":
 		value := x.TxId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountTransfer"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountTransfer does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -21461,23 +23776,23 @@ func (x *fastReflection_EventTradeAccountWithdraw) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountWithdraw) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventTradeAccountTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventTradeAccountWithdraw.amount":
+	case "types.EventTradeAccountTransfer.amount":
 		x.Amount = value.Interface().(string)
-	case "types.EventTradeAccountWithdraw.asset":
+	case "types.EventTradeAccountTransfer.asset":
 		x.Asset = value.Message().Interface().(*common.Asset)
-	case "types.EventTradeAccountWithdraw.asset_address":
-		x.AssetAddress = value.Interface().(string)
-	case "types.EventTradeAccountWithdraw.rune_address":
-		x.RuneAddress = value.Interface().(string)
-	case "types.EventTradeAccountWithdraw.tx_id":
+	case "types.EventTradeAccountTransfer.from_address":
+		x.FromAddress = value.Interface().(string)
+	case "types.EventTradeAccountTransfer.to_address":
+		x.ToAddress = value.Interface().(string)
+	case "types.EventTradeAccountTransfer.tx_id":
 		x.TxId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountTransfer"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountTransfer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -21491,60 +23806,60 @@ func (x *fastReflection_EventTradeAccountWithdraw) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountWithdraw) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTradeAccountWithdraw.asset":
+	case "types.EventTradeAccountTransfer.asset":
 		if x.Asset == nil {
 			x.Asset = new(common.Asset)
 		}
 		return protoreflect.ValueOfMessage(x.Asset.ProtoReflect())
-	case "types.EventTradeAccountWithdraw.amount":
-		panic(fmt.Errorf("field amount of message types.EventTradeAccountWithdraw is not mutable"))
-	case "types.EventTradeAccountWithdraw.asset_address":
-		panic(fmt.Errorf("field asset_address of message types.EventTradeAccountWithdraw is not mutable"))
-	case "types.EventTradeAccountWithdraw.rune_address":
-		panic(fmt.Errorf("field rune_address of message types.EventTradeAccountWithdraw is not mutable"))
-	case "types.EventTradeAccountWithdraw.tx_id":
-		panic(fmt.Errorf("field tx_id of message types.EventTradeAccountWithdraw is not mutable"))
+	case "types.EventTradeAccountTransfer.amount":
+		panic(fmt.Errorf("field amount of message types.EventTradeAccountTransfer is not mutable"))
+	case "types.EventTradeAccountTransfer.from_address":
+		panic(fmt.Errorf("field from_address of message types.EventTradeAccountTransfer is not mutable"))
+	case "types.EventTradeAccountTransfer.to_address":
+		panic(fmt.Errorf("field to_address of message types.EventTradeAccountTransfer is not mutable"))
+	case "types.EventTradeAccountTransfer.tx_id":
+		panic(fmt.Errorf("field tx_id of message types.EventTradeAccountTransfer is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountTransfer"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountTransfer does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventTradeAccountWithdraw) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTradeAccountTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTradeAccountWithdraw.amount":
+	case "types.EventTradeAccountTransfer.amount":
 		return protoreflect.ValueOfString("")
-	case "types.EventTradeAccountWithdraw.asset":
+	case "types.EventTradeAccountTransfer.asset":
 		m := new(common.Asset)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "types.EventTradeAccountWithdraw.asset_address":
+	case "types.EventTradeAccountTransfer.from_address":
 		return protoreflect.ValueOfString("")
-	case "types.EventTradeAccountWithdraw.rune_address":
+	case "types.EventTradeAccountTransfer.to_address":
 		return protoreflect.ValueOfString("")
-	case "types.EventTradeAccountWithdraw.tx_id":
+	case "types.EventTradeAccountTransfer.tx_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTradeAccountTransfer"))
 		}
-		panic(fmt.Errorf("message types.EventTradeAccountWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTradeAccountTransfer does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventTradeAccountWithdraw) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventTradeAccountTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventTradeAccountWithdraw", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventTradeAccountTransfer", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -21552,7 +23867,7 @@ func (x *fastReflection_EventTradeAccountWithdraw) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventTradeAccountWithdraw) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventTradeAccountTransfer) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -21563,7 +23878,7 @@ func (x *fastReflection_EventTradeAccountWithdraw) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTradeAccountWithdraw) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventTradeAccountTransfer) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -21575,7 +23890,7 @@ func (x *fastReflection_EventTradeAccountWithdraw) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventTradeAccountWithdraw) IsValid() bool {
+func (x *fastReflection_EventTradeAccountTransfer) IsValid() bool {
 	return x != nil
 }
 
@@ -21585,9 +23900,9 @@ func (x *fastReflection_EventTradeAccountWithdraw) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventTradeAccountTransfer) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventTradeAccountWithdraw)
+		x := input.Message.Interface().(*EventTradeAccountTransfer)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -21607,11 +23922,11 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 			l = options.Size(x.Asset)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.AssetAddress)
+		l = len(x.FromAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RuneAddress)
+		l = len(x.ToAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -21629,7 +23944,7 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventTradeAccountWithdraw)
+		x := input.Message.Interface().(*EventTradeAccountTransfer)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -21655,17 +23970,17 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 			i--
 			dAtA[i] = 0x2a
 		}
-		if len(x.RuneAddress) > 0 {
-			i -= len(x.RuneAddress)
-			copy(dAtA[i:], x.RuneAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RuneAddress)))
+		if len(x.ToAddress) > 0 {
+			i -= len(x.ToAddress)
+			copy(dAtA[i:], x.ToAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ToAddress)))
 			i--
 			dAtA[i] = 0x22
 		}
-		if len(x.AssetAddress) > 0 {
-			i -= len(x.AssetAddress)
-			copy(dAtA[i:], x.AssetAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AssetAddress)))
+		if len(x.FromAddress) > 0 {
+			i -= len(x.FromAddress)
+			copy(dAtA[i:], x.FromAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FromAddress)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -21701,7 +24016,7 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventTradeAccountWithdraw)
+		x := input.Message.Interface().(*EventTradeAccountTransfer)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -21733,10 +24048,10 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountWithdraw: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountTransfer: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAccountTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -21809,7 +24124,7 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -21837,11 +24152,11 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AssetAddress = string(dAtA[iNdEx:postIndex])
+				x.FromAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RuneAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -21869,7 +24184,7 @@ func (x *fastReflection_EventTradeAccountWithdraw) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RuneAddress = string(dAtA[iNdEx:postIndex])
+				x.ToAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
@@ -21966,7 +24281,7 @@ func (x *EventSecuredAssetDeposit) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSecuredAssetDeposit) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[34]
+	mi := &file_types_type_events_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -22657,7 +24972,7 @@ func (x *EventSecuredAssetWithdraw) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSecuredAssetWithdraw) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[35]
+	mi := &file_types_type_events_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23346,7 +25661,7 @@ func (x *EventRUNEPoolDeposit) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRUNEPoolDeposit) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[36]
+	mi := &file_types_type_events_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -23968,7 +26283,7 @@ func (x *EventRUNEPoolWithdraw) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventRUNEPoolWithdraw) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[37]
+	mi := &file_types_type_events_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -24804,7 +27119,7 @@ func (x *EventLoanOpen) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventLoanOpen) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[38]
+	mi := &file_types_type_events_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -25634,7 +27949,7 @@ func (x *EventLoanRepayment) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventLoanRepayment) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[39]
+	mi := &file_types_type_events_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -26329,7 +28644,7 @@ func (x *EventTHORName) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventTHORName) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[40]
+	mi := &file_types_type_events_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27109,7 +29424,7 @@ func (x *EventSetMimir) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSetMimir) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[41]
+	mi := &file_types_type_events_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -27595,7 +29910,7 @@ func (x *EventSetNodeMimir) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSetNodeMimir) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[42]
+	mi := &file_types_type_events_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28139,7 +30454,7 @@ func (x *EventVersion) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventVersion) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[43]
+	mi := &file_types_type_events_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -28567,7 +30882,7 @@ func (x *EventSwitch) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventSwitch) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[44]
+	mi := &file_types_type_events_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29254,7 +31569,7 @@ func (x *EventOperatorRotate) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventOperatorRotate) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[45]
+	mi := &file_types_type_events_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -29806,7 +32121,7 @@ func (x *EventTCYDistribution) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventTCYDistribution) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[46]
+	mi := &file_types_type_events_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -30296,7 +32611,7 @@ func (x *EventTCYClaim) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventTCYClaim) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[47]
+	mi := &file_types_type_events_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -30898,28 +33213,512 @@ func (x *fastReflection_EventTCYClaim) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventTCYStake         protoreflect.MessageDescriptor
-	fd_EventTCYStake_address protoreflect.FieldDescriptor
-	fd_EventTCYStake_amount  protoreflect.FieldDescriptor
+	md_EventTCYStake         protoreflect.MessageDescriptor
+	fd_EventTCYStake_address protoreflect.FieldDescriptor
+	fd_EventTCYStake_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_type_events_proto_init()
+	md_EventTCYStake = File_types_type_events_proto.Messages().ByName("EventTCYStake")
+	fd_EventTCYStake_address = md_EventTCYStake.Fields().ByName("address")
+	fd_EventTCYStake_amount = md_EventTCYStake.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTCYStake)(nil)
+
+type fastReflection_EventTCYStake EventTCYStake
+
+func (x *EventTCYStake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTCYStake)(x)
+}
+
+func (x *EventTCYStake) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTCYStake_messageType fastReflection_EventTCYStake_messageType
+var _ protoreflect.MessageType = fastReflection_EventTCYStake_messageType{}
+
+type fastReflection_EventTCYStake_messageType struct{}
+
+func (x fastReflection_EventTCYStake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTCYStake)(nil)
+}
+func (x fastReflection_EventTCYStake_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTCYStake)
+}
+func (x fastReflection_EventTCYStake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTCYStake
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTCYStake) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTCYStake
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTCYStake) Type() protoreflect.MessageType {
+	return _fastReflection_EventTCYStake_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTCYStake) New() protoreflect.Message {
+	return new(fastReflection_EventTCYStake)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTCYStake) Interface() protoreflect.ProtoMessage {
+	return (*EventTCYStake)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTCYStake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_EventTCYStake_address, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventTCYStake_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTCYStake) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.EventTCYStake.address":
+		return x.Address != ""
+	case "types.EventTCYStake.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+		}
+		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTCYStake) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.EventTCYStake.address":
+		x.Address = ""
+	case "types.EventTCYStake.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+		}
+		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTCYStake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.EventTCYStake.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "types.EventTCYStake.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+		}
+		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTCYStake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.EventTCYStake.address":
+		x.Address = value.Interface().(string)
+	case "types.EventTCYStake.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+		}
+		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTCYStake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventTCYStake.address":
+		panic(fmt.Errorf("field address of message types.EventTCYStake is not mutable"))
+	case "types.EventTCYStake.amount":
+		panic(fmt.Errorf("field amount of message types.EventTCYStake is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+		}
+		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTCYStake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.EventTCYStake.address":
+		return protoreflect.ValueOfString("")
+	case "types.EventTCYStake.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+		}
+		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTCYStake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.EventTCYStake", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTCYStake) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTCYStake) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTCYStake) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTCYStake) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTCYStake)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTCYStake)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTCYStake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYStake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTCYUnstake         protoreflect.MessageDescriptor
+	fd_EventTCYUnstake_address protoreflect.FieldDescriptor
+	fd_EventTCYUnstake_amount  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventTCYStake = File_types_type_events_proto.Messages().ByName("EventTCYStake")
-	fd_EventTCYStake_address = md_EventTCYStake.Fields().ByName("address")
-	fd_EventTCYStake_amount = md_EventTCYStake.Fields().ByName("amount")
+	md_EventTCYUnstake = File_types_type_events_proto.Messages().ByName("EventTCYUnstake")
+	fd_EventTCYUnstake_address = md_EventTCYUnstake.Fields().ByName("address")
+	fd_EventTCYUnstake_amount = md_EventTCYUnstake.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventTCYStake)(nil)
+var _ protoreflect.Message = (*fastReflection_EventTCYUnstake)(nil)
 
-type fastReflection_EventTCYStake EventTCYStake
+type fastReflection_EventTCYUnstake EventTCYUnstake
 
-func (x *EventTCYStake) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventTCYStake)(x)
+func (x *EventTCYUnstake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTCYUnstake)(x)
 }
 
-func (x *EventTCYStake) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[48]
+func (x *EventTCYUnstake) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -30930,43 +33729,43 @@ func (x *EventTCYStake) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventTCYStake_messageType fastReflection_EventTCYStake_messageType
-var _ protoreflect.MessageType = fastReflection_EventTCYStake_messageType{}
+var _fastReflection_EventTCYUnstake_messageType fastReflection_EventTCYUnstake_messageType
+var _ protoreflect.MessageType = fastReflection_EventTCYUnstake_messageType{}
 
-type fastReflection_EventTCYStake_messageType struct{}
+type fastReflection_EventTCYUnstake_messageType struct{}
 
-func (x fastReflection_EventTCYStake_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventTCYStake)(nil)
+func (x fastReflection_EventTCYUnstake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTCYUnstake)(nil)
 }
-func (x fastReflection_EventTCYStake_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventTCYStake)
+func (x fastReflection_EventTCYUnstake_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTCYUnstake)
 }
-func (x fastReflection_EventTCYStake_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTCYStake
+func (x fastReflection_EventTCYUnstake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTCYUnstake
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventTCYStake) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTCYStake
+func (x *fastReflection_EventTCYUnstake) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTCYUnstake
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventTCYStake) Type() protoreflect.MessageType {
-	return _fastReflection_EventTCYStake_messageType
+func (x *fastReflection_EventTCYUnstake) Type() protoreflect.MessageType {
+	return _fastReflection_EventTCYUnstake_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventTCYStake) New() protoreflect.Message {
-	return new(fastReflection_EventTCYStake)
+func (x *fastReflection_EventTCYUnstake) New() protoreflect.Message {
+	return new(fastReflection_EventTCYUnstake)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventTCYStake) Interface() protoreflect.ProtoMessage {
-	return (*EventTCYStake)(x)
+func (x *fastReflection_EventTCYUnstake) Interface() protoreflect.ProtoMessage {
+	return (*EventTCYUnstake)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -30974,16 +33773,16 @@ func (x *fastReflection_EventTCYStake) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventTCYStake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventTCYUnstake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_EventTCYStake_address, value) {
+		if !f(fd_EventTCYUnstake_address, value) {
 			return
 		}
 	}
 	if x.Amount != "" {
 		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventTCYStake_amount, value) {
+		if !f(fd_EventTCYUnstake_amount, value) {
 			return
 		}
 	}
@@ -31000,17 +33799,17 @@ func (x *fastReflection_EventTCYStake) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventTCYStake) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventTCYUnstake) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventTCYStake.address":
+	case "types.EventTCYUnstake.address":
 		return x.Address != ""
-	case "types.EventTCYStake.amount":
+	case "types.EventTCYUnstake.amount":
 		return x.Amount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
 		}
-		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -31020,17 +33819,17 @@ func (x *fastReflection_EventTCYStake) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYStake) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventTCYUnstake) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventTCYStake.address":
+	case "types.EventTCYUnstake.address":
 		x.Address = ""
-	case "types.EventTCYStake.amount":
+	case "types.EventTCYUnstake.amount":
 		x.Amount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
 		}
-		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -31040,19 +33839,19 @@ func (x *fastReflection_EventTCYStake) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventTCYStake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTCYUnstake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventTCYStake.address":
+	case "types.EventTCYUnstake.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "types.EventTCYStake.amount":
+	case "types.EventTCYUnstake.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
 		}
-		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -31066,17 +33865,17 @@ func (x *fastReflection_EventTCYStake) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYStake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventTCYUnstake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventTCYStake.address":
+	case "types.EventTCYUnstake.address":
 		x.Address = value.Interface().(string)
-	case "types.EventTCYStake.amount":
+	case "types.EventTCYUnstake.amount":
 		x.Amount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
 		}
-		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -31090,44 +33889,44 @@ func (x *fastReflection_EventTCYStake) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYStake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTCYUnstake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTCYStake.address":
-		panic(fmt.Errorf("field address of message types.EventTCYStake is not mutable"))
-	case "types.EventTCYStake.amount":
-		panic(fmt.Errorf("field amount of message types.EventTCYStake is not mutable"))
+	case "types.EventTCYUnstake.address":
+		panic(fmt.Errorf("field address of message types.EventTCYUnstake is not mutable"))
+	case "types.EventTCYUnstake.amount":
+		panic(fmt.Errorf("field amount of message types.EventTCYUnstake is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
 		}
-		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventTCYStake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventTCYUnstake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTCYStake.address":
+	case "types.EventTCYUnstake.address":
 		return protoreflect.ValueOfString("")
-	case "types.EventTCYStake.amount":
+	case "types.EventTCYUnstake.amount":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYStake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
 		}
-		panic(fmt.Errorf("message types.EventTCYStake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventTCYStake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventTCYUnstake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventTCYStake", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventTCYUnstake", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -31135,7 +33934,7 @@ func (x *fastReflection_EventTCYStake) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventTCYStake) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventTCYUnstake) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -31146,7 +33945,7 @@ func (x *fastReflection_EventTCYStake) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYStake) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventTCYUnstake) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -31158,7 +33957,7 @@ func (x *fastReflection_EventTCYStake) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventTCYStake) IsValid() bool {
+func (x *fastReflection_EventTCYUnstake) IsValid() bool {
 	return x != nil
 }
 
@@ -31168,9 +33967,9 @@ func (x *fastReflection_EventTCYStake) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventTCYStake) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventTCYStake)
+		x := input.Message.Interface().(*EventTCYUnstake)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -31200,7 +33999,7 @@ func (x *fastReflection_EventTCYStake) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventTCYStake)
+		x := input.Message.Interface().(*EventTCYUnstake)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -31244,7 +34043,7 @@ func (x *fastReflection_EventTCYStake) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventTCYStake)
+		x := input.Message.Interface().(*EventTCYUnstake)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -31276,10 +34075,10 @@ func (x *fastReflection_EventTCYStake) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYStake: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYUnstake: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYStake: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -31382,28 +34181,34 @@ func (x *fastReflection_EventTCYStake) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventTCYUnstake         protoreflect.MessageDescriptor
-	fd_EventTCYUnstake_address protoreflect.FieldDescriptor
-	fd_EventTCYUnstake_amount  protoreflect.FieldDescriptor
+	md_EventPriceBreaker               protoreflect.MessageDescriptor
+	fd_EventPriceBreaker_pool          protoreflect.FieldDescriptor
+	fd_EventPriceBreaker_price         protoreflect.FieldDescriptor
+	fd_EventPriceBreaker_twap          protoreflect.FieldDescriptor
+	fd_EventPriceBreaker_deviation_bps protoreflect.FieldDescriptor
+	fd_EventPriceBreaker_pause_height  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_types_type_events_proto_init()
-	md_EventTCYUnstake = File_types_type_events_proto.Messages().ByName("EventTCYUnstake")
-	fd_EventTCYUnstake_address = md_EventTCYUnstake.Fields().ByName("address")
-	fd_EventTCYUnstake_amount = md_EventTCYUnstake.Fields().ByName("amount")
+	md_EventPriceBreaker = File_types_type_events_proto.Messages().ByName("EventPriceBreaker")
+	fd_EventPriceBreaker_pool = md_EventPriceBreaker.Fields().ByName("pool")
+	fd_EventPriceBreaker_price = md_EventPriceBreaker.Fields().ByName("price")
+	fd_EventPriceBreaker_twap = md_EventPriceBreaker.Fields().ByName("twap")
+	fd_EventPriceBreaker_deviation_bps = md_EventPriceBreaker.Fields().ByName("deviation_bps")
+	fd_EventPriceBreaker_pause_height = md_EventPriceBreaker.Fields().ByName("pause_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventTCYUnstake)(nil)
+var _ protoreflect.Message = (*fastReflection_EventPriceBreaker)(nil)
 
-type fastReflection_EventTCYUnstake EventTCYUnstake
+type fastReflection_EventPriceBreaker EventPriceBreaker
 
-func (x *EventTCYUnstake) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventTCYUnstake)(x)
+func (x *EventPriceBreaker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventPriceBreaker)(x)
 }
 
-func (x *EventTCYUnstake) slowProtoReflect() protoreflect.Message {
-	mi := &file_types_type_events_proto_msgTypes[49]
+func (x *EventPriceBreaker) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_events_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -31414,43 +34219,43 @@ func (x *EventTCYUnstake) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventTCYUnstake_messageType fastReflection_EventTCYUnstake_messageType
-var _ protoreflect.MessageType = fastReflection_EventTCYUnstake_messageType{}
+var _fastReflection_EventPriceBreaker_messageType fastReflection_EventPriceBreaker_messageType
+var _ protoreflect.MessageType = fastReflection_EventPriceBreaker_messageType{}
 
-type fastReflection_EventTCYUnstake_messageType struct{}
+type fastReflection_EventPriceBreaker_messageType struct{}
 
-func (x fastReflection_EventTCYUnstake_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventTCYUnstake)(nil)
+func (x fastReflection_EventPriceBreaker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventPriceBreaker)(nil)
 }
-func (x fastReflection_EventTCYUnstake_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventTCYUnstake)
+func (x fastReflection_EventPriceBreaker_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventPriceBreaker)
 }
-func (x fastReflection_EventTCYUnstake_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTCYUnstake
+func (x fastReflection_EventPriceBreaker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPriceBreaker
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventTCYUnstake) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventTCYUnstake
+func (x *fastReflection_EventPriceBreaker) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventPriceBreaker
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventTCYUnstake) Type() protoreflect.MessageType {
-	return _fastReflection_EventTCYUnstake_messageType
+func (x *fastReflection_EventPriceBreaker) Type() protoreflect.MessageType {
+	return _fastReflection_EventPriceBreaker_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventTCYUnstake) New() protoreflect.Message {
-	return new(fastReflection_EventTCYUnstake)
+func (x *fastReflection_EventPriceBreaker) New() protoreflect.Message {
+	return new(fastReflection_EventPriceBreaker)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventTCYUnstake) Interface() protoreflect.ProtoMessage {
-	return (*EventTCYUnstake)(x)
+func (x *fastReflection_EventPriceBreaker) Interface() protoreflect.ProtoMessage {
+	return (*EventPriceBreaker)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -31458,16 +34263,34 @@ func (x *fastReflection_EventTCYUnstake) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventTCYUnstake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_EventTCYUnstake_address, value) {
+func (x *fastReflection_EventPriceBreaker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pool != nil {
+		value := protoreflect.ValueOfMessage(x.Pool.ProtoReflect())
+		if !f(fd_EventPriceBreaker_pool, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventTCYUnstake_amount, value) {
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_EventPriceBreaker_price, value) {
+			return
+		}
+	}
+	if x.Twap != "" {
+		value := protoreflect.ValueOfString(x.Twap)
+		if !f(fd_EventPriceBreaker_twap, value) {
+			return
+		}
+	}
+	if x.DeviationBps != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeviationBps)
+		if !f(fd_EventPriceBreaker_deviation_bps, value) {
+			return
+		}
+	}
+	if x.PauseHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PauseHeight)
+		if !f(fd_EventPriceBreaker_pause_height, value) {
 			return
 		}
 	}
@@ -31484,17 +34307,23 @@ func (x *fastReflection_EventTCYUnstake) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventTCYUnstake) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventPriceBreaker) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "types.EventTCYUnstake.address":
-		return x.Address != ""
-	case "types.EventTCYUnstake.amount":
-		return x.Amount != ""
+	case "types.EventPriceBreaker.pool":
+		return x.Pool != nil
+	case "types.EventPriceBreaker.price":
+		return x.Price != ""
+	case "types.EventPriceBreaker.twap":
+		return x.Twap != ""
+	case "types.EventPriceBreaker.deviation_bps":
+		return x.DeviationBps != int64(0)
+	case "types.EventPriceBreaker.pause_height":
+		return x.PauseHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPriceBreaker"))
 		}
-		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPriceBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -31504,17 +34333,23 @@ func (x *fastReflection_EventTCYUnstake) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYUnstake) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventPriceBreaker) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "types.EventTCYUnstake.address":
-		x.Address = ""
-	case "types.EventTCYUnstake.amount":
-		x.Amount = ""
+	case "types.EventPriceBreaker.pool":
+		x.Pool = nil
+	case "types.EventPriceBreaker.price":
+		x.Price = ""
+	case "types.EventPriceBreaker.twap":
+		x.Twap = ""
+	case "types.EventPriceBreaker.deviation_bps":
+		x.DeviationBps = int64(0)
+	case "types.EventPriceBreaker.pause_height":
+		x.PauseHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPriceBreaker"))
 		}
-		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPriceBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -31524,19 +34359,28 @@ func (x *fastReflection_EventTCYUnstake) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventTCYUnstake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventPriceBreaker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "types.EventTCYUnstake.address":
-		value := x.Address
+	case "types.EventPriceBreaker.pool":
+		value := x.Pool
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "types.EventPriceBreaker.price":
+		value := x.Price
 		return protoreflect.ValueOfString(value)
-	case "types.EventTCYUnstake.amount":
-		value := x.Amount
+	case "types.EventPriceBreaker.twap":
+		value := x.Twap
 		return protoreflect.ValueOfString(value)
+	case "types.EventPriceBreaker.deviation_bps":
+		value := x.DeviationBps
+		return protoreflect.ValueOfInt64(value)
+	case "types.EventPriceBreaker.pause_height":
+		value := x.PauseHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPriceBreaker"))
 		}
-		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message types.EventPriceBreaker does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -31550,17 +34394,23 @@ func (x *fastReflection_EventTCYUnstake) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYUnstake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventPriceBreaker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "types.EventTCYUnstake.address":
-		x.Address = value.Interface().(string)
-	case "types.EventTCYUnstake.amount":
-		x.Amount = value.Interface().(string)
+	case "types.EventPriceBreaker.pool":
+		x.Pool = value.Message().Interface().(*common.Asset)
+	case "types.EventPriceBreaker.price":
+		x.Price = value.Interface().(string)
+	case "types.EventPriceBreaker.twap":
+		x.Twap = value.Interface().(string)
+	case "types.EventPriceBreaker.deviation_bps":
+		x.DeviationBps = value.Int()
+	case "types.EventPriceBreaker.pause_height":
+		x.PauseHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPriceBreaker"))
 		}
-		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPriceBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -31574,44 +34424,60 @@ func (x *fastReflection_EventTCYUnstake) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYUnstake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventPriceBreaker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTCYUnstake.address":
-		panic(fmt.Errorf("field address of message types.EventTCYUnstake is not mutable"))
-	case "types.EventTCYUnstake.amount":
-		panic(fmt.Errorf("field amount of message types.EventTCYUnstake is not mutable"))
+	case "types.EventPriceBreaker.pool":
+		if x.Pool == nil {
+			x.Pool = new(common.Asset)
+		}
+		return protoreflect.ValueOfMessage(x.Pool.ProtoReflect())
+	case "types.EventPriceBreaker.price":
+		panic(fmt.Errorf("field price of message types.EventPriceBreaker is not mutable"))
+	case "types.EventPriceBreaker.twap":
+		panic(fmt.Errorf("field twap of message types.EventPriceBreaker is not mutable"))
+	case "types.EventPriceBreaker.deviation_bps":
+		panic(fmt.Errorf("field deviation_bps of message types.EventPriceBreaker is not mutable"))
+	case "types.EventPriceBreaker.pause_height":
+		panic(fmt.Errorf("field pause_height of message types.EventPriceBreaker is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPriceBreaker"))
 		}
-		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPriceBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventTCYUnstake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventPriceBreaker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "types.EventTCYUnstake.address":
+	case "types.EventPriceBreaker.pool":
+		m := new(common.Asset)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "types.EventPriceBreaker.price":
 		return protoreflect.ValueOfString("")
-	case "types.EventTCYUnstake.amount":
+	case "types.EventPriceBreaker.twap":
 		return protoreflect.ValueOfString("")
+	case "types.EventPriceBreaker.deviation_bps":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.EventPriceBreaker.pause_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventTCYUnstake"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.EventPriceBreaker"))
 		}
-		panic(fmt.Errorf("message types.EventTCYUnstake does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message types.EventPriceBreaker does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventTCYUnstake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventPriceBreaker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in types.EventTCYUnstake", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in types.EventPriceBreaker", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -31619,7 +34485,7 @@ func (x *fastReflection_EventTCYUnstake) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventTCYUnstake) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventPriceBreaker) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -31630,7 +34496,7 @@ func (x *fastReflection_EventTCYUnstake) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventTCYUnstake) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventPriceBreaker) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -31642,7 +34508,7 @@ func (x *fastReflection_EventTCYUnstake) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventTCYUnstake) IsValid() bool {
+func (x *fastReflection_EventPriceBreaker) IsValid() bool {
 	return x != nil
 }
 
@@ -31652,9 +34518,9 @@ func (x *fastReflection_EventTCYUnstake) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventPriceBreaker) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventTCYUnstake)
+		x := input.Message.Interface().(*EventPriceBreaker)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -31666,14 +34532,24 @@ func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		if x.Pool != nil {
+			l = options.Size(x.Pool)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Price)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
+		l = len(x.Twap)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DeviationBps != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeviationBps))
+		}
+		if x.PauseHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.PauseHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -31684,7 +34560,7 @@ func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventTCYUnstake)
+		x := input.Message.Interface().(*EventPriceBreaker)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -31703,17 +34579,41 @@ func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		if x.PauseHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PauseHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DeviationBps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeviationBps))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Twap) > 0 {
+			i -= len(x.Twap)
+			copy(dAtA[i:], x.Twap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Twap)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.Pool != nil {
+			encoded, err := options.Marshal(x.Pool)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -31728,7 +34628,7 @@ func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventTCYUnstake)
+		x := input.Message.Interface().(*EventPriceBreaker)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -31760,15 +34660,51 @@ func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYUnstake: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPriceBreaker: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTCYUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventPriceBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pool == nil {
+					x.Pool = &common.Asset{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pool); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -31796,11 +34732,11 @@ func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Price = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -31828,8 +34764,46 @@ func (x *fastReflection_EventTCYUnstake) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.Twap = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+				}
+				x.DeviationBps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeviationBps |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PauseHeight", wireType)
+				}
+				x.PauseHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PauseHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -32199,6 +35173,65 @@ func (x *EventModifyLimitSwap) GetModifiedTargetAmount() string {
 	return ""
 }
 
+type EventModifyStreamingSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	TxId     string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity uint64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *EventModifyStreamingSwap) Reset() {
+	*x = EventModifyStreamingSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_type_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventModifyStreamingSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventModifyStreamingSwap) ProtoMessage() {}
+
+// Deprecated: Use EventModifyStreamingSwap.ProtoReflect.Descriptor instead.
+func (*EventModifyStreamingSwap) Descriptor() ([]byte, []int) {
+	return file_types_type_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventModifyStreamingSwap) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *EventModifyStreamingSwap) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *EventModifyStreamingSwap) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *EventModifyStreamingSwap) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type EventStreamingSwap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -32220,7 +35253,7 @@ type EventStreamingSwap struct {
 func (x *EventStreamingSwap) Reset() {
 	*x = EventStreamingSwap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[3]
+		mi := &file_types_type_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32234,7 +35267,7 @@ func (*EventStreamingSwap) ProtoMessage() {}
 
 // Deprecated: Use EventStreamingSwap.ProtoReflect.Descriptor instead.
 func (*EventStreamingSwap) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{3}
+	return file_types_type_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EventStreamingSwap) GetTxId() string {
@@ -32314,6 +35347,121 @@ func (x *EventStreamingSwap) GetFailedSwapReasons() []string {
 	return nil
 }
 
+type EventRecurringSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId        string        `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Owner       []byte        `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	SourceAsset *common.Asset `protobuf:"bytes,3,opt,name=source_asset,json=sourceAsset,proto3" json:"source_asset,omitempty"`
+	TargetAsset *common.Asset `protobuf:"bytes,4,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset,omitempty"`
+	Destination string        `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount      string        `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Interval    uint64        `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity    uint64        `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Count       uint64        `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
+	Status      string        `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Reason      string        `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventRecurringSwap) Reset() {
+	*x = EventRecurringSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_type_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRecurringSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRecurringSwap) ProtoMessage() {}
+
+// Deprecated: Use EventRecurringSwap.ProtoReflect.Descriptor instead.
+func (*EventRecurringSwap) Descriptor() ([]byte, []int) {
+	return file_types_type_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventRecurringSwap) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *EventRecurringSwap) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *EventRecurringSwap) GetSourceAsset() *common.Asset {
+	if x != nil {
+		return x.SourceAsset
+	}
+	return nil
+}
+
+func (x *EventRecurringSwap) GetTargetAsset() *common.Asset {
+	if x != nil {
+		return x.TargetAsset
+	}
+	return nil
+}
+
+func (x *EventRecurringSwap) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *EventRecurringSwap) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventRecurringSwap) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *EventRecurringSwap) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *EventRecurringSwap) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EventRecurringSwap) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EventRecurringSwap) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type EventSwap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -32336,7 +35484,7 @@ type EventSwap struct {
 func (x *EventSwap) Reset() {
 	*x = EventSwap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[4]
+		mi := &file_types_type_events_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32350,7 +35498,7 @@ func (*EventSwap) ProtoMessage() {}
 
 // Deprecated: Use EventSwap.ProtoReflect.Descriptor instead.
 func (*EventSwap) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{4}
+	return file_types_type_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EventSwap) GetPool() *common.Asset {
@@ -32455,7 +35603,7 @@ type EventAffiliateFee struct {
 func (x *EventAffiliateFee) Reset() {
 	*x = EventAffiliateFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[5]
+		mi := &file_types_type_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32469,7 +35617,7 @@ func (*EventAffiliateFee) ProtoMessage() {}
 
 // Deprecated: Use EventAffiliateFee.ProtoReflect.Descriptor instead.
 func (*EventAffiliateFee) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{5}
+	return file_types_type_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventAffiliateFee) GetTxId() string {
@@ -32546,7 +35694,7 @@ type EventAddLiquidity struct {
 func (x *EventAddLiquidity) Reset() {
 	*x = EventAddLiquidity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[6]
+		mi := &file_types_type_events_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32560,7 +35708,7 @@ func (*EventAddLiquidity) ProtoMessage() {}
 
 // Deprecated: Use EventAddLiquidity.ProtoReflect.Descriptor instead.
 func (*EventAddLiquidity) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{6}
+	return file_types_type_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EventAddLiquidity) GetPool() *common.Asset {
@@ -32636,7 +35784,7 @@ type EventWithdraw struct {
 func (x *EventWithdraw) Reset() {
 	*x = EventWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[7]
+		mi := &file_types_type_events_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32650,7 +35798,7 @@ func (*EventWithdraw) ProtoMessage() {}
 
 // Deprecated: Use EventWithdraw.ProtoReflect.Descriptor instead.
 func (*EventWithdraw) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{7}
+	return file_types_type_events_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EventWithdraw) GetPool() *common.Asset {
@@ -32720,7 +35868,7 @@ type EventPendingLiquidity struct {
 func (x *EventPendingLiquidity) Reset() {
 	*x = EventPendingLiquidity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[8]
+		mi := &file_types_type_events_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32734,7 +35882,7 @@ func (*EventPendingLiquidity) ProtoMessage() {}
 
 // Deprecated: Use EventPendingLiquidity.ProtoReflect.Descriptor instead.
 func (*EventPendingLiquidity) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{8}
+	return file_types_type_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *EventPendingLiquidity) GetPool() *common.Asset {
@@ -32805,7 +35953,7 @@ type EventDonate struct {
 func (x *EventDonate) Reset() {
 	*x = EventDonate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[9]
+		mi := &file_types_type_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32819,7 +35967,7 @@ func (*EventDonate) ProtoMessage() {}
 
 // Deprecated: Use EventDonate.ProtoReflect.Descriptor instead.
 func (*EventDonate) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{9}
+	return file_types_type_events_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EventDonate) GetPool() *common.Asset {
@@ -32848,7 +35996,7 @@ type EventPool struct {
 func (x *EventPool) Reset() {
 	*x = EventPool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[10]
+		mi := &file_types_type_events_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32862,7 +36010,7 @@ func (*EventPool) ProtoMessage() {}
 
 // Deprecated: Use EventPool.ProtoReflect.Descriptor instead.
 func (*EventPool) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{10}
+	return file_types_type_events_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EventPool) GetPool() *common.Asset {
@@ -32891,7 +36039,7 @@ type PoolAmt struct {
 func (x *PoolAmt) Reset() {
 	*x = PoolAmt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[11]
+		mi := &file_types_type_events_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32905,7 +36053,7 @@ func (*PoolAmt) ProtoMessage() {}
 
 // Deprecated: Use PoolAmt.ProtoReflect.Descriptor instead.
 func (*PoolAmt) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{11}
+	return file_types_type_events_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PoolAmt) GetAsset() *common.Asset {
@@ -32937,7 +36085,7 @@ type EventRewards struct {
 func (x *EventRewards) Reset() {
 	*x = EventRewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[12]
+		mi := &file_types_type_events_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -32951,7 +36099,7 @@ func (*EventRewards) ProtoMessage() {}
 
 // Deprecated: Use EventRewards.ProtoReflect.Descriptor instead.
 func (*EventRewards) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{12}
+	return file_types_type_events_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *EventRewards) GetBondReward() string {
@@ -33003,7 +36151,7 @@ type EventRefund struct {
 func (x *EventRefund) Reset() {
 	*x = EventRefund{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[13]
+		mi := &file_types_type_events_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33017,7 +36165,7 @@ func (*EventRefund) ProtoMessage() {}
 
 // Deprecated: Use EventRefund.ProtoReflect.Descriptor instead.
 func (*EventRefund) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{13}
+	return file_types_type_events_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *EventRefund) GetCode() uint32 {
@@ -33063,7 +36211,7 @@ type EventBond struct {
 func (x *EventBond) Reset() {
 	*x = EventBond{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[14]
+		mi := &file_types_type_events_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33077,7 +36225,7 @@ func (*EventBond) ProtoMessage() {}
 
 // Deprecated: Use EventBond.ProtoReflect.Descriptor instead.
 func (*EventBond) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{14}
+	return file_types_type_events_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EventBond) GetAmount() string {
@@ -33130,7 +36278,7 @@ type EventReBond struct {
 func (x *EventReBond) Reset() {
 	*x = EventReBond{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[15]
+		mi := &file_types_type_events_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33144,7 +36292,7 @@ func (*EventReBond) ProtoMessage() {}
 
 // Deprecated: Use EventReBond.ProtoReflect.Descriptor instead.
 func (*EventReBond) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{15}
+	return file_types_type_events_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *EventReBond) GetAmount() string {
@@ -33196,7 +36344,7 @@ type GasPool struct {
 func (x *GasPool) Reset() {
 	*x = GasPool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[16]
+		mi := &file_types_type_events_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33210,7 +36358,7 @@ func (*GasPool) ProtoMessage() {}
 
 // Deprecated: Use GasPool.ProtoReflect.Descriptor instead.
 func (*GasPool) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{16}
+	return file_types_type_events_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GasPool) GetAsset() *common.Asset {
@@ -33252,7 +36400,7 @@ type EventGas struct {
 func (x *EventGas) Reset() {
 	*x = EventGas{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[17]
+		mi := &file_types_type_events_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33266,7 +36414,7 @@ func (*EventGas) ProtoMessage() {}
 
 // Deprecated: Use EventGas.ProtoReflect.Descriptor instead.
 func (*EventGas) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{17}
+	return file_types_type_events_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *EventGas) GetPools() []*GasPool {
@@ -33288,7 +36436,7 @@ type EventReserve struct {
 func (x *EventReserve) Reset() {
 	*x = EventReserve{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[18]
+		mi := &file_types_type_events_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33302,7 +36450,7 @@ func (*EventReserve) ProtoMessage() {}
 
 // Deprecated: Use EventReserve.ProtoReflect.Descriptor instead.
 func (*EventReserve) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{18}
+	return file_types_type_events_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EventReserve) GetReserveContributor() *ReserveContributor {
@@ -33330,7 +36478,7 @@ type EventScheduledOutbound struct {
 func (x *EventScheduledOutbound) Reset() {
 	*x = EventScheduledOutbound{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[19]
+		mi := &file_types_type_events_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33344,7 +36492,7 @@ func (*EventScheduledOutbound) ProtoMessage() {}
 
 // Deprecated: Use EventScheduledOutbound.ProtoReflect.Descriptor instead.
 func (*EventScheduledOutbound) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{19}
+	return file_types_type_events_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EventScheduledOutbound) GetOutTx() *TxOutItem {
@@ -33366,7 +36514,7 @@ type EventSecurity struct {
 func (x *EventSecurity) Reset() {
 	*x = EventSecurity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[20]
+		mi := &file_types_type_events_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33380,7 +36528,7 @@ func (*EventSecurity) ProtoMessage() {}
 
 // Deprecated: Use EventSecurity.ProtoReflect.Descriptor instead.
 func (*EventSecurity) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{20}
+	return file_types_type_events_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *EventSecurity) GetMsg() string {
@@ -33409,7 +36557,7 @@ type EventSlash struct {
 func (x *EventSlash) Reset() {
 	*x = EventSlash{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[21]
+		mi := &file_types_type_events_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33423,7 +36571,7 @@ func (*EventSlash) ProtoMessage() {}
 
 // Deprecated: Use EventSlash.ProtoReflect.Descriptor instead.
 func (*EventSlash) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{21}
+	return file_types_type_events_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *EventSlash) GetPool() *common.Asset {
@@ -33452,7 +36600,7 @@ type EventErrata struct {
 func (x *EventErrata) Reset() {
 	*x = EventErrata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[22]
+		mi := &file_types_type_events_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33466,7 +36614,7 @@ func (*EventErrata) ProtoMessage() {}
 
 // Deprecated: Use EventErrata.ProtoReflect.Descriptor instead.
 func (*EventErrata) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{22}
+	return file_types_type_events_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *EventErrata) GetTxId() string {
@@ -33496,7 +36644,7 @@ type EventFee struct {
 func (x *EventFee) Reset() {
 	*x = EventFee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[23]
+		mi := &file_types_type_events_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33510,7 +36658,7 @@ func (*EventFee) ProtoMessage() {}
 
 // Deprecated: Use EventFee.ProtoReflect.Descriptor instead.
 func (*EventFee) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{23}
+	return file_types_type_events_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *EventFee) GetTxId() string {
@@ -33546,7 +36694,7 @@ type EventOutbound struct {
 func (x *EventOutbound) Reset() {
 	*x = EventOutbound{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[24]
+		mi := &file_types_type_events_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33560,7 +36708,7 @@ func (*EventOutbound) ProtoMessage() {}
 
 // Deprecated: Use EventOutbound.ProtoReflect.Descriptor instead.
 func (*EventOutbound) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{24}
+	return file_types_type_events_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *EventOutbound) GetInTxId() string {
@@ -33590,7 +36738,7 @@ type EventTssKeygenSuccess struct {
 func (x *EventTssKeygenSuccess) Reset() {
 	*x = EventTssKeygenSuccess{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[25]
+		mi := &file_types_type_events_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33604,7 +36752,7 @@ func (*EventTssKeygenSuccess) ProtoMessage() {}
 
 // Deprecated: Use EventTssKeygenSuccess.ProtoReflect.Descriptor instead.
 func (*EventTssKeygenSuccess) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{25}
+	return file_types_type_events_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *EventTssKeygenSuccess) GetPubKey() string {
@@ -33643,7 +36791,7 @@ type EventTssKeygenFailure struct {
 func (x *EventTssKeygenFailure) Reset() {
 	*x = EventTssKeygenFailure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[26]
+		mi := &file_types_type_events_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33657,7 +36805,7 @@ func (*EventTssKeygenFailure) ProtoMessage() {}
 
 // Deprecated: Use EventTssKeygenFailure.ProtoReflect.Descriptor instead.
 func (*EventTssKeygenFailure) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{26}
+	return file_types_type_events_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *EventTssKeygenFailure) GetFailReason() string {
@@ -33707,7 +36855,7 @@ type EventTssKeygenMetric struct {
 func (x *EventTssKeygenMetric) Reset() {
 	*x = EventTssKeygenMetric{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[27]
+		mi := &file_types_type_events_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33721,7 +36869,7 @@ func (*EventTssKeygenMetric) ProtoMessage() {}
 
 // Deprecated: Use EventTssKeygenMetric.ProtoReflect.Descriptor instead.
 func (*EventTssKeygenMetric) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{27}
+	return file_types_type_events_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *EventTssKeygenMetric) GetPubKey() string {
@@ -33750,7 +36898,7 @@ type EventTssKeysignMetric struct {
 func (x *EventTssKeysignMetric) Reset() {
 	*x = EventTssKeysignMetric{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[28]
+		mi := &file_types_type_events_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33764,7 +36912,7 @@ func (*EventTssKeysignMetric) ProtoMessage() {}
 
 // Deprecated: Use EventTssKeysignMetric.ProtoReflect.Descriptor instead.
 func (*EventTssKeysignMetric) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{28}
+	return file_types_type_events_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *EventTssKeysignMetric) GetTxId() string {
@@ -33794,7 +36942,7 @@ type EventSlashPoint struct {
 func (x *EventSlashPoint) Reset() {
 	*x = EventSlashPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[29]
+		mi := &file_types_type_events_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33808,7 +36956,7 @@ func (*EventSlashPoint) ProtoMessage() {}
 
 // Deprecated: Use EventSlashPoint.ProtoReflect.Descriptor instead.
 func (*EventSlashPoint) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{29}
+	return file_types_type_events_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *EventSlashPoint) GetNodeAddress() []byte {
@@ -33844,7 +36992,7 @@ type EventPoolBalanceChanged struct {
 func (x *EventPoolBalanceChanged) Reset() {
 	*x = EventPoolBalanceChanged{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[30]
+		mi := &file_types_type_events_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33858,7 +37006,7 @@ func (*EventPoolBalanceChanged) ProtoMessage() {}
 
 // Deprecated: Use EventPoolBalanceChanged.ProtoReflect.Descriptor instead.
 func (*EventPoolBalanceChanged) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{30}
+	return file_types_type_events_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *EventPoolBalanceChanged) GetPoolChange() *PoolMod {
@@ -33889,7 +37037,7 @@ type EventMintBurn struct {
 func (x *EventMintBurn) Reset() {
 	*x = EventMintBurn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[31]
+		mi := &file_types_type_events_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33903,7 +37051,7 @@ func (*EventMintBurn) ProtoMessage() {}
 
 // Deprecated: Use EventMintBurn.ProtoReflect.Descriptor instead.
 func (*EventMintBurn) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{31}
+	return file_types_type_events_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *EventMintBurn) GetSupply() MintBurnSupplyType {
@@ -33949,7 +37097,7 @@ type EventTradeAccountDeposit struct {
 func (x *EventTradeAccountDeposit) Reset() {
 	*x = EventTradeAccountDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[32]
+		mi := &file_types_type_events_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -33963,7 +37111,7 @@ func (*EventTradeAccountDeposit) ProtoMessage() {}
 
 // Deprecated: Use EventTradeAccountDeposit.ProtoReflect.Descriptor instead.
 func (*EventTradeAccountDeposit) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{32}
+	return file_types_type_events_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *EventTradeAccountDeposit) GetAmount() string {
@@ -34016,7 +37164,7 @@ type EventTradeAccountWithdraw struct {
 func (x *EventTradeAccountWithdraw) Reset() {
 	*x = EventTradeAccountWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[33]
+		mi := &file_types_type_events_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34030,7 +37178,7 @@ func (*EventTradeAccountWithdraw) ProtoMessage() {}
 
 // Deprecated: Use EventTradeAccountWithdraw.ProtoReflect.Descriptor instead.
 func (*EventTradeAccountWithdraw) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{33}
+	return file_types_type_events_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *EventTradeAccountWithdraw) GetAmount() string {
@@ -34068,6 +37216,73 @@ func (x *EventTradeAccountWithdraw) GetTxId() string {
 	return ""
 }
 
+type EventTradeAccountTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount      string        `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Asset       *common.Asset `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	FromAddress string        `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string        `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	TxId        string        `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *EventTradeAccountTransfer) Reset() {
+	*x = EventTradeAccountTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_type_events_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTradeAccountTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTradeAccountTransfer) ProtoMessage() {}
+
+// Deprecated: Use EventTradeAccountTransfer.ProtoReflect.Descriptor instead.
+func (*EventTradeAccountTransfer) Descriptor() ([]byte, []int) {
+	return file_types_type_events_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EventTradeAccountTransfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventTradeAccountTransfer) GetAsset() *common.Asset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+func (x *EventTradeAccountTransfer) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *EventTradeAccountTransfer) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *EventTradeAccountTransfer) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
 type EventSecuredAssetDeposit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -34083,7 +37298,7 @@ type EventSecuredAssetDeposit struct {
 func (x *EventSecuredAssetDeposit) Reset() {
 	*x = EventSecuredAssetDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[34]
+		mi := &file_types_type_events_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34097,7 +37312,7 @@ func (*EventSecuredAssetDeposit) ProtoMessage() {}
 
 // Deprecated: Use EventSecuredAssetDeposit.ProtoReflect.Descriptor instead.
 func (*EventSecuredAssetDeposit) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{34}
+	return file_types_type_events_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *EventSecuredAssetDeposit) GetAmount() string {
@@ -34150,7 +37365,7 @@ type EventSecuredAssetWithdraw struct {
 func (x *EventSecuredAssetWithdraw) Reset() {
 	*x = EventSecuredAssetWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[35]
+		mi := &file_types_type_events_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34164,7 +37379,7 @@ func (*EventSecuredAssetWithdraw) ProtoMessage() {}
 
 // Deprecated: Use EventSecuredAssetWithdraw.ProtoReflect.Descriptor instead.
 func (*EventSecuredAssetWithdraw) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{35}
+	return file_types_type_events_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *EventSecuredAssetWithdraw) GetAmount() string {
@@ -34216,7 +37431,7 @@ type EventRUNEPoolDeposit struct {
 func (x *EventRUNEPoolDeposit) Reset() {
 	*x = EventRUNEPoolDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[36]
+		mi := &file_types_type_events_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34230,7 +37445,7 @@ func (*EventRUNEPoolDeposit) ProtoMessage() {}
 
 // Deprecated: Use EventRUNEPoolDeposit.ProtoReflect.Descriptor instead.
 func (*EventRUNEPoolDeposit) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{36}
+	return file_types_type_events_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *EventRUNEPoolDeposit) GetRuneAddress() []byte {
@@ -34279,7 +37494,7 @@ type EventRUNEPoolWithdraw struct {
 func (x *EventRUNEPoolWithdraw) Reset() {
 	*x = EventRUNEPoolWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[37]
+		mi := &file_types_type_events_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34293,7 +37508,7 @@ func (*EventRUNEPoolWithdraw) ProtoMessage() {}
 
 // Deprecated: Use EventRUNEPoolWithdraw.ProtoReflect.Descriptor instead.
 func (*EventRUNEPoolWithdraw) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{37}
+	return file_types_type_events_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *EventRUNEPoolWithdraw) GetRuneAddress() []byte {
@@ -34369,7 +37584,7 @@ type EventLoanOpen struct {
 func (x *EventLoanOpen) Reset() {
 	*x = EventLoanOpen{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[38]
+		mi := &file_types_type_events_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34383,7 +37598,7 @@ func (*EventLoanOpen) ProtoMessage() {}
 
 // Deprecated: Use EventLoanOpen.ProtoReflect.Descriptor instead.
 func (*EventLoanOpen) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{38}
+	return file_types_type_events_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *EventLoanOpen) GetCollateralDeposited() string {
@@ -34450,7 +37665,7 @@ type EventLoanRepayment struct {
 func (x *EventLoanRepayment) Reset() {
 	*x = EventLoanRepayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[39]
+		mi := &file_types_type_events_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34464,7 +37679,7 @@ func (*EventLoanRepayment) ProtoMessage() {}
 
 // Deprecated: Use EventLoanRepayment.ProtoReflect.Descriptor instead.
 func (*EventLoanRepayment) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{39}
+	return file_types_type_events_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *EventLoanRepayment) GetCollateralWithdrawn() string {
@@ -34519,7 +37734,7 @@ type EventTHORName struct {
 func (x *EventTHORName) Reset() {
 	*x = EventTHORName{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[40]
+		mi := &file_types_type_events_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34533,7 +37748,7 @@ func (*EventTHORName) ProtoMessage() {}
 
 // Deprecated: Use EventTHORName.ProtoReflect.Descriptor instead.
 func (*EventTHORName) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{40}
+	return file_types_type_events_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *EventTHORName) GetName() string {
@@ -34597,7 +37812,7 @@ type EventSetMimir struct {
 func (x *EventSetMimir) Reset() {
 	*x = EventSetMimir{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[41]
+		mi := &file_types_type_events_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34611,7 +37826,7 @@ func (*EventSetMimir) ProtoMessage() {}
 
 // Deprecated: Use EventSetMimir.ProtoReflect.Descriptor instead.
 func (*EventSetMimir) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{41}
+	return file_types_type_events_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *EventSetMimir) GetKey() string {
@@ -34641,7 +37856,7 @@ type EventSetNodeMimir struct {
 func (x *EventSetNodeMimir) Reset() {
 	*x = EventSetNodeMimir{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[42]
+		mi := &file_types_type_events_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34655,7 +37870,7 @@ func (*EventSetNodeMimir) ProtoMessage() {}
 
 // Deprecated: Use EventSetNodeMimir.ProtoReflect.Descriptor instead.
 func (*EventSetNodeMimir) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{42}
+	return file_types_type_events_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *EventSetNodeMimir) GetKey() string {
@@ -34690,7 +37905,7 @@ type EventVersion struct {
 func (x *EventVersion) Reset() {
 	*x = EventVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[43]
+		mi := &file_types_type_events_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34704,7 +37919,7 @@ func (*EventVersion) ProtoMessage() {}
 
 // Deprecated: Use EventVersion.ProtoReflect.Descriptor instead.
 func (*EventVersion) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{43}
+	return file_types_type_events_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *EventVersion) GetVersion() string {
@@ -34729,7 +37944,7 @@ type EventSwitch struct {
 func (x *EventSwitch) Reset() {
 	*x = EventSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[44]
+		mi := &file_types_type_events_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34743,7 +37958,7 @@ func (*EventSwitch) ProtoMessage() {}
 
 // Deprecated: Use EventSwitch.ProtoReflect.Descriptor instead.
 func (*EventSwitch) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{44}
+	return file_types_type_events_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *EventSwitch) GetAmount() string {
@@ -34794,7 +38009,7 @@ type EventOperatorRotate struct {
 func (x *EventOperatorRotate) Reset() {
 	*x = EventOperatorRotate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[45]
+		mi := &file_types_type_events_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34808,7 +38023,7 @@ func (*EventOperatorRotate) ProtoMessage() {}
 
 // Deprecated: Use EventOperatorRotate.ProtoReflect.Descriptor instead.
 func (*EventOperatorRotate) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{45}
+	return file_types_type_events_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *EventOperatorRotate) GetSigner() []byte {
@@ -34844,7 +38059,7 @@ type EventTCYDistribution struct {
 func (x *EventTCYDistribution) Reset() {
 	*x = EventTCYDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[46]
+		mi := &file_types_type_events_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34858,7 +38073,7 @@ func (*EventTCYDistribution) ProtoMessage() {}
 
 // Deprecated: Use EventTCYDistribution.ProtoReflect.Descriptor instead.
 func (*EventTCYDistribution) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{46}
+	return file_types_type_events_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *EventTCYDistribution) GetRuneAddress() []byte {
@@ -34889,7 +38104,7 @@ type EventTCYClaim struct {
 func (x *EventTCYClaim) Reset() {
 	*x = EventTCYClaim{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[47]
+		mi := &file_types_type_events_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34903,7 +38118,7 @@ func (*EventTCYClaim) ProtoMessage() {}
 
 // Deprecated: Use EventTCYClaim.ProtoReflect.Descriptor instead.
 func (*EventTCYClaim) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{47}
+	return file_types_type_events_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *EventTCYClaim) GetRuneAddress() string {
@@ -34946,7 +38161,7 @@ type EventTCYStake struct {
 func (x *EventTCYStake) Reset() {
 	*x = EventTCYStake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[48]
+		mi := &file_types_type_events_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -34960,7 +38175,7 @@ func (*EventTCYStake) ProtoMessage() {}
 
 // Deprecated: Use EventTCYStake.ProtoReflect.Descriptor instead.
 func (*EventTCYStake) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{48}
+	return file_types_type_events_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *EventTCYStake) GetAddress() string {
@@ -34989,7 +38204,7 @@ type EventTCYUnstake struct {
 func (x *EventTCYUnstake) Reset() {
 	*x = EventTCYUnstake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_types_type_events_proto_msgTypes[49]
+		mi := &file_types_type_events_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -35003,7 +38218,7 @@ func (*EventTCYUnstake) ProtoMessage() {}
 
 // Deprecated: Use EventTCYUnstake.ProtoReflect.Descriptor instead.
 func (*EventTCYUnstake) Descriptor() ([]byte, []int) {
-	return file_types_type_events_proto_rawDescGZIP(), []int{49}
+	return file_types_type_events_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *EventTCYUnstake) GetAddress() string {
@@ -35020,6 +38235,73 @@ func (x *EventTCYUnstake) GetAmount() string {
 	return ""
 }
 
+type EventPriceBreaker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool         *common.Asset `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Price        string        `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Twap         string        `protobuf:"bytes,3,opt,name=twap,proto3" json:"twap,omitempty"`
+	DeviationBps int64         `protobuf:"varint,4,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+	PauseHeight  int64         `protobuf:"varint,5,opt,name=pause_height,json=pauseHeight,proto3" json:"pause_height,omitempty"`
+}
+
+func (x *EventPriceBreaker) Reset() {
+	*x = EventPriceBreaker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_type_events_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventPriceBreaker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPriceBreaker) ProtoMessage() {}
+
+// Deprecated: Use EventPriceBreaker.ProtoReflect.Descriptor instead.
+func (*EventPriceBreaker) Descriptor() ([]byte, []int) {
+	return file_types_type_events_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *EventPriceBreaker) GetPool() *common.Asset {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *EventPriceBreaker) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *EventPriceBreaker) GetTwap() string {
+	if x != nil {
+		return x.Twap
+	}
+	return ""
+}
+
+func (x *EventPriceBreaker) GetDeviationBps() int64 {
+	if x != nil {
+		return x.DeviationBps
+	}
+	return 0
+}
+
+func (x *EventPriceBreaker) GetPauseHeight() int64 {
+	if x != nil {
+		return x.PauseHeight
+	}
+	return 0
+}
+
 var File_types_type_events_proto protoreflect.FileDescriptor
 
 var file_types_type_events_proto_rawDesc = []byte{
@@ -35079,842 +38361,944 @@ var file_types_type_events_proto_rawDesc = []byte{
 	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
 	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x14, 0x6d,
 	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0xe0, 0x03, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54,
-	0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54,
-	0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61,
-	0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0b,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x6e, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x24, 0x0a,
-	0x03, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03,
-	0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x77,
-	0x61, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65,
-	0x64, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xe8, 0x05, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x53, 0x77, 0x61, 0x70, 0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3f,
-	0x0a, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x3b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
-	0x6e, 0x74, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x43, 0x0a, 0x0d,
-	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x52, 0x0c, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x46, 0x65,
-	0x65, 0x12, 0x51, 0x0a, 0x15, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x66,
-	0x65, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74,
-	0x52, 0x12, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x49, 0x6e,
-	0x52, 0x75, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x54, 0x78, 0x12, 0x29, 0x0a, 0x07, 0x6f,
-	0x75, 0x74, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
-	0x6f, 0x75, 0x74, 0x54, 0x78, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x6d, 0x69, 0x74, 0x5f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09,
-	0x65, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x79, 0x6e,
-	0x74, 0x68, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a,
-	0x73, 0x79, 0x6e, 0x74, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f,
-	0x73, 0x77, 0x61, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x12, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x6c, 0x69,
-	0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69,
-	0x70, 0x22, 0xe1, 0x03, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x66, 0x66, 0x69, 0x6c,
-	0x69, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x75, 0x6e, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x47, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33,
+	0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78,
+	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78,
+	0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0xe0, 0x03, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44,
 	0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
 	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
 	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44,
-	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68,
-	0x6f, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68,
-	0x6f, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde,
-	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5a,
-	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x24, 0x0a, 0x03, 0x6f, 0x75,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x6f, 0x75, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x05, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54,
+	0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54,
+	0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x67, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
+	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x67, 0x0a, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xe8, 0x05, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12,
+	0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde,
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
 	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x67, 0x72,
-	0x6f, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74,
-	0x52, 0x0b, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x66, 0x65, 0x65, 0x42, 0x70, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa5, 0x05, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41,
-	0x64, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x04, 0x70,
-	0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x45, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8,
+	0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a,
+	0x73, 0x77, 0x61, 0x70, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8,
 	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x0c,
-	0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x73,
+	0x77, 0x61, 0x70, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0c,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x12, 0x51, 0x0a, 0x15,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x12, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x52, 0x75, 0x6e, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x04, 0x69, 0x6e, 0x54, 0x78, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x78,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x54, 0x78,
+	0x73, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x65, 0x6d, 0x69, 0x74, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
 	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x74, 0x68,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a,
+	0x14, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x3b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x6c, 0x69, 0x70, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
+	0x6e, 0x74, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x6c, 0x69, 0x70, 0x22, 0xe1, 0x03, 0x0a,
+	0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x46,
+	0x65, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75,
+	0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
+	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
 	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x65,
-	0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xe2, 0xde,
-	0x1f, 0x08, 0x52, 0x75, 0x6e, 0x65, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69,
-	0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x65,
-	0x54, 0x78, 0x49, 0x64, 0x12, 0x5d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x78,
-	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xe2, 0xde, 0x1f, 0x09, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54,
-	0x78, 0x49, 0x64, 0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f,
-	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb9, 0x03,
-	0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
-	0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x45, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
-	0x74, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x61, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x09, 0x61, 0x73, 0x79,
-	0x6d, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54,
-	0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x54, 0x78, 0x12, 0x3d, 0x0a,
-	0x0a, 0x65, 0x6d, 0x69, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
-	0x74, 0x52, 0x09, 0x65, 0x6d, 0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x09,
-	0x65, 0x6d, 0x69, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52,
-	0x08, 0x65, 0x6d, 0x69, 0x74, 0x52, 0x75, 0x6e, 0x65, 0x22, 0xa2, 0x05, 0x0a, 0x15, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
-	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3e, 0x0a,
-	0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0b, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x56, 0x0a,
-	0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x73, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f,
+	0x62, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66, 0x65, 0x65, 0x42, 0x70,
+	0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x66, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xa5, 0x05, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c,
+	0x12, 0x45, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa,
 	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
 	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
 	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
 	0x12, 0x41, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
 	0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f,
 	0x75, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xe2, 0xde, 0x1f, 0x08, 0x52, 0x75, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xe2, 0xde, 0x1f, 0x08, 0x52, 0x75, 0x6e,
 	0x65, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
 	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x78, 0x49, 0x64, 0x12,
-	0x5d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x08,
+	0x5d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0xe2, 0xde, 0x1f, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54,
 	0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72,
 	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54,
-	0x78, 0x49, 0x44, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x49, 0x64, 0x22, 0x8e,
-	0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x58,
+	0x78, 0x49, 0x44, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x49, 0x64, 0x12, 0x58,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xb9, 0x03, 0x0a, 0x0d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
+	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x45, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x41,
+	0x0a, 0x09, 0x61, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x09, 0x61, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72,
+	0x79, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x54, 0x78, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x6d, 0x69, 0x74,
+	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6d,
+	0x69, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x65, 0x6d, 0x69, 0x74, 0x5f,
+	0x72, 0x75, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6d, 0x69, 0x74,
+	0x52, 0x75, 0x6e, 0x65, 0x22, 0xa2, 0x05, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x58,
 	0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f,
 	0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f,
 	0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x54, 0x78, 0x22,
-	0x90, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x58, 0x0a,
-	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33,
+	0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
 	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
-	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x7d, 0x0a, 0x07, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x74, 0x12, 0x5a, 0x0a,
-	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f,
-	0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f,
-	0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0xdb, 0x02, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0f,
-	0x64, 0x65, 0x76, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x46, 0x75, 0x6e, 0x64, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x12, 0x3f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x62,
-	0x75, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d,
-	0x65, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x48, 0x0a, 0x10, 0x74, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61,
-	0x6b, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52,
-	0x0e, 0x74, 0x63, 0x79, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22,
-	0x85, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x69,
-	0x6e, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e,
-	0x54, 0x78, 0x12, 0x23, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xc4, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a,
-	0x09, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x08, 0x62, 0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x74,
-	0x78, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x74, 0x78,
-	0x49, 0x6e, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x62, 0x6f, 0x6e, 0x64,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31,
-	0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xfc,
-	0x02, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x36,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54,
+	0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
+	0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5a,
+	0x0a, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3c, 0xe2, 0xde, 0x1f, 0x08, 0x52, 0x75, 0x6e, 0x65, 0x54, 0x78, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44,
+	0x52, 0x08, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x78, 0x49, 0x64, 0x12, 0x5d, 0x0a, 0x0b, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3d, 0xe2, 0xde, 0x1f, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde,
+	0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
+	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x09,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x78, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x44, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x54, 0x78, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f,
+	0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d, 0x0a,
+	0x07, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x74, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdb, 0x02, 0x0a,
+	0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3f, 0x0a,
+	0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
+	0x6e, 0x74, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x37,
+	0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x41, 0x6d, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x64, 0x65, 0x76, 0x5f, 0x66,
+	0x75, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74,
+	0x52, 0x0d, 0x64, 0x65, 0x76, 0x46, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x3f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x75, 0x72, 0x6e,
+	0x12, 0x48, 0x0a, 0x10, 0x74, 0x63, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0e, 0x74, 0x63, 0x79, 0x53,
+	0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54,
+	0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x54, 0x78, 0x12, 0x23, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66,
+	0x65, 0x65, 0x22, 0xc4, 0x02, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x6f, 0x6e, 0x64,
+	0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x62, 0x6f, 0x6e, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x62, 0x6f,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54,
 	0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x6e, 0x12, 0x54, 0x0a,
-	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa,
-	0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x0e, 0x6f, 0x6c, 0x64, 0x42, 0x6f, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x5b, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x6e,
-	0x65, 0x77, 0x42, 0x6f, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf3, 0x01,
-	0x0a, 0x07, 0x47, 0x61, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
-	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x6d, 0x74,
-	0x12, 0x3b, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x73, 0x12,
-	0x2a, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0c,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x50, 0x0a, 0x13,
-	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x6f, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x72, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x25,
-	0x0a, 0x05, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x04, 0x69, 0x6e, 0x54, 0x78, 0x22, 0x47, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12,
-	0x2d, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6f, 0x75, 0x74, 0x54, 0x78, 0x22, 0x43,
-	0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x02, 0x74, 0x78, 0x22, 0x9f, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42,
-	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x37, 0x0a, 0x0c,
-	0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x41,
-	0x6d, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45,
-	0x72, 0x72, 0x61, 0x74, 0x61, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde,
-	0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04,
-	0x74, 0x78, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
-	0x4d, 0x6f, 0x64, 0x42, 0x10, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x50, 0x6f, 0x6f,
-	0x6c, 0x4d, 0x6f, 0x64, 0x73, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xbf, 0x01, 0x0a,
-	0x08, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78,
-	0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78,
-	0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x46,
-	0x65, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x3f, 0x0a,
-	0x0b, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
-	0x6e, 0x74, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x74, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x87,
-	0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x54, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x3a, 0xe2, 0xde, 0x1f, 0x06, 0x49, 0x6e, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde,
-	0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x06,
-	0x69, 0x6e, 0x54, 0x78, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04,
-	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x02, 0x74, 0x78, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x73, 0x73, 0x4b, 0x65,
-	0x79, 0x67, 0x65, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x61, 0x69, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x73, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x73, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
-	0x6c, 0x61, 0x6d, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x6c, 0x61, 0x6d, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x12, 0x4b, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
-	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
-	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x94,
-	0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69,
-	0x67, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f,
-	0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49,
-	0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
-	0x52, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x69,
-	0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
-	0x69, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0xaf, 0x03, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x62, 0x6f,
+	0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xfc, 0x02, 0x0a, 0x0b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x42, 0x6f, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x6e, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31,
+	0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5b,
+	0x0a, 0x10, 0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x6f, 0x6c, 0x64,
+	0x42, 0x6f, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5b, 0x0a, 0x10, 0x6e,
+	0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x42, 0x6f, 0x6e,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x07, 0x47, 0x61, 0x73,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73,
 	0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c,
 	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x12, 0x39, 0x0a, 0x08, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
+	0x6e, 0x74, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x6d, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x36,
+	0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x47, 0x61, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x5f,
+	0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x54, 0x78,
+	0x22, 0x47, 0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x6f, 0x75,
+	0x74, 0x5f, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x6f, 0x75, 0x74, 0x54, 0x78, 0x22, 0x43, 0x0a, 0x0d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x20, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x02, 0x74, 0x78, 0x22, 0x9f,
+	0x01, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x58, 0x0a,
+	0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x41, 0x6d, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x94, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x45, 0x72, 0x72, 0x61, 0x74, 0x61,
+	0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74,
 	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
 	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75,
-	0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67,
-	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49,
-	0x64, 0x22, 0xb0, 0x03, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12,
-	0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67,
-	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x42, 0x10,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x73,
+	0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x08, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x46, 0x65, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f,
+	0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
+	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74,
+	0x78, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x74,
+	0x68, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x73,
+	0x79, 0x6e, 0x74, 0x68, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x54, 0x0a, 0x08, 0x69,
+	0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xe2,
+	0xde, 0x1f, 0x06, 0x49, 0x6e, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x06, 0x69, 0x6e, 0x54, 0x78, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x02, 0x74, 0x78, 0x22, 0x96, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x73, 0x73,
+	0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x4b, 0x0a,
+	0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32,
+	0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa6, 0x01, 0x0a,
+	0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x61, 0x69,
+	0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x75, 0x6e,
+	0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x55,
+	0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x61, 0x6d, 0x65, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x61,
+	0x6d, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x73, 0x73, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x4b,
+	0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x32, 0xfa, 0xde, 0x1f, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x15, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c,
 	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
 	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a,
-	0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde,
-	0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04,
-	0x74, 0x78, 0x49, 0x64, 0x22, 0xfe, 0x02, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
-	0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x22, 0xa2, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x6e,
+	0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6c,
+	0x61, 0x73, 0x68, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x12, 0x35, 0x0a, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x4d, 0x6f, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x70, 0x6f, 0x6f,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0xa8, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x75, 0x72,
+	0x6e, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x75,
+	0x72, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xaf, 0x03, 0x0a, 0x18, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f,
 	0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
 	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
 	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56,
-	0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
+	0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde,
+	0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
 	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa,
-	0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52,
-	0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xff, 0x02, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa,
+	0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xb0, 0x03, 0x0a,
+	0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x58,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33,
+	0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22,
+	0xaa, 0x03, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x36, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x56, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x52, 0x0a, 0x0a, 0x74, 0x6f, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa,
 	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
 	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
 	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49,
-	0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f,
-	0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49,
-	0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xaa, 0x02, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x55, 0x4e, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x54, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
-	0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
-	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e,
-	0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a,
-	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0xde,
-	0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
+	0x73, 0x73, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde,
+	0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xfe, 0x02, 0x0a,
+	0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x0d,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde,
+	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
 	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04,
-	0x74, 0x78, 0x49, 0x64, 0x22, 0xab, 0x04, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x55,
-	0x4e, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x54,
-	0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69,
-	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75,
-	0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x45,
-	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa,
-	0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52,
-	0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61,
-	0x74, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x11, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73,
-	0x69, 0x73, 0x50, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61,
-	0x74, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d,
+	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2,
+	0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xff, 0x02,
+	0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x64, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x36, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x58,
+	0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33,
+	0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22,
+	0xaa, 0x02, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x55, 0x4e, 0x45, 0x50, 0x6f, 0x6f,
+	0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31,
+	0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
+	0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
+	0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x05,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xab, 0x04, 0x0a,
+	0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x55, 0x4e, 0x45, 0x50, 0x6f, 0x6f, 0x6c, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x54, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde,
+	0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x34, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
 	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52,
-	0x0f, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x60, 0x0a, 0x11, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f,
-	0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
-	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x10, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0xf0, 0x04, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
-	0x4f, 0x70, 0x65, 0x6e, 0x12, 0x51, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
-	0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x13, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73,
+	0x5f, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x61, 0x66, 0x66, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x74, 0x73, 0x12, 0x49, 0x0a,
+	0x10, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0f, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x60, 0x0a, 0x11, 0x61, 0x66, 0x66, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x10, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf0, 0x04, 0x0a, 0x0d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x51, 0x0a, 0x14,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x12,
+	0x6f, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
+	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x57, 0x0a, 0x17, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
+	0x74, 0x52, 0x16, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x62,
+	0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a,
+	0x64, 0x65, 0x62, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x67, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x4d,
+	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2,
+	0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xb3, 0x03,
+	0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
-	0x6e, 0x74, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x64, 0x12, 0x6f, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x6e, 0x74, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x6f, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
 	0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
 	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
 	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x57, 0x0a, 0x17, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x16, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69,
-	0x6f, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x74, 0x49, 0x73, 0x73, 0x75,
-	0x65, 0x64, 0x12, 0x49, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x67, 0x0a,
-	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa,
-	0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52,
-	0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xb3, 0x03, 0x0a, 0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c,
-	0x6f, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x14,
-	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64,
-	0x72, 0x61, 0x77, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12,
-	0x6f, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x61, 0x73,
+	0x72, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74,
+	0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x64,
+	0x65, 0x62, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69, 0x64, 0x12, 0x49, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69,
+	0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f,
+	0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
+	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74,
+	0x78, 0x49, 0x64, 0x22, 0xa2, 0x03, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x48, 0x4f,
+	0x52, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69,
+	0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x4d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
+	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x49, 0x0a, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x08,
+	0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x07,
+	0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12,
+	0x47, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31,
+	0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x55, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x28, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
+	0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x73,
 	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x58, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde,
+	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
+	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44,
+	0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64,
+	0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0x49,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31,
+	0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x5c, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xad, 0x01,
+	0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x43, 0x59, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde,
+	0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0b,
+	0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
+	0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6, 0x02,
+	0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12,
+	0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x63, 0x79, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x74, 0x63, 0x79,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0a, 0x6c, 0x31, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x09, 0x6c, 0x31, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
 	0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
 	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
 	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
-	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
-	0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x74, 0x52, 0x65, 0x70, 0x61, 0x69,
-	0x64, 0x12, 0x49, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x05,
-	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0xe2, 0xde, 0x1f,
-	0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xa2, 0x03, 0x0a, 0x0d,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x48, 0x4f, 0x52, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x47, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x4d, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f,
-	0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
-	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x65, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
-	0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
-	0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x22, 0x37, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x4d, 0x69, 0x6d, 0x69,
-	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x69, 0x6d, 0x69, 0x72, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x28, 0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x0b, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x58, 0x0a,
-	0x0d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x98, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x43, 0x59, 0x55, 0x6e, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
 	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa,
-	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x4d, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38,
-	0xe2, 0xde, 0x1f, 0x04, 0x54, 0x78, 0x49, 0x44, 0xfa, 0xde, 0x1f, 0x2c, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x54, 0x78, 0x49, 0x44, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x94,
-	0x02, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41,
-	0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5c, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x14, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x43, 0x59, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54,
-	0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x65, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd6, 0x02, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x43, 0x59, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x56, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x65, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa,
-	0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x3d, 0x0a, 0x0a, 0x74, 0x63, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x52, 0x09, 0x74, 0x63, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x52,
-	0x0a, 0x0a, 0x6c, 0x31, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x6c, 0x31, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x5a, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
-	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x22, 0x96,
-	0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x43, 0x59, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x12, 0x4d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x33, 0xfa, 0xde, 0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
+	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
+	0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x11, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x12, 0x58, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x35, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72,
 	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x36, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0f, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x43, 0x59, 0x55, 0x6e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0xfa, 0xde,
-	0x1f, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f,
-	0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x2a, 0x2d, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x71,
-	0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x61, 0x64,
-	0x64, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x10,
-	0x01, 0x2a, 0x4c, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
-	0x09, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
-	0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x10, 0x02,
-	0x12, 0x0d, 0x0a, 0x09, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x10, 0x03, 0x2a,
-	0x28, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6c,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x10, 0x01, 0x42, 0x7c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0f, 0x54, 0x79, 0x70, 0x65, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x34, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x04, 0x74, 0x77, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0xe2, 0xde,
+	0x1f, 0x04, 0x54, 0x57, 0x41, 0x50, 0x52, 0x04, 0x74, 0x77, 0x61, 0x70, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x70,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x2a, 0x2d, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03,
+	0x61, 0x64, 0x64, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x10, 0x01, 0x2a, 0x4c, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0d, 0x0a, 0x09, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x10,
+	0x03, 0x2a, 0x28, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x53, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x62, 0x75, 0x72, 0x6e, 0x10, 0x01, 0x42, 0x7c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0f, 0x54, 0x79, 0x70, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -35930,7 +39314,7 @@ func file_types_type_events_proto_rawDescGZIP() []byte {
 }
 
 var file_types_type_events_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_types_type_events_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_types_type_events_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_types_type_events_proto_goTypes = []interface{}{
 	(PendingLiquidityType)(0),         // 0: types.PendingLiquidityType
 	(BondType)(0),                     // 1: types.BondType
@@ -35938,118 +39322,126 @@ var file_types_type_events_proto_goTypes = []interface{}{
 	(*PoolMod)(nil),                   // 3: types.PoolMod
 	(*EventLimitSwap)(nil),            // 4: types.EventLimitSwap
 	(*EventModifyLimitSwap)(nil),      // 5: types.EventModifyLimitSwap
-	(*EventStreamingSwap)(nil),        // 6: types.EventStreamingSwap
-	(*EventSwap)(nil),                 // 7: types.EventSwap
-	(*EventAffiliateFee)(nil),         // 8: types.EventAffiliateFee
-	(*EventAddLiquidity)(nil),         // 9: types.EventAddLiquidity
-	(*EventWithdraw)(nil),             // 10: types.EventWithdraw
-	(*EventPendingLiquidity)(nil),     // 11: types.EventPendingLiquidity
-	(*EventDonate)(nil),               // 12: types.EventDonate
-	(*EventPool)(nil),                 // 13: types.EventPool
-	(*PoolAmt)(nil),                   // 14: types.PoolAmt
-	(*EventRewards)(nil),              // 15: types.EventRewards
-	(*EventRefund)(nil),               // 16: types.EventRefund
-	(*EventBond)(nil),                 // 17: types.EventBond
-	(*EventReBond)(nil),               // 18: types.EventReBond
-	(*GasPool)(nil),                   // 19: types.GasPool
-	(*EventGas)(nil),                  // 20: types.EventGas
-	(*EventReserve)(nil),              // 21: types.EventReserve
-	(*EventScheduledOutbound)(nil),    // 22: types.EventScheduledOutbound
-	(*EventSecurity)(nil),             // 23: types.EventSecurity
-	(*EventSlash)(nil),                // 24: types.EventSlash
-	(*EventErrata)(nil),               // 25: types.EventErrata
-	(*EventFee)(nil),                  // 26: types.EventFee
-	(*EventOutbound)(nil),             // 27: types.EventOutbound
-	(*EventTssKeygenSuccess)(nil),     // 28: types.EventTssKeygenSuccess
-	(*EventTssKeygenFailure)(nil),     // 29: types.EventTssKeygenFailure
-	(*EventTssKeygenMetric)(nil),      // 30: types.EventTssKeygenMetric
-	(*EventTssKeysignMetric)(nil),     // 31: types.EventTssKeysignMetric
-	(*EventSlashPoint)(nil),           // 32: types.EventSlashPoint
-	(*EventPoolBalanceChanged)(nil),   // 33: types.EventPoolBalanceChanged
-	(*EventMintBurn)(nil),             // 34: types.EventMintBurn
-	(*EventTradeAccountDeposit)(nil),  // 35: types.EventTradeAccountDeposit
-	(*EventTradeAccountWithdraw)(nil), // 36: types.EventTradeAccountWithdraw
-	(*EventSecuredAssetDeposit)(nil),  // 37: types.EventSecuredAssetDeposit
-	(*EventSecuredAssetWithdraw)(nil), // 38: types.EventSecuredAssetWithdraw
-	(*EventRUNEPoolDeposit)(nil),      // 39: types.EventRUNEPoolDeposit
-	(*EventRUNEPoolWithdraw)(nil),     // 40: types.EventRUNEPoolWithdraw
-	(*EventLoanOpen)(nil),             // 41: types.EventLoanOpen
-	(*EventLoanRepayment)(nil),        // 42: types.EventLoanRepayment
-	(*EventTHORName)(nil),             // 43: types.EventTHORName
-	(*EventSetMimir)(nil),             // 44: types.EventSetMimir
-	(*EventSetNodeMimir)(nil),         // 45: types.EventSetNodeMimir
-	(*EventVersion)(nil),              // 46: types.EventVersion
-	(*EventSwitch)(nil),               // 47: types.EventSwitch
-	(*EventOperatorRotate)(nil),       // 48: types.EventOperatorRotate
-	(*EventTCYDistribution)(nil),      // 49: types.EventTCYDistribution
-	(*EventTCYClaim)(nil),             // 50: types.EventTCYClaim
-	(*EventTCYStake)(nil),             // 51: types.EventTCYStake
-	(*EventTCYUnstake)(nil),           // 52: types.EventTCYUnstake
-	(*common.Asset)(nil),              // 53: common.Asset
-	(*common.Coin)(nil),               // 54: common.Coin
-	(*common.Tx)(nil),                 // 55: common.Tx
-	(PoolStatus)(0),                   // 56: types.PoolStatus
-	(*common.Fee)(nil),                // 57: common.Fee
-	(*ReserveContributor)(nil),        // 58: types.ReserveContributor
-	(*TxOutItem)(nil),                 // 59: types.TxOutItem
+	(*EventModifyStreamingSwap)(nil),  // 6: types.EventModifyStreamingSwap
+	(*EventStreamingSwap)(nil),        // 7: types.EventStreamingSwap
+	(*EventRecurringSwap)(nil),        // 8: types.EventRecurringSwap
+	(*EventSwap)(nil),                 // 9: types.EventSwap
+	(*EventAffiliateFee)(nil),         // 10: types.EventAffiliateFee
+	(*EventAddLiquidity)(nil),         // 11: types.EventAddLiquidity
+	(*EventWithdraw)(nil),             // 12: types.EventWithdraw
+	(*EventPendingLiquidity)(nil),     // 13: types.EventPendingLiquidity
+	(*EventDonate)(nil),               // 14: types.EventDonate
+	(*EventPool)(nil),                 // 15: types.EventPool
+	(*PoolAmt)(nil),                   // 16: types.PoolAmt
+	(*EventRewards)(nil),              // 17: types.EventRewards
+	(*EventRefund)(nil),               // 18: types.EventRefund
+	(*EventBond)(nil),                 // 19: types.EventBond
+	(*EventReBond)(nil),               // 20: types.EventReBond
+	(*GasPool)(nil),                   // 21: types.GasPool
+	(*EventGas)(nil),                  // 22: types.EventGas
+	(*EventReserve)(nil),              // 23: types.EventReserve
+	(*EventScheduledOutbound)(nil),    // 24: types.EventScheduledOutbound
+	(*EventSecurity)(nil),             // 25: types.EventSecurity
+	(*EventSlash)(nil),                // 26: types.EventSlash
+	(*EventErrata)(nil),               // 27: types.EventErrata
+	(*EventFee)(nil),                  // 28: types.EventFee
+	(*EventOutbound)(nil),             // 29: types.EventOutbound
+	(*EventTssKeygenSuccess)(nil),     // 30: types.EventTssKeygenSuccess
+	(*EventTssKeygenFailure)(nil),     // 31: types.EventTssKeygenFailure
+	(*EventTssKeygenMetric)(nil),      // 32: types.EventTssKeygenMetric
+	(*EventTssKeysignMetric)(nil),     // 33: types.EventTssKeysignMetric
+	(*EventSlashPoint)(nil),           // 34: types.EventSlashPoint
+	(*EventPoolBalanceChanged)(nil),   // 35: types.EventPoolBalanceChanged
+	(*EventMintBurn)(nil),             // 36: types.EventMintBurn
+	(*EventTradeAccountDeposit)(nil),  // 37: types.EventTradeAccountDeposit
+	(*EventTradeAccountWithdraw)(nil), // 38: types.EventTradeAccountWithdraw
+	(*EventTradeAccountTransfer)(nil), // 39: types.EventTradeAccountTransfer
+	(*EventSecuredAssetDeposit)(nil),  // 40: types.EventSecuredAssetDeposit
+	(*EventSecuredAssetWithdraw)(nil), // 41: types.EventSecuredAssetWithdraw
+	(*EventRUNEPoolDeposit)(nil),      // 42: types.EventRUNEPoolDeposit
+	(*EventRUNEPoolWithdraw)(nil),     // 43: types.EventRUNEPoolWithdraw
+	(*EventLoanOpen)(nil),             // 44: types.EventLoanOpen
+	(*EventLoanRepayment)(nil),        // 45: types.EventLoanRepayment
+	(*EventTHORName)(nil),             // 46: types.EventTHORName
+	(*EventSetMimir)(nil),             // 47: types.EventSetMimir
+	(*EventSetNodeMimir)(nil),         // 48: types.EventSetNodeMimir
+	(*EventVersion)(nil),              // 49: types.EventVersion
+	(*EventSwitch)(nil),               // 50: types.EventSwitch
+	(*EventOperatorRotate)(nil),       // 51: types.EventOperatorRotate
+	(*EventTCYDistribution)(nil),      // 52: types.EventTCYDistribution
+	(*EventTCYClaim)(nil),             // 53: types.EventTCYClaim
+	(*EventTCYStake)(nil),             // 54: types.EventTCYStake
+	(*EventTCYUnstake)(nil),           // 55: types.EventTCYUnstake
+	(*EventPriceBreaker)(nil),         // 56: types.EventPriceBreaker
+	(*common.Asset)(nil),              // 57: common.Asset
+	(*common.Coin)(nil),               // 58: common.Coin
+	(*common.Tx)(nil),                 // 59: common.Tx
+	(PoolStatus)(0),                   // 60: types.PoolStatus
+	(*common.Fee)(nil),                // 61: common.Fee
+	(*ReserveContributor)(nil),        // 62: types.ReserveContributor
+	(*TxOutItem)(nil),                 // 63: types.TxOutItem
 }
 var file_types_type_events_proto_depIdxs = []int32{
-	53, // 0: types.PoolMod.asset:type_name -> common.Asset
-	54, // 1: types.EventLimitSwap.source:type_name -> common.Coin
-	54, // 2: types.EventLimitSwap.target:type_name -> common.Coin
-	54, // 3: types.EventModifyLimitSwap.source:type_name -> common.Coin
-	54, // 4: types.EventModifyLimitSwap.target:type_name -> common.Coin
-	54, // 5: types.EventStreamingSwap.deposit:type_name -> common.Coin
-	54, // 6: types.EventStreamingSwap.in:type_name -> common.Coin
-	54, // 7: types.EventStreamingSwap.out:type_name -> common.Coin
-	53, // 8: types.EventSwap.pool:type_name -> common.Asset
-	55, // 9: types.EventSwap.in_tx:type_name -> common.Tx
-	55, // 10: types.EventSwap.out_txs:type_name -> common.Tx
-	54, // 11: types.EventSwap.emit_asset:type_name -> common.Coin
-	53, // 12: types.EventAffiliateFee.asset:type_name -> common.Asset
-	53, // 13: types.EventAddLiquidity.pool:type_name -> common.Asset
-	53, // 14: types.EventWithdraw.pool:type_name -> common.Asset
-	55, // 15: types.EventWithdraw.in_tx:type_name -> common.Tx
-	53, // 16: types.EventPendingLiquidity.pool:type_name -> common.Asset
-	0,  // 17: types.EventPendingLiquidity.pending_type:type_name -> types.PendingLiquidityType
-	53, // 18: types.EventDonate.pool:type_name -> common.Asset
-	55, // 19: types.EventDonate.in_tx:type_name -> common.Tx
-	53, // 20: types.EventPool.pool:type_name -> common.Asset
-	56, // 21: types.EventPool.Status:type_name -> types.PoolStatus
-	53, // 22: types.PoolAmt.asset:type_name -> common.Asset
-	14, // 23: types.EventRewards.pool_rewards:type_name -> types.PoolAmt
-	55, // 24: types.EventRefund.in_tx:type_name -> common.Tx
-	57, // 25: types.EventRefund.fee:type_name -> common.Fee
-	1,  // 26: types.EventBond.bond_type:type_name -> types.BondType
-	55, // 27: types.EventBond.tx_in:type_name -> common.Tx
-	55, // 28: types.EventReBond.tx_in:type_name -> common.Tx
-	53, // 29: types.GasPool.asset:type_name -> common.Asset
-	19, // 30: types.EventGas.pools:type_name -> types.GasPool
-	58, // 31: types.EventReserve.reserve_contributor:type_name -> types.ReserveContributor
-	55, // 32: types.EventReserve.in_tx:type_name -> common.Tx
-	59, // 33: types.EventScheduledOutbound.out_tx:type_name -> types.TxOutItem
-	55, // 34: types.EventSecurity.tx:type_name -> common.Tx
-	53, // 35: types.EventSlash.pool:type_name -> common.Asset
-	14, // 36: types.EventSlash.slash_amount:type_name -> types.PoolAmt
-	3,  // 37: types.EventErrata.pools:type_name -> types.PoolMod
-	57, // 38: types.EventFee.fee:type_name -> common.Fee
-	55, // 39: types.EventOutbound.tx:type_name -> common.Tx
-	3,  // 40: types.EventPoolBalanceChanged.pool_change:type_name -> types.PoolMod
-	2,  // 41: types.EventMintBurn.supply:type_name -> types.MintBurnSupplyType
-	53, // 42: types.EventTradeAccountDeposit.asset:type_name -> common.Asset
-	53, // 43: types.EventTradeAccountWithdraw.asset:type_name -> common.Asset
-	53, // 44: types.EventSecuredAssetDeposit.asset:type_name -> common.Asset
-	53, // 45: types.EventSecuredAssetWithdraw.asset:type_name -> common.Asset
-	53, // 46: types.EventLoanOpen.collateral_asset:type_name -> common.Asset
-	53, // 47: types.EventLoanOpen.target_asset:type_name -> common.Asset
-	53, // 48: types.EventLoanRepayment.collateral_asset:type_name -> common.Asset
-	53, // 49: types.EventSwitch.asset:type_name -> common.Asset
-	53, // 50: types.EventTCYClaim.asset:type_name -> common.Asset
-	51, // [51:51] is the sub-list for method output_type
-	51, // [51:51] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	57, // 0: types.PoolMod.asset:type_name -> common.Asset
+	58, // 1: types.EventLimitSwap.source:type_name -> common.Coin
+	58, // 2: types.EventLimitSwap.target:type_name -> common.Coin
+	58, // 3: types.EventModifyLimitSwap.source:type_name -> common.Coin
+	58, // 4: types.EventModifyLimitSwap.target:type_name -> common.Coin
+	58, // 5: types.EventStreamingSwap.deposit:type_name -> common.Coin
+	58, // 6: types.EventStreamingSwap.in:type_name -> common.Coin
+	58, // 7: types.EventStreamingSwap.out:type_name -> common.Coin
+	57, // 8: types.EventRecurringSwap.source_asset:type_name -> common.Asset
+	57, // 9: types.EventRecurringSwap.target_asset:type_name -> common.Asset
+	57, // 10: types.EventSwap.pool:type_name -> common.Asset
+	59, // 11: types.EventSwap.in_tx:type_name -> common.Tx
+	59, // 12: types.EventSwap.out_txs:type_name -> common.Tx
+	58, // 13: types.EventSwap.emit_asset:type_name -> common.Coin
+	57, // 14: types.EventAffiliateFee.asset:type_name -> common.Asset
+	57, // 15: types.EventAddLiquidity.pool:type_name -> common.Asset
+	57, // 16: types.EventWithdraw.pool:type_name -> common.Asset
+	59, // 17: types.EventWithdraw.in_tx:type_name -> common.Tx
+	57, // 18: types.EventPendingLiquidity.pool:type_name -> common.Asset
+	0,  // 19: types.EventPendingLiquidity.pending_type:type_name -> types.PendingLiquidityType
+	57, // 20: types.EventDonate.pool:type_name -> common.Asset
+	59, // 21: types.EventDonate.in_tx:type_name -> common.Tx
+	57, // 22: types.EventPool.pool:type_name -> common.Asset
+	60, // 23: types.EventPool.Status:type_name -> types.PoolStatus
+	57, // 24: types.PoolAmt.asset:type_name -> common.Asset
+	16, // 25: types.EventRewards.pool_rewards:type_name -> types.PoolAmt
+	59, // 26: types.EventRefund.in_tx:type_name -> common.Tx
+	61, // 27: types.EventRefund.fee:type_name -> common.Fee
+	1,  // 28: types.EventBond.bond_type:type_name -> types.BondType
+	59, // 29: types.EventBond.tx_in:type_name -> common.Tx
+	59, // 30: types.EventReBond.tx_in:type_name -> common.Tx
+	57, // 31: types.GasPool.asset:type_name -> common.Asset
+	21, // 32: types.EventGas.pools:type_name -> types.GasPool
+	62, // 33: types.EventReserve.reserve_contributor:type_name -> types.ReserveContributor
+	59, // 34: types.EventReserve.in_tx:type_name -> common.Tx
+	63, // 35: types.EventScheduledOutbound.out_tx:type_name -> types.TxOutItem
+	59, // 36: types.EventSecurity.tx:type_name -> common.Tx
+	57, // 37: types.EventSlash.pool:type_name -> common.Asset
+	16, // 38: types.EventSlash.slash_amount:type_name -> types.PoolAmt
+	3,  // 39: types.EventErrata.pools:type_name -> types.PoolMod
+	61, // 40: types.EventFee.fee:type_name -> common.Fee
+	59, // 41: types.EventOutbound.tx:type_name -> common.Tx
+	3,  // 42: types.EventPoolBalanceChanged.pool_change:type_name -> types.PoolMod
+	2,  // 43: types.EventMintBurn.supply:type_name -> types.MintBurnSupplyType
+	57, // 44: types.EventTradeAccountDeposit.asset:type_name -> common.Asset
+	57, // 45: types.EventTradeAccountWithdraw.asset:type_name -> common.Asset
+	57, // 46: types.EventTradeAccountTransfer.asset:type_name -> common.Asset
+	57, // 47: types.EventSecuredAssetDeposit.asset:type_name -> common.Asset
+	57, // 48: types.EventSecuredAssetWithdraw.asset:type_name -> common.Asset
+	57, // 49: types.EventLoanOpen.collateral_asset:type_name -> common.Asset
+	57, // 50: types.EventLoanOpen.target_asset:type_name -> common.Asset
+	57, // 51: types.EventLoanRepayment.collateral_asset:type_name -> common.Asset
+	57, // 52: types.EventSwitch.asset:type_name -> common.Asset
+	57, // 53: types.EventTCYClaim.asset:type_name -> common.Asset
+	57, // 54: types.EventPriceBreaker.pool:type_name -> common.Asset
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_types_type_events_proto_init() }
@@ -36098,7 +39490,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventStreamingSwap); i {
+			switch v := v.(*EventModifyStreamingSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36110,7 +39502,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSwap); i {
+			switch v := v.(*EventStreamingSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36122,7 +39514,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAffiliateFee); i {
+			switch v := v.(*EventRecurringSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36134,7 +39526,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAddLiquidity); i {
+			switch v := v.(*EventSwap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36146,7 +39538,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventWithdraw); i {
+			switch v := v.(*EventAffiliateFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36158,7 +39550,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventPendingLiquidity); i {
+			switch v := v.(*EventAddLiquidity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36170,7 +39562,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventDonate); i {
+			switch v := v.(*EventWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36182,7 +39574,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventPool); i {
+			switch v := v.(*EventPendingLiquidity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36194,7 +39586,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PoolAmt); i {
+			switch v := v.(*EventDonate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36206,7 +39598,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRewards); i {
+			switch v := v.(*EventPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36218,7 +39610,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRefund); i {
+			switch v := v.(*PoolAmt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36230,7 +39622,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventBond); i {
+			switch v := v.(*EventRewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36242,7 +39634,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventReBond); i {
+			switch v := v.(*EventRefund); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36254,7 +39646,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GasPool); i {
+			switch v := v.(*EventBond); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36266,7 +39658,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventGas); i {
+			switch v := v.(*EventReBond); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36278,7 +39670,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventReserve); i {
+			switch v := v.(*GasPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36290,7 +39682,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventScheduledOutbound); i {
+			switch v := v.(*EventGas); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36302,7 +39694,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSecurity); i {
+			switch v := v.(*EventReserve); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36314,7 +39706,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSlash); i {
+			switch v := v.(*EventScheduledOutbound); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36326,7 +39718,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventErrata); i {
+			switch v := v.(*EventSecurity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36338,7 +39730,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventFee); i {
+			switch v := v.(*EventSlash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36350,7 +39742,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventOutbound); i {
+			switch v := v.(*EventErrata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36362,7 +39754,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTssKeygenSuccess); i {
+			switch v := v.(*EventFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36374,7 +39766,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTssKeygenFailure); i {
+			switch v := v.(*EventOutbound); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36386,7 +39778,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTssKeygenMetric); i {
+			switch v := v.(*EventTssKeygenSuccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36398,7 +39790,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTssKeysignMetric); i {
+			switch v := v.(*EventTssKeygenFailure); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36410,7 +39802,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSlashPoint); i {
+			switch v := v.(*EventTssKeygenMetric); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36422,7 +39814,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventPoolBalanceChanged); i {
+			switch v := v.(*EventTssKeysignMetric); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36434,7 +39826,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventMintBurn); i {
+			switch v := v.(*EventSlashPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36446,7 +39838,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTradeAccountDeposit); i {
+			switch v := v.(*EventPoolBalanceChanged); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36458,7 +39850,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTradeAccountWithdraw); i {
+			switch v := v.(*EventMintBurn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36470,7 +39862,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSecuredAssetDeposit); i {
+			switch v := v.(*EventTradeAccountDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36482,7 +39874,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSecuredAssetWithdraw); i {
+			switch v := v.(*EventTradeAccountWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36494,7 +39886,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRUNEPoolDeposit); i {
+			switch v := v.(*EventTradeAccountTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36506,7 +39898,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventRUNEPoolWithdraw); i {
+			switch v := v.(*EventSecuredAssetDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36518,7 +39910,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventLoanOpen); i {
+			switch v := v.(*EventSecuredAssetWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36530,7 +39922,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventLoanRepayment); i {
+			switch v := v.(*EventRUNEPoolDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36542,7 +39934,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTHORName); i {
+			switch v := v.(*EventRUNEPoolWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36554,7 +39946,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSetMimir); i {
+			switch v := v.(*EventLoanOpen); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36566,7 +39958,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSetNodeMimir); i {
+			switch v := v.(*EventLoanRepayment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36578,7 +39970,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventVersion); i {
+			switch v := v.(*EventTHORName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36590,7 +39982,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSwitch); i {
+			switch v := v.(*EventSetMimir); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36602,7 +39994,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventOperatorRotate); i {
+			switch v := v.(*EventSetNodeMimir); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36614,7 +40006,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTCYDistribution); i {
+			switch v := v.(*EventVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36626,7 +40018,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTCYClaim); i {
+			switch v := v.(*EventSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36638,7 +40030,7 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventTCYStake); i {
+			switch v := v.(*EventOperatorRotate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -36650,6 +40042,42 @@ func file_types_type_events_proto_init() {
 			}
 		}
 		file_types_type_events_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTCYDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_type_events_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTCYClaim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_type_events_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTCYStake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_types_type_events_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventTCYUnstake); i {
 			case 0:
 				return &v.state
@@ -36661,6 +40089,18 @@ func file_types_type_events_proto_init() {
 				return nil
 			}
 		}
+		file_types_type_events_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventPriceBreaker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -36668,7 +40108,7 @@ func file_types_type_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_types_type_events_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   50,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1414,9 +1414,11 @@ func (x *fastReflection_NodeAccount) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_BondProvider              protoreflect.MessageDescriptor
-	fd_BondProvider_bond_address protoreflect.FieldDescriptor
-	fd_BondProvider_bond         protoreflect.FieldDescriptor
+	md_BondProvider               protoreflect.MessageDescriptor
+	fd_BondProvider_bond_address  protoreflect.FieldDescriptor
+	fd_BondProvider_bond          protoreflect.FieldDescriptor
+	fd_BondProvider_unbond_amount protoreflect.FieldDescriptor
+	fd_BondProvider_unbond_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1424,6 +1426,8 @@ func init() {
 	md_BondProvider = File_types_type_node_account_proto.Messages().ByName("BondProvider")
 	fd_BondProvider_bond_address = md_BondProvider.Fields().ByName("bond_address")
 	fd_BondProvider_bond = md_BondProvider.Fields().ByName("bond")
+	fd_BondProvider_unbond_amount = md_BondProvider.Fields().ByName("unbond_amount")
+	fd_BondProvider_unbond_height = md_BondProvider.Fields().ByName("unbond_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_BondProvider)(nil)
@@ -1503,6 +1507,18 @@ func (x *fastReflection_BondProvider) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.UnbondAmount != "" {
+		value := protoreflect.ValueOfString(x.UnbondAmount)
+		if !f(fd_BondProvider_unbond_amount, value) {
+			return
+		}
+	}
+	if x.UnbondHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UnbondHeight)
+		if !f(fd_BondProvider_unbond_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1522,6 +1538,10 @@ func (x *fastReflection_BondProvider) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.BondAddress) != 0
 	case "types.BondProvider.bond":
 		return x.Bond != ""
+	case "types.BondProvider.unbond_amount":
+		return x.UnbondAmount != ""
+	case "types.BondProvider.unbond_height":
+		return x.UnbondHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProvider"))
@@ -1542,6 +1562,10 @@ func (x *fastReflection_BondProvider) Clear(fd protoreflect.FieldDescriptor) {
 		x.BondAddress = nil
 	case "types.BondProvider.bond":
 		x.Bond = ""
+	case "types.BondProvider.unbond_amount":
+		x.UnbondAmount = ""
+	case "types.BondProvider.unbond_height":
+		x.UnbondHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProvider"))
@@ -1564,6 +1588,12 @@ func (x *fastReflection_BondProvider) Get(descriptor protoreflect.FieldDescripto
 	case "types.BondProvider.bond":
 		value := x.Bond
 		return protoreflect.ValueOfString(value)
+	case "types.BondProvider.unbond_amount":
+		value := x.UnbondAmount
+		return protoreflect.ValueOfString(value)
+	case "types.BondProvider.unbond_height":
+		value := x.UnbondHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProvider"))
@@ -1588,6 +1618,10 @@ func (x *fastReflection_BondProvider) Set(fd protoreflect.FieldDescriptor, value
 		x.BondAddress = value.Bytes()
 	case "types.BondProvider.bond":
 		x.Bond = value.Interface().(string)
+	case "types.BondProvider.unbond_amount":
+		x.UnbondAmount = value.Interface().(string)
+	case "types.BondProvider.unbond_height":
+		x.UnbondHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProvider"))
@@ -1612,6 +1646,10 @@ func (x *fastReflection_BondProvider) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field bond_address of message types.BondProvider is not mutable"))
 	case "types.BondProvider.bond":
 		panic(fmt.Errorf("field bond of message types.BondProvider is not mutable"))
+	case "types.BondProvider.unbond_amount":
+		panic(fmt.Errorf("field unbond_amount of message types.BondProvider is not mutable"))
+	case "types.BondProvider.unbond_height":
+		panic(fmt.Errorf("field unbond_height of message types.BondProvider is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProvider"))
@@ -1629,6 +1667,10 @@ func (x *fastReflection_BondProvider) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfBytes(nil)
 	case "types.BondProvider.bond":
 		return protoreflect.ValueOfString("")
+	case "types.BondProvider.unbond_amount":
+		return protoreflect.ValueOfString("")
+	case "types.BondProvider.unbond_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProvider"))
@@ -1706,6 +1748,13 @@ func (x *fastReflection_BondProvider) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.UnbondAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnbondHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnbondHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1735,6 +1784,18 @@ func (x *fastReflection_BondProvider) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.UnbondHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnbondHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.UnbondAmount) > 0 {
+			i -= len(x.UnbondAmount)
+			copy(dAtA[i:], x.UnbondAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UnbondAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Bond) > 0 {
 			i -= len(x.Bond)
 			copy(dAtA[i:], x.Bond)
@@ -1864,6 +1925,57 @@ func (x *fastReflection_BondProvider) ProtoMethods() *protoiface.Methods {
 				}
 				x.Bond = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondHeight", wireType)
+				}
+				x.UnbondHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnbondHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1951,10 +2063,13 @@ func (x *_BondProviders_3_list) IsValid() bool {
 }
 
 var (
-	md_BondProviders                   protoreflect.MessageDescriptor
-	fd_BondProviders_node_address      protoreflect.FieldDescriptor
-	fd_BondProviders_node_operator_fee protoreflect.FieldDescriptor
-	fd_BondProviders_providers         protoreflect.FieldDescriptor
+	md_BondProviders                                  protoreflect.MessageDescriptor
+	fd_BondProviders_node_address                     protoreflect.FieldDescriptor
+	fd_BondProviders_node_operator_fee                protoreflect.FieldDescriptor
+	fd_BondProviders_providers                        protoreflect.FieldDescriptor
+	fd_BondProviders_liquid_bond_units                protoreflect.FieldDescriptor
+	fd_BondProviders_pending_node_operator_fee        protoreflect.FieldDescriptor
+	fd_BondProviders_pending_node_operator_fee_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1963,6 +2078,9 @@ func init() {
 	fd_BondProviders_node_address = md_BondProviders.Fields().ByName("node_address")
 	fd_BondProviders_node_operator_fee = md_BondProviders.Fields().ByName("node_operator_fee")
 	fd_BondProviders_providers = md_BondProviders.Fields().ByName("providers")
+	fd_BondProviders_liquid_bond_units = md_BondProviders.Fields().ByName("liquid_bond_units")
+	fd_BondProviders_pending_node_operator_fee = md_BondProviders.Fields().ByName("pending_node_operator_fee")
+	fd_BondProviders_pending_node_operator_fee_height = md_BondProviders.Fields().ByName("pending_node_operator_fee_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_BondProviders)(nil)
@@ -2048,6 +2166,24 @@ func (x *fastReflection_BondProviders) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.LiquidBondUnits != "" {
+		value := protoreflect.ValueOfString(x.LiquidBondUnits)
+		if !f(fd_BondProviders_liquid_bond_units, value) {
+			return
+		}
+	}
+	if x.PendingNodeOperatorFee != "" {
+		value := protoreflect.ValueOfString(x.PendingNodeOperatorFee)
+		if !f(fd_BondProviders_pending_node_operator_fee, value) {
+			return
+		}
+	}
+	if x.PendingNodeOperatorFeeHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.PendingNodeOperatorFeeHeight)
+		if !f(fd_BondProviders_pending_node_operator_fee_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2069,6 +2205,12 @@ func (x *fastReflection_BondProviders) Has(fd protoreflect.FieldDescriptor) bool
 		return x.NodeOperatorFee != ""
 	case "types.BondProviders.providers":
 		return len(x.Providers) != 0
+	case "types.BondProviders.liquid_bond_units":
+		return x.LiquidBondUnits != ""
+	case "types.BondProviders.pending_node_operator_fee":
+		return x.PendingNodeOperatorFee != ""
+	case "types.BondProviders.pending_node_operator_fee_height":
+		return x.PendingNodeOperatorFeeHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProviders"))
@@ -2091,6 +2233,12 @@ func (x *fastReflection_BondProviders) Clear(fd protoreflect.FieldDescriptor) {
 		x.NodeOperatorFee = ""
 	case "types.BondProviders.providers":
 		x.Providers = nil
+	case "types.BondProviders.liquid_bond_units":
+		x.LiquidBondUnits = ""
+	case "types.BondProviders.pending_node_operator_fee":
+		x.PendingNodeOperatorFee = ""
+	case "types.BondProviders.pending_node_operator_fee_height":
+		x.PendingNodeOperatorFeeHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProviders"))
@@ -2119,6 +2267,15 @@ func (x *fastReflection_BondProviders) Get(descriptor protoreflect.FieldDescript
 		}
 		listValue := &_BondProviders_3_list{list: &x.Providers}
 		return protoreflect.ValueOfList(listValue)
+	case "types.BondProviders.liquid_bond_units":
+		value := x.LiquidBondUnits
+		return protoreflect.ValueOfString(value)
+	case "types.BondProviders.pending_node_operator_fee":
+		value := x.PendingNodeOperatorFee
+		return protoreflect.ValueOfString(value)
+	case "types.BondProviders.pending_node_operator_fee_height":
+		value := x.PendingNodeOperatorFeeHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProviders"))
@@ -2147,6 +2304,12 @@ func (x *fastReflection_BondProviders) Set(fd protoreflect.FieldDescriptor, valu
 		lv := value.List()
 		clv := lv.(*_BondProviders_3_list)
 		x.Providers = *clv.list
+	case "types.BondProviders.liquid_bond_units":
+		x.LiquidBondUnits = value.Interface().(string)
+	case "types.BondProviders.pending_node_operator_fee":
+		x.PendingNodeOperatorFee = value.Interface().(string)
+	case "types.BondProviders.pending_node_operator_fee_height":
+		x.PendingNodeOperatorFeeHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProviders"))
@@ -2177,6 +2340,12 @@ func (x *fastReflection_BondProviders) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field node_address of message types.BondProviders is not mutable"))
 	case "types.BondProviders.node_operator_fee":
 		panic(fmt.Errorf("field node_operator_fee of message types.BondProviders is not mutable"))
+	case "types.BondProviders.liquid_bond_units":
+		panic(fmt.Errorf("field liquid_bond_units of message types.BondProviders is not mutable"))
+	case "types.BondProviders.pending_node_operator_fee":
+		panic(fmt.Errorf("field pending_node_operator_fee of message types.BondProviders is not mutable"))
+	case "types.BondProviders.pending_node_operator_fee_height":
+		panic(fmt.Errorf("field pending_node_operator_fee_height of message types.BondProviders is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProviders"))
@@ -2197,6 +2366,12 @@ func (x *fastReflection_BondProviders) NewField(fd protoreflect.FieldDescriptor)
 	case "types.BondProviders.providers":
 		list := []*BondProvider{}
 		return protoreflect.ValueOfList(&_BondProviders_3_list{list: &list})
+	case "types.BondProviders.liquid_bond_units":
+		return protoreflect.ValueOfString("")
+	case "types.BondProviders.pending_node_operator_fee":
+		return protoreflect.ValueOfString("")
+	case "types.BondProviders.pending_node_operator_fee_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.BondProviders"))
@@ -2280,6 +2455,17 @@ func (x *fastReflection_BondProviders) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.LiquidBondUnits)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PendingNodeOperatorFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingNodeOperatorFeeHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.PendingNodeOperatorFeeHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2309,6 +2495,25 @@ func (x *fastReflection_BondProviders) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PendingNodeOperatorFeeHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PendingNodeOperatorFeeHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.PendingNodeOperatorFee) > 0 {
+			i -= len(x.PendingNodeOperatorFee)
+			copy(dAtA[i:], x.PendingNodeOperatorFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PendingNodeOperatorFee)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.LiquidBondUnits) > 0 {
+			i -= len(x.LiquidBondUnits)
+			copy(dAtA[i:], x.LiquidBondUnits)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidBondUnits)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Providers) > 0 {
 			for iNdEx := len(x.Providers) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Providers[iNdEx])
@@ -2488,6 +2693,89 @@ func (x *fastReflection_BondProviders) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidBondUnits", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidBondUnits = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PendingNodeOperatorFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFeeHeight", wireType)
+				}
+				x.PendingNodeOperatorFeeHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PendingNodeOperatorFeeHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3281,6 +3569,10 @@ type BondProvider struct {
 
 	BondAddress []byte `protobuf:"bytes,1,opt,name=bond_address,json=bondAddress,proto3" json:"bond_address,omitempty"`
 	Bond        string `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond,omitempty"`
+	// pending unbond request submitted while the node was active, 0 unbonds the full bond
+	UnbondAmount string `protobuf:"bytes,3,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount,omitempty"`
+	// height the pending unbond request was submitted, 0 if there is none
+	UnbondHeight int64 `protobuf:"varint,4,opt,name=unbond_height,json=unbondHeight,proto3" json:"unbond_height,omitempty"`
 }
 
 func (x *BondProvider) Reset() {
@@ -3317,6 +3609,20 @@ func (x *BondProvider) GetBond() string {
 	return ""
 }
 
+func (x *BondProvider) GetUnbondAmount() string {
+	if x != nil {
+		return x.UnbondAmount
+	}
+	return ""
+}
+
+func (x *BondProvider) GetUnbondHeight() int64 {
+	if x != nil {
+		return x.UnbondHeight
+	}
+	return 0
+}
+
 type BondProviders struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3325,6 +3631,11 @@ type BondProviders struct {
 	NodeAddress     []byte          `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
 	NodeOperatorFee string          `protobuf:"bytes,2,opt,name=node_operator_fee,json=nodeOperatorFee,proto3" json:"node_operator_fee,omitempty"`
 	Providers       []*BondProvider `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers,omitempty"`
+	// receipt units minted against the bond of the liquid bond provider
+	LiquidBondUnits string `protobuf:"bytes,4,opt,name=liquid_bond_units,json=liquidBondUnits,proto3" json:"liquid_bond_units,omitempty"`
+	// scheduled node operator fee increase and the height it takes effect
+	PendingNodeOperatorFee       string `protobuf:"bytes,5,opt,name=pending_node_operator_fee,json=pendingNodeOperatorFee,proto3" json:"pending_node_operator_fee,omitempty"`
+	PendingNodeOperatorFeeHeight int64  `protobuf:"varint,6,opt,name=pending_node_operator_fee_height,json=pendingNodeOperatorFeeHeight,proto3" json:"pending_node_operator_fee_height,omitempty"`
 }
 
 func (x *BondProviders) Reset() {
@@ -3368,6 +3679,27 @@ func (x *BondProviders) GetProviders() []*BondProvider {
 	return nil
 }
 
+func (x *BondProviders) GetLiquidBondUnits() string {
+	if x != nil {
+		return x.LiquidBondUnits
+	}
+	return ""
+}
+
+func (x *BondProviders) GetPendingNodeOperatorFee() string {
+	if x != nil {
+		return x.PendingNodeOperatorFee
+	}
+	return ""
+}
+
+func (x *BondProviders) GetPendingNodeOperatorFeeHeight() int64 {
+	if x != nil {
+		return x.PendingNodeOperatorFeeHeight
+	}
+	return 0
+}
+
 type MinJoinLast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3471,7 +3803,7 @@ var file_types_type_node_account_proto_rawDesc = []byte{
 	0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74,
 	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61,
 	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x00, 0x22,
-	0x9e, 0x01, 0x0a, 0x0c, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x88, 0x02, 0x0a, 0x0c, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
 	0x12, 0x54, 0x0a, 0x0c, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68,
 	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
@@ -3480,49 +3812,70 @@ var file_types_type_node_account_proto_rawDesc = []byte{
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x55, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x01,
-	0x22, 0xf0, 0x01, 0x0a, 0x0d, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55,
-	0x69, 0x6e, 0x74, 0x52, 0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x46, 0x65, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x04, 0x80,
-	0xdc, 0x20, 0x01, 0x22, 0x5d, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x61,
-	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x11, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0x80, 0xdc,
-	0x20, 0x01, 0x2a, 0x62, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0f, 0x0a,
-	0x0b, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52,
-	0x65, 0x61, 0x64, 0x79, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x05,
-	0x1a, 0x04, 0xa8, 0xa4, 0x1e, 0x01, 0x2a, 0x43, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x75,
-	0x6c, 0x74, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6e, 0x10, 0x02, 0x1a, 0x04, 0xa8, 0xa4, 0x1e, 0x01, 0x42, 0x8d, 0x01, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x14, 0x54, 0x79, 0x70, 0x65, 0x4e,
-	0x6f, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68,
-	0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03,
-	0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xc8,
-	0xe1, 0x1e, 0x00, 0xd8, 0xe1, 0x1e, 0x00, 0x80, 0xe2, 0x1e, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x55, 0x69, 0x6e, 0x74, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x0d, 0x75, 0x6e,
+	0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e,
+	0x74, 0x52, 0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x01, 0x22, 0xdf, 0x03, 0x0a, 0x0d, 0x42,
+	0x6f, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x0c,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x0f, 0x6e,
+	0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x55, 0x69,
+	0x6e, 0x74, 0x52, 0x0f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x42, 0x6f, 0x6e, 0x64, 0x55, 0x6e,
+	0x69, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x19, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x16,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x52, 0x16, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4e,
+	0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x12, 0x46,
+	0x0a, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4e, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x46, 0x65, 0x65,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x01, 0x22, 0x5d, 0x0a, 0x0b,
+	0x4d, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x04, 0x80, 0xdc, 0x20, 0x01, 0x2a, 0x62, 0x0a, 0x0a, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6e, 0x64,
+	0x62, 0x79, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x03, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x10, 0x05, 0x1a, 0x04, 0xa8, 0xa4, 0x1e, 0x01, 0x2a,
+	0x43, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x54,
+	0x79, 0x70, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x79, 0x70, 0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x02, 0x1a, 0x04,
+	0xa8, 0xa4, 0x1e, 0x01, 0x42, 0x8d, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xd8, 0xe1, 0x1e, 0x00, 0x80,
+	0xe2, 0x1e, 0x00, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x14,
+	0x54, 0x79, 0x70, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f,
+	0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
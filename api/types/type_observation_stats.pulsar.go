@@ -0,0 +1,1367 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package types
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_ObservationStats_3_list)(nil)
+
+type _ObservationStats_3_list struct {
+	list *[]int64
+}
+
+func (x *_ObservationStats_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ObservationStats_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_ObservationStats_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ObservationStats_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ObservationStats_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ObservationStats at list field ObservationLags as it is not of Message kind"))
+}
+
+func (x *_ObservationStats_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ObservationStats_3_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_ObservationStats_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ObservationStats_4_list)(nil)
+
+type _ObservationStats_4_list struct {
+	list *[]int64
+}
+
+func (x *_ObservationStats_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ObservationStats_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfInt64((*x.list)[i])
+}
+
+func (x *_ObservationStats_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ObservationStats_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Int()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ObservationStats_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ObservationStats at list field NetworkFeeLags as it is not of Message kind"))
+}
+
+func (x *_ObservationStats_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ObservationStats_4_list) NewElement() protoreflect.Value {
+	v := int64(0)
+	return protoreflect.ValueOfInt64(v)
+}
+
+func (x *_ObservationStats_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ObservationStats_6_list)(nil)
+
+type _ObservationStats_6_list struct {
+	list *[]string
+}
+
+func (x *_ObservationStats_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ObservationStats_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ObservationStats_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ObservationStats_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ObservationStats_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ObservationStats at list field ObservationTxIds as it is not of Message kind"))
+}
+
+func (x *_ObservationStats_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ObservationStats_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ObservationStats_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ObservationStats_7_list)(nil)
+
+type _ObservationStats_7_list struct {
+	list *[]string
+}
+
+func (x *_ObservationStats_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ObservationStats_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ObservationStats_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ObservationStats_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ObservationStats_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ObservationStats at list field NetworkFeeIds as it is not of Message kind"))
+}
+
+func (x *_ObservationStats_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ObservationStats_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ObservationStats_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ObservationStats                     protoreflect.MessageDescriptor
+	fd_ObservationStats_node_address        protoreflect.FieldDescriptor
+	fd_ObservationStats_chain               protoreflect.FieldDescriptor
+	fd_ObservationStats_observation_lags    protoreflect.FieldDescriptor
+	fd_ObservationStats_network_fee_lags    protoreflect.FieldDescriptor
+	fd_ObservationStats_last_updated_height protoreflect.FieldDescriptor
+	fd_ObservationStats_observation_tx_ids  protoreflect.FieldDescriptor
+	fd_ObservationStats_network_fee_ids     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_types_type_observation_stats_proto_init()
+	md_ObservationStats = File_types_type_observation_stats_proto.Messages().ByName("ObservationStats")
+	fd_ObservationStats_node_address = md_ObservationStats.Fields().ByName("node_address")
+	fd_ObservationStats_chain = md_ObservationStats.Fields().ByName("chain")
+	fd_ObservationStats_observation_lags = md_ObservationStats.Fields().ByName("observation_lags")
+	fd_ObservationStats_network_fee_lags = md_ObservationStats.Fields().ByName("network_fee_lags")
+	fd_ObservationStats_last_updated_height = md_ObservationStats.Fields().ByName("last_updated_height")
+	fd_ObservationStats_observation_tx_ids = md_ObservationStats.Fields().ByName("observation_tx_ids")
+	fd_ObservationStats_network_fee_ids = md_ObservationStats.Fields().ByName("network_fee_ids")
+}
+
+var _ protoreflect.Message = (*fastReflection_ObservationStats)(nil)
+
+type fastReflection_ObservationStats ObservationStats
+
+func (x *ObservationStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ObservationStats)(x)
+}
+
+func (x *ObservationStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_types_type_observation_stats_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ObservationStats_messageType fastReflection_ObservationStats_messageType
+var _ protoreflect.MessageType = fastReflection_ObservationStats_messageType{}
+
+type fastReflection_ObservationStats_messageType struct{}
+
+func (x fastReflection_ObservationStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ObservationStats)(nil)
+}
+func (x fastReflection_ObservationStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_ObservationStats)
+}
+func (x fastReflection_ObservationStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ObservationStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ObservationStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_ObservationStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ObservationStats) Type() protoreflect.MessageType {
+	return _fastReflection_ObservationStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ObservationStats) New() protoreflect.Message {
+	return new(fastReflection_ObservationStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ObservationStats) Interface() protoreflect.ProtoMessage {
+	return (*ObservationStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ObservationStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.NodeAddress) != 0 {
+		value := protoreflect.ValueOfBytes(x.NodeAddress)
+		if !f(fd_ObservationStats_node_address, value) {
+			return
+		}
+	}
+	if x.Chain != "" {
+		value := protoreflect.ValueOfString(x.Chain)
+		if !f(fd_ObservationStats_chain, value) {
+			return
+		}
+	}
+	if len(x.ObservationLags) != 0 {
+		value := protoreflect.ValueOfList(&_ObservationStats_3_list{list: &x.ObservationLags})
+		if !f(fd_ObservationStats_observation_lags, value) {
+			return
+		}
+	}
+	if len(x.NetworkFeeLags) != 0 {
+		value := protoreflect.ValueOfList(&_ObservationStats_4_list{list: &x.NetworkFeeLags})
+		if !f(fd_ObservationStats_network_fee_lags, value) {
+			return
+		}
+	}
+	if x.LastUpdatedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUpdatedHeight)
+		if !f(fd_ObservationStats_last_updated_height, value) {
+			return
+		}
+	}
+	if len(x.ObservationTxIds) != 0 {
+		value := protoreflect.ValueOfList(&_ObservationStats_6_list{list: &x.ObservationTxIds})
+		if !f(fd_ObservationStats_observation_tx_ids, value) {
+			return
+		}
+	}
+	if len(x.NetworkFeeIds) != 0 {
+		value := protoreflect.ValueOfList(&_ObservationStats_7_list{list: &x.NetworkFeeIds})
+		if !f(fd_ObservationStats_network_fee_ids, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ObservationStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "types.ObservationStats.node_address":
+		return len(x.NodeAddress) != 0
+	case "types.ObservationStats.chain":
+		return x.Chain != ""
+	case "types.ObservationStats.observation_lags":
+		return len(x.ObservationLags) != 0
+	case "types.ObservationStats.network_fee_lags":
+		return len(x.NetworkFeeLags) != 0
+	case "types.ObservationStats.last_updated_height":
+		return x.LastUpdatedHeight != int64(0)
+	case "types.ObservationStats.observation_tx_ids":
+		return len(x.ObservationTxIds) != 0
+	case "types.ObservationStats.network_fee_ids":
+		return len(x.NetworkFeeIds) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.ObservationStats"))
+		}
+		panic(fmt.Errorf("message types.ObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ObservationStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "types.ObservationStats.node_address":
+		x.NodeAddress = nil
+	case "types.ObservationStats.chain":
+		x.Chain = ""
+	case "types.ObservationStats.observation_lags":
+		x.ObservationLags = nil
+	case "types.ObservationStats.network_fee_lags":
+		x.NetworkFeeLags = nil
+	case "types.ObservationStats.last_updated_height":
+		x.LastUpdatedHeight = int64(0)
+	case "types.ObservationStats.observation_tx_ids":
+		x.ObservationTxIds = nil
+	case "types.ObservationStats.network_fee_ids":
+		x.NetworkFeeIds = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.ObservationStats"))
+		}
+		panic(fmt.Errorf("message types.ObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ObservationStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "types.ObservationStats.node_address":
+		value := x.NodeAddress
+		return protoreflect.ValueOfBytes(value)
+	case "types.ObservationStats.chain":
+		value := x.Chain
+		return protoreflect.ValueOfString(value)
+	case "types.ObservationStats.observation_lags":
+		if len(x.ObservationLags) == 0 {
+			return protoreflect.ValueOfList(&_ObservationStats_3_list{})
+		}
+		listValue := &_ObservationStats_3_list{list: &x.ObservationLags}
+		return protoreflect.ValueOfList(listValue)
+	case "types.ObservationStats.network_fee_lags":
+		if len(x.NetworkFeeLags) == 0 {
+			return protoreflect.ValueOfList(&_ObservationStats_4_list{})
+		}
+		listValue := &_ObservationStats_4_list{list: &x.NetworkFeeLags}
+		return protoreflect.ValueOfList(listValue)
+	case "types.ObservationStats.last_updated_height":
+		value := x.LastUpdatedHeight
+		return protoreflect.ValueOfInt64(value)
+	case "types.ObservationStats.observation_tx_ids":
+		if len(x.ObservationTxIds) == 0 {
+			return protoreflect.ValueOfList(&_ObservationStats_6_list{})
+		}
+		listValue := &_ObservationStats_6_list{list: &x.ObservationTxIds}
+		return protoreflect.ValueOfList(listValue)
+	case "types.ObservationStats.network_fee_ids":
+		if len(x.NetworkFeeIds) == 0 {
+			return protoreflect.ValueOfList(&_ObservationStats_7_list{})
+		}
+		listValue := &_ObservationStats_7_list{list: &x.NetworkFeeIds}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.ObservationStats"))
+		}
+		panic(fmt.Errorf("message types.ObservationStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ObservationStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "types.ObservationStats.node_address":
+		x.NodeAddress = value.Bytes()
+	case "types.ObservationStats.chain":
+		x.Chain = value.Interface().(string)
+	case "types.ObservationStats.observation_lags":
+		lv := value.List()
+		clv := lv.(*_ObservationStats_3_list)
+		x.ObservationLags = *clv.list
+	case "types.ObservationStats.network_fee_lags":
+		lv := value.List()
+		clv := lv.(*_ObservationStats_4_list)
+		x.NetworkFeeLags = *clv.list
+	case "types.ObservationStats.last_updated_height":
+		x.LastUpdatedHeight = value.Int()
+	case "types.ObservationStats.observation_tx_ids":
+		lv := value.List()
+		clv := lv.(*_ObservationStats_6_list)
+		x.ObservationTxIds = *clv.list
+	case "types.ObservationStats.network_fee_ids":
+		lv := value.List()
+		clv := lv.(*_ObservationStats_7_list)
+		x.NetworkFeeIds = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.ObservationStats"))
+		}
+		panic(fmt.Errorf("message types.ObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ObservationStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.ObservationStats.observation_lags":
+		if x.ObservationLags == nil {
+			x.ObservationLags = []int64{}
+		}
+		value := &_ObservationStats_3_list{list: &x.ObservationLags}
+		return protoreflect.ValueOfList(value)
+	case "types.ObservationStats.network_fee_lags":
+		if x.NetworkFeeLags == nil {
+			x.NetworkFeeLags = []int64{}
+		}
+		value := &_ObservationStats_4_list{list: &x.NetworkFeeLags}
+		return protoreflect.ValueOfList(value)
+	case "types.ObservationStats.observation_tx_ids":
+		if x.ObservationTxIds == nil {
+			x.ObservationTxIds = []string{}
+		}
+		value := &_ObservationStats_6_list{list: &x.ObservationTxIds}
+		return protoreflect.ValueOfList(value)
+	case "types.ObservationStats.network_fee_ids":
+		if x.NetworkFeeIds == nil {
+			x.NetworkFeeIds = []string{}
+		}
+		value := &_ObservationStats_7_list{list: &x.NetworkFeeIds}
+		return protoreflect.ValueOfList(value)
+	case "types.ObservationStats.node_address":
+		panic(fmt.Errorf("field node_address of message types.ObservationStats is not mutable"))
+	case "types.ObservationStats.chain":
+		panic(fmt.Errorf("field chain of message types.ObservationStats is not mutable"))
+	case "types.ObservationStats.last_updated_height":
+		panic(fmt.Errorf("field last_updated_height of message types.ObservationStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.ObservationStats"))
+		}
+		panic(fmt.Errorf("message types.ObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ObservationStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "types.ObservationStats.node_address":
+		return protoreflect.ValueOfBytes(nil)
+	case "types.ObservationStats.chain":
+		return protoreflect.ValueOfString("")
+	case "types.ObservationStats.observation_lags":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_ObservationStats_3_list{list: &list})
+	case "types.ObservationStats.network_fee_lags":
+		list := []int64{}
+		return protoreflect.ValueOfList(&_ObservationStats_4_list{list: &list})
+	case "types.ObservationStats.last_updated_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "types.ObservationStats.observation_tx_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ObservationStats_6_list{list: &list})
+	case "types.ObservationStats.network_fee_ids":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ObservationStats_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.ObservationStats"))
+		}
+		panic(fmt.Errorf("message types.ObservationStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ObservationStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in types.ObservationStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ObservationStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ObservationStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ObservationStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ObservationStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ObservationStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.NodeAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Chain)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ObservationLags) > 0 {
+			l = 0
+			for _, e := range x.ObservationLags {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.NetworkFeeLags) > 0 {
+			l = 0
+			for _, e := range x.NetworkFeeLags {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.LastUpdatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUpdatedHeight))
+		}
+		if len(x.ObservationTxIds) > 0 {
+			for _, s := range x.ObservationTxIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NetworkFeeIds) > 0 {
+			for _, s := range x.NetworkFeeIds {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ObservationStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NetworkFeeIds) > 0 {
+			for iNdEx := len(x.NetworkFeeIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NetworkFeeIds[iNdEx])
+				copy(dAtA[i:], x.NetworkFeeIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkFeeIds[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.ObservationTxIds) > 0 {
+			for iNdEx := len(x.ObservationTxIds) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ObservationTxIds[iNdEx])
+				copy(dAtA[i:], x.ObservationTxIds[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ObservationTxIds[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.LastUpdatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUpdatedHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.NetworkFeeLags) > 0 {
+			var pksize2 int
+			for _, num := range x.NetworkFeeLags {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num1 := range x.NetworkFeeLags {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ObservationLags) > 0 {
+			var pksize4 int
+			for _, num := range x.ObservationLags {
+				pksize4 += runtime.Sov(uint64(num))
+			}
+			i -= pksize4
+			j3 := i
+			for _, num1 := range x.ObservationLags {
+				num := uint64(num1)
+				for num >= 1<<7 {
+					dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j3++
+				}
+				dAtA[j3] = uint8(num)
+				j3++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize4))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Chain) > 0 {
+			i -= len(x.Chain)
+			copy(dAtA[i:], x.Chain)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Chain)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.NodeAddress) > 0 {
+			i -= len(x.NodeAddress)
+			copy(dAtA[i:], x.NodeAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NodeAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ObservationStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ObservationStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ObservationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NodeAddress = append(x.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+				if x.NodeAddress == nil {
+					x.NodeAddress = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Chain = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ObservationLags = append(x.ObservationLags, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ObservationLags) == 0 {
+						x.ObservationLags = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ObservationLags = append(x.ObservationLags, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ObservationLags", wireType)
+				}
+			case 4:
+				if wireType == 0 {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.NetworkFeeLags = append(x.NetworkFeeLags, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.NetworkFeeLags) == 0 {
+						x.NetworkFeeLags = make([]int64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v int64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= int64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.NetworkFeeLags = append(x.NetworkFeeLags, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeLags", wireType)
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+				}
+				x.LastUpdatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUpdatedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ObservationTxIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ObservationTxIds = append(x.ObservationTxIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeIds", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkFeeIds = append(x.NetworkFeeIds, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: types/type_observation_stats.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ObservationStats tracks how far behind quorum a node observed the most recent
+// transactions and network fees of a chain.
+type ObservationStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeAddress []byte `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address,omitempty"`
+	Chain       string `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	// blocks behind quorum of the most recent observations, oldest first, -1 if missed
+	ObservationLags []int64 `protobuf:"varint,3,rep,packed,name=observation_lags,json=observationLags,proto3" json:"observation_lags,omitempty"`
+	// blocks behind quorum of the most recent network fee votes, oldest first, -1 if missed
+	NetworkFeeLags    []int64 `protobuf:"varint,4,rep,packed,name=network_fee_lags,json=networkFeeLags,proto3" json:"network_fee_lags,omitempty"`
+	LastUpdatedHeight int64   `protobuf:"varint,5,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height,omitempty"`
+	// tx id of each tracked observation, aligned with observation_lags
+	ObservationTxIds []string `protobuf:"bytes,6,rep,name=observation_tx_ids,json=observationTxIds,proto3" json:"observation_tx_ids,omitempty"`
+	// chain height, fee rate and tx size of each tracked network fee vote, aligned with network_fee_lags
+	NetworkFeeIds []string `protobuf:"bytes,7,rep,name=network_fee_ids,json=networkFeeIds,proto3" json:"network_fee_ids,omitempty"`
+}
+
+func (x *ObservationStats) Reset() {
+	*x = ObservationStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_types_type_observation_stats_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObservationStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObservationStats) ProtoMessage() {}
+
+// Deprecated: Use ObservationStats.ProtoReflect.Descriptor instead.
+func (*ObservationStats) Descriptor() ([]byte, []int) {
+	return file_types_type_observation_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ObservationStats) GetNodeAddress() []byte {
+	if x != nil {
+		return x.NodeAddress
+	}
+	return nil
+}
+
+func (x *ObservationStats) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *ObservationStats) GetObservationLags() []int64 {
+	if x != nil {
+		return x.ObservationLags
+	}
+	return nil
+}
+
+func (x *ObservationStats) GetNetworkFeeLags() []int64 {
+	if x != nil {
+		return x.NetworkFeeLags
+	}
+	return nil
+}
+
+func (x *ObservationStats) GetLastUpdatedHeight() int64 {
+	if x != nil {
+		return x.LastUpdatedHeight
+	}
+	return 0
+}
+
+func (x *ObservationStats) GetObservationTxIds() []string {
+	if x != nil {
+		return x.ObservationTxIds
+	}
+	return nil
+}
+
+func (x *ObservationStats) GetNetworkFeeIds() []string {
+	if x != nil {
+		return x.NetworkFeeIds
+	}
+	return nil
+}
+
+var File_types_type_observation_stats_proto protoreflect.FileDescriptor
+
+var file_types_type_observation_stats_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x8c, 0x03, 0x0a, 0x10, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xfa, 0xde,
+	0x1f, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x47, 0x0a, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xfa, 0xde, 0x1f,
+	0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76,
+	0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x67, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x4c, 0x61, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x78, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x49, 0x64, 0x73,
+	0x42, 0x86, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x19,
+	0x54, 0x79, 0x70, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_types_type_observation_stats_proto_rawDescOnce sync.Once
+	file_types_type_observation_stats_proto_rawDescData = file_types_type_observation_stats_proto_rawDesc
+)
+
+func file_types_type_observation_stats_proto_rawDescGZIP() []byte {
+	file_types_type_observation_stats_proto_rawDescOnce.Do(func() {
+		file_types_type_observation_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_types_type_observation_stats_proto_rawDescData)
+	})
+	return file_types_type_observation_stats_proto_rawDescData
+}
+
+var file_types_type_observation_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_types_type_observation_stats_proto_goTypes = []interface{}{
+	(*ObservationStats)(nil), // 0: types.ObservationStats
+}
+var file_types_type_observation_stats_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_types_type_observation_stats_proto_init() }
+func file_types_type_observation_stats_proto_init() {
+	if File_types_type_observation_stats_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_types_type_observation_stats_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObservationStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_types_type_observation_stats_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_types_type_observation_stats_proto_goTypes,
+		DependencyIndexes: file_types_type_observation_stats_proto_depIdxs,
+		MessageInfos:      file_types_type_observation_stats_proto_msgTypes,
+	}.Build()
+	File_types_type_observation_stats_proto = out.File
+	file_types_type_observation_stats_proto_rawDesc = nil
+	file_types_type_observation_stats_proto_goTypes = nil
+	file_types_type_observation_stats_proto_depIdxs = nil
+}
@@ -82,6 +82,7 @@ var (
 	fd_TxOutItem_aggregator_target_limit protoreflect.FieldDescriptor
 	fd_TxOutItem_clout_spent             protoreflect.FieldDescriptor
 	fd_TxOutItem_vault_pub_key_eddsa     protoreflect.FieldDescriptor
+	fd_TxOutItem_split_destination       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -102,6 +103,7 @@ func init() {
 	fd_TxOutItem_aggregator_target_limit = md_TxOutItem.Fields().ByName("aggregator_target_limit")
 	fd_TxOutItem_clout_spent = md_TxOutItem.Fields().ByName("clout_spent")
 	fd_TxOutItem_vault_pub_key_eddsa = md_TxOutItem.Fields().ByName("vault_pub_key_eddsa")
+	fd_TxOutItem_split_destination = md_TxOutItem.Fields().ByName("split_destination")
 }
 
 var _ protoreflect.Message = (*fastReflection_TxOutItem)(nil)
@@ -259,6 +261,12 @@ func (x *fastReflection_TxOutItem) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.SplitDestination != false {
+		value := protoreflect.ValueOfBool(x.SplitDestination)
+		if !f(fd_TxOutItem_split_destination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -304,6 +312,8 @@ func (x *fastReflection_TxOutItem) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CloutSpent != ""
 	case "types.TxOutItem.vault_pub_key_eddsa":
 		return x.VaultPubKeyEddsa != ""
+	case "types.TxOutItem.split_destination":
+		return x.SplitDestination != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TxOutItem"))
@@ -350,6 +360,8 @@ func (x *fastReflection_TxOutItem) Clear(fd protoreflect.FieldDescriptor) {
 		x.CloutSpent = ""
 	case "types.TxOutItem.vault_pub_key_eddsa":
 		x.VaultPubKeyEddsa = ""
+	case "types.TxOutItem.split_destination":
+		x.SplitDestination = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TxOutItem"))
@@ -414,6 +426,9 @@ func (x *fastReflection_TxOutItem) Get(descriptor protoreflect.FieldDescriptor)
 	case "types.TxOutItem.vault_pub_key_eddsa":
 		value := x.VaultPubKeyEddsa
 		return protoreflect.ValueOfString(value)
+	case "types.TxOutItem.split_destination":
+		value := x.SplitDestination
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TxOutItem"))
@@ -466,6 +481,8 @@ func (x *fastReflection_TxOutItem) Set(fd protoreflect.FieldDescriptor, value pr
 		x.CloutSpent = value.Interface().(string)
 	case "types.TxOutItem.vault_pub_key_eddsa":
 		x.VaultPubKeyEddsa = value.Interface().(string)
+	case "types.TxOutItem.split_destination":
+		x.SplitDestination = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TxOutItem"))
@@ -523,6 +540,8 @@ func (x *fastReflection_TxOutItem) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field clout_spent of message types.TxOutItem is not mutable"))
 	case "types.TxOutItem.vault_pub_key_eddsa":
 		panic(fmt.Errorf("field vault_pub_key_eddsa of message types.TxOutItem is not mutable"))
+	case "types.TxOutItem.split_destination":
+		panic(fmt.Errorf("field split_destination of message types.TxOutItem is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TxOutItem"))
@@ -568,6 +587,8 @@ func (x *fastReflection_TxOutItem) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfString("")
 	case "types.TxOutItem.vault_pub_key_eddsa":
 		return protoreflect.ValueOfString("")
+	case "types.TxOutItem.split_destination":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: types.TxOutItem"))
@@ -698,6 +719,9 @@ func (x *fastReflection_TxOutItem) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.SplitDestination {
+			n += 3
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -727,6 +751,18 @@ func (x *fastReflection_TxOutItem) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SplitDestination {
+			i--
+			if x.SplitDestination {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
 		if len(x.VaultPubKeyEddsa) > 0 {
 			i -= len(x.VaultPubKeyEddsa)
 			copy(dAtA[i:], x.VaultPubKeyEddsa)
@@ -1368,6 +1404,26 @@ func (x *fastReflection_TxOutItem) ProtoMethods() *protoiface.Methods {
 				}
 				x.VaultPubKeyEddsa = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SplitDestination", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SplitDestination = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1978,6 +2034,9 @@ type TxOutItem struct {
 	AggregatorTargetLimit string         `protobuf:"bytes,13,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3" json:"aggregator_target_limit,omitempty"`
 	CloutSpent            string         `protobuf:"bytes,14,opt,name=clout_spent,json=cloutSpent,proto3" json:"clout_spent,omitempty"`
 	VaultPubKeyEddsa      string         `protobuf:"bytes,15,opt,name=vault_pub_key_eddsa,json=vaultPubKeyEddsa,proto3" json:"vault_pub_key_eddsa,omitempty"`
+	// split_destination marks the outbounds paying the recipients of a split
+	// destination swap, which can be signed as one batched tx
+	SplitDestination bool `protobuf:"varint,16,opt,name=split_destination,json=splitDestination,proto3" json:"split_destination,omitempty"`
 }
 
 func (x *TxOutItem) Reset() {
@@ -2105,6 +2164,13 @@ func (x *TxOutItem) GetVaultPubKeyEddsa() string {
 	return ""
 }
 
+func (x *TxOutItem) GetSplitDestination() bool {
+	if x != nil {
+		return x.SplitDestination
+	}
+	return false
+}
+
 type TxOut struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2155,7 +2221,7 @@ var file_types_type_tx_out_proto_rawDesc = []byte{
 	0x6f, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
 	0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec, 0x07, 0x0a, 0x09,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x08, 0x0a, 0x09,
 	0x54, 0x78, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x50, 0x0a, 0x05, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0xea, 0xde, 0x1f, 0x05, 0x63, 0x68,
 	0x61, 0x69, 0x6e, 0xfa, 0xde, 0x1f, 0x2d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f,
@@ -2218,22 +2284,25 @@ var file_types_type_tx_out_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
 	0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
 	0x6e, 0x2e, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x10, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x45, 0x64, 0x64, 0x73, 0x61, 0x22, 0x58, 0x0a, 0x05, 0x54, 0x78,
-	0x4f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x74,
-	0x78, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x78, 0x41, 0x72, 0x72, 0x61, 0x79, 0x3a, 0x04,
-	0x80, 0xdc, 0x20, 0x01, 0x42, 0x87, 0x01, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x42, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02,
-	0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2, 0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0xc8, 0xe1, 0x1e, 0x00, 0xd8, 0xe1, 0x1e, 0x00, 0x80, 0xe2, 0x1e, 0x00, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x45, 0x64, 0x64, 0x73, 0x61, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x05, 0x54, 0x78, 0x4f, 0x75, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x61,
+	0x72, 0x72, 0x61, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x74, 0x78, 0x41, 0x72, 0x72, 0x61, 0x79, 0x3a, 0x04, 0x80, 0xdc, 0x20,
+	0x01, 0x42, 0x87, 0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xd8, 0xe1, 0x1e, 0x00, 0x80, 0xe2, 0x1e, 0x00,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x42, 0x0e, 0x54, 0x79, 0x70,
+	0x65, 0x54, 0x78, 0x4f, 0x75, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x68, 0x6f, 0x72, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x76, 0x33, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x54, 0x58, 0x58, 0xaa,
+	0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xca, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0xe2,
+	0x02, 0x11, 0x54, 0x79, 0x70, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
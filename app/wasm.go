@@ -19,6 +19,7 @@ var wasmAcceptedQueries = wasmkeeper.AcceptedQueries{
 	"/types.Query/Node":              &apitypes.QueryNodeResponse{},
 	"/types.Query/OutboundFee":       &apitypes.QueryOutboundFeeResponse{},
 	"/types.Query/Pool":              &apitypes.QueryPoolResponse{},
+	"/types.Query/PoolTWAP":          &apitypes.QueryPoolTWAPResponse{},
 	"/types.Query/QuoteSwap":         &apitypes.QueryQuoteSwapResponse{},
 	"/types.Query/SecuredAsset":      &apitypes.QuerySecuredAssetResponse{},
 }
@@ -99,6 +99,19 @@ func (b *BlockScanner) PreviousHeight() int64 {
 	return atomic.LoadInt64(&b.previousBlock)
 }
 
+// GetBlocksForRetry returns the heights of the blocks that are awaiting a retry
+func (b *BlockScanner) GetBlocksForRetry() ([]int64, error) {
+	blocks, err := b.scannerStorage.GetBlocksForRetry(false)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get blocks for retry: %w", err)
+	}
+	heights := make([]int64, 0, len(blocks))
+	for _, block := range blocks {
+		heights = append(heights, block.Height)
+	}
+	return heights, nil
+}
+
 // RollbackToLastObserved rollback the block scanner to last observed height minus flex period
 func (b *BlockScanner) RollbackToLastObserved() error {
 	lastObservedHeight, err := b.thorchainBridge.GetLastObservedInHeight(b.cfg.ChainID)
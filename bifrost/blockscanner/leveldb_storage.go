@@ -5,13 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 )
 
-// LevelDBScannerStorage is a scanner storage backed by level db
+// LevelDBScannerStorage is a scanner storage backed by the configured db store
 type LevelDBScannerStorage struct {
-	db *leveldb.DB
+	db db.Store
 }
 
 const (
@@ -25,13 +24,13 @@ type BlockStatusItem struct {
 }
 
 // NewLevelDBScannerStorage create a new instance of LevelDBScannerStorage
-func NewLevelDBScannerStorage(db *leveldb.DB) (*LevelDBScannerStorage, error) {
-	return &LevelDBScannerStorage{db: db}, nil
+func NewLevelDBScannerStorage(store db.Store) (*LevelDBScannerStorage, error) {
+	return &LevelDBScannerStorage{db: store}, nil
 }
 
 // GetScanPos get current Scan Pos
 func (ldbss *LevelDBScannerStorage) GetScanPos() (int64, error) {
-	buf, err := ldbss.db.Get([]byte(ScanPosKey), nil)
+	buf, err := ldbss.db.Get([]byte(ScanPosKey))
 	if err != nil {
 		return 0, err
 	}
@@ -43,7 +42,7 @@ func (ldbss *LevelDBScannerStorage) GetScanPos() (int64, error) {
 func (ldbss *LevelDBScannerStorage) SetScanPos(block int64) error {
 	buf := make([]byte, 8)
 	n := binary.PutVarint(buf, block)
-	return ldbss.db.Put([]byte(ScanPosKey), buf[:n], nil)
+	return ldbss.db.Put([]byte(ScanPosKey), buf[:n])
 }
 
 func (ldbss *LevelDBScannerStorage) SetBlockScanStatus(block Block, status BlockScanStatus) error {
@@ -55,7 +54,7 @@ func (ldbss *LevelDBScannerStorage) SetBlockScanStatus(block Block, status Block
 	if err != nil {
 		return fmt.Errorf("fail to marshal BlockStatusItem to json: %w", err)
 	}
-	if err = ldbss.db.Put([]byte(getBlockStatusKey(block.Height)), buf, nil); err != nil {
+	if err = ldbss.db.Put([]byte(getBlockStatusKey(block.Height)), buf); err != nil {
 		return fmt.Errorf("fail to set block scan status: %w", err)
 	}
 	return nil
@@ -63,7 +62,7 @@ func (ldbss *LevelDBScannerStorage) SetBlockScanStatus(block Block, status Block
 
 // GetFailedBlocksForRetry
 func (ldbss *LevelDBScannerStorage) GetBlocksForRetry(failedOnly bool) ([]Block, error) {
-	iterator := ldbss.db.NewIterator(util.BytesPrefix([]byte("block-process-status-")), nil)
+	iterator := ldbss.db.NewIterator([]byte("block-process-status-"))
 	defer iterator.Release()
 	var results []Block
 	for iterator.Next() {
@@ -91,7 +90,7 @@ func getBlockStatusKey(block int64) string {
 }
 
 func (ldbss *LevelDBScannerStorage) RemoveBlockStatus(block int64) error {
-	return ldbss.db.Delete([]byte(getBlockStatusKey(block)), nil)
+	return ldbss.db.Delete([]byte(getBlockStatusKey(block)))
 }
 
 func (ldbss *LevelDBScannerStorage) Close() error {
@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/syndtr/goleveldb/leveldb"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 )
 
 const MockErrorBlockHeight = 1024
@@ -74,6 +74,6 @@ func (mss *MockScannerStorage) Close() error {
 	return nil
 }
 
-func (mss *MockScannerStorage) GetInternalDb() *leveldb.DB {
+func (mss *MockScannerStorage) GetInternalDb() db.Store {
 	return nil
 }
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/syndtr/goleveldb/leveldb"
-
 	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	"gitlab.com/thorchain/thornode/v3/config"
 )
@@ -17,18 +15,18 @@ type ScannerStorage interface {
 	SetBlockScanStatus(block Block, status BlockScanStatus) error
 	RemoveBlockStatus(block int64) error
 	GetBlocksForRetry(failedOnly bool) ([]Block, error)
-	GetInternalDb() *leveldb.DB
+	GetInternalDb() db.Store
 	io.Closer
 }
 
 // BlockScannerStorage
 type BlockScannerStorage struct {
 	*LevelDBScannerStorage
-	db *leveldb.DB
+	db db.Store
 }
 
 func NewBlockScannerStorage(levelDbFolder string, opts config.LevelDBOptions) (*BlockScannerStorage, error) {
-	ldb, err := db.NewLevelDB(levelDbFolder, opts)
+	ldb, err := db.NewStore(levelDbFolder, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create level db: %w", err)
 	}
@@ -43,6 +41,6 @@ func NewBlockScannerStorage(levelDbFolder string, opts config.LevelDBOptions) (*
 	}, nil
 }
 
-func (s *BlockScannerStorage) GetInternalDb() *leveldb.DB {
+func (s *BlockScannerStorage) GetInternalDb() db.Store {
 	return s.db
 }
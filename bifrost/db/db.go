@@ -1,38 +1,127 @@
 package db
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	log "github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
-	"github.com/syndtr/goleveldb/leveldb/util"
 
 	"gitlab.com/thorchain/thornode/v3/config"
 )
 
-func NewLevelDB(path string, opts config.LevelDBOptions) (*leveldb.DB, error) {
-	// if path is empty, use in memory db
-	if path == "" {
-		storage := storage.NewMemStorage()
-		return leveldb.Open(storage, nil)
-	}
+const (
+	BackendLevelDB = "goleveldb"
+	BackendPebble  = "pebble"
+)
+
+// ErrNotFound is returned by Get when the key does not exist, for all backends.
+var ErrNotFound = errors.New("db: key not found")
+
+// Store is the key value store used by the bifrost storage layers.
+type Store interface {
+	// Get returns the value for the key, or ErrNotFound.
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) (bool, error)
+	Put(key, value []byte) error
+	Delete(key []byte) error
+	Write(batch *Batch) error
+
+	// NewIterator returns an iterator over all keys with the given prefix, in
+	// ascending order. The iterator must be released after use.
+	NewIterator(prefix []byte) Iterator
+
+	// Compact compacts the full key range.
+	Compact() error
+	Close() error
+}
+
+// Iterator iterates over a key range. Next must be called before the first entry is
+// read, the returned key and value are only valid until the next call to Next.
+type Iterator interface {
+	Next() bool
+	Key() []byte
+	Value() []byte
+	Error() error
+	Release()
+}
+
+type batchOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// Batch is a set of writes applied atomically by Store.Write.
+type Batch struct {
+	ops []batchOp
+}
+
+func (b *Batch) Put(key, value []byte) {
+	b.ops = append(b.ops, batchOp{key: key, value: value})
+}
 
-	// open the database (or create)
-	db, err := leveldb.OpenFile(path, opts.Options())
+func (b *Batch) Delete(key []byte) {
+	b.ops = append(b.ops, batchOp{key: key, delete: true})
+}
+
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
+func (b *Batch) Reset() {
+	b.ops = b.ops[:0]
+}
+
+// NewStore opens (or creates) the store at the given path with the backend selected in
+// the options. If path is empty, an in memory leveldb store is used.
+func NewStore(path string, opts config.LevelDBOptions) (Store, error) {
+	var store Store
+	var err error
+	switch backend := Backend(opts); backend {
+	case BackendLevelDB:
+		store, err = newLevelDBStore(path, opts)
+	case BackendPebble:
+		store, err = newPebbleStore(path, opts)
+	default:
+		return nil, fmt.Errorf("unknown db backend: %s", backend)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to open level db %s: %w", path, err)
+		return nil, err
 	}
 
 	// compact the database if configured
-	if opts.CompactOnInit {
-		log.Info().Str("path", path).Msg("compacting leveldb...")
-		err = db.CompactRange(util.Range{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to compact level db %s: %w", path, err)
+	if opts.CompactOnInit && path != "" {
+		log.Info().Str("path", path).Msg("compacting db...")
+		if err = store.Compact(); err != nil {
+			_ = store.Close()
+			return nil, fmt.Errorf("failed to compact db %s: %w", path, err)
 		}
-		log.Info().Str("path", path).Msg("leveldb compacted")
+		log.Info().Str("path", path).Msg("db compacted")
 	}
 
-	return db, nil
+	return store, nil
+}
+
+// Backend returns the normalized backend name of the options, defaulting to leveldb.
+func Backend(opts config.LevelDBOptions) string {
+	backend := strings.ToLower(strings.TrimSpace(opts.Backend))
+	if backend == "" || backend == "leveldb" {
+		return BackendLevelDB
+	}
+	return backend
+}
+
+// prefixLimit returns the smallest key greater than all keys with the given prefix,
+// or nil if there is no such key.
+func prefixLimit(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if c := prefix[i]; c < 0xff {
+			limit := make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i] = c + 1
+			return limit
+		}
+	}
+	return nil
 }
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type DBTestSuite struct{}
+
+var _ = Suite(&DBTestSuite{})
+
+func (s *DBTestSuite) testStore(c *C, store Store) {
+	_, err := store.Get([]byte("a:1"))
+	c.Assert(errors.Is(err, ErrNotFound), Equals, true)
+	ok, err := store.Has([]byte("a:1"))
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+
+	c.Assert(store.Put([]byte("a:1"), []byte("one")), IsNil)
+	batch := &Batch{}
+	batch.Put([]byte("a:2"), []byte("two"))
+	batch.Put([]byte("a:3"), []byte("three"))
+	batch.Put([]byte("b:1"), []byte("other"))
+	batch.Delete([]byte("a:3"))
+	c.Assert(batch.Len(), Equals, 4)
+	c.Assert(store.Write(batch), IsNil)
+
+	value, err := store.Get([]byte("a:1"))
+	c.Assert(err, IsNil)
+	c.Assert(string(value), Equals, "one")
+	ok, err = store.Has([]byte("a:3"))
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, false)
+
+	// iterate the prefix in order
+	iter := store.NewIterator([]byte("a:"))
+	var keys, values []string
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()))
+		values = append(values, string(iter.Value()))
+	}
+	c.Assert(iter.Error(), IsNil)
+	iter.Release()
+	c.Assert(keys, DeepEquals, []string{"a:1", "a:2"})
+	c.Assert(values, DeepEquals, []string{"one", "two"})
+
+	c.Assert(store.Delete([]byte("a:1")), IsNil)
+	_, err = store.Get([]byte("a:1"))
+	c.Assert(errors.Is(err, ErrNotFound), Equals, true)
+
+	c.Assert(store.Compact(), IsNil)
+}
+
+func (s *DBTestSuite) TestLevelDB(c *C) {
+	store, err := NewStore(filepath.Join(c.MkDir(), "db"), config.LevelDBOptions{FilterBitsPerKey: 10})
+	c.Assert(err, IsNil)
+	s.testStore(c, store)
+	c.Assert(store.Close(), IsNil)
+}
+
+func (s *DBTestSuite) TestPebble(c *C) {
+	store, err := NewStore(filepath.Join(c.MkDir(), "db"), config.LevelDBOptions{
+		Backend:                       BackendPebble,
+		FilterBitsPerKey:              10,
+		CompactionTableSizeMultiplier: 1,
+		WriteBuffer:                   4 << 20,
+		BlockCacheCapacity:            8 << 20,
+		CompactOnInit:                 true,
+	})
+	c.Assert(err, IsNil)
+	s.testStore(c, store)
+	c.Assert(store.Close(), IsNil)
+
+	// in memory
+	store, err = NewStore("", config.LevelDBOptions{Backend: BackendPebble})
+	c.Assert(err, IsNil)
+	s.testStore(c, store)
+	c.Assert(store.Close(), IsNil)
+}
+
+func (s *DBTestSuite) TestUnknownBackend(c *C) {
+	_, err := NewStore("", config.LevelDBOptions{Backend: "rocksdb"})
+	c.Assert(err, NotNil)
+	c.Assert(Backend(config.LevelDBOptions{Backend: "LevelDB"}), Equals, BackendLevelDB)
+}
+
+func (s *DBTestSuite) TestMigrate(c *C) {
+	dir := c.MkDir()
+	src, err := NewStore(filepath.Join(dir, "src"), config.LevelDBOptions{})
+	c.Assert(err, IsNil)
+	for _, key := range []string{"a", "b", "c"} {
+		c.Assert(src.Put([]byte(key), []byte(key+key)), IsNil)
+	}
+
+	dst, err := NewStore(filepath.Join(dir, "dst"), config.LevelDBOptions{Backend: BackendPebble})
+	c.Assert(err, IsNil)
+	count, err := Migrate(src, dst)
+	c.Assert(err, IsNil)
+	c.Assert(count, Equals, 3)
+	c.Assert(src.Close(), IsNil)
+
+	value, err := dst.Get([]byte("b"))
+	c.Assert(err, IsNil)
+	c.Assert(string(value), Equals, "bb")
+	c.Assert(dst.Close(), IsNil)
+}
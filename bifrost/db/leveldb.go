@@ -0,0 +1,78 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+type levelDBStore struct {
+	db *leveldb.DB
+}
+
+func newLevelDBStore(path string, opts config.LevelDBOptions) (*levelDBStore, error) {
+	// if path is empty, use in memory db
+	if path == "" {
+		db, err := leveldb.Open(storage.NewMemStorage(), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open in memory level db: %w", err)
+		}
+		return &levelDBStore{db: db}, nil
+	}
+
+	// open the database (or create)
+	db, err := leveldb.OpenFile(path, opts.Options())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open level db %s: %w", path, err)
+	}
+	return &levelDBStore{db: db}, nil
+}
+
+func (s *levelDBStore) Get(key []byte) ([]byte, error) {
+	value, err := s.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	return value, err
+}
+
+func (s *levelDBStore) Has(key []byte) (bool, error) {
+	return s.db.Has(key, nil)
+}
+
+func (s *levelDBStore) Put(key, value []byte) error {
+	return s.db.Put(key, value, nil)
+}
+
+func (s *levelDBStore) Delete(key []byte) error {
+	return s.db.Delete(key, nil)
+}
+
+func (s *levelDBStore) Write(batch *Batch) error {
+	b := new(leveldb.Batch)
+	for _, op := range batch.ops {
+		if op.delete {
+			b.Delete(op.key)
+		} else {
+			b.Put(op.key, op.value)
+		}
+	}
+	return s.db.Write(b, nil)
+}
+
+func (s *levelDBStore) NewIterator(prefix []byte) Iterator {
+	return s.db.NewIterator(util.BytesPrefix(prefix), nil)
+}
+
+func (s *levelDBStore) Compact() error {
+	return s.db.CompactRange(util.Range{})
+}
+
+func (s *levelDBStore) Close() error {
+	return s.db.Close()
+}
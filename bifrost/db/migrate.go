@@ -0,0 +1,39 @@
+package db
+
+import "fmt"
+
+// migrateBatchSize is the number of keys copied per batch write.
+const migrateBatchSize = 10_000
+
+// Migrate copies every key in src to dst and returns the number of keys copied. Both
+// stores must be closed to all other writers while migrating.
+func Migrate(src, dst Store) (int, error) {
+	iter := src.NewIterator(nil)
+	defer iter.Release()
+
+	count := 0
+	batch := &Batch{}
+	for iter.Next() {
+		// iterator buffers are reused, copy before batching
+		key := append([]byte{}, iter.Key()...)
+		value := append([]byte{}, iter.Value()...)
+		batch.Put(key, value)
+		count++
+
+		if batch.Len() >= migrateBatchSize {
+			if err := dst.Write(batch); err != nil {
+				return count, fmt.Errorf("fail to write batch: %w", err)
+			}
+			batch.Reset()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return count, fmt.Errorf("fail to iterate source db: %w", err)
+	}
+	if batch.Len() > 0 {
+		if err := dst.Write(batch); err != nil {
+			return count, fmt.Errorf("fail to write batch: %w", err)
+		}
+	}
+	return count, nil
+}
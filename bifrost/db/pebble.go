@@ -0,0 +1,187 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+	"github.com/cockroachdb/pebble/vfs"
+
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+// pebbleTargetFileSize matches the leveldb default table size the compaction
+// multiplier applies to.
+const pebbleTargetFileSize = 2 * 1024 * 1024
+
+type pebbleStore struct {
+	db *pebble.DB
+}
+
+// pebbleOptions maps the leveldb tuning options to their pebble equivalents.
+func pebbleOptions(opts config.LevelDBOptions) *pebble.Options {
+	o := &pebble.Options{}
+	if opts.WriteBuffer > 0 {
+		o.MemTableSize = uint64(opts.WriteBuffer)
+	}
+	if opts.BlockCacheCapacity > 0 {
+		o.Cache = pebble.NewCache(int64(opts.BlockCacheCapacity))
+	}
+
+	level := pebble.LevelOptions{}
+	if opts.FilterBitsPerKey > 0 {
+		level.FilterPolicy = bloom.FilterPolicy(opts.FilterBitsPerKey)
+	}
+	if opts.CompactionTableSizeMultiplier > 0 {
+		level.TargetFileSize = int64(pebbleTargetFileSize * opts.CompactionTableSizeMultiplier)
+	}
+	o.Levels = []pebble.LevelOptions{level}
+
+	return o.EnsureDefaults()
+}
+
+func newPebbleStore(path string, opts config.LevelDBOptions) (*pebbleStore, error) {
+	o := pebbleOptions(opts)
+	if o.Cache != nil {
+		// the db holds its own reference to the cache
+		defer o.Cache.Unref()
+	}
+
+	// if path is empty, use in memory db
+	if path == "" {
+		o.FS = vfs.NewMem()
+	}
+
+	// open the database (or create)
+	db, err := pebble.Open(path, o)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open pebble db %s: %w", path, err)
+	}
+	return &pebbleStore{db: db}, nil
+}
+
+func (s *pebbleStore) Get(key []byte) ([]byte, error) {
+	value, closer, err := s.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	// the returned value is only valid until the closer is closed
+	buf := make([]byte, len(value))
+	copy(buf, value)
+	return buf, nil
+}
+
+func (s *pebbleStore) Has(key []byte) (bool, error) {
+	_, closer, err := s.db.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, closer.Close()
+}
+
+// Writes are not synced to match the leveldb backend, which is used without
+// WriteOptions.Sync. The write ahead log is still written before returning.
+
+func (s *pebbleStore) Put(key, value []byte) error {
+	return s.db.Set(key, value, pebble.NoSync)
+}
+
+func (s *pebbleStore) Delete(key []byte) error {
+	return s.db.Delete(key, pebble.NoSync)
+}
+
+func (s *pebbleStore) Write(batch *Batch) error {
+	b := s.db.NewBatch()
+	defer b.Close()
+	for _, op := range batch.ops {
+		var err error
+		if op.delete {
+			err = b.Delete(op.key, nil)
+		} else {
+			err = b.Set(op.key, op.value, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return b.Commit(pebble.NoSync)
+}
+
+func (s *pebbleStore) NewIterator(prefix []byte) Iterator {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: prefixLimit(prefix),
+	})
+	return &pebbleIterator{iter: iter, err: err}
+}
+
+func (s *pebbleStore) Compact() error {
+	iter, err := s.db.NewIter(nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	// nothing to compact in an empty db
+	if !iter.First() {
+		return iter.Error()
+	}
+	start := append([]byte{}, iter.Key()...)
+	iter.Last()
+	// the end key is exclusive
+	end := append(append([]byte{}, iter.Key()...), 0)
+
+	return s.db.Compact(start, end, true)
+}
+
+func (s *pebbleStore) Close() error {
+	return s.db.Close()
+}
+
+// pebbleIterator adapts the pebble iterator to the leveldb style Next first iteration.
+type pebbleIterator struct {
+	iter    *pebble.Iterator
+	err     error
+	started bool
+}
+
+func (it *pebbleIterator) Next() bool {
+	if it.iter == nil {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		return it.iter.First()
+	}
+	return it.iter.Next()
+}
+
+func (it *pebbleIterator) Key() []byte {
+	return it.iter.Key()
+}
+
+func (it *pebbleIterator) Value() []byte {
+	return it.iter.Value()
+}
+
+func (it *pebbleIterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.iter.Error()
+}
+
+func (it *pebbleIterator) Release() {
+	if it.iter != nil {
+		_ = it.iter.Close()
+	}
+}
@@ -475,6 +475,10 @@ func (m *mockChainClient) GetBlockScannerHeight() (int64, error) {
 	return 0, nil
 }
 
+func (m *mockChainClient) GetBlocksForRetry() ([]int64, error) {
+	return nil, nil
+}
+
 func (m *mockChainClient) RollbackBlockScanner() error {
 	return nil
 }
@@ -164,6 +164,23 @@ func (o *Observer) Start(ctx context.Context) error {
 	return nil
 }
 
+// GetOnDeck returns a copy of the txs currently on deck, waiting to be sent to thorchain.
+func (o *Observer) GetOnDeck() []types.TxIn {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	txIns := make([]types.TxIn, 0, len(o.onDeck))
+	for _, deck := range o.onDeck {
+		txIn := *deck
+		txIn.TxArray = make([]*types.TxInItem, 0, len(deck.TxArray))
+		for _, item := range deck.TxArray {
+			itemCopy := *item
+			txIn.TxArray = append(txIn.TxArray, &itemCopy)
+		}
+		txIns = append(txIns, txIn)
+	}
+	return txIns
+}
+
 // ObserveSigned is called when a tx is signed by the signer and returns an observation that should be immediately submitted.
 // Observations passed to this method with 'allowFutureObservation' false will be cached in memory and skipped if they are later observed in the mempool or block.
 func (o *Observer) ObserveSigned(txIn types.TxIn) {
@@ -740,6 +757,7 @@ func (o *Observer) getThorchainTxIns(txIn *types.TxIn, finalized bool, finaliseH
 		obsTx.Aggregator = item.Aggregator
 		obsTx.AggregatorTarget = item.AggregatorTarget
 		obsTx.AggregatorTargetLimit = item.AggregatorTargetLimit
+		obsTx.Outputs = item.Outputs
 		obsTxs = append(obsTxs, obsTx)
 	}
 	return obsTxs, nil
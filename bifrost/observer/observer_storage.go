@@ -5,9 +5,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
-
 	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/v3/config"
@@ -15,7 +12,7 @@ import (
 
 // ObserverStorage save the ondeck tx in item to key value store, in case bifrost restart
 type ObserverStorage struct {
-	db *leveldb.DB
+	db db.Store
 }
 
 const (
@@ -26,7 +23,7 @@ const (
 
 // NewObserverStorage create a new instance of LevelDBScannerStorage
 func NewObserverStorage(path string, opts config.LevelDBOptions) (*ObserverStorage, error) {
-	ldb, err := db.NewLevelDB(path, opts)
+	ldb, err := db.NewStore(path, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create observer storage: %w", err)
 	}
@@ -47,13 +44,13 @@ func (s *ObserverStorage) createTxKey(txIn *types.TxIn, finalizeHeight int64) st
 }
 
 func (s *ObserverStorage) MigrateLegacy() ([]*types.TxIn, error) {
-	_, err := s.db.Get([]byte(LegacyMigrationHandledKey), nil)
-	if !errors.Is(err, leveldb.ErrNotFound) {
+	_, err := s.db.Get([]byte(LegacyMigrationHandledKey))
+	if !errors.Is(err, db.ErrNotFound) {
 		return nil, nil
 	}
-	buf, err := s.db.Get([]byte(LegacyOnDeckKey), nil)
+	buf, err := s.db.Get([]byte(LegacyOnDeckKey))
 	if err != nil {
-		if errors.Is(err, leveldb.ErrNotFound) {
+		if errors.Is(err, db.ErrNotFound) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("fail to get ondeck tx from key value store: %w", err)
@@ -70,16 +67,16 @@ func (s *ObserverStorage) MigrateLegacy() ([]*types.TxIn, error) {
 		if err != nil {
 			return nil, fmt.Errorf("fail to marshal ondeck tx to json: %w", err)
 		}
-		if err := s.db.Put([]byte(key), data, nil); err != nil {
+		if err := s.db.Put([]byte(key), data); err != nil {
 			return nil, fmt.Errorf("fail to put ondeck tx to key value store: %w", err)
 		}
 	}
 
-	if err := s.db.Delete([]byte(LegacyOnDeckKey), nil); err != nil {
+	if err := s.db.Delete([]byte(LegacyOnDeckKey)); err != nil {
 		return nil, fmt.Errorf("fail to delete legacy ondeck tx from key value store: %w", err)
 	}
 
-	if err := s.db.Put([]byte(LegacyMigrationHandledKey), []byte("true"), nil); err != nil {
+	if err := s.db.Put([]byte(LegacyMigrationHandledKey), []byte("true")); err != nil {
 		return nil, fmt.Errorf("fail to put migration handled flag to key value store: %w", err)
 	}
 
@@ -100,7 +97,7 @@ func (s *ObserverStorage) GetOnDeckTxs() ([]*types.TxIn, error) {
 	}
 
 	// Use a DB iterator to get all keys with the prefix
-	iter := s.db.NewIterator(util.BytesPrefix([]byte(OnDeckTxKeyPrefix)), nil)
+	iter := s.db.NewIterator([]byte(OnDeckTxKeyPrefix))
 	defer iter.Release()
 
 	var result []*types.TxIn
@@ -126,22 +123,22 @@ func (s *ObserverStorage) AddOrUpdateTx(txIn *types.TxIn) error {
 	if err != nil {
 		return fmt.Errorf("fail to marshal ondeck tx to json: %w", err)
 	}
-	return s.db.Put([]byte(key), data, nil)
+	return s.db.Put([]byte(key), data)
 }
 
 // RemoveTx removes a single TxIn from storage
 func (s *ObserverStorage) RemoveTx(txIn *types.TxIn, finalizeHeight int64) error {
 	key := s.createTxKey(txIn, finalizeHeight)
-	return s.db.Delete([]byte(key), nil)
+	return s.db.Delete([]byte(key))
 }
 
 // RemoveAllTxs removes all TxIn from storage
 func (s *ObserverStorage) RemoveAllTxs() error {
-	iter := s.db.NewIterator(util.BytesPrefix([]byte(OnDeckTxKeyPrefix)), nil)
+	iter := s.db.NewIterator([]byte(OnDeckTxKeyPrefix))
 	defer iter.Release()
 
 	for iter.Next() {
-		if err := s.db.Delete(iter.Key(), nil); err != nil {
+		if err := s.db.Delete(iter.Key()); err != nil {
 			return fmt.Errorf("fail to delete ondeck tx from key value store: %w", err)
 		}
 	}
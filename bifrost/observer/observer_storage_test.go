@@ -9,7 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/syndtr/goleveldb/leveldb"
+
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/config"
@@ -145,7 +146,7 @@ func TestGetOnDeckTxs(t *testing.T) {
 		data, err := json.Marshal(legacyTxs)
 		require.NoError(t, err)
 
-		err = storage.db.Put([]byte(LegacyOnDeckKey), data, nil)
+		err = storage.db.Put([]byte(LegacyOnDeckKey), data)
 		require.NoError(t, err)
 
 		txs, err := storage.GetOnDeckTxs()
@@ -157,7 +158,7 @@ func TestGetOnDeckTxs(t *testing.T) {
 		// Verify legacy transactions were migrated
 		for _, tx := range legacyTxs {
 			key := storage.createTxKey(tx, 0)
-			data, err = storage.db.Get([]byte(key), nil)
+			data, err = storage.db.Get([]byte(key))
 			assert.NoError(t, err)
 
 			var storedTx types.TxIn
@@ -168,7 +169,7 @@ func TestGetOnDeckTxs(t *testing.T) {
 		}
 
 		// Verify migration flag was set
-		val, err := storage.db.Get([]byte(LegacyMigrationHandledKey), nil)
+		val, err := storage.db.Get([]byte(LegacyMigrationHandledKey))
 		assert.NoError(t, err)
 		assert.Equal(t, "true", string(val))
 	})
@@ -187,7 +188,7 @@ func TestGetOnDeckTxs(t *testing.T) {
 		require.NoError(t, err)
 
 		// Make GetOnDeckTxs skip legacy check by setting migration flag
-		err = storage.db.Put([]byte(LegacyMigrationHandledKey), []byte("true"), nil)
+		err = storage.db.Put([]byte(LegacyMigrationHandledKey), []byte("true"))
 		require.NoError(t, err)
 
 		txs, err := storage.GetOnDeckTxs()
@@ -215,11 +216,11 @@ func TestGetOnDeckTxs(t *testing.T) {
 
 		// Add invalid JSON data
 		key := "txs:BTC:100"
-		err := storage.db.Put([]byte(key), []byte("invalid json"), nil)
+		err := storage.db.Put([]byte(key), []byte("invalid json"))
 		require.NoError(t, err)
 
 		// Make GetOnDeckTxs skip legacy check
-		err = storage.db.Put([]byte(LegacyMigrationHandledKey), []byte("true"), nil)
+		err = storage.db.Put([]byte(LegacyMigrationHandledKey), []byte("true"))
 		require.NoError(t, err)
 
 		txs, err := storage.GetOnDeckTxs()
@@ -240,7 +241,7 @@ func TestAddOrUpdateTx(t *testing.T) {
 
 		// Verify it was stored
 		key := storage.createTxKey(tx, 0)
-		data, err := storage.db.Get([]byte(key), nil)
+		data, err := storage.db.Get([]byte(key))
 		assert.NoError(t, err)
 
 		var storedTx types.TxIn
@@ -267,7 +268,7 @@ func TestAddOrUpdateTx(t *testing.T) {
 
 		// Verify it was updated
 		key := storage.createTxKey(tx, 0)
-		data, err := storage.db.Get([]byte(key), nil)
+		data, err := storage.db.Get([]byte(key))
 		assert.NoError(t, err)
 
 		var storedTx types.TxIn
@@ -293,8 +294,8 @@ func TestRemoveTx(t *testing.T) {
 
 		// Verify it's gone
 		key := storage.createTxKey(tx, 0)
-		_, err = storage.db.Get([]byte(key), nil)
-		assert.True(t, errors.Is(err, leveldb.ErrNotFound))
+		_, err = storage.db.Get([]byte(key))
+		assert.True(t, errors.Is(err, db.ErrNotFound))
 	})
 
 	t.Run("remove non-existent transaction", func(t *testing.T) {
@@ -186,6 +186,17 @@ func (pc *PartyCoordinator) removePeerGroup(messageID string) {
 	delete(pc.peersGroup, messageID)
 }
 
+// GetPartyStatus returns the peer status of every party currently being joined.
+func (pc *PartyCoordinator) GetPartyStatus() []PartyStatus {
+	pc.joinPartyGroupLock.Lock()
+	defer pc.joinPartyGroupLock.Unlock()
+	statuses := make([]PartyStatus, 0, len(pc.peersGroup))
+	for msgID, ps := range pc.peersGroup {
+		statuses = append(statuses, ps.getPartyStatus(msgID))
+	}
+	return statuses
+}
+
 func (pc *PartyCoordinator) createJoinPartyGroups(messageID string, leaderID peer.ID, peerIDs []peer.ID, threshold int) (*peerStatus, error) {
 	pc.joinPartyGroupLock.Lock()
 	defer pc.joinPartyGroupLock.Unlock()
@@ -10,6 +10,14 @@ import (
 	"gitlab.com/thorchain/thornode/v3/bifrost/p2p/messages"
 )
 
+// PartyStatus is the join party progress of an in-flight TSS ceremony.
+type PartyStatus struct {
+	MsgID   string   `json:"msg_id"`
+	Leader  string   `json:"leader"`
+	Online  []string `json:"online"`
+	Offline []string `json:"offline"`
+}
+
 type peerStatus struct {
 	peersResponse  map[peer.ID]bool
 	peerStatusLock *sync.RWMutex
@@ -116,3 +124,23 @@ func (ps *peerStatus) updatePeer(peerNode peer.ID) (bool, error) {
 	}
 	return false, nil
 }
+
+func (ps *peerStatus) getPartyStatus(msgID string) PartyStatus {
+	online, offline := ps.getPeersStatus()
+	status := PartyStatus{
+		MsgID:   msgID,
+		Online:  make([]string, 0, len(online)),
+		Offline: make([]string, 0, len(offline)),
+	}
+	// parties joined without a leader use the NONE placeholder
+	if leader := ps.getLeader(); leader != "NONE" {
+		status.Leader = leader.String()
+	}
+	for _, p := range online {
+		status.Online = append(status.Online, p.String())
+	}
+	for _, p := range offline {
+		status.Offline = append(status.Offline, p.String())
+	}
+	return status
+}
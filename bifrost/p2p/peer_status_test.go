@@ -67,3 +67,21 @@ func (s *PeerStatusTestSuite) TestPeerStatus(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(ret, Equals, false)
 }
+
+func (s *PeerStatusTestSuite) TestPartyStatus(c *C) {
+	peers := generateRandomPeers(c, 3)
+	peerStatus := newPeerStatus(peers, peers[0], peers[0], 2)
+	_, err := peerStatus.updatePeer(peers[1])
+	c.Assert(err, IsNil)
+
+	status := peerStatus.getPartyStatus("msg")
+	c.Assert(status.MsgID, Equals, "msg")
+	c.Assert(status.Leader, Equals, peers[0].String())
+	c.Assert(status.Online, DeepEquals, []string{peers[1].String()})
+	c.Assert(status.Offline, DeepEquals, []string{peers[2].String()})
+
+	status = newPeerStatus(peers, peers[0], "NONE", 2).getPartyStatus("msg")
+	c.Assert(status.Leader, Equals, "")
+	c.Assert(status.Online, HasLen, 0)
+	c.Assert(status.Offline, HasLen, 2)
+}
@@ -253,6 +253,11 @@ func (c *Client) GetBlockScannerHeight() (int64, error) {
 	return c.blockScanner.PreviousHeight(), nil
 }
 
+// GetBlocksForRetry returns the heights of the blocks awaiting a retry by the block scanner
+func (c *Client) GetBlocksForRetry() ([]int64, error) {
+	return c.blockScanner.GetBlocksForRetry()
+}
+
 // RollbackBlockScanner rolls back the block scanner to the last observed block
 func (c *Client) RollbackBlockScanner() error {
 	return c.blockScanner.RollbackToLastObserved()
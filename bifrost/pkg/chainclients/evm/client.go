@@ -26,6 +26,7 @@ import (
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/runners"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/signercache"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pubkeymanager"
@@ -52,6 +53,7 @@ type EVMClient struct {
 	localPubKey             common.PubKey
 	kw                      *evm.KeySignWrapper
 	ethClient               *ethclient.Client
+	rpcPool                 *rpcpool.Pool
 	evmScanner              *EVMScanner
 	bridge                  thorclient.ThorchainBridge
 	blockScanner            *blockscanner.BlockScanner
@@ -115,51 +117,39 @@ func NewEVMClient(
 	clog := log.With().Str("module", "evm").Stringer("chain", cfg.ChainID).Logger()
 
 	// create rpc client based on what authentication config is set
-	var ethClient *ethclient.Client
+	var opts []rpc.ClientOption
 	switch {
 	case cfg.AuthorizationBearer != "":
-
 		clog.Info().Msg("initializing evm client with bearer token")
-		authFn := func(h http.Header) error {
+		opts = append(opts, rpc.WithHTTPAuth(func(h http.Header) error {
 			h.Set("Authorization", fmt.Sprintf("Bearer %s", cfg.AuthorizationBearer))
 			return nil
-		}
-		var rpcClient *rpc.Client
-		rpcClient, err = rpc.DialOptions(
-			context.Background(),
-			cfg.RPCHost,
-			rpc.WithHTTPAuth(authFn),
-		)
-		if err != nil {
-			return nil, err
-		}
-		ethClient = ethclient.NewClient(rpcClient)
+		}))
 
 	case cfg.UserName != "" && cfg.Password != "":
 		clog.Info().Msg("initializing evm client with http basic auth")
-
-		authFn := func(h http.Header) error {
+		opts = append(opts, rpc.WithHTTPAuth(func(h http.Header) error {
 			auth := base64.StdEncoding.EncodeToString([]byte(cfg.UserName + ":" + cfg.Password))
 			h.Set("Authorization", fmt.Sprintf("Basic %s", auth))
 			return nil
-		}
-		var rpcClient *rpc.Client
-		rpcClient, err = rpc.DialOptions(
-			context.Background(),
-			cfg.RPCHost,
-			rpc.WithHTTPAuth(authFn),
-		)
-		if err != nil {
-			return nil, err
-		}
-		ethClient = ethclient.NewClient(rpcClient)
+		}))
+	}
 
-	default:
-		ethClient, err = ethclient.Dial(cfg.RPCHost)
+	// route requests through the rpc pool if additional endpoints are configured
+	var rpcPool *rpcpool.Pool
+	if cfg.RPCPool.Enabled() {
+		rpcPool, err = newRPCPool(cfg, opts)
 		if err != nil {
-			return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", cfg.RPCHost, err)
+			return nil, fmt.Errorf("fail to create rpc pool: %w", err)
 		}
+		opts = append(opts, rpc.WithHTTPClient(rpcPool.HTTPClient(cfg.BlockScanner.HTTPRequestTimeout)))
+	}
+
+	ethRPCClient, err := rpc.DialOptions(context.Background(), cfg.RPCHost, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to dial ETH rpc host(%s): %w", cfg.RPCHost, err)
 	}
+	ethClient := ethclient.NewClient(ethRPCClient)
 
 	rpcClient, err := evm.NewEthRPC(
 		ethClient,
@@ -195,6 +185,7 @@ func NewEVMClient(
 		logger:       clog,
 		cfg:          cfg,
 		ethClient:    ethClient,
+		rpcPool:      rpcPool,
 		localPubKey:  pk,
 		kw:           keysignWrapper,
 		bridge:       bridge,
@@ -237,6 +228,7 @@ func NewEVMClient(
 	if err != nil {
 		return c, fmt.Errorf("fail to create evm block scanner: %w", err)
 	}
+	c.evmScanner.rpcPool = rpcPool
 
 	// initialize block scanner
 	c.blockScanner, err = blockscanner.NewBlockScanner(
@@ -269,6 +261,9 @@ func (c *EVMClient) Start(
 	c.evmScanner.globalErrataQueue = globalErrataQueue
 	c.evmScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
 	c.globalSolvencyQueue = globalSolvencyQueue
+	if c.rpcPool != nil {
+		c.rpcPool.Start()
+	}
 	c.tssKeySigner.Start()
 	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
@@ -283,6 +278,9 @@ func (c *EVMClient) Stop() {
 	c.blockScanner.Stop()
 	close(c.stopchan)
 	c.wg.Wait()
+	if c.rpcPool != nil {
+		c.rpcPool.Stop()
+	}
 }
 
 // IsBlockScannerHealthy returns true if the block scanner is healthy.
@@ -314,6 +312,11 @@ func (c *EVMClient) GetBlockScannerHeight() (int64, error) {
 	return c.blockScanner.PreviousHeight(), nil
 }
 
+// GetBlocksForRetry returns the heights of the blocks awaiting a retry by the block scanner
+func (c *EVMClient) GetBlocksForRetry() ([]int64, error) {
+	return c.blockScanner.GetBlocksForRetry()
+}
+
 // RollbackBlockScanner rolls back the block scanner to the last observed block
 func (c *EVMClient) RollbackBlockScanner() error {
 	return c.blockScanner.RollbackToLastObserved()
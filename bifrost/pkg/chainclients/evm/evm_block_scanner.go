@@ -26,6 +26,7 @@ import (
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm/types"
 	evmtypes "gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm/types"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/signercache"
 	. "gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/types"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pubkeymanager"
@@ -55,6 +56,7 @@ type EVMScanner struct {
 	lastReportedGasPrice  uint64
 	ethClient             *ethclient.Client
 	ethRpc                *evm.EthRPC
+	rpcPool               *rpcpool.Pool
 	blockMetaAccessor     evm.BlockMetaAccessor
 	globalErrataQueue     chan<- stypes.ErrataBlock
 	globalNetworkFeeQueue chan<- common.NetworkFee
@@ -244,6 +246,9 @@ func (e *EVMScanner) FetchTxs(height, chainHeight int64) (stypes.TxIn, error) {
 	if err != nil {
 		return stypes.TxIn{}, err
 	}
+	if err = e.rpcPool.VerifyBlockHash(height, block.Hash().Hex()); err != nil {
+		return stypes.TxIn{}, err
+	}
 	txIn, err := e.processBlock(block)
 	if err != nil {
 		e.logger.Error().Err(err).Int64("height", height).Msg("failed to search tx in block")
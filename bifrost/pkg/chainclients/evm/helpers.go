@@ -10,7 +10,10 @@ import (
 	ecore "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/txpool"
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/config"
 )
 
 func isAcceptableError(err error) bool {
@@ -28,6 +31,32 @@ func getChainID(client *ethclient.Client, timeout time.Duration) (*big.Int, erro
 	return chainID, err
 }
 
+// newRPCPool creates the rpc pool for the chain, probing each endpoint with a client
+// using the provided authentication options.
+func newRPCPool(cfg config.BifrostChainConfiguration, opts []rpc.ClientOption) (*rpcpool.Pool, error) {
+	prober := rpcpool.NewClientProber(
+		func(host string) (*ethclient.Client, error) {
+			client, err := rpc.DialOptions(context.Background(), host, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return ethclient.NewClient(client), nil
+		},
+		func(ctx context.Context, client *ethclient.Client) (int64, error) {
+			height, err := client.BlockNumber(ctx)
+			return int64(height), err
+		},
+		func(ctx context.Context, client *ethclient.Client, height int64) (string, error) {
+			header, err := client.HeaderByNumber(ctx, big.NewInt(height))
+			if err != nil {
+				return "", err
+			}
+			return header.Hash().Hex(), nil
+		},
+	)
+	return rpcpool.NewPool(cfg.ChainID, cfg.RPCHost, cfg.RPCPool, prober)
+}
+
 func convertThorchainAmountToWei(amt *big.Int) *big.Int {
 	return big.NewInt(0).Mul(amt, big.NewInt(common.One*100))
 }
@@ -44,6 +44,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/v3/common"
@@ -87,6 +88,7 @@ type CosmosBlockScanner struct {
 	cdc                   *codec.ProtoCodec
 	txConfig              client.TxConfig
 	rpc                   TendermintRPC
+	rpcPool               *rpcpool.Pool
 	bridge                thorclient.ThorchainBridge
 	solvencyReporter      SolvencyReporter
 	globalNetworkFeeQueue chan common.NetworkFee
@@ -102,6 +104,7 @@ type CosmosBlockScanner struct {
 // NewCosmosBlockScanner create a new instance of BlockScan
 func NewCosmosBlockScanner(
 	rpcHost string,
+	rpcPool *rpcpool.Pool,
 	cfg config.BifrostBlockScannerConfiguration,
 	scanStorage blockscanner.ScannerStorage,
 	bridge thorclient.ThorchainBridge,
@@ -141,7 +144,13 @@ func NewCosmosBlockScanner(
 
 	// Registry for encoding txs
 	txConfig := tx.NewTxConfig(cdc, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT})
-	rpcClient, err := rpcclienthttp.New(rpcHost, "/websocket")
+	var rpcClient *rpcclienthttp.HTTP
+	var err error
+	if rpcPool != nil {
+		rpcClient, err = rpcclienthttp.NewWithClient(rpcHost, "/websocket", rpcPool.HTTPClient(cfg.HTTPRequestTimeout))
+	} else {
+		rpcClient, err = rpcclienthttp.New(rpcHost, "/websocket")
+	}
 	if err != nil {
 		logger.Fatal().Err(err).Msg("fail to create tendemrint rpcclient")
 	}
@@ -153,6 +162,7 @@ func NewCosmosBlockScanner(
 		cdc:              cdc,
 		txConfig:         txConfig,
 		rpc:              rpcClient,
+		rpcPool:          rpcPool,
 		feeCache:         make([]sdkmath.Uint, 0),
 		lastFee:          sdkmath.NewUint(0),
 		bridge:           bridge,
@@ -160,6 +170,31 @@ func NewCosmosBlockScanner(
 	}, nil
 }
 
+// newRPCPool creates the rpc pool for the chain, probing each endpoint with a
+// tendermint rpc client.
+func newRPCPool(cfg config.BifrostChainConfiguration) (*rpcpool.Pool, error) {
+	prober := rpcpool.NewClientProber(
+		func(host string) (*rpcclienthttp.HTTP, error) {
+			return rpcclienthttp.New(host, "/websocket")
+		},
+		func(ctx context.Context, client *rpcclienthttp.HTTP) (int64, error) {
+			resultBlock, err := client.Block(ctx, nil)
+			if err != nil {
+				return 0, err
+			}
+			return resultBlock.Block.Height, nil
+		},
+		func(ctx context.Context, client *rpcclienthttp.HTTP, height int64) (string, error) {
+			resultBlock, err := client.Block(ctx, &height)
+			if err != nil {
+				return "", err
+			}
+			return resultBlock.Block.Hash().String(), nil
+		},
+	)
+	return rpcpool.NewPool(cfg.ChainID, cfg.RPCHost, cfg.RPCPool, prober)
+}
+
 // GetHeight returns the height from the latest block minus 1
 // NOTE: we must lag by one block due to a race condition fetching the block results
 // Since the GetLatestBlockRequests tells what transactions will be in the block at T+1
@@ -517,6 +552,9 @@ func (c *CosmosBlockScanner) FetchTxs(height, chainHeight int64) (types.TxIn, er
 	if err != nil {
 		return types.TxIn{}, err
 	}
+	if err = c.rpcPool.VerifyBlockHash(height, block.Hash().String()); err != nil {
+		return types.TxIn{}, err
+	}
 
 	txs, err := c.processTxs(height, block.Data.Txs)
 	if err != nil {
@@ -30,6 +30,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/runners"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/signercache"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
@@ -66,6 +67,7 @@ type CosmosClient struct {
 	localKeyManager     *keyManager
 	thorchainBridge     thorclient.ThorchainBridge
 	storage             *blockscanner.BlockScannerStorage
+	rpcPool             *rpcpool.Pool
 	blockScanner        *blockscanner.BlockScanner
 	signerCacheManager  *signercache.CacheManager
 	cosmosScanner       *CosmosBlockScanner
@@ -147,8 +149,17 @@ func NewCosmosClient(
 		return nil, fmt.Errorf("fail to create scan storage: %w", err)
 	}
 
+	// route rpc requests through the rpc pool if additional endpoints are configured
+	if c.cfg.RPCPool.Enabled() {
+		c.rpcPool, err = newRPCPool(c.cfg)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create rpc pool: %w", err)
+		}
+	}
+
 	c.cosmosScanner, err = NewCosmosBlockScanner(
 		c.cfg.RPCHost,
+		c.rpcPool,
 		c.cfg.BlockScanner,
 		c.storage,
 		c.thorchainBridge,
@@ -182,6 +193,9 @@ func (c *CosmosClient) Start(
 ) {
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.cosmosScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
+	if c.rpcPool != nil {
+		c.rpcPool.Start()
+	}
 	c.tssKeyManager.Start()
 	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
@@ -194,6 +208,9 @@ func (c *CosmosClient) Stop() {
 	c.blockScanner.Stop()
 	close(c.stopchan)
 	c.wg.Wait()
+	if c.rpcPool != nil {
+		c.rpcPool.Stop()
+	}
 }
 
 // GetConfig return the configuration used by Cosmos chain client
@@ -218,6 +235,11 @@ func (c *CosmosClient) GetBlockScannerHeight() (int64, error) {
 	return c.blockScanner.PreviousHeight(), nil
 }
 
+// GetBlocksForRetry returns the heights of the blocks awaiting a retry by the block scanner
+func (c *CosmosClient) GetBlocksForRetry() ([]int64, error) {
+	return c.blockScanner.GetBlocksForRetry()
+}
+
 // RollbackBlockScanner rolls back the block scanner to the last observed block
 func (c *CosmosClient) RollbackBlockScanner() error {
 	return c.blockScanner.RollbackToLastObserved()
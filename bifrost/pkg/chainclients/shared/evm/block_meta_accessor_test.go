@@ -5,12 +5,12 @@ import (
 	"fmt"
 
 	etypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	evmtypes "gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm/types"
 	stypes "gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/v3/config"
 )
 
 // PrefixTxStorage declares prefix to use in leveldb to avoid conflicts
@@ -54,20 +54,18 @@ func CreateBlock(height int) (*etypes.Header, error) {
 }
 
 func (s *EthereumBlockMetaAccessorTestSuite) TestNewBlockMetaAccessor(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	dbBlockMetaAccessor, err := NewLevelDBBlockMetaAccessor(PrefixBlockMeta, PrefixSignedTxItem, db)
+	dbBlockMetaAccessor, err := NewLevelDBBlockMetaAccessor(PrefixBlockMeta, PrefixSignedTxItem, memDb)
 	c.Assert(err, IsNil)
 	c.Assert(dbBlockMetaAccessor, NotNil)
-	c.Assert(db.Close(), IsNil)
+	c.Assert(memDb.Close(), IsNil)
 }
 
 func (s *EthereumBlockMetaAccessorTestSuite) TestBlockMetaAccessor(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(PrefixBlockMeta, PrefixSignedTxItem, db)
+	blockMetaAccessor, err := NewLevelDBBlockMetaAccessor(PrefixBlockMeta, PrefixSignedTxItem, memDb)
 	c.Assert(err, IsNil)
 	c.Assert(blockMetaAccessor, NotNil)
 
@@ -100,5 +98,5 @@ func (s *EthereumBlockMetaAccessorTestSuite) TestBlockMetaAccessor(c *C) {
 	allBlockMetas, err := blockMetaAccessor.GetBlockMetas()
 	c.Assert(err, IsNil)
 	c.Assert(allBlockMetas, HasLen, 25)
-	c.Assert(db.Close(), IsNil)
+	c.Assert(memDb.Close(), IsNil)
 }
@@ -4,8 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 
 	evmtypes "gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm/types"
 )
@@ -15,13 +14,13 @@ type LevelDBBlockMetaAccessor struct {
 	PrefixBlockMeta    string
 	PrefixSignedTxItem string
 
-	db *leveldb.DB
+	db db.Store
 }
 
 // NewLevelDBBlockMetaAccessor creates a new level db backed BlockMeta accessor
-func NewLevelDBBlockMetaAccessor(prefixBlockMeta, prefixSignedTxItem string, db *leveldb.DB) (*LevelDBBlockMetaAccessor, error) {
+func NewLevelDBBlockMetaAccessor(prefixBlockMeta, prefixSignedTxItem string, store db.Store) (*LevelDBBlockMetaAccessor, error) {
 	return &LevelDBBlockMetaAccessor{
-		db:                 db,
+		db:                 store,
 		PrefixBlockMeta:    prefixBlockMeta,
 		PrefixSignedTxItem: prefixSignedTxItem,
 	}, nil
@@ -34,14 +33,14 @@ func (t *LevelDBBlockMetaAccessor) getBlockMetaKey(height int64) string {
 // GetBlockMeta at given block height ,  when the requested block meta doesn't exist , it will return nil , thus caller need to double check it
 func (t *LevelDBBlockMetaAccessor) GetBlockMeta(height int64) (*evmtypes.BlockMeta, error) {
 	key := t.getBlockMetaKey(height)
-	exist, err := t.db.Has([]byte(key), nil)
+	exist, err := t.db.Has([]byte(key))
 	if err != nil {
 		return nil, fmt.Errorf("fail to check whether block meta(%s) exist: %w", key, err)
 	}
 	if !exist {
 		return nil, nil
 	}
-	v, err := t.db.Get([]byte(key), nil)
+	v, err := t.db.Get([]byte(key))
 	if err != nil {
 		return nil, fmt.Errorf("fail to get block meta(%s) from storage: %w", key, err)
 	}
@@ -59,7 +58,7 @@ func (t *LevelDBBlockMetaAccessor) SaveBlockMeta(height int64, blockMeta *evmtyp
 	if err != nil {
 		return fmt.Errorf("fail to marshal block meta to json: %w", err)
 	}
-	return t.db.Put([]byte(key), buf, nil)
+	return t.db.Put([]byte(key), buf)
 }
 
 // GetBlockMetas returns all the block metas in storage
@@ -67,7 +66,7 @@ func (t *LevelDBBlockMetaAccessor) SaveBlockMeta(height int64, blockMeta *evmtyp
 // thus it should not grow out of control
 func (t *LevelDBBlockMetaAccessor) GetBlockMetas() ([]*evmtypes.BlockMeta, error) {
 	blockMetas := make([]*evmtypes.BlockMeta, 0)
-	iterator := t.db.NewIterator(util.BytesPrefix([]byte(t.PrefixBlockMeta)), nil)
+	iterator := t.db.NewIterator([]byte(t.PrefixBlockMeta))
 	defer iterator.Release()
 	for iterator.Next() {
 		buf := iterator.Value()
@@ -85,7 +84,7 @@ func (t *LevelDBBlockMetaAccessor) GetBlockMetas() ([]*evmtypes.BlockMeta, error
 
 // PruneBlockMeta removes all block meta that is older than the given block height.
 func (t *LevelDBBlockMetaAccessor) PruneBlockMeta(height int64) error {
-	iterator := t.db.NewIterator(util.BytesPrefix([]byte(t.PrefixBlockMeta)), nil)
+	iterator := t.db.NewIterator([]byte(t.PrefixBlockMeta))
 	defer iterator.Release()
 	targetToDelete := make([]string, 0)
 	for iterator.Next() {
@@ -103,7 +102,7 @@ func (t *LevelDBBlockMetaAccessor) PruneBlockMeta(height int64) error {
 	}
 
 	for _, key := range targetToDelete {
-		if err := t.db.Delete([]byte(key), nil); err != nil {
+		if err := t.db.Delete([]byte(key)); err != nil {
 			return fmt.Errorf("fail to delete block meta with key(%s) from storage: %w", key, err)
 		}
 	}
@@ -121,19 +120,19 @@ func (t *LevelDBBlockMetaAccessor) AddSignedTxItem(item evmtypes.SignedTxItem) e
 	if err != nil {
 		return fmt.Errorf("fail to marshal signed tx item to json: %w", err)
 	}
-	return t.db.Put([]byte(key), buf, nil)
+	return t.db.Put([]byte(key), buf)
 }
 
 // RemoveSignedTxItem remove a signed item from key value store
 func (t *LevelDBBlockMetaAccessor) RemoveSignedTxItem(hash string) error {
 	key := t.getSignedTxItemKey(hash)
-	return t.db.Delete([]byte(key), nil)
+	return t.db.Delete([]byte(key))
 }
 
 // GetSignedTxItems get all the signed tx items that in the key value store
 func (t *LevelDBBlockMetaAccessor) GetSignedTxItems() ([]evmtypes.SignedTxItem, error) {
 	txItems := make([]evmtypes.SignedTxItem, 0)
-	iterator := t.db.NewIterator(util.BytesPrefix([]byte(t.PrefixSignedTxItem)), nil)
+	iterator := t.db.NewIterator([]byte(t.PrefixSignedTxItem))
 	defer iterator.Release()
 	for iterator.Next() {
 		buf := iterator.Value()
@@ -14,7 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm/types"
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
@@ -40,7 +40,7 @@ type TokenManager struct {
 }
 
 // NewTokenManager returns an instance of TokenManager
-func NewTokenManager(db *leveldb.DB,
+func NewTokenManager(store db.Store,
 	prefixTokenMeta string,
 	nativeAsset common.Asset,
 	defaultDecimals uint64,
@@ -50,7 +50,7 @@ func NewTokenManager(db *leveldb.DB,
 	routerContractABI,
 	erc20ContractABI string,
 ) (*TokenManager, error) {
-	tokenDb, err := NewLevelDBTokenMeta(db, prefixTokenMeta)
+	tokenDb, err := NewLevelDBTokenMeta(store, prefixTokenMeta)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create tokenDb: %w", err)
 	}
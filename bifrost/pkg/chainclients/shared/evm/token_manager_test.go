@@ -11,10 +11,10 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/tokenlist"
+	"gitlab.com/thorchain/thornode/v3/config"
 	. "gopkg.in/check.v1"
 )
 
@@ -98,10 +98,9 @@ func (s *TokenManagerTestSuite) SetUpSuite(c *C) {
 }
 
 func (s *TokenManagerTestSuite) TestSaveAndGet(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
+	manager, err := NewTokenManager(memDb, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
 	c.Assert(err, IsNil)
 
 	// Test non-whitelisted token gets rejected
@@ -118,10 +117,9 @@ func (s *TokenManagerTestSuite) TestSaveAndGet(c *C) {
 }
 
 func (s *TokenManagerTestSuite) TestConvertAmounts(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
+	manager, err := NewTokenManager(memDb, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
 	c.Assert(err, IsNil)
 
 	err = manager.SaveTokenMeta("0xB0b86991c6218b36c1d19D4a2e9Eb0cE3606eB49", "TKN", 9)
@@ -132,10 +130,9 @@ func (s *TokenManagerTestSuite) TestConvertAmounts(c *C) {
 }
 
 func (s *TokenManagerTestSuite) TestGetDecimals(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	manager, err := NewTokenManager(db, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
+	manager, err := NewTokenManager(memDb, s.prefix, common.ETHAsset, 18, time.Second, testWhiteList, s.client, routerContractABI, erc20ContractABI)
 	c.Assert(err, IsNil)
 
 	err = manager.SaveTokenMeta("TKN", "0xB0b86991c6218b36c1d19D4a2e9Eb0cE3606eB49", 9)
@@ -5,22 +5,21 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/evm/types"
 )
 
 // LevelDBTokenMeta struct
 type LevelDBTokenMeta struct {
-	db              *leveldb.DB
+	db              db.Store
 	prefixTokenMeta string
 }
 
 // NewLevelDBTokenMeta creates a new level db backed TokenMeta
-func NewLevelDBTokenMeta(db *leveldb.DB, prefixTokenMeta string) (*LevelDBTokenMeta, error) {
+func NewLevelDBTokenMeta(store db.Store, prefixTokenMeta string) (*LevelDBTokenMeta, error) {
 	return &LevelDBTokenMeta{
-		db:              db,
+		db:              store,
 		prefixTokenMeta: prefixTokenMeta,
 	}, nil
 }
@@ -32,14 +31,14 @@ func (t *LevelDBTokenMeta) getTokenMetaKey(address string) string {
 // GetTokenMeta for given token address
 func (t *LevelDBTokenMeta) GetTokenMeta(address string) (types.TokenMeta, error) {
 	key := t.getTokenMetaKey(address)
-	exist, err := t.db.Has([]byte(key), nil)
+	exist, err := t.db.Has([]byte(key))
 	if err != nil {
 		return types.TokenMeta{}, fmt.Errorf("fail to check whether token meta(%s) exist: %w", key, err)
 	}
 	if !exist {
 		return types.TokenMeta{}, nil
 	}
-	v, err := t.db.Get([]byte(key), nil)
+	v, err := t.db.Get([]byte(key))
 	if err != nil {
 		return types.TokenMeta{}, fmt.Errorf("fail to get token meta(%s) from storage: %w", key, err)
 	}
@@ -58,13 +57,13 @@ func (t *LevelDBTokenMeta) SaveTokenMeta(symbol, address string, decimals uint64
 	if err != nil {
 		return fmt.Errorf("fail to marshal token meta to json: %w", err)
 	}
-	return t.db.Put([]byte(key), buf, nil)
+	return t.db.Put([]byte(key), buf)
 }
 
 // GetTokens returns all the token metas in storage
 func (t *LevelDBTokenMeta) GetTokens() ([]*types.TokenMeta, error) {
 	tokenMetas := make([]*types.TokenMeta, 0)
-	iterator := t.db.NewIterator(util.BytesPrefix([]byte(t.prefixTokenMeta)), nil)
+	iterator := t.db.NewIterator([]byte(t.prefixTokenMeta))
 	defer iterator.Release()
 	for iterator.Next() {
 		buf := iterator.Value()
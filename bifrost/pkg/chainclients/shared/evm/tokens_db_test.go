@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
+	"gitlab.com/thorchain/thornode/v3/config"
 	. "gopkg.in/check.v1"
 )
 
@@ -18,20 +18,18 @@ var _ = Suite(
 const prefix = "eth-tokenmeta-"
 
 func (s *EthereumTokenMetaTestSuite) TestNewTokenMeta(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	dbTokenMeta, err := NewLevelDBTokenMeta(db, prefix)
+	dbTokenMeta, err := NewLevelDBTokenMeta(memDb, prefix)
 	c.Assert(err, IsNil)
 	c.Assert(dbTokenMeta, NotNil)
-	c.Assert(db.Close(), IsNil)
+	c.Assert(memDb.Close(), IsNil)
 }
 
 func (s *EthereumTokenMetaTestSuite) TestTokenMeta(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	tokenMeta, err := NewLevelDBTokenMeta(db, prefix)
+	tokenMeta, err := NewLevelDBTokenMeta(memDb, prefix)
 	c.Assert(err, IsNil)
 	c.Assert(tokenMeta, NotNil)
 
@@ -59,5 +57,5 @@ func (s *EthereumTokenMetaTestSuite) TestTokenMeta(c *C) {
 	tokens, err = tokenMeta.GetTokens()
 	c.Assert(err, IsNil)
 	c.Assert(tokens, HasLen, 2)
-	c.Assert(db.Close(), IsNil)
+	c.Assert(memDb.Close(), IsNil)
 }
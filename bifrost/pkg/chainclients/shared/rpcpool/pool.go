@@ -0,0 +1,380 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+const (
+	// maxConsecutiveFailures is the number of consecutive failed requests after which an
+	// endpoint is considered unhealthy until the next successful health check.
+	maxConsecutiveFailures = 3
+
+	// latencyWeight is the weight of the latest sample in the latency moving average.
+	latencyWeight = 0.2
+
+	defaultHealthCheckInterval = 15 * time.Second
+	defaultTimeout             = 5 * time.Second
+)
+
+// ErrNoQuorum is returned when not enough endpoints agree on a block hash.
+var ErrNoQuorum = errors.New("no rpc quorum on block hash")
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Prober
+////////////////////////////////////////////////////////////////////////////////////////
+
+// Prober reads the chain tip and block hashes from a single endpoint. It is implemented
+// for each chain client and used to score endpoints and verify block hashes.
+type Prober interface {
+	// Height returns the latest block height reported by the endpoint.
+	Height(ctx context.Context, host string) (int64, error)
+
+	// BlockHash returns the hash of the block at the height reported by the endpoint.
+	BlockHash(ctx context.Context, host string, height int64) (string, error)
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Pool
+////////////////////////////////////////////////////////////////////////////////////////
+
+// EndpointStatus is a snapshot of the health of an endpoint in the pool.
+type EndpointStatus struct {
+	Host      string        `json:"host"`
+	Height    int64         `json:"height"`
+	Latency   time.Duration `json:"latency"`
+	Failures  int           `json:"failures"`
+	Healthy   bool          `json:"healthy"`
+	LastError string        `json:"last_error,omitempty"`
+}
+
+type endpoint struct {
+	host     string
+	url      *url.URL
+	height   int64
+	latency  time.Duration
+	failures int
+	lastErr  error
+}
+
+// Pool tracks the health of the endpoints of a chain daemon. Requests are routed to
+// the healthiest endpoint through the transport returned by HTTPClient, and block
+// hashes can be verified against a quorum of endpoints with VerifyBlockHash.
+type Pool struct {
+	chain  common.Chain
+	cfg    config.BifrostRPCPoolConfiguration
+	prober Prober
+	logger zerolog.Logger
+
+	lock      sync.RWMutex
+	endpoints []*endpoint
+	primary   *url.URL
+	active    string
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+// NewPool creates a pool of the primary host and the additional hosts in the config.
+func NewPool(chain common.Chain, primary string, cfg config.BifrostRPCPoolConfiguration, prober Prober) (*Pool, error) {
+	if prober == nil {
+		return nil, errors.New("prober is nil")
+	}
+	if cfg.HealthCheckInterval <= 0 {
+		cfg.HealthCheckInterval = defaultHealthCheckInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	p := &Pool{
+		chain:    chain,
+		cfg:      cfg,
+		prober:   prober,
+		logger:   log.Logger.With().Str("module", "rpcpool").Str("chain", chain.String()).Logger(),
+		stopChan: make(chan struct{}),
+	}
+
+	seen := map[string]bool{}
+	for _, host := range append([]string{primary}, cfg.Hosts...) {
+		host = strings.TrimSpace(host)
+		if host == "" || seen[host] {
+			continue
+		}
+		seen[host] = true
+
+		u, err := parseHost(host)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse rpc host(%s): %w", host, err)
+		}
+		p.endpoints = append(p.endpoints, &endpoint{host: host, url: u})
+	}
+	if len(p.endpoints) == 0 {
+		return nil, errors.New("no rpc hosts configured")
+	}
+	if cfg.Quorum > len(p.endpoints) {
+		return nil, fmt.Errorf("quorum %d exceeds %d endpoints", cfg.Quorum, len(p.endpoints))
+	}
+
+	p.primary = p.endpoints[0].url
+	p.active = p.endpoints[0].host
+	return p, nil
+}
+
+// parseHost parses the host into a url, defaulting to http if no scheme is specified.
+func parseHost(host string) (*url.URL, error) {
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http", "https":
+	case "tcp":
+		// tendermint rpc clients accept tcp for http
+		u.Scheme = "http"
+	default:
+		return nil, fmt.Errorf("unsupported scheme: %s", u.Scheme)
+	}
+	return u, nil
+}
+
+// Hosts returns the hosts of all endpoints, starting with the primary.
+func (p *Pool) Hosts() []string {
+	hosts := make([]string, 0, len(p.endpoints))
+	for _, ep := range p.endpoints {
+		hosts = append(hosts, ep.host)
+	}
+	return hosts
+}
+
+// Start runs the health checks in the background until Stop is called.
+func (p *Pool) Start() {
+	p.refresh()
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		ticker := time.NewTicker(p.cfg.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stopChan:
+				return
+			case <-ticker.C:
+				p.refresh()
+			}
+		}
+	}()
+}
+
+// Stop stops the health checks.
+func (p *Pool) Stop() {
+	close(p.stopChan)
+	p.wg.Wait()
+}
+
+// Status returns the health of all endpoints, ordered by preference.
+func (p *Pool) Status() []EndpointStatus {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	best := p.bestHeight()
+	status := make([]EndpointStatus, 0, len(p.endpoints))
+	for _, ep := range p.ranked() {
+		s := EndpointStatus{
+			Host:     ep.host,
+			Height:   ep.height,
+			Latency:  ep.latency,
+			Failures: ep.failures,
+			Healthy:  p.healthy(ep, best),
+		}
+		if ep.lastErr != nil {
+			s.LastError = ep.lastErr.Error()
+		}
+		status = append(status, s)
+	}
+	return status
+}
+
+// refresh queries the height of all endpoints and updates their health.
+func (p *Pool) refresh() {
+	var wg sync.WaitGroup
+	for _, ep := range p.endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), p.cfg.Timeout)
+			defer cancel()
+
+			start := time.Now()
+			height, err := p.prober.Height(ctx, ep.host)
+			if err != nil {
+				p.recordFailure(ep, err)
+				return
+			}
+
+			p.lock.Lock()
+			ep.height = height
+			ep.failures = 0
+			ep.lastErr = nil
+			p.updateLatency(ep, time.Since(start))
+			p.lock.Unlock()
+		}(ep)
+	}
+	wg.Wait()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.updateActive()
+}
+
+// recordSuccess records a successful request to the endpoint.
+func (p *Pool) recordSuccess(ep *endpoint, latency time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	ep.failures = 0
+	ep.lastErr = nil
+	p.updateLatency(ep, latency)
+}
+
+// recordFailure records a failed request to the endpoint.
+func (p *Pool) recordFailure(ep *endpoint, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	ep.failures++
+	ep.lastErr = err
+	p.logger.Debug().Err(err).Str("host", ep.host).Int("failures", ep.failures).Msg("rpc endpoint request failed")
+	p.updateActive()
+}
+
+// updateLatency must be called with the lock held.
+func (p *Pool) updateLatency(ep *endpoint, latency time.Duration) {
+	if ep.latency == 0 {
+		ep.latency = latency
+		return
+	}
+	ep.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(ep.latency))
+}
+
+// updateActive logs when the preferred endpoint changes, must be called with the lock
+// held.
+func (p *Pool) updateActive() {
+	best := p.ranked()[0]
+	if best.host == p.active {
+		return
+	}
+	p.logger.Warn().
+		Str("from", p.active).
+		Str("to", best.host).
+		Int64("height", best.height).
+		Msg("rpc endpoint failover")
+	p.active = best.host
+}
+
+// bestHeight must be called with the lock held.
+func (p *Pool) bestHeight() int64 {
+	var best int64
+	for _, ep := range p.endpoints {
+		if ep.height > best {
+			best = ep.height
+		}
+	}
+	return best
+}
+
+// healthy must be called with the lock held.
+func (p *Pool) healthy(ep *endpoint, bestHeight int64) bool {
+	if ep.failures >= maxConsecutiveFailures {
+		return false
+	}
+	if p.cfg.MaxHeightLag > 0 && bestHeight-ep.height > p.cfg.MaxHeightLag {
+		return false
+	}
+	return true
+}
+
+// ranked returns the endpoints ordered by preference: healthy endpoints first, then by
+// latency, falling back to the configured order. Must be called with the lock held.
+func (p *Pool) ranked() []*endpoint {
+	best := p.bestHeight()
+	ranked := make([]*endpoint, len(p.endpoints))
+	copy(ranked, p.endpoints)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		hi, hj := p.healthy(ranked[i], best), p.healthy(ranked[j], best)
+		if hi != hj {
+			return hi
+		}
+		if !hi {
+			return ranked[i].failures < ranked[j].failures
+		}
+		return ranked[i].latency < ranked[j].latency
+	})
+	return ranked
+}
+
+// VerifyBlockHash returns ErrNoQuorum if fewer than the configured quorum of endpoints
+// report the hash for the block at the height. It is a no-op on a nil pool or if no
+// quorum is configured.
+func (p *Pool) VerifyBlockHash(height int64, hash string) error {
+	if p == nil || p.cfg.Quorum <= 1 {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	agree := 0
+	for _, ep := range p.endpoints {
+		wg.Add(1)
+		go func(ep *endpoint) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), p.cfg.Timeout)
+			defer cancel()
+
+			epHash, err := p.prober.BlockHash(ctx, ep.host, height)
+			if err != nil {
+				p.logger.Debug().Err(err).Str("host", ep.host).Int64("height", height).Msg("fail to get block hash")
+				return
+			}
+			if !equalHash(epHash, hash) {
+				p.logger.Warn().
+					Str("host", ep.host).
+					Int64("height", height).
+					Str("hash", hash).
+					Str("endpoint_hash", epHash).
+					Msg("rpc endpoint block hash mismatch")
+				return
+			}
+			mu.Lock()
+			agree++
+			mu.Unlock()
+		}(ep)
+	}
+	wg.Wait()
+
+	if agree < p.cfg.Quorum {
+		return fmt.Errorf("%w: %d of %d endpoints agree on block %d (%s), need %d",
+			ErrNoQuorum, agree, len(p.endpoints), height, hash, p.cfg.Quorum)
+	}
+	return nil
+}
+
+func equalHash(a, b string) bool {
+	a = strings.TrimPrefix(strings.ToLower(a), "0x")
+	b = strings.TrimPrefix(strings.ToLower(b), "0x")
+	return a == b
+}
@@ -0,0 +1,214 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+func Test(t *testing.T) { TestingT(t) }
+
+type PoolTestSuite struct{}
+
+var _ = Suite(&PoolTestSuite{})
+
+// fakeProber returns the configured heights and hashes per host.
+type fakeProber struct {
+	heights map[string]int64
+	hashes  map[string]string
+}
+
+func (p *fakeProber) Height(_ context.Context, host string) (int64, error) {
+	height, ok := p.heights[host]
+	if !ok {
+		return 0, errors.New("unreachable")
+	}
+	return height, nil
+}
+
+func (p *fakeProber) BlockHash(_ context.Context, host string, _ int64) (string, error) {
+	hash, ok := p.hashes[host]
+	if !ok {
+		return "", errors.New("unreachable")
+	}
+	return hash, nil
+}
+
+func (s *PoolTestSuite) TestNewPool(c *C) {
+	prober := &fakeProber{}
+
+	_, err := NewPool(common.BTCChain, "localhost:8332", config.BifrostRPCPoolConfiguration{}, nil)
+	c.Assert(err, NotNil)
+
+	pool, err := NewPool(common.BTCChain, "localhost:8332", config.BifrostRPCPoolConfiguration{
+		Hosts: []string{"http://backup:8332", "localhost:8332", " "},
+	}, prober)
+	c.Assert(err, IsNil)
+	c.Assert(pool.Hosts(), DeepEquals, []string{"localhost:8332", "http://backup:8332"})
+	c.Assert(pool.primary.String(), Equals, "http://localhost:8332")
+
+	_, err = NewPool(common.BTCChain, "localhost:8332", config.BifrostRPCPoolConfiguration{
+		Hosts:  []string{"http://backup:8332"},
+		Quorum: 3,
+	}, prober)
+	c.Assert(err, NotNil)
+
+	_, err = NewPool(common.BTCChain, "ws://localhost:8546", config.BifrostRPCPoolConfiguration{}, prober)
+	c.Assert(err, NotNil)
+}
+
+func (s *PoolTestSuite) TestHealth(c *C) {
+	prober := &fakeProber{heights: map[string]int64{
+		"http://a": 100,
+		"http://b": 110,
+		"http://c": 108,
+	}}
+	pool, err := NewPool(common.ETHChain, "http://a", config.BifrostRPCPoolConfiguration{
+		Hosts:        []string{"http://b", "http://c", "http://d"},
+		MaxHeightLag: 3,
+	}, prober)
+	c.Assert(err, IsNil)
+	pool.refresh()
+
+	// the lagging primary and unreachable endpoint are ranked last
+	status := pool.Status()
+	c.Assert(status, HasLen, 4)
+	healthy := map[string]bool{}
+	for _, st := range status {
+		healthy[st.Host] = st.Healthy
+	}
+	c.Assert(healthy, DeepEquals, map[string]bool{
+		"http://a": false,
+		"http://b": true,
+		"http://c": true,
+		"http://d": false,
+	})
+	c.Assert(status[2].Host, Equals, "http://a")
+	c.Assert(status[3].Host, Equals, "http://d")
+
+	// repeated failures mark the endpoint unhealthy
+	pool.refresh()
+	pool.refresh()
+	for _, st := range pool.Status() {
+		if st.Host == "http://d" {
+			c.Assert(st.Healthy, Equals, false)
+			c.Assert(st.Failures, Equals, 3)
+			c.Assert(st.LastError, Equals, "unreachable")
+		}
+	}
+}
+
+func (s *PoolTestSuite) TestVerifyBlockHash(c *C) {
+	// nil pool and disabled quorum are no-ops
+	var pool *Pool
+	c.Assert(pool.VerifyBlockHash(1, "0xabc"), IsNil)
+
+	prober := &fakeProber{hashes: map[string]string{
+		"http://a": "0xABC",
+		"http://b": "abc",
+		"http://c": "0xdef",
+	}}
+	cfg := config.BifrostRPCPoolConfiguration{Hosts: []string{"http://b", "http://c"}}
+	pool, err := NewPool(common.ETHChain, "http://a", cfg, prober)
+	c.Assert(err, IsNil)
+	c.Assert(pool.VerifyBlockHash(1, "0xdef"), IsNil)
+
+	cfg.Quorum = 2
+	pool, err = NewPool(common.ETHChain, "http://a", cfg, prober)
+	c.Assert(err, IsNil)
+	c.Assert(pool.VerifyBlockHash(1, "0xabc"), IsNil)
+	err = pool.VerifyBlockHash(1, "0xdef")
+	c.Assert(errors.Is(err, ErrNoQuorum), Equals, true)
+
+	// unreachable endpoints do not count towards the quorum
+	delete(prober.hashes, "http://b")
+	err = pool.VerifyBlockHash(1, "0xabc")
+	c.Assert(errors.Is(err, ErrNoQuorum), Equals, true)
+}
+
+func (s *PoolTestSuite) TestFailover(c *C) {
+	var primaryCalls int
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+
+	var backupPath, backupQuery, backupBody string
+	backup := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		backupPath = r.URL.Path
+		backupQuery = r.URL.RawQuery
+		body, _ := io.ReadAll(r.Body)
+		backupBody = string(body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer backup.Close()
+
+	pool, err := NewPool(common.BTCChain, primary.URL+"/rpc?key=primary", config.BifrostRPCPoolConfiguration{
+		Hosts: []string{backup.URL + "/v1/?key=backup"},
+	}, &fakeProber{})
+	c.Assert(err, IsNil)
+	client := pool.HTTPClient(time.Second)
+
+	resp, err := client.Post(primary.URL+"/rpc/wallet?key=primary", "application/json", strings.NewReader(`{"id":1}`))
+	c.Assert(err, IsNil)
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	c.Assert(string(body), Equals, "ok")
+	c.Assert(primaryCalls, Equals, 1)
+	c.Assert(backupPath, Equals, "/v1/wallet")
+	c.Assert(backupQuery, Equals, "key=backup")
+	c.Assert(backupBody, Equals, `{"id":1}`)
+
+	// the primary is skipped once it is unhealthy
+	for i := 0; i < maxConsecutiveFailures; i++ {
+		resp, err = client.Post(primary.URL+"/rpc", "application/json", strings.NewReader(`{}`))
+		c.Assert(err, IsNil)
+		_ = resp.Body.Close()
+	}
+	c.Assert(primaryCalls, Equals, maxConsecutiveFailures)
+	c.Assert(pool.Status()[0].Host, Equals, backup.URL+"/v1/?key=backup")
+
+	// the response of the last endpoint is returned if all are unavailable
+	backup.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	resp, err = client.Get(primary.URL + "/rpc")
+	c.Assert(err, IsNil)
+	_ = resp.Body.Close()
+	c.Assert(resp.StatusCode, Equals, http.StatusServiceUnavailable)
+}
+
+func (s *PoolTestSuite) TestApplicationErrorsAreNotRetried(c *C) {
+	var backupCalls int
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// bitcoind returns rpc errors with an internal server error status
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer primary.Close()
+	backup := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		backupCalls++
+	}))
+	defer backup.Close()
+
+	pool, err := NewPool(common.BTCChain, primary.URL, config.BifrostRPCPoolConfiguration{
+		Hosts: []string{backup.URL},
+	}, &fakeProber{})
+	c.Assert(err, IsNil)
+
+	resp, err := pool.HTTPClient(time.Second).Get(primary.URL)
+	c.Assert(err, IsNil)
+	_ = resp.Body.Close()
+	c.Assert(resp.StatusCode, Equals, http.StatusInternalServerError)
+	c.Assert(backupCalls, Equals, 0)
+}
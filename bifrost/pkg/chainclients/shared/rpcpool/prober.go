@@ -0,0 +1,64 @@
+package rpcpool
+
+import (
+	"context"
+	"sync"
+)
+
+// ClientProber implements Prober for a chain client type, lazily creating and caching a
+// client for each endpoint.
+type ClientProber[C any] struct {
+	dial      func(host string) (C, error)
+	height    func(ctx context.Context, client C) (int64, error)
+	blockHash func(ctx context.Context, client C, height int64) (string, error)
+
+	lock    sync.Mutex
+	clients map[string]C
+}
+
+// NewClientProber returns a prober using the provided functions to create a client for
+// an endpoint and read the chain tip and block hashes with it.
+func NewClientProber[C any](
+	dial func(host string) (C, error),
+	height func(ctx context.Context, client C) (int64, error),
+	blockHash func(ctx context.Context, client C, height int64) (string, error),
+) *ClientProber[C] {
+	return &ClientProber[C]{
+		dial:      dial,
+		height:    height,
+		blockHash: blockHash,
+		clients:   make(map[string]C),
+	}
+}
+
+// Client returns the client for the endpoint, creating it on first use.
+func (p *ClientProber[C]) Client(host string) (C, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if client, ok := p.clients[host]; ok {
+		return client, nil
+	}
+	client, err := p.dial(host)
+	if err != nil {
+		return client, err
+	}
+	p.clients[host] = client
+	return client, nil
+}
+
+func (p *ClientProber[C]) Height(ctx context.Context, host string) (int64, error) {
+	client, err := p.Client(host)
+	if err != nil {
+		return 0, err
+	}
+	return p.height(ctx, client)
+}
+
+func (p *ClientProber[C]) BlockHash(ctx context.Context, host string, height int64) (string, error) {
+	client, err := p.Client(host)
+	if err != nil {
+		return "", err
+	}
+	return p.blockHash(ctx, client, height)
+}
@@ -0,0 +1,124 @@
+package rpcpool
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPClient returns an http client that routes requests for the primary host to the
+// healthiest endpoint, failing over to the next endpoint on connection errors and
+// unavailable responses. The clients of the chain daemon are created with the primary
+// host and this client.
+func (p *Pool) HTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &transport{pool: p, base: http.DefaultTransport},
+	}
+}
+
+type transport struct {
+	pool *Pool
+	base http.RoundTripper
+}
+
+// failover returns true if the response status indicates the endpoint is unavailable.
+// Other error statuses are returned to the caller, since some daemons (bitcoind) use
+// them for application errors.
+func failover(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// buffer the body so it can be replayed on failover
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.pool.lock.RLock()
+	endpoints := t.pool.ranked()
+	t.pool.lock.RUnlock()
+
+	var lastErr error
+	for i, ep := range endpoints {
+		r := req.Clone(req.Context())
+		r.URL = t.pool.rewrite(req.URL, ep.url)
+		r.Host = ""
+		if body != nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+		}
+		if ep.url.User != nil {
+			password, _ := ep.url.User.Password()
+			r.SetBasicAuth(ep.url.User.Username(), password)
+		}
+
+		start := time.Now()
+		resp, err := t.base.RoundTrip(r)
+		if err == nil && !failover(resp.StatusCode) {
+			t.pool.recordSuccess(ep, time.Since(start))
+			return resp, nil
+		}
+
+		// the caller gave up, do not penalize the endpoint
+		if req.Context().Err() != nil {
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return nil, req.Context().Err()
+		}
+
+		if err == nil {
+			// return the response of the last endpoint as is
+			if i == len(endpoints)-1 {
+				t.pool.recordFailure(ep, fmt.Errorf("unavailable: %s", resp.Status))
+				return resp, nil
+			}
+			err = fmt.Errorf("unavailable: %s", resp.Status)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		t.pool.recordFailure(ep, err)
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// rewrite returns the request url with the primary host replaced by the endpoint.
+func (p *Pool) rewrite(u, ep *url.URL) *url.URL {
+	rewritten := *u
+	rewritten.Scheme = ep.Scheme
+	rewritten.Host = ep.Host
+	rewritten.User = nil
+
+	path := strings.TrimPrefix(u.Path, strings.TrimSuffix(p.primary.Path, "/"))
+	rewritten.Path = strings.TrimSuffix(ep.Path, "/") + path
+	rewritten.RawPath = ""
+
+	// replace query parameters of the primary host (api keys) with the endpoint's
+	if p.primary.RawQuery != "" || ep.RawQuery != "" {
+		query := u.Query()
+		for key := range p.primary.Query() {
+			query.Del(key)
+		}
+		for key, values := range ep.Query() {
+			query[key] = values
+		}
+		rewritten.RawQuery = query.Encode()
+	}
+	return &rewritten
+}
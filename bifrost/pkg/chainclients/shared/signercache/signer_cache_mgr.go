@@ -5,7 +5,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 )
 
 // StorageAccessor define the necessary methods to access the key value store
@@ -25,11 +25,11 @@ type CacheManager struct {
 }
 
 // NewSignerCacheManager create a new instance of CacheManager
-func NewSignerCacheManager(db *leveldb.DB) (*CacheManager, error) {
-	if db == nil {
+func NewSignerCacheManager(store db.Store) (*CacheManager, error) {
+	if store == nil {
 		return nil, fmt.Errorf("db parameter is nil")
 	}
-	cacheStore := NewCacheStore(db)
+	cacheStore := NewCacheStore(store)
 	return &CacheManager{
 		logger:          log.With().Str("module", "SignerCacheManager").Logger(),
 		storageAccessor: cacheStore,
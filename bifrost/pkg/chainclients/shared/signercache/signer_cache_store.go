@@ -6,7 +6,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 )
 
 const (
@@ -18,13 +18,13 @@ const (
 // CacheStore manage the key value store used to store what tx out items have been signed before
 type CacheStore struct {
 	logger zerolog.Logger
-	db     *leveldb.DB
+	db     db.Store
 }
 
 // NewCacheStore create a new instance of CacheStore
-func NewCacheStore(db *leveldb.DB) *CacheStore {
+func NewCacheStore(store db.Store) *CacheStore {
 	return &CacheStore{
-		db:     db,
+		db:     store,
 		logger: log.With().Str("module", "signer-cache").Logger(),
 	}
 }
@@ -33,7 +33,7 @@ func NewCacheStore(db *leveldb.DB) *CacheStore {
 func (s *CacheStore) SetSigned(hash string) error {
 	key := s.getSignedKey(hash)
 	s.logger.Debug().Msgf("key:%s set to signed", key)
-	return s.db.Put([]byte(key), []byte{1}, nil)
+	return s.db.Put([]byte(key), []byte{1})
 }
 
 func (s *CacheStore) getSignedKey(hash string) string {
@@ -51,7 +51,7 @@ func (s *CacheStore) getVaultKey(vaultKey string) string {
 // HasSigned check whether the given height and hash has been signed before or not
 func (s *CacheStore) HasSigned(hash string) bool {
 	key := s.getSignedKey(hash)
-	exist, _ := s.db.Has([]byte(key), nil)
+	exist, _ := s.db.Has([]byte(key))
 	s.logger.Debug().Msgf("key:%s has signed: %t", key, exist)
 	return exist
 }
@@ -61,17 +61,17 @@ func (s *CacheStore) HasSigned(hash string) bool {
 // the cache key for the TxOutItem.
 func (s *CacheStore) RemoveSigned(transactionHash string) error {
 	mapKey := s.getMapKey(transactionHash)
-	value, err := s.db.Get([]byte(mapKey), nil)
+	value, err := s.db.Get([]byte(mapKey))
 	if err != nil {
 		// bifrost didn't sign this tx , so it is fine
-		if errors.Is(err, leveldb.ErrNotFound) {
+		if errors.Is(err, db.ErrNotFound) {
 			return nil
 		}
 		s.logger.Err(err).Msg("fail to check map key exist")
 		return err
 	}
 	key := s.getSignedKey(string(value))
-	if err = s.db.Delete([]byte(key), nil); err != nil {
+	if err = s.db.Delete([]byte(key)); err != nil {
 		s.logger.Error().Err(err).Msgf("fail to remove %s from signed cache", string(value))
 		return fmt.Errorf("fail to remove signed cache, err: %w", err)
 	}
@@ -81,18 +81,18 @@ func (s *CacheStore) RemoveSigned(transactionHash string) error {
 // SetTransactionHashMap map a transaction hash to a tx out item hash
 func (s *CacheStore) SetTransactionHashMap(txOutItemHash, transactionHash string) error {
 	key := s.getMapKey(transactionHash)
-	return s.db.Put([]byte(key), []byte(txOutItemHash), nil)
+	return s.db.Put([]byte(key), []byte(txOutItemHash))
 }
 
 // SetLatestRecordedTx map a vault and transaction inbound or outbound to transaction hash
 func (s *CacheStore) SetLatestRecordedTx(vaultKey, transactionHash string) error {
 	key := s.getVaultKey(vaultKey)
-	return s.db.Put([]byte(key), []byte(transactionHash), nil)
+	return s.db.Put([]byte(key), []byte(transactionHash))
 }
 
 func (s *CacheStore) GetLatestRecordedTx(vaultKey string) (string, error) {
 	key := s.getVaultKey(vaultKey)
-	hash, err := s.db.Get([]byte(key), nil)
+	hash, err := s.db.Get([]byte(key))
 	if err != nil {
 		return "", err
 	}
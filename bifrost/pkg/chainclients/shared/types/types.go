@@ -60,6 +60,9 @@ type ChainClient interface {
 	// GetBlockScannerHeight returns block scanner height for chain
 	GetBlockScannerHeight() (int64, error)
 
+	// GetBlocksForRetry returns the heights of the blocks awaiting a retry by the block scanner.
+	GetBlocksForRetry() ([]int64, error)
+
 	// GetLatestTxForVault returns last observed and broadcasted tx for a particular vault and chain
 	GetLatestTxForVault(vault string) (string, string, error)
 
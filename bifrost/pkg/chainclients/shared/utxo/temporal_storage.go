@@ -6,8 +6,7 @@ import (
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 )
 
 // -------------------------------------------------------------------------------------
@@ -60,12 +59,12 @@ type TransactionFee struct {
 // processing, and to ensure duplicate observations are not posted to Thorchain
 // which could result in bond slash.
 type TemporalStorage struct {
-	db               *leveldb.DB
+	db               db.Store
 	mempoolTxIDCache *lru.Cache
 }
 
-func NewTemporalStorage(db *leveldb.DB, txidCacheSize int) (*TemporalStorage, error) {
-	t := &TemporalStorage{db: db}
+func NewTemporalStorage(store db.Store, txidCacheSize int) (*TemporalStorage, error) {
+	t := &TemporalStorage{db: store}
 
 	if txidCacheSize > 0 {
 		var err error
@@ -82,14 +81,14 @@ func NewTemporalStorage(db *leveldb.DB, txidCacheSize int) (*TemporalStorage, er
 // for the requested height is not found, we will return nil with nil error.
 func (t *TemporalStorage) GetBlockMeta(height int64) (*BlockMeta, error) {
 	key := t.getBlockMetaKey(height)
-	exist, err := t.db.Has([]byte(key), nil)
+	exist, err := t.db.Has([]byte(key))
 	if err != nil {
 		return nil, fmt.Errorf("fail to check whether block meta(%s) exist: %w", key, err)
 	}
 	if !exist {
 		return nil, nil
 	}
-	v, err := t.db.Get([]byte(key), nil)
+	v, err := t.db.Get([]byte(key))
 	if err != nil {
 		return nil, fmt.Errorf("fail to get block meta(%s) from storage: %w", key, err)
 	}
@@ -107,15 +106,15 @@ func (t *TemporalStorage) SaveBlockMeta(height int64, blockMeta *BlockMeta) erro
 	if err != nil {
 		return fmt.Errorf("fail to marshal block meta to json: %w", err)
 	}
-	return t.db.Put([]byte(key), buf, nil)
+	return t.db.Put([]byte(key), buf)
 }
 
 // GetBlockMetas returns all the block metas in storage.
 func (t *TemporalStorage) GetBlockMetas() ([]*BlockMeta, error) {
 	blockMetas := make([]*BlockMeta, 0)
-	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixBlockMeta)), nil)
+	iterator := t.db.NewIterator([]byte(PrefixBlockMeta))
 	defer iterator.Release()
-	for ; iterator.Next(); iterator.Valid() {
+	for iterator.Next() {
 		buf := iterator.Value()
 		if len(buf) == 0 {
 			continue
@@ -133,10 +132,10 @@ func (t *TemporalStorage) GetBlockMetas() ([]*BlockMeta, error) {
 // and pass the provided filter function. Consumers should provide a function for the
 // filter to ensure there are no transactions in the mempool corresponding to the block.
 func (t *TemporalStorage) PruneBlockMeta(height int64, filter PruneBlockMetaFunc) error {
-	iterator := t.db.NewIterator(util.BytesPrefix([]byte(PrefixBlockMeta)), nil)
+	iterator := t.db.NewIterator([]byte(PrefixBlockMeta))
 	defer iterator.Release()
 	targetToDelete := make([]string, 0)
-	for ; iterator.Next(); iterator.Valid() {
+	for iterator.Next() {
 		buf := iterator.Value()
 		if len(buf) == 0 {
 			continue
@@ -155,7 +154,7 @@ func (t *TemporalStorage) PruneBlockMeta(height int64, filter PruneBlockMetaFunc
 	}
 
 	for _, key := range targetToDelete {
-		if err := t.db.Delete([]byte(key), nil); err != nil {
+		if err := t.db.Delete([]byte(key)); err != nil {
 			return fmt.Errorf("fail to delete block meta with key(%s) from storage: %w", key, err)
 		}
 	}
@@ -172,12 +171,12 @@ func (t *TemporalStorage) UpsertTransactionFee(fee float64, vSize int32) error {
 	if err != nil {
 		return fmt.Errorf("fail to marshal transaction fee struct to json: %w", err)
 	}
-	return t.db.Put([]byte(TransactionFeeKey), buf, nil)
+	return t.db.Put([]byte(TransactionFeeKey), buf)
 }
 
 // GetTransactionFee returns the last transaction fee written to storage.
 func (t *TemporalStorage) GetTransactionFee() (float64, int32, error) {
-	buf, err := t.db.Get([]byte(TransactionFeeKey), nil)
+	buf, err := t.db.Get([]byte(TransactionFeeKey))
 	if err != nil {
 		return 0.0, 0, fmt.Errorf("fail to get transaction fee from storage: %w", err)
 	}
@@ -199,7 +198,7 @@ func (t *TemporalStorage) TrackMempoolTx(txid string) (bool, error) {
 		return false, nil
 	}
 
-	exist, err := t.db.Has([]byte(key), nil)
+	exist, err := t.db.Has([]byte(key))
 	if err != nil {
 		return exist, err
 	}
@@ -211,7 +210,7 @@ func (t *TemporalStorage) TrackMempoolTx(txid string) (bool, error) {
 
 		return false, nil
 	}
-	err = t.db.Put([]byte(key), []byte(txid), nil)
+	err = t.db.Put([]byte(key), []byte(txid))
 
 	// if successful, add to cache
 	if err == nil && t.mempoolTxIDCache != nil {
@@ -224,7 +223,7 @@ func (t *TemporalStorage) TrackMempoolTx(txid string) (bool, error) {
 // UntrackMempoolTx untracks the provided mempool txid.
 func (t *TemporalStorage) UntrackMempoolTx(txid string) error {
 	key := t.getMemPoolKey(txid)
-	err := t.db.Delete([]byte(key), nil)
+	err := t.db.Delete([]byte(key))
 
 	// if successful, remove from cache
 	if err == nil && t.mempoolTxIDCache != nil {
@@ -239,21 +238,21 @@ func (t *TemporalStorage) UntrackMempoolTx(txid string) error {
 // an error occurred during write.
 func (t *TemporalStorage) TrackObservedTx(txid string) (bool, error) {
 	key := t.getObservedTxKey(txid)
-	exist, err := t.db.Has([]byte(key), nil)
+	exist, err := t.db.Has([]byte(key))
 	if err != nil {
 		return exist, err
 	}
 	if exist {
 		return false, nil
 	}
-	err = t.db.Put([]byte(key), []byte(txid), nil)
+	err = t.db.Put([]byte(key), []byte(txid))
 	return true, err
 }
 
 // UntrackObservedTx untracks the provided observed txid.
 func (t *TemporalStorage) UntrackObservedTx(txid string) error {
 	key := t.getObservedTxKey(txid)
-	return t.db.Delete([]byte(key), nil)
+	return t.db.Delete([]byte(key))
 }
 
 // ------------------------------ internal ------------------------------
@@ -3,10 +3,10 @@ package utxo
 import (
 	"fmt"
 
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
+	"gitlab.com/thorchain/thornode/v3/config"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain"
 )
 
@@ -17,20 +17,18 @@ var _ = Suite(
 )
 
 func (s *BitcoinTemporalStorageTestSuite) TestNewTemporalStorage(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	dbTemporalStorage, err := NewTemporalStorage(db, 0)
+	dbTemporalStorage, err := NewTemporalStorage(memDb, 0)
 	c.Assert(err, IsNil)
 	c.Assert(dbTemporalStorage, NotNil)
-	c.Assert(db.Close(), IsNil)
+	c.Assert(memDb.Close(), IsNil)
 }
 
 func (s *BitcoinTemporalStorageTestSuite) TestTemporalStorage(c *C) {
-	memStorage := storage.NewMemStorage()
-	db, err := leveldb.Open(memStorage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	store, err := NewTemporalStorage(db, 0)
+	store, err := NewTemporalStorage(memDb, 0)
 	c.Assert(err, IsNil)
 	c.Assert(store, NotNil)
 
@@ -78,5 +76,5 @@ func (s *BitcoinTemporalStorageTestSuite) TestTemporalStorage(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(fee, Equals, 1.0)
 	c.Assert(vSize, Equals, int32(1))
-	c.Assert(db.Close(), IsNil)
+	c.Assert(memDb.Close(), IsNil)
 }
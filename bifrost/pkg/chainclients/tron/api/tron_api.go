@@ -34,6 +34,13 @@ func NewTronApi(url string, timeout time.Duration) *TronApi {
 	}
 }
 
+// WithHTTPClient replaces the http client used for requests, for example to route
+// requests through an rpc pool.
+func (api *TronApi) WithHTTPClient(client *http.Client) *TronApi {
+	api.http = client
+	return api
+}
+
 // public
 // ----------------------------------------------------------------------------
 
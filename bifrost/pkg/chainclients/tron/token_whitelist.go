@@ -0,0 +1,140 @@
+package tron
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/tron/api"
+	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/tokenlist"
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+// Token is a TRC-20 token accepted by the Tron client.
+type Token struct {
+	tokenlist.ERC20Token
+
+	// EnergyLimit is the maximum energy a transfer of the token may consume,
+	// zero when no limit has been set through mimir.
+	EnergyLimit int64
+}
+
+// TokenWhitelist holds the TRC-20 tokens the client observes and signs for, keyed
+// by their base58 contract address. It is seeded from the configured whitelist and
+// extended with the tokens enabled on THORChain via the TokenDecimals mimir.
+type TokenWhitelist struct {
+	logger zerolog.Logger
+	chain  common.Chain
+	bridge thorclient.ThorchainBridge
+	static map[string]Token
+	tokens map[string]Token
+	lock   *sync.RWMutex
+}
+
+// NewTokenWhitelist creates a whitelist containing the configured tokens. Call
+// Refresh to load the tokens enabled on THORChain.
+func NewTokenWhitelist(cfg config.BifrostChainConfiguration, bridge thorclient.ThorchainBridge) *TokenWhitelist {
+	static := map[string]Token{}
+	for _, token := range tokenlist.GetEVMTokenList(cfg.ChainID).Tokens {
+		for _, address := range cfg.BlockScanner.WhitelistTokens {
+			if strings.EqualFold(address, token.Address) {
+				static[address] = Token{ERC20Token: token}
+			}
+		}
+	}
+
+	tokens := make(map[string]Token, len(static))
+	for address, token := range static {
+		tokens[address] = token
+	}
+
+	return &TokenWhitelist{
+		logger: log.With().Str("module", "token_whitelist").Str("chain", cfg.ChainID.String()).Logger(),
+		chain:  cfg.ChainID,
+		bridge: bridge,
+		static: static,
+		tokens: tokens,
+		lock:   &sync.RWMutex{},
+	}
+}
+
+// Refresh reloads the governance enabled tokens from THORChain. Configured tokens
+// are always kept, but their decimals and energy limit are overridden by mimir.
+func (w *TokenWhitelist) Refresh() error {
+	governed, err := w.bridge.GetTokenWhitelist(w.chain)
+	if err != nil {
+		return err
+	}
+
+	tokens := make(map[string]Token, len(w.static)+len(governed))
+	for address, token := range w.static {
+		tokens[address] = token
+	}
+
+	for _, item := range governed {
+		// hex -> base58
+		address, err := api.ConvertAddress(item.Address)
+		if err != nil {
+			w.logger.Err(err).Str("address", item.Address).Msg("invalid whitelisted token address")
+			continue
+		}
+
+		tokens[address] = Token{
+			ERC20Token: tokenlist.ERC20Token{
+				Address:  address,
+				Symbol:   item.Symbol,
+				Name:     item.Symbol,
+				Decimals: item.Decimals,
+			},
+			EnergyLimit: item.EnergyLimit,
+		}
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.tokens = tokens
+
+	return nil
+}
+
+// Get returns the whitelisted token for the given base58 contract address.
+func (w *TokenWhitelist) Get(address string) (Token, bool) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	token, ok := w.tokens[address]
+	return token, ok
+}
+
+// GetByAsset returns the whitelisted token matching the given asset.
+func (w *TokenWhitelist) GetByAsset(asset common.Asset) (Token, bool) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	for _, token := range w.tokens {
+		if token.Asset(w.chain).Symbol.Equals(asset.Symbol) {
+			return token, true
+		}
+	}
+	return Token{}, false
+}
+
+// Tokens returns a copy of all whitelisted tokens.
+func (w *TokenWhitelist) Tokens() []Token {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	tokens := make([]Token, 0, len(w.tokens))
+	for _, token := range w.tokens {
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+// Len returns the number of whitelisted tokens.
+func (w *TokenWhitelist) Len() int {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	return len(w.tokens)
+}
@@ -0,0 +1,66 @@
+package tron
+
+import (
+	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
+	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/config"
+
+	. "gopkg.in/check.v1"
+)
+
+type TokenWhitelistTestSuite struct{}
+
+var _ = Suite(&TokenWhitelistTestSuite{})
+
+type whitelistBridge struct {
+	thorclient.ThorchainBridge
+	tokens []types.WhitelistToken
+}
+
+func (b whitelistBridge) GetTokenWhitelist(_ common.Chain) ([]types.WhitelistToken, error) {
+	return b.tokens, nil
+}
+
+func (s *TokenWhitelistTestSuite) TestRefresh(c *C) {
+	bridge := whitelistBridge{
+		tokens: []types.WhitelistToken{{
+			Symbol:      "USDT",
+			Address:     "41A614F803B6FD780986A42C78EC9C7F77E6DED13C",
+			Decimals:    6,
+			EnergyLimit: 65_000,
+		}, {
+			Symbol:   "BAD",
+			Address:  "41XYZ",
+			Decimals: 6,
+		}},
+	}
+
+	whitelist := NewTokenWhitelist(config.BifrostChainConfiguration{
+		ChainID: common.TRONChain,
+	}, bridge)
+	c.Assert(whitelist.Len(), Equals, 0)
+
+	c.Assert(whitelist.Refresh(), IsNil)
+	c.Assert(whitelist.Len(), Equals, 1)
+
+	token, ok := whitelist.Get("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	c.Assert(ok, Equals, true)
+	c.Assert(token.Symbol, Equals, "USDT")
+	c.Assert(token.Decimals, Equals, 6)
+	c.Assert(token.EnergyLimit, Equals, int64(65_000))
+
+	asset, err := common.NewAsset("TRON.USDT-TR7NHQJEKQXGTCI8Q8ZY4PL8OTSZGJLJ6T")
+	c.Assert(err, IsNil)
+	token, ok = whitelist.GetByAsset(asset)
+	c.Assert(ok, Equals, true)
+	c.Assert(token.Address, Equals, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+
+	// tokens removed from mimir are dropped on the next refresh
+	bridge.tokens = nil
+	whitelist.bridge = bridge
+	c.Assert(whitelist.Refresh(), IsNil)
+	c.Assert(whitelist.Len(), Equals, 0)
+	_, ok = whitelist.GetByAsset(asset)
+	c.Assert(ok, Equals, false)
+}
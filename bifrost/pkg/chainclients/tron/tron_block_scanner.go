@@ -2,30 +2,33 @@ package tron
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
 	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sort"
 	"strings"
+	"sync/atomic"
 
 	"cosmossdk.io/math"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/tron/api"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
 	"gitlab.com/thorchain/thornode/v3/common"
-	"gitlab.com/thorchain/thornode/v3/common/tokenlist"
 	"gitlab.com/thorchain/thornode/v3/config"
 )
 
 var (
-	refBlocksMax            = 10
-	refBlockInterval  int64 = 25
-	updateGasInterval int64 = 30
+	refBlocksMax                  = 10
+	refBlockInterval        int64 = 25
+	updateGasInterval       int64 = 30
+	updateWhitelistInterval int64 = 30
 )
 
 type ReportSolvency func(int64) error
@@ -41,18 +44,22 @@ type TronBlockScanner struct {
 	logger                zerolog.Logger
 	bridge                thorclient.ThorchainBridge
 	api                   *api.TronApi
-	whitelist             map[string]tokenlist.ERC20Token
+	rpcPool               *rpcpool.Pool
+	whitelist             *TokenWhitelist
 	abi                   abi.ABI
 	refBlocks             []RefBlock
 	refAddress            string // needed for energy estimation via api
 	currentFee            uint64
+	energyFee             atomic.Int64 // sun per unit of energy, read by the signer
 	reportSolvency        ReportSolvency
 	globalNetworkFeeQueue chan common.NetworkFee
 }
 
 func NewTronBlockScanner(
 	cfg config.BifrostChainConfiguration,
+	rpcPool *rpcpool.Pool,
 	bridge thorclient.ThorchainBridge,
+	whitelist *TokenWhitelist,
 	reportSolvency ReportSolvency,
 ) (*TronBlockScanner, error) {
 	logger := log.Logger.With().
@@ -78,23 +85,17 @@ func NewTronBlockScanner(
 		}
 	}
 
-	// load whitelisted tokens
-	tokens := tokenlist.GetEVMTokenList(cfg.ChainID).Tokens
-
-	whitelist := map[string]tokenlist.ERC20Token{}
-	for _, token := range tokens {
-		for _, address := range cfg.BlockScanner.WhitelistTokens {
-			if strings.EqualFold(address, token.Address) {
-				whitelist[address] = token
-			}
-		}
+	tronApi := api.NewTronApi(cfg.APIHost, cfg.BlockScanner.HTTPRequestTimeout)
+	if rpcPool != nil {
+		tronApi.WithHTTPClient(rpcPool.HTTPClient(cfg.BlockScanner.HTTPRequestTimeout))
 	}
 
 	scanner := TronBlockScanner{
 		config:         cfg.BlockScanner,
 		logger:         logger,
 		whitelist:      whitelist,
-		api:            api.NewTronApi(cfg.APIHost, cfg.BlockScanner.HTTPRequestTimeout),
+		api:            tronApi,
+		rpcPool:        rpcPool,
 		bridge:         bridge,
 		refBlocks:      []RefBlock{},
 		refAddress:     refAddress,
@@ -112,6 +113,31 @@ func NewTronBlockScanner(
 	return &scanner, nil
 }
 
+// newRPCPool creates the rpc pool for the api host of the chain, probing each endpoint
+// with a tron api client.
+func newRPCPool(cfg config.BifrostChainConfiguration) (*rpcpool.Pool, error) {
+	prober := rpcpool.NewClientProber(
+		func(host string) (*api.TronApi, error) {
+			return api.NewTronApi(host, cfg.RPCPool.Timeout), nil
+		},
+		func(_ context.Context, client *api.TronApi) (int64, error) {
+			block, err := client.GetLatestBlock()
+			if err != nil {
+				return 0, err
+			}
+			return block.Header.RawData.Number, nil
+		},
+		func(_ context.Context, client *api.TronApi, height int64) (string, error) {
+			block, err := client.GetBlock(height)
+			if err != nil {
+				return "", err
+			}
+			return block.BlockId, nil
+		},
+	)
+	return rpcpool.NewPool(cfg.ChainID, cfg.APIHost, cfg.RPCPool, prober)
+}
+
 func (s *TronBlockScanner) GetHeight() (int64, error) {
 	block, err := s.api.GetLatestBlock()
 	if err != nil {
@@ -139,6 +165,16 @@ func (s *TronBlockScanner) FetchTxs(
 		s.logger.Err(err).Msg("")
 		return types.TxIn{}, err
 	}
+	if err = s.rpcPool.VerifyBlockHash(fetchHeight, block.BlockId); err != nil {
+		return types.TxIn{}, err
+	}
+
+	// pick up tokens enabled or changed through mimir before processing the block
+	if fetchHeight%updateWhitelistInterval == 0 {
+		if err = s.whitelist.Refresh(); err != nil {
+			s.logger.Err(err).Msg("failed to refresh token whitelist")
+		}
+	}
 
 	txs, err := s.processTxs(block)
 	if err != nil {
@@ -240,7 +276,7 @@ func (s *TronBlockScanner) processTxs(
 			}
 
 			// skip unknown contracts
-			token, ok := s.whitelist[address]
+			token, ok := s.whitelist.Get(address)
 			if !ok {
 				continue
 			}
@@ -396,7 +432,7 @@ func (s *TronBlockScanner) updateFee(height int64) {
 	// bandwidth calculation:
 	// len(raw_data) + protobuf overhead + max_result_size + signature length
 	// len(raw_data) + 3 bytes + 64 bytes + 67 bytes
-	if len(s.whitelist) == 0 {
+	if s.whitelist.Len() == 0 {
 		// only TRX transfers
 		// => 150 + 3 + 64 + 67 = 284
 		bandwidth = 284 * params.BandwidthFee
@@ -414,6 +450,8 @@ func (s *TronBlockScanner) updateFee(height int64) {
 		energy = maxEnergy * params.EnergyFee
 	}
 
+	s.energyFee.Store(params.EnergyFee)
+
 	// add 1.1 TRX in case the new account needs to be activated:
 	// https://developers.tron.network/docs/account#account-activation
 	fee = energy + bandwidth + params.MemoFee + 1_100_000
@@ -452,7 +490,7 @@ func (s *TronBlockScanner) getMaxEnergy() (int64, error) {
 
 	input := fmt.Sprintf("%024x%s%064x", 0, hexAddress[2:], 1)
 
-	for _, token := range s.whitelist {
+	for _, token := range s.whitelist.Tokens() {
 		energy, err := s.api.EstimateEnergy(
 			s.refAddress,
 			token.Address,
@@ -460,14 +498,28 @@ func (s *TronBlockScanner) getMaxEnergy() (int64, error) {
 			input,
 		)
 		if err != nil {
-			s.logger.Err(err).Msg("failed to estimate energy")
+			s.logger.Err(err).Str("token", token.Address).Msg("failed to estimate energy")
 			return 0, err
 		}
 
-		// it takes almost twice the energy to send a token to a wallet
-		// for the first time (x2 for safety)
-		maxEnergy = max(maxEnergy, energy*2)
+		maxEnergy = max(maxEnergy, tokenEnergy(token, energy))
 	}
 
 	return maxEnergy, nil
 }
+
+// tokenEnergy returns the energy to account for when transferring the token, given
+// the estimated energy of a transfer to an existing holder.
+func tokenEnergy(token Token, estimated int64) int64 {
+	// it takes almost twice the energy to send a token to a wallet
+	// for the first time (x2 for safety)
+	energy := estimated * 2
+
+	// a transfer can never consume more than the token's energy limit, as it is
+	// used as fee limit when signing
+	if token.EnergyLimit > 0 {
+		energy = min(energy, token.EnergyLimit)
+	}
+
+	return energy
+}
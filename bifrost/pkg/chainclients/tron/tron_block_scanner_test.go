@@ -42,21 +42,26 @@ func (s *BlockScannerTestSuite) SetUpSuite(c *C) {
 	)
 	c.Assert(err, IsNil)
 
+	whitelist := NewTokenWhitelist(chainConfig, bridge)
+	whitelist.tokens = map[string]Token{
+		"TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs": {
+			ERC20Token: tokenlist.ERC20Token{
+				Name:     "USDT",
+				Symbol:   "USDT",
+				Address:  "TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs",
+				Decimals: 6,
+			},
+		},
+	}
+
 	s.scanner, err = NewTronBlockScanner(
 		chainConfig,
+		nil,
 		bridge,
+		whitelist,
 		func(h int64) error { return nil },
 	)
 	c.Assert(err, IsNil)
-
-	s.scanner.whitelist = map[string]tokenlist.ERC20Token{
-		"TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs": {
-			Name:     "USDT",
-			Symbol:   "USDT",
-			Address:  "TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs",
-			Decimals: 6,
-		},
-	}
 }
 
 func (s *BlockScannerTestSuite) TestGetHeight(c *C) {
@@ -87,3 +92,15 @@ func (s *BlockScannerTestSuite) TestGetMaxEnergy(c *C) {
 	// mock data returns 900k (2x)
 	c.Assert(maxEnergy, Equals, int64(1800000))
 }
+
+func (s *BlockScannerTestSuite) TestTokenEnergy(c *C) {
+	token := Token{}
+	c.Assert(tokenEnergy(token, 30_000), Equals, int64(60_000))
+
+	// capped by the energy limit set through mimir
+	token.EnergyLimit = 45_000
+	c.Assert(tokenEnergy(token, 30_000), Equals, int64(45_000))
+
+	token.EnergyLimit = 100_000
+	c.Assert(tokenEnergy(token, 30_000), Equals, int64(60_000))
+}
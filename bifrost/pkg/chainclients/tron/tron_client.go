@@ -22,6 +22,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	tcmetrics "gitlab.com/thorchain/thornode/v3/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/runners"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/signercache"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/tron/api"
@@ -31,7 +32,6 @@ import (
 	"gitlab.com/thorchain/thornode/v3/bifrost/tss"
 	tctss "gitlab.com/thorchain/thornode/v3/bifrost/tss/go-tss/tss"
 	"gitlab.com/thorchain/thornode/v3/common"
-	"gitlab.com/thorchain/thornode/v3/common/tokenlist"
 	tcconfig "gitlab.com/thorchain/thornode/v3/config"
 	"gitlab.com/thorchain/thornode/v3/constants"
 )
@@ -57,9 +57,10 @@ type TronClient struct {
 	tronScanner        *TronBlockScanner
 	api                *api.TronApi
 	rpc                *rpc.TronRpc
+	rpcPool            *rpcpool.Pool
 	abi                abi.ABI
 
-	whitelist           map[string]tokenlist.ERC20Token
+	whitelist           *TokenWhitelist
 	bridge              thorclient.ThorchainBridge
 	globalSolvencyQueue chan types.Solvency
 	wg                  *sync.WaitGroup
@@ -78,15 +79,11 @@ func NewTronClient(
 
 	logger := log.With().Str("module", config.ChainID.String()).Logger()
 
-	tokens := tokenlist.GetEVMTokenList(config.ChainID).Tokens
-
-	whitelist := map[string]tokenlist.ERC20Token{}
-	for _, token := range tokens {
-		for _, address := range config.BlockScanner.WhitelistTokens {
-			if strings.EqualFold(address, token.Address) {
-				whitelist[address] = token
-			}
-		}
+	whitelist := NewTokenWhitelist(config, bridge)
+	if err = whitelist.Refresh(); err != nil {
+		// the scanner refreshes the whitelist periodically, continue with the
+		// configured tokens until THORChain is reachable
+		logger.Err(err).Msg("failed to load token whitelist from thorchain")
 	}
 
 	client := TronClient{
@@ -101,6 +98,16 @@ func NewTronClient(
 		rpc:       rpc.NewTronRpc(config.RPCHost, config.BlockScanner.HTTPRequestTimeout),
 	}
 
+	// route api requests through the rpc pool if additional endpoints are configured
+	if config.RPCPool.Enabled() {
+		client.rpcPool, err = newRPCPool(config)
+		if err != nil {
+			logger.Err(err).Msg("failed to create rpc pool")
+			return nil, err
+		}
+		client.api.WithHTTPClient(client.rpcPool.HTTPClient(config.BlockScanner.HTTPRequestTimeout))
+	}
+
 	client.tssKeyManager, err = tss.NewKeySign(server, bridge)
 	if err != nil {
 		logger.Err(err).Msg("failed to create tss signer")
@@ -131,7 +138,9 @@ func NewTronClient(
 
 	client.tronScanner, err = NewTronBlockScanner(
 		config,
+		client.rpcPool,
 		client.bridge,
+		client.whitelist,
 		client.ReportSolvency,
 	)
 	if err != nil {
@@ -177,6 +186,9 @@ func (c *TronClient) Start(
 ) {
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.tronScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
+	if c.rpcPool != nil {
+		c.rpcPool.Start()
+	}
 	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
 	c.tssKeyManager.Start()
 
@@ -197,6 +209,9 @@ func (c *TronClient) Stop() {
 	c.blockScanner.Stop()
 	close(c.stopchan)
 	c.wg.Wait()
+	if c.rpcPool != nil {
+		c.rpcPool.Stop()
+	}
 }
 
 func (c *TronClient) IsBlockScannerHealthy() bool {
@@ -265,12 +280,8 @@ func (c *TronClient) GetAccountByAddress(
 		Coins: coins,
 	}
 
-	if len(c.whitelist) == 0 {
-		return account, nil
-	}
-
-	for contract, token := range c.whitelist {
-		balance, err := c.getTokenBalance(address, contract)
+	for _, token := range c.whitelist.Tokens() {
+		balance, err := c.getTokenBalance(address, token.Address)
 		if err != nil {
 			c.logger.Err(err).Msg("failed to get token balance")
 			return account, err
@@ -301,6 +312,11 @@ func (c *TronClient) GetBlockScannerHeight() (int64, error) {
 	return c.blockScanner.PreviousHeight(), nil
 }
 
+// GetBlocksForRetry returns the heights of the blocks awaiting a retry by the block scanner
+func (c *TronClient) GetBlocksForRetry() ([]int64, error) {
+	return c.blockScanner.GetBlocksForRetry()
+}
+
 // RollbackBlockScanner rolls back the block scanner to the last observed block
 func (c *TronClient) RollbackBlockScanner() error {
 	return c.blockScanner.RollbackToLastObserved()
@@ -385,18 +401,7 @@ func (c *TronClient) SignTx(
 			return nil, nil, nil, err
 		}
 	} else {
-		found := false
-		var contract string
-		var token tokenlist.ERC20Token
-
-		for contract, token = range c.whitelist {
-			symbol := strings.ToUpper(token.Symbol + "-" + contract)
-			if coin.Asset.Symbol.String() == symbol {
-				found = true
-				break
-			}
-		}
-
+		token, found := c.whitelist.GetByAsset(coin.Asset)
 		if !found {
 			err := fmt.Errorf("token not whitelisted")
 			c.logger.Err(err).Msg("")
@@ -415,9 +420,9 @@ func (c *TronClient) SignTx(
 		tronTx, err = c.createTrc20Transaction(
 			fromAddress,
 			txOutItem.ToAddress.String(),
-			contract,
+			token.Address,
 			*amount,
-			*txOutItem.MaxGas[0].Amount.Quo(math.NewUint(100)).BigInt(), // 1e8 -> 1e6
+			c.getFeeLimit(token, txOutItem.MaxGas),
 		)
 		if err != nil {
 			c.logger.Err(err).Msg("failed to create trc20 tx")
@@ -695,6 +700,20 @@ func (c *TronClient) createTrc20Transaction(
 	return tx, nil
 }
 
+// getFeeLimit returns the maximum TRX (in sun) a transfer of the token may burn. The
+// token's energy limit is used when known, bounded by the max gas of the outbound.
+func (c *TronClient) getFeeLimit(token Token, maxGas common.Gas) big.Int {
+	feeLimit := maxGas[0].Amount.Quo(math.NewUint(100)) // 1e8 -> 1e6
+
+	energyFee := c.tronScanner.energyFee.Load()
+	if token.EnergyLimit > 0 && energyFee > 0 {
+		tokenLimit := math.NewUint(uint64(token.EnergyLimit)).MulUint64(uint64(energyFee))
+		feeLimit = math.MinUint(feeLimit, tokenLimit)
+	}
+
+	return *feeLimit.BigInt()
+}
+
 func (c *TronClient) getTokenBalance(
 	address, contract string,
 ) (*big.Int, error) {
@@ -128,17 +128,20 @@ func (s *TronTestSuite) SetUpSuite(c *C) {
 	)
 	c.Assert(err, IsNil)
 
-	whitelist := map[string]tokenlist.ERC20Token{
+	whitelist := NewTokenWhitelist(chainConfig, bridge)
+	whitelist.tokens = map[string]Token{
 		"TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs": {
-			Name:     "USDT",
-			Symbol:   "USDT",
-			Address:  "TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs",
-			Decimals: 6,
+			ERC20Token: tokenlist.ERC20Token{
+				Name:     "USDT",
+				Symbol:   "USDT",
+				Address:  "TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs",
+				Decimals: 6,
+			},
 		},
 	}
 
 	scanner, err := NewTronBlockScanner(
-		chainConfig, bridge, func(int64) error { return nil },
+		chainConfig, nil, bridge, whitelist, func(int64) error { return nil },
 	)
 	c.Assert(err, IsNil)
 
@@ -306,3 +309,40 @@ func (s *TronTestSuite) TestCreateTrc20Transaction(c *C) {
 		c.Assert(tx.TxId, Equals, "482b1a3b61894f75ea25bd10b14335a4db86c7e2c642ae07abc5a8ae45fb0027")
 	}
 }
+
+func (s *TronTestSuite) TestGetFeeLimit(c *C) {
+	maxGas := common.Gas{{
+		Asset:    common.TRXAsset,
+		Amount:   cosmos.NewUint(2_000_000_000),
+		Decimals: 6,
+	}}
+
+	token := Token{
+		ERC20Token: tokenlist.ERC20Token{
+			Symbol:   "USDT",
+			Address:  "TG3XXyExBkPp9nzdajDZsozEu4BkaSJozs",
+			Decimals: 6,
+		},
+	}
+
+	s.client.tronScanner.energyFee.Store(210)
+
+	// no energy limit, use max gas
+	feeLimit := s.client.getFeeLimit(token, maxGas)
+	c.Assert(feeLimit.Int64(), Equals, int64(20_000_000))
+
+	// energy limit below max gas
+	token.EnergyLimit = 65_000
+	feeLimit = s.client.getFeeLimit(token, maxGas)
+	c.Assert(feeLimit.Int64(), Equals, int64(13_650_000))
+
+	// energy limit above max gas
+	token.EnergyLimit = 200_000
+	feeLimit = s.client.getFeeLimit(token, maxGas)
+	c.Assert(feeLimit.Int64(), Equals, int64(20_000_000))
+
+	// energy fee unknown
+	s.client.tronScanner.energyFee.Store(0)
+	feeLimit = s.client.getFeeLimit(token, maxGas)
+	c.Assert(feeLimit.Int64(), Equals, int64(20_000_000))
+}
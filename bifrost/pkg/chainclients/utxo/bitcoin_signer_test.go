@@ -1,6 +1,7 @@
 package utxo
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -15,16 +16,16 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/utxo"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
@@ -43,7 +44,7 @@ type BitcoinSignerSuite struct {
 	bridge thorclient.ThorchainBridge
 	cfg    config.BifrostChainConfiguration
 	m      *metrics.Metrics
-	db     *leveldb.DB
+	db     db.Store
 	keys   *thorclient.Keys
 }
 
@@ -142,11 +143,10 @@ func (s *BitcoinSignerSuite) SetUpTest(c *C) {
 	c.Assert(err, IsNil)
 	s.client, err = NewClient(s.keys, s.cfg, nil, s.bridge, s.m)
 	c.Assert(err, IsNil)
-	storage := storage.NewMemStorage()
-	db, err := leveldb.Open(storage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	s.db = db
-	s.client.temporalStorage, err = utxo.NewTemporalStorage(db, 0)
+	s.db = memDb
+	s.client.temporalStorage, err = utxo.NewTemporalStorage(memDb, 0)
 	c.Assert(err, IsNil)
 	c.Assert(s.client, NotNil)
 }
@@ -250,6 +250,64 @@ func (s *BitcoinSignerSuite) TestSignTxHappyPathWithPrivateKey(c *C) {
 	c.Assert(buf, NotNil)
 }
 
+func (s *BitcoinSignerSuite) TestSignTxBatched(c *C) {
+	inHash := thorchain.GetRandomTxHash()
+	memo := "OUT:" + inHash.String()
+	newItem := func(amt uint64) stypes.TxOutItem {
+		addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
+		c.Assert(err, IsNil)
+		return stypes.TxOutItem{
+			Chain:     common.BTCChain,
+			ToAddress: addr,
+			Coins: common.Coins{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(amt)),
+			},
+			MaxGas: common.Gas{
+				common.NewCoin(common.BTCAsset, cosmos.NewUint(1000)),
+			},
+			InHash: inHash,
+			Memo:   memo,
+		}
+	}
+	txOutItem := newItem(10)
+	invalid := newItem(40)
+	invalid.ToAddress = types2.GetRandomETHAddress()
+	txOutItem.Batch = []stypes.TxOutItem{newItem(20), invalid, newItem(30)}
+
+	txHash := "256222fb25a9950479bb26049a2c00e75b89abbb7f0cf646c623b93e942c4c34"
+	blockMeta := utxo.NewBlockMeta("000000000000008a0da55afa8432af3b15c225cc7e04d32f0de912702dd9e2ae",
+		100,
+		"0000000000000068f0710c510e94bd29aa624745da43e32a1de887387306bfda")
+	blockMeta.AddCustomerTransaction(txHash)
+	c.Assert(s.client.temporalStorage.SaveBlockMeta(blockMeta.Height, blockMeta), IsNil)
+	priKeyBuf, err := hex.DecodeString("b404c5ec58116b5f0fe13464a92e46626fc5db130e418cbce98df86ffe9317c5")
+	c.Assert(err, IsNil)
+	pkey, _ := btcec.PrivKeyFromBytes(btcec.S256(), priKeyBuf)
+	c.Assert(pkey, NotNil)
+	s.client.nodePrivKey = pkey
+	s.client.nodePubKey, err = bech32AccountPubKey(pkey)
+	c.Assert(err, IsNil)
+	txOutItem.VaultPubKey = s.client.nodePubKey
+	buf, _, txIn, err := s.client.SignTx(txOutItem, 1)
+	c.Assert(err, IsNil)
+	c.Assert(buf, NotNil)
+
+	// the recipient with the invalid address is dropped from the batch
+	redeemTx := wire.NewMsgTx(wire.TxVersion)
+	c.Assert(redeemTx.Deserialize(bytes.NewReader(buf)), IsNil)
+	c.Assert(redeemTx.TxOut[0].Value, Equals, int64(10))
+	c.Assert(redeemTx.TxOut[1].Value, Equals, int64(20))
+	c.Assert(redeemTx.TxOut[2].Value, Equals, int64(30))
+
+	// the observation reports the batched recipients as outputs
+	c.Assert(txIn, NotNil)
+	c.Assert(txIn.Outputs, HasLen, 2)
+	c.Check(txIn.Outputs[0].ToAddress.Equals(txOutItem.Batch[0].ToAddress), Equals, true)
+	c.Check(txIn.Outputs[0].Coins.EqualsEx(txOutItem.Batch[0].Coins), Equals, true)
+	c.Check(txIn.Outputs[1].ToAddress.Equals(txOutItem.Batch[2].ToAddress), Equals, true)
+	c.Check(txIn.Outputs[1].Coins.EqualsEx(txOutItem.Batch[2].Coins), Equals, true)
+}
+
 func (s *BitcoinSignerSuite) TestSignTxWithoutPredefinedMaxGas(c *C) {
 	addr, err := types2.GetRandomPubKey().GetAddress(common.BTCChain)
 	c.Assert(err, IsNil)
@@ -1409,6 +1409,77 @@ func (s *BitcoinSuite) TestGetOutput(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *BitcoinSuite) TestGetBatchOutputs(c *C) {
+	var vaultPubKey common.PubKey
+	var err error
+	if common.CurrentChainNetwork == common.MainNet {
+		vaultPubKey, err = common.NewPubKey("thorpub1addwnpepqwprh5vd0rrk78kd98qjruuazwvapnxft7f86w7hlf768whxytpn5quf2gs") // from PubKeys-Mainnet.json
+	} else {
+		vaultPubKey, err = common.NewPubKey("tthorpub1addwnpepqflvfv08t6qt95lmttd6wpf3ss8wx63e9vf6fvyuj2yy6nnyna576rfzjks") // from PubKeys.json
+	}
+	c.Assert(err, IsNil, Commentf(vaultPubKey.String()))
+	vaultAddress, err := vaultPubKey.GetAddress(s.client.GetChain())
+	c.Assert(err, IsNil)
+	vaultAddressString := vaultAddress.String()
+
+	tx := btcjson.TxRawResult{
+		Vin: []btcjson.Vin{
+			{
+				Txid: "5b0876dcc027d2f0c671fc250460ee388df39697c3ff082007b6ddd9cb9a7513",
+				Vout: 1,
+			},
+		},
+		Vout: []btcjson.Vout{
+			{
+				N:     0,
+				Value: 1.49655603,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{"tb1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw43s835"},
+				},
+			},
+			{
+				N:     1,
+				Value: 0.5,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{"tb1qkq7weysjn6ljc2ywmjmwp8ttcckg8yyxjdz5k6"},
+				},
+			},
+			{
+				N:     2,
+				Value: 0.00195384,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Addresses: []string{vaultAddressString},
+				},
+			},
+			{
+				N: 3,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{
+					Asm:  "OP_RETURN 74686f72636861696e3a636f6e736f6c6964617465",
+					Type: "nulldata",
+				},
+			},
+		},
+	}
+	out, err := s.client.getOutput(vaultAddressString, &tx, false)
+	c.Assert(err, IsNil)
+	outputs, err := s.client.getBatchOutputs(vaultAddressString, &tx, out)
+	c.Assert(err, IsNil)
+	c.Assert(outputs, HasLen, 1)
+	c.Check(outputs[0].ToAddress.String(), Equals, "tb1qkq7weysjn6ljc2ywmjmwp8ttcckg8yyxjdz5k6")
+	c.Check(outputs[0].Coins.EqualsEx(common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(50000000)))), Equals, true)
+
+	// outputs of a tx not sent by a vault are not batched outputs
+	outputs, err = s.client.getBatchOutputs("tb1qj08ys4ct2hzzc2hcz6h2hgrvlmsjynaw43s835", &tx, out)
+	c.Assert(err, IsNil)
+	c.Assert(outputs, HasLen, 0)
+
+	// a single outbound has no batched outputs
+	tx.Vout = append(tx.Vout[:1], tx.Vout[2:]...)
+	outputs, err = s.client.getBatchOutputs(vaultAddressString, &tx, out)
+	c.Assert(err, IsNil)
+	c.Assert(outputs, HasLen, 0)
+}
+
 func (s *BitcoinSuite) TestIsValidUTXO(c *C) {
 	// normal pay to pubkey hash segwit
 	c.Assert(s.client.isValidUTXO("00140653096f54ae1ae2d73291d15854aef08ebcfa8c"), Equals, true)
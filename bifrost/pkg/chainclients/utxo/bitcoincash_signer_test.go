@@ -21,10 +21,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/storage"
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/utxo"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
@@ -43,7 +42,7 @@ type BitcoinCashSignerSuite struct {
 	bridge thorclient.ThorchainBridge
 	cfg    config.BifrostChainConfiguration
 	m      *metrics.Metrics
-	db     *leveldb.DB
+	db     db.Store
 	keys   *thorclient.Keys
 }
 
@@ -139,11 +138,10 @@ func (s *BitcoinCashSignerSuite) SetUpTest(c *C) {
 	c.Assert(err, IsNil)
 	s.client, err = NewClient(s.keys, s.cfg, nil, s.bridge, s.m)
 	c.Assert(err, IsNil)
-	storage := storage.NewMemStorage()
-	db, err := leveldb.Open(storage, nil)
+	memDb, err := db.NewStore("", config.LevelDBOptions{})
 	c.Assert(err, IsNil)
-	s.client.temporalStorage, err = utxo.NewTemporalStorage(db, 0)
-	s.db = db
+	s.client.temporalStorage, err = utxo.NewTemporalStorage(memDb, 0)
+	s.db = memDb
 	c.Assert(err, IsNil)
 	c.Assert(s.client, NotNil)
 }
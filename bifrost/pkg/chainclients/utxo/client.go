@@ -1,6 +1,7 @@
 package utxo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -17,10 +18,12 @@ import (
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcutil"
+	erpc "github.com/ethereum/go-ethereum/rpc"
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	btypes "gitlab.com/thorchain/thornode/v3/bifrost/blockscanner/types"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/runners"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/signercache"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/utxo"
@@ -53,6 +56,10 @@ type Client struct {
 	m   *metrics.Metrics
 	rpc *rpc.Client
 
+	// ---------- rpc pool ----------
+	rpcPool   *rpcpool.Pool
+	rpcProber *rpcpool.ClientProber[*rpc.Client]
+
 	// ---------- signing ----------
 	nodePubKey         common.PubKey
 	nodePrivKey        *btcec.PrivateKey
@@ -121,8 +128,32 @@ func NewClient(
 
 	logger := log.Logger.With().Stringer("chain", cfg.ChainID).Logger()
 
+	// create rpc pool if additional endpoints are configured
+	var opts []erpc.ClientOption
+	var rpcPool *rpcpool.Pool
+	var rpcProber *rpcpool.ClientProber[*rpc.Client]
+	if cfg.RPCPool.Enabled() {
+		rpcProber = rpcpool.NewClientProber(
+			func(host string) (*rpc.Client, error) {
+				return rpc.NewClient(host, cfg.UserName, cfg.Password, 0, logger)
+			},
+			func(_ context.Context, client *rpc.Client) (int64, error) {
+				return client.GetBlockCount()
+			},
+			func(_ context.Context, client *rpc.Client, height int64) (string, error) {
+				return client.GetBlockHash(height)
+			},
+		)
+		var err error
+		rpcPool, err = rpcpool.NewPool(cfg.ChainID, cfg.RPCHost, cfg.RPCPool, rpcProber)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create rpc pool: %w", err)
+		}
+		opts = append(opts, erpc.WithHTTPClient(rpcPool.HTTPClient(cfg.BlockScanner.HTTPRequestTimeout)))
+	}
+
 	// create rpc client
-	rpcClient, err := rpc.NewClient(cfg.RPCHost, cfg.UserName, cfg.Password, cfg.MaxRPCRetries, logger)
+	rpcClient, err := rpc.NewClient(cfg.RPCHost, cfg.UserName, cfg.Password, cfg.MaxRPCRetries, logger, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("fail to create rpc client: %w", err)
 	}
@@ -148,6 +179,8 @@ func NewClient(
 		log:                       logger,
 		m:                         m,
 		rpc:                       rpcClient,
+		rpcPool:                   rpcPool,
+		rpcProber:                 rpcProber,
 		nodePubKey:                nodePubKey,
 		nodePrivKey:               nodePrivKey,
 		tssKeySigner:              tssKeysign,
@@ -225,6 +258,11 @@ func (c *Client) GetBlockScannerHeight() (int64, error) {
 	return c.blockScanner.PreviousHeight(), nil
 }
 
+// GetBlocksForRetry returns the heights of the blocks awaiting a retry by the block scanner
+func (c *Client) GetBlocksForRetry() ([]int64, error) {
+	return c.blockScanner.GetBlocksForRetry()
+}
+
 // RollbackBlockScanner rolls back the block scanner to the last observed block
 func (c *Client) RollbackBlockScanner() error {
 	return c.blockScanner.RollbackToLastObserved()
@@ -263,6 +301,9 @@ func (c *Client) Start(
 	c.globalErrataQueue = globalErrataQueue
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.globalNetworkFeeQueue = globalNetworkFeeQueue
+	if c.rpcPool != nil {
+		c.rpcPool.Start()
+	}
 	c.tssKeySigner.Start()
 	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
@@ -277,14 +318,44 @@ func (c *Client) Stop() {
 	c.tssKeySigner.Stop()
 	close(c.stopchan)
 	c.wg.Wait()
+	if c.rpcPool != nil {
+		c.rpcPool.Stop()
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////
 // Client - Accounts
 ////////////////////////////////////////////////////////////////////////////////////////
 
-// RegisterPublicKey imports the provided public key in the chain daemon.
+// RegisterPublicKey imports the provided public key in the chain daemon. If an rpc pool
+// is configured, the key is imported on every endpoint so wallet calls are consistent
+// after failover.
 func (c *Client) RegisterPublicKey(pubkey common.PubKey) error {
+	if c.rpcPool == nil {
+		return c.registerPublicKey(c.rpc, pubkey)
+	}
+
+	var lastErr error
+	registered := 0
+	for _, host := range c.rpcPool.Hosts() {
+		client, err := c.rpcProber.Client(host)
+		if err == nil {
+			err = c.registerPublicKey(client, pubkey)
+		}
+		if err != nil {
+			c.log.Error().Err(err).Str("host", host).Msg("fail to register public key on rpc endpoint")
+			lastErr = err
+			continue
+		}
+		registered++
+	}
+	if registered == 0 {
+		return lastErr
+	}
+	return nil
+}
+
+func (c *Client) registerPublicKey(client *rpc.Client, pubkey common.PubKey) error {
 	addr, err := pubkey.GetAddress(c.cfg.ChainID)
 	if err != nil {
 		return fmt.Errorf("fail to get address from pubkey(%s): %w", pubkey, err)
@@ -293,14 +364,14 @@ func (c *Client) RegisterPublicKey(pubkey common.PubKey) error {
 	// litecoin does not have a default wallet so we need to create one
 	switch c.cfg.ChainID {
 	case common.LTCChain, common.BTCChain:
-		err = c.rpc.CreateWallet("")
+		err = client.CreateWallet("")
 		if err != nil {
 			c.log.Info().Err(err).Msg("fail to create wallet")
 			return err
 		}
 	}
 
-	err = c.rpc.ImportAddress(addr.String())
+	err = client.ImportAddress(addr.String())
 	if err != nil {
 		c.log.Error().Err(err).
 			Str("pubkey", pubkey.String()).
@@ -432,6 +503,9 @@ func (c *Client) FetchTxs(height, chainHeight int64) (types.TxIn, error) {
 	if block.Hash == "" && block.PreviousHash == "" {
 		return txIn, fmt.Errorf("fail to get block: %w", err)
 	}
+	if err = c.rpcPool.VerifyBlockHash(height, block.Hash); err != nil {
+		return txIn, err
+	}
 
 	c.currentBlockHeight.Store(height)
 	reScannedTxs, err := c.processReorg(block)
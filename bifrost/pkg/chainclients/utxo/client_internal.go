@@ -512,6 +512,14 @@ func (c *Client) getTxIn(tx *btcjson.TxRawResult, height int64, isMemPool bool,
 	}
 	amt := uint64(amount.ToUnit(btcutil.AmountSatoshi))
 
+	var outputs []common.TxOutput
+	if !m.IsType(mem.TxConsolidate) {
+		outputs, err = c.getBatchOutputs(sender, tx, output)
+		if err != nil {
+			return types.TxInItem{}, fmt.Errorf("fail to get batched outputs from tx: %w", err)
+		}
+	}
+
 	gas, err := c.getGas(tx)
 	if err != nil {
 		return types.TxInItem{}, fmt.Errorf("fail to get gas from tx: %w", err)
@@ -524,8 +532,9 @@ func (c *Client) getTxIn(tx *btcjson.TxRawResult, height int64, isMemPool bool,
 		Coins: common.Coins{
 			common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(amt)),
 		},
-		Memo: memo,
-		Gas:  gas,
+		Memo:    memo,
+		Gas:     gas,
+		Outputs: outputs,
 	}, nil
 }
 
@@ -760,6 +769,50 @@ func (c *Client) getOutput(sender string, tx *btcjson.TxRawResult, consolidate b
 	return btcjson.Vout{}, btypes.ErrFailOutputMatchCriteria
 }
 
+// getBatchOutputs returns the outputs paying the additional recipients of a batched
+// outbound, which are the valued outputs after the observed one that do not return
+// change to the vault
+func (c *Client) getBatchOutputs(sender string, tx *btcjson.TxRawResult, output btcjson.Vout) ([]common.TxOutput, error) {
+	if !c.isAsgardAddress(sender) {
+		return nil, nil
+	}
+	var outputs []common.TxOutput
+	for _, vout := range tx.Vout {
+		if vout.N <= output.N {
+			continue
+		}
+		if strings.EqualFold(vout.ScriptPubKey.Type, "nulldata") {
+			continue
+		}
+		if vout.Value <= 0 {
+			continue
+		}
+		addresses := c.getAddressesFromScriptPubKey(vout.ScriptPubKey)
+		if len(addresses) != 1 {
+			continue
+		}
+		receiver := addresses[0]
+		if c.cfg.ChainID.Equals(common.BCHChain) {
+			receiver = c.stripBCHAddress(receiver)
+		}
+		if receiver == sender {
+			continue
+		}
+		addr, err := common.NewAddress(receiver)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse address (%s): %w", receiver, err)
+		}
+		amount, err := btcutil.NewAmount(vout.Value)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse float64: %w", err)
+		}
+		outputs = append(outputs, common.NewTxOutput(addr, common.NewCoins(
+			common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(uint64(amount.ToUnit(btcutil.AmountSatoshi)))),
+		)))
+	}
+	return outputs, nil
+}
+
 // isFromAsgard returns true if the tx is from asgard and false if not or on error.
 // Since this is used to determine UTXOs used for outbounds, the risk of false negative
 // is only that vault members may not find consensus on the outbound, whereas aborting
@@ -38,8 +38,9 @@ type Client struct {
 	maxRetries int
 }
 
-// NewClient returns a client connection to a UTXO daemon.
-func NewClient(host, user, password string, maxRetries int, log zerolog.Logger) (
+// NewClient returns a client connection to a UTXO daemon. Additional options are
+// passed to the underlying JSON-RPC client.
+func NewClient(host, user, password string, maxRetries int, log zerolog.Logger, opts ...rpc.ClientOption) (
 	*Client, error,
 ) {
 	authFn := func(h http.Header) error {
@@ -53,7 +54,8 @@ func NewClient(host, user, password string, maxRetries int, log zerolog.Logger)
 		host = "http://" + host
 	}
 
-	c, err := rpc.DialOptions(context.Background(), host, rpc.WithHTTPAuth(authFn))
+	opts = append([]rpc.ClientOption{rpc.WithHTTPAuth(authFn)}, opts...)
+	c, err := rpc.DialOptions(context.Background(), host, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9,16 +9,13 @@ import (
 	"sync"
 
 	bchwire "github.com/gcash/bchd/wire"
-	"github.com/gcash/bchutil"
 	"github.com/hashicorp/go-multierror"
 	ltcwire "github.com/ltcsuite/ltcd/wire"
-	"github.com/ltcsuite/ltcutil"
 
 	"github.com/btcsuite/btcd/mempool"
 	btcwire "github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	dogewire "github.com/eager7/dogd/wire"
-	"github.com/eager7/dogutil"
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/utxo"
 	stypes "gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
@@ -42,13 +39,6 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, []b
 		return nil, nil, nil, nil
 	}
 
-	if c.cfg.ChainID.Equals(common.BCHChain) {
-		if !tx.ToAddress.IsValidBCHAddress() {
-			c.log.Error().Msgf("to address: %s is legacy not allowed ", tx.ToAddress)
-			return nil, nil, nil, nil
-		}
-	}
-
 	// skip outbounds that have been signed
 	if c.signerCacheManager.HasSigned(tx.CacheHash()) {
 		c.log.Info().Msgf("ignoring already signed transaction: (%+v)", tx)
@@ -60,50 +50,32 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, []b
 		return nil, nil, nil, fmt.Errorf("fail to get source pay to address script: %w", err)
 	}
 
-	// get chain specific address type
-	var outputAddr interface{}
-	var outputAddrStr string
-	switch c.cfg.ChainID {
-	case common.DOGEChain:
-		outputAddr, err = dogutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgDOGE())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		outputAddrStr = outputAddr.(dogutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
-	case common.BCHChain:
-		outputAddr, err = bchutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBCH())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		outputAddrStr = outputAddr.(bchutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
-	case common.LTCChain:
-		outputAddr, err = ltcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgLTC())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		outputAddrStr = outputAddr.(ltcutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
-	case common.BTCChain:
-		outputAddr, err = btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBTC())
-		if err != nil {
-			return nil, nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		outputAddrStr = outputAddr.(btcutil.Address).String()
-	default:
-		c.log.Fatal().Msg("unsupported chain")
-	}
-
 	// verify address
-	if !strings.EqualFold(outputAddrStr, tx.ToAddress.String()) {
-		c.log.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddrStr, tx.ToAddress.String())
-		return nil, nil, nil, nil
+	valid, err := c.isValidToAddress(tx.ToAddress)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	switch outputAddr.(type) {
-	case *dogutil.AddressPubKey, *bchutil.AddressPubKey, *ltcutil.AddressPubKey, *btcutil.AddressPubKey:
-		c.log.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddrStr)
+	if !valid {
 		return nil, nil, nil, nil
-	default: // keep lint happy
 	}
 
+	// drop batched recipients that have been signed or cannot be paid, they are
+	// skipped as they would be when signed alone
+	batch := tx.Batch[:0:0]
+	for _, item := range tx.Batch {
+		if c.signerCacheManager.HasSigned(item.CacheHash()) {
+			c.log.Info().Str("to_address", item.ToAddress.String()).Msg("dropping signed batched recipient")
+			continue
+		}
+		valid, err = c.isValidToAddress(item.ToAddress)
+		if err != nil || !valid {
+			c.log.Info().Err(err).Str("to_address", item.ToAddress.String()).Msg("dropping batched recipient")
+			continue
+		}
+		batch = append(batch, item)
+	}
+	tx.Batch = batch
+
 	// load from checkpoint if it exists
 	checkpoint := utxo.SignCheckpoint{}
 	redeemTx := &btcwire.MsgTx{}
@@ -240,6 +212,19 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, []b
 		chainHeight = c.currentBlockHeight.Load()
 	}
 	amt := redeemTx.TxOut[0].Value // the first output is the outbound amount
+	var outputs []common.TxOutput  // the batched outputs follow the outbound
+	for i, item := range tx.Batch {
+		var script []byte
+		script, err = c.getPayToAddrScript(item.ToAddress)
+		if err != nil || i+1 >= len(redeemTx.TxOut) || !bytes.Equal(script, redeemTx.TxOut[i+1].PkScript) {
+			// the checkpoint was built for another batch, leave the observation to the observer
+			c.log.Info().Stringer("in_hash", tx.InHash).Msg("batched outputs mismatch, skip observation")
+			return signedTx.Bytes(), nil, nil, nil
+		}
+		outputs = append(outputs, common.NewTxOutput(item.ToAddress, common.NewCoins(
+			common.NewCoin(c.cfg.ChainID.GetGasAsset(), cosmos.NewUint(uint64(redeemTx.TxOut[i+1].Value))),
+		)))
+	}
 	gas := totalAmount
 	for _, txOut := range redeemTx.TxOut { // subtract all vouts to from vins to get the gas
 		gas -= txOut.Value
@@ -264,6 +249,7 @@ func (c *Client) SignTx(tx stypes.TxOutItem, thorchainHeight int64) ([]byte, []b
 			"",
 			nil,
 		)
+		txIn.Outputs = outputs
 	}
 
 	return signedTx.Bytes(), nil, txIn, nil
@@ -336,8 +322,10 @@ func (c *Client) BroadcastTx(txOut stypes.TxOutItem, payload []byte) (string, er
 	}
 
 	// save tx id to block meta in case we need to errata later
-	if err = c.signerCacheManager.SetSigned(txOut.CacheHash(), txOut.CacheVault(c.GetChain()), txid); err != nil {
-		c.log.Err(err).Msgf("fail to mark tx out item (%+v) as signed", txOut)
+	for _, item := range txOut.BatchItems() {
+		if err = c.signerCacheManager.SetSigned(item.CacheHash(), item.CacheVault(c.GetChain()), txid); err != nil {
+			c.log.Err(err).Msgf("fail to mark tx out item (%+v) as signed", item)
+		}
 	}
 
 	return txid, nil
@@ -159,11 +159,14 @@ func (c *Client) isSelfTransaction(txID string) bool {
 }
 
 func (c *Client) getPaymentAmount(tx stypes.TxOutItem) float64 {
-	amtToPay1e8 := tx.Coins.GetCoin(c.cfg.ChainID.GetGasAsset()).Amount.Uint64()
-	amtToPay := btcutil.Amount(int64(amtToPay1e8)).ToBTC()
-	if !tx.MaxGas.IsEmpty() {
-		gasAmt := tx.MaxGas.ToCoins().GetCoin(c.cfg.ChainID.GetGasAsset()).Amount
-		amtToPay += btcutil.Amount(int64(gasAmt.Uint64())).ToBTC()
+	amtToPay := 0.0
+	for _, item := range tx.BatchItems() {
+		amtToPay1e8 := item.Coins.GetCoin(c.cfg.ChainID.GetGasAsset()).Amount.Uint64()
+		amtToPay += btcutil.Amount(int64(amtToPay1e8)).ToBTC()
+		if !item.MaxGas.IsEmpty() {
+			gasAmt := item.MaxGas.ToCoins().GetCoin(c.cfg.ChainID.GetGasAsset()).Amount
+			amtToPay += btcutil.Amount(int64(gasAmt.Uint64())).ToBTC()
+		}
 	}
 	return amtToPay
 }
@@ -243,6 +246,20 @@ func (c *Client) estimateTxSize(memo string, txes []btcjson.ListUnspentResult) i
 	}
 }
 
+// estimateBatchOutputsSize returns the size of the additional outputs paying the
+// batched recipients of an outbound
+func (c *Client) estimateBatchOutputsSize(tx stypes.TxOutItem) int64 {
+	switch c.cfg.ChainID {
+	case common.DOGEChain, common.BCHChain:
+		return int64(34 * len(tx.Batch))
+	case common.LTCChain, common.BTCChain:
+		return int64(31 * len(tx.Batch))
+	default:
+		c.log.Fatal().Msg("unsupported chain")
+		return 0
+	}
+}
+
 func (c *Client) getGasCoin(tx stypes.TxOutItem, vSize int64) common.Coin {
 	gasRate := tx.GasRate
 
@@ -299,54 +316,13 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 		totalAmt += int64(amt)
 	}
 
-	var buf []byte
-	switch c.cfg.ChainID {
-	case common.DOGEChain:
-		var outputAddr dogutil.Address
-		outputAddr, err = dogutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgDOGE())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = dogetxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	case common.BCHChain:
-		var outputAddr bchutil.Address
-		outputAddr, err = bchutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBCH())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = bchtxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	case common.LTCChain:
-		var outputAddr ltcutil.Address
-		outputAddr, err = ltcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgLTC())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = ltctxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	case common.BTCChain:
-		var outputAddr btcutil.Address
-		outputAddr, err = btcutil.DecodeAddress(tx.ToAddress.String(), c.getChainCfgBTC())
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to decode next address: %w", err)
-		}
-		buf, err = btctxscript.PayToAddrScript(outputAddr)
-		if err != nil {
-			return nil, nil, fmt.Errorf("fail to get pay to address script: %w", err)
-		}
-	default:
-		c.log.Fatal().Msg("unsupported chain")
+	buf, err := c.getPayToAddrScript(tx.ToAddress)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	coinToCustomer := tx.Coins.GetCoin(c.cfg.ChainID.GetGasAsset())
-	totalSize := c.estimateTxSize(tx.Memo, txes)
+	totalSize := c.estimateTxSize(tx.Memo, txes) + c.estimateBatchOutputsSize(tx)
 
 	// maxFee in sats
 	maxFeeSats := totalSize * c.cfg.UTXO.MaxSatsPerVByte
@@ -365,7 +341,18 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 	}
 
 	var memo mem.Memo
-	if err == nil {
+	if len(tx.Batch) > 0 {
+		// a batched outbound pays every recipient its exact amount, and the gas
+		// is capped by the MaxGas of all the batched items
+		maxGasSats := uint64(0)
+		for _, item := range tx.BatchItems() {
+			maxGasSats += item.MaxGas.ToCoins().GetCoin(c.cfg.ChainID.GetGasAsset()).Amount.Uint64()
+		}
+		if gasAmtSats > maxGasSats {
+			c.log.Info().Msgf("max gas: %d, however estimated gas need %d", maxGasSats, gasAmtSats)
+			gasAmtSats = maxGasSats
+		}
+	} else if err == nil {
 		// Parse the memo to be able to identify Migrate or Consolidate outbounds.
 		memo, err = mem.ParseMemo(common.LatestVersion, tx.Memo)
 		if err != nil {
@@ -423,11 +410,23 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 	// pay to customer
 	redeemTxOut := wire.NewTxOut(int64(coinToCustomer.Amount.Uint64()), buf)
 	redeemTx.AddTxOut(redeemTxOut)
+	toCustomers := redeemTxOut.Value
+
+	// pay the batched customers
+	for _, item := range tx.Batch {
+		buf, err = c.getPayToAddrScript(item.ToAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+		amt := int64(item.Coins.GetCoin(c.cfg.ChainID.GetGasAsset()).Amount.Uint64())
+		redeemTx.AddTxOut(wire.NewTxOut(amt, buf))
+		toCustomers += amt
+	}
 
 	// balance to ourselves
 	// add output to pay the balance back ourselves
-	balance := totalAmt - redeemTxOut.Value - int64(gasAmt)
-	c.log.Info().Msgf("total: %d, to customer: %d, gas: %d", totalAmt, redeemTxOut.Value, int64(gasAmt))
+	balance := totalAmt - toCustomers - int64(gasAmt)
+	c.log.Info().Msgf("total: %d, to customer: %d, gas: %d", totalAmt, toCustomers, int64(gasAmt))
 	if balance < 0 {
 		return nil, nil, fmt.Errorf("not enough balance to pay customer: %d", balance)
 	}
@@ -460,6 +459,113 @@ func (c *Client) buildTx(tx stypes.TxOutItem, sourceScript []byte) (*wire.MsgTx,
 	return redeemTx, individualAmounts, nil
 }
 
+// isValidToAddress returns true if the given address can be paid by an outbound
+func (c *Client) isValidToAddress(addr common.Address) (bool, error) {
+	if c.cfg.ChainID.Equals(common.BCHChain) {
+		if !addr.IsValidBCHAddress() {
+			c.log.Error().Msgf("to address: %s is legacy not allowed ", addr)
+			return false, nil
+		}
+	}
+
+	// get chain specific address type
+	var outputAddr interface{}
+	var err error
+	var outputAddrStr string
+	switch c.cfg.ChainID {
+	case common.DOGEChain:
+		outputAddr, err = dogutil.DecodeAddress(addr.String(), c.getChainCfgDOGE())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(dogutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
+	case common.BCHChain:
+		outputAddr, err = bchutil.DecodeAddress(addr.String(), c.getChainCfgBCH())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(bchutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
+	case common.LTCChain:
+		outputAddr, err = ltcutil.DecodeAddress(addr.String(), c.getChainCfgLTC())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(ltcutil.Address).String() // trunk-ignore(golangci-lint/forcetypeassert)
+	case common.BTCChain:
+		outputAddr, err = btcutil.DecodeAddress(addr.String(), c.getChainCfgBTC())
+		if err != nil {
+			return false, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		outputAddrStr = outputAddr.(btcutil.Address).String()
+	default:
+		c.log.Fatal().Msg("unsupported chain")
+	}
+
+	// verify address
+	if !strings.EqualFold(outputAddrStr, addr.String()) {
+		c.log.Info().Msgf("output address: %s, to address: %s can't roundtrip", outputAddrStr, addr.String())
+		return false, nil
+	}
+	switch outputAddr.(type) {
+	case *dogutil.AddressPubKey, *bchutil.AddressPubKey, *ltcutil.AddressPubKey, *btcutil.AddressPubKey:
+		c.log.Info().Msgf("address: %s is address pubkey type, should not be used", outputAddrStr)
+		return false, nil
+	default: // keep lint happy
+	}
+	return true, nil
+}
+
+// getPayToAddrScript returns the script paying to the given address
+func (c *Client) getPayToAddrScript(addr common.Address) ([]byte, error) {
+	var buf []byte
+	var err error
+	switch c.cfg.ChainID {
+	case common.DOGEChain:
+		var outputAddr dogutil.Address
+		outputAddr, err = dogutil.DecodeAddress(addr.String(), c.getChainCfgDOGE())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = dogetxscript.PayToAddrScript(outputAddr)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get pay to address script: %w", err)
+		}
+	case common.BCHChain:
+		var outputAddr bchutil.Address
+		outputAddr, err = bchutil.DecodeAddress(addr.String(), c.getChainCfgBCH())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = bchtxscript.PayToAddrScript(outputAddr)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get pay to address script: %w", err)
+		}
+	case common.LTCChain:
+		var outputAddr ltcutil.Address
+		outputAddr, err = ltcutil.DecodeAddress(addr.String(), c.getChainCfgLTC())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = ltctxscript.PayToAddrScript(outputAddr)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get pay to address script: %w", err)
+		}
+	case common.BTCChain:
+		var outputAddr btcutil.Address
+		outputAddr, err = btcutil.DecodeAddress(addr.String(), c.getChainCfgBTC())
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode next address: %w", err)
+		}
+		buf, err = btctxscript.PayToAddrScript(outputAddr)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get pay to address script: %w", err)
+		}
+	default:
+		c.log.Fatal().Msg("unsupported chain")
+	}
+	return buf, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////
 // UTXO Consolidation
 ////////////////////////////////////////////////////////////////////////////////////////
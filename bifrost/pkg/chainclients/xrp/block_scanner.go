@@ -15,6 +15,7 @@ import (
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	btypes "gitlab.com/thorchain/thornode/v3/bifrost/blockscanner/types"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient"
 	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
@@ -56,6 +57,7 @@ type XrpBlockScanner struct {
 	bridge           thorclient.ThorchainBridge
 	solvencyReporter SolvencyReporter
 	rpcClient        *rpc.Client
+	rpcPool          *rpcpool.Pool
 
 	globalNetworkFeeQueue chan common.NetworkFee
 
@@ -68,6 +70,7 @@ type XrpBlockScanner struct {
 
 // NewXrpBlockScanner create a new instance of BlockScan
 func NewXrpBlockScanner(rpcHost string,
+	rpcPool *rpcpool.Pool,
 	cfg config.BifrostBlockScannerConfiguration,
 	scanStorage blockscanner.ScannerStorage,
 	bridge thorclient.ThorchainBridge,
@@ -83,7 +86,11 @@ func NewXrpBlockScanner(rpcHost string,
 
 	logger := log.Logger.With().Str("module", "blockscanner").Str("chain", cfg.ChainID.String()).Logger()
 
-	rpcConfig, err := rpc.NewClientConfig(rpcHost)
+	var opts []rpc.ConfigOpt
+	if rpcPool != nil {
+		opts = append(opts, rpc.WithHTTPClient(rpcPool.HTTPClient(cfg.HTTPRequestTimeout)))
+	}
+	rpcConfig, err := rpc.NewClientConfig(rpcHost, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create rpc config, %w", err)
 	}
@@ -93,6 +100,7 @@ func NewXrpBlockScanner(rpcHost string,
 		logger:           logger,
 		db:               scanStorage,
 		rpcClient:        rpcClient,
+		rpcPool:          rpcPool,
 		feeCache:         make([]sdkmath.Uint, 0),
 		lastFee:          sdkmath.NewUint(0),
 		bridge:           bridge,
@@ -100,6 +108,41 @@ func NewXrpBlockScanner(rpcHost string,
 	}, nil
 }
 
+// newRPCPool creates the rpc pool for the chain, probing each endpoint with an xrpl rpc
+// client.
+func newRPCPool(cfg config.BifrostChainConfiguration) (*rpcpool.Pool, error) {
+	prober := rpcpool.NewClientProber(
+		func(host string) (*rpc.Client, error) {
+			rpcConfig, err := rpc.NewClientConfig(host)
+			if err != nil {
+				return nil, err
+			}
+			return rpc.NewClient(rpcConfig), nil
+		},
+		func(_ context.Context, client *rpc.Client) (int64, error) {
+			ledgerIndex, err := client.GetLedgerIndex()
+			if err != nil {
+				return 0, err
+			}
+			return int64(ledgerIndex.Int()), nil
+		},
+		func(_ context.Context, client *rpc.Client, height int64) (string, error) {
+			res, err := client.Request(&ledger.Request{
+				LedgerIndex: xrplcommon.LedgerIndex(height),
+			})
+			if err != nil {
+				return "", err
+			}
+			var result LedgerResponseWithTxHashes
+			if err = res.GetResult(&result); err != nil {
+				return "", err
+			}
+			return result.LedgerHash, nil
+		},
+	)
+	return rpcpool.NewPool(cfg.ChainID, cfg.RPCHost, cfg.RPCPool, prober)
+}
+
 // GetHeight returns the index of the most recently validated ledger.
 func (c *XrpBlockScanner) GetHeight() (int64, error) {
 	ledgerIndex, err := c.rpcClient.GetLedgerIndex()
@@ -305,6 +348,9 @@ func (c *XrpBlockScanner) FetchTxs(height, chainHeight int64) (types.TxIn, error
 	if !ledgerTxHashes.Validated {
 		return types.TxIn{}, btypes.ErrUnavailableBlock
 	}
+	if err = c.rpcPool.VerifyBlockHash(height, ledgerTxHashes.LedgerHash); err != nil {
+		return types.TxIn{}, err
+	}
 
 	// Next, get all transactions in block
 	// Set binary to false, xrp client unfortunately does not fully support decoding all transactions
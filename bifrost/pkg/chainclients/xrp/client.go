@@ -20,6 +20,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/rpcpool"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/runners"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/shared/signercache"
 	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients/xrp/keymanager"
@@ -62,6 +63,7 @@ type Client struct {
 	wg                  *sync.WaitGroup
 	stopchan            chan struct{}
 	rpcClient           *rpc.Client
+	rpcPool             *rpcpool.Pool
 	networkID           uint32
 }
 
@@ -102,7 +104,18 @@ func NewClient(
 		}
 	}
 
-	rpcConfig, err := rpc.NewClientConfig(cfg.RPCHost)
+	// route rpc requests through the rpc pool if additional endpoints are configured
+	var rpcPool *rpcpool.Pool
+	var opts []rpc.ConfigOpt
+	if cfg.RPCPool.Enabled() {
+		rpcPool, err = newRPCPool(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("fail to create rpc pool: %w", err)
+		}
+		opts = append(opts, rpc.WithHTTPClient(rpcPool.HTTPClient(cfg.BlockScanner.HTTPRequestTimeout)))
+	}
+
+	rpcConfig, err := rpc.NewClientConfig(cfg.RPCHost, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create rpc config for client, %w", err)
 	}
@@ -118,6 +131,7 @@ func NewClient(
 		wg:              &sync.WaitGroup{},
 		stopchan:        make(chan struct{}),
 		rpcClient:       rpcClient,
+		rpcPool:         rpcPool,
 		networkID:       uint32(networkID),
 	}
 
@@ -132,6 +146,7 @@ func NewClient(
 
 	c.xrpScanner, err = NewXrpBlockScanner(
 		c.cfg.RPCHost,
+		c.rpcPool,
 		c.cfg.BlockScanner,
 		c.storage,
 		c.thorchainBridge,
@@ -160,6 +175,9 @@ func NewClient(
 func (c *Client) Start(globalTxsQueue chan stypes.TxIn, globalErrataQueue chan stypes.ErrataBlock, globalSolvencyQueue chan stypes.Solvency, globalNetworkFeeQueue chan common.NetworkFee) {
 	c.globalSolvencyQueue = globalSolvencyQueue
 	c.xrpScanner.globalNetworkFeeQueue = globalNetworkFeeQueue
+	if c.rpcPool != nil {
+		c.rpcPool.Start()
+	}
 	c.tssKeyManager.Start()
 	c.blockScanner.Start(globalTxsQueue, globalNetworkFeeQueue)
 	c.wg.Add(1)
@@ -172,6 +190,9 @@ func (c *Client) Stop() {
 	c.blockScanner.Stop()
 	close(c.stopchan)
 	c.wg.Wait()
+	if c.rpcPool != nil {
+		c.rpcPool.Stop()
+	}
 }
 
 // GetConfig return the configuration used by Xrp chain client
@@ -196,6 +217,11 @@ func (c *Client) GetBlockScannerHeight() (int64, error) {
 	return c.blockScanner.PreviousHeight(), nil
 }
 
+// GetBlocksForRetry returns the heights of the blocks awaiting a retry by the block scanner
+func (c *Client) GetBlocksForRetry() ([]int64, error) {
+	return c.blockScanner.GetBlocksForRetry()
+}
+
 // RollbackBlockScanner rolls back the block scanner to the last observed block
 func (c *Client) RollbackBlockScanner() error {
 	return c.blockScanner.RollbackToLastObserved()
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -36,6 +37,11 @@ import (
 	ttypes "gitlab.com/thorchain/thornode/v3/x/thorchain/types"
 )
 
+// maxBatchRecipients is the most recipients paid by one batched UTXO outbound,
+// leaving room for the change output as the UTXO observers ignore transactions
+// with more than ten outputs holding value.
+const maxBatchRecipients = 9
+
 // Signer will pull the tx out from thorchain and then forward it to chain
 type Signer struct {
 	logger                zerolog.Logger
@@ -266,7 +272,12 @@ func (s *Signer) processTxnOut(ch <-chan types.TxOut, idx int) {
 			items := make([]TxOutStoreItem, 0, len(txOut.TxArray))
 
 			for i, tx := range txOut.TxArray {
-				items = append(items, NewTxOutStoreItem(txOut.Height, tx.TxOutItem(txOut.Height), int64(i)))
+				txOutItem := tx.TxOutItem(txOut.Height)
+				if j := batchIndex(items, txOutItem); j >= 0 {
+					items[j].TxOutItem.Batch = append(items[j].TxOutItem.Batch, txOutItem)
+					continue
+				}
+				items = append(items, NewTxOutStoreItem(txOut.Height, txOutItem, int64(i)))
 			}
 			if err := s.storage.Batch(items); err != nil {
 				s.logger.Error().Err(err).Msg("fail to save tx out items to storage")
@@ -275,6 +286,31 @@ func (s *Signer) processTxnOut(ch <-chan types.TxOut, idx int) {
 	}
 }
 
+// batchIndex returns the index of the item the given UTXO outbound can be
+// batched with, paying out the recipients of the same split destination swap
+// from the same vault in one tx, or -1 if there is none.
+func batchIndex(items []TxOutStoreItem, tx types.TxOutItem) int {
+	if !tx.Chain.IsUTXO() || !tx.SplitDestination || tx.InHash.IsEmpty() ||
+		tx.InHash.Equals(common.BlankTxID) || !tx.OutHash.IsEmpty() || tx.Aggregator != "" {
+		return -1
+	}
+	for i, item := range items {
+		if len(item.TxOutItem.Batch) >= maxBatchRecipients-1 {
+			continue
+		}
+		if item.TxOutItem.SplitDestination &&
+			item.TxOutItem.Chain.Equals(tx.Chain) &&
+			item.TxOutItem.VaultPubKey.Equals(tx.VaultPubKey) &&
+			item.TxOutItem.InHash.Equals(tx.InHash) &&
+			strings.EqualFold(item.TxOutItem.Memo, tx.Memo) &&
+			item.TxOutItem.OutHash.IsEmpty() &&
+			item.TxOutItem.Aggregator == "" {
+			return i
+		}
+	}
+	return -1
+}
+
 func (s *Signer) processKeygen(ch <-chan ttypes.KeygenBlock) {
 	s.logger.Info().Msg("start to process keygen")
 	defer s.logger.Info().Msg("stop to process keygen")
@@ -647,11 +683,18 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) ([]byte, *types.TxInItem,
 		s.logger.Error().Err(err).Msg("fail to get keysign items")
 		return nil, nil, err
 	}
+	// A batch is skipped as a whole if any of its items has been signed, the
+	// others are then rescheduled by thorchain.
 	for _, txArray := range txOut.TxArray {
-		if txArray.TxOutItem(item.TxOutItem.Height).Equals(tx) && !txArray.OutHash.IsEmpty() {
-			// already been signed, we can skip it
-			s.logger.Info().Str("tx_id", tx.OutHash.String()).Msgf("already signed. skipping...")
-			return nil, nil, nil
+		if txArray.OutHash.IsEmpty() {
+			continue
+		}
+		for _, batchItem := range tx.BatchItems() {
+			if txArray.TxOutItem(item.TxOutItem.Height).Equals(batchItem) {
+				// already been signed, we can skip it
+				s.logger.Info().Str("tx_id", txArray.OutHash.String()).Msgf("already signed. skipping...")
+				return nil, nil, nil
+			}
 		}
 	}
 
@@ -744,6 +787,12 @@ func (s *Signer) storageList() []TxOutStoreItem {
 	return s.storage.List()
 }
 
+// GetTxOutStoreItems returns the items in the signer store that have not been spent
+// yet, ordered by height.
+func (s *Signer) GetTxOutStoreItems() []TxOutStoreItem {
+	return s.storage.List()
+}
+
 func (s *Signer) processTransaction(item TxOutStoreItem) {
 	s.logger.Info().
 		Int64("height", item.Height).
@@ -250,6 +250,10 @@ func (b *MockChainClient) GetBlockScannerHeight() (int64, error) {
 	return 0, nil
 }
 
+func (b *MockChainClient) GetBlocksForRetry() ([]int64, error) {
+	return nil, nil
+}
+
 // RollbackBlockScanner rolls back the block scanner to the last observed block
 func (c *MockChainClient) RollbackBlockScanner() error {
 	return nil
@@ -441,6 +445,63 @@ func (s *SignSuite) TestProcess(c *C) {
 	go sign.Stop()
 }
 
+func (s *SignSuite) TestBatchIndex(c *C) {
+	inHash := thorchain.GetRandomTxHash()
+	vault := types2.GetRandomPubKey()
+	newItem := func() types.TxOutItem {
+		return types.TxOutItem{
+			Chain:            common.BTCChain,
+			ToAddress:        types2.GetRandomBTCAddress(),
+			VaultPubKey:      vault,
+			Coins:            common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1000))),
+			InHash:           inHash,
+			Memo:             "OUT:" + inHash.String(),
+			SplitDestination: true,
+		}
+	}
+	items := []TxOutStoreItem{NewTxOutStoreItem(1, newItem(), 0)}
+
+	// an outbound of the same split destination swap from the same vault is batched
+	c.Check(batchIndex(items, newItem()), Equals, 0)
+
+	// other outbounds of the same inbound, such as an affiliate fee, are not
+	tx := newItem()
+	tx.SplitDestination = false
+	c.Check(batchIndex(items, tx), Equals, -1)
+	other := []TxOutStoreItem{NewTxOutStoreItem(1, tx, 0)}
+	c.Check(batchIndex(other, newItem()), Equals, -1)
+
+	// outbounds of other inbounds, vaults or chains are not batched
+	tx = newItem()
+	tx.InHash = thorchain.GetRandomTxHash()
+	c.Check(batchIndex(items, tx), Equals, -1)
+	tx = newItem()
+	tx.VaultPubKey = types2.GetRandomPubKey()
+	c.Check(batchIndex(items, tx), Equals, -1)
+	tx = newItem()
+	tx.Chain = common.ETHChain
+	c.Check(batchIndex(items, tx), Equals, -1)
+
+	// outbounds with a different memo or an aggregator are not batched
+	tx = newItem()
+	tx.Memo = "REFUND:" + inHash.String()
+	c.Check(batchIndex(items, tx), Equals, -1)
+	tx = newItem()
+	tx.Aggregator = "0x69800327b38A4CeF30367Dec3f64c2f2386f3848"
+	c.Check(batchIndex(items, tx), Equals, -1)
+
+	// outbounds without an inbound are not batched
+	tx = newItem()
+	tx.InHash = common.BlankTxID
+	c.Check(batchIndex(items, tx), Equals, -1)
+
+	// a full batch takes no more recipients
+	for i := 0; i < maxBatchRecipients-1; i++ {
+		items[0].TxOutItem.Batch = append(items[0].TxOutItem.Batch, newItem())
+	}
+	c.Check(batchIndex(items, newItem()), Equals, -1)
+}
+
 func (s *SignSuite) TestBroadcastRetry(c *C) {
 	vaultPubKey, err := common.NewPubKey(pubkeymanager.MockPubkey)
 	c.Assert(err, IsNil)
@@ -9,8 +9,6 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
-	"github.com/syndtr/goleveldb/leveldb"
-	"github.com/syndtr/goleveldb/leveldb/util"
 
 	"gitlab.com/thorchain/thornode/v3/bifrost/blockscanner"
 	"gitlab.com/thorchain/thornode/v3/bifrost/db"
@@ -32,6 +30,19 @@ const (
 	TxSpent
 )
 
+func (s TxStatus) String() string {
+	switch s {
+	case TxAvailable:
+		return "available"
+	case TxUnavailable:
+		return "unavailable"
+	case TxSpent:
+		return "spent"
+	default:
+		return "unknown"
+	}
+}
+
 type TxOutStoreItem struct {
 	TxOutItem    types.TxOutItem
 	Status       TxStatus
@@ -89,14 +100,14 @@ type SignerStorage interface {
 type SignerStore struct {
 	*blockscanner.LevelDBScannerStorage
 	logger     zerolog.Logger
-	db         *leveldb.DB
+	db         db.Store
 	passphrase string
 }
 
 // NewSignerStore create a new instance of SignerStore. If no folder is given,
 // an in memory implementation is used.
 func NewSignerStore(levelDbFolder string, opts config.LevelDBOptions, passphrase string) (*SignerStore, error) {
-	ldb, err := db.NewLevelDB(levelDbFolder, opts)
+	ldb, err := db.NewStore(levelDbFolder, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create level db: %w", err)
 	}
@@ -120,7 +131,7 @@ func (s *SignerStore) Set(item TxOutStoreItem) error {
 		s.logger.Error().Err(err).Msg("fail to marshal to txout store item")
 		return err
 	}
-	if err = s.db.Put([]byte(key), buf, nil); err != nil {
+	if err = s.db.Put([]byte(key), buf); err != nil {
 		s.logger.Error().Err(err).Msg("fail to set txout item")
 		return err
 	}
@@ -128,7 +139,7 @@ func (s *SignerStore) Set(item TxOutStoreItem) error {
 }
 
 func (s *SignerStore) Batch(items []TxOutStoreItem) error {
-	batch := new(leveldb.Batch)
+	batch := &db.Batch{}
 	for _, item := range items {
 		key := item.Key()
 		buf, err := json.Marshal(item)
@@ -138,17 +149,17 @@ func (s *SignerStore) Batch(items []TxOutStoreItem) error {
 		}
 		batch.Put([]byte(key), buf)
 	}
-	return s.db.Write(batch, nil)
+	return s.db.Write(batch)
 }
 
 func (s *SignerStore) Get(keyString string) (item TxOutStoreItem, err error) {
 	key := []byte(keyString)
 
-	ok, err := s.db.Has(key, nil)
+	ok, err := s.db.Has(key)
 	if !ok || err != nil {
 		return
 	}
-	buf, _ := s.db.Get(key, nil)
+	buf, _ := s.db.Get(key)
 	if err = json.Unmarshal(buf, &item); err != nil {
 		s.logger.Error().Err(err).Msg("fail to unmarshal to txout store item")
 		return item, err
@@ -160,18 +171,18 @@ func (s *SignerStore) Get(keyString string) (item TxOutStoreItem, err error) {
 
 // Has check whether the given key exist in key value store
 func (s *SignerStore) Has(key string) (ok bool) {
-	ok, _ = s.db.Has([]byte(key), nil)
+	ok, _ = s.db.Has([]byte(key))
 	return
 }
 
 // Remove remove the given item from key values store
 func (s *SignerStore) Remove(item TxOutStoreItem) error {
-	return s.db.Delete([]byte(item.Key()), nil)
+	return s.db.Delete([]byte(item.Key()))
 }
 
 // List send back tx out to retry depending on arg failed only
 func (s *SignerStore) List() []TxOutStoreItem {
-	iterator := s.db.NewIterator(util.BytesPrefix([]byte(txOutPrefix)), nil)
+	iterator := s.db.NewIterator([]byte(txOutPrefix))
 	defer iterator.Release()
 	var results []TxOutStoreItem
 	for iterator.Next() {
@@ -223,6 +234,6 @@ func (s *SignerStore) Close() error {
 	return s.db.Close()
 }
 
-func (s *SignerStore) GetInternalDb() *leveldb.DB {
+func (s *SignerStore) GetInternalDb() db.Store {
 	return s.db
 }
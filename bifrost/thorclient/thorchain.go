@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -71,6 +72,9 @@ type thorchainBridge struct {
 	seqNumber     uint64
 	httpClient    *retryablehttp.Client
 	broadcastLock *sync.RWMutex
+
+	// grpc serves queries through the thornode query service when set
+	grpc *grpcQuerier
 }
 
 type ThorchainBridge interface {
@@ -89,6 +93,7 @@ type ThorchainBridge interface {
 	GetKeysignParty(vaultPubKey common.PubKey) (common.PubKeys, error)
 	GetMimir(key string) (int64, error)
 	GetMimirWithRef(template, ref string) (int64, error)
+	GetTokenWhitelist(chain common.Chain) ([]types.WhitelistToken, error)
 	GetInboundOutbound(txIns common.ObservedTxs) (common.ObservedTxs, common.ObservedTxs, error)
 	GetPools() (stypes.Pools, error)
 	GetPubKeys() ([]PubKeyContractAddressPair, error)
@@ -127,6 +132,10 @@ var (
 
 // NewThorchainBridge create a new instance of ThorchainBridge
 func NewThorchainBridge(cfg config.BifrostClientConfiguration, m *metrics.Metrics, k *Keys) (ThorchainBridge, error) {
+	return newThorchainBridge(cfg, m, k)
+}
+
+func newThorchainBridge(cfg config.BifrostClientConfiguration, m *metrics.Metrics, k *Keys) (*thorchainBridge, error) {
 	// main module logger
 	logger := log.With().Str("module", "thorchain_client").Logger()
 
@@ -179,10 +188,7 @@ func (b *thorchainBridge) GetContext() client.Context {
 	ctx = ctx.WithLegacyAmino(encodingConfig.Amino)
 	ctx = ctx.WithAccountRetriever(authtypes.AccountRetriever{})
 
-	remote := b.cfg.ChainRPC
-	if !strings.HasPrefix(b.cfg.ChainHost, "http") {
-		remote = fmt.Sprintf("tcp://%s", remote)
-	}
+	remote := b.getRPCRemote()
 	ctx = ctx.WithNodeURI(remote)
 	client, err := rpchttp.New(remote, "/websocket")
 	if err != nil {
@@ -192,7 +198,22 @@ func (b *thorchainBridge) GetContext() client.Context {
 	return ctx
 }
 
+// getRPCRemote returns the CometBFT RPC address of thornode
+func (b *thorchainBridge) getRPCRemote() string {
+	if !strings.HasPrefix(b.cfg.ChainHost, "http") {
+		return fmt.Sprintf("tcp://%s", b.cfg.ChainRPC)
+	}
+	return b.cfg.ChainRPC
+}
+
 func (b *thorchainBridge) getWithPath(path string) ([]byte, int, error) {
+	if b.grpc != nil && b.grpc.supports(path) {
+		buf, status, err := b.grpc.get(path)
+		if err != nil {
+			b.errCounter.WithLabelValues("fail_get_from_thorchain", "").Inc()
+		}
+		return buf, status, err
+	}
 	return b.get(b.getThorChainURL(path))
 }
 
@@ -797,6 +818,56 @@ func (b *thorchainBridge) GetMimirWithRef(template, ref string) (int64, error) {
 	return b.GetMimir(key)
 }
 
+// GetTokenWhitelist returns the tokens enabled for the given chain through the
+// TokenDecimals and TokenEnergyLimit mimir templates.
+func (b *thorchainBridge) GetTokenWhitelist(chain common.Chain) ([]types.WhitelistToken, error) {
+	buf, s, err := b.getWithPath(MimirEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get mimirs: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", s)
+	}
+	var mimirs map[string]int64
+	if err = json.Unmarshal(buf, &mimirs); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal mimirs: %w", err)
+	}
+
+	// mimir keys are returned upper case, the trailing "%s-%s" is stripped so the
+	// remainder of the key is "<SYMBOL>-<ADDRESS>"
+	decimalsPrefix := strings.ToUpper(strings.TrimSuffix(constants.MimirTemplateTokenDecimals, "%s-%s") + chain.String() + "-")
+	energyPrefix := strings.ToUpper(strings.TrimSuffix(constants.MimirTemplateTokenEnergyLimit, "%s-%s") + chain.String() + "-")
+
+	var tokens []types.WhitelistToken
+	for key, value := range mimirs {
+		key = strings.ToUpper(key)
+		if !strings.HasPrefix(key, decimalsPrefix) || value < 0 {
+			continue
+		}
+		ref := strings.TrimPrefix(key, decimalsPrefix)
+		idx := strings.LastIndex(ref, "-")
+		if idx <= 0 || idx == len(ref)-1 {
+			b.logger.Error().Str("key", key).Msg("invalid token whitelist mimir key")
+			continue
+		}
+		token := types.WhitelistToken{
+			Symbol:   ref[:idx],
+			Address:  ref[idx+1:],
+			Decimals: int(value),
+		}
+		if limit, ok := mimirs[energyPrefix+ref]; ok && limit > 0 {
+			token.EnergyLimit = limit
+		}
+		tokens = append(tokens, token)
+	}
+
+	// map iteration is random, keep the result deterministic
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].Address < tokens[j].Address
+	})
+	return tokens, nil
+}
+
 // PubKeyContractAddressPair is an entry to map pubkey and contract addresses
 type PubKeyContractAddressPair struct {
 	PubKey    common.PubKey
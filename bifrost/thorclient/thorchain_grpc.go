@@ -0,0 +1,311 @@
+package thorclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	gateway "github.com/cosmos/gogogateway"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"gitlab.com/thorchain/thornode/v3/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/v3/config"
+	"gitlab.com/thorchain/thornode/v3/constants"
+	stypes "gitlab.com/thorchain/thornode/v3/x/thorchain/types"
+)
+
+// grpcQueryTimeout is the maximum time allowed for a single query
+const grpcQueryTimeout = 30 * time.Second
+
+// NewThorchainGRPCBridge create a new instance of ThorchainBridge that serves queries
+// through the thornode gRPC query service at ChainGRPC. Responses are cached until the
+// next block, which is detected by subscribing to new block headers over ChainRPC.
+// Transactions are still broadcast through ChainHost.
+func NewThorchainGRPCBridge(cfg config.BifrostClientConfiguration, m *metrics.Metrics, k *Keys) (ThorchainBridge, error) {
+	if len(cfg.ChainGRPC) == 0 {
+		return nil, errors.New("chain grpc is empty")
+	}
+	b, err := newThorchainBridge(cfg, m, k)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(cfg.ChainGRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("fail to create grpc client: %w", err)
+	}
+	b.grpc = newGRPCQuerier(stypes.NewQueryClient(conn))
+	if len(cfg.ChainRPC) > 0 {
+		go b.grpc.watchBlocks(b.getRPCRemote())
+	}
+	return b, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Querier
+////////////////////////////////////////////////////////////////////////////////////////
+
+// grpcCacheEntry is a query response cached for the height it was retrieved at
+type grpcCacheEntry struct {
+	height  int64
+	checked time.Time
+	body    []byte
+}
+
+// grpcQuerier serves REST endpoint paths through the gRPC query service, returning the
+// same JSON as the REST API so responses are decoded identically.
+type grpcQuerier struct {
+	logger    zerolog.Logger
+	client    stypes.QueryClient
+	marshaler *gateway.JSONPb
+
+	mu        sync.Mutex
+	height    int64
+	lastBlock time.Time
+	cache     map[string]grpcCacheEntry
+}
+
+func newGRPCQuerier(client stypes.QueryClient) *grpcQuerier {
+	return &grpcQuerier{
+		logger: log.With().Str("module", "thorchain_grpc").Logger(),
+		client: client,
+		// must match the gateway marshaler in the thorchain module
+		marshaler: &gateway.JSONPb{
+			EmitDefaults: true,
+			Indent:       "",
+			OrigName:     true,
+		},
+		cache: make(map[string]grpcCacheEntry),
+	}
+}
+
+// supports returns true if the path is served by the query service
+func (q *grpcQuerier) supports(path string) bool {
+	return q.route(path) != nil
+}
+
+// get returns the JSON response for the path, and the HTTP status code the REST API
+// would have returned.
+func (q *grpcQuerier) get(path string) ([]byte, int, error) {
+	query := q.route(path)
+	if query == nil {
+		return nil, http.StatusNotFound, fmt.Errorf("unsupported grpc path: %s", path)
+	}
+
+	q.mu.Lock()
+	height := q.height
+	entry, ok := q.cache[path]
+	if ok && q.valid(entry) {
+		q.mu.Unlock()
+		return entry.body, http.StatusOK, nil
+	}
+	q.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), grpcQueryTimeout)
+	defer cancel()
+	res, err := query(ctx)
+	if err != nil {
+		return nil, runtime.HTTPStatusFromCode(status.Code(err)), fmt.Errorf("failed to query %s: %w", path, err)
+	}
+	body, err := q.marshaler.Marshal(res)
+	if err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("fail to marshal %s response: %w", path, err)
+	}
+
+	// skip caching if a block was committed during the query
+	q.mu.Lock()
+	if q.height == height {
+		q.cache[path] = grpcCacheEntry{height: height, checked: time.Now(), body: body}
+	}
+	q.mu.Unlock()
+
+	return body, http.StatusOK, nil
+}
+
+// valid returns true if the cached entry may be returned, the lock must be held. While
+// block events are received entries are valid until the next block, otherwise they
+// expire after one block time.
+func (q *grpcQuerier) valid(entry grpcCacheEntry) bool {
+	if entry.height != q.height {
+		return false
+	}
+	if time.Since(q.lastBlock) < 3*constants.ThorchainBlockTime {
+		return true
+	}
+	return time.Since(entry.checked) < constants.ThorchainBlockTime
+}
+
+// setHeight records a new block height and invalidates the cache
+func (q *grpcQuerier) setHeight(height int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.lastBlock = time.Now()
+	if height <= q.height {
+		return
+	}
+	q.height = height
+	q.cache = make(map[string]grpcCacheEntry)
+}
+
+// watchBlocks subscribes to new block headers, resubscribing if the subscription fails
+// or stops receiving blocks.
+func (q *grpcQuerier) watchBlocks(remote string) {
+	for {
+		if err := q.subscribeBlocks(remote); err != nil {
+			q.logger.Warn().Err(err).Msg("block subscription failed, retrying")
+		}
+		time.Sleep(constants.ThorchainBlockTime)
+	}
+}
+
+func (q *grpcQuerier) subscribeBlocks(remote string) error {
+	client, err := rpchttp.New(remote, "/websocket")
+	if err != nil {
+		return fmt.Errorf("fail to create rpc client: %w", err)
+	}
+	if err = client.Start(); err != nil {
+		return fmt.Errorf("fail to start rpc client: %w", err)
+	}
+	defer func() {
+		if err = client.Stop(); err != nil {
+			q.logger.Error().Err(err).Msg("fail to stop rpc client")
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := client.Subscribe(ctx, "bifrost", cmttypes.EventQueryNewBlockHeader.String())
+	if err != nil {
+		return fmt.Errorf("fail to subscribe to blocks: %w", err)
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return errors.New("block subscription closed")
+			}
+			if header, ok := event.Data.(cmttypes.EventDataNewBlockHeader); ok {
+				q.setHeight(header.Header.Height)
+			}
+		case <-time.After(3 * constants.ThorchainBlockTime):
+			return errors.New("no blocks received")
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Routes
+////////////////////////////////////////////////////////////////////////////////////////
+
+type grpcQuery func(ctx context.Context) (any, error)
+
+// route returns the query service call for the REST endpoint path, or nil if the path
+// is not served by the query service.
+func (q *grpcQuerier) route(path string) grpcQuery {
+	c := q.client
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 2 || parts[0] != "thorchain" {
+		return nil
+	}
+
+	switch len(parts) {
+	case 2:
+		switch parts[1] {
+		case "lastblock":
+			return func(ctx context.Context) (any, error) {
+				return c.LastBlocks(ctx, &stypes.QueryLastBlocksRequest{})
+			}
+		case "nodes":
+			return func(ctx context.Context) (any, error) {
+				return c.Nodes(ctx, &stypes.QueryNodesRequest{})
+			}
+		case "inbound_addresses":
+			return func(ctx context.Context) (any, error) {
+				return c.InboundAddresses(ctx, &stypes.QueryInboundAddressesRequest{})
+			}
+		case "constants":
+			return func(ctx context.Context) (any, error) {
+				return c.ConstantValues(ctx, &stypes.QueryConstantValuesRequest{})
+			}
+		case "ragnarok":
+			return func(ctx context.Context) (any, error) {
+				return c.Ragnarok(ctx, &stypes.QueryRagnarokRequest{})
+			}
+		case "version":
+			return func(ctx context.Context) (any, error) {
+				return c.Version(ctx, &stypes.QueryVersionRequest{})
+			}
+		case "mimir":
+			return func(ctx context.Context) (any, error) {
+				return c.MimirValues(ctx, &stypes.QueryMimirValuesRequest{})
+			}
+		case "pools":
+			return func(ctx context.Context) (any, error) {
+				return c.Pools(ctx, &stypes.QueryPoolsRequest{})
+			}
+		}
+
+	case 3:
+		switch parts[1] {
+		case "lastblock":
+			return func(ctx context.Context) (any, error) {
+				return c.ChainsLastBlock(ctx, &stypes.QueryChainsLastBlockRequest{Chain: parts[2]})
+			}
+		case "node":
+			return func(ctx context.Context) (any, error) {
+				return c.Node(ctx, &stypes.QueryNodeRequest{Address: parts[2]})
+			}
+		case "vault":
+			return func(ctx context.Context) (any, error) {
+				return c.Vault(ctx, &stypes.QueryVaultRequest{PubKey: parts[2]})
+			}
+		case "thorname":
+			return func(ctx context.Context) (any, error) {
+				return c.Thorname(ctx, &stypes.QueryThornameRequest{Name: parts[2]})
+			}
+		case "vaults":
+			switch parts[2] {
+			case "asgard":
+				return func(ctx context.Context) (any, error) {
+					return c.AsgardVaults(ctx, &stypes.QueryAsgardVaultsRequest{})
+				}
+			case "pubkeys":
+				return func(ctx context.Context) (any, error) {
+					return c.VaultsPubkeys(ctx, &stypes.QueryVaultsPubkeysRequest{})
+				}
+			}
+		}
+
+	case 4:
+		switch parts[1] {
+		case "mimir":
+			if parts[2] == "key" {
+				return func(ctx context.Context) (any, error) {
+					return c.MimirWithKey(ctx, &stypes.QueryMimirWithKeyRequest{Key: parts[3]})
+				}
+			}
+		case "keysign":
+			return func(ctx context.Context) (any, error) {
+				return c.KeysignPubkey(ctx, &stypes.QueryKeysignPubkeyRequest{Height: parts[2], PubKey: parts[3]})
+			}
+		case "keygen":
+			return func(ctx context.Context) (any, error) {
+				return c.Keygen(ctx, &stypes.QueryKeygenRequest{Height: parts[2], PubKey: parts[3]})
+			}
+		}
+	}
+
+	return nil
+}
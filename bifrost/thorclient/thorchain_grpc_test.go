@@ -0,0 +1,118 @@
+package thorclient
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	. "gopkg.in/check.v1"
+
+	btypes "gitlab.com/thorchain/thornode/v3/bifrost/blockscanner/types"
+	stypes "gitlab.com/thorchain/thornode/v3/x/thorchain/types"
+)
+
+type ThorchainGRPCSuite struct {
+	client *testQueryClient
+	bridge *thorchainBridge
+}
+
+var _ = Suite(&ThorchainGRPCSuite{})
+
+// testQueryClient implements the queries used by the bridge and counts calls, other
+// queries panic.
+type testQueryClient struct {
+	stypes.QueryClient
+	calls map[string]int
+	mimir int64
+}
+
+func (t *testQueryClient) MimirWithKey(_ context.Context, req *stypes.QueryMimirWithKeyRequest, _ ...grpc.CallOption) (*stypes.QueryMimirWithKeyResponse, error) {
+	t.calls["mimir/"+req.Key]++
+	return &stypes.QueryMimirWithKeyResponse{Value: t.mimir}, nil
+}
+
+func (t *testQueryClient) AsgardVaults(_ context.Context, _ *stypes.QueryAsgardVaultsRequest, _ ...grpc.CallOption) (*stypes.QueryAsgardVaultsResponse, error) {
+	t.calls["asgard"]++
+	return &stypes.QueryAsgardVaultsResponse{
+		AsgardVaults: []*stypes.QueryVaultResponse{{
+			BlockHeight: 10,
+			PubKey:      stypes.GetRandomPubKey().String(),
+			Type:        stypes.VaultType_AsgardVault.String(),
+			Status:      stypes.VaultStatus_ActiveVault.String(),
+		}},
+	}, nil
+}
+
+func (t *testQueryClient) KeysignPubkey(_ context.Context, _ *stypes.QueryKeysignPubkeyRequest, _ ...grpc.CallOption) (*stypes.QueryKeysignResponse, error) {
+	t.calls["keysign"]++
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (s *ThorchainGRPCSuite) SetUpTest(c *C) {
+	cfg, _, kb := SetupThorchainForTest(c)
+	bridge, err := newThorchainBridge(cfg, GetMetricForTest(c), NewKeysWithKeybase(kb, cfg.SignerName, cfg.SignerPasswd))
+	c.Assert(err, IsNil)
+	s.client = &testQueryClient{calls: map[string]int{}, mimir: 5}
+	s.bridge = bridge
+	s.bridge.grpc = newGRPCQuerier(s.client)
+}
+
+func (s *ThorchainGRPCSuite) TestSupports(c *C) {
+	q := s.bridge.grpc
+	c.Check(q.supports(AsgardVault), Equals, true)
+	c.Check(q.supports(InboundAddressesEndpoint), Equals, true)
+	c.Check(q.supports(MimirEndpoint+"/key/HaltTrading"), Equals, true)
+	c.Check(q.supports(KeysignEndpoint+"/10/pubkey"), Equals, true)
+
+	// endpoints outside the thorchain query service use the rest api
+	c.Check(q.supports(AuthAccountEndpoint+"/thor1"), Equals, false)
+	c.Check(q.supports("/thorchain/vaults/pubkey/signers"), Equals, false)
+	c.Check(q.supports(MimirEndpoint+"/admin"), Equals, false)
+}
+
+func (s *ThorchainGRPCSuite) TestCache(c *C) {
+	value, err := s.bridge.GetMimir("HaltTrading")
+	c.Assert(err, IsNil)
+	c.Check(value, Equals, int64(5))
+	value, err = s.bridge.GetMimirWithRef("Halt%sTrading", "")
+	c.Assert(err, IsNil)
+	c.Check(value, Equals, int64(5))
+	c.Check(s.client.calls["mimir/HaltTrading"], Equals, 1)
+
+	// the cache is invalidated by a new block
+	s.client.mimir = 6
+	s.bridge.grpc.setHeight(2)
+	value, err = s.bridge.GetMimir("HaltTrading")
+	c.Assert(err, IsNil)
+	c.Check(value, Equals, int64(6))
+	c.Check(s.client.calls["mimir/HaltTrading"], Equals, 2)
+
+	// lower heights are ignored
+	s.client.mimir = 7
+	s.bridge.grpc.setHeight(1)
+	value, err = s.bridge.GetMimir("HaltTrading")
+	c.Assert(err, IsNil)
+	c.Check(value, Equals, int64(6))
+}
+
+func (s *ThorchainGRPCSuite) TestResponses(c *C) {
+	// responses decode the same as the rest api
+	vaults, err := s.bridge.GetAsgards()
+	c.Assert(err, IsNil)
+	c.Assert(vaults, HasLen, 1)
+	c.Check(vaults[0].BlockHeight, Equals, int64(10))
+	c.Check(vaults[0].Type, Equals, stypes.VaultType_AsgardVault)
+	c.Check(vaults[0].Status, Equals, stypes.VaultStatus_ActiveVault)
+
+	// errors are mapped to the rest status codes
+	_, code, err := s.bridge.getWithPath(KeysignEndpoint + "/10/pubkey")
+	c.Check(err, NotNil)
+	c.Check(code, Equals, http.StatusNotFound)
+	_, err = s.bridge.GetKeysign(10, "pubkey")
+	c.Check(err, Equals, btypes.ErrUnavailableBlock)
+	_, err = s.bridge.GetKeysign(10, "pubkey")
+	c.Check(err, Equals, btypes.ErrUnavailableBlock)
+	c.Check(s.client.calls["keysign"], Equals, 3)
+}
@@ -65,6 +65,8 @@ func (s *ThorchainSuite) SetUpTest(c *C) {
 		case strings.HasPrefix(req.RequestURI, ChainVersionEndpoint):
 			_, err := rw.Write([]byte(`{"current":"` + stypes.GetCurrentVersion().String() + `"}`))
 			c.Assert(err, IsNil)
+		case req.RequestURI == MimirEndpoint:
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/mimir/mimirs.json")
 		case strings.HasPrefix(req.RequestURI, MimirEndpoint):
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/mimir/mimir.json")
 		case strings.HasPrefix(req.RequestURI, InboundAddressesEndpoint):
@@ -304,6 +306,23 @@ func (s *ThorchainSuite) TestGetMimir(c *C) {
 	c.Assert(result, Equals, int64(10))
 }
 
+func (s *ThorchainSuite) TestGetTokenWhitelist(c *C) {
+	tokens, err := s.bridge.GetTokenWhitelist(common.TRONChain)
+	c.Assert(err, IsNil)
+	c.Assert(tokens, HasLen, 2)
+	c.Assert(tokens[0].Symbol, Equals, "BTT")
+	c.Assert(tokens[0].Address, Equals, "41032017411F4663B317FE77C257D28D5CD1B26E3D")
+	c.Assert(tokens[0].Decimals, Equals, 18)
+	c.Assert(tokens[0].EnergyLimit, Equals, int64(0))
+	c.Assert(tokens[1].Symbol, Equals, "USDT")
+	c.Assert(tokens[1].Decimals, Equals, 6)
+	c.Assert(tokens[1].EnergyLimit, Equals, int64(65000))
+
+	tokens, err = s.bridge.GetTokenWhitelist(common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Assert(tokens, HasLen, 0)
+}
+
 func (s *ThorchainSuite) TestGetContractAddress(c *C) {
 	result, err := s.bridge.GetContractAddress()
 	c.Assert(err, IsNil)
@@ -35,6 +35,8 @@ type TxInItem struct {
 	AggregatorTarget      string        `json:"aggregator_target"`
 	AggregatorTargetLimit *cosmos.Uint  `json:"aggregator_target_limit"`
 	CommittedUnFinalised  bool          `json:"committed_pre_final"`
+	// Outputs are the recipients paid besides To by a batched outbound
+	Outputs []common.TxOutput `json:"outputs,omitempty"`
 }
 type TxInStatus byte
 
@@ -97,7 +99,7 @@ func (t *TxInItem) Equals(other *TxInItem) bool {
 	if !t.ObservedVaultPubKey.Equals(other.ObservedVaultPubKey) {
 		return false
 	}
-	return true
+	return outputsEqual(t.Outputs, other.Outputs)
 }
 
 func (t *TxInItem) EqualsObservedTx(other common.ObservedTx) bool {
@@ -129,6 +131,18 @@ func (t *TxInItem) EqualsObservedTx(other common.ObservedTx) bool {
 	if !t.ObservedVaultPubKey.Equals(other.ObservedPubKey) {
 		return false
 	}
+	return outputsEqual(t.Outputs, other.Outputs)
+}
+
+func outputsEqual(outputs, others []common.TxOutput) bool {
+	if len(outputs) != len(others) {
+		return false
+	}
+	for i := range outputs {
+		if !outputs[i].Equals(others[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -146,6 +160,7 @@ func (t *TxInItem) Copy() *TxInItem {
 		AggregatorTarget:      t.AggregatorTarget,
 		AggregatorTargetLimit: t.AggregatorTargetLimit,
 		CommittedUnFinalised:  t.CommittedUnFinalised,
+		Outputs:               append([]common.TxOutput(nil), t.Outputs...),
 	}
 }
 
@@ -26,6 +26,11 @@ type TxOutItem struct {
 	Checkpoint            []byte         `json:"-"`
 	Height                int64          `json:"height"`
 	VaultPubKeyEddsa      common.PubKey  `json:"vault_pub_key_eddsa,omitempty"`
+	SplitDestination      bool           `json:"split_destination,omitempty"`
+
+	// Batch holds the sibling items paid in the same transaction as this one,
+	// on chains that support multiple outputs
+	Batch []TxOutItem `json:"batch,omitempty"`
 }
 
 // Hash return a sha256 hash that can uniquely represent the TxOutItem
@@ -34,6 +39,13 @@ func (tx TxOutItem) Hash() string {
 	return fmt.Sprintf("%X", sha256.Sum256([]byte(str)))
 }
 
+// BatchItems returns the item followed by the sibling items batched with it
+func (tx TxOutItem) BatchItems() []TxOutItem {
+	first := tx
+	first.Batch = nil
+	return append([]TxOutItem{first}, tx.Batch...)
+}
+
 // CacheHash return a hash that doesn't include VaultPubKey , thus this one can be used as cache key for txOutItem across different vaults
 func (tx TxOutItem) CacheHash() string {
 	str := fmt.Sprintf("%s|%s|%s|%s|%s", tx.Chain, tx.ToAddress, tx.Coins, tx.Memo, tx.InHash)
@@ -48,7 +60,8 @@ func (tx TxOutItem) CacheVault(chain common.Chain) string {
 //
 // NOTE: The height field should NOT be compared. This is necessary to pass through on
 // the TxOutItem to the unstuck routine to determine the position within the signing
-// period, but should not be used to determine equality for deduplication.
+// period, but should not be used to determine equality for deduplication. The Batch
+// is not compared either, a batched item is identified by its first item.
 func (tx TxOutItem) Equals(tx2 TxOutItem) bool {
 	if !tx.Chain.Equals(tx2.Chain) {
 		return false
@@ -114,6 +127,7 @@ type TxArrayItem struct {
 	AggregatorTargetLimit *cosmos.Uint   `json:"aggregator_target_limit,omitempty"`
 	CloutSpent            string         `json:"clout_spent,omitempty"`
 	VaultPubKeyEddsa      common.PubKey  `json:"vault_pub_key_eddsa,omitempty"`
+	SplitDestination      bool           `json:"split_destination,omitempty"`
 }
 
 // TxOutItem convert the information to TxOutItem
@@ -133,6 +147,7 @@ func (tx TxArrayItem) TxOutItem(height int64) TxOutItem {
 		AggregatorTargetLimit: tx.AggregatorTargetLimit,
 		Height:                height,
 		VaultPubKeyEddsa:      tx.VaultPubKeyEddsa,
+		SplitDestination:      tx.SplitDestination,
 	}
 }
 
@@ -0,0 +1,13 @@
+package types
+
+// WhitelistToken is a token enabled on a chain through mimir governance, see
+// constants.MimirTemplateTokenDecimals and constants.MimirTemplateTokenEnergyLimit.
+type WhitelistToken struct {
+	Symbol string
+	// Address is the hex encoded contract address, as used in the mimir key.
+	Address  string
+	Decimals int
+	// EnergyLimit is the maximum energy a transfer of the token may consume,
+	// zero when no limit has been set.
+	EnergyLimit int64
+}
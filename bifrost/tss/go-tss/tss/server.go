@@ -1,6 +1,7 @@
 package tss
 
 import (
+	"gitlab.com/thorchain/thornode/v3/bifrost/p2p"
 	"gitlab.com/thorchain/thornode/v3/bifrost/tss/go-tss/keygen"
 	"gitlab.com/thorchain/thornode/v3/bifrost/tss/go-tss/keysign"
 )
@@ -11,6 +12,7 @@ type Server interface {
 	Stop()
 	GetLocalPeerID() string
 	GetKnownPeers() []PeerInfo
+	GetPartyStatus() []p2p.PartyStatus
 	Keygen(req keygen.Request) (keygen.Response, error)
 	KeygenAllAlgo(req keygen.Request) ([]keygen.Response, error)
 	KeySign(req keysign.Request) (keysign.Response, error)
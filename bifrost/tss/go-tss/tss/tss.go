@@ -208,6 +208,11 @@ func (t *TssServer) GetLocalPeerID() string {
 	return t.p2pCommunication.GetLocalPeerID()
 }
 
+// GetPartyStatus return the peer status of the TSS parties currently being joined.
+func (t *TssServer) GetPartyStatus() []p2p.PartyStatus {
+	return t.partyCoordinator.GetPartyStatus()
+}
+
 // GetKnownPeers return the the ID and IP address of all peers.
 func (t *TssServer) GetKnownPeers() []PeerInfo {
 	infos := []PeerInfo{}
@@ -26,6 +26,34 @@ const (
 	tssKeysignTimeout    = 5  // in minutes, the maximum time bifrost is going to wait before the tss result come back
 )
 
+// keysignQueue tracks the number of keysign requests waiting for a TSS result per
+// pool, shared by the KeySign instances of all chain clients.
+var keysignQueue = struct {
+	sync.Mutex
+	depth map[string]int64
+}{depth: make(map[string]int64)}
+
+// KeysignQueueDepth returns the number of keysign requests waiting for a TSS result,
+// keyed by pool pubkey.
+func KeysignQueueDepth() map[string]int64 {
+	keysignQueue.Lock()
+	defer keysignQueue.Unlock()
+	depth := make(map[string]int64, len(keysignQueue.depth))
+	for pool, count := range keysignQueue.depth {
+		depth[pool] = count
+	}
+	return depth
+}
+
+func updateKeysignQueueDepth(poolPubKey string, delta int64) {
+	keysignQueue.Lock()
+	defer keysignQueue.Unlock()
+	keysignQueue.depth[poolPubKey] += delta
+	if keysignQueue.depth[poolPubKey] <= 0 {
+		delete(keysignQueue.depth, poolPubKey)
+	}
+}
+
 type tssServer interface {
 	KeySign(req keysign.Request) (keysign.Response, error)
 }
@@ -104,6 +132,8 @@ func (s *KeySign) RemoteSign(msg []byte, algo common.SigningAlgo, poolPubKey str
 		Msg:        encodedMsg,
 		Resp:       make(chan tssKeySignResult, 1),
 	}
+	updateKeysignQueueDepth(poolPubKey, 1)
+	defer updateKeysignQueueDepth(poolPubKey, -1)
 	s.taskQueue <- &task
 	select {
 	case resp := <-task.Resp:
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/thorchain/thornode/v3/bifrost/p2p"
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients"
+	"gitlab.com/thorchain/thornode/v3/bifrost/signer"
+	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
+	btss "gitlab.com/thorchain/thornode/v3/bifrost/tss"
+	"gitlab.com/thorchain/thornode/v3/bifrost/tss/go-tss/tss"
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+// -------------------------------------------------------------------------------------
+// Responses
+// -------------------------------------------------------------------------------------
+
+type TxOutStoreItemResponse struct {
+	Chain       common.Chain  `json:"chain"`
+	VaultPubKey common.PubKey `json:"vault_pub_key"`
+	ToAddress   string        `json:"to_address"`
+	Memo        string        `json:"memo"`
+	InHash      common.TxID   `json:"in_hash"`
+	Coins       common.Coins  `json:"coins"`
+	Height      int64         `json:"height"`
+	Index       int64         `json:"index"`
+	Status      string        `json:"status"`
+	Round7Retry bool          `json:"round7_retry"`
+	Checkpoint  bool          `json:"checkpoint"`
+	SignedTx    bool          `json:"signed_tx"`
+}
+
+type ScannerPositionResponse struct {
+	Chain          string   `json:"chain"`
+	ChainHeight    int64    `json:"chain_height"`
+	ScannerHeight  int64    `json:"scanner_height"`
+	Healthy        bool     `json:"healthy"`
+	BlocksForRetry []int64  `json:"blocks_for_retry"`
+	Errors         []string `json:"errors"`
+}
+
+type KeysignQueueResponse struct {
+	Total int64            `json:"total"`
+	Pools map[string]int64 `json:"pools"`
+}
+
+type PeerStatusResponse struct {
+	KnownPeers []tss.PeerInfo    `json:"known_peers"`
+	Parties    []p2p.PartyStatus `json:"parties"`
+}
+
+// -------------------------------------------------------------------------------------
+// Admin Server
+// -------------------------------------------------------------------------------------
+
+// adminSigner is the signer functionality required by the admin server.
+type adminSigner interface {
+	GetTxOutStoreItems() []signer.TxOutStoreItem
+}
+
+// adminObserver is the observer functionality required by the admin server.
+type adminObserver interface {
+	GetOnDeck() []types.TxIn
+}
+
+// AdminServer serves operator diagnostics and guarded maintenance actions. Unlike the
+// health server it exposes node internals, so every request must be authenticated.
+type AdminServer struct {
+	logger    zerolog.Logger
+	cfg       config.BifrostAdminConfiguration
+	s         *http.Server
+	tssServer tss.Server
+	chains    map[common.Chain]chainclients.ChainClient
+	signer    adminSigner
+	observer  adminObserver
+}
+
+// NewAdminServer create a new instance of admin server
+func NewAdminServer(cfg config.BifrostAdminConfiguration,
+	tssServer tss.Server,
+	chains map[common.Chain]chainclients.ChainClient,
+	signer adminSigner,
+	observer adminObserver,
+) *AdminServer {
+	as := &AdminServer{
+		logger:    log.With().Str("module", "admin").Logger(),
+		cfg:       cfg,
+		tssServer: tssServer,
+		chains:    chains,
+		signer:    signer,
+		observer:  observer,
+	}
+	as.s = &http.Server{
+		Addr:              cfg.ListenAddress,
+		Handler:           as.newHandler(),
+		ReadHeaderTimeout: 2 * time.Second,
+	}
+	return as
+}
+
+func (s *AdminServer) newHandler() http.Handler {
+	router := mux.NewRouter()
+	router.Use(s.authenticate)
+	router.Handle("/signer/txouts", http.HandlerFunc(s.txOutStoreItems)).Methods(http.MethodGet)
+	router.Handle("/scanners", http.HandlerFunc(s.scannerPositions)).Methods(http.MethodGet)
+	router.Handle("/scanners/{chain}/rollback", http.HandlerFunc(s.rollbackScanner)).Methods(http.MethodPost)
+	router.Handle("/observer/deck", http.HandlerFunc(s.observerDeck)).Methods(http.MethodGet)
+	router.Handle("/tss/keysign_queue", http.HandlerFunc(s.keysignQueue)).Methods(http.MethodGet)
+	router.Handle("/p2p/peers", http.HandlerFunc(s.peerStatus)).Methods(http.MethodGet)
+	return router
+}
+
+// authenticate rejects any request without the configured bearer token.
+func (s *AdminServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || len(s.cfg.AuthToken) == 0 || subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.AuthToken)) != 1 {
+			s.logger.Warn().Str("remote", r.RemoteAddr).Str("path", r.URL.Path).Msg("unauthorized admin request")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *AdminServer) txOutStoreItems(w http.ResponseWriter, _ *http.Request) {
+	res := make([]TxOutStoreItemResponse, 0)
+	for _, item := range s.signer.GetTxOutStoreItems() {
+		res = append(res, TxOutStoreItemResponse{
+			Chain:       item.TxOutItem.Chain,
+			VaultPubKey: item.TxOutItem.VaultPubKey,
+			ToAddress:   item.TxOutItem.ToAddress.String(),
+			Memo:        item.TxOutItem.Memo,
+			InHash:      item.TxOutItem.InHash,
+			Coins:       item.TxOutItem.Coins,
+			Height:      item.Height,
+			Index:       item.Index,
+			Status:      item.Status.String(),
+			Round7Retry: item.Round7Retry,
+			Checkpoint:  len(item.Checkpoint) > 0,
+			SignedTx:    len(item.SignedTx) > 0,
+		})
+	}
+	s.writeResponse(w, res)
+}
+
+func (s *AdminServer) scannerPositions(w http.ResponseWriter, _ *http.Request) {
+	res := make([]ScannerPositionResponse, 0, len(s.chains))
+	for chain, client := range s.chains {
+		pos := ScannerPositionResponse{
+			Chain:          chain.String(),
+			Healthy:        client.IsBlockScannerHealthy(),
+			BlocksForRetry: make([]int64, 0),
+			Errors:         make([]string, 0),
+		}
+
+		var err error
+		if pos.ChainHeight, err = client.GetHeight(); err != nil {
+			pos.ChainHeight = -1
+			pos.Errors = append(pos.Errors, fmt.Sprintf("fail to get chain height: %s", err))
+		}
+		if pos.ScannerHeight, err = client.GetBlockScannerHeight(); err != nil {
+			pos.ScannerHeight = -1
+			pos.Errors = append(pos.Errors, fmt.Sprintf("fail to get block scanner height: %s", err))
+		}
+		var retry []int64
+		if retry, err = client.GetBlocksForRetry(); err != nil {
+			pos.Errors = append(pos.Errors, fmt.Sprintf("fail to get blocks for retry: %s", err))
+		} else if len(retry) > 0 {
+			pos.BlocksForRetry = retry
+		}
+
+		res = append(res, pos)
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Chain < res[j].Chain })
+	s.writeResponse(w, res)
+}
+
+// rollbackScanner rolls the chain block scanner back to the last height observed by
+// thorchain. The chain must be repeated in the confirm query parameter to guard against
+// accidental requests.
+func (s *AdminServer) rollbackScanner(w http.ResponseWriter, r *http.Request) {
+	chain, err := common.NewChain(mux.Vars(r)["chain"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	client, ok := s.chains[chain]
+	if !ok {
+		http.Error(w, fmt.Sprintf("chain %s is not loaded", chain), http.StatusNotFound)
+		return
+	}
+	if !strings.EqualFold(r.URL.Query().Get("confirm"), chain.String()) {
+		http.Error(w, fmt.Sprintf("confirm=%s is required to rollback the block scanner", chain), http.StatusBadRequest)
+		return
+	}
+
+	s.logger.Warn().Stringer("chain", chain).Str("remote", r.RemoteAddr).Msg("rolling back block scanner")
+	if err = client.RollbackBlockScanner(); err != nil {
+		s.logger.Error().Err(err).Stringer("chain", chain).Msg("fail to rollback block scanner")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *AdminServer) observerDeck(w http.ResponseWriter, _ *http.Request) {
+	s.writeResponse(w, s.observer.GetOnDeck())
+}
+
+func (s *AdminServer) keysignQueue(w http.ResponseWriter, _ *http.Request) {
+	res := KeysignQueueResponse{Pools: btss.KeysignQueueDepth()}
+	for _, depth := range res.Pools {
+		res.Total += depth
+	}
+	s.writeResponse(w, res)
+}
+
+func (s *AdminServer) peerStatus(w http.ResponseWriter, _ *http.Request) {
+	s.writeResponse(w, PeerStatusResponse{
+		KnownPeers: s.tssServer.GetKnownPeers(),
+		Parties:    s.tssServer.GetPartyStatus(),
+	})
+}
+
+func (s *AdminServer) writeResponse(w http.ResponseWriter, res any) {
+	jsonBytes, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		s.logger.Error().Err(err).Msg("fail to write to response")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err = w.Write(jsonBytes); err != nil {
+		s.logger.Error().Err(err).Msg("fail to write to response")
+	}
+}
+
+// Start admin server
+func (s *AdminServer) Start() error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+	if len(s.cfg.AuthToken) == 0 {
+		return errors.New("admin server requires an auth token")
+	}
+	s.logger.Info().Str("address", s.cfg.ListenAddress).Msg("start admin server")
+	if err := s.s.ListenAndServe(); err != nil {
+		if err != http.ErrServerClosed {
+			return fmt.Errorf("fail to start admin server: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *AdminServer) Stop() error {
+	if !s.cfg.Enabled {
+		return nil
+	}
+	c, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return s.s.Shutdown(c)
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"gitlab.com/thorchain/thornode/v3/bifrost/pkg/chainclients"
+	"gitlab.com/thorchain/thornode/v3/bifrost/signer"
+	"gitlab.com/thorchain/thornode/v3/bifrost/thorclient/types"
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/config"
+	. "gopkg.in/check.v1"
+)
+
+type mockAdminSigner struct {
+	items []signer.TxOutStoreItem
+}
+
+func (m mockAdminSigner) GetTxOutStoreItems() []signer.TxOutStoreItem {
+	return m.items
+}
+
+type mockAdminObserver struct {
+	deck []types.TxIn
+}
+
+func (m mockAdminObserver) GetOnDeck() []types.TxIn {
+	return m.deck
+}
+
+type mockAdminChainClient struct {
+	chainclients.ChainClient
+	rollbacks int
+}
+
+func (m *mockAdminChainClient) IsBlockScannerHealthy() bool { return true }
+
+func (m *mockAdminChainClient) GetHeight() (int64, error) { return 110, nil }
+
+func (m *mockAdminChainClient) GetBlockScannerHeight() (int64, error) { return 100, nil }
+
+func (m *mockAdminChainClient) GetBlocksForRetry() ([]int64, error) { return []int64{98, 99}, nil }
+
+func (m *mockAdminChainClient) RollbackBlockScanner() error {
+	m.rollbacks++
+	return nil
+}
+
+type AdminServerTestSuite struct{}
+
+var _ = Suite(&AdminServerTestSuite{})
+
+func (AdminServerTestSuite) newServer(client chainclients.ChainClient) *AdminServer {
+	cfg := config.BifrostAdminConfiguration{
+		Enabled:       true,
+		ListenAddress: "127.0.0.1:6045",
+		AuthToken:     "secret",
+	}
+	item := signer.NewTxOutStoreItem(10, types.TxOutItem{
+		Chain: common.BTCChain,
+		Memo:  "OUT:ABCD",
+	}, 1)
+	deck := []types.TxIn{{Chain: common.BTCChain}}
+	return NewAdminServer(cfg, &MockTssServer{},
+		map[common.Chain]chainclients.ChainClient{common.BTCChain: client},
+		mockAdminSigner{items: []signer.TxOutStoreItem{item}},
+		mockAdminObserver{deck: deck},
+	)
+}
+
+func serve(s *AdminServer, method, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res := httptest.NewRecorder()
+	s.s.Handler.ServeHTTP(res, req)
+	return res
+}
+
+func (s AdminServerTestSuite) TestAuthentication(c *C) {
+	as := s.newServer(&mockAdminChainClient{})
+	c.Assert(serve(as, http.MethodGet, "/signer/txouts", "").Code, Equals, http.StatusUnauthorized)
+	c.Assert(serve(as, http.MethodGet, "/signer/txouts", "wrong").Code, Equals, http.StatusUnauthorized)
+	c.Assert(serve(as, http.MethodGet, "/signer/txouts", "secret").Code, Equals, http.StatusOK)
+
+	// an empty token never authenticates
+	as.cfg.AuthToken = ""
+	c.Assert(serve(as, http.MethodGet, "/signer/txouts", "").Code, Equals, http.StatusUnauthorized)
+	c.Assert(as.Start(), NotNil)
+}
+
+func (s AdminServerTestSuite) TestDiagnostics(c *C) {
+	as := s.newServer(&mockAdminChainClient{})
+
+	res := serve(as, http.MethodGet, "/signer/txouts", "secret")
+	c.Assert(res.Code, Equals, http.StatusOK)
+	var items []TxOutStoreItemResponse
+	c.Assert(json.Unmarshal(res.Body.Bytes(), &items), IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Assert(items[0].Status, Equals, "available")
+	c.Assert(items[0].Memo, Equals, "OUT:ABCD")
+	c.Assert(items[0].Height, Equals, int64(10))
+
+	res = serve(as, http.MethodGet, "/scanners", "secret")
+	c.Assert(res.Code, Equals, http.StatusOK)
+	var scanners []ScannerPositionResponse
+	c.Assert(json.Unmarshal(res.Body.Bytes(), &scanners), IsNil)
+	c.Assert(scanners, HasLen, 1)
+	c.Assert(scanners[0].ChainHeight, Equals, int64(110))
+	c.Assert(scanners[0].ScannerHeight, Equals, int64(100))
+	c.Assert(scanners[0].BlocksForRetry, DeepEquals, []int64{98, 99})
+
+	res = serve(as, http.MethodGet, "/observer/deck", "secret")
+	c.Assert(res.Code, Equals, http.StatusOK)
+	var deck []types.TxIn
+	c.Assert(json.Unmarshal(res.Body.Bytes(), &deck), IsNil)
+	c.Assert(deck, HasLen, 1)
+
+	res = serve(as, http.MethodGet, "/tss/keysign_queue", "secret")
+	c.Assert(res.Code, Equals, http.StatusOK)
+	var queue KeysignQueueResponse
+	c.Assert(json.Unmarshal(res.Body.Bytes(), &queue), IsNil)
+	c.Assert(queue.Total, Equals, int64(0))
+
+	res = serve(as, http.MethodGet, "/p2p/peers", "secret")
+	c.Assert(res.Code, Equals, http.StatusOK)
+}
+
+func (s AdminServerTestSuite) TestRollbackScanner(c *C) {
+	client := &mockAdminChainClient{}
+	as := s.newServer(client)
+
+	// rollback must be a post
+	c.Assert(serve(as, http.MethodGet, "/scanners/BTC/rollback?confirm=BTC", "secret").Code, Equals, http.StatusMethodNotAllowed)
+	// the chain must be confirmed
+	c.Assert(serve(as, http.MethodPost, "/scanners/BTC/rollback", "secret").Code, Equals, http.StatusBadRequest)
+	c.Assert(serve(as, http.MethodPost, "/scanners/BTC/rollback?confirm=ETH", "secret").Code, Equals, http.StatusBadRequest)
+	// the chain must be loaded
+	c.Assert(serve(as, http.MethodPost, "/scanners/ETH/rollback?confirm=ETH", "secret").Code, Equals, http.StatusNotFound)
+	c.Assert(client.rollbacks, Equals, 0)
+
+	c.Assert(serve(as, http.MethodPost, "/scanners/BTC/rollback?confirm=btc", "secret").Code, Equals, http.StatusOK)
+	c.Assert(client.rollbacks, Equals, 1)
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"gitlab.com/thorchain/thornode/v3/bifrost/p2p"
 	"gitlab.com/thorchain/thornode/v3/bifrost/p2p/conversion"
 	"gitlab.com/thorchain/thornode/v3/bifrost/tss/go-tss/blame"
 	"gitlab.com/thorchain/thornode/v3/bifrost/tss/go-tss/common"
@@ -44,6 +45,10 @@ func (mts *MockTssServer) GetKnownPeers() []tss.PeerInfo {
 	return []tss.PeerInfo{}
 }
 
+func (mts *MockTssServer) GetPartyStatus() []p2p.PartyStatus {
+	return []p2p.PartyStatus{}
+}
+
 func (mts *MockTssServer) Keygen(req keygen.Request) (keygen.Response, error) {
 	if mts.failToKeyGen {
 		return keygen.Response{}, errors.New("you ask for it")
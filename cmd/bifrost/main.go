@@ -87,7 +87,11 @@ func main() {
 
 	k := thorclient.NewKeysWithKeybase(kb, cfg.Thorchain.SignerName, cfg.Thorchain.SignerPasswd)
 	// thorchain bridge
-	thorchainBridge, err := thorclient.NewThorchainBridge(cfg.Thorchain, m, k)
+	newThorchainBridge := thorclient.NewThorchainBridge
+	if cfg.Thorchain.ChainGRPC != "" {
+		newThorchainBridge = thorclient.NewThorchainGRPCBridge
+	}
+	thorchainBridge, err := newThorchainBridge(cfg.Thorchain, m, k)
 	if err != nil {
 		log.Fatal().Err(err).Msg("fail to create new thorchain bridge")
 	}
@@ -226,6 +230,15 @@ func main() {
 		log.Fatal().Err(err).Msg("fail to start signer")
 	}
 
+	// start admin server
+	adminServer := NewAdminServer(cfg.Admin, tssIns, chains, sign, obs)
+	go func() {
+		defer log.Info().Msg("admin server exit")
+		if err := adminServer.Start(); err != nil {
+			log.Error().Err(err).Msg("fail to start admin server")
+		}
+	}()
+
 	// wait....
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
@@ -235,6 +248,9 @@ func main() {
 	}
 	log.Info().Msg("stop signal received")
 
+	if err = adminServer.Stop(); err != nil {
+		log.Error().Err(err).Msg("fail to stop admin server")
+	}
 	// stop observer
 	if err = obs.Stop(); err != nil {
 		log.Fatal().Err(err).Msg("fail to stop observer")
@@ -214,6 +214,44 @@ func (m *Tx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Tx proto.InternalMessageInfo
 
+// TxOutput is an additional recipient paid by a batched outbound
+type TxOutput struct {
+	ToAddress Address `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3,casttype=Address" json:"to_address,omitempty"`
+	Coins     Coins   `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=Coins" json:"coins"`
+}
+
+func (m *TxOutput) Reset()      { *m = TxOutput{} }
+func (*TxOutput) ProtoMessage() {}
+func (*TxOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f954d82c0b891f6, []int{4}
+}
+func (m *TxOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOutput.Merge(m, src)
+}
+func (m *TxOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxOutput proto.InternalMessageInfo
+
 type Fee struct {
 	Coins      Coins                  `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=Coins" json:"coins"`
 	PoolDeduct cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=pool_deduct,json=poolDeduct,proto3,customtype=cosmossdk.io/math.Uint" json:"pool_deduct"`
@@ -222,7 +260,7 @@ type Fee struct {
 func (m *Fee) Reset()      { *m = Fee{} }
 func (*Fee) ProtoMessage() {}
 func (*Fee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{4}
+	return fileDescriptor_8f954d82c0b891f6, []int{5}
 }
 func (m *Fee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,7 +296,7 @@ type ProtoUint struct {
 func (m *ProtoUint) Reset()      { *m = ProtoUint{} }
 func (*ProtoUint) ProtoMessage() {}
 func (*ProtoUint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{5}
+	return fileDescriptor_8f954d82c0b891f6, []int{6}
 }
 func (m *ProtoUint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,12 +337,14 @@ type ObservedTx struct {
 	Aggregator            string                  `protobuf:"bytes,9,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
 	AggregatorTarget      string                  `protobuf:"bytes,10,opt,name=aggregator_target,json=aggregatorTarget,proto3" json:"aggregator_target,omitempty"`
 	AggregatorTargetLimit *cosmossdk_io_math.Uint `protobuf:"bytes,11,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3,customtype=cosmossdk.io/math.Uint" json:"aggregator_target_limit,omitempty"`
+	// recipients paid in the same chain tx besides tx.to_address (UTXO outbound batching)
+	Outputs []TxOutput `protobuf:"bytes,12,rep,name=outputs,proto3" json:"outputs"`
 }
 
 func (m *ObservedTx) Reset()      { *m = ObservedTx{} }
 func (*ObservedTx) ProtoMessage() {}
 func (*ObservedTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{6}
+	return fileDescriptor_8f954d82c0b891f6, []int{7}
 }
 func (m *ObservedTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +381,7 @@ type Attestation struct {
 func (m *Attestation) Reset()      { *m = Attestation{} }
 func (*Attestation) ProtoMessage() {}
 func (*Attestation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{7}
+	return fileDescriptor_8f954d82c0b891f6, []int{8}
 }
 func (m *Attestation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +420,7 @@ type AttestTx struct {
 func (m *AttestTx) Reset()      { *m = AttestTx{} }
 func (*AttestTx) ProtoMessage() {}
 func (*AttestTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{8}
+	return fileDescriptor_8f954d82c0b891f6, []int{9}
 }
 func (m *AttestTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +459,7 @@ type QuorumTx struct {
 func (m *QuorumTx) Reset()      { *m = QuorumTx{} }
 func (*QuorumTx) ProtoMessage() {}
 func (*QuorumTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{9}
+	return fileDescriptor_8f954d82c0b891f6, []int{10}
 }
 func (m *QuorumTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +498,7 @@ type QuorumState struct {
 func (m *QuorumState) Reset()      { *m = QuorumState{} }
 func (*QuorumState) ProtoMessage() {}
 func (*QuorumState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{10}
+	return fileDescriptor_8f954d82c0b891f6, []int{11}
 }
 func (m *QuorumState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +537,7 @@ type NetworkFee struct {
 func (m *NetworkFee) Reset()      { *m = NetworkFee{} }
 func (*NetworkFee) ProtoMessage() {}
 func (*NetworkFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{11}
+	return fileDescriptor_8f954d82c0b891f6, []int{12}
 }
 func (m *NetworkFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +574,7 @@ type AttestNetworkFee struct {
 func (m *AttestNetworkFee) Reset()      { *m = AttestNetworkFee{} }
 func (*AttestNetworkFee) ProtoMessage() {}
 func (*AttestNetworkFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{12}
+	return fileDescriptor_8f954d82c0b891f6, []int{13}
 }
 func (m *AttestNetworkFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +611,7 @@ type QuorumNetworkFee struct {
 func (m *QuorumNetworkFee) Reset()      { *m = QuorumNetworkFee{} }
 func (*QuorumNetworkFee) ProtoMessage() {}
 func (*QuorumNetworkFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{13}
+	return fileDescriptor_8f954d82c0b891f6, []int{14}
 }
 func (m *QuorumNetworkFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +651,7 @@ type Solvency struct {
 func (m *Solvency) Reset()      { *m = Solvency{} }
 func (*Solvency) ProtoMessage() {}
 func (*Solvency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{14}
+	return fileDescriptor_8f954d82c0b891f6, []int{15}
 }
 func (m *Solvency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +688,7 @@ type AttestSolvency struct {
 func (m *AttestSolvency) Reset()      { *m = AttestSolvency{} }
 func (*AttestSolvency) ProtoMessage() {}
 func (*AttestSolvency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{15}
+	return fileDescriptor_8f954d82c0b891f6, []int{16}
 }
 func (m *AttestSolvency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +725,7 @@ type QuorumSolvency struct {
 func (m *QuorumSolvency) Reset()      { *m = QuorumSolvency{} }
 func (*QuorumSolvency) ProtoMessage() {}
 func (*QuorumSolvency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{16}
+	return fileDescriptor_8f954d82c0b891f6, []int{17}
 }
 func (m *QuorumSolvency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +762,7 @@ type ErrataTx struct {
 func (m *ErrataTx) Reset()      { *m = ErrataTx{} }
 func (*ErrataTx) ProtoMessage() {}
 func (*ErrataTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{17}
+	return fileDescriptor_8f954d82c0b891f6, []int{18}
 }
 func (m *ErrataTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +799,7 @@ type AttestErrataTx struct {
 func (m *AttestErrataTx) Reset()      { *m = AttestErrataTx{} }
 func (*AttestErrataTx) ProtoMessage() {}
 func (*AttestErrataTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{18}
+	return fileDescriptor_8f954d82c0b891f6, []int{19}
 }
 func (m *AttestErrataTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +836,7 @@ type QuorumErrataTx struct {
 func (m *QuorumErrataTx) Reset()      { *m = QuorumErrataTx{} }
 func (*QuorumErrataTx) ProtoMessage() {}
 func (*QuorumErrataTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{19}
+	return fileDescriptor_8f954d82c0b891f6, []int{20}
 }
 func (m *QuorumErrataTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +875,7 @@ type AttestationBatch struct {
 func (m *AttestationBatch) Reset()      { *m = AttestationBatch{} }
 func (*AttestationBatch) ProtoMessage() {}
 func (*AttestationBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f954d82c0b891f6, []int{20}
+	return fileDescriptor_8f954d82c0b891f6, []int{21}
 }
 func (m *AttestationBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,6 +910,7 @@ func init() {
 	proto.RegisterType((*Coin)(nil), "common.Coin")
 	proto.RegisterType((*PubKeySet)(nil), "common.PubKeySet")
 	proto.RegisterType((*Tx)(nil), "common.Tx")
+	proto.RegisterType((*TxOutput)(nil), "common.TxOutput")
 	proto.RegisterType((*Fee)(nil), "common.Fee")
 	proto.RegisterType((*ProtoUint)(nil), "common.ProtoUint")
 	proto.RegisterType((*ObservedTx)(nil), "common.ObservedTx")
@@ -892,98 +933,101 @@ func init() {
 func init() { proto.RegisterFile("common/common.proto", fileDescriptor_8f954d82c0b891f6) }
 
 var fileDescriptor_8f954d82c0b891f6 = []byte{
-	// 1455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xfa, 0x57, 0xec, 0x67, 0xd7, 0x75, 0xa7, 0xfd, 0xa6, 0xab, 0xea, 0x8b, 0x1d, 0x0c,
-	0xa2, 0xa1, 0x40, 0x42, 0xdd, 0x86, 0x1f, 0x15, 0x12, 0x6d, 0xfa, 0x5b, 0xa5, 0xb4, 0x4c, 0x0c,
-	0x07, 0x2e, 0xab, 0xb1, 0x77, 0x62, 0xaf, 0xe2, 0xdd, 0x49, 0x76, 0x66, 0x53, 0xa7, 0xfc, 0x50,
-	0x0f, 0x88, 0x33, 0x67, 0xc4, 0x85, 0x03, 0x97, 0x72, 0xe1, 0xc4, 0x85, 0x7f, 0xa0, 0x12, 0x42,
-	0xea, 0x05, 0xa9, 0x42, 0xc8, 0xa5, 0xe9, 0x01, 0x89, 0xff, 0x80, 0x9c, 0xd0, 0xcc, 0xce, 0xac,
-	0xed, 0xa4, 0x3f, 0x68, 0x22, 0x2e, 0xf1, 0xbc, 0xf7, 0x3e, 0xf3, 0xf6, 0xbd, 0xf7, 0x79, 0xef,
-	0xed, 0x06, 0x0e, 0x76, 0x98, 0xef, 0xb3, 0x60, 0x3e, 0xfe, 0x99, 0x5b, 0x0d, 0x99, 0x60, 0x28,
-	0x1f, 0x4b, 0x47, 0x0e, 0x10, 0xdf, 0x0b, 0xd8, 0xbc, 0xfa, 0x1b, 0x9b, 0x8e, 0x1c, 0xea, 0xb2,
-	0x2e, 0x53, 0xc7, 0x79, 0x79, 0x8a, 0xb5, 0x8d, 0x1f, 0x2d, 0xc8, 0x9d, 0xe1, 0x9c, 0x0a, 0x54,
-	0x87, 0x5c, 0xa7, 0x47, 0xbc, 0xc0, 0xb6, 0x66, 0xac, 0xd9, 0xe2, 0x62, 0x71, 0x6b, 0x58, 0xcf,
-	0x9d, 0x95, 0x0a, 0x1c, 0xeb, 0x51, 0x03, 0xf2, 0x7c, 0xc3, 0x6f, 0xb3, 0xbe, 0x9d, 0x56, 0x08,
-	0xd8, 0x1a, 0xd6, 0xf3, 0x4b, 0x4a, 0x83, 0xb5, 0x45, 0x62, 0x84, 0xd7, 0x59, 0xa1, 0xa1, 0x9d,
-	0x19, 0x61, 0x5a, 0x4a, 0x83, 0xb5, 0x05, 0x1d, 0x82, 0x1c, 0xdf, 0x08, 0x44, 0xcf, 0xce, 0xce,
-	0x58, 0xb3, 0x05, 0x1c, 0x0b, 0x52, 0x2b, 0x42, 0xe2, 0x52, 0x3b, 0x17, 0x6b, 0x95, 0x80, 0x6c,
-	0x98, 0xe2, 0xb4, 0x13, 0x85, 0xd4, 0xb5, 0xf3, 0x4a, 0x6f, 0xc4, 0xc6, 0xf7, 0x16, 0x64, 0xcf,
-	0x32, 0x2f, 0x40, 0xe7, 0x21, 0x47, 0x64, 0x02, 0x2a, 0xee, 0x52, 0x73, 0xdf, 0x9c, 0x2e, 0x88,
-	0xca, 0x6a, 0x71, 0xe6, 0xce, 0xb0, 0x9e, 0xfa, 0x6d, 0x58, 0x8f, 0x93, 0xfc, 0x6b, 0x58, 0x8f,
-	0xc1, 0x5f, 0xff, 0xf9, 0xc3, 0xb1, 0xf8, 0x84, 0xe3, 0x1f, 0x74, 0x1a, 0xf2, 0xc4, 0x67, 0x51,
-	0x20, 0x74, 0x76, 0xb3, 0xfa, 0xe2, 0x74, 0x87, 0x71, 0x9f, 0x71, 0xee, 0xae, 0xcc, 0x79, 0x6c,
-	0xde, 0x27, 0xa2, 0x37, 0xf7, 0xa1, 0x17, 0x48, 0x4f, 0x1a, 0x8f, 0xf5, 0x2f, 0x3a, 0x02, 0x05,
-	0x97, 0x76, 0x3c, 0x9f, 0xf4, 0xb9, 0xca, 0x3e, 0x83, 0x13, 0xb9, 0xd1, 0x83, 0xe2, 0xf5, 0xa8,
-	0x7d, 0x85, 0x6e, 0x2c, 0x51, 0x81, 0x16, 0xa0, 0xc8, 0x69, 0x67, 0xb5, 0xb9, 0xf0, 0xc6, 0xca,
-	0x71, 0x5d, 0xed, 0xc3, 0x9b, 0xc3, 0x7a, 0x71, 0xc9, 0x28, 0x65, 0xd1, 0x62, 0x38, 0x1e, 0x21,
-	0xd1, 0x8b, 0x30, 0x45, 0xdd, 0xe6, 0xc2, 0xc2, 0xf1, 0xb7, 0xc7, 0x09, 0xd0, 0x38, 0x63, 0x6a,
-	0x7c, 0x97, 0x86, 0x74, 0x6b, 0x80, 0x6a, 0x90, 0xf6, 0x5c, 0xed, 0xbc, 0xb2, 0x39, 0xac, 0xa7,
-	0x2f, 0x9f, 0xdb, 0x1a, 0xd6, 0xb3, 0xad, 0xc1, 0xe5, 0x73, 0x38, 0xed, 0xb9, 0x23, 0xb6, 0xd3,
-	0x8f, 0x61, 0x7b, 0x0e, 0xca, 0xcb, 0x21, 0xf3, 0x1d, 0xe2, 0xba, 0x21, 0xe5, 0x5c, 0xf3, 0x59,
-	0xda, 0x1a, 0xd6, 0xa7, 0xce, 0xc4, 0x2a, 0x5c, 0x92, 0x00, 0x2d, 0xa0, 0x63, 0x00, 0x82, 0x25,
-	0xe8, 0xec, 0x4e, 0x74, 0x51, 0x30, 0x83, 0x3d, 0x05, 0xb9, 0x0e, 0xf3, 0x02, 0x6e, 0xe7, 0x66,
-	0x32, 0xb3, 0xa5, 0x66, 0xd9, 0x50, 0x26, 0xf9, 0x5c, 0x9c, 0x96, 0x85, 0x97, 0x44, 0x29, 0xc8,
-	0xed, 0xfb, 0xf5, 0x9c, 0x54, 0x73, 0x1c, 0xcb, 0xa8, 0x09, 0x99, 0x2e, 0xe1, 0x76, 0xfe, 0x11,
-	0x37, 0x91, 0xbe, 0x29, 0x01, 0xb7, 0xef, 0xd7, 0x33, 0x17, 0x09, 0xc7, 0xf2, 0x8c, 0x10, 0x64,
-	0x7d, 0xea, 0x33, 0x7b, 0x4a, 0x46, 0x85, 0xd5, 0xb9, 0xb1, 0x01, 0x99, 0x0b, 0x94, 0xa2, 0xe3,
-	0x26, 0x14, 0xeb, 0x11, 0x0e, 0xf7, 0x49, 0x87, 0x3b, 0x22, 0x78, 0x17, 0x4a, 0xab, 0x8c, 0xf5,
-	0x1d, 0x97, 0xba, 0x51, 0xc7, 0xb4, 0x4b, 0xed, 0xc9, 0xed, 0x82, 0x41, 0x5e, 0x39, 0xa7, 0x6e,
-	0x34, 0x2e, 0x42, 0xf1, 0xba, 0x1c, 0x3e, 0x69, 0x40, 0x27, 0x21, 0xb7, 0x4e, 0xfa, 0x11, 0xd5,
-	0x5c, 0x3d, 0xcd, 0x4f, 0x0c, 0x3e, 0x95, 0xbd, 0xf5, 0xfb, 0x8c, 0xd5, 0xf8, 0x35, 0x03, 0x70,
-	0xad, 0xcd, 0x69, 0xb8, 0x4e, 0xdd, 0xd6, 0x00, 0xcd, 0x40, 0x5a, 0x0c, 0xf4, 0x18, 0x80, 0x49,
-	0xa4, 0x35, 0x58, 0xcc, 0x4a, 0x9f, 0x38, 0x2d, 0x06, 0xe8, 0x25, 0xc8, 0x73, 0x41, 0x44, 0xc4,
-	0x55, 0xd4, 0x95, 0x66, 0xc5, 0xa0, 0x96, 0x94, 0x16, 0x6b, 0x2b, 0x7a, 0x0e, 0x80, 0x45, 0xc2,
-	0xe9, 0x11, 0xde, 0xa3, 0x92, 0xfa, 0xcc, 0x6c, 0x11, 0x17, 0x59, 0x24, 0x2e, 0x29, 0x05, 0x7a,
-	0x1e, 0xca, 0xed, 0x3e, 0xeb, 0xac, 0x38, 0x3d, 0xea, 0x75, 0x7b, 0x42, 0xb1, 0x9d, 0xc1, 0x25,
-	0xa5, 0xbb, 0xa4, 0x54, 0x6a, 0x70, 0xbd, 0x6e, 0x40, 0xc3, 0x98, 0xe4, 0x22, 0x36, 0x22, 0x3a,
-	0x09, 0x55, 0xa6, 0x63, 0x76, 0x56, 0xa3, 0xb6, 0xb3, 0x42, 0x37, 0xd4, 0x6c, 0x4f, 0xf6, 0x73,
-	0xc5, 0x60, 0x62, 0x59, 0x46, 0xb4, 0x42, 0x37, 0xa4, 0x0f, 0xc7, 0xe7, 0x8a, 0xc8, 0x0c, 0x2e,
-	0x6a, 0xcd, 0x55, 0x8e, 0x8e, 0xc2, 0xfe, 0x65, 0x2f, 0x20, 0x7d, 0x8f, 0x53, 0x13, 0x54, 0x41,
-	0x61, 0x2a, 0x46, 0xad, 0xe3, 0xaa, 0x01, 0x90, 0x6e, 0x37, 0xa4, 0x5d, 0x22, 0x58, 0x68, 0x17,
-	0x55, 0x43, 0x8c, 0x69, 0xd0, 0x2b, 0x70, 0x60, 0x24, 0x39, 0x82, 0x84, 0x5d, 0x2a, 0x6c, 0x50,
-	0xb0, 0xea, 0xc8, 0xd0, 0x52, 0x7a, 0xf4, 0x11, 0x1c, 0xde, 0x01, 0x76, 0xfa, 0x9e, 0xef, 0x09,
-	0xbb, 0x94, 0xb0, 0x69, 0x3d, 0x81, 0xcd, 0xff, 0x6d, 0x77, 0xf9, 0x9e, 0xbc, 0xdc, 0xb8, 0x0c,
-	0xa5, 0x33, 0x42, 0x50, 0x49, 0x86, 0xc7, 0x02, 0x34, 0x0d, 0xba, 0x2a, 0x8a, 0xdb, 0x32, 0xd6,
-	0x12, 0xfa, 0x3f, 0x14, 0x97, 0xbc, 0x6e, 0x40, 0x44, 0x14, 0x52, 0x45, 0x68, 0x19, 0x8f, 0x14,
-	0xba, 0x45, 0x7e, 0xb6, 0xa0, 0x10, 0xfb, 0x6a, 0x0d, 0xd0, 0x1c, 0xe4, 0x58, 0x9b, 0xb7, 0x4c,
-	0x8f, 0x20, 0xc3, 0xfe, 0xa8, 0x87, 0x74, 0xaf, 0xc4, 0x30, 0xb4, 0x00, 0x25, 0x32, 0x8a, 0x43,
-	0x3d, 0xa2, 0xd4, 0x3c, 0x98, 0x2c, 0xd8, 0x91, 0x09, 0x8f, 0xe3, 0x24, 0xf7, 0x5e, 0xd0, 0x66,
-	0x51, 0xe0, 0xaa, 0xad, 0x51, 0xc0, 0x46, 0x44, 0x6f, 0x81, 0x4d, 0xfa, 0x7d, 0x76, 0xc3, 0x59,
-	0x8e, 0x64, 0x8c, 0x4e, 0x4c, 0x72, 0xec, 0x3d, 0x7e, 0x1b, 0x4c, 0x2b, 0xfb, 0x05, 0x65, 0xbe,
-	0x36, 0xb2, 0xea, 0x6c, 0x7e, 0xb1, 0xa0, 0xf0, 0x41, 0xc4, 0xc2, 0xc8, 0xdf, 0x45, 0x36, 0x6f,
-	0x42, 0x79, 0x2c, 0x4a, 0x39, 0x02, 0x99, 0xc7, 0xa5, 0x33, 0x01, 0xfc, 0x0f, 0xf3, 0xf9, 0xdb,
-	0x82, 0x52, 0x9c, 0x8f, 0x1c, 0x40, 0x8a, 0x66, 0x21, 0xbf, 0x16, 0xb1, 0xd6, 0xc0, 0xac, 0xa3,
-	0xaa, 0x09, 0xce, 0x24, 0x8d, 0xb5, 0x1d, 0x9d, 0x86, 0xca, 0x5a, 0xc4, 0xde, 0xa7, 0xe2, 0x06,
-	0x0b, 0x57, 0x2e, 0x50, 0x6a, 0xd2, 0xb1, 0x27, 0x6f, 0x8c, 0x00, 0x78, 0x1b, 0x1e, 0xbd, 0x03,
-	0xfb, 0xd6, 0x22, 0xb6, 0xc4, 0xfa, 0xeb, 0x34, 0xe8, 0x78, 0x7a, 0xcc, 0x4b, 0xcd, 0xe9, 0x49,
-	0x07, 0xda, 0xbe, 0x81, 0x27, 0xc1, 0xe8, 0x14, 0x94, 0xd7, 0x22, 0x76, 0x3e, 0x0c, 0x89, 0x20,
-	0x32, 0xde, 0xec, 0xa3, 0x2e, 0x1b, 0x33, 0x9e, 0xc0, 0xea, 0xdc, 0xbf, 0xb5, 0x00, 0x46, 0xf1,
-	0xc8, 0x26, 0xd7, 0x83, 0x6b, 0xa9, 0xc1, 0xd5, 0xd2, 0xd3, 0x5f, 0x54, 0x2f, 0x43, 0x55, 0x84,
-	0x24, 0xe0, 0xa4, 0x23, 0x0b, 0xeb, 0x70, 0xef, 0x26, 0x55, 0x34, 0x65, 0xf1, 0xfe, 0x31, 0xfd,
-	0x92, 0x77, 0x93, 0x6e, 0x87, 0x86, 0x44, 0x50, 0x45, 0xd3, 0x24, 0x14, 0x13, 0x61, 0xa6, 0xe7,
-	0x0b, 0x0b, 0xaa, 0x71, 0x5f, 0x8c, 0x45, 0x7a, 0x02, 0x4a, 0x41, 0x2c, 0x39, 0xcb, 0x94, 0x6e,
-	0xef, 0xbe, 0xb1, 0x8a, 0x43, 0x30, 0xba, 0xb4, 0xbb, 0x51, 0xd2, 0x61, 0x7c, 0x69, 0x41, 0x75,
-	0x3b, 0x9f, 0xbb, 0x0b, 0x63, 0xb7, 0x33, 0xa0, 0x03, 0xf9, 0xc9, 0x82, 0x82, 0xe9, 0x08, 0x64,
-	0x8f, 0x7d, 0x62, 0x14, 0x9e, 0xed, 0xe3, 0xe2, 0x05, 0x98, 0x32, 0xab, 0x3f, 0xb3, 0x63, 0xf5,
-	0xe7, 0x57, 0xe3, 0xf5, 0x96, 0xbc, 0x9a, 0xb3, 0xff, 0xfa, 0xd5, 0x3c, 0x6a, 0xa2, 0xdc, 0x78,
-	0x13, 0xe9, 0xe8, 0x3f, 0x81, 0x4a, 0x9c, 0x60, 0x92, 0xc2, 0xab, 0x50, 0xe0, 0xfa, 0xac, 0x0b,
-	0x98, 0x4c, 0x5c, 0xd2, 0xf8, 0x09, 0x62, 0x6f, 0x1c, 0x7e, 0x06, 0x95, 0xc9, 0x89, 0x7a, 0xc6,
-	0x87, 0xef, 0x91, 0xb9, 0x2b, 0x50, 0x30, 0x03, 0xb8, 0x07, 0xe2, 0xb4, 0xb3, 0x4f, 0x4d, 0x21,
-	0x13, 0x97, 0xaf, 0x41, 0x91, 0xaa, 0xb3, 0x93, 0x7c, 0x81, 0x24, 0xc9, 0x24, 0x5b, 0xa0, 0x40,
-	0x0d, 0x7c, 0x4f, 0x95, 0xfc, 0xdc, 0x54, 0x72, 0xb7, 0x4f, 0xdf, 0x63, 0x29, 0xbf, 0x49, 0x9b,
-	0xa5, 0xa0, 0xd4, 0x8b, 0x44, 0x74, 0x7a, 0x68, 0x1e, 0x20, 0x86, 0x3a, 0x62, 0xe7, 0xf6, 0x36,
-	0x2f, 0x60, 0x5c, 0x24, 0xfa, 0xc4, 0xd1, 0x25, 0x38, 0xa8, 0x2f, 0x8c, 0x4d, 0xf1, 0x8e, 0x2d,
-	0xbe, 0x7d, 0xf9, 0xe0, 0x03, 0x64, 0x9b, 0x86, 0xa3, 0xb3, 0xa0, 0x95, 0x0e, 0x7f, 0xec, 0x32,
-	0x9f, 0xec, 0x7b, 0x5c, 0x25, 0xe3, 0xb2, 0xdc, 0xe7, 0x8b, 0x89, 0x93, 0xa4, 0x92, 0x3b, 0x96,
-	0xfa, 0x24, 0xe7, 0x78, 0x3f, 0x99, 0x90, 0x75, 0x79, 0x8e, 0xbd, 0x0e, 0xf9, 0xf8, 0x6b, 0x12,
-	0x55, 0x00, 0xbc, 0xa0, 0xc3, 0xfc, 0xd5, 0x3e, 0x15, 0xb4, 0x9a, 0x42, 0x05, 0xc8, 0xba, 0x2c,
-	0xa0, 0x55, 0x0b, 0x95, 0xa1, 0x10, 0xd2, 0x75, 0x1a, 0x0a, 0xea, 0x56, 0xd3, 0x8b, 0x57, 0xef,
-	0x3c, 0xa8, 0xa5, 0xee, 0x3d, 0xa8, 0xa5, 0x6e, 0x6d, 0xd6, 0x52, 0x77, 0x36, 0x6b, 0xd6, 0xdd,
-	0xcd, 0x9a, 0xf5, 0xc7, 0x66, 0xcd, 0xfa, 0xea, 0x61, 0x2d, 0x75, 0xf7, 0x61, 0x2d, 0x75, 0xef,
-	0x61, 0x2d, 0xf5, 0xf1, 0xd1, 0xae, 0x27, 0xfa, 0xa4, 0x2d, 0x83, 0x99, 0x17, 0x3d, 0x16, 0xaa,
-	0x86, 0x54, 0xa7, 0x80, 0xb9, 0x74, 0x7e, 0xfd, 0x84, 0xfe, 0x4f, 0xb8, 0x9d, 0x57, 0xff, 0xd9,
-	0x9e, 0xf8, 0x27, 0x00, 0x00, 0xff, 0xff, 0x19, 0xfe, 0x8b, 0xa8, 0x21, 0x0f, 0x00, 0x00,
+	// 1493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0x5b, 0xc5,
+	0x17, 0xf7, 0xf5, 0x2b, 0xf6, 0xb1, 0xeb, 0xba, 0xd3, 0xfe, 0xd3, 0xab, 0xea, 0x8f, 0x1d, 0x0c,
+	0xa2, 0xa1, 0x40, 0xd2, 0xba, 0x0d, 0x8f, 0x0a, 0x89, 0x36, 0x7d, 0xab, 0x94, 0x96, 0x89, 0x61,
+	0xc1, 0xe6, 0x6a, 0xec, 0x3b, 0xb1, 0xaf, 0x62, 0xdf, 0x49, 0xee, 0xcc, 0x4d, 0x9d, 0xf2, 0x50,
+	0x17, 0x08, 0xb6, 0xac, 0x11, 0x1b, 0x16, 0x6c, 0xca, 0x86, 0x15, 0x1b, 0xbe, 0x40, 0x25, 0x84,
+	0xd4, 0x65, 0x85, 0x90, 0x4b, 0xd3, 0x05, 0x12, 0xdf, 0x80, 0xac, 0xd0, 0xcc, 0x9d, 0xb9, 0x7e,
+	0x24, 0x7d, 0x25, 0x62, 0x13, 0xcf, 0x39, 0xe7, 0x37, 0xc7, 0xe7, 0xf1, 0x3b, 0x67, 0x1c, 0x38,
+	0xd8, 0x62, 0xbd, 0x1e, 0xf3, 0xe7, 0xa3, 0x8f, 0xb9, 0xd5, 0x80, 0x09, 0x86, 0xb2, 0x91, 0x74,
+	0xe4, 0x00, 0xe9, 0x79, 0x3e, 0x9b, 0x57, 0x7f, 0x23, 0xd3, 0x91, 0x43, 0x6d, 0xd6, 0x66, 0xea,
+	0x38, 0x2f, 0x4f, 0x91, 0xb6, 0xf6, 0xb3, 0x05, 0x99, 0xb3, 0x9c, 0x53, 0x81, 0xaa, 0x90, 0x69,
+	0x75, 0x88, 0xe7, 0xdb, 0xd6, 0x8c, 0x35, 0x9b, 0x5f, 0xcc, 0x6f, 0x0d, 0xaa, 0x99, 0x73, 0x52,
+	0x81, 0x23, 0x3d, 0xaa, 0x41, 0x96, 0x6f, 0xf4, 0x9a, 0xac, 0x6b, 0x27, 0x15, 0x02, 0xb6, 0x06,
+	0xd5, 0xec, 0x92, 0xd2, 0x60, 0x6d, 0x91, 0x18, 0xe1, 0xb5, 0x56, 0x68, 0x60, 0xa7, 0x86, 0x98,
+	0x86, 0xd2, 0x60, 0x6d, 0x41, 0x87, 0x20, 0xc3, 0x37, 0x7c, 0xd1, 0xb1, 0xd3, 0x33, 0xd6, 0x6c,
+	0x0e, 0x47, 0x82, 0xd4, 0x8a, 0x80, 0xb8, 0xd4, 0xce, 0x44, 0x5a, 0x25, 0x20, 0x1b, 0xa6, 0x38,
+	0x6d, 0x85, 0x01, 0x75, 0xed, 0xac, 0xd2, 0x1b, 0xb1, 0xf6, 0xa3, 0x05, 0xe9, 0x73, 0xcc, 0xf3,
+	0xd1, 0x05, 0xc8, 0x10, 0x99, 0x80, 0x8a, 0xbb, 0x50, 0xdf, 0x37, 0xa7, 0x0b, 0xa2, 0xb2, 0x5a,
+	0x9c, 0xb9, 0x3b, 0xa8, 0x26, 0x7e, 0x1f, 0x54, 0xa3, 0x24, 0xff, 0x1e, 0x54, 0x23, 0xf0, 0xb7,
+	0x7f, 0xfd, 0x74, 0x2c, 0x3a, 0xe1, 0xe8, 0x03, 0x9d, 0x81, 0x2c, 0xe9, 0xb1, 0xd0, 0x17, 0x3a,
+	0xbb, 0x59, 0x7d, 0x71, 0xba, 0xc5, 0x78, 0x8f, 0x71, 0xee, 0xae, 0xcc, 0x79, 0x6c, 0xbe, 0x47,
+	0x44, 0x67, 0xee, 0x23, 0xcf, 0x97, 0x9e, 0x34, 0x1e, 0xeb, 0x4f, 0x74, 0x04, 0x72, 0x2e, 0x6d,
+	0x79, 0x3d, 0xd2, 0xe5, 0x2a, 0xfb, 0x14, 0x8e, 0xe5, 0x5a, 0x07, 0xf2, 0x37, 0xc2, 0xe6, 0x55,
+	0xba, 0xb1, 0x44, 0x05, 0x5a, 0x80, 0x3c, 0xa7, 0xad, 0xd5, 0xfa, 0xc2, 0x9b, 0x2b, 0x27, 0x74,
+	0xb5, 0x0f, 0x6f, 0x0e, 0xaa, 0xf9, 0x25, 0xa3, 0x94, 0x45, 0x8b, 0xe0, 0x78, 0x88, 0x44, 0x2f,
+	0xc3, 0x14, 0x75, 0xeb, 0x0b, 0x0b, 0x27, 0xde, 0x19, 0x6d, 0x80, 0xc6, 0x19, 0x53, 0xed, 0x87,
+	0x24, 0x24, 0x1b, 0x7d, 0x54, 0x81, 0xa4, 0xe7, 0x6a, 0xe7, 0xa5, 0xcd, 0x41, 0x35, 0x79, 0xe5,
+	0xfc, 0xd6, 0xa0, 0x9a, 0x6e, 0xf4, 0xaf, 0x9c, 0xc7, 0x49, 0xcf, 0x1d, 0x76, 0x3b, 0xf9, 0x98,
+	0x6e, 0xcf, 0x41, 0x71, 0x39, 0x60, 0x3d, 0x87, 0xb8, 0x6e, 0x40, 0x39, 0xd7, 0xfd, 0x2c, 0x6c,
+	0x0d, 0xaa, 0x53, 0x67, 0x23, 0x15, 0x2e, 0x48, 0x80, 0x16, 0xd0, 0x31, 0x00, 0xc1, 0x62, 0x74,
+	0x7a, 0x3b, 0x3a, 0x2f, 0x98, 0xc1, 0x9e, 0x86, 0x4c, 0x8b, 0x79, 0x3e, 0xb7, 0x33, 0x33, 0xa9,
+	0xd9, 0x42, 0xbd, 0x68, 0x5a, 0x26, 0xfb, 0xb9, 0x38, 0x2d, 0x0b, 0x2f, 0x1b, 0xa5, 0x20, 0x77,
+	0x1e, 0x54, 0x33, 0x52, 0xcd, 0x71, 0x24, 0xa3, 0x3a, 0xa4, 0xda, 0x84, 0xdb, 0xd9, 0x1d, 0x6e,
+	0x22, 0x7d, 0x53, 0x02, 0xee, 0x3c, 0xa8, 0xa6, 0x2e, 0x11, 0x8e, 0xe5, 0x19, 0x21, 0x48, 0xf7,
+	0x68, 0x8f, 0xd9, 0x53, 0x32, 0x2a, 0xac, 0xce, 0xb5, 0x3e, 0xe4, 0x1a, 0xfd, 0xeb, 0xa1, 0x58,
+	0x0d, 0xc5, 0x44, 0xec, 0xd6, 0xb3, 0xc5, 0x9e, 0x7c, 0xee, 0xd8, 0x4f, 0xa7, 0x6f, 0xff, 0x31,
+	0x63, 0xd5, 0x36, 0x20, 0x75, 0x91, 0x52, 0x74, 0xc2, 0x38, 0xb2, 0x76, 0x70, 0xb4, 0x4f, 0x3a,
+	0xda, 0x96, 0xfb, 0x7b, 0x50, 0x58, 0x65, 0xac, 0xeb, 0xb8, 0xd4, 0x0d, 0x5b, 0x86, 0xa8, 0x95,
+	0x27, 0x13, 0x15, 0x83, 0xbc, 0x72, 0x5e, 0xdd, 0xa8, 0x5d, 0x82, 0xfc, 0x0d, 0x39, 0xf6, 0xd2,
+	0x80, 0x4e, 0x41, 0x66, 0x9d, 0x74, 0x43, 0xaa, 0x13, 0x7e, 0x9a, 0x9f, 0x08, 0xac, 0x73, 0xf8,
+	0x3a, 0x0d, 0x70, 0xbd, 0xc9, 0x69, 0xb0, 0x4e, 0xdd, 0x46, 0x1f, 0xcd, 0x40, 0x52, 0xf4, 0xf5,
+	0x00, 0x82, 0x49, 0xa4, 0xd1, 0x5f, 0x4c, 0x4b, 0x9f, 0x38, 0x29, 0xfa, 0xe8, 0x15, 0xc8, 0x72,
+	0x41, 0x44, 0xc8, 0x55, 0xd4, 0xa5, 0x7a, 0xc9, 0xa0, 0x96, 0x94, 0x16, 0x6b, 0x2b, 0x7a, 0x01,
+	0x80, 0x85, 0xc2, 0xe9, 0x10, 0xde, 0xa1, 0x92, 0x74, 0xa9, 0xd9, 0x3c, 0xce, 0xb3, 0x50, 0x5c,
+	0x56, 0x0a, 0xf4, 0x22, 0x14, 0x9b, 0x5d, 0xd6, 0x5a, 0x71, 0x3a, 0xd4, 0x6b, 0x77, 0x84, 0xe2,
+	0x59, 0x0a, 0x17, 0x94, 0xee, 0xb2, 0x52, 0xa9, 0x95, 0xe1, 0xb5, 0x7d, 0x1a, 0x44, 0xf4, 0xca,
+	0x63, 0x23, 0xa2, 0x53, 0x50, 0x66, 0x3a, 0x66, 0x67, 0x35, 0x6c, 0x3a, 0x2b, 0x74, 0x43, 0x6d,
+	0x95, 0xf1, 0x49, 0x2a, 0x19, 0x4c, 0x24, 0xcb, 0x88, 0x56, 0xe8, 0x86, 0xf4, 0xe1, 0xf4, 0xb8,
+	0xa2, 0x50, 0x0a, 0xe7, 0xb5, 0xe6, 0x1a, 0x47, 0x47, 0x61, 0xff, 0xb2, 0xe7, 0x93, 0xae, 0xc7,
+	0xa9, 0x09, 0x2a, 0xa7, 0x30, 0x25, 0xa3, 0xd6, 0x71, 0x55, 0x00, 0x48, 0xbb, 0x1d, 0xd0, 0x36,
+	0x11, 0x2c, 0xb0, 0xf3, 0x8a, 0x8a, 0x23, 0x1a, 0xf4, 0x1a, 0x1c, 0x18, 0x4a, 0x8e, 0x20, 0x41,
+	0x9b, 0x0a, 0x1b, 0x14, 0xac, 0x3c, 0x34, 0x34, 0x94, 0x1e, 0x7d, 0x0c, 0x87, 0xb7, 0x81, 0x9d,
+	0xae, 0xd7, 0xf3, 0x84, 0x5d, 0x88, 0xbb, 0x69, 0x3d, 0xa1, 0x9b, 0xff, 0x9b, 0x74, 0xf9, 0xbe,
+	0xbc, 0x8c, 0x8e, 0xc3, 0x14, 0x53, 0x33, 0xc1, 0xed, 0xa2, 0xa2, 0x65, 0x79, 0xd8, 0xcd, 0x68,
+	0x58, 0x74, 0x4f, 0x0d, 0xac, 0x76, 0x05, 0x0a, 0x67, 0x85, 0xa0, 0xb2, 0x7d, 0x1e, 0xf3, 0xd1,
+	0x34, 0xe8, 0x3a, 0x2a, 0x36, 0x14, 0xb1, 0x96, 0xd0, 0xff, 0x21, 0xbf, 0xe4, 0xb5, 0x7d, 0x22,
+	0xc2, 0x80, 0x2a, 0x0a, 0x14, 0xf1, 0x50, 0xa1, 0x49, 0xf5, 0xab, 0x05, 0xb9, 0xc8, 0x57, 0xa3,
+	0x8f, 0xe6, 0x20, 0xc3, 0x9a, 0xbc, 0x61, 0x58, 0x85, 0x4c, 0x1c, 0x43, 0xd6, 0xe9, 0x48, 0x22,
+	0x18, 0x5a, 0x80, 0x02, 0x19, 0xc6, 0xa1, 0xbe, 0xa2, 0x50, 0x3f, 0x18, 0x3f, 0x06, 0x43, 0x13,
+	0x1e, 0xc5, 0x49, 0xb6, 0x78, 0x7e, 0x93, 0x85, 0xbe, 0xab, 0x36, 0x5c, 0x0e, 0x1b, 0x11, 0xbd,
+	0x0d, 0x36, 0xe9, 0x76, 0xd9, 0x4d, 0x67, 0x39, 0x94, 0x31, 0x3a, 0x11, 0x2d, 0x22, 0xef, 0xd1,
+	0xcb, 0x35, 0xad, 0xec, 0x17, 0x95, 0xf9, 0xfa, 0xd0, 0xaa, 0xb3, 0xf9, 0xcd, 0x82, 0xdc, 0x87,
+	0x21, 0x0b, 0xc2, 0xde, 0x2e, 0xb2, 0x79, 0x0b, 0x8a, 0x23, 0x51, 0x9a, 0x65, 0xb3, 0x63, 0x3a,
+	0x63, 0xc0, 0xff, 0x30, 0x9f, 0x7f, 0x2c, 0x28, 0x44, 0xf9, 0xc8, 0x91, 0xa5, 0x68, 0x16, 0xb2,
+	0x6b, 0x21, 0x6b, 0xf4, 0xcd, 0x02, 0x8b, 0x99, 0x62, 0x92, 0xc6, 0xda, 0x8e, 0xce, 0x40, 0x69,
+	0x2d, 0x64, 0x1f, 0x50, 0x71, 0x93, 0x05, 0x2b, 0x17, 0x29, 0x35, 0xe9, 0xd8, 0xe3, 0x37, 0x86,
+	0x00, 0x3c, 0x81, 0x47, 0xef, 0xc2, 0xbe, 0xb5, 0x90, 0x2d, 0xb1, 0xee, 0x3a, 0xf5, 0x5b, 0x9e,
+	0x5e, 0x0c, 0x85, 0xfa, 0xf4, 0xb8, 0x03, 0x6d, 0xdf, 0xc0, 0xe3, 0x60, 0x74, 0x1a, 0x8a, 0x6b,
+	0x21, 0xbb, 0x10, 0x04, 0x44, 0x10, 0x19, 0x6f, 0x7a, 0xa7, 0xcb, 0xc6, 0x8c, 0xc7, 0xb0, 0x3a,
+	0xf7, 0xef, 0x2d, 0x80, 0x61, 0x3c, 0x92, 0xe4, 0x7a, 0xd4, 0x2d, 0x35, 0xea, 0x5a, 0x7a, 0xfa,
+	0xa3, 0xfa, 0x2a, 0x94, 0x45, 0x40, 0x7c, 0x4e, 0x5a, 0xb2, 0xb0, 0x0e, 0xf7, 0x6e, 0x51, 0xd5,
+	0xa6, 0x34, 0xde, 0x3f, 0xa2, 0x5f, 0xf2, 0x6e, 0xd1, 0x49, 0x68, 0x40, 0x04, 0x55, 0x6d, 0x1a,
+	0x87, 0x62, 0x22, 0xcc, 0xf4, 0x7c, 0x69, 0x41, 0x39, 0xe2, 0xc5, 0x48, 0xa4, 0x27, 0xa1, 0xe0,
+	0x47, 0x92, 0xb3, 0x4c, 0xe9, 0x24, 0xfb, 0x46, 0x2a, 0x0e, 0xfe, 0xf0, 0xd2, 0xee, 0x46, 0x49,
+	0x87, 0xf1, 0x95, 0x05, 0xe5, 0xc9, 0x7e, 0xee, 0x2e, 0x8c, 0xdd, 0xce, 0x80, 0x0e, 0xe4, 0x17,
+	0x0b, 0x72, 0x86, 0x11, 0xc8, 0x1e, 0xf9, 0x39, 0x94, 0x7b, 0xbe, 0x1f, 0x42, 0x2f, 0xc1, 0x94,
+	0x79, 0x2c, 0x52, 0xdb, 0x1e, 0x8b, 0xec, 0x6a, 0xb4, 0xde, 0xe2, 0xc7, 0x3c, 0xfd, 0xcc, 0x8f,
+	0xf9, 0x90, 0x44, 0x99, 0x51, 0x12, 0xe9, 0xe8, 0x3f, 0x85, 0x52, 0x94, 0x60, 0x9c, 0xc2, 0xeb,
+	0x90, 0xe3, 0xfa, 0xac, 0x0b, 0x18, 0x4f, 0x5c, 0x4c, 0xfc, 0x18, 0xb1, 0xb7, 0x1e, 0x7e, 0x0e,
+	0xa5, 0xf1, 0x89, 0x7a, 0xce, 0x2f, 0xdf, 0x63, 0xe7, 0xae, 0x42, 0xce, 0x0c, 0xe0, 0x1e, 0x1a,
+	0xa7, 0x9d, 0x7d, 0x66, 0x0a, 0x19, 0xbb, 0x7c, 0x03, 0xf2, 0x54, 0x9d, 0x9d, 0xf8, 0x37, 0x4b,
+	0x9c, 0x4c, 0xbc, 0x05, 0x72, 0xd4, 0xc0, 0xf7, 0x54, 0xc9, 0x2f, 0x4c, 0x25, 0x77, 0xfb, 0xed,
+	0x7b, 0x2c, 0xe5, 0x77, 0x49, 0xb3, 0x14, 0x94, 0x7a, 0x91, 0x88, 0x56, 0x07, 0xcd, 0x03, 0x44,
+	0x50, 0x47, 0x6c, 0xdf, 0xde, 0xe6, 0x01, 0xc6, 0x79, 0xa2, 0x4f, 0x1c, 0x5d, 0x86, 0x83, 0xfa,
+	0xc2, 0xc8, 0x14, 0x6f, 0xdb, 0xe2, 0x93, 0xcb, 0x07, 0x1f, 0x20, 0x13, 0x1a, 0x8e, 0xce, 0x81,
+	0x56, 0x3a, 0xfc, 0xb1, 0xcb, 0x7c, 0x9c, 0xf7, 0xb8, 0x4c, 0x46, 0x65, 0xb9, 0xcf, 0x17, 0x63,
+	0x27, 0x71, 0x25, 0xb7, 0x2d, 0xf5, 0xf1, 0x9e, 0xe3, 0xfd, 0x64, 0x4c, 0xd6, 0xe5, 0x39, 0x76,
+	0x1c, 0xb2, 0xd1, 0xef, 0x4f, 0x54, 0x02, 0xf0, 0xfc, 0x16, 0xeb, 0xad, 0x76, 0xa9, 0xa0, 0xe5,
+	0x04, 0xca, 0x41, 0xda, 0x65, 0x3e, 0x2d, 0x5b, 0xa8, 0x08, 0xb9, 0x80, 0xae, 0xd3, 0x40, 0x50,
+	0xb7, 0x9c, 0x5c, 0xbc, 0x76, 0xf7, 0x61, 0x25, 0x71, 0xff, 0x61, 0x25, 0x71, 0x7b, 0xb3, 0x92,
+	0xb8, 0xbb, 0x59, 0xb1, 0xee, 0x6d, 0x56, 0xac, 0x3f, 0x37, 0x2b, 0xd6, 0x37, 0x8f, 0x2a, 0x89,
+	0x7b, 0x8f, 0x2a, 0x89, 0xfb, 0x8f, 0x2a, 0x89, 0x4f, 0x8e, 0xb6, 0x3d, 0xd1, 0x25, 0x4d, 0x19,
+	0xcc, 0xbc, 0xe8, 0xb0, 0x40, 0x11, 0x52, 0x9d, 0x7c, 0xe6, 0xd2, 0xf9, 0xf5, 0x93, 0xfa, 0xbf,
+	0xf6, 0x66, 0x56, 0xfd, 0x17, 0x7e, 0xf2, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x9c, 0x44,
+	0x63, 0xcd, 0x0f, 0x00, 0x00,
 }
 
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -1231,6 +1275,50 @@ func (m *Tx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TxOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Fee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1331,6 +1419,20 @@ func (m *ObservedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommon(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.AggregatorTargetLimit != nil {
 		{
 			size := m.AggregatorTargetLimit.Size()
@@ -2290,6 +2392,25 @@ func (m *Tx) Size() (n int) {
 	return n
 }
 
+func (m *TxOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Fee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2366,6 +2487,12 @@ func (m *ObservedTx) Size() (n int) {
 		l = m.AggregatorTargetLimit.Size()
 		n += 1 + l + sovCommon(uint64(l))
 	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovCommon(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2679,6 +2806,22 @@ func sovCommon(x uint64) (n int) {
 func sozCommon(x uint64) (n int) {
 	return sovCommon(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *TxOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCoins := "[]Coin{"
+	for _, f := range this.Coins {
+		repeatedStringForCoins += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForCoins += "}"
+	s := strings.Join([]string{`&TxOutput{`,
+		`ToAddress:` + fmt.Sprintf("%v", this.ToAddress) + `,`,
+		`Coins:` + repeatedStringForCoins + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProtoUint) String() string {
 	if this == nil {
 		return "nil"
@@ -3663,6 +3806,122 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TxOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Fee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4199,6 +4458,40 @@ func (m *ObservedTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, TxOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommon(dAtA[iNdEx:])
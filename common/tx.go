@@ -248,3 +248,27 @@ func (tx Tx) ToAttributes() []cosmos.Attribute {
 		cosmos.NewAttribute("memo", tx.Memo),
 	}
 }
+
+// NewTxOutput create a new instance of TxOutput
+func NewTxOutput(to Address, coins Coins) TxOutput {
+	return TxOutput{
+		ToAddress: to,
+		Coins:     coins,
+	}
+}
+
+// Equals compare two TxOutput
+func (o TxOutput) Equals(o2 TxOutput) bool {
+	return o.ToAddress.Equals(o2.ToAddress) && o.Coins.EqualsEx(o2.Coins)
+}
+
+// Valid do some data sanity check on the output
+func (o TxOutput) Valid() error {
+	if o.ToAddress.IsEmpty() {
+		return errors.New("to address cannot be empty")
+	}
+	if len(o.Coins) == 0 {
+		return errors.New("must have at least 1 coin")
+	}
+	return o.Coins.Valid()
+}
@@ -40,6 +40,11 @@ func (m *ObservedTx) Valid() error {
 	if m.FinaliseHeight <= 0 {
 		return errors.New("finalise block height can't be zero")
 	}
+	for _, output := range m.Outputs {
+		if err := output.Valid(); err != nil {
+			return fmt.Errorf("invalid output: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -78,9 +83,55 @@ func (m ObservedTx) Equals(tx2 ObservedTx) bool {
 	if !m.AggregatorTargetLimit.Equal(*tx2.AggregatorTargetLimit) {
 		return false
 	}
+	if len(m.Outputs) != len(tx2.Outputs) {
+		return false
+	}
+	for i := range m.Outputs {
+		if !m.Outputs[i].Equals(tx2.Outputs[i]) {
+			return false
+		}
+	}
 	return true
 }
 
+// OutputTxs returns one ObservedTx per recipient paid by the tx. A batched
+// outbound pays its Outputs in the same chain tx as Tx.ToAddress, so the gas
+// is shared between the recipients, any remainder staying with the first.
+func (m ObservedTx) OutputTxs() ObservedTxs {
+	txs := ObservedTxs{m}
+	if len(m.Outputs) == 0 {
+		return txs
+	}
+	txs[0].Outputs = nil
+	txs[0].Tx.Gas = make(Gas, len(m.Tx.Gas))
+	n := cosmos.NewUint(uint64(len(m.Outputs) + 1))
+	for _, output := range m.Outputs {
+		tx := m
+		tx.Outputs = nil
+		tx.Tx.ToAddress = output.ToAddress
+		tx.Tx.Coins = output.Coins.Copy()
+		tx.Tx.Gas = make(Gas, len(m.Tx.Gas))
+		for i, gas := range m.Tx.Gas {
+			tx.Tx.Gas[i] = NewCoin(gas.Asset, gas.Amount.Quo(n))
+		}
+		txs = append(txs, tx)
+	}
+	for i, gas := range m.Tx.Gas {
+		share := gas.Amount.Quo(n)
+		txs[0].Tx.Gas[i] = NewCoin(gas.Asset, gas.Amount.Sub(share.MulUint64(uint64(len(m.Outputs)))))
+	}
+	return txs
+}
+
+// OutputCoins returns the coins paid to all the recipients of the tx
+func (m ObservedTx) OutputCoins() Coins {
+	coins := m.Tx.Coins.Copy()
+	for _, output := range m.Outputs {
+		coins = coins.Add(output.Coins...)
+	}
+	return coins
+}
+
 // IsFinal indicates whether ObserveTx is final.
 func (m *ObservedTx) IsFinal() bool {
 	return m.FinaliseHeight == m.BlockHeight
@@ -148,6 +199,12 @@ func (m *ObservedTx) SetDone(hash TxID, numOuts int) {
 			}
 		}
 	}
+	m.SetBatchDone(hash, numOuts)
+}
+
+// SetBatchDone records another recipient of an outbound tx already recorded by
+// SetDone, as a batched outbound pays several outbound items with one hash
+func (m *ObservedTx) SetBatchDone(hash TxID, numOuts int) {
 	m.OutHashes = append(m.OutHashes, hash.String())
 	if m.IsDone(numOuts) {
 		m.Status = Status_done
@@ -488,6 +488,7 @@ type Bifrost struct {
 	Thorchain         BifrostClientConfiguration     `mapstructure:"thorchain"`
 	AttestationGossip BifrostAttestationGossipConfig `mapstructure:"attestation_gossip"`
 	Metrics           BifrostMetricsConfiguration    `mapstructure:"metrics"`
+	Admin             BifrostAdminConfiguration      `mapstructure:"admin"`
 	Chains            struct {
 		AVAX  BifrostChainConfiguration `mapstructure:"avax"`
 		BCH   BifrostChainConfiguration `mapstructure:"bch"`
@@ -526,6 +527,12 @@ func (b Bifrost) GetChains() map[common.Chain]BifrostChainConfiguration {
 
 // LevelDBOptions are a superset of the options passed to the LevelDB constructor.
 type LevelDBOptions struct {
+	// Backend is the storage engine, "goleveldb" (default) or "pebble". The remaining
+	// options are mapped to their pebble equivalents when pebble is used. Switching the
+	// backend of an existing database requires migrating it offline with
+	// tools/bifrost-db-migrate.
+	Backend string `mapstructure:"backend"`
+
 	// FilterBitsPerKey is the number of bits per key for the bloom filter.
 	FilterBitsPerKey int `mapstructure:"filter_bits_per_key"`
 
@@ -666,6 +673,9 @@ type BifrostChainConfiguration struct {
 	// will be provided to the backend in an Authorization header.
 	AuthorizationBearer string `mapstructure:"authorization_bearer"`
 
+	// RPCPool configures additional endpoints for failover and block hash quorum.
+	RPCPool BifrostRPCPoolConfiguration `mapstructure:"rpc_pool"`
+
 	// EVM contains EVM chain specific configuration.
 	EVM struct {
 		// MaxGasTipPercentage is the percentage of the max fee to set for the max tip cap on
@@ -750,6 +760,43 @@ func (b *BifrostChainConfiguration) Validate() {
 				Msg("rpc host is required")
 		}
 	}
+
+	if b.RPCPool.Quorum > len(b.RPCPool.Hosts)+1 {
+		log.Fatal().
+			Str("chain", b.ChainID.String()).
+			Int("quorum", b.RPCPool.Quorum).
+			Int("endpoints", len(b.RPCPool.Hosts)+1).
+			Msg("rpc pool quorum exceeds the number of endpoints")
+	}
+}
+
+// BifrostRPCPoolConfiguration configures the pool of endpoints used by a chain client.
+// The primary endpoint is always the configured RPCHost (APIHost on Tron), additional
+// hosts are used on failover and to verify block hashes.
+type BifrostRPCPoolConfiguration struct {
+	// Hosts are the additional endpoints of the chain daemon, in the same format as the
+	// primary host.
+	Hosts []string `mapstructure:"hosts"`
+
+	// Quorum is the number of endpoints that must agree on a block hash before the block
+	// is reported. A value of 0 or 1 disables the check.
+	Quorum int `mapstructure:"quorum"`
+
+	// MaxHeightLag is the number of blocks an endpoint may lag the highest endpoint in
+	// the pool before it is considered unhealthy. A value of 0 disables the check.
+	MaxHeightLag int64 `mapstructure:"max_height_lag"`
+
+	// HealthCheckInterval is the interval at which endpoint heights and latency are
+	// refreshed.
+	HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+
+	// Timeout is the timeout for health check and block hash requests to an endpoint.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// Enabled returns true if more than the primary endpoint is configured.
+func (c BifrostRPCPoolConfiguration) Enabled() bool {
+	return len(c.Hosts) > 0
 }
 
 type BifrostBlockScannerConfiguration struct {
@@ -840,6 +887,7 @@ type BifrostClientConfiguration struct {
 	ChainHost       string       `mapstructure:"chain_host"`
 	ChainRPC        string       `mapstructure:"chain_rpc"`
 	ChainEBifrost   string       `mapstructure:"chain_ebifrost"`
+	ChainGRPC       string       `mapstructure:"chain_grpc"`
 	ChainHomeFolder string       `mapstructure:"chain_home_folder"`
 	SignerName      string       `mapstructure:"signer_name"`
 	SignerPasswd    string
@@ -854,6 +902,17 @@ type BifrostMetricsConfiguration struct {
 	Chains       []common.Chain `mapstructure:"chains"`
 }
 
+type BifrostAdminConfiguration struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// ListenAddress should be a loopback address, the admin api exposes node internals.
+	ListenAddress string `mapstructure:"listen_address"`
+
+	// AuthToken must be sent as a bearer token on every request, the server refuses to
+	// start without one.
+	AuthToken string `mapstructure:"auth_token"`
+}
+
 type BifrostTSSConfiguration struct {
 	BootstrapPeers               []string `mapstructure:"bootstrap_peers"`
 	Rendezvous                   string   `mapstructure:"rendezvous"`
@@ -37,12 +37,18 @@ const (
 	MissingBlockChurnOut
 	MaxMissingBlockChurnOut
 	MaxTrackMissingBlock
+	ObservationStatsWindow
+	ObservationMissChurnOutBps
+	MaxObservationMissChurnOut
 	BadValidatorRedline
 	LackOfObservationPenalty
 	SigningTransactionPeriod
 	DoubleSignMaxAge
 	PauseBond
 	PauseUnbond
+	BondProviderUnbondNoticeBlocks
+	LiquidBondEnabled
+	NodeOperatorFeeNoticeBlocks
 	MinimumBondInRune
 	FundMigrationInterval
 	MaxOutboundAttempts
@@ -63,6 +69,14 @@ const (
 	MaxSwapsPerBlock
 	EnableOrderBooks
 	EnableAdvSwapQueue
+	TriggerSwapMaxLength
+	SwapRouteMaxLength
+	SwapRouteMaxHopSlipBps
+	PriceBreakerDeviationBps
+	PriceBreakerTWAPBlocks
+	PriceBreakerPauseBlocks
+	PoolTWAPMaxBlocks
+	DerivedPoolTWAPBlocks
 	MaxSynthPerPoolDepth
 	MaxSynthsForSaversYield
 	VirtualMultSynths
@@ -98,6 +112,7 @@ const (
 	RagnarokProcessNumOfLPPerIteration
 	SynthYieldBasisPoints
 	SynthYieldCycle
+	PoolHistoryDays
 	MinimumL1OutboundFeeUSD
 	MinimumPoolLiquidityFee
 	ChurnMigrateRounds
@@ -125,9 +140,14 @@ const (
 	DerivedSlipMinBps
 	TradeAccountsEnabled
 	TradeAccountsDepositEnabled
+	RecurringSwapsEnabled
+	RecurringSwapMinInterval
+	RecurringSwapMaxQuantity
+	RecurringSwapFillMaxRetryBlocks
 	SecuredAssetSlipMinBps
 	EVMDisableContractWhitelist
 	OperationalVotesMin
+	MimirHistoryBlocks
 	RUNEPoolEnabled
 	RUNEPoolDepositMaturityBlocks
 	RUNEPoolMaxReserveBackstop
@@ -140,6 +160,7 @@ const (
 	PendulumUseVaultAssets
 	TVLCapBasisPoints
 	MultipleAffiliatesMaxCount
+	MultipleDestinationsMaxCount
 	BondSlashBan
 	BankSendEnabled
 	RUNEPoolHaltDeposit
@@ -33,139 +33,160 @@ func _() {
 	_ = x[MissingBlockChurnOut-22]
 	_ = x[MaxMissingBlockChurnOut-23]
 	_ = x[MaxTrackMissingBlock-24]
-	_ = x[BadValidatorRedline-25]
-	_ = x[LackOfObservationPenalty-26]
-	_ = x[SigningTransactionPeriod-27]
-	_ = x[DoubleSignMaxAge-28]
-	_ = x[PauseBond-29]
-	_ = x[PauseUnbond-30]
-	_ = x[MinimumBondInRune-31]
-	_ = x[FundMigrationInterval-32]
-	_ = x[MaxOutboundAttempts-33]
-	_ = x[SlashPenalty-34]
-	_ = x[PauseOnSlashThreshold-35]
-	_ = x[FailKeygenSlashPoints-36]
-	_ = x[FailKeysignSlashPoints-37]
-	_ = x[LiquidityLockUpBlocks-38]
-	_ = x[ObserveSlashPoints-39]
-	_ = x[DoubleBlockSignSlashPoints-40]
-	_ = x[MissBlockSignSlashPoints-41]
-	_ = x[ObservationDelayFlexibility-42]
-	_ = x[JailTimeKeygen-43]
-	_ = x[JailTimeKeysign-44]
-	_ = x[NodePauseChainBlocks-45]
-	_ = x[EnableDerivedAssets-46]
-	_ = x[MinSwapsPerBlock-47]
-	_ = x[MaxSwapsPerBlock-48]
-	_ = x[EnableOrderBooks-49]
-	_ = x[EnableAdvSwapQueue-50]
-	_ = x[MaxSynthPerPoolDepth-51]
-	_ = x[MaxSynthsForSaversYield-52]
-	_ = x[VirtualMultSynths-53]
-	_ = x[VirtualMultSynthsBasisPoints-54]
-	_ = x[MinSlashPointsForBadValidator-55]
-	_ = x[MaxBondProviders-56]
-	_ = x[MinTxOutVolumeThreshold-57]
-	_ = x[TxOutDelayRate-58]
-	_ = x[TxOutDelayMax-59]
-	_ = x[MaxTxOutOffset-60]
-	_ = x[TNSRegisterFee-61]
-	_ = x[TNSFeeOnSale-62]
-	_ = x[TNSFeePerBlock-63]
-	_ = x[StreamingSwapPause-64]
-	_ = x[StreamingSwapMinBPFee-65]
-	_ = x[StreamingSwapMaxLength-66]
-	_ = x[StreamingSwapMaxLengthNative-67]
-	_ = x[MinCR-68]
-	_ = x[MaxCR-69]
-	_ = x[LoanStreamingSwapsInterval-70]
-	_ = x[PauseLoans-71]
-	_ = x[LoanRepaymentMaturity-72]
-	_ = x[LendingLever-73]
-	_ = x[PermittedSolvencyGap-74]
-	_ = x[NodeOperatorFee-75]
-	_ = x[ValidatorMaxRewardRatio-76]
-	_ = x[MaxNodeToChurnOutForLowVersion-77]
-	_ = x[ChurnOutForLowVersionBlocks-78]
-	_ = x[POLMaxNetworkDeposit-79]
-	_ = x[POLMaxPoolMovement-80]
-	_ = x[POLTargetSynthPerPoolDepth-81]
-	_ = x[POLBuffer-82]
-	_ = x[RagnarokProcessNumOfLPPerIteration-83]
-	_ = x[SynthYieldBasisPoints-84]
-	_ = x[SynthYieldCycle-85]
-	_ = x[MinimumL1OutboundFeeUSD-86]
-	_ = x[MinimumPoolLiquidityFee-87]
-	_ = x[ChurnMigrateRounds-88]
-	_ = x[AllowWideBlame-89]
-	_ = x[MaxAffiliateFeeBasisPoints-90]
-	_ = x[TargetOutboundFeeSurplusRune-91]
-	_ = x[MaxOutboundFeeMultiplierBasisPoints-92]
-	_ = x[MinOutboundFeeMultiplierBasisPoints-93]
-	_ = x[NativeOutboundFeeUSD-94]
-	_ = x[NativeTransactionFeeUSD-95]
-	_ = x[TNSRegisterFeeUSD-96]
-	_ = x[TNSFeePerBlockUSD-97]
-	_ = x[EnableUSDFees-98]
-	_ = x[PreferredAssetOutboundFeeMultiplier-99]
-	_ = x[FeeUSDRoundSignificantDigits-100]
-	_ = x[MigrationVaultSecurityBps-101]
-	_ = x[CloutReset-102]
-	_ = x[CloutLimit-103]
-	_ = x[KeygenRetryInterval-104]
-	_ = x[SaversStreamingSwapsInterval-105]
-	_ = x[RescheduleCoalesceBlocks-106]
-	_ = x[L1SlipMinBps-107]
-	_ = x[SynthSlipMinBps-108]
-	_ = x[TradeAccountsSlipMinBps-109]
-	_ = x[DerivedSlipMinBps-110]
-	_ = x[TradeAccountsEnabled-111]
-	_ = x[TradeAccountsDepositEnabled-112]
-	_ = x[SecuredAssetSlipMinBps-113]
-	_ = x[EVMDisableContractWhitelist-114]
-	_ = x[OperationalVotesMin-115]
-	_ = x[RUNEPoolEnabled-116]
-	_ = x[RUNEPoolDepositMaturityBlocks-117]
-	_ = x[RUNEPoolMaxReserveBackstop-118]
-	_ = x[SaversEjectInterval-119]
-	_ = x[SystemIncomeBurnRateBps-120]
-	_ = x[DevFundSystemIncomeBps-121]
-	_ = x[DevFundAddress-122]
-	_ = x[PendulumAssetsBasisPoints-123]
-	_ = x[PendulumUseEffectiveSecurity-124]
-	_ = x[PendulumUseVaultAssets-125]
-	_ = x[TVLCapBasisPoints-126]
-	_ = x[MultipleAffiliatesMaxCount-127]
-	_ = x[BondSlashBan-128]
-	_ = x[BankSendEnabled-129]
-	_ = x[RUNEPoolHaltDeposit-130]
-	_ = x[RUNEPoolHaltWithdraw-131]
-	_ = x[MinRuneForTCYStakeDistribution-132]
-	_ = x[MinTCYForTCYStakeDistribution-133]
-	_ = x[TCYStakeSystemIncomeBps-134]
-	_ = x[TCYClaimingSwapHalt-135]
-	_ = x[TCYStakeDistributionHalt-136]
-	_ = x[TCYStakingHalt-137]
-	_ = x[TCYUnstakingHalt-138]
-	_ = x[TCYClaimingHalt-139]
-	_ = x[HaltRebond-140]
-	_ = x[HaltOperatorRotate-141]
-	_ = x[ArtificialRagnarokBlockHeight-142]
-	_ = x[BondLockupPeriod-143]
-	_ = x[BurnSynths-144]
-	_ = x[DefaultPoolStatus-145]
-	_ = x[ManualSwapsToSynthDisabled-146]
-	_ = x[MaximumLiquidityRune-147]
-	_ = x[MintSynths-148]
-	_ = x[NumberOfNewNodesPerChurn-149]
-	_ = x[SignerConcurrency-150]
-	_ = x[StrictBondLiquidityRatio-151]
-	_ = x[SwapOutDexAggregationDisabled-152]
+	_ = x[ObservationStatsWindow-25]
+	_ = x[ObservationMissChurnOutBps-26]
+	_ = x[MaxObservationMissChurnOut-27]
+	_ = x[BadValidatorRedline-28]
+	_ = x[LackOfObservationPenalty-29]
+	_ = x[SigningTransactionPeriod-30]
+	_ = x[DoubleSignMaxAge-31]
+	_ = x[PauseBond-32]
+	_ = x[PauseUnbond-33]
+	_ = x[BondProviderUnbondNoticeBlocks-34]
+	_ = x[LiquidBondEnabled-35]
+	_ = x[NodeOperatorFeeNoticeBlocks-36]
+	_ = x[MinimumBondInRune-37]
+	_ = x[FundMigrationInterval-38]
+	_ = x[MaxOutboundAttempts-39]
+	_ = x[SlashPenalty-40]
+	_ = x[PauseOnSlashThreshold-41]
+	_ = x[FailKeygenSlashPoints-42]
+	_ = x[FailKeysignSlashPoints-43]
+	_ = x[LiquidityLockUpBlocks-44]
+	_ = x[ObserveSlashPoints-45]
+	_ = x[DoubleBlockSignSlashPoints-46]
+	_ = x[MissBlockSignSlashPoints-47]
+	_ = x[ObservationDelayFlexibility-48]
+	_ = x[JailTimeKeygen-49]
+	_ = x[JailTimeKeysign-50]
+	_ = x[NodePauseChainBlocks-51]
+	_ = x[EnableDerivedAssets-52]
+	_ = x[MinSwapsPerBlock-53]
+	_ = x[MaxSwapsPerBlock-54]
+	_ = x[EnableOrderBooks-55]
+	_ = x[EnableAdvSwapQueue-56]
+	_ = x[TriggerSwapMaxLength-57]
+	_ = x[SwapRouteMaxLength-58]
+	_ = x[SwapRouteMaxHopSlipBps-59]
+	_ = x[PriceBreakerDeviationBps-60]
+	_ = x[PriceBreakerTWAPBlocks-61]
+	_ = x[PriceBreakerPauseBlocks-62]
+	_ = x[PoolTWAPMaxBlocks-63]
+	_ = x[DerivedPoolTWAPBlocks-64]
+	_ = x[MaxSynthPerPoolDepth-65]
+	_ = x[MaxSynthsForSaversYield-66]
+	_ = x[VirtualMultSynths-67]
+	_ = x[VirtualMultSynthsBasisPoints-68]
+	_ = x[MinSlashPointsForBadValidator-69]
+	_ = x[MaxBondProviders-70]
+	_ = x[MinTxOutVolumeThreshold-71]
+	_ = x[TxOutDelayRate-72]
+	_ = x[TxOutDelayMax-73]
+	_ = x[MaxTxOutOffset-74]
+	_ = x[TNSRegisterFee-75]
+	_ = x[TNSFeeOnSale-76]
+	_ = x[TNSFeePerBlock-77]
+	_ = x[StreamingSwapPause-78]
+	_ = x[StreamingSwapMinBPFee-79]
+	_ = x[StreamingSwapMaxLength-80]
+	_ = x[StreamingSwapMaxLengthNative-81]
+	_ = x[MinCR-82]
+	_ = x[MaxCR-83]
+	_ = x[LoanStreamingSwapsInterval-84]
+	_ = x[PauseLoans-85]
+	_ = x[LoanRepaymentMaturity-86]
+	_ = x[LendingLever-87]
+	_ = x[PermittedSolvencyGap-88]
+	_ = x[NodeOperatorFee-89]
+	_ = x[ValidatorMaxRewardRatio-90]
+	_ = x[MaxNodeToChurnOutForLowVersion-91]
+	_ = x[ChurnOutForLowVersionBlocks-92]
+	_ = x[POLMaxNetworkDeposit-93]
+	_ = x[POLMaxPoolMovement-94]
+	_ = x[POLTargetSynthPerPoolDepth-95]
+	_ = x[POLBuffer-96]
+	_ = x[RagnarokProcessNumOfLPPerIteration-97]
+	_ = x[SynthYieldBasisPoints-98]
+	_ = x[SynthYieldCycle-99]
+	_ = x[PoolHistoryDays-100]
+	_ = x[MinimumL1OutboundFeeUSD-101]
+	_ = x[MinimumPoolLiquidityFee-102]
+	_ = x[ChurnMigrateRounds-103]
+	_ = x[AllowWideBlame-104]
+	_ = x[MaxAffiliateFeeBasisPoints-105]
+	_ = x[TargetOutboundFeeSurplusRune-106]
+	_ = x[MaxOutboundFeeMultiplierBasisPoints-107]
+	_ = x[MinOutboundFeeMultiplierBasisPoints-108]
+	_ = x[NativeOutboundFeeUSD-109]
+	_ = x[NativeTransactionFeeUSD-110]
+	_ = x[TNSRegisterFeeUSD-111]
+	_ = x[TNSFeePerBlockUSD-112]
+	_ = x[EnableUSDFees-113]
+	_ = x[PreferredAssetOutboundFeeMultiplier-114]
+	_ = x[FeeUSDRoundSignificantDigits-115]
+	_ = x[MigrationVaultSecurityBps-116]
+	_ = x[CloutReset-117]
+	_ = x[CloutLimit-118]
+	_ = x[KeygenRetryInterval-119]
+	_ = x[SaversStreamingSwapsInterval-120]
+	_ = x[RescheduleCoalesceBlocks-121]
+	_ = x[L1SlipMinBps-122]
+	_ = x[SynthSlipMinBps-123]
+	_ = x[TradeAccountsSlipMinBps-124]
+	_ = x[DerivedSlipMinBps-125]
+	_ = x[TradeAccountsEnabled-126]
+	_ = x[TradeAccountsDepositEnabled-127]
+	_ = x[RecurringSwapsEnabled-128]
+	_ = x[RecurringSwapMinInterval-129]
+	_ = x[RecurringSwapMaxQuantity-130]
+	_ = x[RecurringSwapFillMaxRetryBlocks-131]
+	_ = x[SecuredAssetSlipMinBps-132]
+	_ = x[EVMDisableContractWhitelist-133]
+	_ = x[OperationalVotesMin-134]
+	_ = x[MimirHistoryBlocks-135]
+	_ = x[RUNEPoolEnabled-136]
+	_ = x[RUNEPoolDepositMaturityBlocks-137]
+	_ = x[RUNEPoolMaxReserveBackstop-138]
+	_ = x[SaversEjectInterval-139]
+	_ = x[SystemIncomeBurnRateBps-140]
+	_ = x[DevFundSystemIncomeBps-141]
+	_ = x[DevFundAddress-142]
+	_ = x[PendulumAssetsBasisPoints-143]
+	_ = x[PendulumUseEffectiveSecurity-144]
+	_ = x[PendulumUseVaultAssets-145]
+	_ = x[TVLCapBasisPoints-146]
+	_ = x[MultipleAffiliatesMaxCount-147]
+	_ = x[MultipleDestinationsMaxCount-148]
+	_ = x[BondSlashBan-149]
+	_ = x[BankSendEnabled-150]
+	_ = x[RUNEPoolHaltDeposit-151]
+	_ = x[RUNEPoolHaltWithdraw-152]
+	_ = x[MinRuneForTCYStakeDistribution-153]
+	_ = x[MinTCYForTCYStakeDistribution-154]
+	_ = x[TCYStakeSystemIncomeBps-155]
+	_ = x[TCYClaimingSwapHalt-156]
+	_ = x[TCYStakeDistributionHalt-157]
+	_ = x[TCYStakingHalt-158]
+	_ = x[TCYUnstakingHalt-159]
+	_ = x[TCYClaimingHalt-160]
+	_ = x[HaltRebond-161]
+	_ = x[HaltOperatorRotate-162]
+	_ = x[ArtificialRagnarokBlockHeight-163]
+	_ = x[BondLockupPeriod-164]
+	_ = x[BurnSynths-165]
+	_ = x[DefaultPoolStatus-166]
+	_ = x[ManualSwapsToSynthDisabled-167]
+	_ = x[MaximumLiquidityRune-168]
+	_ = x[MintSynths-169]
+	_ = x[NumberOfNewNodesPerChurn-170]
+	_ = x[SignerConcurrency-171]
+	_ = x[StrictBondLiquidityRatio-172]
+	_ = x[SwapOutDexAggregationDisabled-173]
 }
 
-const _ConstantName_name = "EmissionCurveMaxRuneSupplyBlocksPerYearOutboundTransactionFeeNativeTransactionFeePoolCycleMinRunePoolDepthMaxAvailablePoolsStagedPoolCostPendingLiquidityAgeLimitMinimumNodesForBFTDesiredValidatorSetAsgardSizeDerivedDepthBasisPtsDerivedMinDepthMaxAnchorSlipMaxAnchorBlocksDynamicMaxAnchorSlipBlocksDynamicMaxAnchorTargetDynamicMaxAnchorCalcIntervalChurnIntervalChurnRetryIntervalMissingBlockChurnOutMaxMissingBlockChurnOutMaxTrackMissingBlockBadValidatorRedlineLackOfObservationPenaltySigningTransactionPeriodDoubleSignMaxAgePauseBondPauseUnbondMinimumBondInRuneFundMigrationIntervalMaxOutboundAttemptsSlashPenaltyPauseOnSlashThresholdFailKeygenSlashPointsFailKeysignSlashPointsLiquidityLockUpBlocksObserveSlashPointsDoubleBlockSignSlashPointsMissBlockSignSlashPointsObservationDelayFlexibilityJailTimeKeygenJailTimeKeysignNodePauseChainBlocksEnableDerivedAssetsMinSwapsPerBlockMaxSwapsPerBlockEnableOrderBooksEnableAdvSwapQueueMaxSynthPerPoolDepthMaxSynthsForSaversYieldVirtualMultSynthsVirtualMultSynthsBasisPointsMinSlashPointsForBadValidatorMaxBondProvidersMinTxOutVolumeThresholdTxOutDelayRateTxOutDelayMaxMaxTxOutOffsetTNSRegisterFeeTNSFeeOnSaleTNSFeePerBlockStreamingSwapPauseStreamingSwapMinBPFeeStreamingSwapMaxLengthStreamingSwapMaxLengthNativeMinCRMaxCRLoanStreamingSwapsIntervalPauseLoansLoanRepaymentMaturityLendingLeverPermittedSolvencyGapNodeOperatorFeeValidatorMaxRewardRatioMaxNodeToChurnOutForLowVersionChurnOutForLowVersionBlocksPOLMaxNetworkDepositPOLMaxPoolMovementPOLTargetSynthPerPoolDepthPOLBufferRagnarokProcessNumOfLPPerIterationSynthYieldBasisPointsSynthYieldCycleMinimumL1OutboundFeeUSDMinimumPoolLiquidityFeeChurnMigrateRoundsAllowWideBlameMaxAffiliateFeeBasisPointsTargetOutboundFeeSurplusRuneMaxOutboundFeeMultiplierBasisPointsMinOutboundFeeMultiplierBasisPointsNativeOutboundFeeUSDNativeTransactionFeeUSDTNSRegisterFeeUSDTNSFeePerBlockUSDEnableUSDFeesPreferredAssetOutboundFeeMultiplierFeeUSDRoundSignificantDigitsMigrationVaultSecurityBpsCloutResetCloutLimitKeygenRetryIntervalSaversStreamingSwapsIntervalRescheduleCoalesceBlocksL1SlipMinBpsSynthSlipMinBpsTradeAccountsSlipMinBpsDerivedSlipMinBpsTradeAccountsEnabledTradeAccountsDepositEnabledSecuredAssetSlipMinBpsEVMDisableContractWhitelistOperationalVotesMinRUNEPoolEnabledRUNEPoolDepositMaturityBlocksRUNEPoolMaxReserveBackstopSaversEjectIntervalSystemIncomeBurnRateBpsDevFundSystemIncomeBpsDevFundAddressPendulumAssetsBasisPointsPendulumUseEffectiveSecurityPendulumUseVaultAssetsTVLCapBasisPointsMultipleAffiliatesMaxCountBondSlashBanBankSendEnabledRUNEPoolHaltDepositRUNEPoolHaltWithdrawMinRuneForTCYStakeDistributionMinTCYForTCYStakeDistributionTCYStakeSystemIncomeBpsTCYClaimingSwapHaltTCYStakeDistributionHaltTCYStakingHaltTCYUnstakingHaltTCYClaimingHaltHaltRebondHaltOperatorRotateArtificialRagnarokBlockHeightBondLockupPeriodBurnSynthsDefaultPoolStatusManualSwapsToSynthDisabledMaximumLiquidityRuneMintSynthsNumberOfNewNodesPerChurnSignerConcurrencyStrictBondLiquidityRatioSwapOutDexAggregationDisabled"
+const _ConstantName_name = "EmissionCurveMaxRuneSupplyBlocksPerYearOutboundTransactionFeeNativeTransactionFeePoolCycleMinRunePoolDepthMaxAvailablePoolsStagedPoolCostPendingLiquidityAgeLimitMinimumNodesForBFTDesiredValidatorSetAsgardSizeDerivedDepthBasisPtsDerivedMinDepthMaxAnchorSlipMaxAnchorBlocksDynamicMaxAnchorSlipBlocksDynamicMaxAnchorTargetDynamicMaxAnchorCalcIntervalChurnIntervalChurnRetryIntervalMissingBlockChurnOutMaxMissingBlockChurnOutMaxTrackMissingBlockObservationStatsWindowObservationMissChurnOutBpsMaxObservationMissChurnOutBadValidatorRedlineLackOfObservationPenaltySigningTransactionPeriodDoubleSignMaxAgePauseBondPauseUnbondBondProviderUnbondNoticeBlocksLiquidBondEnabledNodeOperatorFeeNoticeBlocksMinimumBondInRuneFundMigrationIntervalMaxOutboundAttemptsSlashPenaltyPauseOnSlashThresholdFailKeygenSlashPointsFailKeysignSlashPointsLiquidityLockUpBlocksObserveSlashPointsDoubleBlockSignSlashPointsMissBlockSignSlashPointsObservationDelayFlexibilityJailTimeKeygenJailTimeKeysignNodePauseChainBlocksEnableDerivedAssetsMinSwapsPerBlockMaxSwapsPerBlockEnableOrderBooksEnableAdvSwapQueueTriggerSwapMaxLengthSwapRouteMaxLengthSwapRouteMaxHopSlipBpsPriceBreakerDeviationBpsPriceBreakerTWAPBlocksPriceBreakerPauseBlocksPoolTWAPMaxBlocksDerivedPoolTWAPBlocksMaxSynthPerPoolDepthMaxSynthsForSaversYieldVirtualMultSynthsVirtualMultSynthsBasisPointsMinSlashPointsForBadValidatorMaxBondProvidersMinTxOutVolumeThresholdTxOutDelayRateTxOutDelayMaxMaxTxOutOffsetTNSRegisterFeeTNSFeeOnSaleTNSFeePerBlockStreamingSwapPauseStreamingSwapMinBPFeeStreamingSwapMaxLengthStreamingSwapMaxLengthNativeMinCRMaxCRLoanStreamingSwapsIntervalPauseLoansLoanRepaymentMaturityLendingLeverPermittedSolvencyGapNodeOperatorFeeValidatorMaxRewardRatioMaxNodeToChurnOutForLowVersionChurnOutForLowVersionBlocksPOLMaxNetworkDepositPOLMaxPoolMovementPOLTargetSynthPerPoolDepthPOLBufferRagnarokProcessNumOfLPPerIterationSynthYieldBasisPointsSynthYieldCyclePoolHistoryDaysMinimumL1OutboundFeeUSDMinimumPoolLiquidityFeeChurnMigrateRoundsAllowWideBlameMaxAffiliateFeeBasisPointsTargetOutboundFeeSurplusRuneMaxOutboundFeeMultiplierBasisPointsMinOutboundFeeMultiplierBasisPointsNativeOutboundFeeUSDNativeTransactionFeeUSDTNSRegisterFeeUSDTNSFeePerBlockUSDEnableUSDFeesPreferredAssetOutboundFeeMultiplierFeeUSDRoundSignificantDigitsMigrationVaultSecurityBpsCloutResetCloutLimitKeygenRetryIntervalSaversStreamingSwapsIntervalRescheduleCoalesceBlocksL1SlipMinBpsSynthSlipMinBpsTradeAccountsSlipMinBpsDerivedSlipMinBpsTradeAccountsEnabledTradeAccountsDepositEnabledRecurringSwapsEnabledRecurringSwapMinIntervalRecurringSwapMaxQuantityRecurringSwapFillMaxRetryBlocksSecuredAssetSlipMinBpsEVMDisableContractWhitelistOperationalVotesMinMimirHistoryBlocksRUNEPoolEnabledRUNEPoolDepositMaturityBlocksRUNEPoolMaxReserveBackstopSaversEjectIntervalSystemIncomeBurnRateBpsDevFundSystemIncomeBpsDevFundAddressPendulumAssetsBasisPointsPendulumUseEffectiveSecurityPendulumUseVaultAssetsTVLCapBasisPointsMultipleAffiliatesMaxCountMultipleDestinationsMaxCountBondSlashBanBankSendEnabledRUNEPoolHaltDepositRUNEPoolHaltWithdrawMinRuneForTCYStakeDistributionMinTCYForTCYStakeDistributionTCYStakeSystemIncomeBpsTCYClaimingSwapHaltTCYStakeDistributionHaltTCYStakingHaltTCYUnstakingHaltTCYClaimingHaltHaltRebondHaltOperatorRotateArtificialRagnarokBlockHeightBondLockupPeriodBurnSynthsDefaultPoolStatusManualSwapsToSynthDisabledMaximumLiquidityRuneMintSynthsNumberOfNewNodesPerChurnSignerConcurrencyStrictBondLiquidityRatioSwapOutDexAggregationDisabled"
 
-var _ConstantName_index = [...]uint16{0, 13, 26, 39, 61, 81, 90, 106, 123, 137, 161, 179, 198, 208, 228, 243, 256, 271, 297, 319, 347, 360, 378, 398, 421, 441, 460, 484, 508, 524, 533, 544, 561, 582, 601, 613, 634, 655, 677, 698, 716, 742, 766, 793, 807, 822, 842, 861, 877, 893, 909, 927, 947, 970, 987, 1015, 1044, 1060, 1083, 1097, 1110, 1124, 1138, 1150, 1164, 1182, 1203, 1225, 1253, 1258, 1263, 1289, 1299, 1320, 1332, 1352, 1367, 1390, 1420, 1447, 1467, 1485, 1511, 1520, 1554, 1575, 1590, 1613, 1636, 1654, 1668, 1694, 1722, 1757, 1792, 1812, 1835, 1852, 1869, 1882, 1917, 1945, 1970, 1980, 1990, 2009, 2037, 2061, 2073, 2088, 2111, 2128, 2148, 2175, 2197, 2224, 2243, 2258, 2287, 2313, 2332, 2355, 2377, 2391, 2416, 2444, 2466, 2483, 2509, 2521, 2536, 2555, 2575, 2605, 2634, 2657, 2676, 2700, 2714, 2730, 2745, 2755, 2773, 2802, 2818, 2828, 2845, 2871, 2891, 2901, 2925, 2942, 2966, 2995}
+var _ConstantName_index = [...]uint16{0, 13, 26, 39, 61, 81, 90, 106, 123, 137, 161, 179, 198, 208, 228, 243, 256, 271, 297, 319, 347, 360, 378, 398, 421, 441, 463, 489, 515, 534, 558, 582, 598, 607, 618, 648, 665, 692, 709, 730, 749, 761, 782, 803, 825, 846, 864, 890, 914, 941, 955, 970, 990, 1009, 1025, 1041, 1057, 1075, 1095, 1113, 1135, 1159, 1181, 1204, 1221, 1242, 1262, 1285, 1302, 1330, 1359, 1375, 1398, 1412, 1425, 1439, 1453, 1465, 1479, 1497, 1518, 1540, 1568, 1573, 1578, 1604, 1614, 1635, 1647, 1667, 1682, 1705, 1735, 1762, 1782, 1800, 1826, 1835, 1869, 1890, 1905, 1920, 1943, 1966, 1984, 1998, 2024, 2052, 2087, 2122, 2142, 2165, 2182, 2199, 2212, 2247, 2275, 2300, 2310, 2320, 2339, 2367, 2391, 2403, 2418, 2441, 2458, 2478, 2505, 2526, 2550, 2574, 2605, 2627, 2654, 2673, 2691, 2706, 2735, 2761, 2780, 2803, 2825, 2839, 2864, 2892, 2914, 2931, 2957, 2985, 2997, 3012, 3031, 3051, 3081, 3110, 3133, 3152, 3176, 3190, 3206, 3221, 3231, 3249, 3278, 3294, 3304, 3321, 3347, 3367, 3377, 3401, 3418, 3442, 3471}
 
 func (i ConstantName) String() string {
 	if i < 0 || i >= ConstantName(len(_ConstantName_index)-1) {
@@ -65,6 +65,10 @@ func init() {
 		DevFundAddress:    "tthor1qk8c8sfrmfm0tkncs0zxeutc8v5mx3pjj07k4u", // addr_thor_pig in regtest
 	}
 
+	// mimir keys only used by the regression tests
+	registerMimir(MimirDefinition{Key: "RegressionTest", Type: MimirTypeInt, Description: "Regression test mimir"})
+	registerMimir(MimirDefinition{Key: "FoxTest", Type: MimirTypeInt, Description: "Regression test mimir"})
+
 	v1Values := NewConstantValue()
 
 	// allow overrides from environment variables in mocknet
@@ -32,12 +32,18 @@ func NewConstantValue() *ConstantVals {
 			MissingBlockChurnOut:                0,                  // num of blocks a validator needs to NOT sign between churns
 			MaxMissingBlockChurnOut:             0,                  // max number of nodes to be churned out due to not signing blocks
 			MaxTrackMissingBlock:                700,                // maximum number of missing blocks to track for a block signer
+			ObservationStatsWindow:              0,                  // number of recent observations and network fee votes tracked per node and chain, 0 disables the stats
+			ObservationMissChurnOutBps:          0,                  // basis points of tracked observations a validator can miss before being churned out, 0 to disable
+			MaxObservationMissChurnOut:          0,                  // max number of nodes to be churned out due to missing observations
 			BadValidatorRedline:                 3,                  // redline multiplier to find a multitude of bad actors
 			LackOfObservationPenalty:            2,                  // add two slash point for each block where a node does not observe
 			SigningTransactionPeriod:            300,                // how many blocks before a request to sign a tx by yggdrasil pool, is counted as delinquent.
 			DoubleSignMaxAge:                    24,                 // number of blocks to limit double signing a block
 			PauseBond:                           0,                  // pauses the ability to bond
 			PauseUnbond:                         0,                  // pauses the ability to unbond
+			BondProviderUnbondNoticeBlocks:      43200,              // blocks a bond provider unbond request waits before it can execute on an active node (~3 days), 0 disables queued unbonds
+			LiquidBondEnabled:                   0,                  // enable/disable converting provider bond into liquid bond receipts
+			NodeOperatorFeeNoticeBlocks:         100800,             // blocks a node operator fee increase waits before it takes effect (~7 days), 0 applies increases immediately
 			MinimumBondInRune:                   1_000_000_00000000, // 1 million rune
 			MaxBondProviders:                    6,                  // maximum number of bond providers
 			MaxOutboundAttempts:                 0,                  // maximum retries to reschedule a transaction
@@ -59,6 +65,14 @@ func NewConstantValue() *ConstantVals {
 			MaxSwapsPerBlock:                    100,                // max swaps to process per block
 			EnableOrderBooks:                    0,                  // enable order books instead of swap queue
 			EnableAdvSwapQueue:                  0,                  // enable advanced swap queue, value of 2 skips limit swaps and forces all swaps to be market trades
+			TriggerSwapMaxLength:                14400 * 7,          // max number of blocks a stop-loss/take-profit order can rest in the advanced swap queue before expiring
+			SwapRouteMaxLength:                  3,                  // max number of intermediate assets in an explicit swap route
+			SwapRouteMaxHopSlipBps:              1000,               // max swap slip (in basis points) of each pool swap of a routed swap
+			PriceBreakerDeviationBps:            0,                  // max deviation (in basis points) of a pool price from its TWAP before swaps are paused, 0 disables the breaker
+			PriceBreakerTWAPBlocks:              100,                // number of blocks averaged in the pool price TWAP of the price breaker
+			PriceBreakerPauseBlocks:             300,                // number of blocks swaps are paused in a pool when the price breaker trips
+			PoolTWAPMaxBlocks:                   0,                  // number of blocks of pool price observations kept for TWAP queries, 0 disables the observations
+			DerivedPoolTWAPBlocks:               0,                  // number of blocks of the anchor pool TWAP that prices derived pools, 0 uses the anchor pool spot prices
 			VirtualMultSynths:                   2,                  // pool depth multiplier for synthetic swaps
 			VirtualMultSynthsBasisPoints:        10_000,             // pool depth multiplier for synthetic swaps (in basis points)
 			MaxSynthPerPoolDepth:                1700,               // percentage (in basis points) of how many synths are allowed relative to pool depth of the related pool
@@ -94,6 +108,7 @@ func NewConstantValue() *ConstantVals {
 			RagnarokProcessNumOfLPPerIteration:  200,              // the number of LP to be processed per iteration during ragnarok pool
 			SynthYieldBasisPoints:               5000,             // amount of the yield the capital earns the synth holder receives if synth per pool is 0%
 			SynthYieldCycle:                     0,                // number of blocks when the network pays out rewards to yield bearing synths
+			PoolHistoryDays:                     30,               // number of days of per pool fee, reward and synth yield history kept, 0 disables the history
 			MinimumL1OutboundFeeUSD:             1000000,          // Minimum fee in USD to charge for LP swap, default to $0.01 , nodes need to vote it to a larger value
 			MinimumPoolLiquidityFee:             0,                // Minimum liquidity fee made by the pool,active pool fail to meet this within a PoolCycle will be demoted
 			ChurnMigrateRounds:                  5,                // Number of rounds to migrate vaults during churn
@@ -113,8 +128,13 @@ func NewConstantValue() *ConstantVals {
 			RescheduleCoalesceBlocks:            0,                // number of blocks to coalesce rescheduled outbounds
 			TradeAccountsEnabled:                0,                // enable/disable trade account
 			TradeAccountsDepositEnabled:         1,
+			RecurringSwapsEnabled:               1,                  // enable/disable recurring swaps funded from trade accounts
+			RecurringSwapMinInterval:            10,                 // min number of blocks between the swaps of a recurring swap
+			RecurringSwapMaxQuantity:            1000,               // max number of swaps a recurring swap can make
+			RecurringSwapFillMaxRetryBlocks:     600,                // blocks a failed recurring swap that cannot be credited back is retried before the recurring swap is cancelled
 			EVMDisableContractWhitelist:         0,                  // enable/disable contract whitelist
 			OperationalVotesMin:                 3,                  // Minimum node votes to set an Operational Mimir
+			MimirHistoryBlocks:                  1_296_000,          // number of blocks of mimir value and node vote history kept, 0 disables the history
 			L1SlipMinBps:                        0,                  // Minimum L1 asset swap fee in basis points
 			TradeAccountsSlipMinBps:             0,                  // Minimum trade asset swap fee in basis points
 			SecuredAssetSlipMinBps:              5,                  // Minimum secured asset swap fee in basis points
@@ -131,6 +151,7 @@ func NewConstantValue() *ConstantVals {
 			PendulumUseVaultAssets:              0,                  // If 1. use the L1 Assets in the vaults (the Assets seizable by the lower-bond 2/3rds of nodes in each vault) as the Assets to be secured.  If 0, instead use only the L1 Assets in pools, ignoring the L1 Assets in for instance streaming swaps, oversolvencies, and Trade/Bridge Assets.
 			TVLCapBasisPoints:                   0,                  // If 0, TVL Cap is set to the effective active bond. If non-zero, the value is interrupted as basis points relative to total active bond
 			MultipleAffiliatesMaxCount:          5,                  // maximum number of nested affiliates
+			MultipleDestinationsMaxCount:        10,                 // maximum number of recipients of a split-destination swap
 			BondSlashBan:                        5_000_00000000,     // 5000 RUNE - amount to slash bond of banned nodes
 			BankSendEnabled:                     0,                  // enable/disable cosmos bank send messages
 			RUNEPoolHaltDeposit:                 0,                  // enable/disable RUNEPool deposit (block height)
@@ -0,0 +1,359 @@
+package constants
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// MimirType is the kind of value held by a mimir key
+type MimirType string
+
+const (
+	MimirTypeInt    MimirType = "int"    // an amount, count or rate
+	MimirTypeBool   MimirType = "bool"   // 0 is disabled, 1 is enabled
+	MimirTypeHeight MimirType = "height" // a block height to take effect from, 1 takes effect immediately
+)
+
+// Units of mimir values
+const (
+	MimirUnitBasisPoints = "basis_points"
+	MimirUnitBlocks      = "blocks"
+	MimirUnitRune        = "rune_1e8"
+	MimirUnitUSD         = "usd_1e8"
+)
+
+// MimirDefinition describes a known mimir key, or a key template used with
+// GetMimirWithRef
+type MimirDefinition struct {
+	Key         string // the mimir key, or template with a %s for each reference
+	Type        MimirType
+	Unit        string
+	Min         int64
+	Max         int64 // 0 for no upper bound
+	Description string
+
+	pattern *regexp.Regexp // matches keys of a template
+}
+
+// IsTemplate returns true when the definition is for a key template
+func (d MimirDefinition) IsTemplate() bool {
+	return strings.Contains(d.Key, "%s")
+}
+
+// Matches returns true when the given key is the defined key, or a key of the
+// defined template
+func (d MimirDefinition) Matches(key string) bool {
+	if d.pattern != nil {
+		return d.pattern.MatchString(key)
+	}
+	return strings.EqualFold(d.Key, key)
+}
+
+// ValidateValue checks a mimir value is within the bounds of the definition
+func (d MimirDefinition) ValidateValue(value int64) error {
+	if value < d.Min {
+		return fmt.Errorf("mimir %s value %d is below the minimum of %d", d.Key, value, d.Min)
+	}
+	if d.Type == MimirTypeBool && value > 1 {
+		return fmt.Errorf("mimir %s value %d must be 0 or 1", d.Key, value)
+	}
+	if d.Max > 0 && value > d.Max {
+		return fmt.Errorf("mimir %s value %d is above the maximum of %d", d.Key, value, d.Max)
+	}
+	return nil
+}
+
+// constantMimirs declares the mimir overrides of the int64 constants
+var constantMimirs = map[ConstantName]MimirDefinition{
+	EmissionCurve:                       {Type: MimirTypeInt, Description: "Divisor of the reserve emitted as block rewards each year"},
+	BlocksPerYear:                       {Type: MimirTypeInt, Description: "Number of blocks in a year, used for emission and APY calculations"},
+	MaxRuneSupply:                       {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Maximum supply of RUNE"},
+	OutboundTransactionFee:              {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "RUNE fee charged on outbound transactions when USD fees are disabled"},
+	NativeOutboundFeeUSD:                {Type: MimirTypeInt, Unit: MimirUnitUSD, Description: "USD fee charged on native swaps and withdrawals"},
+	NativeTransactionFee:                {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "RUNE fee charged on native transactions when USD fees are disabled"},
+	NativeTransactionFeeUSD:             {Type: MimirTypeInt, Unit: MimirUnitUSD, Description: "USD fee charged on native transactions"},
+	PoolCycle:                           {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks between enabling a new pool"},
+	StagedPoolCost:                      {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "RUNE taken from each staged pool every pool cycle"},
+	PendingLiquidityAgeLimit:            {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks liquidity can be pending before it is committed to the pool"},
+	MinRunePoolDepth:                    {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Minimum RUNE depth for a pool to become available"},
+	MaxAvailablePools:                   {Type: MimirTypeInt, Description: "Maximum number of available pools"},
+	MinimumNodesForBFT:                  {Type: MimirTypeInt, Description: "Minimum node count to keep the network running, below which ragnarok starts"},
+	DesiredValidatorSet:                 {Type: MimirTypeInt, Description: "Desired number of active validators"},
+	AsgardSize:                          {Type: MimirTypeInt, Description: "Desired number of nodes in an asgard vault"},
+	DerivedDepthBasisPts:                {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Derived pool depth relative to the layer 1 pool depth (10000 is 1x)"},
+	DerivedMinDepth:                     {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Minimum derived pool depth"},
+	MaxAnchorSlip:                       {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Basis points of rune depth to trigger pausing a derived virtual pool"},
+	MaxAnchorBlocks:                     {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Max blocks to accumulate swap slips in anchor pools"},
+	DynamicMaxAnchorSlipBlocks:          {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks to sample in calculating the dynamic max anchor slip"},
+	DynamicMaxAnchorTarget:              {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Target depth of derived virtual pool (in basis points)"},
+	DynamicMaxAnchorCalcInterval:        {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks to recalculate the dynamic max anchor"},
+	FundMigrationInterval:               {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks THORNode will attempt to move funds from a retiring vault to an active one"},
+	ChurnInterval:                       {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "How many blocks THORNode try to rotate validators"},
+	ChurnRetryInterval:                  {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "How many blocks until we retry a churn (only if we haven't had a successful churn in ChurnInterval blocks"},
+	MissingBlockChurnOut:                {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Num of blocks a validator needs to NOT sign between churns"},
+	MaxMissingBlockChurnOut:             {Type: MimirTypeInt, Description: "Max number of nodes to be churned out due to not signing blocks"},
+	MaxTrackMissingBlock:                {Type: MimirTypeInt, Description: "Maximum number of missing blocks to track for a block signer"},
+	ObservationStatsWindow:              {Type: MimirTypeInt, Description: "Number of recent observations and network fee votes tracked per node and chain, 0 disables the stats"},
+	ObservationMissChurnOutBps:          {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Basis points of tracked observations a validator can miss before being churned out, 0 to disable"},
+	MaxObservationMissChurnOut:          {Type: MimirTypeInt, Description: "Max number of nodes to be churned out due to missing observations"},
+	BadValidatorRedline:                 {Type: MimirTypeInt, Description: "Redline multiplier to find a multitude of bad actors"},
+	LackOfObservationPenalty:            {Type: MimirTypeInt, Description: "Add two slash point for each block where a node does not observe"},
+	SigningTransactionPeriod:            {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "How many blocks before a request to sign a tx by yggdrasil pool, is counted as delinquent"},
+	DoubleSignMaxAge:                    {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks to limit double signing a block"},
+	PauseBond:                           {Type: MimirTypeInt, Description: "Pauses the ability to bond"},
+	PauseUnbond:                         {Type: MimirTypeInt, Description: "Pauses the ability to unbond"},
+	BondProviderUnbondNoticeBlocks:      {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks a bond provider unbond request waits before it can execute on an active node (~3 days), 0 disables queued unbonds"},
+	LiquidBondEnabled:                   {Type: MimirTypeBool, Description: "Enable/disable converting provider bond into liquid bond receipts"},
+	NodeOperatorFeeNoticeBlocks:         {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks a node operator fee increase waits before it takes effect (~7 days), at least the bond provider unbond notice plus the churn interval, 0 applies increases immediately"},
+	MinimumBondInRune:                   {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Minimum bond of a node"},
+	MaxBondProviders:                    {Type: MimirTypeInt, Description: "Maximum number of bond providers"},
+	MaxOutboundAttempts:                 {Type: MimirTypeInt, Description: "Maximum retries to reschedule a transaction"},
+	SlashPenalty:                        {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Penalty paid (in basis points) for theft of assets"},
+	PauseOnSlashThreshold:               {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Number of rune to pause the network on the event a vault is slash for theft"},
+	FailKeygenSlashPoints:               {Type: MimirTypeInt, Description: "Slash for 720 blocks , which equals 1 hour"},
+	FailKeysignSlashPoints:              {Type: MimirTypeInt, Description: "Slash for 2 blocks"},
+	LiquidityLockUpBlocks:               {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "The number of blocks LP can withdraw after their liquidity"},
+	ObserveSlashPoints:                  {Type: MimirTypeInt, Description: "The number of slashpoints for making an observation (redeems later if observation reaches consensus"},
+	DoubleBlockSignSlashPoints:          {Type: MimirTypeInt, Description: "Slash points for double block sign (3-4 days (over 43200 blocks) rewards lost from 5 minutes (50 blocks))"},
+	MissBlockSignSlashPoints:            {Type: MimirTypeInt, Description: "Slash points for not signing a block"},
+	ObservationDelayFlexibility:         {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks of flexibility for a validator to get their slash points taken off for making an observation"},
+	JailTimeKeygen:                      {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks a node account is jailed for failing to keygen. DO NOT drop below tss timeout"},
+	JailTimeKeysign:                     {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks a node account is jailed for failing to keysign. DO NOT drop below tss timeout"},
+	NodePauseChainBlocks:                {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks that a node can pause/resume a global chain halt"},
+	NodeOperatorFee:                     {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Node operator fee"},
+	EnableDerivedAssets:                 {Type: MimirTypeBool, Description: "Enable/disable swapping of derived assets"},
+	MinSwapsPerBlock:                    {Type: MimirTypeInt, Description: "Process all swaps if queue is less than this number"},
+	MaxSwapsPerBlock:                    {Type: MimirTypeInt, Description: "Max swaps to process per block"},
+	EnableOrderBooks:                    {Type: MimirTypeBool, Description: "Enable order books instead of swap queue"},
+	EnableAdvSwapQueue:                  {Type: MimirTypeInt, Description: "Enable advanced swap queue, value of 2 skips limit swaps and forces all swaps to be market trades"},
+	TriggerSwapMaxLength:                {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Max number of blocks a stop-loss/take-profit order can rest in the advanced swap queue before expiring"},
+	SwapRouteMaxLength:                  {Type: MimirTypeInt, Description: "Max number of intermediate assets in an explicit swap route"},
+	SwapRouteMaxHopSlipBps:              {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Max swap slip (in basis points) of each pool swap of a routed swap"},
+	PriceBreakerDeviationBps:            {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Max deviation (in basis points) of a pool price from its TWAP before swaps are paused, 0 disables the breaker"},
+	PriceBreakerTWAPBlocks:              {Type: MimirTypeInt, Unit: MimirUnitBlocks, Min: 1, Description: "Number of blocks averaged in the pool price TWAP of the price breaker"},
+	PriceBreakerPauseBlocks:             {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks swaps are paused in a pool when the price breaker trips"},
+	PoolTWAPMaxBlocks:                   {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks of pool price observations kept for TWAP queries, 0 disables the observations"},
+	DerivedPoolTWAPBlocks:               {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks of the anchor pool TWAP that prices derived pools, 0 uses the anchor pool spot prices"},
+	VirtualMultSynths:                   {Type: MimirTypeInt, Description: "Pool depth multiplier for synthetic swaps"},
+	VirtualMultSynthsBasisPoints:        {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Pool depth multiplier for synthetic swaps (in basis points)"},
+	MaxSynthPerPoolDepth:                {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Percentage (in basis points) of how many synths are allowed relative to pool depth of the related pool"},
+	MaxSynthsForSaversYield:             {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Percentage (in basis points) synth per pool where synth yield reaches 0%"},
+	MinSlashPointsForBadValidator:       {Type: MimirTypeInt, Description: "Minimum slash points for a validator to be considered bad"},
+	StreamingSwapPause:                  {Type: MimirTypeInt, Description: "Pause streaming swaps from being processed or accepted"},
+	StreamingSwapMinBPFee:               {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Min swap fee (in basis points) for a streaming swap trade"},
+	StreamingSwapMaxLength:              {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Max number of blocks a streaming swap can trade for"},
+	StreamingSwapMaxLengthNative:        {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Max number of blocks native streaming swaps can trade over"},
+	MinCR:                               {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Minimum collateralization ratio (basis pts)"},
+	MaxCR:                               {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Maximum collateralization ratio (basis pts)"},
+	LoanStreamingSwapsInterval:          {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Block interval between each streaming swap of opening or closing a loan"},
+	PauseLoans:                          {Type: MimirTypeInt, Description: "Pause opening new loans and repaying loans"},
+	LoanRepaymentMaturity:               {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks before loan has reached maturity and can be repaid"},
+	LendingLever:                        {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "This controls (in basis points) how much lending is allowed relative to rune supply"},
+	MinTxOutVolumeThreshold:             {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Total txout volume (in rune) a block needs to have to slow outbound transactions"},
+	TxOutDelayRate:                      {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Outbound rune per block rate for scheduled transactions (excluding native assets)"},
+	TxOutDelayMax:                       {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Max number of blocks a transaction can be delayed"},
+	MaxTxOutOffset:                      {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Max blocks to offset a txout into a future block"},
+	TNSRegisterFee:                      {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "RUNE fee to register a THORName when USD fees are disabled"},
+	TNSRegisterFeeUSD:                   {Type: MimirTypeInt, Unit: MimirUnitUSD, Description: "Registration fee for new THORName in USD"},
+	TNSFeeOnSale:                        {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Fee for TNS sale in basis points"},
+	TNSFeePerBlock:                      {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "RUNE cost per block of a THORName when USD fees are disabled"},
+	TNSFeePerBlockUSD:                   {Type: MimirTypeInt, Unit: MimirUnitUSD, Description: "Per block cost for TNS in USD"},
+	PermittedSolvencyGap:                {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "The setting is in basis points"},
+	ValidatorMaxRewardRatio:             {Type: MimirTypeInt, Description: "The ratio to MinimumBondInRune at which validators stop receiving rewards proportional to their bond"},
+	MaxNodeToChurnOutForLowVersion:      {Type: MimirTypeInt, Description: "The maximum number of nodes to churn out for low version per churn"},
+	ChurnOutForLowVersionBlocks:         {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "The blocks after the MinJoinVersion changes before nodes can be churned out for low version"},
+	POLMaxNetworkDeposit:                {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Maximum amount of rune deposited into the pools"},
+	POLMaxPoolMovement:                  {Type: MimirTypeInt, Description: "Maximum amount of rune to enter/exit a pool per iteration - 1 equals one hundredth of a basis point of pool rune depth"},
+	POLTargetSynthPerPoolDepth:          {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Target synth per pool depth for POL (basis points)"},
+	POLBuffer:                           {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Buffer around the POL synth utilization (basis points added to/subtracted from POLTargetSynthPerPoolDepth basis points)"},
+	RagnarokProcessNumOfLPPerIteration:  {Type: MimirTypeInt, Description: "The number of LP to be processed per iteration during ragnarok pool"},
+	SynthYieldBasisPoints:               {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Amount of the yield the capital earns the synth holder receives if synth per pool is 0%"},
+	SynthYieldCycle:                     {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks when the network pays out rewards to yield bearing synths"},
+	PoolHistoryDays:                     {Type: MimirTypeInt, Description: "Number of days of per pool fee, reward and synth yield history kept, 0 disables the history"},
+	MinimumL1OutboundFeeUSD:             {Type: MimirTypeInt, Unit: MimirUnitUSD, Description: "Minimum fee in USD to charge for LP swap, default to $0.01 , nodes need to vote it to a larger value"},
+	MinimumPoolLiquidityFee:             {Type: MimirTypeInt, Description: "Minimum liquidity fee made by the pool,active pool fail to meet this within a PoolCycle will be demoted"},
+	ChurnMigrateRounds:                  {Type: MimirTypeInt, Description: "Number of rounds to migrate vaults during churn"},
+	AllowWideBlame:                      {Type: MimirTypeBool, Description: "Allow for a wide blame, only set in mocknet for regression testing tss keysign failures"},
+	MaxAffiliateFeeBasisPoints:          {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Max allowed affiliate fee basis points"},
+	TargetOutboundFeeSurplusRune:        {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Target amount of RUNE for Outbound Fee Surplus: the sum of the diff between outbound cost to user and outbound cost to network"},
+	MaxOutboundFeeMultiplierBasisPoints: {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Maximum multiplier applied to base outbound fee charged to user, in basis points"},
+	MinOutboundFeeMultiplierBasisPoints: {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Minimum multiplier applied to base outbound fee charged to user, in basis points"},
+	EnableUSDFees:                       {Type: MimirTypeBool, Description: "Enable USD fees"},
+	PreferredAssetOutboundFeeMultiplier: {Type: MimirTypeInt, Description: "Multiplier of the current preferred asset outbound fee, if rune balance > multiplier * outbound_fee, a preferred asset swap is triggered"},
+	FeeUSDRoundSignificantDigits:        {Type: MimirTypeInt, Description: "Number of significant digits to round the RUNE value of USD denominated fees"},
+	MigrationVaultSecurityBps:           {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Vault bond must be greater than bps of funds value in rune to receive migrations"},
+	CloutReset:                          {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks before clout spent gets reset"},
+	CloutLimit:                          {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Max clout allowed to spend"},
+	KeygenRetryInterval:                 {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks to wait before retrying a keygen"},
+	SaversStreamingSwapsInterval:        {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "For Savers deposits and withdraws, the streaming swaps interval to use for the Native <> Synth swap"},
+	RescheduleCoalesceBlocks:            {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks to coalesce rescheduled outbounds"},
+	TradeAccountsEnabled:                {Type: MimirTypeBool, Description: "Enable/disable trade account"},
+	TradeAccountsDepositEnabled:         {Type: MimirTypeBool, Description: "Enable/disable deposits into trade accounts"},
+	RecurringSwapsEnabled:               {Type: MimirTypeBool, Description: "Enable/disable recurring swaps funded from trade accounts"},
+	RecurringSwapMinInterval:            {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Min number of blocks between the swaps of a recurring swap"},
+	RecurringSwapMaxQuantity:            {Type: MimirTypeInt, Description: "Max number of swaps a recurring swap can make"},
+	RecurringSwapFillMaxRetryBlocks:     {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks a failed recurring swap that cannot be credited back is retried before the recurring swap is cancelled"},
+	EVMDisableContractWhitelist:         {Type: MimirTypeBool, Description: "Enable/disable contract whitelist"},
+	OperationalVotesMin:                 {Type: MimirTypeInt, Description: "Minimum node votes to set an Operational Mimir"},
+	MimirHistoryBlocks:                  {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks of mimir value and node vote history kept, 0 disables the history"},
+	L1SlipMinBps:                        {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Minimum L1 asset swap fee in basis points"},
+	TradeAccountsSlipMinBps:             {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Minimum trade asset swap fee in basis points"},
+	SecuredAssetSlipMinBps:              {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Minimum secured asset swap fee in basis points"},
+	SynthSlipMinBps:                     {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Minimum synth asset swap fee in basis points"},
+	DerivedSlipMinBps:                   {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Minimum derived asset swap fee in basis points"},
+	RUNEPoolEnabled:                     {Type: MimirTypeBool, Description: "Enable/disable RUNE Pool"},
+	RUNEPoolDepositMaturityBlocks:       {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks from last deposit to allow withdraw"},
+	RUNEPoolMaxReserveBackstop:          {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "5 million RUNE"},
+	SaversEjectInterval:                 {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Number of blocks for savers check, disabled if zero"},
+	SystemIncomeBurnRateBps:             {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Burn 1bps (0.01%) RUNE of all system income per ADR 17"},
+	DevFundSystemIncomeBps:              {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Allocate 500bps (5%) RUNE of all system income to dev fund per ADR 18"},
+	PendulumAssetsBasisPoints:           {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Incentive curve adjustment lever to proportionally underestimate or overestimate Assets needing to be secured"},
+	PendulumUseEffectiveSecurity:        {Type: MimirTypeBool, Description: "If 1, use the effective security bond (the bond sacrificable to seize L1 Assets) as the securing bond for which to target double the value of the secured Assets. If 0, instead use the whole (rewards-receiving) total effective bond"},
+	PendulumUseVaultAssets:              {Type: MimirTypeBool, Description: "If 1. use the L1 Assets in the vaults (the Assets seizable by the lower-bond 2/3rds of nodes in each vault) as the Assets to be secured.  If 0, instead use only the L1 Assets in pools, ignoring the L1 Assets in for instance streaming swaps, oversolvencies, and Trade/Bridge Assets"},
+	TVLCapBasisPoints:                   {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "If 0, TVL Cap is set to the effective active bond. If non-zero, the value is interrupted as basis points relative to total active bond"},
+	MultipleAffiliatesMaxCount:          {Type: MimirTypeInt, Description: "Maximum number of nested affiliates"},
+	MultipleDestinationsMaxCount:        {Type: MimirTypeInt, Description: "Maximum number of recipients of a split-destination swap"},
+	BondSlashBan:                        {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "5000 RUNE - amount to slash bond of banned nodes"},
+	BankSendEnabled:                     {Type: MimirTypeBool, Description: "Enable/disable cosmos bank send messages"},
+	RUNEPoolHaltDeposit:                 {Type: MimirTypeHeight, Description: "Enable/disable RUNEPool deposit (block height)"},
+	RUNEPoolHaltWithdraw:                {Type: MimirTypeHeight, Description: "Enable/disable RUNEPool withdraw (block height)"},
+	MinRuneForTCYStakeDistribution:      {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Set what is the minimum amount of rune need it on TCY fund in order to be distributed"},
+	MinTCYForTCYStakeDistribution:       {Type: MimirTypeInt, Description: "Set what is the minimum amount of TCY need it on TCY fund in order to be distributed"},
+	TCYStakeSystemIncomeBps:             {Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Allocate 1000bps (10%) RUNE of all system income to TCY Fund"},
+	TCYClaimingSwapHalt:                 {Type: MimirTypeInt, Description: "Enable/disable claiming module rune to tcy swap"},
+	TCYStakeDistributionHalt:            {Type: MimirTypeInt, Description: "Enable/disable tcy stake distribution"},
+	TCYStakingHalt:                      {Type: MimirTypeInt, Description: "Enable/disable tcy staking"},
+	TCYUnstakingHalt:                    {Type: MimirTypeInt, Description: "Enable/disable tcy unstaking"},
+	TCYClaimingHalt:                     {Type: MimirTypeInt, Description: "Enable/disable tcy claiming"},
+	HaltRebond:                          {Type: MimirTypeInt, Description: "Halts rebonding when greater than zero"},
+	HaltOperatorRotate:                  {Type: MimirTypeInt, Description: "Halts node operator rotation when greater than zero"},
+	ArtificialRagnarokBlockHeight:       {Type: MimirTypeHeight, Description: "Block height to start an artificial ragnarok"},
+	BondLockupPeriod:                    {Type: MimirTypeInt, Unit: MimirUnitBlocks, Description: "Blocks a bond is locked after a node is churned out"},
+	BurnSynths:                          {Type: MimirTypeHeight, Description: "Block height from which synths can no longer be burned"},
+	ManualSwapsToSynthDisabled:          {Type: MimirTypeBool, Description: "Disable swaps to synths that are not part of savers"},
+	MaximumLiquidityRune:                {Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Maximum RUNE liquidity that can be deposited into pools"},
+	MintSynths:                          {Type: MimirTypeHeight, Description: "Block height from which synths can no longer be minted"},
+	NumberOfNewNodesPerChurn:            {Type: MimirTypeInt, Description: "Number of new nodes to churn in per churn"},
+	SignerConcurrency:                   {Type: MimirTypeInt, Description: "Number of concurrent signers for active and retiring vaults"},
+	SwapOutDexAggregationDisabled:       {Type: MimirTypeBool, Description: "Disable swaps out through DEX aggregators"},
+}
+
+// keyMimirs declares the mimir keys and key templates that are not constants
+var keyMimirs = []MimirDefinition{
+	{Key: "HaltTrading", Type: MimirTypeHeight, Description: "Halts trading globally"},
+	{Key: "Halt%sTrading", Type: MimirTypeHeight, Description: "Halts trading of a chain"},
+	{Key: "HaltTCYTrading", Type: MimirTypeHeight, Description: "Halts trading of TCY"},
+	{Key: "HaltChainGlobal", Type: MimirTypeHeight, Description: "Halts observation and signing of all chains"},
+	{Key: "Halt%sChain", Type: MimirTypeHeight, Description: "Halts observation and signing of a chain"},
+	{Key: "SolvencyHalt%sChain", Type: MimirTypeHeight, Description: "Halts a chain after a failed solvency check"},
+	{Key: "NodePauseChainGlobal", Type: MimirTypeHeight, Description: "Pauses all chains until the height, set by node pause chain messages"},
+	{Key: "HaltChurning", Type: MimirTypeHeight, Description: "Halts churning"},
+	{Key: "HaltSigning", Type: MimirTypeHeight, Description: "Halts signing of all chains"},
+	{Key: "HaltSigning%s", Type: MimirTypeHeight, Description: "Halts signing of a chain"},
+	{Key: "PauseLP", Type: MimirTypeHeight, Description: "Pauses adding and withdrawing liquidity for all chains"},
+	{Key: "PauseLP%s", Type: MimirTypeHeight, Description: "Pauses adding and withdrawing liquidity for a chain"},
+	{Key: MimirTemplatePauseLPDeposit, Type: MimirTypeInt, Description: "Pauses deposits into a pool when greater than zero"},
+	{Key: MimirTemplatePoolHalt, Type: MimirTypeHeight, Description: "Halts trading, liquidity, trade and secured asset deposits and withdrawals of a pool"},
+	{Key: MimirTemplatePoolResume, Type: MimirTypeHeight, Description: "Resumes a pool halted by HaltPool-%s before the height"},
+	{Key: MimirTemplateSolvencyPoolHalt, Type: MimirTypeHeight, Description: "Halts a pool after a failed solvency check of the asset"},
+	{Key: MimirKeySolvencyHaltPools, Type: MimirTypeBool, Description: "Halts the insolvent token pools instead of the chain when only tokens fail a solvency check"},
+	{Key: "PauseAsymWithdrawal-%s", Type: MimirTypeInt, Description: "Pauses asymmetric withdrawals from the pools of a chain when greater than zero"},
+	{Key: "StopSolvencyCheck", Type: MimirTypeInt, Description: "Stops the solvency checker of all chains when greater than zero"},
+	{Key: "StopSolvencyCheck%s", Type: MimirTypeInt, Description: "Stops the solvency checker of a chain when greater than zero"},
+	{Key: "Ragnarok-%s", Type: MimirTypeInt, Description: "Ragnaroks a pool when greater than zero"},
+	{Key: "Lending-%s", Type: MimirTypeInt, Description: "Enables lending against a collateral asset when greater than zero"},
+	{Key: "POL-%s", Type: MimirTypeInt, Description: "Enables protocol owned liquidity for a pool when greater than zero"},
+	{Key: "TorAnchor-%s", Type: MimirTypeInt, Description: "Uses a pool as an anchor for the TOR price when greater than zero"},
+	{Key: "THORNames", Type: MimirTypeInt, Description: "Disables THORName registration when less than zero"},
+	{Key: "MaximumBondInRune", Type: MimirTypeInt, Unit: MimirUnitRune, Description: "Maximum bond of a node"},
+	{Key: "DollarsPerRune", Type: MimirTypeInt, Unit: MimirUnitUSD, Description: "Fixed price of RUNE in USD, used when there are no anchor pools"},
+	{Key: "MaxUTXOsToSpend", Type: MimirTypeInt, Description: "Maximum number of UTXOs to spend in a single transaction"},
+	{Key: MimirTemplateConfMultiplierBasisPoints, Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Description: "Multiplier of the confirmations required for a chain"},
+	{Key: MimirTemplateMaxConfirmations, Type: MimirTypeInt, Description: "Maximum confirmations required for a chain"},
+	{Key: MimirTemplateSwapSlipBasisPointsMin, Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000, Description: "Minimum swap fee of an asset type"},
+	{Key: MimirKeySecuredAssetHaltGlobal, Type: MimirTypeHeight, Description: "Halts secured asset deposits and withdrawals of all chains"},
+	{Key: MimirTemplateSecuredAssetHaltDeposit, Type: MimirTypeHeight, Description: "Halts secured asset deposits of a chain"},
+	{Key: MimirTemplateSecuredAssetHaltWithdraw, Type: MimirTypeHeight, Description: "Halts secured asset withdrawals of a chain"},
+	{Key: MimirKeyTradeAccountHaltTransfer, Type: MimirTypeHeight, Description: "Halts trade account transfers of all chains"},
+	{Key: MimirTemplateTradeAccountHaltTransfer, Type: MimirTypeHeight, Description: "Halts trade account transfers of a chain"},
+	{Key: MimirKeyWasmPermissionless, Type: MimirTypeBool, Description: "Allows anyone to deploy wasm contracts"},
+	{Key: MimirKeyWasmHaltGlobal, Type: MimirTypeHeight, Description: "Halts all wasm contracts"},
+	{Key: MimirKeyWasmMinGasPrice, Type: MimirTypeInt, Description: "Minimum gas price of wasm transactions"},
+	{Key: MimirTemplateWasmHaltChecksum, Type: MimirTypeHeight, Description: "Halts the wasm contracts of a code checksum"},
+	{Key: MimirTemplateWasmHaltContract, Type: MimirTypeHeight, Description: "Halts a wasm contract"},
+	{Key: MimirTemplateWasmHaltDeployer, Type: MimirTypeHeight, Description: "Halts the wasm contracts of a deployer"},
+	{Key: MimirTemplateSwitch, Type: MimirTypeInt, Description: "Enables switching a token to its native denom when greater than zero"},
+	{Key: MimirTemplateTokenDecimals, Type: MimirTypeInt, Description: "Decimals of a whitelisted token"},
+	{Key: MimirTemplateTokenEnergyLimit, Type: MimirTypeInt, Description: "Energy limit of a whitelisted token transfer"},
+	{Key: MimirMaxBatchSize, Type: MimirTypeInt, Description: "Maximum number of attestations in a gossip batch"},
+	{Key: MimirPeerConcurrentSends, Type: MimirTypeInt, Description: "Maximum concurrent attestation sends per peer"},
+	{Key: MimirPeerConcurrentReceives, Type: MimirTypeInt, Description: "Maximum concurrent attestation receives per peer"},
+	{Key: "MimirRecallFund%s", Type: MimirTypeInt, Description: "Recalls the funds of a chain router, used for router upgrades only"},
+	{Key: "MimirUpgradeContract%s", Type: MimirTypeInt, Description: "Upgrades the router contract of a chain, used for router upgrades only"},
+}
+
+var (
+	mimirKeys      = map[string]MimirDefinition{}
+	mimirTemplates []MimirDefinition
+)
+
+func init() {
+	for name, def := range constantMimirs {
+		def.Key = name.String()
+		registerMimir(def)
+	}
+	for _, def := range keyMimirs {
+		registerMimir(def)
+	}
+}
+
+// registerMimir adds a definition to the mimir registry
+func registerMimir(def MimirDefinition) {
+	if !def.IsTemplate() {
+		mimirKeys[strings.ToUpper(def.Key)] = def
+		return
+	}
+
+	parts := strings.Split(def.Key, "%s")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	def.pattern = regexp.MustCompile(`(?i)^` + strings.Join(parts, `[a-zA-Z0-9-]+`) + `$`)
+	mimirTemplates = append(mimirTemplates, def)
+
+	// check the most specific templates first, so PauseLPDeposit-%s is preferred
+	// to PauseLP%s
+	sort.SliceStable(mimirTemplates, func(i, j int) bool {
+		return len(mimirTemplates[i].Key) > len(mimirTemplates[j].Key)
+	})
+}
+
+// GetMimirDefinition returns the registry definition of a mimir key
+func GetMimirDefinition(key string) (MimirDefinition, bool) {
+	if def, ok := mimirKeys[strings.ToUpper(key)]; ok {
+		return def, true
+	}
+	for _, def := range mimirTemplates {
+		if def.Matches(key) {
+			return def, true
+		}
+	}
+	return MimirDefinition{}, false
+}
+
+// GetMimirRegistry returns all the registered mimir keys and key templates,
+// sorted by key
+func GetMimirRegistry() []MimirDefinition {
+	defs := make([]MimirDefinition, 0, len(mimirKeys)+len(mimirTemplates))
+	// analyze-ignore(map-iteration)
+	for _, def := range mimirKeys {
+		defs = append(defs, def)
+	}
+	defs = append(defs, mimirTemplates...)
+	sort.Slice(defs, func(i, j int) bool {
+		return strings.ToUpper(defs[i].Key) < strings.ToUpper(defs[j].Key)
+	})
+	return defs
+}
@@ -0,0 +1,257 @@
+package constants
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type MimirRegistryTestSuite struct{}
+
+var _ = Suite(&MimirRegistryTestSuite{})
+
+func (MimirRegistryTestSuite) TestConstantsRegistered(c *C) {
+	consts := NewConstantValue()
+	for name := range consts.int64values {
+		def, ok := GetMimirDefinition(name.String())
+		c.Check(ok, Equals, true, Commentf("%s is not registered", name))
+		c.Check(def.Key, Equals, name.String())
+		c.Check(def.ValidateValue(consts.GetInt64Value(name)), IsNil, Commentf("%s default is out of bounds", name))
+	}
+}
+
+func (MimirRegistryTestSuite) TestGetMimirDefinition(c *C) {
+	def, ok := GetMimirDefinition("maxaffiliatefeebasispoints")
+	c.Assert(ok, Equals, true)
+	c.Check(def.Key, Equals, "MaxAffiliateFeeBasisPoints")
+	c.Check(def.Unit, Equals, MimirUnitBasisPoints)
+	c.Check(def.IsTemplate(), Equals, false)
+
+	def, ok = GetMimirDefinition("HALTETHTRADING")
+	c.Assert(ok, Equals, true)
+	c.Check(def.Key, Equals, "Halt%sTrading")
+	c.Check(def.IsTemplate(), Equals, true)
+
+	// the most specific template is matched
+	def, ok = GetMimirDefinition("PauseLPDeposit-BTC-BTC")
+	c.Assert(ok, Equals, true)
+	c.Check(def.Key, Equals, MimirTemplatePauseLPDeposit)
+	def, ok = GetMimirDefinition("PauseLPBTC")
+	c.Assert(ok, Equals, true)
+	c.Check(def.Key, Equals, "PauseLP%s")
+
+	def, ok = GetMimirDefinition("EnableSwitch-GAIA-KUJI")
+	c.Assert(ok, Equals, true)
+	c.Check(def.Key, Equals, MimirTemplateSwitch)
+
+	_, ok = GetMimirDefinition("NotARegisteredMimir")
+	c.Check(ok, Equals, false)
+	_, ok = GetMimirDefinition("HaltTradingX")
+	c.Check(ok, Equals, false)
+	_, ok = GetMimirDefinition("Halt.Trading")
+	c.Check(ok, Equals, false)
+}
+
+func (MimirRegistryTestSuite) TestValidateValue(c *C) {
+	bps := MimirDefinition{Key: "Bps", Type: MimirTypeInt, Unit: MimirUnitBasisPoints, Max: 10_000}
+	c.Check(bps.ValidateValue(0), IsNil)
+	c.Check(bps.ValidateValue(10_000), IsNil)
+	c.Check(bps.ValidateValue(10_001), NotNil)
+	c.Check(bps.ValidateValue(-1), NotNil)
+
+	enabled := MimirDefinition{Key: "Enabled", Type: MimirTypeBool}
+	c.Check(enabled.ValidateValue(0), IsNil)
+	c.Check(enabled.ValidateValue(1), IsNil)
+	c.Check(enabled.ValidateValue(2), NotNil)
+
+	height := MimirDefinition{Key: "Height", Type: MimirTypeHeight}
+	c.Check(height.ValidateValue(1), IsNil)
+	c.Check(height.ValidateValue(123_456_789), IsNil)
+}
+
+func (MimirRegistryTestSuite) TestGetMimirRegistry(c *C) {
+	defs := GetMimirRegistry()
+	c.Assert(len(defs) > len(constantMimirs), Equals, true)
+	for i := 1; i < len(defs); i++ {
+		c.Check(strings.ToUpper(defs[i-1].Key) < strings.ToUpper(defs[i].Key), Equals, true)
+	}
+}
+
+// mimirKeyResolver resolves the mimir key arguments of GetMimir and
+// GetMimirWithRef calls to the key, or key template, they read
+type mimirKeyResolver struct {
+	consts map[string]string
+}
+
+// resolve returns the string value of a key expression, with any unresolved
+// %s placeholders left in place
+func (r mimirKeyResolver) resolve(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return "", false
+		}
+		value, err := strconv.Unquote(e.Value)
+		return value, err == nil
+	case *ast.Ident:
+		if value, ok := r.consts[e.Name]; ok {
+			return value, true
+		}
+		if e.Obj == nil {
+			return "", false
+		}
+		switch decl := e.Obj.Decl.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range decl.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok && id.Name == e.Name && i < len(decl.Rhs) {
+					return r.resolve(decl.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range decl.Names {
+				if name.Name == e.Name && i < len(decl.Values) {
+					return r.resolve(decl.Values[i])
+				}
+			}
+		}
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok && pkg.Name == "constants" {
+			value, ok := r.consts[e.Sel.Name]
+			return value, ok
+		}
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return "", false
+		}
+		// constants.<ConstantName>.String()
+		if sel.Sel.Name == "String" && len(e.Args) == 0 {
+			if inner, ok := sel.X.(*ast.SelectorExpr); ok {
+				if pkg, ok := inner.X.(*ast.Ident); ok && pkg.Name == "constants" {
+					return inner.Sel.Name, true
+				}
+			}
+			return "", false
+		}
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "fmt" && sel.Sel.Name == "Sprintf" && len(e.Args) > 0 {
+			format, ok := r.resolve(e.Args[0])
+			if !ok {
+				return "", false
+			}
+			return r.format(format, e.Args[1:]), true
+		}
+	}
+	return "", false
+}
+
+// format fills the %s placeholders of a template with the resolved arguments,
+// leaving the placeholders of arguments that can't be resolved
+func (r mimirKeyResolver) format(template string, args []ast.Expr) string {
+	for _, arg := range args {
+		value, ok := r.resolve(arg)
+		if !ok {
+			value = "%s"
+		}
+		i := strings.Index(template, "%s")
+		if i < 0 {
+			break
+		}
+		template = template[:i] + value + "\x00" + template[i+2:]
+	}
+	return strings.ReplaceAll(template, "\x00", "")
+}
+
+func (MimirRegistryTestSuite) TestMimirCallsRegistered(c *C) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, dir := range []string{".", "../x", "../bifrost"} {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if dir == "." && path != "." {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, ".pb.go") {
+				return nil
+			}
+			file, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return err
+			}
+			files = append(files, file)
+			return nil
+		})
+		c.Assert(err, IsNil)
+	}
+
+	// package level string constants, keyed by name
+	resolver := mimirKeyResolver{consts: map[string]string{}}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if i >= len(vs.Values) {
+						continue
+					}
+					if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+						resolver.consts[name.Name], _ = strconv.Unquote(lit.Value)
+					}
+				}
+			}
+		}
+	}
+
+	resolved := 0
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			var key string
+			switch sel.Sel.Name {
+			case "GetMimir":
+				key, ok = resolver.resolve(call.Args[len(call.Args)-1])
+			case "GetMimirWithRef":
+				// the template is the first resolvable argument, followed by the refs
+				for i, arg := range call.Args {
+					if key, ok = resolver.resolve(arg); ok {
+						key = resolver.format(key, call.Args[i+1:])
+						break
+					}
+				}
+			default:
+				return true
+			}
+			if !ok {
+				// keys passed through as parameters are checked at their source
+				return true
+			}
+			resolved++
+			sample := strings.ReplaceAll(key, "%s", "BTC")
+			_, found := GetMimirDefinition(sample)
+			c.Check(found, Equals, true, Commentf("%s: mimir %s is not registered", fset.Position(call.Pos()), key))
+			return true
+		})
+	}
+	c.Check(resolved > 50, Equals, true, Commentf("only resolved %d mimir calls", resolved))
+}
@@ -5,21 +5,29 @@ package constants
 // Each string should clearly indicate its usage for the final Mimir key (key, template, reference)
 // and no Mimir key should require the combination of more than two strings.
 const (
-	MimirKeySecuredAssetHaltGlobal = "HaltSecuredGlobal"
-	MimirKeyWasmPermissionless     = "WasmPermissionless"
-	MimirKeyWasmHaltGlobal         = "HaltWasmGlobal"
-	MimirKeyWasmMinGasPrice        = "WasmMinGasPrice"
+	MimirKeySecuredAssetHaltGlobal   = "HaltSecuredGlobal"
+	MimirKeyTradeAccountHaltTransfer = "HaltTradeTransfer"
+	MimirKeyWasmPermissionless       = "WasmPermissionless"
+	MimirKeyWasmHaltGlobal           = "HaltWasmGlobal"
+	MimirKeyWasmMinGasPrice          = "WasmMinGasPrice"
+	MimirKeySolvencyHaltPools        = "SolvencyHaltPools"
 
 	MimirTemplateConfMultiplierBasisPoints = "ConfMultiplierBasisPoints-%s" // Use with Chain
 	MimirTemplateMaxConfirmations          = "MaxConfirmations-%s"          // Use with Chain
 	MimirTemplateSwapSlipBasisPointsMin    = "SwapSlipBasisPointsMin-%s"    // Use with MimirRef
 	MimirTemplateSecuredAssetHaltDeposit   = "HaltSecuredDeposit-%s"        // Use with Chain
 	MimirTemplateSecuredAssetHaltWithdraw  = "HaltSecuredWithdraw-%s"       // Use with Chain
+	MimirTemplateTradeAccountHaltTransfer  = "HaltTradeTransfer-%s"         // Use with Chain
 	MimirTemplateWasmHaltChecksum          = "HaltWasmCs-%s"                // Encode the checksum to base32 to fit within mimir's 64 char limit and case insenstivity. Truncate trailing `=` for brevity
 	MimirTemplateWasmHaltContract          = "HaltWasmContract-%s"          // Use contract address checksum (last 6) for brevity and to fit inside mimir's 64 char length
 	MimirTemplateWasmHaltDeployer          = "HaltWasmDeployer-%s"          // Use deployer address (last 6) to prevent a deployer from instantiating new contracts
 	MimirTemplateSwitch                    = "EnableSwitch-%s-%s"           // Use with Chain, Symbol
 	MimirTemplatePauseLPDeposit            = "PauseLPDeposit-%s"            // Use with Asset MimirString
+	MimirTemplatePoolHalt                  = "HaltPool-%s"                  // Use with Asset MimirString
+	MimirTemplatePoolResume                = "ResumePool-%s"                // Use with Asset MimirString
+	MimirTemplateSolvencyPoolHalt          = "SolvencyHaltPool-%s"          // Use with Asset MimirString
+	MimirTemplateTokenDecimals             = "TokenDecimals-%s-%s"          // Use with Chain, Symbol-HexContractAddress (hex keeps the address case insensitive)
+	MimirTemplateTokenEnergyLimit          = "TokenEnergyLimit-%s-%s"       // Use with Chain, Symbol-HexContractAddress
 
 	MimirRefL1           = "L1"           // Use with SwapSlipBasisPoints
 	MimirRefSynth        = "Synth"        // Use with SwapSlipBasisPoints
@@ -28,6 +28,7 @@ type ApiDpoolRequest struct {
 	ApiService *PoolsApiService
 	asset string
 	height *int64
+	twapBlocks *int64
 }
 
 // optional block height, defaults to current tip
@@ -36,6 +37,12 @@ func (r ApiDpoolRequest) Height(height int64) ApiDpoolRequest {
 	return r
 }
 
+// the number of blocks of the anchor pool TWAP returned in anchor_twap_price
+func (r ApiDpoolRequest) TwapBlocks(twapBlocks int64) ApiDpoolRequest {
+	r.twapBlocks = &twapBlocks
+	return r
+}
+
 func (r ApiDpoolRequest) Execute() (*DerivedPool, *http.Response, error) {
 	return r.ApiService.DpoolExecute(r)
 }
@@ -82,6 +89,9 @@ func (a *PoolsApiService) DpoolExecute(r ApiDpoolRequest) (*DerivedPool, *http.R
 	if r.height != nil {
 		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
 	}
+	if r.twapBlocks != nil {
+		localVarQueryParams.Add("twap_blocks", parameterToString(*r.twapBlocks, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -358,6 +368,252 @@ func (a *PoolsApiService) PoolExecute(r ApiPoolRequest) (*Pool, *http.Response,
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiPoolHistoryRequest struct {
+	ctx context.Context
+	ApiService *PoolsApiService
+	asset string
+	height *int64
+	interval *string
+}
+
+// optional block height, defaults to current tip
+func (r ApiPoolHistoryRequest) Height(height int64) ApiPoolHistoryRequest {
+	r.height = &height
+	return r
+}
+
+// the length of each returned interval, day or week
+func (r ApiPoolHistoryRequest) Interval(interval string) ApiPoolHistoryRequest {
+	r.interval = &interval
+	return r
+}
+
+func (r ApiPoolHistoryRequest) Execute() (*PoolHistoryResponse, *http.Response, error) {
+	return r.ApiService.PoolHistoryExecute(r)
+}
+
+/*
+PoolHistory Method for PoolHistory
+
+Returns the liquidity fee, block reward, synth yield and POL history of the pool for the provided asset.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param asset
+ @return ApiPoolHistoryRequest
+*/
+func (a *PoolsApiService) PoolHistory(ctx context.Context, asset string) ApiPoolHistoryRequest {
+	return ApiPoolHistoryRequest{
+		ApiService: a,
+		ctx: ctx,
+		asset: asset,
+	}
+}
+
+// Execute executes the request
+//  @return PoolHistoryResponse
+func (a *PoolsApiService) PoolHistoryExecute(r ApiPoolHistoryRequest) (*PoolHistoryResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *PoolHistoryResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PoolsApiService.PoolHistory")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/thorchain/pool/{asset}/history"
+	localVarPath = strings.Replace(localVarPath, "{"+"asset"+"}", url.PathEscape(parameterToString(r.asset, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.interval != nil {
+		localVarQueryParams.Add("interval", parameterToString(*r.interval, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiPoolTwapRequest struct {
+	ctx context.Context
+	ApiService *PoolsApiService
+	asset string
+	height *int64
+	blocks *int64
+}
+
+// optional block height, defaults to current tip
+func (r ApiPoolTwapRequest) Height(height int64) ApiPoolTwapRequest {
+	r.height = &height
+	return r
+}
+
+// the number of blocks to average the pool price over, up to PoolTWAPMaxBlocks
+func (r ApiPoolTwapRequest) Blocks(blocks int64) ApiPoolTwapRequest {
+	r.blocks = &blocks
+	return r
+}
+
+func (r ApiPoolTwapRequest) Execute() (*PoolTWAPResponse, *http.Response, error) {
+	return r.ApiService.PoolTwapExecute(r)
+}
+
+/*
+PoolTwap Method for PoolTwap
+
+Returns the time weighted average price of the pool for the provided asset over the given number of blocks.
+
+ @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ @param asset
+ @return ApiPoolTwapRequest
+*/
+func (a *PoolsApiService) PoolTwap(ctx context.Context, asset string) ApiPoolTwapRequest {
+	return ApiPoolTwapRequest{
+		ApiService: a,
+		ctx: ctx,
+		asset: asset,
+	}
+}
+
+// Execute executes the request
+//  @return PoolTWAPResponse
+func (a *PoolsApiService) PoolTwapExecute(r ApiPoolTwapRequest) (*PoolTWAPResponse, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		formFiles            []formFile
+		localVarReturnValue  *PoolTWAPResponse
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "PoolsApiService.PoolTwap")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/thorchain/pool/{asset}/twap"
+	localVarPath = strings.Replace(localVarPath, "{"+"asset"+"}", url.PathEscape(parameterToString(r.asset, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.height != nil {
+		localVarQueryParams.Add("height", parameterToString(*r.height, ""))
+	}
+	if r.blocks != nil {
+		localVarQueryParams.Add("blocks", parameterToString(*r.blocks, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := ioutil.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiPoolsRequest struct {
 	ctx context.Context
 	ApiService *PoolsApiService
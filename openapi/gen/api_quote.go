@@ -643,6 +643,7 @@ type ApiQuoteswapRequest struct {
 	liquidityToleranceBps *int64
 	affiliateBps *int64
 	affiliate *string
+	route *string
 }
 
 // optional block height, defaults to current tip
@@ -717,6 +718,12 @@ func (r ApiQuoteswapRequest) Affiliate(affiliate string) ApiQuoteswapRequest {
 	return r
 }
 
+// the intermediate assets to route the swap through separated by >, defaults to the best route found
+func (r ApiQuoteswapRequest) Route(route string) ApiQuoteswapRequest {
+	r.route = &route
+	return r
+}
+
 func (r ApiQuoteswapRequest) Execute() (*QuoteSwapResponse, *http.Response, error) {
 	return r.ApiService.QuoteswapExecute(r)
 }
@@ -793,6 +800,9 @@ func (a *QuoteApiService) QuoteswapExecute(r ApiQuoteswapRequest) (*QuoteSwapRes
 	if r.affiliate != nil {
 		localVarQueryParams.Add("affiliate", parameterToString(*r.affiliate, ""))
 	}
+	if r.route != nil {
+		localVarQueryParams.Add("route", parameterToString(*r.route, ""))
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
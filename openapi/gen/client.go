@@ -82,6 +82,8 @@ type APIClient struct {
 
 	QuoteApi *QuoteApiService
 
+	RecurringSwapApi *RecurringSwapApiService
+
 	RUNEPoolApi *RUNEPoolApiService
 
 	SaversApi *SaversApiService
@@ -148,6 +150,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.PoolsApi = (*PoolsApiService)(&c.common)
 	c.QueueApi = (*QueueApiService)(&c.common)
 	c.QuoteApi = (*QuoteApiService)(&c.common)
+	c.RecurringSwapApi = (*RecurringSwapApiService)(&c.common)
 	c.RUNEPoolApi = (*RUNEPoolApiService)(&c.common)
 	c.SaversApi = (*SaversApiService)(&c.common)
 	c.SecuredAssetApi = (*SecuredAssetApiService)(&c.common)
@@ -23,6 +23,8 @@ type DerivedPool struct {
 	BalanceRune string `json:"balance_rune"`
 	// the depth of the derived virtual pool relative to L1 pool (in basis points)
 	DerivedDepthBps string `json:"derived_depth_bps"`
+	// the median time weighted average price of the anchor pools over twap_blocks, in asset per RUNE (1e8)
+	AnchorTwapPrice *string `json:"anchor_twap_price,omitempty"`
 }
 
 // NewDerivedPool instantiates a new DerivedPool object
@@ -199,6 +201,38 @@ func (o *DerivedPool) SetDerivedDepthBps(v string) {
 	o.DerivedDepthBps = v
 }
 
+// GetAnchorTwapPrice returns the AnchorTwapPrice field value if set, zero value otherwise.
+func (o *DerivedPool) GetAnchorTwapPrice() string {
+	if o == nil || o.AnchorTwapPrice == nil {
+		var ret string
+		return ret
+	}
+	return *o.AnchorTwapPrice
+}
+
+// GetAnchorTwapPriceOk returns a tuple with the AnchorTwapPrice field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DerivedPool) GetAnchorTwapPriceOk() (*string, bool) {
+	if o == nil || o.AnchorTwapPrice == nil {
+		return nil, false
+	}
+	return o.AnchorTwapPrice, true
+}
+
+// HasAnchorTwapPrice returns a boolean if a field has been set.
+func (o *DerivedPool) HasAnchorTwapPrice() bool {
+	if o != nil && o.AnchorTwapPrice != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetAnchorTwapPrice gets a reference to the given string and assigns it to the AnchorTwapPrice field.
+func (o *DerivedPool) SetAnchorTwapPrice(v string) {
+	o.AnchorTwapPrice = &v
+}
+
 func (o DerivedPool) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -219,6 +253,9 @@ func (o DerivedPool) MarshalJSON_deprecated() ([]byte, error) {
 	if true {
 		toSerialize["derived_depth_bps"] = o.DerivedDepthBps
 	}
+	if o.AnchorTwapPrice != nil {
+		toSerialize["anchor_twap_price"] = o.AnchorTwapPrice
+	}
 	return json.Marshal(toSerialize)
 }
 
@@ -35,12 +35,20 @@ type MsgSwap struct {
 	AggregatorTargetAddress *string `json:"aggregator_target_address,omitempty"`
 	// the minimum amount of SwapOut asset to receive (else cancelling the SwapOut and receiving THORChain's output)
 	AggregatorTargetLimit *string `json:"aggregator_target_limit,omitempty"`
-	// market if immediately completed or refunded, limit if held until fulfillable
+	// market if immediately completed or refunded, limit if held until fulfillable, stop_loss or take_profit if held until the pool price crosses the trigger (trade_target) and then executed as a market swap
 	SwapType *string `json:"swap_type,omitempty"`
 	// number of swaps to execute in a streaming swap
 	StreamQuantity *int64 `json:"stream_quantity,omitempty"`
 	// the interval (in blocks) to execute the streaming swap
 	StreamInterval *int64 `json:"stream_interval,omitempty"`
+	// the block height at which an untriggered stop_loss or take_profit order is refunded
+	Expiry *int64 `json:"expiry,omitempty"`
+	// the recipients of a split-destination swap, the first is also the destination
+	Destinations []string `json:"destinations,omitempty"`
+	// the share of the swap output paid to each of the destinations, in basis points
+	DestinationsBasisPoints []string `json:"destinations_basis_points,omitempty"`
+	// the intermediate assets the swap is routed through
+	Route []string `json:"route,omitempty"`
 }
 
 // NewMsgSwap instantiates a new MsgSwap object
@@ -448,6 +456,134 @@ func (o *MsgSwap) SetStreamInterval(v int64) {
 	o.StreamInterval = &v
 }
 
+// GetExpiry returns the Expiry field value if set, zero value otherwise.
+func (o *MsgSwap) GetExpiry() int64 {
+	if o == nil || o.Expiry == nil {
+		var ret int64
+		return ret
+	}
+	return *o.Expiry
+}
+
+// GetExpiryOk returns a tuple with the Expiry field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MsgSwap) GetExpiryOk() (*int64, bool) {
+	if o == nil || o.Expiry == nil {
+		return nil, false
+	}
+	return o.Expiry, true
+}
+
+// HasExpiry returns a boolean if a field has been set.
+func (o *MsgSwap) HasExpiry() bool {
+	if o != nil && o.Expiry != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiry gets a reference to the given int64 and assigns it to the Expiry field.
+func (o *MsgSwap) SetExpiry(v int64) {
+	o.Expiry = &v
+}
+
+// GetDestinations returns the Destinations field value if set, zero value otherwise.
+func (o *MsgSwap) GetDestinations() []string {
+	if o == nil || o.Destinations == nil {
+		var ret []string
+		return ret
+	}
+	return o.Destinations
+}
+
+// GetDestinationsOk returns a tuple with the Destinations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MsgSwap) GetDestinationsOk() ([]string, bool) {
+	if o == nil || o.Destinations == nil {
+		return nil, false
+	}
+	return o.Destinations, true
+}
+
+// HasDestinations returns a boolean if a field has been set.
+func (o *MsgSwap) HasDestinations() bool {
+	if o != nil && o.Destinations != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDestinations gets a reference to the given []string and assigns it to the Destinations field.
+func (o *MsgSwap) SetDestinations(v []string) {
+	o.Destinations = v
+}
+
+// GetDestinationsBasisPoints returns the DestinationsBasisPoints field value if set, zero value otherwise.
+func (o *MsgSwap) GetDestinationsBasisPoints() []string {
+	if o == nil || o.DestinationsBasisPoints == nil {
+		var ret []string
+		return ret
+	}
+	return o.DestinationsBasisPoints
+}
+
+// GetDestinationsBasisPointsOk returns a tuple with the DestinationsBasisPoints field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MsgSwap) GetDestinationsBasisPointsOk() ([]string, bool) {
+	if o == nil || o.DestinationsBasisPoints == nil {
+		return nil, false
+	}
+	return o.DestinationsBasisPoints, true
+}
+
+// HasDestinationsBasisPoints returns a boolean if a field has been set.
+func (o *MsgSwap) HasDestinationsBasisPoints() bool {
+	if o != nil && o.DestinationsBasisPoints != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetDestinationsBasisPoints gets a reference to the given []string and assigns it to the DestinationsBasisPoints field.
+func (o *MsgSwap) SetDestinationsBasisPoints(v []string) {
+	o.DestinationsBasisPoints = v
+}
+
+// GetRoute returns the Route field value if set, zero value otherwise.
+func (o *MsgSwap) GetRoute() []string {
+	if o == nil || o.Route == nil {
+		var ret []string
+		return ret
+	}
+	return o.Route
+}
+
+// GetRouteOk returns a tuple with the Route field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *MsgSwap) GetRouteOk() ([]string, bool) {
+	if o == nil || o.Route == nil {
+		return nil, false
+	}
+	return o.Route, true
+}
+
+// HasRoute returns a boolean if a field has been set.
+func (o *MsgSwap) HasRoute() bool {
+	if o != nil && o.Route != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRoute gets a reference to the given []string and assigns it to the Route field.
+func (o *MsgSwap) SetRoute(v []string) {
+	o.Route = v
+}
+
 func (o MsgSwap) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -489,6 +625,18 @@ func (o MsgSwap) MarshalJSON_deprecated() ([]byte, error) {
 	if o.StreamInterval != nil {
 		toSerialize["stream_interval"] = o.StreamInterval
 	}
+	if o.Expiry != nil {
+		toSerialize["expiry"] = o.Expiry
+	}
+	if o.Destinations != nil {
+		toSerialize["destinations"] = o.Destinations
+	}
+	if o.DestinationsBasisPoints != nil {
+		toSerialize["destinations_basis_points"] = o.DestinationsBasisPoints
+	}
+	if o.Route != nil {
+		toSerialize["route"] = o.Route
+	}
 	return json.Marshal(toSerialize)
 }
 
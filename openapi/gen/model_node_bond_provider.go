@@ -18,6 +18,10 @@ import (
 type NodeBondProvider struct {
 	BondAddress *string `json:"bond_address,omitempty"`
 	Bond *string `json:"bond,omitempty"`
+	// the amount of a pending unbond request, 0 unbonds the full bond
+	UnbondAmount *string `json:"unbond_amount,omitempty"`
+	// the height a pending unbond request becomes executable
+	UnbondHeight *int64 `json:"unbond_height,omitempty"`
 }
 
 // NewNodeBondProvider instantiates a new NodeBondProvider object
@@ -101,6 +105,70 @@ func (o *NodeBondProvider) SetBond(v string) {
 	o.Bond = &v
 }
 
+// GetUnbondAmount returns the UnbondAmount field value if set, zero value otherwise.
+func (o *NodeBondProvider) GetUnbondAmount() string {
+	if o == nil || o.UnbondAmount == nil {
+		var ret string
+		return ret
+	}
+	return *o.UnbondAmount
+}
+
+// GetUnbondAmountOk returns a tuple with the UnbondAmount field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NodeBondProvider) GetUnbondAmountOk() (*string, bool) {
+	if o == nil || o.UnbondAmount == nil {
+		return nil, false
+	}
+	return o.UnbondAmount, true
+}
+
+// HasUnbondAmount returns a boolean if a field has been set.
+func (o *NodeBondProvider) HasUnbondAmount() bool {
+	if o != nil && o.UnbondAmount != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUnbondAmount gets a reference to the given string and assigns it to the UnbondAmount field.
+func (o *NodeBondProvider) SetUnbondAmount(v string) {
+	o.UnbondAmount = &v
+}
+
+// GetUnbondHeight returns the UnbondHeight field value if set, zero value otherwise.
+func (o *NodeBondProvider) GetUnbondHeight() int64 {
+	if o == nil || o.UnbondHeight == nil {
+		var ret int64
+		return ret
+	}
+	return *o.UnbondHeight
+}
+
+// GetUnbondHeightOk returns a tuple with the UnbondHeight field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NodeBondProvider) GetUnbondHeightOk() (*int64, bool) {
+	if o == nil || o.UnbondHeight == nil {
+		return nil, false
+	}
+	return o.UnbondHeight, true
+}
+
+// HasUnbondHeight returns a boolean if a field has been set.
+func (o *NodeBondProvider) HasUnbondHeight() bool {
+	if o != nil && o.UnbondHeight != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetUnbondHeight gets a reference to the given int64 and assigns it to the UnbondHeight field.
+func (o *NodeBondProvider) SetUnbondHeight(v int64) {
+	o.UnbondHeight = &v
+}
+
 func (o NodeBondProvider) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if o.BondAddress != nil {
@@ -109,6 +177,12 @@ func (o NodeBondProvider) MarshalJSON_deprecated() ([]byte, error) {
 	if o.Bond != nil {
 		toSerialize["bond"] = o.Bond
 	}
+	if o.UnbondAmount != nil {
+		toSerialize["unbond_amount"] = o.UnbondAmount
+	}
+	if o.UnbondHeight != nil {
+		toSerialize["unbond_height"] = o.UnbondHeight
+	}
 	return json.Marshal(toSerialize)
 }
 
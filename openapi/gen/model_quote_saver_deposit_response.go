@@ -49,6 +49,8 @@ type QuoteSaverDepositResponse struct {
 	ExpectedAmountOut *string `json:"expected_amount_out,omitempty"`
 	// the amount of the target asset the user can expect to deposit after fees
 	ExpectedAmountDeposit string `json:"expected_amount_deposit"`
+	// the synth yield of the pool over the retained history annualised against the current savers depth, in basis points
+	RealisedApyBps *int64 `json:"realised_apy_bps,omitempty"`
 }
 
 // NewQuoteSaverDepositResponse instantiates a new QuoteSaverDepositResponse object
@@ -549,6 +551,38 @@ func (o *QuoteSaverDepositResponse) SetExpectedAmountDeposit(v string) {
 	o.ExpectedAmountDeposit = v
 }
 
+// GetRealisedApyBps returns the RealisedApyBps field value if set, zero value otherwise.
+func (o *QuoteSaverDepositResponse) GetRealisedApyBps() int64 {
+	if o == nil || o.RealisedApyBps == nil {
+		var ret int64
+		return ret
+	}
+	return *o.RealisedApyBps
+}
+
+// GetRealisedApyBpsOk returns a tuple with the RealisedApyBps field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *QuoteSaverDepositResponse) GetRealisedApyBpsOk() (*int64, bool) {
+	if o == nil || o.RealisedApyBps == nil {
+		return nil, false
+	}
+	return o.RealisedApyBps, true
+}
+
+// HasRealisedApyBps returns a boolean if a field has been set.
+func (o *QuoteSaverDepositResponse) HasRealisedApyBps() bool {
+	if o != nil && o.RealisedApyBps != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRealisedApyBps gets a reference to the given int64 and assigns it to the RealisedApyBps field.
+func (o *QuoteSaverDepositResponse) SetRealisedApyBps(v int64) {
+	o.RealisedApyBps = &v
+}
+
 func (o QuoteSaverDepositResponse) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if true {
@@ -602,6 +636,9 @@ func (o QuoteSaverDepositResponse) MarshalJSON_deprecated() ([]byte, error) {
 	if true {
 		toSerialize["expected_amount_deposit"] = o.ExpectedAmountDeposit
 	}
+	if o.RealisedApyBps != nil {
+		toSerialize["realised_apy_bps"] = o.RealisedApyBps
+	}
 	return json.Marshal(toSerialize)
 }
 
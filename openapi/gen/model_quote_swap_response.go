@@ -55,6 +55,10 @@ type QuoteSwapResponse struct {
 	StreamingSwapSeconds *int64 `json:"streaming_swap_seconds,omitempty"`
 	// total number of seconds a swap is expected to take (inbound conf + streaming swap + outbound delay)
 	TotalSwapSeconds *int64 `json:"total_swap_seconds,omitempty"`
+	// the intermediate assets the quoted swap is routed through, empty for the default route
+	Route []string `json:"route,omitempty"`
+	// the last block swaps are paused in a pool of the swap by the price circuit breaker, queued swaps are held until then
+	PriceBreakerHeight *int64 `json:"price_breaker_height,omitempty"`
 }
 
 // NewQuoteSwapResponse instantiates a new QuoteSwapResponse object
@@ -665,6 +669,70 @@ func (o *QuoteSwapResponse) SetTotalSwapSeconds(v int64) {
 	o.TotalSwapSeconds = &v
 }
 
+// GetRoute returns the Route field value if set, zero value otherwise.
+func (o *QuoteSwapResponse) GetRoute() []string {
+	if o == nil || o.Route == nil {
+		var ret []string
+		return ret
+	}
+	return o.Route
+}
+
+// GetRouteOk returns a tuple with the Route field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *QuoteSwapResponse) GetRouteOk() ([]string, bool) {
+	if o == nil || o.Route == nil {
+		return nil, false
+	}
+	return o.Route, true
+}
+
+// HasRoute returns a boolean if a field has been set.
+func (o *QuoteSwapResponse) HasRoute() bool {
+	if o != nil && o.Route != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetRoute gets a reference to the given []string and assigns it to the Route field.
+func (o *QuoteSwapResponse) SetRoute(v []string) {
+	o.Route = v
+}
+
+// GetPriceBreakerHeight returns the PriceBreakerHeight field value if set, zero value otherwise.
+func (o *QuoteSwapResponse) GetPriceBreakerHeight() int64 {
+	if o == nil || o.PriceBreakerHeight == nil {
+		var ret int64
+		return ret
+	}
+	return *o.PriceBreakerHeight
+}
+
+// GetPriceBreakerHeightOk returns a tuple with the PriceBreakerHeight field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *QuoteSwapResponse) GetPriceBreakerHeightOk() (*int64, bool) {
+	if o == nil || o.PriceBreakerHeight == nil {
+		return nil, false
+	}
+	return o.PriceBreakerHeight, true
+}
+
+// HasPriceBreakerHeight returns a boolean if a field has been set.
+func (o *QuoteSwapResponse) HasPriceBreakerHeight() bool {
+	if o != nil && o.PriceBreakerHeight != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetPriceBreakerHeight gets a reference to the given int64 and assigns it to the PriceBreakerHeight field.
+func (o *QuoteSwapResponse) SetPriceBreakerHeight(v int64) {
+	o.PriceBreakerHeight = &v
+}
+
 func (o QuoteSwapResponse) MarshalJSON_deprecated() ([]byte, error) {
 	toSerialize := map[string]interface{}{}
 	if o.InboundAddress != nil {
@@ -727,6 +795,12 @@ func (o QuoteSwapResponse) MarshalJSON_deprecated() ([]byte, error) {
 	if o.TotalSwapSeconds != nil {
 		toSerialize["total_swap_seconds"] = o.TotalSwapSeconds
 	}
+	if o.Route != nil {
+		toSerialize["route"] = o.Route
+	}
+	if o.PriceBreakerHeight != nil {
+		toSerialize["price_breaker_height"] = o.PriceBreakerHeight
+	}
 	return json.Marshal(toSerialize)
 }
 
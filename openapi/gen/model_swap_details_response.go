@@ -17,7 +17,7 @@ import (
 // SwapDetailsResponse struct for SwapDetailsResponse
 type SwapDetailsResponse struct {
 	Swap *MsgSwap `json:"swap,omitempty"`
-	// Current status of the swap
+	// Current status of the swap, queued or awaiting_trigger for untriggered stop_loss and take_profit orders
 	Status *string `json:"status,omitempty"`
 	// Type of queue the swap is in
 	QueueType *string `json:"queue_type,omitempty"`
@@ -51,6 +51,27 @@ func (Test) TestJSONSpec(c *C) {
 	assertJSONStructTagsMatch(c, types.TssKeygenMetric{}, gen.KeygenMetric{})
 	assertJSONStructTagsMatch(c, types.TssKeysignMetric{}, gen.TssKeysignMetric{})
 
+	// pools
+	assertJSONStructTagsMatch(c, types.QueryPoolHistoryResponse{}, gen.PoolHistoryResponse{})
+	assertJSONStructTagsMatch(c, types.QueryPoolHistoryInterval{}, gen.PoolHistoryInterval{})
+	assertJSONStructTagsMatch(c, types.QueryPoolTWAPResponse{}, gen.PoolTWAPResponse{})
+	assertJSONStructTagsMatch(c, types.QueryDerivedPoolResponse{}, gen.DerivedPool{})
+
+	// nodes
+	assertJSONStructTagsMatch(c, types.QueryChainObservationStats{}, gen.ChainObservationStats{})
+
+	// swaps
+	assertJSONStructTagsMatch(c, types.QueryRecurringSwapResponse{}, gen.RecurringSwap{})
+	assertJSONStructTagsMatch(c, types.QueryRecurringSwapFill{}, gen.RecurringSwapFill{})
+
+	// mimir
+	assertJSONStructTagsMatch(c, types.QueryMimirScheduledResponse{}, gen.MimirScheduledResponse{})
+	assertJSONStructTagsMatch(c, types.QueryScheduledMimir{}, gen.ScheduledMimir{})
+	assertJSONStructTagsMatch(c, types.QueryMimirHistoryResponse{}, gen.MimirHistoryResponse{})
+	assertJSONStructTagsMatch(c, types.QueryMimirHistory{}, gen.MimirHistory{})
+	assertJSONStructTagsMatch(c, types.QueryMimirRegistryResponse{}, gen.MimirRegistryResponse{})
+	assertJSONStructTagsMatch(c, types.QueryMimirDefinition{}, gen.MimirDefinition{})
+
 	// miscellaneous
 	assertJSONStructTagsMatch(c, types.BanVoter{}, gen.BanResponse{})
 }
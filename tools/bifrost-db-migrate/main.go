@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/thorchain/thornode/v3/bifrost/db"
+	"gitlab.com/thorchain/thornode/v3/config"
+)
+
+// Migrates a bifrost database (scanner, signer or observer storage) between storage
+// backends. Bifrost must be stopped while migrating. Once complete, move the migrated
+// directory into place and set the backend in the corresponding leveldb config.
+//
+//	bifrost-db-migrate -src ~/.bifrost/data/btc -dst ~/.bifrost/data/btc.pebble -to pebble
+
+func check(e error, msg string) {
+	if e != nil {
+		_, file, line, _ := runtime.Caller(1)
+		callerLine := fmt.Sprintf("%s:%d", file, line)
+		log.Fatal().Msgf("%s: %s\n%s", callerLine, msg, e)
+	}
+}
+
+func main() {
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout})
+
+	src := flag.String("src", "", "path of the source database")
+	dst := flag.String("dst", "", "path of the destination database, must not exist")
+	from := flag.String("from", db.BackendLevelDB, "backend of the source database")
+	to := flag.String("to", db.BackendPebble, "backend of the destination database")
+	flag.Parse()
+
+	if *src == "" || *dst == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+	if _, err := os.Stat(*src); err != nil {
+		check(err, "source database not found")
+	}
+	if _, err := os.Stat(*dst); err == nil {
+		log.Fatal().Str("dst", *dst).Msg("destination already exists")
+	}
+
+	srcStore, err := db.NewStore(*src, config.LevelDBOptions{Backend: *from})
+	check(err, "fail to open source database")
+	defer srcStore.Close()
+
+	dstStore, err := db.NewStore(*dst, config.LevelDBOptions{Backend: *to})
+	check(err, "fail to create destination database")
+
+	count, err := db.Migrate(srcStore, dstStore)
+	check(err, "fail to migrate database")
+
+	log.Info().Int("keys", count).Msg("compacting destination database")
+	check(dstStore.Compact(), "fail to compact destination database")
+	check(dstStore.Close(), "fail to close destination database")
+
+	log.Info().Str("src", *src).Str("dst", *dst).Int("keys", count).Msg("migration complete")
+}
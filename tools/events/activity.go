@@ -2,8 +2,6 @@ package main
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
@@ -29,304 +27,13 @@ import (
 ////////////////////////////////////////////////////////////////////////////////////////
 
 func ScanActivity(block *thorscan.BlockResponse) {
-	LargeUnconfirmedInbounds(block)
-	LargeStreamingSwaps(block)
 	ScheduledOutbounds(block)
-	LargeTransfers(block)
-	InactiveVaultInbounds(block)
 	NewNode(block)
 	Bond(block)
-	FailedTransactions(block)
-	LargeHighSlipSwaps(block)
-	FailedRefunds(block)
 
 	// THORNameRegistrations(block) (disable per community request)
 }
 
-////////////////////////////////////////////////////////////////////////////////////////
-// Large Unconfirmed Inbounds
-////////////////////////////////////////////////////////////////////////////////////////
-
-func LargeUnconfirmedInbounds(block *thorscan.BlockResponse) {
-	for _, tx := range block.Txs {
-		// skip failed transactions
-		if *tx.Result.Code != 0 {
-			continue
-		}
-
-		// skip failed decode transactions
-		if tx.Tx == nil {
-			continue
-		}
-
-		for _, msg := range tx.Tx.GetMsgs() {
-			// skip anything other than observed transactions
-			msgObservedTxIn, ok := msg.(*thorchain.MsgObservedTxIn)
-			if !ok {
-				continue
-			}
-
-			// the observed tx in can have multiple transactions
-			for _, tx := range msgObservedTxIn.Txs {
-				// skip migrate inbounds
-				if reMemoMigration.MatchString(tx.Tx.Memo) {
-					continue
-				}
-
-				// skip consolidate inbounds and trade/secured asset deposits
-				if tx.Tx.Memo != "" { // saver deposits have empty memo
-					memoParts := strings.Split(tx.Tx.Memo, ":")
-					memoType, err := memo.StringToTxType(memoParts[0])
-					if err != nil {
-						log.Error().Err(err).
-							Int64("height", block.Header.Height).
-							Str("memo", tx.Tx.Memo).
-							Msg("failed to parse memo type")
-					}
-					switch memoType {
-					case memo.TxConsolidate, memo.TxTradeAccountDeposit, memo.TxSecuredAssetDeposit:
-						continue
-					}
-				}
-
-				// since this is checked often, only update cached price every 10 blocks
-				priceHeight := block.Header.Height / 10 * 10
-
-				// skip if below usd threshold
-				usdValue := util.USDValue(priceHeight, tx.Tx.Coins[0])
-				if uint64(usdValue) < config.Get().Thresholds.USDValue {
-					continue
-				}
-
-				// check threshold with clout
-				fromClout := util.Clout(priceHeight, tx.Tx.FromAddress.String())
-				fromCloutUSD := util.USDValue(priceHeight, fromClout)
-				if uint64(usdValue) < config.Get().Thresholds.USDValue+uint64(fromCloutUSD) {
-					continue
-				}
-
-				// skip if under 2 minutes until confirmation
-				confirmBlocks := tx.FinaliseHeight - tx.BlockHeight
-				blockMs := tx.Tx.Chain.GetGasAsset().Chain.ApproximateBlockMilliseconds()
-				confirmDuration := time.Duration(confirmBlocks*blockMs) * time.Millisecond
-				if confirmDuration < time.Minute*2 {
-					continue
-				}
-
-				// skip if previously seen
-				seen := false
-				seenKey := fmt.Sprintf("seen-large-unconfirmed-inbound/%s", tx.Tx.ID.String())
-				err := util.Load(seenKey, &seen)
-				if err != nil {
-					log.Debug().Err(err).Msg("unable to load seen large unconfirmed inbound")
-				}
-				if seen {
-					continue
-				}
-
-				// mark this inbound as seen
-				err = util.Store(seenKey, true)
-				if err != nil {
-					log.Panic().Err(err).Msg("unable to store seen large unconfirmed inbound")
-				}
-
-				// build notification
-				title := "Large Unconfirmed Inbound"
-				fields := util.NewOrderedMap()
-				fields.Set("Chain", tx.Tx.Chain.String())
-				fields.Set("Hash", tx.Tx.ID.String())
-				fields.Set("Memo", fmt.Sprintf("`%s`", tx.Tx.Memo))
-				fields.Set("Confirmation Time", util.FormatDuration(confirmDuration))
-				fields.Set("Amount", fmt.Sprintf(
-					"%f %s (%s)",
-					float64(tx.Tx.Coins[0].Amount.Uint64())/common.One,
-					tx.Tx.Coins[0].Asset,
-					util.USDValueString(priceHeight, tx.Tx.Coins[0]),
-				),
-				)
-				fields.Set(fmt.Sprintf("Clout (%s)", tx.Tx.FromAddress),
-					fmt.Sprintf(
-						"%f RUNE (%s)",
-						float64(fromClout.Amount.Uint64())/common.One,
-						util.FormatUSD(fromCloutUSD),
-					),
-				)
-
-				// notify
-				level := notify.Warning
-				if usdValue > float64(config.Get().Thresholds.Security.USDValue) {
-					level = notify.Danger
-				}
-				notify.Notify(config.Get().Notifications.Activity, title, block.Header.Height, nil, level, fields)
-
-				// notify security if over security threshold
-				if level == notify.Danger {
-					notify.Notify(config.Get().Notifications.Security, title, block.Header.Height, nil, notify.Warning, fields)
-				}
-			}
-		}
-
-	}
-}
-
-////////////////////////////////////////////////////////////////////////////////////////
-// Large Streaming Swap
-////////////////////////////////////////////////////////////////////////////////////////
-
-func LargeStreamingSwaps(block *thorscan.BlockResponse) {
-	for _, event := range append(block.EndBlockEvents, block.FinalizeBlockEvents...) {
-		if event["type"] != types.SwapEventType {
-			continue
-		}
-
-		// only alert on the first sub swap
-		if event["streaming_swap_count"] != "1" {
-			continue
-		}
-
-		// only alert when there are multiple swaps
-		if event["streaming_swap_quantity"] == "1" {
-			continue
-		}
-
-		// parse the quantity
-		quantity, err := strconv.Atoi(event["streaming_swap_quantity"])
-		if err != nil {
-			log.Panic().Err(err).Msg("unable to parse streaming swap quantity")
-		}
-
-		// check first the approximate USD value before fetching the inbound
-		coin, err := common.ParseCoin(event["coin"])
-		if err != nil {
-			log.Panic().Str("coin", event["coin"]).Err(err).Msg("unable to parse streaming swap coin")
-		}
-		usdValue := util.USDValue(block.Header.Height, coin)
-		if uint64(usdValue*float64(quantity)) < config.Get().Thresholds.USDValue {
-			continue
-		}
-
-		// skip previously seen streaming swaps
-		seen := false
-		seenKey := fmt.Sprintf("seen-large-streaming-swap/%s", event["id"])
-		err = util.Load(seenKey, &seen)
-		if err != nil {
-			log.Debug().Err(err).Msg("unable to load seen large streaming swap")
-		}
-		if seen {
-			continue
-		}
-
-		// get the tx for the precise value
-		tx := struct {
-			ObservedTx openapi.ObservedTx `json:"observed_tx"`
-		}{}
-		url := fmt.Sprintf("thorchain/tx/%s", event["id"])
-		err = util.ThornodeCachedRetryGet(url, block.Header.Height, &tx)
-		if err != nil {
-			log.Panic().Err(err).Msg("failed to get tx")
-		}
-
-		cloutFields := util.NewOrderedMap()
-		fromClout := util.Clout(block.Header.Height, event["from"])
-		fromCloutUSD := util.USDValue(block.Header.Height, fromClout)
-		totalCloutUSD := uint64(fromCloutUSD)
-		cloutFields.Set(
-			fmt.Sprintf("Clout (%s)", event["from"]),
-			fmt.Sprintf(
-				"%f RUNE (%s)",
-				float64(fromClout.Amount.Uint64())/common.One,
-				util.FormatUSD(fromCloutUSD),
-			),
-		)
-
-		// get to address from memo ("to" field in event is asgard)
-		if event["memo"] != "noop" { // streaming loans send "noop"
-			memoParts := strings.Split(event["memo"], ":")
-			toAddress := memoParts[2]
-			toClout := util.Clout(block.Header.Height, toAddress)
-			toCloutUSD := util.USDValue(block.Header.Height, toClout)
-			totalCloutUSD += uint64(toCloutUSD)
-			cloutFields.Set(
-				fmt.Sprintf("Clout (%s)", toAddress),
-				fmt.Sprintf(
-					"%f RUNE (%s)",
-					float64(toClout.Amount.Uint64())/common.One,
-					util.FormatUSD(toCloutUSD),
-				),
-			)
-		}
-
-		// verify precise amount
-		coinStr := fmt.Sprintf("%s %s", tx.ObservedTx.Tx.Coins[0].Amount, tx.ObservedTx.Tx.Coins[0].Asset)
-		coin, err = common.ParseCoin(coinStr)
-		if err != nil {
-			log.Panic().Str("coin", coinStr).Err(err).Msg("unable to parse coin")
-		}
-		usdValue = util.USDValue(block.Header.Height, coin)
-		if uint64(usdValue) < config.Get().Thresholds.USDValue+totalCloutUSD {
-			continue
-		}
-
-		// mark this swap as seen
-		err = util.Store(seenKey, true)
-		if err != nil {
-			log.Panic().Err(err).Msg("unable to store seen large streaming swap")
-		}
-
-		// build notification
-		title := "Streaming Swap"
-		lines := []string{}
-		if uint64(usdValue) > config.Get().Styles.USDPerMoneyBag {
-			lines = append(lines, util.Moneybags(uint64(usdValue)))
-		}
-		fields := util.NewOrderedMap()
-		fields.Set("Chain", event["chain"])
-		fields.Set("Hash", event["id"])
-		fields.Set("Amount", fmt.Sprintf(
-			"%f %s (%s)",
-			float64(coin.Amount.Uint64())/common.One,
-			coin.Asset,
-			util.USDValueString(block.Header.Height, coin),
-		))
-		fields.Set("Memo", fmt.Sprintf("`%s`", event["memo"]))
-		fields.Set("Quantity", fmt.Sprintf("%s swaps", event["streaming_swap_quantity"]))
-
-		// attempt adding interval and expected time
-		args := strings.Split(event["memo"], ":")
-		if len(args) > 3 {
-			limitParams := strings.Split(args[3], "/")
-			var interval int
-			if len(limitParams) > 1 {
-				interval, err = strconv.Atoi(limitParams[1])
-				if err != nil {
-					log.Error().Err(err).Msg("unable to parse streaming swap interval")
-				}
-			}
-			if quantity > 0 && interval > 0 {
-				ms := quantity * interval * int(common.THORChain.ApproximateBlockMilliseconds())
-				swapDuration := time.Duration(ms) * time.Millisecond
-				fields.Set("Interval", fmt.Sprintf("%d blocks", interval))
-				fields.Set("Expected Swap Time", util.FormatDuration(swapDuration))
-			}
-		}
-
-		// add clout fields
-		for _, field := range cloutFields.Keys() {
-			val, _ := cloutFields.Get(field)
-			fields.Set(field, val)
-		}
-
-		links := []string{
-			fmt.Sprintf("[Tracker](%s/%s)", config.Get().Links.Track, event["id"]),
-			fmt.Sprintf("[Transaction](%s/tx/%s)", config.Get().Links.Explorer, event["id"]),
-		}
-		fields.Set("Links", strings.Join(links, " | "))
-
-		// notify
-		notify.Notify(config.Get().Notifications.Activity, title, block.Header.Height, lines, notify.Warning, fields)
-	}
-}
-
 ////////////////////////////////////////////////////////////////////////////////////////
 // Scheduled Outbounds
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -733,232 +440,6 @@ func ScheduledOutbounds(block *thorscan.BlockResponse) {
 	}
 }
 
-////////////////////////////////////////////////////////////////////////////////////////
-// Large Transfers
-////////////////////////////////////////////////////////////////////////////////////////
-
-func LargeTransfers(block *thorscan.BlockResponse) {
-	for _, tx := range block.Txs {
-		// skip failed transactions
-		if *tx.Result.Code != 0 {
-			continue
-		}
-
-		// skip failed decode transactions
-		if tx.Tx == nil {
-			continue
-		}
-
-		for _, msg := range tx.Tx.GetMsgs() {
-			amount := uint64(0)
-			var fromAddr, toAddr string
-			switch m := msg.(type) {
-			case *thorchain.MsgSend:
-				for _, coin := range m.Amount {
-					if coin.Denom == "rune" {
-						amount = coin.Amount.Uint64()
-					}
-				}
-				fromAddr = m.FromAddress.String()
-				toAddr = m.ToAddress.String()
-			case *bank.MsgSend:
-				for _, coin := range m.Amount {
-					if coin.Denom == "rune" {
-						amount = coin.Amount.Uint64()
-					}
-				}
-				fromAddr = m.FromAddress
-				toAddr = m.ToAddress
-			default:
-				continue
-			}
-
-			// skip small transfers
-			if amount < config.Get().Thresholds.RuneTransferValue*common.One {
-				continue
-			}
-
-			fields := util.NewOrderedMap()
-
-			// determine if this is an external migration
-			txWithMemo, ok := tx.Tx.(ctypes.TxWithMemo)
-			if !ok {
-				log.Panic().Msg("failed to cast tx to TxWithMemo")
-			}
-			matches := reMemoMigration.FindStringSubmatch(txWithMemo.GetMemo())
-			if len(matches) > 0 {
-				title := fmt.Sprintf(
-					"External Migration `%s` (%s RUNE)",
-					txWithMemo.GetMemo(), util.FormatLocale(amount/common.One),
-				)
-				fields.Set(
-					"Links",
-					fmt.Sprintf("[Transaction](%s/tx/%s)", config.Get().Links.Explorer, tx.Hash),
-				)
-				notify.Notify(config.Get().Notifications.Activity, title, block.Header.Height, nil, notify.Info, fields)
-				continue
-			}
-
-			// otherwise this is just a large transfer
-			title := fmt.Sprintf(
-				"Large Transfer >> %s RUNE (%s)",
-				util.FormatLocale(amount/common.One),
-				util.USDValueString(block.Header.Height, common.NewCoin(common.RuneAsset(), cosmos.NewUint(amount))),
-			)
-
-			// use known address labels in alert
-			fromAddrLabel := fromAddr
-			toAddrLabel := toAddr
-			if _, ok = config.Get().LabeledAddresses[fromAddr]; ok {
-				fromAddrLabel = config.Get().LabeledAddresses[fromAddr]
-			}
-			if _, ok = config.Get().LabeledAddresses[toAddr]; ok {
-				toAddrLabel = config.Get().LabeledAddresses[toAddr]
-			}
-
-			links := []string{
-				fmt.Sprintf("[Transaction](%s/tx/%s)", config.Get().Links.Explorer, tx.BlockTx.Hash),
-				fmt.Sprintf("[%s](%s/address/%s)", fromAddrLabel, config.Get().Links.Explorer, fromAddr),
-				fmt.Sprintf("[%s](%s/address/%s)", toAddrLabel, config.Get().Links.Explorer, toAddr),
-			}
-			fields.Set("Hash", tx.BlockTx.Hash)
-			fields.Set("From", fromAddr)
-			fields.Set("To", toAddr)
-			fields.Set("Links", strings.Join(links, " | "))
-			notify.Notify(config.Get().Notifications.Activity, title, block.Header.Height, nil, notify.Warning, fields)
-		}
-	}
-}
-
-////////////////////////////////////////////////////////////////////////////////////////
-// Inactive Vault Inbounds
-////////////////////////////////////////////////////////////////////////////////////////
-
-type Vaults struct {
-	Active   map[string]bool
-	Retiring map[string]bool
-	Height   int64
-}
-
-var vaults *Vaults
-
-func init() {
-	_ = util.Load("vaults", vaults)
-}
-
-func InactiveVaultInbounds(block *thorscan.BlockResponse) {
-	// update our active vault set any time there is an active vault event
-	update := false
-	for _, tx := range block.Txs {
-		for _, event := range tx.Result.Events {
-			if event["type"] == types.VaultStatus_ActiveVault.String() {
-				update = true
-				break
-			}
-		}
-	}
-
-	if vaults == nil || update {
-		vaults = &Vaults{
-			Active:   make(map[string]bool),
-			Retiring: make(map[string]bool),
-			Height:   block.Header.Height,
-		}
-		vaultsRes := []openapi.Vault{}
-		err := util.ThornodeCachedRetryGet("thorchain/vaults/asgard", block.Header.Height, &vaultsRes)
-		if err != nil {
-			log.Panic().Err(err).Msg("failed to get vaults")
-		}
-		for _, vault := range vaultsRes {
-			if vault.Status == types.VaultStatus_ActiveVault.String() {
-				vaults.Active[*vault.PubKey] = true
-			}
-			if vault.Status == types.VaultStatus_RetiringVault.String() {
-				vaults.Retiring[*vault.PubKey] = true
-			}
-		}
-		err = util.Store("vaults", vaults)
-		if err != nil {
-			log.Panic().Err(err).Msg("failed to store vaults")
-		}
-	}
-
-	// check for inactive vault inbounds
-	for _, tx := range block.Txs {
-		// skip failed decode transactions
-		if tx.Tx == nil {
-			continue
-		}
-
-		for _, msg := range tx.Tx.GetMsgs() {
-			// skip anything other than observed transactions
-			msgObservedTxIn, ok := msg.(*thorchain.MsgObservedTxIn)
-			if !ok {
-				continue
-			}
-
-			// the observed tx in can have multiple transactions
-			for _, tx := range msgObservedTxIn.Txs {
-				// skip inbounds to active vaults
-				if vaults.Active[tx.ObservedPubKey.String()] {
-					continue
-				}
-
-				// skip inbounds to retiring vaults within 12 hours
-				if vaults.Retiring[tx.ObservedPubKey.String()] &&
-					block.Header.Height-vaults.Height < 7200 {
-					continue
-				}
-
-				// skip previously seen inactive inbounds
-				seen := false
-				seenKey := fmt.Sprintf("seen-inactive-inbound/%s", tx.Tx.ID.String())
-				err := util.Load(seenKey, &seen)
-				if err != nil {
-					log.Debug().Err(err).Msg("unable to load seen inactive inbound")
-				}
-				if seen {
-					continue
-				}
-
-				// skip finalized inbounds
-				stages := openapi.TxStagesResponse{}
-				err = util.ThornodeCachedRetryGet(fmt.Sprintf("thorchain/tx/stages/%s", tx.Tx.ID), block.Header.Height, &stages)
-				if err != nil {
-					log.Panic().Err(err).Msg("failed to get tx stages")
-				}
-				if stages.InboundFinalised != nil && stages.InboundFinalised.Completed {
-					continue
-				}
-
-				// mark this inbound as seen
-				err = util.Store(seenKey, true)
-				if err != nil {
-					log.Panic().Err(err).Msg("unable to store seen inactive inbound")
-				}
-
-				// gather links
-				links := []string{
-					fmt.Sprintf("[Transaction](%s/tx/%s)", config.Get().Links.Explorer, tx.Tx.ID),
-					fmt.Sprintf("[Track](%s/%s)", config.Get().Links.Track, tx.Tx.ID),
-				}
-
-				// build notification
-				title := "Inbound to Non-Active Vault"
-				fields := util.NewOrderedMap()
-				fields.Set("Chain", tx.Tx.Chain.String())
-				fields.Set("Vault", tx.ObservedPubKey.String())
-				fields.Set("Vault Address", tx.Tx.ToAddress.String())
-				fields.Set("Memo", fmt.Sprintf("`%s`", tx.Tx.Memo))
-				fields.Set("Links", strings.Join(links, " | "))
-
-				// notify
-				notify.Notify(config.Get().Notifications.Activity, title, block.Header.Height, nil, notify.Warning, fields)
-			}
-		}
-	}
-}
-
 ////////////////////////////////////////////////////////////////////////////////////////
 // New Node
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -1133,101 +614,6 @@ txs:
 	}
 }
 
-////////////////////////////////////////////////////////////////////////////////////////
-// Failed Transactions
-////////////////////////////////////////////////////////////////////////////////////////
-
-func FailedTransactions(block *thorscan.BlockResponse) {
-	for _, tx := range block.Txs {
-		// skip successful transactions and failed gas or sequence
-		switch *tx.Result.Code {
-		case 0: // success
-			continue
-		case 5: // insufficient funds
-			continue
-		case 32: // bad sequence
-			continue
-		case 99: // internal, avoid noise
-			continue
-		}
-
-		// alert fields
-		fields := util.NewOrderedMap()
-		fields.Set("Code", fmt.Sprintf("%d", *tx.Result.Code))
-		fields.Set(
-			"Transaction",
-			fmt.Sprintf("%s/cosmos/tx/v1beta1/txs/%s", config.Get().Links.Thornode, tx.BlockTx.Hash),
-		)
-
-		// determine if the transaction failed to decode
-		if tx.Tx == nil {
-			fields.Set("Failed Decode", "true")
-		}
-		if tx.Result.Codespace != nil {
-			fields.Set("Codespace", fmt.Sprintf("`%s`", *tx.Result.Codespace))
-		}
-		if tx.Result.Log != nil {
-			fields.Set("Log", fmt.Sprintf("`%s`", *tx.Result.Log))
-		}
-
-		// notify failed transaction
-		title := "Failed Transaction"
-		notify.Notify(config.Get().Notifications.Activity, title, block.Header.Height, nil, notify.Warning, fields)
-	}
-}
-
-////////////////////////////////////////////////////////////////////////////////////////
-// Failed Refunds
-////////////////////////////////////////////////////////////////////////////////////////
-
-func FailedRefunds(block *thorscan.BlockResponse) {
-	for _, event := range block.EndBlockEvents {
-		if event["type"] != types.RefundEventType {
-			continue
-		}
-
-		// only failed refunds
-		if !strings.Contains(event["reason"], "fail to refund") {
-			continue
-		}
-
-		coin, err := common.ParseCoin(event["coin"])
-		if err != nil {
-			log.Panic().Str("coin", event["coin"]).Err(err).Msg("unable to parse refund coin")
-		}
-
-		// skip refunds for less than the native transaction fee (failed affiliate swaps)
-		txFee := float64(constants.NewConstantValue().GetInt64Value(constants.NativeTransactionFee)) / common.One
-		if util.RuneValue(block.Header.Height, coin) < txFee {
-			continue
-		}
-
-		fields := util.NewOrderedMap()
-		fields.Set("Chain", event["chain"])
-		fields.Set("Hash", event["id"])
-		fields.Set("Inbound From Address", fmt.Sprintf("`%s`", event["from"]))
-		fields.Set("Inbound Memo", fmt.Sprintf("`%s`", event["memo"]))
-		fields.Set("Amount", fmt.Sprintf(
-			"%f %s (%s)",
-			float64(coin.Amount.Uint64())/common.One,
-			coin.Asset,
-			util.USDValueString(block.Header.Height, coin),
-		))
-		reason := event["reason"]
-		reason = regexp.MustCompile(`\s+`).ReplaceAllString(reason, " ")
-		fields.Set("Reason", fmt.Sprintf("`%s`", reason))
-
-		links := []string{
-			fmt.Sprintf("[Transaction](%s/tx/%s)", config.Get().Links.Explorer, event["id"]),
-			fmt.Sprintf("[Track](%s/%s)", config.Get().Links.Track, event["id"]),
-		}
-		fields.Set("Links", strings.Join(links, " | "))
-
-		title := "Failed Refund"
-		notify.Notify(config.Get().Notifications.FailedRefunds, title, block.Header.Height, nil, notify.Info, fields)
-	}
-}
-
 ////////////////////////////////////////////////////////////////////////////////////////
 // THORName Registrations
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -1290,60 +676,3 @@ func THORNameRegistrations(block *thorscan.BlockResponse) {
 		}
 	}
 }
-
-////////////////////////////////////////////////////////////////////////////////////////
-// Large High Slip Swaps
-////////////////////////////////////////////////////////////////////////////////////////
-
-func LargeHighSlipSwaps(block *thorscan.BlockResponse) {
-	for _, event := range append(block.EndBlockEvents, block.FinalizeBlockEvents...) {
-		if event["type"] != types.SwapEventType {
-			continue
-		}
-
-		// skip swap events below the slip threshold
-		poolSlip := cosmos.NewUintFromString(event["pool_slip"]).Uint64()
-		swapSlip := cosmos.NewUintFromString(event["swap_slip"]).Uint64()
-		if swapSlip < config.Get().Thresholds.SwapSlipBasisPoints && poolSlip < config.Get().Thresholds.SwapSlipBasisPoints {
-			continue
-		}
-
-		// check first the approximate USD value before fetching the inbound
-		coin, err := common.ParseCoin(event["coin"])
-		if err != nil {
-			log.Panic().Str("coin", event["coin"]).Err(err).Msg("unable to parse streaming swap coin")
-		}
-		usdValue := util.USDValue(block.Header.Height, coin)
-		if uint64(usdValue) < config.Get().Thresholds.USDValue {
-			continue
-		}
-
-		// build notification
-		title := "High Slip Swap"
-		lines := []string{}
-		if uint64(usdValue) > config.Get().Styles.USDPerMoneyBag {
-			lines = append(lines, util.Moneybags(uint64(usdValue)))
-		}
-		fields := util.NewOrderedMap()
-		fields.Set("Chain", event["chain"])
-		fields.Set("Hash", event["id"])
-		fields.Set("Amount", fmt.Sprintf(
-			"%f %s (%s)",
-			float64(coin.Amount.Uint64())/common.One,
-			coin.Asset,
-			util.USDValueString(block.Header.Height, coin),
-		))
-		fields.Set("Memo", fmt.Sprintf("`%s`", event["memo"]))
-		fields.Set("Swap Slip", fmt.Sprintf("%.2f%%", float64(swapSlip)/100))
-		fields.Set("Pool Slip", fmt.Sprintf("%.2f%%", float64(poolSlip)/100))
-
-		links := []string{
-			fmt.Sprintf("[Tracker](%s/%s)", config.Get().Links.Track, event["id"]),
-			fmt.Sprintf("[Transaction](%s/tx/%s)", config.Get().Links.Explorer, event["id"]),
-		}
-		fields.Set("Links", strings.Join(links, " | "))
-
-		// notify
-		notify.Notify(config.Get().Notifications.Activity, title, block.Header.Height, lines, notify.Warning, fields)
-	}
-}
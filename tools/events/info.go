@@ -3,7 +3,6 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
 
@@ -12,9 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
-	"gitlab.com/thorchain/thornode/v3/constants"
 	openapi "gitlab.com/thorchain/thornode/v3/openapi/gen"
 	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/config"
 	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/notify"
@@ -29,28 +26,13 @@ import (
 ////////////////////////////////////////////////////////////////////////////////////////
 
 func ScanInfo(block *thorscan.BlockResponse) {
-	Version(block)
 	Churn(block)
 	SetNodeMimir(block)
 	SetMimir(block)
 	KeygenFailure(block)
-	TORAnchorDrift(block)
 	UpgradeProposalAndApproval(block)
 }
 
-////////////////////////////////////////////////////////////////////////////////////////
-// Version
-////////////////////////////////////////////////////////////////////////////////////////
-
-func Version(block *thorscan.BlockResponse) {
-	for _, event := range block.BeginBlockEvents {
-		if event["type"] == types.VersionEventType {
-			msg := fmt.Sprintf("Network Version Upgraded: `%s`", event["version"])
-			notify.Notify(config.Get().Notifications.Info, msg, block.Header.Height, nil, notify.Success, nil)
-		}
-	}
-}
-
 ////////////////////////////////////////////////////////////////////////////////////////
 // Set Mimir
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -610,86 +592,6 @@ func formatNodeMimirMessage(height int64, node, key, value string) (string, *uti
 	return title, fields
 }
 
-////////////////////////////////////////////////////////////////////////////////////////
-// TOR Anchor Drift
-////////////////////////////////////////////////////////////////////////////////////////
-
-func TORAnchorDrift(block *thorscan.BlockResponse) {
-	if block.Header.Height%config.Get().TORAnchorCheckBlocks != 0 {
-		return
-	}
-
-	// get mimirs
-	mimirs := map[string]int64{}
-	err := util.ThornodeCachedRetryGet("thorchain/mimir", block.Header.Height, &mimirs)
-	if err != nil {
-		log.Panic().Err(err).Msg("failed to get mimirs")
-	}
-
-	// get pools
-	pools := []openapi.Pool{}
-	err = util.ThornodeCachedRetryGet("thorchain/pools", block.Header.Height, &pools)
-	if err != nil {
-		log.Panic().Err(err).Msg("failed to get pools")
-	}
-
-	// find all TOR pools
-	torPools := []openapi.Pool{}
-	minPrice := cosmos.NewUint(common.One)
-	maxPrice := cosmos.NewUint(common.One)
-	for _, pool := range pools {
-		var asset common.Asset
-		asset, err = common.NewAsset(pool.Asset)
-		if err != nil {
-			log.Panic().Err(err).Msg("failed to parse pool asset")
-		}
-		if mimirs[fmt.Sprintf("TORANCHOR-%s", asset.MimirString())] > 0 {
-			price := cosmos.NewUintFromString(pool.AssetTorPrice)
-			if price.LT(minPrice) {
-				minPrice = price
-			}
-			if price.GT(maxPrice) {
-				maxPrice = price
-			}
-			torPools = append(torPools, pool)
-		}
-	}
-
-	// skip if not over the drift threshold
-	driftBPS := maxPrice.Sub(minPrice).MulUint64(constants.MaxBasisPts).Quo(maxPrice)
-	if driftBPS.LT(cosmos.NewUint(config.Get().Thresholds.TORAnchorDriftBasisPoints)) {
-		return
-	}
-
-	// sort tor pools by price
-	sort.Slice(torPools, func(i, j int) bool {
-		iPrice := cosmos.NewUintFromString(torPools[i].AssetTorPrice)
-		jPrice := cosmos.NewUintFromString(torPools[j].AssetTorPrice)
-		return iPrice.LT(jPrice)
-	})
-
-	// build notification
-	fields := util.NewOrderedMap()
-	maxFieldLenth := 0
-	for _, pool := range torPools {
-		shortAsset := strings.Split(pool.Asset, "-")[0]
-		if len(shortAsset) > maxFieldLenth {
-			maxFieldLenth = len(shortAsset)
-		}
-	}
-	for _, pool := range torPools {
-		shortAsset := strings.Split(pool.Asset, "-")[0]
-		if len(shortAsset) < maxFieldLenth {
-			shortAsset = strings.Repeat(" ", maxFieldLenth-len(shortAsset)) + shortAsset
-		}
-		price := float64(cosmos.NewUintFromString(pool.AssetTorPrice).Uint64()) / common.One
-		fields.Set(shortAsset, util.FormatUSD(price))
-	}
-
-	title := fmt.Sprintf("TOR Anchor Drift (%.02f%%)", float64(driftBPS.Uint64())/100)
-	notify.Notify(config.Get().Notifications.Info, title, block.Header.Height, nil, notify.Warning, fields)
-}
-
 ////////////////////////////////////////////////////////////////////////////////////////
 // Upgrade Proposal and Approval
 ////////////////////////////////////////////////////////////////////////////////////////
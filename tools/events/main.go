@@ -12,6 +12,7 @@ import (
 	"gitlab.com/thorchain/thornode/v3/cmd"
 	"gitlab.com/thorchain/thornode/v3/constants"
 	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/config"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/rules"
 	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/util"
 	"gitlab.com/thorchain/thornode/v3/tools/thorscan"
 )
@@ -81,11 +82,14 @@ func InitNetwork() {
 // ScanBlock
 ////////////////////////////////////////////////////////////////////////////////////////
 
+// ruleEngine evaluates the declarative alert rules.
+var ruleEngine *rules.Engine
+
 func ScanBlock(block *thorscan.BlockResponse) {
 	ScanInfo(block)
 	ScanActivity(block)
-	ScanSecurity(block)
 	ScanLoans(block)
+	ruleEngine.ScanBlock(block)
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -106,15 +110,24 @@ func main() {
 	InitNetwork()
 	thorscan.APIEndpoint = config.Get().Endpoints.Thornode
 
+	// load alert rules
+	alertRules, err := rules.Load(config.Get())
+	if err != nil {
+		log.Fatal().Err(err).Msg("unable to load rules")
+	}
+	ruleEngine = rules.NewEngine(alertRules)
+	log.Info().Int("count", len(alertRules)).Msg("loaded rules")
+
 	// prune local storage
 	util.Prune("scheduled-outbound")
 	util.Prune("seen-inactive-inbound")
 	util.Prune("seen-large-unconfirmed-inbound")
 	util.Prune("seen-large-streaming-swap")
+	util.Prune(rules.DedupStoragePath)
 
 	// load the last scanned height from storage
 	height := -1
-	err := util.Load("height", &height)
+	err = util.Load("height", &height)
 	if err != nil {
 		log.Warn().Err(err).Msg("unable to load height")
 	} else {
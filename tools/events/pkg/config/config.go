@@ -31,6 +31,9 @@ type Webhooks struct {
 	Slack     string `mapstructure:"slack"`
 	Discord   string `mapstructure:"discord"`
 	PagerDuty string `mapstructure:"pagerduty"`
+
+	// Webhook is a generic endpoint that receives the notification as JSON.
+	Webhook string `mapstructure:"webhook"`
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -84,6 +87,16 @@ type Config struct {
 		FailedRefunds Webhooks `mapstructure:"failed_refunds"`
 	} `mapstructure:"notifications"`
 
+	// Rules contain the declarative alert rules evaluated for each block.
+	Rules struct {
+		// Path is a YAML file of rules loaded in addition to the default rules. Rules
+		// with the name of a default rule replace it.
+		Path string `mapstructure:"path"`
+
+		// DisableDefaults skips loading the default rules.
+		DisableDefaults bool `mapstructure:"disable_defaults"`
+	} `mapstructure:"rules"`
+
 	// Links contain URLs to services linked in alerts.
 	Links struct {
 		// Track is the Nine Realms Tracker service.
@@ -144,6 +157,26 @@ type Config struct {
 	LabeledAddresses map[string]string `mapstructure:"labeled_addresses"`
 }
 
+// NotificationWebhooks returns the webhooks for a notification category by its
+// configuration key.
+func (c Config) NotificationWebhooks(category string) (Webhooks, bool) {
+	switch category {
+	case "activity":
+		return c.Notifications.Activity, true
+	case "lending":
+		return c.Notifications.Lending, true
+	case "info":
+		return c.Notifications.Info, true
+	case "security":
+		return c.Notifications.Security, true
+	case "reschedules":
+		return c.Notifications.Reschedules, true
+	case "failed_refunds":
+		return c.Notifications.FailedRefunds, true
+	}
+	return Webhooks{}, false
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////
 // Default
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -67,6 +67,22 @@ func Notify(w config.Webhooks, title string, block int64, lines []string, level
 			log.Panic().Err(err).Msg("unable to send pagerduty notification")
 		}
 	}
+
+	// send generic webhook
+	if w.Webhook != "" {
+		Webhook(w.Webhook, w.Category, discordTitle, block, lines, level, fields)
+	}
+}
+
+// Webhook sends the notification as JSON to a generic webhook.
+func Webhook(url, category, title string, block int64, lines []string, level Level, fields *util.OrderedMap) {
+	err := util.Retry(
+		config.Get().MaxRetries,
+		func() error { return webhook(url, category, title, block, lines, level, fields) },
+	)
+	if err != nil {
+		log.Panic().Err(err).Msg("unable to send webhook notification")
+	}
 }
 
 ////////////////////////////////////////////////////////////////////////////////////////
@@ -139,6 +155,49 @@ func slack(webhook, title string, lines []string, level Level, fields *util.Orde
 	return nil
 }
 
+func webhook(url, category, title string, block int64, lines []string, level Level, fields *util.OrderedMap) error {
+	msg := WebhookMessage{
+		Category: category,
+		Title:    title,
+		Height:   block,
+		Level:    level.String(),
+		Lines:    append([]string{}, lines...),
+		Fields:   []WebhookField{},
+	}
+	if fields != nil {
+		for _, k := range fields.Keys() {
+			v, _ := fields.Get(k)
+			msg.Fields = append(msg.Fields, WebhookField{Name: k, Value: fmt.Sprint(v)})
+		}
+	}
+
+	// build the request
+	body, err := json.Marshal(msg)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to marshal webhook message")
+		return err
+	}
+
+	// send the request
+	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		log.Error().Err(err).Msg("unable to send webhook message")
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, err = io.ReadAll(resp.Body)
+		if err == nil {
+			log.Error().Str("status", resp.Status).Str("body", string(body)).Msg("webhook error")
+		} else {
+			log.Error().Err(err).Str("status", resp.Status).Msg("unable to read webhook response")
+		}
+		return fmt.Errorf("failed to send webhook message")
+	}
+
+	return nil
+}
+
 func console(category, title string, lines []string, level Level, fields *util.OrderedMap) {
 	// ansi escape codes
 	boldStart := "\033[1m"
@@ -1,5 +1,7 @@
 package notify
 
+import "fmt"
+
 type Level int
 
 const (
@@ -11,6 +13,29 @@ const (
 	Danger
 )
 
+var levelNames = map[Level]string{
+	Info:      "info",
+	Broadcast: "broadcast",
+	Success:   "success",
+	Warning:   "warning",
+	Error:     "error",
+	Danger:    "danger",
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// ParseLevel returns the level with the provided name.
+func ParseLevel(name string) (Level, error) {
+	for level, levelName := range levelNames {
+		if levelName == name {
+			return level, nil
+		}
+	}
+	return Info, fmt.Errorf("unknown level: %s", name)
+}
+
 type DiscordEmbedField struct {
 	Name   string `json:"name,omitempty"`
 	Value  string `json:"value,omitempty"`
@@ -36,3 +61,18 @@ type DiscordMessage struct {
 	Content string         `json:"content,omitempty"`
 	Embeds  []DiscordEmbed `json:"embeds,omitempty"`
 }
+
+type WebhookField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// WebhookMessage is the JSON body sent to generic webhooks.
+type WebhookMessage struct {
+	Category string         `json:"category"`
+	Title    string         `json:"title"`
+	Height   int64          `json:"height"`
+	Level    string         `json:"level"`
+	Lines    []string       `json:"lines"`
+	Fields   []WebhookField `json:"fields"`
+}
@@ -0,0 +1,420 @@
+package rules
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+
+	ctypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog/log"
+
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/config"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/notify"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/util"
+	"gitlab.com/thorchain/thornode/v3/tools/thorscan"
+)
+
+// DedupStoragePath is the storage path for rule dedup state.
+const DedupStoragePath = "rule-dedup"
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Context
+////////////////////////////////////////////////////////////////////////////////////////
+
+// Tx is the transaction data available to tx and msg scoped rules, and to events
+// emitted by a transaction.
+type Tx struct {
+	Hash      string
+	Code      int64
+	Codespace string
+	Log       string
+	Memo      string
+
+	// Decoded is false if the transaction failed to decode.
+	Decoded bool
+}
+
+// Context is the data available to rule templates.
+type Context struct {
+	Height int64
+	Time   string
+
+	// Stage is the block stage of the event: begin, end, finalize or tx.
+	Stage string
+
+	Event map[string]string
+	Tx    *Tx
+
+	// Msg is the JSON form of the message with its type URL set in "type".
+	Msg map[string]any
+
+	// Item is the JSON form of the current item for rules with each.
+	Item any
+
+	// Vars are the rendered rule vars.
+	Vars map[string]string
+
+	Config config.Config
+}
+
+// thornodeGet is the Thornode API request used by rules, overridden in tests.
+var thornodeGet = util.ThornodeCachedRetryGet
+
+// Thornode returns the JSON response of the Thornode API path at the context height,
+// numbers are decoded as json.Number to preserve integers.
+func (c Context) Thornode(path string) (any, error) {
+	var data json.RawMessage
+	err := thornodeGet(strings.TrimPrefix(path, "/"), c.Height, &data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s: %w", path, err)
+	}
+
+	var result any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("unable to decode %s: %w", path, err)
+	}
+	return result, nil
+}
+
+// USD returns the USD value of the coin at the context height.
+func (c Context) USD(coin any) (float64, error) {
+	cc, err := toCoin(coin)
+	if err != nil {
+		return 0, err
+	}
+	return util.USDValue(c.Height, cc), nil
+}
+
+// USDString returns the formatted USD value of the coin at the context height.
+func (c Context) USDString(coin any) (string, error) {
+	cc, err := toCoin(coin)
+	if err != nil {
+		return "", err
+	}
+	return util.USDValueString(c.Height, cc), nil
+}
+
+// Rune returns the RUNE value of the coin at the context height.
+func (c Context) Rune(coin any) (float64, error) {
+	cc, err := toCoin(coin)
+	if err != nil {
+		return 0, err
+	}
+	return util.RuneValue(c.Height, cc), nil
+}
+
+// Clout returns the USD value of the clout for the address at the context height.
+func (c Context) Clout(address string) float64 {
+	return util.USDValue(c.Height, util.Clout(c.Height, address))
+}
+
+// Label returns the configured label for the address, or the address if unlabeled.
+func (c Context) Label(address string) string {
+	if label, ok := c.Config.LabeledAddresses[address]; ok {
+		return label
+	}
+	return address
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Engine
+////////////////////////////////////////////////////////////////////////////////////////
+
+// Engine evaluates rules against scanned blocks.
+type Engine struct {
+	rules map[Scope][]*Rule
+}
+
+// NewEngine returns an engine for the provided rules.
+func NewEngine(rules []*Rule) *Engine {
+	e := &Engine{rules: map[Scope][]*Rule{}}
+	for _, rule := range rules {
+		e.rules[rule.Scope] = append(e.rules[rule.Scope], rule)
+	}
+	return e
+}
+
+// ScanBlock evaluates all rules against the block.
+func (e *Engine) ScanBlock(block *thorscan.BlockResponse) {
+	base := Context{
+		Height: block.Header.Height,
+		Time:   block.Header.Time,
+		Config: config.Get(),
+	}
+
+	// block rules
+	for _, rule := range e.rules[ScopeBlock] {
+		e.evaluate(rule, base)
+	}
+
+	// block events
+	stages := []struct {
+		name   string
+		events []map[string]string
+	}{
+		{"begin", block.BeginBlockEvents},
+		{"end", block.EndBlockEvents},
+		{"finalize", block.FinalizeBlockEvents},
+	}
+	for _, stage := range stages {
+		for _, event := range stage.events {
+			ctx := base
+			ctx.Stage = stage.name
+			ctx.Event = event
+			for _, rule := range e.rules[ScopeEvent] {
+				e.evaluate(rule, ctx)
+			}
+		}
+	}
+
+	// transactions
+	for _, tx := range block.Txs {
+		txCtx := base
+		txCtx.Stage = "tx"
+		txCtx.Tx = newTx(tx)
+
+		for _, rule := range e.rules[ScopeTx] {
+			e.evaluate(rule, txCtx)
+		}
+
+		for _, event := range tx.Result.Events {
+			ctx := txCtx
+			ctx.Event = event
+			for _, rule := range e.rules[ScopeEvent] {
+				e.evaluate(rule, ctx)
+			}
+		}
+
+		// skip messages if there are no msg rules or the transaction failed decode
+		if len(e.rules[ScopeMsg]) == 0 || tx.Tx == nil {
+			continue
+		}
+		for _, msg := range tx.Tx.GetMsgs() {
+			ctx := txCtx
+			var err error
+			ctx.Msg, err = msgMap(msg)
+			if err != nil {
+				log.Error().Err(err).Str("tx", tx.Hash).Msg("unable to convert message")
+				continue
+			}
+			for _, rule := range e.rules[ScopeMsg] {
+				e.evaluate(rule, ctx)
+			}
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Internal
+////////////////////////////////////////////////////////////////////////////////////////
+
+func newTx(tx thorscan.BlockTx) *Tx {
+	t := &Tx{
+		Hash:    tx.Hash,
+		Decoded: tx.Tx != nil,
+	}
+	if tx.Result.Code != nil {
+		t.Code = *tx.Result.Code
+	}
+	if tx.Result.Codespace != nil {
+		t.Codespace = *tx.Result.Codespace
+	}
+	if tx.Result.Log != nil {
+		t.Log = *tx.Result.Log
+	}
+	if txWithMemo, ok := tx.Tx.(ctypes.TxWithMemo); ok {
+		t.Memo = txWithMemo.GetMemo()
+	}
+	return t
+}
+
+func msgMap(msg ctypes.Msg) (map[string]any, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{}
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, err
+	}
+	m["type"] = ctypes.MsgTypeURL(msg)
+	return m, nil
+}
+
+func (e *Engine) evaluate(rule *Rule, ctx Context) {
+	if rule.each == nil {
+		e.notify(rule, ctx)
+		return
+	}
+
+	items, err := rule.Items(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("rule", rule.Name).Int64("height", ctx.Height).Msg("unable to evaluate rule items")
+		return
+	}
+	for _, item := range items {
+		itemCtx := ctx
+		itemCtx.Item = item
+		e.notify(rule, itemCtx)
+	}
+}
+
+func (e *Engine) notify(rule *Rule, ctx Context) {
+	n, ok, err := rule.Render(ctx)
+	if err != nil {
+		log.Error().Err(err).Str("rule", rule.Name).Int64("height", ctx.Height).Msg("unable to evaluate rule")
+		return
+	}
+	if !ok || e.duplicate(rule, ctx, n.dedupKey) {
+		return
+	}
+
+	for _, category := range rule.Notify {
+		webhooks, _ := ctx.Config.NotificationWebhooks(category)
+		notify.Notify(webhooks, n.Title, ctx.Height, n.Lines, rule.level, n.Fields)
+	}
+	for _, url := range rule.Webhooks {
+		notify.Webhook(url, rule.Name, n.Title, ctx.Height, n.Lines, rule.level, n.Fields)
+	}
+}
+
+// duplicate returns true if the rule already fired for the key within the dedup window,
+// otherwise it records the key at the current height.
+func (e *Engine) duplicate(rule *Rule, ctx Context, key string) bool {
+	if rule.Dedup == nil {
+		return false
+	}
+
+	hash := sha256.Sum256([]byte(key))
+	storagePath := path.Join(DedupStoragePath, rule.Name, hex.EncodeToString(hash[:]))
+
+	var height int64
+	if err := util.Load(storagePath, &height); err == nil {
+		if rule.Dedup.Blocks == 0 || ctx.Height-height < rule.Dedup.Blocks {
+			return true
+		}
+	}
+
+	if err := util.Store(storagePath, ctx.Height); err != nil {
+		log.Error().Err(err).Str("rule", rule.Name).Msg("unable to store rule dedup")
+	}
+	return false
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Render
+////////////////////////////////////////////////////////////////////////////////////////
+
+// Notification is a rendered rule notification.
+type Notification struct {
+	Title  string
+	Lines  []string
+	Fields *util.OrderedMap
+
+	dedupKey string
+}
+
+// Match returns true if the rule condition matches the context.
+func (r *Rule) Match(ctx Context) (bool, error) {
+	when, err := execute(r.when, ctx)
+	return when == "true", err
+}
+
+// Items returns the list the rule is evaluated for each item of, nil if the rule has
+// no each or the list is empty.
+func (r *Rule) Items(ctx Context) ([]any, error) {
+	if r.each == nil {
+		return nil, nil
+	}
+	data, err := execute(r.each, ctx)
+	if err != nil {
+		return nil, err
+	}
+	var items any
+	if err = json.Unmarshal([]byte(data), &items); err != nil {
+		return nil, fmt.Errorf("unable to decode items: %w", err)
+	}
+	switch list := items.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		return list, nil
+	}
+	return nil, fmt.Errorf("each is not a list: %s", data)
+}
+
+// Render evaluates the rule conditions and vars against the context and renders the
+// notification if it matches. Empty lines and fields are omitted.
+func (r *Rule) Render(ctx Context) (Notification, bool, error) {
+	n := Notification{Fields: util.NewOrderedMap()}
+
+	ok, err := r.Match(ctx)
+	if err != nil || !ok {
+		return n, false, err
+	}
+
+	ctx.Vars = make(map[string]string, len(r.vars))
+	for i, tmpl := range r.vars {
+		ctx.Vars[r.Vars[i].Name], err = execute(tmpl, ctx)
+		if err != nil {
+			return n, false, fmt.Errorf("var %s: %w", r.Vars[i].Name, err)
+		}
+	}
+
+	required, err := execute(r.require, ctx)
+	if err != nil || required != "true" {
+		return n, false, err
+	}
+
+	n.Title, err = execute(r.title, ctx)
+	if err != nil {
+		return n, false, err
+	}
+
+	for _, tmpl := range r.lines {
+		var line string
+		line, err = execute(tmpl, ctx)
+		if err != nil {
+			return n, false, err
+		}
+		if line != "" {
+			n.Lines = append(n.Lines, line)
+		}
+	}
+
+	for i, tmpl := range r.fields {
+		var value string
+		value, err = execute(tmpl, ctx)
+		if err != nil {
+			return n, false, err
+		}
+		if value != "" {
+			n.Fields.Set(r.Fields[i].Name, value)
+		}
+	}
+
+	if r.dedupKey != nil {
+		n.dedupKey, err = execute(r.dedupKey, ctx)
+		if err != nil {
+			return n, false, err
+		}
+	}
+
+	return n, true, nil
+}
+
+func execute(tmpl *template.Template, ctx Context) (string, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, ctx); err != nil {
+		return "", fmt.Errorf("unable to execute template: %w", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,402 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/util"
+	memo "gitlab.com/thorchain/thornode/v3/x/thorchain/memo"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Template Functions
+////////////////////////////////////////////////////////////////////////////////////////
+
+// funcs are available to all rule templates. The numeric comparisons override the
+// template builtins to allow comparison across numeric types and numeric strings.
+var funcs = template.FuncMap{
+	// numbers
+	"int":   toInt,
+	"float": toFloat,
+	"add":   func(a, b any) (float64, error) { return arithmetic(a, b, func(x, y float64) float64 { return x + y }) },
+	"sub":   func(a, b any) (float64, error) { return arithmetic(a, b, func(x, y float64) float64 { return x - y }) },
+	"mul":   func(a, b any) (float64, error) { return arithmetic(a, b, func(x, y float64) float64 { return x * y }) },
+	"div":   div,
+	"mod":   mod,
+	"min":   func(a, b any) (float64, error) { return arithmetic(a, b, math.Min) },
+	"max":   func(a, b any) (float64, error) { return arithmetic(a, b, math.Max) },
+	"lt":    func(a, b any) (bool, error) { return compare(a, b, func(x, y float64) bool { return x < y }) },
+	"le":    func(a, b any) (bool, error) { return compare(a, b, func(x, y float64) bool { return x <= y }) },
+	"gt":    func(a, b any) (bool, error) { return compare(a, b, func(x, y float64) bool { return x > y }) },
+	"ge":    func(a, b any) (bool, error) { return compare(a, b, func(x, y float64) bool { return x >= y }) },
+
+	// strings
+	"contains":  strings.Contains,
+	"hasPrefix": strings.HasPrefix,
+	"hasSuffix": strings.HasSuffix,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"join":      join,
+	"split":     func(sep, s string) []string { return strings.Split(s, sep) },
+	"matches":   matches,
+	"replace":   replace,
+	"default":   defaultValue,
+	"json":      toJSON,
+	"sortBy":    sortBy,
+
+	// coins
+	"coin":       toCoin,
+	"coinAmount": coinAmount,
+	"amount":     func(coin common.Coin) float64 { return float64(coin.Amount.Uint64()) / common.One },
+	"constant":   constant,
+
+	// thorchain
+	"mimirString":  mimirString,
+	"memoType":     memoType,
+	"blockSeconds": blockSeconds,
+
+	// formatting
+	"formatLocale":   formatLocale,
+	"formatDuration": formatDuration,
+	"formatUSD":      func(v any) (string, error) { f, err := toFloat(v); return util.FormatUSD(f), err },
+	"moneybags":      func(v any) (string, error) { f, err := toFloat(v); return util.Moneybags(uint64(f)), err },
+	"isModule":       util.IsThorchainModule,
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Numbers
+////////////////////////////////////////////////////////////////////////////////////////
+
+func toFloat(v any) (float64, error) {
+	switch n := v.(type) {
+	case nil:
+		return 0, nil
+	case string:
+		if n == "" {
+			return 0, nil
+		}
+		return strconv.ParseFloat(n, 64)
+	case json.Number:
+		return n.Float64()
+	case cosmos.Uint:
+		return float64(n.Uint64()), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Bool:
+		if rv.Bool() {
+			return 1, nil
+		}
+		return 0, nil
+	}
+
+	return 0, fmt.Errorf("not a number: %v", v)
+}
+
+func toInt(v any) (int64, error) {
+	// parse integer strings directly to avoid float precision loss
+	if s, ok := v.(string); ok {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, nil
+		}
+	}
+	f, err := toFloat(v)
+	return int64(f), err
+}
+
+func arithmetic(a, b any, op func(x, y float64) float64) (float64, error) {
+	x, err := toFloat(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := toFloat(b)
+	if err != nil {
+		return 0, err
+	}
+	return op(x, y), nil
+}
+
+func div(a, b any) (float64, error) {
+	y, err := toFloat(b)
+	if err != nil {
+		return 0, err
+	}
+	if y == 0 {
+		return 0, fmt.Errorf("division by zero")
+	}
+	return arithmetic(a, y, func(x, y float64) float64 { return x / y })
+}
+
+func mod(a, b any) (int64, error) {
+	x, err := toInt(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := toInt(b)
+	if err != nil {
+		return 0, err
+	}
+	if y == 0 {
+		return 0, fmt.Errorf("division by zero")
+	}
+	return x % y, nil
+}
+
+func compare(a, b any, op func(x, y float64) bool) (bool, error) {
+	x, err := toFloat(a)
+	if err != nil {
+		return false, err
+	}
+	y, err := toFloat(b)
+	if err != nil {
+		return false, err
+	}
+	return op(x, y), nil
+}
+
+// formatDuration formats a number of seconds.
+func formatDuration(seconds any) (string, error) {
+	f, err := toFloat(seconds)
+	return util.FormatDuration(time.Duration(f * float64(time.Second))), err
+}
+
+func formatLocale(v any) (string, error) {
+	switch n := v.(type) {
+	case int:
+		return util.FormatLocale(n), nil
+	case int64:
+		return util.FormatLocale(n), nil
+	case uint64:
+		return util.FormatLocale(n), nil
+	}
+	f, err := toFloat(v)
+	return util.FormatLocale(f), err
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Strings
+////////////////////////////////////////////////////////////////////////////////////////
+
+func join(sep string, v any) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return fmt.Sprint(v)
+	}
+	parts := make([]string, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		parts[i] = fmt.Sprint(rv.Index(i).Interface())
+	}
+	return strings.Join(parts, sep)
+}
+
+func matches(pattern string, v any) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, err
+	}
+	if v == nil {
+		return false, nil
+	}
+	return re.MatchString(fmt.Sprint(v)), nil
+}
+
+func replace(pattern, replacement string, v any) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", err
+	}
+	return re.ReplaceAllString(fmt.Sprint(v), replacement), nil
+}
+
+func defaultValue(def, v any) any {
+	if v == nil {
+		return def
+	}
+	if rv := reflect.ValueOf(v); rv.IsZero() {
+		return def
+	}
+	return v
+}
+
+// sortBy returns a copy of the JSON list of objects sorted ascending by the numeric
+// value of the key.
+func sortBy(key string, v any) ([]any, error) {
+	list, ok := v.([]any)
+	if !ok {
+		if v == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("not a list: %v", v)
+	}
+
+	values := make([]float64, len(list))
+	for i, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("not an object: %v", item)
+		}
+		var err error
+		values[i], err = toFloat(m[key])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	indexes := make([]int, len(list))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool { return values[indexes[i]] < values[indexes[j]] })
+	sorted := make([]any, len(list))
+	for i, index := range indexes {
+		sorted[i] = list[index]
+	}
+	return sorted, nil
+}
+
+func toJSON(v any) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	return string(data), err
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Coins
+////////////////////////////////////////////////////////////////////////////////////////
+
+// toCoin converts a coin string ("<amount> <asset>"), a JSON coin object with asset or
+// denom, or an amount and asset pair to a coin.
+func toCoin(v any, asset ...string) (common.Coin, error) {
+	if len(asset) > 0 {
+		return parseCoin(v, asset[0])
+	}
+
+	switch c := v.(type) {
+	case common.Coin:
+		return c, nil
+	case string:
+		return common.ParseCoin(c)
+	case map[string]any:
+		denom, ok := c["asset"]
+		if !ok {
+			denom = c["denom"]
+		}
+		return parseCoin(c["amount"], fmt.Sprint(denom))
+	}
+
+	return common.NoCoin, fmt.Errorf("not a coin: %v", v)
+}
+
+func parseCoin(amount any, asset string) (common.Coin, error) {
+	a, err := common.NewAsset(asset)
+	if err != nil {
+		return common.NoCoin, err
+	}
+	if f, ok := amount.(float64); ok {
+		return common.NewCoin(a, cosmos.NewUint(uint64(f))), nil
+	}
+	n, err := cosmos.ParseUint(fmt.Sprint(amount))
+	if err != nil {
+		return common.NoCoin, err
+	}
+	return common.NewCoin(a, n), nil
+}
+
+// coinAmount returns the total amount of the denom in a JSON list of coins.
+func coinAmount(coins any, denom string) (uint64, error) {
+	list, ok := coins.([]any)
+	if !ok {
+		if coins == nil {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("not a list of coins: %v", coins)
+	}
+
+	total := uint64(0)
+	for _, item := range list {
+		c, ok := item.(map[string]any)
+		if !ok {
+			return 0, fmt.Errorf("not a coin: %v", item)
+		}
+		d, ok := c["denom"]
+		if !ok {
+			d = c["asset"]
+		}
+		if !strings.EqualFold(fmt.Sprint(d), denom) {
+			continue
+		}
+		n, err := cosmos.ParseUint(fmt.Sprint(c["amount"]))
+		if err != nil {
+			return 0, err
+		}
+		total += n.Uint64()
+	}
+	return total, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// THORChain
+////////////////////////////////////////////////////////////////////////////////////////
+
+// mimirString returns the mimir key form of the asset.
+func mimirString(asset string) (string, error) {
+	a, err := common.NewAsset(asset)
+	if err != nil {
+		return "", err
+	}
+	return a.MimirString(), nil
+}
+
+// memoType returns the transaction type of the memo, or an empty string if the memo
+// has no known type.
+func memoType(m any) string {
+	if m == nil {
+		return ""
+	}
+	txType, err := memo.StringToTxType(strings.Split(fmt.Sprint(m), ":")[0])
+	if err != nil {
+		return ""
+	}
+	return txType.String()
+}
+
+// blockSeconds returns the approximate seconds for the number of blocks of the chain.
+func blockSeconds(chain string, blocks any) (float64, error) {
+	c, err := common.NewChain(chain)
+	if err != nil {
+		return 0, err
+	}
+	n, err := toFloat(blocks)
+	if err != nil {
+		return 0, err
+	}
+	ms := c.GetGasAsset().Chain.ApproximateBlockMilliseconds()
+	return n * float64(ms) / 1000, nil
+}
+
+// constant returns the default value of an int64 network constant.
+func constant(name string) (int64, error) {
+	values := constants.NewConstantValue().GetConstantValsByKeyname().Int64Values
+	value, ok := values[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown constant: %s", name)
+	}
+	return value, nil
+}
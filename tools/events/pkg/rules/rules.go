@@ -0,0 +1,313 @@
+package rules
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"text/template"
+
+	"gopkg.in/yaml.v2"
+
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/config"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/notify"
+)
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Types
+////////////////////////////////////////////////////////////////////////////////////////
+
+// Scope is the block data a rule is evaluated against.
+type Scope string
+
+const (
+	// ScopeBlock rules are evaluated once per block.
+	ScopeBlock Scope = "block"
+
+	// ScopeEvent rules are evaluated for each begin, end, finalize and tx event.
+	ScopeEvent Scope = "event"
+
+	// ScopeTx rules are evaluated for each transaction.
+	ScopeTx Scope = "tx"
+
+	// ScopeMsg rules are evaluated for each message of decoded transactions.
+	ScopeMsg Scope = "msg"
+)
+
+// Field is a templated field added to the notification.
+type Field struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// Dedup suppresses repeated notifications with the same rendered key.
+type Dedup struct {
+	// Key is a template rendered to identify the notification.
+	Key string `yaml:"key"`
+
+	// Blocks is the number of blocks to suppress the key after a notification, if zero
+	// the key is suppressed until pruned from storage.
+	Blocks int64 `yaml:"blocks"`
+}
+
+// Rule is a declarative alert evaluated by the engine.
+type Rule struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+
+	// Disabled rules are dropped on load, this allows overrides to disable defaults.
+	Disabled bool `yaml:"disabled"`
+
+	Scope Scope `yaml:"scope"`
+
+	// Each is an optional template pipeline returning a list, the rule is evaluated for
+	// each item of the list with the item available as .Item.
+	Each string `yaml:"each"`
+
+	// When is a template pipeline that must evaluate truthy for the rule to fire.
+	When string `yaml:"when"`
+
+	// Vars are templates rendered in order after When matches, available as .Vars.
+	Vars []Field `yaml:"vars"`
+
+	// Require is a template pipeline evaluated after Vars that must also be truthy for
+	// the rule to fire.
+	Require string `yaml:"require"`
+
+	Title  string   `yaml:"title"`
+	Lines  []string `yaml:"lines"`
+	Fields []Field  `yaml:"fields"`
+	Level  string   `yaml:"level"`
+
+	// Notify is the list of notification categories in config to route to.
+	Notify []string `yaml:"notify"`
+
+	// Webhooks is a list of generic JSON webhook URLs to send to.
+	Webhooks []string `yaml:"webhooks"`
+
+	Dedup *Dedup `yaml:"dedup"`
+
+	// compiled templates
+	level    notify.Level
+	each     *template.Template
+	when     *template.Template
+	vars     []*template.Template
+	require  *template.Template
+	title    *template.Template
+	lines    []*template.Template
+	fields   []*template.Template
+	dedupKey *template.Template
+}
+
+//go:embed defaults.yaml
+var defaultRules []byte
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Load
+////////////////////////////////////////////////////////////////////////////////////////
+
+// Parse decodes and compiles a YAML list of rules.
+func Parse(data []byte) ([]*Rule, error) {
+	rules := []*Rule{}
+	err := yaml.UnmarshalStrict(data, &rules)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode rules: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		if seen[rule.Name] {
+			return nil, fmt.Errorf("duplicate rule: %s", rule.Name)
+		}
+		seen[rule.Name] = true
+
+		if err = rule.compile(); err != nil {
+			return nil, fmt.Errorf("invalid rule %s: %w", rule.Name, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// Defaults returns the default rules shipped with the tool.
+func Defaults() ([]*Rule, error) {
+	return Parse(defaultRules)
+}
+
+// Merge returns the base rules with overrides applied. Overrides replace base rules of
+// the same name in place, new rules are appended, and disabled rules are removed.
+func Merge(base, overrides []*Rule) []*Rule {
+	merged := append([]*Rule{}, base...)
+	index := map[string]int{}
+	for i, rule := range merged {
+		index[rule.Name] = i
+	}
+	for _, rule := range overrides {
+		if i, ok := index[rule.Name]; ok {
+			merged[i] = rule
+			continue
+		}
+		index[rule.Name] = len(merged)
+		merged = append(merged, rule)
+	}
+
+	enabled := []*Rule{}
+	for _, rule := range merged {
+		if !rule.Disabled {
+			enabled = append(enabled, rule)
+		}
+	}
+	return enabled
+}
+
+// Load returns the rules for the provided configuration.
+func Load(cfg config.Config) ([]*Rule, error) {
+	rules := []*Rule{}
+	if !cfg.Rules.DisableDefaults {
+		defaults, err := Defaults()
+		if err != nil {
+			return nil, fmt.Errorf("unable to load default rules: %w", err)
+		}
+		rules = defaults
+	}
+
+	overrides := []*Rule{}
+	if cfg.Rules.Path != "" {
+		data, err := os.ReadFile(cfg.Rules.Path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read rules: %w", err)
+		}
+		overrides, err = Parse(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return Merge(rules, overrides), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////////////
+// Internal
+////////////////////////////////////////////////////////////////////////////////////////
+
+func (r *Rule) compile() error {
+	if r.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+
+	// disabled rules only need a name to override defaults
+	if r.Disabled {
+		return nil
+	}
+
+	switch r.Scope {
+	case ScopeBlock, ScopeEvent, ScopeTx, ScopeMsg:
+	default:
+		return fmt.Errorf("unknown scope: %s", r.Scope)
+	}
+
+	if r.Title == "" {
+		return fmt.Errorf("missing title")
+	}
+	if len(r.Notify) == 0 && len(r.Webhooks) == 0 {
+		return fmt.Errorf("missing notify or webhooks")
+	}
+	for _, category := range r.Notify {
+		if _, ok := config.Get().NotificationWebhooks(category); !ok {
+			return fmt.Errorf("unknown notification category: %s", category)
+		}
+	}
+
+	var err error
+	r.level = notify.Info
+	if r.Level != "" {
+		r.level, err = notify.ParseLevel(r.Level)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.Each != "" {
+		r.each, err = newTemplate("each", fmt.Sprintf("{{ json (%s) }}", r.Each))
+		if err != nil {
+			return err
+		}
+	}
+
+	// an empty condition always fires
+	r.when, err = newCondition("when", r.When)
+	if err != nil {
+		return err
+	}
+
+	r.vars = make([]*template.Template, len(r.Vars))
+	seen := map[string]bool{}
+	for i, v := range r.Vars {
+		if v.Name == "" {
+			return fmt.Errorf("missing name for vars[%d]", i)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("duplicate var: %s", v.Name)
+		}
+		seen[v.Name] = true
+		r.vars[i], err = newTemplate(v.Name, v.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.require, err = newCondition("require", r.Require)
+	if err != nil {
+		return err
+	}
+
+	r.title, err = newTemplate("title", r.Title)
+	if err != nil {
+		return err
+	}
+
+	r.lines = make([]*template.Template, len(r.Lines))
+	for i, line := range r.Lines {
+		r.lines[i], err = newTemplate(fmt.Sprintf("lines[%d]", i), line)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.fields = make([]*template.Template, len(r.Fields))
+	for i, field := range r.Fields {
+		if field.Name == "" {
+			return fmt.Errorf("missing name for fields[%d]", i)
+		}
+		r.fields[i], err = newTemplate(field.Name, field.Value)
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.Dedup != nil {
+		if r.Dedup.Key == "" {
+			return fmt.Errorf("missing dedup key")
+		}
+		r.dedupKey, err = newTemplate("dedup", r.Dedup.Key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newCondition(name, pipeline string) (*template.Template, error) {
+	if pipeline == "" {
+		pipeline = "true"
+	}
+	return newTemplate(name, fmt.Sprintf("{{ if %s }}true{{ end }}", pipeline))
+}
+
+func newTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s template: %w", name, err)
+	}
+	return tmpl, nil
+}
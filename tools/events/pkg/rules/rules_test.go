@@ -0,0 +1,486 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/config"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/notify"
+	"gitlab.com/thorchain/thornode/v3/tools/events/pkg/util"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/types"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type Test struct{}
+
+var _ = Suite(&Test{})
+
+func (t *Test) TestDefaults(c *C) {
+	rules, err := Defaults()
+	c.Assert(err, IsNil)
+	c.Assert(len(rules) > 0, Equals, true)
+	for _, rule := range rules {
+		c.Check(rule.Description, Not(Equals), "", Commentf("%s is missing a description", rule.Name))
+	}
+}
+
+func (t *Test) TestParse(c *C) {
+	_, err := Parse([]byte(`[{name: a, scope: event, title: A, notify: [activity]}]`))
+	c.Assert(err, IsNil)
+
+	// invalid rules
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A, notify: [unknown]}]`))
+	c.Check(err, ErrorMatches, ".*unknown notification category: unknown")
+	_, err = Parse([]byte(`[{name: a, scope: unknown, title: A, notify: [activity]}]`))
+	c.Check(err, ErrorMatches, ".*unknown scope: unknown")
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A}]`))
+	c.Check(err, ErrorMatches, ".*missing notify or webhooks")
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A, level: loud, notify: [activity]}]`))
+	c.Check(err, ErrorMatches, ".*unknown level: loud")
+	_, err = Parse([]byte(`[{name: a, scope: event, title: "{{ .Event", notify: [activity]}]`))
+	c.Check(err, ErrorMatches, ".*unable to parse title template.*")
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A, notify: [activity], unknown: 1}]`))
+	c.Check(err, NotNil)
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A, notify: [activity]}, {name: a, disabled: true}]`))
+	c.Check(err, ErrorMatches, "duplicate rule: a")
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A, notify: [activity], vars: [{value: x}]}]`))
+	c.Check(err, ErrorMatches, ".*missing name for vars\\[0\\]")
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A, notify: [activity], vars: [{name: x}, {name: x}]}]`))
+	c.Check(err, ErrorMatches, ".*duplicate var: x")
+	_, err = Parse([]byte(`[{name: a, scope: event, title: A, notify: [activity], require: "(eq"}]`))
+	c.Check(err, ErrorMatches, ".*unable to parse require template.*")
+}
+
+func (t *Test) TestMerge(c *C) {
+	base, err := Parse([]byte(`
+- {name: a, scope: event, title: A, notify: [activity]}
+- {name: b, scope: event, title: B, notify: [activity]}
+- {name: c, scope: event, title: C, notify: [activity]}
+`))
+	c.Assert(err, IsNil)
+	overrides, err := Parse([]byte(`
+- {name: d, scope: tx, title: D, webhooks: ["http://localhost"]}
+- {name: b, scope: tx, title: B2, notify: [security]}
+- {name: a, disabled: true}
+`))
+	c.Assert(err, IsNil)
+
+	merged := Merge(base, overrides)
+	c.Assert(merged, HasLen, 3)
+	c.Check(merged[0].Name, Equals, "b")
+	c.Check(merged[0].Title, Equals, "B2")
+	c.Check(merged[1].Name, Equals, "c")
+	c.Check(merged[2].Name, Equals, "d")
+}
+
+func (t *Test) TestRender(c *C) {
+	rules, err := Parse([]byte(`
+- name: large-swap
+  scope: event
+  when: and (eq .Event.type "swap") (ge .Event.swap_slip .Config.Thresholds.SwapSlipBasisPoints)
+  title: "Swap {{ .Event.id }}"
+  lines:
+    - "{{ if gt (len .Event.memo) 100 }}long memo{{ end }}"
+    - "{{ upper .Event.memo }}"
+  fields:
+    - name: Slip
+      value: '{{ printf "%.2f%%" (div .Event.swap_slip 100) }}'
+    - name: Empty
+      value: "{{ .Event.missing }}"
+    - name: Amount
+      value: '{{ $coin := coin .Event.coin }}{{ printf "%.1f" (amount $coin) }} {{ $coin.Asset }}'
+  level: warning
+  notify: [activity]
+`))
+	c.Assert(err, IsNil)
+	rule := rules[0]
+	c.Check(rule.level, Equals, notify.Warning)
+
+	ctx := Context{
+		Height: 1,
+		Config: config.Get(),
+		Event: map[string]string{
+			"type":      "swap",
+			"id":        "ABC",
+			"memo":      "=:eth.eth:0x1",
+			"swap_slip": "150",
+			"coin":      "250000000 BTC.BTC",
+		},
+	}
+	ctx.Config.Thresholds.SwapSlipBasisPoints = 100
+
+	n, ok, err := rule.Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Title, Equals, "Swap ABC")
+	c.Check(n.Lines, DeepEquals, []string{"=:ETH.ETH:0X1"})
+	c.Check(n.Fields.Keys(), DeepEquals, []string{"Slip", "Amount"})
+	slip, _ := n.Fields.Get("Slip")
+	c.Check(slip, Equals, "1.50%")
+	amount, _ := n.Fields.Get("Amount")
+	c.Check(amount, Equals, "2.5 BTC.BTC")
+
+	// below threshold
+	ctx.Event["swap_slip"] = "50"
+	_, ok, err = rule.Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// other event types
+	ctx.Event = map[string]string{"type": "outbound"}
+	_, ok, err = rule.Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// invalid numbers fail evaluation
+	ctx.Event = map[string]string{"type": "swap", "swap_slip": "abc"}
+	_, _, err = rule.Render(ctx)
+	c.Check(err, NotNil)
+}
+
+func (t *Test) TestEachVars(c *C) {
+	rules, err := Parse([]byte(`
+- name: large-coin
+  scope: msg
+  each: .Msg.coins
+  when: eq .Msg.type "/types.MsgDeposit"
+  vars:
+    - name: amount
+      value: "{{ .Item.amount }}"
+    - name: double
+      value: "{{ mul .Vars.amount 2 }}"
+  require: ge .Vars.double 100
+  title: "{{ .Item.asset }} {{ .Vars.double }}"
+  notify: [activity]
+`))
+	c.Assert(err, IsNil)
+	rule := rules[0]
+
+	ctx := Context{Height: 1, Config: config.Get(), Msg: map[string]any{
+		"type": "/types.MsgDeposit",
+		"coins": []any{
+			map[string]any{"asset": "BTC.BTC", "amount": "40"},
+			map[string]any{"asset": "ETH.ETH", "amount": "60"},
+		},
+	}}
+	items, err := rule.Items(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+
+	ctx.Item = items[0]
+	_, ok, err := rule.Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	ctx.Item = items[1]
+	n, ok, err := rule.Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Title, Equals, "ETH.ETH 120")
+
+	// missing lists have no items, other values fail
+	ctx.Msg = map[string]any{"type": "/types.MsgSend"}
+	items, err = rule.Items(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+	ctx.Msg = map[string]any{"coins": "abc"}
+	_, err = rule.Items(ctx)
+	c.Check(err, ErrorMatches, "each is not a list.*")
+}
+
+func (t *Test) TestDefaultRules(c *C) {
+	rules, err := Defaults()
+	c.Assert(err, IsNil)
+	byName := map[string]*Rule{}
+	for _, rule := range rules {
+		byName[rule.Name] = rule
+	}
+
+	// version
+	ctx := Context{
+		Height: 1,
+		Config: config.Get(),
+		Stage:  "begin",
+		Event:  map[string]string{"type": "version", "version": "3.1.0"},
+	}
+	n, ok, err := byName["version"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Title, Equals, "Network Version Upgraded: `3.1.0`")
+
+	// security events only include transaction fields for transaction events
+	ctx.Stage = "end"
+	ctx.Event = map[string]string{"type": "security", "msg": "test"}
+	n, ok, err = byName["security-event"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Lines, DeepEquals, []string{"```{\n  \"msg\": \"test\",\n  \"type\": \"security\"\n}```"})
+	c.Check(n.Fields.Keys(), HasLen, 0)
+	ctx.Stage = "tx"
+	ctx.Tx = &Tx{Hash: "ABC"}
+	n, ok, err = byName["security-event"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Fields.Keys(), DeepEquals, []string{"Hash", "Links"})
+
+	// failed transactions skip noisy codes
+	ctx.Event = nil
+	ctx.Tx = &Tx{Hash: "ABC", Code: 32, Decoded: true}
+	_, ok, err = byName["failed-transaction"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+	ctx.Tx = &Tx{Hash: "ABC", Code: 11, Log: "out of gas", Decoded: true}
+	n, ok, err = byName["failed-transaction"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Fields.Keys(), DeepEquals, []string{"Code", "Transaction", "Log"})
+
+	// last round failures
+	msg, err := msgMap(&types.MsgTssKeysignFail{
+		Blame: types.Blame{Round: "SignRound7Message"},
+		Memo:  "OUT:ABC",
+		Coins: common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One))},
+	})
+	c.Assert(err, IsNil)
+	c.Check(msg["type"], Equals, "/types.MsgTssKeysignFail")
+	ctx.Msg = msg
+	ok, err = byName["last-round-failure"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+
+	msg["memo"] = "MIGRATE:10"
+	ok, err = byName["last-round-failure"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	msg["memo"] = "OUT:ABC"
+	msg["blame"] = map[string]any{"round": "SignRound4Message"}
+	ok, err = byName["last-round-failure"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// transfers below the threshold
+	msg, err = msgMap(&bank.MsgSend{
+		FromAddress: "thor1a",
+		ToAddress:   "thor1b",
+		Amount:      sdk.NewCoins(sdk.NewCoin("rune", math.NewInt(common.One))),
+	})
+	c.Assert(err, IsNil)
+	c.Check(msg["type"], Equals, "/cosmos.bank.v1beta1.MsgSend")
+	ctx.Msg = msg
+	ctx.Tx = &Tx{Hash: "ABC", Decoded: true}
+	ok, err = byName["large-transfer"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+	ok, err = byName["external-migration"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// transfers above the threshold are migrations if the memo matches
+	threshold := int64(ctx.Config.Thresholds.RuneTransferValue)
+	msg["amount"] = []any{map[string]any{"denom": "rune", "amount": cosmos.NewUint(uint64(threshold * common.One)).String()}}
+	ok, err = byName["large-transfer"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+	ctx.Tx.Memo = "MIGRATE:10"
+	ok, err = byName["large-transfer"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+	ok, err = byName["external-migration"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+}
+
+func (t *Test) TestDefaultStatefulRules(c *C) {
+	rules, err := Defaults()
+	c.Assert(err, IsNil)
+	byName := map[string]*Rule{}
+	for _, rule := range rules {
+		byName[rule.Name] = rule
+	}
+
+	responses := map[string]string{}
+	thornodeGet = func(path string, _ int64, result interface{}, _ ...int) error {
+		response, ok := responses[path]
+		if !ok {
+			return fmt.Errorf("status code 404")
+		}
+		return json.Unmarshal([]byte(response), result)
+	}
+	defer func() { thornodeGet = util.ThornodeCachedRetryGet }()
+
+	// tor anchor drift is only checked periodically
+	ctx := Context{Height: 301, Config: config.Get()}
+	ok, err := byName["tor-anchor-drift"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	responses["thorchain/mimir"] = `{"TORANCHOR-ETH-USDC-0XA": 1, "TORANCHOR-AVAX-USDC-0XB": 1}`
+	responses["thorchain/pools"] = `[
+		{"asset": "ETH.USDC-0XA", "asset_tor_price": "100000000"},
+		{"asset": "AVAX.USDC-0XB", "asset_tor_price": "94000000"},
+		{"asset": "BTC.BTC", "asset_tor_price": "5000000000000"}
+	]`
+	ctx.Height = 300
+	n, ok, err := byName["tor-anchor-drift"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Title, Equals, "TOR Anchor Drift (6.00%)")
+	c.Check(n.Lines, DeepEquals, []string{"`AVAX.USDC`: $0.94\n`ETH.USDC`: $1.00"})
+
+	responses["thorchain/pools"] = `[
+		{"asset": "ETH.USDC-0XA", "asset_tor_price": "100000000"},
+		{"asset": "AVAX.USDC-0XB", "asset_tor_price": "96000000"}
+	]`
+	_, ok, err = byName["tor-anchor-drift"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// inbounds to inactive vaults
+	tx := common.NewObservedTx(common.Tx{
+		ID:    "ABC",
+		Chain: common.BTCChain,
+		Coins: common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One))),
+		Memo:  "=:ETH.ETH:0x1",
+	}, 100, "vault", 100)
+	msg, err := msgMap(&types.MsgObservedTxIn{Txs: common.ObservedTxs{tx}})
+	c.Assert(err, IsNil)
+	ctx = Context{Height: 10000, Config: config.Get(), Tx: &Tx{Hash: "DEF", Decoded: true}, Msg: msg}
+	items, err := byName["inactive-vault-inbound"].Items(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	ctx.Item = items[0]
+
+	responses["thorchain/vault/vault"] = `{"status": "ActiveVault", "status_since": 9000}`
+	responses["thorchain/tx/stages/ABC"] = `{"inbound_observed": {"completed": true}}`
+	_, ok, err = byName["inactive-vault-inbound"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	responses["thorchain/vault/vault"] = `{"status": "RetiringVault", "status_since": 9000}`
+	_, ok, err = byName["inactive-vault-inbound"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false, Commentf("recently retiring vaults are skipped"))
+
+	responses["thorchain/vault/vault"] = `{"status": "RetiringVault", "status_since": 2000}`
+	n, ok, err = byName["inactive-vault-inbound"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	c.Check(n.Title, Equals, "Inbound to Non-Active Vault")
+	vault, _ := n.Fields.Get("Vault")
+	c.Check(vault, Equals, "vault")
+
+	responses["thorchain/tx/stages/ABC"] = `{"inbound_finalised": {"completed": true}}`
+	_, ok, err = byName["inactive-vault-inbound"].Render(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false, Commentf("finalised inbounds are skipped"))
+
+	// unconfirmed inbounds must take over 2 minutes to confirm
+	ok, err = byName["large-unconfirmed-inbound"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+	tx.FinaliseHeight = tx.BlockHeight + 12
+	msg, err = msgMap(&types.MsgObservedTxIn{Txs: common.ObservedTxs{tx}})
+	c.Assert(err, IsNil)
+	ctx.Msg = msg
+	ctx.Item = msg["txs"].([]any)[0]
+	ok, err = byName["large-unconfirmed-inbound"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+	ctx.Item.(map[string]any)["tx"].(map[string]any)["memo"] = "trade+:thor1a"
+	ok, err = byName["large-unconfirmed-inbound"].Match(ctx)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// streaming swap intervals are parsed from the memo limit
+	streaming := byName["large-streaming-swap"]
+	interval := streaming.vars[len(streaming.vars)-1]
+	c.Assert(streaming.Vars[len(streaming.vars)-1].Name, Equals, "interval")
+	for memo, expected := range map[string]string{
+		"=:ETH.ETH:0x1:0/10/20": "10",
+		"=:ETH.ETH:0x1:0/x/20":  "",
+		"=:ETH.ETH:0x1":         "",
+		"noop":                  "",
+	} {
+		ctx.Event = map[string]string{"memo": memo}
+		var value string
+		value, err = execute(interval, ctx)
+		c.Assert(err, IsNil)
+		c.Check(value, Equals, expected, Commentf(memo))
+	}
+}
+
+func (t *Test) TestFuncs(c *C) {
+	c.Check(mustCompare(c, "lt", "5", uint64(10)), Equals, true)
+	c.Check(mustCompare(c, "ge", 1.5, int64(2)), Equals, false)
+
+	amount, err := coinAmount([]any{
+		map[string]any{"denom": "rune", "amount": "100"},
+		map[string]any{"denom": "x/ruji", "amount": "50"},
+		map[string]any{"denom": "rune", "amount": "5"},
+	}, "rune")
+	c.Assert(err, IsNil)
+	c.Check(amount, Equals, uint64(105))
+
+	coin, err := toCoin(map[string]any{"asset": "BTC.BTC", "amount": "100"})
+	c.Assert(err, IsNil)
+	c.Check(coin.Equals(common.NewCoin(common.BTCAsset, cosmos.NewUint(100))), Equals, true)
+	coin, err = toCoin(uint64(100), "THOR.RUNE")
+	c.Assert(err, IsNil)
+	c.Check(coin.Asset.IsRune(), Equals, true)
+
+	fee, err := constant("NativeTransactionFee")
+	c.Assert(err, IsNil)
+	c.Check(fee > 0, Equals, true)
+	_, err = constant("Unknown")
+	c.Check(err, NotNil)
+
+	s, err := formatLocale(int64(1234567))
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "1,234,567")
+
+	m, err := mod("600", int64(300))
+	c.Assert(err, IsNil)
+	c.Check(m, Equals, int64(0))
+	_, err = mod(1, 0)
+	c.Check(err, NotNil)
+
+	sorted, err := sortBy("price", []any{
+		map[string]any{"asset": "a", "price": "3"},
+		map[string]any{"asset": "b", "price": json.Number("1")},
+		map[string]any{"asset": "c", "price": 2.0},
+	})
+	c.Assert(err, IsNil)
+	c.Check(join(",", sorted), Equals, "map[asset:b price:1],map[asset:c price:2],map[asset:a price:3]")
+
+	c.Check(memoType("=:ETH.ETH:0x1"), Equals, "swap")
+	c.Check(memoType("consolidate"), Equals, "consolidate")
+	c.Check(memoType(""), Equals, "")
+	c.Check(memoType(nil), Equals, "")
+
+	seconds, err := blockSeconds("BTC", 2)
+	c.Assert(err, IsNil)
+	c.Check(seconds, Equals, float64(1200))
+	s, err = formatDuration(seconds)
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "20m")
+
+	s, err = mimirString("ETH.USDC-0XA")
+	c.Assert(err, IsNil)
+	c.Check(s, Equals, "ETH-USDC-0XA")
+}
+
+func mustCompare(c *C, name string, a, b any) bool {
+	result, err := funcs[name].(func(a, b any) (bool, error))(a, b)
+	c.Assert(err, IsNil)
+	return result
+}
@@ -0,0 +1,114 @@
+package thorscan
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+)
+
+// -------------------------------------------------------------------------------------
+// Cache
+// -------------------------------------------------------------------------------------
+
+// CacheSource wraps a source with a local on-disk cache of gzipped JSON blocks, so
+// repeated backfills over the same range do not refetch blocks.
+type CacheSource struct {
+	Source Source
+	Dir    string
+}
+
+// NewCacheSource returns a source caching blocks from the wrapped source in dir.
+func NewCacheSource(source Source, dir string) (*CacheSource, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &CacheSource{Source: source, Dir: dir}, nil
+}
+
+// Block implements the Source interface. The latest block is never cached.
+func (c *CacheSource) Block(height int64) (*BlockResponse, error) {
+	if height <= 0 {
+		return c.Source.Block(height)
+	}
+
+	// return the cached block if it exists
+	block, err := c.load(height)
+	switch {
+	case err == nil:
+		return block, nil
+	case !errors.Is(err, os.ErrNotExist):
+		log.Warn().Err(err).Int64("height", height).Msg("failed to read cached block")
+	}
+
+	block, err = c.Source.Block(height)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.store(height, block); err != nil {
+		log.Warn().Err(err).Int64("height", height).Msg("failed to cache block")
+	}
+	return block, nil
+}
+
+func (c *CacheSource) path(height int64) string {
+	// shard by 10k blocks to avoid large directories
+	return filepath.Join(
+		c.Dir,
+		strconv.FormatInt(height/10_000, 10),
+		strconv.FormatInt(height, 10)+".json.gz",
+	)
+}
+
+func (c *CacheSource) load(height int64) (*BlockResponse, error) {
+	f, err := os.Open(c.path(height))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	block := &BlockResponse{}
+	if err = json.NewDecoder(gz).Decode(block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (c *CacheSource) store(height int64, block *BlockResponse) error {
+	path := c.path(height)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temporary file and rename so partial writes are never read
+	f, err := os.CreateTemp(filepath.Dir(path), ".block-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	gz := gzip.NewWriter(f)
+	err = json.NewEncoder(gz).Encode(block)
+	if err == nil {
+		err = gz.Close()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
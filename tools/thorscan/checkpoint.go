@@ -0,0 +1,64 @@
+package thorscan
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// -------------------------------------------------------------------------------------
+// Checkpoint
+// -------------------------------------------------------------------------------------
+
+// Checkpoint persists the last height delivered by Scan to allow resuming.
+type Checkpoint interface {
+	// Load returns the last saved height, and false if no height has been saved.
+	Load() (int64, bool, error)
+
+	// Save records the height as processed.
+	Save(height int64) error
+}
+
+// FileCheckpoint stores the checkpoint height as text in a file.
+type FileCheckpoint struct {
+	Path string
+}
+
+// NewFileCheckpoint returns a checkpoint stored at the provided path.
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{Path: path}
+}
+
+// Load implements the Checkpoint interface.
+func (c *FileCheckpoint) Load() (int64, bool, error) {
+	data, err := os.ReadFile(c.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	height, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid checkpoint: %w", err)
+	}
+	return height, true, nil
+}
+
+// Save implements the Checkpoint interface.
+func (c *FileCheckpoint) Save(height int64) error {
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temporary file and rename so the checkpoint is never partially written
+	tmp := c.Path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(height, 10)+"\n"), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.Path)
+}
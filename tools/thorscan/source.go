@@ -0,0 +1,211 @@
+package thorscan
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+
+	openapi "gitlab.com/thorchain/thornode/v3/openapi/gen"
+)
+
+// ErrBlockNotFound is returned by sources for heights that do not exist yet.
+var ErrBlockNotFound = errors.New("block not found")
+
+// -------------------------------------------------------------------------------------
+// Source
+// -------------------------------------------------------------------------------------
+
+// Source provides blocks to Scan.
+type Source interface {
+	// Block returns the block at the provided height, or the latest block if the height
+	// is not positive. ErrBlockNotFound is returned for heights beyond the latest block.
+	Block(height int64) (*BlockResponse, error)
+}
+
+// APISource reads blocks from the thornode REST API at APIEndpoint.
+type APISource struct{}
+
+// Block implements the Source interface.
+func (APISource) Block(height int64) (*BlockResponse, error) {
+	return getBlock(height)
+}
+
+// -------------------------------------------------------------------------------------
+// Local
+// -------------------------------------------------------------------------------------
+
+// LocalSource reads blocks directly from the block and state stores in a thornode data
+// directory. The node must not be running since the databases are opened exclusively,
+// and it must have retained ABCI responses (discard_abci_responses = false).
+type LocalSource struct {
+	blocks *store.BlockStore
+	state  sm.Store
+}
+
+// NewLocalSource opens the block and state stores in the data directory (for example
+// ~/.thornode/data) with the provided database backend (for example "goleveldb").
+func NewLocalSource(dataDir, backend string) (*LocalSource, error) {
+	blockDB, err := dbm.NewDB("blockstore", dbm.BackendType(backend), dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open block store: %w", err)
+	}
+	stateDB, err := dbm.NewDB("state", dbm.BackendType(backend), dataDir)
+	if err != nil {
+		blockDB.Close()
+		return nil, fmt.Errorf("failed to open state store: %w", err)
+	}
+
+	return &LocalSource{
+		blocks: store.NewBlockStore(blockDB),
+		state:  sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false}),
+	}, nil
+}
+
+// Close closes the underlying databases.
+func (s *LocalSource) Close() error {
+	return errors.Join(s.blocks.Close(), s.state.Close())
+}
+
+// Block implements the Source interface.
+func (s *LocalSource) Block(height int64) (*BlockResponse, error) {
+	if height <= 0 {
+		height = s.blocks.Height()
+	}
+	if height > s.blocks.Height() {
+		return nil, ErrBlockNotFound
+	}
+	if height < s.blocks.Base() {
+		return nil, fmt.Errorf("block %d is pruned, base height is %d", height, s.blocks.Base())
+	}
+
+	block := s.blocks.LoadBlock(height)
+	meta := s.blocks.LoadBlockMeta(height)
+	if block == nil || meta == nil {
+		return nil, fmt.Errorf("failed to load block %d", height)
+	}
+	results, err := s.state.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load block results %d: %w", height, err)
+	}
+	if len(results.TxResults) != len(block.Txs) {
+		return nil, fmt.Errorf("block %d has %d txs and %d results", height, len(block.Txs), len(results.TxResults))
+	}
+
+	// build the response in the same format as the thornode block endpoint
+	res := &BlockResponse{}
+	res.Id = openapi.BlockResponseId{
+		Hash: meta.BlockID.Hash.String(),
+		Parts: openapi.BlockResponseIdParts{
+			Total: int64(meta.BlockID.PartSetHeader.Total),
+			Hash:  meta.BlockID.PartSetHeader.Hash.String(),
+		},
+	}
+	res.Header = openapi.BlockResponseHeader{
+		Version: openapi.BlockResponseHeaderVersion{
+			Block: strconv.FormatUint(block.Version.Block, 10),
+			App:   strconv.FormatUint(block.Version.App, 10),
+		},
+		ChainId: block.ChainID,
+		Height:  block.Height,
+		Time:    block.Time.Format(time.RFC3339Nano),
+		LastBlockId: openapi.BlockResponseId{
+			Hash: block.LastBlockID.Hash.String(),
+			Parts: openapi.BlockResponseIdParts{
+				Total: int64(block.LastBlockID.PartSetHeader.Total),
+				Hash:  block.LastBlockID.PartSetHeader.Hash.String(),
+			},
+		},
+		LastCommitHash:     block.LastCommitHash.String(),
+		DataHash:           block.DataHash.String(),
+		ValidatorsHash:     block.ValidatorsHash.String(),
+		NextValidatorsHash: block.NextValidatorsHash.String(),
+		ConsensusHash:      block.ConsensusHash.String(),
+		AppHash:            block.AppHash.String(),
+		LastResultsHash:    block.LastResultsHash.String(),
+		EvidenceHash:       block.EvidenceHash.String(),
+		ProposerAddress:    block.ProposerAddress.String(),
+	}
+
+	// split the block events by mode
+	res.BeginBlockEvents = []map[string]string{}
+	res.EndBlockEvents = []map[string]string{}
+	res.FinalizeBlockEvents = []map[string]string{}
+	for _, event := range results.Events {
+		switch eventMode(event) {
+		case "BeginBlock":
+			res.BeginBlockEvents = append(res.BeginBlockEvents, eventMap(event))
+		case "EndBlock":
+			res.EndBlockEvents = append(res.EndBlockEvents, eventMap(event))
+		default:
+			res.FinalizeBlockEvents = append(res.FinalizeBlockEvents, eventMap(event))
+		}
+	}
+
+	// decode the transactions
+	res.Txs = make([]BlockTx, len(block.Txs))
+	for i, tx := range block.Txs {
+		result := results.TxResults[i]
+		code := int64(result.Code)
+		gasWanted := strconv.FormatInt(result.GasWanted, 10)
+		gasUsed := strconv.FormatInt(result.GasUsed, 10)
+
+		res.Txs[i].Hash = strings.ToUpper(hex.EncodeToString(tx.Hash()))
+		res.Txs[i].Result = openapi.BlockTxResult{
+			Code:      &code,
+			Log:       optional(result.Log),
+			Info:      optional(result.Info),
+			GasWanted: &gasWanted,
+			GasUsed:   &gasUsed,
+			Codespace: optional(result.Codespace),
+			Events:    make([]map[string]string, len(result.Events)),
+		}
+		for j, event := range result.Events {
+			res.Txs[i].Result.Events[j] = eventMap(event)
+		}
+
+		// transactions that fail to decode are returned without the decoded tx
+		res.Txs[i].Tx, err = encodingConfig.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			res.Txs[i].Tx = nil
+		}
+	}
+
+	return res, nil
+}
+
+// -------------------------------------------------------------------------------------
+// Helpers
+// -------------------------------------------------------------------------------------
+
+func eventMode(event abci.Event) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// optional returns nil for empty strings to match omitted fields in API responses.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func eventMap(event abci.Event) map[string]string {
+	m := map[string]string{"type": event.Type}
+	for _, attr := range event.Attributes {
+		m[attr.Key] = attr.Value
+	}
+	return m
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -92,13 +93,13 @@ func getBlock(height int64) (*BlockResponse, error) {
 	switch res.StatusCode {
 	case http.StatusOK:
 	case http.StatusNotFound:
-		return nil, fmt.Errorf("block not found")
+		return nil, ErrBlockNotFound
 	case http.StatusInternalServerError:
 		// attempt to read the body
 		var body []byte
 		body, err = io.ReadAll(res.Body)
 		if err == nil && bytes.Contains(body, []byte("cannot query with height in the future")) {
-			return nil, fmt.Errorf("block not found")
+			return nil, ErrBlockNotFound
 		}
 		fallthrough
 	default:
@@ -202,20 +203,116 @@ func (b *BlockTx) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func (b BlockTx) MarshalJSON() ([]byte, error) {
+	// marshal from temporary type with the tx encoded from the cosmos type
+	type marshalQueryBlockTx struct {
+		openapi.BlockTx
+		Tx json.RawMessage `json:"tx,omitempty"`
+	}
+	mbt := marshalQueryBlockTx{BlockTx: b.BlockTx}
+	if b.Tx != nil {
+		tx, err := encodingConfig.TxConfig.TxJSONEncoder()(b.Tx)
+		if err != nil {
+			return nil, err
+		}
+		mbt.Tx = tx
+	}
+	return json.Marshal(mbt)
+}
+
 // BlockResponse wraps the openapi type with a custom Txs field for unmarshaling.
 type BlockResponse struct {
 	openapi.BlockResponse
 	Txs []BlockTx `json:"txs"`
 }
 
+// -------------------------------------------------------------------------------------
+// Options
+// -------------------------------------------------------------------------------------
+
+// Option configures Scan.
+type Option func(*scanOptions)
+
+type scanOptions struct {
+	parallelism int
+	source      Source
+	cacheDir    string
+	checkpoint  Checkpoint
+}
+
+// WithParallelism sets the number of blocks to prefetch concurrently, blocks are still
+// delivered in order. Defaults to Parallelism.
+func WithParallelism(n int) Option {
+	return func(o *scanOptions) {
+		o.parallelism = n
+	}
+}
+
+// WithSource sets the source to read blocks from. Defaults to the REST API.
+func WithSource(source Source) Option {
+	return func(o *scanOptions) {
+		o.source = source
+	}
+}
+
+// WithCache caches blocks from the source as gzipped JSON files in dir.
+func WithCache(dir string) Option {
+	return func(o *scanOptions) {
+		o.cacheDir = dir
+	}
+}
+
+// WithCheckpoint resumes the scan from the height after the saved checkpoint if one
+// exists, overriding the start height. A block is checkpointed once the consumer
+// receives the next block, so the last block received before a crash is delivered again
+// on resume.
+func WithCheckpoint(checkpoint Checkpoint) Option {
+	return func(o *scanOptions) {
+		o.checkpoint = checkpoint
+	}
+}
+
 // -------------------------------------------------------------------------------------
 // Exported
 // -------------------------------------------------------------------------------------
 
-func Scan(startHeight, stopHeight int) <-chan *BlockResponse {
+// Scan returns a channel of blocks from the start to stop height. A non-positive start
+// height is relative to the current height, and a negative stop height is relative to
+// the current height, a zero stop height tails the chain indefinitely.
+func Scan(startHeight, stopHeight int, opts ...Option) <-chan *BlockResponse {
+	o := scanOptions{
+		parallelism: Parallelism,
+		source:      APISource{},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.parallelism < 1 {
+		log.Fatal().Int("parallelism", o.parallelism).Msg("parallelism must be positive")
+	}
+	if o.cacheDir != "" {
+		source, err := NewCacheSource(o.source, o.cacheDir)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create block cache")
+		}
+		o.source = source
+	}
+
+	// resume from the checkpoint if one exists
+	if o.checkpoint != nil {
+		height, ok, err := o.checkpoint.Load()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to load checkpoint")
+		}
+		if ok {
+			log.Info().Int64("height", height).Msg("resuming from checkpoint")
+			startHeight = int(height) + 1
+		}
+	}
+
 	// get current height if start was not provided
 	if startHeight <= 0 || stopHeight < 0 {
-		block, err := getBlock(-1)
+		block, err := o.source.Block(-1)
 		if err != nil {
 			log.Fatal().Err(err).Msg("failed to get current height")
 		}
@@ -238,29 +335,30 @@ func Scan(startHeight, stopHeight int) <-chan *BlockResponse {
 	}()
 
 	// setup ring buffer for block prefetching with routine per slot
-	ring := make([]chan *BlockResponse, Parallelism)
-	shutdown := make(chan struct{}, Parallelism-1)
-	for i := 0; i < Parallelism; i++ {
+	parallelism := o.parallelism
+	ring := make([]chan *BlockResponse, parallelism)
+	shutdown := make(chan struct{}, parallelism-1)
+	for i := 0; i < parallelism; i++ {
 		ring[i] = make(chan *BlockResponse)
 		go func(i int) {
 			for height := range queue {
 				for {
-					b, err := getBlock(height)
+					b, err := o.source.Block(height)
 					if err != nil {
-						if !strings.Contains(err.Error(), "block not found") {
+						if !errors.Is(err, ErrBlockNotFound) {
 							log.Error().Err(err).Int64("height", height).Msg("failed to fetch block")
 						}
 						time.Sleep(constants.ThorchainBlockTime)
 						continue
 					}
-					ring[int(height)%Parallelism] <- b
+					ring[int(height)%parallelism] <- b
 
 					// allow all but one routine to exit once we near tip
 					blockTime, err := time.Parse(time.RFC3339, b.Header.Time)
 					if err != nil {
 						log.Fatal().Err(err).Msg("failed to parse block time")
 					}
-					near := time.Now().Add(-constants.ThorchainBlockTime * time.Duration(Parallelism))
+					near := time.Now().Add(-constants.ThorchainBlockTime * time.Duration(parallelism))
 					if err == nil && blockTime.After(near) {
 						select {
 						case shutdown <- struct{}{}:
@@ -280,7 +378,14 @@ func Scan(startHeight, stopHeight int) <-chan *BlockResponse {
 	out := make(chan *BlockResponse)
 	go func() {
 		for height := int64(startHeight); stopHeight == 0 || int(height) <= stopHeight; height++ {
-			out <- <-ring[int(height)%Parallelism]
+			out <- <-ring[int(height)%parallelism]
+
+			// the consumer received this block so it is done with the previous one
+			if o.checkpoint != nil && height > int64(startHeight) {
+				if err := o.checkpoint.Save(height - 1); err != nil {
+					log.Fatal().Err(err).Int64("height", height-1).Msg("failed to save checkpoint")
+				}
+			}
 		}
 		close(out)
 	}()
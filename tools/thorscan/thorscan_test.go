@@ -0,0 +1,146 @@
+package thorscan
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	. "gopkg.in/check.v1"
+)
+
+func TestPackage(t *testing.T) { TestingT(t) }
+
+type Test struct{}
+
+var _ = Suite(&Test{})
+
+// testSource returns empty blocks up to the tip and counts fetches by height.
+type testSource struct {
+	mu      sync.Mutex
+	tip     int64
+	fetches map[int64]int
+}
+
+func newTestSource(tip int64) *testSource {
+	return &testSource{tip: tip, fetches: map[int64]int{}}
+}
+
+func (s *testSource) Block(height int64) (*BlockResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if height <= 0 {
+		height = s.tip
+	}
+	if height > s.tip {
+		return nil, ErrBlockNotFound
+	}
+	s.fetches[height]++
+
+	// stagger responses so later heights may be fetched first
+	time.Sleep(time.Duration(height%3) * time.Millisecond)
+
+	b := &BlockResponse{}
+	b.Header.Height = height
+	b.Header.Time = time.Unix(height, 0).UTC().Format(time.RFC3339)
+	b.EndBlockEvents = []map[string]string{{"type": "test"}}
+	return b, nil
+}
+
+func heights(blocks <-chan *BlockResponse) []int64 {
+	result := []int64{}
+	for b := range blocks {
+		result = append(result, b.Header.Height)
+	}
+	return result
+}
+
+func (t *Test) TestScanOrdered(c *C) {
+	source := newTestSource(100)
+	result := heights(Scan(1, 50, WithSource(source), WithParallelism(8)))
+	c.Assert(result, HasLen, 50)
+	for i, height := range result {
+		c.Check(height, Equals, int64(i+1))
+	}
+
+	// relative heights
+	c.Check(heights(Scan(-2, -1, WithSource(source))), DeepEquals, []int64{98, 99})
+}
+
+func (t *Test) TestScanCheckpoint(c *C) {
+	checkpoint := NewFileCheckpoint(filepath.Join(c.MkDir(), "checkpoint"))
+	_, ok, err := checkpoint.Load()
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// consume part of the scan, the last received block is not checkpointed
+	source := newTestSource(100)
+	blocks := Scan(1, 100, WithSource(source), WithCheckpoint(checkpoint))
+	for i := 0; i < 10; i++ {
+		<-blocks
+	}
+
+	// the checkpoint is saved asynchronously after the block is received
+	var height int64
+	for i := 0; i < 100 && height != 9; i++ {
+		time.Sleep(10 * time.Millisecond)
+		height, ok, err = checkpoint.Load()
+		c.Assert(err, IsNil)
+	}
+	c.Check(ok, Equals, true)
+	c.Check(height, Equals, int64(9))
+
+	// resume overrides the start height
+	result := heights(Scan(1, 12, WithSource(source), WithCheckpoint(checkpoint)))
+	c.Check(result, DeepEquals, []int64{10, 11, 12})
+	height, _, err = checkpoint.Load()
+	c.Assert(err, IsNil)
+	c.Check(height, Equals, int64(11))
+}
+
+func (t *Test) TestCacheSource(c *C) {
+	source := newTestSource(100)
+	cache, err := NewCacheSource(source, c.MkDir())
+	c.Assert(err, IsNil)
+
+	b, err := cache.Block(10)
+	c.Assert(err, IsNil)
+	c.Check(b.Header.Height, Equals, int64(10))
+	b, err = cache.Block(10)
+	c.Assert(err, IsNil)
+	c.Check(b.Header.Height, Equals, int64(10))
+	c.Check(b.EndBlockEvents, DeepEquals, []map[string]string{{"type": "test"}})
+	c.Check(source.fetches[10], Equals, 1)
+
+	// the latest block and missing blocks are not cached
+	_, err = cache.Block(-1)
+	c.Assert(err, IsNil)
+	_, err = cache.Block(-1)
+	c.Assert(err, IsNil)
+	c.Check(source.fetches[100], Equals, 2)
+	_, err = cache.Block(101)
+	c.Check(err, Equals, ErrBlockNotFound)
+}
+
+func (t *Test) TestBlockTxJSON(c *C) {
+	builder := encodingConfig.TxConfig.NewTxBuilder()
+	c.Assert(builder.SetMsgs(&bank.MsgSend{
+		FromAddress: "thor1a",
+		ToAddress:   "thor1b",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("rune", 1)),
+	}), IsNil)
+	builder.SetMemo("memo")
+
+	tx := BlockTx{Tx: builder.GetTx()}
+	tx.Hash = "ABC"
+	data, err := tx.MarshalJSON()
+	c.Assert(err, IsNil)
+
+	decoded := BlockTx{}
+	c.Assert(decoded.UnmarshalJSON(data), IsNil)
+	c.Check(decoded.Hash, Equals, "ABC")
+	c.Assert(decoded.Tx, NotNil)
+	c.Check(decoded.Tx.GetMsgs(), HasLen, 1)
+}
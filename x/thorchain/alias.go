@@ -20,6 +20,8 @@ const (
 	RUNEPoolName           = types.RUNEPoolName
 	TCYClaimingName        = types.TCYClaimingName
 	TCYStakeName           = types.TCYStakeName
+	LiquidBondName         = types.LiquidBondName
+	RecurringSwapName      = types.RecurringSwapName
 	RouterKey              = types.RouterKey
 	StoreKey               = types.StoreKey
 	DefaultCodespace       = types.DefaultCodespace
@@ -32,6 +34,17 @@ const (
 	// Admin config keys
 	MaxWithdrawBasisPoints = types.MaxWithdrawBasisPoints
 
+	// Observation stats
+	ObservationMissed = types.ObservationMissed
+
+	// Recurring swaps
+	RecurringSwapStatusCreated   = types.RecurringSwapStatusCreated
+	RecurringSwapStatusCompleted = types.RecurringSwapStatusCompleted
+	RecurringSwapStatusCancelled = types.RecurringSwapStatusCancelled
+	RecurringSwapFillPending     = types.RecurringSwapFillPending
+	RecurringSwapFillFilled      = types.RecurringSwapFillFilled
+	RecurringSwapFillFailed      = types.RecurringSwapFillFailed
+
 	// Vaults
 	AsgardVault   = types.VaultType_AsgardVault
 	UnknownVault  = types.VaultType_UnknownVault
@@ -65,36 +78,42 @@ const (
 	WithdrawPendingLiquidity = types.PendingLiquidityType_withdraw
 
 	// Swap Type
-	MarketSwap = types.SwapType_market
-	LimitSwap  = types.SwapType_limit
+	MarketSwap     = types.SwapType_market
+	LimitSwap      = types.SwapType_limit
+	StopLossSwap   = types.SwapType_stop_loss
+	TakeProfitSwap = types.SwapType_take_profit
 
 	// Mint/Burn type
 	MintSupplyType = types.MintBurnSupplyType_mint
 	BurnSupplyType = types.MintBurnSupplyType_burn
 
 	// Memos
-	TxSwap            = mem.TxSwap
-	TxLimitSwap       = mem.TxLimitSwap
-	TxModifyLimitSwap = mem.TxModifyLimitSwap
-	TxAdd             = mem.TxAdd
-	TxBond            = mem.TxBond
-	TxMigrate         = mem.TxMigrate
-	TxRagnarok        = mem.TxRagnarok
-	TxReserve         = mem.TxReserve
-	TxOutbound        = mem.TxOutbound
-	TxRefund          = mem.TxRefund
-	TxUnBond          = mem.TxUnbond
-	TxRebond          = mem.TxRebond
-	TxLeave           = mem.TxLeave
-	TxMaint           = mem.TxMaint
-	TxWithdraw        = mem.TxWithdraw
-	TxTHORName        = mem.TxTHORName
-	TxLoanOpen        = mem.TxLoanOpen
-	TxLoanRepayment   = mem.TxLoanRepayment
-	TxTCYClaim        = mem.TxTCYClaim
-	TxTCYStake        = mem.TxTCYStake
-	TxTCYUnstake      = mem.TxTCYUnstake
-	TxOperatorRotate  = mem.TxOperatorRotate
+	TxSwap                = mem.TxSwap
+	TxLimitSwap           = mem.TxLimitSwap
+	TxModifyLimitSwap     = mem.TxModifyLimitSwap
+	TxModifyStreamingSwap = mem.TxModifyStreamingSwap
+	TxRecurringSwapCancel = mem.TxRecurringSwapCancel
+	TxAdd                 = mem.TxAdd
+	TxBond                = mem.TxBond
+	TxMigrate             = mem.TxMigrate
+	TxRagnarok            = mem.TxRagnarok
+	TxReserve             = mem.TxReserve
+	TxOutbound            = mem.TxOutbound
+	TxRefund              = mem.TxRefund
+	TxUnBond              = mem.TxUnbond
+	TxRebond              = mem.TxRebond
+	TxLiquidBond          = mem.TxLiquidBond
+	TxLiquidUnbond        = mem.TxLiquidUnbond
+	TxLeave               = mem.TxLeave
+	TxMaint               = mem.TxMaint
+	TxWithdraw            = mem.TxWithdraw
+	TxTHORName            = mem.TxTHORName
+	TxLoanOpen            = mem.TxLoanOpen
+	TxLoanRepayment       = mem.TxLoanRepayment
+	TxTCYClaim            = mem.TxTCYClaim
+	TxTCYStake            = mem.TxTCYStake
+	TxTCYUnstake          = mem.TxTCYUnstake
+	TxOperatorRotate      = mem.TxOperatorRotate
 )
 
 var (
@@ -105,17 +124,25 @@ var (
 	NewObservedTx                  = common.NewObservedTx
 	NewTssVoter                    = types.NewTssVoter
 	NewBanVoter                    = types.NewBanVoter
+	NewObservationStats            = types.NewObservationStats
+	NewPoolHistory                 = types.NewPoolHistory
 	NewErrataTxVoter               = types.NewErrataTxVoter
 	NewObservedTxVoter             = types.NewObservedTxVoter
 	NewMsgRunePoolDeposit          = types.NewMsgRunePoolDeposit
 	NewMsgRunePoolWithdraw         = types.NewMsgRunePoolWithdraw
 	NewMsgTradeAccountDeposit      = types.NewMsgTradeAccountDeposit
 	NewMsgTradeAccountWithdrawal   = types.NewMsgTradeAccountWithdrawal
+	NewMsgTradeAccountTransfer     = types.NewMsgTradeAccountTransfer
+	NewMsgRecurringSwap            = types.NewMsgRecurringSwap
+	NewMsgRecurringSwapCancel      = types.NewMsgRecurringSwapCancel
+	NewMsgLiquidBond               = types.NewMsgLiquidBond
+	NewMsgLiquidUnbond             = types.NewMsgLiquidUnbond
 	NewMsgSecuredAssetDeposit      = types.NewMsgSecuredAssetDeposit
 	NewMsgSecuredAssetWithdraw     = types.NewMsgSecuredAssetWithdraw
 	NewMsgLoanOpen                 = types.NewMsgLoanOpen
 	NewMsgLoanRepayment            = types.NewMsgLoanRepayment
 	NewMsgMimir                    = types.NewMsgMimir
+	NewMsgScheduledMimir           = types.NewMsgScheduledMimir
 	NewMsgNodePauseChain           = types.NewMsgNodePauseChain
 	NewMsgDeposit                  = types.NewMsgDeposit
 	NewMsgTssPool                  = types.NewMsgTssPool
@@ -130,6 +157,7 @@ var (
 	NewMsgWithdrawLiquidity        = types.NewMsgWithdrawLiquidity
 	NewMsgSwap                     = types.NewMsgSwap
 	NewMsgModifyLimitSwap          = types.NewMsgModifyLimitSwap
+	NewMsgModifyStreamingSwap      = types.NewMsgModifyStreamingSwap
 	NewKeygen                      = types.NewKeygen
 	NewKeygenBlock                 = types.NewKeygenBlock
 	NewMsgSetNodeKeys              = types.NewMsgSetNodeKeys
@@ -145,6 +173,7 @@ var (
 	NewEventStreamingSwap          = types.NewEventStreamingSwap
 	NewEventLimitSwap              = types.NewEventLimitSwap
 	NewEventModifyLimitSwap        = types.NewEventModifyLimitSwap
+	NewEventModifyStreamingSwap    = types.NewEventModifyStreamingSwap
 	NewEventAddLiquidity           = types.NewEventAddLiquidity
 	NewEventWithdraw               = types.NewEventWithdraw
 	NewEventRefund                 = types.NewEventRefund
@@ -161,6 +190,7 @@ var (
 	NewEventOutbound               = types.NewEventOutbound
 	NewEventSetMimir               = types.NewEventSetMimir
 	NewEventSetNodeMimir           = types.NewEventSetNodeMimir
+	NewScheduledMimir              = types.NewScheduledMimir
 	NewEventTssKeygenSuccess       = types.NewEventTssKeygenSuccess
 	NewEventTssKeygenFailure       = types.NewEventTssKeygenFailure
 	NewEventTssKeygenMetric        = types.NewEventTssKeygenMetric
@@ -172,6 +202,9 @@ var (
 	NewEventVersion                = types.NewEventVersion
 	NewEventTradeAccountDeposit    = types.NewEventTradeAccountDeposit
 	NewEventTradeAccountWithdraw   = types.NewEventTradeAccountWithdraw
+	NewEventTradeAccountTransfer   = types.NewEventTradeAccountTransfer
+	NewEventRecurringSwap          = types.NewEventRecurringSwap
+	NewEventPriceBreaker           = types.NewEventPriceBreaker
 	NewEventSecuredAssetDeposit    = types.NewEventSecuredAssetDeposit
 	NewEventSecuredAssetWithdraw   = types.NewEventSecuredAssetWithdraw
 	NewEventRUNEPoolDeposit        = types.NewEventRUNEPoolDeposit
@@ -190,6 +223,9 @@ var (
 	RegisterInterfaces             = types.RegisterInterfaces
 	NewBondProviders               = types.NewBondProviders
 	NewBondProvider                = types.NewBondProvider
+	GetLiquidBondAddress           = types.GetLiquidBondAddress
+	GetLiquidBondDenomID           = types.GetLiquidBondDenomID
+	GetLiquidBondDenom             = types.GetLiquidBondDenom
 	NewNodeAccount                 = types.NewNodeAccount
 	NewVault                       = types.NewVault
 	NewVaultV2                     = types.NewVaultV2
@@ -212,6 +248,8 @@ var (
 	NewTHORName                    = types.NewTHORName
 	NewLoan                        = types.NewLoan
 	NewStreamingSwap               = types.NewStreamingSwap
+	NewRecurringSwap               = types.NewRecurringSwap
+	NewRecurringSwapFill           = types.NewRecurringSwapFill
 	GetPoolStatus                  = types.GetPoolStatus
 	GetRandomVault                 = types.GetRandomVault
 	GetRandomTx                    = types.GetRandomTx
@@ -268,6 +306,11 @@ type (
 	MsgNoOp                   = types.MsgNoOp
 	MsgTradeAccountDeposit    = types.MsgTradeAccountDeposit
 	MsgTradeAccountWithdrawal = types.MsgTradeAccountWithdrawal
+	MsgTradeAccountTransfer   = types.MsgTradeAccountTransfer
+	MsgRecurringSwap          = types.MsgRecurringSwap
+	MsgRecurringSwapCancel    = types.MsgRecurringSwapCancel
+	MsgLiquidBond             = types.MsgLiquidBond
+	MsgLiquidUnbond           = types.MsgLiquidUnbond
 	MsgSecuredAssetDeposit    = types.MsgSecuredAssetDeposit
 	MsgSecuredAssetWithdraw   = types.MsgSecuredAssetWithdraw
 	MsgConsolidate            = types.MsgConsolidate
@@ -284,6 +327,7 @@ type (
 	MsgBan                    = types.MsgBan
 	MsgSwap                   = types.MsgSwap
 	MsgModifyLimitSwap        = types.MsgModifyLimitSwap
+	MsgModifyStreamingSwap    = types.MsgModifyStreamingSwap
 	MsgSetVersion             = types.MsgSetVersion
 	MsgProposeUpgrade         = types.MsgProposeUpgrade
 	MsgApproveUpgrade         = types.MsgApproveUpgrade
@@ -320,12 +364,16 @@ type (
 	Loan                     = types.Loan
 	Loans                    = types.Loans
 	StreamingSwap            = types.StreamingSwap
+	RecurringSwap            = types.RecurringSwap
+	RecurringSwapFill        = types.RecurringSwapFill
 	StreamingSwaps           = types.StreamingSwaps
 	ObservedTxs              = common.ObservedTxs
 	ObservedTx               = common.ObservedTx
 	ObservedTxVoter          = types.ObservedTxVoter
 	ObservedTxVoters         = types.ObservedTxVoters
 	BanVoter                 = types.BanVoter
+	ObservationStats         = types.ObservationStats
+	PoolHistory              = types.PoolHistory
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
@@ -378,6 +426,8 @@ type (
 	AffiliateFeeCollector    = types.AffiliateFeeCollector
 	NodeMimir                = types.NodeMimir
 	NodeMimirs               = types.NodeMimirs
+	ScheduledMimir           = types.ScheduledMimir
+	MimirHistory             = types.MimirHistory
 	SwapperClout             = types.SwapperClout
 	TradeAccount             = types.TradeAccount
 	TradeUnit                = types.TradeUnit
@@ -390,6 +440,7 @@ type (
 	// Memo
 	SwapMemo                   = mem.SwapMemo
 	ModifyLimitSwapMemo        = mem.ModifyLimitSwapMemo
+	ModifyStreamingSwapMemo    = mem.ModifyStreamingSwapMemo
 	AddLiquidityMemo           = mem.AddLiquidityMemo
 	WithdrawLiquidityMemo      = mem.WithdrawLiquidityMemo
 	DonateMemo                 = mem.DonateMemo
@@ -408,6 +459,11 @@ type (
 	ManageTHORNameMemo         = mem.ManageTHORNameMemo
 	TradeAccountDepositMemo    = mem.TradeAccountDepositMemo
 	TradeAccountWithdrawalMemo = mem.TradeAccountWithdrawalMemo
+	TradeAccountTransferMemo   = mem.TradeAccountTransferMemo
+	RecurringSwapMemo          = mem.RecurringSwapMemo
+	RecurringSwapCancelMemo    = mem.RecurringSwapCancelMemo
+	LiquidBondMemo             = mem.LiquidBondMemo
+	LiquidUnbondMemo           = mem.LiquidUnbondMemo
 	SecuredAssetDepositMemo    = mem.SecuredAssetDepositMemo
 	SecuredAssetWithdrawMemo   = mem.SecuredAssetWithdrawMemo
 	LoanOpenMemo               = mem.LoanOpenMemo
@@ -1,10 +1,13 @@
 package cli
 
 import (
+	"fmt"
 	"log"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"gitlab.com/thorchain/thornode/v3/common/relay"
@@ -33,6 +36,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(GetCmdGetVersion())
 	cmd.AddCommand(GetCmdGetNORelay())
+	cmd.AddCommand(getQueryServiceCmds()...)
 	return cmd
 }
 
@@ -92,3 +96,478 @@ func GetCmdGetNORelay() *cobra.Command {
 
 	return cmd
 }
+
+const (
+	flagAffiliate             = "affiliate"
+	flagAffiliateBps          = "affiliate-bps"
+	flagDestination           = "destination"
+	flagExtended              = "extended"
+	flagInterval              = "interval"
+	flagLiquidityToleranceBps = "liquidity-tolerance-bps"
+	flagMinOut                = "min-out"
+	flagOwner                 = "owner"
+	flagRefundAddress         = "refund-address"
+	flagRoute                 = "route"
+	flagStreamingInterval     = "streaming-interval"
+	flagStreamingQuantity     = "streaming-quantity"
+	flagToleranceBps          = "tolerance-bps"
+)
+
+// queryFunc calls a query service method with the command arguments
+type queryFunc func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error)
+
+// newQueryCmd returns a command calling a query service method, the query height is
+// set with --height and the output format with --output
+func newQueryCmd(use, short string, args cobra.PositionalArgs, query queryFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := query(cmd, types.NewQueryClient(clientCtx), args)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getQueryServiceCmds returns a command for each method of the query service
+func getQueryServiceCmds() []*cobra.Command {
+	return []*cobra.Command{
+		// accounts
+		newQueryCmd("account [address]", "Gets an account", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Account(cmd.Context(), &types.QueryAccountRequest{Address: args[0]})
+		}),
+		newQueryCmd("balances [address]", "Gets the balances of an account", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Balances(cmd.Context(), &types.QueryBalancesRequest{Address: args[0]})
+		}),
+		newQueryCmd("balance-module [name]", "Gets the balances of a module account", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.BalanceModule(cmd.Context(), &types.QueryBalanceModuleRequest{Name: args[0]})
+		}),
+		newQueryCmd("export", "Exports the THORChain module genesis state", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Export(cmd.Context(), &types.QueryExportRequest{})
+		}),
+
+		// pools
+		newQueryCmd("pool [asset]", "Gets a pool", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Pool(cmd.Context(), &types.QueryPoolRequest{Asset: args[0]})
+		}),
+		newQueryCmd("pools", "Gets all pools", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Pools(cmd.Context(), &types.QueryPoolsRequest{})
+		}),
+		newQueryCmd("derived-pool [asset]", "Gets a derived pool", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.DerivedPool(cmd.Context(), &types.QueryDerivedPoolRequest{Asset: args[0]})
+		}),
+		newQueryCmd("derived-pools", "Gets all derived pools", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.DerivedPools(cmd.Context(), &types.QueryDerivedPoolsRequest{})
+		}),
+		getCmdPoolHistory(),
+		newQueryCmd("pool-twap [asset] [blocks]", "Gets the time weighted average price of a pool over the last blocks", cobra.ExactArgs(2), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			blocks, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid blocks %s: %w", args[1], err)
+			}
+			return q.PoolTWAP(cmd.Context(), &types.QueryPoolTWAPRequest{Asset: args[0], Blocks: blocks})
+		}),
+		newQueryCmd("pool-slip [asset]", "Gets the swap slip of a pool", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.PoolSlip(cmd.Context(), &types.QueryPoolSlipRequest{Asset: args[0]})
+		}),
+		newQueryCmd("pool-slips", "Gets the swap slip of all pools", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.PoolSlips(cmd.Context(), &types.QueryPoolSlipsRequest{})
+		}),
+		newQueryCmd("liquidity-provider [asset] [address]", "Gets a liquidity provider of a pool", cobra.ExactArgs(2), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.LiquidityProvider(cmd.Context(), &types.QueryLiquidityProviderRequest{Asset: args[0], Address: args[1]})
+		}),
+		newQueryCmd("liquidity-providers [asset]", "Gets all liquidity providers of a pool", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.LiquidityProviders(cmd.Context(), &types.QueryLiquidityProvidersRequest{Asset: args[0]})
+		}),
+		newQueryCmd("saver [asset] [address]", "Gets a saver of a pool", cobra.ExactArgs(2), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Saver(cmd.Context(), &types.QuerySaverRequest{Asset: args[0], Address: args[1]})
+		}),
+		newQueryCmd("savers [asset]", "Gets all savers of a pool", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Savers(cmd.Context(), &types.QuerySaversRequest{Asset: args[0]})
+		}),
+		newQueryCmd("borrower [asset] [address]", "Gets a borrower of a pool", cobra.ExactArgs(2), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Borrower(cmd.Context(), &types.QueryBorrowerRequest{Asset: args[0], Address: args[1]})
+		}),
+		newQueryCmd("borrowers [asset]", "Gets all borrowers of a pool", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Borrowers(cmd.Context(), &types.QueryBorrowersRequest{Asset: args[0]})
+		}),
+
+		// trade and secured assets
+		newQueryCmd("trade-unit [asset]", "Gets the trade units of an asset", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TradeUnit(cmd.Context(), &types.QueryTradeUnitRequest{Asset: args[0]})
+		}),
+		newQueryCmd("trade-units", "Gets the trade units of all assets", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.TradeUnits(cmd.Context(), &types.QueryTradeUnitsRequest{})
+		}),
+		newQueryCmd("trade-account [address]", "Gets the trade accounts of an address", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TradeAccount(cmd.Context(), &types.QueryTradeAccountRequest{Address: args[0]})
+		}),
+		newQueryCmd("trade-accounts [asset]", "Gets all trade accounts of an asset", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TradeAccounts(cmd.Context(), &types.QueryTradeAccountsRequest{Asset: args[0]})
+		}),
+		newQueryCmd("secured-asset [asset]", "Gets a secured asset", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.SecuredAsset(cmd.Context(), &types.QuerySecuredAssetRequest{Asset: args[0]})
+		}),
+		newQueryCmd("secured-assets", "Gets all secured assets", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.SecuredAssets(cmd.Context(), &types.QuerySecuredAssetsRequest{})
+		}),
+
+		// nodes
+		newQueryCmd("node [address]", "Gets a node", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Node(cmd.Context(), &types.QueryNodeRequest{Address: args[0]})
+		}),
+		newQueryCmd("node-observation-stats [address]", "Gets the observation stats of a node", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.NodeObservationStats(cmd.Context(), &types.QueryObservationStatsRequest{Address: args[0]})
+		}),
+		newQueryCmd("nodes", "Gets all nodes", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Nodes(cmd.Context(), &types.QueryNodesRequest{})
+		}),
+		newQueryCmd("ban [address]", "Gets the ban votes of a node", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Ban(cmd.Context(), &types.QueryBanRequest{Address: args[0]})
+		}),
+
+		// swaps
+		newQueryCmd("outbound-fee [asset]", "Gets the outbound fee of an asset", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.OutboundFee(cmd.Context(), &types.QueryOutboundFeeRequest{Asset: args[0]})
+		}),
+		newQueryCmd("outbound-fees", "Gets the outbound fees of all assets", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.OutboundFees(cmd.Context(), &types.QueryOutboundFeesRequest{})
+		}),
+		newQueryCmd("streaming-swap [tx-id]", "Gets a streaming swap", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.StreamingSwap(cmd.Context(), &types.QueryStreamingSwapRequest{TxId: args[0]})
+		}),
+		newQueryCmd("streaming-swaps", "Gets all streaming swaps", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.StreamingSwaps(cmd.Context(), &types.QueryStreamingSwapsRequest{})
+		}),
+		newQueryCmd("recurring-swap [tx-id]", "Gets a recurring swap", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.RecurringSwap(cmd.Context(), &types.QueryRecurringSwapRequest{TxId: args[0]})
+		}),
+		getCmdRecurringSwaps(),
+		newQueryCmd("swap-queue", "Gets the swap queue", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.SwapQueue(cmd.Context(), &types.QuerySwapQueueRequest{})
+		}),
+		newQueryCmd("swap-details [tx-id]", "Gets the details of a queued swap", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.SwapDetails(cmd.Context(), &types.QuerySwapDetailsRequest{TxId: args[0]})
+		}),
+		newQueryCmd("clout [address]", "Gets the swapper clout of an address", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Clout(cmd.Context(), &types.QuerySwapperCloutRequest{Address: args[0]})
+		}),
+
+		// quotes
+		getCmdQuoteSwap(),
+		getCmdQuoteSaverDeposit(),
+		newQueryCmd("quote-saver-withdraw [asset] [address] [withdraw-bps]", "Gets a quote for a saver withdraw", cobra.ExactArgs(3), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.QuoteSaverWithdraw(cmd.Context(), &types.QueryQuoteSaverWithdrawRequest{Asset: args[0], Address: args[1], WithdrawBps: args[2]})
+		}),
+		getCmdQuoteLoanOpen(),
+		getCmdQuoteLoanClose(),
+
+		// rune pool and tcy
+		newQueryCmd("rune-pool", "Gets the RUNE pool", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.RunePool(cmd.Context(), &types.QueryRunePoolRequest{})
+		}),
+		newQueryCmd("rune-provider [address]", "Gets a RUNE pool provider", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.RuneProvider(cmd.Context(), &types.QueryRuneProviderRequest{Address: args[0]})
+		}),
+		newQueryCmd("rune-providers", "Gets all RUNE pool providers", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.RuneProviders(cmd.Context(), &types.QueryRuneProvidersRequest{})
+		}),
+		newQueryCmd("tcy-staker [address]", "Gets a TCY staker", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TCYStaker(cmd.Context(), &types.QueryTCYStakerRequest{Address: args[0]})
+		}),
+		newQueryCmd("tcy-stakers", "Gets all TCY stakers", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.TCYStakers(cmd.Context(), &types.QueryTCYStakersRequest{})
+		}),
+		newQueryCmd("tcy-claimer [address]", "Gets a TCY claimer", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TCYClaimer(cmd.Context(), &types.QueryTCYClaimerRequest{Address: args[0]})
+		}),
+		newQueryCmd("tcy-claimers", "Gets all TCY claimers", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.TCYClaimers(cmd.Context(), &types.QueryTCYClaimersRequest{})
+		}),
+
+		// mimir
+		newQueryCmd("mimir", "Gets all mimir values", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.MimirValues(cmd.Context(), &types.QueryMimirValuesRequest{})
+		}),
+		newQueryCmd("mimir-key [key]", "Gets a mimir value", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.MimirWithKey(cmd.Context(), &types.QueryMimirWithKeyRequest{Key: args[0]})
+		}),
+		newQueryCmd("mimir-admin", "Gets all admin mimir values", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.MimirAdminValues(cmd.Context(), &types.QueryMimirAdminValuesRequest{})
+		}),
+		newQueryCmd("mimir-scheduled", "Gets the scheduled mimir changes and node votes", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.MimirScheduled(cmd.Context(), &types.QueryMimirScheduledRequest{})
+		}),
+		newQueryCmd("mimir-registry", "Gets the registered mimir keys and key templates", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.MimirRegistry(cmd.Context(), &types.QueryMimirRegistryRequest{})
+		}),
+		newQueryCmd("mimir-history [key]", "Gets the change history of a mimir value", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.MimirHistory(cmd.Context(), &types.QueryMimirHistoryRequest{Key: args[0]})
+		}),
+		newQueryCmd("mimir-nodes-all", "Gets all node mimir votes", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.MimirNodesAllValues(cmd.Context(), &types.QueryMimirNodesAllValuesRequest{})
+		}),
+		newQueryCmd("mimir-nodes", "Gets the node mimir values with consensus", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.MimirNodesValues(cmd.Context(), &types.QueryMimirNodesValuesRequest{})
+		}),
+		newQueryCmd("mimir-node [address]", "Gets the mimir votes of a node", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.MimirNodeValues(cmd.Context(), &types.QueryMimirNodeValuesRequest{Address: args[0]})
+		}),
+		newQueryCmd("mimir-node-history [address]", "Gets the mimir vote history of a node", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.MimirNodeHistory(cmd.Context(), &types.QueryMimirNodeHistoryRequest{Address: args[0]})
+		}),
+		newQueryCmd("constants", "Gets all constant values", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.ConstantValues(cmd.Context(), &types.QueryConstantValuesRequest{})
+		}),
+
+		// network
+		newQueryCmd("network", "Gets the network information", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Network(cmd.Context(), &types.QueryNetworkRequest{})
+		}),
+		newQueryCmd("protocol-version", "Gets the current and next protocol version of the network", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Version(cmd.Context(), &types.QueryVersionRequest{})
+		}),
+		newQueryCmd("inbound-addresses", "Gets the inbound addresses of all chains", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.InboundAddresses(cmd.Context(), &types.QueryInboundAddressesRequest{})
+		}),
+		newQueryCmd("last-blocks", "Gets the last observed and signed heights of all chains", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.LastBlocks(cmd.Context(), &types.QueryLastBlocksRequest{})
+		}),
+		newQueryCmd("last-block [chain]", "Gets the last observed and signed heights of a chain", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.ChainsLastBlock(cmd.Context(), &types.QueryChainsLastBlockRequest{Chain: args[0]})
+		}),
+		newQueryCmd("block", "Gets the block with its transactions and events", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Block(cmd.Context(), &types.QueryBlockRequest{})
+		}),
+		newQueryCmd("ragnarok", "Gets whether ragnarok is in progress", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Ragnarok(cmd.Context(), &types.QueryRagnarokRequest{})
+		}),
+		newQueryCmd("thorname [name]", "Gets a THORName", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Thorname(cmd.Context(), &types.QueryThornameRequest{Name: args[0]})
+		}),
+		newQueryCmd("invariant [path]", "Runs an invariant", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Invariant(cmd.Context(), &types.QueryInvariantRequest{Path: args[0]})
+		}),
+		newQueryCmd("invariants", "Gets the invariant routes", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Invariants(cmd.Context(), &types.QueryInvariantsRequest{})
+		}),
+		newQueryCmd("upgrade-proposals", "Gets all upgrade proposals", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.UpgradeProposals(cmd.Context(), &types.QueryUpgradeProposalsRequest{})
+		}),
+		newQueryCmd("upgrade-proposal [name]", "Gets an upgrade proposal", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.UpgradeProposal(cmd.Context(), &types.QueryUpgradeProposalRequest{Name: args[0]})
+		}),
+		newQueryCmd("upgrade-votes [name]", "Gets the votes of an upgrade proposal", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.UpgradeVotes(cmd.Context(), &types.QueryUpgradeVotesRequest{Name: args[0]})
+		}),
+		newQueryCmd("eip712-typed-data [sign-bytes]", "Gets the EIP-712 typed data of amino JSON sign bytes", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Eip712TypedData(cmd.Context(), &types.QueryEip712TypedDataRequest{SignBytes: []byte(args[0])})
+		}),
+
+		// vaults
+		newQueryCmd("vault [pubkey]", "Gets a vault", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Vault(cmd.Context(), &types.QueryVaultRequest{PubKey: args[0]})
+		}),
+		newQueryCmd("asgard-vaults", "Gets all asgard vaults", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.AsgardVaults(cmd.Context(), &types.QueryAsgardVaultsRequest{})
+		}),
+		newQueryCmd("vaults-pubkeys", "Gets the public keys of all vaults", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.VaultsPubkeys(cmd.Context(), &types.QueryVaultsPubkeysRequest{})
+		}),
+
+		// transactions and outbounds
+		newQueryCmd("tx [tx-id]", "Gets an observed transaction", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Tx(cmd.Context(), &types.QueryTxRequest{TxId: args[0]})
+		}),
+		newQueryCmd("tx-details [tx-id]", "Gets the observations and outbounds of a transaction", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TxVoters(cmd.Context(), &types.QueryTxVotersRequest{TxId: args[0]})
+		}),
+		newQueryCmd("tx-signers [tx-id]", "Gets the observations of a transaction", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TxVotersOld(cmd.Context(), &types.QueryTxVotersRequest{TxId: args[0]})
+		}),
+		newQueryCmd("tx-stages [tx-id]", "Gets the processing stages of a transaction", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TxStages(cmd.Context(), &types.QueryTxStagesRequest{TxId: args[0]})
+		}),
+		newQueryCmd("tx-status [tx-id]", "Gets the status of a transaction", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TxStatus(cmd.Context(), &types.QueryTxStatusRequest{TxId: args[0]})
+		}),
+		newQueryCmd("queue", "Gets the sizes of the swap and outbound queues", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.Queue(cmd.Context(), &types.QueryQueueRequest{})
+		}),
+		newQueryCmd("scheduled-outbound", "Gets the scheduled outbounds", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.ScheduledOutbound(cmd.Context(), &types.QueryScheduledOutboundRequest{})
+		}),
+		newQueryCmd("pending-outbound", "Gets the pending outbounds", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.PendingOutbound(cmd.Context(), &types.QueryPendingOutboundRequest{})
+		}),
+
+		// tss
+		newQueryCmd("keysign [keysign-height] [pubkey]", "Gets the keysign items of a block height, optionally for a vault", cobra.RangeArgs(1, 2), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			if len(args) == 2 {
+				return q.KeysignPubkey(cmd.Context(), &types.QueryKeysignPubkeyRequest{Height: args[0], PubKey: args[1]})
+			}
+			return q.Keysign(cmd.Context(), &types.QueryKeysignRequest{Height: args[0]})
+		}),
+		newQueryCmd("keygen [keygen-height] [pubkey]", "Gets the keygen of a block height for a node", cobra.ExactArgs(2), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.Keygen(cmd.Context(), &types.QueryKeygenRequest{Height: args[0], PubKey: args[1]})
+		}),
+		newQueryCmd("tss-keygen-metric [pubkey]", "Gets the keygen metrics of a vault", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+			return q.TssKeygenMetric(cmd.Context(), &types.QueryTssKeygenMetricRequest{PubKey: args[0]})
+		}),
+		newQueryCmd("tss-metrics", "Gets the keygen and keysign metrics", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+			return q.TssMetric(cmd.Context(), &types.QueryTssMetricRequest{})
+		}),
+	}
+}
+
+func getCmdPoolHistory() *cobra.Command {
+	cmd := newQueryCmd("pool-history [asset]", "Gets the fee, reward and yield history of a pool", cobra.ExactArgs(1), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+		interval, err := cmd.Flags().GetString(flagInterval)
+		if err != nil {
+			return nil, err
+		}
+		return q.PoolHistory(cmd.Context(), &types.QueryPoolHistoryRequest{Asset: args[0], Interval: interval})
+	})
+	cmd.Flags().String(flagInterval, "", "the length of each returned interval, day or week")
+	return cmd
+}
+
+func getCmdRecurringSwaps() *cobra.Command {
+	cmd := newQueryCmd("recurring-swaps", "Gets all recurring swaps", cobra.NoArgs, func(cmd *cobra.Command, q types.QueryClient, _ []string) (proto.Message, error) {
+		owner, err := cmd.Flags().GetString(flagOwner)
+		if err != nil {
+			return nil, err
+		}
+		return q.RecurringSwaps(cmd.Context(), &types.QueryRecurringSwapsRequest{Owner: owner})
+	})
+	cmd.Flags().String(flagOwner, "", "only return the recurring swaps of an owner")
+	return cmd
+}
+
+func getCmdQuoteSwap() *cobra.Command {
+	cmd := newQueryCmd("quote-swap [from-asset] [to-asset] [amount]", "Gets a quote for a swap", cobra.ExactArgs(3), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+		req := &types.QueryQuoteSwapRequest{FromAsset: args[0], ToAsset: args[1], Amount: args[2]}
+		f := cmd.Flags()
+		var err error
+		if req.Destination, err = f.GetString(flagDestination); err != nil {
+			return nil, err
+		}
+		if req.RefundAddress, err = f.GetString(flagRefundAddress); err != nil {
+			return nil, err
+		}
+		if req.StreamingInterval, err = f.GetString(flagStreamingInterval); err != nil {
+			return nil, err
+		}
+		if req.StreamingQuantity, err = f.GetString(flagStreamingQuantity); err != nil {
+			return nil, err
+		}
+		if req.ToleranceBps, err = f.GetString(flagToleranceBps); err != nil {
+			return nil, err
+		}
+		if req.LiquidityToleranceBps, err = f.GetString(flagLiquidityToleranceBps); err != nil {
+			return nil, err
+		}
+		if req.Affiliate, err = f.GetStringSlice(flagAffiliate); err != nil {
+			return nil, err
+		}
+		if req.AffiliateBps, err = f.GetStringSlice(flagAffiliateBps); err != nil {
+			return nil, err
+		}
+		if req.Route, err = f.GetString(flagRoute); err != nil {
+			return nil, err
+		}
+		if req.Extended, err = f.GetBool(flagExtended); err != nil {
+			return nil, err
+		}
+		return q.QuoteSwap(cmd.Context(), req)
+	})
+	f := cmd.Flags()
+	f.String(flagDestination, "", "the destination address, required to generate memo")
+	f.String(flagRefundAddress, "", "the refund address, refunds will be sent here if the swap fails")
+	f.String(flagStreamingInterval, "", "the interval in which streaming swaps are swapped")
+	f.String(flagStreamingQuantity, "", "the quantity of swaps within a streaming swap")
+	f.String(flagToleranceBps, "", "the maximum basis points from the current feeless swap price to set the limit in the generated memo")
+	f.String(flagLiquidityToleranceBps, "", "the maximum basis points of tolerance for pool price movements to set the limit in the generated memo")
+	f.StringSlice(flagAffiliate, nil, "the affiliates (address or thorname)")
+	f.StringSlice(flagAffiliateBps, nil, "the affiliate fees in basis points")
+	f.String(flagRoute, "", "the intermediate assets to route the swap through separated by >")
+	f.Bool(flagExtended, false, "return the extended quote details")
+	return cmd
+}
+
+func getCmdQuoteSaverDeposit() *cobra.Command {
+	cmd := newQueryCmd("quote-saver-deposit [asset] [amount]", "Gets a quote for a saver deposit", cobra.ExactArgs(2), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+		req := &types.QueryQuoteSaverDepositRequest{Asset: args[0], Amount: args[1]}
+		f := cmd.Flags()
+		var err error
+		if req.Affiliate, err = f.GetStringSlice(flagAffiliate); err != nil {
+			return nil, err
+		}
+		if req.AffiliateBps, err = f.GetStringSlice(flagAffiliateBps); err != nil {
+			return nil, err
+		}
+		return q.QuoteSaverDeposit(cmd.Context(), req)
+	})
+	cmd.Flags().StringSlice(flagAffiliate, nil, "the affiliates (address or thorname)")
+	cmd.Flags().StringSlice(flagAffiliateBps, nil, "the affiliate fees in basis points")
+	return cmd
+}
+
+func getCmdQuoteLoanOpen() *cobra.Command {
+	cmd := newQueryCmd("quote-loan-open [from-asset] [to-asset] [amount]", "Gets a quote for opening a loan", cobra.ExactArgs(3), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+		req := &types.QueryQuoteLoanOpenRequest{FromAsset: args[0], ToAsset: args[1], Amount: args[2]}
+		f := cmd.Flags()
+		var err error
+		if req.Destination, err = f.GetString(flagDestination); err != nil {
+			return nil, err
+		}
+		if req.MinOut, err = f.GetString(flagMinOut); err != nil {
+			return nil, err
+		}
+		if req.Affiliate, err = f.GetStringSlice(flagAffiliate); err != nil {
+			return nil, err
+		}
+		if req.AffiliateBps, err = f.GetStringSlice(flagAffiliateBps); err != nil {
+			return nil, err
+		}
+		return q.QuoteLoanOpen(cmd.Context(), req)
+	})
+	f := cmd.Flags()
+	f.String(flagDestination, "", "the destination address, required to generate memo")
+	f.String(flagMinOut, "", "the minimum amount of the target asset to accept")
+	f.StringSlice(flagAffiliate, nil, "the affiliates (address or thorname)")
+	f.StringSlice(flagAffiliateBps, nil, "the affiliate fees in basis points")
+	return cmd
+}
+
+func getCmdQuoteLoanClose() *cobra.Command {
+	cmd := newQueryCmd("quote-loan-close [from-asset] [to-asset] [repay-bps] [loan-owner]", "Gets a quote for closing a loan", cobra.ExactArgs(4), func(cmd *cobra.Command, q types.QueryClient, args []string) (proto.Message, error) {
+		minOut, err := cmd.Flags().GetString(flagMinOut)
+		if err != nil {
+			return nil, err
+		}
+		return q.QuoteLoanClose(cmd.Context(), &types.QueryQuoteLoanCloseRequest{
+			FromAsset: args[0],
+			ToAsset:   args[1],
+			RepayBps:  args[2],
+			LoanOwner: args[3],
+			MinOut:    minOut,
+		})
+	})
+	cmd.Flags().String(flagMinOut, "", "the minimum amount of the target asset to accept")
+	return cmd
+}
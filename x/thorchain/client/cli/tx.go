@@ -115,7 +115,9 @@ func GetCmdSend() *cobra.Command {
 
 // GetCmdMimir command to change a mimir attribute
 func GetCmdMimir() *cobra.Command {
-	return &cobra.Command{
+	const flagActivationHeight = "activation-height"
+
+	cmd := &cobra.Command{
 		Use:   "mimir [key] [value]",
 		Short: "updates a mimir attribute (admin only)",
 		Args:  cobra.ExactArgs(2),
@@ -129,14 +131,19 @@ func GetCmdMimir() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("invalid value (must be an integer): %w", err)
 			}
+			height, _ := cmd.Flags().GetInt64(flagActivationHeight)
 
-			msg := types.NewMsgMimir(strings.ToUpper(args[0]), val, clientCtx.GetFromAddress())
+			msg := types.NewMsgScheduledMimir(strings.ToUpper(args[0]), val, height, clientCtx.GetFromAddress())
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
+	cmd.Flags().Int64(flagActivationHeight, 0, "block height the value takes effect at (0 for immediately)")
+
+	return cmd
 }
 
 // GetCmdNodePauseChain command to change node pause chain
@@ -53,6 +53,22 @@ func (s *queryServer) Pool(c context.Context, req *types.QueryPoolRequest) (*typ
 	return s.queryPool(ctx, req)
 }
 
+func (s *queryServer) PoolHistory(c context.Context, req *types.QueryPoolHistoryRequest) (*types.QueryPoolHistoryResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryPoolHistory(ctx, req)
+}
+
+func (s *queryServer) PoolTWAP(c context.Context, req *types.QueryPoolTWAPRequest) (*types.QueryPoolTWAPResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryPoolTWAP(ctx, req)
+}
+
 func (s *queryServer) Pools(c context.Context, req *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -189,6 +205,14 @@ func (s *queryServer) Nodes(c context.Context, req *types.QueryNodesRequest) (*t
 	return s.queryNodes(ctx, req)
 }
 
+func (s *queryServer) NodeObservationStats(c context.Context, req *types.QueryObservationStatsRequest) (*types.QueryObservationStatsResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryNodeObservationStats(ctx, req)
+}
+
 func (s *queryServer) PoolSlip(c context.Context, req *types.QueryPoolSlipRequest) (*types.QueryPoolSlipsResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -237,6 +261,22 @@ func (s *queryServer) StreamingSwaps(c context.Context, req *types.QueryStreamin
 	return s.queryStreamingSwaps(ctx, req)
 }
 
+func (s *queryServer) RecurringSwap(c context.Context, req *types.QueryRecurringSwapRequest) (*types.QueryRecurringSwapResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryRecurringSwap(ctx, req)
+}
+
+func (s *queryServer) RecurringSwaps(c context.Context, req *types.QueryRecurringSwapsRequest) (*types.QueryRecurringSwapsResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryRecurringSwaps(ctx, req)
+}
+
 func (s *queryServer) Ban(c context.Context, req *types.QueryBanRequest) (*types.BanVoter, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -301,6 +341,30 @@ func (s *queryServer) MimirAdminValues(c context.Context, req *types.QueryMimirA
 	return s.queryMimirAdminValues(ctx, req)
 }
 
+func (s *queryServer) MimirScheduled(c context.Context, req *types.QueryMimirScheduledRequest) (*types.QueryMimirScheduledResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryMimirScheduled(ctx, req)
+}
+
+func (s *queryServer) MimirRegistry(c context.Context, req *types.QueryMimirRegistryRequest) (*types.QueryMimirRegistryResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryMimirRegistry(ctx, req)
+}
+
+func (s *queryServer) MimirHistory(c context.Context, req *types.QueryMimirHistoryRequest) (*types.QueryMimirHistoryResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryMimirHistory(ctx, req)
+}
+
 func (s *queryServer) MimirNodesAllValues(c context.Context, req *types.QueryMimirNodesAllValuesRequest) (*types.QueryMimirNodesAllValuesResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
@@ -325,6 +389,14 @@ func (s *queryServer) MimirNodeValues(c context.Context, req *types.QueryMimirNo
 	return s.queryMimirNodeValues(ctx, req)
 }
 
+func (s *queryServer) MimirNodeHistory(c context.Context, req *types.QueryMimirNodeHistoryRequest) (*types.QueryMimirHistoryResponse, error) {
+	if err := checkHeightParam(req.Height); err != nil {
+		return nil, err
+	}
+	ctx := s.unwrapSdkContext(c)
+	return s.queryMimirNodeHistory(ctx, req)
+}
+
 func (s *queryServer) InboundAddresses(c context.Context, req *types.QueryInboundAddressesRequest) (*types.QueryInboundAddressesResponse, error) {
 	if err := checkHeightParam(req.Height); err != nil {
 		return nil, err
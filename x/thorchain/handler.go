@@ -9,6 +9,7 @@ import (
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
 	"gitlab.com/thorchain/thornode/v3/common/tokenlist"
+	"gitlab.com/thorchain/thornode/v3/constants"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/types"
 )
@@ -67,11 +68,17 @@ func getInternalHandlerMapping(mgr Manager) map[string]MsgHandler {
 	m[sdk.MsgTypeURL(&MsgLoanRepayment{})] = NewLoanRepaymentHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgTradeAccountDeposit{})] = NewTradeAccountDepositHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgTradeAccountWithdrawal{})] = NewTradeAccountWithdrawalHandler(mgr)
+	m[sdk.MsgTypeURL(&MsgTradeAccountTransfer{})] = NewTradeAccountTransferHandler(mgr)
+	m[sdk.MsgTypeURL(&MsgRecurringSwap{})] = NewRecurringSwapHandler(mgr)
+	m[sdk.MsgTypeURL(&MsgRecurringSwapCancel{})] = NewRecurringSwapCancelHandler(mgr)
+	m[sdk.MsgTypeURL(&MsgLiquidBond{})] = NewLiquidBondHandler(mgr)
+	m[sdk.MsgTypeURL(&MsgLiquidUnbond{})] = NewLiquidUnbondHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgSecuredAssetDeposit{})] = NewSecuredAssetDepositHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgSecuredAssetWithdraw{})] = NewSecuredAssetWithdrawHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgRunePoolDeposit{})] = NewRunePoolDepositHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgRunePoolWithdraw{})] = NewRunePoolWithdrawHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgModifyLimitSwap{})] = NewModifyLimitSwapHandler(mgr)
+	m[sdk.MsgTypeURL(&MsgModifyStreamingSwap{})] = NewModifyStreamingSwapHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgWasmExec{})] = NewWasmExecHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgSwitch{})] = NewSwitchHandler(mgr)
 	m[sdk.MsgTypeURL(&MsgTCYClaim{})] = NewTCYClaimHandler(mgr)
@@ -85,7 +92,28 @@ func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer cosmos.AccAddress)
 	if memo.Destination.IsEmpty() {
 		memo.Destination = tx.Tx.FromAddress
 	}
-	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetSwapType(), memo.GetStreamQuantity(), memo.GetStreamInterval(), signer), nil
+	msg := NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, memo.AffiliateAddress, memo.AffiliateBasisPoints, memo.GetDexAggregator(), memo.GetDexTargetAddress(), memo.GetDexTargetLimit(), memo.GetSwapType(), memo.GetStreamQuantity(), memo.GetStreamInterval(), signer)
+	msg.Expiry = memo.GetExpiry()
+	msg.Destinations = memo.Destinations
+	msg.DestinationsBasisPoints = memo.DestinationsBasisPoints
+	msg.Route = memo.Route
+	return msg, nil
+}
+
+// validateTriggerSwapMemo ensures stop-loss and take-profit orders can rest in
+// the advanced swap queue, as any other queue would execute them immediately
+func validateTriggerSwapMemo(ctx cosmos.Context, k keeper.Keeper, memo SwapMemo) error {
+	if memo.SwapType != StopLossSwap && memo.SwapType != TakeProfitSwap {
+		return nil
+	}
+	mode := types.AdvSwapQueueMode(k.GetConfigInt64(ctx, constants.EnableAdvSwapQueue))
+	if mode != types.AdvSwapQueueModeEnabled {
+		return fmt.Errorf("stop-loss and take-profit orders require the advanced swap queue")
+	}
+	if memo.Expiry > 0 && memo.Expiry <= ctx.BlockHeight() {
+		return fmt.Errorf("expiry (%d) must be after the current block height (%d)", memo.Expiry, ctx.BlockHeight())
+	}
+	return nil
 }
 
 func getMsgWithdrawFromMemo(memo WithdrawLiquidityMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -129,6 +157,24 @@ func getMsgModifyLimitSwap(memo ModifyLimitSwapMemo, tx ObservedTx, signer cosmo
 	return NewMsgModifyLimitSwap(tx.Tx.FromAddress, memo.Source, memo.Target, memo.ModifiedTargetAmount, signer), nil
 }
 
+func getMsgModifyStreamingSwap(memo ModifyStreamingSwapMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+	return NewMsgModifyStreamingSwap(tx.Tx.FromAddress, memo.TxID, memo.Interval, memo.Quantity, signer), nil
+}
+
+func getMsgRecurringSwapFromMemo(ctx cosmos.Context, keeper keeper.Keeper, memo RecurringSwapMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+	if memo.Quantity == 0 {
+		return nil, fmt.Errorf("recurring swap quantity cannot be zero")
+	}
+	// the committed amount is split evenly across the swaps of the schedule
+	coin := tx.Tx.Coins[0]
+	target := fuzzyAssetMatch(ctx, keeper, memo.Asset)
+	destination := memo.Destination
+	if destination.IsEmpty() {
+		destination = tx.Tx.FromAddress
+	}
+	return NewMsgRecurringSwap(tx.Tx, coin.Asset, target, destination, coin.Amount.QuoUint64(memo.Quantity), memo.Interval, memo.Quantity, signer), nil
+}
+
 func getMsgRefundFromMemo(memo RefundMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
 	return NewMsgRefundTx(tx, memo.GetTxID(), signer), nil
 }
@@ -164,7 +210,9 @@ func getMsgBondFromMemo(memo BondMemo, tx ObservedTx, signer cosmos.AccAddress)
 }
 
 func getMsgUnbondFromMemo(memo UnbondMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
-	return NewMsgUnBond(tx.Tx, memo.GetAccAddress(), memo.GetAmount(), tx.Tx.FromAddress, memo.BondProviderAddress, signer), nil
+	msg := NewMsgUnBond(tx.Tx, memo.GetAccAddress(), memo.GetAmount(), tx.Tx.FromAddress, memo.BondProviderAddress, signer)
+	msg.Cancel = memo.Cancel
+	return msg, nil
 }
 
 func getMsgRebondFromMemo(memo RebondMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -206,10 +254,18 @@ func processOneTxIn(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, sig
 		newMsg, err = getMsgWithdrawFromMemo(m, tx, signer)
 	case SwapMemo:
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
+		for i := range m.Route {
+			m.Route[i] = fuzzyAssetMatch(ctx, keeper, m.Route[i])
+		}
 		m.DexTargetAddress = externalAssetMatch(m.Asset.GetChain(), m.DexTargetAddress)
+		if err = validateTriggerSwapMemo(ctx, keeper, m); err != nil {
+			return nil, err
+		}
 		newMsg, err = getMsgSwapFromMemo(m, tx, signer)
 	case ModifyLimitSwapMemo:
 		newMsg, err = getMsgModifyLimitSwap(m, tx, signer)
+	case ModifyStreamingSwapMemo:
+		newMsg, err = getMsgModifyStreamingSwap(m, tx, signer)
 	case DonateMemo:
 		m.Asset = fuzzyAssetMatch(ctx, keeper, m.Asset)
 		newMsg, err = getMsgDonateFromMemo(m, tx, signer)
@@ -250,6 +306,27 @@ func processOneTxIn(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, sig
 	case TradeAccountWithdrawalMemo:
 		coin := tx.Tx.Coins[0]
 		newMsg = NewMsgTradeAccountWithdrawal(coin.Asset, coin.Amount, m.GetAddress(), signer, tx.Tx)
+	case TradeAccountTransferMemo:
+		coin := tx.Tx.Coins[0]
+		if tx.Tx.Chain.IsTHORChain() {
+			newMsg = NewMsgTradeAccountTransfer(coin.Asset, coin.Amount, m.GetAccAddress(), signer, tx.Tx)
+			break
+		}
+		// a layer1 inbound is credited to the trade account of the sender, then
+		// transferred from it
+		sender, err := tx.Tx.FromAddress.MappedAccAddress()
+		if err != nil {
+			return nil, err
+		}
+		newMsg = NewMsgTradeAccountTransfer(coin.Asset.GetTradeAsset(), coin.Amount, m.GetAccAddress(), sender, tx.Tx)
+	case RecurringSwapMemo:
+		newMsg, err = getMsgRecurringSwapFromMemo(ctx, keeper, m, tx, signer)
+	case RecurringSwapCancelMemo:
+		newMsg = NewMsgRecurringSwapCancel(tx.Tx, m.GetTxID(), signer)
+	case LiquidBondMemo:
+		newMsg = NewMsgLiquidBond(tx.Tx, m.GetAccAddress(), m.GetAmount(), signer)
+	case LiquidUnbondMemo:
+		newMsg = NewMsgLiquidUnbond(tx.Tx, m.GetAccAddress(), m.GetUnits(), signer)
 	case SecuredAssetDepositMemo:
 		coin := tx.Tx.Coins[0]
 		newMsg = NewMsgSecuredAssetDeposit(coin.Asset, coin.Amount, m.GetAccAddress(), signer, tx.Tx)
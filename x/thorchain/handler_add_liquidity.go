@@ -174,6 +174,9 @@ func (h AddLiquidityHandler) validate(ctx cosmos.Context, msg MsgAddLiquidity) e
 	if h.mgr.Keeper().IsPoolDepositPaused(ctx, msg.Asset) {
 		return fmt.Errorf("unable to add liquidity, deposits are paused for asset (%s)", msg.Asset.String())
 	}
+	if h.mgr.Keeper().IsPoolTradingHalted(ctx, msg.Asset) {
+		return fmt.Errorf("unable to add liquidity, pool is halted for asset (%s)", msg.Asset.String())
+	}
 
 	ensureLiquidityNoLargerThanBond := h.mgr.GetConstants().GetBoolValue(constants.StrictBondLiquidityRatio)
 	// if the pool is THORChain no need to check economic security
@@ -674,6 +677,9 @@ func (h AddLiquidityHandler) addLiquidity(ctx cosmos.Context,
 		if err = h.mgr.Keeper().SetPOL(ctx, pol); err != nil {
 			return err
 		}
+		history := NewPoolHistory(pool.Asset, ctx.BlockHeight())
+		history.PolRuneDeposited = pendingRuneAmt
+		h.mgr.Keeper().AddPoolHistory(ctx, history)
 
 		ctx.Logger().Info("POL deposit", "pool", pool.Asset, "rune", pendingRuneAmt)
 		telemetry.IncrCounterWithLabels(
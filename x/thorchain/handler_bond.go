@@ -95,6 +95,10 @@ func (h BondHandler) validateV3_0_0(ctx cosmos.Context, msg MsgBond) error {
 		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
 	}
 
+	if msg.BondProviderAddress.Equals(GetLiquidBondAddress()) {
+		return cosmos.ErrUnknownRequest("liquid bond provider is managed with LBOND and LUNBOND")
+	}
+
 	// Attempting to set Operator Fee. If the Node has no bond address yet, it will have no fee set, continue
 	if msg.OperatorFee > -1 && !nodeAccount.BondAddress.IsEmpty() {
 		// Only Node Operator can set fee
@@ -211,7 +215,32 @@ func (h BondHandler) handleV3_0_0(ctx cosmos.Context, msg MsgBond) (err error) {
 
 	// Update operator fee (-1 means operator fee is not being set)
 	if msg.OperatorFee > -1 && msg.OperatorFee <= 10000 {
-		bp.NodeOperatorFee = cosmos.NewUint(uint64(msg.OperatorFee))
+		fee := cosmos.NewUint(uint64(msg.OperatorFee))
+		noticePeriod := h.mgr.Keeper().GetConfigInt64(ctx, constants.NodeOperatorFeeNoticeBlocks)
+		if noticePeriod > 0 {
+			// a provider that leaves on notice only exits at the first churn after
+			// its unbond notice period, the increase must not apply before it
+			minNotice := h.mgr.Keeper().GetConfigInt64(ctx, constants.BondProviderUnbondNoticeBlocks) +
+				h.mgr.Keeper().GetConfigInt64(ctx, constants.ChurnInterval)
+			if noticePeriod <= minNotice {
+				noticePeriod = minNotice + 1
+			}
+		}
+		// an increase is only applied after a notice period when other providers
+		// have bond at stake, giving them time to leave before it takes effect
+		if fee.GT(bp.NodeOperatorFee) && noticePeriod > 0 && bp.HasProviderBonded(from) {
+			bp.SetPendingNodeOperatorFee(fee, ctx.BlockHeight()+noticePeriod)
+			ctx.EventManager().EmitEvent(
+				cosmos.NewEvent("node_operator_fee_scheduled",
+					cosmos.NewAttribute("node_address", nodeAccount.NodeAddress.String()),
+					cosmos.NewAttribute("current_fee", bp.NodeOperatorFee.String()),
+					cosmos.NewAttribute("pending_fee", fee.String()),
+					cosmos.NewAttribute("effective_height", fmt.Sprintf("%d", bp.PendingNodeOperatorFeeHeight)),
+				))
+		} else {
+			bp.NodeOperatorFee = fee
+			bp.ClearPendingNodeOperatorFee()
+		}
 	}
 
 	if err = h.mgr.Keeper().SetNodeAccount(ctx, nodeAccount); err != nil {
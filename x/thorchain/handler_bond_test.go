@@ -310,6 +310,72 @@ func (HandlerBondSuite) TestBondProvider_OperatorFee(c *C) {
 	c.Assert(err, IsNil)
 }
 
+func (HandlerBondSuite) TestBondProvider_OperatorFeeSchedule(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	handler := NewBondHandler(NewDummyMgrWithKeeper(k))
+	noticePeriod := k.GetConfigInt64(ctx, constants.NodeOperatorFeeNoticeBlocks)
+
+	na := GetRandomValidatorNode(NodeStandby)
+	operatorAccAddress, _ := na.BondAddress.AccAddress()
+	providerAccAddr := GetRandomBech32Addr()
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	bp := NewBondProviders(na.NodeAddress)
+	bp.NodeOperatorFee = cosmos.NewUint(1000)
+	bp.Providers = []BondProvider{NewBondProvider(operatorAccAddress), NewBondProvider(providerAccAddr)}
+	bp.Providers[0].Bond = na.Bond
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+
+	amt := cosmos.NewUint(common.One)
+	txIn := GetRandomTx()
+	txIn.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), amt))
+	setFee := func(fee int64) BondProviders {
+		msg := NewMsgBond(txIn, na.NodeAddress, amt, na.BondAddress, nil, operatorAccAddress, fee)
+		c.Assert(handler.validate(ctx, *msg), IsNil)
+		c.Assert(handler.handle(ctx, *msg), IsNil)
+		bp, err := k.GetBondProviders(ctx, na.NodeAddress)
+		c.Assert(err, IsNil)
+		return bp
+	}
+
+	// no other provider has bond at stake, the increase applies immediately
+	bp = setFee(2000)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(2000))
+	c.Check(bp.HasPendingNodeOperatorFee(), Equals, false)
+
+	// once a provider has bonded, an increase is scheduled
+	bp.Providers[1].Bond = cosmos.NewUint(common.One)
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	bp = setFee(3000)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(2000))
+	c.Check(bp.PendingNodeOperatorFee.Uint64(), Equals, uint64(3000))
+	c.Check(bp.PendingNodeOperatorFeeHeight, Equals, ctx.BlockHeight()+noticePeriod)
+	nodes, err := k.GetNodeOperatorFeeNodes(ctx)
+	c.Assert(err, IsNil)
+	c.Check(nodes, HasLen, 1)
+
+	// the notice period always outlasts a provider unbond requested on notice
+	k.SetMimir(ctx, constants.NodeOperatorFeeNoticeBlocks.String(), 100)
+	minNotice := k.GetConfigInt64(ctx, constants.BondProviderUnbondNoticeBlocks) + k.GetConfigInt64(ctx, constants.ChurnInterval)
+	bp = setFee(3500)
+	c.Check(bp.PendingNodeOperatorFee.Uint64(), Equals, uint64(3500))
+	c.Check(bp.PendingNodeOperatorFeeHeight, Equals, ctx.BlockHeight()+minNotice+1)
+
+	// a decrease applies immediately and cancels the scheduled increase
+	bp = setFee(1500)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(1500))
+	c.Check(bp.HasPendingNodeOperatorFee(), Equals, false)
+	nodes, err = k.GetNodeOperatorFeeNodes(ctx)
+	c.Assert(err, IsNil)
+	c.Check(nodes, HasLen, 0)
+
+	// without a notice period increases apply immediately
+	k.SetMimir(ctx, constants.NodeOperatorFeeNoticeBlocks.String(), 0)
+	bp = setFee(2500)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(2500))
+	c.Check(bp.HasPendingNodeOperatorFee(), Equals, false)
+}
+
 func (HandlerBondSuite) TestBondProvider_Handler(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	activeNodeAccount := GetRandomValidatorNode(NodeActive)
@@ -127,7 +127,7 @@ func (h DepositHandler) handle(ctx cosmos.Context, msg MsgDeposit) (*cosmos.Resu
 	case TxBond, TxUnBond, TxLeave, TxOperatorRotate:
 		targetModule = BondName
 	// For TxTCYClaim, send to Reserve so retrievable if done accidentally
-	case TxReserve, TxTHORName, TxTCYClaim, TxMaint, TxModifyLimitSwap:
+	case TxReserve, TxTHORName, TxTCYClaim, TxMaint, TxModifyLimitSwap, TxModifyStreamingSwap, TxRecurringSwapCancel, TxLiquidBond, TxLiquidUnbond:
 		targetModule = ReserveName
 	case TxTCYStake, TxTCYUnstake:
 		targetModule = TCYStakeName
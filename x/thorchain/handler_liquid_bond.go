@@ -0,0 +1,315 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+// LiquidBondHandler is handler to process MsgLiquidBond
+type LiquidBondHandler struct {
+	mgr Manager
+}
+
+// NewLiquidBondHandler create a new instance of LiquidBondHandler
+func NewLiquidBondHandler(mgr Manager) LiquidBondHandler {
+	return LiquidBondHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for LiquidBondHandler
+func (h LiquidBondHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgLiquidBond)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgLiquidBond failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgLiquidBond", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h LiquidBondHandler) validate(ctx cosmos.Context, msg MsgLiquidBond) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h LiquidBondHandler) validateV3_0_0(ctx cosmos.Context, msg MsgLiquidBond) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if h.mgr.Keeper().GetConfigInt64(ctx, constants.LiquidBondEnabled) <= 0 {
+		return fmt.Errorf("liquid bond is disabled")
+	}
+
+	na, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	if na.IsEmpty() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("node account %s does not exist", msg.NodeAddress))
+	}
+	if na.BondAddress.Equals(common.Address(msg.Signer.String())) {
+		return cosmos.ErrUnknownRequest("node operator bond cannot be made liquid")
+	}
+
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+	if !bp.Has(msg.Signer) {
+		return cosmos.ErrUnauthorized(fmt.Sprintf("%s is not a bond provider of %s", msg.Signer, msg.NodeAddress))
+	}
+	if !bp.LiquidBondUnits.IsZero() && bp.GetLiquidBond().Bond.IsZero() {
+		return cosmos.ErrUnknownRequest("liquid bond of the node has no redemption value")
+	}
+
+	return nil
+}
+
+func (h LiquidBondHandler) handle(ctx cosmos.Context, msg MsgLiquidBond) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handleV3_0_0 moves bond of the provider to the liquid bond provider of the
+// node and mints receipts for it at the current redemption value
+func (h LiquidBondHandler) handleV3_0_0(ctx cosmos.Context, msg MsgLiquidBond) error {
+	na, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+	if err = passiveBackfill(ctx, h.mgr, na, &bp); err != nil {
+		return err
+	}
+	bp.Adjust(na.Bond) // redistribute node bond amongst bond providers
+
+	provider := bp.Get(msg.Signer)
+	amt := msg.Amount
+	if amt.IsZero() || amt.GT(provider.Bond) {
+		amt = provider.Bond
+	}
+	if amt.IsZero() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("%s has no bond on %s", msg.Signer, msg.NodeAddress))
+	}
+
+	liquid := bp.GetLiquidBond()
+	if liquid.IsEmpty() {
+		liquid = NewBondProvider(GetLiquidBondAddress())
+		bp.Providers = append(bp.Providers, liquid)
+	}
+
+	// the first receipts are minted one to one with bond, later receipts at the
+	// current redemption value so rewards and slashes stay with prior holders
+	units := amt
+	if !bp.LiquidBondUnits.IsZero() {
+		units = common.GetSafeShare(amt, liquid.Bond, bp.LiquidBondUnits)
+	}
+	if units.IsZero() {
+		return cosmos.ErrUnknownRequest("liquid bond amount too small")
+	}
+
+	bp.Unbond(amt, msg.Signer)
+	bp.Bond(amt, liquid.BondAddress)
+	bp.LiquidBondUnits = bp.LiquidBondUnits.Add(units)
+
+	if err = h.mgr.LiquidBondManager().Mint(ctx, msg.NodeAddress, units, msg.Signer); err != nil {
+		return ErrInternal(err, "fail to mint liquid bond receipts")
+	}
+	if err = h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		cosmos.NewEvent("liquid_bond",
+			cosmos.NewAttribute("node_address", msg.NodeAddress.String()),
+			cosmos.NewAttribute("bond_address", msg.Signer.String()),
+			cosmos.NewAttribute("amount", amt.String()),
+			cosmos.NewAttribute("units", units.String()),
+			cosmos.NewAttribute("denom", GetLiquidBondDenom(msg.NodeAddress)),
+			cosmos.NewAttribute("txid", msg.Tx.ID.String())))
+
+	return nil
+}
+
+// LiquidUnbondHandler is handler to process MsgLiquidUnbond
+type LiquidUnbondHandler struct {
+	mgr Manager
+}
+
+// NewLiquidUnbondHandler create a new instance of LiquidUnbondHandler
+func NewLiquidUnbondHandler(mgr Manager) LiquidUnbondHandler {
+	return LiquidUnbondHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for LiquidUnbondHandler
+func (h LiquidUnbondHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgLiquidUnbond)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgLiquidUnbond failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgLiquidUnbond", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h LiquidUnbondHandler) validate(ctx cosmos.Context, msg MsgLiquidUnbond) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// validateV3_0_0 applies the unbond rules to redemptions, receipts can always be
+// redeemed once the node is no longer part of the network
+func (h LiquidUnbondHandler) validateV3_0_0(ctx cosmos.Context, msg MsgLiquidUnbond) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	na, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	if na.IsEmpty() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("node account %s does not exist", msg.NodeAddress))
+	}
+	if na.Status == NodeActive || na.Status == NodeReady {
+		return cosmos.ErrUnknownRequest("cannot redeem liquid bond while node is in active or ready status")
+	}
+	if h.mgr.Keeper().GetConfigInt64(ctx, constants.PauseUnbond) > 0 {
+		return fmt.Errorf("unbonding has been paused")
+	}
+
+	vaults, err := h.mgr.Keeper().GetAsgardVaultsByStatus(ctx, RetiringVault)
+	if err != nil {
+		return ErrInternal(err, "fail to get retiring vault")
+	}
+	for _, v := range vaults {
+		if v.GetMembership().Contains(na.PubKeySet.Secp256k1) {
+			return cosmos.ErrUnknownRequest("cannot redeem liquid bond, node is still part of the retiring vault")
+		}
+	}
+
+	if h.mgr.LiquidBondManager().BalanceOf(ctx, msg.NodeAddress, msg.Signer).IsZero() {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("%s holds no liquid bond receipts of %s", msg.Signer, msg.NodeAddress))
+	}
+
+	return nil
+}
+
+func (h LiquidUnbondHandler) handle(ctx cosmos.Context, msg MsgLiquidUnbond) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handleV3_0_0 burns receipts of the sender and returns their share of the
+// liquid bond of the node
+func (h LiquidUnbondHandler) handleV3_0_0(ctx cosmos.Context, msg MsgLiquidUnbond) error {
+	na, err := h.mgr.Keeper().GetNodeAccount(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
+	}
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
+	}
+	if err = passiveBackfill(ctx, h.mgr, na, &bp); err != nil {
+		return err
+	}
+	bp.Adjust(na.Bond) // redistribute node bond amongst bond providers
+
+	units := msg.Units
+	balance := h.mgr.LiquidBondManager().BalanceOf(ctx, msg.NodeAddress, msg.Signer)
+	if units.IsZero() || units.GT(balance) {
+		units = balance
+	}
+	if units.GT(bp.LiquidBondUnits) {
+		return ErrInternal(fmt.Errorf("receipts exceed liquid bond units"), fmt.Sprintf("fail to redeem liquid bond of %s", msg.NodeAddress))
+	}
+
+	liquid := bp.GetLiquidBond()
+	amt := common.GetSafeShare(units, bp.LiquidBondUnits, liquid.Bond)
+
+	if err = h.mgr.LiquidBondManager().Burn(ctx, msg.NodeAddress, units, msg.Signer); err != nil {
+		return ErrInternal(err, "fail to burn liquid bond receipts")
+	}
+	bp.LiquidBondUnits = common.SafeSub(bp.LiquidBondUnits, units)
+	bp.Unbond(amt, liquid.BondAddress)
+	if bp.LiquidBondUnits.IsZero() {
+		bp.Remove(liquid.BondAddress)
+	}
+
+	if !amt.IsZero() {
+		// this is always RUNE, sent directly as with any other bond return
+		unbondCoin := common.NewCoin(common.RuneAsset(), amt)
+		if err = h.mgr.Keeper().SendFromModuleToAccount(ctx, BondName, msg.Signer, common.NewCoins(unbondCoin)); err != nil {
+			return ErrInternal(err, "fail to send unbonded RUNE to bond address")
+		}
+		na.Bond = common.SafeSub(na.Bond, amt)
+
+		bondEvent := NewEventBond(amt, BondReturned, msg.Tx, &na, msg.Signer)
+		if err = h.mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
+			ctx.Logger().Error("fail to emit bond event", "error", err)
+		}
+	}
+
+	if err = h.mgr.Keeper().SetNodeAccount(ctx, na); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save node account(%s)", na.NodeAddress))
+	}
+	if err = h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		cosmos.NewEvent("liquid_unbond",
+			cosmos.NewAttribute("node_address", msg.NodeAddress.String()),
+			cosmos.NewAttribute("bond_address", msg.Signer.String()),
+			cosmos.NewAttribute("amount", amt.String()),
+			cosmos.NewAttribute("units", units.String()),
+			cosmos.NewAttribute("denom", GetLiquidBondDenom(msg.NodeAddress)),
+			cosmos.NewAttribute("txid", msg.Tx.ID.String())))
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package thorchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+type HandlerLiquidBondSuite struct{}
+
+var _ = Suite(&HandlerLiquidBondSuite{})
+
+func (s *HandlerLiquidBondSuite) checkInvariant(c *C, ctx cosmos.Context, mgr *Mgrs) {
+	for _, route := range mgr.Keeper().InvariantRoutes() {
+		if route.Route != "liquid_bond" {
+			continue
+		}
+		msgs, broken := route.Invariant(ctx)
+		c.Check(broken, Equals, false, Commentf("%v", msgs))
+	}
+}
+
+func (s *HandlerLiquidBondSuite) TestLiquidBond(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	k := mgr.Keeper()
+
+	na := GetRandomValidatorNode(NodeActive)
+	na.Bond = cosmos.NewUint(100 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	FundModule(c, ctx, k, BondName, 100*common.One)
+
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	bp := NewBondProviders(na.NodeAddress)
+	op := NewBondProvider(operator)
+	op.Bond = cosmos.NewUint(50 * common.One)
+	provider := NewBondProvider(GetRandomBech32Addr())
+	provider.Bond = cosmos.NewUint(50 * common.One)
+	bp.Providers = []BondProvider{op, provider}
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+
+	tx := GetRandomTx()
+	bondHandler := NewLiquidBondHandler(mgr)
+	unbondHandler := NewLiquidUnbondHandler(mgr)
+	denom := GetLiquidBondDenom(na.NodeAddress)
+
+	// disabled by default
+	msg := NewMsgLiquidBond(tx, na.NodeAddress, cosmos.NewUint(20*common.One), provider.BondAddress)
+	_, err = bondHandler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	k.SetMimir(ctx, constants.LiquidBondEnabled.String(), 1)
+
+	// node operator bond cannot be made liquid
+	_, err = bondHandler.Run(ctx, NewMsgLiquidBond(tx, na.NodeAddress, cosmos.NewUint(20*common.One), operator))
+	c.Assert(err, NotNil)
+
+	// only bond providers of the node
+	_, err = bondHandler.Run(ctx, NewMsgLiquidBond(tx, na.NodeAddress, cosmos.NewUint(20*common.One), GetRandomBech32Addr()))
+	c.Assert(err, NotNil)
+
+	// bond provider converts part of their bond into receipts
+	_, err = bondHandler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.LiquidBondManager().BalanceOf(ctx, na.NodeAddress, provider.BondAddress).Uint64(), Equals, uint64(20*common.One))
+	bp, err = k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.Get(provider.BondAddress).Bond.Uint64(), Equals, uint64(30*common.One))
+	c.Check(bp.GetLiquidBond().Bond.Uint64(), Equals, uint64(20*common.One))
+	c.Check(bp.LiquidBondUnits.Uint64(), Equals, uint64(20*common.One))
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Uint64(), Equals, uint64(100*common.One))
+	s.checkInvariant(c, ctx, mgr)
+
+	// receipts are transferable
+	holder := GetRandomBech32Addr()
+	c.Assert(k.SendCoins(ctx, provider.BondAddress, holder, sdk.NewCoins(sdk.NewCoin(denom, cosmos.NewInt(10*common.One)))), IsNil)
+	c.Check(mgr.LiquidBondManager().BalanceOf(ctx, na.NodeAddress, holder).Uint64(), Equals, uint64(10*common.One))
+
+	// a slash reduces the redemption value proportionally
+	slasher := newSlasherVCUR(k, mgr.EventMgr())
+	slasher.slashAndUpdateNodeAccount(ctx, na, common.NewCoin(common.BTCAsset, cosmos.ZeroUint()), Vault{}, na.Bond, cosmos.NewUint(10*common.One))
+	bp, err = k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.GetLiquidBond().Bond.Uint64(), Equals, uint64(18*common.One))
+	c.Check(bp.LiquidBondUnits.Uint64(), Equals, uint64(20*common.One))
+
+	// later receipts are minted at the slashed value
+	_, err = bondHandler.Run(ctx, NewMsgLiquidBond(tx, na.NodeAddress, cosmos.NewUint(9*common.One), provider.BondAddress))
+	c.Assert(err, IsNil)
+	c.Check(mgr.LiquidBondManager().BalanceOf(ctx, na.NodeAddress, provider.BondAddress).Uint64(), Equals, uint64(20*common.One))
+	bp, err = k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.GetLiquidBond().Bond.Uint64(), Equals, uint64(27*common.One))
+	c.Check(bp.LiquidBondUnits.Uint64(), Equals, uint64(30*common.One))
+	s.checkInvariant(c, ctx, mgr)
+
+	// cannot redeem from an active node
+	_, err = unbondHandler.Run(ctx, NewMsgLiquidUnbond(tx, na.NodeAddress, cosmos.ZeroUint(), holder))
+	c.Assert(err, NotNil)
+
+	// the liquid bond provider cannot be unbonded directly
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	na.UpdateStatus(NodeStandby, ctx.BlockHeight())
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	txIn := GetRandomTx()
+	txIn.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.ZeroUint()))
+	_, err = NewUnBondHandler(mgr).Run(ctx, NewMsgUnBond(txIn, na.NodeAddress, cosmos.ZeroUint(), na.BondAddress, GetLiquidBondAddress(), na.NodeAddress))
+	c.Assert(err, NotNil)
+
+	// holder redeems their receipts for RUNE
+	_, err = unbondHandler.Run(ctx, NewMsgLiquidUnbond(tx, na.NodeAddress, cosmos.ZeroUint(), holder))
+	c.Assert(err, IsNil)
+	c.Check(k.GetBalance(ctx, holder).AmountOf(common.RuneNative.Native()).Uint64(), Equals, uint64(9*common.One))
+	c.Check(mgr.LiquidBondManager().BalanceOf(ctx, na.NodeAddress, holder).IsZero(), Equals, true)
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Uint64(), Equals, uint64(81*common.One))
+	s.checkInvariant(c, ctx, mgr)
+
+	// nothing left to redeem
+	_, err = unbondHandler.Run(ctx, NewMsgLiquidUnbond(tx, na.NodeAddress, cosmos.ZeroUint(), holder))
+	c.Assert(err, NotNil)
+
+	// last holder redeems and the liquid bond provider is removed
+	_, err = unbondHandler.Run(ctx, NewMsgLiquidUnbond(tx, na.NodeAddress, cosmos.ZeroUint(), provider.BondAddress))
+	c.Assert(err, IsNil)
+	c.Check(k.GetBalance(ctx, provider.BondAddress).AmountOf(common.RuneNative.Native()).Uint64(), Equals, uint64(18*common.One))
+	bp, err = k.GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.Has(GetLiquidBondAddress()), Equals, false)
+	c.Check(bp.LiquidBondUnits.IsZero(), Equals, true)
+	s.checkInvariant(c, ctx, mgr)
+}
@@ -65,6 +65,19 @@ func (h MimirHandler) validateV3_0_0(ctx cosmos.Context, msg MsgMimir) error {
 	if !mimirValidKey(msg.Key) || len(msg.Key) > constants.MaxMimirLength {
 		return cosmos.ErrUnknownRequest("invalid mimir key")
 	}
+	def, ok := constants.GetMimirDefinition(msg.Key)
+	if !ok {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("unknown mimir key: %s", msg.Key))
+	}
+	// negative values delete the mimir or withdraw a node vote
+	if msg.Value >= 0 {
+		if err := def.ValidateValue(msg.Value); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+	}
+	if msg.Height != 0 && msg.Height <= ctx.BlockHeight() {
+		return cosmos.ErrUnknownRequest("mimir activation height must be in the future")
+	}
 	if _, err := validateMimirAuth(ctx, h.mgr.Keeper(), msg); err != nil {
 		return err
 	}
@@ -72,7 +85,7 @@ func (h MimirHandler) validateV3_0_0(ctx cosmos.Context, msg MsgMimir) error {
 }
 
 func (h MimirHandler) handle(ctx cosmos.Context, msg MsgMimir) error {
-	ctx.Logger().Info("handleMsgMimir request", "node", msg.Signer, "key", msg.Key, "value", msg.Value)
+	ctx.Logger().Info("handleMsgMimir request", "node", msg.Signer, "key", msg.Key, "value", msg.Value, "height", msg.Height)
 	version := h.mgr.GetVersion()
 	switch {
 	case version.GTE(semver.MustParse("3.0.0")):
@@ -109,6 +122,17 @@ func (h MimirHandler) handleV3_0_0(ctx cosmos.Context, msg MsgMimir) error {
 			return err
 		}
 	}
+	bondEvent := NewEventBond(cost, BondCost, common.Tx{}, &nodeAccount, nil)
+
+	// scheduled mimir votes are tallied separately from the immediate ones
+	if msg.Height > 0 {
+		if err = h.mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
+			ctx.Logger().Error("fail to emit bond event", "error", err)
+			return err
+		}
+		return h.handleScheduled(ctx, msg)
+	}
+
 	if err = h.mgr.Keeper().SetNodeMimir(ctx, msg.Key, msg.Value, msg.Signer); err != nil {
 		ctx.Logger().Error("fail to save node mimir", "error", err)
 		return err
@@ -118,7 +142,6 @@ func (h MimirHandler) handleV3_0_0(ctx cosmos.Context, msg MsgMimir) error {
 		ctx.Logger().Error("fail to emit set_node_mimir event", "error", err)
 		return err
 	}
-	bondEvent := NewEventBond(cost, BondCost, common.Tx{}, &nodeAccount, nil)
 	if err = h.mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
 		ctx.Logger().Error("fail to emit bond event", "error", err)
 		return err
@@ -140,19 +163,7 @@ func (h MimirHandler) handleV3_0_0(ctx cosmos.Context, msg MsgMimir) error {
 		return err
 	}
 
-	var effectiveValue int64
-	if h.mgr.Keeper().IsOperationalMimir(msg.Key) {
-		// A value of -1 indicates either a tie or that no values satisfy the required minimum votes.
-		operationalVotesMin := h.mgr.Keeper().GetConfigInt64(ctx, constants.OperationalVotesMin)
-		effectiveValue = nodeMimirs.ValueOfOperational(msg.Key, operationalVotesMin, activeNodes.GetNodeAddresses())
-	} else {
-		// Economic Mimir, so require supermajority to set.
-		var currentlyHasSuperMajority bool
-		effectiveValue, currentlyHasSuperMajority = nodeMimirs.HasSuperMajority(msg.Key, activeNodes.GetNodeAddresses())
-		if !currentlyHasSuperMajority {
-			effectiveValue = -1
-		}
-	}
+	effectiveValue := h.effectiveValue(ctx, msg.Key, nodeMimirs, activeNodes)
 	// If the effective value is negative (used to signal no effective value), change nothing.
 	if effectiveValue < 0 {
 		return nil
@@ -175,6 +186,150 @@ func (h MimirHandler) handleV3_0_0(ctx cosmos.Context, msg MsgMimir) error {
 	return nil
 }
 
+// effectiveValue returns the mimir value the given node votes agree on, or -1
+// when there is no agreement
+func (h MimirHandler) effectiveValue(ctx cosmos.Context, key string, nodeMimirs NodeMimirs, activeNodes NodeAccounts) int64 {
+	if h.mgr.Keeper().IsOperationalMimir(key) {
+		// A value of -1 indicates either a tie or that no values satisfy the required minimum votes.
+		operationalVotesMin := h.mgr.Keeper().GetConfigInt64(ctx, constants.OperationalVotesMin)
+		return nodeMimirs.ValueOfOperational(key, operationalVotesMin, activeNodes.GetNodeAddresses())
+	}
+	// Economic Mimir, so require supermajority to set.
+	effectiveValue, currentlyHasSuperMajority := nodeMimirs.HasSuperMajority(key, activeNodes.GetNodeAddresses())
+	if !currentlyHasSuperMajority {
+		return -1
+	}
+	return effectiveValue
+}
+
+// handleScheduled records a node vote for a mimir value taking effect at
+// msg.Height, and schedules (or cancels) the value as consensus is reached (or
+// lost). A negative value withdraws the node's vote.
+func (h MimirHandler) handleScheduled(ctx cosmos.Context, msg MsgMimir) error {
+	key := strings.ToUpper(msg.Key)
+	if err := h.mgr.Keeper().SetScheduledNodeMimir(ctx, key, msg.Value, msg.Height, msg.Signer); err != nil {
+		ctx.Logger().Error("fail to save scheduled node mimir", "error", err)
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		cosmos.NewEvent("set_scheduled_node_mimir",
+			cosmos.NewAttribute("key", key),
+			cosmos.NewAttribute("value", strconv.FormatInt(msg.Value, 10)),
+			cosmos.NewAttribute("height", strconv.FormatInt(msg.Height, 10)),
+			cosmos.NewAttribute("address", msg.Signer.String()),
+		),
+	)
+
+	nodeMimirs, err := h.mgr.Keeper().GetScheduledNodeMimirs(ctx, key, msg.Height)
+	if err != nil {
+		ctx.Logger().Error("fail to get scheduled node mimirs", "error", err)
+		return err
+	}
+	activeNodes, err := h.mgr.Keeper().ListActiveValidators(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to list active validators", "error", err)
+		return err
+	}
+	scheduled, found, err := h.mgr.Keeper().GetScheduledMimir(ctx, key, msg.Height)
+	if err != nil {
+		ctx.Logger().Error("fail to get scheduled mimir", "error", err)
+		return err
+	}
+
+	effectiveValue := h.effectiveValue(ctx, key, nodeMimirs, activeNodes)
+	if effectiveValue < 0 {
+		// consensus has been lost, cancel the pending change
+		if found {
+			h.mgr.Keeper().DeleteScheduledMimir(ctx, key, msg.Height)
+			ctx.EventManager().EmitEvent(
+				cosmos.NewEvent("scheduled_mimir_cancelled",
+					cosmos.NewAttribute("key", key),
+					cosmos.NewAttribute("value", strconv.FormatInt(scheduled.Value, 10)),
+					cosmos.NewAttribute("height", strconv.FormatInt(msg.Height, 10)),
+				),
+			)
+		}
+		return nil
+	}
+	if found && scheduled.Value == effectiveValue {
+		return nil
+	}
+
+	if err = h.mgr.Keeper().SetScheduledMimir(ctx, NewScheduledMimir(key, effectiveValue, msg.Height)); err != nil {
+		ctx.Logger().Error("fail to save scheduled mimir", "error", err)
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		cosmos.NewEvent("scheduled_mimir",
+			cosmos.NewAttribute("key", key),
+			cosmos.NewAttribute("value", strconv.FormatInt(effectiveValue, 10)),
+			cosmos.NewAttribute("height", strconv.FormatInt(msg.Height, 10)),
+		),
+	)
+	return nil
+}
+
+// processScheduledMimirs applies the scheduled mimir values that are due at the
+// current block height, and drops the node votes of schedules that never
+// reached consensus
+func processScheduledMimirs(ctx cosmos.Context, mgr Manager) {
+	k := mgr.Keeper()
+
+	var due []ScheduledMimir
+	iter := k.GetScheduledMimirIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var scheduled ScheduledMimir
+		if err := k.Cdc().Unmarshal(iter.Value(), &scheduled); err != nil {
+			ctx.Logger().Error("fail to unmarshal scheduled mimir", "key", string(iter.Key()), "error", err)
+			continue
+		}
+		// keys are ordered by activation height
+		if scheduled.Height > ctx.BlockHeight() {
+			break
+		}
+		due = append(due, scheduled)
+	}
+	iter.Close()
+
+	for _, scheduled := range due {
+		k.SetMimir(ctx, scheduled.Key, scheduled.Value)
+		k.DeleteScheduledMimir(ctx, scheduled.Key, scheduled.Height)
+		mimirEvent := NewEventSetMimir(scheduled.Key, strconv.FormatInt(scheduled.Value, 10))
+		if err := mgr.EventMgr().EmitEvent(ctx, mimirEvent); err != nil {
+			ctx.Logger().Error("fail to emit set_mimir event", "error", err)
+		}
+	}
+
+	var expired [][]byte
+	iterNode := k.GetScheduledNodeMimirIterator(ctx)
+	for ; iterNode.Valid(); iterNode.Next() {
+		height, err := scheduledMimirHeightFromKey(iterNode.Key())
+		if err != nil {
+			ctx.Logger().Error("fail to parse scheduled node mimir key", "key", string(iterNode.Key()), "error", err)
+			continue
+		}
+		if height > ctx.BlockHeight() {
+			break
+		}
+		expired = append(expired, iterNode.Key())
+	}
+	iterNode.Close()
+
+	for _, key := range expired {
+		k.DeleteKey(ctx, key)
+	}
+}
+
+// scheduledMimirHeightFromKey parses the activation height out of a scheduled
+// mimir store key ("<prefix>/<height>/<key>")
+func scheduledMimirHeightFromKey(key []byte) (int64, error) {
+	parts := strings.Split(string(key), "/")
+	if len(parts) < 3 {
+		return 0, fmt.Errorf("invalid scheduled mimir key: %s", key)
+	}
+	return strconv.ParseInt(parts[len(parts)-2], 10, 64)
+}
+
 func validateMimirAuth(ctx cosmos.Context, k keeper.Keeper, msg MsgMimir) (cosmos.Context, error) {
 	return activeNodeAccountsSignerPriority(ctx, k, msg.GetSigners())
 }
@@ -55,58 +55,76 @@ func (s *HandlerMimirSuite) TestMimirHandle(c *C) {
 	c.Assert(keeper.SetNodeAccount(ctx, na2), IsNil)
 	c.Assert(keeper.SetNodeAccount(ctx, na3), IsNil)
 
-	result, err = handler.Run(ctx, NewMsgMimir("AffiliateFeeBasisPointsMax", 1, na1.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAffiliateFeeBasisPoints", 1, na1.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
-	mimirs, err := keeper.GetNodeMimirs(ctx, "AffiliateFeeBasisPointsMax")
+
+	// unregistered keys and out of bounds values are rejected
+	result, err = handler.Run(ctx, NewMsgMimir("NotARegisteredMimir", 1, na1.NodeAddress))
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAffiliateFeeBasisPoints", 10_001, na1.NodeAddress))
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+	result, err = handler.Run(ctx, NewMsgMimir("TradeAccountsEnabled", 2, na1.NodeAddress))
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+	// negative values withdraw the vote and are not bounds checked
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAffiliateFeeBasisPoints", -1, na1.NodeAddress))
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAffiliateFeeBasisPoints", 1, na1.NodeAddress))
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	mimirs, err := keeper.GetNodeMimirs(ctx, "MaxAffiliateFeeBasisPoints")
 	c.Assert(err, IsNil)
 	c.Assert(mimirs.Mimirs, HasLen, 1)
 
 	// first node set mimir , no consensus
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 1, na1.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAvailablePools", 1, na1.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
-	mvalue, err := keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err := keeper.GetMimir(ctx, "MaxAvailablePools")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(-1))
 
 	// second node set mimir, reach consensus
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 1, na2.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAvailablePools", 1, na2.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "MaxAvailablePools")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(1))
 
 	// third node set mimir, reach consensus
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 1, na3.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAvailablePools", 1, na3.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "MaxAvailablePools")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(1))
 
 	// third node vote mimir to a different value, it should not change the admin mimir value
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 0, na3.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAvailablePools", 0, na3.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "MaxAvailablePools")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(1))
 
 	// second node vote mimir to a different value , it should update admin mimir
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir", 0, na2.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("MaxAvailablePools", 0, na2.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
-	mvalue, err = keeper.GetMimir(ctx, "node-mimir")
+	mvalue, err = keeper.GetMimir(ctx, "MaxAvailablePools")
 	c.Assert(err, IsNil)
 	c.Assert(mvalue, Equals, int64(0))
 
-	result, err = handler.Run(ctx, NewMsgMimir("node-mimir-1", 0, na2.NodeAddress))
+	result, err = handler.Run(ctx, NewMsgMimir("MinRunePoolDepth", 0, na2.NodeAddress))
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 
@@ -130,3 +148,89 @@ func (s *HandlerMimirSuite) TestMimirHandle(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(val, Equals, int64(1))
 }
+
+func (s *HandlerMimirSuite) TestScheduledMimir(c *C) {
+	ctx, keeper := setupKeeperForTest(c)
+	mgr := NewDummyMgrWithKeeper(keeper)
+	handler := NewMimirHandler(mgr)
+
+	FundModule(c, ctx, keeper, BondName, 100*common.One)
+	na1 := GetRandomValidatorNode(NodeActive)
+	na2 := GetRandomValidatorNode(NodeActive)
+	na3 := GetRandomValidatorNode(NodeActive)
+	c.Assert(keeper.SetNodeAccount(ctx, na1), IsNil)
+	c.Assert(keeper.SetNodeAccount(ctx, na2), IsNil)
+	c.Assert(keeper.SetNodeAccount(ctx, na3), IsNil)
+
+	height := ctx.BlockHeight() + 10
+
+	// activation height must be in the future
+	_, err := handler.Run(ctx, NewMsgScheduledMimir("MaxAvailablePools", 5, ctx.BlockHeight(), na1.NodeAddress))
+	c.Assert(err, NotNil)
+
+	// first vote, no consensus
+	_, err = handler.Run(ctx, NewMsgScheduledMimir("MaxAvailablePools", 5, height, na1.NodeAddress))
+	c.Assert(err, IsNil)
+	votes, err := keeper.GetScheduledNodeMimirs(ctx, "MaxAvailablePools", height)
+	c.Assert(err, IsNil)
+	c.Assert(votes.Mimirs, HasLen, 1)
+	_, found, err := keeper.GetScheduledMimir(ctx, "MaxAvailablePools", height)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, false)
+
+	// second vote reaches consensus, the value is scheduled but not yet applied
+	_, err = handler.Run(ctx, NewMsgScheduledMimir("MaxAvailablePools", 5, height, na2.NodeAddress))
+	c.Assert(err, IsNil)
+	scheduled, found, err := keeper.GetScheduledMimir(ctx, "MaxAvailablePools", height)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, true)
+	c.Assert(scheduled.Value, Equals, int64(5))
+	mvalue, err := keeper.GetMimir(ctx, "MaxAvailablePools")
+	c.Assert(err, IsNil)
+	c.Assert(mvalue, Equals, int64(-1))
+	nodeMimirs, err := keeper.GetNodeMimirs(ctx, "MaxAvailablePools")
+	c.Assert(err, IsNil)
+	c.Assert(nodeMimirs.Mimirs, HasLen, 0)
+
+	// withdrawing a vote cancels the scheduled change
+	_, err = handler.Run(ctx, NewMsgScheduledMimir("MaxAvailablePools", -1, height, na2.NodeAddress))
+	c.Assert(err, IsNil)
+	_, found, err = keeper.GetScheduledMimir(ctx, "MaxAvailablePools", height)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, false)
+
+	// vote again to reach consensus
+	_, err = handler.Run(ctx, NewMsgScheduledMimir("MaxAvailablePools", 5, height, na3.NodeAddress))
+	c.Assert(err, IsNil)
+	_, found, err = keeper.GetScheduledMimir(ctx, "MaxAvailablePools", height)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, true)
+
+	// votes without consensus at another height are dropped once due
+	_, err = handler.Run(ctx, NewMsgScheduledMimir("PoolCycle", 1, height, na1.NodeAddress))
+	c.Assert(err, IsNil)
+
+	// nothing applied before the activation height
+	processScheduledMimirs(ctx.WithBlockHeight(height-1), mgr)
+	mvalue, err = keeper.GetMimir(ctx, "MaxAvailablePools")
+	c.Assert(err, IsNil)
+	c.Assert(mvalue, Equals, int64(-1))
+
+	ctx = ctx.WithBlockHeight(height)
+	processScheduledMimirs(ctx, mgr)
+	mvalue, err = keeper.GetMimir(ctx, "MaxAvailablePools")
+	c.Assert(err, IsNil)
+	c.Assert(mvalue, Equals, int64(5))
+	mvalue, err = keeper.GetMimir(ctx, "PoolCycle")
+	c.Assert(err, IsNil)
+	c.Assert(mvalue, Equals, int64(-1))
+	_, found, err = keeper.GetScheduledMimir(ctx, "MaxAvailablePools", height)
+	c.Assert(err, IsNil)
+	c.Assert(found, Equals, false)
+	votes, err = keeper.GetScheduledNodeMimirs(ctx, "MaxAvailablePools", height)
+	c.Assert(err, IsNil)
+	c.Assert(votes.Mimirs, HasLen, 0)
+	votes, err = keeper.GetScheduledNodeMimirs(ctx, "PoolCycle", height)
+	c.Assert(err, IsNil)
+	c.Assert(votes.Mimirs, HasLen, 0)
+}
@@ -0,0 +1,217 @@
+package thorchain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+// ModifyStreamingSwapHandler is the handler to process MsgModifyStreamingSwap.
+type ModifyStreamingSwapHandler struct {
+	mgr Manager
+}
+
+// NewModifyStreamingSwapHandler creates a new instance of ModifyStreamingSwapHandler.
+func NewModifyStreamingSwapHandler(mgr Manager) ModifyStreamingSwapHandler {
+	return ModifyStreamingSwapHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for ModifyStreamingSwapHandler.
+func (h ModifyStreamingSwapHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgModifyStreamingSwap)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+
+	err := h.validate(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("MsgModifyStreamingSwap failed validation", "error", err)
+		return nil, err
+	}
+
+	err = h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgModifyStreamingSwap", "error", err)
+		return nil, err
+	}
+
+	return &cosmos.Result{}, err
+}
+
+func (h ModifyStreamingSwapHandler) validate(ctx cosmos.Context, msg MsgModifyStreamingSwap) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h ModifyStreamingSwapHandler) validateV3_0_0(ctx cosmos.Context, msg MsgModifyStreamingSwap) error {
+	return msg.ValidateBasic()
+}
+
+func (h ModifyStreamingSwapHandler) handle(ctx cosmos.Context, msg MsgModifyStreamingSwap) error {
+	msgSwap, index, adv, err := h.findStreamingSwap(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	swp := msgSwap.GetStreamingSwap()
+	stored := h.mgr.Keeper().StreamingSwapExists(ctx, msgSwap.Tx.ID)
+	if stored {
+		swp, err = h.mgr.Keeper().GetStreamingSwap(ctx, msgSwap.Tx.ID)
+		if err != nil {
+			return fmt.Errorf("fail to get streaming swap: %w", err)
+		}
+	}
+
+	if msg.IsCancellation() {
+		if err := h.cancel(ctx, msgSwap, index, adv, swp); err != nil {
+			return err
+		}
+	} else {
+		if err := h.modify(ctx, msg, msgSwap, index, adv, swp, stored); err != nil {
+			return err
+		}
+	}
+
+	modEvent := NewEventModifyStreamingSwap(msg.From, msg.TxID, msg.Interval, msg.Quantity)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, modEvent); err != nil {
+		ctx.Logger().Error("fail to emit modEvent event", "error", err)
+	}
+
+	return nil
+}
+
+// findStreamingSwap returns the queued streaming swap (and its queue index) for
+// the given inbound tx, ensuring it was sent by the same address requesting the
+// modification so people can't change other people's streaming swaps. Swaps
+// resting in the advanced swap queue are keyed by tx id only, and are returned
+// with adv set.
+func (h ModifyStreamingSwapHandler) findStreamingSwap(ctx cosmos.Context, msg MsgModifyStreamingSwap) (MsgSwap, int, bool, error) {
+	iterator := h.mgr.Keeper().GetSwapQueueIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var msgSwap MsgSwap
+		if err := h.mgr.Keeper().Cdc().Unmarshal(iterator.Value(), &msgSwap); err != nil {
+			ctx.Logger().Error("fail to fetch swap msg from queue", "error", err)
+			continue
+		}
+		if !h.isMatch(msgSwap, msg) {
+			continue
+		}
+
+		ss := strings.Split(string(iterator.Key()), "-")
+		i, err := strconv.Atoi(ss[len(ss)-1])
+		if err != nil {
+			ctx.Logger().Error("fail to parse swap queue msg index", "key", iterator.Key(), "error", err)
+			continue
+		}
+		return msgSwap, i, false, nil
+	}
+
+	advIterator := h.mgr.Keeper().GetAdvSwapQueueItemIterator(ctx)
+	defer advIterator.Close()
+	for ; advIterator.Valid(); advIterator.Next() {
+		var msgSwap MsgSwap
+		if err := h.mgr.Keeper().Cdc().Unmarshal(advIterator.Value(), &msgSwap); err != nil {
+			ctx.Logger().Error("fail to fetch swap msg from adv queue", "error", err)
+			continue
+		}
+		if h.isMatch(msgSwap, msg) {
+			return msgSwap, 0, true, nil
+		}
+	}
+	return MsgSwap{}, 0, false, fmt.Errorf("could not find matching streaming swap")
+}
+
+func (h ModifyStreamingSwapHandler) isMatch(msgSwap MsgSwap, msg MsgModifyStreamingSwap) bool {
+	return msgSwap.Tx.ID.Equals(msg.TxID) && msgSwap.IsStreaming() && msgSwap.Tx.FromAddress.Equals(msg.From)
+}
+
+// removeQueuedSwap removes the swap from the queue it was found in
+func (h ModifyStreamingSwapHandler) removeQueuedSwap(ctx cosmos.Context, msgSwap MsgSwap, index int, adv bool) error {
+	if adv {
+		return h.mgr.Keeper().RemoveAdvSwapQueueItem(ctx, msgSwap.Tx.ID)
+	}
+	h.mgr.Keeper().RemoveSwapQueueItem(ctx, msgSwap.Tx.ID, index)
+	return nil
+}
+
+func (h ModifyStreamingSwapHandler) cancel(ctx cosmos.Context, msgSwap MsgSwap, index int, adv bool, swp StreamingSwap) error {
+	reason := "streaming swap cancelled"
+
+	// no sub-swaps have been executed yet, refund the original transaction
+	if swp.In.IsZero() && swp.Out.IsZero() {
+		if err := h.removeQueuedSwap(ctx, msgSwap, index, adv); err != nil {
+			return err
+		}
+		h.mgr.Keeper().RemoveStreamingSwap(ctx, msgSwap.Tx.ID)
+
+		voter, voterErr := h.mgr.Keeper().GetObservedTxInVoter(ctx, msgSwap.Tx.ID)
+		var refundErr error
+		if voterErr == nil && !voter.Tx.IsEmpty() {
+			refundErr = refundTx(ctx, ObservedTx{Tx: msgSwap.Tx, ObservedPubKey: voter.Tx.ObservedPubKey}, h.mgr, CodeSwapFail, reason, "")
+		} else {
+			refundErr = refundTx(ctx, ObservedTx{Tx: msgSwap.Tx}, h.mgr, CodeSwapFail, reason, "")
+		}
+		if refundErr != nil {
+			ctx.Logger().Error("fail to refund cancelled streaming swap", "error", refundErr)
+			return refundErr
+		}
+		return nil
+	}
+
+	// pay out what has been swapped so far and refund the remainder
+	swp.FailedSwaps = append(swp.FailedSwaps, swp.Count+1)
+	swp.FailedSwapReasons = append(swp.FailedSwapReasons, reason)
+	if adv {
+		if err := h.removeQueuedSwap(ctx, msgSwap, index, adv); err != nil {
+			return err
+		}
+	}
+	return settleStreamingSwap(ctx, h.mgr, msgSwap, index, swp)
+}
+
+func (h ModifyStreamingSwapHandler) modify(ctx cosmos.Context, msg MsgModifyStreamingSwap, msgSwap MsgSwap, index int, adv bool, swp StreamingSwap, stored bool) error {
+	if msg.Interval > 0 {
+		maxLength := h.mgr.Keeper().GetConfigInt64(ctx, constants.StreamingSwapMaxLength)
+		if msg.Interval > uint64(maxLength) {
+			return fmt.Errorf("interval (%d) cannot be greater than max length (%d)", msg.Interval, maxLength)
+		}
+		swp.Interval = msg.Interval
+	}
+	if msg.Quantity > 0 {
+		if msg.Quantity <= swp.Count {
+			return fmt.Errorf("quantity (%d) must be greater than completed sub-swaps (%d)", msg.Quantity, swp.Count)
+		}
+		// once streaming has started, the quantity has been capped by the
+		// pool depths, so it may only be reduced
+		if swp.Count > 0 && msg.Quantity > swp.Quantity {
+			return fmt.Errorf("quantity (%d) cannot be greater than current quantity (%d)", msg.Quantity, swp.Quantity)
+		}
+		swp.Quantity = msg.Quantity
+	}
+
+	// before the first sub-swap, the swap handler derives the streaming swap
+	// from the queued msg (capping it as needed), so update the msg instead
+	if !stored {
+		msgSwap.StreamInterval = swp.Interval
+		msgSwap.StreamQuantity = swp.Quantity
+		if adv {
+			return h.mgr.Keeper().SetAdvSwapQueueItem(ctx, msgSwap)
+		}
+		return h.mgr.Keeper().SetSwapQueueItem(ctx, msgSwap, index)
+	}
+	h.mgr.Keeper().SetStreamingSwap(ctx, swp)
+	return nil
+}
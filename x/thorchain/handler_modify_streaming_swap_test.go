@@ -0,0 +1,190 @@
+package thorchain
+
+import (
+	"fmt"
+	"strings"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+type HandlerModifyStreamingSwapSuite struct{}
+
+var _ = Suite(&HandlerModifyStreamingSwapSuite{})
+
+func (s *HandlerModifyStreamingSwapSuite) newStreamingSwap(c *C, ctx cosmos.Context, mgr *Mgrs, from common.Address) *MsgSwap {
+	btcAddr := GetRandomBTCAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		from,
+		from,
+		common.NewCoins(common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One*100))),
+		common.Gas{common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One))},
+		fmt.Sprintf("=:BTC.BTC:%s:0/10/20", btcAddr),
+	)
+	msg := NewMsgSwap(tx, common.BTCAsset, btcAddr, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketSwap, 20, 10, GetRandomBech32Addr())
+	c.Assert(mgr.Keeper().SetSwapQueueItem(ctx, *msg, 0), IsNil)
+	return msg
+}
+
+func (s *HandlerModifyStreamingSwapSuite) TestModifyStreamingSwap(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	handler := NewModifyStreamingSwapHandler(mgr)
+	from := GetRandomETHAddress()
+	signer := GetRandomBech32Addr()
+
+	// no matching streaming swap
+	msg := NewMsgModifyStreamingSwap(from, GetRandomTxHash(), 5, 10, signer)
+	result, err := handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	c.Assert(result, IsNil)
+	c.Check(err.Error(), Equals, "could not find matching streaming swap")
+
+	// different sender cannot modify the swap
+	swap := s.newStreamingSwap(c, ctx, mgr, from)
+	msg = NewMsgModifyStreamingSwap(GetRandomETHAddress(), swap.Tx.ID, 5, 10, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// before the first sub-swap, the queued msg is updated
+	msg = NewMsgModifyStreamingSwap(from, swap.Tx.ID, 5, 10, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	queued, err := mgr.Keeper().GetSwapQueueItem(ctx, swap.Tx.ID, 0)
+	c.Assert(err, IsNil)
+	c.Check(queued.StreamInterval, Equals, uint64(5))
+	c.Check(queued.StreamQuantity, Equals, uint64(10))
+	c.Check(mgr.Keeper().StreamingSwapExists(ctx, swap.Tx.ID), Equals, false)
+
+	// interval cannot exceed the max length
+	msg = NewMsgModifyStreamingSwap(from, swap.Tx.ID, 1_000_000, 0, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// once streaming, the stored streaming swap is updated
+	swp := queued.GetStreamingSwap()
+	swp.Count = 4
+	swp.In = swp.Deposit.QuoUint64(4)
+	swp.Out = cosmos.NewUint(12345)
+	mgr.Keeper().SetStreamingSwap(ctx, swp)
+
+	// quantity must exceed completed sub-swaps
+	msg = NewMsgModifyStreamingSwap(from, swap.Tx.ID, 0, 4, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	// quantity cannot be increased once streaming
+	msg = NewMsgModifyStreamingSwap(from, swap.Tx.ID, 0, 11, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	msg = NewMsgModifyStreamingSwap(from, swap.Tx.ID, 2, 6, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	swp, err = mgr.Keeper().GetStreamingSwap(ctx, swap.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Interval, Equals, uint64(2))
+	c.Check(swp.Quantity, Equals, uint64(6))
+	c.Check(swp.Count, Equals, uint64(4))
+}
+
+func (s *HandlerModifyStreamingSwapSuite) TestCancelStreamingSwap(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewModifyStreamingSwapHandler(mgr)
+
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.BalanceRune = cosmos.NewUint(143166 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	from := GetRandomETHAddress()
+	signer := GetRandomBech32Addr()
+
+	// cancel before the first sub-swap refunds the full deposit
+	swap := s.newStreamingSwap(c, ctx, mgr, from)
+	msg := NewMsgModifyStreamingSwap(from, swap.Tx.ID, 0, 0, signer)
+	_, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(strings.HasPrefix(items[0].Memo, "REFUND:"), Equals, true)
+	c.Check(items[0].Coin.Equals(swap.Tx.Coins[0]), Equals, true, Commentf("%s", items[0].Coin.String()))
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, swap.Tx.ID, 0), Equals, false)
+	mgr.TxOutStore().ClearOutboundItems(ctx)
+
+	// cancel mid-stream pays out the accumulated output and refunds the remainder
+	swap = s.newStreamingSwap(c, ctx, mgr, from)
+	swp := swap.GetStreamingSwap()
+	swp.Count = 5
+	swp.In = swp.Deposit.QuoUint64(4)
+	swp.Out = cosmos.NewUint(12345)
+	mgr.Keeper().SetStreamingSwap(ctx, swp)
+
+	msg = NewMsgModifyStreamingSwap(from, swap.Tx.ID, 0, 0, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].Memo, Equals, "")
+	c.Check(items[0].Coin.Equals(common.NewCoin(common.BTCAsset, cosmos.NewUint(12345))), Equals, true, Commentf("%s", items[0].Coin.String()))
+	c.Check(strings.HasPrefix(items[1].Memo, "REFUND:"), Equals, true)
+	c.Check(items[1].Coin.Equals(common.NewCoin(common.ETHAsset, cosmos.NewUint(75*common.One))), Equals, true, Commentf("%s", items[1].Coin.String()))
+	c.Check(mgr.Keeper().StreamingSwapExists(ctx, swap.Tx.ID), Equals, false)
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, swap.Tx.ID, 0), Equals, false)
+
+	// the cancellation reason is recorded on the emitted streaming swap event
+	found := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != "streaming_swap" {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if attr.Key == "failed_swap_reasons" && strings.Contains(attr.Value, "streaming swap cancelled") {
+				found = true
+			}
+		}
+	}
+	c.Check(found, Equals, true)
+}
+
+func (s *HandlerModifyStreamingSwapSuite) TestModifyAdvQueueStreamingSwap(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewModifyStreamingSwapHandler(mgr)
+
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.BalanceRune = cosmos.NewUint(143166 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	from := GetRandomETHAddress()
+	signer := GetRandomBech32Addr()
+
+	// streaming swaps resting in the advanced swap queue can be modified
+	swap := s.newStreamingSwap(c, ctx, mgr, from)
+	mgr.Keeper().RemoveSwapQueueItem(ctx, swap.Tx.ID, 0)
+	c.Assert(mgr.Keeper().SetAdvSwapQueueItem(ctx, *swap), IsNil)
+	msg := NewMsgModifyStreamingSwap(from, swap.Tx.ID, 5, 10, signer)
+	_, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	queued, err := mgr.Keeper().GetAdvSwapQueueItem(ctx, swap.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(queued.StreamInterval, Equals, uint64(5))
+	c.Check(queued.StreamQuantity, Equals, uint64(10))
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, swap.Tx.ID, 0), Equals, false)
+
+	// and cancelled, refunding the deposit
+	msg = NewMsgModifyStreamingSwap(from, swap.Tx.ID, 0, 0, signer)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, swap.Tx.ID), Equals, false)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(strings.HasPrefix(items[0].Memo, "REFUND:"), Equals, true)
+	c.Check(items[0].Coin.Equals(swap.Tx.Coins[0]), Equals, true, Commentf("%s", items[0].Coin.String()))
+}
@@ -50,6 +50,7 @@ func processNetworkFeeAttestation(
 		// After consensus, only decrement slash points if within the ObservationDelayFlexibility period.
 		if (voter.BlockHeight + observeFlex) >= ctx.BlockHeight() {
 			mgr.Slasher().DecSlashPoints(slashCtx, lackOfObservationPenalty, attester)
+			recordLateNetworkFee(ctx, mgr.Keeper(), nf, attester, voter.BlockHeight)
 		}
 		// MsgNetworkFeeQuorum tx already processed
 		return nil
@@ -64,6 +65,7 @@ func processNetworkFeeAttestation(
 	nonSigners := getNonSigners(active, signers)
 	mgr.Slasher().DecSlashPoints(slashCtx, observeSlashPoints, signers...)
 	mgr.Slasher().IncSlashPoints(slashCtx, lackOfObservationPenalty, nonSigners...)
+	recordNetworkFeeQuorum(ctx, mgr.Keeper(), nf, signers, nonSigners)
 
 	ctx.Logger().Info("update network fee", "chain", nf.Chain.String(), "transaction-size", nf.TransactionSize, "fee-rate", nf.TransactionRate)
 	if err := mgr.Keeper().SaveNetworkFee(ctx, nf.Chain, NetworkFee{
@@ -69,6 +69,7 @@ func processTxInAttestation(
 			nonSigners := getNonSigners(nas, signers)
 			slasher.DecSlashPoints(slashCtx, observeSlashPoints, signers...)
 			slasher.IncSlashPoints(slashCtx, lackOfObservationPenalty, nonSigners...)
+			recordObservationQuorum(ctx, k, voter.Tx.Tx, signers, nonSigners)
 		} else if ctx.BlockHeight() <= (voter.FinalisedHeight+observeFlex) &&
 			voter.Tx.IsFinal() == tx.IsFinal() &&
 			voter.Tx.Tx.EqualsEx(tx.Tx) &&
@@ -78,6 +79,7 @@ func processTxInAttestation(
 			// event the tx had been processed , given the signer just a bit late , so still take away their slash points
 			// but only when the tx signer are voting is the tx that already reached consensus
 			slasher.DecSlashPoints(slashCtx, observeSlashPoints+lackOfObservationPenalty, signer)
+			recordLateObservation(ctx, k, voter.Tx.Tx, signer, voter.FinalisedHeight)
 		}
 	}
 	if !ok && voter.HasConsensus(nas) && !tx.IsFinal() && voter.FinalisedHeight == 0 {
@@ -393,6 +395,7 @@ func processTxOutAttestation(
 			nonSigners := getNonSigners(nas, signers)
 			slasher.DecSlashPoints(slashCtx, observeSlashPoints, signers...)
 			slasher.IncSlashPoints(slashCtx, lackOfObservationPenalty, nonSigners...)
+			recordObservationQuorum(ctx, k, voter.Tx.Tx, signers, nonSigners)
 		} else if ctx.BlockHeight() <= (voter.FinalisedHeight+observeFlex) &&
 			voter.Tx.IsFinal() == tx.IsFinal() &&
 			voter.Tx.Tx.EqualsEx(tx.Tx) &&
@@ -401,6 +404,7 @@ func processTxOutAttestation(
 			voter.Tx.Signers = append(voter.Tx.Signers, signer.String())
 			// event the tx had been processed , given the signer just a bit late , so we still take away their slash points
 			slasher.DecSlashPoints(slashCtx, observeSlashPoints+lackOfObservationPenalty, signer)
+			recordLateObservation(ctx, k, voter.Tx.Tx, signer, voter.FinalisedHeight)
 		}
 	}
 	if !ok && voter.HasConsensus(nas) && !tx.IsFinal() && voter.FinalisedHeight == 0 {
@@ -499,9 +503,7 @@ func handleObservedTxOutQuorum(
 			ctx.Logger().Error("fail to get vault", "error", err)
 			return nil
 		}
-		toSlash := make(common.Coins, len(tx.Tx.Coins))
-		copy(toSlash, tx.Tx.Coins)
-		toSlash = toSlash.Add(tx.Tx.Gas.ToCoins()...)
+		toSlash := tx.OutputCoins().Add(tx.Tx.Gas.ToCoins()...)
 
 		slashCtx := ctx.WithContext(context.WithValue(ctx.Context(), constants.CtxMetricLabels, []metrics.Label{
 			telemetry.NewLabel("reason", "sent_extra_funds"),
@@ -521,12 +523,18 @@ func handleObservedTxOutQuorum(
 
 	txOut := voter.GetTx(activeNodeAccounts) // get consensus tx, in case our for loop is incorrect
 	txOut.Tx.Memo = tx.Tx.Memo
-	m, err := processOneTxIn(ctx, k, *txOut, signer)
-	if err != nil || tx.Tx.Chain.IsEmpty() {
-		ctx.Logger().Error("fail to process txOut",
-			"error", err,
-			"tx", tx.Tx.String())
-		return nil
+	// a batched outbound is handled as one outbound per recipient
+	outTxs := txOut.OutputTxs()
+	msgs := make([]cosmos.Msg, 0, len(outTxs))
+	for _, outTx := range outTxs {
+		m, err := processOneTxIn(ctx, k, outTx, signer)
+		if err != nil || tx.Tx.Chain.IsEmpty() {
+			ctx.Logger().Error("fail to process txOut",
+				"error", err,
+				"tx", outTx.Tx.String())
+			return nil
+		}
+		msgs = append(msgs, m)
 	}
 
 	// Apply Gas fees
@@ -551,11 +559,16 @@ func handleObservedTxOutQuorum(
 			}
 		}
 	}
-	_, err = handler(ctx, m)
-	if err != nil {
-		ctx.Logger().Error("handler failed:", "error", err)
-		return nil
+	// the recipients of a batched outbound are applied together, so a failed
+	// one leaves none of them applied and the vault untouched
+	batchCtx, commit := ctx.CacheContext()
+	for _, m := range msgs {
+		if _, err := handler(batchCtx, m); err != nil {
+			ctx.Logger().Error("handler failed:", "error", err)
+			return nil
+		}
 	}
+	commit()
 	voter.SetDone()
 	k.SetObservedTxOutVoter(ctx, voter)
 	// process the msg first , and then deduct the fund from vault last
@@ -568,7 +581,7 @@ func handleObservedTxOutQuorum(
 	if !tx.Tx.FromAddress.Equals(tx.Tx.ToAddress) {
 		// Don't add to or subtract from vault balances when the sender and recipient are the same
 		// (particularly avoid Consolidate SafeSub zeroing of vault balances).
-		vault.SubFunds(tx.Tx.Coins)
+		vault.SubFunds(tx.OutputCoins())
 		vault.OutboundTxCount++
 	}
 	if vault.IsAsgard() && memo.IsType(TxMigrate) {
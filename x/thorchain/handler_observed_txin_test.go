@@ -935,3 +935,81 @@ func (s *HandlerObservedTxInSuite) TestObservingSlashing(c *C) {
 
 	// Note that nas[6], the Standby node, remains unaffected by the Actives nodes' observations.
 }
+
+func (s *HandlerObservedTxInSuite) TestObservationStats(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	height := int64(1024)
+	ctx = ctx.WithBlockHeight(height)
+	mgr.Keeper().SetMimir(ctx, constants.ObservationStatsWindow.String(), 100)
+
+	asgardVault := GetRandomVault()
+	c.Assert(mgr.Keeper().SetVault(ctx, asgardVault), IsNil)
+
+	// 4 Active nodes; 2/3rds consensus needs 3.
+	nas := NodeAccounts{
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+		GetRandomValidatorNode(NodeActive),
+	}
+	for _, item := range nas {
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, item), IsNil)
+	}
+
+	newTx := func(finaliseHeight int64) common.ObservedTx {
+		tx := GetRandomObservedTx()
+		tx.BlockHeight = height
+		tx.FinaliseHeight = finaliseHeight
+		tx.ObservedPubKey = asgardVault.PubKey
+		var err error
+		tx.Tx.ToAddress, err = tx.ObservedPubKey.GetAddress(tx.Tx.Chain)
+		c.Assert(err, IsNil)
+		return tx
+	}
+
+	handler := NewObservedTxInHandler(mgr)
+	broadcast := func(ctx cosmos.Context, na NodeAccount, tx common.ObservedTx) {
+		_, err := handler.handle(ctx, *NewMsgObservedTxIn([]common.ObservedTx{tx}, na.NodeAddress))
+		c.Assert(err, IsNil)
+	}
+	lags := func(na NodeAccount, chain common.Chain) []int64 {
+		stats, err := mgr.Keeper().GetObservationStats(ctx, na.NodeAddress, chain)
+		c.Assert(err, IsNil)
+		return stats.ObservationLags
+	}
+
+	// nas[3] misses two finalised txs
+	tx1 := newTx(height)
+	tx2 := newTx(height)
+	tx2.Tx.Chain = tx1.Tx.Chain
+	tx2.Tx.ToAddress = tx1.Tx.ToAddress
+	for _, tx := range []common.ObservedTx{tx1, tx2} {
+		for _, na := range nas[:3] {
+			broadcast(ctx, na, tx)
+		}
+	}
+	c.Check(lags(nas[0], tx1.Tx.Chain), DeepEquals, []int64{0, 0})
+	c.Check(lags(nas[3], tx1.Tx.Chain), DeepEquals, []int64{-1, -1})
+
+	// a late observation of the first tx replaces the miss of that tx
+	ctx = ctx.WithBlockHeight(height + 2)
+	broadcast(ctx, nas[3], tx1)
+	c.Check(lags(nas[3], tx1.Tx.Chain), DeepEquals, []int64{2, -1})
+
+	// a tx reaching consensus before it is final is recorded once, when finalised
+	tx3 := newTx(height + 5)
+	tx3.Tx.Chain = tx1.Tx.Chain
+	tx3.Tx.ToAddress = tx1.Tx.ToAddress
+	for _, na := range nas[:3] {
+		broadcast(ctx, na, tx3)
+	}
+	c.Check(lags(nas[0], tx1.Tx.Chain), DeepEquals, []int64{0, 0})
+	c.Check(lags(nas[3], tx1.Tx.Chain), DeepEquals, []int64{2, -1})
+
+	tx3.BlockHeight = tx3.FinaliseHeight
+	for _, na := range nas[1:] {
+		broadcast(ctx, na, tx3)
+	}
+	c.Check(lags(nas[0], tx1.Tx.Chain), DeepEquals, []int64{0, 0, -1})
+	c.Check(lags(nas[3], tx1.Tx.Chain), DeepEquals, []int64{2, -1, 0})
+}
@@ -262,6 +262,87 @@ func (s *HandlerObservedTxOutSuite) TestHandle(c *C) {
 	c.Assert(hashes, HasLen, 1)
 }
 
+func (s *HandlerObservedTxOutSuite) TestHandleBatchedOutbound(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+
+	pk := GetRandomPubKey()
+	vaultAddr, err := pk.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	txInHash := GetRandomTxHash()
+	to1, to2 := GetRandomBTCAddress(), GetRandomBTCAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		vaultAddr,
+		to1,
+		common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1000))),
+		common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(3000))},
+		NewOutboundMemo(txInHash).String(),
+	)
+	obTx := NewObservedTx(tx, 12, pk, 12)
+	obTx.Outputs = []common.TxOutput{
+		common.NewTxOutput(to2, common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(2000)))),
+	}
+
+	vault := NewVault(ctx.BlockHeight(), ActiveVault, AsgardVault, pk, common.Chains{common.BTCChain}.Strings(), []ChainContract{})
+	vault.Membership = []string{pk.String()}
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)),
+	}
+	txOutStore := NewTxStoreDummy()
+	for _, output := range obTx.OutputTxs() {
+		txOutStore.blockOut.TxArray = append(txOutStore.blockOut.TxArray, TxOutItem{
+			Chain:       common.BTCChain,
+			InHash:      txInHash,
+			ToAddress:   output.Tx.ToAddress,
+			VaultPubKey: pk,
+			Coin:        output.Tx.Coins[0],
+			Memo:        tx.Memo,
+		})
+	}
+	txInVoter := NewObservedTxVoter(txInHash, make(ObservedTxs, 0))
+	txInVoter.Actions = txOutStore.blockOut.TxArray
+	keeper := &TestObservedTxOutHandleKeeper{
+		nas:       NodeAccounts{GetRandomValidatorNode(NodeActive)},
+		voter:     NewObservedTxVoter(tx.ID, make(ObservedTxs, 0)),
+		txInVoter: txInVoter,
+		pool: Pool{
+			Asset:        common.BTCAsset,
+			BalanceRune:  cosmos.NewUint(200_000),
+			BalanceAsset: cosmos.NewUint(300_000),
+		},
+		vaultExists: true,
+		vault:       vault,
+		hashes:      make([]common.TxID, 0),
+		txOutStore:  txOutStore,
+	}
+	mgr.K = keeper
+	eventMgr := NewDummyEventMgr()
+	mgr.eventMgr = eventMgr
+	mgr.slasher = newSlasherVCUR(keeper, eventMgr)
+
+	handler := NewObservedTxOutHandler(mgr)
+	msg := NewMsgObservedTxOut(ObservedTxs{obTx}, keeper.nas[0].NodeAddress)
+	_, err = handler.handle(ctx, *msg)
+	c.Assert(err, IsNil)
+
+	// every recipient of the batched tx matched its own outbound item
+	items, err := txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	for _, item := range items {
+		c.Check(item.OutHash.Equals(tx.ID), Equals, true)
+	}
+	c.Check(keeper.txInVoter.OutTxs, HasLen, 2)
+	c.Check(keeper.txInVoter.IsDone(), Equals, true)
+	c.Check(keeper.voter.Tx.IsEmpty(), Equals, false)
+
+	// all the recipients are deducted from the vault, the gas only once
+	c.Check(keeper.vault.GetCoin(common.BTCAsset).Amount.Uint64(), Equals, uint64(common.One-1000-2000-3000))
+	c.Check(keeper.vault.OutboundTxCount, Equals, int64(1))
+	mgr.GasMgr().EndBlock(ctx, keeper, eventMgr)
+	c.Check(keeper.pool.BalanceAsset.Uint64(), Equals, uint64(300_000-3000))
+}
+
 func (s *HandlerObservedTxOutSuite) TestHandleFailedTransaction(c *C) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
@@ -0,0 +1,192 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+// RecurringSwapHandler is handler to process MsgRecurringSwap
+type RecurringSwapHandler struct {
+	mgr Manager
+}
+
+// NewRecurringSwapHandler create a new instance of RecurringSwapHandler
+func NewRecurringSwapHandler(mgr Manager) RecurringSwapHandler {
+	return RecurringSwapHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for RecurringSwapHandler
+func (h RecurringSwapHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgRecurringSwap)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgRecurringSwap failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgRecurringSwap", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h RecurringSwapHandler) validate(ctx cosmos.Context, msg MsgRecurringSwap) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h RecurringSwapHandler) validateV3_0_0(ctx cosmos.Context, msg MsgRecurringSwap) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if h.mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsEnabled) <= 0 {
+		return fmt.Errorf("trade accounts are disabled")
+	}
+	if h.mgr.Keeper().GetConfigInt64(ctx, constants.RecurringSwapsEnabled) <= 0 {
+		return fmt.Errorf("recurring swaps are disabled")
+	}
+
+	minInterval := h.mgr.Keeper().GetConfigInt64(ctx, constants.RecurringSwapMinInterval)
+	if msg.Interval < uint64(minInterval) {
+		return fmt.Errorf("interval (%d) cannot be less than %d blocks", msg.Interval, minInterval)
+	}
+	maxQuantity := h.mgr.Keeper().GetConfigInt64(ctx, constants.RecurringSwapMaxQuantity)
+	if msg.Quantity > uint64(maxQuantity) {
+		return fmt.Errorf("quantity (%d) cannot be greater than %d", msg.Quantity, maxQuantity)
+	}
+
+	if !msg.TargetAsset.IsRune() && !h.mgr.Keeper().PoolExist(ctx, msg.TargetAsset.GetLayer1Asset()) {
+		return fmt.Errorf("%s pool doesn't exist", msg.TargetAsset.GetLayer1Asset())
+	}
+	if h.mgr.Keeper().RecurringSwapExists(ctx, msg.Tx.ID) {
+		return fmt.Errorf("recurring swap (%s) already exists", msg.Tx.ID)
+	}
+
+	escrow := getRecurringSwapEscrow(msg)
+	balance := h.mgr.TradeAccountManager().BalanceOf(ctx, msg.SourceAsset, msg.Signer)
+	if balance.LT(escrow) {
+		return fmt.Errorf("insufficient trade account balance: %s < %s", balance, escrow)
+	}
+
+	return nil
+}
+
+// getRecurringSwapEscrow returns the amount committed to a recurring swap, the
+// deposited amount when it is not split evenly across the swaps
+func getRecurringSwapEscrow(msg MsgRecurringSwap) cosmos.Uint {
+	escrow := msg.Amount.MulUint64(msg.Quantity)
+	if coin := msg.Tx.Coins.GetCoin(msg.SourceAsset); coin.Amount.GT(escrow) {
+		escrow = coin.Amount
+	}
+	return escrow
+}
+
+func (h RecurringSwapHandler) handle(ctx cosmos.Context, msg MsgRecurringSwap) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgRecurringSwap. The committed amount is moved from the
+// owner's trade account to the escrow of the recurring swap, each swap of the
+// schedule draws from it when it comes due, the first one in this block.
+func (h RecurringSwapHandler) handleV3_0_0(ctx cosmos.Context, msg MsgRecurringSwap) error {
+	swp := NewRecurringSwap(msg.Tx.ID, msg.Signer, msg.SourceAsset, msg.TargetAsset, msg.Destination, msg.Amount, msg.Interval, msg.Quantity, ctx.BlockHeight())
+	if err := swp.Valid(); err != nil {
+		return err
+	}
+	if _, err := h.mgr.TradeAccountManager().Transfer(ctx, swp.SourceAsset, getRecurringSwapEscrow(msg), swp.Owner, swp.GetEscrowAddress(), swp.TxID); err != nil {
+		return fmt.Errorf("fail to escrow recurring swap: %w", err)
+	}
+	if err := h.mgr.Keeper().SetRecurringSwapHeight(ctx, swp.NextHeight, swp.TxID); err != nil {
+		return fmt.Errorf("fail to schedule recurring swap: %w", err)
+	}
+	h.mgr.Keeper().SetRecurringSwap(ctx, swp)
+
+	evt := NewEventRecurringSwap(swp, RecurringSwapStatusCreated, "")
+	if err := h.mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit recurring swap event", "error", err)
+	}
+	return nil
+}
+
+// RecurringSwapCancelHandler is handler to process MsgRecurringSwapCancel
+type RecurringSwapCancelHandler struct {
+	mgr Manager
+}
+
+// NewRecurringSwapCancelHandler create a new instance of RecurringSwapCancelHandler
+func NewRecurringSwapCancelHandler(mgr Manager) RecurringSwapCancelHandler {
+	return RecurringSwapCancelHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for RecurringSwapCancelHandler
+func (h RecurringSwapCancelHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgRecurringSwapCancel)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgRecurringSwapCancel failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgRecurringSwapCancel", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h RecurringSwapCancelHandler) validate(ctx cosmos.Context, msg MsgRecurringSwapCancel) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return msg.ValidateBasic()
+	default:
+		return errBadVersion
+	}
+}
+
+func (h RecurringSwapCancelHandler) handle(ctx cosmos.Context, msg MsgRecurringSwapCancel) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgRecurringSwapCancel, swaps already queued are left to
+// complete and the rest of the escrow is returned to the owner
+func (h RecurringSwapCancelHandler) handleV3_0_0(ctx cosmos.Context, msg MsgRecurringSwapCancel) error {
+	swp, err := h.mgr.Keeper().GetRecurringSwap(ctx, msg.TxID)
+	if err != nil {
+		return err
+	}
+	if !swp.Owner.Equals(msg.Signer) {
+		return fmt.Errorf("only the owner can cancel a recurring swap")
+	}
+	closeRecurringSwap(ctx, h.mgr, swp, RecurringSwapStatusCancelled, "")
+	return nil
+}
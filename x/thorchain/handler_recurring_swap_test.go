@@ -0,0 +1,381 @@
+package thorchain
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
+)
+
+type HandlerRecurringSwapSuite struct{}
+
+type TestRecurringSwapKeeper struct {
+	keeper.Keeper
+	failTradeUnit int // number of the next trade unit lookups to fail
+}
+
+func (k *TestRecurringSwapKeeper) GetTradeUnit(ctx cosmos.Context, asset common.Asset) (TradeUnit, error) {
+	if k.failTradeUnit > 0 {
+		k.failTradeUnit--
+		return TradeUnit{}, fmt.Errorf("kaboom")
+	}
+	return k.Keeper.GetTradeUnit(ctx, asset)
+}
+
+var _ = Suite(&HandlerRecurringSwapSuite{})
+
+func (s *HandlerRecurringSwapSuite) setup(c *C) (cosmos.Context, *Mgrs, cosmos.AccAddress) {
+	ctx, mgr := setupManagerForTest(c)
+	for _, asset := range []common.Asset{common.BTCAsset, common.ETHAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.Status = PoolAvailable
+		pool.BalanceRune = cosmos.NewUint(10000 * common.One)
+		pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+		c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	}
+	vault := GetRandomVault()
+	vault.Status = ActiveVault
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+
+	owner := GetRandomBech32Addr()
+	msg := NewMsgTradeAccountDeposit(common.BTCAsset, cosmos.NewUint(10*common.One), owner, owner, common.Tx{ID: GetRandomTxHash()})
+	_, err := NewTradeAccountDepositHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+	return ctx, mgr, owner
+}
+
+func (s *HandlerRecurringSwapSuite) TestValidate(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	h := NewRecurringSwapHandler(mgr)
+	source := common.BTCAsset.GetTradeAsset()
+	target := common.ETHAsset.GetTradeAsset()
+	dest := common.Address(owner.String())
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	// interval below the minimum
+	msg := NewMsgRecurringSwap(tx, source, target, dest, cosmos.NewUint(common.One), 1, 3, owner)
+	_, err := h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// quantity above the maximum
+	msg = NewMsgRecurringSwap(tx, source, target, dest, cosmos.NewUint(common.One), 10, 1_000_000, owner)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// target pool must exist
+	msg = NewMsgRecurringSwap(tx, source, common.DOGEAsset, GetRandomDOGEAddress(), cosmos.NewUint(common.One), 10, 3, owner)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// disabled
+	mgr.Keeper().SetMimir(ctx, constants.RecurringSwapsEnabled.String(), 0)
+	msg = NewMsgRecurringSwap(tx, source, target, dest, cosmos.NewUint(common.One), 10, 3, owner)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	mgr.Keeper().SetMimir(ctx, constants.RecurringSwapsEnabled.String(), 1)
+
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	swp, err := mgr.Keeper().GetRecurringSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Owner.Equals(owner), Equals, true)
+	c.Check(swp.NextHeight, Equals, ctx.BlockHeight())
+	hashes, err := mgr.Keeper().GetRecurringSwapHeight(ctx, ctx.BlockHeight())
+	c.Assert(err, IsNil)
+	c.Check(hashes, DeepEquals, common.TxIDs{tx.ID})
+
+	// cannot be created twice
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+}
+
+func (s *HandlerRecurringSwapSuite) TestTradeAccountTarget(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	source := common.BTCAsset.GetTradeAsset()
+	target := common.ETHAsset.GetTradeAsset()
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	msg := NewMsgRecurringSwap(tx, source, target, common.Address(owner.String()), cosmos.NewUint(4*common.One), 10, 2, owner)
+	_, err := NewRecurringSwapHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	// the committed amount is escrowed
+	swp, err := mgr.Keeper().GetRecurringSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "200000000")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, swp.GetEscrowAddress()).String(), Equals, "800000000")
+
+	// first swap is made in the same block
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	swp, err = mgr.Keeper().GetRecurringSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Count, Equals, uint64(1))
+	c.Check(swp.NextHeight, Equals, ctx.BlockHeight()+10)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "200000000")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, swp.GetEscrowAddress()).String(), Equals, "400000000")
+	ethBalance := mgr.TradeAccountManager().BalanceOf(ctx, target, owner)
+	c.Check(ethBalance.IsZero(), Equals, false)
+
+	fillID, err := swp.GetFillTxID(0)
+	c.Assert(err, IsNil)
+	fill, err := mgr.Keeper().GetRecurringSwapFill(ctx, fillID)
+	c.Assert(err, IsNil)
+	c.Check(fill.Status, Equals, RecurringSwapFillFilled, Commentf("%s", fill.Reason))
+	c.Check(fill.Out.Equal(ethBalance), Equals, true)
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, fillID, 0), Equals, false)
+
+	// nothing due until the next interval
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	swp, err = mgr.Keeper().GetRecurringSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Count, Equals, uint64(1))
+
+	// the last swap closes the recurring swap
+	ctx = ctx.WithBlockHeight(swp.NextHeight)
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().RecurringSwapExists(ctx, tx.ID), Equals, false)
+	c.Check(mgr.Keeper().RecurringSwapFillExists(ctx, fillID), Equals, false)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "200000000")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, swp.GetEscrowAddress()).IsZero(), Equals, true)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, target, owner).GT(ethBalance), Equals, true)
+}
+
+func (s *HandlerRecurringSwapSuite) TestLayer1Target(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	source := common.BTCAsset.GetTradeAsset()
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	msg := NewMsgRecurringSwap(tx, source, common.ETHAsset, GetRandomETHAddress(), cosmos.NewUint(2*common.One), 10, 3, owner)
+	_, err := NewRecurringSwapHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "400000000")
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Asset.Equals(common.ETHAsset), Equals, true)
+	c.Check(items[0].ToAddress.Equals(msg.Destination), Equals, true)
+
+	// a failed swap is credited back to the trade account, not refunded
+	pool, err := mgr.Keeper().GetPool(ctx, common.ETHAsset)
+	c.Assert(err, IsNil)
+	pool.Status = PoolStaged
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	mgr.TxOutStore().ClearOutboundItems(ctx)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "600000000")
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	swp, err := mgr.Keeper().GetRecurringSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Count, Equals, uint64(2))
+	fillID, err := swp.GetFillTxID(1)
+	c.Assert(err, IsNil)
+	fill, err := mgr.Keeper().GetRecurringSwapFill(ctx, fillID)
+	c.Assert(err, IsNil)
+	c.Check(fill.Status, Equals, RecurringSwapFillFailed)
+	c.Check(fill.Reason, Not(Equals), "")
+}
+
+func (s *HandlerRecurringSwapSuite) TestFailedCredit(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	k := &TestRecurringSwapKeeper{Keeper: mgr.Keeper()}
+	mgr.tradeManager = newTradeMgrVCUR(k, mgr.EventMgr())
+	source := common.BTCAsset.GetTradeAsset()
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	msg := NewMsgRecurringSwap(tx, source, common.ETHAsset, GetRandomETHAddress(), cosmos.NewUint(2*common.One), 10, 3, owner)
+	_, err := NewRecurringSwapHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+	swp, err := mgr.Keeper().GetRecurringSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Assert(queueRecurringSwapFill(ctx, mgr, &swp), IsNil)
+	fillID, err := swp.GetFillTxID(0)
+	c.Assert(err, IsNil)
+
+	// while the source pool is halted the swap fails, and if it cannot be
+	// credited back to the trade account it stays queued
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	haltKey := fmt.Sprintf(constants.MimirTemplatePoolHalt, common.BTCAsset.MimirString())
+	mgr.Keeper().SetMimir(ctx, haltKey, 1)
+	k.failTradeUnit = 1
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, fillID, 0), Equals, true)
+	fill, err := mgr.Keeper().GetRecurringSwapFill(ctx, fillID)
+	c.Assert(err, IsNil)
+	c.Check(fill.Status, Equals, RecurringSwapFillPending)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "400000000")
+
+	// once resumed it is swapped
+	mgr.Keeper().SetMimir(ctx, haltKey, 0)
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, fillID, 0), Equals, false)
+	fill, err = mgr.Keeper().GetRecurringSwapFill(ctx, fillID)
+	c.Assert(err, IsNil)
+	c.Check(fill.Status, Equals, RecurringSwapFillFilled, Commentf("%s", fill.Reason))
+}
+
+func (s *HandlerRecurringSwapSuite) TestAbandonedCredit(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	k := &TestRecurringSwapKeeper{Keeper: mgr.Keeper()}
+	mgr.tradeManager = newTradeMgrVCUR(k, mgr.EventMgr())
+	source := common.BTCAsset.GetTradeAsset()
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	msg := NewMsgRecurringSwap(tx, source, common.ETHAsset, GetRandomETHAddress(), cosmos.NewUint(2*common.One), 10, 3, owner)
+	_, err := NewRecurringSwapHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+	swp, err := mgr.Keeper().GetRecurringSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Assert(queueRecurringSwapFill(ctx, mgr, &swp), IsNil)
+	mgr.Keeper().SetRecurringSwap(ctx, swp)
+	fillID, err := swp.GetFillTxID(0)
+	c.Assert(err, IsNil)
+
+	haltKey := fmt.Sprintf(constants.MimirTemplatePoolHalt, common.BTCAsset.MimirString())
+	mgr.Keeper().SetMimir(ctx, haltKey, 1)
+	maxRetryBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.RecurringSwapFillMaxRetryBlocks)
+
+	// the swap is retried until the retry limit
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + maxRetryBlocks - 1)
+	k.failTradeUnit = 1
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, fillID, 0), Equals, true)
+	c.Check(mgr.Keeper().RecurringSwapExists(ctx, tx.ID), Equals, true)
+
+	// then it is abandoned, and the rest of the escrow returned to the owner
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.failTradeUnit = 1
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().HasSwapQueueItem(ctx, fillID, 0), Equals, false)
+	c.Check(mgr.Keeper().RecurringSwapExists(ctx, tx.ID), Equals, false)
+	c.Check(mgr.Keeper().RecurringSwapFillExists(ctx, fillID), Equals, false)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, swp.GetEscrowAddress()).IsZero(), Equals, true)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "800000000")
+}
+
+func (s *HandlerRecurringSwapSuite) TestInsufficientBalance(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	source := common.BTCAsset.GetTradeAsset()
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	// the trade account must cover every swap of the schedule
+	msg := NewMsgRecurringSwap(tx, source, common.RuneAsset(), common.Address(owner.String()), cosmos.NewUint(4*common.One), 10, 3, owner)
+	_, err := NewRecurringSwapHandler(mgr).Run(ctx, msg)
+	c.Assert(err, ErrorMatches, "insufficient trade account balance.*")
+	c.Check(mgr.Keeper().RecurringSwapExists(ctx, tx.ID), Equals, false)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).String(), Equals, "1000000000")
+}
+
+func (s *HandlerRecurringSwapSuite) TestRemainder(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	source := common.BTCAsset.GetTradeAsset()
+	target := common.ETHAsset.GetTradeAsset()
+	coins := common.NewCoins(common.NewCoin(source, cosmos.NewUint(10*common.One)))
+	tx := common.NewTx(GetRandomTxHash(), common.Address(owner.String()), GetRandomTHORAddress(), coins, common.Gas{}, "dca+:ETH~ETH::10:3")
+	tx.Chain = common.THORChain
+
+	m, err := processOneTxIn(ctx, mgr.Keeper(), ObservedTx{Tx: tx}, owner)
+	c.Assert(err, IsNil)
+	_, err = NewRecurringSwapHandler(mgr).Run(ctx, m)
+	c.Assert(err, IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).IsZero(), Equals, true)
+
+	for i := 0; i < 3; i++ {
+		c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	}
+	c.Check(mgr.Keeper().RecurringSwapExists(ctx, tx.ID), Equals, false)
+
+	// the last swap takes the remainder of the split, so nothing is left behind
+	swp := NewRecurringSwap(tx.ID, owner, source, target, common.Address(owner.String()), cosmos.NewUint(333333333), 10, 3, 0)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, swp.GetEscrowAddress()).IsZero(), Equals, true)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, source, owner).IsZero(), Equals, true)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, target, owner).IsZero(), Equals, false)
+
+	found := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != "trade_account_withdraw" {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if attr.Key == "amount" && attr.Value == "333333334" {
+				found = true
+			}
+		}
+	}
+	c.Check(found, Equals, true)
+}
+
+func (s *HandlerRecurringSwapSuite) TestCancel(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	tx := common.Tx{ID: GetRandomTxHash()}
+
+	msg := NewMsgRecurringSwap(tx, common.BTCAsset.GetTradeAsset(), common.ETHAsset.GetTradeAsset(), common.Address(owner.String()), cosmos.NewUint(common.One), 10, 3, owner)
+	_, err := NewRecurringSwapHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, common.BTCAsset.GetTradeAsset(), owner).String(), Equals, "700000000")
+
+	h := NewRecurringSwapCancelHandler(mgr)
+	_, err = h.Run(ctx, NewMsgRecurringSwapCancel(common.Tx{ID: GetRandomTxHash()}, GetRandomTxHash(), owner))
+	c.Assert(err, NotNil)
+
+	// only the owner can cancel
+	_, err = h.Run(ctx, NewMsgRecurringSwapCancel(common.Tx{ID: GetRandomTxHash()}, tx.ID, GetRandomBech32Addr()))
+	c.Assert(err, NotNil)
+
+	_, err = h.Run(ctx, NewMsgRecurringSwapCancel(common.Tx{ID: GetRandomTxHash()}, tx.ID, owner))
+	c.Assert(err, IsNil)
+	c.Check(mgr.Keeper().RecurringSwapExists(ctx, tx.ID), Equals, false)
+
+	// the cancelled recurring swap is skipped when due
+	c.Assert(mgr.SwapQ().EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, common.BTCAsset.GetTradeAsset(), owner).String(), Equals, "1000000000")
+
+	found := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != "recurring_swap" {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if attr.Key == "status" && attr.Value == RecurringSwapStatusCancelled {
+				found = true
+			}
+		}
+	}
+	c.Check(found, Equals, true)
+}
+
+func (s *HandlerRecurringSwapSuite) TestMemo(c *C) {
+	ctx, mgr, owner := s.setup(c)
+	from := common.Address(owner.String())
+	coins := common.NewCoins(common.NewCoin(common.BTCAsset.GetTradeAsset(), cosmos.NewUint(9*common.One)))
+	tx := common.NewTx(GetRandomTxHash(), from, GetRandomTHORAddress(), coins, common.Gas{}, "dca+:ETH~ETH::10:3")
+	tx.Chain = common.THORChain
+
+	m, err := processOneTxIn(ctx, mgr.Keeper(), ObservedTx{Tx: tx}, owner)
+	c.Assert(err, IsNil)
+	msg, ok := m.(*MsgRecurringSwap)
+	c.Assert(ok, Equals, true)
+	c.Check(msg.TargetAsset.Equals(common.ETHAsset.GetTradeAsset()), Equals, true)
+	c.Check(msg.Destination.Equals(from), Equals, true)
+	c.Check(msg.Amount.String(), Equals, "300000000")
+
+	tx.Memo = "dca+:ETH~ETH::10:0"
+	_, err = processOneTxIn(ctx, mgr.Keeper(), ObservedTx{Tx: tx}, owner)
+	c.Assert(err, NotNil)
+}
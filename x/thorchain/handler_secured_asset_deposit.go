@@ -69,6 +69,10 @@ func (h SecuredAssetDepositHandler) validateV3_0_0(ctx cosmos.Context, msg MsgSe
 		return fmt.Errorf("%s secured deposits more than bond", msg.Asset)
 	}
 
+	if h.mgr.Keeper().IsPoolTradingHalted(ctx, msg.Asset) {
+		return fmt.Errorf("pool is halted for asset (%s)", msg.Asset.GetLayer1Asset())
+	}
+
 	return msg.ValidateBasic()
 }
 
@@ -58,6 +58,10 @@ func (h SecuredAssetWithdrawHandler) validateV3_0_0(ctx cosmos.Context, msg MsgS
 		return fmt.Errorf("%s secured asset withdrawals are disabled", msg.Asset.Chain)
 	}
 
+	if h.mgr.Keeper().IsPoolTradingHalted(ctx, msg.Asset) {
+		return fmt.Errorf("pool is halted for asset (%s)", msg.Asset.GetLayer1Asset())
+	}
+
 	return msg.ValidateBasic()
 }
 
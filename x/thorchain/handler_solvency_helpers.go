@@ -3,6 +3,7 @@ package thorchain
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -119,16 +120,44 @@ func processSolvencyAttestation(
 		return nil
 	}
 
-	isInsolvent := insolvencyCheck(ctx, mgr, vault, voter.Coins, voter.Chain)
+	insolventAssets := insolvencyCheck(ctx, mgr, vault, voter.Coins, voter.Chain)
+	isInsolvent := len(insolventAssets) > 0
+
+	// If only tokens are insolvent and SolvencyHaltPools is enabled, halt the pools of
+	// the insolvent tokens instead of the chain.
+	haltPools, err := k.GetMimir(ctx, constants.MimirKeySolvencyHaltPools)
+	if err != nil {
+		ctx.Logger().Error("fail to get mimir", "key", constants.MimirKeySolvencyHaltPools, "error", err)
+	}
+	if isInsolvent && haltPools > 0 && !slices.ContainsFunc(insolventAssets, common.Asset.IsGasAsset) {
+		for _, asset := range insolventAssets {
+			haltPoolKey := fmt.Sprintf(constants.MimirTemplateSolvencyPoolHalt, asset.MimirString())
+			if haltPool, _ := k.GetMimir(ctx, haltPoolKey); haltPool <= 0 {
+				setSolvencyMimir(ctx, mgr, haltPoolKey, ctx.BlockHeight())
+				ctx.Logger().Info("asset is insolvent, halt pool until it is resolved", "asset", asset)
+			}
+		}
+		isInsolvent = false
+	}
+
+	// Unhalt the pools of solvent assets halted from an earlier block height, the same
+	// as the chain below.
+	for _, coin := range vault.Coins {
+		if !coin.Asset.Chain.Equals(voter.Chain) || slices.ContainsFunc(insolventAssets, coin.Asset.Equals) {
+			continue
+		}
+		haltPoolKey := fmt.Sprintf(constants.MimirTemplateSolvencyPoolHalt, coin.Asset.MimirString())
+		haltPool, _ := k.GetMimir(ctx, haltPoolKey)
+		if haltPool > 1 && haltPool < ctx.BlockHeight() {
+			ctx.Logger().Info("auto un-halt", "asset", coin.Asset, "previous halt height", haltPool, "current block height", ctx.BlockHeight())
+			setSolvencyMimir(ctx, mgr, haltPoolKey, 0)
+		}
+	}
 
 	// If insolvent and already halted, leave the Mimir key unchanged as a record of since when it's been insolvent.
 	// If insolvent and unhalted, halt the chain.
 	if isInsolvent && haltChain <= 0 {
-		k.SetMimir(ctx, haltChainKey, ctx.BlockHeight())
-		mimirEvent := NewEventSetMimir(strings.ToUpper(haltChainKey), strconv.FormatInt(ctx.BlockHeight(), 10))
-		if err := mgr.EventMgr().EmitEvent(ctx, mimirEvent); err != nil {
-			ctx.Logger().Error("fail to emit set_mimir event", "error", err)
-		}
+		setSolvencyMimir(ctx, mgr, haltChainKey, ctx.BlockHeight())
 		ctx.Logger().Info("chain is insolvent, halt until it is resolved", "chain", voter.Chain)
 	}
 
@@ -138,29 +167,35 @@ func processSolvencyAttestation(
 	if !isInsolvent && haltChain > 1 {
 		// if the chain was halted by previous solvency checker, auto unhalt it
 		ctx.Logger().Info("auto un-halt", "chain", voter.Chain, "previous halt height", haltChain, "current block height", ctx.BlockHeight())
-		k.SetMimir(ctx, haltChainKey, 0)
-		mimirEvent := NewEventSetMimir(strings.ToUpper(haltChainKey), "0")
-		if err := mgr.EventMgr().EmitEvent(ctx, mimirEvent); err != nil {
-			ctx.Logger().Error("fail to emit set_mimir event", "error", err)
-		}
+		setSolvencyMimir(ctx, mgr, haltChainKey, 0)
 	}
 
 	return nil
 }
 
+// setSolvencyMimir sets a mimir halt key on behalf of the solvency checker
+func setSolvencyMimir(ctx cosmos.Context, mgr Manager, key string, value int64) {
+	mgr.Keeper().SetMimir(ctx, key, value)
+	mimirEvent := NewEventSetMimir(strings.ToUpper(key), strconv.FormatInt(value, 10))
+	if err := mgr.EventMgr().EmitEvent(ctx, mimirEvent); err != nil {
+		ctx.Logger().Error("fail to emit set_mimir event", "error", err)
+	}
+}
+
 // insolvencyCheck compare the coins in vault against the coins report by solvency message
 // insolvent usually means vault has more coins than wallet
-// return true means the vault is insolvent , the network should halt , otherwise false
-func insolvencyCheck(ctx cosmos.Context, mgr Manager, vault Vault, coins common.Coins, chain common.Chain) bool {
+// return the insolvent assets, the network should halt if any are returned
+func insolvencyCheck(ctx cosmos.Context, mgr Manager, vault Vault, coins common.Coins, chain common.Chain) []common.Asset {
 	adjustVault, err := excludePendingOutboundFromVault(ctx, mgr, vault)
 	if err != nil {
-		return false
+		return nil
 	}
 	permittedSolvencyGap, err := mgr.Keeper().GetMimir(ctx, constants.PermittedSolvencyGap.String())
 	if err != nil || permittedSolvencyGap <= 0 {
 		permittedSolvencyGap = mgr.GetConstants().GetInt64Value(constants.PermittedSolvencyGap)
 	}
 	// Use the coin in vault as baseline , wallet can have more coins than vault
+	var insolvent []common.Asset
 	for _, c := range adjustVault.Coins {
 		if !c.Asset.Chain.Equals(chain) {
 			continue
@@ -171,7 +206,8 @@ func insolvencyCheck(ctx cosmos.Context, mgr Manager, vault Vault, coins common.
 		walletCoin := coins.GetCoin(c.Asset)
 		if walletCoin.IsEmpty() {
 			ctx.Logger().Info("asset exist in vault , but not in wallet, insolvent", "asset", c.Asset.String(), "amount", c.Amount.String())
-			return true
+			insolvent = append(insolvent, c.Asset)
+			continue
 		}
 		if c.Asset.IsGasAsset() {
 			gas, err := mgr.GasMgr().GetMaxGas(ctx, c.Asset.GetChain())
@@ -188,11 +224,11 @@ func insolvencyCheck(ctx cosmos.Context, mgr Manager, vault Vault, coins common.
 			permittedGap := walletCoin.Amount.MulUint64(uint64(permittedSolvencyGap)).QuoUint64(10000)
 			if gap.GT(permittedGap) {
 				ctx.Logger().Info("vault has more asset than wallet, insolvent", "asset", c.Asset.String(), "vault amount", c.Amount.String(), "wallet amount", walletCoin.Amount.String(), "gap", gap.String())
-				return true
+				insolvent = append(insolvent, c.Asset)
 			}
 		}
 	}
-	return false
+	return insolvent
 }
 
 func excludePendingOutboundFromVault(ctx cosmos.Context, mgr Manager, vault Vault) (Vault, error) {
@@ -243,3 +243,70 @@ func (s *HandlerSolvencyTestSuite) TestObservingSlashing(c *C) {
 
 	// Note that nas[6], the Standby node, remains unaffected by the Actives nodes' observations.
 }
+
+func (s *HandlerSolvencyTestSuite) TestSolvencyHaltPools(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(1024)
+	handler := NewSolvencyHandler(mgr)
+
+	var activeNodes [3]NodeAccount
+	for i := range activeNodes {
+		activeNodes[i] = GetRandomValidatorNode(NodeActive)
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, activeNodes[i]), IsNil)
+	}
+
+	usdc, err := common.NewAsset("ETH.USDC-0XA0B86991C6218B36C1D19D4A2E9EB0CE3606EB48")
+	c.Assert(err, IsNil)
+	asgard := GetRandomVault()
+	asgard.AddFunds(common.NewCoins(
+		common.NewCoin(common.ETHAsset, cosmos.NewUint(1000*common.One)),
+		common.NewCoin(usdc, cosmos.NewUint(1000*common.One)),
+	))
+	c.Assert(mgr.Keeper().SetVault(ctx, asgard), IsNil)
+	mgr.Keeper().SetMimir(ctx, constants.MimirKeySolvencyHaltPools, 1)
+
+	report := func(usdcAmount uint64) {
+		msg, err := NewMsgSolvency(common.ETHChain, asgard.PubKey, common.NewCoins(
+			common.NewCoin(common.ETHAsset, cosmos.NewUint(1000*common.One)),
+			common.NewCoin(usdc, cosmos.NewUint(usdcAmount*common.One)),
+		), ctx.BlockHeight(), activeNodes[0].NodeAddress)
+		c.Assert(err, IsNil)
+		for _, node := range activeNodes {
+			msg.Signer = node.NodeAddress
+			_, err = handler.Run(ctx, msg)
+			c.Assert(err, IsNil)
+		}
+	}
+
+	// only the token is insolvent, so only its pool is halted
+	report(100)
+	haltPoolKey := fmt.Sprintf(constants.MimirTemplateSolvencyPoolHalt, usdc.MimirString())
+	halt, err := mgr.Keeper().GetMimir(ctx, haltPoolKey)
+	c.Assert(err, IsNil)
+	c.Check(halt, Equals, ctx.BlockHeight())
+	halt, err = mgr.Keeper().GetMimir(ctx, "SolvencyHaltETHChain")
+	c.Assert(err, IsNil)
+	c.Check(halt, Equals, int64(-1))
+	c.Check(mgr.Keeper().IsPoolTradingHalted(ctx, usdc), Equals, true)
+	c.Check(mgr.Keeper().IsPoolTradingHalted(ctx, common.ETHAsset), Equals, false)
+	c.Check(mgr.Keeper().IsChainTradingHalted(ctx, common.ETHChain), Equals, false)
+
+	// the pool is unhalted once solvent
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	report(1000)
+	halt, err = mgr.Keeper().GetMimir(ctx, haltPoolKey)
+	c.Assert(err, IsNil)
+	c.Check(halt, Equals, int64(0))
+	c.Check(mgr.Keeper().IsPoolTradingHalted(ctx, usdc), Equals, false)
+
+	// without SolvencyHaltPools the chain is halted
+	mgr.Keeper().SetMimir(ctx, constants.MimirKeySolvencyHaltPools, 0)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	report(100)
+	halt, err = mgr.Keeper().GetMimir(ctx, "SolvencyHaltETHChain")
+	c.Assert(err, IsNil)
+	c.Check(halt, Equals, ctx.BlockHeight())
+	halt, err = mgr.Keeper().GetMimir(ctx, haltPoolKey)
+	c.Assert(err, IsNil)
+	c.Check(halt, Equals, int64(0))
+}
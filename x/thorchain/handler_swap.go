@@ -124,6 +124,12 @@ func (h SwapHandler) validateV3_0_0(ctx cosmos.Context, msg MsgSwap) error {
 		return errors.New("swapping to a secured asset of a native coin is not allowed")
 	}
 
+	if len(msg.Route) > 0 {
+		if err := h.validateRoute(ctx, msg); err != nil {
+			return err
+		}
+	}
+
 	var sourceCoin common.Coin
 	if len(msg.Tx.Coins) > 0 {
 		sourceCoin = msg.Tx.Coins[0]
@@ -251,6 +257,31 @@ func (h SwapHandler) validateV3_0_0(ctx cosmos.Context, msg MsgSwap) error {
 	return nil
 }
 
+// validateRoute checks the intermediate assets of an explicit swap route can be
+// swapped through, the pool of each must exist and be available
+func (h SwapHandler) validateRoute(ctx cosmos.Context, msg MsgSwap) error {
+	maxLength := h.mgr.Keeper().GetConfigInt64(ctx, constants.SwapRouteMaxLength)
+	if int64(len(msg.Route)) > maxLength {
+		return fmt.Errorf("swap route has %d assets, maximum allowed is %d", len(msg.Route), maxLength)
+	}
+	for _, hop := range msg.Route {
+		if hop.IsDerivedAsset() && h.mgr.Keeper().GetConfigInt64(ctx, constants.EnableDerivedAssets) == 0 {
+			return fmt.Errorf("swapping through a derived asset (%s) is not allowed", hop)
+		}
+		if hop.IsTradeAsset() && h.mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsEnabled) <= 0 {
+			return fmt.Errorf("trade accounts are disabled, can't swap through %s", hop)
+		}
+		pool, err := h.mgr.Keeper().GetPool(ctx, hop.GetLayer1Asset())
+		if err != nil {
+			return ErrInternal(err, "fail to get pool")
+		}
+		if pool.IsEmpty() || !pool.IsAvailable() {
+			return fmt.Errorf("pool %s is not available for swap route", hop.GetLayer1Asset())
+		}
+	}
+	return nil
+}
+
 func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap) (*cosmos.Result, error) {
 	ctx.Logger().Info("receive MsgSwap", "request tx hash", msg.Tx.ID, "source asset", msg.Tx.Coins[0].Asset, "target asset", msg.TargetAsset, "signer", msg.Signer.String())
 	version := h.mgr.GetVersion()
@@ -326,12 +357,19 @@ func (h SwapHandler) handleV3_0_0(ctx cosmos.Context, msg MsgSwap) (*cosmos.Resu
 		msg.Tx.Coins[0].Amount, msg.TradeTarget = swp.NextSize()
 	}
 
+	// split-destination outbounds are added below, once per recipient
+	destination := msg.Destination
+	if msg.HasSplitDestinations() {
+		destination = common.NoopAddress
+	}
+
 	emit, _, swapErr := swapper.Swap(
 		ctx,
 		h.mgr.Keeper(),
 		msg.Tx,
 		msg.TargetAsset,
-		msg.Destination,
+		msg.Route,
+		destination,
 		msg.TradeTarget,
 		dexAgg,
 		dexAggTargetAsset,
@@ -386,6 +424,25 @@ func (h SwapHandler) handleV3_0_0(ctx cosmos.Context, msg MsgSwap) (*cosmos.Resu
 		}
 	}
 
+	// streaming swap outbounds are handled in the swap queue manager
+	if msg.HasSplitDestinations() && !msg.IsStreaming() {
+		toi := TxOutItem{
+			Chain:  msg.TargetAsset.GetChain(),
+			InHash: msg.Tx.ID,
+			Coin:   common.NewCoin(msg.TargetAsset, emit),
+		}
+		minOuts := msg.GetSplitDestinationAmounts(msg.TradeTarget)
+		for i, item := range getSplitDestinationTxOutItems(msg, toi) {
+			ok, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, item, minOuts[i])
+			if err != nil {
+				return nil, ErrInternal(err, "fail to add outbound tx")
+			}
+			if !ok {
+				return nil, errFailAddOutboundTx
+			}
+		}
+	}
+
 	if msg.IsStreaming() {
 		// only increment In/Out if we have a successful swap
 		swp.In = swp.In.Add(msg.Tx.Coins[0].Amount)
@@ -400,6 +457,13 @@ func (h SwapHandler) handleV3_0_0(ctx cosmos.Context, msg MsgSwap) (*cosmos.Resu
 		emit = swp.Out
 	}
 
+	// this is a recurring swap, record its output
+	if h.mgr.Keeper().RecurringSwapFillExists(ctx, msg.Tx.ID) {
+		if err := settleRecurringSwapFill(ctx, h.mgr, msg, emit, nil); err != nil {
+			ctx.Logger().Error("fail to settle recurring swap", "error", err)
+		}
+	}
+
 	// this is a preferred asset swap, so return early since there is no need to call any
 	// downstream handlers
 	memo := msg.Tx.Memo
@@ -500,3 +564,22 @@ func (h SwapHandler) getTotalLiquidityRUNE(ctx cosmos.Context) (cosmos.Uint, err
 	}
 	return total, nil
 }
+
+// getSplitDestinationTxOutItems divides the coin of the given outbound between
+// the split destinations of the swap, one outbound per recipient. They are
+// marked as split destination outbounds, so they can be signed as one tx.
+func getSplitDestinationTxOutItems(msg MsgSwap, toi TxOutItem) []TxOutItem {
+	amounts := msg.GetSplitDestinationAmounts(toi.Coin.Amount)
+	items := make([]TxOutItem, 0, len(msg.Destinations))
+	for i, dest := range msg.Destinations {
+		if amounts[i].IsZero() {
+			continue
+		}
+		item := toi
+		item.ToAddress = dest
+		item.Coin = common.NewCoin(toi.Coin.Asset, amounts[i])
+		item.SplitDestination = true
+		items = append(items, item)
+	}
+	return items
+}
@@ -380,6 +380,108 @@ func (s *HandlerSwapSuite) TestHandle(c *C) {
 	keeper.haltChain = 0
 }
 
+func (s *HandlerSwapSuite) TestHandleSplitDestinations(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewSwapHandler(mgr)
+
+	pool := NewPool()
+	pool.Asset = common.DOGEAsset
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	doge1 := GetRandomDOGEAddress()
+	doge2 := GetRandomDOGEAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomTHORAddress(),
+		GetRandomTHORAddress(),
+		common.Coins{
+			common.NewCoin(common.RuneAsset(), cosmos.NewUint(2*common.One)),
+		},
+		common.Gas{
+			common.NewCoin(common.RuneNative, cosmos.NewUint(2000000)),
+		},
+		"",
+	)
+	msg := NewMsgSwap(tx, common.DOGEAsset, doge1, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketSwap, 0, 0, GetRandomBech32Addr())
+	msg.Destinations = []common.Address{doge1, doge2}
+	msg.DestinationsBasisPoints = []cosmos.Uint{cosmos.NewUint(2500), cosmos.NewUint(7500)}
+
+	_, err := handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].ToAddress.Equals(doge1), Equals, true)
+	c.Check(items[1].ToAddress.Equals(doge2), Equals, true)
+	c.Check(items[0].InHash.Equals(tx.ID), Equals, true)
+	c.Check(items[1].InHash.Equals(tx.ID), Equals, true)
+	c.Check(items[0].SplitDestination, Equals, true)
+	c.Check(items[1].SplitDestination, Equals, true)
+	total := items[0].Coin.Amount.Add(items[1].Coin.Amount)
+	c.Check(total.Uint64(), Equals, uint64(192233756))
+	c.Check(items[0].Coin.Amount.Uint64(), Equals, total.QuoUint64(4).Uint64())
+}
+
+func (s *HandlerSwapSuite) TestHandleSwapRoute(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewSwapHandler(mgr)
+
+	for _, asset := range []common.Asset{common.DOGEAsset, common.BTCAsset} {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+		pool.BalanceRune = cosmos.NewUint(100 * common.One)
+		c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	}
+
+	dogeAddr := GetRandomDOGEAddress()
+	newMsg := func() *MsgSwap {
+		tx := common.NewTx(
+			GetRandomTxHash(),
+			GetRandomTHORAddress(),
+			GetRandomTHORAddress(),
+			common.Coins{
+				common.NewCoin(common.RuneAsset(), cosmos.NewUint(2*common.One)),
+			},
+			common.Gas{
+				common.NewCoin(common.RuneNative, cosmos.NewUint(2000000)),
+			},
+			"",
+		)
+		msg := NewMsgSwap(tx, common.DOGEAsset, dogeAddr, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketSwap, 0, 0, GetRandomBech32Addr())
+		msg.Route = []common.Asset{common.BTCAsset}
+		return msg
+	}
+
+	// route through the btc pool
+	_, err := handler.Run(ctx, newMsg())
+	c.Assert(err, IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].ToAddress.Equals(dogeAddr), Equals, true)
+	c.Check(items[0].Coin.Asset.Equals(common.DOGEAsset), Equals, true)
+	btcPool, err := mgr.Keeper().GetPool(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(btcPool.BalanceRune.Equal(cosmos.NewUint(100*common.One)), Equals, false)
+
+	// route longer than the mimir limit
+	mgr.Keeper().SetMimir(ctx, constants.SwapRouteMaxLength.String(), 0)
+	_, err = handler.Run(ctx, newMsg())
+	c.Assert(err, NotNil)
+	mgr.Keeper().SetMimir(ctx, constants.SwapRouteMaxLength.String(), 3)
+
+	// hop slip above the mimir limit
+	mgr.Keeper().SetMimir(ctx, constants.SwapRouteMaxHopSlipBps.String(), 1)
+	_, err = handler.Run(ctx, newMsg())
+	c.Assert(err, NotNil)
+	c.Check(strings.Contains(err.Error(), "exceeds limit"), Equals, true)
+}
+
 func (s *HandlerSwapSuite) TestHandleStreamingSwap(c *C) {
 	var err error
 	ctx, mgr := setupManagerForTest(c)
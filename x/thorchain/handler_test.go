@@ -34,6 +34,8 @@ import (
 	"gitlab.com/thorchain/thornode/v3/constants"
 	thorlog "gitlab.com/thorchain/thornode/v3/log"
 
+	denomkeeper "gitlab.com/thorchain/thornode/v3/x/denom/keeper"
+	denomtypes "gitlab.com/thorchain/thornode/v3/x/denom/types"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
 	kv1 "gitlab.com/thorchain/thornode/v3/x/thorchain/keeper/v1"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/types"
@@ -95,6 +97,7 @@ func setupManagerForTest(c *C) (cosmos.Context, *Mgrs) {
 	keyBank := cosmos.NewKVStoreKey(banktypes.StoreKey)
 	keyUpgrade := cosmos.NewKVStoreKey(upgradetypes.StoreKey)
 	keyWasm := cosmos.NewKVStoreKey(wasmtypes.StoreKey)
+	keyDenom := cosmos.NewKVStoreKey(denomtypes.StoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db, sdklog.NewNopLogger(), storemetrics.NewNoOpMetrics())
@@ -102,6 +105,7 @@ func setupManagerForTest(c *C) (cosmos.Context, *Mgrs) {
 	ms.MountStoreWithDB(keyThorchain, cosmos.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyBank, cosmos.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyWasm, cosmos.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyDenom, cosmos.StoreTypeIAVL, db)
 
 	err := ms.LoadLatestVersion()
 	c.Assert(err, IsNil)
@@ -128,6 +132,7 @@ func setupManagerForTest(c *C) (cosmos.Context, *Mgrs) {
 			types.RUNEPoolName:           {},
 			types.TCYStakeName:           {},
 			types.TCYClaimingName:        {},
+			denomtypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		},
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
 		sdk.GetConfig().GetBech32AccountAddrPrefix(),
@@ -169,6 +174,13 @@ func setupManagerForTest(c *C) (cosmos.Context, *Mgrs) {
 		nil,
 		authtypes.NewModuleAddress(ModuleName).String(),
 	)
+	dk := denomkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keyDenom),
+		ak,
+		bk.WithMintCoinsRestriction(denomtypes.NewDenomMintCoinsRestriction()),
+		authtypes.NewModuleAddress(ModuleName).String(),
+	)
 	k := kv1.NewKeeper(encodingConfig.Codec, serviceThorchain, bk, ak, uk)
 	FundModule(c, ctx, k, ModuleName, 10_000*common.One)
 	FundModule(c, ctx, k, AsgardName, 100_000_000*common.One)
@@ -192,7 +204,7 @@ func setupManagerForTest(c *C) (cosmos.Context, *Mgrs) {
 	}), IsNil)
 
 	os.Setenv("NET", "mocknet")
-	mgr := NewManagers(k, encodingConfig.Codec, serviceThorchain, bk, ak, uk, wk)
+	mgr := NewManagers(k, encodingConfig.Codec, serviceThorchain, bk, ak, uk, wk, dk)
 	constants.SWVersion = GetCurrentVersion()
 
 	_, hasVerStored := k.GetVersionWithCtx(ctx)
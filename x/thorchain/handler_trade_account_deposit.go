@@ -55,6 +55,9 @@ func (h TradeAccountDepositHandler) validateV3_0_0(ctx cosmos.Context, msg MsgTr
 	if tradeAccountsEnabled <= 0 || tradeAccountsDespositEnabled <= 0 {
 		return fmt.Errorf("trade accounts are disabled")
 	}
+	if h.mgr.Keeper().IsPoolTradingHalted(ctx, msg.Asset) {
+		return fmt.Errorf("pool is halted for asset (%s)", msg.Asset.GetLayer1Asset())
+	}
 	return msg.ValidateBasic()
 }
 
@@ -24,4 +24,14 @@ func (HandlerTradeAccountDeposit) TestTradeAccountDeposit(c *C) {
 
 	bal := mgr.TradeAccountManager().BalanceOf(ctx, asset, addr)
 	c.Check(bal.String(), Equals, "350")
+
+	// deposits are rejected while the pool is halted, but internal credits,
+	// such as refunds, still go through the manager
+	mgr.Keeper().SetMimir(ctx, "HaltPool-BTC-BTC", 1)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, ErrorMatches, "pool is halted for asset.*")
+	_, err = mgr.TradeAccountManager().Deposit(ctx, asset, cosmos.NewUint(50), addr, common.NoAddress, dummyTx.ID)
+	c.Assert(err, IsNil)
+	bal = mgr.TradeAccountManager().BalanceOf(ctx, asset, addr)
+	c.Check(bal.String(), Equals, "400")
 }
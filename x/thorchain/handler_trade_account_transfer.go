@@ -0,0 +1,114 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+// TradeAccountTransferHandler is handler to process MsgTradeAccountTransfer
+type TradeAccountTransferHandler struct {
+	mgr Manager
+}
+
+// NewTradeAccountTransferHandler create a new instance of TradeAccountTransferHandler
+func NewTradeAccountTransferHandler(mgr Manager) TradeAccountTransferHandler {
+	return TradeAccountTransferHandler{
+		mgr: mgr,
+	}
+}
+
+// Run is the main entry point for TradeAccountTransferHandler
+func (h TradeAccountTransferHandler) Run(ctx cosmos.Context, m cosmos.Msg) (*cosmos.Result, error) {
+	msg, ok := m.(*MsgTradeAccountTransfer)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, *msg); err != nil {
+		ctx.Logger().Error("MsgTradeAccountTransfer failed validation", "error", err)
+		return nil, err
+	}
+	err := h.handle(ctx, *msg)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgTradeAccountTransfer", "error", err)
+	}
+	return &cosmos.Result{}, err
+}
+
+func (h TradeAccountTransferHandler) validate(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.validateV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+func (h TradeAccountTransferHandler) validateV3_0_0(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	tradeAccountsEnabled := h.mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsEnabled)
+	if tradeAccountsEnabled <= 0 {
+		return fmt.Errorf("trade accounts are disabled")
+	}
+
+	m, err := h.mgr.Keeper().GetMimir(ctx, constants.MimirKeyTradeAccountHaltTransfer)
+	if err != nil {
+		return err
+	}
+	if m > 0 && m <= ctx.BlockHeight() {
+		return fmt.Errorf("trade account transfers are disabled")
+	}
+
+	m, err = h.mgr.Keeper().GetMimirWithRef(ctx, constants.MimirTemplateTradeAccountHaltTransfer, msg.Asset.Chain.String())
+	if err != nil {
+		return err
+	}
+	if m > 0 && m <= ctx.BlockHeight() {
+		return fmt.Errorf("%s trade account transfers are disabled", msg.Asset.Chain)
+	}
+
+	if h.isLayer1Deposit(msg) {
+		if h.mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsDepositEnabled) <= 0 {
+			return fmt.Errorf("trade account deposits are disabled")
+		}
+		if h.mgr.Keeper().IsPoolTradingHalted(ctx, msg.Asset) {
+			return fmt.Errorf("pool is halted for asset (%s)", msg.Asset.GetLayer1Asset())
+		}
+	}
+
+	return msg.ValidateBasic()
+}
+
+func (h TradeAccountTransferHandler) handle(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	version := h.mgr.GetVersion()
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return h.handleV3_0_0(ctx, msg)
+	default:
+		return errBadVersion
+	}
+}
+
+// handle process MsgTradeAccountTransfer
+func (h TradeAccountTransferHandler) handleV3_0_0(ctx cosmos.Context, msg MsgTradeAccountTransfer) error {
+	amount := msg.Amount
+	if h.isLayer1Deposit(msg) {
+		var err error
+		amount, err = h.mgr.TradeAccountManager().Deposit(ctx, msg.Asset, msg.Amount, msg.Signer, msg.Tx.FromAddress, msg.Tx.ID)
+		if err != nil {
+			return fmt.Errorf("fail to credit trade account: %w", err)
+		}
+	}
+	_, err := h.mgr.TradeAccountManager().Transfer(ctx, msg.Asset, amount, msg.Signer, msg.ToAddress, msg.Tx.ID)
+	return err
+}
+
+// isLayer1Deposit returns true if the transfer was sent from another chain, in
+// which case the inbound layer1 asset is credited to the sender's trade account
+// before it is transferred. A failed transfer is refunded as a whole.
+func (h TradeAccountTransferHandler) isLayer1Deposit(msg MsgTradeAccountTransfer) bool {
+	return !msg.Tx.Coins.GetCoin(msg.Asset.GetLayer1Asset()).IsEmpty()
+}
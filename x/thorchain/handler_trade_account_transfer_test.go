@@ -0,0 +1,157 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+	. "gopkg.in/check.v1"
+)
+
+type HandlerTradeAccountTransfer struct{}
+
+var _ = Suite(&HandlerTradeAccountTransfer{})
+
+func (HandlerTradeAccountTransfer) TestTradeAccountTransfer(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	asset := common.BTCAsset
+	from := GetRandomBech32Addr()
+	to := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	{
+		msg := NewMsgTradeAccountDeposit(asset, cosmos.NewUint(500), from, from, dummyTx)
+		_, err := NewTradeAccountDepositHandler(mgr).Run(ctx, msg)
+		c.Assert(err, IsNil)
+
+		msg = NewMsgTradeAccountDeposit(asset, cosmos.NewUint(100), to, to, dummyTx)
+		_, err = NewTradeAccountDepositHandler(mgr).Run(ctx, msg)
+		c.Assert(err, IsNil)
+	}
+
+	h := NewTradeAccountTransferHandler(mgr)
+	msg := NewMsgTradeAccountTransfer(asset.GetTradeAsset(), cosmos.NewUint(350), to, from, dummyTx)
+	_, err := h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, from).String(), Equals, "150")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, to).String(), Equals, "450")
+
+	// trade unit totals are untouched by a transfer
+	tu, err := mgr.Keeper().GetTradeUnit(ctx, asset.GetTradeAsset())
+	c.Assert(err, IsNil)
+	c.Check(tu.Units.String(), Equals, "600")
+	c.Check(tu.Depth.String(), Equals, "600")
+
+	// transfers are capped at the sender's balance
+	msg = NewMsgTradeAccountTransfer(asset.GetTradeAsset(), cosmos.NewUint(1000), to, from, dummyTx)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, from).String(), Equals, "0")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, to).String(), Equals, "600")
+
+	// nothing left to transfer
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	for _, route := range mgr.Keeper().InvariantRoutes() {
+		if route.Route != "trade_accounts" {
+			continue
+		}
+		msgs, broken := route.Invariant(ctx)
+		c.Check(broken, Equals, false, Commentf("%v", msgs))
+	}
+
+	// halted globally
+	msg = NewMsgTradeAccountTransfer(asset.GetTradeAsset(), cosmos.NewUint(100), from, to, dummyTx)
+	mgr.Keeper().SetMimir(ctx, constants.MimirKeyTradeAccountHaltTransfer, 1)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	mgr.Keeper().SetMimir(ctx, constants.MimirKeyTradeAccountHaltTransfer, 0)
+
+	// halted for the asset chain
+	mgr.Keeper().SetMimir(ctx, "HaltTradeTransfer-BTC", 1)
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, NotNil)
+	mgr.Keeper().SetMimir(ctx, "HaltTradeTransfer-BTC", 0)
+
+	_, err = h.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, from).String(), Equals, "100")
+}
+
+func (HandlerTradeAccountTransfer) TestTradeAccountTransferMemo(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	asset := common.BTCAsset
+	from := GetRandomBech32Addr()
+	to := GetRandomBech32Addr()
+
+	msg := NewMsgTradeAccountDeposit(asset, cosmos.NewUint(500), from, from, common.Tx{ID: "test"})
+	_, err := NewTradeAccountDepositHandler(mgr).Run(ctx, msg)
+	c.Assert(err, IsNil)
+
+	coins := common.Coins{common.NewCoin(asset.GetTradeAsset(), cosmos.NewUint(200))}
+	deposit := NewMsgDeposit(coins, fmt.Sprintf("trade>:%s", to), from)
+	_, err = NewDepositHandler(mgr).Run(ctx, deposit)
+	c.Assert(err, IsNil)
+
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, from).String(), Equals, "300")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, asset, to).String(), Equals, "200")
+}
+
+func (HandlerTradeAccountTransfer) TestTradeAccountTransferMemoL1(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	to := GetRandomBech32Addr()
+	from := GetRandomETHAddress()
+	sender, err := from.MappedAccAddress()
+	c.Assert(err, IsNil)
+
+	// a layer1 inbound is transferred from the trade account of the sender
+	coins := common.Coins{common.NewCoin(common.ETHAsset, cosmos.NewUint(200))}
+	tx := common.NewTx(GetRandomTxHash(), from, GetRandomETHAddress(), coins, common.Gas{}, fmt.Sprintf("trade>:%s", to))
+	na := GetRandomValidatorNode(NodeActive)
+	m, err := processOneTxIn(ctx, mgr.Keeper(), NewObservedTx(tx, 1, GetRandomPubKey(), 1), na.NodeAddress)
+	c.Assert(err, IsNil)
+	msg, ok := m.(*MsgTradeAccountTransfer)
+	c.Assert(ok, Equals, true)
+	c.Check(msg.Asset.Equals(common.ETHAsset.GetTradeAsset()), Equals, true)
+	c.Check(msg.Signer.Equals(sender), Equals, true)
+	c.Check(msg.ToAddress.Equals(to), Equals, true)
+
+	// if the transfer fails nothing is credited, so the inbound can be refunded
+	mgr.Keeper().SetMimir(ctx, "HaltTradeTransfer-ETH", 1)
+	_, err = NewInternalHandler(mgr)(ctx, msg)
+	c.Assert(err, NotNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, common.ETHAsset, sender).String(), Equals, "0")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, common.ETHAsset, to).String(), Equals, "0")
+	mgr.Keeper().SetMimir(ctx, "HaltTradeTransfer-ETH", 0)
+
+	// as are deposits while the pool is halted
+	mgr.Keeper().SetMimir(ctx, "HaltPool-ETH-ETH", 1)
+	_, err = NewInternalHandler(mgr)(ctx, msg)
+	c.Assert(err, ErrorMatches, "pool is halted for asset.*")
+	mgr.Keeper().SetMimir(ctx, "HaltPool-ETH-ETH", 0)
+
+	_, err = NewInternalHandler(mgr)(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, common.ETHAsset, sender).String(), Equals, "0")
+	c.Check(mgr.TradeAccountManager().BalanceOf(ctx, common.ETHAsset, to).String(), Equals, "200")
+	tu, err := mgr.Keeper().GetTradeUnit(ctx, common.ETHAsset.GetTradeAsset())
+	c.Assert(err, IsNil)
+	c.Check(tu.Depth.String(), Equals, "200")
+
+	// the same memo in a native transaction transfers from the signer
+	native := GetRandomBech32Addr()
+	coins = common.Coins{common.NewCoin(common.BTCAsset.GetTradeAsset(), cosmos.NewUint(200))}
+	tx = common.NewTx(GetRandomTxHash(), common.Address(native.String()), GetRandomTHORAddress(), coins, common.Gas{}, fmt.Sprintf("trade>:%s", to))
+	tx.Chain = common.THORChain
+	m, err = processOneTxIn(ctx, mgr.Keeper(), ObservedTx{Tx: tx}, native)
+	c.Assert(err, IsNil)
+	msg, ok = m.(*MsgTradeAccountTransfer)
+	c.Assert(ok, Equals, true)
+	c.Check(msg.Signer.Equals(native), Equals, true)
+	c.Check(msg.ToAddress.Equals(to), Equals, true)
+	_, err = NewInternalHandler(mgr)(ctx, msg)
+	c.Assert(err, NotNil)
+}
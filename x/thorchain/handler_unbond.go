@@ -58,14 +58,6 @@ func (h UnBondHandler) validate(ctx cosmos.Context, msg MsgUnBond) error {
 		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
 	}
 
-	if na.Status == NodeActive || na.Status == NodeReady {
-		return cosmos.ErrUnknownRequest("cannot unbond while node is in active or ready status")
-	}
-
-	if h.mgr.Keeper().GetConfigInt64(ctx, constants.PauseUnbond) > 0 {
-		return ErrInternal(err, "unbonding has been paused")
-	}
-
 	bp, err := h.mgr.Keeper().GetBondProviders(ctx, msg.NodeAddress)
 	if err != nil {
 		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", msg.NodeAddress))
@@ -78,9 +70,52 @@ func (h UnBondHandler) validate(ctx cosmos.Context, msg MsgUnBond) error {
 		return cosmos.ErrUnauthorized(fmt.Sprintf("%s are not authorized to manage %s", msg.BondAddress, msg.NodeAddress))
 	}
 
+	provider := h.getUnbondProvider(na, msg, from)
+	if provider.Equals(GetLiquidBondAddress()) {
+		return cosmos.ErrUnknownRequest("liquid bond is redeemed with LUNBOND")
+	}
+	if msg.Cancel {
+		p := bp.Get(provider)
+		if !p.HasUnbondRequest() {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("%s has no pending unbond request on %s", provider, msg.NodeAddress))
+		}
+		return nil
+	}
+
+	if (na.Status == NodeActive || na.Status == NodeReady) && !h.canQueueUnbond(ctx, na, provider) {
+		return cosmos.ErrUnknownRequest("cannot unbond while node is in active or ready status")
+	}
+
+	if h.mgr.Keeper().GetConfigInt64(ctx, constants.PauseUnbond) > 0 {
+		return ErrInternal(err, "unbonding has been paused")
+	}
+
 	return nil
 }
 
+// getUnbondProvider returns the bond provider being unbonded, the provider named
+// by the node operator or else the sender
+func (h UnBondHandler) getUnbondProvider(na NodeAccount, msg MsgUnBond, from cosmos.AccAddress) cosmos.AccAddress {
+	if msg.BondAddress.Equals(na.BondAddress) && !msg.BondProviderAddress.Empty() {
+		return msg.BondProviderAddress
+	}
+	return from
+}
+
+// canQueueUnbond returns true if the unbond of the given provider from an active
+// or ready node can be queued, only bond providers other than the node operator
+// can queue an unbond
+func (h UnBondHandler) canQueueUnbond(ctx cosmos.Context, na NodeAccount, provider cosmos.AccAddress) bool {
+	if h.mgr.Keeper().GetConfigInt64(ctx, constants.BondProviderUnbondNoticeBlocks) <= 0 {
+		return false
+	}
+	operator, err := na.BondAddress.AccAddress()
+	if err != nil {
+		return false
+	}
+	return !provider.Equals(operator)
+}
+
 func (h UnBondHandler) handle(ctx cosmos.Context, msg MsgUnBond) error {
 	version := h.mgr.GetVersion()
 	switch {
@@ -97,6 +132,12 @@ func (h UnBondHandler) handleV3_0_0(ctx cosmos.Context, msg MsgUnBond) error {
 		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
 	}
 
+	// bond providers of an active node queue their unbond, to be executed on a
+	// later churn by the validator manager
+	if msg.Cancel || na.Status == NodeActive || na.Status == NodeReady {
+		return h.handleUnbondRequest(ctx, msg, na)
+	}
+
 	bondLockPeriod, err := h.mgr.Keeper().GetMimir(ctx, constants.BondLockupPeriod.String())
 	if err != nil || bondLockPeriod < 0 {
 		bondLockPeriod = h.mgr.GetConstants().GetInt64Value(constants.BondLockupPeriod)
@@ -165,3 +206,30 @@ func (h UnBondHandler) handleV3_0_0(ctx cosmos.Context, msg MsgUnBond) error {
 
 	return nil
 }
+
+// handleUnbondRequest queues or cancels the unbond request of a bond provider
+func (h UnBondHandler) handleUnbondRequest(ctx cosmos.Context, msg MsgUnBond, na NodeAccount) error {
+	from, err := msg.BondAddress.AccAddress()
+	if err != nil {
+		return ErrInternal(err, "fail to parse from address")
+	}
+	provider := h.getUnbondProvider(na, msg, from)
+
+	bp, err := h.mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers(%s)", na.NodeAddress))
+	}
+	if msg.Cancel {
+		bp.ClearUnbondRequest(provider)
+		ctx.Logger().Info("bond provider unbond request cancelled", "node address", na.NodeAddress, "provider", provider)
+	} else {
+		if !bp.SetUnbondRequest(msg.Amount, provider, ctx.BlockHeight()) {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("%s is not a bond provider of %s", provider, na.NodeAddress))
+		}
+		ctx.Logger().Info("bond provider unbond request queued", "node address", na.NodeAddress, "provider", provider, "amount", msg.Amount)
+	}
+	if err := h.mgr.Keeper().SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers(%s)", bp.NodeAddress.String()))
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
 )
 
@@ -392,3 +393,68 @@ func (HandlerUnBondSuite) TestBondProviders_Handler(c *C) {
 	c.Check(bp.Has(p.BondAddress), Equals, true)
 	c.Check(bp.Get(p.BondAddress).Bond.Uint64(), Equals, uint64(0), Commentf("%d", bp.Get(p.BondAddress).Bond.Uint64()))
 }
+
+func (HandlerUnBondSuite) TestBondProviders_UnbondRequest(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomValidatorNode(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, activeNodeAccount), IsNil)
+	txIn := GetRandomTx()
+	txIn.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.ZeroUint()))
+	handler := NewUnBondHandler(NewDummyMgrWithKeeper(k))
+
+	operator, err := activeNodeAccount.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	p := NewBondProvider(GetRandomBech32Addr())
+	p.Bond = cosmos.NewUint(50 * common.One)
+	bp := NewBondProviders(activeNodeAccount.NodeAddress)
+	bp.Providers = []BondProvider{NewBondProvider(operator), p}
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	providerAddr := common.Address(p.BondAddress.String())
+
+	// node operator cannot queue an unbond of their own bond
+	msg := NewMsgUnBond(txIn, activeNodeAccount.NodeAddress, cosmos.NewUint(5*common.One), activeNodeAccount.BondAddress, nil, activeNodeAccount.NodeAddress)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// nothing to cancel
+	msg = NewMsgUnBond(txIn, activeNodeAccount.NodeAddress, cosmos.ZeroUint(), providerAddr, nil, activeNodeAccount.NodeAddress)
+	msg.Cancel = true
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+
+	// bond provider queues an unbond on an active node
+	msg = NewMsgUnBond(txIn, activeNodeAccount.NodeAddress, cosmos.NewUint(10*common.One), providerAddr, nil, activeNodeAccount.NodeAddress)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	na, err := k.GetNodeAccount(ctx, activeNodeAccount.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(activeNodeAccount.Bond), Equals, true)
+	bp, err = k.GetBondProviders(ctx, activeNodeAccount.NodeAddress)
+	c.Assert(err, IsNil)
+	provider := bp.Get(p.BondAddress)
+	c.Check(provider.HasUnbondRequest(), Equals, true)
+	c.Check(provider.UnbondAmount.Uint64(), Equals, uint64(10*common.One))
+	c.Check(provider.UnbondHeight, Equals, ctx.BlockHeight())
+	nodes, err := k.GetBondProviderUnbondNodes(ctx)
+	c.Assert(err, IsNil)
+	c.Check(nodes, HasLen, 1)
+
+	// bond provider cancels the unbond
+	msg = NewMsgUnBond(txIn, activeNodeAccount.NodeAddress, cosmos.ZeroUint(), providerAddr, nil, activeNodeAccount.NodeAddress)
+	msg.Cancel = true
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, IsNil)
+	bp, err = k.GetBondProviders(ctx, activeNodeAccount.NodeAddress)
+	c.Assert(err, IsNil)
+	provider = bp.Get(p.BondAddress)
+	c.Check(provider.HasUnbondRequest(), Equals, false)
+	nodes, err = k.GetBondProviderUnbondNodes(ctx)
+	c.Assert(err, IsNil)
+	c.Check(nodes, HasLen, 0)
+
+	// queued unbonds are disabled when the notice period is zero
+	k.SetMimir(ctx, constants.BondProviderUnbondNoticeBlocks.String(), 0)
+	msg = NewMsgUnBond(txIn, activeNodeAccount.NodeAddress, cosmos.NewUint(10*common.One), providerAddr, nil, activeNodeAccount.NodeAddress)
+	_, err = handler.Run(ctx, msg)
+	c.Assert(err, NotNil)
+}
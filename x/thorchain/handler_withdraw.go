@@ -89,6 +89,9 @@ func (h WithdrawLiquidityHandler) validateV3_0_0(ctx cosmos.Context, msg MsgWith
 	if h.mgr.Keeper().IsChainHalted(ctx, msg.Asset.Chain) || h.mgr.Keeper().IsLPPaused(ctx, msg.Asset.Chain) {
 		return fmt.Errorf("unable to withdraw liquidity while chain is halted or paused LP actions")
 	}
+	if h.mgr.Keeper().IsPoolTradingHalted(ctx, msg.Asset) {
+		return fmt.Errorf("unable to withdraw liquidity, pool is halted for asset (%s)", msg.Asset.String())
+	}
 
 	return nil
 }
@@ -254,6 +257,9 @@ func (h WithdrawLiquidityHandler) handleV3_0_0(ctx cosmos.Context, msg MsgWithdr
 			if err := h.mgr.Keeper().SetPOL(ctx, pol); err != nil {
 				return nil, err
 			}
+			history := NewPoolHistory(msg.Asset, ctx.BlockHeight())
+			history.PolRuneWithdrawn = runeAmt
+			h.mgr.Keeper().AddPoolHistory(ctx, history)
 
 			ctx.Logger().Info("POL withdrawn", "pool", msg.Asset, "rune", runeAmt)
 			telemetry.IncrCounterWithLabels(
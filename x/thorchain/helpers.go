@@ -308,6 +308,13 @@ func refundBond(ctx cosmos.Context, tx common.Tx, acc cosmos.AccAddress, amt cos
 		return nil
 	}
 
+	return returnBond(ctx, tx, acc, amt, nodeAcc, mgr)
+}
+
+// returnBond returns up to amt of the bond of the given bond provider
+// regardless of the node status, and clears any pending unbond request of the
+// provider
+func returnBond(ctx cosmos.Context, tx common.Tx, acc cosmos.AccAddress, amt cosmos.Uint, nodeAcc *NodeAccount, mgr Manager) error {
 	if amt.IsZero() || amt.GT(nodeAcc.Bond) {
 		amt = nodeAcc.Bond
 	}
@@ -349,8 +356,9 @@ func refundBond(ctx cosmos.Context, tx common.Tx, acc cosmos.AccAddress, amt cos
 
 		nodeAcc.Bond = common.SafeSub(nodeAcc.Bond, amt)
 	}
+	bp.ClearUnbondRequest(acc)
 
-	if nodeAcc.RequestedToLeave {
+	if nodeAcc.RequestedToLeave && nodeAcc.Status != NodeActive {
 		// when node already request to leave , it can't come back , here means the node already unbond
 		// so set the node to disabled status
 		nodeAcc.UpdateStatus(NodeDisabled, ctx.BlockHeight())
@@ -536,6 +544,21 @@ func isSynthMintPaused(ctx cosmos.Context, mgr Manager, targetAsset common.Asset
 	return nil
 }
 
+// isSwapPriceBreakerTripped returns true if swaps are paused by the price breaker in
+// any pool the swap trades through, queued swaps are held until the pause ends
+func isSwapPriceBreakerTripped(ctx cosmos.Context, k keeper.Keeper, msg MsgSwap) bool {
+	assets := append([]common.Asset{msg.TargetAsset}, msg.Route...)
+	if len(msg.Tx.Coins) > 0 {
+		assets = append(assets, msg.Tx.Coins[0].Asset)
+	}
+	for _, asset := range assets {
+		if k.IsPriceBreakerTripped(ctx, asset) {
+			return true
+		}
+	}
+	return false
+}
+
 func telem(input cosmos.Uint) float32 {
 	if !input.BigInt().IsUint64() {
 		return 0
@@ -908,6 +931,82 @@ func getNonSigners(nas []NodeAccount, signers []cosmos.AccAddress) []cosmos.AccA
 	return nonSigners
 }
 
+// recordObservationQuorum records an on time observation of a finalised tx for the
+// nodes that observed it before quorum, and a miss for the active nodes that did not.
+func recordObservationQuorum(ctx cosmos.Context, k keeper.Keeper, tx common.Tx, signers, nonSigners []cosmos.AccAddress) {
+	window := k.GetConfigInt64(ctx, constants.ObservationStatsWindow)
+	if window <= 0 {
+		return
+	}
+	for _, signer := range signers {
+		updateObservationStats(ctx, k, signer, tx.Chain, func(stats *ObservationStats) {
+			stats.RecordObservation(ctx.BlockHeight(), tx.ID.String(), 0, window)
+		})
+	}
+	for _, nonSigner := range nonSigners {
+		updateObservationStats(ctx, k, nonSigner, tx.Chain, func(stats *ObservationStats) {
+			stats.RecordObservation(ctx.BlockHeight(), tx.ID.String(), ObservationMissed, window)
+		})
+	}
+}
+
+// recordLateObservation replaces the miss of a finalised tx with the lag of an
+// observation made within ObservationDelayFlexibility after it reached quorum.
+func recordLateObservation(ctx cosmos.Context, k keeper.Keeper, tx common.Tx, signer cosmos.AccAddress, quorumHeight int64) {
+	if k.GetConfigInt64(ctx, constants.ObservationStatsWindow) <= 0 {
+		return
+	}
+	updateObservationStats(ctx, k, signer, tx.Chain, func(stats *ObservationStats) {
+		stats.RecordLateObservation(ctx.BlockHeight(), tx.ID.String(), ctx.BlockHeight()-quorumHeight)
+	})
+}
+
+// recordNetworkFeeQuorum records an on time vote for the nodes that voted for a
+// network fee before it reached quorum, and a miss for the active nodes that did not.
+func recordNetworkFeeQuorum(ctx cosmos.Context, k keeper.Keeper, nf *common.NetworkFee, signers, nonSigners []cosmos.AccAddress) {
+	window := k.GetConfigInt64(ctx, constants.ObservationStatsWindow)
+	if window <= 0 {
+		return
+	}
+	id := networkFeeStatsID(nf)
+	for _, signer := range signers {
+		updateObservationStats(ctx, k, signer, nf.Chain, func(stats *ObservationStats) {
+			stats.RecordNetworkFee(ctx.BlockHeight(), id, 0, window)
+		})
+	}
+	for _, nonSigner := range nonSigners {
+		updateObservationStats(ctx, k, nonSigner, nf.Chain, func(stats *ObservationStats) {
+			stats.RecordNetworkFee(ctx.BlockHeight(), id, ObservationMissed, window)
+		})
+	}
+}
+
+// recordLateNetworkFee replaces the miss of a network fee with the lag of a vote
+// made within ObservationDelayFlexibility after the network fee reached quorum.
+func recordLateNetworkFee(ctx cosmos.Context, k keeper.Keeper, nf *common.NetworkFee, signer cosmos.AccAddress, quorumHeight int64) {
+	if k.GetConfigInt64(ctx, constants.ObservationStatsWindow) <= 0 {
+		return
+	}
+	updateObservationStats(ctx, k, signer, nf.Chain, func(stats *ObservationStats) {
+		stats.RecordLateNetworkFee(ctx.BlockHeight(), networkFeeStatsID(nf), ctx.BlockHeight()-quorumHeight)
+	})
+}
+
+// networkFeeStatsID identifies a network fee voter in the observation stats
+func networkFeeStatsID(nf *common.NetworkFee) string {
+	return fmt.Sprintf("%d/%d/%d", nf.Height, nf.TransactionRate, nf.TransactionSize)
+}
+
+func updateObservationStats(ctx cosmos.Context, k keeper.Keeper, addr cosmos.AccAddress, chain common.Chain, update func(*ObservationStats)) {
+	stats, err := k.GetObservationStats(ctx, addr, chain)
+	if err != nil {
+		ctx.Logger().Error("fail to get observation stats", "error", err, "node", addr, "chain", chain)
+		return
+	}
+	update(&stats)
+	k.SetObservationStats(ctx, stats)
+}
+
 // In the case where the max gas of the chain of a queued outbound tx has changed
 // Update the ObservedTxVoter so the network can still match the outbound with
 // the observed inbound
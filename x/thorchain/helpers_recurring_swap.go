@@ -0,0 +1,232 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+// queueRecurringSwaps adds a swap to the swap queue for every recurring swap
+// due at the current block height
+func queueRecurringSwaps(ctx cosmos.Context, mgr Manager) {
+	hashes, err := mgr.Keeper().GetRecurringSwapHeight(ctx, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("fail to get recurring swaps", "error", err)
+		return
+	}
+	defer mgr.Keeper().RemoveRecurringSwapHeight(ctx, ctx.BlockHeight())
+
+	enabled := mgr.Keeper().GetConfigInt64(ctx, constants.RecurringSwapsEnabled) > 0 &&
+		mgr.Keeper().GetConfigInt64(ctx, constants.TradeAccountsEnabled) > 0
+	for _, hash := range hashes {
+		swp, err := mgr.Keeper().GetRecurringSwap(ctx, hash)
+		if err != nil {
+			// cancelled recurring swaps are removed from the store, but not
+			// from the height index
+			continue
+		}
+		if swp.NextHeight != ctx.BlockHeight() {
+			continue
+		}
+
+		// while recurring swaps are paused, skip this interval rather than
+		// queueing every missed swap at once when resumed
+		if enabled {
+			if err := queueRecurringSwapFill(ctx, mgr, &swp); err != nil {
+				ctx.Logger().Info("fail to queue recurring swap", "tx id", swp.TxID, "error", err)
+				closeRecurringSwap(ctx, mgr, swp, RecurringSwapStatusCancelled, err.Error())
+				continue
+			}
+			if swp.IsDone() {
+				closeRecurringSwap(ctx, mgr, swp, RecurringSwapStatusCompleted, "")
+				continue
+			}
+		}
+
+		swp.NextHeight = ctx.BlockHeight() + int64(swp.Interval)
+		if err := mgr.Keeper().SetRecurringSwapHeight(ctx, swp.NextHeight, swp.TxID); err != nil {
+			ctx.Logger().Error("fail to schedule recurring swap", "tx id", swp.TxID, "error", err)
+		}
+		mgr.Keeper().SetRecurringSwap(ctx, swp)
+	}
+}
+
+// queueRecurringSwapFill adds the next swap of the recurring swap to the swap
+// queue, drawn from its escrow. Swaps into a trade asset or RUNE are made from
+// the owner's trade account, so the amount is moved back to it, otherwise the
+// trade asset is withdrawn first so the layer 1 asset can be swapped out to the
+// destination.
+func queueRecurringSwapFill(ctx cosmos.Context, mgr Manager, swp *RecurringSwap) error {
+	escrow := swp.GetEscrowAddress()
+	balance := mgr.TradeAccountManager().BalanceOf(ctx, swp.SourceAsset, escrow)
+	amount := swp.Amount
+	if swp.Count+1 >= swp.Quantity {
+		// the last swap takes the remainder of the escrow
+		amount = balance
+	}
+	if amount.IsZero() || balance.LT(amount) {
+		return fmt.Errorf("insufficient escrow balance: %s < %s", balance, amount)
+	}
+
+	hash, err := swp.GetFillTxID(swp.Count)
+	if err != nil {
+		return fmt.Errorf("fail to get recurring swap tx id: %w", err)
+	}
+	asgardAddress, err := mgr.Keeper().GetModuleAddress(AsgardName)
+	if err != nil {
+		return fmt.Errorf("fail to get asgard address: %w", err)
+	}
+
+	var coin common.Coin
+	if swp.SwapsToTradeAccount() {
+		amt, err := mgr.TradeAccountManager().Transfer(ctx, swp.SourceAsset, amount, escrow, swp.Owner, hash)
+		if err != nil {
+			return fmt.Errorf("fail to release from escrow: %w", err)
+		}
+		coin = common.NewCoin(swp.SourceAsset, amt)
+	} else {
+		amt, err := mgr.TradeAccountManager().Withdrawal(ctx, swp.SourceAsset, amount, escrow, common.NoAddress, hash)
+		if err != nil {
+			return fmt.Errorf("fail to withdraw from escrow: %w", err)
+		}
+		coin = common.NewCoin(swp.SourceAsset.GetLayer1Asset(), amt)
+	}
+
+	tx := common.NewTx(
+		hash,
+		common.Address(swp.Owner.String()),
+		asgardAddress,
+		common.NewCoins(coin),
+		common.Gas{},
+		fmt.Sprintf("=:%s:%s", swp.TargetAsset, swp.Destination),
+	)
+	tx.Chain = common.THORChain
+	msg := NewMsgSwap(
+		tx,
+		swp.TargetAsset,
+		swp.Destination,
+		cosmos.ZeroUint(),
+		common.NoAddress,
+		cosmos.ZeroUint(),
+		"",
+		"", nil,
+		MarketSwap,
+		0, 0,
+		swp.Owner,
+	)
+
+	txIn := ObservedTx{Tx: tx}
+	txInVoter := NewObservedTxVoter(txIn.Tx.ID, []common.ObservedTx{txIn})
+	txInVoter.Height = ctx.BlockHeight()
+	txInVoter.FinalisedHeight = ctx.BlockHeight()
+	txInVoter.Tx = txIn
+	mgr.Keeper().SetObservedTxInVoter(ctx, txInVoter)
+
+	if err := mgr.Keeper().SetSwapQueueItem(ctx, *msg, 0); err != nil {
+		return fmt.Errorf("fail to add recurring swap to queue: %w", err)
+	}
+
+	mgr.Keeper().SetRecurringSwapFill(ctx, NewRecurringSwapFill(hash, swp.TxID, swp.Count, ctx.BlockHeight(), coin))
+	swp.Count++
+	return nil
+}
+
+// closeRecurringSwap returns the rest of the escrow to the owner, then removes
+// the recurring swap and the history of its settled swaps. Swaps still in the
+// swap queue keep their record until they settle.
+func closeRecurringSwap(ctx cosmos.Context, mgr Manager, swp RecurringSwap, status, reason string) {
+	escrow := swp.GetEscrowAddress()
+	if balance := mgr.TradeAccountManager().BalanceOf(ctx, swp.SourceAsset, escrow); !balance.IsZero() {
+		if _, err := mgr.TradeAccountManager().Transfer(ctx, swp.SourceAsset, balance, escrow, swp.Owner, swp.TxID); err != nil {
+			ctx.Logger().Error("fail to release recurring swap escrow", "tx id", swp.TxID, "error", err)
+		}
+	}
+
+	for i := uint64(0); i < swp.Count; i++ {
+		hash, err := swp.GetFillTxID(i)
+		if err != nil {
+			continue
+		}
+		fill, err := mgr.Keeper().GetRecurringSwapFill(ctx, hash)
+		if err != nil || fill.Status == RecurringSwapFillPending {
+			continue
+		}
+		mgr.Keeper().RemoveRecurringSwapFill(ctx, hash)
+	}
+	mgr.Keeper().RemoveRecurringSwap(ctx, swp.TxID)
+
+	evt := NewEventRecurringSwap(swp, status, reason)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit recurring swap event", "error", err)
+	}
+}
+
+// abandonRecurringSwapFill gives up on a failed swap of a recurring swap that
+// could not be credited back to the trade account for RecurringSwapFillMaxRetryBlocks.
+// The swap is recorded as failed, and the recurring swap is cancelled so the rest
+// of its escrow is returned to the owner. Returns false while the swap should
+// still be retried.
+func abandonRecurringSwapFill(ctx cosmos.Context, mgr Manager, msg MsgSwap, creditErr error) bool {
+	fill, err := mgr.Keeper().GetRecurringSwapFill(ctx, msg.Tx.ID)
+	if err != nil {
+		ctx.Logger().Error("fail to get recurring swap fill", "tx id", msg.Tx.ID, "error", err)
+		return false
+	}
+	maxRetryBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.RecurringSwapFillMaxRetryBlocks)
+	if ctx.BlockHeight()-fill.Height < maxRetryBlocks {
+		return false
+	}
+
+	// the layer 1 asset is left in the vault, as with any other failed refund
+	ctx.Logger().Error("abandon failed recurring swap, leaving coins in vault", "tx id", fill.TxID, "coin", fill.In, "error", creditErr)
+	fill.Status = RecurringSwapFillFailed
+	fill.Reason = creditErr.Error()
+	if !mgr.Keeper().RecurringSwapExists(ctx, fill.RecurringSwapID) {
+		mgr.Keeper().RemoveRecurringSwapFill(ctx, fill.TxID)
+		return true
+	}
+	swp, err := mgr.Keeper().GetRecurringSwap(ctx, fill.RecurringSwapID)
+	if err != nil {
+		ctx.Logger().Error("fail to get recurring swap", "tx id", fill.RecurringSwapID, "error", err)
+		return false
+	}
+	mgr.Keeper().SetRecurringSwapFill(ctx, fill)
+	closeRecurringSwap(ctx, mgr, swp, RecurringSwapStatusCancelled, fmt.Sprintf("fail to credit swap %s: %s", fill.TxID, creditErr))
+	return true
+}
+
+// settleRecurringSwapFill records the outcome of a queued recurring swap. A
+// failed swap of a layer 1 asset is credited back to the owner's trade account,
+// the trade account is untouched by any other failed swap.
+func settleRecurringSwapFill(ctx cosmos.Context, mgr Manager, msg MsgSwap, emit cosmos.Uint, swapErr error) error {
+	hash := msg.Tx.ID
+	fill, err := mgr.Keeper().GetRecurringSwapFill(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	if swapErr != nil {
+		fill.Status = RecurringSwapFillFailed
+		fill.Reason = swapErr.Error()
+		if !fill.In.Asset.IsTradeAsset() {
+			// the swap is signed by the owner of the recurring swap
+			if _, err := mgr.TradeAccountManager().Deposit(ctx, fill.In.Asset, fill.In.Amount, msg.Signer, common.NoAddress, hash); err != nil {
+				return fmt.Errorf("fail to credit trade account: %w", err)
+			}
+		}
+	} else {
+		fill.Status = RecurringSwapFillFilled
+		fill.Out = emit
+	}
+
+	// the recurring swap has been closed, so there is nothing left to query
+	// the settled swap from
+	if !mgr.Keeper().RecurringSwapExists(ctx, fill.RecurringSwapID) {
+		mgr.Keeper().RemoveRecurringSwapFill(ctx, hash)
+		return nil
+	}
+	mgr.Keeper().SetRecurringSwapFill(ctx, fill)
+	return nil
+}
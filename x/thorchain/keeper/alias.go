@@ -40,8 +40,12 @@ type (
 	LiquidityProvider        = types.LiquidityProvider
 	Loan                     = types.Loan
 	StreamingSwap            = types.StreamingSwap
+	RecurringSwap            = types.RecurringSwap
+	RecurringSwapFill        = types.RecurringSwapFill
 	ObservedTxVoter          = types.ObservedTxVoter
 	BanVoter                 = types.BanVoter
+	ObservationStats         = types.ObservationStats
+	PoolHistory              = types.PoolHistory
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
@@ -59,6 +63,8 @@ type (
 	NodeAccount              = types.NodeAccount
 	NodeAccounts             = types.NodeAccounts
 	NodeMimirs               = types.NodeMimirs
+	ScheduledMimir           = types.ScheduledMimir
+	MimirHistory             = types.MimirHistory
 	NodeStatus               = types.NodeStatus
 	Network                  = types.Network
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
@@ -62,11 +62,14 @@ type Keeper interface {
 	KeeperNodeAccount
 	KeeperUpgrade
 	KeeperObserver
+	KeeperObservationStats
 	KeeperObservedTx
 	KeeperTxOut
 	KeeperLiquidityFees
+	KeeperPoolHistory
 	KeeperOutboundFees
 	KeeperSwapSlip
+	KeeperPoolPrice
 	KeeperVault
 	KeeperReserveContributors
 	KeeperNetwork
@@ -87,6 +90,7 @@ type Keeper interface {
 	KeeperHalt
 	KeeperAnchors
 	KeeperStreamingSwap
+	KeeperRecurringSwap
 	KeeperSwapperClout
 	KeeperTradeAccount
 	KeeperSecuredAsset
@@ -137,6 +141,21 @@ type KeeperStreamingSwap interface {
 	RemoveStreamingSwap(ctx cosmos.Context, _ common.TxID)
 }
 
+type KeeperRecurringSwap interface {
+	GetRecurringSwapIterator(ctx cosmos.Context) cosmos.Iterator
+	SetRecurringSwap(ctx cosmos.Context, _ RecurringSwap)
+	GetRecurringSwap(ctx cosmos.Context, _ common.TxID) (RecurringSwap, error)
+	RecurringSwapExists(ctx cosmos.Context, _ common.TxID) bool
+	RemoveRecurringSwap(ctx cosmos.Context, _ common.TxID)
+	SetRecurringSwapHeight(ctx cosmos.Context, height int64, _ common.TxID) error
+	GetRecurringSwapHeight(ctx cosmos.Context, height int64) (common.TxIDs, error)
+	RemoveRecurringSwapHeight(ctx cosmos.Context, height int64)
+	SetRecurringSwapFill(ctx cosmos.Context, _ RecurringSwapFill)
+	GetRecurringSwapFill(ctx cosmos.Context, _ common.TxID) (RecurringSwapFill, error)
+	RecurringSwapFillExists(ctx cosmos.Context, _ common.TxID) bool
+	RemoveRecurringSwapFill(ctx cosmos.Context, _ common.TxID)
+}
+
 type KeeperLoan interface {
 	GetLoanIterator(ctx cosmos.Context, _ common.Asset) cosmos.Iterator
 	GetLoan(ctx cosmos.Context, asset common.Asset, addr common.Address) (Loan, error)
@@ -176,6 +195,8 @@ type KeeperNodeAccount interface {
 	ReleaseNodeAccountFromJail(ctx cosmos.Context, addr cosmos.AccAddress) error
 	SetBondProviders(ctx cosmos.Context, _ BondProviders) error
 	GetBondProviders(ctx cosmos.Context, add cosmos.AccAddress) (BondProviders, error)
+	GetBondProviderUnbondNodes(ctx cosmos.Context) ([]cosmos.AccAddress, error)
+	GetNodeOperatorFeeNodes(ctx cosmos.Context) ([]cosmos.AccAddress, error)
 	DeductNativeTxFeeFromBond(ctx cosmos.Context, nodeAddr cosmos.AccAddress) error
 	RemoveLowBondValidatorAccounts(ctx cosmos.Context) error
 }
@@ -205,6 +226,13 @@ type KeeperObserver interface {
 	ClearObservingAddresses(ctx cosmos.Context)
 }
 
+type KeeperObservationStats interface {
+	GetObservationStats(ctx cosmos.Context, addr cosmos.AccAddress, chain common.Chain) (ObservationStats, error)
+	GetObservationStatsByNode(ctx cosmos.Context, addr cosmos.AccAddress) ([]ObservationStats, error)
+	SetObservationStats(ctx cosmos.Context, stats ObservationStats)
+	RemoveObservationStatsByNode(ctx cosmos.Context, addr cosmos.AccAddress)
+}
+
 type KeeperObservedTx interface {
 	SetObservedTxInVoter(ctx cosmos.Context, tx ObservedTxVoter)
 	GetObservedTxInVoterIterator(ctx cosmos.Context) cosmos.Iterator
@@ -234,6 +262,12 @@ type KeeperLiquidityFees interface {
 	ResetRollingPoolLiquidityFee(ctx cosmos.Context, asset common.Asset)
 }
 
+type KeeperPoolHistory interface {
+	AddPoolHistory(ctx cosmos.Context, record PoolHistory)
+	GetPoolHistory(ctx cosmos.Context, asset common.Asset) ([]PoolHistory, error)
+	GetPoolHistoryIntervalBlocks() int64
+}
+
 type KeeperOutboundFees interface {
 	AddToOutboundFeeWithheldRune(ctx cosmos.Context, outAsset common.Asset, withheld cosmos.Uint) error
 	AddToOutboundFeeSpentRune(ctx cosmos.Context, outAsset common.Asset, spent cosmos.Uint) error
@@ -259,6 +293,16 @@ type KeeperSwapSlip interface {
 	GetSwapSlipSnapShotIterator(ctx cosmos.Context, asset common.Asset) cosmos.Iterator
 }
 
+type KeeperPoolPrice interface {
+	GetPriceBreakerHeight(ctx cosmos.Context, asset common.Asset) (int64, error)
+	SetPriceBreakerHeight(ctx cosmos.Context, asset common.Asset, height int64)
+	IsPriceBreakerTripped(ctx cosmos.Context, asset common.Asset) bool
+	GetPoolPriceObservationBlocks(ctx cosmos.Context) int64
+	AddPoolPriceObservation(ctx cosmos.Context, asset common.Asset, price cosmos.Uint)
+	GetPoolCumulativePrice(ctx cosmos.Context, asset common.Asset, height int64) (cosmos.Uint, error)
+	GetPoolTWAP(ctx cosmos.Context, asset common.Asset, blocks int64) (cosmos.Uint, error)
+}
+
 type KeeperTradeAccount interface {
 	GetTradeAccount(ctx cosmos.Context, addr cosmos.AccAddress, asset common.Asset) (TradeAccount, error)
 	SetTradeAccount(ctx cosmos.Context, record TradeAccount)
@@ -388,6 +432,9 @@ type KeeperAdvSwapQueues interface {
 	RemoveAdvSwapQueueIndex(_ cosmos.Context, _ MsgSwap) error
 	SetAdvSwapQueueProcessor(_ cosmos.Context, _ []bool) error
 	GetAdvSwapQueueProcessor(_ cosmos.Context) ([]bool, error)
+	SetAdvSwapQueueExpiry(_ cosmos.Context, height int64, txID common.TxID) error
+	GetAdvSwapQueueExpiry(_ cosmos.Context, height int64) (common.TxIDs, error)
+	RemoveAdvSwapQueueExpiry(_ cosmos.Context, height int64)
 }
 
 type KeeperMimir interface {
@@ -401,6 +448,16 @@ type KeeperMimir interface {
 	GetMimirIterator(ctx cosmos.Context) cosmos.Iterator
 	GetNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator
 	DeleteMimir(_ cosmos.Context, key string) error
+	GetScheduledMimir(ctx cosmos.Context, key string, height int64) (ScheduledMimir, bool, error)
+	SetScheduledMimir(ctx cosmos.Context, mimir ScheduledMimir) error
+	DeleteScheduledMimir(ctx cosmos.Context, key string, height int64)
+	GetScheduledMimirIterator(ctx cosmos.Context) cosmos.Iterator
+	GetScheduledNodeMimirs(ctx cosmos.Context, key string, height int64) (NodeMimirs, error)
+	SetScheduledNodeMimir(ctx cosmos.Context, key string, value, height int64, acc cosmos.AccAddress) error
+	DeleteScheduledNodeMimirs(ctx cosmos.Context, key string, height int64)
+	GetScheduledNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator
+	GetMimirHistory(ctx cosmos.Context, key string) ([]MimirHistory, error)
+	GetNodeMimirHistory(ctx cosmos.Context, acc cosmos.AccAddress) ([]MimirHistory, error)
 	GetNodePauseChain(ctx cosmos.Context, acc cosmos.AccAddress) int64
 	SetNodePauseChain(ctx cosmos.Context, acc cosmos.AccAddress)
 	IsOperationalMimir(key string) bool
@@ -447,6 +504,7 @@ type KeeperHalt interface {
 	IsTradingHalt(ctx cosmos.Context, msg cosmos.Msg) bool
 	IsGlobalTradingHalted(ctx cosmos.Context) bool
 	IsChainTradingHalted(ctx cosmos.Context, chain common.Chain) bool
+	IsPoolTradingHalted(ctx cosmos.Context, asset common.Asset) bool
 	IsChainHalted(ctx cosmos.Context, chain common.Chain) bool
 	IsLPPaused(ctx cosmos.Context, chain common.Chain) bool
 	IsPoolDepositPaused(ctx cosmos.Context, asset common.Asset) bool
@@ -455,6 +513,7 @@ type KeeperHalt interface {
 type KeeperAnchors interface {
 	GetAnchors(ctx cosmos.Context, asset common.Asset) []common.Asset
 	AnchorMedian(ctx cosmos.Context, assets []common.Asset) cosmos.Uint
+	AnchorMedianTWAP(ctx cosmos.Context, assets []common.Asset, blocks int64) cosmos.Uint
 	DollarsPerRune(ctx cosmos.Context) cosmos.Uint
 	RunePerDollar(ctx cosmos.Context) cosmos.Uint
 }
@@ -277,6 +277,28 @@ func (k KVStoreDummy) GetStreamingSwap(ctx cosmos.Context, _ common.TxID) (Strea
 func (k KVStoreDummy) StreamingSwapExists(ctx cosmos.Context, _ common.TxID) bool { return false }
 func (k KVStoreDummy) RemoveStreamingSwap(ctx cosmos.Context, _ common.TxID)      {}
 
+func (k KVStoreDummy) GetRecurringSwapIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) SetRecurringSwap(ctx cosmos.Context, _ RecurringSwap)        {}
+func (k KVStoreDummy) GetRecurringSwap(ctx cosmos.Context, _ common.TxID) (RecurringSwap, error) {
+	return RecurringSwap{}, kaboom
+}
+func (k KVStoreDummy) RecurringSwapExists(ctx cosmos.Context, _ common.TxID) bool { return false }
+func (k KVStoreDummy) RemoveRecurringSwap(ctx cosmos.Context, _ common.TxID)      {}
+func (k KVStoreDummy) SetRecurringSwapHeight(ctx cosmos.Context, _ int64, _ common.TxID) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) GetRecurringSwapHeight(ctx cosmos.Context, _ int64) (common.TxIDs, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) RemoveRecurringSwapHeight(ctx cosmos.Context, _ int64)        {}
+func (k KVStoreDummy) SetRecurringSwapFill(ctx cosmos.Context, _ RecurringSwapFill) {}
+func (k KVStoreDummy) GetRecurringSwapFill(ctx cosmos.Context, _ common.TxID) (RecurringSwapFill, error) {
+	return RecurringSwapFill{}, kaboom
+}
+func (k KVStoreDummy) RecurringSwapFillExists(ctx cosmos.Context, _ common.TxID) bool { return false }
+func (k KVStoreDummy) RemoveRecurringSwapFill(ctx cosmos.Context, _ common.TxID)      {}
+
 func (k KVStoreDummy) GetLoanIterator(ctx cosmos.Context, _ common.Asset) cosmos.Iterator {
 	return nil
 }
@@ -368,6 +390,14 @@ func (k KVStoreDummy) GetBondProviders(ctx cosmos.Context, _ cosmos.AccAddress)
 	return BondProviders{}, kaboom
 }
 
+func (k KVStoreDummy) GetBondProviderUnbondNodes(ctx cosmos.Context) ([]cosmos.AccAddress, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) GetNodeOperatorFeeNodes(ctx cosmos.Context) ([]cosmos.AccAddress, error) {
+	return nil, kaboom
+}
+
 func (k KVStoreDummy) DeductNativeTxFeeFromBond(ctx cosmos.Context, nodeAddr cosmos.AccAddress) error {
 	return kaboom
 }
@@ -435,6 +465,14 @@ func (k KVStoreDummy) GetRollingPoolLiquidityFee(ctx cosmos.Context, asset commo
 
 func (k KVStoreDummy) ResetRollingPoolLiquidityFee(ctx cosmos.Context, asset common.Asset) {}
 
+func (k KVStoreDummy) AddPoolHistory(ctx cosmos.Context, record PoolHistory) {}
+
+func (k KVStoreDummy) GetPoolHistory(ctx cosmos.Context, asset common.Asset) ([]PoolHistory, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) GetPoolHistoryIntervalBlocks() int64 { return 0 }
+
 func (k KVStoreDummy) GetChains(_ cosmos.Context) (common.Chains, error) { return nil, kaboom }
 func (k KVStoreDummy) SetChains(_ cosmos.Context, _ common.Chains)       {}
 func (k KVStoreDummy) AddToSwapSlip(ctx cosmos.Context, asset common.Asset, amt cosmos.Int) error {
@@ -461,6 +499,29 @@ func (k KVStoreDummy) GetLongRollup(ctx cosmos.Context, asset common.Asset) (int
 
 func (k KVStoreDummy) SetLongRollup(ctx cosmos.Context, asset common.Asset, slip int64) {}
 
+func (k KVStoreDummy) GetPriceBreakerHeight(ctx cosmos.Context, asset common.Asset) (int64, error) {
+	return 0, kaboom
+}
+
+func (k KVStoreDummy) SetPriceBreakerHeight(ctx cosmos.Context, asset common.Asset, height int64) {}
+
+func (k KVStoreDummy) IsPriceBreakerTripped(ctx cosmos.Context, asset common.Asset) bool {
+	return false
+}
+
+func (k KVStoreDummy) GetPoolPriceObservationBlocks(ctx cosmos.Context) int64 { return 0 }
+
+func (k KVStoreDummy) AddPoolPriceObservation(ctx cosmos.Context, asset common.Asset, price cosmos.Uint) {
+}
+
+func (k KVStoreDummy) GetPoolCumulativePrice(ctx cosmos.Context, asset common.Asset, height int64) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), kaboom
+}
+
+func (k KVStoreDummy) GetPoolTWAP(ctx cosmos.Context, asset common.Asset, blocks int64) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), kaboom
+}
+
 func (k KVStoreDummy) GetPoolSwapSlip(ctx cosmos.Context, height int64, asset common.Asset) (cosmos.Int, error) {
 	return cosmos.ZeroInt(), kaboom
 }
@@ -603,6 +664,16 @@ func (k KVStoreDummy) GetAdvSwapQueueProcessor(ctx cosmos.Context) ([]bool, erro
 	return nil, kaboom
 }
 
+func (k KVStoreDummy) SetAdvSwapQueueExpiry(_ cosmos.Context, _ int64, _ common.TxID) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) GetAdvSwapQueueExpiry(_ cosmos.Context, _ int64) (common.TxIDs, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) RemoveAdvSwapQueueExpiry(_ cosmos.Context, _ int64) {}
+
 func (k KVStoreDummy) GetTCYClaimer(ctx cosmos.Context, l1Address common.Address, asset common.Asset) (TCYClaimer, error) {
 	return TCYClaimer{}, nil
 }
@@ -674,6 +745,31 @@ func (k KVStoreDummy) PurgeOperationalNodeMimirs(_ cosmos.Context)             {
 func (k KVStoreDummy) DeleteMimir(_ cosmos.Context, key string) error          { return kaboom }
 func (k KVStoreDummy) GetMimirIterator(ctx cosmos.Context) cosmos.Iterator     { return nil }
 func (k KVStoreDummy) GetNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+
+func (k KVStoreDummy) GetScheduledMimir(_ cosmos.Context, _ string, _ int64) (ScheduledMimir, bool, error) {
+	return ScheduledMimir{}, false, kaboom
+}
+
+func (k KVStoreDummy) SetScheduledMimir(_ cosmos.Context, _ ScheduledMimir) error { return kaboom }
+func (k KVStoreDummy) DeleteScheduledMimir(_ cosmos.Context, _ string, _ int64)   {}
+func (k KVStoreDummy) GetScheduledMimirIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetScheduledNodeMimirs(_ cosmos.Context, _ string, _ int64) (NodeMimirs, error) {
+	return NodeMimirs{}, kaboom
+}
+
+func (k KVStoreDummy) SetScheduledNodeMimir(_ cosmos.Context, _ string, _, _ int64, _ cosmos.AccAddress) error {
+	return kaboom
+}
+func (k KVStoreDummy) DeleteScheduledNodeMimirs(_ cosmos.Context, _ string, _ int64)  {}
+func (k KVStoreDummy) GetScheduledNodeMimirIterator(_ cosmos.Context) cosmos.Iterator { return nil }
+
+func (k KVStoreDummy) GetMimirHistory(_ cosmos.Context, _ string) ([]MimirHistory, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) GetNodeMimirHistory(_ cosmos.Context, _ cosmos.AccAddress) ([]MimirHistory, error) {
+	return nil, kaboom
+}
 func (k KVStoreDummy) GetNodePauseChain(ctx cosmos.Context, acc cosmos.AccAddress) int64 {
 	return int64(-1)
 }
@@ -718,6 +814,20 @@ func (k KVStoreDummy) GetLastObserveHeight(ctx cosmos.Context, address cosmos.Ac
 	return nil, kaboom
 }
 
+func (k KVStoreDummy) GetObservationStats(ctx cosmos.Context, addr cosmos.AccAddress, chain common.Chain) (ObservationStats, error) {
+	return ObservationStats{}, kaboom
+}
+
+func (k KVStoreDummy) GetObservationStatsByNode(ctx cosmos.Context, addr cosmos.AccAddress) ([]ObservationStats, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) SetObservationStats(ctx cosmos.Context, stats ObservationStats) {
+}
+
+func (k KVStoreDummy) RemoveObservationStatsByNode(ctx cosmos.Context, addr cosmos.AccAddress) {
+}
+
 func (k KVStoreDummy) SetTssKeygenMetric(_ cosmos.Context, metric *TssKeygenMetric) {
 }
 
@@ -789,6 +899,7 @@ func (k KVStoreDummy) IsRagnarok(ctx cosmos.Context, assets []common.Asset) bool
 func (k KVStoreDummy) IsTradingHalt(ctx cosmos.Context, msg cosmos.Msg) bool            { return false }
 func (k KVStoreDummy) IsGlobalTradingHalted(ctx cosmos.Context) bool                    { return false }
 func (k KVStoreDummy) IsChainTradingHalted(ctx cosmos.Context, chain common.Chain) bool { return false }
+func (k KVStoreDummy) IsPoolTradingHalted(ctx cosmos.Context, asset common.Asset) bool  { return false }
 func (k KVStoreDummy) IsChainHalted(ctx cosmos.Context, chain common.Chain) bool        { return false }
 func (k KVStoreDummy) IsLPPaused(ctx cosmos.Context, chain common.Chain) bool           { return false }
 func (k KVStoreDummy) IsPoolDepositPaused(ctx cosmos.Context, asset common.Asset) bool  { return false }
@@ -797,6 +908,10 @@ func (k KVStoreDummy) GetAnchors(ctx cosmos.Context, asset common.Asset) []commo
 func (k KVStoreDummy) AnchorMedian(ctx cosmos.Context, assets []common.Asset) cosmos.Uint {
 	return cosmos.ZeroUint()
 }
+
+func (k KVStoreDummy) AnchorMedianTWAP(ctx cosmos.Context, assets []common.Asset, blocks int64) cosmos.Uint {
+	return cosmos.ZeroUint()
+}
 func (k KVStoreDummy) DollarsPerRune(ctx cosmos.Context) cosmos.Uint { return cosmos.ZeroUint() }
 func (k KVStoreDummy) RunePerDollar(ctx cosmos.Context) cosmos.Uint  { return cosmos.ZeroUint() }
 func (k KVStoreDummy) DollarConfigInRune(ctx cosmos.Context, key constants.ConstantName) cosmos.Uint {
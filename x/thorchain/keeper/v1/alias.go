@@ -50,12 +50,17 @@ var (
 	NewJail                    = types.NewJail
 	NewLoan                    = types.NewLoan
 	NewStreamingSwap           = types.NewStreamingSwap
+	NewRecurringSwap           = types.NewRecurringSwap
+	NewRecurringSwapFill       = types.NewRecurringSwapFill
 	NewNetwork                 = types.NewNetwork
 	NewProtocolOwnedLiquidity  = types.NewProtocolOwnedLiquidity
 	NewRUNEPool                = types.NewRUNEPool
+	NewMimirHistory            = types.NewMimirHistory
 	NewObservedTx              = common.NewObservedTx
 	NewTssVoter                = types.NewTssVoter
 	NewBanVoter                = types.NewBanVoter
+	NewObservationStats        = types.NewObservationStats
+	NewPoolHistory             = types.NewPoolHistory
 	NewErrataTxVoter           = types.NewErrataTxVoter
 	NewObservedTxVoter         = types.NewObservedTxVoter
 	NewKeygen                  = types.NewKeygen
@@ -75,6 +80,8 @@ var (
 	NewTHORName                = types.NewTHORName
 	NewEventBond               = types.NewEventBond
 	NewEventMintBurn           = types.NewEventMintBurn
+	GetLiquidBondDenom         = types.GetLiquidBondDenom
+	GetLiquidBondAddress       = types.GetLiquidBondAddress
 	GetRandomTx                = types.GetRandomTx
 	GetRandomValidatorNode     = types.GetRandomValidatorNode
 	GetRandomVaultNode         = types.GetRandomVaultNode
@@ -107,9 +114,14 @@ type (
 	LiquidityProvider        = types.LiquidityProvider
 	Loan                     = types.Loan
 	StreamingSwap            = types.StreamingSwap
+	RecurringSwap            = types.RecurringSwap
+	RecurringSwapFill        = types.RecurringSwapFill
 	ObservedTxs              = common.ObservedTxs
 	ObservedTxVoter          = types.ObservedTxVoter
 	BanVoter                 = types.BanVoter
+	ObservationStats         = types.ObservationStats
+	PoolHistory              = types.PoolHistory
+	PoolPriceObservation     = types.PoolPriceObservation
 	ErrataTxVoter            = types.ErrataTxVoter
 	TssVoter                 = types.TssVoter
 	TssKeysignFailVoter      = types.TssKeysignFailVoter
@@ -141,6 +153,8 @@ type (
 	MinJoinLast              = types.MinJoinLast
 	NodeMimir                = types.NodeMimir
 	NodeMimirs               = types.NodeMimirs
+	ScheduledMimir           = types.ScheduledMimir
+	MimirHistory             = types.MimirHistory
 	ProtocolOwnedLiquidity   = types.ProtocolOwnedLiquidity
 	SwapperClout             = types.SwapperClout
 	TradeAccount             = types.TradeAccount
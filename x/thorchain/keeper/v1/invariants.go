@@ -14,12 +14,14 @@ func (k KVStore) InvariantRoutes() []common.InvariantRoute {
 	return []common.InvariantRoute{
 		common.NewInvariantRoute("asgard", AsgardInvariant(k)),
 		common.NewInvariantRoute("bond", BondInvariant(k)),
+		common.NewInvariantRoute("liquid_bond", LiquidBondInvariant(k)),
 		common.NewInvariantRoute("thorchain", THORChainInvariant(k)),
 		common.NewInvariantRoute("affiliate_collector", AffilliateCollectorInvariant(k)),
 		common.NewInvariantRoute("pools", PoolsInvariant(k)),
 		common.NewInvariantRoute("streaming_swaps", StreamingSwapsInvariant(k)),
 		common.NewInvariantRoute("runepool", RUNEPoolInvariant(k)),
 		common.NewInvariantRoute("lending", LendingInvariant(k)),
+		common.NewInvariantRoute("trade_accounts", TradeAccountsInvariant(k)),
 	}
 }
 
@@ -184,6 +186,40 @@ func BondInvariant(k KVStore) common.Invariant {
 	}
 }
 
+// LiquidBondInvariant the supply of the liquid bond receipts of a node should
+// equal the units recorded against its liquid bond provider
+func LiquidBondInvariant(k KVStore) common.Invariant {
+	return func(ctx cosmos.Context) (msg []string, broken bool) {
+		naIter := k.GetNodeAccountIterator(ctx)
+		defer naIter.Close()
+		for ; naIter.Valid(); naIter.Next() {
+			var na NodeAccount
+			k.Cdc().MustUnmarshal(naIter.Value(), &na)
+
+			bp, err := k.GetBondProviders(ctx, na.NodeAddress)
+			if err != nil {
+				msg = append(msg, fmt.Sprintf("%s: fail to get bond providers: %s", na.NodeAddress, err))
+				broken = true
+				continue
+			}
+
+			denom := GetLiquidBondDenom(na.NodeAddress)
+			supply := k.coinKeeper.GetSupply(ctx, denom).Amount
+			units := cosmos.NewIntFromBigInt(bp.LiquidBondUnits.BigInt())
+			if !supply.Equal(units) {
+				msg = append(msg, fmt.Sprintf("%s supply %s != liquid bond units %s", denom, supply, units))
+				broken = true
+			}
+			if !units.IsZero() && !bp.Has(GetLiquidBondAddress()) {
+				msg = append(msg, fmt.Sprintf("%s liquid bond units %s without a liquid bond provider", na.NodeAddress, units))
+				broken = true
+			}
+		}
+
+		return msg, broken
+	}
+}
+
 // THORChainInvariant the thorchain module should never hold a balance
 func THORChainInvariant(k KVStore) common.Invariant {
 	return func(ctx cosmos.Context) (msg []string, broken bool) {
@@ -510,3 +546,52 @@ func LendingInvariant(k KVStore) common.Invariant {
 		return msg, broken
 	}
 }
+
+// TradeAccountsInvariant ensures the units of every trade unit equal the sum of the
+// units held by the trade accounts of that asset
+func TradeAccountsInvariant(k KVStore) common.Invariant {
+	return func(ctx cosmos.Context) (msg []string, broken bool) {
+		accountUnits := make(map[string]cosmos.Uint)
+		iterator := k.GetTradeAccountIterator(ctx)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var tr TradeAccount
+			k.Cdc().MustUnmarshal(iterator.Value(), &tr)
+			units, ok := accountUnits[tr.Asset.String()]
+			if !ok {
+				units = cosmos.ZeroUint()
+			}
+			accountUnits[tr.Asset.String()] = units.Add(tr.Units)
+		}
+
+		unitIterator := k.GetTradeUnitIterator(ctx)
+		defer unitIterator.Close()
+		for ; unitIterator.Valid(); unitIterator.Next() {
+			var tu TradeUnit
+			k.Cdc().MustUnmarshal(unitIterator.Value(), &tu)
+			units, ok := accountUnits[tu.Asset.String()]
+			if !ok {
+				units = cosmos.ZeroUint()
+			}
+			delete(accountUnits, tu.Asset.String())
+			if !units.Equal(tu.Units) {
+				msg = append(msg, fmt.Sprintf(
+					"%s trade units %s != account units %s",
+					tu.Asset, tu.Units, units,
+				))
+				broken = true
+			}
+		}
+
+		// accounts holding units of an asset without a trade unit record
+		for asset, units := range accountUnits {
+			if units.IsZero() {
+				continue
+			}
+			msg = append(msg, fmt.Sprintf("%s trade units 0 != account units %s", asset, units))
+			broken = true
+		}
+
+		return msg, broken
+	}
+}
@@ -144,3 +144,52 @@ func (s *InvariantsSuite) TestTHORChainInvariant(c *C) {
 	c.Assert(len(msg), Equals, 1)
 	c.Assert(msg[0], Equals, "oversolvent: 1rune")
 }
+
+func (s *InvariantsSuite) TestTradeAccountsInvariant(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	invariant := TradeAccountsInvariant(k)
+	asset := common.BTCAsset.GetTradeAsset()
+
+	// should pass since there are no trade accounts
+	msg, broken := invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, IsNil)
+
+	tu := NewTradeUnit(asset)
+	tu.Units = cosmos.NewUint(300)
+	tu.Depth = cosmos.NewUint(300)
+	k.SetTradeUnit(ctx, tu)
+
+	tr1 := NewTradeAccount(GetRandomBech32Addr(), asset)
+	tr1.Units = cosmos.NewUint(100)
+	k.SetTradeAccount(ctx, tr1)
+	tr2 := NewTradeAccount(GetRandomBech32Addr(), asset)
+	tr2.Units = cosmos.NewUint(200)
+	k.SetTradeAccount(ctx, tr2)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, false)
+	c.Assert(msg, IsNil)
+
+	// account units exceeding the trade units
+	tr2.Units = cosmos.NewUint(250)
+	k.SetTradeAccount(ctx, tr2)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, HasLen, 1)
+	c.Assert(msg[0], Equals, "BTC~BTC trade units 300 != account units 350")
+
+	// account units without a trade unit
+	tr2.Units = cosmos.NewUint(200)
+	k.SetTradeAccount(ctx, tr2)
+	tr3 := NewTradeAccount(GetRandomBech32Addr(), common.ETHAsset.GetTradeAsset())
+	tr3.Units = cosmos.NewUint(10)
+	k.SetTradeAccount(ctx, tr3)
+
+	msg, broken = invariant(ctx)
+	c.Assert(broken, Equals, true)
+	c.Assert(msg, HasLen, 1)
+	c.Assert(msg[0], Equals, "ETH~ETH trade units 0 != account units 10")
+}
@@ -27,75 +27,92 @@ import (
 // Also, use underscores between words and use lowercase characters only
 
 const (
-	prefixObservedTxIn            types.DbPrefix = "observed_tx_in/"
-	prefixObservedTxOut           types.DbPrefix = "observed_tx_out/"
-	prefixObservedLink            types.DbPrefix = "ob_link/"
-	prefixPool                    types.DbPrefix = "pool/"
-	prefixPoolLUVI                types.DbPrefix = "luvi/"
-	prefixTxOut                   types.DbPrefix = "txout/"
-	prefixTotalLiquidityFee       types.DbPrefix = "total_liquidity_fee/"
-	prefixPoolLiquidityFee        types.DbPrefix = "pool_liquidity_fee/"
-	prefixPoolSwapSlip            types.DbPrefix = "pool_swap_slip/"
-	prefixPoolSwapSlipLong        types.DbPrefix = "pool_swap_slip_long/"
-	prefixPoolSwapSnapShot        types.DbPrefix = "pool_swap_slip_ss/"
-	prefixLiquidityProvider       types.DbPrefix = "lp/"
-	prefixLastChainHeight         types.DbPrefix = "last_chain_height/"
-	prefixLastSignedHeight        types.DbPrefix = "last_signed_height/"
-	prefixLastObserveHeight       types.DbPrefix = "last_observe_height/"
-	prefixNodeAccount             types.DbPrefix = "node_account/"
-	prefixBondProviders           types.DbPrefix = "bond_providers/"
-	prefixVault                   types.DbPrefix = "vault/"
-	prefixVaultAsgardIndex        types.DbPrefix = "vault_asgard_index/"
-	prefixNetwork                 types.DbPrefix = "network/"
-	prefixSwapperClout            types.DbPrefix = "sclout/"
-	prefixPOL                     types.DbPrefix = "pol/"
-	prefixLoan                    types.DbPrefix = "loan/"
-	prefixTradeAccount            types.DbPrefix = "tr_acct/"
-	prefixSecuredAsset            types.DbPrefix = "sa/"
-	prefixRUNEProvider            types.DbPrefix = "rune_provider/"
-	prefixRUNEPool                types.DbPrefix = "rune_pool/"
-	prefixTradeUnit               types.DbPrefix = "tr_unit/"
-	prefixStreamingSwap           types.DbPrefix = "stream/"
-	prefixLoanTotalCollateral     types.DbPrefix = "loan_col_total/"
-	prefixObservingAddresses      types.DbPrefix = "observing_addresses/"
-	prefixTss                     types.DbPrefix = "tss/"
-	prefixTssKeysignFailure       types.DbPrefix = "tssKeysignFailure/"
-	prefixKeygen                  types.DbPrefix = "keygen/"
-	prefixRagnarokHeight          types.DbPrefix = "ragnarokHeight/"
-	prefixRagnarokNth             types.DbPrefix = "ragnarokNth/"
-	prefixRagnarokPending         types.DbPrefix = "ragnarokPending/"
-	prefixRagnarokPosition        types.DbPrefix = "ragnarokPosition/"
-	prefixRagnarokPoolHeight      types.DbPrefix = "ragnarokPool/"
-	prefixErrataTx                types.DbPrefix = "errata/"
-	prefixBanVoter                types.DbPrefix = "ban/"
-	prefixNodeSlashPoints         types.DbPrefix = "slash/"
-	prefixNodeJail                types.DbPrefix = "jail/"
-	prefixSwapQueueItem           types.DbPrefix = "swapitem/"
-	prefixAdvSwapQueueItem        types.DbPrefix = "aq/"
-	prefixAdvSwapQueueLimitIndex  types.DbPrefix = "aqlim/"
-	prefixAdvSwapQueueMarketIndex types.DbPrefix = "aqmark/"
-	prefixAdvSwapQueueProcessor   types.DbPrefix = "aqproc/"
-	prefixOutboundFeeWithheldRune types.DbPrefix = "outbound_fee_withheld_rune/"
-	prefixOutboundFeeSpentRune    types.DbPrefix = "outbound_fee_spent_rune/"
-	prefixMimir                   types.DbPrefix = "mimir/"
-	prefixMinJoinLast             types.DbPrefix = "minjoinlast/"
-	prefixNodeMimir               types.DbPrefix = "nodemimir/"
-	prefixNodePauseChain          types.DbPrefix = "node_pause_chain/"
-	prefixNetworkFee              types.DbPrefix = "network_fee/"
-	prefixNetworkFeeVoter         types.DbPrefix = "network_fee_voter/"
-	prefixTssKeygenMetric         types.DbPrefix = "tss_keygen_metric/"
-	prefixTssKeysignMetric        types.DbPrefix = "tss_keysign_metric/"
-	prefixTssKeysignMetricLatest  types.DbPrefix = "latest_tss_keysign_metric/"
-	prefixChainContract           types.DbPrefix = "chain_contract/"
-	prefixSolvencyVoter           types.DbPrefix = "solvency_voter/"
-	prefixTHORName                types.DbPrefix = "thorname/"
-	prefixAffiliateCollector      types.DbPrefix = "affcol/"
-	prefixRollingPoolLiquidityFee types.DbPrefix = "rolling_pool_liquidity_fee/"
-	prefixVersion                 types.DbPrefix = "version/"
-	prefixUpgradeProposals        types.DbPrefix = "upgr_props/"
-	prefixUpgradeVotes            types.DbPrefix = "upgr_votes/"
-	prefixTCYClaimer              types.DbPrefix = "tcy_claimer/"
-	prefixTCYStaker               types.DbPrefix = "tcy_staker/"
+	prefixObservedTxIn                types.DbPrefix = "observed_tx_in/"
+	prefixObservedTxOut               types.DbPrefix = "observed_tx_out/"
+	prefixObservedLink                types.DbPrefix = "ob_link/"
+	prefixPool                        types.DbPrefix = "pool/"
+	prefixPoolLUVI                    types.DbPrefix = "luvi/"
+	prefixTxOut                       types.DbPrefix = "txout/"
+	prefixTotalLiquidityFee           types.DbPrefix = "total_liquidity_fee/"
+	prefixPoolLiquidityFee            types.DbPrefix = "pool_liquidity_fee/"
+	prefixPoolSwapSlip                types.DbPrefix = "pool_swap_slip/"
+	prefixPoolSwapSlipLong            types.DbPrefix = "pool_swap_slip_long/"
+	prefixPoolSwapSnapShot            types.DbPrefix = "pool_swap_slip_ss/"
+	prefixPriceBreaker                types.DbPrefix = "price_breaker/"
+	prefixPoolPriceObservation        types.DbPrefix = "pool_price_obs/"
+	prefixPoolPriceLast               types.DbPrefix = "pool_price_last/"
+	prefixLiquidityProvider           types.DbPrefix = "lp/"
+	prefixLastChainHeight             types.DbPrefix = "last_chain_height/"
+	prefixLastSignedHeight            types.DbPrefix = "last_signed_height/"
+	prefixLastObserveHeight           types.DbPrefix = "last_observe_height/"
+	prefixObservationStats            types.DbPrefix = "observation_stats/"
+	prefixPoolHistory                 types.DbPrefix = "pool_history/"
+	prefixNodeAccount                 types.DbPrefix = "node_account/"
+	prefixBondProviders               types.DbPrefix = "bond_providers/"
+	prefixBondProviderUnbondNodes     types.DbPrefix = "bond_provider_unbond_nodes/"
+	prefixNodeOperatorFeeNodes        types.DbPrefix = "node_operator_fee_nodes/"
+	prefixVault                       types.DbPrefix = "vault/"
+	prefixVaultAsgardIndex            types.DbPrefix = "vault_asgard_index/"
+	prefixNetwork                     types.DbPrefix = "network/"
+	prefixSwapperClout                types.DbPrefix = "sclout/"
+	prefixPOL                         types.DbPrefix = "pol/"
+	prefixLoan                        types.DbPrefix = "loan/"
+	prefixTradeAccount                types.DbPrefix = "tr_acct/"
+	prefixSecuredAsset                types.DbPrefix = "sa/"
+	prefixRUNEProvider                types.DbPrefix = "rune_provider/"
+	prefixRUNEPool                    types.DbPrefix = "rune_pool/"
+	prefixTradeUnit                   types.DbPrefix = "tr_unit/"
+	prefixStreamingSwap               types.DbPrefix = "stream/"
+	prefixRecurringSwap               types.DbPrefix = "recurring_swap/"
+	prefixRecurringSwapHeight         types.DbPrefix = "recurring_swap_height/"
+	prefixRecurringSwapFill           types.DbPrefix = "recurring_swap_fill/"
+	prefixLoanTotalCollateral         types.DbPrefix = "loan_col_total/"
+	prefixObservingAddresses          types.DbPrefix = "observing_addresses/"
+	prefixTss                         types.DbPrefix = "tss/"
+	prefixTssKeysignFailure           types.DbPrefix = "tssKeysignFailure/"
+	prefixKeygen                      types.DbPrefix = "keygen/"
+	prefixRagnarokHeight              types.DbPrefix = "ragnarokHeight/"
+	prefixRagnarokNth                 types.DbPrefix = "ragnarokNth/"
+	prefixRagnarokPending             types.DbPrefix = "ragnarokPending/"
+	prefixRagnarokPosition            types.DbPrefix = "ragnarokPosition/"
+	prefixRagnarokPoolHeight          types.DbPrefix = "ragnarokPool/"
+	prefixErrataTx                    types.DbPrefix = "errata/"
+	prefixBanVoter                    types.DbPrefix = "ban/"
+	prefixNodeSlashPoints             types.DbPrefix = "slash/"
+	prefixNodeJail                    types.DbPrefix = "jail/"
+	prefixSwapQueueItem               types.DbPrefix = "swapitem/"
+	prefixAdvSwapQueueItem            types.DbPrefix = "aq/"
+	prefixAdvSwapQueueLimitIndex      types.DbPrefix = "aqlim/"
+	prefixAdvSwapQueueMarketIndex     types.DbPrefix = "aqmark/"
+	prefixAdvSwapQueueProcessor       types.DbPrefix = "aqproc/"
+	prefixAdvSwapQueueStopLossIndex   types.DbPrefix = "aqsl/"
+	prefixAdvSwapQueueTakeProfitIndex types.DbPrefix = "aqtp/"
+	prefixAdvSwapQueueExpiry          types.DbPrefix = "aqexp/"
+	prefixOutboundFeeWithheldRune     types.DbPrefix = "outbound_fee_withheld_rune/"
+	prefixOutboundFeeSpentRune        types.DbPrefix = "outbound_fee_spent_rune/"
+	prefixMimir                       types.DbPrefix = "mimir/"
+	prefixMinJoinLast                 types.DbPrefix = "minjoinlast/"
+	prefixNodeMimir                   types.DbPrefix = "nodemimir/"
+	prefixScheduledMimir              types.DbPrefix = "scheduled_mimir/"
+	prefixScheduledNodeMimir          types.DbPrefix = "scheduled_nodemimir/"
+	prefixMimirHistory                types.DbPrefix = "mimir_history/"
+	prefixNodeMimirHistory            types.DbPrefix = "nodemimir_history/"
+	prefixNodePauseChain              types.DbPrefix = "node_pause_chain/"
+	prefixNetworkFee                  types.DbPrefix = "network_fee/"
+	prefixNetworkFeeVoter             types.DbPrefix = "network_fee_voter/"
+	prefixTssKeygenMetric             types.DbPrefix = "tss_keygen_metric/"
+	prefixTssKeysignMetric            types.DbPrefix = "tss_keysign_metric/"
+	prefixTssKeysignMetricLatest      types.DbPrefix = "latest_tss_keysign_metric/"
+	prefixChainContract               types.DbPrefix = "chain_contract/"
+	prefixSolvencyVoter               types.DbPrefix = "solvency_voter/"
+	prefixTHORName                    types.DbPrefix = "thorname/"
+	prefixAffiliateCollector          types.DbPrefix = "affcol/"
+	prefixRollingPoolLiquidityFee     types.DbPrefix = "rolling_pool_liquidity_fee/"
+	prefixVersion                     types.DbPrefix = "version/"
+	prefixUpgradeProposals            types.DbPrefix = "upgr_props/"
+	prefixUpgradeVotes                types.DbPrefix = "upgr_votes/"
+	prefixTCYClaimer                  types.DbPrefix = "tcy_claimer/"
+	prefixTCYStaker                   types.DbPrefix = "tcy_staker/"
 )
 
 func dbError(ctx cosmos.Context, wrapper string, err error) error {
@@ -179,6 +196,20 @@ func (k KVStore) del(ctx cosmos.Context, key []byte) {
 	}
 }
 
+// delRange - delete the keys from start (inclusive) to end (exclusive)
+func (k KVStore) delRange(ctx cosmos.Context, start, end []byte) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := store.Iterator(start, end)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // has - kvstore has key
 func (k KVStore) has(ctx cosmos.Context, key []byte) bool {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -3,6 +3,7 @@ package keeperv1
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -38,6 +39,9 @@ func (k KVStore) SetAdvSwapQueueItem(ctx cosmos.Context, msg MsgSwap) error {
 	if msg.SwapType == types.SwapType_limit && msg.TradeTarget.IsZero() {
 		return fmt.Errorf("trade target cannot be zero for limit swaps")
 	}
+	if msg.IsTriggerOrder() && msg.TradeTarget.IsZero() {
+		return fmt.Errorf("trigger cannot be zero for stop-loss and take-profit orders")
+	}
 	if msg.Tx.ID.IsEmpty() {
 		return fmt.Errorf("invalid tx hash")
 	}
@@ -134,6 +138,14 @@ func (k KVStore) GetAdvSwapQueueIndexIterator(ctx cosmos.Context, swapType types
 	case types.SwapType_limit:
 		prefix := k.GetKey(prefixAdvSwapQueueLimitIndex, fmt.Sprintf("%s>%s/", source, target))
 		return cosmos.KVStoreReversePrefixIterator(store, prefix)
+	case types.SwapType_take_profit:
+		// highest ratio first, same as limit swaps
+		prefix := k.GetKey(prefixAdvSwapQueueTakeProfitIndex, fmt.Sprintf("%s>%s/", source, target))
+		return cosmos.KVStoreReversePrefixIterator(store, prefix)
+	case types.SwapType_stop_loss:
+		// lowest ratio first, as these trigger once the pool ratio rises to meet them
+		prefix := k.GetKey(prefixAdvSwapQueueStopLossIndex, fmt.Sprintf("%s>%s/", source, target))
+		return cosmos.KVStorePrefixIterator(store, prefix)
 	case types.SwapType_market:
 		return nil
 	default:
@@ -208,11 +220,18 @@ func (k KVStore) RemoveAdvSwapQueueIndex(ctx cosmos.Context, msg MsgSwap) error
 
 func (k KVStore) getAdvSwapQueueIndexKey(ctx cosmos.Context, msg MsgSwap) []byte {
 	switch msg.SwapType {
-	case types.SwapType_limit:
+	case types.SwapType_limit, types.SwapType_stop_loss, types.SwapType_take_profit:
+		prefix := prefixAdvSwapQueueLimitIndex
+		switch msg.SwapType {
+		case types.SwapType_stop_loss:
+			prefix = prefixAdvSwapQueueStopLossIndex
+		case types.SwapType_take_profit:
+			prefix = prefixAdvSwapQueueTakeProfitIndex
+		}
 		ra := rewriteRatio(ratioLength, getRatio(msg.Tx.Coins[0].Amount, msg.TradeTarget))
 		f := msg.Tx.Coins[0].Asset
 		t := msg.TargetAsset
-		return k.GetKey(prefixAdvSwapQueueLimitIndex, fmt.Sprintf("%s>%s/%s/", f.String(), t.String(), ra))
+		return k.GetKey(prefix, fmt.Sprintf("%s>%s/%s/", f.String(), t.String(), ra))
 	case types.SwapType_market:
 		return k.GetKey(prefixAdvSwapQueueMarketIndex, "")
 	default:
@@ -220,6 +239,53 @@ func (k KVStore) getAdvSwapQueueIndexKey(ctx cosmos.Context, msg MsgSwap) []byte
 	}
 }
 
+///-------------------------- Adv Swap Queue Expiry --------------------------///
+// Stop-loss and take-profit orders are indexed by the block height at which
+// they expire, so they can be refunded without iterating the whole queue.
+
+// SetAdvSwapQueueExpiry - records that the given adv swap queue item expires at height
+func (k KVStore) SetAdvSwapQueueExpiry(ctx cosmos.Context, height int64, txID common.TxID) error {
+	key := k.GetKey(prefixAdvSwapQueueExpiry, strconv.FormatInt(height, 10))
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, key, &record)
+	if err != nil {
+		return err
+	}
+	for _, r := range record {
+		if strings.EqualFold(txID.String(), r) {
+			return nil
+		}
+	}
+	record = append(record, txID.String())
+	k.setStrings(ctx, key, record)
+	return nil
+}
+
+// GetAdvSwapQueueExpiry - returns the adv swap queue items that expire at height
+func (k KVStore) GetAdvSwapQueueExpiry(ctx cosmos.Context, height int64) (common.TxIDs, error) {
+	key := k.GetKey(prefixAdvSwapQueueExpiry, strconv.FormatInt(height, 10))
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, key, &record)
+	if err != nil {
+		return nil, err
+	}
+	result := make(common.TxIDs, 0, len(record))
+	for _, rec := range record {
+		hash, err := common.NewTxID(rec)
+		if err != nil {
+			_ = dbError(ctx, fmt.Sprintf("failed to parse tx hash: (%s)", rec), err)
+			continue
+		}
+		result = append(result, hash)
+	}
+	return result, nil
+}
+
+// RemoveAdvSwapQueueExpiry - removes the expiry record for the given height
+func (k KVStore) RemoveAdvSwapQueueExpiry(ctx cosmos.Context, height int64) {
+	k.del(ctx, k.GetKey(prefixAdvSwapQueueExpiry, strconv.FormatInt(height, 10)))
+}
+
 func getRatio(input, output cosmos.Uint) string {
 	if output.IsZero() {
 		return "0"
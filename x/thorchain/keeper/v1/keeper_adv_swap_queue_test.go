@@ -96,3 +96,50 @@ func (s *KeeperAdvSwapQueueSuite) TestRemoveSlice(c *C) {
 	c.Check(removeString([]string{"foo", "bar", "baz"}, 3), DeepEquals, []string{"foo", "bar", "baz"})
 	c.Check(removeString([]string{"foo", "bar", "baz"}, -1), DeepEquals, []string{"foo", "bar", "baz"})
 }
+
+func (s *KeeperAdvSwapQueueSuite) TestKeeperAdvSwapQueueTriggerOrders(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// trigger orders require a trigger
+	msg := MsgSwap{
+		Tx:          GetRandomTx(),
+		TradeTarget: cosmos.ZeroUint(),
+		SwapType:    types.SwapType_stop_loss,
+	}
+	c.Assert(k.SetAdvSwapQueueItem(ctx, msg), NotNil)
+
+	msg.TradeTarget = cosmos.NewUint(10 * common.One)
+	c.Assert(k.SetAdvSwapQueueItem(ctx, msg), IsNil)
+	ok, err := k.HasAdvSwapQueueIndex(ctx, msg)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+
+	// stop-loss and take-profit orders are indexed separately from limit swaps
+	iter := k.GetAdvSwapQueueIndexIterator(ctx, types.SwapType_limit, msg.Tx.Coins[0].Asset, msg.TargetAsset)
+	c.Check(iter.Valid(), Equals, false)
+	iter.Close()
+	iter = k.GetAdvSwapQueueIndexIterator(ctx, types.SwapType_take_profit, msg.Tx.Coins[0].Asset, msg.TargetAsset)
+	c.Check(iter.Valid(), Equals, false)
+	iter.Close()
+	iter = k.GetAdvSwapQueueIndexIterator(ctx, types.SwapType_stop_loss, msg.Tx.Coins[0].Asset, msg.TargetAsset)
+	c.Check(iter.Valid(), Equals, true)
+	iter.Close()
+
+	// expiry
+	hashes, err := k.GetAdvSwapQueueExpiry(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 0)
+	txID := GetRandomTxHash()
+	c.Assert(k.SetAdvSwapQueueExpiry(ctx, 100, msg.Tx.ID), IsNil)
+	c.Assert(k.SetAdvSwapQueueExpiry(ctx, 100, msg.Tx.ID), IsNil)
+	c.Assert(k.SetAdvSwapQueueExpiry(ctx, 100, txID), IsNil)
+	hashes, err = k.GetAdvSwapQueueExpiry(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Assert(hashes, HasLen, 2)
+	c.Check(hashes[0].Equals(msg.Tx.ID), Equals, true)
+	c.Check(hashes[1].Equals(txID), Equals, true)
+	k.RemoveAdvSwapQueueExpiry(ctx, 100)
+	hashes, err = k.GetAdvSwapQueueExpiry(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 0)
+}
@@ -89,3 +89,34 @@ func (k KVStore) AnchorMedian(ctx cosmos.Context, assets []common.Asset) cosmos.
 	}
 	return common.GetMedianUint(p)
 }
+
+// AnchorMedianTWAP - the median of the anchor pool TWAPs over the given blocks,
+// in the same terms as AnchorMedian. Anchors without a TWAP over the blocks are
+// skipped, zero if none have one.
+func (k KVStore) AnchorMedianTWAP(ctx cosmos.Context, assets []common.Asset, blocks int64) cosmos.Uint {
+	p := make([]cosmos.Uint, 0)
+	for _, asset := range assets {
+		if k.IsGlobalTradingHalted(ctx) || k.IsChainTradingHalted(ctx, asset.Chain) {
+			continue
+		}
+		pool, err := k.GetPool(ctx, asset)
+		if err != nil {
+			ctx.Logger().Error("fail to get usd pool", "asset", asset.String(), "error", err)
+			continue
+		}
+		if !pool.IsAvailable() {
+			continue
+		}
+		// the twap is in rune per asset
+		twap, err := k.GetPoolTWAP(ctx, asset, blocks)
+		if err != nil || twap.IsZero() {
+			continue
+		}
+		value := common.GetUncappedShare(cosmos.NewUint(common.One), twap, cosmos.NewUint(constants.DollarMulti*common.One))
+
+		if !value.IsZero() {
+			p = append(p, value)
+		}
+	}
+	return common.GetMedianUint(p)
+}
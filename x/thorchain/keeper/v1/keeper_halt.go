@@ -19,6 +19,8 @@ func (k KVStore) IsTradingHalt(ctx cosmos.Context, msg cosmos.Msg) bool {
 		if !(source.IsSyntheticAsset() && m.TargetAsset.Equals(source.GetLayer1Asset())) {
 			checkAssets = []common.Asset{m.Tx.Coins[0].Asset, m.TargetAsset}
 		}
+		// intermediate pools of a routed swap are traded through as well
+		checkAssets = append(checkAssets, m.Route...)
 
 	case *MsgAddLiquidity:
 		checkAssets = []common.Asset{m.Asset}
@@ -37,6 +39,22 @@ func (k KVStore) IsTradingHalt(ctx cosmos.Context, msg cosmos.Msg) bool {
 		}
 		target := m.TargetAsset.GetLayer1Asset().Chain
 
+		if k.IsPoolTradingHalted(ctx, sourceAsset) || k.IsPoolTradingHalted(ctx, m.TargetAsset) {
+			return true
+		}
+		for _, hop := range m.Route {
+			if k.IsPoolTradingHalted(ctx, hop) {
+				return true
+			}
+			if hop.IsTCY() {
+				if k.IsTCYTradingHalted(ctx) {
+					return true
+				}
+			} else if k.IsChainTradingHalted(ctx, hop.GetLayer1Asset().Chain) {
+				return true
+			}
+		}
+
 		if sourceAsset.IsTCY() {
 			return k.IsTCYTradingHalted(ctx) || k.IsChainTradingHalted(ctx, target)
 		} else if m.TargetAsset.IsTCY() {
@@ -45,6 +63,9 @@ func (k KVStore) IsTradingHalt(ctx cosmos.Context, msg cosmos.Msg) bool {
 
 		return k.IsChainTradingHalted(ctx, source) || k.IsChainTradingHalted(ctx, target) || k.IsGlobalTradingHalted(ctx)
 	case *MsgAddLiquidity:
+		if k.IsPoolTradingHalted(ctx, m.Asset) {
+			return true
+		}
 		if m.Asset.IsTCY() {
 			return k.IsTCYTradingHalted(ctx)
 		}
@@ -73,6 +94,45 @@ func (k KVStore) IsChainTradingHalted(ctx cosmos.Context, chain common.Chain) bo
 	return k.IsChainHalted(ctx, chain)
 }
 
+// IsPoolTradingHalted returns true if the pool of the asset is halted, either by
+// HaltPool (admin or node vote) until any scheduled ResumePool height, or by the
+// solvency checker. Synth, trade, secured and derived assets use the layer1 pool.
+func (k KVStore) IsPoolTradingHalted(ctx cosmos.Context, asset common.Asset) bool {
+	asset = asset.GetLayer1Asset()
+	if asset.IsDerivedAsset() {
+		if asset.Equals(common.TOR) {
+			return false
+		}
+		// NOTE: if the symbol of a derived asset isn't the chain, this won't work
+		// (ie GAIA.ATOM)
+		chain, err := common.NewChain(asset.Symbol.String())
+		if err != nil {
+			return false
+		}
+		asset.Chain = chain
+	}
+	if asset.IsEmpty() || asset.IsRune() {
+		return false
+	}
+
+	haltPool, err := k.GetMimirWithRef(ctx, constants.MimirTemplatePoolHalt, asset.MimirString())
+	if err == nil && haltPool > 0 && haltPool <= ctx.BlockHeight() {
+		// a resume height only applies to the halt preceding it
+		resumePool, err := k.GetMimirWithRef(ctx, constants.MimirTemplatePoolResume, asset.MimirString())
+		if err != nil || resumePool <= haltPool || resumePool > ctx.BlockHeight() {
+			ctx.Logger().Debug("pool is halt", "asset", asset)
+			return true
+		}
+	}
+
+	solvencyHaltPool, err := k.GetMimirWithRef(ctx, constants.MimirTemplateSolvencyPoolHalt, asset.MimirString())
+	if err == nil && solvencyHaltPool > 0 && solvencyHaltPool <= ctx.BlockHeight() {
+		ctx.Logger().Debug("pool is halt via solvency check", "asset", asset)
+		return true
+	}
+	return false
+}
+
 func (k KVStore) IsChainHalted(ctx cosmos.Context, chain common.Chain) bool {
 	haltChain, err := k.GetMimir(ctx, "HaltChainGlobal")
 	if err == nil && (haltChain > 0 && haltChain <= ctx.BlockHeight()) {
@@ -322,3 +322,68 @@ func (s *KeeperHaltSuite) TestIsPoolDepositPaused(c *C) {
 	c.Check(k.IsPoolDepositPaused(ctx, common.BTCAsset), Equals, false)
 	c.Check(k.IsPoolDepositPaused(ctx, common.ETHAsset), Equals, false)
 }
+
+func (s *KeeperHaltSuite) TestIsPoolTradingHalted(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+
+	tx := common.Tx{Coins: common.Coins{common.Coin{Asset: common.RuneAsset()}}}
+	swapMsg := &MsgSwap{Tx: tx, TargetAsset: common.ETHAsset.GetSyntheticAsset()}
+	addMsg := &MsgAddLiquidity{Asset: common.ETHAsset}
+
+	// no halts
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, false)
+	c.Check(k.IsPoolTradingHalted(ctx, common.RuneAsset()), Equals, false)
+	c.Check(k.IsTradingHalt(ctx, swapMsg), Equals, false)
+
+	// halts from a future height do not apply yet
+	k.SetMimir(ctx, "HaltPool-ETH-ETH", 11)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, false)
+
+	// the layer1 pool halt applies to all asset types
+	k.SetMimir(ctx, "HaltPool-ETH-ETH", 5)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, true)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset.GetTradeAsset()), Equals, true)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset.GetSecuredAsset()), Equals, true)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset.GetDerivedAsset()), Equals, true)
+	c.Check(k.IsPoolTradingHalted(ctx, common.BTCAsset.GetDerivedAsset()), Equals, false)
+	c.Check(k.IsPoolTradingHalted(ctx, common.TOR), Equals, false)
+	c.Check(k.IsPoolTradingHalted(ctx, common.BTCAsset), Equals, false)
+	c.Check(k.IsChainTradingHalted(ctx, common.ETHChain), Equals, false)
+	c.Check(k.IsTradingHalt(ctx, swapMsg), Equals, true)
+	c.Check(k.IsTradingHalt(ctx, addMsg), Equals, true)
+	swapMsg.Tx.Coins[0].Asset = common.ETHAsset
+	swapMsg.TargetAsset = common.RuneAsset()
+	c.Check(k.IsTradingHalt(ctx, swapMsg), Equals, true)
+
+	// scheduled resume
+	k.SetMimir(ctx, "ResumePool-ETH-ETH", 12)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, true)
+	c.Check(k.IsPoolTradingHalted(ctx.WithBlockHeight(12), common.ETHAsset), Equals, false)
+	c.Check(k.IsTradingHalt(ctx.WithBlockHeight(12), swapMsg), Equals, false)
+
+	// a resume before the halt height does not lift a later halt
+	k.SetMimir(ctx, "ResumePool-ETH-ETH", 4)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, true)
+	_ = k.DeleteMimir(ctx, "HaltPool-ETH-ETH")
+	_ = k.DeleteMimir(ctx, "ResumePool-ETH-ETH")
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, false)
+
+	// solvency halt
+	k.SetMimir(ctx, "SolvencyHaltPool-ETH-ETH", 10)
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, true)
+	_ = k.DeleteMimir(ctx, "SolvencyHaltPool-ETH-ETH")
+	c.Check(k.IsPoolTradingHalted(ctx, common.ETHAsset), Equals, false)
+
+	// the pools and chains of route hops are checked
+	routeMsg := &MsgSwap{Tx: tx, TargetAsset: common.BTCAsset, Route: []common.Asset{common.ETHAsset}}
+	routeMsg.Tx.Coins = common.Coins{common.Coin{Asset: common.DOGEAsset}}
+	c.Check(k.IsTradingHalt(ctx, routeMsg), Equals, false)
+	k.SetMimir(ctx, "HaltPool-ETH-ETH", 5)
+	c.Check(k.IsTradingHalt(ctx, routeMsg), Equals, true)
+	_ = k.DeleteMimir(ctx, "HaltPool-ETH-ETH")
+	k.SetMimir(ctx, "HaltETHTrading", 5)
+	c.Check(k.IsTradingHalt(ctx, routeMsg), Equals, true)
+	_ = k.DeleteMimir(ctx, "HaltETHTrading")
+	c.Check(k.IsTradingHalt(ctx, routeMsg), Equals, false)
+}
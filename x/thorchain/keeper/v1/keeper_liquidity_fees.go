@@ -30,6 +30,10 @@ func (k KVStore) AddToLiquidityFees(ctx cosmos.Context, asset common.Asset, fee
 	// update pool liquidity
 	k.setUint64(ctx, k.GetKey(prefixPoolLiquidityFee, fmt.Sprintf("%d-%s", currentHeight, asset.String())), poolFees.Uint64())
 
+	history := NewPoolHistory(asset, ctx.BlockHeight())
+	history.LiquidityFees = fee
+	k.AddPoolHistory(ctx, history)
+
 	var currentValue uint64
 	currentValue, err = k.GetRollingPoolLiquidityFee(ctx, asset)
 	if err != nil {
@@ -2,10 +2,14 @@ package keeperv1
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper/types"
 )
 
 // GetMimir get a mimir value from key value store
@@ -25,7 +29,14 @@ func (k KVStore) GetMimirWithRef(ctx cosmos.Context, template string, ref ...any
 
 // SetMimir save a mimir value to key value store
 func (k KVStore) SetMimir(ctx cosmos.Context, key string, value int64) {
+	previous, err := k.GetMimir(ctx, key)
+	if err != nil {
+		ctx.Logger().Error("fail to get mimir value", "key", key, "error", err)
+	}
 	k.setInt64(ctx, k.GetKey(prefixMimir, key), value)
+	if previous != value {
+		k.addMimirHistory(ctx, NewMimirHistory(key, value, previous, ctx.BlockHeight(), nil))
+	}
 }
 
 // GetNodeMimirs get node mimirs value from key value store
@@ -51,6 +62,10 @@ func (k KVStore) SetNodeMimir(ctx cosmos.Context, key string, value int64, acc c
 	if err != nil {
 		return err
 	}
+	previous, ok := record.Get(key, acc)
+	if !ok {
+		previous = -1
+	}
 	record.Set(key, value, acc)
 
 	// delete the node mimir if value is negative
@@ -65,6 +80,13 @@ func (k KVStore) SetNodeMimir(ctx cosmos.Context, key string, value int64, acc c
 	} else {
 		store.Set(kvkey, buf)
 	}
+
+	if value < 0 {
+		value = -1
+	}
+	if previous != value {
+		k.addMimirHistory(ctx, NewMimirHistory(key, value, previous, ctx.BlockHeight(), acc))
+	}
 	return err
 }
 
@@ -95,7 +117,14 @@ func (k KVStore) GetNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator {
 }
 
 func (k KVStore) DeleteMimir(ctx cosmos.Context, key string) error {
+	previous, err := k.GetMimir(ctx, key)
+	if err != nil {
+		return err
+	}
 	k.del(ctx, k.GetKey(prefixMimir, key))
+	if previous >= 0 {
+		k.addMimirHistory(ctx, NewMimirHistory(key, -1, previous, ctx.BlockHeight(), nil))
+	}
 	return nil
 }
 
@@ -150,3 +179,170 @@ func (k KVStore) IsOperationalMimir(key string) bool {
 
 	return false
 }
+
+func (k KVStore) getScheduledMimirKey(prefix types.DbPrefix, key string, height int64) []byte {
+	return k.GetKey(prefix, fmt.Sprintf("%012d/%s", height, key))
+}
+
+// GetScheduledMimir get the mimir value scheduled to take effect at the given height
+func (k KVStore) GetScheduledMimir(ctx cosmos.Context, key string, height int64) (ScheduledMimir, bool, error) {
+	record := ScheduledMimir{}
+	kvkey := k.getScheduledMimirKey(prefixScheduledMimir, key, height)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !store.Has(kvkey) {
+		return record, false, nil
+	}
+	if err := k.cdc.Unmarshal(store.Get(kvkey), &record); err != nil {
+		return ScheduledMimir{}, true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, kvkey), err)
+	}
+	return record, true, nil
+}
+
+// SetScheduledMimir save a mimir value that takes effect at a future height
+func (k KVStore) SetScheduledMimir(ctx cosmos.Context, mimir ScheduledMimir) error {
+	if !mimir.Valid() {
+		return fmt.Errorf("invalid scheduled mimir: %s at height %d", mimir.Key, mimir.Height)
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(k.getScheduledMimirKey(prefixScheduledMimir, mimir.Key, mimir.Height), k.cdc.MustMarshal(&mimir))
+	return nil
+}
+
+// DeleteScheduledMimir removes a scheduled mimir value
+func (k KVStore) DeleteScheduledMimir(ctx cosmos.Context, key string, height int64) {
+	k.del(ctx, k.getScheduledMimirKey(prefixScheduledMimir, key, height))
+}
+
+// GetScheduledMimirIterator iterate scheduled mimirs, ordered by activation height
+func (k KVStore) GetScheduledMimirIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixScheduledMimir)
+}
+
+// GetScheduledNodeMimirs get node votes for a mimir value scheduled at the given height
+func (k KVStore) GetScheduledNodeMimirs(ctx cosmos.Context, key string, height int64) (NodeMimirs, error) {
+	record := NodeMimirs{}
+	kvkey := k.getScheduledMimirKey(prefixScheduledNodeMimir, key, height)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !store.Has(kvkey) {
+		return record, nil
+	}
+	if err := k.cdc.Unmarshal(store.Get(kvkey), &record); err != nil {
+		return NodeMimirs{}, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, kvkey), err)
+	}
+	return record, nil
+}
+
+// SetScheduledNodeMimir save a node vote for a mimir value taking effect at the
+// given height, a negative value withdraws the vote
+func (k KVStore) SetScheduledNodeMimir(ctx cosmos.Context, key string, value, height int64, acc cosmos.AccAddress) error {
+	key = strings.ToUpper(key)
+	kvkey := k.getScheduledMimirKey(prefixScheduledNodeMimir, key, height)
+	record, err := k.GetScheduledNodeMimirs(ctx, key, height)
+	if err != nil {
+		return err
+	}
+	record.Set(key, value, acc)
+
+	// delete the node vote if value is negative
+	if value < 0 {
+		record.Delete(key, acc)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil || len(record.Mimirs) == 0 {
+		store.Delete(kvkey)
+	} else {
+		store.Set(kvkey, buf)
+	}
+	return nil
+}
+
+// DeleteScheduledNodeMimirs deletes all node votes for a scheduled mimir
+func (k KVStore) DeleteScheduledNodeMimirs(ctx cosmos.Context, key string, height int64) {
+	k.del(ctx, k.getScheduledMimirKey(prefixScheduledNodeMimir, key, height))
+}
+
+// GetScheduledNodeMimirIterator iterate node votes for scheduled mimirs
+func (k KVStore) GetScheduledNodeMimirIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixScheduledNodeMimir)
+}
+
+// addMimirHistory appends a mimir change to the history of its key, and to the
+// history of the signer for node votes. Records older than MimirHistoryBlocks are
+// pruned as new ones are added. Keys the protocol sets itself, such as
+// MaxRuneSupply, are not recorded.
+func (k KVStore) addMimirHistory(ctx cosmos.Context, record MimirHistory) {
+	if strings.EqualFold(record.Key, constants.MaxRuneSupply.String()) {
+		return
+	}
+	blocks := k.GetConfigInt64(ctx, constants.MimirHistoryBlocks)
+	if blocks <= 0 {
+		return
+	}
+	cutoff := ctx.BlockHeight() - blocks
+
+	k.appendMimirHistory(ctx, k.GetKey(prefixMimirHistory, record.Key, "/"), record, cutoff)
+	if record.IsNodeVote() {
+		k.appendMimirHistory(ctx, k.GetKey(prefixNodeMimirHistory, record.Signer.String(), "/"), record, cutoff)
+	}
+}
+
+func (k KVStore) appendMimirHistory(ctx cosmos.Context, prefix []byte, record MimirHistory, cutoff int64) {
+	// records are keyed by height, so stale ones are a range at the start
+	if cutoff > 0 {
+		k.delRange(ctx, prefix, mimirHistoryHeightKey(prefix, cutoff))
+	}
+
+	// the sequence follows the last record of the same height
+	height := mimirHistoryHeightKey(prefix, record.Height)
+	seq := int64(0)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iter := store.ReverseIterator(height, storetypes.PrefixEndBytes(height))
+	if iter.Valid() {
+		last, err := strconv.ParseInt(string(iter.Key()[len(height):]), 10, 64)
+		if err != nil {
+			ctx.Logger().Error("fail to parse mimir history sequence", "key", string(iter.Key()), "error", err)
+		}
+		seq = last + 1
+	}
+	iter.Close()
+
+	key := append(height, []byte(fmt.Sprintf("%06d", seq))...)
+	store.Set(key, k.cdc.MustMarshal(&record))
+}
+
+// mimirHistoryHeightKey returns the key prefix of the history records at a height
+func mimirHistoryHeightKey(prefix []byte, height int64) []byte {
+	return append(append([]byte{}, prefix...), []byte(fmt.Sprintf("%012d/", height))...)
+}
+
+func (k KVStore) getMimirHistory(ctx cosmos.Context, prefix []byte) ([]MimirHistory, error) {
+	history := make([]MimirHistory, 0)
+	iter := k.getIterator(ctx, types.DbPrefix(prefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record MimirHistory
+		if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, iter.Key()), err)
+		}
+		history = append(history, record)
+	}
+
+	// newest first
+	for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+		history[i], history[j] = history[j], history[i]
+	}
+	return history, nil
+}
+
+// GetMimirHistory returns the changes to a mimir value and the node votes for
+// it, newest first
+func (k KVStore) GetMimirHistory(ctx cosmos.Context, key string) ([]MimirHistory, error) {
+	return k.getMimirHistory(ctx, k.GetKey(prefixMimirHistory, key, "/"))
+}
+
+// GetNodeMimirHistory returns the mimir votes of a node, newest first
+func (k KVStore) GetNodeMimirHistory(ctx cosmos.Context, acc cosmos.AccAddress) ([]MimirHistory, error) {
+	return k.getMimirHistory(ctx, k.GetKey(prefixNodeMimirHistory, acc.String(), "/"))
+}
@@ -2,6 +2,8 @@ package keeperv1
 
 import (
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/constants"
 )
 
 type KeeperMimirSuite struct{}
@@ -21,3 +23,70 @@ func (s *KeeperMimirSuite) TestMimir(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(val, Equals, int64(-1))
 }
+
+func (s *KeeperMimirSuite) TestMimirHistory(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(100)
+	k.SetMimir(ctx, constants.MimirHistoryBlocks.String(), 50)
+
+	k.SetMimir(ctx, "foo", 1)
+	k.SetMimir(ctx, "foo", 1) // unchanged, not recorded
+	k.SetMimir(ctx, "foo", 2)
+
+	acc := GetRandomBech32Addr()
+	c.Assert(k.SetNodeMimir(ctx, "foo", 3, acc), IsNil)
+	c.Assert(k.SetNodeMimir(ctx.WithBlockHeight(120), "foo", -1, acc), IsNil)
+
+	history, err := k.GetMimirHistory(ctx, "FOO")
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 4)
+	c.Check(history[0].Value, Equals, int64(-1))
+	c.Check(history[0].PreviousValue, Equals, int64(3))
+	c.Check(history[0].Height, Equals, int64(120))
+	c.Check(history[0].Signer.Equals(acc), Equals, true)
+	c.Check(history[1].Value, Equals, int64(3))
+	c.Check(history[1].PreviousValue, Equals, int64(-1))
+	c.Check(history[1].IsNodeVote(), Equals, true)
+	c.Check(history[2].Value, Equals, int64(2))
+	c.Check(history[2].PreviousValue, Equals, int64(1))
+	c.Check(history[2].IsNodeVote(), Equals, false)
+	c.Check(history[3].Value, Equals, int64(1))
+	c.Check(history[3].PreviousValue, Equals, int64(-1))
+
+	nodeHistory, err := k.GetNodeMimirHistory(ctx, acc)
+	c.Assert(err, IsNil)
+	c.Assert(nodeHistory, HasLen, 2)
+	c.Check(nodeHistory[0].Height, Equals, int64(120))
+
+	// records older than MimirHistoryBlocks are pruned as new ones are added
+	c.Assert(k.DeleteMimir(ctx.WithBlockHeight(160), "foo"), IsNil)
+	history, err = k.GetMimirHistory(ctx, "foo")
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 2)
+	c.Check(history[0].Value, Equals, int64(-1))
+	c.Check(history[0].PreviousValue, Equals, int64(2))
+	c.Check(history[1].Height, Equals, int64(120))
+
+	// other keys sharing the prefix are unaffected
+	history, err = k.GetMimirHistory(ctx, "fo")
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 0)
+
+	// records of the same height keep their order
+	for i := int64(1); i <= 12; i++ {
+		k.SetMimir(ctx.WithBlockHeight(170), "bar", i)
+	}
+	history, err = k.GetMimirHistory(ctx, "bar")
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 12)
+	for i, record := range history {
+		c.Check(record.Value, Equals, int64(12-i))
+	}
+
+	// protocol driven keys are not recorded
+	k.SetMimir(ctx, constants.MaxRuneSupply.String(), 100)
+	k.SetMimir(ctx, constants.MaxRuneSupply.String(), 99)
+	history, err = k.GetMimirHistory(ctx, constants.MaxRuneSupply.String())
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 0)
+}
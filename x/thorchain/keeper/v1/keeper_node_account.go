@@ -101,14 +101,18 @@ func (k KVStore) RemoveLowBondValidatorAccounts(ctx cosmos.Context) error {
 			continue
 		}
 		if na.Type == NodeTypeValidator && na.Bond.LTE(cosmos.NewUint(common.One)) {
-			lowBondValidators = append(lowBondValidators, naIterator.Key())
-			if na.Bond.IsZero() {
-				continue
-			}
 			bps, err := k.GetBondProviders(ctx, na.NodeAddress)
 			if err != nil {
 				return err
 			}
+			// keep the node until its liquid bond receipts are redeemed
+			if !bps.LiquidBondUnits.IsZero() {
+				continue
+			}
+			lowBondValidators = append(lowBondValidators, naIterator.Key())
+			if na.Bond.IsZero() {
+				continue
+			}
 			to, err := na.BondAddress.AccAddress()
 			if err != nil {
 				return dbError(ctx, "", fmt.Errorf("fail to parse bond address(%s)", na.BondAddress))
@@ -156,6 +160,12 @@ func (k KVStore) RemoveLowBondValidatorAccounts(ctx cosmos.Context) error {
 
 			// remove bond providers
 			k.del(ctx, k.GetKey(prefixBondProviders, na.NodeAddress.String()))
+			if err := k.setBondProvidersIndex(ctx, prefixBondProviderUnbondNodes, na.NodeAddress, false); err != nil {
+				return err
+			}
+			if err := k.setBondProvidersIndex(ctx, prefixNodeOperatorFeeNodes, na.NodeAddress, false); err != nil {
+				return err
+			}
 		}
 	}
 	for _, naKey := range lowBondValidators {
@@ -497,15 +507,70 @@ func (k KVStore) getBondProviders(ctx cosmos.Context, key []byte, record *BondPr
 func (k KVStore) GetBondProviders(ctx cosmos.Context, addr cosmos.AccAddress) (BondProviders, error) {
 	record := NewBondProviders(addr)
 	_, err := k.getBondProviders(ctx, k.GetKey(prefixBondProviders, addr.String()), &record)
+	// records saved before the unbond request and liquid bond fields were added
+	// decode them as nil
+	if record.LiquidBondUnits.IsNil() {
+		record.LiquidBondUnits = cosmos.ZeroUint()
+	}
+	if record.PendingNodeOperatorFee.IsNil() {
+		record.PendingNodeOperatorFee = cosmos.ZeroUint()
+	}
+	for i := range record.Providers {
+		if record.Providers[i].UnbondAmount.IsNil() {
+			record.Providers[i].UnbondAmount = cosmos.ZeroUint()
+		}
+	}
 	return record, err
 }
 
 // SetBondProviders - update the bond providers of a node account
 func (k KVStore) SetBondProviders(ctx cosmos.Context, record BondProviders) error {
 	k.setBondProviders(ctx, k.GetKey(prefixBondProviders, record.NodeAddress.String()), record)
+	if err := k.setBondProvidersIndex(ctx, prefixBondProviderUnbondNodes, record.NodeAddress, record.HasUnbondRequests()); err != nil {
+		return err
+	}
+	return k.setBondProvidersIndex(ctx, prefixNodeOperatorFeeNodes, record.NodeAddress, record.HasPendingNodeOperatorFee())
+}
+
+// setBondProvidersIndex - keeps an index of node accounts with pending bond
+// provider changes (unbond requests, operator fee changes) in sync, so they can
+// be processed without iterating every node account
+func (k KVStore) setBondProvidersIndex(ctx cosmos.Context, prefix types.DbPrefix, addr cosmos.AccAddress, pending bool) error {
+	key := k.GetKey(prefix, "")
+	record := make([]cosmos.AccAddress, 0)
+	if _, err := k.getAccAddresses(ctx, key, &record); err != nil {
+		return err
+	}
+	for i, rec := range record {
+		if rec.Equals(addr) {
+			if !pending {
+				record = append(record[:i], record[i+1:]...)
+				k.setAccAddresses(ctx, key, record)
+			}
+			return nil
+		}
+	}
+	if pending {
+		record = append(record, addr)
+		k.setAccAddresses(ctx, key, record)
+	}
 	return nil
 }
 
+// GetBondProviderUnbondNodes - gets the node accounts with pending bond provider unbond requests
+func (k KVStore) GetBondProviderUnbondNodes(ctx cosmos.Context) ([]cosmos.AccAddress, error) {
+	record := make([]cosmos.AccAddress, 0)
+	_, err := k.getAccAddresses(ctx, k.GetKey(prefixBondProviderUnbondNodes, ""), &record)
+	return record, err
+}
+
+// GetNodeOperatorFeeNodes - gets the node accounts with a scheduled node operator fee change
+func (k KVStore) GetNodeOperatorFeeNodes(ctx cosmos.Context) ([]cosmos.AccAddress, error) {
+	record := make([]cosmos.AccAddress, 0)
+	_, err := k.getAccAddresses(ctx, k.GetKey(prefixNodeOperatorFeeNodes, ""), &record)
+	return record, err
+}
+
 func (k KVStore) DeductNativeTxFeeFromBond(ctx cosmos.Context, nodeAddr cosmos.AccAddress) error {
 	fee := k.GetNativeTxFee(ctx)
 	if fee.IsZero() {
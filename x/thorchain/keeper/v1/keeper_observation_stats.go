@@ -0,0 +1,81 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper/types"
+)
+
+func (k KVStore) setObservationStats(ctx cosmos.Context, key []byte, record ObservationStats) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete(key)
+	} else {
+		store.Set(key, buf)
+	}
+}
+
+func (k KVStore) getObservationStats(ctx cosmos.Context, key []byte, record *ObservationStats) (bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !store.Has(key) {
+		return false, nil
+	}
+
+	bz := store.Get(key)
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// SetObservationStats save the observation stats of a node on a chain
+func (k KVStore) SetObservationStats(ctx cosmos.Context, stats ObservationStats) {
+	if !stats.Valid() {
+		return
+	}
+	key := k.GetKey(prefixObservationStats, stats.NodeAddress.String(), "/", stats.Chain.String())
+	k.setObservationStats(ctx, key, stats)
+}
+
+// GetObservationStats retrieve the observation stats of a node on a chain
+func (k KVStore) GetObservationStats(ctx cosmos.Context, addr cosmos.AccAddress, chain common.Chain) (ObservationStats, error) {
+	record := NewObservationStats(addr, chain)
+	key := k.GetKey(prefixObservationStats, addr.String(), "/", chain.String())
+	_, err := k.getObservationStats(ctx, key, &record)
+	return record, err
+}
+
+// GetObservationStatsByNode retrieve the observation stats of a node on all chains
+func (k KVStore) GetObservationStatsByNode(ctx cosmos.Context, addr cosmos.AccAddress) ([]ObservationStats, error) {
+	prefixKey := k.GetKey(prefixObservationStats, addr.String(), "/")
+	iter := k.getIterator(ctx, types.DbPrefix(prefixKey))
+	defer iter.Close()
+	result := make([]ObservationStats, 0)
+	for ; iter.Valid(); iter.Next() {
+		var record ObservationStats
+		if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, iter.Key()), err)
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+// RemoveObservationStatsByNode delete the observation stats of a node on all chains
+func (k KVStore) RemoveObservationStatsByNode(ctx cosmos.Context, addr cosmos.AccAddress) {
+	prefixKey := k.GetKey(prefixObservationStats, addr.String(), "/")
+	iter := k.getIterator(ctx, types.DbPrefix(prefixKey))
+	defer iter.Close()
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		k.del(ctx, key)
+	}
+}
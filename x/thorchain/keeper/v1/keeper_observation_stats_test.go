@@ -0,0 +1,58 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+)
+
+type KeeperObservationStatsSuite struct{}
+
+var _ = Suite(&KeeperObservationStatsSuite{})
+
+func (s *KeeperObservationStatsSuite) TestObservationStats(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+
+	stats, err := k.GetObservationStats(ctx, addr, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(stats.NodeAddress.Equals(addr), Equals, true)
+	c.Check(stats.Chain.Equals(common.BTCChain), Equals, true)
+	c.Check(stats.ObservationLags, HasLen, 0)
+
+	stats.RecordObservation(10, "tx1", 1, 100)
+	k.SetObservationStats(ctx, stats)
+	ethStats := NewObservationStats(addr, common.ETHChain)
+	ethStats.RecordNetworkFee(11, "100/10/250", 0, 100)
+	k.SetObservationStats(ctx, ethStats)
+	otherStats := NewObservationStats(GetRandomBech32Addr(), common.BTCChain)
+	otherStats.RecordObservation(12, "tx2", 0, 100)
+	k.SetObservationStats(ctx, otherStats)
+
+	stats, err = k.GetObservationStats(ctx, addr, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{1})
+	c.Check(stats.LastUpdatedHeight, Equals, int64(10))
+
+	all, err := k.GetObservationStatsByNode(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Assert(all, HasLen, 2)
+	c.Check(all[0].Chain.Equals(common.BTCChain), Equals, true)
+	c.Check(all[1].Chain.Equals(common.ETHChain), Equals, true)
+	c.Check(all[1].NetworkFeeLags, DeepEquals, []int64{0})
+
+	// invalid stats are not saved
+	k.SetObservationStats(ctx, NewObservationStats(addr, common.EmptyChain))
+	all, err = k.GetObservationStatsByNode(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(all, HasLen, 2)
+
+	// removing a node's stats leaves the other nodes' stats
+	k.RemoveObservationStatsByNode(ctx, addr)
+	all, err = k.GetObservationStatsByNode(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(all, HasLen, 0)
+	all, err = k.GetObservationStatsByNode(ctx, otherStats.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(all, HasLen, 1)
+}
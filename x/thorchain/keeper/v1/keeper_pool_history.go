@@ -0,0 +1,112 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper/types"
+)
+
+func (k KVStore) setPoolHistory(ctx cosmos.Context, key []byte, record PoolHistory) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete(key)
+	} else {
+		store.Set(key, buf)
+	}
+}
+
+func (k KVStore) getPoolHistory(ctx cosmos.Context, key []byte, record *PoolHistory) (bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !store.Has(key) {
+		return false, nil
+	}
+
+	bz := store.Get(key)
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+func (k KVStore) getPoolHistoryKey(asset common.Asset, day int64) []byte {
+	// zero padded so the intervals of a pool iterate in order
+	return k.GetKey(prefixPoolHistory, asset.String(), "/", fmt.Sprintf("%012d", day))
+}
+
+// GetPoolHistoryIntervalBlocks - the number of blocks in each pool history interval (one day)
+func (k KVStore) GetPoolHistoryIntervalBlocks() int64 {
+	return k.GetConstants().GetInt64Value(constants.BlocksPerYear) / 365
+}
+
+// AddPoolHistory - add the amounts of the given record to the pool history
+// interval of the current block. Intervals older than PoolHistoryDays are pruned
+// when a new interval of the pool starts.
+func (k KVStore) AddPoolHistory(ctx cosmos.Context, record PoolHistory) {
+	days := k.GetConfigInt64(ctx, constants.PoolHistoryDays)
+	if days <= 0 || !record.Valid() || record.IsEmpty() {
+		return
+	}
+	intervalBlocks := k.GetPoolHistoryIntervalBlocks()
+	day := ctx.BlockHeight() / intervalBlocks
+	key := k.getPoolHistoryKey(record.Asset, day)
+
+	history := NewPoolHistory(record.Asset, day*intervalBlocks)
+	found, err := k.getPoolHistory(ctx, key, &history)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool history", "asset", record.Asset, "error", err)
+		return
+	}
+	if !found {
+		k.prunePoolHistory(ctx, record.Asset, (day-days+1)*intervalBlocks)
+	}
+	history.Add(record)
+	k.setPoolHistory(ctx, key, history)
+}
+
+// prunePoolHistory - remove the pool history intervals starting before the given height
+func (k KVStore) prunePoolHistory(ctx cosmos.Context, asset common.Asset, height int64) {
+	prefix := k.GetKey(prefixPoolHistory, asset.String(), "/")
+	iter := k.getIterator(ctx, types.DbPrefix(prefix))
+	var stale [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var record PoolHistory
+		if err := k.cdc.Unmarshal(iter.Value(), &record); err != nil {
+			ctx.Logger().Error("fail to unmarshal pool history", "key", string(iter.Key()), "error", err)
+			continue
+		}
+		if record.StartHeight >= height {
+			break
+		}
+		stale = append(stale, iter.Key())
+	}
+	iter.Close()
+	for _, key := range stale {
+		k.del(ctx, key)
+	}
+}
+
+// GetPoolHistory - get the retained pool history intervals of the given pool,
+// most recent interval first
+func (k KVStore) GetPoolHistory(ctx cosmos.Context, asset common.Asset) ([]PoolHistory, error) {
+	result := make([]PoolHistory, 0)
+	days := k.GetConfigInt64(ctx, constants.PoolHistoryDays)
+	intervalBlocks := k.GetPoolHistoryIntervalBlocks()
+	current := ctx.BlockHeight() / intervalBlocks
+	for day := current; day > current-days && day >= 0; day-- {
+		record := NewPoolHistory(asset, day*intervalBlocks)
+		found, err := k.getPoolHistory(ctx, k.getPoolHistoryKey(asset, day), &record)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			result = append(result, record)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+type KeeperPoolHistorySuite struct{}
+
+var _ = Suite(&KeeperPoolHistorySuite{})
+
+func (s *KeeperPoolHistorySuite) TestPoolHistory(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	interval := k.GetPoolHistoryIntervalBlocks()
+	c.Assert(interval, Equals, int64(14400))
+
+	// liquidity fees are recorded as they are collected
+	ctx = ctx.WithBlockHeight(10)
+	c.Assert(k.AddToLiquidityFees(ctx, common.BTCAsset, cosmos.NewUint(100)), IsNil)
+	ctx = ctx.WithBlockHeight(20)
+	record := NewPoolHistory(common.BTCAsset, ctx.BlockHeight())
+	record.Rewards = cosmos.NewUint(50)
+	k.AddPoolHistory(ctx, record)
+	ctx = ctx.WithBlockHeight(interval + 5)
+	record = NewPoolHistory(common.BTCAsset, ctx.BlockHeight())
+	record.SynthYield = cosmos.NewUint(7)
+	k.AddPoolHistory(ctx, record)
+
+	// empty records are not stored
+	k.AddPoolHistory(ctx, NewPoolHistory(common.ETHAsset, ctx.BlockHeight()))
+
+	history, err := k.GetPoolHistory(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 2)
+	c.Check(history[0].StartHeight, Equals, interval)
+	c.Check(history[0].SynthYield.Uint64(), Equals, uint64(7))
+	c.Check(history[1].StartHeight, Equals, int64(0))
+	c.Check(history[1].LiquidityFees.Uint64(), Equals, uint64(100))
+	c.Check(history[1].Rewards.Uint64(), Equals, uint64(50))
+	history, err = k.GetPoolHistory(ctx, common.ETHAsset)
+	c.Assert(err, IsNil)
+	c.Check(history, HasLen, 0)
+
+	// intervals past the retention are pruned when a new interval starts
+	k.SetMimir(ctx, constants.PoolHistoryDays.String(), 2)
+	ctx = ctx.WithBlockHeight(3 * interval)
+	record = NewPoolHistory(common.BTCAsset, ctx.BlockHeight())
+	record.PolRuneDeposited = cosmos.NewUint(1000)
+	k.AddPoolHistory(ctx, record)
+	history, err = k.GetPoolHistory(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 1)
+	c.Check(history[0].PolRuneDeposited.Uint64(), Equals, uint64(1000))
+	ctx = ctx.WithBlockHeight(interval + 5)
+	history, err = k.GetPoolHistory(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(history, HasLen, 0)
+
+	// disabled history records nothing
+	k.SetMimir(ctx, constants.PoolHistoryDays.String(), 0)
+	ctx = ctx.WithBlockHeight(3 * interval)
+	record = NewPoolHistory(common.BTCAsset, ctx.BlockHeight())
+	record.Rewards = cosmos.NewUint(1)
+	k.AddPoolHistory(ctx, record)
+	k.SetMimir(ctx, constants.PoolHistoryDays.String(), 2)
+	history, err = k.GetPoolHistory(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Assert(history, HasLen, 1)
+	c.Check(history[0].Rewards.IsZero(), Equals, true)
+}
@@ -0,0 +1,151 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+// GetPriceBreakerHeight - the last block height swaps in the pool are paused by
+// the price breaker
+func (k KVStore) GetPriceBreakerHeight(ctx cosmos.Context, asset common.Asset) (int64, error) {
+	var record int64
+	_, err := k.getInt64(ctx, k.GetKey(prefixPriceBreaker, asset.String()), &record)
+	return record, err
+}
+
+func (k KVStore) SetPriceBreakerHeight(ctx cosmos.Context, asset common.Asset, height int64) {
+	k.setInt64(ctx, k.GetKey(prefixPriceBreaker, asset.String()), height)
+}
+
+// IsPriceBreakerTripped - returns true if swaps in the pool of the asset are
+// paused by the price breaker
+func (k KVStore) IsPriceBreakerTripped(ctx cosmos.Context, asset common.Asset) bool {
+	if asset.IsEmpty() || asset.IsRune() {
+		return false
+	}
+	height, err := k.GetPriceBreakerHeight(ctx, asset.GetLayer1Asset())
+	if err != nil {
+		ctx.Logger().Error("fail to get price breaker height", "asset", asset, "error", err)
+		return false
+	}
+	return height >= ctx.BlockHeight()
+}
+
+func (k KVStore) setPoolPriceObservation(ctx cosmos.Context, key []byte, record PoolPriceObservation) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete(key)
+	} else {
+		store.Set(key, buf)
+	}
+}
+
+func (k KVStore) getPoolPriceObservation(ctx cosmos.Context, key []byte, record *PoolPriceObservation) (bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !store.Has(key) {
+		return false, nil
+	}
+
+	bz := store.Get(key)
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+func (k KVStore) getPoolPriceObservationKey(asset common.Asset, height int64) []byte {
+	// zero padded so the observations of a pool iterate in order
+	return k.GetKey(prefixPoolPriceObservation, asset.String(), "/", fmt.Sprintf("%012d", height))
+}
+
+// GetPoolPriceObservationBlocks - the number of blocks of pool price observations
+// kept, the longest of PoolTWAPMaxBlocks, DerivedPoolTWAPBlocks and, while the
+// price breaker is enabled, PriceBreakerTWAPBlocks. Zero when observations are
+// disabled.
+func (k KVStore) GetPoolPriceObservationBlocks(ctx cosmos.Context) int64 {
+	maxBlocks := max(k.GetConfigInt64(ctx, constants.PoolTWAPMaxBlocks), k.GetConfigInt64(ctx, constants.DerivedPoolTWAPBlocks))
+	if k.GetConfigInt64(ctx, constants.PriceBreakerDeviationBps) > 0 {
+		maxBlocks = max(maxBlocks, k.GetConfigInt64(ctx, constants.PriceBreakerTWAPBlocks))
+	}
+	return max(maxBlocks, 0)
+}
+
+// AddPoolPriceObservation - record the pool price at the start of the current
+// block, accumulating the price of the previous observation over the blocks since
+// it was recorded. Observations older than GetPoolPriceObservationBlocks are
+// pruned, including any kept for a longer window before.
+func (k KVStore) AddPoolPriceObservation(ctx cosmos.Context, asset common.Asset, price cosmos.Uint) {
+	maxBlocks := k.GetPoolPriceObservationBlocks(ctx)
+	if maxBlocks <= 0 {
+		return
+	}
+	height := ctx.BlockHeight()
+
+	record := PoolPriceObservation{Height: height, Price: price, CumulativePrice: cosmos.ZeroUint()}
+	var last PoolPriceObservation
+	found, err := k.getPoolPriceObservation(ctx, k.GetKey(prefixPoolPriceLast, asset.String()), &last)
+	if err != nil {
+		ctx.Logger().Error("fail to get last pool price observation", "asset", asset, "error", err)
+		return
+	}
+	if found {
+		if last.Height >= height {
+			return
+		}
+		record.CumulativePrice = last.CumulativePrice.Add(last.Price.MulUint64(uint64(height - last.Height)))
+	}
+
+	k.setPoolPriceObservation(ctx, k.getPoolPriceObservationKey(asset, height), record)
+	k.setPoolPriceObservation(ctx, k.GetKey(prefixPoolPriceLast, asset.String()), record)
+	k.delRange(ctx, k.getPoolPriceObservationKey(asset, 0), k.getPoolPriceObservationKey(asset, max(height-maxBlocks, 0)))
+}
+
+// GetPoolCumulativePrice - the cumulative price of the pool at the given height,
+// extrapolated from the last observation if none was recorded at the height
+func (k KVStore) GetPoolCumulativePrice(ctx cosmos.Context, asset common.Asset, height int64) (cosmos.Uint, error) {
+	var record PoolPriceObservation
+	found, err := k.getPoolPriceObservation(ctx, k.getPoolPriceObservationKey(asset, height), &record)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	if found {
+		return record.CumulativePrice, nil
+	}
+
+	found, err = k.getPoolPriceObservation(ctx, k.GetKey(prefixPoolPriceLast, asset.String()), &record)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	if !found || record.Height > height {
+		return cosmos.ZeroUint(), fmt.Errorf("no price observation of %s at height %d", asset, height)
+	}
+	return record.CumulativePrice.Add(record.Price.MulUint64(uint64(height - record.Height))), nil
+}
+
+// GetPoolTWAP - the time weighted average price of the pool in rune per asset
+// (1e8) over the given number of blocks before the current block
+func (k KVStore) GetPoolTWAP(ctx cosmos.Context, asset common.Asset, blocks int64) (cosmos.Uint, error) {
+	maxBlocks := k.GetPoolPriceObservationBlocks(ctx)
+	if blocks < 1 || blocks > maxBlocks {
+		return cosmos.ZeroUint(), fmt.Errorf("blocks must be between 1 and %d", maxBlocks)
+	}
+
+	end, err := k.GetPoolCumulativePrice(ctx, asset, ctx.BlockHeight())
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	start, err := k.GetPoolCumulativePrice(ctx, asset, ctx.BlockHeight()-blocks)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	if end.LT(start) {
+		return cosmos.ZeroUint(), fmt.Errorf("invalid price observations of %s", asset)
+	}
+	return end.Sub(start).QuoUint64(uint64(blocks)), nil
+}
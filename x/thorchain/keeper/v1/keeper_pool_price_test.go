@@ -0,0 +1,93 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
+)
+
+type KeeperPoolPriceSuite struct{}
+
+var _ = Suite(&KeeperPoolPriceSuite{})
+
+func (s *KeeperPoolPriceSuite) TestPoolPriceObservations(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	k.SetMimir(ctx, constants.PoolTWAPMaxBlocks.String(), 100)
+
+	ctx = ctx.WithBlockHeight(10)
+	k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(100))
+	ctx = ctx.WithBlockHeight(12)
+	k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(200))
+	// only the first observation of a block is recorded
+	k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(1000))
+	ctx = ctx.WithBlockHeight(15)
+	k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(400))
+
+	cum, err := k.GetPoolCumulativePrice(ctx, common.BTCAsset, 10)
+	c.Assert(err, IsNil)
+	c.Check(cum.Uint64(), Equals, uint64(0))
+	cum, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 12)
+	c.Assert(err, IsNil)
+	c.Check(cum.Uint64(), Equals, uint64(200))
+	cum, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 15)
+	c.Assert(err, IsNil)
+	c.Check(cum.Uint64(), Equals, uint64(800))
+	// extrapolated from the last observation
+	cum, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 20)
+	c.Assert(err, IsNil)
+	c.Check(cum.Uint64(), Equals, uint64(2800))
+	_, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 11)
+	c.Assert(err, NotNil)
+	_, err = k.GetPoolCumulativePrice(ctx, common.ETHAsset, 15)
+	c.Assert(err, NotNil)
+
+	twap, err := k.GetPoolTWAP(ctx, common.BTCAsset, 5)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(160))
+	twap, err = k.GetPoolTWAP(ctx, common.BTCAsset, 3)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(200))
+	ctx = ctx.WithBlockHeight(20)
+	twap, err = k.GetPoolTWAP(ctx, common.BTCAsset, 5)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(400))
+
+	_, err = k.GetPoolTWAP(ctx, common.BTCAsset, 0)
+	c.Assert(err, NotNil)
+	_, err = k.GetPoolTWAP(ctx, common.BTCAsset, 11)
+	c.Assert(err, NotNil)
+	_, err = k.GetPoolTWAP(ctx, common.BTCAsset, 101)
+	c.Assert(err, NotNil)
+	k.SetMimir(ctx, constants.PoolTWAPMaxBlocks.String(), 5)
+	_, err = k.GetPoolTWAP(ctx, common.BTCAsset, 10)
+	c.Assert(err, NotNil)
+
+	// observations older than the max blocks are pruned, including those kept
+	// for a larger max blocks
+	ctx = ctx.WithBlockHeight(16)
+	k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(400))
+	_, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 10)
+	c.Assert(err, NotNil)
+	cum, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 12)
+	c.Assert(err, IsNil)
+	c.Check(cum.Uint64(), Equals, uint64(200))
+	k.SetMimir(ctx, constants.PoolTWAPMaxBlocks.String(), 2)
+	ctx = ctx.WithBlockHeight(17)
+	k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(400))
+	_, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 12)
+	c.Assert(err, NotNil)
+	_, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 14)
+	c.Assert(err, NotNil)
+	cum, err = k.GetPoolCumulativePrice(ctx, common.BTCAsset, 15)
+	c.Assert(err, IsNil)
+	c.Check(cum.Uint64(), Equals, uint64(800))
+
+	// disabled observations record nothing
+	k.SetMimir(ctx, constants.PoolTWAPMaxBlocks.String(), 0)
+	ctx = ctx.WithBlockHeight(18)
+	k.AddPoolPriceObservation(ctx, common.ETHAsset, cosmos.NewUint(100))
+	_, err = k.GetPoolCumulativePrice(ctx, common.ETHAsset, 18)
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,169 @@
+package keeperv1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper/types"
+)
+
+func (k KVStore) setRecurringSwap(ctx cosmos.Context, key []byte, record RecurringSwap) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete(key)
+	} else {
+		store.Set(key, buf)
+	}
+}
+
+func (k KVStore) getRecurringSwap(ctx cosmos.Context, key []byte, record *RecurringSwap) (bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !store.Has(key) {
+		return false, nil
+	}
+
+	bz := store.Get(key)
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetRecurringSwapIterator iterate recurring swaps
+func (k KVStore) GetRecurringSwapIterator(ctx cosmos.Context) cosmos.Iterator {
+	key := k.GetKey(prefixRecurringSwap, "")
+	return k.getIterator(ctx, types.DbPrefix(key))
+}
+
+// GetRecurringSwap retrieve recurring swap from the data store
+func (k KVStore) GetRecurringSwap(ctx cosmos.Context, hash common.TxID) (RecurringSwap, error) {
+	record := RecurringSwap{
+		TxID:   hash,
+		Amount: cosmos.ZeroUint(),
+	}
+	ok, err := k.getRecurringSwap(ctx, k.GetKey(prefixRecurringSwap, hash.String()), &record)
+	if !ok && err == nil {
+		return record, fmt.Errorf("recurring swap (%s) not found", hash)
+	}
+	return record, err
+}
+
+// RecurringSwapExists check whether the given hash is associated with a recurring swap
+func (k KVStore) RecurringSwapExists(ctx cosmos.Context, hash common.TxID) bool {
+	return k.has(ctx, k.GetKey(prefixRecurringSwap, hash.String()))
+}
+
+// SetRecurringSwap save the recurring swap to kv store
+func (k KVStore) SetRecurringSwap(ctx cosmos.Context, swp RecurringSwap) {
+	k.setRecurringSwap(ctx, k.GetKey(prefixRecurringSwap, swp.TxID.String()), swp)
+}
+
+// RemoveRecurringSwap remove the recurring swap from the kv store
+func (k KVStore) RemoveRecurringSwap(ctx cosmos.Context, hash common.TxID) {
+	k.del(ctx, k.GetKey(prefixRecurringSwap, hash.String()))
+}
+
+///-------------------------- Recurring Swap Height --------------------------///
+// Recurring swaps are indexed by the block height of their next swap, so the
+// due swaps can be queued without iterating every recurring swap each block.
+
+// SetRecurringSwapHeight - records that the given recurring swap is due at height
+func (k KVStore) SetRecurringSwapHeight(ctx cosmos.Context, height int64, hash common.TxID) error {
+	key := k.GetKey(prefixRecurringSwapHeight, strconv.FormatInt(height, 10))
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, key, &record)
+	if err != nil {
+		return err
+	}
+	for _, r := range record {
+		if strings.EqualFold(hash.String(), r) {
+			return nil
+		}
+	}
+	record = append(record, hash.String())
+	k.setStrings(ctx, key, record)
+	return nil
+}
+
+// GetRecurringSwapHeight - returns the recurring swaps due at height
+func (k KVStore) GetRecurringSwapHeight(ctx cosmos.Context, height int64) (common.TxIDs, error) {
+	key := k.GetKey(prefixRecurringSwapHeight, strconv.FormatInt(height, 10))
+	record := make([]string, 0)
+	_, err := k.getStrings(ctx, key, &record)
+	if err != nil {
+		return nil, err
+	}
+	result := make(common.TxIDs, 0, len(record))
+	for _, rec := range record {
+		hash, err := common.NewTxID(rec)
+		if err != nil {
+			_ = dbError(ctx, fmt.Sprintf("failed to parse tx hash: (%s)", rec), err)
+			continue
+		}
+		result = append(result, hash)
+	}
+	return result, nil
+}
+
+// RemoveRecurringSwapHeight - removes the recurring swap record for the given height
+func (k KVStore) RemoveRecurringSwapHeight(ctx cosmos.Context, height int64) {
+	k.del(ctx, k.GetKey(prefixRecurringSwapHeight, strconv.FormatInt(height, 10)))
+}
+
+///-------------------------- Recurring Swap Fill --------------------------///
+
+func (k KVStore) setRecurringSwapFill(ctx cosmos.Context, key []byte, record RecurringSwapFill) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	buf := k.cdc.MustMarshal(&record)
+	if buf == nil {
+		store.Delete(key)
+	} else {
+		store.Set(key, buf)
+	}
+}
+
+func (k KVStore) getRecurringSwapFill(ctx cosmos.Context, key []byte, record *RecurringSwapFill) (bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if !store.Has(key) {
+		return false, nil
+	}
+
+	bz := store.Get(key)
+	if err := k.cdc.Unmarshal(bz, record); err != nil {
+		return true, dbError(ctx, fmt.Sprintf("Unmarshal kvstore: (%T) %s", record, key), err)
+	}
+	return true, nil
+}
+
+// GetRecurringSwapFill retrieve the swap made by a recurring swap
+func (k KVStore) GetRecurringSwapFill(ctx cosmos.Context, hash common.TxID) (RecurringSwapFill, error) {
+	record := RecurringSwapFill{
+		TxID: hash,
+		Out:  cosmos.ZeroUint(),
+	}
+	ok, err := k.getRecurringSwapFill(ctx, k.GetKey(prefixRecurringSwapFill, hash.String()), &record)
+	if !ok && err == nil {
+		return record, fmt.Errorf("recurring swap fill (%s) not found", hash)
+	}
+	return record, err
+}
+
+// RecurringSwapFillExists check whether the given hash is a swap made by a recurring swap
+func (k KVStore) RecurringSwapFillExists(ctx cosmos.Context, hash common.TxID) bool {
+	return k.has(ctx, k.GetKey(prefixRecurringSwapFill, hash.String()))
+}
+
+// SetRecurringSwapFill save the recurring swap fill to kv store
+func (k KVStore) SetRecurringSwapFill(ctx cosmos.Context, fill RecurringSwapFill) {
+	k.setRecurringSwapFill(ctx, k.GetKey(prefixRecurringSwapFill, fill.TxID.String()), fill)
+}
+
+// RemoveRecurringSwapFill remove the recurring swap fill from the kv store
+func (k KVStore) RemoveRecurringSwapFill(ctx cosmos.Context, hash common.TxID) {
+	k.del(ctx, k.GetKey(prefixRecurringSwapFill, hash.String()))
+}
@@ -0,0 +1,71 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	cosmos "gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+type KeeperRecurringSwapSuite struct{}
+
+var _ = Suite(&KeeperRecurringSwapSuite{})
+
+func (s *KeeperRecurringSwapSuite) SetUpSuite(c *C) {
+	SetupConfigForTest()
+}
+
+func (s *KeeperRecurringSwapSuite) TestRecurringSwap(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	txID := GetRandomTxHash()
+
+	_, err := k.GetRecurringSwap(ctx, txID)
+	c.Assert(err, NotNil)
+	c.Check(k.RecurringSwapExists(ctx, txID), Equals, false)
+
+	owner := GetRandomBech32Addr()
+	swp := NewRecurringSwap(txID, owner, common.ETHAsset.GetTradeAsset(), common.BTCAsset, GetRandomBTCAddress(), cosmos.NewUint(100), 10, 5, 18)
+	k.SetRecurringSwap(ctx, swp)
+	c.Check(k.RecurringSwapExists(ctx, txID), Equals, true)
+	swp, err = k.GetRecurringSwap(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(swp.Owner.Equals(owner), Equals, true)
+	c.Check(swp.Interval, Equals, uint64(10))
+	c.Check(swp.Quantity, Equals, uint64(5))
+	c.Check(swp.NextHeight, Equals, int64(18))
+	iter := k.GetRecurringSwapIterator(ctx)
+	c.Check(iter, NotNil)
+	iter.Close()
+	k.RemoveRecurringSwap(ctx, txID)
+	c.Check(k.RecurringSwapExists(ctx, txID), Equals, false)
+
+	// height index
+	c.Assert(k.SetRecurringSwapHeight(ctx, 18, txID), IsNil)
+	c.Assert(k.SetRecurringSwapHeight(ctx, 18, txID), IsNil)
+	other := GetRandomTxHash()
+	c.Assert(k.SetRecurringSwapHeight(ctx, 18, other), IsNil)
+	hashes, err := k.GetRecurringSwapHeight(ctx, 18)
+	c.Assert(err, IsNil)
+	c.Assert(hashes, HasLen, 2)
+	c.Check(hashes[0].Equals(txID), Equals, true)
+	c.Check(hashes[1].Equals(other), Equals, true)
+	k.RemoveRecurringSwapHeight(ctx, 18)
+	hashes, err = k.GetRecurringSwapHeight(ctx, 18)
+	c.Assert(err, IsNil)
+	c.Check(hashes, HasLen, 0)
+
+	// fills
+	fillID, err := swp.GetFillTxID(0)
+	c.Assert(err, IsNil)
+	_, err = k.GetRecurringSwapFill(ctx, fillID)
+	c.Assert(err, NotNil)
+	fill := NewRecurringSwapFill(fillID, txID, 0, 18, common.NewCoin(common.ETHAsset.GetTradeAsset(), cosmos.NewUint(100)))
+	k.SetRecurringSwapFill(ctx, fill)
+	c.Check(k.RecurringSwapFillExists(ctx, fillID), Equals, true)
+	fill, err = k.GetRecurringSwapFill(ctx, fillID)
+	c.Assert(err, IsNil)
+	c.Check(fill.RecurringSwapID.Equals(txID), Equals, true)
+	c.Check(fill.Status, Equals, "pending")
+	k.RemoveRecurringSwapFill(ctx, fillID)
+	c.Check(k.RecurringSwapFillExists(ctx, fillID), Equals, false)
+}
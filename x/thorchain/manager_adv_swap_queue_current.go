@@ -86,6 +86,12 @@ func (vm *SwapQueueAdvVCUR) FetchQueue(ctx cosmos.Context, mgr Manager, pairs tr
 		}
 	}
 
+	for _, pair := range todo {
+		for _, swapType := range []types.SwapType{TakeProfitSwap, StopLossSwap} {
+			items = append(items, vm.discoverTriggerSwaps(ctx, pair, pools, swapType)...)
+		}
+	}
+
 	return items, nil
 }
 
@@ -145,17 +151,111 @@ func (vm *SwapQueueAdvVCUR) discoverLimitSwaps(ctx cosmos.Context, pair tradePai
 	return items, done
 }
 
+// discoverTriggerSwaps returns the stop-loss or take-profit orders for the
+// given pair whose trigger has been crossed by the pool-implied price.
+func (vm *SwapQueueAdvVCUR) discoverTriggerSwaps(ctx cosmos.Context, pair tradePair, pools Pools, swapType types.SwapType) swapItems {
+	items := make(swapItems, 0)
+
+	poolRatio, ok := vm.getPoolRatio(pools, pair)
+	if !ok {
+		return items
+	}
+
+	iter := vm.k.GetAdvSwapQueueIndexIterator(ctx, swapType, pair.source, pair.target)
+	if iter == nil {
+		return items
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		ratio, err := vm.parseRatioFromKey(string(iter.Key()))
+		if err != nil {
+			ctx.Logger().Error("fail to parse ratio", "key", string(iter.Key()), "error", err)
+			continue
+		}
+
+		// indexes are iterated closest to being triggered first, so once an
+		// index isn't triggered, none of the remaining ones will be either
+		if !vm.isTriggered(swapType, cosmos.NewUint(ratio), poolRatio) {
+			break
+		}
+
+		record := make([]string, 0)
+		value := ProtoStrings{Value: record}
+		if err := vm.k.Cdc().Unmarshal(iter.Value(), &value); err != nil {
+			ctx.Logger().Error("fail to fetch indexed txn hashes", "error", err)
+			continue
+		}
+
+		for i, rec := range value.Value {
+			hash, err := common.NewTxID(rec)
+			if err != nil {
+				ctx.Logger().Error("fail to parse tx hash", "error", err)
+				continue
+			}
+			msg, err := vm.k.GetAdvSwapQueueItem(ctx, hash)
+			if err != nil {
+				ctx.Logger().Error("fail to fetch msg swap", "error", err)
+				continue
+			}
+			items = append(items, swapItem{
+				msg:   msg,
+				index: i,
+				fee:   cosmos.ZeroUint(),
+				slip:  cosmos.ZeroUint(),
+			})
+		}
+	}
+	return items
+}
+
+// isTriggered returns true when the pool ratio (source per target) has crossed
+// the order's ratio. A take-profit triggers once the pool pays at least the
+// trigger amount, a stop-loss once it pays no more than the trigger amount.
+func (vm *SwapQueueAdvVCUR) isTriggered(swapType types.SwapType, orderRatio, poolRatio cosmos.Uint) bool {
+	if poolRatio.IsZero() {
+		return false
+	}
+	switch swapType {
+	case TakeProfitSwap:
+		return orderRatio.GTE(poolRatio)
+	case StopLossSwap:
+		return orderRatio.LTE(poolRatio)
+	default:
+		return false
+	}
+}
+
+// isTriggerOrderTriggered checks a single stop-loss or take-profit order
+// against the current pools
+func (vm *SwapQueueAdvVCUR) isTriggerOrderTriggered(pools Pools, msg MsgSwap) bool {
+	poolRatio, ok := vm.getPoolRatio(pools, genTradePair(msg.Tx.Coins[0].Asset, msg.TargetAsset))
+	if !ok {
+		return false
+	}
+	return vm.isTriggered(msg.SwapType, vm.getRatio(msg.Tx.Coins[0].Amount, msg.TradeTarget), poolRatio)
+}
+
 func (vm *SwapQueueAdvVCUR) checkFeelessSwap(pools Pools, pair tradePair, indexRatio uint64) bool {
+	ratio, ok := vm.getPoolRatio(pools, pair)
+	if !ok {
+		return false
+	}
+	return cosmos.NewUint(indexRatio).GT(ratio)
+}
+
+// getPoolRatio returns the fee-less, pool-implied ratio of source to target
+// (in 1e8), matching the ratios used to index adv swap queue items
+func (vm *SwapQueueAdvVCUR) getPoolRatio(pools Pools, pair tradePair) (cosmos.Uint, bool) {
 	var ratio cosmos.Uint
 	switch {
 	case !pair.HasRune():
 		sourcePool, ok := pools.Get(pair.source.GetLayer1Asset())
 		if !ok {
-			return false
+			return cosmos.ZeroUint(), false
 		}
 		targetPool, ok := pools.Get(pair.target.GetLayer1Asset())
 		if !ok {
-			return false
+			return cosmos.ZeroUint(), false
 		}
 		one := cosmos.NewUint(common.One)
 		runeAmt := common.GetSafeShare(one, sourcePool.BalanceAsset, sourcePool.BalanceRune)
@@ -164,17 +264,17 @@ func (vm *SwapQueueAdvVCUR) checkFeelessSwap(pools Pools, pair tradePair, indexR
 	case pair.source.IsRune():
 		pool, ok := pools.Get(pair.target.GetLayer1Asset())
 		if !ok {
-			return false
+			return cosmos.ZeroUint(), false
 		}
 		ratio = vm.getRatio(pool.BalanceRune, pool.BalanceAsset)
 	case pair.target.IsRune():
 		pool, ok := pools.Get(pair.source.GetLayer1Asset())
 		if !ok {
-			return false
+			return cosmos.ZeroUint(), false
 		}
 		ratio = vm.getRatio(pool.BalanceAsset, pool.BalanceRune)
 	}
-	return cosmos.NewUint(indexRatio).GT(ratio)
+	return ratio, true
 }
 
 func (vm *SwapQueueAdvVCUR) checkWithFeeSwap(ctx cosmos.Context, pools Pools, msg MsgSwap) bool {
@@ -308,11 +408,22 @@ func (vm *SwapQueueAdvVCUR) AddSwapQueueItem(ctx cosmos.Context, msg MsgSwap) er
 	if types.AdvSwapQueueMode(val) == types.AdvSwapQueueModeMarketOnly {
 		msg.SwapType = MarketSwap
 	}
+	if msg.IsTriggerOrder() {
+		// trigger orders can't rest in the queue indefinitely
+		maxLength := vm.k.GetConfigInt64(ctx, constants.TriggerSwapMaxLength)
+		if msg.Expiry <= 0 || msg.Expiry > ctx.BlockHeight()+maxLength {
+			msg.Expiry = ctx.BlockHeight() + maxLength
+		}
+		if err := vm.k.SetAdvSwapQueueExpiry(ctx, msg.Expiry, msg.Tx.ID); err != nil {
+			ctx.Logger().Error("fail to add trigger order expiry", "error", err)
+			return err
+		}
+	}
 	if err := vm.k.SetAdvSwapQueueItem(ctx, msg); err != nil {
 		ctx.Logger().Error("fail to add swap item", "error", err)
 		return err
 	}
-	if msg.SwapType == LimitSwap {
+	if msg.SwapType == LimitSwap || msg.IsTriggerOrder() {
 		if err := vm.k.SetAdvSwapQueueIndex(ctx, msg); err != nil {
 			ctx.Logger().Error("fail to add limit swap index", "error", err)
 			return err
@@ -344,6 +455,29 @@ func (vm *SwapQueueAdvVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		synthVirtualDepthMult = mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
 	}
 
+	refund := func(msg MsgSwap, err error) {
+		ctx.Logger().Error("fail to execute swap", "msg", msg.Tx.String(), "error", err)
+
+		var refundErr error
+
+		// Get the full ObservedTx from the TxID, for the vault ObservedPubKey to first try to refund from.
+		voter, voterErr := mgr.Keeper().GetObservedTxInVoter(ctx, msg.Tx.ID)
+		if voterErr == nil && !voter.Tx.IsEmpty() {
+			refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx, ObservedPubKey: voter.Tx.ObservedPubKey}, mgr, CodeSwapFail, err.Error(), "")
+		} else {
+			// If the full ObservedTx could not be retrieved, proceed with just the MsgSwap's Tx (no ObservedPubKey).
+			ctx.Logger().Error("fail to get non-empty observed tx", "error", voterErr)
+			refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, CodeSwapFail, err.Error(), "")
+		}
+
+		if nil != refundErr {
+			ctx.Logger().Error("fail to refund swap", "error", err)
+		}
+	}
+
+	// refund stop-loss and take-profit orders that expire this block
+	vm.expireTriggerOrders(ctx, refund)
+
 	todo := make(tradePairs, 0)
 	pairs, pools := vm.getAssetPairs(ctx)
 
@@ -353,8 +487,12 @@ func (vm *SwapQueueAdvVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		return err
 	}
 
-	// pull new limit swaps added this block (if not already added)
+	// pull new limit swaps added this block (if not already added), and new
+	// trigger orders whose trigger has already been crossed
 	for _, item := range vm.limitSwaps {
+		if item.msg.IsTriggerOrder() && !vm.isTriggerOrderTriggered(pools, item.msg) {
+			continue
+		}
 		if !swaps.HasItem(item.msg.Tx.ID) {
 			swaps = append(swaps, item)
 		}
@@ -368,25 +506,15 @@ func (vm *SwapQueueAdvVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	}
 	swaps = swaps.Sort()
 
-	refund := func(msg MsgSwap, err error) {
-		ctx.Logger().Error("fail to execute swap", "msg", msg.Tx.String(), "error", err)
-
-		var refundErr error
-
-		// Get the full ObservedTx from the TxID, for the vault ObservedPubKey to first try to refund from.
-		voter, voterErr := mgr.Keeper().GetObservedTxInVoter(ctx, msg.Tx.ID)
-		if voterErr == nil && !voter.Tx.IsEmpty() {
-			refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx, ObservedPubKey: voter.Tx.ObservedPubKey}, mgr, CodeSwapFail, err.Error(), "")
-		} else {
-			// If the full ObservedTx could not be retrieved, proceed with just the MsgSwap's Tx (no ObservedPubKey).
-			ctx.Logger().Error("fail to get non-empty observed tx", "error", voterErr)
-			refundErr = refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, CodeSwapFail, err.Error(), "")
-		}
-
-		if nil != refundErr {
-			ctx.Logger().Error("fail to refund swap", "error", err)
+	// held swaps are dropped before the swaps of this block are counted, so
+	// they don't use up the slots of the swaps that can execute
+	ready := make(swapItems, 0, len(swaps))
+	for _, item := range swaps {
+		if !vm.isHeld(ctx, item.msg) {
+			ready = append(ready, item)
 		}
 	}
+	swaps = ready
 
 	for i := int64(0); i < vm.getTodoNum(int64(len(swaps)), minSwapsPerBlock, maxSwapsPerBlock); i++ {
 		pick := swaps[i]
@@ -395,6 +523,21 @@ func (vm *SwapQueueAdvVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 			ctx.Logger().Error("fail copy msg", "msg", msg.Tx.String(), "error", err)
 			continue
 		}
+		if pick.msg.IsTriggerOrder() {
+			// the trigger has been crossed, the order becomes a market swap
+			msg.SwapType = MarketSwap
+			msg.TradeTarget = cosmos.ZeroUint()
+			if msg.IsStreaming() {
+				// streaming swaps are executed by the regular swap queue
+				if err := vm.k.RemoveAdvSwapQueueItem(ctx, pick.msg.Tx.ID); err != nil {
+					ctx.Logger().Error("fail to remove adv swap item", "msg", pick.msg.Tx.String(), "error", err)
+				}
+				if err := vm.k.SetSwapQueueItem(ctx, msg, 0); err != nil {
+					refund(pick.msg, err)
+				}
+				continue
+			}
+		}
 		if !msg.AffiliateBasisPoints.IsZero() && msg.AffiliateAddress.IsChain(common.THORChain) {
 			affiliateAmt := common.GetSafeShare(
 				msg.AffiliateBasisPoints,
@@ -474,6 +617,42 @@ func (vm *SwapQueueAdvVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 	return nil
 }
 
+// isHeld returns true if the swap stays in the queue this block. All swaps of
+// pools paused by the price breaker are held. Limit, trigger and streaming swaps
+// rest in the queue, so they also wait out a trading halt rather than being
+// refunded like a market swap.
+func (vm *SwapQueueAdvVCUR) isHeld(ctx cosmos.Context, msg MsgSwap) bool {
+	if isSwapPriceBreakerTripped(ctx, vm.k, msg) {
+		return true
+	}
+	if msg.SwapType == MarketSwap && !msg.IsStreaming() {
+		return false
+	}
+	return vm.k.IsTradingHalt(ctx, &msg)
+}
+
+// expireTriggerOrders refunds any stop-loss and take-profit orders that have
+// not been triggered by their expiry height
+func (vm *SwapQueueAdvVCUR) expireTriggerOrders(ctx cosmos.Context, refund func(MsgSwap, error)) {
+	hashes, err := vm.k.GetAdvSwapQueueExpiry(ctx, ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error("fail to get adv swap queue expiry", "error", err)
+		return
+	}
+	for _, hash := range hashes {
+		msg, err := vm.k.GetAdvSwapQueueItem(ctx, hash)
+		if err != nil || !msg.IsTriggerOrder() {
+			// already triggered or cancelled
+			continue
+		}
+		refund(msg, fmt.Errorf("trigger order expired"))
+		if err := vm.k.RemoveAdvSwapQueueItem(ctx, hash); err != nil {
+			ctx.Logger().Error("fail to remove adv swap item", "msg", msg.Tx.String(), "error", err)
+		}
+	}
+	vm.k.RemoveAdvSwapQueueExpiry(ctx, ctx.BlockHeight())
+}
+
 // getTodoNum - determine how many swaps to do.
 func (vm *SwapQueueAdvVCUR) getTodoNum(queueLen, minSwapsPerBlock, maxSwapsPerBlock int64) int64 {
 	// Do half the length of the queue. Unless...
@@ -2,12 +2,15 @@ package thorchain
 
 import (
 	"fmt"
+	"strings"
 
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/types"
 )
 
 type AdvSwapQueueVCURSuite struct{}
@@ -467,3 +470,265 @@ func (s AdvSwapQueueVCURSuite) TestEndBlock(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(proc, DeepEquals, []bool{true, false, false, false, true, true}, Commentf("%+v", proc))
 }
+
+func (s AdvSwapQueueVCURSuite) TestEndBlockPriceBreaker(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	book := newSwapQueueAdvVCUR(mgr.Keeper())
+
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.BalanceAsset = cosmos.NewUint(2088519094783)
+	pool.BalanceRune = cosmos.NewUint(199019591474591)
+	pool.Status = PoolAvailable
+	c.Check(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	tx := GetRandomTx()
+	ethAddr := GetRandomETHAddress()
+	tx.Memo = fmt.Sprintf("swap:ETH.ETH:%s", ethAddr)
+	tx.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.NewUint(2*common.One)))
+	market := NewMsgSwap(
+		tx, common.ETHAsset, ethAddr, cosmos.ZeroUint(),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		MarketSwap,
+		0, 0, GetRandomBech32Addr())
+	c.Assert(mgr.Keeper().SetAdvSwapQueueItem(ctx, *market), IsNil)
+	c.Assert(mgr.Keeper().SetAdvSwapQueueProcessor(ctx, []bool{true}), IsNil)
+
+	// swaps are held while the price breaker is tripped
+	mgr.Keeper().SetPriceBreakerHeight(ctx, common.ETHAsset, ctx.BlockHeight()+1)
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, market.Tx.ID), Equals, true)
+
+	// and swapped once the pause ends
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 1)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, market.Tx.ID), Equals, false)
+	mgr.TxOutStore().ClearOutboundItems(ctx)
+
+	// held swaps don't use up the swaps executed per block
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.BalanceAsset = cosmos.NewUint(2088519094783)
+	btcPool.BalanceRune = cosmos.NewUint(199019591474591)
+	btcPool.Status = PoolAvailable
+	c.Check(mgr.Keeper().SetPool(ctx, btcPool), IsNil)
+	mgr.Keeper().SetMimir(ctx, constants.MinSwapsPerBlock.String(), 1)
+	mgr.Keeper().SetMimir(ctx, constants.MaxSwapsPerBlock.String(), 1)
+	held := make([]*MsgSwap, 0)
+	for i := 0; i < 3; i++ {
+		tx = GetRandomTx()
+		tx.Memo = fmt.Sprintf("swap:ETH.ETH:%s", ethAddr)
+		tx.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.NewUint(uint64(10+i)*common.One)))
+		msg := NewMsgSwap(tx, common.ETHAsset, ethAddr, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketSwap, 0, 0, GetRandomBech32Addr())
+		c.Assert(mgr.Keeper().SetAdvSwapQueueItem(ctx, *msg), IsNil)
+		held = append(held, msg)
+	}
+	tx = GetRandomTx()
+	btcAddr := GetRandomBTCAddress()
+	tx.Memo = fmt.Sprintf("swap:BTC.BTC:%s", btcAddr)
+	tx.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.NewUint(common.One)))
+	btcSwap := NewMsgSwap(tx, common.BTCAsset, btcAddr, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, MarketSwap, 0, 0, GetRandomBech32Addr())
+	c.Assert(mgr.Keeper().SetAdvSwapQueueItem(ctx, *btcSwap), IsNil)
+	c.Assert(mgr.Keeper().SetAdvSwapQueueProcessor(ctx, make([]bool, 6)), IsNil)
+
+	mgr.Keeper().SetPriceBreakerHeight(ctx, common.ETHAsset, ctx.BlockHeight()+1)
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, btcSwap.Tx.ID), Equals, false)
+	for _, msg := range held {
+		c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, msg.Tx.ID), Equals, true)
+	}
+}
+
+func (s AdvSwapQueueVCURSuite) TestEndBlockPoolHalt(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	book := newSwapQueueAdvVCUR(mgr.Keeper())
+
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.BalanceAsset = cosmos.NewUint(2088519094783)
+	pool.BalanceRune = cosmos.NewUint(199019591474591)
+	pool.Status = PoolAvailable
+	c.Check(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	tx := GetRandomTx()
+	ethAddr := GetRandomETHAddress()
+	tx.Memo = fmt.Sprintf("=<:ETH.ETH:%s:1", ethAddr)
+	tx.Coins = common.NewCoins(common.NewCoin(common.RuneAsset(), cosmos.NewUint(2*common.One)))
+	limit := NewMsgSwap(
+		tx, common.ETHAsset, ethAddr, cosmos.NewUint(1),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		LimitSwap,
+		0, 0, GetRandomBech32Addr())
+	c.Assert(book.AddSwapQueueItem(ctx, *limit), IsNil)
+	c.Assert(mgr.Keeper().SetAdvSwapQueueProcessor(ctx, []bool{true, true}), IsNil)
+
+	// resting swaps are held, rather than refunded, while the pool is halted
+	mgr.Keeper().SetMimir(ctx, "HaltPool-ETH-ETH", 1)
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, limit.Tx.ID), Equals, true)
+
+	// and swapped once trading resumes
+	c.Assert(mgr.Keeper().DeleteMimir(ctx, "HaltPool-ETH-ETH"), IsNil)
+	c.Assert(mgr.Keeper().SetAdvSwapQueueProcessor(ctx, []bool{true, true}), IsNil)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 1)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, limit.Tx.ID), Equals, false)
+}
+
+func (s AdvSwapQueueVCURSuite) TestIsTriggered(c *C) {
+	book := newSwapQueueAdvVCUR(keeper.KVStoreDummy{})
+	pool := cosmos.NewUint(100)
+
+	// take-profit triggers once the pool pays at least the trigger amount
+	c.Check(book.isTriggered(TakeProfitSwap, cosmos.NewUint(101), pool), Equals, true)
+	c.Check(book.isTriggered(TakeProfitSwap, cosmos.NewUint(100), pool), Equals, true)
+	c.Check(book.isTriggered(TakeProfitSwap, cosmos.NewUint(99), pool), Equals, false)
+
+	// stop-loss triggers once the pool pays no more than the trigger amount
+	c.Check(book.isTriggered(StopLossSwap, cosmos.NewUint(99), pool), Equals, true)
+	c.Check(book.isTriggered(StopLossSwap, cosmos.NewUint(100), pool), Equals, true)
+	c.Check(book.isTriggered(StopLossSwap, cosmos.NewUint(101), pool), Equals, false)
+
+	c.Check(book.isTriggered(LimitSwap, cosmos.NewUint(101), pool), Equals, false)
+	c.Check(book.isTriggered(StopLossSwap, cosmos.NewUint(99), cosmos.ZeroUint()), Equals, false)
+}
+
+func (s AdvSwapQueueVCURSuite) newTriggerOrder(swapType types.SwapType, target uint64) *MsgSwap {
+	from := GetRandomETHAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		from,
+		from,
+		common.NewCoins(common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One))),
+		common.Gas{common.NewCoin(common.ETHAsset, cosmos.NewUint(37500))},
+		"",
+	)
+	return NewMsgSwap(
+		tx, common.RuneAsset(), GetRandomTHORAddress(), cosmos.NewUint(target),
+		common.NoAddress, cosmos.ZeroUint(),
+		"", "", nil,
+		swapType,
+		0, 0, GetRandomBech32Addr())
+}
+
+func (s AdvSwapQueueVCURSuite) TestDiscoverTriggerSwaps(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	book := newSwapQueueAdvVCUR(mgr.Keeper())
+
+	// 1 ETH pays ~95 RUNE
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.BalanceAsset = cosmos.NewUint(2088519094783)
+	pool.BalanceRune = cosmos.NewUint(199019591474591)
+	pool.Status = PoolAvailable
+	c.Check(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	tp1 := s.newTriggerOrder(TakeProfitSwap, 50*common.One)
+	tp2 := s.newTriggerOrder(TakeProfitSwap, 200*common.One)
+	sl1 := s.newTriggerOrder(StopLossSwap, 50*common.One)
+	sl2 := s.newTriggerOrder(StopLossSwap, 200*common.One)
+	for _, msg := range []*MsgSwap{tp1, tp2, sl1, sl2} {
+		c.Assert(mgr.Keeper().SetAdvSwapQueueItem(ctx, *msg), IsNil)
+	}
+
+	pairs, pools := book.getAssetPairs(ctx)
+	pair := genTradePair(common.ETHAsset, common.RuneAsset())
+
+	items := book.discoverTriggerSwaps(ctx, pair, pools, TakeProfitSwap)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].msg.Tx.ID.Equals(tp1.Tx.ID), Equals, true)
+
+	items = book.discoverTriggerSwaps(ctx, pair, pools, StopLossSwap)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].msg.Tx.ID.Equals(sl2.Tx.ID), Equals, true)
+
+	c.Check(book.isTriggerOrderTriggered(pools, *tp1), Equals, true)
+	c.Check(book.isTriggerOrderTriggered(pools, *tp2), Equals, false)
+	c.Check(book.isTriggerOrderTriggered(pools, *sl1), Equals, false)
+	c.Check(book.isTriggerOrderTriggered(pools, *sl2), Equals, true)
+
+	proc := make([]bool, len(pairs))
+	for i := range proc {
+		proc[i] = true
+	}
+	c.Assert(mgr.Keeper().SetAdvSwapQueueProcessor(ctx, proc), IsNil)
+	items, err := book.FetchQueue(ctx, mgr, pairs, pools)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 2)
+}
+
+func (s AdvSwapQueueVCURSuite) TestTriggerOrderEndBlock(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	mgr.txOutStore = NewTxStoreDummy()
+	book := newSwapQueueAdvVCUR(mgr.Keeper())
+	mgr.Keeper().SetMimir(ctx, constants.EnableAdvSwapQueue.String(), int64(types.AdvSwapQueueModeEnabled))
+
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.BalanceAsset = cosmos.NewUint(2088519094783)
+	pool.BalanceRune = cosmos.NewUint(199019591474591)
+	pool.Status = PoolAvailable
+	c.Check(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	// expiry defaults to the max length
+	maxLength := mgr.Keeper().GetConfigInt64(ctx, constants.TriggerSwapMaxLength)
+	resting := s.newTriggerOrder(StopLossSwap, 50*common.One)
+	c.Assert(book.AddSwapQueueItem(ctx, *resting), IsNil)
+	hashes, err := mgr.Keeper().GetAdvSwapQueueExpiry(ctx, ctx.BlockHeight()+maxLength)
+	c.Assert(err, IsNil)
+	c.Assert(hashes, HasLen, 1)
+	c.Check(hashes[0].Equals(resting.Tx.ID), Equals, true)
+
+	// a triggered streaming order is handed off to the regular swap queue
+	streaming := s.newTriggerOrder(TakeProfitSwap, 50*common.One)
+	streaming.StreamInterval = 1
+	streaming.StreamQuantity = 5
+	c.Assert(book.AddSwapQueueItem(ctx, *streaming), IsNil)
+
+	// an untriggered order expiring next block
+	expiring := s.newTriggerOrder(TakeProfitSwap, 200*common.One)
+	expiring.Expiry = ctx.BlockHeight() + 1
+	c.Assert(book.AddSwapQueueItem(ctx, *expiring), IsNil)
+
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, streaming.Tx.ID), Equals, false)
+	queued, err := mgr.Keeper().GetSwapQueueItem(ctx, streaming.Tx.ID, 0)
+	c.Assert(err, IsNil)
+	c.Check(queued.SwapType, Equals, MarketSwap)
+	c.Check(queued.TradeTarget.IsZero(), Equals, true)
+	c.Check(queued.StreamQuantity, Equals, uint64(5))
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, resting.Tx.ID), Equals, true)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, expiring.Tx.ID), Equals, true)
+
+	// the untriggered order is refunded at its expiry
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	book = newSwapQueueAdvVCUR(mgr.Keeper())
+	c.Assert(book.EndBlock(ctx, mgr), IsNil)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, expiring.Tx.ID), Equals, false)
+	c.Check(mgr.Keeper().HasAdvSwapQueueItem(ctx, resting.Tx.ID), Equals, true)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].InHash.Equals(expiring.Tx.ID), Equals, true)
+	c.Check(strings.HasPrefix(items[0].Memo, "REFUND:"), Equals, true)
+}
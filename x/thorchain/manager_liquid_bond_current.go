@@ -0,0 +1,86 @@
+package thorchain
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	denomkeeper "gitlab.com/thorchain/thornode/v3/x/denom/keeper"
+	denomtypes "gitlab.com/thorchain/thornode/v3/x/denom/types"
+	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
+)
+
+var _ LiquidBondManager = &LiquidBondMgrVCUR{}
+
+// LiquidBondMgrVCUR is VCUR implementation of LiquidBondManager
+type LiquidBondMgrVCUR struct {
+	keeper      keeper.Keeper
+	denomKeeper denomkeeper.Keeper
+}
+
+// newLiquidBondMgrVCUR create a new instance of LiquidBondManager
+func newLiquidBondMgrVCUR(keeper keeper.Keeper, denomKeeper denomkeeper.Keeper) *LiquidBondMgrVCUR {
+	return &LiquidBondMgrVCUR{
+		keeper:      keeper,
+		denomKeeper: denomKeeper,
+	}
+}
+
+// Mint creates the receipt denom of the node on first use and mints the given
+// units to the recipient, the thorchain module is the admin of every receipt
+// denom
+func (m *LiquidBondMgrVCUR) Mint(ctx cosmos.Context, nodeAddr cosmos.AccAddress, units cosmos.Uint, to cosmos.AccAddress) error {
+	admin := m.keeper.GetModuleAccAddress(ModuleName)
+	denom := GetLiquidBondDenom(nodeAddr)
+	server := denomkeeper.NewMsgServerImpl(m.denomKeeper)
+
+	current, err := m.denomKeeper.GetAdmin(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("fail to get receipt denom admin: %w", err)
+	}
+	if current.Empty() {
+		_, err = server.CreateDenom(ctx, &denomtypes.MsgCreateDenom{
+			Sender: admin.String(),
+			Id:     GetLiquidBondDenomID(nodeAddr),
+			Metadata: banktypes.Metadata{
+				Description: fmt.Sprintf("liquid bond receipt of node %s", nodeAddr),
+				Display:     denom,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("fail to create receipt denom: %w", err)
+		}
+	}
+
+	_, err = server.MintTokens(ctx, &denomtypes.MsgMintTokens{
+		Sender:    admin.String(),
+		Amount:    sdk.NewCoin(denom, cosmos.NewIntFromBigInt(units.BigInt())),
+		Recipient: to.String(),
+	})
+	return err
+}
+
+// Burn takes the given units of receipts from the holder and burns them
+func (m *LiquidBondMgrVCUR) Burn(ctx cosmos.Context, nodeAddr cosmos.AccAddress, units cosmos.Uint, from cosmos.AccAddress) error {
+	admin := m.keeper.GetModuleAccAddress(ModuleName)
+	coin := sdk.NewCoin(GetLiquidBondDenom(nodeAddr), cosmos.NewIntFromBigInt(units.BigInt()))
+
+	// only the denom admin can burn, so the receipts pass through the thorchain
+	// module on their way out
+	if err := m.keeper.SendCoins(ctx, from, admin, sdk.NewCoins(coin)); err != nil {
+		return fmt.Errorf("fail to collect receipts: %w", err)
+	}
+	_, err := denomkeeper.NewMsgServerImpl(m.denomKeeper).BurnTokens(ctx, &denomtypes.MsgBurnTokens{
+		Sender: admin.String(),
+		Amount: coin,
+	})
+	return err
+}
+
+// BalanceOf returns the receipt units of the node held by the owner
+func (m *LiquidBondMgrVCUR) BalanceOf(ctx cosmos.Context, nodeAddr, owner cosmos.AccAddress) cosmos.Uint {
+	balance := m.keeper.GetBalance(ctx, owner).AmountOf(GetLiquidBondDenom(nodeAddr))
+	return cosmos.NewUintFromBigInt(balance.BigInt())
+}
@@ -84,9 +84,81 @@ func (vm *NetworkMgrVCUR) processGenesisSetup(ctx cosmos.Context) error {
 }
 
 func (vm *NetworkMgrVCUR) BeginBlock(ctx cosmos.Context, mgr Manager) error {
+	vm.updatePoolPrices(ctx, mgr)
 	return vm.spawnDerivedAssets(ctx, mgr)
 }
 
+// updatePoolPrices records the price of each available pool for the pool TWAP,
+// and compares it to the TWAP of the last PriceBreakerTWAPBlocks, pausing swaps in
+// the pool for PriceBreakerPauseBlocks when the price deviates by more than
+// PriceBreakerDeviationBps.
+func (vm *NetworkMgrVCUR) updatePoolPrices(ctx cosmos.Context, mgr Manager) {
+	if mgr.Keeper().GetPoolPriceObservationBlocks(ctx) <= 0 {
+		return
+	}
+	maxDeviationBps := mgr.Keeper().GetConfigInt64(ctx, constants.PriceBreakerDeviationBps)
+	twapBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.PriceBreakerTWAPBlocks)
+	pauseBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.PriceBreakerPauseBlocks)
+
+	pools, err := mgr.Keeper().GetPools(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get pools", "error", err)
+		return
+	}
+	for _, pool := range pools {
+		if !pool.IsAvailable() || pool.IsEmpty() || pool.Asset.IsDerivedAsset() {
+			continue
+		}
+
+		price := pool.AssetValueInRune(cosmos.NewUint(common.One))
+		mgr.Keeper().AddPoolPriceObservation(ctx, pool.Asset, price)
+		if maxDeviationBps <= 0 || !vm.isPriceBreakerArmed(ctx, mgr, pool.Asset, twapBlocks, pauseBlocks) {
+			continue
+		}
+		// the twap of the blocks before this one, the first blocks after the
+		// breaker is enabled have no twap yet
+		twap, err := mgr.Keeper().GetPoolTWAP(ctx, pool.Asset, twapBlocks)
+		if err != nil || twap.IsZero() {
+			continue
+		}
+
+		diff := common.SafeSub(price, twap)
+		if twap.GT(price) {
+			diff = common.SafeSub(twap, price)
+		}
+		// a price collapse against a tiny twap can exceed an int64, cap it first
+		deviation := cosmos.MinUint(diff.MulUint64(constants.MaxBasisPts).Quo(twap), cosmos.NewUint(1<<63-1))
+		deviationBps := int64(deviation.Uint64())
+		if deviationBps <= maxDeviationBps {
+			continue
+		}
+
+		pauseHeight := ctx.BlockHeight() + pauseBlocks
+		mgr.Keeper().SetPriceBreakerHeight(ctx, pool.Asset, pauseHeight)
+		ctx.Logger().Info("price breaker tripped", "pool", pool.Asset, "price", price, "twap", twap, "deviation", deviationBps, "pause height", pauseHeight)
+		evt := NewEventPriceBreaker(pool.Asset, price, twap, deviationBps, pauseHeight)
+		if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit price breaker event", "error", err)
+		}
+	}
+}
+
+// isPriceBreakerArmed returns false while the pool is paused, and until the TWAP
+// only covers prices after the last trip, so the pool doesn't trip again on the
+// same price move once the pause ends.
+func (vm *NetworkMgrVCUR) isPriceBreakerArmed(ctx cosmos.Context, mgr Manager, asset common.Asset, twapBlocks, pauseBlocks int64) bool {
+	pauseHeight, err := mgr.Keeper().GetPriceBreakerHeight(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get price breaker height", "asset", asset, "error", err)
+		return false
+	}
+	if pauseHeight <= 0 {
+		return true
+	}
+	tripHeight := pauseHeight - pauseBlocks
+	return pauseHeight < ctx.BlockHeight() && tripHeight <= ctx.BlockHeight()-twapBlocks
+}
+
 func (vm *NetworkMgrVCUR) suspendVirtualPool(ctx cosmos.Context, mgr Manager, derivedAsset common.Asset, suspendReasonErr error) {
 	// Ensure that derivedAsset is indeed a derived asset.
 	derivedAsset = derivedAsset.GetDerivedAsset()
@@ -180,7 +252,15 @@ func (vm *NetworkMgrVCUR) CalcAnchor(ctx cosmos.Context, mgr Manager, asset comm
 		slipsDepths = append(slipsDepths, depths[i])
 	}
 
-	price := mgr.Keeper().AnchorMedian(ctx, availableAnchors)
+	// price from the anchor pool TWAPs when enabled, until the anchors have a
+	// full window of observations the spot prices are used
+	price := cosmos.ZeroUint()
+	if twapBlocks := mgr.Keeper().GetConfigInt64(ctx, constants.DerivedPoolTWAPBlocks); twapBlocks > 0 {
+		price = mgr.Keeper().AnchorMedianTWAP(ctx, availableAnchors, twapBlocks)
+	}
+	if price.IsZero() {
+		price = mgr.Keeper().AnchorMedian(ctx, availableAnchors)
+	}
 
 	// calculate the weighted mean slip
 	totalRuneDepth := cosmos.Sum(slipsDepths)
@@ -851,6 +931,9 @@ func (vm *NetworkMgrVCUR) paySaverYield(ctx cosmos.Context, asset common.Asset,
 		ctx.Logger().Error("fail to save saver", "saver", saver.Asset, "error", err)
 		return err
 	}
+	history := NewPoolHistory(pool.Asset, ctx.BlockHeight())
+	history.SynthYield = earnings
+	vm.k.AddPoolHistory(ctx, history)
 
 	// emit event
 	modAddress, err := vm.k.GetModuleAddress(ModuleName)
@@ -1707,6 +1790,9 @@ func (vm *NetworkMgrVCUR) payPoolRewards(ctx cosmos.Context, poolRewards []cosmo
 		if err := vm.k.SendFromModuleToModule(ctx, ReserveName, AsgardName, common.NewCoins(coin)); err != nil {
 			return fmt.Errorf("fail to transfer funds from reserve to asgard: %w", err)
 		}
+		history := NewPoolHistory(pools[i].Asset, ctx.BlockHeight())
+		history.Rewards = reward
+		vm.k.AddPoolHistory(ctx, history)
 	}
 	return nil
 }
@@ -1198,6 +1198,45 @@ func (s *NetworkManagerVCURTestSuite) TestSpawnDerivedAssets(c *C) {
 	c.Assert(bnbPool.BalanceRune.Uint64(), Equals, uint64(187493559385369), Commentf("%d", bnbPool.BalanceRune.Uint64()))
 }
 
+func (s *NetworkManagerVCURTestSuite) TestCalcAnchorTWAP(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	nmgr := newNetworkMgrVCUR(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
+	ctx = ctx.WithBlockHeight(100)
+
+	mgr.Keeper().SetMimir(ctx, "TorAnchor-NOBLE-USDC", 1)
+	pool := NewPool()
+	pool.Asset = common.USDCAsset
+	pool.Status = PoolAvailable
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.Decimals = 8
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+
+	// the anchor pools spot prices are used until the twap has a full window
+	mgr.Keeper().SetMimir(ctx, constants.DerivedPoolTWAPBlocks.String(), 2)
+	c.Check(mgr.Keeper().GetPoolPriceObservationBlocks(ctx), Equals, int64(2))
+	nmgr.updatePoolPrices(ctx, mgr)
+	_, price, _ := nmgr.CalcAnchor(ctx, mgr, common.TOR)
+	c.Check(price.Uint64(), Equals, uint64(constants.DollarMulti*common.One))
+
+	ctx = ctx.WithBlockHeight(101)
+	nmgr.updatePoolPrices(ctx, mgr)
+	pool.BalanceRune = cosmos.NewUint(200 * common.One)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	ctx = ctx.WithBlockHeight(102)
+	nmgr.updatePoolPrices(ctx, mgr)
+
+	// the twap of the anchor pools prices the derived pools
+	_, price, _ = nmgr.CalcAnchor(ctx, mgr, common.TOR)
+	c.Check(price.Uint64(), Equals, uint64(constants.DollarMulti*common.One))
+	c.Check(mgr.Keeper().AnchorMedian(ctx, []common.Asset{common.USDCAsset}).Uint64(), Equals, uint64(constants.DollarMulti*common.One/2))
+
+	// spot prices when disabled
+	mgr.Keeper().SetMimir(ctx, constants.DerivedPoolTWAPBlocks.String(), 0)
+	_, price, _ = nmgr.CalcAnchor(ctx, mgr, common.TOR)
+	c.Check(price.Uint64(), Equals, uint64(constants.DollarMulti*common.One/2))
+}
+
 func (s *NetworkManagerVCURTestSuite) TestSpawnDerivedAssetsBasisPoints(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 
@@ -1285,6 +1324,103 @@ func (s *NetworkManagerVCURTestSuite) TestFetchMeanSlip(c *C) {
 	c.Check(slip, Equals, int64(100950))
 }
 
+func (s *NetworkManagerVCURTestSuite) TestUpdatePoolPrices(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	nmgr := newNetworkMgrVCUR(mgr.Keeper(), NewTxStoreDummy(), NewDummyEventMgr())
+
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.LPUnits = cosmos.NewUint(100)
+	pool.Status = PoolAvailable
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	setPrice := func(runeDepth uint64) {
+		pool.BalanceRune = cosmos.NewUint(runeDepth * common.One)
+		c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	}
+	nextBlock := func() {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		nmgr.updatePoolPrices(ctx, mgr)
+	}
+
+	// nothing is recorded while the breaker and observations are disabled
+	nmgr.updatePoolPrices(ctx, mgr)
+	_, err := mgr.Keeper().GetPoolCumulativePrice(ctx, common.BTCAsset, ctx.BlockHeight())
+	c.Assert(err, NotNil)
+
+	// enabling the breaker keeps the observations of its twap
+	mgr.Keeper().SetMimir(ctx, constants.PriceBreakerDeviationBps.String(), 1000)
+	mgr.Keeper().SetMimir(ctx, constants.PriceBreakerTWAPBlocks.String(), 4)
+	mgr.Keeper().SetMimir(ctx, constants.PriceBreakerPauseBlocks.String(), 10)
+	c.Check(mgr.Keeper().GetPoolPriceObservationBlocks(ctx), Equals, int64(4))
+	nmgr.updatePoolPrices(ctx, mgr)
+
+	// moves don't trip the breaker before the twap is available
+	setPrice(130)
+	nextBlock()
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.BTCAsset), Equals, false)
+	setPrice(100)
+	for i := 0; i < 5; i++ {
+		nextBlock()
+	}
+	twap, err := mgr.Keeper().GetPoolTWAP(ctx, common.BTCAsset, 4)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(common.One))
+
+	// small price moves don't trip the breaker
+	setPrice(105)
+	nextBlock()
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.BTCAsset), Equals, false)
+
+	// a large price move trips the breaker
+	setPrice(150)
+	nextBlock()
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.BTCAsset), Equals, true)
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.BTCAsset.GetTradeAsset()), Equals, true)
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.ETHAsset), Equals, false)
+	height, err := mgr.Keeper().GetPriceBreakerHeight(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(height, Equals, ctx.BlockHeight()+10)
+
+	found := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.PriceBreakerEventType {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+
+	// the pause ends after the pause blocks
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx.WithBlockHeight(height), common.BTCAsset), Equals, true)
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx.WithBlockHeight(height+1), common.BTCAsset), Equals, false)
+
+	// the price move of the trip doesn't trip the breaker again, even with a
+	// twap longer than the pause
+	mgr.Keeper().SetMimir(ctx, constants.PriceBreakerTWAPBlocks.String(), 20)
+	for ctx.BlockHeight() < height+1 {
+		nextBlock()
+	}
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.BTCAsset), Equals, false)
+	c.Check(nmgr.isPriceBreakerArmed(ctx, mgr, common.BTCAsset, 20, 10), Equals, false)
+	for ctx.BlockHeight() < height-10+20 {
+		nextBlock()
+	}
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.BTCAsset), Equals, false)
+	c.Check(nmgr.isPriceBreakerArmed(ctx, mgr, common.BTCAsset, 20, 10), Equals, true)
+
+	// a price collapse against a tiny twap doesn't overflow the deviation
+	mgr.Keeper().SetMimir(ctx, constants.PriceBreakerTWAPBlocks.String(), 1)
+	pool.BalanceRune = cosmos.NewUint(1)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	nextBlock()
+	pool.BalanceRune = cosmos.NewUint(10_000_000_000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(1)
+	c.Assert(mgr.Keeper().SetPool(ctx, pool), IsNil)
+	nextBlock()
+	c.Check(mgr.Keeper().IsPriceBreakerTripped(ctx, common.BTCAsset), Equals, true)
+}
+
 func (s *NetworkManagerVCURTestSuite) TestDistributeTCYStake(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	mgr.K.SetMimir(ctx, "TCYStakeDistributionHalt", 0)
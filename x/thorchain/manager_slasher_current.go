@@ -566,6 +566,7 @@ func (s SlasherVCUR) slashAndUpdateNodeAccount(ctx cosmos.Context, na types.Node
 	}
 
 	ctx.Logger().Info("slash node account", "node address", na.NodeAddress.String(), "amount", slashAmountRune.String(), "total slash amount", totalSlashAmountInRune)
+	s.slashLiquidBond(ctx, na, slashAmountRune)
 	na.Bond = common.SafeSub(na.Bond, slashAmountRune)
 
 	bondEvent := NewEventBond(slashAmountRune, BondCost, common.Tx{}, &na, nil)
@@ -595,6 +596,26 @@ func (s SlasherVCUR) slashAndUpdateNodeAccount(ctx cosmos.Context, na types.Node
 	return slashAmountRune
 }
 
+// slashLiquidBond applies the slash to the bond providers of a node with liquid
+// bond right away, so the redemption value of its receipts drops in proportion
+// to the slash. Rewards accrued until now are realigned first so the node
+// operator fee is unaffected.
+func (s SlasherVCUR) slashLiquidBond(ctx cosmos.Context, na types.NodeAccount, slashAmountRune cosmos.Uint) {
+	bp, err := s.keeper.GetBondProviders(ctx, na.NodeAddress)
+	if err != nil {
+		ctx.Logger().Error("fail to get bond providers for slash", "error", err)
+		return
+	}
+	if bp.LiquidBondUnits.IsZero() {
+		return
+	}
+	bp.Adjust(na.Bond)
+	bp.Adjust(common.SafeSub(na.Bond, slashAmountRune))
+	if err := s.keeper.SetBondProviders(ctx, bp); err != nil {
+		ctx.Logger().Error("fail to save bond providers for slash", "error", err)
+	}
+}
+
 // IncSlashPoints will increase the given account's slash points
 func (s *SlasherVCUR) IncSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
 	for _, addr := range addresses {
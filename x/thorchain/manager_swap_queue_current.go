@@ -44,6 +44,11 @@ func (vm *SwapQueueVCUR) FetchQueue(ctx cosmos.Context) (swapItems, error) { //
 			continue
 		}
 
+		// hold swaps in pools paused by the price breaker
+		if isSwapPriceBreakerTripped(ctx, vm.k, msg) {
+			continue
+		}
+
 		// exclude streaming swaps when its not "their time". Always want to
 		// allow the first sub-swap immediately (ie no LastHeight yet)
 		if msg.IsStreaming() {
@@ -103,6 +108,10 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		synthVirtualDepthMult = mgr.GetConstants().GetInt64Value(constants.VirtualMultSynthsBasisPoints)
 	}
 
+	// recurring swaps due this block are queued before the queue is fetched,
+	// so they are swapped in the same block
+	queueRecurringSwaps(ctx, mgr)
+
 	swaps, err := vm.FetchQueue(ctx)
 	if err != nil {
 		ctx.Logger().Error("fail to fetch swap queue from store", "error", err)
@@ -122,6 +131,7 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		swp := pick.msg.GetStreamingSwap()
 
 		triggerRefund := false
+		keepQueued := false
 		_, handleErr := handler(ctx, &pick.msg)
 		if handleErr != nil {
 			ctx.Logger().Error("fail to swap", "msg", pick.msg.Tx.String(), "error", handleErr)
@@ -164,6 +174,16 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 						ctx.Logger().Error("failed to cleanup failed preferred asset swap", "error", err)
 					}
 				}
+
+				// Should not refund a failed recurring swap, the source asset is credited back to the trade account.
+				// If it cannot be credited the swap stays queued, so it is retried next block, until it is abandoned.
+				if vm.k.RecurringSwapFillExists(ctx, pick.msg.Tx.ID) {
+					triggerRefund = false
+					if err := settleRecurringSwapFill(ctx, mgr, pick.msg, cosmos.ZeroUint(), handleErr); err != nil {
+						ctx.Logger().Error("failed to settle failed recurring swap", "error", err)
+						keepQueued = !abandonRecurringSwapFill(ctx, mgr, pick.msg, err)
+					}
+				}
 			}
 
 			if triggerRefund {
@@ -199,75 +219,91 @@ func (vm *SwapQueueVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 				mgr.Keeper().SetStreamingSwap(ctx, swp)
 			}
 			if swp.Valid() == nil && swp.IsDone() {
-				vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
-				vm.k.RemoveStreamingSwap(ctx, pick.msg.Tx.ID)
-
-				memo, err := ParseMemoWithTHORNames(ctx, vm.k, pick.msg.Tx.Memo)
-				if err != nil {
+				if err := settleStreamingSwap(ctx, mgr, pick.msg, pick.index, swp); err != nil {
 					return err
 				}
-				isSaversAdd := memo.IsType(TxAdd)
-
-				// If this is a savers add skip scheduling outbound
-				if !swp.Out.IsZero() && !isSaversAdd {
-					dexAgg := ""
-					if len(pick.msg.Aggregator) > 0 {
-						dexAgg, err = FetchDexAggregator(
-							pick.msg.TargetAsset.GetChain(),
-							pick.msg.Aggregator,
-						)
-						if err != nil {
-							return err
-						}
-					}
-					dexAggTargetAsset := pick.msg.AggregatorTargetAddress
-
-					toi := TxOutItem{
-						Chain:                 pick.msg.TargetAsset.GetChain(),
-						InHash:                pick.msg.Tx.ID,
-						ToAddress:             pick.msg.Destination,
-						Coin:                  common.NewCoin(pick.msg.TargetAsset, swp.Out),
-						Aggregator:            dexAgg,
-						AggregatorTargetAsset: dexAggTargetAsset,
-						AggregatorTargetLimit: pick.msg.AggregatorTargetLimit,
-					}
+			}
+		} else if !keepQueued {
+			vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
+		}
+	}
+	return nil
+}
 
-					if _, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint()); err != nil {
-						ctx.Logger().Error("fail streaming swap outbound", "error", err)
-						unrefundableCoinCleanup(ctx, mgr, toi, "failed_outbound")
-
-						// Emit a "fail to refund" refund event to signal to explorers/interfaces what has happened to the streaming swap output.
-						refundReason := fmt.Sprintf("%s; fail to refund (%s): streaming swap output", err, toi.Coin.String())
-						// All aspects of the inbound Tx are unchanged except for the Coins, which here have become the already-swapped output Coins.
-						refundTx := common.NewTx(pick.msg.Tx.ID, pick.msg.Tx.FromAddress, pick.msg.Tx.ToAddress, common.NewCoins(toi.Coin), pick.msg.Tx.Gas, pick.msg.Tx.Memo)
-						eventRefund := NewEventRefund(CodeFailAddOutboundTx, refundReason, refundTx, common.Fee{}) // fee param not used in downstream event
-						if err := mgr.EventMgr().EmitEvent(ctx, eventRefund); err != nil {
-							ctx.Logger().Error("fail to emit refund event", "error", err)
-						}
-					}
-				}
+// settleStreamingSwap removes a streaming swap from the swap queue, schedules the
+// outbound of the output swapped so far and refunds the unswapped remainder of
+// the deposit.
+func settleStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap, index int, swp StreamingSwap) error {
+	mgr.Keeper().RemoveSwapQueueItem(ctx, msg.Tx.ID, index)
+	mgr.Keeper().RemoveStreamingSwap(ctx, msg.Tx.ID)
 
-				if swp.Deposit.GT(swp.In) {
-					remainder := common.SafeSub(swp.Deposit, swp.In)
-					source := pick.msg.Tx.Coins[0].Asset
-					refundCoin := common.NewCoin(source, remainder)
-					refundCoinTx := pick.msg.Tx
-					refundCoinTx.Coins = common.NewCoins(refundCoin)
-					// As this is a streaming swap's partial refund, the vault context may have changed, so do vault selection.
-					if refundErr := refundTx(ctx, ObservedTx{Tx: refundCoinTx}, mgr, CodeSwapFail, "streaming partial-refund", ""); refundErr != nil {
-						ctx.Logger().Error("fail to partial-refund swap", "error", refundErr)
-					}
-				}
+	memo, err := ParseMemoWithTHORNames(ctx, mgr.Keeper(), msg.Tx.Memo)
+	if err != nil {
+		return err
+	}
+	isSaversAdd := memo.IsType(TxAdd)
+
+	// If this is a savers add skip scheduling outbound
+	if !swp.Out.IsZero() && !isSaversAdd {
+		dexAgg := ""
+		if len(msg.Aggregator) > 0 {
+			dexAgg, err = FetchDexAggregator(
+				msg.TargetAsset.GetChain(),
+				msg.Aggregator,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		dexAggTargetAsset := msg.AggregatorTargetAddress
+
+		toi := TxOutItem{
+			Chain:                 msg.TargetAsset.GetChain(),
+			InHash:                msg.Tx.ID,
+			ToAddress:             msg.Destination,
+			Coin:                  common.NewCoin(msg.TargetAsset, swp.Out),
+			Aggregator:            dexAgg,
+			AggregatorTargetAsset: dexAggTargetAsset,
+			AggregatorTargetLimit: msg.AggregatorTargetLimit,
+		}
 
-				evt := NewEventStreamingSwap(pick.msg.Tx.Coins[0].Asset, pick.msg.TargetAsset, swp)
-				if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
-					ctx.Logger().Error("fail to emit streaming swap event", "error", err)
+		tois := []TxOutItem{toi}
+		if msg.HasSplitDestinations() {
+			tois = getSplitDestinationTxOutItems(msg, toi)
+		}
+		for _, toi := range tois {
+			if _, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi, cosmos.ZeroUint()); err != nil {
+				ctx.Logger().Error("fail streaming swap outbound", "error", err)
+				unrefundableCoinCleanup(ctx, mgr, toi, "failed_outbound")
+
+				// Emit a "fail to refund" refund event to signal to explorers/interfaces what has happened to the streaming swap output.
+				refundReason := fmt.Sprintf("%s; fail to refund (%s): streaming swap output", err, toi.Coin.String())
+				// All aspects of the inbound Tx are unchanged except for the Coins, which here have become the already-swapped output Coins.
+				refundTx := common.NewTx(msg.Tx.ID, msg.Tx.FromAddress, msg.Tx.ToAddress, common.NewCoins(toi.Coin), msg.Tx.Gas, msg.Tx.Memo)
+				eventRefund := NewEventRefund(CodeFailAddOutboundTx, refundReason, refundTx, common.Fee{}) // fee param not used in downstream event
+				if err := mgr.EventMgr().EmitEvent(ctx, eventRefund); err != nil {
+					ctx.Logger().Error("fail to emit refund event", "error", err)
 				}
 			}
-		} else {
-			vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID, pick.index)
 		}
 	}
+
+	if swp.Deposit.GT(swp.In) {
+		remainder := common.SafeSub(swp.Deposit, swp.In)
+		source := msg.Tx.Coins[0].Asset
+		refundCoin := common.NewCoin(source, remainder)
+		refundCoinTx := msg.Tx
+		refundCoinTx.Coins = common.NewCoins(refundCoin)
+		// As this is a streaming swap's partial refund, the vault context may have changed, so do vault selection.
+		if refundErr := refundTx(ctx, ObservedTx{Tx: refundCoinTx}, mgr, CodeSwapFail, "streaming partial-refund", ""); refundErr != nil {
+			ctx.Logger().Error("fail to partial-refund swap", "error", refundErr)
+		}
+	}
+
+	evt := NewEventStreamingSwap(msg.Tx.Coins[0].Asset, msg.TargetAsset, swp)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit streaming swap event", "error", err)
+	}
 	return nil
 }
 
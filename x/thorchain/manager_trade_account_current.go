@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"fmt"
+
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
@@ -119,3 +121,46 @@ func (s *TradeMgrVCUR) Withdrawal(ctx cosmos.Context, asset common.Asset, amount
 
 	return tokensToClaim, nil
 }
+
+// Transfer moves the units backing amount of the trade asset from one trade account
+// to another. The trade unit totals are untouched, so no depth enters or leaves the
+// trade accounts.
+func (s *TradeMgrVCUR) Transfer(ctx cosmos.Context, asset common.Asset, amount cosmos.Uint, from, to cosmos.AccAddress, txID common.TxID) (cosmos.Uint, error) {
+	asset = asset.GetTradeAsset()
+	tu, err := s.keeper.GetTradeUnit(ctx, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+
+	sender, err := s.keeper.GetTradeAccount(ctx, from, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	recipient, err := s.keeper.GetTradeAccount(ctx, to, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+
+	// same share calculation as a withdrawal, capped at the sender's balance
+	assetAvailable := common.GetSafeShare(sender.Units, tu.Units, tu.Depth)
+	unitsToTransfer := common.GetSafeShare(amount, assetAvailable, sender.Units)
+	tokensToTransfer := common.GetSafeShare(unitsToTransfer, sender.Units, assetAvailable)
+	if unitsToTransfer.IsZero() {
+		return cosmos.ZeroUint(), fmt.Errorf("insufficient %s trade account balance to transfer", asset)
+	}
+
+	sender.Units = common.SafeSub(sender.Units, unitsToTransfer)
+	sender.LastWithdrawHeight = ctx.BlockHeight()
+	recipient.Units = recipient.Units.Add(unitsToTransfer)
+	recipient.LastAddHeight = ctx.BlockHeight()
+
+	s.keeper.SetTradeAccount(ctx, sender)
+	s.keeper.SetTradeAccount(ctx, recipient)
+
+	transferEvent := NewEventTradeAccountTransfer(tokensToTransfer, asset, common.Address(from.String()), common.Address(to.String()), txID)
+	if err := s.eventMgr.EmitEvent(ctx, transferEvent); err != nil {
+		ctx.Logger().Error("fail to emit trade account transfer event", "error", err)
+	}
+
+	return tokensToTransfer, nil
+}
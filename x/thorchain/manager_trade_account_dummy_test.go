@@ -27,3 +27,7 @@ func (d DummyTradeAccountManager) Deposit(ctx cosmos.Context, asset common.Asset
 func (d DummyTradeAccountManager) Withdrawal(ctx cosmos.Context, asset common.Asset, amount cosmos.Uint, owner cosmos.AccAddress, assetAddr common.Address, _ common.TxID) (cosmos.Uint, error) {
 	return cosmos.ZeroUint(), nil
 }
+
+func (d DummyTradeAccountManager) Transfer(ctx cosmos.Context, asset common.Asset, amount cosmos.Uint, from, to cosmos.AccAddress, _ common.TxID) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), nil
+}
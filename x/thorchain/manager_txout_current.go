@@ -45,6 +45,24 @@ func (tos *TxOutStorageVCUR) EndBlock(ctx cosmos.Context, mgr Manager) error {
 		return err
 	}
 
+	// outbounds of a halted pool are held by moving them to a later block
+	// before they can be signed
+	pending := make([]TxOutItem, 0, len(txOut.TxArray))
+	for _, tx := range txOut.TxArray {
+		if tx.OutHash.IsEmpty() && tos.isOutboundHeld(ctx, tx) {
+			if err := tos.keeper.AppendTxOut(ctx, tos.heldOutboundHeight(ctx, tx.Coin.Asset), tx); err != nil {
+				return fmt.Errorf("fail to hold outbound: %w", err)
+			}
+			continue
+		}
+		pending = append(pending, tx)
+	}
+	if len(pending) == 0 && len(txOut.TxArray) > 0 {
+		// an empty tx out is not saved, so clear it instead
+		return tos.keeper.ClearTxOut(ctx, txOut.Height)
+	}
+	txOut.TxArray = pending
+
 	maxGasCache := make(map[common.Chain]common.Coin)
 	gasRateCache := make(map[common.Chain]int64)
 
@@ -259,6 +277,9 @@ func (tos *TxOutStorageVCUR) cachedTryAddTxOutItem(ctx cosmos.Context, mgr Manag
 	// calculate the single block height to send all of these txout items,
 	// using the summed amount
 	outboundHeight := ctx.BlockHeight()
+	if tos.isOutboundHeld(ctx, toi) {
+		outboundHeight = tos.heldOutboundHeight(ctx, toi.Coin.Asset)
+	}
 	cloutApplied := cosmos.ZeroUint()
 	if !toi.Chain.IsTHORChain() && !toi.InHash.IsEmpty() && !toi.InHash.Equals(common.BlankTxID) {
 		toi.Memo = outputs[0].Memo
@@ -283,6 +304,10 @@ func (tos *TxOutStorageVCUR) cachedTryAddTxOutItem(ctx cosmos.Context, mgr Manag
 			outboundHeight = targetHeight
 		}
 
+		if toi.Chain.IsUTXO() && len(outputs) == 1 {
+			outboundHeight = tos.alignBatchSiblings(ctx, outputs[0], outboundHeight)
+		}
+
 		// While each outbound has its own security-appropriate outbound delay,
 		// ensure the voter.OutboundHeight reflects the furthest-future scheduled outbound height
 		// so as to serve as an estimate of when the entire transaction may be completed.
@@ -325,6 +350,111 @@ func (tos *TxOutStorageVCUR) cachedTryAddTxOutItem(ctx cosmos.Context, mgr Manag
 	return true, nil
 }
 
+// alignBatchSiblings returns the height to schedule a UTXO outbound at, so that
+// it shares a block with its pending batch siblings on the same vault and
+// bifrost can pay all their recipients in one tx. Siblings scheduled earlier
+// are moved to that height, outbounds are only ever delayed.
+func (tos *TxOutStorageVCUR) alignBatchSiblings(ctx cosmos.Context, toi TxOutItem, outboundHeight int64) int64 {
+	txOutDelayMax := tos.keeper.GetConfigInt64(ctx, constants.TxOutDelayMax)
+	maxTxOutOffset := tos.keeper.GetConfigInt64(ctx, constants.MaxTxOutOffset)
+	isSibling := func(item TxOutItem) bool {
+		return item.OutHash.IsEmpty() && item.VaultPubKey.Equals(toi.VaultPubKey) && isBatchSibling(item, toi)
+	}
+
+	var siblingHeights []int64
+	for height := ctx.BlockHeight(); height <= ctx.BlockHeight()+txOutDelayMax; height++ {
+		txOut, err := tos.keeper.GetTxOut(ctx, height)
+		if err != nil {
+			ctx.Logger().Error("fail to get block tx out", "error", err)
+			continue
+		}
+		if height > ctx.BlockHeight()+maxTxOutOffset && len(txOut.TxArray) == 0 {
+			break
+		}
+		for _, item := range txOut.TxArray {
+			if isSibling(item) {
+				siblingHeights = append(siblingHeights, height)
+				if height > outboundHeight {
+					outboundHeight = height
+				}
+				break
+			}
+		}
+	}
+
+	for _, height := range siblingHeights {
+		if height == outboundHeight {
+			continue
+		}
+		txOut, err := tos.keeper.GetTxOut(ctx, height)
+		if err != nil {
+			ctx.Logger().Error("fail to get block tx out", "error", err)
+			continue
+		}
+		var kept, moved []TxOutItem
+		for _, item := range txOut.TxArray {
+			if isSibling(item) {
+				moved = append(moved, item)
+			} else {
+				kept = append(kept, item)
+			}
+		}
+		txOut.TxArray = kept
+		if len(kept) == 0 {
+			err = tos.keeper.ClearTxOut(ctx, height)
+		} else {
+			err = tos.keeper.SetTxOut(ctx, txOut)
+		}
+		if err != nil {
+			ctx.Logger().Error("fail to save block tx out", "error", err)
+			continue
+		}
+		for _, item := range moved {
+			if err := tos.keeper.AppendTxOut(ctx, outboundHeight, item); err != nil {
+				ctx.Logger().Error("fail to move batch sibling outbound", "height", outboundHeight, "error", err)
+			}
+		}
+	}
+	return outboundHeight
+}
+
+// isBatchSibling returns true when both outbounds pay out the recipients of the
+// same split destination swap on the same chain with the same memo, thus can be
+// signed as one batched tx
+func isBatchSibling(item, toi TxOutItem) bool {
+	if !item.SplitDestination || !toi.SplitDestination {
+		return false
+	}
+	if toi.InHash.IsEmpty() || toi.InHash.Equals(common.BlankTxID) {
+		return false
+	}
+	if item.Aggregator != "" || toi.Aggregator != "" {
+		return false
+	}
+	return item.InHash.Equals(toi.InHash) &&
+		item.Chain.Equals(toi.Chain) &&
+		strings.EqualFold(item.Memo, toi.Memo)
+}
+
+// isOutboundHeld returns true when the outbound pays out an asset of a halted pool,
+// vault migrations are never held
+func (tos *TxOutStorageVCUR) isOutboundHeld(ctx cosmos.Context, toi TxOutItem) bool {
+	if toi.Chain.IsTHORChain() || strings.Split(toi.Memo, ":")[0] == constants.MemoPrefixMigrate {
+		return false
+	}
+	return tos.keeper.IsPoolTradingHalted(ctx, toi.Coin.Asset)
+}
+
+// heldOutboundHeight returns the height to hold an outbound of a halted pool until,
+// the scheduled resume height of the pool if there is one, otherwise the next block
+func (tos *TxOutStorageVCUR) heldOutboundHeight(ctx cosmos.Context, asset common.Asset) int64 {
+	resumePool, err := tos.keeper.GetMimirWithRef(ctx, constants.MimirTemplatePoolResume, asset.GetLayer1Asset().MimirString())
+	if err == nil && resumePool > ctx.BlockHeight() {
+		return resumePool
+	}
+	return ctx.BlockHeight() + 1
+}
+
 // UnSafeAddTxOutItem - blindly adds a tx out, skipping vault selection, transaction
 // fee deduction, etc
 func (tos *TxOutStorageVCUR) UnSafeAddTxOutItem(ctx cosmos.Context, mgr Manager, toi TxOutItem, height int64) error {
@@ -566,7 +696,27 @@ func (tos *TxOutStorageVCUR) prepareTxOutItem(ctx cosmos.Context, toi TxOutItem)
 			// iterate over discovered vaults and find vaults to send funds from
 
 			// All else being equal, choose active Asgards over retiring Asgards.
-			outputs, remaining = tos.DiscoverOutbounds(ctx, transactionFeeAmount, maxGasCoin, toi, append(activeAsgards, retiringAsgards...))
+			vaults := append(activeAsgards, retiringAsgards...)
+
+			// On UTXO chains prefer the vault of a pending batch sibling,
+			// so that bifrost can pay both recipients in one tx.
+			if toi.Chain.IsUTXO() {
+				for _, pending := range pendingOutbounds {
+					if !isBatchSibling(pending, toi) {
+						continue
+					}
+					for _, vault := range vaults {
+						if vault.PubKey.Equals(pending.VaultPubKey) {
+							outputs, remaining = tos.DiscoverOutbounds(ctx, transactionFeeAmount, maxGasCoin, toi, Vaults{vault})
+							break
+						}
+					}
+					break
+				}
+			}
+			if len(outputs) == 0 || !remaining.IsZero() {
+				outputs, remaining = tos.DiscoverOutbounds(ctx, transactionFeeAmount, maxGasCoin, toi, vaults)
+			}
 
 			// Check we found enough funds to satisfy the request, error if we didn't
 			if !remaining.IsZero() {
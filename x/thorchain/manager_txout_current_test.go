@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"fmt"
+
 	"gopkg.in/check.v1"
 	. "gopkg.in/check.v1"
 
@@ -50,6 +52,92 @@ func (s TxOutStoreVCURSuite) TestEndBlock(c *C) {
 	c.Check(items[0].MaxGas[0].Amount.Uint64(), Equals, uint64(56250))
 }
 
+func (s TxOutStoreVCURSuite) TestAddOutTxItemPoolHalt(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(10000*common.One)),
+		common.NewCoin(common.DOGEAsset, cosmos.NewUint(10000*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+
+	inTxID := GetRandomTxHash()
+	voter := NewObservedTxVoter(inTxID, common.ObservedTxs{
+		common.ObservedTx{
+			Tx:             GetRandomTx(),
+			Status:         common.Status_incomplete,
+			BlockHeight:    1,
+			Signers:        []string{w.activeNodeAccount.NodeAddress.String()},
+			FinaliseHeight: 1,
+		},
+	})
+	w.keeper.SetObservedTxInVoter(w.ctx, voter)
+
+	haltKey := fmt.Sprintf(constants.MimirTemplatePoolHalt, common.DOGEAsset.MimirString())
+	resumeKey := fmt.Sprintf(constants.MimirTemplatePoolResume, common.DOGEAsset.MimirString())
+	w.keeper.SetMimir(w.ctx, haltKey, 1)
+
+	item := TxOutItem{
+		Chain:     common.DOGEChain,
+		ToAddress: GetRandomDOGEAddress(),
+		InHash:    inTxID,
+		Coin:      common.NewCoin(common.DOGEAsset, cosmos.NewUint(20*common.One)),
+	}
+	txOutStore := newTxOutStorageVCUR(w.keeper, w.mgr.GetConstants(), w.mgr.EventMgr(), w.mgr.GasMgr())
+	ok, err := txOutStore.TryAddTxOutItem(w.ctx, w.mgr, item, cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+
+	// the outbound of the halted pool is held until the next block
+	height := w.ctx.BlockHeight()
+	msgs, err := txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 0)
+	ctx := w.ctx.WithBlockHeight(height + 1)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+
+	// still halted, so end block moves it on again
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 0)
+	ctx = w.ctx.WithBlockHeight(height + 2)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+
+	// a scheduled resumption holds it until the resume height
+	w.keeper.SetMimir(ctx, resumeKey, height+10)
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 0)
+	ctx = w.ctx.WithBlockHeight(height + 10)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+
+	// once the pool resumes the outbound stays in its block
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	c.Check(msgs[0].InHash.Equals(inTxID), Equals, true)
+
+	// vault migrations are never held
+	w.keeper.SetMimir(ctx, resumeKey, 0)
+	migrate := TxOutItem{
+		Chain:     common.DOGEChain,
+		ToAddress: GetRandomDOGEAddress(),
+		Coin:      common.NewCoin(common.DOGEAsset, cosmos.NewUint(common.One)),
+		Memo:      NewMigrateMemo(1).String(),
+	}
+	c.Check(txOutStore.isOutboundHeld(ctx, item), Equals, true)
+	c.Check(txOutStore.isOutboundHeld(ctx, migrate), Equals, false)
+}
+
 func (s TxOutStoreVCURSuite) TestAddOutTxItem(c *C) {
 	w := getHandlerTestWrapper(c, 1, true, true)
 	vault := GetRandomVault()
@@ -517,11 +605,13 @@ func (s TxOutStoreVCURSuite) TestAddOutTxItem_MultipleOutboundWillNotBeScheduled
 	c.Assert(msgs, HasLen, 0)
 	//  the outbound has been delayed
 
+	// a refund memo, as outbounds sharing the memo would be batched at one height
 	item1 := TxOutItem{
 		Chain:     common.DOGEChain,
 		ToAddress: GetRandomDOGEAddress(),
 		InHash:    inTxID,
 		Coin:      common.NewCoin(common.DOGEAsset, cosmos.NewUint(10*common.One)),
+		Memo:      NewRefundMemo(inTxID).String(),
 	}
 
 	ok, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, item1, cosmos.ZeroUint())
@@ -560,6 +650,116 @@ func (s TxOutStoreVCURSuite) TestAddOutTxItem_MultipleOutboundWillNotBeScheduled
 	c.Assert(afterVoter1.OutboundHeight, Equals, int64(4))
 }
 
+func (s TxOutStoreVCURSuite) TestAddOutTxItem_BatchSiblings(c *C) {
+	SetupConfigForTest()
+	w := getHandlerTestWrapper(c, 1, true, true)
+	for i := 0; i < 2; i++ {
+		vault := GetRandomVault()
+		vault.Coins = common.Coins{
+			common.NewCoin(common.RuneAsset(), cosmos.NewUint(10000*common.One)),
+			common.NewCoin(common.DOGEAsset, cosmos.NewUint(10000*common.One)),
+		}
+		c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	}
+
+	w.keeper.SetMimir(w.ctx, constants.MinTxOutVolumeThreshold.String(), 100000000000)
+	w.keeper.SetMimir(w.ctx, constants.TxOutDelayRate.String(), 2500000000)
+	w.keeper.SetMimir(w.ctx, constants.MaxTxOutOffset.String(), 720)
+	inTxID := GetRandomTxHash()
+	voter := NewObservedTxVoter(inTxID, common.ObservedTxs{
+		common.ObservedTx{
+			Tx:             GetRandomTx(),
+			Status:         common.Status_incomplete,
+			BlockHeight:    1,
+			Signers:        []string{w.activeNodeAccount.NodeAddress.String()},
+			FinaliseHeight: 1,
+		},
+	})
+	w.keeper.SetObservedTxInVoter(w.ctx, voter)
+	txOutStore := newTxOutStorageVCUR(w.keeper, w.mgr.GetConstants(), w.mgr.EventMgr(), w.mgr.GasMgr())
+
+	// the small outbound is not delayed on its own
+	item := TxOutItem{
+		Chain:            common.DOGEChain,
+		ToAddress:        GetRandomDOGEAddress(),
+		InHash:           inTxID,
+		Coin:             common.NewCoin(common.DOGEAsset, cosmos.NewUint(10*common.One)),
+		SplitDestination: true,
+	}
+	ok, err := txOutStore.TryAddTxOutItem(w.ctx, w.mgr, item, cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	msgs, err := txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	vaultPubKey := msgs[0].VaultPubKey
+
+	// an outbound of another inbound is not a batch sibling
+	other := item
+	other.InHash = GetRandomTxHash()
+	c.Check(isBatchSibling(msgs[0], other), Equals, false)
+	other = item
+	other.Memo = NewRefundMemo(inTxID).String()
+	c.Check(isBatchSibling(msgs[0], other), Equals, false)
+	other = item
+	other.Chain = common.BTCChain
+	c.Check(isBatchSibling(msgs[0], other), Equals, false)
+	c.Check(isBatchSibling(msgs[0], item), Equals, false) // memo not yet set
+	item.Memo = msgs[0].Memo
+	c.Check(isBatchSibling(msgs[0], item), Equals, true)
+
+	// only the outbounds of a split destination swap are batch siblings
+	other = item
+	other.SplitDestination = false
+	c.Check(isBatchSibling(msgs[0], other), Equals, false)
+	c.Check(isBatchSibling(other, item), Equals, false)
+
+	// the delayed sibling is paid from the same vault, and the earlier
+	// sibling is moved to its height so both are signed in one tx
+	item1 := TxOutItem{
+		Chain:            common.DOGEChain,
+		ToAddress:        GetRandomDOGEAddress(),
+		InHash:           inTxID,
+		Coin:             common.NewCoin(common.DOGEAsset, cosmos.NewUint(80*common.One)),
+		SplitDestination: true,
+	}
+	ok, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, item1, cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	msgs, err = txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 0)
+
+	msgs, err = txOutStore.GetOutboundItems(w.ctx.WithBlockHeight(4))
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 2)
+	for _, msg := range msgs {
+		c.Check(msg.VaultPubKey.Equals(vaultPubKey), Equals, true)
+		c.Check(msg.InHash.Equals(inTxID), Equals, true)
+	}
+	c.Check(msgs[0].Coin.Amount.Uint64(), Equals, uint64(9_99925000))
+	c.Check(msgs[1].Coin.Amount.Uint64(), Equals, uint64(79_99925000))
+
+	afterVoter, err := w.keeper.GetObservedTxInVoter(w.ctx, inTxID)
+	c.Assert(err, IsNil)
+	c.Check(afterVoter.OutboundHeight, Equals, int64(4))
+
+	// a later small sibling joins them rather than going out first
+	item2 := item
+	item2.Memo = ""
+	item2.ToAddress = GetRandomDOGEAddress()
+	ok, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, item2, cosmos.ZeroUint())
+	c.Assert(err, IsNil)
+	c.Assert(ok, Equals, true)
+	msgs, err = txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 0)
+	msgs, err = txOutStore.GetOutboundItems(w.ctx.WithBlockHeight(4))
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 3)
+	c.Check(msgs[2].VaultPubKey.Equals(vaultPubKey), Equals, true)
+}
+
 func (s TxOutStoreVCURSuite) TestAddOutTxItemInteractionWithPool(c *C) {
 	w := getHandlerTestWrapper(c, 1, true, true)
 	pool, err := w.keeper.GetPool(w.ctx, common.DOGEAsset)
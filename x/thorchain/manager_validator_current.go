@@ -144,6 +144,11 @@ func (vm *ValidatorMgrVCUR) churn(ctx cosmos.Context) error {
 		return err
 	}
 
+	// mark someone(s) for missing observations
+	if err := vm.markMissingObservers(ctx); err != nil {
+		return err
+	}
+
 	// mark someone to get churned out for low bond
 	if err := vm.markLowBondActor(ctx); err != nil {
 		return err
@@ -292,6 +297,8 @@ func (vm *ValidatorMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) []abci.Val
 		return nil
 	}
 
+	vm.processNodeOperatorFeeChanges(ctx)
+
 	newNodes, removedNodes, err := vm.getChangedNodes(ctx, activeNodes)
 	if err != nil {
 		ctx.Logger().Error("fail to get node changes", "error", err)
@@ -324,6 +331,7 @@ func (vm *ValidatorMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) []abci.Val
 
 	// If there's been a churn (the nodes have changed), continue; if there hasn't, end the function.
 	if len(newNodes) == 0 && len(removedNodes) == 0 {
+		vm.processBondProviderUnbonds(ctx, mgr, false)
 		return nil
 	}
 
@@ -386,6 +394,8 @@ func (vm *ValidatorMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) []abci.Val
 		if err := vm.k.SetNodeAccount(ctx, nodeRemove); err != nil {
 			ctx.Logger().Error("fail to save node account", "error", err)
 		}
+		// observation stats only track active nodes, start afresh if the node rejoins
+		vm.k.RemoveObservationStatsByNode(ctx, nodeRemove.NodeAddress)
 
 		pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeConsPub, nodeRemove.ValidatorConsPubKey)
 		if err != nil {
@@ -429,9 +439,156 @@ func (vm *ValidatorMgrVCUR) EndBlock(ctx cosmos.Context, mgr Manager) []abci.Val
 	// the EconomicMimir OperationalVotesMin could be set to a higher threshold.
 	vm.k.PurgeOperationalNodeMimirs(ctx)
 
+	// execute the queued bond provider unbonds now that the node statuses are final
+	vm.processBondProviderUnbonds(ctx, mgr, true)
+
 	return validators
 }
 
+// processBondProviderUnbonds executes the pending bond provider unbond requests.
+// On an active node a request executes on a churn once its notice period has
+// passed, and only if the node bond stays at or above the minimum bond. On a node
+// that is no longer active it executes once the node has left the retiring vaults.
+// As with a direct unbond, nothing executes while unbonding is paused or within
+// the bond lockup period of the node, the requests stay queued until then.
+func (vm *ValidatorMgrVCUR) processBondProviderUnbonds(ctx cosmos.Context, mgr Manager, churned bool) {
+	if vm.k.GetConfigInt64(ctx, constants.PauseUnbond) > 0 {
+		return
+	}
+
+	nodes, err := vm.k.GetBondProviderUnbondNodes(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get nodes with pending unbond requests", "error", err)
+		return
+	}
+	if len(nodes) == 0 {
+		return
+	}
+
+	noticePeriod := vm.k.GetConfigInt64(ctx, constants.BondProviderUnbondNoticeBlocks)
+	bondLockPeriod := vm.k.GetConfigInt64(ctx, constants.BondLockupPeriod)
+	minBond := cosmos.SafeUintFromInt64(vm.k.GetConfigInt64(ctx, constants.MinimumBondInRune))
+	var retiring Vaults
+	retiringLoaded := false
+
+	for _, addr := range nodes {
+		na, err := vm.k.GetNodeAccount(ctx, addr)
+		if err != nil {
+			ctx.Logger().Error("fail to get node account", "node address", addr, "error", err)
+			continue
+		}
+		if ctx.BlockHeight()-na.StatusSince < bondLockPeriod {
+			continue
+		}
+
+		switch na.Status {
+		case NodeReady:
+			continue
+		case NodeActive:
+			if !churned {
+				continue
+			}
+		default:
+			if !retiringLoaded {
+				retiring, err = vm.k.GetAsgardVaultsByStatus(ctx, RetiringVault)
+				if err != nil {
+					ctx.Logger().Error("fail to get retiring vaults", "error", err)
+					return
+				}
+				retiringLoaded = true
+			}
+			isRetiring := false
+			for _, v := range retiring {
+				if v.GetMembership().Contains(na.PubKeySet.Secp256k1) {
+					isRetiring = true
+					break
+				}
+			}
+			if isRetiring {
+				continue
+			}
+		}
+
+		bp, err := vm.k.GetBondProviders(ctx, addr)
+		if err != nil {
+			ctx.Logger().Error("fail to get bond providers", "node address", addr, "error", err)
+			continue
+		}
+		for _, provider := range bp.Providers {
+			if !provider.HasUnbondRequest() {
+				continue
+			}
+			if na.Status == NodeActive {
+				if ctx.BlockHeight() < provider.UnbondHeight+noticePeriod {
+					continue
+				}
+				// the provider bond is realigned with the node bond before it is returned
+				current, err := vm.k.GetBondProviders(ctx, addr)
+				if err != nil {
+					ctx.Logger().Error("fail to get bond providers", "node address", addr, "error", err)
+					break
+				}
+				current.Adjust(na.Bond)
+				amt := provider.UnbondAmount
+				providerBond := current.Get(provider.BondAddress).Bond
+				if amt.IsZero() || amt.GT(providerBond) {
+					amt = providerBond
+				}
+				if common.SafeSub(na.Bond, amt).LT(minBond) {
+					ctx.Logger().Info("node bond too low to execute unbond request", "node address", addr, "provider", provider.BondAddress)
+					continue
+				}
+			}
+			if err := returnBond(ctx, common.Tx{}, provider.BondAddress, provider.UnbondAmount, &na, mgr); err != nil {
+				ctx.Logger().Error("fail to execute unbond request", "node address", addr, "provider", provider.BondAddress, "error", err)
+			}
+		}
+	}
+}
+
+// processNodeOperatorFeeChanges applies the scheduled node operator fee changes
+// whose notice period has passed. Rewards accrued before the change are first
+// distributed at the previous fee.
+func (vm *ValidatorMgrVCUR) processNodeOperatorFeeChanges(ctx cosmos.Context) {
+	nodes, err := vm.k.GetNodeOperatorFeeNodes(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get nodes with pending operator fee changes", "error", err)
+		return
+	}
+
+	for _, addr := range nodes {
+		bp, err := vm.k.GetBondProviders(ctx, addr)
+		if err != nil {
+			ctx.Logger().Error("fail to get bond providers", "node address", addr, "error", err)
+			continue
+		}
+		if ctx.BlockHeight() < bp.PendingNodeOperatorFeeHeight {
+			continue
+		}
+		na, err := vm.k.GetNodeAccount(ctx, addr)
+		if err != nil {
+			ctx.Logger().Error("fail to get node account", "node address", addr, "error", err)
+			continue
+		}
+
+		bp.Adjust(na.Bond)
+		previous := bp.NodeOperatorFee
+		bp.NodeOperatorFee = bp.PendingNodeOperatorFee
+		bp.ClearPendingNodeOperatorFee()
+		if err := vm.k.SetBondProviders(ctx, bp); err != nil {
+			ctx.Logger().Error("fail to save bond providers", "node address", addr, "error", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			cosmos.NewEvent("node_operator_fee_activated",
+				cosmos.NewAttribute("node_address", addr.String()),
+				cosmos.NewAttribute("previous_fee", previous.String()),
+				cosmos.NewAttribute("fee", bp.NodeOperatorFee.String()),
+			))
+	}
+}
+
 // getChangedNodes to identify which node had been removed ,and which one had been added
 // newNodes , removed nodes,err
 func (vm *ValidatorMgrVCUR) getChangedNodes(ctx cosmos.Context, activeNodes NodeAccounts) (NodeAccounts, NodeAccounts, error) {
@@ -1117,6 +1274,70 @@ func (vm *ValidatorMgrVCUR) markMissingActors(ctx cosmos.Context) error {
 	return nil
 }
 
+// markMissingObservers marks the active nodes that missed the most observations and
+// network fee votes, relative to the ObservationMissChurnOutBps threshold. Nodes are
+// only considered once a full ObservationStatsWindow of observations is tracked.
+func (vm *ValidatorMgrVCUR) markMissingObservers(ctx cosmos.Context) error {
+	maxMissedBps := vm.k.GetConfigInt64(ctx, constants.ObservationMissChurnOutBps)
+	maxChurnOut := vm.k.GetConfigInt64(ctx, constants.MaxObservationMissChurnOut)
+	window := vm.k.GetConfigInt64(ctx, constants.ObservationStatsWindow)
+	if maxMissedBps == 0 || maxChurnOut == 0 || window <= 0 {
+		return nil // skip this mark
+	}
+
+	nas, err := vm.k.ListActiveValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	type missingObserver struct {
+		na        NodeAccount
+		missedBps int64
+	}
+	var candidates []missingObserver
+	for _, n := range nas {
+		// Only mark a missing observer not already marked for churn-out.
+		if n.LeaveScore > 0 {
+			continue
+		}
+
+		stats, err := vm.k.GetObservationStatsByNode(ctx, n.NodeAddress)
+		if err != nil {
+			return err
+		}
+		var total, missed int64
+		for i := range stats {
+			observations := stats[i].ObservationSummary()
+			networkFees := stats[i].NetworkFeeSummary()
+			total += observations.Count + networkFees.Count
+			missed += observations.Missed + networkFees.Missed
+		}
+		if total < window {
+			continue
+		}
+
+		missedBps := missed * int64(constants.MaxBasisPts) / total
+		if missedBps > maxMissedBps {
+			candidates = append(candidates, missingObserver{na: n, missedBps: missedBps})
+		}
+	}
+
+	// mark the nodes missing the most observations first
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].missedBps > candidates[j].missedBps
+	})
+	for i, candidate := range candidates {
+		if int64(i) >= maxChurnOut {
+			break
+		}
+		if err := vm.markActor(ctx, candidate.na, "for missing observations"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Iterate over active node accounts, finding the one that has been active longest
 func (vm *ValidatorMgrVCUR) findOldActor(ctx cosmos.Context) (NodeAccount, error) {
 	na := NodeAccount{}
@@ -1045,3 +1045,200 @@ func (vts *ValidatorMgrVCURTestSuite) TestMarkMissingActors_MimirDisabled(c *C)
 	c.Assert(err, IsNil)
 	c.Assert(nodeAfter.LeaveScore, Equals, uint64(0), Commentf("Node should not be marked when MissingBlockChurnOut is disabled"))
 }
+
+func (vts *ValidatorMgrVCURTestSuite) TestMarkMissingObservers(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(1000)
+	networkMgr := newValidatorMgrVCUR(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+
+	mgr.Keeper().SetMimir(ctx, constants.ObservationStatsWindow.String(), 10)
+
+	setStats := func(na NodeAccount, missed, total int) {
+		stats := NewObservationStats(na.NodeAddress, common.BTCChain)
+		for i := 0; i < total; i++ {
+			lag := int64(0)
+			if i < missed {
+				lag = ObservationMissed
+			}
+			stats.RecordObservation(ctx.BlockHeight(), GetRandomTxHash().String(), lag, 10)
+		}
+		mgr.Keeper().SetObservationStats(ctx, stats)
+	}
+
+	// node missing 10% of observations
+	node1 := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, node1), IsNil)
+	setStats(node1, 1, 10)
+
+	// node missing 50% of observations
+	node2 := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, node2), IsNil)
+	setStats(node2, 5, 10)
+
+	// node missing 80% of observations
+	node3 := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, node3), IsNil)
+	setStats(node3, 8, 10)
+
+	// node missing all observations, but without a full window tracked
+	node4 := GetRandomValidatorNode(NodeActive)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, node4), IsNil)
+	setStats(node4, 5, 5)
+
+	leaveScore := func(na NodeAccount) uint64 {
+		after, err := mgr.Keeper().GetNodeAccount(ctx, na.NodeAddress)
+		c.Assert(err, IsNil)
+		return after.LeaveScore
+	}
+
+	// disabled by default
+	c.Assert(networkMgr.markMissingObservers(ctx), IsNil)
+	for _, na := range []NodeAccount{node1, node2, node3, node4} {
+		c.Check(leaveScore(na), Equals, uint64(0))
+	}
+
+	mgr.Keeper().SetMimir(ctx, constants.ObservationMissChurnOutBps.String(), 2000)
+	mgr.Keeper().SetMimir(ctx, constants.MaxObservationMissChurnOut.String(), 1)
+	c.Assert(networkMgr.markMissingObservers(ctx), IsNil)
+	c.Check(leaveScore(node1), Equals, uint64(0))
+	c.Check(leaveScore(node2), Equals, uint64(0), Commentf("only the worst observer should be marked"))
+	c.Check(leaveScore(node3) > 0, Equals, true)
+	c.Check(leaveScore(node4), Equals, uint64(0))
+
+	// the next worst observer is marked once the limit allows it
+	mgr.Keeper().SetMimir(ctx, constants.MaxObservationMissChurnOut.String(), 5)
+	c.Assert(networkMgr.markMissingObservers(ctx), IsNil)
+	c.Check(leaveScore(node1), Equals, uint64(0))
+	c.Check(leaveScore(node2) > 0, Equals, true)
+	c.Check(leaveScore(node4), Equals, uint64(0))
+}
+
+func (vts *ValidatorMgrVCURTestSuite) TestProcessBondProviderUnbonds(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	vMgr := newValidatorMgrVCUR(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	noticePeriod := mgr.Keeper().GetConfigInt64(ctx, constants.BondProviderUnbondNoticeBlocks)
+
+	newNode := func(status NodeStatus, operatorBond, providerBond uint64) (NodeAccount, cosmos.AccAddress) {
+		na := GetRandomValidatorNode(status)
+		na.Bond = cosmos.NewUint(operatorBond + providerBond)
+		c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+		FundModule(c, ctx, mgr.Keeper(), BondName, na.Bond.Uint64())
+
+		operator, err := na.BondAddress.AccAddress()
+		c.Assert(err, IsNil)
+		provider := GetRandomBech32Addr()
+		bp := NewBondProviders(na.NodeAddress)
+		bp.Providers = []BondProvider{NewBondProvider(operator), NewBondProvider(provider)}
+		bp.Providers[0].Bond = cosmos.NewUint(operatorBond)
+		bp.Providers[1].Bond = cosmos.NewUint(providerBond)
+		c.Assert(bp.SetUnbondRequest(cosmos.ZeroUint(), provider, 1), Equals, true)
+		c.Assert(mgr.Keeper().SetBondProviders(ctx, bp), IsNil)
+		return na, provider
+	}
+	checkBond := func(na NodeAccount, provider cosmos.AccAddress, bond uint64, pending bool) {
+		na, err := mgr.Keeper().GetNodeAccount(ctx, na.NodeAddress)
+		c.Assert(err, IsNil)
+		c.Check(na.Bond.Uint64(), Equals, bond)
+		bp, err := mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+		c.Assert(err, IsNil)
+		p := bp.Get(provider)
+		c.Check(p.HasUnbondRequest(), Equals, pending)
+	}
+
+	minBond := uint64(mgr.Keeper().GetConfigInt64(ctx, constants.MinimumBondInRune))
+	active, activeProvider := newNode(NodeActive, 2*minBond, minBond)
+	lowBond, lowBondProvider := newNode(NodeActive, minBond/2, minBond)
+	standby, standbyProvider := newNode(NodeStandby, minBond, minBond)
+
+	nodes, err := mgr.Keeper().GetBondProviderUnbondNodes(ctx)
+	c.Assert(err, IsNil)
+	c.Check(nodes, HasLen, 3)
+
+	// standby node is still a member of a retiring vault
+	vault := GetRandomVault()
+	vault.Status = RetiringVault
+	vault.Membership = []string{standby.PubKeySet.Secp256k1.String()}
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+
+	// notice period has not passed
+	vMgr.processBondProviderUnbonds(ctx, mgr, true)
+	checkBond(active, activeProvider, 3*minBond, true)
+	checkBond(lowBond, lowBondProvider, minBond+minBond/2, true)
+	checkBond(standby, standbyProvider, 2*minBond, true)
+
+	// active nodes only unbond on a churn
+	ctx = ctx.WithBlockHeight(1 + noticePeriod)
+	vMgr.processBondProviderUnbonds(ctx, mgr, false)
+	checkBond(active, activeProvider, 3*minBond, true)
+
+	// nothing executes while unbonding is paused
+	mgr.Keeper().SetMimir(ctx, constants.PauseUnbond.String(), 1)
+	vMgr.processBondProviderUnbonds(ctx, mgr, true)
+	checkBond(active, activeProvider, 3*minBond, true)
+	mgr.Keeper().SetMimir(ctx, constants.PauseUnbond.String(), 0)
+
+	// or within the bond lockup period of the node
+	mgr.Keeper().SetMimir(ctx, constants.BondLockupPeriod.String(), ctx.BlockHeight()+1)
+	vMgr.processBondProviderUnbonds(ctx, mgr, true)
+	checkBond(active, activeProvider, 3*minBond, true)
+	mgr.Keeper().SetMimir(ctx, constants.BondLockupPeriod.String(), 0)
+
+	// node bond must stay above the minimum bond
+	vMgr.processBondProviderUnbonds(ctx, mgr, true)
+	checkBond(active, activeProvider, 2*minBond, false)
+	checkBond(lowBond, lowBondProvider, minBond+minBond/2, true)
+	checkBond(standby, standbyProvider, 2*minBond, true)
+
+	// standby node unbonds once it has left the retiring vault
+	vault.Status = InactiveVault
+	c.Assert(mgr.Keeper().SetVault(ctx, vault), IsNil)
+	vMgr.processBondProviderUnbonds(ctx, mgr, false)
+	checkBond(standby, standbyProvider, minBond, false)
+
+	nodes, err = mgr.Keeper().GetBondProviderUnbondNodes(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(nodes, HasLen, 1)
+	c.Check(nodes[0].Equals(lowBond.NodeAddress), Equals, true)
+}
+
+func (vts *ValidatorMgrVCURTestSuite) TestProcessNodeOperatorFeeChanges(c *C) {
+	ctx, mgr := setupManagerForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	vMgr := newValidatorMgrVCUR(mgr.Keeper(), mgr.NetworkMgr(), mgr.TxOutStore(), mgr.EventMgr())
+
+	na := GetRandomValidatorNode(NodeActive)
+	na.Bond = cosmos.NewUint(200 * common.One)
+	c.Assert(mgr.Keeper().SetNodeAccount(ctx, na), IsNil)
+	operator, err := na.BondAddress.AccAddress()
+	c.Assert(err, IsNil)
+	provider := GetRandomBech32Addr()
+	bp := NewBondProviders(na.NodeAddress)
+	bp.NodeOperatorFee = cosmos.NewUint(1000)
+	bp.Providers = []BondProvider{NewBondProvider(operator), NewBondProvider(provider)}
+	bp.Providers[0].Bond = cosmos.NewUint(50 * common.One)
+	bp.Providers[1].Bond = cosmos.NewUint(50 * common.One)
+	bp.SetPendingNodeOperatorFee(cosmos.NewUint(5000), 20)
+	c.Assert(mgr.Keeper().SetBondProviders(ctx, bp), IsNil)
+
+	// notice period has not passed
+	vMgr.processNodeOperatorFeeChanges(ctx)
+	bp, err = mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(1000))
+	c.Check(bp.HasPendingNodeOperatorFee(), Equals, true)
+
+	// rewards accrued so far are distributed at the previous fee
+	ctx = ctx.WithBlockHeight(20)
+	vMgr.processNodeOperatorFeeChanges(ctx)
+	bp, err = mgr.Keeper().GetBondProviders(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee.Uint64(), Equals, uint64(5000))
+	c.Check(bp.HasPendingNodeOperatorFee(), Equals, false)
+	c.Check(bp.Get(operator).Bond.Uint64(), Equals, uint64(105*common.One))
+	c.Check(bp.Get(provider).Bond.Uint64(), Equals, uint64(95*common.One))
+
+	nodes, err := mgr.Keeper().GetNodeOperatorFeeNodes(ctx)
+	c.Assert(err, IsNil)
+	c.Check(nodes, HasLen, 0)
+}
@@ -18,6 +18,7 @@ import (
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
 	"gitlab.com/thorchain/thornode/v3/common/wasmpermissions"
 	"gitlab.com/thorchain/thornode/v3/constants"
+	denomkeeper "gitlab.com/thorchain/thornode/v3/x/denom/keeper"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
 	kv1 "gitlab.com/thorchain/thornode/v3/x/thorchain/keeper/v1"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/types"
@@ -49,12 +50,14 @@ type Manager interface {
 	SecuredAssetManager() SecuredAssetManager
 	WasmManager() WasmManager
 	SwitchManager() SwitchManager
+	LiquidBondManager() LiquidBondManager
 }
 
 type TradeAccountManager interface {
 	EndBlock(ctx cosmos.Context, keeper keeper.Keeper) error
 	Deposit(_ cosmos.Context, _ common.Asset, amount cosmos.Uint, owner cosmos.AccAddress, assetAddr common.Address, _ common.TxID) (cosmos.Uint, error)
 	Withdrawal(_ cosmos.Context, _ common.Asset, amount cosmos.Uint, owner cosmos.AccAddress, assetAddr common.Address, _ common.TxID) (cosmos.Uint, error)
+	Transfer(_ cosmos.Context, _ common.Asset, amount cosmos.Uint, from, to cosmos.AccAddress, _ common.TxID) (cosmos.Uint, error)
 	BalanceOf(_ cosmos.Context, _ common.Asset, owner cosmos.AccAddress) cosmos.Uint
 }
 
@@ -68,6 +71,13 @@ type SecuredAssetManager interface {
 	CheckHalt(_ cosmos.Context) error
 }
 
+// LiquidBondManager mints and burns the x/denom receipts of liquid bond
+type LiquidBondManager interface {
+	Mint(ctx cosmos.Context, nodeAddr cosmos.AccAddress, units cosmos.Uint, to cosmos.AccAddress) error
+	Burn(ctx cosmos.Context, nodeAddr cosmos.AccAddress, units cosmos.Uint, from cosmos.AccAddress) error
+	BalanceOf(ctx cosmos.Context, nodeAddr, owner cosmos.AccAddress) cosmos.Uint
+}
+
 // GasManager define all the methods required to manage gas
 type GasManager interface {
 	BeginBlock()
@@ -216,6 +226,7 @@ type Swapper interface {
 		keeper keeper.Keeper,
 		tx common.Tx,
 		target common.Asset,
+		route []common.Asset,
 		destination common.Address,
 		swapTarget cosmos.Uint,
 		dexAgg string,
@@ -254,6 +265,7 @@ type Mgrs struct {
 	securedManager SecuredAssetManager
 	wasmManager    WasmManager
 	switchManager  SwitchManager
+	liquidBondMgr  LiquidBondManager
 
 	K             keeper.Keeper
 	cdc           codec.Codec
@@ -261,6 +273,7 @@ type Mgrs struct {
 	accountKeeper authkeeper.AccountKeeper
 	upgradeKeeper *upgradekeeper.Keeper
 	wasmKeeper    wasmkeeper.Keeper
+	denomKeeper   denomkeeper.Keeper
 	storeService  store.KVStoreService
 }
 
@@ -273,6 +286,7 @@ func NewManagers(
 	accountKeeper authkeeper.AccountKeeper,
 	upgradeKeeper *upgradekeeper.Keeper,
 	wasmKeeper wasmkeeper.Keeper,
+	denomKeeper denomkeeper.Keeper,
 ) *Mgrs {
 	return &Mgrs{
 		K:             keeper,
@@ -281,6 +295,7 @@ func NewManagers(
 		accountKeeper: accountKeeper,
 		upgradeKeeper: upgradeKeeper,
 		wasmKeeper:    wasmKeeper,
+		denomKeeper:   denomKeeper,
 		storeService:  storeService,
 	}
 }
@@ -402,6 +417,11 @@ func (mgr *Mgrs) recreateManagers(ctx cosmos.Context, v semver.Version) error {
 		return fmt.Errorf("fail to create switch manager: %w", err)
 	}
 
+	mgr.liquidBondMgr, err = GetLiquidBondManager(v, mgr.K, mgr.denomKeeper)
+	if err != nil {
+		return fmt.Errorf("fail to create liquid bond manager: %w", err)
+	}
+
 	return nil
 }
 
@@ -446,6 +466,8 @@ func (mgr *Mgrs) WasmManager() WasmManager { return mgr.wasmManager }
 
 func (mgr *Mgrs) SwitchManager() SwitchManager { return mgr.switchManager }
 
+func (mgr *Mgrs) LiquidBondManager() LiquidBondManager { return mgr.liquidBondMgr }
+
 // GetKeeper return Keeper
 func GetKeeper(
 	version semver.Version,
@@ -581,3 +603,12 @@ func GetWasmManager(ctx cosmos.Context, keeper keeper.Keeper, wasmKeeper wasmkee
 func GetSwitchManager(version semver.Version, keeper keeper.Keeper, eventMgr EventManager) (SwitchManager, error) {
 	return newSwitchMgrVCUR(keeper, eventMgr), nil
 }
+
+func GetLiquidBondManager(version semver.Version, keeper keeper.Keeper, denomKeeper denomkeeper.Keeper) (LiquidBondManager, error) {
+	switch {
+	case version.GTE(semver.MustParse("3.0.0")):
+		return newLiquidBondMgrVCUR(keeper, denomKeeper), nil
+	default:
+		return nil, errInvalidVersion
+	}
+}
@@ -23,6 +23,7 @@ type DummyMgr struct {
 	securedMgr    SecuredAssetManager
 	wasmMgr       WasmManager
 	switchMgr     SwitchManager
+	liquidBondMgr LiquidBondManager
 }
 
 func NewDummyMgrWithKeeper(k keeper.Keeper) *DummyMgr {
@@ -80,3 +81,4 @@ func (m DummyMgr) TradeAccountManager() TradeAccountManager { return m.tradeMgr
 func (m DummyMgr) SecuredAssetManager() SecuredAssetManager { return m.securedMgr }
 func (m DummyMgr) WasmManager() WasmManager                 { return m.wasmMgr }
 func (m DummyMgr) SwitchManager() SwitchManager             { return m.switchMgr }
+func (m DummyMgr) LiquidBondManager() LiquidBondManager     { return m.liquidBondMgr }
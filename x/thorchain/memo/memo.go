@@ -23,7 +23,10 @@ const (
 	TxWithdraw
 	TxSwap
 	TxLimitSwap
+	TxStopLossSwap
+	TxTakeProfitSwap
 	TxModifyLimitSwap
+	TxModifyStreamingSwap
 	TxOutbound
 	TxDonate
 	TxBond
@@ -40,6 +43,9 @@ const (
 	TxLoanRepayment
 	TxTradeAccountDeposit
 	TxTradeAccountWithdrawal
+	TxTradeAccountTransfer
+	TxRecurringSwap
+	TxRecurringSwapCancel
 	TxSecuredAssetDeposit
 	TxSecuredAssetWithdraw
 	TxRunePoolDeposit
@@ -51,6 +57,8 @@ const (
 	TxTCYUnstake
 	TxMaint
 	TxRebond
+	TxLiquidBond
+	TxLiquidUnbond
 	TxOperatorRotate
 )
 
@@ -64,13 +72,18 @@ var stringToTxTypeMap = map[string]TxType{
 	"s":           TxSwap,
 	"=":           TxSwap,
 	"=<":          TxLimitSwap,
+	"=sl":         TxStopLossSwap,
+	"=tp":         TxTakeProfitSwap,
 	"m=<":         TxModifyLimitSwap,
+	"m=~":         TxModifyStreamingSwap,
 	"out":         TxOutbound,
 	"donate":      TxDonate,
 	"d":           TxDonate,
 	"bond":        TxBond,
 	"unbond":      TxUnbond,
 	"rebond":      TxRebond,
+	"lbond":       TxLiquidBond,
+	"lunbond":     TxLiquidUnbond,
 	"leave":       TxLeave,
 	"reserve":     TxReserve,
 	"refund":      TxRefund,
@@ -87,6 +100,9 @@ var stringToTxTypeMap = map[string]TxType{
 	"loan-":       TxLoanRepayment,
 	"trade+":      TxTradeAccountDeposit,
 	"trade-":      TxTradeAccountWithdrawal,
+	"trade>":      TxTradeAccountTransfer,
+	"dca+":        TxRecurringSwap,
+	"dca-":        TxRecurringSwapCancel,
 	"secure+":     TxSecuredAssetDeposit,
 	"secure-":     TxSecuredAssetWithdraw,
 	"pool+":       TxRunePoolDeposit,
@@ -106,13 +122,18 @@ var txToStringMap = map[TxType]string{
 	TxWithdraw:               "withdraw",
 	TxSwap:                   "swap",
 	TxLimitSwap:              "=<",
+	TxStopLossSwap:           "=sl",
+	TxTakeProfitSwap:         "=tp",
 	TxModifyLimitSwap:        "m=<",
+	TxModifyStreamingSwap:    "m=~",
 	TxOutbound:               "out",
 	TxRefund:                 "refund",
 	TxDonate:                 "donate",
 	TxBond:                   "bond",
 	TxUnbond:                 "unbond",
 	TxRebond:                 "rebond",
+	TxLiquidBond:             "lbond",
+	TxLiquidUnbond:           "lunbond",
 	TxLeave:                  "leave",
 	TxReserve:                "reserve",
 	TxMigrate:                "migrate",
@@ -124,6 +145,9 @@ var txToStringMap = map[TxType]string{
 	TxLoanRepayment:          "$-",
 	TxTradeAccountDeposit:    "trade+",
 	TxTradeAccountWithdrawal: "trade-",
+	TxTradeAccountTransfer:   "trade>",
+	TxRecurringSwap:          "dca+",
+	TxRecurringSwapCancel:    "dca-",
 	TxSecuredAssetDeposit:    "secure+",
 	TxSecuredAssetWithdraw:   "secure-",
 	TxExec:                   "x",
@@ -151,17 +175,25 @@ func (tx TxType) IsInbound() bool {
 		TxWithdraw,
 		TxTradeAccountDeposit,
 		TxTradeAccountWithdrawal,
+		TxTradeAccountTransfer,
+		TxRecurringSwap,
+		TxRecurringSwapCancel,
 		TxRunePoolDeposit,
 		TxRunePoolWithdraw,
 		TxSecuredAssetDeposit,
 		TxSecuredAssetWithdraw,
 		TxSwap,
 		TxLimitSwap,
+		TxStopLossSwap,
+		TxTakeProfitSwap,
 		TxModifyLimitSwap,
+		TxModifyStreamingSwap,
 		TxDonate,
 		TxBond,
 		TxUnbond,
 		TxRebond,
+		TxLiquidBond,
+		TxLiquidUnbond,
 		TxLeave,
 		TxMaint,
 		TxReserve,
@@ -204,7 +236,12 @@ func (tx TxType) HasOutbound() bool {
 	switch tx {
 	case TxAdd,
 		TxBond,
+		TxLiquidBond,
+		TxLiquidUnbond,
 		TxTradeAccountDeposit,
+		TxTradeAccountTransfer,
+		TxRecurringSwap,
+		TxRecurringSwapCancel,
 		TxSecuredAssetDeposit,
 		TxRunePoolDeposit,
 		TxDonate,
@@ -0,0 +1,55 @@
+package thorchain
+
+import (
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+type LiquidBondMemo struct {
+	MemoBase
+	NodeAddress cosmos.AccAddress
+	Amount      cosmos.Uint
+}
+
+func (m LiquidBondMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
+func (m LiquidBondMemo) GetAmount() cosmos.Uint           { return m.Amount }
+
+func NewLiquidBondMemo(addr cosmos.AccAddress, amt cosmos.Uint) LiquidBondMemo {
+	return LiquidBondMemo{
+		MemoBase:    MemoBase{TxType: TxLiquidBond},
+		NodeAddress: addr,
+		Amount:      amt,
+	}
+}
+
+// ParseLiquidBondMemo parses "LBOND:NODEADDR:AMOUNT", an omitted or zero amount
+// converts the whole bond of the sender
+func (p *parser) ParseLiquidBondMemo() (LiquidBondMemo, error) {
+	addr := p.getAccAddress(1, true, nil)
+	amt := p.getUint(2, false, 0)
+	return NewLiquidBondMemo(addr, amt), p.Error()
+}
+
+type LiquidUnbondMemo struct {
+	MemoBase
+	NodeAddress cosmos.AccAddress
+	Units       cosmos.Uint
+}
+
+func (m LiquidUnbondMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
+func (m LiquidUnbondMemo) GetUnits() cosmos.Uint            { return m.Units }
+
+func NewLiquidUnbondMemo(addr cosmos.AccAddress, units cosmos.Uint) LiquidUnbondMemo {
+	return LiquidUnbondMemo{
+		MemoBase:    MemoBase{TxType: TxLiquidUnbond},
+		NodeAddress: addr,
+		Units:       units,
+	}
+}
+
+// ParseLiquidUnbondMemo parses "LUNBOND:NODEADDR:UNITS", omitted or zero units
+// redeem all receipts held by the sender
+func (p *parser) ParseLiquidUnbondMemo() (LiquidUnbondMemo, error) {
+	addr := p.getAccAddress(1, true, nil)
+	units := p.getUint(2, false, 0)
+	return NewLiquidUnbondMemo(addr, units), p.Error()
+}
@@ -0,0 +1,35 @@
+package thorchain
+
+import (
+	"gitlab.com/thorchain/thornode/v3/common"
+)
+
+type ModifyStreamingSwapMemo struct {
+	MemoBase
+	TxID     common.TxID
+	Interval uint64
+	Quantity uint64
+}
+
+func (m ModifyStreamingSwapMemo) GetTxID() common.TxID { return m.TxID }
+func (m ModifyStreamingSwapMemo) GetInterval() uint64  { return m.Interval }
+func (m ModifyStreamingSwapMemo) GetQuantity() uint64  { return m.Quantity }
+func (m ModifyStreamingSwapMemo) IsCancellation() bool { return m.Interval == 0 && m.Quantity == 0 }
+
+func NewModifyStreamingSwapMemo(txID common.TxID, interval, quantity uint64) ModifyStreamingSwapMemo {
+	return ModifyStreamingSwapMemo{
+		MemoBase: MemoBase{TxType: TxModifyStreamingSwap},
+		TxID:     txID,
+		Interval: interval,
+		Quantity: quantity,
+	}
+}
+
+// ParseModifyStreamingSwap parses "m=~:TXID:INTERVAL:QUANTITY". Omitting (or
+// zeroing) both interval and quantity cancels the streaming swap.
+func (p *parser) ParseModifyStreamingSwap() (ModifyStreamingSwapMemo, error) {
+	txID := p.getTxID(1, true, common.BlankTxID)
+	interval := p.getUint(2, false, 0)
+	quantity := p.getUint(3, false, 0)
+	return NewModifyStreamingSwapMemo(txID, interval.Uint64(), quantity.Uint64()), p.Error()
+}
@@ -76,10 +76,12 @@ func (p *parser) parse() (mem Memo, err error) {
 		return p.ParseRunePoolDepositMemo()
 	case TxRunePoolWithdraw:
 		return p.ParseRunePoolWithdrawMemo()
-	case TxSwap, TxLimitSwap:
+	case TxSwap, TxLimitSwap, TxStopLossSwap, TxTakeProfitSwap:
 		return p.ParseSwapMemo()
 	case TxModifyLimitSwap:
 		return p.ParseModifyLimitSwap()
+	case TxModifyStreamingSwap:
+		return p.ParseModifyStreamingSwap()
 	case TxOutbound:
 		return p.ParseOutboundMemo()
 	case TxRefund:
@@ -90,6 +92,10 @@ func (p *parser) parse() (mem Memo, err error) {
 		return p.ParseUnbondMemo()
 	case TxRebond:
 		return p.ParseRebondMemo()
+	case TxLiquidBond:
+		return p.ParseLiquidBondMemo()
+	case TxLiquidUnbond:
+		return p.ParseLiquidUnbondMemo()
 	case TxReserve:
 		return p.ParseReserveMemo()
 	case TxMigrate:
@@ -110,6 +116,12 @@ func (p *parser) parse() (mem Memo, err error) {
 		return p.ParseTradeAccountDeposit()
 	case TxTradeAccountWithdrawal:
 		return p.ParseTradeAccountWithdrawal()
+	case TxTradeAccountTransfer:
+		return p.ParseTradeAccountTransfer()
+	case TxRecurringSwap:
+		return p.ParseRecurringSwap()
+	case TxRecurringSwapCancel:
+		return p.ParseRecurringSwapCancel()
 	case TxSecuredAssetDeposit:
 		return p.ParseSecuredAssetDeposit()
 	case TxSecuredAssetWithdraw:
@@ -350,6 +362,36 @@ func (p *parser) getAddressAndRefundAddressWithKeeper(idx int, required bool, de
 	return destination, refundAddress
 }
 
+// getSplitDestinationsWithKeeper parses a weighted list of destinations in the
+// form ADDR@BPS,ADDR@BPS, optionally followed by a /REFUNDADDR
+func (p *parser) getSplitDestinationsWithKeeper(idx int, chain common.Chain) ([]common.Address, []cosmos.Uint, common.Address) {
+	refundAddress := common.NoAddress
+	parts := strings.SplitN(p.get(idx), "/", 2)
+	if len(parts) > 1 {
+		refundAddress, _ = common.NewAddress(parts[1])
+	}
+
+	entries := strings.Split(parts[0], ",")
+	destinations := make([]common.Address, 0, len(entries))
+	bps := make([]cosmos.Uint, 0, len(entries))
+	for _, entry := range entries {
+		addrBps := strings.SplitN(entry, "@", 2)
+		if len(addrBps) != 2 {
+			p.addErr(fmt.Errorf("cannot parse '%s' as a split destination", entry))
+			return nil, nil, refundAddress
+		}
+		addr := p.getAddressFromString(addrBps[0], chain, true)
+		u, err := cosmos.ParseUint(addrBps[1])
+		if err != nil {
+			p.addErr(fmt.Errorf("cannot parse '%s' as an uint: %w", addrBps[1], err))
+			return nil, nil, refundAddress
+		}
+		destinations = append(destinations, addr)
+		bps = append(bps, u)
+	}
+	return destinations, bps, refundAddress
+}
+
 func (p *parser) getAddressFromString(val string, chain common.Chain, required bool) common.Address {
 	addr, err := FetchAddress(p.ctx, p.keeper, val, chain)
 	if err != nil {
@@ -382,6 +424,31 @@ func (p *parser) getAsset(idx int, required bool, def common.Asset) common.Asset
 	return value
 }
 
+// getAssetWithRoute parses an asset optionally preceded by the intermediate
+// assets of a swap route, in the form HOP>HOP>ASSET
+func (p *parser) getAssetWithRoute(idx int, required bool) ([]common.Asset, common.Asset) {
+	p.incRequired(required)
+	parts := strings.Split(p.get(idx), ">")
+	route := make([]common.Asset, 0, len(parts)-1)
+	for _, part := range parts[:len(parts)-1] {
+		hop, err := common.NewAssetWithShortCodes(p.version, part)
+		if err != nil {
+			p.addErr(fmt.Errorf("cannot parse '%s' as a route asset: %w", part, err))
+			return nil, common.EmptyAsset
+		}
+		route = append(route, hop)
+	}
+	value, err := common.NewAssetWithShortCodes(p.version, parts[len(parts)-1])
+	if err != nil && (required || p.get(idx) != "") {
+		p.addErr(fmt.Errorf("cannot parse '%s' as an asset: %w", parts[len(parts)-1], err))
+		return nil, common.EmptyAsset
+	}
+	if len(route) == 0 {
+		route = nil
+	}
+	return route, value
+}
+
 func (p *parser) getCoin(idx int, required bool, def common.Coin) common.Coin {
 	p.incRequired(required)
 	coinStr := p.get(idx)
@@ -0,0 +1,60 @@
+package thorchain
+
+import (
+	"gitlab.com/thorchain/thornode/v3/common"
+)
+
+type RecurringSwapMemo struct {
+	MemoBase
+	Destination common.Address
+	Interval    uint64
+	Quantity    uint64
+}
+
+func (m RecurringSwapMemo) GetDestination() common.Address { return m.Destination }
+func (m RecurringSwapMemo) GetInterval() uint64            { return m.Interval }
+func (m RecurringSwapMemo) GetQuantity() uint64            { return m.Quantity }
+
+func NewRecurringSwapMemo(asset common.Asset, destination common.Address, interval, quantity uint64) RecurringSwapMemo {
+	return RecurringSwapMemo{
+		MemoBase:    MemoBase{TxType: TxRecurringSwap, Asset: asset},
+		Destination: destination,
+		Interval:    interval,
+		Quantity:    quantity,
+	}
+}
+
+// ParseRecurringSwap parses "dca+:ASSET:DESTADDR:INTERVAL:QUANTITY". The
+// destination may be omitted when swapping into a trade asset or RUNE, in which
+// case the output is credited to the sender.
+func (p *parser) ParseRecurringSwap() (RecurringSwapMemo, error) {
+	asset := p.getAsset(1, true, common.EmptyAsset)
+	chain := common.THORChain
+	if !asset.IsEmpty() && !asset.IsTradeAsset() && !asset.IsRune() {
+		chain = asset.GetChain()
+	}
+	destination := p.getAddressWithKeeper(2, false, common.NoAddress, chain)
+	interval := p.getUint(3, true, 0)
+	quantity := p.getUint(4, true, 0)
+	return NewRecurringSwapMemo(asset, destination, interval.Uint64(), quantity.Uint64()), p.Error()
+}
+
+type RecurringSwapCancelMemo struct {
+	MemoBase
+	TxID common.TxID
+}
+
+func (m RecurringSwapCancelMemo) GetTxID() common.TxID { return m.TxID }
+
+func NewRecurringSwapCancelMemo(txID common.TxID) RecurringSwapCancelMemo {
+	return RecurringSwapCancelMemo{
+		MemoBase: MemoBase{TxType: TxRecurringSwapCancel},
+		TxID:     txID,
+	}
+}
+
+// ParseRecurringSwapCancel parses "dca-:TXID".
+func (p *parser) ParseRecurringSwapCancel() (RecurringSwapCancelMemo, error) {
+	txID := p.getTxID(1, true, common.BlankTxID)
+	return NewRecurringSwapCancelMemo(txID), p.Error()
+}
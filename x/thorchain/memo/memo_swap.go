@@ -25,10 +25,17 @@ type SwapMemo struct {
 	SwapType              types.SwapType
 	StreamInterval        uint64
 	StreamQuantity        uint64
+	Expiry                int64
 	AffiliateTHORName     *types.THORName // TODO: remove on hardfork
 	RefundAddress         common.Address
 	Affiliates            []string
 	AffiliatesBasisPoints []cosmos.Uint
+	// Destinations and DestinationsBasisPoints are set for split-destination
+	// swaps, Destination is then the first recipient
+	Destinations            []common.Address
+	DestinationsBasisPoints []cosmos.Uint
+	// Route lists the intermediate assets of an explicitly routed swap
+	Route []common.Asset
 }
 
 func (m SwapMemo) GetDestination() common.Address          { return m.Destination }
@@ -41,6 +48,7 @@ func (m SwapMemo) GetDexTargetLimit() *cosmos.Uint         { return m.DexTargetL
 func (m SwapMemo) GetSwapType() types.SwapType             { return m.SwapType }
 func (m SwapMemo) GetStreamQuantity() uint64               { return m.StreamQuantity }
 func (m SwapMemo) GetStreamInterval() uint64               { return m.StreamInterval }
+func (m SwapMemo) GetExpiry() int64                        { return m.Expiry }
 func (m SwapMemo) GetAffiliateTHORName() *types.THORName   { return m.AffiliateTHORName }
 func (m SwapMemo) GetRefundAddress() common.Address        { return m.RefundAddress }
 func (m SwapMemo) GetAffiliates() []string                 { return m.Affiliates }
@@ -75,11 +83,28 @@ func (m SwapMemo) string(short bool) string {
 	} else {
 		assetString = m.Asset.String()
 	}
+	if len(m.Route) > 0 {
+		hops := make([]string, len(m.Route))
+		for i, hop := range m.Route {
+			hops[i] = hop.String()
+			if short && len(hop.ShortCode()) > 0 {
+				hops[i] = hop.ShortCode()
+			}
+		}
+		assetString = strings.Join(hops, ">") + ">" + assetString
+	}
 
 	// destination + custom refund addr
 	destString := m.Destination.String()
+	if len(m.Destinations) > 0 {
+		dests := make([]string, len(m.Destinations))
+		for i, dest := range m.Destinations {
+			dests[i] = fmt.Sprintf("%s@%s", dest, m.DestinationsBasisPoints[i])
+		}
+		destString = strings.Join(dests, ",")
+	}
 	if !m.RefundAddress.IsEmpty() {
-		destString = m.Destination.String() + "/" + m.RefundAddress.String()
+		destString = destString + "/" + m.RefundAddress.String()
 	}
 
 	tns := make([]string, len(m.Affiliates))
@@ -161,26 +186,56 @@ func (p *parser) ParseSwapMemo() (SwapMemo, error) {
 }
 
 func (p *parser) ParseSwapMemoV3_0_0() (SwapMemo, error) {
+	// ASSET can be preceded by the intermediate assets of a swap route (HOP>HOP>ASSET)
+	route, asset := p.getAssetWithRoute(1, true)
+
 	// TODO confirm and remove this.
 	if p.keeper == nil {
-		return ParseSwapMemoV1(p.ctx, p.keeper, p.getAsset(1, true, common.EmptyAsset), p.parts)
+		return ParseSwapMemoV1(p.ctx, p.keeper, asset, p.parts)
 	}
 
 	var err error
-	asset := p.getAsset(1, true, common.EmptyAsset)
 	var swapType types.SwapType
-	if strings.EqualFold(p.parts[0], TxLimitSwap.String()) {
+	switch {
+	case strings.EqualFold(p.parts[0], TxLimitSwap.String()):
 		swapType = types.SwapType_limit
+	case strings.EqualFold(p.parts[0], TxStopLossSwap.String()):
+		swapType = types.SwapType_stop_loss
+	case strings.EqualFold(p.parts[0], TxTakeProfitSwap.String()):
+		swapType = types.SwapType_take_profit
+	}
+	isTrigger := swapType == types.SwapType_stop_loss || swapType == types.SwapType_take_profit
+
+	// DESTADDR can be empty , if it is empty , it will swap to the sender address.
+	// It can also be a weighted list of recipients (ADDR@BPS,ADDR@BPS)
+	var destination, refundAddress common.Address
+	var destinations []common.Address
+	var destinationsBps []cosmos.Uint
+	if strings.Contains(p.get(2), "@") {
+		destinations, destinationsBps, refundAddress = p.getSplitDestinationsWithKeeper(2, asset.Chain)
+		if err = p.Error(); err != nil {
+			return SwapMemo{}, err
+		}
+		if err = validateSplitDestinations(p.ctx, p.keeper, destinations, destinationsBps); err != nil {
+			return SwapMemo{}, err
+		}
+		destination = destinations[0]
+	} else {
+		destination, refundAddress = p.getAddressAndRefundAddressWithKeeper(2, false, common.NoAddress, asset.Chain)
 	}
 
-	// DESTADDR can be empty , if it is empty , it will swap to the sender address
-	destination, refundAddress := p.getAddressAndRefundAddressWithKeeper(2, false, common.NoAddress, asset.Chain)
-
-	// price limit can be empty , when it is empty , there is no price protection
+	// price limit can be empty , when it is empty , there is no price protection.
+	// For stop-loss and take-profit orders it is the trigger, and may be
+	// followed by an expiry block height (TRIGGER/INTERVAL/QUANTITY/EXPIRY)
 	var slip cosmos.Uint
 	var streamInterval, streamQuantity uint64
+	var expiry int64
 	if strings.Contains(p.get(3), "/") {
-		parts := strings.SplitN(p.get(3), "/", 3)
+		n := 3
+		if isTrigger {
+			n = 4
+		}
+		parts := strings.SplitN(p.get(3), "/", n)
 		for i := range parts {
 			if parts[i] == "" {
 				parts[i] = "0"
@@ -205,6 +260,12 @@ func (p *parser) ParseSwapMemoV3_0_0() (SwapMemo, error) {
 				return SwapMemo{}, fmt.Errorf("failed to parse stream quantity: %s: %s", parts[2], err)
 			}
 		}
+		if len(parts) > 3 {
+			expiry, err = strconv.ParseInt(parts[3], 10, 64)
+			if err != nil || expiry < 0 {
+				return SwapMemo{}, fmt.Errorf("failed to parse expiry: %s", parts[3])
+			}
+		}
 	} else {
 		slip = p.getUintWithScientificNotation(3, false, 0)
 	}
@@ -258,7 +319,46 @@ func (p *parser) ParseSwapMemoV3_0_0() (SwapMemo, error) {
 	dexTargetAddress := p.get(7)
 	dexTargetLimit := p.getUintWithScientificNotation(8, false, 0)
 
-	return NewSwapMemo(asset, destination, slip, affAddr, totalAffBps, dexAgg, dexTargetAddress, dexTargetLimit, swapType, streamQuantity, streamInterval, tn, refundAddress, affiliates, affFeeBps), p.Error()
+	if isTrigger && slip.IsZero() {
+		return SwapMemo{}, fmt.Errorf("trigger is required for stop-loss and take-profit orders")
+	}
+
+	swapMemo := NewSwapMemo(asset, destination, slip, affAddr, totalAffBps, dexAgg, dexTargetAddress, dexTargetLimit, swapType, streamQuantity, streamInterval, tn, refundAddress, affiliates, affFeeBps)
+	swapMemo.Expiry = expiry
+	swapMemo.Destinations = destinations
+	swapMemo.DestinationsBasisPoints = destinationsBps
+	swapMemo.Route = route
+	return swapMemo, p.Error()
+}
+
+func validateSplitDestinations(ctx cosmos.Context, k keeper.Keeper, destinations []common.Address, bps []cosmos.Uint) error {
+	if len(destinations) < 2 {
+		return fmt.Errorf("split destinations must have at least two recipients")
+	}
+	maxDestinations := k.GetConfigInt64(ctx, constants.MultipleDestinationsMaxCount)
+	if len(destinations) > int(maxDestinations) {
+		return fmt.Errorf("maximum allowed destinations is %d", maxDestinations)
+	}
+	// outbounds are matched by inbound hash and recipient, so each recipient
+	// can only be paid once
+	for i := range destinations {
+		for j := 0; j < i; j++ {
+			if destinations[i].Equals(destinations[j]) {
+				return fmt.Errorf("split destination %s is duplicated", destinations[i])
+			}
+		}
+	}
+	total := cosmos.ZeroUint()
+	for _, u := range bps {
+		if u.IsZero() {
+			return fmt.Errorf("split destination basis points cannot be zero")
+		}
+		total = total.Add(u)
+	}
+	if !total.Equal(cosmos.NewUint(constants.MaxBasisPts)) {
+		return fmt.Errorf("split destination basis points must add up to %d", constants.MaxBasisPts)
+	}
+	return nil
 }
 
 func ParseSwapMemoV1(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset, parts []string) (SwapMemo, error) {
@@ -208,6 +208,65 @@ func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
 	c.Assert(err, NotNil)
 	c.Assert(err.Error(), Equals, "total affiliate fee basis points can't be more than 10000")
 
+	// split destinations
+	ms = "=:e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a@6000,0x70f2b1ae50e6018230e90a33f98c7844a0ab635a@4000"
+	memo, err = ParseMemoWithTHORNames(ctx, k, ms)
+	c.Assert(err, IsNil)
+	splitMemo, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(splitMemo.Destination.String(), Equals, "0x90f2b1ae50e6018230e90a33f98c7844a0ab635a")
+	c.Assert(splitMemo.Destinations, HasLen, 2)
+	c.Check(splitMemo.Destinations[1].String(), Equals, "0x70f2b1ae50e6018230e90a33f98c7844a0ab635a")
+	c.Check(splitMemo.DestinationsBasisPoints[0].Uint64(), Equals, uint64(6000))
+	c.Check(splitMemo.DestinationsBasisPoints[1].Uint64(), Equals, uint64(4000))
+	c.Check(splitMemo.String(), Equals, "=:ETH.ETH:"+ms[4:])
+
+	// split destinations + refund address
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("%s/%s:0/10/0", ms, refundAddr))
+	c.Assert(err, IsNil)
+	c.Check(memo.GetRefundAddress().String(), Equals, refundAddr.String())
+	c.Check(memo.(SwapMemo).StreamInterval, Equals, uint64(10))
+
+	// split destinations must add up to 10000
+	_, err = ParseMemoWithTHORNames(ctx, k, "=:e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a@6000,0x70f2b1ae50e6018230e90a33f98c7844a0ab635a@3000")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "split destination basis points must add up to 10000")
+
+	// split destinations need at least two recipients
+	_, err = ParseMemoWithTHORNames(ctx, k, "=:e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a@10000")
+	c.Assert(err, NotNil)
+
+	// split destinations must be distinct
+	_, err = ParseMemoWithTHORNames(ctx, k, "=:e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a@5000,0x90F2B1AE50E6018230E90A33F98C7844A0AB635A@5000")
+	c.Assert(err, NotNil)
+	c.Assert(err.Error(), Equals, "split destination 0x90F2B1AE50E6018230E90A33F98C7844A0AB635A is duplicated")
+
+	// split destination without basis points
+	_, err = ParseMemoWithTHORNames(ctx, k, "=:e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a@5000,0x70f2b1ae50e6018230e90a33f98c7844a0ab635a")
+	c.Assert(err, NotNil)
+
+	// explicit route
+	ms = "=:BTC~BTC>e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a"
+	memo, err = ParseMemoWithTHORNames(ctx, k, ms)
+	c.Assert(err, IsNil)
+	routeMemo, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(routeMemo.GetAsset().String(), Equals, "ETH.ETH")
+	c.Assert(routeMemo.Route, HasLen, 1)
+	c.Check(routeMemo.Route[0].String(), Equals, "BTC~BTC")
+	c.Check(routeMemo.String(), Equals, "=:BTC~BTC>ETH.ETH:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a")
+	c.Check(routeMemo.ShortString(), Equals, ms)
+
+	// explicit route with multiple hops
+	memo, err = ParseMemoWithTHORNames(ctx, k, "=:BTC~BTC>THOR.BTC>e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a")
+	c.Assert(err, IsNil)
+	c.Assert(memo.(SwapMemo).Route, HasLen, 2)
+	c.Check(memo.(SwapMemo).Route[1].String(), Equals, "THOR.BTC")
+
+	// explicit route with an empty hop
+	_, err = ParseMemoWithTHORNames(ctx, k, "=:BTC~BTC>>e:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a")
+	c.Assert(err, NotNil)
+
 	// test streaming swap
 	memo, err = ParseMemoWithTHORNames(ctx, k, "=:"+common.RuneAsset().String()+":0x90f2b1ae50e6018230e90a33f98c7844a0ab635a:1200/10/20")
 	c.Assert(err, IsNil)
@@ -268,6 +327,31 @@ func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(memo.GetDexTargetLimit().Equal(cosmos.NewUintFromString("1425000000000000000000")), Equals, true) // noting the large number overflows `cosmos.NewUint`
 
+	// stop-loss and take-profit orders
+	memo, err = ParseMemoWithTHORNames(ctx, k, "=sl:ETH.ETH:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a:1200/1/5/500")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxSwap), Equals, true)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetSwapType(), Equals, types.SwapType_stop_loss)
+	c.Check(swapMemo.GetSlipLimit().Uint64(), Equals, uint64(1200))
+	c.Check(swapMemo.GetStreamInterval(), Equals, uint64(1))
+	c.Check(swapMemo.GetStreamQuantity(), Equals, uint64(5))
+	c.Check(swapMemo.GetExpiry(), Equals, int64(500))
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, "=tp:ETH.ETH:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a:1200")
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.GetSwapType(), Equals, types.SwapType_take_profit)
+	c.Check(swapMemo.GetSlipLimit().Uint64(), Equals, uint64(1200))
+	c.Check(swapMemo.GetExpiry(), Equals, int64(0))
+
+	_, err = ParseMemoWithTHORNames(ctx, k, "=sl:ETH.ETH:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a") // missing trigger
+	c.Assert(err, NotNil)
+	_, err = ParseMemoWithTHORNames(ctx, k, "=tp:ETH.ETH:0x90f2b1ae50e6018230e90a33f98c7844a0ab635a:1200/1/5/-1") // bad expiry
+	c.Assert(err, NotNil)
+
 	memo, err = ParseMemoWithTHORNames(ctx, k, "OUT:MUKVQILIHIAUSEOVAXBFEZAJKYHFJYHRUUYGQJZGFYBYVXCXYNEMUOAIQKFQLLCX")
 	c.Assert(err, IsNil)
 	c.Check(memo.IsType(TxOutbound), Equals, true, Commentf("%s", memo.GetType()))
@@ -283,6 +367,26 @@ func (s *MemoSuite) TestParseWithAbbreviated(c *C) {
 	c.Check(modMemo.Target.Equals(common.NewCoin(common.ETHAsset, cosmos.NewUint(1003))), Equals, true)
 	c.Check(modMemo.ModifiedTargetAmount.Uint64(), Equals, uint64(900))
 
+	txID := types.GetRandomTxHash()
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("m=~:%s:5:20", txID))
+	c.Assert(err, IsNil)
+	modStreamMemo, ok := memo.(ModifyStreamingSwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(modStreamMemo.IsInbound(), Equals, true)
+	c.Check(modStreamMemo.GetTxID().Equals(txID), Equals, true)
+	c.Check(modStreamMemo.GetInterval(), Equals, uint64(5))
+	c.Check(modStreamMemo.GetQuantity(), Equals, uint64(20))
+	c.Check(modStreamMemo.IsCancellation(), Equals, false)
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("m=~:%s", txID))
+	c.Assert(err, IsNil)
+	modStreamMemo, ok = memo.(ModifyStreamingSwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(modStreamMemo.IsCancellation(), Equals, true)
+
+	_, err = ParseMemoWithTHORNames(ctx, k, "m=~")
+	c.Assert(err, NotNil)
+
 	memo, err = ParseMemoWithTHORNames(ctx, k, "REFUND:MUKVQILIHIAUSEOVAXBFEZAJKYHFJYHRUUYGQJZGFYBYVXCXYNEMUOAIQKFQLLCX")
 	c.Assert(err, IsNil)
 	c.Check(memo.IsType(TxRefund), Equals, true)
@@ -451,6 +555,49 @@ func (s *MemoSuite) TestParse(c *C) {
 	fmt.Println(tr2)
 	c.Check(tr2.GetAddress().Equals(ethAddr), Equals, true)
 
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("trade>:%s", trAccAddr))
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxTradeAccountTransfer), Equals, true, Commentf("MEMO: %+v", memo))
+	tr3, ok := memo.(TradeAccountTransferMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(tr3.GetAccAddress().Equals(trAccAddr), Equals, true)
+
+	_, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("trade>:%s", ethAddr))
+	c.Assert(err, NotNil)
+
+	// recurring swap unit tests
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("dca+:ETH~ETH:%s:10:5", trAccAddr))
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxRecurringSwap), Equals, true, Commentf("MEMO: %+v", memo))
+	dca, ok := memo.(RecurringSwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(dca.GetAsset().Equals(common.ETHAsset.GetTradeAsset()), Equals, true)
+	c.Check(dca.GetDestination().String(), Equals, trAccAddr.String())
+	c.Check(dca.GetInterval(), Equals, uint64(10))
+	c.Check(dca.GetQuantity(), Equals, uint64(5))
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, "dca+:ETH~ETH::10:5")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetDestination().IsEmpty(), Equals, true)
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("dca+:ETH.ETH:%s:10:5", ethAddr))
+	c.Assert(err, IsNil)
+	c.Check(memo.GetDestination().Equals(ethAddr), Equals, true)
+
+	_, err = ParseMemoWithTHORNames(ctx, k, "dca+:ETH~ETH")
+	c.Assert(err, NotNil)
+
+	dcaTxID := types.GetRandomTxHash()
+	memo, err = ParseMemoWithTHORNames(ctx, k, fmt.Sprintf("dca-:%s", dcaTxID))
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxRecurringSwapCancel), Equals, true, Commentf("MEMO: %+v", memo))
+	dcaCancel, ok := memo.(RecurringSwapCancelMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(dcaCancel.GetTxID().Equals(dcaTxID), Equals, true)
+
+	_, err = ParseMemoWithTHORNames(ctx, k, "dca-")
+	c.Assert(err, NotNil)
+
 	memo, err = ParseMemoWithTHORNames(ctx, k, "WITHDRAW:"+common.RuneAsset().String()+":25")
 	c.Assert(err, IsNil)
 	c.Check(memo.GetAsset().String(), Equals, common.RuneAsset().String())
@@ -518,6 +665,30 @@ func (s *MemoSuite) TestParse(c *C) {
 	unbondMemo, err := parser.ParseUnbondMemo()
 	c.Assert(err, IsNil)
 	c.Assert(unbondMemo.BondProviderAddress.String(), Equals, bondProvider.String())
+	c.Assert(unbondMemo.Cancel, Equals, false)
+	parser, _ = newParser(ctx, k, k.GetVersion(), fmt.Sprintf("UNBOND:%s:cancel:%s", whiteListAddr.String(), bondProvider.String()))
+	unbondMemo, err = parser.ParseUnbondMemo()
+	c.Assert(err, IsNil)
+	c.Assert(unbondMemo.Cancel, Equals, true)
+	c.Assert(unbondMemo.Amount.IsZero(), Equals, true)
+	c.Assert(unbondMemo.BondProviderAddress.String(), Equals, bondProvider.String())
+
+	memo, err = ParseMemoWithTHORNames(ctx, k, "lbond:"+whiteListAddr.String()+":300")
+	c.Assert(err, IsNil)
+	c.Assert(memo.IsType(TxLiquidBond), Equals, true)
+	c.Assert(memo.GetAccAddress().String(), Equals, whiteListAddr.String())
+	c.Assert(memo.GetAmount().Equal(cosmos.NewUint(300)), Equals, true)
+	memo, err = ParseMemoWithTHORNames(ctx, k, "LBOND:"+whiteListAddr.String())
+	c.Assert(err, IsNil)
+	c.Assert(memo.GetAmount().IsZero(), Equals, true)
+	parser, _ = newParser(ctx, k, k.GetVersion(), fmt.Sprintf("LUNBOND:%s:500", whiteListAddr.String()))
+	liquidUnbondMemo, err := parser.ParseLiquidUnbondMemo()
+	c.Assert(err, IsNil)
+	c.Assert(liquidUnbondMemo.IsType(TxLiquidUnbond), Equals, true)
+	c.Assert(liquidUnbondMemo.NodeAddress.String(), Equals, whiteListAddr.String())
+	c.Assert(liquidUnbondMemo.Units.Equal(cosmos.NewUint(500)), Equals, true)
+	_, err = ParseMemoWithTHORNames(ctx, k, "LUNBOND:")
+	c.Assert(err, NotNil)
 
 	memo, err = ParseMemoWithTHORNames(ctx, k, "migrate:100")
 	c.Assert(err, IsNil)
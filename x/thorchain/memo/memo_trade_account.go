@@ -44,3 +44,22 @@ func (p *parser) ParseTradeAccountWithdrawal() (TradeAccountWithdrawalMemo, erro
 	addr := p.getAddress(1, true, common.NoAddress)
 	return NewTradeAccountWithdrawalMemo(addr), p.Error()
 }
+
+type TradeAccountTransferMemo struct {
+	MemoBase
+	Address cosmos.AccAddress
+}
+
+func (m TradeAccountTransferMemo) GetAccAddress() cosmos.AccAddress { return m.Address }
+
+func NewTradeAccountTransferMemo(addr cosmos.AccAddress) TradeAccountTransferMemo {
+	return TradeAccountTransferMemo{
+		MemoBase: MemoBase{TxType: TxTradeAccountTransfer},
+		Address:  addr,
+	}
+}
+
+func (p *parser) ParseTradeAccountTransfer() (TradeAccountTransferMemo, error) {
+	addr := p.getAccAddress(1, true, nil)
+	return NewTradeAccountTransferMemo(addr), p.Error()
+}
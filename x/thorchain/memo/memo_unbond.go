@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"strings"
+
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
 )
 
@@ -9,6 +11,7 @@ type UnbondMemo struct {
 	NodeAddress         cosmos.AccAddress
 	Amount              cosmos.Uint
 	BondProviderAddress cosmos.AccAddress
+	Cancel              bool
 }
 
 func (m UnbondMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
@@ -25,6 +28,13 @@ func NewUnbondMemo(addr, additional cosmos.AccAddress, amt cosmos.Uint) UnbondMe
 
 func (p *parser) ParseUnbondMemo() (UnbondMemo, error) {
 	addr := p.getAccAddress(1, true, nil)
+	// a queued bond provider unbond is cancelled with CANCEL in place of the amount
+	if strings.EqualFold(p.get(2), "cancel") {
+		additional := p.getAccAddress(3, false, nil)
+		memo := NewUnbondMemo(addr, additional, cosmos.ZeroUint())
+		memo.Cancel = true
+		return memo, p.Error()
+	}
 	amt := p.getUint(2, true, 0)
 	additional := p.getAccAddress(3, false, nil)
 	return NewUnbondMemo(addr, additional, amt), p.Error()
@@ -186,6 +186,7 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 	}
 
 	am.mgr.Keeper().ClearObservingAddresses(ctx)
+	processScheduledMimirs(ctx, am.mgr)
 
 	am.mgr.GasMgr().BeginBlock()
 	if err := am.mgr.NetworkMgr().BeginBlock(ctx, am.mgr); err != nil {
@@ -52,6 +52,11 @@ func (ms msgServer) ModifyLimitSwap(goCtx context.Context, msg *types.MsgModifyL
 	return externalHandler(goCtx, handler, msg)
 }
 
+func (ms msgServer) ModifyStreamingSwap(goCtx context.Context, msg *types.MsgModifyStreamingSwap) (*types.MsgEmpty, error) {
+	handler := NewModifyStreamingSwapHandler(ms.mgr)
+	return externalHandler(goCtx, handler, msg)
+}
+
 func (ms msgServer) NetworkFee(goCtx context.Context, msg *types.MsgNetworkFee) (*types.MsgEmpty, error) {
 	handler := NewNetworkFeeHandler(ms.mgr)
 	return externalHandler(goCtx, handler, msg)
@@ -34,6 +34,8 @@ import (
 // Swap status constants
 const (
 	SwapStatusQueued = "queued"
+	// stop-loss and take-profit orders resting until their trigger is crossed
+	SwapStatusAwaitingTrigger = "awaiting_trigger"
 )
 
 // Queue type constants
@@ -573,6 +575,30 @@ func (qs queryServer) queryInboundAddresses(ctx cosmos.Context, _ *types.QueryIn
 	}, nil
 }
 
+// setNodeBondProviderUnbond adds the pending unbond request of the bond provider
+// to the query response, with the height its notice period ends
+func setNodeBondProviderUnbond(ctx cosmos.Context, mgr *Mgrs, res *types.NodeBondProvider, provider BondProvider) {
+	if !provider.HasUnbondRequest() {
+		return
+	}
+	res.UnbondAmount = provider.UnbondAmount.String()
+	res.UnbondHeight = provider.UnbondHeight + mgr.Keeper().GetConfigInt64(ctx, constants.BondProviderUnbondNoticeBlocks)
+}
+
+// newNodeBondProviders builds the bond providers of a node response
+func newNodeBondProviders(bp BondProviders, providers []*types.NodeBondProvider) *types.NodeBondProviders {
+	res := &types.NodeBondProviders{
+		// Since redundant, leave out the node address
+		NodeOperatorFee: bp.NodeOperatorFee.String(),
+		Providers:       providers,
+	}
+	if bp.HasPendingNodeOperatorFee() {
+		res.PendingNodeOperatorFee = bp.PendingNodeOperatorFee.String()
+		res.PendingNodeOperatorFeeHeight = bp.PendingNodeOperatorFeeHeight
+	}
+	return res
+}
+
 // queryNode return the Node information related to the request node address
 // /thorchain/node/{nodeaddress}
 func (qs queryServer) queryNode(ctx cosmos.Context, req *types.QueryNodeRequest) (*types.QueryNodeResponse, error) {
@@ -650,14 +676,11 @@ func (qs queryServer) queryNode(ctx cosmos.Context, req *types.QueryNodeRequest)
 				BondAddress: p.BondAddress.String(),
 				Bond:        p.Bond.String(),
 			}
+			setNodeBondProviderUnbond(ctx, qs.mgr, providers[i], p)
 		}
 	}
 
-	result.BondProviders = &types.NodeBondProviders{
-		// Since redundant, leave out the node address
-		NodeOperatorFee: bp.NodeOperatorFee.String(),
-		Providers:       providers,
-	}
+	result.BondProviders = newNodeBondProviders(bp, providers)
 
 	// CurrentAward is an estimation of reward for node in active status
 	// Node in other status should not have current reward
@@ -751,6 +774,45 @@ func getNodeCurrentRewards(ctx cosmos.Context, mgr *Mgrs, nodeAcc NodeAccount, l
 	return reward, nil
 }
 
+// queryNodeObservationStats returns the observation latency of a node on each chain
+// /thorchain/node/{address}/observation_stats
+func (qs queryServer) queryNodeObservationStats(ctx cosmos.Context, req *types.QueryObservationStatsRequest) (*types.QueryObservationStatsResponse, error) {
+	if len(req.Address) == 0 {
+		return nil, errors.New("node address not provided")
+	}
+	addr, err := cosmos.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, cosmos.ErrUnknownRequest("invalid account address")
+	}
+
+	stats, err := qs.mgr.Keeper().GetObservationStatsByNode(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get observation stats: %w", err)
+	}
+
+	result := types.QueryObservationStatsResponse{
+		NodeAddress: addr.String(),
+		Chains:      make([]*types.QueryChainObservationStats, 0, len(stats)),
+	}
+	for i := range stats {
+		observations := stats[i].ObservationSummary()
+		networkFees := stats[i].NetworkFeeSummary()
+		result.Chains = append(result.Chains, &types.QueryChainObservationStats{
+			Chain:             stats[i].Chain.String(),
+			Observations:      observations.Count,
+			MedianLag:         observations.MedianLag,
+			MaxLag:            observations.MaxLag,
+			Missed:            observations.Missed,
+			Late:              observations.Late,
+			NetworkFeeVotes:   networkFees.Count,
+			NetworkFeeMissed:  networkFees.Missed,
+			NetworkFeeLate:    networkFees.Late,
+			LastUpdatedHeight: stats[i].LastUpdatedHeight,
+		})
+	}
+	return &result, nil
+}
+
 // queryNodes return all the nodes that has bond
 // /thorchain/nodes
 func (qs queryServer) queryNodes(ctx cosmos.Context, _ *types.QueryNodesRequest) (*types.QueryNodesResponse, error) {
@@ -880,14 +942,11 @@ func (qs queryServer) queryNodes(ctx cosmos.Context, _ *types.QueryNodesRequest)
 					BondAddress: bp.Providers[i].BondAddress.String(),
 					Bond:        bp.Providers[i].Bond.String(),
 				}
+				setNodeBondProviderUnbond(ctx, qs.mgr, providers[i], bp.Providers[i])
 			}
 		}
 
-		result[i].BondProviders = &types.NodeBondProviders{
-			// Since redundant, leave out the node address
-			NodeOperatorFee: bp.NodeOperatorFee.String(),
-			Providers:       providers,
-		}
+		result[i].BondProviders = newNodeBondProviders(bp, providers)
 	}
 
 	return &types.QueryNodesResponse{Nodes: result}, nil
@@ -1270,6 +1329,86 @@ func (qs queryServer) queryStreamingSwaps(ctx cosmos.Context, _ *types.QueryStre
 	return &types.QueryStreamingSwapsResponse{StreamingSwaps: streams}, nil
 }
 
+func newRecurringSwap(ctx cosmos.Context, k keeper.Keeper, swp RecurringSwap) *types.QueryRecurringSwapResponse {
+	var fills []*types.QueryRecurringSwapFill
+	for i := uint64(0); i < swp.Count; i++ {
+		hash, err := swp.GetFillTxID(i)
+		if err != nil {
+			continue
+		}
+		fill, err := k.GetRecurringSwapFill(ctx, hash)
+		if err != nil {
+			continue
+		}
+		fills = append(fills, &types.QueryRecurringSwapFill{
+			TxId:   fill.TxID.String(),
+			Index:  int64(fill.Index),
+			Height: fill.Height,
+			In:     fill.In.Amount.String(),
+			Out:    fill.Out.String(),
+			Status: fill.Status,
+			Reason: fill.Reason,
+		})
+	}
+
+	return &types.QueryRecurringSwapResponse{
+		TxId:        swp.TxID.String(),
+		Owner:       swp.Owner.String(),
+		SourceAsset: swp.SourceAsset.String(),
+		TargetAsset: swp.TargetAsset.String(),
+		Destination: swp.Destination.String(),
+		Amount:      swp.Amount.String(),
+		Interval:    int64(swp.Interval),
+		Quantity:    int64(swp.Quantity),
+		Count:       int64(swp.Count),
+		NextHeight:  swp.NextHeight,
+		Fills:       fills,
+	}
+}
+
+func (qs queryServer) queryRecurringSwaps(ctx cosmos.Context, req *types.QueryRecurringSwapsRequest) (*types.QueryRecurringSwapsResponse, error) {
+	var owner cosmos.AccAddress
+	if len(req.Owner) > 0 {
+		var err error
+		owner, err = cosmos.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse owner: %w", err)
+		}
+	}
+
+	swps := make([]*types.QueryRecurringSwapResponse, 0)
+	iter := qs.mgr.Keeper().GetRecurringSwapIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var swp RecurringSwap
+		qs.mgr.Keeper().Cdc().MustUnmarshal(iter.Value(), &swp)
+		if !owner.Empty() && !swp.Owner.Equals(owner) {
+			continue
+		}
+		swps = append(swps, newRecurringSwap(ctx, qs.mgr.Keeper(), swp))
+	}
+	return &types.QueryRecurringSwapsResponse{RecurringSwaps: swps}, nil
+}
+
+func (qs queryServer) queryRecurringSwap(ctx cosmos.Context, req *types.QueryRecurringSwapRequest) (*types.QueryRecurringSwapResponse, error) {
+	if len(req.TxId) == 0 {
+		return nil, errors.New("tx id not provided")
+	}
+	txid, err := common.NewTxID(req.TxId)
+	if err != nil {
+		ctx.Logger().Error("fail to parse txid", "error", err)
+		return nil, fmt.Errorf("could not parse txid: %w", err)
+	}
+
+	swp, err := qs.mgr.Keeper().GetRecurringSwap(ctx, txid)
+	if err != nil {
+		ctx.Logger().Error("fail to get recurring swap", "error", err)
+		return nil, fmt.Errorf("could not get recurring swap: %w", err)
+	}
+
+	return newRecurringSwap(ctx, qs.mgr.Keeper(), swp), nil
+}
+
 func (qs queryServer) querySwapperClout(ctx cosmos.Context, req *types.QuerySwapperCloutRequest) (*types.SwapperClout, error) {
 	if len(req.Address) == 0 {
 		return nil, errors.New("address not provided")
@@ -1407,6 +1546,10 @@ func (qs queryServer) queryPool(ctx cosmos.Context, req *types.QueryPoolRequest)
 		tradingHalted = true
 	}
 
+	if !tradingHalted && qs.mgr.Keeper().IsPoolTradingHalted(ctx, l1Asset) {
+		tradingHalted = true
+	}
+
 	if !tradingHalted && qs.mgr.Keeper().IsChainHalted(ctx, chain) {
 		tradingHalted = true
 	}
@@ -1449,6 +1592,147 @@ func (qs queryServer) queryPool(ctx cosmos.Context, req *types.QueryPoolRequest)
 	return &p, nil
 }
 
+// queryPoolHistory returns the liquidity fee, reward, synth yield and POL history
+// of a pool, aggregated by day or week
+func (qs queryServer) queryPoolHistory(ctx cosmos.Context, req *types.QueryPoolHistoryRequest) (*types.QueryPoolHistoryResponse, error) {
+	if len(req.Asset) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	asset, err := common.NewAsset(req.Asset)
+	if err != nil {
+		ctx.Logger().Error("fail to parse asset", "error", err)
+		return nil, fmt.Errorf("could not parse asset: %w", err)
+	}
+	pool, err := qs.mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool", "error", err)
+		return nil, fmt.Errorf("could not get pool: %w", err)
+	}
+	if pool.IsEmpty() {
+		return nil, fmt.Errorf("pool: %s doesn't exist", req.Asset)
+	}
+
+	interval := strings.ToLower(req.Interval)
+	days := int64(1)
+	switch interval {
+	case "", "day":
+		interval = "day"
+	case "week":
+		days = 7
+	default:
+		return nil, fmt.Errorf("invalid interval: %s, must be day or week", req.Interval)
+	}
+
+	history, err := qs.mgr.Keeper().GetPoolHistory(ctx, pool.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pool history: %w", err)
+	}
+
+	// history is most recent first, merge consecutive days into the interval
+	intervalBlocks := qs.mgr.Keeper().GetPoolHistoryIntervalBlocks() * days
+	var merged []PoolHistory
+	for _, record := range history {
+		start := record.StartHeight / intervalBlocks * intervalBlocks
+		if len(merged) == 0 || merged[len(merged)-1].StartHeight != start {
+			merged = append(merged, NewPoolHistory(pool.Asset, start))
+		}
+		merged[len(merged)-1].Add(record)
+	}
+
+	intervals := make([]*types.QueryPoolHistoryInterval, len(merged))
+	for i, record := range merged {
+		intervals[i] = &types.QueryPoolHistoryInterval{
+			StartHeight:      record.StartHeight,
+			EndHeight:        record.StartHeight + intervalBlocks - 1,
+			LiquidityFees:    record.LiquidityFees.String(),
+			Rewards:          record.Rewards.String(),
+			SynthYield:       record.SynthYield.String(),
+			PolRuneDeposited: record.PolRuneDeposited.String(),
+			PolRuneWithdrawn: record.PolRuneWithdrawn.String(),
+		}
+	}
+
+	liquidityAPY, saversAPY, err := calcPoolHistoryAPY(ctx, qs.mgr, pool, history)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolHistoryResponse{
+		Asset:           pool.Asset.String(),
+		Interval:        interval,
+		LiquidityApyBps: liquidityAPY,
+		SaversApyBps:    saversAPY,
+		Intervals:       intervals,
+	}, nil
+}
+
+// queryPoolTWAP returns the time weighted average price of a pool over the given
+// number of blocks, from the cumulative price observations recorded each block
+func (qs queryServer) queryPoolTWAP(ctx cosmos.Context, req *types.QueryPoolTWAPRequest) (*types.QueryPoolTWAPResponse, error) {
+	if len(req.Asset) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	asset, err := common.NewAsset(req.Asset)
+	if err != nil {
+		ctx.Logger().Error("fail to parse asset", "error", err)
+		return nil, fmt.Errorf("could not parse asset: %w", err)
+	}
+	pool, err := qs.mgr.Keeper().GetPool(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool", "error", err)
+		return nil, fmt.Errorf("could not get pool: %w", err)
+	}
+	if pool.IsEmpty() {
+		return nil, fmt.Errorf("pool: %s doesn't exist", req.Asset)
+	}
+
+	twap, err := qs.mgr.Keeper().GetPoolTWAP(ctx, pool.Asset, req.Blocks)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pool twap: %w", err)
+	}
+
+	return &types.QueryPoolTWAPResponse{
+		Asset:       pool.Asset.String(),
+		Blocks:      req.Blocks,
+		StartHeight: ctx.BlockHeight() - req.Blocks,
+		EndHeight:   ctx.BlockHeight() - 1,
+		TwapPrice:   twap.String(),
+		SpotPrice:   pool.AssetValueInRune(cosmos.NewUint(common.One)).String(),
+	}, nil
+}
+
+// calcPoolHistoryAPY annualises the income recorded in the pool history against
+// the current pool depth and savers depth, returned in basis points
+func calcPoolHistoryAPY(ctx cosmos.Context, mgr *Mgrs, pool Pool, history []PoolHistory) (int64, int64, error) {
+	if len(history) == 0 {
+		return 0, 0, nil
+	}
+	oldest := history[len(history)-1].StartHeight
+	blocks := cosmos.SafeUintFromInt64(ctx.BlockHeight() - oldest + 1)
+	total := NewPoolHistory(pool.Asset, oldest)
+	for _, record := range history {
+		total.Add(record)
+	}
+	blocksPerYear := cosmos.SafeUintFromInt64(mgr.Keeper().GetConstants().GetInt64Value(constants.BlocksPerYear))
+	annualise := func(income, depth cosmos.Uint) int64 {
+		if depth.IsZero() || blocks.IsZero() {
+			return 0
+		}
+		return int64(common.GetUncappedShare(income.Mul(blocksPerYear), depth.Mul(blocks), cosmos.NewUint(constants.MaxBasisPts)).Uint64())
+	}
+
+	// liquidity providers own both sides of the pool
+	liquidityAPY := annualise(total.LiquidityFees.Add(total.Rewards), pool.BalanceRune.MulUint64(2))
+
+	saversPool, err := mgr.Keeper().GetPool(ctx, pool.Asset.GetSyntheticAsset())
+	if err != nil {
+		return 0, 0, fmt.Errorf("fail to get savers pool: %w", err)
+	}
+	saversAPY := annualise(total.SynthYield, saversPool.BalanceAsset)
+
+	return liquidityAPY, saversAPY, nil
+}
+
 func (qs queryServer) queryPools(ctx cosmos.Context, _ *types.QueryPoolsRequest) (*types.QueryPoolsResponse, error) {
 	dollarsPerRune := dollarsPerRuneIgnoreHalt(ctx, qs.mgr.Keeper())
 
@@ -1538,6 +1822,10 @@ func (qs queryServer) queryPools(ctx cosmos.Context, _ *types.QueryPoolsRequest)
 			tradingHalted = isChainOrChainTradingHalted[chain]
 		}
 
+		if !tradingHalted {
+			tradingHalted = qs.mgr.Keeper().IsPoolTradingHalted(ctx, l1Asset)
+		}
+
 		if !pool.IsAvailable() {
 			tradingHalted = true
 		}
@@ -1698,6 +1986,16 @@ func (qs queryServer) queryDerivedPool(ctx cosmos.Context, req *types.QueryDeriv
 	}
 	p.DerivedDepthBps = dbps.String()
 
+	// anchor price from the pool TWAP of the anchor pools, as used to price the
+	// derived pool when DerivedPoolTWAPBlocks is set
+	if req.TwapBlocks > 0 {
+		median := qs.mgr.Keeper().AnchorMedianTWAP(ctx, qs.mgr.Keeper().GetAnchors(ctx, asset), req.TwapBlocks)
+		if median.IsZero() {
+			return nil, fmt.Errorf("no anchor pool twap available over %d blocks", req.TwapBlocks)
+		}
+		p.AnchorTwapPrice = median.QuoUint64(constants.DollarMulti).String()
+	}
+
 	return &p, nil
 }
 
@@ -2789,6 +3087,53 @@ func (qs queryServer) queryMimirNodesAllValues(ctx cosmos.Context, _ *types.Quer
 	return &resp, nil
 }
 
+func (qs queryServer) queryMimirScheduled(ctx cosmos.Context, _ *types.QueryMimirScheduledRequest) (*types.QueryMimirScheduledResponse, error) {
+	resp := types.QueryMimirScheduledResponse{
+		Scheduled: make([]*types.QueryScheduledMimir, 0),
+		NodeVotes: make([]*types.QueryScheduledMimir, 0),
+	}
+
+	k := qs.mgr.Keeper()
+	iter := k.GetScheduledMimirIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		m := ScheduledMimir{}
+		if err := k.Cdc().Unmarshal(iter.Value(), &m); err != nil {
+			ctx.Logger().Error("fail to unmarshal scheduled mimir", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal scheduled mimir: %w", err)
+		}
+		resp.Scheduled = append(resp.Scheduled, &types.QueryScheduledMimir{
+			Key:    m.Key,
+			Value:  m.Value,
+			Height: m.Height,
+		})
+	}
+
+	iterNode := k.GetScheduledNodeMimirIterator(ctx)
+	defer iterNode.Close()
+	for ; iterNode.Valid(); iterNode.Next() {
+		height, err := scheduledMimirHeightFromKey(iterNode.Key())
+		if err != nil {
+			return nil, err
+		}
+		m := NodeMimirs{}
+		if err = k.Cdc().Unmarshal(iterNode.Value(), &m); err != nil {
+			ctx.Logger().Error("fail to unmarshal scheduled node mimir", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal scheduled node mimir: %w", err)
+		}
+		for _, vote := range m.Mimirs {
+			resp.NodeVotes = append(resp.NodeVotes, &types.QueryScheduledMimir{
+				Key:    vote.Key,
+				Value:  vote.Value,
+				Height: height,
+				Signer: vote.Signer.String(),
+			})
+		}
+	}
+
+	return &resp, nil
+}
+
 func (qs queryServer) queryMimirNodesValues(ctx cosmos.Context, _ *types.QueryMimirNodesValuesRequest) (*types.QueryMimirNodesValuesResponse, error) {
 	activeNodes, err := qs.mgr.Keeper().ListActiveValidators(ctx)
 	if err != nil {
@@ -2821,6 +3166,72 @@ func (qs queryServer) queryMimirNodesValues(ctx cosmos.Context, _ *types.QueryMi
 	return &resp, nil
 }
 
+func (qs queryServer) queryMimirRegistry(_ cosmos.Context, _ *types.QueryMimirRegistryRequest) (*types.QueryMimirRegistryResponse, error) {
+	defs := constants.GetMimirRegistry()
+	resp := types.QueryMimirRegistryResponse{
+		Definitions: make([]*types.QueryMimirDefinition, 0, len(defs)),
+	}
+	for _, def := range defs {
+		resp.Definitions = append(resp.Definitions, &types.QueryMimirDefinition{
+			Key:         def.Key,
+			Type:        string(def.Type),
+			Unit:        def.Unit,
+			Min:         def.Min,
+			Max:         def.Max,
+			Description: def.Description,
+			Template:    def.IsTemplate(),
+		})
+	}
+	return &resp, nil
+}
+
+func (qs queryServer) queryMimirHistory(ctx cosmos.Context, req *types.QueryMimirHistoryRequest) (*types.QueryMimirHistoryResponse, error) {
+	if !mimirValidKey(req.Key) || len(req.Key) > constants.MaxMimirLength {
+		return nil, fmt.Errorf("invalid mimir key: %s", req.Key)
+	}
+	history, err := qs.mgr.Keeper().GetMimirHistory(ctx, req.Key)
+	if err != nil {
+		ctx.Logger().Error("fail to get mimir history", "error", err)
+		return nil, fmt.Errorf("fail to get mimir history: %w", err)
+	}
+	return newQueryMimirHistoryResponse(history), nil
+}
+
+func (qs queryServer) queryMimirNodeHistory(ctx cosmos.Context, req *types.QueryMimirNodeHistoryRequest) (*types.QueryMimirHistoryResponse, error) {
+	acc, err := cosmos.AccAddressFromBech32(req.Address)
+	if err != nil {
+		ctx.Logger().Error("fail to parse thor address", "error", err)
+		return nil, fmt.Errorf("fail to parse thor address: %w", err)
+	}
+	history, err := qs.mgr.Keeper().GetNodeMimirHistory(ctx, acc)
+	if err != nil {
+		ctx.Logger().Error("fail to get node mimir history", "error", err)
+		return nil, fmt.Errorf("fail to get node mimir history: %w", err)
+	}
+	return newQueryMimirHistoryResponse(history), nil
+}
+
+func newQueryMimirHistoryResponse(history []MimirHistory) *types.QueryMimirHistoryResponse {
+	resp := types.QueryMimirHistoryResponse{
+		History: make([]*types.QueryMimirHistory, 0, len(history)),
+	}
+	for i := range history {
+		record := &types.QueryMimirHistory{
+			Key:           history[i].Key,
+			Value:         history[i].Value,
+			PreviousValue: history[i].PreviousValue,
+			Height:        history[i].Height,
+			Type:          "mimir",
+		}
+		if history[i].IsNodeVote() {
+			record.Signer = history[i].Signer.String()
+			record.Type = "node_vote"
+		}
+		resp.History = append(resp.History, record)
+	}
+	return &resp
+}
+
 func (qs queryServer) queryMimirNodeValues(ctx cosmos.Context, req *types.QueryMimirNodeValuesRequest) (*types.QueryMimirNodeValuesResponse, error) {
 	acc, err := cosmos.AccAddressFromBech32(req.Address)
 	if err != nil {
@@ -3089,9 +3500,13 @@ func (qs queryServer) querySwapDetails(ctx cosmos.Context, req *types.QuerySwapD
 			if err != nil {
 				return nil, fmt.Errorf("failed to get advanced swap queue item: %w", err)
 			}
+			status := SwapStatusQueued
+			if msg.IsTriggerOrder() {
+				status = SwapStatusAwaitingTrigger
+			}
 			return &types.QuerySwapDetailsResponse{
 				Swap:      &msg,
-				Status:    SwapStatusQueued,
+				Status:    status,
 				QueueType: QueueTypeAdvanced,
 			}, nil
 		}
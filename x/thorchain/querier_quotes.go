@@ -177,6 +177,32 @@ func quoteHandleAffiliate(ctx cosmos.Context, mgr *Mgrs, affiliateParam, affilia
 	return affiliate, memo, bps, amount, affAmt, nil
 }
 
+// quoteCheckPoolHalt returns an error if the pool of any of the assets is halted.
+func quoteCheckPoolHalt(ctx cosmos.Context, mgr *Mgrs, assets ...common.Asset) error {
+	for _, asset := range assets {
+		if mgr.Keeper().IsPoolTradingHalted(ctx, asset) {
+			return fmt.Errorf("pool is halted for asset (%s)", asset.GetLayer1Asset())
+		}
+	}
+	return nil
+}
+
+// quotePriceBreakerHeight returns the last block swaps are paused by the price
+// breaker in any pool of the assets, or zero if none are paused.
+func quotePriceBreakerHeight(ctx cosmos.Context, mgr *Mgrs, assets ...common.Asset) int64 {
+	var height int64
+	for _, asset := range assets {
+		if !mgr.Keeper().IsPriceBreakerTripped(ctx, asset) {
+			continue
+		}
+		pauseHeight, err := mgr.Keeper().GetPriceBreakerHeight(ctx, asset.GetLayer1Asset())
+		if err == nil && pauseHeight > height {
+			height = pauseHeight
+		}
+	}
+	return height
+}
+
 func hasSuffixMatch(suffix string, values []string) bool {
 	for _, value := range values {
 		if strings.HasSuffix(value, suffix) {
@@ -354,6 +380,56 @@ func quoteSimulateSwap(ctx cosmos.Context, mgr *Mgrs, amount sdkmath.Uint, msg *
 	}, emitAmount, outboundFeeAmount, nil
 }
 
+// quoteBestSwapRoute simulates the swap through each candidate route and returns
+// the route with the largest emit, or nil if the direct route is best. Candidate
+// hops are the derived assets of the source and target pools.
+func quoteBestSwapRoute(ctx cosmos.Context, mgr *Mgrs, amount sdkmath.Uint, msg *MsgSwap) []common.Asset {
+	if mgr.Keeper().GetConfigInt64(ctx, constants.SwapRouteMaxLength) <= 0 ||
+		mgr.Keeper().GetConfigInt64(ctx, constants.EnableDerivedAssets) <= 0 {
+		return nil
+	}
+
+	simulateRoute := func(route []common.Asset) (sdkmath.Uint, error) {
+		candidate := *msg
+		candidate.Route = route
+		candidate.Tx.Coins = common.NewCoins(msg.Tx.Coins...)
+		_, emit, _, err := quoteSimulateSwap(ctx, mgr, amount, &candidate, 1)
+		return emit, err
+	}
+
+	bestEmit, err := simulateRoute(nil)
+	if err != nil {
+		return nil
+	}
+
+	var best []common.Asset
+	source := msg.Tx.Coins[0].Asset
+	for _, asset := range []common.Asset{source, msg.TargetAsset} {
+		if asset.IsRune() || asset.IsDerivedAsset() {
+			continue
+		}
+		hop := asset.GetLayer1Asset().GetDerivedAsset()
+		if hop.Equals(source) || hop.Equals(msg.TargetAsset) {
+			continue
+		}
+		pool, err := mgr.Keeper().GetPool(ctx, hop)
+		if err != nil || pool.IsEmpty() || !pool.IsAvailable() {
+			continue
+		}
+		route := []common.Asset{hop}
+		emit, err := simulateRoute(route)
+		if err != nil {
+			continue
+		}
+		if emit.GT(bestEmit) {
+			bestEmit = emit
+			best = route
+		}
+	}
+
+	return best
+}
+
 func convertThorchainAmountToWei(amt *big.Int) *big.Int {
 	return big.NewInt(0).Mul(amt, big.NewInt(common.One*100))
 }
@@ -521,6 +597,9 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 		return nil, fmt.Errorf("bad to asset: %w", err)
 	}
 	toAsset = fuzzyAssetMatch(ctx, qs.mgr.Keeper(), toAsset)
+	if err = quoteCheckPoolHalt(ctx, qs.mgr, fromAsset, toAsset); err != nil {
+		return nil, err
+	}
 
 	// parse amount
 	amount, err := cosmos.ParseUint(req.Amount)
@@ -665,6 +744,18 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 		return nil, fmt.Errorf("bad affiliate params: %w", err)
 	}
 
+	// parse the explicit route if provided
+	var route []common.Asset
+	if len(req.Route) > 0 {
+		for _, hopString := range strings.Split(req.Route, ">") {
+			hop, err := common.NewAssetWithShortCodes(qs.mgr.GetVersion(), hopString)
+			if err != nil {
+				return nil, fmt.Errorf("bad route asset: %w", err)
+			}
+			route = append(route, fuzzyAssetMatch(ctx, qs.mgr.Keeper(), hop))
+		}
+	}
+
 	// always attempt to shorten the to asset to fuzzy match
 	fuzzyToAsset, err := quoteReverseFuzzyAsset(ctx, qs.mgr, toAsset)
 	memoToAsset := toAsset
@@ -686,6 +777,7 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 		StreamInterval:        streamingInterval,
 		StreamQuantity:        streamingQuantity,
 		RefundAddress:         refundAddress,
+		Route:                 route,
 	}
 	memoString := memo.ShortString()
 
@@ -737,6 +829,18 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 		Destination:          destination,
 		AffiliateAddress:     common.NoAddress,
 		AffiliateBasisPoints: cosmos.ZeroUint(),
+		Route:                route,
+	}
+
+	// if no route was provided, use the best of the candidate routes
+	if len(req.Route) == 0 {
+		route = quoteBestSwapRoute(ctx, qs.mgr, amount, msg)
+		if len(route) > 0 {
+			memo.Route = route
+			memoString = memo.ShortString()
+			msg.Tx.Memo = memoString
+			msg.Route = route
+		}
 	}
 
 	// simulate the swap
@@ -865,6 +969,12 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 	if inboundConfirmations > 0 {
 		totalSeconds += res.InboundConfirmationSeconds
 	}
+
+	// the swap is held until the price breaker pause ends
+	res.PriceBreakerHeight = quotePriceBreakerHeight(ctx, qs.mgr, append([]common.Asset{fromAsset, toAsset}, route...)...)
+	if res.PriceBreakerHeight > 0 {
+		totalSeconds += (res.PriceBreakerHeight - ctx.BlockHeight() + 1) * common.THORChain.ApproximateBlockMilliseconds() / 1000
+	}
 	res.TotalSwapSeconds = totalSeconds
 
 	// send memo if the destination was provided
@@ -880,6 +990,10 @@ func (qs queryServer) queryQuoteSwap(ctx cosmos.Context, req *types.QueryQuoteSw
 		res.DustThreshold = fromAsset.Chain.DustThreshold().String()
 	}
 
+	for _, hop := range route {
+		res.Route = append(res.Route, hop.String())
+	}
+
 	res.Notes = fromAsset.GetChain().InboundNotes()
 	res.Warning = quoteWarning
 	res.Expiry = time.Now().Add(quoteExpiration).Unix()
@@ -1008,6 +1122,9 @@ func (qs queryServer) queryQuoteSaverDeposit(ctx cosmos.Context, req *types.Quer
 		return nil, fmt.Errorf("bad asset: %w", err)
 	}
 	asset = fuzzyAssetMatch(ctx, qs.mgr.Keeper(), asset)
+	if err = quoteCheckPoolHalt(ctx, qs.mgr, asset); err != nil {
+		return nil, err
+	}
 
 	// parse amount
 	amount, err := cosmos.ParseUint(req.Amount)
@@ -1015,6 +1132,21 @@ func (qs queryServer) queryQuoteSaverDeposit(ctx cosmos.Context, req *types.Quer
 		return nil, fmt.Errorf("bad amount: %w", err)
 	}
 
+	// the synth yield realised by the savers of the pool, computed before the
+	// simulated swap below records its liquidity fee
+	pool, err := qs.mgr.Keeper().GetPool(ctx, asset.GetLayer1Asset())
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pool: %w", err)
+	}
+	history, err := qs.mgr.Keeper().GetPoolHistory(ctx, pool.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get pool history: %w", err)
+	}
+	_, realisedAPY, err := calcPoolHistoryAPY(ctx, qs.mgr, pool, history)
+	if err != nil {
+		return nil, err
+	}
+
 	// parse affiliate
 	affiliate, affiliateMemo, affiliateBps, depositAmount, _, err := quoteHandleAffiliate(ctx, qs.mgr, req.Affiliate, req.AffiliateBps, amount)
 	if err != nil {
@@ -1071,6 +1203,7 @@ func (qs queryServer) queryQuoteSaverDeposit(ctx cosmos.Context, req *types.Quer
 		InboundConfirmationBlocks:  swapRes.InboundConfirmationBlocks,
 		InboundConfirmationSeconds: swapRes.InboundConfirmationSeconds,
 		Memo:                       depositMemo,
+		RealisedApyBps:             realisedAPY,
 	}
 
 	// estimate the inbound info
@@ -1122,6 +1255,9 @@ func (qs queryServer) queryQuoteSaverWithdraw(ctx cosmos.Context, req *types.Que
 	}
 	asset = fuzzyAssetMatch(ctx, qs.mgr.Keeper(), asset)
 	asset = asset.GetSyntheticAsset() // always use the vault asset
+	if err = quoteCheckPoolHalt(ctx, qs.mgr, asset); err != nil {
+		return nil, err
+	}
 
 	// parse address
 	address, err := common.NewAddress(req.Address)
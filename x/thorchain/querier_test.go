@@ -20,6 +20,7 @@ import (
 	"gitlab.com/thorchain/thornode/v3/cmd"
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	"gitlab.com/thorchain/thornode/v3/constants"
 	openapi "gitlab.com/thorchain/thornode/v3/openapi/gen"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/keeper"
 	"gitlab.com/thorchain/thornode/v3/x/thorchain/types"
@@ -203,6 +204,89 @@ func (s *QuerierSuite) TestQueryPool(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *QuerierSuite) TestQueryPoolHistory(c *C) {
+	interval := s.k.GetPoolHistoryIntervalBlocks()
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(100)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	savers := NewPool()
+	savers.Asset = common.BTCAsset.GetSyntheticAsset()
+	savers.BalanceAsset = cosmos.NewUint(100 * common.One)
+	c.Assert(s.k.SetPool(s.ctx, savers), IsNil)
+
+	ctx := s.ctx.WithBlockHeight(100)
+	record := NewPoolHistory(common.BTCAsset, ctx.BlockHeight())
+	record.LiquidityFees = cosmos.NewUint(10 * common.One)
+	record.Rewards = cosmos.NewUint(5 * common.One)
+	s.k.AddPoolHistory(ctx, record)
+	ctx = s.ctx.WithBlockHeight(interval + 100)
+	record = NewPoolHistory(common.BTCAsset, ctx.BlockHeight())
+	record.LiquidityFees = cosmos.NewUint(20 * common.One)
+	record.SynthYield = cosmos.NewUint(common.One)
+	s.k.AddPoolHistory(ctx, record)
+
+	ctx = s.ctx.WithBlockHeight(2*interval - 1)
+	resp, err := s.queryServer.PoolHistory(ctx, &types.QueryPoolHistoryRequest{Asset: "BTC.BTC"})
+	c.Assert(err, IsNil)
+	c.Check(resp.Interval, Equals, "day")
+	c.Assert(resp.Intervals, HasLen, 2)
+	c.Check(resp.Intervals[0].StartHeight, Equals, interval)
+	c.Check(resp.Intervals[0].EndHeight, Equals, 2*interval-1)
+	c.Check(resp.Intervals[0].LiquidityFees, Equals, "2000000000")
+	c.Check(resp.Intervals[0].SynthYield, Equals, "100000000")
+	c.Check(resp.Intervals[1].Rewards, Equals, "500000000")
+	// 35 RUNE over one year of two days against 2000 RUNE of depth
+	c.Check(resp.LiquidityApyBps, Equals, int64(31938))
+	// 1 BTC over one year of two days against 100 BTC of savers
+	c.Check(resp.SaversApyBps, Equals, int64(18250))
+
+	resp, err = s.queryServer.PoolHistory(ctx, &types.QueryPoolHistoryRequest{Asset: "BTC.BTC", Interval: "week"})
+	c.Assert(err, IsNil)
+	c.Assert(resp.Intervals, HasLen, 1)
+	c.Check(resp.Intervals[0].StartHeight, Equals, int64(0))
+	c.Check(resp.Intervals[0].EndHeight, Equals, 7*interval-1)
+	c.Check(resp.Intervals[0].LiquidityFees, Equals, "3000000000")
+
+	_, err = s.queryServer.PoolHistory(ctx, &types.QueryPoolHistoryRequest{Asset: "BTC.BTC", Interval: "month"})
+	c.Assert(err, NotNil)
+	_, err = s.queryServer.PoolHistory(ctx, &types.QueryPoolHistoryRequest{Asset: "ETH.ETH"})
+	c.Assert(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryPoolTWAP(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10 * common.One)
+	pool.LPUnits = cosmos.NewUint(100)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	s.k.SetMimir(s.ctx, constants.PoolTWAPMaxBlocks.String(), 100)
+
+	ctx := s.ctx.WithBlockHeight(100)
+	s.k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(50*common.One))
+	ctx = s.ctx.WithBlockHeight(110)
+	s.k.AddPoolPriceObservation(ctx, common.BTCAsset, cosmos.NewUint(100*common.One))
+
+	ctx = s.ctx.WithBlockHeight(120)
+	resp, err := s.queryServer.PoolTWAP(ctx, &types.QueryPoolTWAPRequest{Asset: "BTC.BTC", Blocks: 20})
+	c.Assert(err, IsNil)
+	c.Check(resp.Asset, Equals, "BTC.BTC")
+	c.Check(resp.StartHeight, Equals, int64(100))
+	c.Check(resp.EndHeight, Equals, int64(119))
+	c.Check(resp.TwapPrice, Equals, "7500000000")
+	c.Check(resp.SpotPrice, Equals, "10000000000")
+
+	_, err = s.queryServer.PoolTWAP(ctx, &types.QueryPoolTWAPRequest{Asset: "BTC.BTC", Blocks: 21})
+	c.Assert(err, NotNil)
+	_, err = s.queryServer.PoolTWAP(ctx, &types.QueryPoolTWAPRequest{Asset: "BTC.BTC"})
+	c.Assert(err, NotNil)
+	_, err = s.queryServer.PoolTWAP(ctx, &types.QueryPoolTWAPRequest{Asset: "ETH.ETH", Blocks: 20})
+	c.Assert(err, NotNil)
+}
+
 func (s *QuerierSuite) TestVaults(c *C) {
 	ctx, mgr := setupManagerForTest(c)
 	txConfig, err := params.TxConfig(s.mgr.cdc, nil)
@@ -857,6 +941,30 @@ func (s *QuerierSuite) TestQueryMimir(c *C) {
 	c.Assert(m["HELLO"], Equals, int64(111))
 }
 
+func (s *QuerierSuite) TestQueryMimirHistory(c *C) {
+	acc := GetRandomBech32Addr()
+	s.k.SetMimir(s.ctx, "hello", 111)
+	c.Assert(s.k.SetNodeMimir(s.ctx, "hello", 222, acc), IsNil)
+
+	resp, err := s.queryServer.MimirHistory(s.ctx, &types.QueryMimirHistoryRequest{Key: "hello"})
+	c.Assert(err, IsNil)
+	c.Assert(resp.History, HasLen, 2)
+	c.Check(resp.History[0].Type, Equals, "node_vote")
+	c.Check(resp.History[0].Signer, Equals, acc.String())
+	c.Check(resp.History[0].Value, Equals, int64(222))
+	c.Check(resp.History[1].Type, Equals, "mimir")
+	c.Check(resp.History[1].Value, Equals, int64(111))
+	c.Check(resp.History[1].PreviousValue, Equals, int64(-1))
+
+	resp, err = s.queryServer.MimirNodeHistory(s.ctx, &types.QueryMimirNodeHistoryRequest{Address: acc.String()})
+	c.Assert(err, IsNil)
+	c.Assert(resp.History, HasLen, 1)
+	c.Check(resp.History[0].Key, Equals, "HELLO")
+
+	_, err = s.queryServer.MimirNodeHistory(s.ctx, &types.QueryMimirNodeHistoryRequest{Address: "bogus"})
+	c.Assert(err, NotNil)
+}
+
 func (s *QuerierSuite) TestQueryBan(c *C) {
 	queryBanResp, err := s.queryServer.Ban(s.ctx, &types.QueryBanRequest{})
 	c.Assert(queryBanResp, IsNil)
@@ -885,6 +993,98 @@ func (s *QuerierSuite) TestQueryBan(c *C) {
 	c.Assert(*openapiBanResp.NodeAddress, Equals, queryBanResp.NodeAddress.String())
 }
 
+func (s *QuerierSuite) TestQueryNodeObservationStats(c *C) {
+	resp, err := s.queryServer.NodeObservationStats(s.ctx, &types.QueryObservationStatsRequest{})
+	c.Assert(resp, IsNil)
+	c.Assert(err, NotNil)
+
+	resp, err = s.queryServer.NodeObservationStats(s.ctx, &types.QueryObservationStatsRequest{
+		Address: "Whatever",
+	})
+	c.Assert(resp, IsNil)
+	c.Assert(err, NotNil)
+
+	addr := GetRandomBech32Addr()
+	stats := NewObservationStats(addr, common.BTCChain)
+	for _, lag := range []int64{0, 2, ObservationMissed, 0, 1} {
+		stats.RecordObservation(s.ctx.BlockHeight(), GetRandomTxHash().String(), lag, 10)
+	}
+	stats.RecordNetworkFee(s.ctx.BlockHeight(), "100/10/250", ObservationMissed, 10)
+	s.k.SetObservationStats(s.ctx, stats)
+
+	resp, err = s.queryServer.NodeObservationStats(s.ctx, &types.QueryObservationStatsRequest{
+		Address: addr.String(),
+	})
+	c.Assert(err, IsNil)
+	c.Assert(resp, NotNil)
+	c.Assert(resp.Chains, HasLen, 1)
+
+	result, err := resp.MarshalJSONPB(nil)
+	c.Assert(err, IsNil)
+
+	var openapiResp openapi.ObservationStatsResponse
+	err = json.Unmarshal(result, &openapiResp)
+	c.Assert(err, IsNil)
+
+	// Verify conformance to openapi spec
+	c.Assert(openapiResp.NodeAddress, Equals, addr.String())
+	c.Assert(openapiResp.Chains, HasLen, 1)
+	chain := openapiResp.Chains[0]
+	c.Check(chain.Chain, Equals, common.BTCChain.String())
+	c.Check(chain.Observations, Equals, int64(5))
+	c.Check(chain.MedianLag, Equals, int64(1))
+	c.Check(chain.MaxLag, Equals, int64(2))
+	c.Check(chain.Missed, Equals, int64(1))
+	c.Check(chain.Late, Equals, int64(2))
+	c.Check(chain.NetworkFeeVotes, Equals, int64(1))
+	c.Check(chain.NetworkFeeMissed, Equals, int64(1))
+}
+
+func (s *QuerierSuite) TestQueryRecurringSwaps(c *C) {
+	_, err := s.queryServer.RecurringSwap(s.ctx, &types.QueryRecurringSwapRequest{})
+	c.Assert(err, NotNil)
+	_, err = s.queryServer.RecurringSwap(s.ctx, &types.QueryRecurringSwapRequest{TxId: GetRandomTxHash().String()})
+	c.Assert(err, NotNil)
+
+	owner := GetRandomBech32Addr()
+	swp := NewRecurringSwap(GetRandomTxHash(), owner, common.BTCAsset.GetTradeAsset(), common.ETHAsset.GetTradeAsset(), common.Address(owner.String()), cosmos.NewUint(100), 10, 5, 20)
+	swp.Count = 1
+	s.k.SetRecurringSwap(s.ctx, swp)
+	fillID, err := swp.GetFillTxID(0)
+	c.Assert(err, IsNil)
+	fill := NewRecurringSwapFill(fillID, swp.TxID, 0, 10, common.NewCoin(common.BTCAsset.GetTradeAsset(), cosmos.NewUint(100)))
+	fill.Status = RecurringSwapFillFilled
+	fill.Out = cosmos.NewUint(1500)
+	s.k.SetRecurringSwapFill(s.ctx, fill)
+	other := NewRecurringSwap(GetRandomTxHash(), GetRandomBech32Addr(), common.BTCAsset.GetTradeAsset(), common.RuneAsset(), GetRandomTHORAddress(), cosmos.NewUint(100), 10, 5, 20)
+	s.k.SetRecurringSwap(s.ctx, other)
+
+	resp, err := s.queryServer.RecurringSwap(s.ctx, &types.QueryRecurringSwapRequest{TxId: swp.TxID.String()})
+	c.Assert(err, IsNil)
+	result, err := resp.MarshalJSONPB(nil)
+	c.Assert(err, IsNil)
+	var openapiResp openapi.RecurringSwap
+	c.Assert(json.Unmarshal(result, &openapiResp), IsNil)
+	c.Check(openapiResp.TxId, Equals, swp.TxID.String())
+	c.Check(openapiResp.Owner, Equals, owner.String())
+	c.Check(openapiResp.Count, Equals, int64(1))
+	c.Check(openapiResp.NextHeight, Equals, int64(20))
+	c.Assert(openapiResp.Fills, HasLen, 1)
+	c.Check(openapiResp.Fills[0].TxId, Equals, fillID.String())
+	c.Check(openapiResp.Fills[0].Status, Equals, "filled")
+	c.Check(openapiResp.Fills[0].Out, Equals, "1500")
+
+	swps, err := s.queryServer.RecurringSwaps(s.ctx, &types.QueryRecurringSwapsRequest{})
+	c.Assert(err, IsNil)
+	c.Check(swps.RecurringSwaps, HasLen, 2)
+	swps, err = s.queryServer.RecurringSwaps(s.ctx, &types.QueryRecurringSwapsRequest{Owner: owner.String()})
+	c.Assert(err, IsNil)
+	c.Assert(swps.RecurringSwaps, HasLen, 1)
+	c.Check(swps.RecurringSwaps[0].TxId, Equals, swp.TxID.String())
+	_, err = s.queryServer.RecurringSwaps(s.ctx, &types.QueryRecurringSwapsRequest{Owner: "bogus"})
+	c.Assert(err, NotNil)
+}
+
 func (s *QuerierSuite) TestQueryNodeAccount(c *C) {
 	queryNodeAccount, err := s.queryServer.Node(s.ctx, &types.QueryNodeRequest{})
 	c.Assert(queryNodeAccount, IsNil)
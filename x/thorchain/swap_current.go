@@ -44,6 +44,7 @@ func (s *SwapperVCUR) Swap(ctx cosmos.Context,
 	keeper keeper.Keeper,
 	tx common.Tx,
 	target common.Asset,
+	route []common.Asset,
 	destination common.Address,
 	swapTarget cosmos.Uint,
 	dexAgg string,
@@ -79,6 +80,43 @@ func (s *SwapperVCUR) Swap(ctx cosmos.Context,
 		return cosmos.ZeroUint(), swapEvents, fmt.Errorf("cannot swap from %s --> %s, assets match", source, target)
 	}
 
+	// an explicit route swaps through each of its assets in turn before the target,
+	// with the swap slip of every pool swap on the way capped
+	maxHopSlip := cosmos.ZeroUint()
+	if len(route) > 0 {
+		maxHopSlip = cosmos.NewUint(uint64(mgr.Keeper().GetConfigInt64(ctx, constants.SwapRouteMaxHopSlipBps)))
+	}
+	for _, hop := range route {
+		// trade and secured assets share the pool of their layer1 asset, and are
+		// never credited to an account mid-route
+		if hop.IsTradeAsset() || hop.IsSecuredAsset() {
+			hop = hop.GetLayer1Asset()
+		}
+		if !source.IsRune() {
+			amt, swapEvt, swapErr := s.swapOne(ctx, mgr, tx, common.RuneAsset(), destination, cosmos.ZeroUint(), synthVirtualDepthMult)
+			if swapErr != nil {
+				return cosmos.ZeroUint(), swapEvents, swapErr
+			}
+			swapEvents = append(swapEvents, swapEvt)
+			if err := checkRouteHopSlip(swapEvt, maxHopSlip); err != nil {
+				return cosmos.ZeroUint(), swapEvents, err
+			}
+			tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), amt)}
+			tx.Gas = nil
+		}
+		amt, swapEvt, swapErr := s.swapOne(ctx, mgr, tx, hop, destination, cosmos.ZeroUint(), synthVirtualDepthMult)
+		if swapErr != nil {
+			return cosmos.ZeroUint(), swapEvents, swapErr
+		}
+		swapEvents = append(swapEvents, swapEvt)
+		if err := checkRouteHopSlip(swapEvt, maxHopSlip); err != nil {
+			return cosmos.ZeroUint(), swapEvents, err
+		}
+		tx.Coins = common.Coins{common.NewCoin(hop, amt)}
+		tx.Gas = nil
+		source = hop
+	}
+
 	isDoubleSwap := !source.IsRune() && !target.IsRune()
 	if isDoubleSwap {
 		var swapErr error
@@ -92,12 +130,22 @@ func (s *SwapperVCUR) Swap(ctx cosmos.Context,
 		tx.Coins = common.Coins{common.NewCoin(common.RuneAsset(), amt)}
 		tx.Gas = nil
 		swapEvents = append(swapEvents, swapEvt)
+		if len(route) > 0 {
+			if err := checkRouteHopSlip(swapEvt, maxHopSlip); err != nil {
+				return cosmos.ZeroUint(), swapEvents, err
+			}
+		}
 	}
 	assetAmount, swapEvt, swapErr := s.swapOne(ctx, mgr, tx, target, destination, swapTarget, synthVirtualDepthMult)
 	if swapErr != nil {
 		return cosmos.ZeroUint(), swapEvents, swapErr
 	}
 	swapEvents = append(swapEvents, swapEvt)
+	if len(route) > 0 {
+		if err := checkRouteHopSlip(swapEvt, maxHopSlip); err != nil {
+			return cosmos.ZeroUint(), swapEvents, err
+		}
+	}
 	if !swapTarget.IsZero() && assetAmount.LT(swapTarget) {
 		// **NOTE** this error string is utilized by the adv swap queue manager to
 		// catch the error. DO NOT change this error string without updating
@@ -165,6 +213,15 @@ func (s *SwapperVCUR) Swap(ctx cosmos.Context,
 	return assetAmount, swapEvents, nil
 }
 
+// checkRouteHopSlip fails a routed swap when one of its pool swaps slips more
+// than SwapRouteMaxHopSlipBps
+func checkRouteHopSlip(evt *EventSwap, maxHopSlip cosmos.Uint) error {
+	if evt.SwapSlip.GT(maxHopSlip) {
+		return fmt.Errorf("swap slip %s of route hop %s exceeds limit %s", evt.SwapSlip, evt.Pool, maxHopSlip)
+	}
+	return nil
+}
+
 func (s *SwapperVCUR) swapOne(ctx cosmos.Context,
 	mgr Manager, tx common.Tx,
 	target common.Asset,
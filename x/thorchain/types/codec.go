@@ -56,7 +56,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgManageTHORName{}, ModuleName+"/MsgManageTHORName", nil)
 	cdc.RegisterConcrete(&MsgTradeAccountDeposit{}, ModuleName+"/MsgTradeAccountDeposit", nil)
 	cdc.RegisterConcrete(&MsgTradeAccountWithdrawal{}, ModuleName+"/MsgTradeAccountWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgTradeAccountTransfer{}, ModuleName+"/MsgTradeAccountTransfer", nil)
+	cdc.RegisterConcrete(&MsgRecurringSwap{}, ModuleName+"/MsgRecurringSwap", nil)
+	cdc.RegisterConcrete(&MsgRecurringSwapCancel{}, ModuleName+"/MsgRecurringSwapCancel", nil)
+	cdc.RegisterConcrete(&MsgLiquidBond{}, ModuleName+"/MsgLiquidBond", nil)
+	cdc.RegisterConcrete(&MsgLiquidUnbond{}, ModuleName+"/MsgLiquidUnbond", nil)
 	cdc.RegisterConcrete(&MsgModifyLimitSwap{}, ModuleName+"/MsgModifyLimitSwap", nil)
+	cdc.RegisterConcrete(&MsgModifyStreamingSwap{}, ModuleName+"/MsgModifyStreamingSwap", nil)
 	cdc.RegisterConcrete(&MsgSecuredAssetDeposit{}, ModuleName+"/MsgSecuredAssetDeposit", nil)
 	cdc.RegisterConcrete(&MsgSecuredAssetWithdraw{}, ModuleName+"/MsgSecuredAssetWithdraw", nil)
 	cdc.RegisterConcrete(&MsgTCYClaim{}, ModuleName+"/MsgTCYClaim", nil)
@@ -106,7 +112,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSolvencyQuorum{},
 		&MsgTradeAccountDeposit{},
 		&MsgTradeAccountWithdrawal{},
+		&MsgTradeAccountTransfer{},
+		&MsgRecurringSwap{},
+		&MsgRecurringSwapCancel{},
+		&MsgLiquidBond{},
+		&MsgLiquidUnbond{},
 		&MsgModifyLimitSwap{},
+		&MsgModifyStreamingSwap{},
 		&MsgSecuredAssetDeposit{},
 		&MsgSecuredAssetWithdraw{},
 		&MsgTCYClaim{},
@@ -124,6 +136,7 @@ func DefineCustomGetSigners(signingOptions *signing.Options) {
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgErrataTxQuorum"), MsgErrataTxQuorumCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgMimir"), MsgMimirCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgModifyLimitSwap"), MsgModifyLimitSwapCustomGetSigners)
+	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgModifyStreamingSwap"), MsgModifyStreamingSwapCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgNetworkFee"), MsgNetworkFeeCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgNetworkFeeQuorum"), MsgNetworkFeeQuorumCustomGetSigners)
 	signingOptions.DefineCustomGetSigners(protoreflect.FullName("types.MsgNodePauseChain"), MsgNodePauseChainCustomGetSigners)
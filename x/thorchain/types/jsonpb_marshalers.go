@@ -41,6 +41,7 @@ var (
 	_ jsonpb.JSONPBMarshaler = &QueryMimirWithKeyResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryNodeResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryNodesResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryObservationStatsResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryObservedTxVoter{}
 	_ jsonpb.JSONPBMarshaler = &QueryOutboundFeesResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryOutboundResponse{}
@@ -54,6 +55,8 @@ var (
 	_ jsonpb.JSONPBMarshaler = &QueryQuoteSaverWithdrawResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryQuoteSwapResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryRagnarokResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryRecurringSwapResponse{}
+	_ jsonpb.JSONPBMarshaler = &QueryRecurringSwapsResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryRuneProviderResponse{}
 	_ jsonpb.JSONPBMarshaler = &QueryRuneProvidersResponse{}
 	_ jsonpb.JSONPBMarshaler = &QuerySaverResponse{}
@@ -249,6 +252,11 @@ func (m *QueryNodesResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error)
 	return jsonify(m.Nodes)
 }
 
+// QueryObservationStatsResponse
+func (m *QueryObservationStatsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m)
+}
+
 // ObservedTxVoter
 func (m *QueryObservedTxVoter) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
 	return jsonify(m)
@@ -357,6 +365,15 @@ func (m *QueryStreamingSwapsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte
 	return jsonify(m.StreamingSwaps)
 }
 
+// QueryRecurringSwapResponse
+func (m *QueryRecurringSwapResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m)
+}
+
+func (m *QueryRecurringSwapsResponse) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
+	return jsonify(m.RecurringSwaps)
+}
+
 // SwapperClout
 func (m *SwapperClout) MarshalJSONPB(_ *jsonpb.Marshaler) ([]byte, error) {
 	return jsonify(m)
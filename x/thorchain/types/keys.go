@@ -23,6 +23,10 @@ const (
 	TCYClaimingName = "tcy_claim"
 	// TCYStakeName the name of the account used to track stake funds from $TCY
 	TCYStakeName = "tcy_stake"
+	// LiquidBondName the name of the virtual bond provider holding the bond backing liquid bond receipts
+	LiquidBondName = "liquid_bond"
+	// RecurringSwapName the name of the virtual trade accounts escrowing recurring swaps
+	RecurringSwapName = "recurring_swap"
 
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+var (
+	_ sdk.Msg              = &MsgLiquidBond{}
+	_ sdk.HasValidateBasic = &MsgLiquidBond{}
+	_ sdk.LegacyMsg        = &MsgLiquidBond{}
+
+	_ sdk.Msg              = &MsgLiquidUnbond{}
+	_ sdk.HasValidateBasic = &MsgLiquidUnbond{}
+	_ sdk.LegacyMsg        = &MsgLiquidUnbond{}
+)
+
+// NewMsgLiquidBond is a constructor function for MsgLiquidBond
+func NewMsgLiquidBond(tx common.Tx, nodeAddress cosmos.AccAddress, amount cosmos.Uint, signer cosmos.AccAddress) *MsgLiquidBond {
+	return &MsgLiquidBond{
+		Tx:          tx,
+		NodeAddress: nodeAddress,
+		Amount:      amount,
+		Signer:      signer,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgLiquidBond) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.NodeAddress.Empty() {
+		return cosmos.ErrInvalidAddress("node address cannot be empty")
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgLiquidBond) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgLiquidUnbond is a constructor function for MsgLiquidUnbond
+func NewMsgLiquidUnbond(tx common.Tx, nodeAddress cosmos.AccAddress, units cosmos.Uint, signer cosmos.AccAddress) *MsgLiquidUnbond {
+	return &MsgLiquidUnbond{
+		Tx:          tx,
+		NodeAddress: nodeAddress,
+		Units:       units,
+		Signer:      signer,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgLiquidUnbond) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.NodeAddress.Empty() {
+		return cosmos.ErrInvalidAddress("node address cannot be empty")
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgLiquidUnbond) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+type MsgLiquidBondSuite struct{}
+
+var _ = Suite(&MsgLiquidBondSuite{})
+
+func (MsgLiquidBondSuite) TestLiquidBond(c *C) {
+	nodeAddr := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: GetRandomTxHash()}
+
+	m := NewMsgLiquidBond(dummyTx, nodeAddr, cosmos.NewUint(100), signer)
+	EnsureMsgBasicCorrect(m, c)
+
+	m = NewMsgLiquidBond(dummyTx, cosmos.AccAddress{}, cosmos.NewUint(100), signer)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgLiquidBond(common.Tx{}, nodeAddr, cosmos.NewUint(100), signer)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	u := NewMsgLiquidUnbond(dummyTx, nodeAddr, cosmos.NewUint(100), signer)
+	EnsureMsgBasicCorrect(u, c)
+
+	u = NewMsgLiquidUnbond(dummyTx, cosmos.AccAddress{}, cosmos.NewUint(100), signer)
+	c.Check(u.ValidateBasic(), NotNil)
+	u = NewMsgLiquidUnbond(common.Tx{}, nodeAddr, cosmos.NewUint(100), signer)
+	c.Check(u.ValidateBasic(), NotNil)
+}
+
+func (MsgLiquidBondSuite) TestLiquidBondDenom(c *C) {
+	nodeAddr := GetRandomBech32Addr()
+	c.Check(GetLiquidBondDenom(nodeAddr), Equals, "x/lbond-"+nodeAddr.String())
+	c.Check(sdk.ValidateDenom(GetLiquidBondDenom(nodeAddr)), IsNil)
+	c.Check(GetLiquidBondAddress().Empty(), Equals, false)
+}
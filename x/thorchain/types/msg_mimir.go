@@ -26,6 +26,14 @@ func NewMsgMimir(key string, value int64, signer cosmos.AccAddress) *MsgMimir {
 	}
 }
 
+// NewMsgScheduledMimir is a constructor function for a MsgMimir taking effect at
+// the given block height
+func NewMsgScheduledMimir(key string, value, height int64, signer cosmos.AccAddress) *MsgMimir {
+	msg := NewMsgMimir(key, value, signer)
+	msg.Height = height
+	return msg
+}
+
 // ValidateBasic implements HasValidateBasic
 // ValidateBasic is now ran in the message service router handler for messages that
 // used to be routed using the external handler and only when HasValidateBasic is implemented.
@@ -37,6 +45,9 @@ func (m *MsgMimir) ValidateBasic() error {
 	if m.Signer.Empty() {
 		return cosmos.ErrInvalidAddress(m.Signer.String())
 	}
+	if m.Height < 0 {
+		return cosmos.ErrUnknownRequest("height cannot be negative")
+	}
 	return nil
 }
 
@@ -29,6 +29,8 @@ type MsgMimir struct {
 	Key    string                                        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value  int64                                         `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+	// the block height the value takes effect, 0 applies it immediately
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 }
 
 func (m *MsgMimir) Reset()         { *m = MsgMimir{} }
@@ -85,6 +87,13 @@ func (m *MsgMimir) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	return nil
 }
 
+func (m *MsgMimir) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgMimir)(nil), "types.MsgMimir")
 }
@@ -92,11 +101,11 @@ func init() {
 func init() { proto.RegisterFile("types/msg_mimir.proto", fileDescriptor_ee911c3b4d2b6772) }
 
 var fileDescriptor_ee911c3b4d2b6772 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
+	// 288 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2d, 0xa9, 0x2c, 0x48,
 	0x2d, 0xd6, 0xcf, 0x2d, 0x4e, 0x8f, 0xcf, 0xcd, 0xcc, 0xcd, 0x2c, 0xd2, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x62, 0x05, 0x0b, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45, 0xf4, 0x41, 0x2c,
-	0x88, 0xa4, 0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0x2d, 0x64,
+	0x88, 0xa4, 0x94, 0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x08, 0x29, 0xed, 0x64,
 	0xe4, 0xe2, 0xf0, 0x2d, 0x4e, 0xf7, 0x05, 0x19, 0x21, 0x24, 0xc0, 0xc5, 0x9c, 0x9d, 0x5a, 0x29,
 	0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0x62, 0x0a, 0x89, 0x70, 0xb1, 0x96, 0x25, 0xe6, 0x94,
 	0xa6, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0x30, 0x07, 0x41, 0x38, 0x42, 0x21, 0x5c, 0x6c, 0xc5, 0x99,
@@ -104,12 +113,13 @@ var fileDescriptor_ee911c3b4d2b6772 = []byte{
 	0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0xe7, 0x17, 0xe7, 0xe6,
 	0x17, 0x43, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0x7d, 0xb0, 0xab, 0xf4, 0x1c, 0x93, 0x93, 0x1d, 0x53,
 	0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x67, 0x3d, 0xdf, 0xa0, 0xc5, 0x96, 0x94, 0x9a, 0x9c, 0x61, 0x6c,
-	0x14, 0x04, 0x35, 0xcb, 0x4a, 0xbc, 0xeb, 0xf9, 0x06, 0x2d, 0xa1, 0x92, 0x8c, 0xfc, 0xa2, 0xe4,
-	0x8c, 0xc4, 0xcc, 0x3c, 0x7d, 0x98, 0xb3, 0x9c, 0x7c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0xca, 0x28, 0x3d, 0xb3, 0x24, 0x27, 0x11, 0x62, 0x29, 0x42, 0x23, 0x88, 0x95,
-	0x97, 0x9f, 0x92, 0xaa, 0x5f, 0x66, 0xac, 0x5f, 0x81, 0x2c, 0x0e, 0x72, 0x44, 0x12, 0x1b, 0xd8,
-	0xe3, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x16, 0x75, 0x1d, 0xee, 0x41, 0x01, 0x00, 0x00,
+	0x14, 0x04, 0x35, 0x4b, 0x48, 0x8c, 0x8b, 0x2d, 0x23, 0x35, 0x33, 0x3d, 0xa3, 0x44, 0x82, 0x05,
+	0x6c, 0x19, 0x94, 0x67, 0x25, 0xde, 0xf5, 0x7c, 0x83, 0x96, 0x50, 0x49, 0x46, 0x7e, 0x51, 0x72,
+	0x46, 0x62, 0x66, 0x9e, 0x3e, 0xcc, 0xb9, 0x4e, 0x3e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
+	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
+	0x2c, 0xc7, 0x10, 0x65, 0x94, 0x9e, 0x59, 0x92, 0x93, 0x08, 0x71, 0x0c, 0x42, 0x23, 0x88, 0x95,
+	0x97, 0x9f, 0x92, 0xaa, 0x5f, 0x66, 0xac, 0x5f, 0x81, 0x2c, 0x0e, 0x72, 0x5c, 0x12, 0x1b, 0x38,
+	0x40, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xcd, 0xba, 0xba, 0x59, 0x01, 0x00, 0x00,
 }
 
 func (m *MsgMimir) Marshal() (dAtA []byte, err error) {
@@ -132,6 +142,11 @@ func (m *MsgMimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintMsgMimir(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -182,6 +197,9 @@ func (m *MsgMimir) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgMimir(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovMsgMimir(uint64(m.Height))
+	}
 	return n
 }
 
@@ -305,6 +323,25 @@ func (m *MsgMimir) Unmarshal(dAtA []byte) error {
 				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgMimir(dAtA[iNdEx:])
@@ -25,4 +25,10 @@ func (MsgMimirSuite) TestMsgMimir(c *C) {
 	err1 := msg1.ValidateBasic()
 	c.Assert(err1, NotNil)
 	c.Assert(errors.Is(err1, se.ErrInvalidAddress), Equals, true)
+
+	scheduled := NewMsgScheduledMimir("key", 12, 100, addr)
+	c.Check(scheduled.ValidateBasic(), IsNil)
+	c.Check(scheduled.Height, Equals, int64(100))
+	scheduled.Height = -1
+	c.Check(scheduled.ValidateBasic(), NotNil)
 }
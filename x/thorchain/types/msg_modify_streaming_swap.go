@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.com/thorchain/thornode/v3/api/types"
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+var (
+	_ sdk.Msg              = &MsgModifyStreamingSwap{}
+	_ sdk.HasValidateBasic = &MsgModifyStreamingSwap{}
+	_ sdk.LegacyMsg        = &MsgModifyStreamingSwap{}
+)
+
+// NewMsgModifyStreamingSwap is a constructor function for MsgModifyStreamingSwap
+func NewMsgModifyStreamingSwap(from common.Address, txID common.TxID, interval, quantity uint64, signer cosmos.AccAddress) *MsgModifyStreamingSwap {
+	return &MsgModifyStreamingSwap{
+		From:     from,
+		TxID:     txID,
+		Interval: interval,
+		Quantity: quantity,
+		Signer:   signer,
+	}
+}
+
+// IsCancellation returns true when the message requests the streaming swap be
+// cancelled rather than modified
+func (m *MsgModifyStreamingSwap) IsCancellation() bool {
+	return m.Interval == 0 && m.Quantity == 0
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgModifyStreamingSwap) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.From.IsEmpty() {
+		return cosmos.ErrInvalidAddress("from address cannot be empty")
+	}
+	if m.TxID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("tx id cannot be empty")
+	}
+	if m.From.IsChain(common.THORChain) {
+		frm, err := m.From.AccAddress()
+		if err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+		if !frm.Equals(m.Signer) {
+			return cosmos.ErrUnknownRequest("from and signer address must match when from address is native")
+		}
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgModifyStreamingSwap) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+func MsgModifyStreamingSwapCustomGetSigners(m proto.Message) ([][]byte, error) {
+	msgModifyStreamingSwap, ok := m.(*types.MsgModifyStreamingSwap)
+	if !ok {
+		return nil, fmt.Errorf("can't cast as MsgModifyStreamingSwap: %T", m)
+	}
+	return [][]byte{msgModifyStreamingSwap.Signer}, nil
+}
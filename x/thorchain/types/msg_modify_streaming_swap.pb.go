@@ -0,0 +1,509 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/msg_modify_streaming_swap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	gitlab_com_thorchain_thornode_v3_common "gitlab.com/thorchain/thornode/v3/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgModifyStreamingSwap struct {
+	From     gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,1,opt,name=from,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"from,omitempty"`
+	TxID     gitlab_com_thorchain_thornode_v3_common.TxID    `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
+	Interval uint64                                          `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity uint64                                          `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Signer   github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgModifyStreamingSwap) Reset()         { *m = MsgModifyStreamingSwap{} }
+func (m *MsgModifyStreamingSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgModifyStreamingSwap) ProtoMessage()    {}
+func (*MsgModifyStreamingSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6559b7efd07f3ca0, []int{0}
+}
+func (m *MsgModifyStreamingSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModifyStreamingSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModifyStreamingSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgModifyStreamingSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModifyStreamingSwap.Merge(m, src)
+}
+func (m *MsgModifyStreamingSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModifyStreamingSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModifyStreamingSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModifyStreamingSwap proto.InternalMessageInfo
+
+func (m *MsgModifyStreamingSwap) GetFrom() gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgModifyStreamingSwap) GetTxID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *MsgModifyStreamingSwap) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgModifyStreamingSwap) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *MsgModifyStreamingSwap) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgModifyStreamingSwap)(nil), "types.MsgModifyStreamingSwap")
+}
+
+func init() {
+	proto.RegisterFile("types/msg_modify_streaming_swap.proto", fileDescriptor_6559b7efd07f3ca0)
+}
+
+var fileDescriptor_6559b7efd07f3ca0 = []byte{
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2d, 0xa9, 0x2c, 0x48,
+	0x2d, 0xd6, 0xcf, 0x2d, 0x4e, 0x8f, 0xcf, 0xcd, 0x4f, 0xc9, 0x4c, 0xab, 0x8c, 0x2f, 0x2e, 0x29,
+	0x4a, 0x4d, 0xcc, 0xcd, 0xcc, 0x4b, 0x8f, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x62, 0x05, 0x2b, 0x93, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x8b, 0xe8, 0x83, 0x58,
+	0x10, 0x49, 0x29, 0x41, 0x90, 0xf2, 0x7c, 0x7d, 0x30, 0x09, 0x15, 0x12, 0x4f, 0xce, 0x2f, 0xce,
+	0xcd, 0x07, 0x9b, 0xab, 0x5f, 0x66, 0x08, 0xa2, 0x20, 0x12, 0x4a, 0x1f, 0x98, 0xb8, 0xc4, 0x7c,
+	0x8b, 0xd3, 0x7d, 0xc1, 0x76, 0x05, 0xc3, 0xac, 0x0a, 0x2e, 0x4f, 0x2c, 0x10, 0x72, 0xe7, 0x62,
+	0x49, 0x2b, 0xca, 0xcf, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x32, 0xfe, 0x75, 0x4f, 0x5e,
+	0x3f, 0x3d, 0xb3, 0x24, 0x27, 0x31, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x24, 0x23, 0xbf, 0x28,
+	0x39, 0x23, 0x31, 0x33, 0x0f, 0xcc, 0xca, 0xcb, 0x4f, 0x49, 0xd5, 0x2f, 0x33, 0xd6, 0x4f, 0xce,
+	0xcf, 0xcd, 0xcd, 0xcf, 0xd3, 0x73, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x02, 0x1b, 0x20,
+	0xe4, 0xcb, 0xc5, 0x5a, 0x52, 0x11, 0x9f, 0x99, 0x22, 0xc1, 0x04, 0x36, 0xc9, 0xe2, 0xd1, 0x3d,
+	0x79, 0x96, 0x90, 0x0a, 0x4f, 0x97, 0x5f, 0xf7, 0xe4, 0x75, 0x88, 0x35, 0x11, 0xa4, 0x3e, 0x88,
+	0xa5, 0xa4, 0xc2, 0x33, 0x45, 0x48, 0x8a, 0x8b, 0x23, 0x33, 0xaf, 0x24, 0xb5, 0xa8, 0x2c, 0x31,
+	0x47, 0x82, 0x59, 0x81, 0x51, 0x83, 0x25, 0x08, 0xce, 0x07, 0xc9, 0x15, 0x96, 0x26, 0xe6, 0x95,
+	0x64, 0x96, 0x54, 0x4a, 0xb0, 0x40, 0xe4, 0x60, 0x7c, 0xa1, 0x10, 0x2e, 0xb6, 0xe2, 0xcc, 0xf4,
+	0xbc, 0xd4, 0x22, 0x09, 0x56, 0x05, 0x46, 0x0d, 0x1e, 0x27, 0x9b, 0x5f, 0xf7, 0xe4, 0x75, 0xd3,
+	0x33, 0x4b, 0x32, 0x4a, 0x21, 0xf6, 0x43, 0x83, 0x08, 0x42, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x83,
+	0x03, 0x59, 0xcf, 0x31, 0x39, 0x19, 0xea, 0x9f, 0x59, 0xcf, 0x37, 0x68, 0xb1, 0x25, 0xa5, 0x26,
+	0x67, 0x18, 0x1b, 0x05, 0x41, 0xcd, 0xb2, 0x32, 0x68, 0x02, 0x09, 0x42, 0x38, 0x5d, 0xcf, 0x37,
+	0x68, 0x29, 0x20, 0xbc, 0x81, 0x3d, 0x5c, 0x9d, 0x7c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
+	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
+	0x58, 0x8e, 0x21, 0xca, 0x88, 0x60, 0x68, 0x54, 0x20, 0x8b, 0x83, 0x5c, 0x97, 0xc4, 0x06, 0x8e,
+	0x47, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xdd, 0x2c, 0x07, 0x39, 0x02, 0x00, 0x00,
+}
+
+func (m *MsgModifyStreamingSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModifyStreamingSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModifyStreamingSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgModifyStreamingSwap(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintMsgModifyStreamingSwap(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintMsgModifyStreamingSwap(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintMsgModifyStreamingSwap(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintMsgModifyStreamingSwap(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgModifyStreamingSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgModifyStreamingSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgModifyStreamingSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovMsgModifyStreamingSwap(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovMsgModifyStreamingSwap(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovMsgModifyStreamingSwap(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovMsgModifyStreamingSwap(uint64(m.Quantity))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgModifyStreamingSwap(uint64(l))
+	}
+	return n
+}
+
+func sovMsgModifyStreamingSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgModifyStreamingSwap(x uint64) (n int) {
+	return sovMsgModifyStreamingSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgModifyStreamingSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgModifyStreamingSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyStreamingSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyStreamingSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgModifyStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgModifyStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgModifyStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgModifyStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgModifyStreamingSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgModifyStreamingSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgModifyStreamingSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgModifyStreamingSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgModifyStreamingSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgModifyStreamingSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgModifyStreamingSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgModifyStreamingSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgModifyStreamingSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgModifyStreamingSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgModifyStreamingSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgModifyStreamingSwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,55 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+type MsgModifyStreamingSwapSuite struct{}
+
+var _ = Suite(&MsgModifyStreamingSwapSuite{})
+
+func (MsgModifyStreamingSwapSuite) TestMsgModifyStreamingSwapValidateBasic(c *C) {
+	SetupConfigForTest()
+
+	from := GetRandomBTCAddress()
+	txID := GetRandomTxHash()
+	signer := GetRandomBech32Addr()
+
+	msg := NewMsgModifyStreamingSwap(from, txID, 5, 10, signer)
+	c.Check(msg.From.Equals(from), Equals, true)
+	c.Check(msg.TxID.Equals(txID), Equals, true)
+	c.Check(msg.Interval, Equals, uint64(5))
+	c.Check(msg.Quantity, Equals, uint64(10))
+	c.Check(msg.IsCancellation(), Equals, false)
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Check(msg.GetSigners(), DeepEquals, []cosmos.AccAddress{signer})
+
+	// zero interval and quantity is a cancellation
+	msg = NewMsgModifyStreamingSwap(from, txID, 0, 0, signer)
+	c.Check(msg.IsCancellation(), Equals, true)
+	c.Assert(msg.ValidateBasic(), IsNil)
+
+	// empty signer
+	msg = NewMsgModifyStreamingSwap(from, txID, 5, 10, cosmos.AccAddress{})
+	c.Assert(msg.ValidateBasic(), NotNil)
+
+	// empty from
+	msg = NewMsgModifyStreamingSwap(common.NoAddress, txID, 5, 10, signer)
+	c.Assert(msg.ValidateBasic(), NotNil)
+
+	// empty tx id
+	msg = NewMsgModifyStreamingSwap(from, common.TxID(""), 5, 10, signer)
+	c.Assert(msg.ValidateBasic(), NotNil)
+
+	// native from address must match the signer
+	thorAddr := GetRandomTHORAddress()
+	msg = NewMsgModifyStreamingSwap(thorAddr, txID, 5, 10, signer)
+	c.Assert(msg.ValidateBasic(), NotNil)
+	thorSigner, err := thorAddr.AccAddress()
+	c.Assert(err, IsNil)
+	msg = NewMsgModifyStreamingSwap(thorAddr, txID, 5, 10, thorSigner)
+	c.Assert(msg.ValidateBasic(), IsNil)
+}
@@ -47,6 +47,9 @@ func (m *MsgObservedTxQuorum) ValidateBasic() error {
 		if !tx.Tx.ToAddress.Equals(obAddr) {
 			return cosmos.ErrUnknownRequest("request is not an inbound observed transaction")
 		}
+		if len(tx.Outputs) > 0 {
+			return cosmos.ErrUnknownRequest("outputs must be empty")
+		}
 	} else {
 		if !tx.Tx.FromAddress.Equals(obAddr) {
 			return cosmos.ErrUnknownRequest("request is not an outbound observed transaction")
@@ -48,6 +48,9 @@ func (m *MsgObservedTxIn) ValidateBasic() error {
 		if !tx.Tx.ToAddress.Equals(obAddr) {
 			return cosmos.ErrUnknownRequest("request is not an inbound observed transaction")
 		}
+		if len(tx.Outputs) > 0 {
+			return cosmos.ErrUnknownRequest("outputs must be empty")
+		}
 		if len(tx.Signers) > 0 {
 			return cosmos.ErrUnknownRequest("signers must be empty")
 		}
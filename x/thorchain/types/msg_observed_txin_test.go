@@ -66,4 +66,14 @@ func (s *MsgObservedTxInSuite) TestMsgObservedTxIn(c *C) {
 	err7 := m7.ValidateBasic()
 	c.Assert(err7, NotNil)
 	c.Assert(errors.Is(err4, se.ErrUnknownRequest), Equals, true)
+
+	// only outbounds can be batched
+	tx8 := common.NewObservedTx(GetRandomTx(), 1, pk, 1)
+	tx8.Tx.ToAddress, err = pk.GetAddress(tx8.Tx.Coins[0].Asset.Chain)
+	c.Assert(err, IsNil)
+	c.Assert(NewMsgObservedTxIn(common.ObservedTxs{tx8}, acc).ValidateBasic(), IsNil)
+	tx8.Outputs = []common.TxOutput{common.NewTxOutput(GetRandomTHORAddress(), tx8.Tx.Coins)}
+	err8 := NewMsgObservedTxIn(common.ObservedTxs{tx8}, acc).ValidateBasic()
+	c.Assert(err8, NotNil)
+	c.Assert(errors.Is(err8, se.ErrUnknownRequest), Equals, true)
 }
@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+var (
+	_ sdk.Msg              = &MsgRecurringSwap{}
+	_ sdk.HasValidateBasic = &MsgRecurringSwap{}
+	_ sdk.LegacyMsg        = &MsgRecurringSwap{}
+
+	_ sdk.Msg              = &MsgRecurringSwapCancel{}
+	_ sdk.HasValidateBasic = &MsgRecurringSwapCancel{}
+	_ sdk.LegacyMsg        = &MsgRecurringSwapCancel{}
+)
+
+// NewMsgRecurringSwap is a constructor function for MsgRecurringSwap
+func NewMsgRecurringSwap(tx common.Tx, source, target common.Asset, destination common.Address, amount cosmos.Uint, interval, quantity uint64, signer cosmos.AccAddress) *MsgRecurringSwap {
+	return &MsgRecurringSwap{
+		Tx:          tx,
+		SourceAsset: source,
+		TargetAsset: target,
+		Destination: destination,
+		Amount:      amount,
+		Interval:    interval,
+		Quantity:    quantity,
+		Signer:      signer,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgRecurringSwap) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	if !m.SourceAsset.IsTradeAsset() {
+		return cosmos.ErrUnknownRequest("source asset must be a trade asset")
+	}
+	if m.TargetAsset.IsEmpty() {
+		return cosmos.ErrUnknownRequest("target asset cannot be empty")
+	}
+	if m.TargetAsset.IsSyntheticAsset() || m.TargetAsset.IsSecuredAsset() || m.TargetAsset.IsDerivedAsset() {
+		return cosmos.ErrUnknownRequest("target asset must be a trade asset, RUNE or a layer 1 asset")
+	}
+	if m.TargetAsset.Equals(m.SourceAsset) || m.TargetAsset.Equals(m.SourceAsset.GetLayer1Asset()) {
+		return cosmos.ErrUnknownRequest("source and target asset cannot be the same")
+	}
+	if m.Destination.IsEmpty() {
+		return cosmos.ErrUnknownRequest("destination cannot be empty")
+	}
+	if (m.TargetAsset.IsTradeAsset() || m.TargetAsset.IsRune()) && !m.Destination.IsChain(common.THORChain) {
+		return cosmos.ErrUnknownRequest("destination must be a THORChain address")
+	}
+	if m.Amount.IsZero() {
+		return cosmos.ErrUnknownRequest("amount cannot be zero")
+	}
+	if m.Interval == 0 {
+		return cosmos.ErrUnknownRequest("interval cannot be zero")
+	}
+	if m.Quantity == 0 {
+		return cosmos.ErrUnknownRequest("quantity cannot be zero")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgRecurringSwap) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
+
+// NewMsgRecurringSwapCancel is a constructor function for MsgRecurringSwapCancel
+func NewMsgRecurringSwapCancel(tx common.Tx, txID common.TxID, signer cosmos.AccAddress) *MsgRecurringSwapCancel {
+	return &MsgRecurringSwapCancel{
+		Tx:     tx,
+		TxID:   txID,
+		Signer: signer,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgRecurringSwapCancel) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.TxID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("recurring swap tx id cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgRecurringSwapCancel) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
@@ -0,0 +1,929 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/msg_recurring_swap.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	common "gitlab.com/thorchain/thornode/v3/common"
+	gitlab_com_thorchain_thornode_v3_common "gitlab.com/thorchain/thornode/v3/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgRecurringSwap struct {
+	Tx          common.Tx                                       `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	SourceAsset gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,2,opt,name=source_asset,json=sourceAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"source_asset"`
+	TargetAsset gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,3,opt,name=target_asset,json=targetAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"target_asset"`
+	Destination gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,4,opt,name=destination,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"destination,omitempty"`
+	Amount      cosmossdk_io_math.Uint                          `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	Interval    uint64                                          `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity    uint64                                          `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Signer      github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,8,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgRecurringSwap) Reset()         { *m = MsgRecurringSwap{} }
+func (m *MsgRecurringSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgRecurringSwap) ProtoMessage()    {}
+func (*MsgRecurringSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_070483cc5c9cbc16, []int{0}
+}
+func (m *MsgRecurringSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecurringSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecurringSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecurringSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecurringSwap.Merge(m, src)
+}
+func (m *MsgRecurringSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecurringSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecurringSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecurringSwap proto.InternalMessageInfo
+
+func (m *MsgRecurringSwap) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgRecurringSwap) GetDestination() gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *MsgRecurringSwap) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgRecurringSwap) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *MsgRecurringSwap) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgRecurringSwapCancel struct {
+	Tx     common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	TxID   gitlab_com_thorchain_thornode_v3_common.TxID  `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgRecurringSwapCancel) Reset()         { *m = MsgRecurringSwapCancel{} }
+func (m *MsgRecurringSwapCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgRecurringSwapCancel) ProtoMessage()    {}
+func (*MsgRecurringSwapCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_070483cc5c9cbc16, []int{1}
+}
+func (m *MsgRecurringSwapCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecurringSwapCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecurringSwapCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecurringSwapCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecurringSwapCancel.Merge(m, src)
+}
+func (m *MsgRecurringSwapCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecurringSwapCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecurringSwapCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecurringSwapCancel proto.InternalMessageInfo
+
+func (m *MsgRecurringSwapCancel) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgRecurringSwapCancel) GetTxID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *MsgRecurringSwapCancel) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgRecurringSwap)(nil), "types.MsgRecurringSwap")
+	proto.RegisterType((*MsgRecurringSwapCancel)(nil), "types.MsgRecurringSwapCancel")
+}
+
+func init() { proto.RegisterFile("types/msg_recurring_swap.proto", fileDescriptor_070483cc5c9cbc16) }
+
+var fileDescriptor_070483cc5c9cbc16 = []byte{
+	// 460 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x53, 0x27, 0xb4, 0x97, 0x22, 0x21, 0x83, 0x2a, 0x2b, 0x83, 0x6d, 0x75, 0xca, 0x40,
+	0x7d, 0xa2, 0x11, 0x88, 0xb5, 0x86, 0x25, 0x12, 0x5d, 0x4c, 0xbb, 0xb0, 0x44, 0x57, 0xfb, 0x74,
+	0x39, 0x35, 0xbe, 0x0b, 0x77, 0xcf, 0xad, 0xfb, 0x2f, 0x18, 0xf9, 0x49, 0x1d, 0x33, 0x30, 0x20,
+	0x06, 0x0b, 0x25, 0xff, 0x22, 0x13, 0xf2, 0x9d, 0x83, 0x22, 0x16, 0x22, 0xc1, 0x74, 0xef, 0xbd,
+	0xef, 0xf3, 0xf7, 0xbd, 0xf7, 0xf4, 0x8c, 0x02, 0x78, 0x58, 0x50, 0x8d, 0x0b, 0xcd, 0xa6, 0x8a,
+	0x66, 0xa5, 0x52, 0x5c, 0xb0, 0xa9, 0xbe, 0x27, 0x8b, 0x78, 0xa1, 0x24, 0x48, 0xaf, 0x67, 0xf0,
+	0xe1, 0xf3, 0x4c, 0x16, 0x85, 0x14, 0xd8, 0x3e, 0x16, 0x1b, 0xbe, 0x60, 0x92, 0x49, 0x13, 0xe2,
+	0x26, 0xb2, 0xd5, 0xd3, 0xaf, 0x2e, 0x7a, 0x76, 0xa9, 0x59, 0xba, 0x55, 0xfb, 0x78, 0x4f, 0x16,
+	0x5e, 0x84, 0xba, 0x50, 0xf9, 0x4e, 0xe4, 0x8c, 0x06, 0xe7, 0x28, 0x6e, 0x55, 0xae, 0xaa, 0xc4,
+	0x7d, 0xac, 0xc3, 0x4e, 0xda, 0x85, 0xca, 0x63, 0xe8, 0x58, 0xcb, 0x52, 0x65, 0x74, 0x4a, 0xb4,
+	0xa6, 0xe0, 0x77, 0x0d, 0xf7, 0xe9, 0x96, 0x7b, 0xd1, 0x14, 0x93, 0xd7, 0x0d, 0xfd, 0x47, 0x1d,
+	0x9e, 0x31, 0x0e, 0x73, 0x72, 0xd3, 0x80, 0x18, 0x66, 0x52, 0x65, 0x33, 0xc2, 0x85, 0x89, 0x84,
+	0xcc, 0x29, 0xbe, 0x1b, 0xe3, 0xdd, 0xcf, 0xd2, 0x81, 0x55, 0x36, 0x49, 0x63, 0x04, 0x44, 0x31,
+	0x0a, 0xad, 0xd1, 0xc1, 0xff, 0x34, 0xb2, 0xca, 0xd6, 0xe8, 0x1a, 0x0d, 0x72, 0xaa, 0x81, 0x0b,
+	0x02, 0x5c, 0x0a, 0xdf, 0x8d, 0x9c, 0xd1, 0x51, 0x32, 0xde, 0xd4, 0x21, 0xde, 0x5b, 0x34, 0xcf,
+	0x15, 0xd5, 0x3a, 0xdd, 0xd5, 0xf1, 0xde, 0xa0, 0x3e, 0x29, 0x64, 0x29, 0xc0, 0xef, 0x19, 0xc5,
+	0xa0, 0x6d, 0xf5, 0x24, 0x93, 0xba, 0x90, 0x5a, 0xe7, 0xb7, 0x31, 0x97, 0xb8, 0x20, 0x30, 0x8b,
+	0xaf, 0xb9, 0x80, 0xb4, 0x65, 0x7b, 0x43, 0x74, 0xc8, 0x05, 0x50, 0x75, 0x47, 0xe6, 0x7e, 0x3f,
+	0x72, 0x46, 0x6e, 0xfa, 0x3b, 0x6f, 0xb0, 0xcf, 0x25, 0x11, 0xc0, 0xe1, 0xc1, 0x7f, 0x62, 0xb1,
+	0x6d, 0xee, 0x4d, 0x50, 0x5f, 0x73, 0x26, 0xa8, 0xf2, 0x0f, 0x23, 0x67, 0x74, 0x9c, 0xbc, 0xda,
+	0xd8, 0xb5, 0xcc, 0x4a, 0x3b, 0x81, 0xb5, 0x6d, 0x9f, 0x33, 0x9d, 0xdf, 0x62, 0x73, 0x32, 0xf1,
+	0x45, 0x96, 0x6d, 0xfb, 0x6f, 0x05, 0x4e, 0xbf, 0x39, 0xe8, 0xe4, 0xcf, 0xd3, 0x78, 0x47, 0x44,
+	0x46, 0xe7, 0x7b, 0x1c, 0xc8, 0x25, 0xea, 0x41, 0x35, 0xe5, 0xb9, 0xb9, 0x8c, 0xa3, 0xe4, 0xed,
+	0xaa, 0x0e, 0xdd, 0xab, 0x6a, 0xf2, 0x7e, 0x53, 0x87, 0x2f, 0xf7, 0x5d, 0x68, 0xc3, 0x4f, 0x5d,
+	0xa8, 0x26, 0xf9, 0xce, 0x58, 0x07, 0xff, 0x38, 0x56, 0xf2, 0xe1, 0x71, 0x15, 0x38, 0xcb, 0x55,
+	0xe0, 0xfc, 0x5c, 0x05, 0xce, 0x97, 0x75, 0xd0, 0x59, 0xae, 0x83, 0xce, 0xf7, 0x75, 0xd0, 0xf9,
+	0x74, 0xfe, 0xd7, 0xc6, 0xaa, 0xdd, 0x7a, 0x63, 0x70, 0xd3, 0x37, 0xbf, 0xd1, 0xf8, 0x57, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0xa7, 0xf4, 0x0e, 0x14, 0x9a, 0x03, 0x00, 0x00,
+}
+
+func (m *MsgRecurringSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecurringSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecurringSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Interval != 0 {
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.TargetAsset.Size()
+		i -= size
+		if _, err := m.TargetAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SourceAsset.Size()
+		i -= size
+		if _, err := m.SourceAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecurringSwapCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecurringSwapCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecurringSwapCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgRecurringSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgRecurringSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRecurringSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	l = m.SourceAsset.Size()
+	n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	l = m.TargetAsset.Size()
+	n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovMsgRecurringSwap(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovMsgRecurringSwap(uint64(m.Quantity))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecurringSwapCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgRecurringSwap(uint64(l))
+	}
+	return n
+}
+
+func sovMsgRecurringSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgRecurringSwap(x uint64) (n int) {
+	return sovMsgRecurringSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRecurringSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecurringSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecurringSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecurringSwapCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecurringSwapCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecurringSwapCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgRecurringSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgRecurringSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgRecurringSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgRecurringSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgRecurringSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgRecurringSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgRecurringSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgRecurringSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgRecurringSwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+type MsgRecurringSwapSuite struct{}
+
+var _ = Suite(&MsgRecurringSwapSuite{})
+
+func (MsgRecurringSwapSuite) TestRecurringSwap(c *C) {
+	source := common.ETHAsset.GetTradeAsset()
+	target := common.BTCAsset.GetTradeAsset()
+	amt := cosmos.NewUint(100)
+	signer := GetRandomBech32Addr()
+	dest := GetRandomTHORAddress()
+	dummyTx := common.Tx{ID: GetRandomTxHash()}
+
+	m := NewMsgRecurringSwap(dummyTx, source, target, dest, amt, 10, 5, signer)
+	EnsureMsgBasicCorrect(m, c)
+
+	// swap out to a layer 1 destination
+	m = NewMsgRecurringSwap(dummyTx, source, common.BTCAsset, GetRandomBTCAddress(), amt, 10, 5, signer)
+	c.Check(m.ValidateBasic(), IsNil)
+
+	// source must be a trade asset
+	m = NewMsgRecurringSwap(dummyTx, common.ETHAsset, target, dest, amt, 10, 5, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// cannot swap into the same asset
+	m = NewMsgRecurringSwap(dummyTx, source, common.ETHAsset, GetRandomETHAddress(), amt, 10, 5, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// trade asset output must stay on THORChain
+	m = NewMsgRecurringSwap(dummyTx, source, target, GetRandomBTCAddress(), amt, 10, 5, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgRecurringSwap(dummyTx, source, target, common.NoAddress, amt, 10, 5, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgRecurringSwap(dummyTx, source, target, dest, cosmos.ZeroUint(), 10, 5, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgRecurringSwap(dummyTx, source, target, dest, amt, 0, 5, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgRecurringSwap(dummyTx, source, target, dest, amt, 10, 0, signer)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgRecurringSwap(dummyTx, source, target, dest, amt, 10, 5, cosmos.AccAddress{})
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgRecurringSwapSuite) TestRecurringSwapCancel(c *C) {
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: GetRandomTxHash()}
+
+	m := NewMsgRecurringSwapCancel(dummyTx, GetRandomTxHash(), signer)
+	EnsureMsgBasicCorrect(m, c)
+
+	m = NewMsgRecurringSwapCancel(dummyTx, common.TxID(""), signer)
+	c.Check(m.ValidateBasic(), NotNil)
+	m = NewMsgRecurringSwapCancel(dummyTx, GetRandomTxHash(), cosmos.AccAddress{})
+	c.Check(m.ValidateBasic(), NotNil)
+}
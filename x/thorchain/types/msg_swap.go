@@ -41,6 +41,35 @@ func (m *MsgSwap) IsStreaming() bool {
 	return m.StreamInterval > 0
 }
 
+// IsTriggerOrder returns true for stop-loss and take-profit orders, which rest
+// in the advanced swap queue until the pool price crosses their trigger
+func (m *MsgSwap) IsTriggerOrder() bool {
+	return m.SwapType == SwapType_stop_loss || m.SwapType == SwapType_take_profit
+}
+
+// HasSplitDestinations returns true when the swap output is paid to several
+// recipients in the proportions of DestinationsBasisPoints
+func (m *MsgSwap) HasSplitDestinations() bool {
+	return len(m.Destinations) > 1
+}
+
+// GetSplitDestinationAmounts divides the given swap output between the split
+// destinations by their basis points, any rounding remainder goes to the last
+// recipient so that the amounts always add up to the total
+func (m *MsgSwap) GetSplitDestinationAmounts(total cosmos.Uint) []cosmos.Uint {
+	amounts := make([]cosmos.Uint, len(m.Destinations))
+	remaining := total
+	for i := range m.Destinations {
+		if i == len(m.Destinations)-1 {
+			amounts[i] = remaining
+			break
+		}
+		amounts[i] = common.GetSafeShare(m.DestinationsBasisPoints[i], cosmos.NewUint(10000), total)
+		remaining = common.SafeSub(remaining, amounts[i])
+	}
+	return amounts
+}
+
 func (m *MsgSwap) GetStreamingSwap() StreamingSwap {
 	return NewStreamingSwap(
 		m.Tx.ID,
@@ -95,6 +124,84 @@ func (m *MsgSwap) ValidateBasic() error {
 	if len(m.AggregatorTargetAddress) > 0 && len(m.Aggregator) == 0 {
 		return cosmos.ErrUnknownRequest("aggregator is empty")
 	}
+	if m.IsTriggerOrder() && m.TradeTarget.IsZero() {
+		return cosmos.ErrUnknownRequest("trigger cannot be zero for stop-loss and take-profit orders")
+	}
+	if m.Expiry < 0 {
+		return cosmos.ErrUnknownRequest("expiry cannot be negative")
+	}
+	if len(m.Destinations) > 0 {
+		if err := m.validateSplitDestinations(); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+	}
+	if len(m.Route) > 0 {
+		if err := m.validateRoute(); err != nil {
+			return cosmos.ErrUnknownRequest(err.Error())
+		}
+	}
+	return nil
+}
+
+func (m *MsgSwap) validateRoute() error {
+	if len(m.Aggregator) > 0 {
+		return fmt.Errorf("swap route cannot be used with an aggregator")
+	}
+	seen := []common.Asset{m.Tx.Coins[0].Asset, m.TargetAsset}
+	for _, hop := range m.Route {
+		if hop.IsEmpty() {
+			return fmt.Errorf("swap route asset cannot be empty")
+		}
+		if hop.IsRune() {
+			return fmt.Errorf("swap route cannot include RUNE, it is part of every route")
+		}
+		if hop.IsSyntheticAsset() {
+			return fmt.Errorf("swap route cannot include synthetic asset %s", hop)
+		}
+		for _, asset := range seen {
+			if hop.Equals(asset) {
+				return fmt.Errorf("swap route asset %s is repeated", hop)
+			}
+		}
+		seen = append(seen, hop)
+	}
+	return nil
+}
+
+func (m *MsgSwap) validateSplitDestinations() error {
+	if len(m.Destinations) < 2 {
+		return fmt.Errorf("split destinations must have at least two recipients")
+	}
+	if len(m.Destinations) != len(m.DestinationsBasisPoints) {
+		return fmt.Errorf("split destinations and basis points count mismatch")
+	}
+	if !m.Destinations[0].Equals(m.Destination) {
+		return fmt.Errorf("swap destination must be the first split destination")
+	}
+	if len(m.Aggregator) > 0 {
+		return fmt.Errorf("split destinations cannot be used with an aggregator")
+	}
+	total := cosmos.ZeroUint()
+	for i, dest := range m.Destinations {
+		if dest.IsEmpty() || dest.IsNoop() {
+			return fmt.Errorf("split destination %d is empty", i)
+		}
+		if !dest.IsChain(m.TargetAsset.GetChain()) {
+			return fmt.Errorf("split destination %s is not the same chain as the target asset", dest)
+		}
+		for _, prev := range m.Destinations[:i] {
+			if prev.Equals(dest) {
+				return fmt.Errorf("split destination %s is duplicated", dest)
+			}
+		}
+		if m.DestinationsBasisPoints[i].IsZero() {
+			return fmt.Errorf("split destination %s basis points cannot be zero", dest)
+		}
+		total = total.Add(m.DestinationsBasisPoints[i])
+	}
+	if !total.Equal(cosmos.NewUint(10000)) {
+		return fmt.Errorf("split destination basis points must add up to 10000, got %s", total)
+	}
 	return nil
 }
 
@@ -30,18 +30,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type SwapType int32
 
 const (
-	SwapType_market SwapType = 0
-	SwapType_limit  SwapType = 1
+	SwapType_market      SwapType = 0
+	SwapType_limit       SwapType = 1
+	SwapType_stop_loss   SwapType = 2
+	SwapType_take_profit SwapType = 3
 )
 
 var SwapType_name = map[int32]string{
 	0: "market",
 	1: "limit",
+	2: "stop_loss",
+	3: "take_profit",
 }
 
 var SwapType_value = map[string]int32{
-	"market": 0,
-	"limit":  1,
+	"market":      0,
+	"limit":       1,
+	"stop_loss":   2,
+	"take_profit": 3,
 }
 
 func (x SwapType) String() string {
@@ -53,19 +59,23 @@ func (SwapType) EnumDescriptor() ([]byte, []int) {
 }
 
 type MsgSwap struct {
-	Tx                      common.Tx                                       `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
-	TargetAsset             gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,2,opt,name=target_asset,json=targetAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"target_asset"`
-	Destination             gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,3,opt,name=destination,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"destination,omitempty"`
-	TradeTarget             cosmossdk_io_math.Uint                          `protobuf:"bytes,4,opt,name=trade_target,json=tradeTarget,proto3,customtype=cosmossdk.io/math.Uint" json:"trade_target"`
-	AffiliateAddress        gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,5,opt,name=affiliate_address,json=affiliateAddress,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"affiliate_address,omitempty"`
-	AffiliateBasisPoints    cosmossdk_io_math.Uint                          `protobuf:"bytes,6,opt,name=affiliate_basis_points,json=affiliateBasisPoints,proto3,customtype=cosmossdk.io/math.Uint" json:"affiliate_basis_points"`
-	Signer                  github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,7,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
-	Aggregator              string                                          `protobuf:"bytes,8,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
-	AggregatorTargetAddress string                                          `protobuf:"bytes,9,opt,name=aggregator_target_address,json=aggregatorTargetAddress,proto3" json:"aggregator_target_address,omitempty"`
-	AggregatorTargetLimit   *cosmossdk_io_math.Uint                         `protobuf:"bytes,10,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3,customtype=cosmossdk.io/math.Uint" json:"aggregator_target_limit,omitempty"`
-	SwapType                SwapType                                        `protobuf:"varint,11,opt,name=swap_type,json=swapType,proto3,enum=types.SwapType" json:"swap_type,omitempty"`
-	StreamQuantity          uint64                                          `protobuf:"varint,12,opt,name=stream_quantity,json=streamQuantity,proto3" json:"stream_quantity,omitempty"`
-	StreamInterval          uint64                                          `protobuf:"varint,13,opt,name=stream_interval,json=streamInterval,proto3" json:"stream_interval,omitempty"`
+	Tx                      common.Tx                                         `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	TargetAsset             gitlab_com_thorchain_thornode_v3_common.Asset     `protobuf:"bytes,2,opt,name=target_asset,json=targetAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"target_asset"`
+	Destination             gitlab_com_thorchain_thornode_v3_common.Address   `protobuf:"bytes,3,opt,name=destination,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"destination,omitempty"`
+	TradeTarget             cosmossdk_io_math.Uint                            `protobuf:"bytes,4,opt,name=trade_target,json=tradeTarget,proto3,customtype=cosmossdk.io/math.Uint" json:"trade_target"`
+	AffiliateAddress        gitlab_com_thorchain_thornode_v3_common.Address   `protobuf:"bytes,5,opt,name=affiliate_address,json=affiliateAddress,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"affiliate_address,omitempty"`
+	AffiliateBasisPoints    cosmossdk_io_math.Uint                            `protobuf:"bytes,6,opt,name=affiliate_basis_points,json=affiliateBasisPoints,proto3,customtype=cosmossdk.io/math.Uint" json:"affiliate_basis_points"`
+	Signer                  github_com_cosmos_cosmos_sdk_types.AccAddress     `protobuf:"bytes,7,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+	Aggregator              string                                            `protobuf:"bytes,8,opt,name=aggregator,proto3" json:"aggregator,omitempty"`
+	AggregatorTargetAddress string                                            `protobuf:"bytes,9,opt,name=aggregator_target_address,json=aggregatorTargetAddress,proto3" json:"aggregator_target_address,omitempty"`
+	AggregatorTargetLimit   *cosmossdk_io_math.Uint                           `protobuf:"bytes,10,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3,customtype=cosmossdk.io/math.Uint" json:"aggregator_target_limit,omitempty"`
+	SwapType                SwapType                                          `protobuf:"varint,11,opt,name=swap_type,json=swapType,proto3,enum=types.SwapType" json:"swap_type,omitempty"`
+	StreamQuantity          uint64                                            `protobuf:"varint,12,opt,name=stream_quantity,json=streamQuantity,proto3" json:"stream_quantity,omitempty"`
+	StreamInterval          uint64                                            `protobuf:"varint,13,opt,name=stream_interval,json=streamInterval,proto3" json:"stream_interval,omitempty"`
+	Expiry                  int64                                             `protobuf:"varint,14,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Destinations            []gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,15,rep,name=destinations,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"destinations,omitempty"`
+	DestinationsBasisPoints []cosmossdk_io_math.Uint                          `protobuf:"bytes,16,rep,name=destinations_basis_points,json=destinationsBasisPoints,proto3,customtype=cosmossdk.io/math.Uint" json:"destinations_basis_points,omitempty"`
+	Route                   []gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,17,rep,name=route,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"route,omitempty"`
 }
 
 func (m *MsgSwap) Reset()         { *m = MsgSwap{} }
@@ -164,6 +174,20 @@ func (m *MsgSwap) GetStreamInterval() uint64 {
 	return 0
 }
 
+func (m *MsgSwap) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *MsgSwap) GetDestinations() []gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("types.SwapType", SwapType_name, SwapType_value)
 	proto.RegisterType((*MsgSwap)(nil), "types.MsgSwap")
@@ -172,44 +196,51 @@ func init() {
 func init() { proto.RegisterFile("types/msg_swap.proto", fileDescriptor_a59a5d8aa38a4a7a) }
 
 var fileDescriptor_a59a5d8aa38a4a7a = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xf3, 0x35, 0x7f, 0x93, 0xb4, 0xcd, 0x37, 0x84, 0x76, 0xe8, 0xc2, 0x0e, 0x2c, 0x20,
-	0x42, 0xd4, 0x16, 0xc9, 0x06, 0xb1, 0xab, 0x25, 0x16, 0x95, 0x8a, 0x44, 0x4d, 0xca, 0x82, 0x8d,
-	0x99, 0xc4, 0x53, 0x67, 0x94, 0xd8, 0x63, 0x3c, 0xd3, 0x26, 0x79, 0x0b, 0x5e, 0x82, 0x77, 0xc9,
-	0xb2, 0x4b, 0xc4, 0xc2, 0x42, 0xc9, 0xae, 0x8f, 0xd0, 0x15, 0xf2, 0x8c, 0xd3, 0x18, 0x50, 0x55,
-	0xc4, 0xca, 0xf7, 0x9e, 0xb9, 0xe7, 0x9e, 0x7b, 0x6e, 0x66, 0x02, 0x5a, 0x62, 0x1e, 0x11, 0x6e,
-	0x05, 0xdc, 0x77, 0xf9, 0x14, 0x47, 0x66, 0x14, 0x33, 0xc1, 0x60, 0x49, 0xa2, 0x07, 0x0f, 0x86,
-	0x2c, 0x08, 0x58, 0x68, 0xa9, 0x8f, 0x3a, 0x3b, 0x68, 0xf9, 0xcc, 0x67, 0x32, 0xb4, 0xd2, 0x48,
-	0xa1, 0x4f, 0xbe, 0x56, 0x40, 0xe5, 0x2d, 0xf7, 0xdf, 0x4f, 0x71, 0x04, 0x9f, 0x82, 0xa2, 0x98,
-	0x21, 0xad, 0xad, 0x75, 0xea, 0x5d, 0x60, 0x66, 0xe4, 0xfe, 0xcc, 0x06, 0x8b, 0xc4, 0x28, 0x5c,
-	0x27, 0x46, 0x51, 0xcc, 0x9c, 0xa2, 0x98, 0xc1, 0x29, 0x68, 0x08, 0x1c, 0xfb, 0x44, 0xb8, 0x98,
-	0x73, 0x22, 0x50, 0x51, 0x32, 0xb6, 0xd7, 0x8c, 0xa3, 0x14, 0xb4, 0xdf, 0xa4, 0xa4, 0xef, 0x89,
-	0x71, 0xe8, 0x53, 0x31, 0xc1, 0x83, 0xf4, 0xd0, 0x12, 0x23, 0x16, 0x0f, 0x47, 0x98, 0x86, 0x32,
-	0x0a, 0x99, 0x47, 0xac, 0xcb, 0x9e, 0x95, 0xa7, 0x5d, 0x27, 0xc6, 0x2f, 0xbd, 0x9d, 0xba, 0xca,
-	0xe4, 0x21, 0x3c, 0x03, 0x75, 0x8f, 0x70, 0x41, 0x43, 0x2c, 0x28, 0x0b, 0xd1, 0x7f, 0x6d, 0xad,
-	0x53, 0xb3, 0x7b, 0x37, 0x89, 0x61, 0xfd, 0xb5, 0x88, 0xe7, 0xc5, 0x84, 0x73, 0x27, 0xdf, 0x07,
-	0x9e, 0x82, 0x86, 0x88, 0xb1, 0x47, 0x5c, 0xa5, 0x85, 0xb6, 0x64, 0x5f, 0x33, 0x33, 0xb0, 0x37,
-	0x64, 0x3c, 0x60, 0x9c, 0x7b, 0x63, 0x93, 0x32, 0x2b, 0xc0, 0x62, 0x64, 0x9e, 0xd1, 0x50, 0x4d,
-	0x9a, 0x63, 0x39, 0x75, 0x99, 0xf5, 0x65, 0x02, 0x3f, 0x81, 0xff, 0xf1, 0xf9, 0x39, 0x9d, 0x50,
-	0x2c, 0x88, 0x8b, 0x95, 0x28, 0x2a, 0xfd, 0xfb, 0xbc, 0xcd, 0xdb, 0x6e, 0x19, 0x02, 0x43, 0xb0,
-	0xb7, 0x51, 0x18, 0x60, 0x4e, 0xb9, 0x1b, 0x31, 0x1a, 0x0a, 0x8e, 0xca, 0x52, 0xe6, 0xd5, 0xbd,
-	0xe3, 0xdf, 0xc1, 0x77, 0x5a, 0xb7, 0xb8, 0x9d, 0xc2, 0xef, 0x24, 0x0a, 0x8f, 0x41, 0x99, 0x53,
-	0x3f, 0x24, 0x31, 0xaa, 0xb4, 0xb5, 0x4e, 0xc3, 0x7e, 0x79, 0xa3, 0x7e, 0xdb, 0xd1, 0x85, 0xb2,
-	0xa1, 0x64, 0xb2, 0xcf, 0x21, 0xf7, 0xc6, 0x96, 0xbc, 0x8b, 0xe6, 0xd1, 0x70, 0xb8, 0x36, 0x91,
-	0x35, 0x80, 0x3a, 0x00, 0xd8, 0xf7, 0x63, 0xe2, 0x63, 0xc1, 0x62, 0x54, 0x4d, 0xc7, 0x75, 0x72,
-	0x08, 0x7c, 0x0d, 0x1e, 0x6d, 0x32, 0x77, 0x7d, 0x1d, 0xb2, 0x25, 0xd6, 0x64, 0xf9, 0xfe, 0xa6,
-	0x40, 0x6d, 0x7c, 0xbd, 0x96, 0x0f, 0x60, 0xff, 0x4f, 0xee, 0x84, 0x06, 0x54, 0x20, 0x20, 0xf7,
-	0xa2, 0x2f, 0x12, 0x43, 0xbb, 0x7b, 0x2f, 0xce, 0xc3, 0xdf, 0x3b, 0x9f, 0xa4, 0x64, 0xf8, 0x02,
-	0xd4, 0xd2, 0x77, 0xe6, 0xa6, 0xa6, 0x50, 0xbd, 0xad, 0x75, 0x76, 0xba, 0xbb, 0xa6, 0x72, 0x98,
-	0xbe, 0x9d, 0xfe, 0x3c, 0x22, 0x4e, 0x95, 0x67, 0x11, 0x7c, 0x06, 0x76, 0xb9, 0x88, 0x09, 0x0e,
-	0xdc, 0xcf, 0x17, 0x38, 0x14, 0x54, 0xcc, 0x51, 0xa3, 0xad, 0x75, 0xb6, 0x9c, 0x1d, 0x05, 0x9f,
-	0x66, 0x68, 0xae, 0x90, 0x86, 0x82, 0xc4, 0x97, 0x78, 0x82, 0xb6, 0xf3, 0x85, 0xc7, 0x19, 0xfa,
-	0xfc, 0x31, 0xa8, 0xae, 0x75, 0x20, 0x00, 0xe5, 0x00, 0xc7, 0x63, 0x22, 0x9a, 0x05, 0x58, 0x03,
-	0x25, 0xe9, 0xae, 0xa9, 0xd9, 0x27, 0x8b, 0xa5, 0xae, 0x5d, 0x2d, 0x75, 0xed, 0xc7, 0x52, 0xd7,
-	0xbe, 0xac, 0xf4, 0xc2, 0xd5, 0x4a, 0x2f, 0x7c, 0x5b, 0xe9, 0x85, 0x8f, 0xdd, 0x7b, 0xaf, 0xdb,
-	0x2c, 0x8f, 0xa7, 0xae, 0x06, 0x65, 0xf9, 0xff, 0xd0, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0xbb,
-	0xb7, 0xc6, 0x42, 0x69, 0x04, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x4f, 0xdb, 0x40,
+	0x14, 0x8e, 0x13, 0x12, 0xc8, 0x4b, 0x20, 0xe1, 0x4a, 0xe1, 0x60, 0x70, 0xac, 0x56, 0x6a, 0xa3,
+	0x0a, 0x62, 0x15, 0x96, 0xaa, 0x1b, 0x91, 0x90, 0x8a, 0x44, 0xa5, 0xe2, 0x42, 0x2b, 0x75, 0x71,
+	0x8f, 0xe4, 0x70, 0x4e, 0x89, 0x7d, 0xae, 0xef, 0x01, 0xc9, 0xd8, 0xb1, 0x5b, 0xff, 0x2c, 0x46,
+	0xc6, 0xaa, 0x43, 0x54, 0xc1, 0xc6, 0x9f, 0xc0, 0x54, 0xf9, 0xec, 0x80, 0x29, 0x42, 0x54, 0x4c,
+	0xbe, 0xf7, 0xf9, 0x7d, 0xdf, 0xfb, 0xe1, 0xcf, 0x07, 0x0b, 0x38, 0x0a, 0xb9, 0xb2, 0x7d, 0xe5,
+	0xb9, 0xea, 0x84, 0x85, 0xad, 0x30, 0x92, 0x28, 0x49, 0x51, 0xa3, 0x2b, 0x4f, 0x3a, 0xd2, 0xf7,
+	0x65, 0x60, 0x27, 0x8f, 0xe4, 0xdd, 0xca, 0x82, 0x27, 0x3d, 0xa9, 0x8f, 0x76, 0x7c, 0x4a, 0xd0,
+	0x67, 0x3f, 0x00, 0xa6, 0xdf, 0x2b, 0xef, 0xe3, 0x09, 0x0b, 0xc9, 0x0b, 0xc8, 0xe3, 0x90, 0x1a,
+	0x96, 0xd1, 0xac, 0xac, 0x43, 0x2b, 0x25, 0xef, 0x0d, 0xdb, 0x70, 0x3a, 0x6e, 0xe4, 0x2e, 0xc7,
+	0x8d, 0x3c, 0x0e, 0x9d, 0x3c, 0x0e, 0xc9, 0x09, 0x54, 0x91, 0x45, 0x1e, 0x47, 0x97, 0x29, 0xc5,
+	0x91, 0xe6, 0x35, 0x63, 0x76, 0xc2, 0xd8, 0x8c, 0xc1, 0xf6, 0x56, 0x4c, 0xfa, 0x3d, 0x6e, 0xac,
+	0x79, 0x02, 0x07, 0xec, 0x20, 0x7e, 0x69, 0x63, 0x4f, 0x46, 0x9d, 0x1e, 0x13, 0x81, 0x3e, 0x05,
+	0xb2, 0xcb, 0xed, 0xe3, 0x0d, 0x3b, 0x4b, 0xbb, 0x1c, 0x37, 0x6e, 0x69, 0x3b, 0x95, 0x24, 0xd2,
+	0x2f, 0xc9, 0x3e, 0x54, 0xba, 0x5c, 0xa1, 0x08, 0x18, 0x0a, 0x19, 0xd0, 0x82, 0x65, 0x34, 0xcb,
+	0xed, 0x8d, 0xab, 0x71, 0xc3, 0xfe, 0xef, 0x22, 0xdd, 0x6e, 0xc4, 0x95, 0x72, 0xb2, 0x3a, 0x64,
+	0x17, 0xaa, 0x18, 0xb1, 0x2e, 0x77, 0x93, 0x5a, 0x74, 0x4a, 0xeb, 0xb6, 0xd2, 0x01, 0x16, 0x3b,
+	0x52, 0xf9, 0x52, 0xa9, 0x6e, 0xbf, 0x25, 0xa4, 0xed, 0x33, 0xec, 0xb5, 0xf6, 0x45, 0x90, 0x74,
+	0x9a, 0x61, 0x39, 0x15, 0x1d, 0xed, 0xe9, 0x80, 0x7c, 0x85, 0x79, 0x76, 0x78, 0x28, 0x06, 0x82,
+	0x21, 0x77, 0x59, 0x52, 0x94, 0x16, 0x1f, 0xdf, 0x6f, 0xfd, 0x5a, 0x2d, 0x45, 0x48, 0x00, 0x8b,
+	0x37, 0x15, 0x0e, 0x98, 0x12, 0xca, 0x0d, 0xa5, 0x08, 0x50, 0xd1, 0x92, 0x2e, 0xf3, 0xe6, 0xc1,
+	0xf6, 0xef, 0xe1, 0x3b, 0x0b, 0xd7, 0x78, 0x3b, 0x86, 0x3f, 0x68, 0x94, 0x6c, 0x43, 0x49, 0x09,
+	0x2f, 0xe0, 0x11, 0x9d, 0xb6, 0x8c, 0x66, 0xb5, 0xfd, 0xfa, 0x2a, 0xf9, 0xb6, 0xbd, 0xa3, 0x64,
+	0x8c, 0xa4, 0x4c, 0xfa, 0x58, 0x53, 0xdd, 0xbe, 0xad, 0xbd, 0xd8, 0xda, 0xec, 0x74, 0x26, 0x43,
+	0xa4, 0x02, 0xc4, 0x04, 0x60, 0x9e, 0x17, 0x71, 0x8f, 0xa1, 0x8c, 0xe8, 0x4c, 0xdc, 0xae, 0x93,
+	0x41, 0xc8, 0x5b, 0x58, 0xbe, 0x89, 0xdc, 0x89, 0x1d, 0xd2, 0x25, 0x96, 0x75, 0xfa, 0xd2, 0x4d,
+	0x42, 0xb2, 0xf1, 0xc9, 0x5a, 0x3e, 0xc1, 0xd2, 0x5d, 0xee, 0x40, 0xf8, 0x02, 0x29, 0xe8, 0xbd,
+	0x98, 0xa7, 0xe3, 0x86, 0x71, 0xff, 0x5e, 0x9c, 0xa7, 0xff, 0x2a, 0xef, 0xc4, 0x64, 0xb2, 0x0a,
+	0xe5, 0xf8, 0x3f, 0x73, 0xe3, 0xa1, 0x68, 0xc5, 0x32, 0x9a, 0x73, 0xeb, 0xb5, 0x56, 0x32, 0x61,
+	0xfc, 0xef, 0xec, 0x8d, 0x42, 0xee, 0xcc, 0xa8, 0xf4, 0x44, 0x5e, 0x42, 0x4d, 0x61, 0xc4, 0x99,
+	0xef, 0x7e, 0x3b, 0x62, 0x01, 0x0a, 0x1c, 0xd1, 0xaa, 0x65, 0x34, 0xa7, 0x9c, 0xb9, 0x04, 0xde,
+	0x4d, 0xd1, 0x4c, 0xa2, 0x08, 0x90, 0x47, 0xc7, 0x6c, 0x40, 0x67, 0xb3, 0x89, 0xdb, 0x29, 0x4a,
+	0x16, 0xa1, 0xc4, 0x87, 0xa1, 0x88, 0x46, 0x74, 0xce, 0x32, 0x9a, 0x05, 0x27, 0x8d, 0xc8, 0x67,
+	0xa8, 0x66, 0xac, 0xac, 0x68, 0xcd, 0x2a, 0x3c, 0xd6, 0x63, 0xb7, 0x84, 0xc8, 0x77, 0x03, 0x96,
+	0xb3, 0xc0, 0x6d, 0x8f, 0xd5, 0x75, 0x99, 0xad, 0x07, 0x3d, 0xf6, 0xfc, 0x5e, 0x89, 0x55, 0xe9,
+	0x0b, 0xe4, 0x7e, 0x88, 0x23, 0x67, 0x29, 0x9b, 0x94, 0xf5, 0x9c, 0x0f, 0xc5, 0x48, 0x1e, 0x21,
+	0xa7, 0xf3, 0x56, 0xe1, 0xee, 0x0d, 0xf3, 0xee, 0xb1, 0x37, 0x4c, 0x4d, 0x8b, 0x66, 0x1a, 0x48,
+	0xaa, 0xbc, 0xda, 0x84, 0x99, 0xc9, 0xb7, 0x24, 0x00, 0x25, 0x9f, 0x45, 0x7d, 0x8e, 0xf5, 0x1c,
+	0x29, 0x43, 0x51, 0x3b, 0xa8, 0x6e, 0x90, 0x59, 0x28, 0x2b, 0x94, 0xa1, 0x3b, 0x90, 0x4a, 0xd5,
+	0xf3, 0xa4, 0x06, 0x15, 0x64, 0x7d, 0xee, 0x86, 0x91, 0x3c, 0x14, 0x58, 0x2f, 0xb4, 0x77, 0x4e,
+	0xcf, 0x4d, 0xe3, 0xec, 0xdc, 0x34, 0xfe, 0x9c, 0x9b, 0xc6, 0xcf, 0x0b, 0x33, 0x77, 0x76, 0x61,
+	0xe6, 0x7e, 0x5d, 0x98, 0xb9, 0x2f, 0xeb, 0x0f, 0x76, 0x39, 0xcc, 0xe2, 0xb1, 0xb3, 0x0e, 0x4a,
+	0xfa, 0x8e, 0xde, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x70, 0x86, 0xf3, 0x88, 0xed, 0x05, 0x00,
+	0x00,
 }
 
 func (m *MsgSwap) Marshal() (dAtA []byte, err error) {
@@ -232,6 +263,52 @@ func (m *MsgSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Route[iNdEx].Size()
+				i -= size
+				if _, err := m.Route[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMsgSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.DestinationsBasisPoints) > 0 {
+		for iNdEx := len(m.DestinationsBasisPoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DestinationsBasisPoints[iNdEx].Size()
+				i -= size
+				if _, err := m.DestinationsBasisPoints[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintMsgSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Destinations[iNdEx])
+			copy(dAtA[i:], m.Destinations[iNdEx])
+			i = encodeVarintMsgSwap(dAtA, i, uint64(len(m.Destinations[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintMsgSwap(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.StreamInterval != 0 {
 		i = encodeVarintMsgSwap(dAtA, i, uint64(m.StreamInterval))
 		i--
@@ -395,6 +472,27 @@ func (m *MsgSwap) Size() (n int) {
 	if m.StreamInterval != 0 {
 		n += 1 + sovMsgSwap(uint64(m.StreamInterval))
 	}
+	if m.Expiry != 0 {
+		n += 1 + sovMsgSwap(uint64(m.Expiry))
+	}
+	if len(m.Destinations) > 0 {
+		for _, s := range m.Destinations {
+			l = len(s)
+			n += 1 + l + sovMsgSwap(uint64(l))
+		}
+	}
+	if len(m.DestinationsBasisPoints) > 0 {
+		for _, e := range m.DestinationsBasisPoints {
+			l = e.Size()
+			n += 2 + l + sovMsgSwap(uint64(l))
+		}
+	}
+	if len(m.Route) > 0 {
+		for _, e := range m.Route {
+			l = e.Size()
+			n += 2 + l + sovMsgSwap(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -822,6 +920,127 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationsBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.DestinationsBasisPoints = append(m.DestinationsBasisPoints, v)
+			if err := m.DestinationsBasisPoints[len(m.DestinationsBasisPoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, gitlab_com_thorchain_thornode_v3_common.Asset{})
+			if err := m.Route[len(m.Route)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgSwap(dAtA[iNdEx:])
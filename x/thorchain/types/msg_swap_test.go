@@ -160,4 +160,103 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 	// affiliate fee basis point larger than 1000 should be rejected
 	m = NewMsgSwap(tx, common.ETHAsset, GetRandomETHAddress(), cosmos.ZeroUint(), GetRandomTHORAddress(), cosmos.NewUint(1024), "", "", nil, 0, 0, 0, addr)
 	c.Assert(m.ValidateBasic(), NotNil)
+
+	// stop-loss and take-profit orders require a trigger
+	m = NewMsgSwap(tx, common.ETHAsset, GetRandomETHAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, SwapType_stop_loss, 0, 0, addr)
+	c.Assert(m.ValidateBasic(), NotNil)
+	m = NewMsgSwap(tx, common.ETHAsset, GetRandomETHAddress(), cosmos.NewUint(common.One), common.NoAddress, cosmos.ZeroUint(), "", "", nil, SwapType_take_profit, 0, 0, addr)
+	c.Assert(m.ValidateBasic(), IsNil)
+	c.Check(m.IsTriggerOrder(), Equals, true)
+	m.Expiry = -1
+	c.Assert(m.ValidateBasic(), NotNil)
+}
+
+func (MsgSwapSuite) TestMsgSwapSplitDestinations(c *C) {
+	addr := GetRandomBech32Addr()
+	eth1 := GetRandomETHAddress()
+	eth2 := GetRandomETHAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBTCAddress(),
+		GetRandomBTCAddress(),
+		common.Coins{
+			common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)),
+		},
+		common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(10000))},
+		"",
+	)
+
+	m := NewMsgSwap(tx, common.ETHAsset, eth1, cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, 0, 0, addr)
+	m.Destinations = []common.Address{eth1, eth2}
+	m.DestinationsBasisPoints = []cosmos.Uint{cosmos.NewUint(3333), cosmos.NewUint(6667)}
+	c.Assert(m.ValidateBasic(), IsNil)
+	c.Check(m.HasSplitDestinations(), Equals, true)
+
+	amounts := m.GetSplitDestinationAmounts(cosmos.NewUint(100))
+	c.Assert(amounts, HasLen, 2)
+	c.Check(amounts[0].Uint64(), Equals, uint64(33))
+	c.Check(amounts[1].Uint64(), Equals, uint64(67))
+
+	// basis points must add up to 10000
+	m.DestinationsBasisPoints = []cosmos.Uint{cosmos.NewUint(3333), cosmos.NewUint(6666)}
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// destinations must be on the target chain
+	m.DestinationsBasisPoints = []cosmos.Uint{cosmos.NewUint(3333), cosmos.NewUint(6667)}
+	m.Destinations = []common.Address{eth1, GetRandomBTCAddress()}
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// destination must be the first recipient
+	m.Destinations = []common.Address{eth2, eth1}
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// each recipient can only be paid once
+	m.Destinations = []common.Address{eth1, eth1}
+	c.Check(m.ValidateBasic(), ErrorMatches, ".*is duplicated.*")
+
+	// no aggregator
+	m.Destinations = []common.Address{eth1, eth2}
+	m.Aggregator = "123"
+	m.AggregatorTargetAddress = "0x123"
+	c.Check(m.ValidateBasic(), NotNil)
+}
+
+func (MsgSwapSuite) TestMsgSwapRoute(c *C) {
+	addr := GetRandomBech32Addr()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBTCAddress(),
+		GetRandomBTCAddress(),
+		common.Coins{
+			common.NewCoin(common.BTCAsset, cosmos.NewUint(common.One)),
+		},
+		common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(10000))},
+		"",
+	)
+
+	m := NewMsgSwap(tx, common.ETHAsset, GetRandomETHAddress(), cosmos.ZeroUint(), common.NoAddress, cosmos.ZeroUint(), "", "", nil, 0, 0, 0, addr)
+	m.Route = []common.Asset{common.BTCAsset.GetDerivedAsset()}
+	c.Assert(m.ValidateBasic(), IsNil)
+
+	// route cannot include rune
+	m.Route = []common.Asset{common.RuneAsset()}
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// route cannot include synths
+	m.Route = []common.Asset{common.BTCAsset.GetSyntheticAsset()}
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// route cannot repeat the source, the target or another hop
+	m.Route = []common.Asset{common.BTCAsset}
+	c.Check(m.ValidateBasic(), NotNil)
+	m.Route = []common.Asset{common.ETHAsset}
+	c.Check(m.ValidateBasic(), NotNil)
+	m.Route = []common.Asset{common.BTCAsset.GetDerivedAsset(), common.BTCAsset.GetDerivedAsset()}
+	c.Check(m.ValidateBasic(), NotNil)
+
+	// no aggregator
+	m.Route = []common.Asset{common.BTCAsset.GetDerivedAsset()}
+	m.Aggregator = "123"
+	m.AggregatorTargetAddress = "0x123"
+	c.Check(m.ValidateBasic(), NotNil)
 }
@@ -15,6 +15,10 @@ var (
 	_ sdk.Msg              = &MsgTradeAccountWithdrawal{}
 	_ sdk.HasValidateBasic = &MsgTradeAccountWithdrawal{}
 	_ sdk.LegacyMsg        = &MsgTradeAccountWithdrawal{}
+
+	_ sdk.Msg              = &MsgTradeAccountTransfer{}
+	_ sdk.HasValidateBasic = &MsgTradeAccountTransfer{}
+	_ sdk.LegacyMsg        = &MsgTradeAccountTransfer{}
 )
 
 // NewMsgTradeAccountDeposit is a constructor function for MsgTradeAccountDeposit
@@ -97,3 +101,45 @@ func (m *MsgTradeAccountWithdrawal) ValidateBasic() error {
 func (m *MsgTradeAccountWithdrawal) GetSigners() []cosmos.AccAddress {
 	return []cosmos.AccAddress{m.Signer}
 }
+
+// NewMsgTradeAccountTransfer is a constructor function for MsgTradeAccountTransfer
+func NewMsgTradeAccountTransfer(asset common.Asset, amount cosmos.Uint, to, signer cosmos.AccAddress, tx common.Tx) *MsgTradeAccountTransfer {
+	return &MsgTradeAccountTransfer{
+		Tx:        tx,
+		Asset:     asset,
+		Amount:    amount,
+		ToAddress: to,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgTradeAccountTransfer) ValidateBasic() error {
+	if m.Asset.IsEmpty() {
+		return cosmos.ErrUnknownRequest("asset cannot be empty")
+	}
+	if !m.Asset.IsTradeAsset() {
+		return cosmos.ErrUnknownRequest("asset must be a trade asset")
+	}
+	if m.Amount.IsZero() {
+		return cosmos.ErrUnknownRequest("amount cannot be zero")
+	}
+	if m.ToAddress.Empty() {
+		return cosmos.ErrInvalidAddress(m.ToAddress.String())
+	}
+	if m.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(m.Signer.String())
+	}
+	if m.ToAddress.Equals(m.Signer) {
+		return cosmos.ErrUnknownRequest("cannot transfer to the same address")
+	}
+	if m.Tx.ID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("txID cannot be empty")
+	}
+	return nil
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgTradeAccountTransfer) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{m.Signer}
+}
@@ -151,40 +151,105 @@ func (m *MsgTradeAccountWithdrawal) GetSigner() github_com_cosmos_cosmos_sdk_typ
 	return nil
 }
 
+type MsgTradeAccountTransfer struct {
+	Tx        common.Tx                                     `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx"`
+	Asset     gitlab_com_thorchain_thornode_v3_common.Asset `protobuf:"bytes,2,opt,name=asset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"asset"`
+	Amount    cosmossdk_io_math.Uint                        `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	ToAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"to_address,omitempty"`
+	Signer    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgTradeAccountTransfer) Reset()         { *m = MsgTradeAccountTransfer{} }
+func (m *MsgTradeAccountTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgTradeAccountTransfer) ProtoMessage()    {}
+func (*MsgTradeAccountTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbd1a86f29843216, []int{2}
+}
+func (m *MsgTradeAccountTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTradeAccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTradeAccountTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTradeAccountTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTradeAccountTransfer.Merge(m, src)
+}
+func (m *MsgTradeAccountTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTradeAccountTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTradeAccountTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTradeAccountTransfer proto.InternalMessageInfo
+
+func (m *MsgTradeAccountTransfer) GetTx() common.Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return common.Tx{}
+}
+
+func (m *MsgTradeAccountTransfer) GetToAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ToAddress
+	}
+	return nil
+}
+
+func (m *MsgTradeAccountTransfer) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgTradeAccountDeposit)(nil), "types.MsgTradeAccountDeposit")
 	proto.RegisterType((*MsgTradeAccountWithdrawal)(nil), "types.MsgTradeAccountWithdrawal")
+	proto.RegisterType((*MsgTradeAccountTransfer)(nil), "types.MsgTradeAccountTransfer")
 }
 
 func init() { proto.RegisterFile("types/msg_trade_account.proto", fileDescriptor_fbd1a86f29843216) }
 
 var fileDescriptor_fbd1a86f29843216 = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xdc, 0xb6, 0x97, 0xce, 0x6d, 0x37, 0xb9, 0x52, 0x62, 0xc1, 0x34, 0x74, 0xd5,
-	0x4d, 0x33, 0xd8, 0xa2, 0xfb, 0x04, 0x37, 0xa2, 0x6e, 0x42, 0x45, 0xe9, 0xa6, 0x4c, 0x93, 0x90,
-	0x0c, 0x6d, 0x32, 0x25, 0x33, 0xd5, 0xf8, 0x16, 0x3e, 0x56, 0x97, 0x5d, 0x8a, 0x8b, 0x22, 0x2d,
-	0xf8, 0x08, 0x2e, 0xba, 0x92, 0x99, 0x49, 0xa1, 0xb8, 0x51, 0xc4, 0x8d, 0xab, 0x73, 0x38, 0xe7,
-	0x3f, 0xdf, 0xfc, 0xfc, 0x30, 0xe0, 0x88, 0x3d, 0xcc, 0x42, 0x0a, 0x13, 0x1a, 0x8d, 0x58, 0x86,
-	0x82, 0x70, 0x84, 0x7c, 0x9f, 0xcc, 0x53, 0x66, 0xcf, 0x32, 0xc2, 0x88, 0x5e, 0x16, 0xeb, 0xe6,
-	0x7f, 0x9f, 0x24, 0x09, 0x49, 0xa1, 0x2c, 0x72, 0xd7, 0x3c, 0x88, 0x48, 0x44, 0x44, 0x0b, 0x79,
-	0x27, 0xa7, 0xed, 0x57, 0x0d, 0x34, 0xae, 0x68, 0x34, 0xe0, 0x30, 0x47, 0xb2, 0xce, 0xc2, 0x19,
-	0xa1, 0x98, 0xe9, 0x16, 0xd0, 0x58, 0x6e, 0xa8, 0x96, 0xda, 0xf9, 0xd7, 0x03, 0x76, 0xc1, 0x1a,
-	0xe4, 0x6e, 0x69, 0xb1, 0x6a, 0x29, 0x9e, 0xc6, 0x72, 0x7d, 0x08, 0xca, 0x88, 0xd2, 0x90, 0x19,
-	0x9a, 0x10, 0xd5, 0x77, 0x22, 0x87, 0x0f, 0xdd, 0x13, 0xae, 0x7b, 0x5e, 0xb5, 0xba, 0x11, 0x66,
-	0x53, 0x34, 0xe6, 0x4b, 0xc8, 0x62, 0x92, 0xf9, 0x31, 0xc2, 0xa9, 0xe8, 0x52, 0x12, 0x84, 0xf0,
-	0xae, 0x0f, 0xf7, 0xcf, 0x3c, 0x89, 0xd4, 0x4f, 0x41, 0x05, 0x25, 0xdc, 0x8e, 0xf1, 0xc7, 0x52,
-	0x3b, 0x55, 0xd7, 0x2c, 0x68, 0x0d, 0x9f, 0xd0, 0x84, 0x50, 0x1a, 0x4c, 0x6c, 0x4c, 0x60, 0x82,
-	0x58, 0x6c, 0x5f, 0xe3, 0x94, 0x79, 0x85, 0x5a, 0xbf, 0x00, 0x7f, 0x51, 0x10, 0x64, 0x21, 0xa5,
-	0x46, 0xc9, 0x52, 0x3b, 0x35, 0xf7, 0x78, 0x2b, 0x2d, 0xc4, 0x73, 0x69, 0x41, 0xde, 0x17, 0xa5,
-	0x4b, 0x83, 0x09, 0x14, 0xa1, 0xd9, 0x8e, 0xef, 0x3b, 0xf2, 0xd0, 0xdb, 0x11, 0xf4, 0x73, 0x50,
-	0xa1, 0x38, 0x4a, 0xc3, 0xcc, 0x28, 0x7f, 0x97, 0x55, 0x00, 0xda, 0x6f, 0x1a, 0x38, 0xfc, 0x10,
-	0xf4, 0x0d, 0x66, 0x71, 0x90, 0xa1, 0x7b, 0x34, 0xfd, 0xa5, 0x59, 0xdf, 0x82, 0xba, 0x00, 0x8c,
-	0xf6, 0x13, 0xaf, 0xba, 0xfd, 0xed, 0xaa, 0x05, 0xbf, 0x6c, 0xa4, 0xc8, 0xa9, 0x26, 0x48, 0xce,
-	0x8f, 0x07, 0xef, 0x5e, 0x2e, 0xd6, 0xa6, 0xba, 0x5c, 0x9b, 0xea, 0xcb, 0xda, 0x54, 0x1f, 0x37,
-	0xa6, 0xb2, 0xdc, 0x98, 0xca, 0xd3, 0xc6, 0x54, 0x86, 0xbd, 0x4f, 0x3d, 0xe6, 0xfb, 0x73, 0xfe,
-	0xc0, 0xb8, 0x22, 0xbe, 0x4d, 0xff, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x44, 0x33, 0xc8, 0x87, 0x89,
-	0x03, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x4f, 0x8b, 0xda, 0x40,
+	0x14, 0xc0, 0x93, 0x54, 0x2d, 0x4e, 0xf5, 0x92, 0x16, 0x9b, 0x0a, 0x8d, 0xc1, 0x93, 0x17, 0x33,
+	0x54, 0x69, 0xef, 0x09, 0xbd, 0x94, 0xb6, 0x50, 0x82, 0xa5, 0xc5, 0x4b, 0x18, 0x93, 0x69, 0x12,
+	0x34, 0x19, 0x99, 0x19, 0xdb, 0xf4, 0x5b, 0xec, 0xc7, 0xf2, 0xe8, 0x71, 0xd9, 0x83, 0x2c, 0x0a,
+	0x7b, 0xda, 0xf3, 0x1e, 0x3c, 0x2d, 0x99, 0x89, 0x20, 0x5e, 0x76, 0xd9, 0xf5, 0xe2, 0xe9, 0x3d,
+	0xde, 0x9f, 0x5f, 0x1e, 0x3f, 0xc2, 0x80, 0xf7, 0xfc, 0xff, 0x1c, 0x33, 0x98, 0xb2, 0xc8, 0xe7,
+	0x14, 0x85, 0xd8, 0x47, 0x41, 0x40, 0x16, 0x19, 0xb7, 0xe7, 0x94, 0x70, 0xa2, 0x57, 0x45, 0xbb,
+	0xfd, 0x3a, 0x20, 0x69, 0x4a, 0x32, 0x28, 0x83, 0xec, 0xb5, 0xdf, 0x44, 0x24, 0x22, 0x22, 0x85,
+	0x45, 0x26, 0xab, 0xdd, 0x1b, 0x0d, 0xb4, 0xbe, 0xb3, 0x68, 0x54, 0xc0, 0x1c, 0xc9, 0xfa, 0x8c,
+	0xe7, 0x84, 0x25, 0x5c, 0xb7, 0x80, 0xc6, 0x73, 0x43, 0xb5, 0xd4, 0xde, 0xab, 0x01, 0xb0, 0x4b,
+	0xd6, 0x28, 0x77, 0x2b, 0xcb, 0x75, 0x47, 0xf1, 0x34, 0x9e, 0xeb, 0x63, 0x50, 0x45, 0x8c, 0x61,
+	0x6e, 0x68, 0x62, 0xa8, 0xb9, 0x1f, 0x72, 0x8a, 0xa2, 0xfb, 0xb1, 0x98, 0xbb, 0x5a, 0x77, 0xfa,
+	0x51, 0xc2, 0x67, 0x68, 0x52, 0x34, 0x21, 0x8f, 0x09, 0x0d, 0x62, 0x94, 0x64, 0x22, 0xcb, 0x48,
+	0x88, 0xe1, 0xdf, 0x21, 0x3c, 0x5c, 0xf3, 0x24, 0x52, 0xff, 0x04, 0x6a, 0x28, 0x2d, 0xce, 0x31,
+	0x5e, 0x58, 0x6a, 0xaf, 0xee, 0x9a, 0x25, 0xad, 0x15, 0x10, 0x96, 0x12, 0xc6, 0xc2, 0xa9, 0x9d,
+	0x10, 0x98, 0x22, 0x1e, 0xdb, 0x3f, 0x93, 0x8c, 0x7b, 0xe5, 0xb4, 0xfe, 0x15, 0xbc, 0x44, 0x61,
+	0x48, 0x31, 0x63, 0x46, 0xc5, 0x52, 0x7b, 0x0d, 0xf7, 0xc3, 0x4e, 0x9e, 0x10, 0x2f, 0xe4, 0x09,
+	0x72, 0xbf, 0x0c, 0x7d, 0x16, 0x4e, 0xa1, 0x90, 0x66, 0x3b, 0x41, 0xe0, 0xc8, 0x45, 0x6f, 0x4f,
+	0xd0, 0xbf, 0x80, 0x1a, 0x4b, 0xa2, 0x0c, 0x53, 0xa3, 0xfa, 0x54, 0x56, 0x09, 0xe8, 0xde, 0x69,
+	0xe0, 0xdd, 0x91, 0xe8, 0x5f, 0x09, 0x8f, 0x43, 0x8a, 0xfe, 0xa1, 0xd9, 0x99, 0xba, 0xfe, 0x0d,
+	0x9a, 0x02, 0xe0, 0x1f, 0x1a, 0xaf, 0xbb, 0xc3, 0xdd, 0xba, 0x03, 0x1f, 0x7d, 0x48, 0xe9, 0xa9,
+	0x21, 0x48, 0xce, 0xe9, 0xc5, 0xdf, 0x6a, 0xe0, 0xed, 0x91, 0xf8, 0x11, 0x45, 0x19, 0xfb, 0x83,
+	0xe9, 0x99, 0x6a, 0xff, 0x01, 0x00, 0x27, 0xfe, 0xb3, 0xff, 0xf2, 0x3a, 0x27, 0xa7, 0xd7, 0xed,
+	0x7e, 0x5b, 0x6e, 0x4c, 0x75, 0xb5, 0x31, 0xd5, 0xeb, 0x8d, 0xa9, 0x5e, 0x6c, 0x4d, 0x65, 0xb5,
+	0x35, 0x95, 0xcb, 0xad, 0xa9, 0x8c, 0x07, 0x0f, 0x4a, 0xca, 0x0f, 0xeb, 0xc5, 0x07, 0x26, 0x35,
+	0xf1, 0x4a, 0x0d, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x02, 0x59, 0x85, 0xf8, 0x04, 0x00,
+	0x00,
 }
 
 func (m *MsgTradeAccountDeposit) Marshal() (dAtA []byte, err error) {
@@ -321,6 +386,73 @@ func (m *MsgTradeAccountWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTradeAccountTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTradeAccountTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTradeAccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Asset.Size()
+		i -= size
+		if _, err := m.Asset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgTradeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgTradeAccount(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgTradeAccount(v)
 	base := offset
@@ -378,6 +510,29 @@ func (m *MsgTradeAccountWithdrawal) Size() (n int) {
 	return n
 }
 
+func (m *MsgTradeAccountTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tx.Size()
+	n += 1 + l + sovMsgTradeAccount(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovMsgTradeAccount(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgTradeAccount(uint64(l))
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgTradeAccount(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgTradeAccount(uint64(l))
+	}
+	return n
+}
+
 func sovMsgTradeAccount(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -818,6 +973,224 @@ func (m *MsgTradeAccountWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgTradeAccountTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgTradeAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTradeAccountTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTradeAccountTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = append(m.ToAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddress == nil {
+				m.ToAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgTradeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgTradeAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgTradeAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgTradeAccount(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
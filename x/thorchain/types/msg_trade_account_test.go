@@ -51,3 +51,29 @@ func (MsgTradeAccountSuite) TestWithdrawal(c *C) {
 	m = NewMsgTradeAccountWithdrawal(asset, cosmos.ZeroUint(), GetRandomTHORAddress(), signer, dummyTx)
 	c.Check(m.ValidateBasic(), NotNil)
 }
+
+func (MsgTradeAccountSuite) TestTransfer(c *C) {
+	asset := common.ETHAsset.GetTradeAsset()
+	amt := cosmos.NewUint(100)
+	to := GetRandomBech32Addr()
+	signer := GetRandomBech32Addr()
+	dummyTx := common.Tx{ID: "test"}
+
+	m := NewMsgTradeAccountTransfer(asset, amt, to, signer, dummyTx)
+	EnsureMsgBasicCorrect(m, c)
+
+	m = NewMsgTradeAccountTransfer(common.EmptyAsset, amt, to, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgTradeAccountTransfer(common.ETHAsset, amt, to, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgTradeAccountTransfer(asset, cosmos.ZeroUint(), to, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgTradeAccountTransfer(asset, amt, cosmos.AccAddress{}, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+
+	m = NewMsgTradeAccountTransfer(asset, amt, signer, signer, dummyTx)
+	c.Check(m.ValidateBasic(), NotNil)
+}
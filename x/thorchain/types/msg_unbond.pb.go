@@ -34,6 +34,7 @@ type MsgUnBond struct {
 	Amount              cosmossdk_io_math.Uint                          `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
 	Signer              github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,7,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 	BondProviderAddress github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,8,opt,name=bond_provider_address,json=bondProviderAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bond_provider_address,omitempty"`
+	Cancel              bool                                            `protobuf:"varint,9,opt,name=cancel,proto3" json:"cancel,omitempty"`
 }
 
 func (m *MsgUnBond) Reset()         { *m = MsgUnBond{} }
@@ -104,6 +105,13 @@ func (m *MsgUnBond) GetBondProviderAddress() github_com_cosmos_cosmos_sdk_types.
 	return nil
 }
 
+func (m *MsgUnBond) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgUnBond)(nil), "types.MsgUnBond")
 }
@@ -111,30 +119,31 @@ func init() {
 func init() { proto.RegisterFile("types/msg_unbond.proto", fileDescriptor_b488a47712984178) }
 
 var fileDescriptor_b488a47712984178 = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0xfa, 0x30,
-	0x18, 0xc6, 0xdb, 0xff, 0x5f, 0xbb, 0x19, 0x3d, 0xd5, 0x4d, 0x8a, 0x87, 0x56, 0x06, 0x03, 0x2f,
-	0x36, 0x4c, 0x61, 0x77, 0x7b, 0x13, 0x36, 0x18, 0xa2, 0x3b, 0xec, 0x22, 0xb5, 0x29, 0x6d, 0x70,
-	0xcd, 0x2b, 0x4d, 0x94, 0xee, 0x5b, 0xec, 0x63, 0xc9, 0x4e, 0x1e, 0xc7, 0x0e, 0x65, 0xe8, 0xb7,
-	0xf0, 0x34, 0xd2, 0x46, 0xd9, 0x6d, 0xe0, 0xe9, 0x7d, 0xf3, 0x24, 0xcf, 0x2f, 0xef, 0x13, 0x82,
-	0x5a, 0xe2, 0x6d, 0x19, 0x72, 0x9c, 0xf0, 0x68, 0xb6, 0x62, 0x73, 0x60, 0xc4, 0x5d, 0xa6, 0x20,
-	0xc0, 0xac, 0x16, 0x7a, 0xbb, 0x19, 0x40, 0x92, 0x00, 0xc3, 0x65, 0x29, 0xf7, 0xda, 0x57, 0x11,
-	0x44, 0x50, 0xb4, 0x58, 0x76, 0xa5, 0x7a, 0xf3, 0xf1, 0x1f, 0xd5, 0x1e, 0x79, 0x34, 0x65, 0x1e,
-	0x30, 0x62, 0xde, 0xa2, 0xaa, 0xc8, 0x66, 0x94, 0x59, 0x7a, 0x47, 0xef, 0xd6, 0xfb, 0xc8, 0x55,
-	0x84, 0x49, 0xe6, 0x55, 0x36, 0xb9, 0xa3, 0x8d, 0x2b, 0x22, 0x1b, 0x31, 0x73, 0x82, 0x1a, 0x0c,
-	0x48, 0x38, 0xf3, 0x09, 0x49, 0x43, 0xce, 0xad, 0x7f, 0x1d, 0xbd, 0xdb, 0xf0, 0xee, 0x0e, 0xb9,
-	0xd3, 0x8b, 0xa8, 0x88, 0x57, 0x73, 0xe9, 0xc3, 0x01, 0xf0, 0x04, 0xb8, 0x2a, 0x3d, 0x4e, 0x16,
-	0xb8, 0x98, 0xce, 0x1d, 0x06, 0xc1, 0xb0, 0x34, 0x8e, 0xeb, 0x12, 0xa3, 0x16, 0xe6, 0x33, 0x6a,
-	0xc8, 0x28, 0x27, 0x6a, 0xb5, 0xa3, 0x77, 0x6b, 0xde, 0xe0, 0x90, 0x3b, 0x38, 0xa2, 0xe2, 0xd5,
-	0x2f, 0xa9, 0x22, 0x86, 0x34, 0x88, 0x7d, 0xca, 0x8a, 0x4e, 0xfa, 0xf1, 0x7a, 0x70, 0x4c, 0x7a,
-	0xe2, 0x4a, 0xd0, 0x91, 0x7b, 0x8f, 0x0c, 0x3f, 0x81, 0x15, 0x13, 0x96, 0x51, 0x10, 0x6d, 0x99,
-	0xe4, 0x2b, 0x77, 0x5a, 0xe5, 0x64, 0x9c, 0x2c, 0x5c, 0x0a, 0x38, 0xf1, 0x45, 0xec, 0x4e, 0x29,
-	0x13, 0x63, 0x75, 0xda, 0x1c, 0x21, 0x83, 0xd3, 0x88, 0x85, 0xa9, 0x75, 0x71, 0x6e, 0x3e, 0x05,
-	0x30, 0x43, 0x74, 0x5d, 0x44, 0x5b, 0xa6, 0xb0, 0xa6, 0x24, 0x4c, 0x4f, 0x19, 0x2f, 0xcf, 0x25,
-	0x37, 0x25, 0xef, 0x49, 0xe1, 0x94, 0xe8, 0x3d, 0x6c, 0x76, 0xb6, 0xbe, 0xdd, 0xd9, 0xfa, 0xf7,
-	0xce, 0xd6, 0xdf, 0xf7, 0xb6, 0xb6, 0xdd, 0xdb, 0xda, 0xe7, 0xde, 0xd6, 0x5e, 0xfa, 0x7f, 0xbe,
-	0x60, 0xf6, 0x5b, 0x97, 0xb7, 0xcd, 0x8d, 0xe2, 0x87, 0x0c, 0x7e, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xf1, 0x92, 0xb6, 0x14, 0x6d, 0x02, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x6b, 0xf2, 0x30,
+	0x18, 0xc0, 0x9b, 0xf7, 0xd5, 0xbe, 0x1a, 0x3d, 0xd5, 0x77, 0x52, 0x3c, 0xb4, 0x65, 0x30, 0xe8,
+	0xc5, 0x86, 0x29, 0xec, 0x6e, 0x6f, 0xc2, 0x06, 0xa3, 0xe8, 0x0e, 0xbb, 0x48, 0x6d, 0x4a, 0x1b,
+	0xb4, 0x89, 0x34, 0x51, 0xba, 0x6f, 0xb1, 0x8f, 0xe5, 0xd1, 0xe3, 0xd8, 0xa1, 0x8c, 0xfa, 0x2d,
+	0x3c, 0x8d, 0xb4, 0x55, 0x76, 0x1b, 0x78, 0x7a, 0xfe, 0x24, 0xcf, 0x8f, 0xe7, 0x17, 0x02, 0xfb,
+	0xe2, 0x6d, 0x13, 0x72, 0x94, 0xf0, 0x68, 0xb1, 0xa5, 0x4b, 0x46, 0xb1, 0xb3, 0x49, 0x99, 0x60,
+	0x5a, 0xb3, 0xec, 0x0f, 0x7a, 0x01, 0x4b, 0x12, 0x46, 0x51, 0x15, 0xaa, 0xb3, 0xc1, 0xff, 0x88,
+	0x45, 0xac, 0x4c, 0x91, 0xcc, 0xaa, 0xee, 0x6d, 0xf1, 0x17, 0xb6, 0x9f, 0x78, 0x34, 0xa7, 0x2e,
+	0xa3, 0x58, 0xbb, 0x83, 0x4d, 0x91, 0x2d, 0x08, 0xd5, 0x81, 0x05, 0xec, 0xce, 0x08, 0x3a, 0x35,
+	0x61, 0x96, 0xb9, 0x8d, 0x7d, 0x6e, 0x2a, 0x5e, 0x43, 0x64, 0x53, 0xaa, 0xcd, 0x60, 0x97, 0x32,
+	0x1c, 0x2e, 0x7c, 0x8c, 0xd3, 0x90, 0x73, 0xfd, 0x8f, 0x05, 0xec, 0xae, 0x7b, 0x7f, 0xca, 0xcd,
+	0x61, 0x44, 0x44, 0xbc, 0x5d, 0xca, 0x39, 0x14, 0x30, 0x9e, 0x30, 0x5e, 0x87, 0x21, 0xc7, 0x2b,
+	0x54, 0x6e, 0xe7, 0x4c, 0x82, 0x60, 0x52, 0x0d, 0x7a, 0x1d, 0x89, 0xa9, 0x0b, 0xed, 0x05, 0x76,
+	0xa5, 0xca, 0x85, 0xda, 0xb4, 0x80, 0xdd, 0x76, 0xc7, 0xa7, 0xdc, 0x44, 0x11, 0x11, 0x6b, 0xbf,
+	0xa2, 0x8a, 0x98, 0xa5, 0x41, 0xec, 0x13, 0x5a, 0x66, 0x72, 0x1e, 0xed, 0xc6, 0x67, 0xd3, 0x0b,
+	0x57, 0x82, 0xce, 0xdc, 0x07, 0xa8, 0xfa, 0x09, 0xdb, 0x52, 0xa1, 0xab, 0x25, 0xd1, 0x90, 0x26,
+	0x9f, 0xb9, 0xd9, 0xaf, 0x36, 0xe3, 0x78, 0xe5, 0x10, 0x86, 0x12, 0x5f, 0xc4, 0xce, 0x9c, 0x50,
+	0xe1, 0xd5, 0xb7, 0xb5, 0x29, 0x54, 0x39, 0x89, 0x68, 0x98, 0xea, 0xff, 0xae, 0xf5, 0xab, 0x01,
+	0x5a, 0x08, 0x6f, 0x4a, 0xb5, 0x4d, 0xca, 0x76, 0x04, 0x87, 0xe9, 0xc5, 0xb1, 0x75, 0x2d, 0xb9,
+	0x27, 0x79, 0xcf, 0x35, 0xee, 0x6c, 0xda, 0x87, 0x6a, 0xe0, 0xd3, 0x20, 0x5c, 0xeb, 0x6d, 0x0b,
+	0xd8, 0x2d, 0xaf, 0xae, 0xdc, 0xc7, 0x7d, 0x61, 0x80, 0x43, 0x61, 0x80, 0xaf, 0xc2, 0x00, 0xef,
+	0x47, 0x43, 0x39, 0x1c, 0x0d, 0xe5, 0xe3, 0x68, 0x28, 0xaf, 0xa3, 0x5f, 0x5f, 0x36, 0xfb, 0xd9,
+	0x97, 0x5b, 0x2c, 0xd5, 0xf2, 0xe7, 0x8c, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x51, 0x32, 0x0e,
+	0xf3, 0x85, 0x02, 0x00, 0x00,
 }
 
 func (m *MsgUnBond) Marshal() (dAtA []byte, err error) {
@@ -157,6 +166,16 @@ func (m *MsgUnBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cancel {
+		i--
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.BondProviderAddress) > 0 {
 		i -= len(m.BondProviderAddress)
 		copy(dAtA[i:], m.BondProviderAddress)
@@ -245,6 +264,9 @@ func (m *MsgUnBond) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgUnbond(uint64(l))
 	}
+	if m.Cancel {
+		n += 2
+	}
 	return n
 }
 
@@ -484,6 +506,26 @@ func (m *MsgUnBond) Unmarshal(dAtA []byte) error {
 				m.BondProviderAddress = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgUnbond
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancel = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgUnbond(dAtA[iNdEx:])
@@ -30,173 +30,187 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("types/query.proto", fileDescriptor_3dd69a0a5972f391) }
 
 var fileDescriptor_3dd69a0a5972f391 = []byte{
-	// 2646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0x1b, 0xb9,
-	0x15, 0x5f, 0x6d, 0xd7, 0x89, 0x4d, 0xdb, 0x71, 0xc2, 0x7c, 0xf8, 0x5b, 0x76, 0x1c, 0x27, 0xe9,
-	0x6e, 0x77, 0x33, 0x68, 0xd2, 0x8f, 0x4b, 0x2f, 0xb6, 0xb3, 0x45, 0x17, 0x49, 0x36, 0x4e, 0xec,
-	0x75, 0xd1, 0xa0, 0xa8, 0x4a, 0x69, 0xb8, 0xf2, 0x54, 0xa3, 0xa1, 0x3c, 0xe4, 0xc8, 0xd2, 0xaa,
-	0xda, 0x02, 0x5d, 0xa0, 0x28, 0x8a, 0x1e, 0x0a, 0xf4, 0xd2, 0x53, 0xff, 0x9e, 0x1e, 0x17, 0xe8,
-	0xa5, 0xe8, 0xa9, 0x48, 0xfa, 0x87, 0x14, 0xe4, 0x90, 0x23, 0x72, 0xe6, 0x89, 0xf6, 0xa1, 0x17,
-	0x41, 0x78, 0xbf, 0xc7, 0xf7, 0xe3, 0xc7, 0xe3, 0xe3, 0xe3, 0xe3, 0xa0, 0x1b, 0x62, 0xd8, 0xa3,
-	0x3c, 0x38, 0xcb, 0x68, 0x3a, 0x7c, 0xd4, 0x4b, 0x99, 0x60, 0x78, 0x46, 0x89, 0xd6, 0x36, 0xda,
-	0x8c, 0xb5, 0x63, 0x1a, 0x90, 0x5e, 0x14, 0x90, 0x24, 0x61, 0x82, 0x88, 0x88, 0x25, 0x3c, 0x57,
-	0x5a, 0xbb, 0xd5, 0x66, 0x6d, 0xa6, 0xfe, 0x06, 0xf2, 0x9f, 0x96, 0xde, 0xb1, 0xac, 0x35, 0x7a,
-	0x8c, 0xc5, 0x5a, 0x5e, 0xb7, 0xe5, 0x21, 0x4d, 0xa3, 0x3e, 0x0d, 0x6d, 0x7c, 0xd7, 0xc6, 0xe3,
-	0xe8, 0x2c, 0x8b, 0xc2, 0x48, 0x0c, 0x1b, 0xbd, 0x94, 0xf5, 0xa3, 0x90, 0xa6, 0x5a, 0x6b, 0xd9,
-	0xd6, 0xe2, 0xa4, 0x5f, 0x00, 0x6b, 0x36, 0xd0, 0x64, 0x69, 0xca, 0xce, 0x0b, 0x6c, 0xc3, 0xc6,
-	0x44, 0x4a, 0x42, 0xda, 0xc8, 0x92, 0x48, 0x68, 0x74, 0xab, 0x8a, 0x92, 0x56, 0x8b, 0x65, 0x89,
-	0x80, 0x46, 0x94, 0xb0, 0x90, 0x6a, 0xf9, 0x7a, 0x79, 0xa4, 0x0d, 0x1e, 0x47, 0x3d, 0x68, 0xb8,
-	0x2c, 0x13, 0x4d, 0x96, 0x25, 0x61, 0xe3, 0x4b, 0x6a, 0x1a, 0x6f, 0x3b, 0x03, 0x11, 0x29, 0x25,
-	0xdd, 0x28, 0x69, 0x37, 0xf8, 0x39, 0x31, 0x16, 0x6e, 0x3b, 0x23, 0x22, 0x89, 0x3b, 0x50, 0xf9,
-	0x2b, 0xa5, 0x8d, 0x3e, 0x13, 0xf0, 0x24, 0xa4, 0xa4, 0x9d, 0x90, 0x94, 0x75, 0xa0, 0xde, 0xa6,
-	0x59, 0x42, 0xed, 0xc9, 0xdf, 0xaa, 0x82, 0x9e, 0x79, 0xef, 0x46, 0xdd, 0xc8, 0x00, 0x77, 0x6d,
-	0x20, 0x4a, 0xf2, 0x61, 0x92, 0x30, 0x4c, 0x29, 0x37, 0x7e, 0xb2, 0x6a, 0xab, 0xf4, 0x69, 0xca,
-	0x23, 0x96, 0x40, 0x1d, 0x16, 0xa7, 0x2c, 0x4d, 0x48, 0x97, 0x42, 0xab, 0x16, 0x25, 0x7d, 0x92,
-	0x46, 0x24, 0x11, 0xa0, 0xd1, 0x84, 0x8a, 0x73, 0x96, 0x76, 0xa0, 0xa9, 0x6d, 0x92, 0x98, 0x24,
-	0x2d, 0xda, 0xe8, 0xb2, 0x30, 0x8b, 0x8d, 0xe9, 0x15, 0x5b, 0xe3, 0x2c, 0x63, 0x82, 0x72, 0x68,
-	0x38, 0x2d, 0x96, 0x70, 0x41, 0x12, 0xd1, 0xe8, 0x93, 0x38, 0x2b, 0x54, 0x9c, 0x7e, 0xc9, 0xf5,
-	0x6a, 0x9c, 0x65, 0x34, 0xa3, 0xd0, 0xba, 0x2b, 0x34, 0xa4, 0x82, 0x44, 0x31, 0xd8, 0x3a, 0x26,
-	0x5c, 0x34, 0x9a, 0x31, 0x6b, 0x75, 0xa0, 0x69, 0xee, 0x93, 0x2c, 0x36, 0x3e, 0x78, 0xcb, 0x99,
-	0xa8, 0x01, 0xb4, 0x6c, 0x92, 0xac, 0x47, 0xd3, 0x46, 0x2b, 0x66, 0x59, 0xc9, 0x75, 0x95, 0xb3,
-	0xd8, 0xf2, 0x65, 0x77, 0x02, 0x26, 0xdd, 0x5f, 0x83, 0xdc, 0x16, 0x6a, 0x64, 0xf7, 0xda, 0xf1,
-	0x54, 0x51, 0xac, 0xfb, 0xba, 0x45, 0x2e, 0x38, 0x6f, 0x74, 0xa9, 0x48, 0xa3, 0x16, 0x44, 0xd4,
-	0xa1, 0x43, 0x2e, 0x58, 0x4a, 0xa1, 0xb5, 0xcd, 0x7a, 0x6d, 0xb9, 0x27, 0x21, 0xc8, 0xdd, 0xa6,
-	0xce, 0xa2, 0xd2, 0x41, 0x8f, 0xa5, 0xe0, 0x06, 0x6e, 0x92, 0xa4, 0x03, 0x4e, 0x1f, 0x6d, 0x65,
-	0x29, 0x0d, 0x1b, 0x84, 0x73, 0x6a, 0x1a, 0x6e, 0x3a, 0x03, 0x6b, 0xc9, 0x8d, 0x4a, 0x3a, 0x34,
-	0xe5, 0xd0, 0x5a, 0x4b, 0xb8, 0x15, 0x93, 0xa8, 0x3b, 0xc1, 0x97, 0x5d, 0x67, 0x0a, 0x0b, 0x17,
-	0x72, 0xbb, 0x1a, 0xf5, 0x7e, 0xfc, 0xfd, 0xc7, 0x39, 0xf2, 0xf8, 0xdf, 0x3f, 0x43, 0x33, 0xaf,
-	0xa4, 0x18, 0x87, 0xe8, 0xea, 0x5e, 0x3e, 0x3e, 0xbc, 0xf6, 0x48, 0xe9, 0x3f, 0x52, 0x80, 0x16,
-	0xbe, 0xa6, 0x67, 0x19, 0xe5, 0x62, 0x6d, 0x1d, 0xc4, 0x78, 0x8f, 0x25, 0x9c, 0xee, 0x6c, 0xff,
-	0xfe, 0x9f, 0xff, 0xfd, 0xeb, 0xfb, 0x6b, 0x78, 0x25, 0x20, 0x99, 0x38, 0x0d, 0xf4, 0x7c, 0xf1,
-	0x60, 0xa4, 0x37, 0xe8, 0x18, 0xb7, 0xd1, 0xec, 0x7e, 0xbe, 0x43, 0x38, 0x76, 0x4c, 0x19, 0xa9,
-	0xe1, 0xd9, 0x80, 0xc1, 0x0a, 0x91, 0x9c, 0xde, 0x40, 0x6f, 0x3a, 0x9b, 0xe8, 0x0d, 0xba, 0xf2,
-	0xa9, 0x5a, 0x13, 0xbc, 0x6a, 0x5b, 0xca, 0x65, 0x86, 0x64, 0x0d, 0x82, 0x34, 0xc5, 0xaa, 0xa2,
-	0xb8, 0x89, 0x6f, 0x04, 0x32, 0x4e, 0xb4, 0x4e, 0x49, 0x94, 0x04, 0xf9, 0x2a, 0xe3, 0x37, 0xe8,
-	0x83, 0x43, 0xc6, 0x62, 0xbc, 0x6c, 0x37, 0x97, 0x12, 0x63, 0x77, 0xa5, 0x0a, 0x68, 0xab, 0x5b,
-	0xca, 0xea, 0x2a, 0x5e, 0xb6, 0xac, 0xca, 0xb8, 0x18, 0x8c, 0x94, 0x1f, 0x8c, 0xf1, 0x09, 0x9a,
-	0x91, 0x0d, 0x38, 0xae, 0xd8, 0x28, 0xa6, 0x66, 0x15, 0x40, 0xb4, 0xf9, 0x15, 0x65, 0x1e, 0xe3,
-	0xeb, 0x25, 0xf3, 0x1c, 0xf7, 0xd0, 0xfc, 0xd3, 0xfc, 0x10, 0x54, 0x5d, 0xaf, 0xdb, 0x36, 0x2c,
-	0xc0, 0x70, 0x6c, 0x4d, 0xc5, 0x2b, 0x2b, 0x30, 0x61, 0x0a, 0x9d, 0x91, 0xc4, 0x68, 0xc1, 0x6a,
-	0xc8, 0xf1, 0x34, 0x93, 0xc5, 0xb8, 0xb6, 0xa7, 0x2b, 0x78, 0xd6, 0x24, 0xcc, 0xc7, 0xf7, 0xf7,
-	0x1a, 0xba, 0xf1, 0xdc, 0x9c, 0xe2, 0x87, 0xfa, 0x30, 0xc1, 0xbb, 0xb6, 0xc9, 0x0a, 0x6c, 0x88,
-	0xef, 0x5f, 0xa0, 0xa5, 0xd9, 0x7f, 0xa2, 0xd8, 0x7f, 0x84, 0x7f, 0x30, 0x65, 0xed, 0x82, 0x6a,
-	0x16, 0x61, 0x39, 0xe4, 0xdf, 0x6a, 0x08, 0x57, 0x6c, 0x73, 0xec, 0xe7, 0x2e, 0xe6, 0xe6, 0xc1,
-	0x45, 0x6a, 0xba, 0x8f, 0x4f, 0x54, 0x1f, 0x3f, 0xc1, 0xdf, 0xbb, 0x7c, 0x1f, 0x39, 0xee, 0xa2,
-	0x99, 0x23, 0x99, 0xda, 0xb8, 0x3e, 0xa7, 0x44, 0xa0, 0xcf, 0x69, 0x44, 0x53, 0x06, 0x8a, 0xf2,
-	0x43, 0xfc, 0x70, 0x1a, 0xa5, 0x4a, 0x9b, 0x9c, 0x18, 0x70, 0x45, 0x59, 0xe0, 0xb8, 0x6a, 0x95,
-	0x83, 0x5b, 0xd3, 0x40, 0x9a, 0xf1, 0x81, 0x62, 0xdc, 0xc6, 0x75, 0x2f, 0x23, 0xc7, 0x43, 0x34,
-	0xbb, 0xaf, 0x33, 0xb3, 0x52, 0xb0, 0xd1, 0x52, 0x38, 0xd8, 0x14, 0xa0, 0xa6, 0x7b, 0xac, 0xe8,
-	0x3e, 0xc6, 0x1f, 0x4d, 0xa3, 0x33, 0xe9, 0x9f, 0x35, 0x46, 0x8e, 0xe6, 0x8c, 0x1d, 0x8e, 0x41,
-	0xf3, 0xc5, 0x48, 0x37, 0xa7, 0xa0, 0x9a, 0xfd, 0x43, 0xc5, 0x7e, 0x0f, 0xdf, 0xbd, 0x88, 0x9d,
-	0x63, 0x86, 0xe6, 0x8e, 0xe5, 0xd9, 0xf5, 0x45, 0x12, 0x09, 0x97, 0xb4, 0x10, 0x83, 0xa4, 0x16,
-	0xaa, 0x49, 0xef, 0x2b, 0xd2, 0x2d, 0xbc, 0x69, 0x91, 0xaa, 0x54, 0x35, 0x90, 0x89, 0x6c, 0xb1,
-	0xc5, 0x3b, 0x08, 0x15, 0x6d, 0x39, 0x86, 0x6d, 0x16, 0xe3, 0xac, 0x4f, 0x83, 0x35, 0x67, 0x5d,
-	0x71, 0xae, 0xe0, 0x3b, 0x20, 0x27, 0xc7, 0x5f, 0xa3, 0x05, 0xd5, 0xca, 0x9c, 0x52, 0x5b, 0x15,
-	0x7b, 0xa5, 0xa3, 0xea, 0xee, 0x34, 0x85, 0x09, 0xe7, 0x47, 0x8a, 0x73, 0x17, 0xef, 0x54, 0x38,
-	0xf5, 0xd9, 0x65, 0x2d, 0xe9, 0xef, 0xd0, 0xa2, 0x63, 0x04, 0x6f, 0x7b, 0xec, 0x5f, 0xba, 0x07,
-	0xd0, 0xf2, 0x3a, 0x3d, 0xe0, 0xc5, 0x6c, 0x7f, 0x85, 0x16, 0x8e, 0xf2, 0xa4, 0x61, 0x4f, 0x0a,
-	0xdc, 0x09, 0xb0, 0x11, 0x30, 0xa0, 0xba, 0x0a, 0x9a, 0xfd, 0xa1, 0x62, 0xbf, 0x8b, 0xb7, 0x2c,
-	0x76, 0x9d, 0x98, 0x28, 0xd2, 0x82, 0xbb, 0x8f, 0x16, 0x6d, 0x03, 0xa5, 0xc1, 0x3b, 0x10, 0x38,
-	0xf8, 0x92, 0x86, 0xe7, 0x10, 0xb1, 0xe9, 0x39, 0xfe, 0x25, 0xfa, 0xe0, 0x73, 0x16, 0x52, 0xf7,
-	0xa8, 0x95, 0x12, 0xf0, 0xa8, 0xcd, 0x01, 0x6d, 0xfc, 0xae, 0x32, 0xbe, 0x8e, 0x57, 0x2d, 0xe3,
-	0xf2, 0x36, 0x65, 0x2d, 0xe9, 0x09, 0x9a, 0x91, 0x4d, 0x4a, 0x87, 0xad, 0x12, 0x81, 0x81, 0x4f,
-	0x23, 0x9e, 0xc3, 0x36, 0x51, 0xe6, 0xda, 0x68, 0x56, 0x1e, 0x5c, 0x47, 0x71, 0xd4, 0x73, 0x03,
-	0x8f, 0x91, 0x82, 0xdb, 0xd0, 0x80, 0xdc, 0x9b, 0x2d, 0xc8, 0x3b, 0x5f, 0xb1, 0x2c, 0x4d, 0x34,
-	0x57, 0xb4, 0x72, 0x77, 0xbc, 0x65, 0xec, 0x52, 0x54, 0xd0, 0x60, 0xb8, 0x32, 0x3b, 0x40, 0xf3,
-	0x2f, 0x75, 0x62, 0xfe, 0x53, 0x4a, 0xdd, 0xcc, 0xc1, 0x02, 0x40, 0xa7, 0xb3, 0x70, 0xee, 0x75,
-	0x3a, 0xfb, 0xc6, 0x5a, 0x8c, 0x8e, 0xa3, 0x05, 0xdb, 0x80, 0xeb, 0xf0, 0xae, 0xe9, 0xcb, 0x72,
-	0x43, 0x1e, 0x67, 0x73, 0x73, 0xb9, 0xcd, 0x8f, 0xcc, 0xf5, 0xf8, 0xe8, 0x9c, 0xf4, 0x4a, 0x9e,
-	0x6e, 0x43, 0xb0, 0xa7, 0xbb, 0x1a, 0x9e, 0x6d, 0x2e, 0x2f, 0x50, 0x41, 0x71, 0x15, 0x0f, 0x46,
-	0x62, 0xd0, 0x88, 0xc2, 0x31, 0x1e, 0xa1, 0x6b, 0x8e, 0x0d, 0x8e, 0xa7, 0xdb, 0x2f, 0x46, 0xbe,
-	0xe3, 0x53, 0xd1, 0x7d, 0xd8, 0x51, 0x7d, 0xd8, 0xc0, 0x6b, 0xa5, 0x3e, 0xf0, 0x49, 0x27, 0xf0,
-	0x21, 0xfa, 0xce, 0x3e, 0x49, 0xf0, 0x1d, 0x37, 0xfb, 0x4e, 0x0c, 0xcd, 0x92, 0x96, 0xef, 0x93,
-	0xe4, 0x84, 0x09, 0x9a, 0x82, 0xf3, 0xd9, 0x24, 0x89, 0xb5, 0xc7, 0x9a, 0x68, 0xf6, 0xb5, 0xae,
-	0x0c, 0xb8, 0x7b, 0xc1, 0x48, 0xc1, 0x43, 0x78, 0x02, 0xea, 0xce, 0xaf, 0x2b, 0xa2, 0xdb, 0xf8,
-	0xa6, 0x45, 0x64, 0x2a, 0x0e, 0x8a, 0x23, 0x4b, 0xa8, 0xca, 0x6c, 0x5d, 0x0e, 0x2d, 0x85, 0x39,
-	0x0a, 0xd0, 0xc7, 0x91, 0x25, 0x54, 0x1e, 0xb7, 0x78, 0x8c, 0x16, 0x54, 0x03, 0x93, 0x5a, 0x6e,
-	0x55, 0x4c, 0x95, 0xb2, 0xca, 0xed, 0xe9, 0x0a, 0x9e, 0xd3, 0xc7, 0x29, 0x86, 0x58, 0xd3, 0x78,
-	0x8e, 0x16, 0x6d, 0x1b, 0xa5, 0x00, 0xec, 0x40, 0xa0, 0x5b, 0x96, 0x34, 0x3c, 0x31, 0xd2, 0xe9,
-	0x01, 0xc7, 0xa7, 0x68, 0xfe, 0x45, 0xd4, 0x8d, 0xd2, 0x13, 0x55, 0x93, 0x70, 0xb7, 0xbf, 0x05,
-	0x80, 0x17, 0x07, 0x07, 0xf7, 0x04, 0x1a, 0x55, 0xe0, 0xc1, 0x19, 0x5a, 0x50, 0x0d, 0x7e, 0x1e,
-	0x89, 0xd3, 0x67, 0x74, 0x88, 0xab, 0xa6, 0x34, 0x02, 0xce, 0xb0, 0xab, 0xe0, 0x71, 0x79, 0x45,
-	0x16, 0x74, 0xe8, 0x30, 0x18, 0x75, 0xe8, 0x70, 0x8c, 0xc7, 0xe8, 0xba, 0x6a, 0xbb, 0x17, 0x76,
-	0xa3, 0x44, 0x8f, 0xf2, 0x5e, 0xc5, 0xb2, 0x85, 0x1a, 0xfa, 0x5d, 0xbf, 0x92, 0x27, 0xad, 0xc9,
-	0xbb, 0x40, 0xa4, 0x36, 0xfe, 0x53, 0x0d, 0xdd, 0x54, 0x8d, 0xd5, 0xe1, 0xb2, 0x17, 0xc7, 0xba,
-	0x0b, 0x0f, 0x2a, 0xd6, 0x5d, 0x05, 0xd3, 0x8b, 0x87, 0x17, 0xea, 0x5d, 0x38, 0x17, 0xea, 0xd0,
-	0x6a, 0x90, 0x38, 0x2e, 0xe6, 0x42, 0x99, 0x98, 0x3a, 0x17, 0x16, 0x3a, 0x7d, 0x2e, 0x1c, 0xa5,
-	0x0b, 0xe7, 0x22, 0x3f, 0x37, 0xbf, 0xa9, 0xa1, 0xa5, 0xa2, 0xb1, 0xa6, 0xdf, 0x01, 0x2d, 0xbb,
-	0xec, 0xf7, 0xbc, 0x3a, 0x9e, 0x63, 0x67, 0x42, 0x6e, 0x6d, 0xb5, 0x6f, 0x6a, 0xe8, 0xfa, 0x67,
-	0x79, 0x65, 0x71, 0x2f, 0x97, 0x95, 0x67, 0xa1, 0x8c, 0x82, 0xb3, 0x50, 0x55, 0xd2, 0x1d, 0xd9,
-	0x55, 0x1d, 0xa9, 0xe3, 0x0d, 0xab, 0x23, 0xa5, 0x4a, 0x26, 0xe5, 0xf8, 0x57, 0xe8, 0xea, 0x49,
-	0x5e, 0xbb, 0x74, 0xeb, 0x31, 0x5a, 0x08, 0xd6, 0x63, 0x0a, 0x4c, 0x33, 0xad, 0x29, 0xa6, 0x5b,
-	0x18, 0x5b, 0x4c, 0xba, 0x20, 0x8a, 0x23, 0x34, 0x7b, 0xac, 0x0b, 0xa0, 0x6e, 0xcc, 0x34, 0x52,
-	0x30, 0x66, 0x4e, 0x40, 0x8f, 0x57, 0x99, 0xc2, 0x6a, 0x30, 0x92, 0xbf, 0x63, 0xdc, 0x45, 0x73,
-	0x9f, 0x99, 0x7a, 0xaa, 0x9b, 0xa5, 0x14, 0x62, 0x30, 0x4b, 0xb1, 0x50, 0xcd, 0x76, 0x4f, 0xb1,
-	0x6d, 0xe2, 0x75, 0x67, 0xea, 0xb4, 0x56, 0x30, 0xea, 0x11, 0x71, 0x3a, 0xc6, 0xbf, 0x41, 0xa8,
-	0x68, 0x59, 0xba, 0x95, 0x4c, 0xe4, 0xe0, 0xad, 0xc4, 0x86, 0x35, 0xe3, 0xa6, 0x62, 0x5c, 0xc6,
-	0xb7, 0x21, 0x46, 0xb5, 0x4a, 0x9f, 0xe7, 0xc5, 0x60, 0x77, 0x95, 0xb4, 0x10, 0x5c, 0xa5, 0x02,
-	0xf3, 0xac, 0x92, 0xae, 0x30, 0xe3, 0xaf, 0xd1, 0xa2, 0x2e, 0x7e, 0xbd, 0x50, 0x05, 0x65, 0x37,
-	0xec, 0x3b, 0x10, 0x18, 0xf6, 0x4b, 0x1a, 0x9a, 0xf1, 0xbb, 0x8a, 0x71, 0x07, 0x6f, 0x3b, 0xa7,
-	0xb6, 0xd2, 0x0c, 0xf2, 0xc2, 0xb5, 0x59, 0xba, 0x36, 0x9a, 0x7b, 0x95, 0x31, 0x41, 0x55, 0x26,
-	0xe4, 0x2c, 0x5d, 0x21, 0x06, 0x97, 0xce, 0x42, 0x3d, 0x13, 0xa9, 0x4a, 0xe1, 0x2a, 0x07, 0xc1,
-	0x7f, 0xac, 0xa1, 0x1b, 0x79, 0x23, 0x79, 0x77, 0x7f, 0x4a, 0x7b, 0x8c, 0x47, 0xc2, 0xad, 0xdf,
-	0x54, 0x60, 0xb0, 0x7e, 0x03, 0x68, 0x79, 0xca, 0x06, 0xba, 0x07, 0xaa, 0x42, 0x11, 0x6a, 0xd2,
-	0x3f, 0xd7, 0x10, 0x9e, 0x58, 0x91, 0x47, 0x4a, 0x98, 0x92, 0x73, 0x3c, 0x85, 0xc5, 0xe0, 0x60,
-	0xa5, 0x06, 0x52, 0xf3, 0x84, 0x23, 0xbb, 0x37, 0xe7, 0x86, 0x77, 0x80, 0x16, 0x95, 0x99, 0xe7,
-	0x8c, 0x24, 0x2f, 0x7b, 0x34, 0x71, 0x5d, 0xc0, 0x81, 0x40, 0x17, 0x28, 0x69, 0x78, 0xf6, 0x6d,
-	0x4e, 0x1f, 0x33, 0x92, 0x04, 0x4c, 0x12, 0xfd, 0x16, 0x5d, 0x2b, 0x1a, 0x1f, 0xc4, 0x8c, 0x53,
-	0x0c, 0x1b, 0x56, 0x18, 0x98, 0x89, 0x96, 0x55, 0x3c, 0xdb, 0xd8, 0x22, 0x6f, 0x29, 0xae, 0x0c,
-	0x5d, 0x3b, 0xd0, 0x0f, 0x22, 0xfa, 0x28, 0x70, 0xd8, 0x5d, 0x0c, 0x64, 0x2f, 0xab, 0x68, 0xf6,
-	0x0d, 0xc5, 0x7e, 0x07, 0xdf, 0xb2, 0xd8, 0xcd, 0xd3, 0x8b, 0xbc, 0xbb, 0xcd, 0x49, 0xbf, 0x7d,
-	0x95, 0xd1, 0x8c, 0xba, 0x1e, 0x5f, 0x88, 0x41, 0x8f, 0xb7, 0x50, 0xaf, 0xc7, 0xd3, 0x4c, 0x7b,
-	0xfc, 0x18, 0xcd, 0xcb, 0x36, 0x4f, 0xf3, 0xe7, 0x1a, 0x37, 0xb1, 0xb2, 0x00, 0x30, 0xb1, 0x72,
-	0x70, 0x4d, 0xf7, 0x89, 0xa2, 0x7b, 0x88, 0xef, 0x83, 0x74, 0x81, 0x7e, 0x16, 0x2a, 0xae, 0x19,
-	0x11, 0x42, 0xcf, 0x09, 0x17, 0xfb, 0x31, 0x6b, 0x75, 0x4a, 0x51, 0x72, 0x22, 0x07, 0xa3, 0xa4,
-	0x0d, 0x7b, 0xa6, 0x34, 0x26, 0x5c, 0xa8, 0x57, 0x1b, 0x3c, 0x42, 0x4b, 0x07, 0x52, 0xc4, 0x8b,
-	0x96, 0xee, 0xa9, 0x5e, 0x02, 0x2f, 0x4b, 0x0a, 0x9d, 0xa3, 0x05, 0x69, 0x30, 0x52, 0x82, 0x31,
-	0xfe, 0x35, 0x9a, 0x39, 0x21, 0x59, 0x2c, 0xdc, 0x3b, 0xbe, 0x12, 0x81, 0x77, 0x7c, 0x8d, 0x78,
-	0xb6, 0x89, 0x7a, 0x27, 0x0b, 0x46, 0xbd, 0xac, 0xd9, 0x50, 0x09, 0x24, 0x47, 0x0b, 0x7b, 0xbc,
-	0x4d, 0xd2, 0x50, 0x35, 0x2d, 0x5d, 0x53, 0x6d, 0x04, 0xcc, 0x5b, 0x5d, 0x05, 0xcf, 0x35, 0x55,
-	0xd1, 0xf2, 0x80, 0x28, 0x7d, 0x79, 0x1f, 0xc8, 0xdb, 0x1c, 0x66, 0xcd, 0x0e, 0x1d, 0x96, 0xee,
-	0x03, 0x0e, 0x04, 0x46, 0x85, 0x92, 0x86, 0xe7, 0x3e, 0xa0, 0x79, 0x7b, 0x9a, 0xa7, 0x83, 0x66,
-	0x8f, 0x07, 0x47, 0x82, 0xb4, 0xcb, 0x2f, 0x38, 0x46, 0x0a, 0xe7, 0x0d, 0x05, 0xe8, 0x59, 0x3c,
-	0x31, 0x08, 0xb8, 0xd2, 0x2a, 0x9c, 0xd4, 0x90, 0x89, 0x0c, 0x22, 0x13, 0x99, 0x87, 0x4c, 0x81,
-	0x17, 0x92, 0x89, 0x6c, 0x42, 0xf6, 0x1a, 0xbd, 0x7f, 0x3c, 0xc0, 0xb7, 0x5d, 0x4b, 0x86, 0xe0,
-	0x4e, 0x59, 0xec, 0xd9, 0xe4, 0x62, 0xe0, 0x0e, 0x40, 0x5d, 0x95, 0x2b, 0x03, 0xc8, 0xa5, 0x60,
-	0x86, 0xf0, 0xb2, 0xc9, 0x69, 0xda, 0xa7, 0xa1, 0x56, 0x82, 0xcb, 0xb1, 0x83, 0xca, 0x96, 0x4e,
-	0xd0, 0xbc, 0x31, 0xfb, 0x32, 0x0e, 0xff, 0xff, 0x7c, 0x9a, 0x27, 0xe0, 0x51, 0x3b, 0x91, 0x03,
-	0xfa, 0x12, 0xcd, 0x1c, 0xc4, 0x2c, 0x2b, 0x57, 0x22, 0xf3, 0x77, 0x61, 0x85, 0x18, 0xb6, 0x9b,
-	0x5a, 0xc1, 0xc6, 0xc0, 0x13, 0x50, 0x3d, 0x1a, 0xe7, 0x01, 0xcb, 0x29, 0xd3, 0xe5, 0xe1, 0x78,
-	0xc5, 0x3d, 0x5b, 0xac, 0x50, 0xbc, 0x0a, 0x20, 0x9e, 0x0b, 0xa7, 0x8a, 0x8b, 0x78, 0x84, 0x6e,
-	0x1c, 0xb5, 0x4e, 0xa9, 0xcc, 0x77, 0x42, 0x53, 0x2d, 0x72, 0x53, 0x8e, 0x0a, 0x0c, 0xfa, 0xdb,
-	0x04, 0xf4, 0x1e, 0xae, 0x2a, 0x14, 0x1b, 0x83, 0xb8, 0x8f, 0x96, 0x0e, 0x69, 0x12, 0x46, 0x49,
-	0xbb, 0xa0, 0x76, 0x82, 0x62, 0x09, 0xbc, 0x1c, 0x31, 0xb4, 0x7f, 0x73, 0x62, 0x53, 0xe4, 0x92,
-	0x93, 0x99, 0x87, 0x60, 0x67, 0x32, 0x9d, 0xc0, 0xbb, 0x0a, 0x20, 0x9e, 0xc9, 0xcc, 0x83, 0xfc,
-	0x1f, 0x6a, 0x68, 0xe9, 0x98, 0xf3, 0x67, 0x74, 0xd8, 0xa6, 0xc9, 0x0b, 0xf5, 0xf4, 0xee, 0x0e,
-	0xa8, 0x04, 0x82, 0x77, 0xb7, 0x8a, 0x8e, 0xa7, 0x52, 0x92, 0x3f, 0xef, 0xcb, 0x8b, 0x7c, 0x9b,
-	0x26, 0x56, 0x38, 0x0e, 0xd1, 0xdc, 0x31, 0xe7, 0xba, 0x07, 0x1b, 0x25, 0xeb, 0x2e, 0xf7, 0xe6,
-	0x14, 0xd4, 0x93, 0x98, 0xe7, 0xac, 0x32, 0x4d, 0xb8, 0xfa, 0x8c, 0x0e, 0xe5, 0x4e, 0x70, 0x13,
-	0x7f, 0x2d, 0x04, 0xb7, 0x59, 0x81, 0x79, 0xd2, 0xa0, 0x4e, 0xae, 0x13, 0x8c, 0x4e, 0x69, 0xd4,
-	0x3e, 0x15, 0x63, 0x3c, 0x46, 0x8b, 0xba, 0x5d, 0x1e, 0xac, 0xdd, 0x40, 0xef, 0x40, 0x97, 0x22,
-	0x85, 0xd2, 0x84, 0x32, 0xa9, 0x35, 0x9b, 0x5d, 0x74, 0x25, 0x5f, 0x11, 0xf7, 0xb1, 0x2e, 0x97,
-	0x81, 0x8f, 0x75, 0x06, 0xd2, 0x7c, 0x1f, 0x2b, 0xbe, 0x07, 0x78, 0xd7, 0xe5, 0x53, 0x6b, 0x56,
-	0xa5, 0x93, 0x77, 0xef, 0x2f, 0xf2, 0x2f, 0x30, 0x0e, 0x53, 0xd6, 0x63, 0x9c, 0xc4, 0xa5, 0xbb,
-	0x77, 0x19, 0x05, 0xef, 0xde, 0x55, 0x25, 0xcf, 0x49, 0xa0, 0xbf, 0xf8, 0x68, 0xf4, 0x0a, 0x42,
-	0xe9, 0xcb, 0x25, 0x13, 0xae, 0x2f, 0x97, 0x40, 0xd0, 0x97, 0x2b, 0x3a, 0x1e, 0x5f, 0x2e, 0x77,
-	0xc1, 0x5c, 0xbf, 0xbe, 0x42, 0x0b, 0xda, 0x8c, 0x0c, 0xcc, 0xa5, 0xd4, 0xc2, 0x46, 0xc0, 0xd4,
-	0xc2, 0x55, 0xf0, 0xdc, 0x3b, 0x0c, 0x7d, 0x5f, 0x6a, 0x1a, 0xee, 0x33, 0x34, 0x77, 0x7c, 0xf0,
-	0x8b, 0x23, 0xf5, 0x05, 0x4a, 0x69, 0x1f, 0x19, 0x31, 0xbc, 0x8f, 0x26, 0xa8, 0x87, 0x72, 0xf2,
-	0x75, 0x8b, 0x15, 0xe8, 0x3b, 0x08, 0x15, 0xad, 0xcb, 0xef, 0x89, 0x85, 0x1c, 0x7e, 0x4f, 0xb4,
-	0x60, 0xdf, 0x7b, 0xe2, 0xe4, 0x9b, 0x1a, 0x9c, 0x29, 0xb2, 0x83, 0xfc, 0x13, 0x9a, 0x0a, 0x99,
-	0x96, 0x4f, 0x23, 0x2b, 0x60, 0xcf, 0x8d, 0xda, 0xfa, 0x42, 0xc7, 0x1a, 0x23, 0x43, 0xf3, 0x93,
-	0xf6, 0xa5, 0xb4, 0xdf, 0x02, 0xc0, 0xb4, 0xdf, 0xc1, 0x3d, 0x6f, 0x44, 0xf6, 0xb7, 0x41, 0x78,
-	0x80, 0x96, 0x3e, 0x55, 0x9f, 0xfc, 0x1c, 0x0f, 0x7b, 0x34, 0x7c, 0x4a, 0x04, 0x71, 0x7d, 0xb9,
-	0x04, 0x82, 0xbe, 0x5c, 0xd1, 0xf1, 0xe4, 0xfd, 0xea, 0xfb, 0xa2, 0x1f, 0x06, 0x62, 0xb0, 0xff,
-	0xfc, 0x1f, 0x6f, 0xeb, 0xb5, 0x6f, 0xdf, 0xd6, 0x6b, 0xff, 0x79, 0x5b, 0xaf, 0xfd, 0xe5, 0x5d,
-	0xfd, 0xbd, 0x6f, 0xdf, 0xd5, 0xdf, 0xfb, 0xd7, 0xbb, 0xfa, 0x7b, 0x6f, 0x1e, 0xb7, 0x23, 0x11,
-	0x93, 0xe6, 0xa3, 0x16, 0xeb, 0x96, 0xeb, 0x46, 0x2c, 0xa4, 0x41, 0xff, 0x49, 0x30, 0xb0, 0xe5,
-	0xb2, 0x23, 0xcd, 0x2b, 0xea, 0x8b, 0xa5, 0x27, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x15,
-	0xed, 0xc9, 0x3f, 0x2a, 0x00, 0x00,
+	// 2879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xdd, 0x6f, 0x1c, 0xb7,
+	0x11, 0xcf, 0xa5, 0xb1, 0x63, 0x51, 0x52, 0x1c, 0xd3, 0x1f, 0xb2, 0x64, 0xe9, 0x24, 0x4b, 0x8a,
+	0xdd, 0xa4, 0x89, 0x17, 0x75, 0xfa, 0xf1, 0xd2, 0x17, 0x49, 0x4e, 0xd1, 0x20, 0x4e, 0x2c, 0x5b,
+	0x8a, 0x82, 0x06, 0x45, 0xaf, 0xbc, 0x5b, 0xe6, 0xb4, 0xbd, 0xbd, 0xe5, 0x69, 0xc9, 0x3d, 0xdd,
+	0xe5, 0x7a, 0x29, 0xd0, 0x00, 0x45, 0x11, 0xf4, 0xa1, 0x40, 0x81, 0xa2, 0x4f, 0xfd, 0x7b, 0xfa,
+	0x18, 0xa0, 0x28, 0xd0, 0xc7, 0x22, 0xe9, 0x1f, 0x52, 0x90, 0x4b, 0xee, 0x91, 0xdc, 0x39, 0x4a,
+	0x05, 0xfa, 0x72, 0x38, 0xcc, 0x6f, 0x38, 0x3f, 0x7e, 0x0c, 0x87, 0xc3, 0xe1, 0xa2, 0x1b, 0x62,
+	0x3c, 0xa0, 0x3c, 0x3a, 0x2b, 0x68, 0x3e, 0x7e, 0x34, 0xc8, 0x99, 0x60, 0xf8, 0x8a, 0x12, 0xad,
+	0xad, 0x77, 0x19, 0xeb, 0xa6, 0x34, 0x22, 0x83, 0x24, 0x22, 0x59, 0xc6, 0x04, 0x11, 0x09, 0xcb,
+	0x78, 0xa9, 0xb4, 0x76, 0xab, 0xcb, 0xba, 0x4c, 0xfd, 0x8d, 0xe4, 0x3f, 0x2d, 0xbd, 0x63, 0x59,
+	0x6b, 0x0d, 0x18, 0x4b, 0xb5, 0xbc, 0x69, 0xcb, 0x63, 0x9a, 0x27, 0x43, 0x1a, 0xdb, 0xf8, 0xae,
+	0x8d, 0xa7, 0xc9, 0x59, 0x91, 0xc4, 0x89, 0x18, 0xb7, 0x06, 0x39, 0x1b, 0x26, 0x31, 0xcd, 0xb5,
+	0xd6, 0x8a, 0xad, 0xc5, 0xc9, 0xb0, 0x02, 0xd6, 0x6c, 0xa0, 0xcd, 0xf2, 0x9c, 0x9d, 0x57, 0xd8,
+	0xba, 0x8d, 0x89, 0x9c, 0xc4, 0xb4, 0x55, 0x64, 0x89, 0xd0, 0xe8, 0x66, 0x1d, 0x25, 0x9d, 0x0e,
+	0x2b, 0x32, 0x01, 0x8d, 0x28, 0x63, 0x31, 0xd5, 0xf2, 0x1d, 0x5b, 0xce, 0xda, 0x9c, 0xe6, 0x43,
+	0x35, 0x3f, 0x2d, 0x2e, 0x88, 0x30, 0x93, 0x74, 0xcf, 0x9f, 0x8e, 0x16, 0x4f, 0x93, 0x01, 0x34,
+	0x27, 0x0a, 0x3c, 0x4d, 0xb8, 0x60, 0x66, 0x19, 0x80, 0xc6, 0xe2, 0x9c, 0x80, 0x8d, 0x59, 0x21,
+	0xda, 0xac, 0xc8, 0xe2, 0xd6, 0x67, 0xd4, 0x74, 0x6f, 0xcb, 0x99, 0x2a, 0x91, 0x53, 0xd2, 0x4f,
+	0xb2, 0x6e, 0x8b, 0xcf, 0x2c, 0x38, 0x1a, 0x39, 0xed, 0x14, 0x79, 0xee, 0x69, 0xdc, 0x76, 0x66,
+	0x95, 0x64, 0xee, 0x64, 0xcb, 0x5f, 0x29, 0x6d, 0x0d, 0x99, 0x80, 0x17, 0x22, 0x27, 0xdd, 0x8c,
+	0xe4, 0xac, 0x07, 0x8d, 0x27, 0x2f, 0x32, 0x6a, 0x3b, 0xc0, 0x66, 0x1d, 0x0c, 0xac, 0x7d, 0x3f,
+	0xe9, 0x27, 0x06, 0xb8, 0x6f, 0x03, 0x49, 0x56, 0x4e, 0x04, 0x89, 0xe3, 0x9c, 0x72, 0xb3, 0x0c,
+	0xab, 0xb6, 0xca, 0x90, 0xe6, 0x3c, 0x61, 0x19, 0xd4, 0x61, 0x71, 0xca, 0xf2, 0x8c, 0xf4, 0x29,
+	0xe4, 0x39, 0x49, 0x36, 0x24, 0x79, 0x42, 0x32, 0x01, 0x1a, 0xcd, 0xa8, 0x38, 0x67, 0x79, 0x0f,
+	0x9a, 0xda, 0x36, 0x49, 0x49, 0xd6, 0xa1, 0xad, 0x3e, 0x8b, 0x8b, 0xd4, 0x98, 0xbe, 0x6b, 0x6b,
+	0x9c, 0x15, 0x4c, 0x50, 0x0e, 0x0d, 0xa7, 0xc3, 0x32, 0x2e, 0x48, 0x26, 0x5a, 0x43, 0x92, 0x16,
+	0x95, 0x8a, 0xd3, 0x2f, 0xb9, 0x5e, 0xad, 0xb3, 0x82, 0x16, 0x14, 0xf2, 0x0c, 0x85, 0xc6, 0x54,
+	0x90, 0x24, 0x05, 0x5b, 0xa7, 0x84, 0x8b, 0x56, 0x3b, 0x65, 0x9d, 0x1e, 0x34, 0xcd, 0x43, 0x52,
+	0xa4, 0x66, 0x1f, 0xdc, 0x72, 0x26, 0x6a, 0x04, 0x2d, 0x9b, 0x24, 0x1b, 0xd0, 0xbc, 0xd5, 0x49,
+	0x59, 0xe1, 0x6d, 0x1f, 0xe5, 0x2c, 0xb6, 0x7c, 0xc5, 0x9d, 0x80, 0x59, 0xf7, 0xd7, 0x20, 0xc7,
+	0x86, 0x1a, 0xd9, 0xbd, 0x76, 0x3c, 0x55, 0x70, 0x6f, 0xfb, 0x29, 0x72, 0xc1, 0x79, 0xab, 0x4f,
+	0x45, 0x9e, 0x74, 0x20, 0xa2, 0x1e, 0x1d, 0xcb, 0xad, 0x47, 0xa1, 0xb5, 0x2d, 0x06, 0x5d, 0x19,
+	0x17, 0x20, 0xc8, 0x0d, 0x15, 0xce, 0xa2, 0xd2, 0xd1, 0x80, 0xe5, 0x60, 0x10, 0x69, 0x93, 0xac,
+	0x07, 0x4e, 0x9f, 0xdc, 0x83, 0x34, 0x6e, 0x11, 0xce, 0xa9, 0x69, 0xb8, 0xe1, 0x0c, 0xac, 0x23,
+	0xb7, 0x32, 0xe9, 0xd1, 0x9c, 0x43, 0x6b, 0x2d, 0xe1, 0x4e, 0x4a, 0x92, 0xfe, 0x0c, 0x5f, 0x71,
+	0x9d, 0x29, 0xae, 0x5c, 0xc8, 0xed, 0x6a, 0x32, 0xf8, 0xf1, 0xf7, 0x1f, 0x97, 0xc8, 0xe3, 0x7f,
+	0x3e, 0x47, 0x57, 0x9e, 0x4b, 0x31, 0x8e, 0xd1, 0xab, 0x7b, 0xe5, 0xf8, 0xf0, 0xda, 0x23, 0xa5,
+	0xff, 0x48, 0x01, 0x5a, 0xf8, 0x82, 0x9e, 0x15, 0x94, 0x8b, 0xb5, 0x7b, 0x20, 0xc6, 0x07, 0x2c,
+	0xe3, 0x74, 0x7b, 0xeb, 0x77, 0xff, 0xf8, 0xcf, 0x9f, 0x5f, 0x5e, 0xc3, 0x77, 0x23, 0x52, 0x88,
+	0xd3, 0x48, 0xcf, 0x17, 0x8f, 0x26, 0x7a, 0x83, 0x4e, 0x71, 0x17, 0x5d, 0xdb, 0x2f, 0x77, 0x08,
+	0xc7, 0x8e, 0x29, 0x23, 0x35, 0x3c, 0xeb, 0x30, 0x58, 0x23, 0x92, 0xd3, 0x1b, 0xe9, 0x4d, 0x67,
+	0x13, 0x7d, 0x8a, 0xae, 0xbe, 0xa7, 0xd6, 0x04, 0xaf, 0xda, 0x96, 0x4a, 0x99, 0x21, 0x59, 0x83,
+	0x20, 0x4d, 0xb1, 0xaa, 0x28, 0x6e, 0xe2, 0x1b, 0x91, 0x8c, 0x13, 0x9d, 0x53, 0x92, 0x64, 0x51,
+	0xb9, 0xca, 0xf8, 0x53, 0xf4, 0xca, 0x21, 0x63, 0x29, 0x5e, 0xb1, 0x9b, 0x4b, 0x89, 0xb1, 0x7b,
+	0xb7, 0x0e, 0x68, 0xab, 0x9b, 0xca, 0xea, 0x2a, 0x5e, 0xb1, 0xac, 0xca, 0xb8, 0x18, 0x4d, 0x94,
+	0x1f, 0x4c, 0xf1, 0x09, 0xba, 0x22, 0x1b, 0x70, 0x5c, 0xb3, 0x51, 0x4d, 0xcd, 0x2a, 0x80, 0x68,
+	0xf3, 0x77, 0x95, 0x79, 0x8c, 0x5f, 0xf7, 0xcc, 0x73, 0x3c, 0x40, 0x8b, 0x4f, 0xca, 0x83, 0x58,
+	0x75, 0xbd, 0x69, 0xdb, 0xb0, 0x00, 0xc3, 0xb1, 0x39, 0x17, 0xaf, 0xad, 0xc0, 0x8c, 0x29, 0x76,
+	0x46, 0x92, 0xa2, 0x25, 0xab, 0x21, 0xc7, 0xf3, 0x4c, 0x56, 0xe3, 0xda, 0x9a, 0xaf, 0x10, 0x58,
+	0x93, 0xb8, 0x1c, 0xdf, 0x39, 0x5a, 0x94, 0xba, 0x3f, 0x2b, 0xcf, 0x54, 0x77, 0x7c, 0x16, 0x00,
+	0x8e, 0xcf, 0xc1, 0x35, 0xd5, 0x43, 0x45, 0x75, 0x1f, 0x6f, 0xce, 0x59, 0xa8, 0x48, 0x9f, 0xde,
+	0xb8, 0x87, 0xae, 0xc9, 0xf6, 0xc7, 0x9f, 0xec, 0x1d, 0xba, 0x1e, 0x6d, 0xa4, 0xa0, 0x47, 0xcf,
+	0x40, 0xcd, 0xb7, 0xab, 0xf8, 0x9a, 0x78, 0x7d, 0x1e, 0x9f, 0xcc, 0x06, 0xf0, 0xdf, 0x1a, 0xe8,
+	0xc6, 0x53, 0x93, 0x2f, 0x1d, 0xea, 0x23, 0x13, 0xef, 0xda, 0x96, 0x6b, 0xb0, 0xe1, 0x7f, 0xe3,
+	0x02, 0x2d, 0xdd, 0x91, 0x9f, 0xa8, 0x8e, 0xfc, 0x08, 0xff, 0x60, 0x5e, 0x47, 0xea, 0xf9, 0x9a,
+	0xb5, 0xed, 0xfe, 0xda, 0x40, 0xb8, 0x66, 0x9b, 0xe3, 0x30, 0x77, 0xe5, 0x01, 0x0f, 0x2e, 0x52,
+	0xd3, 0x7d, 0x7c, 0x57, 0xf5, 0xf1, 0x1d, 0xfc, 0xbd, 0xcb, 0xf7, 0x91, 0xe3, 0x3e, 0xba, 0x72,
+	0x24, 0x93, 0x48, 0x77, 0x67, 0x29, 0x11, 0xb8, 0xb3, 0x34, 0xa2, 0x29, 0x23, 0x45, 0xf9, 0x26,
+	0x7e, 0x38, 0x8f, 0x52, 0x25, 0xa8, 0x4e, 0xa4, 0xbb, 0xaa, 0x2c, 0x70, 0x5c, 0xb7, 0xca, 0xc1,
+	0x00, 0x64, 0x20, 0xcd, 0xf8, 0x40, 0x31, 0x6e, 0xe1, 0x66, 0x90, 0x91, 0xe3, 0x31, 0xba, 0xb6,
+	0xaf, 0x73, 0x60, 0x2f, 0xa4, 0x6a, 0x29, 0x1c, 0x52, 0x2b, 0x50, 0xd3, 0x3d, 0x56, 0x74, 0x6f,
+	0xe3, 0xb7, 0xe6, 0xd1, 0x99, 0x44, 0xdb, 0x1a, 0x23, 0x47, 0x0b, 0xc6, 0x0e, 0xc7, 0xa0, 0xf9,
+	0x6a, 0xa4, 0x1b, 0x73, 0x50, 0xcd, 0xfe, 0xa6, 0x62, 0xdf, 0xc1, 0xf7, 0x2f, 0x62, 0xe7, 0x98,
+	0xa1, 0x85, 0x63, 0x79, 0x42, 0x7f, 0x9c, 0x25, 0xc2, 0x25, 0xad, 0xc4, 0x20, 0xa9, 0x85, 0x6a,
+	0xd2, 0x37, 0x14, 0xe9, 0x26, 0xde, 0xb0, 0x48, 0xd5, 0xa5, 0x20, 0x92, 0x57, 0x86, 0x2a, 0x90,
+	0xf5, 0x10, 0xaa, 0xda, 0x72, 0x0c, 0xdb, 0xac, 0xc6, 0xd9, 0x9c, 0x07, 0x6b, 0xce, 0xa6, 0xe2,
+	0xbc, 0x8b, 0xef, 0x80, 0x9c, 0x1c, 0x7f, 0x81, 0x96, 0x54, 0x2b, 0x73, 0x16, 0x6f, 0xd6, 0xec,
+	0x79, 0x07, 0xf2, 0xfd, 0x79, 0x0a, 0x33, 0xce, 0xb7, 0x14, 0xe7, 0x2e, 0xde, 0xae, 0x71, 0xea,
+	0x13, 0xda, 0x5a, 0xd2, 0xdf, 0xa2, 0x65, 0xc7, 0x08, 0xde, 0x0a, 0xd8, 0xbf, 0x74, 0x0f, 0xa0,
+	0xe5, 0x75, 0x7a, 0xc0, 0xab, 0xd9, 0xfe, 0x1c, 0x2d, 0x1d, 0x95, 0xa9, 0xd1, 0x9e, 0x14, 0xb8,
+	0x13, 0x60, 0x23, 0xe0, 0xb1, 0xe1, 0x2a, 0x04, 0x62, 0xb9, 0x4e, 0xbf, 0x14, 0x69, 0xc5, 0x3d,
+	0x44, 0xcb, 0xb6, 0x01, 0x6f, 0xf0, 0x0e, 0x04, 0x0e, 0xde, 0xd3, 0x08, 0x1c, 0x95, 0x36, 0x3d,
+	0xc7, 0xbf, 0x40, 0xaf, 0x7c, 0xc4, 0x62, 0xea, 0x26, 0x14, 0x52, 0x02, 0x26, 0x14, 0x25, 0xa0,
+	0x8d, 0xdf, 0x57, 0xc6, 0xef, 0xe1, 0x55, 0xcb, 0xb8, 0xbc, 0xb7, 0x5a, 0x4b, 0xfa, 0x97, 0x06,
+	0xba, 0x25, 0xdb, 0x3c, 0x9b, 0x5d, 0x5b, 0x8f, 0xe4, 0xad, 0x15, 0xef, 0xd8, 0x56, 0x7d, 0xd4,
+	0x50, 0xef, 0x86, 0x95, 0x02, 0x11, 0xd9, 0xed, 0x46, 0x54, 0xbb, 0x35, 0xcb, 0x5c, 0x47, 0xf6,
+	0xcb, 0xcb, 0x75, 0x94, 0x08, 0x8c, 0xc8, 0x1a, 0x09, 0xe4, 0x3a, 0x99, 0x32, 0xd7, 0x2d, 0x8f,
+	0xe4, 0xa3, 0x34, 0x19, 0xd4, 0x8f, 0x64, 0x29, 0x05, 0xe3, 0x83, 0x01, 0x79, 0x30, 0x59, 0x93,
+	0x37, 0xfa, 0xca, 0x5f, 0xda, 0x68, 0xa1, 0x6a, 0x85, 0xd7, 0xe7, 0x18, 0xbb, 0x14, 0x15, 0x34,
+	0x18, 0xae, 0xcc, 0x8e, 0xd0, 0xe2, 0x33, 0x7d, 0x2f, 0xfa, 0x29, 0xa5, 0x6e, 0x62, 0x63, 0x01,
+	0xe0, 0x6e, 0xb0, 0x70, 0x1e, 0xdc, 0x0d, 0x76, 0x49, 0xa1, 0x1a, 0x1d, 0x47, 0x4b, 0xb6, 0x01,
+	0x77, 0x27, 0xba, 0xa6, 0x2f, 0xcb, 0x0d, 0x6d, 0x05, 0x9b, 0x9b, 0xcb, 0xf8, 0x73, 0x64, 0xea,
+	0x17, 0x47, 0x32, 0xe5, 0x71, 0xb7, 0xa0, 0x0d, 0xc1, 0x5b, 0xd0, 0xd5, 0x08, 0xc4, 0x1f, 0x79,
+	0x7f, 0x8d, 0xaa, 0x5a, 0x49, 0x34, 0x11, 0xa3, 0x56, 0x12, 0x4f, 0xf1, 0x04, 0xbd, 0xe6, 0xd8,
+	0xe0, 0x78, 0xbe, 0xfd, 0x6a, 0xe4, 0xdb, 0x21, 0x15, 0xdd, 0x87, 0x6d, 0xd5, 0x87, 0x75, 0xbc,
+	0xe6, 0xf5, 0x81, 0xcf, 0x3a, 0x21, 0x47, 0xff, 0xc2, 0xd4, 0x66, 0xea, 0xa3, 0x77, 0x20, 0x70,
+	0xf4, 0x9e, 0xc6, 0x45, 0xa3, 0xaf, 0xea, 0x40, 0xf6, 0xe8, 0x1d, 0x1b, 0xde, 0xe8, 0x5d, 0x0c,
+	0x1c, 0xbd, 0xaf, 0x72, 0xe1, 0xe8, 0xab, 0x4e, 0xe0, 0x43, 0xf4, 0x9d, 0x7d, 0x92, 0xe1, 0x3b,
+	0xee, 0xd5, 0x2f, 0x33, 0x34, 0xd7, 0xb5, 0x7c, 0x9f, 0x64, 0x27, 0x4c, 0xd0, 0x1c, 0xf4, 0xa6,
+	0x36, 0xc9, 0xac, 0xd0, 0xd7, 0x46, 0xd7, 0x5e, 0xe8, 0xb2, 0x94, 0x1b, 0x09, 0x8c, 0x14, 0xcc,
+	0x8d, 0x66, 0xa0, 0xee, 0xfc, 0x3d, 0x45, 0x74, 0x1b, 0xdf, 0xb4, 0x88, 0x4c, 0xb9, 0x4b, 0x71,
+	0x14, 0x19, 0x55, 0xd7, 0x2a, 0x97, 0x43, 0x4b, 0x61, 0x8e, 0x0a, 0x0c, 0x71, 0x14, 0x19, 0x95,
+	0x59, 0x10, 0x9e, 0xa2, 0x25, 0xd5, 0xc0, 0x64, 0xfc, 0x9b, 0x35, 0x53, 0x5e, 0xb2, 0xbf, 0x35,
+	0x5f, 0x21, 0x90, 0x14, 0x38, 0x95, 0x38, 0x6b, 0x1a, 0xcf, 0xd1, 0xb2, 0x6d, 0xc3, 0x3b, 0x17,
+	0x1d, 0x08, 0x76, 0x4b, 0x57, 0x23, 0x70, 0x74, 0x39, 0x3d, 0xe0, 0xf8, 0x14, 0x2d, 0x7e, 0x98,
+	0xf4, 0x93, 0xfc, 0x44, 0x15, 0xc4, 0xdc, 0xe0, 0x67, 0x01, 0xe0, 0xad, 0xce, 0xc1, 0x03, 0x61,
+	0x56, 0x55, 0x17, 0x71, 0x81, 0x96, 0x54, 0x83, 0x4f, 0x12, 0x71, 0xfa, 0x01, 0x1d, 0xe3, 0xba,
+	0x29, 0x8d, 0x80, 0x33, 0xec, 0x2a, 0x04, 0x5c, 0x5e, 0x91, 0x45, 0x3d, 0x3a, 0x8e, 0x26, 0x3d,
+	0x3a, 0x9e, 0xe2, 0x29, 0x7a, 0x5d, 0xb5, 0xdd, 0x8b, 0xfb, 0x49, 0xa6, 0x47, 0xb9, 0x53, 0xb3,
+	0x6c, 0xa1, 0xe0, 0xb1, 0x5c, 0x57, 0x0a, 0x64, 0x9b, 0x65, 0x17, 0x88, 0xd4, 0x96, 0xdb, 0x5d,
+	0xb5, 0x3d, 0xea, 0x9c, 0xd2, 0xb8, 0x48, 0x69, 0xec, 0x6e, 0x77, 0x17, 0x03, 0xb7, 0xbb, 0xaf,
+	0x72, 0xe1, 0xd8, 0x79, 0x45, 0x75, 0x8e, 0x96, 0x55, 0xeb, 0x17, 0xb4, 0x9b, 0x70, 0x91, 0x8f,
+	0x71, 0x7d, 0x4a, 0x0d, 0x04, 0x7a, 0x95, 0xa7, 0x11, 0xf0, 0xaa, 0x92, 0x39, 0x37, 0x3c, 0x63,
+	0xbd, 0xd6, 0xa6, 0x58, 0x50, 0x5f, 0x6b, 0xaf, 0x5a, 0xb0, 0x35, 0x5f, 0x21, 0x70, 0x59, 0x2b,
+	0x59, 0x75, 0x9d, 0x40, 0xaf, 0xf7, 0x57, 0x0d, 0x74, 0x53, 0x19, 0x50, 0xb9, 0xcc, 0x5e, 0x9a,
+	0xea, 0x35, 0x7f, 0x50, 0x63, 0x70, 0x15, 0x4c, 0x4f, 0x1e, 0x5e, 0xa8, 0x77, 0xe1, 0x02, 0xa8,
+	0x1c, 0xa9, 0x45, 0xd2, 0xb4, 0x72, 0x3e, 0x65, 0x62, 0xae, 0xf3, 0x59, 0xe8, 0x7c, 0xe7, 0x73,
+	0x94, 0x2e, 0x74, 0xbe, 0x32, 0x4d, 0xfb, 0xb2, 0x81, 0xae, 0x57, 0x8d, 0x35, 0xfd, 0x36, 0x68,
+	0xd9, 0x65, 0xdf, 0x09, 0xea, 0x04, 0xb2, 0x9c, 0x19, 0xb9, 0x15, 0xdb, 0xbe, 0x6a, 0x58, 0xb3,
+	0x60, 0x3c, 0x02, 0xa6, 0xf8, 0x9f, 0xbd, 0x02, 0x2a, 0x1a, 0x40, 0x9d, 0xa8, 0x8a, 0x49, 0x5f,
+	0x36, 0xd0, 0xeb, 0xef, 0x97, 0x8f, 0x1a, 0x7b, 0x25, 0xe6, 0x2f, 0x89, 0x8f, 0x82, 0x4b, 0x52,
+	0x57, 0x0a, 0x54, 0x99, 0xbc, 0x47, 0x14, 0xca, 0xf1, 0x2f, 0xd1, 0xab, 0x27, 0xe5, 0xb3, 0x89,
+	0x5b, 0x0a, 0xd6, 0x42, 0xb0, 0x14, 0x5c, 0x61, 0x9a, 0x69, 0x4d, 0x31, 0xdd, 0xc2, 0xd8, 0x62,
+	0xd2, 0x6f, 0x31, 0x38, 0x41, 0xd7, 0x8e, 0xf5, 0xdb, 0x8b, 0x7b, 0x62, 0x1a, 0x29, 0x78, 0x62,
+	0xce, 0xc0, 0x80, 0x8b, 0x9b, 0x37, 0x9d, 0x68, 0x22, 0x7f, 0xa7, 0xb8, 0x8f, 0x16, 0xde, 0x37,
+	0x4f, 0x39, 0x6e, 0x86, 0x5e, 0x89, 0xc1, 0x0c, 0xdd, 0x42, 0x35, 0xdb, 0x8e, 0x62, 0xdb, 0xc0,
+	0xf7, 0x9c, 0xa9, 0xd3, 0x5a, 0xd1, 0x64, 0x40, 0xc4, 0xe9, 0x14, 0xff, 0x1a, 0xa1, 0xaa, 0xa5,
+	0x57, 0x2a, 0x98, 0xc9, 0xc1, 0x52, 0x81, 0x0d, 0x6b, 0xc6, 0x0d, 0xc5, 0xb8, 0x82, 0x6f, 0x43,
+	0x8c, 0x6a, 0x95, 0x3e, 0x2a, 0xdf, 0xa1, 0xdc, 0x55, 0xd2, 0x42, 0x70, 0x95, 0x2a, 0x2c, 0xb0,
+	0x4a, 0xfa, 0x71, 0x0b, 0x7f, 0x81, 0x96, 0x75, 0xdd, 0xfd, 0x43, 0xf5, 0x96, 0xe5, 0x86, 0x67,
+	0x07, 0x02, 0xc3, 0xb3, 0xa7, 0xa1, 0x19, 0xbf, 0xab, 0x18, 0xb7, 0xf1, 0x96, 0x93, 0xb3, 0x29,
+	0xcd, 0xa8, 0x7c, 0x33, 0x33, 0x4b, 0xd7, 0x45, 0x0b, 0xcf, 0x0b, 0x26, 0xa8, 0xca, 0x83, 0x9d,
+	0xa5, 0xab, 0xc4, 0xe0, 0xd2, 0x59, 0x68, 0x60, 0x22, 0xd5, 0x2b, 0x9c, 0xca, 0x40, 0xf1, 0x1f,
+	0x1a, 0xe8, 0x46, 0xd9, 0x88, 0x0c, 0x69, 0xfe, 0x84, 0x0e, 0x18, 0x4f, 0x84, 0x5b, 0x54, 0xad,
+	0xc1, 0x60, 0x51, 0x15, 0xd0, 0x0a, 0x1c, 0x0f, 0xba, 0x07, 0xaa, 0x6c, 0x18, 0x6b, 0xd2, 0x3f,
+	0x36, 0x10, 0x9e, 0x59, 0x91, 0x09, 0x45, 0x9c, 0x93, 0x73, 0x3c, 0x87, 0xc5, 0xe0, 0x60, 0xf9,
+	0x14, 0x52, 0x0b, 0xc4, 0x46, 0xbb, 0x37, 0xe7, 0x86, 0x77, 0x84, 0x96, 0x95, 0x99, 0xa7, 0x8c,
+	0x64, 0xcf, 0x06, 0x34, 0x73, 0x5d, 0xc0, 0x81, 0x40, 0x17, 0xf0, 0x34, 0x02, 0xfb, 0xb6, 0xa4,
+	0x4f, 0x19, 0xc9, 0x22, 0x26, 0x89, 0x7e, 0x83, 0x5e, 0xab, 0x1a, 0x1f, 0xa4, 0x8c, 0x53, 0x0c,
+	0x1b, 0x56, 0x18, 0x98, 0x98, 0xf8, 0x2a, 0x81, 0x6d, 0x6c, 0x91, 0x77, 0x14, 0x57, 0x81, 0x5e,
+	0x3b, 0xd0, 0x6f, 0xb1, 0xfa, 0x5c, 0x72, 0xd8, 0x5d, 0x0c, 0x64, 0xf7, 0x55, 0x34, 0xfb, 0xba,
+	0x62, 0xbf, 0x83, 0x6f, 0x59, 0xec, 0xe6, 0xd5, 0x97, 0x4b, 0x8f, 0x97, 0x7e, 0xfb, 0xbc, 0xa0,
+	0x05, 0x75, 0x3d, 0xbe, 0x12, 0x83, 0x1e, 0x6f, 0xa1, 0x41, 0x8f, 0xa7, 0x85, 0xf6, 0xf8, 0x29,
+	0x5a, 0x94, 0x6d, 0x9e, 0x94, 0x2f, 0xc5, 0x6e, 0x5a, 0x6d, 0x01, 0x60, 0x5a, 0xed, 0xe0, 0x9a,
+	0xee, 0x1d, 0x45, 0xf7, 0x10, 0xbf, 0x01, 0xd2, 0x45, 0xfa, 0x45, 0xba, 0xba, 0x64, 0x26, 0x08,
+	0x3d, 0x25, 0x5c, 0xec, 0xa7, 0xac, 0xd3, 0xf3, 0xa2, 0xe4, 0x4c, 0x0e, 0x46, 0x49, 0x1b, 0x0e,
+	0x4c, 0x69, 0x4a, 0xb8, 0x50, 0x0f, 0xc6, 0x78, 0x82, 0xae, 0x1f, 0x48, 0x11, 0xaf, 0x5a, 0xba,
+	0x29, 0x86, 0x07, 0x5e, 0x96, 0x14, 0x3a, 0x47, 0x2b, 0xd2, 0x68, 0xa2, 0x04, 0x53, 0xfc, 0x2b,
+	0x74, 0xe5, 0x84, 0x14, 0xa9, 0x70, 0xeb, 0x5b, 0x4a, 0x04, 0xd6, 0xb7, 0x34, 0x12, 0xd8, 0x26,
+	0xea, 0x89, 0x3e, 0x9a, 0x0c, 0x8a, 0x76, 0x4b, 0xa5, 0x93, 0x1c, 0x2d, 0xed, 0xf1, 0x2e, 0xc9,
+	0x63, 0xd5, 0xd4, 0x2b, 0xd1, 0xd8, 0x08, 0x98, 0xb3, 0xb8, 0x0a, 0x81, 0x12, 0x8d, 0xa2, 0xe5,
+	0x11, 0x51, 0xfa, 0x32, 0x6f, 0x2f, 0xdb, 0x1c, 0x16, 0xed, 0x1e, 0x1d, 0x7b, 0xb7, 0x41, 0x07,
+	0x02, 0xa3, 0x82, 0xa7, 0x11, 0xc8, 0xdb, 0x35, 0xef, 0x40, 0xf3, 0xf4, 0xd0, 0xb5, 0xe3, 0xd1,
+	0x91, 0x20, 0x5d, 0xff, 0xf1, 0xd8, 0x48, 0xe1, 0xbc, 0xa1, 0x02, 0x03, 0x8b, 0x27, 0x46, 0x11,
+	0x57, 0x5a, 0x95, 0x93, 0x1a, 0x32, 0x51, 0x40, 0x64, 0xa2, 0x08, 0x90, 0x29, 0xf0, 0x42, 0x32,
+	0x51, 0xcc, 0xc8, 0x5e, 0xa0, 0x97, 0x8f, 0x47, 0xf8, 0xb6, 0x6b, 0xc9, 0x10, 0xdc, 0xf1, 0xc5,
+	0x81, 0x4d, 0x2e, 0x46, 0xee, 0x00, 0x54, 0xa1, 0xa4, 0x36, 0x80, 0x52, 0x0a, 0x66, 0x08, 0x65,
+	0x85, 0x97, 0xc6, 0x5a, 0x09, 0x7e, 0x23, 0x19, 0xd5, 0xb6, 0x74, 0x86, 0x16, 0x8d, 0xd9, 0x67,
+	0x69, 0xfc, 0xff, 0xe7, 0xd3, 0x3c, 0x11, 0x4f, 0xba, 0x99, 0x1c, 0xd0, 0x67, 0xe8, 0xca, 0x41,
+	0xca, 0x0a, 0xff, 0x79, 0xa0, 0xfc, 0x24, 0x45, 0x21, 0x86, 0xed, 0xa6, 0x56, 0xb0, 0x31, 0xf0,
+	0x04, 0x54, 0xdf, 0xab, 0x94, 0x01, 0x6b, 0x76, 0x3b, 0x38, 0x51, 0x9f, 0x47, 0x14, 0xd4, 0xdd,
+	0xc2, 0x4e, 0x28, 0x5e, 0x05, 0x90, 0x40, 0xb9, 0x41, 0xc5, 0x45, 0x3c, 0x41, 0x37, 0xaa, 0x4b,
+	0xb3, 0xa9, 0x94, 0xba, 0x29, 0x47, 0x0d, 0x06, 0xfd, 0x6d, 0x06, 0x06, 0x0f, 0x57, 0x15, 0x8a,
+	0xab, 0x8b, 0xf7, 0x10, 0x5d, 0x3f, 0xa4, 0x59, 0x9c, 0x64, 0xdd, 0x8a, 0xda, 0x09, 0x8a, 0x1e,
+	0x78, 0x39, 0x62, 0x68, 0xff, 0x96, 0xc4, 0xa6, 0xc0, 0x2b, 0x27, 0xb3, 0x0c, 0xc1, 0xce, 0x64,
+	0x3a, 0x81, 0x77, 0x15, 0x40, 0x02, 0x93, 0x59, 0x06, 0xf9, 0xdf, 0x37, 0xd0, 0xf5, 0x63, 0xce,
+	0x3f, 0xa0, 0xe3, 0x2e, 0xcd, 0x3e, 0x54, 0x5f, 0xfd, 0xb8, 0x03, 0xf2, 0x40, 0xf0, 0x22, 0x59,
+	0xd3, 0x09, 0xd4, 0xc9, 0xca, 0x2f, 0x8b, 0xa2, 0x9e, 0xd2, 0xb7, 0xc2, 0x71, 0x8c, 0x16, 0x8e,
+	0x39, 0xd7, 0x3d, 0x58, 0xf7, 0xac, 0xbb, 0xdc, 0x1b, 0x73, 0xd0, 0x40, 0x62, 0x5e, 0xb2, 0xca,
+	0x34, 0xe1, 0xd5, 0x0f, 0xe8, 0x58, 0xee, 0x04, 0x37, 0xf1, 0xd7, 0x42, 0x70, 0x9b, 0x55, 0x58,
+	0x20, 0x0d, 0xea, 0x95, 0x3a, 0xd1, 0xe4, 0x94, 0x26, 0xdd, 0x53, 0x31, 0xc5, 0x53, 0xb4, 0xac,
+	0xdb, 0x95, 0xc1, 0xda, 0x0d, 0xf4, 0x0e, 0x74, 0x29, 0x52, 0x28, 0x4d, 0xf0, 0x49, 0xad, 0xd9,
+	0xec, 0xa3, 0xab, 0xe5, 0x8a, 0xb8, 0x2f, 0xe8, 0xa5, 0x0c, 0x7c, 0x41, 0x37, 0x90, 0xe6, 0x7b,
+	0x5b, 0xf1, 0x3d, 0xc0, 0xbb, 0x2e, 0x9f, 0x5a, 0xb3, 0x3a, 0x9d, 0xbc, 0x7b, 0x7f, 0x5c, 0x7e,
+	0xfc, 0x75, 0x98, 0xb3, 0x01, 0xe3, 0x24, 0xf5, 0xee, 0xde, 0x3e, 0x0a, 0xde, 0xbd, 0xeb, 0x4a,
+	0x81, 0x93, 0x40, 0x7f, 0x6c, 0xd6, 0x1a, 0x54, 0x84, 0xd2, 0x97, 0x3d, 0x13, 0xae, 0x2f, 0x7b,
+	0x20, 0xe8, 0xcb, 0x35, 0x9d, 0x80, 0x2f, 0xfb, 0x5d, 0x30, 0xd7, 0xaf, 0xcf, 0xd1, 0x92, 0x36,
+	0x23, 0x03, 0xb3, 0x97, 0x5a, 0xd8, 0x08, 0x98, 0x5a, 0xb8, 0x0a, 0x81, 0x7b, 0x87, 0xa1, 0x1f,
+	0x4a, 0x4d, 0xc3, 0x7d, 0x86, 0x16, 0x8e, 0x0f, 0x7e, 0x7e, 0xa4, 0x3e, 0x7e, 0xf3, 0xf6, 0x91,
+	0x11, 0xc3, 0xfb, 0x68, 0x86, 0x06, 0x28, 0x67, 0x1f, 0xd6, 0x59, 0x81, 0xbe, 0x87, 0x50, 0xd5,
+	0xda, 0x7f, 0xe4, 0xaf, 0xe4, 0xf0, 0x23, 0xbf, 0x05, 0x87, 0x1e, 0xf9, 0x67, 0x9f, 0xf3, 0xe1,
+	0x42, 0x91, 0x1d, 0x94, 0x5f, 0xef, 0xd5, 0xc8, 0xb4, 0x7c, 0x1e, 0x59, 0x05, 0x07, 0x6e, 0xd4,
+	0xd6, 0xc7, 0x81, 0xd6, 0x18, 0x19, 0x5a, 0x9c, 0xb5, 0xf7, 0xd2, 0x7e, 0x0b, 0x00, 0xd3, 0x7e,
+	0x07, 0x0f, 0xbc, 0x8f, 0xda, 0x9f, 0x25, 0xe2, 0x11, 0xba, 0xfe, 0x9e, 0xfa, 0xda, 0xf0, 0x78,
+	0x3c, 0xa0, 0xf1, 0x13, 0x22, 0x88, 0xeb, 0xcb, 0x1e, 0x08, 0xfa, 0x72, 0x4d, 0x27, 0x90, 0xf7,
+	0xab, 0x4f, 0x1b, 0x7f, 0x18, 0x89, 0xd1, 0xfe, 0xd3, 0xbf, 0x7f, 0xd3, 0x6c, 0x7c, 0xfd, 0x4d,
+	0xb3, 0xf1, 0xef, 0x6f, 0x9a, 0x8d, 0x3f, 0x7d, 0xdb, 0x7c, 0xe9, 0xeb, 0x6f, 0x9b, 0x2f, 0xfd,
+	0xeb, 0xdb, 0xe6, 0x4b, 0x9f, 0x3e, 0xee, 0x26, 0x22, 0x25, 0xed, 0x47, 0x1d, 0xd6, 0xf7, 0xeb,
+	0x46, 0x2c, 0xa6, 0xd1, 0xf0, 0xdd, 0x68, 0x64, 0xcb, 0x65, 0x47, 0xda, 0x57, 0xd5, 0xc7, 0x92,
+	0xef, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x53, 0x55, 0xf9, 0x7d, 0x3e, 0x2f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -227,6 +241,10 @@ type QueryClient interface {
 	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
 	DerivedPool(ctx context.Context, in *QueryDerivedPoolRequest, opts ...grpc.CallOption) (*QueryDerivedPoolResponse, error)
 	DerivedPools(ctx context.Context, in *QueryDerivedPoolsRequest, opts ...grpc.CallOption) (*QueryDerivedPoolsResponse, error)
+	// Returns the liquidity fee, reward, synth yield and POL history of a pool.
+	PoolHistory(ctx context.Context, in *QueryPoolHistoryRequest, opts ...grpc.CallOption) (*QueryPoolHistoryResponse, error)
+	// Returns the time weighted average price of a pool over the given number of blocks.
+	PoolTWAP(ctx context.Context, in *QueryPoolTWAPRequest, opts ...grpc.CallOption) (*QueryPoolTWAPResponse, error)
 	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(ctx context.Context, in *QueryLiquidityProvidersRequest, opts ...grpc.CallOption) (*QueryLiquidityProvidersResponse, error)
 	Saver(ctx context.Context, in *QuerySaverRequest, opts ...grpc.CallOption) (*QuerySaverResponse, error)
@@ -240,6 +258,7 @@ type QueryClient interface {
 	SecuredAsset(ctx context.Context, in *QuerySecuredAssetRequest, opts ...grpc.CallOption) (*QuerySecuredAssetResponse, error)
 	SecuredAssets(ctx context.Context, in *QuerySecuredAssetsRequest, opts ...grpc.CallOption) (*QuerySecuredAssetsResponse, error)
 	Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error)
+	NodeObservationStats(ctx context.Context, in *QueryObservationStatsRequest, opts ...grpc.CallOption) (*QueryObservationStatsResponse, error)
 	Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error)
 	PoolSlip(ctx context.Context, in *QueryPoolSlipRequest, opts ...grpc.CallOption) (*QueryPoolSlipsResponse, error)
 	PoolSlips(ctx context.Context, in *QueryPoolSlipsRequest, opts ...grpc.CallOption) (*QueryPoolSlipsResponse, error)
@@ -247,6 +266,8 @@ type QueryClient interface {
 	OutboundFees(ctx context.Context, in *QueryOutboundFeesRequest, opts ...grpc.CallOption) (*QueryOutboundFeesResponse, error)
 	StreamingSwap(ctx context.Context, in *QueryStreamingSwapRequest, opts ...grpc.CallOption) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(ctx context.Context, in *QueryStreamingSwapsRequest, opts ...grpc.CallOption) (*QueryStreamingSwapsResponse, error)
+	RecurringSwap(ctx context.Context, in *QueryRecurringSwapRequest, opts ...grpc.CallOption) (*QueryRecurringSwapResponse, error)
+	RecurringSwaps(ctx context.Context, in *QueryRecurringSwapsRequest, opts ...grpc.CallOption) (*QueryRecurringSwapsResponse, error)
 	Ban(ctx context.Context, in *QueryBanRequest, opts ...grpc.CallOption) (*BanVoter, error)
 	Ragnarok(ctx context.Context, in *QueryRagnarokRequest, opts ...grpc.CallOption) (*QueryRagnarokResponse, error)
 	RunePool(ctx context.Context, in *QueryRunePoolRequest, opts ...grpc.CallOption) (*QueryRunePoolResponse, error)
@@ -255,9 +276,13 @@ type QueryClient interface {
 	MimirValues(ctx context.Context, in *QueryMimirValuesRequest, opts ...grpc.CallOption) (*QueryMimirValuesResponse, error)
 	MimirWithKey(ctx context.Context, in *QueryMimirWithKeyRequest, opts ...grpc.CallOption) (*QueryMimirWithKeyResponse, error)
 	MimirAdminValues(ctx context.Context, in *QueryMimirAdminValuesRequest, opts ...grpc.CallOption) (*QueryMimirAdminValuesResponse, error)
+	MimirScheduled(ctx context.Context, in *QueryMimirScheduledRequest, opts ...grpc.CallOption) (*QueryMimirScheduledResponse, error)
+	MimirRegistry(ctx context.Context, in *QueryMimirRegistryRequest, opts ...grpc.CallOption) (*QueryMimirRegistryResponse, error)
+	MimirHistory(ctx context.Context, in *QueryMimirHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error)
 	MimirNodesAllValues(ctx context.Context, in *QueryMimirNodesAllValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodesAllValuesResponse, error)
 	MimirNodesValues(ctx context.Context, in *QueryMimirNodesValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodesValuesResponse, error)
 	MimirNodeValues(ctx context.Context, in *QueryMimirNodeValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodeValuesResponse, error)
+	MimirNodeHistory(ctx context.Context, in *QueryMimirNodeHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error)
 	InboundAddresses(ctx context.Context, in *QueryInboundAddressesRequest, opts ...grpc.CallOption) (*QueryInboundAddressesResponse, error)
 	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
 	Thorname(ctx context.Context, in *QueryThornameRequest, opts ...grpc.CallOption) (*QueryThornameResponse, error)
@@ -374,6 +399,24 @@ func (c *queryClient) DerivedPools(ctx context.Context, in *QueryDerivedPoolsReq
 	return out, nil
 }
 
+func (c *queryClient) PoolHistory(ctx context.Context, in *QueryPoolHistoryRequest, opts ...grpc.CallOption) (*QueryPoolHistoryResponse, error) {
+	out := new(QueryPoolHistoryResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/PoolHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolTWAP(ctx context.Context, in *QueryPoolTWAPRequest, opts ...grpc.CallOption) (*QueryPoolTWAPResponse, error) {
+	out := new(QueryPoolTWAPResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/PoolTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error) {
 	out := new(QueryLiquidityProviderResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/LiquidityProvider", in, out, opts...)
@@ -491,6 +534,15 @@ func (c *queryClient) Node(ctx context.Context, in *QueryNodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) NodeObservationStats(ctx context.Context, in *QueryObservationStatsRequest, opts ...grpc.CallOption) (*QueryObservationStatsResponse, error) {
+	out := new(QueryObservationStatsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/NodeObservationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error) {
 	out := new(QueryNodesResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/Nodes", in, out, opts...)
@@ -554,6 +606,24 @@ func (c *queryClient) StreamingSwaps(ctx context.Context, in *QueryStreamingSwap
 	return out, nil
 }
 
+func (c *queryClient) RecurringSwap(ctx context.Context, in *QueryRecurringSwapRequest, opts ...grpc.CallOption) (*QueryRecurringSwapResponse, error) {
+	out := new(QueryRecurringSwapResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/RecurringSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringSwaps(ctx context.Context, in *QueryRecurringSwapsRequest, opts ...grpc.CallOption) (*QueryRecurringSwapsResponse, error) {
+	out := new(QueryRecurringSwapsResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/RecurringSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Ban(ctx context.Context, in *QueryBanRequest, opts ...grpc.CallOption) (*BanVoter, error) {
 	out := new(BanVoter)
 	err := c.cc.Invoke(ctx, "/types.Query/Ban", in, out, opts...)
@@ -626,6 +696,33 @@ func (c *queryClient) MimirAdminValues(ctx context.Context, in *QueryMimirAdminV
 	return out, nil
 }
 
+func (c *queryClient) MimirScheduled(ctx context.Context, in *QueryMimirScheduledRequest, opts ...grpc.CallOption) (*QueryMimirScheduledResponse, error) {
+	out := new(QueryMimirScheduledResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/MimirScheduled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MimirRegistry(ctx context.Context, in *QueryMimirRegistryRequest, opts ...grpc.CallOption) (*QueryMimirRegistryResponse, error) {
+	out := new(QueryMimirRegistryResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/MimirRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MimirHistory(ctx context.Context, in *QueryMimirHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error) {
+	out := new(QueryMimirHistoryResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/MimirHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) MimirNodesAllValues(ctx context.Context, in *QueryMimirNodesAllValuesRequest, opts ...grpc.CallOption) (*QueryMimirNodesAllValuesResponse, error) {
 	out := new(QueryMimirNodesAllValuesResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/MimirNodesAllValues", in, out, opts...)
@@ -653,6 +750,15 @@ func (c *queryClient) MimirNodeValues(ctx context.Context, in *QueryMimirNodeVal
 	return out, nil
 }
 
+func (c *queryClient) MimirNodeHistory(ctx context.Context, in *QueryMimirNodeHistoryRequest, opts ...grpc.CallOption) (*QueryMimirHistoryResponse, error) {
+	out := new(QueryMimirHistoryResponse)
+	err := c.cc.Invoke(ctx, "/types.Query/MimirNodeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) InboundAddresses(ctx context.Context, in *QueryInboundAddressesRequest, opts ...grpc.CallOption) (*QueryInboundAddressesResponse, error) {
 	out := new(QueryInboundAddressesResponse)
 	err := c.cc.Invoke(ctx, "/types.Query/InboundAddresses", in, out, opts...)
@@ -1058,6 +1164,10 @@ type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
 	DerivedPool(context.Context, *QueryDerivedPoolRequest) (*QueryDerivedPoolResponse, error)
 	DerivedPools(context.Context, *QueryDerivedPoolsRequest) (*QueryDerivedPoolsResponse, error)
+	// Returns the liquidity fee, reward, synth yield and POL history of a pool.
+	PoolHistory(context.Context, *QueryPoolHistoryRequest) (*QueryPoolHistoryResponse, error)
+	// Returns the time weighted average price of a pool over the given number of blocks.
+	PoolTWAP(context.Context, *QueryPoolTWAPRequest) (*QueryPoolTWAPResponse, error)
 	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
 	LiquidityProviders(context.Context, *QueryLiquidityProvidersRequest) (*QueryLiquidityProvidersResponse, error)
 	Saver(context.Context, *QuerySaverRequest) (*QuerySaverResponse, error)
@@ -1071,6 +1181,7 @@ type QueryServer interface {
 	SecuredAsset(context.Context, *QuerySecuredAssetRequest) (*QuerySecuredAssetResponse, error)
 	SecuredAssets(context.Context, *QuerySecuredAssetsRequest) (*QuerySecuredAssetsResponse, error)
 	Node(context.Context, *QueryNodeRequest) (*QueryNodeResponse, error)
+	NodeObservationStats(context.Context, *QueryObservationStatsRequest) (*QueryObservationStatsResponse, error)
 	Nodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error)
 	PoolSlip(context.Context, *QueryPoolSlipRequest) (*QueryPoolSlipsResponse, error)
 	PoolSlips(context.Context, *QueryPoolSlipsRequest) (*QueryPoolSlipsResponse, error)
@@ -1078,6 +1189,8 @@ type QueryServer interface {
 	OutboundFees(context.Context, *QueryOutboundFeesRequest) (*QueryOutboundFeesResponse, error)
 	StreamingSwap(context.Context, *QueryStreamingSwapRequest) (*QueryStreamingSwapResponse, error)
 	StreamingSwaps(context.Context, *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error)
+	RecurringSwap(context.Context, *QueryRecurringSwapRequest) (*QueryRecurringSwapResponse, error)
+	RecurringSwaps(context.Context, *QueryRecurringSwapsRequest) (*QueryRecurringSwapsResponse, error)
 	Ban(context.Context, *QueryBanRequest) (*BanVoter, error)
 	Ragnarok(context.Context, *QueryRagnarokRequest) (*QueryRagnarokResponse, error)
 	RunePool(context.Context, *QueryRunePoolRequest) (*QueryRunePoolResponse, error)
@@ -1086,9 +1199,13 @@ type QueryServer interface {
 	MimirValues(context.Context, *QueryMimirValuesRequest) (*QueryMimirValuesResponse, error)
 	MimirWithKey(context.Context, *QueryMimirWithKeyRequest) (*QueryMimirWithKeyResponse, error)
 	MimirAdminValues(context.Context, *QueryMimirAdminValuesRequest) (*QueryMimirAdminValuesResponse, error)
+	MimirScheduled(context.Context, *QueryMimirScheduledRequest) (*QueryMimirScheduledResponse, error)
+	MimirRegistry(context.Context, *QueryMimirRegistryRequest) (*QueryMimirRegistryResponse, error)
+	MimirHistory(context.Context, *QueryMimirHistoryRequest) (*QueryMimirHistoryResponse, error)
 	MimirNodesAllValues(context.Context, *QueryMimirNodesAllValuesRequest) (*QueryMimirNodesAllValuesResponse, error)
 	MimirNodesValues(context.Context, *QueryMimirNodesValuesRequest) (*QueryMimirNodesValuesResponse, error)
 	MimirNodeValues(context.Context, *QueryMimirNodeValuesRequest) (*QueryMimirNodeValuesResponse, error)
+	MimirNodeHistory(context.Context, *QueryMimirNodeHistoryRequest) (*QueryMimirHistoryResponse, error)
 	InboundAddresses(context.Context, *QueryInboundAddressesRequest) (*QueryInboundAddressesResponse, error)
 	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
 	Thorname(context.Context, *QueryThornameRequest) (*QueryThornameResponse, error)
@@ -1159,6 +1276,12 @@ func (*UnimplementedQueryServer) DerivedPool(ctx context.Context, req *QueryDeri
 func (*UnimplementedQueryServer) DerivedPools(ctx context.Context, req *QueryDerivedPoolsRequest) (*QueryDerivedPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DerivedPools not implemented")
 }
+func (*UnimplementedQueryServer) PoolHistory(ctx context.Context, req *QueryPoolHistoryRequest) (*QueryPoolHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolHistory not implemented")
+}
+func (*UnimplementedQueryServer) PoolTWAP(ctx context.Context, req *QueryPoolTWAPRequest) (*QueryPoolTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolTWAP not implemented")
+}
 func (*UnimplementedQueryServer) LiquidityProvider(ctx context.Context, req *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvider not implemented")
 }
@@ -1198,6 +1321,9 @@ func (*UnimplementedQueryServer) SecuredAssets(ctx context.Context, req *QuerySe
 func (*UnimplementedQueryServer) Node(ctx context.Context, req *QueryNodeRequest) (*QueryNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Node not implemented")
 }
+func (*UnimplementedQueryServer) NodeObservationStats(ctx context.Context, req *QueryObservationStatsRequest) (*QueryObservationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeObservationStats not implemented")
+}
 func (*UnimplementedQueryServer) Nodes(ctx context.Context, req *QueryNodesRequest) (*QueryNodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Nodes not implemented")
 }
@@ -1219,6 +1345,12 @@ func (*UnimplementedQueryServer) StreamingSwap(ctx context.Context, req *QuerySt
 func (*UnimplementedQueryServer) StreamingSwaps(ctx context.Context, req *QueryStreamingSwapsRequest) (*QueryStreamingSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StreamingSwaps not implemented")
 }
+func (*UnimplementedQueryServer) RecurringSwap(ctx context.Context, req *QueryRecurringSwapRequest) (*QueryRecurringSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringSwap not implemented")
+}
+func (*UnimplementedQueryServer) RecurringSwaps(ctx context.Context, req *QueryRecurringSwapsRequest) (*QueryRecurringSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringSwaps not implemented")
+}
 func (*UnimplementedQueryServer) Ban(ctx context.Context, req *QueryBanRequest) (*BanVoter, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ban not implemented")
 }
@@ -1243,6 +1375,15 @@ func (*UnimplementedQueryServer) MimirWithKey(ctx context.Context, req *QueryMim
 func (*UnimplementedQueryServer) MimirAdminValues(ctx context.Context, req *QueryMimirAdminValuesRequest) (*QueryMimirAdminValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MimirAdminValues not implemented")
 }
+func (*UnimplementedQueryServer) MimirScheduled(ctx context.Context, req *QueryMimirScheduledRequest) (*QueryMimirScheduledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirScheduled not implemented")
+}
+func (*UnimplementedQueryServer) MimirRegistry(ctx context.Context, req *QueryMimirRegistryRequest) (*QueryMimirRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirRegistry not implemented")
+}
+func (*UnimplementedQueryServer) MimirHistory(ctx context.Context, req *QueryMimirHistoryRequest) (*QueryMimirHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirHistory not implemented")
+}
 func (*UnimplementedQueryServer) MimirNodesAllValues(ctx context.Context, req *QueryMimirNodesAllValuesRequest) (*QueryMimirNodesAllValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MimirNodesAllValues not implemented")
 }
@@ -1252,6 +1393,9 @@ func (*UnimplementedQueryServer) MimirNodesValues(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) MimirNodeValues(ctx context.Context, req *QueryMimirNodeValuesRequest) (*QueryMimirNodeValuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MimirNodeValues not implemented")
 }
+func (*UnimplementedQueryServer) MimirNodeHistory(ctx context.Context, req *QueryMimirNodeHistoryRequest) (*QueryMimirHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MimirNodeHistory not implemented")
+}
 func (*UnimplementedQueryServer) InboundAddresses(ctx context.Context, req *QueryInboundAddressesRequest) (*QueryInboundAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InboundAddresses not implemented")
 }
@@ -1512,6 +1656,42 @@ func _Query_DerivedPools_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PoolHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/PoolHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolHistory(ctx, req.(*QueryPoolHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/PoolTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolTWAP(ctx, req.(*QueryPoolTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLiquidityProviderRequest)
 	if err := dec(in); err != nil {
@@ -1746,6 +1926,24 @@ func _Query_Node_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_NodeObservationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObservationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NodeObservationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/NodeObservationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeObservationStats(ctx, req.(*QueryObservationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Nodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNodesRequest)
 	if err := dec(in); err != nil {
@@ -1872,6 +2070,42 @@ func _Query_StreamingSwaps_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecurringSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/RecurringSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringSwap(ctx, req.(*QueryRecurringSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/RecurringSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringSwaps(ctx, req.(*QueryRecurringSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Ban_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBanRequest)
 	if err := dec(in); err != nil {
@@ -2016,6 +2250,60 @@ func _Query_MimirAdminValues_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MimirScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/MimirScheduled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirScheduled(ctx, req.(*QueryMimirScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MimirRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/MimirRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirRegistry(ctx, req.(*QueryMimirRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MimirHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/MimirHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirHistory(ctx, req.(*QueryMimirHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_MimirNodesAllValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryMimirNodesAllValuesRequest)
 	if err := dec(in); err != nil {
@@ -2070,6 +2358,24 @@ func _Query_MimirNodeValues_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MimirNodeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMimirNodeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MimirNodeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Query/MimirNodeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MimirNodeHistory(ctx, req.(*QueryMimirNodeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_InboundAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryInboundAddressesRequest)
 	if err := dec(in); err != nil {
@@ -2876,6 +3182,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DerivedPools",
 			Handler:    _Query_DerivedPools_Handler,
 		},
+		{
+			MethodName: "PoolHistory",
+			Handler:    _Query_PoolHistory_Handler,
+		},
+		{
+			MethodName: "PoolTWAP",
+			Handler:    _Query_PoolTWAP_Handler,
+		},
 		{
 			MethodName: "LiquidityProvider",
 			Handler:    _Query_LiquidityProvider_Handler,
@@ -2928,6 +3242,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Node",
 			Handler:    _Query_Node_Handler,
 		},
+		{
+			MethodName: "NodeObservationStats",
+			Handler:    _Query_NodeObservationStats_Handler,
+		},
 		{
 			MethodName: "Nodes",
 			Handler:    _Query_Nodes_Handler,
@@ -2956,6 +3274,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StreamingSwaps",
 			Handler:    _Query_StreamingSwaps_Handler,
 		},
+		{
+			MethodName: "RecurringSwap",
+			Handler:    _Query_RecurringSwap_Handler,
+		},
+		{
+			MethodName: "RecurringSwaps",
+			Handler:    _Query_RecurringSwaps_Handler,
+		},
 		{
 			MethodName: "Ban",
 			Handler:    _Query_Ban_Handler,
@@ -2988,6 +3314,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MimirAdminValues",
 			Handler:    _Query_MimirAdminValues_Handler,
 		},
+		{
+			MethodName: "MimirScheduled",
+			Handler:    _Query_MimirScheduled_Handler,
+		},
+		{
+			MethodName: "MimirRegistry",
+			Handler:    _Query_MimirRegistry_Handler,
+		},
+		{
+			MethodName: "MimirHistory",
+			Handler:    _Query_MimirHistory_Handler,
+		},
 		{
 			MethodName: "MimirNodesAllValues",
 			Handler:    _Query_MimirNodesAllValues_Handler,
@@ -3000,6 +3338,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MimirNodeValues",
 			Handler:    _Query_MimirNodeValues_Handler,
 		},
+		{
+			MethodName: "MimirNodeHistory",
+			Handler:    _Query_MimirNodeHistory_Handler,
+		},
 		{
 			MethodName: "InboundAddresses",
 			Handler:    _Query_InboundAddresses_Handler,
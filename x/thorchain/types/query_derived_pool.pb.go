@@ -24,8 +24,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryDerivedPoolRequest struct {
-	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset      string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Height     string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+	TwapBlocks int64  `protobuf:"varint,3,opt,name=twap_blocks,json=twapBlocks,proto3" json:"twap_blocks,omitempty"`
 }
 
 func (m *QueryDerivedPoolRequest) Reset()         { *m = QueryDerivedPoolRequest{} }
@@ -75,6 +76,13 @@ func (m *QueryDerivedPoolRequest) GetHeight() string {
 	return ""
 }
 
+func (m *QueryDerivedPoolRequest) GetTwapBlocks() int64 {
+	if m != nil {
+		return m.TwapBlocks
+	}
+	return 0
+}
+
 type QueryDerivedPoolResponse struct {
 	Asset        string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
 	Status       string `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
@@ -83,6 +91,8 @@ type QueryDerivedPoolResponse struct {
 	BalanceRune  string `protobuf:"bytes,5,opt,name=balance_rune,json=balanceRune,proto3" json:"balance_rune"`
 	// the depth of the derived virtual pool relative to L1 pool (in basis points)
 	DerivedDepthBps string `protobuf:"bytes,6,opt,name=derived_depth_bps,json=derivedDepthBps,proto3" json:"derived_depth_bps"`
+	// the median time weighted average price of the anchor pools over twap_blocks, in asset per RUNE (1e8)
+	AnchorTwapPrice string `protobuf:"bytes,7,opt,name=anchor_twap_price,json=anchorTwapPrice,proto3" json:"anchor_twap_price,omitempty"`
 }
 
 func (m *QueryDerivedPoolResponse) Reset()         { *m = QueryDerivedPoolResponse{} }
@@ -160,6 +170,13 @@ func (m *QueryDerivedPoolResponse) GetDerivedDepthBps() string {
 	return ""
 }
 
+func (m *QueryDerivedPoolResponse) GetAnchorTwapPrice() string {
+	if m != nil {
+		return m.AnchorTwapPrice
+	}
+	return ""
+}
+
 type QueryDerivedPoolsRequest struct {
 	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
 }
@@ -258,31 +275,34 @@ func init() {
 func init() { proto.RegisterFile("types/query_derived_pool.proto", fileDescriptor_00e27877577b0667) }
 
 var fileDescriptor_00e27877577b0667 = []byte{
-	// 384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcf, 0x4e, 0xbb, 0x40,
-	0x18, 0xec, 0xb6, 0x3f, 0xc8, 0xaf, 0x5b, 0x8d, 0x96, 0x54, 0xc5, 0x1e, 0xa0, 0xe1, 0xd4, 0x13,
-	0x24, 0x6d, 0xf4, 0x5e, 0xd2, 0xc4, 0x8b, 0x07, 0xdd, 0xa3, 0x17, 0xc2, 0x9f, 0x0d, 0x90, 0x50,
-	0x96, 0xb2, 0x4b, 0x63, 0xdf, 0xc2, 0xf7, 0xf0, 0x45, 0x3c, 0xf6, 0xe8, 0x89, 0x98, 0xf6, 0xc6,
-	0x53, 0x18, 0x16, 0x50, 0x49, 0xf5, 0xc4, 0x37, 0x33, 0xcc, 0x7c, 0xc9, 0x7c, 0x0b, 0x15, 0xb6,
-	0x4d, 0x30, 0x35, 0xd6, 0x19, 0x4e, 0xb7, 0x96, 0x87, 0xd3, 0x70, 0x83, 0x3d, 0x2b, 0x21, 0x24,
-	0xd2, 0x93, 0x94, 0x30, 0x22, 0x09, 0x5c, 0x1f, 0x8f, 0x7c, 0xe2, 0x13, 0xce, 0x18, 0xe5, 0x54,
-	0x89, 0xda, 0x1d, 0xbc, 0x7a, 0x2c, 0x8d, 0xcb, 0xca, 0xf7, 0x40, 0x48, 0x84, 0xf0, 0x3a, 0xc3,
-	0x94, 0x49, 0x23, 0x28, 0xd8, 0x94, 0x62, 0x26, 0x83, 0x09, 0x98, 0xf6, 0x51, 0x05, 0xa4, 0x4b,
-	0x28, 0x06, 0x38, 0xf4, 0x03, 0x26, 0x77, 0x39, 0x5d, 0x23, 0xed, 0xb5, 0x0b, 0xe5, 0xe3, 0x24,
-	0x9a, 0x90, 0x98, 0x62, 0x49, 0x6d, 0x45, 0x99, 0xfd, 0x22, 0x57, 0x2b, 0xa2, 0x49, 0xd5, 0xa0,
-	0x48, 0x99, 0xcd, 0x32, 0x5a, 0xa5, 0x9a, 0xb0, 0xc8, 0xd5, 0x9a, 0x41, 0xf5, 0x57, 0x1a, 0xc3,
-	0xff, 0x1e, 0x76, 0xc3, 0x95, 0x1d, 0x51, 0xb9, 0x37, 0x01, 0xd3, 0x1e, 0xfa, 0xc2, 0xd2, 0x2d,
-	0x3c, 0x75, 0xec, 0xc8, 0x8e, 0x5d, 0x6c, 0x55, 0x8b, 0xfe, 0xf1, 0x98, 0x61, 0x91, 0xab, 0x6d,
-	0x01, 0x9d, 0xd4, 0x70, 0xc1, 0xf7, 0xce, 0x61, 0x83, 0xad, 0x34, 0x8b, 0xb1, 0x2c, 0x70, 0xdb,
-	0x79, 0x91, 0xab, 0x2d, 0x1e, 0x0d, 0x6a, 0x84, 0xb2, 0x18, 0x4b, 0x0b, 0x38, 0x6c, 0x6a, 0xf6,
-	0x70, 0xc2, 0x02, 0xcb, 0x49, 0xa8, 0x2c, 0x72, 0xe7, 0x45, 0x91, 0xab, 0xc7, 0x22, 0x3a, 0xab,
-	0xa9, 0x65, 0xc9, 0x98, 0x09, 0xd5, 0x66, 0xc7, 0x65, 0xd1, 0xa6, 0xf7, 0xef, 0x86, 0x41, 0xab,
-	0x61, 0x04, 0xaf, 0x7f, 0xf1, 0xd4, 0x0d, 0xdf, 0x40, 0xa1, 0x3c, 0x39, 0x95, 0xc1, 0xa4, 0x37,
-	0x1d, 0xcc, 0x54, 0x9d, 0x1f, 0x5d, 0xff, 0xeb, 0x22, 0xa8, 0xfa, 0xdb, 0xbc, 0x7f, 0xdb, 0x2b,
-	0x60, 0xb7, 0x57, 0xc0, 0xc7, 0x5e, 0x01, 0x2f, 0x07, 0xa5, 0xb3, 0x3b, 0x28, 0x9d, 0xf7, 0x83,
-	0xd2, 0x79, 0x9a, 0xf9, 0x21, 0x8b, 0x6c, 0x47, 0x77, 0xc9, 0xca, 0x60, 0x01, 0x49, 0xdd, 0xc0,
-	0x0e, 0x63, 0x3e, 0xc5, 0xc4, 0xc3, 0xc6, 0x66, 0x6e, 0x3c, 0xff, 0xe4, 0xcb, 0x6d, 0x8e, 0xc8,
-	0xdf, 0xd4, 0xfc, 0x33, 0x00, 0x00, 0xff, 0xff, 0x82, 0xe3, 0x5f, 0x36, 0x92, 0x02, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0xe3, 0x86, 0x04, 0xfa, 0x02, 0x2a, 0x39, 0x15, 0x30, 0x1d, 0xec, 0xc8, 0x53, 0xc4,
+	0x10, 0x4b, 0x89, 0x60, 0xaf, 0xd5, 0x91, 0xa1, 0x9c, 0x98, 0x58, 0xac, 0xb3, 0xfd, 0x64, 0x5b,
+	0xb8, 0xbe, 0xab, 0xef, 0xdc, 0xd2, 0xff, 0x82, 0x3f, 0x8b, 0xb1, 0x1b, 0x4c, 0x16, 0x4a, 0x36,
+	0xff, 0x15, 0xc8, 0x77, 0x36, 0x34, 0x0a, 0x9d, 0x72, 0xdf, 0xef, 0xe5, 0xf3, 0x27, 0xbd, 0xef,
+	0x81, 0xa3, 0xee, 0x04, 0x4a, 0xff, 0xba, 0xc6, 0xea, 0x2e, 0x4c, 0xb0, 0xca, 0x6f, 0x30, 0x09,
+	0x05, 0xe7, 0xc5, 0x4a, 0x54, 0x5c, 0x71, 0x32, 0xd1, 0xf3, 0xb3, 0xd3, 0x94, 0xa7, 0x5c, 0x13,
+	0xbf, 0x7b, 0x99, 0xa1, 0x97, 0xc1, 0x9b, 0x4f, 0x9d, 0xf1, 0xc2, 0xf8, 0x2e, 0x39, 0x2f, 0x28,
+	0x5e, 0xd7, 0x28, 0x15, 0x39, 0x85, 0x09, 0x93, 0x12, 0x95, 0x6d, 0x2d, 0xac, 0xe5, 0x31, 0x35,
+	0x82, 0xbc, 0x86, 0x69, 0x86, 0x79, 0x9a, 0x29, 0xfb, 0x48, 0xe3, 0x5e, 0x11, 0x17, 0x66, 0xea,
+	0x96, 0x89, 0x30, 0x2a, 0x78, 0xfc, 0x55, 0xda, 0xe3, 0x85, 0xb5, 0x1c, 0x53, 0xe8, 0x50, 0xa0,
+	0x89, 0xf7, 0xf3, 0x08, 0xec, 0xc3, 0x28, 0x29, 0x78, 0x29, 0x91, 0xb8, 0x7b, 0x59, 0xc1, 0x71,
+	0xdb, 0xb8, 0x06, 0x0c, 0xb1, 0x1e, 0x4c, 0xa5, 0x62, 0xaa, 0x96, 0x26, 0x36, 0x80, 0xb6, 0x71,
+	0x7b, 0x42, 0xfb, 0x5f, 0x72, 0x06, 0xcf, 0x12, 0x8c, 0xf3, 0x2b, 0x56, 0x0c, 0xf9, 0x7f, 0x35,
+	0xf9, 0x00, 0x2f, 0x22, 0x56, 0xb0, 0x32, 0xc6, 0xd0, 0x04, 0x3d, 0xd1, 0x9f, 0x99, 0xb7, 0x8d,
+	0xbb, 0x3f, 0xa0, 0xcf, 0x7b, 0x79, 0xae, 0x73, 0x37, 0x30, 0xe8, 0xb0, 0xaa, 0x4b, 0xb4, 0x27,
+	0xda, 0xf6, 0xb2, 0x6d, 0xdc, 0x3d, 0x4e, 0x67, 0xbd, 0xa2, 0x75, 0x89, 0xe4, 0x1c, 0xe6, 0x43,
+	0x0f, 0x09, 0x0a, 0x95, 0x85, 0x91, 0x90, 0xf6, 0x54, 0x3b, 0x5f, 0xb5, 0x8d, 0x7b, 0x38, 0xa4,
+	0x27, 0x3d, 0xba, 0xe8, 0x48, 0x20, 0x24, 0x79, 0x07, 0x73, 0x56, 0xc6, 0x19, 0xaf, 0x42, 0xbd,
+	0x55, 0x51, 0xe5, 0x31, 0xda, 0x4f, 0xf5, 0xc6, 0x4f, 0xcc, 0xe0, 0xf3, 0x2d, 0x13, 0x97, 0x1d,
+	0xf6, 0xd6, 0x87, 0x8b, 0x95, 0x43, 0x89, 0xff, 0xea, 0xb2, 0x1e, 0xd6, 0xe5, 0x51, 0x78, 0xfb,
+	0x1f, 0x4f, 0xdf, 0xc6, 0x7b, 0x98, 0x74, 0xf7, 0x23, 0x6d, 0x6b, 0x31, 0x5e, 0xce, 0xd6, 0xee,
+	0x4a, 0x5f, 0xd0, 0xea, 0xb1, 0xf6, 0xa8, 0xf9, 0x77, 0xf0, 0xf1, 0xc7, 0xd6, 0xb1, 0xee, 0xb7,
+	0x8e, 0xf5, 0x7b, 0xeb, 0x58, 0xdf, 0x77, 0xce, 0xe8, 0x7e, 0xe7, 0x8c, 0x7e, 0xed, 0x9c, 0xd1,
+	0x97, 0x75, 0x9a, 0xab, 0x82, 0x45, 0xab, 0x98, 0x5f, 0xf9, 0x2a, 0xe3, 0x55, 0x9c, 0xb1, 0xbc,
+	0xd4, 0xaf, 0x92, 0x27, 0xe8, 0xdf, 0x6c, 0xfc, 0x6f, 0x0f, 0x79, 0x97, 0x16, 0x4d, 0xf5, 0x81,
+	0x6e, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x53, 0x2c, 0x48, 0xe7, 0xdf, 0x02, 0x00, 0x00,
 }
 
 func (m *QueryDerivedPoolRequest) Marshal() (dAtA []byte, err error) {
@@ -305,6 +325,11 @@ func (m *QueryDerivedPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.TwapBlocks != 0 {
+		i = encodeVarintQueryDerivedPool(dAtA, i, uint64(m.TwapBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Height) > 0 {
 		i -= len(m.Height)
 		copy(dAtA[i:], m.Height)
@@ -342,6 +367,13 @@ func (m *QueryDerivedPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.AnchorTwapPrice) > 0 {
+		i -= len(m.AnchorTwapPrice)
+		copy(dAtA[i:], m.AnchorTwapPrice)
+		i = encodeVarintQueryDerivedPool(dAtA, i, uint64(len(m.AnchorTwapPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.DerivedDepthBps) > 0 {
 		i -= len(m.DerivedDepthBps)
 		copy(dAtA[i:], m.DerivedDepthBps)
@@ -477,6 +509,9 @@ func (m *QueryDerivedPoolRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryDerivedPool(uint64(l))
 	}
+	if m.TwapBlocks != 0 {
+		n += 1 + sovQueryDerivedPool(uint64(m.TwapBlocks))
+	}
 	return n
 }
 
@@ -509,6 +544,10 @@ func (m *QueryDerivedPoolResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryDerivedPool(uint64(l))
 	}
+	l = len(m.AnchorTwapPrice)
+	if l > 0 {
+		n += 1 + l + sovQueryDerivedPool(uint64(l))
+	}
 	return n
 }
 
@@ -639,6 +678,25 @@ func (m *QueryDerivedPoolRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapBlocks", wireType)
+			}
+			m.TwapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDerivedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryDerivedPool(dAtA[iNdEx:])
@@ -868,6 +926,38 @@ func (m *QueryDerivedPoolResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.DerivedDepthBps = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnchorTwapPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryDerivedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryDerivedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryDerivedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnchorTwapPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryDerivedPool(dAtA[iNdEx:])
@@ -567,23 +567,22 @@ func (m *QueryMimirNodeValuesResponse) GetNodeMimirs() []*Mimir {
 	return nil
 }
 
-type Mimir struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+type QueryMimirScheduledRequest struct {
+	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *Mimir) Reset()         { *m = Mimir{} }
-func (m *Mimir) String() string { return proto.CompactTextString(m) }
-func (*Mimir) ProtoMessage()    {}
-func (*Mimir) Descriptor() ([]byte, []int) {
+func (m *QueryMimirScheduledRequest) Reset()         { *m = QueryMimirScheduledRequest{} }
+func (m *QueryMimirScheduledRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirScheduledRequest) ProtoMessage()    {}
+func (*QueryMimirScheduledRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dae8c053a533c659, []int{12}
 }
-func (m *Mimir) XXX_Unmarshal(b []byte) error {
+func (m *QueryMimirScheduledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Mimir) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryMimirScheduledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Mimir.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryMimirScheduledRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -593,761 +592,3335 @@ func (m *Mimir) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Mimir) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Mimir.Merge(m, src)
+func (m *QueryMimirScheduledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirScheduledRequest.Merge(m, src)
 }
-func (m *Mimir) XXX_Size() int {
+func (m *QueryMimirScheduledRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Mimir) XXX_DiscardUnknown() {
-	xxx_messageInfo_Mimir.DiscardUnknown(m)
+func (m *QueryMimirScheduledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirScheduledRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Mimir proto.InternalMessageInfo
+var xxx_messageInfo_QueryMimirScheduledRequest proto.InternalMessageInfo
 
-func (m *Mimir) GetKey() string {
+func (m *QueryMimirScheduledRequest) GetHeight() string {
 	if m != nil {
-		return m.Key
+		return m.Height
 	}
 	return ""
 }
 
-func (m *Mimir) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
+type QueryMimirScheduledResponse struct {
+	// mimir changes that reached consensus, in order of activation
+	Scheduled []*QueryScheduledMimir `protobuf:"bytes,1,rep,name=scheduled,proto3" json:"scheduled"`
+	// node votes for mimir changes at a future height
+	NodeVotes []*QueryScheduledMimir `protobuf:"bytes,2,rep,name=node_votes,json=nodeVotes,proto3" json:"node_votes"`
 }
 
-func init() {
-	proto.RegisterType((*QueryMimirValuesRequest)(nil), "types.QueryMimirValuesRequest")
-	proto.RegisterType((*QueryMimirValuesResponse)(nil), "types.QueryMimirValuesResponse")
-	proto.RegisterType((*QueryMimirWithKeyRequest)(nil), "types.QueryMimirWithKeyRequest")
-	proto.RegisterType((*QueryMimirWithKeyResponse)(nil), "types.QueryMimirWithKeyResponse")
-	proto.RegisterType((*QueryMimirAdminValuesRequest)(nil), "types.QueryMimirAdminValuesRequest")
-	proto.RegisterType((*QueryMimirAdminValuesResponse)(nil), "types.QueryMimirAdminValuesResponse")
-	proto.RegisterType((*QueryMimirNodesAllValuesRequest)(nil), "types.QueryMimirNodesAllValuesRequest")
-	proto.RegisterType((*QueryMimirNodesAllValuesResponse)(nil), "types.QueryMimirNodesAllValuesResponse")
-	proto.RegisterType((*QueryMimirNodesValuesRequest)(nil), "types.QueryMimirNodesValuesRequest")
-	proto.RegisterType((*QueryMimirNodesValuesResponse)(nil), "types.QueryMimirNodesValuesResponse")
-	proto.RegisterType((*QueryMimirNodeValuesRequest)(nil), "types.QueryMimirNodeValuesRequest")
-	proto.RegisterType((*QueryMimirNodeValuesResponse)(nil), "types.QueryMimirNodeValuesResponse")
-	proto.RegisterType((*Mimir)(nil), "types.Mimir")
+func (m *QueryMimirScheduledResponse) Reset()         { *m = QueryMimirScheduledResponse{} }
+func (m *QueryMimirScheduledResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirScheduledResponse) ProtoMessage()    {}
+func (*QueryMimirScheduledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{13}
 }
-
-func init() { proto.RegisterFile("types/query_mimir.proto", fileDescriptor_dae8c053a533c659) }
-
-var fileDescriptor_dae8c053a533c659 = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4f, 0x8b, 0xda, 0x40,
-	0x14, 0x4f, 0x14, 0x2d, 0x7d, 0x4a, 0x11, 0x11, 0x4d, 0x6d, 0x1b, 0x25, 0xf4, 0xe0, 0xa5, 0x09,
-	0x2a, 0x94, 0xf6, 0x56, 0xa5, 0x3d, 0xb5, 0xf6, 0x4f, 0x0e, 0x2d, 0x94, 0x05, 0x89, 0x66, 0x48,
-	0xc2, 0x26, 0x19, 0xcd, 0x44, 0xd9, 0x7c, 0x8b, 0xfd, 0x58, 0x1e, 0x3d, 0xee, 0x49, 0x16, 0xbd,
-	0xed, 0xa7, 0x58, 0x66, 0x32, 0x6a, 0xe2, 0xaa, 0xb8, 0x97, 0xf0, 0xe6, 0xcd, 0xfb, 0xfd, 0x99,
-	0xf7, 0x5e, 0xa0, 0x16, 0x46, 0x13, 0x44, 0xb4, 0xe9, 0x0c, 0x05, 0xd1, 0xd0, 0x73, 0x3c, 0x27,
-	0x50, 0x27, 0x01, 0x0e, 0x71, 0x39, 0xc7, 0x2e, 0xea, 0x15, 0x0b, 0x5b, 0x98, 0x65, 0x34, 0x1a,
-	0xc5, 0x97, 0xf5, 0x6a, 0x8c, 0xa2, 0xdf, 0x24, 0x48, 0x69, 0x43, 0xed, 0x0f, 0x65, 0x1a, 0xd0,
-	0xdc, 0x5f, 0xc3, 0x9d, 0x21, 0xa2, 0xa3, 0xe9, 0x0c, 0x91, 0xb0, 0x5c, 0x85, 0xbc, 0x8d, 0x1c,
-	0xcb, 0x0e, 0x25, 0xb1, 0x29, 0xb6, 0x5e, 0xea, 0xfc, 0xa4, 0x7c, 0x01, 0xe9, 0x29, 0x84, 0x4c,
-	0xb0, 0x4f, 0x50, 0xf9, 0x3d, 0xe4, 0x19, 0x3b, 0x91, 0xc4, 0x66, 0xb6, 0x55, 0xe8, 0x14, 0x55,
-	0xa6, 0xab, 0xb2, 0x5a, 0x9d, 0xdf, 0x29, 0x5f, 0x93, 0x0c, 0xff, 0x9c, 0xd0, 0xfe, 0x8e, 0xa2,
-	0xad, 0x6a, 0x09, 0xb2, 0xd7, 0x28, 0xe2, 0x92, 0x34, 0x4c, 0xf8, 0xc8, 0xa4, 0x7c, 0xb4, 0xe1,
-	0xf5, 0x11, 0x16, 0x6e, 0xa4, 0x02, 0xb9, 0x39, 0xb5, 0xc6, 0x88, 0xb2, 0x7a, 0x7c, 0x50, 0x3e,
-	0xc2, 0xdb, 0x3d, 0xa4, 0x67, 0x7a, 0x8e, 0x7f, 0xd9, 0x93, 0x7f, 0xc3, 0xbb, 0x13, 0x38, 0x2e,
-	0xa7, 0x41, 0xd1, 0xa0, 0xe9, 0xe1, 0x99, 0xd7, 0x17, 0x58, 0xc5, 0x20, 0x6e, 0xc1, 0x67, 0x68,
-	0xec, 0x19, 0x7f, 0x62, 0x13, 0x91, 0x9e, 0xeb, 0x5e, 0x66, 0xe6, 0x0a, 0x9a, 0xa7, 0xa1, 0xdc,
-	0xcf, 0xa7, 0x83, 0x39, 0x94, 0xb8, 0x13, 0x5a, 0xce, 0x70, 0xfd, 0x57, 0x8b, 0x55, 0x43, 0x78,
-	0x58, 0x35, 0x78, 0xdd, 0x6e, 0x36, 0xa9, 0x16, 0x31, 0xf6, 0xcb, 0x5c, 0x7d, 0x4b, 0xb6, 0x28,
-	0x85, 0x7b, 0xd6, 0x6a, 0xfc, 0x82, 0x37, 0x69, 0x9a, 0xb4, 0xba, 0x04, 0x2f, 0x0c, 0xd3, 0x0c,
-	0x10, 0x21, 0x5c, 0x7e, 0x7b, 0x3c, 0xb9, 0x25, 0x83, 0xc3, 0xf7, 0x1c, 0xd8, 0xfa, 0x00, 0x05,
-	0x1f, 0x9b, 0xe8, 0xdc, 0xe0, 0xc0, 0xdf, 0x76, 0x8d, 0x28, 0x1a, 0xe4, 0x58, 0x74, 0x64, 0x4f,
-	0x77, 0x2b, 0x97, 0x49, 0xac, 0x5c, 0xff, 0xc7, 0x62, 0x2d, 0x8b, 0xcb, 0xb5, 0x2c, 0xde, 0xaf,
-	0x65, 0xf1, 0x76, 0x23, 0x0b, 0xcb, 0x8d, 0x2c, 0xdc, 0x6d, 0x64, 0xe1, 0x7f, 0xc7, 0x72, 0x42,
-	0xd7, 0x18, 0xa9, 0x63, 0xec, 0x69, 0xa1, 0x8d, 0x83, 0xb1, 0x6d, 0x38, 0x3e, 0x8b, 0xa8, 0x9e,
-	0x36, 0xef, 0x6a, 0x37, 0xc9, 0x3c, 0x35, 0x34, 0xca, 0xb3, 0xbf, 0xb6, 0xfb, 0x18, 0x00, 0x00,
-	0xff, 0xff, 0xab, 0x66, 0xca, 0x08, 0x05, 0x04, 0x00, 0x00,
+func (m *QueryMimirScheduledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryMimirValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMimirScheduledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirScheduledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryMimirValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMimirScheduledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirScheduledResponse.Merge(m, src)
+}
+func (m *QueryMimirScheduledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirScheduledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirScheduledResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMimirValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Height) > 0 {
-		i -= len(m.Height)
-		copy(dAtA[i:], m.Height)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMimirScheduledResponse proto.InternalMessageInfo
+
+func (m *QueryMimirScheduledResponse) GetScheduled() []*QueryScheduledMimir {
+	if m != nil {
+		return m.Scheduled
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMimirValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMimirScheduledResponse) GetNodeVotes() []*QueryScheduledMimir {
+	if m != nil {
+		return m.NodeVotes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMimirValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryScheduledMimir struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value"`
+	// the block height the value takes effect
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+	// the node that voted for the change, unset for scheduled changes
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *QueryMimirValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Mimirs) > 0 {
-		for iNdEx := len(m.Mimirs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryScheduledMimir) Reset()         { *m = QueryScheduledMimir{} }
+func (m *QueryScheduledMimir) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledMimir) ProtoMessage()    {}
+func (*QueryScheduledMimir) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{14}
+}
+func (m *QueryScheduledMimir) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledMimir) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledMimir.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryScheduledMimir) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledMimir.Merge(m, src)
+}
+func (m *QueryScheduledMimir) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledMimir) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledMimir.DiscardUnknown(m)
 }
 
-func (m *QueryMimirWithKeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScheduledMimir proto.InternalMessageInfo
+
+func (m *QueryScheduledMimir) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMimirWithKeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryScheduledMimir) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
 }
 
-func (m *QueryMimirWithKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Height) > 0 {
-		i -= len(m.Height)
-		copy(dAtA[i:], m.Height)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryScheduledMimir) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryMimirWithKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScheduledMimir) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMimirWithKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMimirHistoryRequest struct {
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryMimirWithKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryMimirHistoryRequest) Reset()         { *m = QueryMimirHistoryRequest{} }
+func (m *QueryMimirHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirHistoryRequest) ProtoMessage()    {}
+func (*QueryMimirHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{15}
 }
-
-func (m *QueryMimirAdminValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMimirHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryMimirAdminValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMimirHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirHistoryRequest.Merge(m, src)
+}
+func (m *QueryMimirHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMimirAdminValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Height) > 0 {
-		i -= len(m.Height)
-		copy(dAtA[i:], m.Height)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMimirHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMimirHistoryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryMimirAdminValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMimirHistoryRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMimirAdminValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMimirNodeHistoryRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryMimirAdminValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdminMimirs) > 0 {
-		for iNdEx := len(m.AdminMimirs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AdminMimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMimirNodeHistoryRequest) Reset()         { *m = QueryMimirNodeHistoryRequest{} }
+func (m *QueryMimirNodeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirNodeHistoryRequest) ProtoMessage()    {}
+func (*QueryMimirNodeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{16}
+}
+func (m *QueryMimirNodeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirNodeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirNodeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMimirNodesAllValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMimirNodeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirNodeHistoryRequest.Merge(m, src)
 }
-
-func (m *QueryMimirNodesAllValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMimirNodeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirNodeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirNodeHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMimirNodesAllValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Height) > 0 {
-		i -= len(m.Height)
-		copy(dAtA[i:], m.Height)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryMimirNodeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMimirNodeHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryMimirNodesAllValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMimirNodeHistoryRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryMimirNodesAllValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMimirHistoryResponse struct {
+	// mimir changes, newest first
+	History []*QueryMimirHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
 }
 
-func (m *QueryMimirNodesAllValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Mimirs) > 0 {
-		for iNdEx := len(m.Mimirs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMimirHistoryResponse) Reset()         { *m = QueryMimirHistoryResponse{} }
+func (m *QueryMimirHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirHistoryResponse) ProtoMessage()    {}
+func (*QueryMimirHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{17}
+}
+func (m *QueryMimirHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMimirNodesValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMimirHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirHistoryResponse.Merge(m, src)
 }
-
-func (m *QueryMimirNodesValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMimirHistoryResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryMimirNodesValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Height) > 0 {
-		i -= len(m.Height)
-		copy(dAtA[i:], m.Height)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryMimirHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMimirNodesValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMimirHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryMimirHistoryResponse) GetHistory() []*QueryMimirHistory {
+	if m != nil {
+		return m.History
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMimirNodesValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMimirHistory struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	// the new value, -1 for a deleted value or withdrawn vote
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value"`
+	// the value before the change, -1 if unset
+	PreviousValue int64 `protobuf:"varint,3,opt,name=previous_value,json=previousValue,proto3" json:"previous_value"`
+	// the block height of the change
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height"`
+	// the node that voted, unset for mimir values
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+	// mimir for a set mimir value, node_vote for a node vote
+	Type string `protobuf:"bytes,6,opt,name=type,proto3" json:"type"`
 }
 
-func (m *QueryMimirNodesValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Mimirs) > 0 {
-		for iNdEx := len(m.Mimirs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMimirHistory) Reset()         { *m = QueryMimirHistory{} }
+func (m *QueryMimirHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirHistory) ProtoMessage()    {}
+func (*QueryMimirHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{18}
+}
+func (m *QueryMimirHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMimirNodeValuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMimirHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirHistory.Merge(m, src)
 }
-
-func (m *QueryMimirNodeValuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMimirHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirHistory.DiscardUnknown(m)
 }
 
-func (m *QueryMimirNodeValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Height) > 0 {
-		i -= len(m.Height)
-		copy(dAtA[i:], m.Height)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryMimirHistory proto.InternalMessageInfo
+
+func (m *QueryMimirHistory) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryMimirHistory) GetValue() int64 {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryMimirNodeValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMimirHistory) GetPreviousValue() int64 {
+	if m != nil {
+		return m.PreviousValue
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryMimirNodeValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMimirHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *QueryMimirNodeValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NodeMimirs) > 0 {
-		for iNdEx := len(m.NodeMimirs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NodeMimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryMimirHistory) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Mimir) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMimirHistory) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Mimir) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMimirRegistryRequest struct {
+	Height string `protobuf:"bytes,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *Mimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryMimirRegistryRequest) Reset()         { *m = QueryMimirRegistryRequest{} }
+func (m *QueryMimirRegistryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirRegistryRequest) ProtoMessage()    {}
+func (*QueryMimirRegistryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{19}
 }
-
-func encodeVarintQueryMimir(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQueryMimir(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryMimirRegistryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *QueryMimirValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
+func (m *QueryMimirRegistryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirRegistryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryMimirRegistryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirRegistryRequest.Merge(m, src)
+}
+func (m *QueryMimirRegistryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirRegistryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirRegistryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMimirValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Mimirs) > 0 {
-		for _, e := range m.Mimirs {
-			l = e.Size()
-			n += 1 + l + sovQueryMimir(uint64(l))
-		}
+var xxx_messageInfo_QueryMimirRegistryRequest proto.InternalMessageInfo
+
+func (m *QueryMimirRegistryRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return ""
 }
 
-func (m *QueryMimirWithKeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
-	}
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
-	}
-	return n
+type QueryMimirRegistryResponse struct {
+	Definitions []*QueryMimirDefinition `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions"`
 }
 
-func (m *QueryMimirWithKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovQueryMimir(uint64(m.Value))
+func (m *QueryMimirRegistryResponse) Reset()         { *m = QueryMimirRegistryResponse{} }
+func (m *QueryMimirRegistryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirRegistryResponse) ProtoMessage()    {}
+func (*QueryMimirRegistryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{20}
+}
+func (m *QueryMimirRegistryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirRegistryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirRegistryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryMimirRegistryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirRegistryResponse.Merge(m, src)
+}
+func (m *QueryMimirRegistryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirRegistryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirRegistryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMimirAdminValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
+var xxx_messageInfo_QueryMimirRegistryResponse proto.InternalMessageInfo
+
+func (m *QueryMimirRegistryResponse) GetDefinitions() []*QueryMimirDefinition {
+	if m != nil {
+		return m.Definitions
 	}
-	return n
+	return nil
 }
 
-func (m *QueryMimirAdminValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AdminMimirs) > 0 {
-		for _, e := range m.AdminMimirs {
-			l = e.Size()
-			n += 1 + l + sovQueryMimir(uint64(l))
+type QueryMimirDefinition struct {
+	// the mimir key, or key template with a %s for each reference
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	// int, bool or height
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	// the unit of the value, unset for plain numbers
+	Unit string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Min  int64  `protobuf:"varint,4,opt,name=min,proto3" json:"min"`
+	// the maximum value, unset for no upper bound
+	Max         int64  `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description"`
+	// true for key templates
+	Template bool `protobuf:"varint,7,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (m *QueryMimirDefinition) Reset()         { *m = QueryMimirDefinition{} }
+func (m *QueryMimirDefinition) String() string { return proto.CompactTextString(m) }
+func (*QueryMimirDefinition) ProtoMessage()    {}
+func (*QueryMimirDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{21}
+}
+func (m *QueryMimirDefinition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMimirDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMimirDefinition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryMimirDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMimirDefinition.Merge(m, src)
+}
+func (m *QueryMimirDefinition) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMimirDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMimirDefinition.DiscardUnknown(m)
 }
 
-func (m *QueryMimirNodesAllValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
+var xxx_messageInfo_QueryMimirDefinition proto.InternalMessageInfo
+
+func (m *QueryMimirDefinition) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return n
+	return ""
 }
 
-func (m *QueryMimirNodesAllValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Mimirs) > 0 {
-		for _, e := range m.Mimirs {
-			l = e.Size()
-			n += 1 + l + sovQueryMimir(uint64(l))
-		}
+func (m *QueryMimirDefinition) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return n
+	return ""
 }
 
-func (m *QueryMimirNodesValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
+func (m *QueryMimirDefinition) GetUnit() string {
+	if m != nil {
+		return m.Unit
 	}
-	return n
+	return ""
 }
 
-func (m *QueryMimirNodesValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Mimirs) > 0 {
-		for _, e := range m.Mimirs {
-			l = e.Size()
-			n += 1 + l + sovQueryMimir(uint64(l))
-		}
+func (m *QueryMimirDefinition) GetMin() int64 {
+	if m != nil {
+		return m.Min
 	}
-	return n
+	return 0
 }
 
-func (m *QueryMimirNodeValuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
-	}
-	l = len(m.Height)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
+func (m *QueryMimirDefinition) GetMax() int64 {
+	if m != nil {
+		return m.Max
 	}
-	return n
+	return 0
 }
 
-func (m *QueryMimirNodeValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.NodeMimirs) > 0 {
-		for _, e := range m.NodeMimirs {
-			l = e.Size()
-			n += 1 + l + sovQueryMimir(uint64(l))
-		}
+func (m *QueryMimirDefinition) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return n
+	return ""
 }
 
-func (m *Mimir) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovQueryMimir(uint64(l))
-	}
-	if m.Value != 0 {
-		n += 1 + sovQueryMimir(uint64(m.Value))
+func (m *QueryMimirDefinition) GetTemplate() bool {
+	if m != nil {
+		return m.Template
 	}
-	return n
+	return false
 }
 
-func sovQueryMimir(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type Mimir struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
 }
-func sozQueryMimir(x uint64) (n int) {
-	return sovQueryMimir(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Mimir) Reset()         { *m = Mimir{} }
+func (m *Mimir) String() string { return proto.CompactTextString(m) }
+func (*Mimir) ProtoMessage()    {}
+func (*Mimir) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dae8c053a533c659, []int{22}
 }
-func (m *QueryMimirValuesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *Mimir) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Mimir) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Mimir.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Mimir) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Mimir.Merge(m, src)
+}
+func (m *Mimir) XXX_Size() int {
+	return m.Size()
+}
+func (m *Mimir) XXX_DiscardUnknown() {
+	xxx_messageInfo_Mimir.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Mimir proto.InternalMessageInfo
+
+func (m *Mimir) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Mimir) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryMimirValuesRequest)(nil), "types.QueryMimirValuesRequest")
+	proto.RegisterType((*QueryMimirValuesResponse)(nil), "types.QueryMimirValuesResponse")
+	proto.RegisterType((*QueryMimirWithKeyRequest)(nil), "types.QueryMimirWithKeyRequest")
+	proto.RegisterType((*QueryMimirWithKeyResponse)(nil), "types.QueryMimirWithKeyResponse")
+	proto.RegisterType((*QueryMimirAdminValuesRequest)(nil), "types.QueryMimirAdminValuesRequest")
+	proto.RegisterType((*QueryMimirAdminValuesResponse)(nil), "types.QueryMimirAdminValuesResponse")
+	proto.RegisterType((*QueryMimirNodesAllValuesRequest)(nil), "types.QueryMimirNodesAllValuesRequest")
+	proto.RegisterType((*QueryMimirNodesAllValuesResponse)(nil), "types.QueryMimirNodesAllValuesResponse")
+	proto.RegisterType((*QueryMimirNodesValuesRequest)(nil), "types.QueryMimirNodesValuesRequest")
+	proto.RegisterType((*QueryMimirNodesValuesResponse)(nil), "types.QueryMimirNodesValuesResponse")
+	proto.RegisterType((*QueryMimirNodeValuesRequest)(nil), "types.QueryMimirNodeValuesRequest")
+	proto.RegisterType((*QueryMimirNodeValuesResponse)(nil), "types.QueryMimirNodeValuesResponse")
+	proto.RegisterType((*QueryMimirScheduledRequest)(nil), "types.QueryMimirScheduledRequest")
+	proto.RegisterType((*QueryMimirScheduledResponse)(nil), "types.QueryMimirScheduledResponse")
+	proto.RegisterType((*QueryScheduledMimir)(nil), "types.QueryScheduledMimir")
+	proto.RegisterType((*QueryMimirHistoryRequest)(nil), "types.QueryMimirHistoryRequest")
+	proto.RegisterType((*QueryMimirNodeHistoryRequest)(nil), "types.QueryMimirNodeHistoryRequest")
+	proto.RegisterType((*QueryMimirHistoryResponse)(nil), "types.QueryMimirHistoryResponse")
+	proto.RegisterType((*QueryMimirHistory)(nil), "types.QueryMimirHistory")
+	proto.RegisterType((*QueryMimirRegistryRequest)(nil), "types.QueryMimirRegistryRequest")
+	proto.RegisterType((*QueryMimirRegistryResponse)(nil), "types.QueryMimirRegistryResponse")
+	proto.RegisterType((*QueryMimirDefinition)(nil), "types.QueryMimirDefinition")
+	proto.RegisterType((*Mimir)(nil), "types.Mimir")
+}
+
+func init() { proto.RegisterFile("types/query_mimir.proto", fileDescriptor_dae8c053a533c659) }
+
+var fileDescriptor_dae8c053a533c659 = []byte{
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5f, 0x4f, 0x13, 0x41,
+	0x10, 0xef, 0xd1, 0x7f, 0x74, 0x0a, 0x88, 0x67, 0x03, 0x47, 0xc1, 0x5e, 0x73, 0xf1, 0x81, 0x17,
+	0xdb, 0x00, 0xc6, 0xc8, 0x93, 0xd2, 0x60, 0xd4, 0x28, 0x88, 0x6b, 0x82, 0x89, 0x21, 0x69, 0x4a,
+	0x6f, 0x6d, 0x37, 0xf6, 0xee, 0xca, 0xed, 0xb5, 0xa1, 0x1f, 0xc2, 0xc4, 0xaf, 0xe2, 0xb7, 0xe0,
+	0x91, 0x47, 0x9f, 0x4e, 0x03, 0x3e, 0xdd, 0xa7, 0x30, 0xbb, 0xb7, 0xd7, 0x6e, 0xff, 0x41, 0xd1,
+	0x97, 0xcb, 0xcc, 0xec, 0xfc, 0x66, 0x67, 0xe6, 0x37, 0xb3, 0x39, 0x58, 0xf5, 0x7a, 0x6d, 0x4c,
+	0xcb, 0x67, 0x1d, 0xec, 0xf6, 0xaa, 0x16, 0xb1, 0x88, 0x5b, 0x6a, 0xbb, 0x8e, 0xe7, 0xa8, 0x49,
+	0x7e, 0x90, 0xcf, 0x35, 0x9c, 0x86, 0xc3, 0x2d, 0x65, 0x26, 0x85, 0x87, 0xf9, 0x95, 0x10, 0xc5,
+	0xbe, 0x32, 0xc8, 0xd8, 0x82, 0xd5, 0x0f, 0x2c, 0xd2, 0x01, 0xb3, 0x1d, 0xd7, 0x5a, 0x1d, 0x4c,
+	0x11, 0x3e, 0xeb, 0x60, 0xea, 0xa9, 0x2b, 0x90, 0x6a, 0x62, 0xd2, 0x68, 0x7a, 0x9a, 0x52, 0x54,
+	0x36, 0x33, 0x48, 0x68, 0xc6, 0x0b, 0xd0, 0xc6, 0x21, 0xb4, 0xed, 0xd8, 0x14, 0xab, 0x8f, 0x20,
+	0xc5, 0xa3, 0x53, 0x4d, 0x29, 0xc6, 0x37, 0xb3, 0xdb, 0x0b, 0x25, 0x7e, 0x6f, 0x89, 0xfb, 0x22,
+	0x71, 0x66, 0xec, 0xcb, 0x11, 0x3e, 0x11, 0xaf, 0xf9, 0x16, 0xf7, 0xa2, 0x5b, 0x97, 0x21, 0xfe,
+	0x15, 0xf7, 0xc4, 0x95, 0x4c, 0x94, 0xf2, 0x98, 0x1b, 0xca, 0x63, 0x0b, 0xd6, 0x26, 0x44, 0x11,
+	0x89, 0xe4, 0x20, 0xd9, 0x65, 0xa9, 0xf1, 0x40, 0x71, 0x14, 0x2a, 0xc6, 0x53, 0xd8, 0x18, 0x40,
+	0xf6, 0x4c, 0x8b, 0xd8, 0xb3, 0x95, 0x7c, 0x04, 0x0f, 0xa7, 0xe0, 0xc4, 0x75, 0x65, 0x58, 0xa8,
+	0x31, 0x73, 0xf5, 0x86, 0xea, 0xb3, 0xdc, 0xe3, 0x20, 0x6c, 0xc1, 0x2e, 0xe8, 0x83, 0x88, 0x87,
+	0x8e, 0x89, 0xe9, 0x5e, 0xab, 0x35, 0x5b, 0x32, 0x27, 0x50, 0x9c, 0x0e, 0x15, 0xf9, 0x3c, 0x1b,
+	0xe1, 0x61, 0x59, 0x64, 0xc2, 0xdc, 0x39, 0xae, 0xb2, 0x74, 0xe1, 0xeb, 0xb1, 0xc0, 0xd7, 0x85,
+	0x5f, 0x9f, 0x9b, 0xa1, 0x16, 0xf1, 0xe8, 0xb3, 0x65, 0xf5, 0x52, 0x6e, 0xd1, 0x10, 0xee, 0x4e,
+	0xa3, 0xf1, 0x1e, 0xd6, 0x87, 0xc3, 0x0c, 0xdf, 0xae, 0x41, 0xba, 0x66, 0x9a, 0x2e, 0xa6, 0x54,
+	0x5c, 0x1f, 0xa9, 0x53, 0xa7, 0xe4, 0x60, 0xb4, 0x9e, 0x91, 0xb4, 0x1e, 0x43, 0xd6, 0x76, 0x4c,
+	0x7c, 0x13, 0x71, 0x60, 0x47, 0x5d, 0xa3, 0xc6, 0x13, 0xc8, 0x0f, 0xc2, 0x7d, 0xac, 0x37, 0xb1,
+	0xd9, 0x69, 0x61, 0xf3, 0xb6, 0xe6, 0xfc, 0x50, 0xe4, 0xb2, 0x24, 0x98, 0x48, 0xe2, 0x15, 0x64,
+	0x68, 0x64, 0x14, 0x29, 0xe4, 0x45, 0x0a, 0x1c, 0xd6, 0x47, 0x84, 0xdc, 0x2d, 0x06, 0xbe, 0x3e,
+	0x00, 0xa0, 0x81, 0xa8, 0xbe, 0x01, 0x9e, 0x6c, 0xb5, 0xeb, 0x78, 0x98, 0x6a, 0x73, 0xb7, 0x46,
+	0x5a, 0x0a, 0x7c, 0x5d, 0x42, 0xa0, 0x0c, 0x93, 0x8f, 0x99, 0x68, 0x7c, 0x53, 0xe0, 0xc1, 0x04,
+	0x88, 0xba, 0x26, 0x2d, 0x68, 0x25, 0x1d, 0xf8, 0x3a, 0x53, 0xc3, 0x4d, 0xd5, 0xa3, 0xa5, 0x63,
+	0x14, 0xc4, 0x2b, 0x99, 0xc0, 0xd7, 0x43, 0x83, 0xd8, 0x3f, 0xd5, 0xe8, 0xf7, 0x27, 0xce, 0x3d,
+	0x80, 0x0d, 0x60, 0x68, 0x89, 0x7a, 0xc5, 0x7a, 0x48, 0x49, 0xc3, 0xc6, 0xae, 0x96, 0x08, 0x7b,
+	0x18, 0x6a, 0xc3, 0x8f, 0xc6, 0x6b, 0x42, 0x3d, 0xc7, 0xfd, 0x87, 0x47, 0xe3, 0x68, 0x74, 0x1c,
+	0x46, 0x22, 0xdd, 0x7d, 0xc0, 0x4e, 0xe4, 0x67, 0xa8, 0x1f, 0x4d, 0x10, 0xfb, 0x1c, 0xd2, 0xcd,
+	0xd0, 0x24, 0x68, 0xd5, 0x64, 0x32, 0x64, 0x48, 0x25, 0x1b, 0xf8, 0x7a, 0xe4, 0x8c, 0x22, 0xc1,
+	0xf8, 0xa3, 0xc0, 0xfd, 0x31, 0xdf, 0xff, 0xe2, 0x60, 0x17, 0x96, 0xda, 0x2e, 0xee, 0x12, 0xa7,
+	0x43, 0xab, 0xa1, 0x67, 0xc8, 0x85, 0x1a, 0xf8, 0xfa, 0xc8, 0x09, 0x5a, 0x8c, 0xf4, 0xe3, 0x11,
+	0xfa, 0x12, 0x33, 0xd0, 0x97, 0x94, 0xe9, 0x53, 0x37, 0x20, 0xc1, 0x2a, 0xd7, 0x52, 0x3c, 0xe7,
+	0xf9, 0xc0, 0xd7, 0xb9, 0x8e, 0xf8, 0xd7, 0xd8, 0x91, 0x9b, 0x88, 0x70, 0x83, 0x50, 0x6f, 0xc0,
+	0xc9, 0xb4, 0xad, 0x6a, 0xc9, 0xbb, 0x38, 0x00, 0x89, 0xd6, 0x1f, 0x42, 0xd6, 0xc4, 0x5f, 0x88,
+	0x4d, 0x3c, 0xe2, 0xd8, 0xd1, 0x62, 0xaf, 0x8f, 0xb5, 0x7f, 0xbf, 0xef, 0x53, 0xb9, 0x17, 0xf8,
+	0xba, 0x8c, 0x41, 0xb2, 0x62, 0xfc, 0x52, 0x20, 0x37, 0x09, 0x76, 0x13, 0x19, 0x51, 0xd1, 0x73,
+	0x93, 0x8a, 0x56, 0x55, 0x48, 0x74, 0x6c, 0x12, 0xee, 0x42, 0x06, 0x71, 0x99, 0x05, 0xb3, 0x88,
+	0x2d, 0xfa, 0xcb, 0x83, 0x59, 0xc4, 0x46, 0xec, 0xc3, 0x86, 0xdc, 0xaa, 0x9d, 0xf3, 0xb6, 0xc6,
+	0x11, 0x13, 0xd5, 0x2d, 0x56, 0x22, 0xad, 0xbb, 0xa4, 0xcd, 0x12, 0x11, 0xad, 0x15, 0x55, 0xf4,
+	0xcd, 0x48, 0x56, 0xd4, 0x3c, 0xcc, 0x7b, 0xd8, 0x6a, 0xb7, 0x6a, 0x1e, 0xd6, 0xd2, 0x45, 0x65,
+	0x73, 0x1e, 0xf5, 0x75, 0xa3, 0x0c, 0xc9, 0x70, 0xc5, 0xc7, 0xd7, 0x29, 0x37, 0x34, 0x55, 0x62,
+	0x94, 0x2a, 0xef, 0x2e, 0xae, 0x0a, 0xca, 0xe5, 0x55, 0x41, 0xf9, 0x7d, 0x55, 0x50, 0xbe, 0x5f,
+	0x17, 0x62, 0x97, 0xd7, 0x85, 0xd8, 0xcf, 0xeb, 0x42, 0xec, 0xf3, 0x76, 0x83, 0x78, 0xad, 0xda,
+	0x69, 0xa9, 0xee, 0x58, 0x65, 0xaf, 0xe9, 0xb8, 0xf5, 0x66, 0x8d, 0xd8, 0x5c, 0x62, 0x0f, 0x4c,
+	0xb9, 0xbb, 0x53, 0x3e, 0x97, 0xed, 0x8c, 0x93, 0xd3, 0x14, 0xff, 0x23, 0xd9, 0xf9, 0x1b, 0x00,
+	0x00, 0xff, 0xff, 0xf4, 0x44, 0x7e, 0xfa, 0xe1, 0x08, 0x00, 0x00,
+}
+
+func (m *QueryMimirValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for iNdEx := len(m.Mimirs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirWithKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirWithKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirWithKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirWithKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirWithKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirWithKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirAdminValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirAdminValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirAdminValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirAdminValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirAdminValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirAdminValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdminMimirs) > 0 {
+		for iNdEx := len(m.AdminMimirs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdminMimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirNodesAllValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirNodesAllValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirNodesAllValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirNodesAllValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirNodesAllValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirNodesAllValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for iNdEx := len(m.Mimirs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirNodesValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirNodesValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirNodesValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirNodesValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirNodesValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirNodesValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for iNdEx := len(m.Mimirs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirNodeValuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirNodeValuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirNodeValuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirNodeValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirNodeValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirNodeValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeMimirs) > 0 {
+		for iNdEx := len(m.NodeMimirs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeMimirs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirScheduledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirScheduledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirScheduledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirScheduledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirScheduledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirScheduledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeVotes) > 0 {
+		for iNdEx := len(m.NodeVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Scheduled) > 0 {
+		for iNdEx := len(m.Scheduled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scheduled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledMimir) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledMimir) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledMimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Value != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirNodeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirNodeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirNodeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousValue != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.PreviousValue))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Value != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Definitions) > 0 {
+		for iNdEx := len(m.Definitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Definitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryMimir(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMimirDefinition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMimirDefinition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMimirDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Template {
+		i--
+		if m.Template {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Max != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Max))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Min != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Min))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Mimir) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Mimir) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Mimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintQueryMimir(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQueryMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryMimir(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryMimir(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMimirValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for _, e := range m.Mimirs {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMimirWithKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirWithKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *QueryMimirAdminValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirAdminValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AdminMimirs) > 0 {
+		for _, e := range m.AdminMimirs {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMimirNodesAllValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirNodesAllValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for _, e := range m.Mimirs {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMimirNodesValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirNodesValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mimirs) > 0 {
+		for _, e := range m.Mimirs {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMimirNodeValuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirNodeValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeMimirs) > 0 {
+		for _, e := range m.NodeMimirs {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMimirScheduledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirScheduledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scheduled) > 0 {
+		for _, e := range m.Scheduled {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	if len(m.NodeVotes) > 0 {
+		for _, e := range m.NodeVotes {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryScheduledMimir) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Value))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Height))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirNodeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMimirHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Value))
+	}
+	if m.PreviousValue != 0 {
+		n += 1 + sovQueryMimir(uint64(m.PreviousValue))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Height))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMimirRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Definitions) > 0 {
+		for _, e := range m.Definitions {
+			l = e.Size()
+			n += 1 + l + sovQueryMimir(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMimirDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	if m.Min != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Min))
+	}
+	if m.Max != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Max))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	if m.Template {
+		n += 2
+	}
+	return n
+}
+
+func (m *Mimir) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQueryMimir(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovQueryMimir(uint64(m.Value))
+	}
+	return n
+}
+
+func sovQueryMimir(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryMimir(x uint64) (n int) {
+	return sovQueryMimir(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMimirValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mimirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mimirs = append(m.Mimirs, &Mimir{})
+			if err := m.Mimirs[len(m.Mimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirWithKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirWithKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirWithKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirWithKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirWithKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirWithKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirAdminValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirAdminValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirAdminValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirAdminValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirAdminValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirAdminValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminMimirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminMimirs = append(m.AdminMimirs, &Mimir{})
+			if err := m.AdminMimirs[len(m.AdminMimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirNodesAllValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirNodesAllValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirNodesAllValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirNodesAllValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirNodesAllValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirNodesAllValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mimirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mimirs = append(m.Mimirs, NodeMimir{})
+			if err := m.Mimirs[len(m.Mimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirNodesValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirNodesValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirNodesValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirNodesValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirNodesValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirNodesValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mimirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mimirs = append(m.Mimirs, &Mimir{})
+			if err := m.Mimirs[len(m.Mimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirNodeValuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirNodeValuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirNodeValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirNodeValuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirNodeValuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirNodeValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeMimirs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeMimirs = append(m.NodeMimirs, &Mimir{})
+			if err := m.NodeMimirs[len(m.NodeMimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirScheduledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirScheduledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirScheduledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMimirScheduledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMimirScheduledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMimirScheduledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scheduled = append(m.Scheduled, &QueryScheduledMimir{})
+			if err := m.Scheduled[len(m.Scheduled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeVotes = append(m.NodeVotes, &QueryScheduledMimir{})
+			if err := m.NodeVotes[len(m.NodeVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledMimir) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQueryMimir
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledMimir: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledMimir: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirValuesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,7 +3948,7 @@ func (m *QueryMimirValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1398,7 +3971,7 @@ func (m *QueryMimirValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMimirValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMimirHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,17 +3994,17 @@ func (m *QueryMimirValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMimirHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMimirHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mimirs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryMimir
@@ -1441,25 +4014,55 @@ func (m *QueryMimirValuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQueryMimir
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueryMimir
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mimirs = append(m.Mimirs, &Mimir{})
-			if err := m.Mimirs[len(m.Mimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Height = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1482,7 +4085,7 @@ func (m *QueryMimirValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMimirWithKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMimirNodeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1505,15 +4108,15 @@ func (m *QueryMimirWithKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirWithKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMimirNodeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirWithKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMimirNodeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1541,7 +4144,7 @@ func (m *QueryMimirWithKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1596,7 +4199,7 @@ func (m *QueryMimirWithKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMimirWithKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMimirHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1619,17 +4222,17 @@ func (m *QueryMimirWithKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirWithKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMimirHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirWithKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMimirHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryMimir
@@ -1639,11 +4242,26 @@ func (m *QueryMimirWithKeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &QueryMimirHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryMimir(dAtA[iNdEx:])
@@ -1665,7 +4283,7 @@ func (m *QueryMimirWithKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMimirAdminValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMimirHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1688,15 +4306,15 @@ func (m *QueryMimirAdminValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirAdminValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMimirHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirAdminValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMimirHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1724,63 +4342,102 @@ func (m *QueryMimirAdminValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueryMimir(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueryMimir
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValue", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMimirAdminValuesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueryMimir
+			m.PreviousValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirAdminValuesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirAdminValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminMimirs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryMimir
@@ -1790,25 +4447,23 @@ func (m *QueryMimirAdminValuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQueryMimir
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueryMimir
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminMimirs = append(m.AdminMimirs, &Mimir{})
-			if err := m.AdminMimirs[len(m.AdminMimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1831,7 +4486,7 @@ func (m *QueryMimirAdminValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMimirNodesAllValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMimirRegistryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,10 +4509,10 @@ func (m *QueryMimirNodesAllValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirNodesAllValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMimirRegistryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirNodesAllValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMimirRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1913,7 +4568,7 @@ func (m *QueryMimirNodesAllValuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMimirNodesAllValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMimirRegistryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1936,15 +4591,15 @@ func (m *QueryMimirNodesAllValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirNodesAllValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMimirRegistryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirNodesAllValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMimirRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mimirs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1971,8 +4626,8 @@ func (m *QueryMimirNodesAllValuesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mimirs = append(m.Mimirs, NodeMimir{})
-			if err := m.Mimirs[len(m.Mimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Definitions = append(m.Definitions, &QueryMimirDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1997,7 +4652,7 @@ func (m *QueryMimirNodesAllValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMimirNodesValuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMimirDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2020,15 +4675,15 @@ func (m *QueryMimirNodesValuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirNodesValuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMimirDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirNodesValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMimirDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2056,63 +4711,13 @@ func (m *QueryMimirNodesValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueryMimir(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueryMimir
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMimirNodesValuesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueryMimir
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirNodesValuesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirNodesValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mimirs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryMimir
@@ -2122,79 +4727,27 @@ func (m *QueryMimirNodesValuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQueryMimir
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQueryMimir
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mimirs = append(m.Mimirs, &Mimir{})
-			if err := m.Mimirs[len(m.Mimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueryMimir(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueryMimir
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMimirNodeValuesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueryMimir
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirNodeValuesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirNodeValuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2222,11 +4775,49 @@ func (m *QueryMimirNodeValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Unit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			m.Min = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Min |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2254,63 +4845,13 @@ func (m *QueryMimirNodeValuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Height = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueryMimir(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueryMimir
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMimirNodeValuesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueryMimir
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMimirNodeValuesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMimirNodeValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeMimirs", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueryMimir
@@ -2320,26 +4861,12 @@ func (m *QueryMimirNodeValuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQueryMimir
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueryMimir
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeMimirs = append(m.NodeMimirs, &Mimir{})
-			if err := m.NodeMimirs[len(m.NodeMimirs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Template = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryMimir(dAtA[iNdEx:])
@@ -398,6 +398,10 @@ func (m *QueryNodesResponse) GetNodes() []*QueryNodeResponse {
 type NodeBondProviders struct {
 	NodeOperatorFee string              `protobuf:"bytes,1,opt,name=node_operator_fee,json=nodeOperatorFee,proto3" json:"node_operator_fee"`
 	Providers       []*NodeBondProvider `protobuf:"bytes,2,rep,name=providers,proto3" json:"providers"`
+	// a scheduled node operator fee increase, unset if there is none
+	PendingNodeOperatorFee string `protobuf:"bytes,3,opt,name=pending_node_operator_fee,json=pendingNodeOperatorFee,proto3" json:"pending_node_operator_fee,omitempty"`
+	// the height the scheduled node operator fee takes effect, unset if there is none
+	PendingNodeOperatorFeeHeight int64 `protobuf:"varint,4,opt,name=pending_node_operator_fee_height,json=pendingNodeOperatorFeeHeight,proto3" json:"pending_node_operator_fee_height,omitempty"`
 }
 
 func (m *NodeBondProviders) Reset()         { *m = NodeBondProviders{} }
@@ -447,9 +451,27 @@ func (m *NodeBondProviders) GetProviders() []*NodeBondProvider {
 	return nil
 }
 
+func (m *NodeBondProviders) GetPendingNodeOperatorFee() string {
+	if m != nil {
+		return m.PendingNodeOperatorFee
+	}
+	return ""
+}
+
+func (m *NodeBondProviders) GetPendingNodeOperatorFeeHeight() int64 {
+	if m != nil {
+		return m.PendingNodeOperatorFeeHeight
+	}
+	return 0
+}
+
 type NodeBondProvider struct {
 	BondAddress string `protobuf:"bytes,1,opt,name=bond_address,json=bondAddress,proto3" json:"bond_address,omitempty"`
 	Bond        string `protobuf:"bytes,2,opt,name=bond,proto3" json:"bond,omitempty"`
+	// the amount of a pending unbond request, 0 unbonds the full bond
+	UnbondAmount string `protobuf:"bytes,3,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount,omitempty"`
+	// the height a pending unbond request becomes executable, unset if there is none
+	UnbondHeight int64 `protobuf:"varint,4,opt,name=unbond_height,json=unbondHeight,proto3" json:"unbond_height,omitempty"`
 }
 
 func (m *NodeBondProvider) Reset()         { *m = NodeBondProvider{} }
@@ -499,6 +521,20 @@ func (m *NodeBondProvider) GetBond() string {
 	return ""
 }
 
+func (m *NodeBondProvider) GetUnbondAmount() string {
+	if m != nil {
+		return m.UnbondAmount
+	}
+	return ""
+}
+
+func (m *NodeBondProvider) GetUnbondHeight() int64 {
+	if m != nil {
+		return m.UnbondHeight
+	}
+	return 0
+}
+
 type NodeJail struct {
 	ReleaseHeight int64  `protobuf:"varint,1,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
 	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
@@ -680,73 +716,77 @@ func init() {
 func init() { proto.RegisterFile("types/query_node.proto", fileDescriptor_aa5f5713cfe920f4) }
 
 var fileDescriptor_aa5f5713cfe920f4 = []byte{
-	// 1055 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0x37, 0x6d, 0xda, 0x3c, 0x37, 0xff, 0x26, 0x6d, 0x6a, 0x2a, 0x14, 0x87, 0x88, 0x95,
-	0x22, 0xa1, 0x36, 0xa2, 0x95, 0x90, 0x10, 0xa7, 0x7a, 0xab, 0x85, 0xc2, 0x96, 0x2d, 0x53, 0x4e,
-	0x70, 0xb0, 0x9c, 0x78, 0x9a, 0x98, 0x4d, 0x3c, 0x5e, 0x8f, 0x13, 0xa8, 0xb8, 0xf2, 0x01, 0xf8,
-	0x00, 0x7c, 0xa0, 0x3d, 0xee, 0x91, 0x93, 0x85, 0xda, 0x9b, 0x3f, 0x05, 0x9a, 0x37, 0x76, 0x33,
-	0x69, 0xda, 0x4b, 0x3c, 0xef, 0xf7, 0x7e, 0x1e, 0xcf, 0xbc, 0x3f, 0xbf, 0x17, 0x68, 0x27, 0xb7,
-	0x11, 0x13, 0x83, 0xf7, 0x73, 0x16, 0xdf, 0xba, 0x21, 0xf7, 0xd9, 0x71, 0x14, 0xf3, 0x84, 0x93,
-	0x2d, 0xc4, 0x0f, 0xf7, 0xc6, 0x7c, 0xcc, 0x11, 0x19, 0xc8, 0x95, 0x72, 0x1e, 0xb6, 0x46, 0x7c,
-	0x36, 0xe3, 0xe1, 0x40, 0x3d, 0x14, 0xd8, 0x3b, 0x87, 0xc6, 0x4f, 0x72, 0x97, 0x1f, 0xb9, 0xcf,
-	0x28, 0x7b, 0x3f, 0x67, 0x22, 0x21, 0x16, 0x6c, 0x7b, 0xbe, 0x1f, 0x33, 0x21, 0x2c, 0xa3, 0x6b,
-	0xf4, 0x2b, 0xb4, 0x30, 0x49, 0x1b, 0xca, 0x13, 0x16, 0x8c, 0x27, 0x89, 0xf5, 0x02, 0x1d, 0xb9,
-	0xd5, 0xfb, 0xcb, 0x84, 0xa6, 0xb6, 0x8d, 0x88, 0x78, 0x28, 0x18, 0x39, 0x85, 0x5d, 0x79, 0x36,
-	0x77, 0x65, 0x33, 0xa7, 0x91, 0xa5, 0xf6, 0x0a, 0x4e, 0x4d, 0x69, 0x9d, 0xe5, 0x9f, 0xe8, 0x41,
-	0x59, 0x24, 0x5e, 0x32, 0x17, 0xea, 0x13, 0x0e, 0x64, 0xa9, 0x9d, 0x23, 0x34, 0x7f, 0x92, 0xd7,
-	0x60, 0x46, 0xf3, 0xa1, 0xfb, 0x8e, 0xdd, 0xba, 0x82, 0x25, 0x56, 0xa9, 0x6b, 0xf4, 0xcd, 0x93,
-	0xe6, 0x71, 0x7e, 0xb1, 0xab, 0xf9, 0xf0, 0x07, 0x76, 0x7b, 0xcd, 0x12, 0xa7, 0xf5, 0x21, 0xb5,
-	0x37, 0xb2, 0xd4, 0xd6, 0xd9, 0xb4, 0x12, 0x15, 0x7e, 0xf2, 0x16, 0xda, 0x0b, 0x6f, 0x1a, 0xf8,
-	0x5e, 0xc2, 0x63, 0x77, 0xc4, 0x43, 0xe1, 0xe6, 0x44, 0x6b, 0x13, 0xbf, 0x7d, 0x98, 0xa5, 0xf6,
-	0x33, 0x0c, 0xda, 0x7a, 0xc0, 0x5f, 0xf1, 0x50, 0xa8, 0x6f, 0x92, 0xcf, 0x61, 0x3b, 0x62, 0x2c,
-	0x76, 0x03, 0xdf, 0xda, 0xc2, 0x1d, 0xcc, 0x2c, 0xb5, 0x0b, 0x88, 0x96, 0xe5, 0xe2, 0xc2, 0x27,
-	0xdf, 0x42, 0xcb, 0x1b, 0x25, 0xc1, 0x82, 0xb9, 0xc3, 0x29, 0x1f, 0xbd, 0x73, 0xf3, 0x90, 0x96,
-	0xbb, 0x46, 0xbf, 0xe4, 0x1c, 0x64, 0xa9, 0xfd, 0x94, 0x9b, 0x36, 0x15, 0xe8, 0x48, 0xec, 0x3b,
-	0x84, 0x64, 0x80, 0x55, 0x44, 0x5c, 0x11, 0x84, 0x23, 0x66, 0x6d, 0xe3, 0x0e, 0x18, 0x60, 0x1d,
-	0xa7, 0xa6, 0xb2, 0xae, 0xa5, 0x41, 0x2e, 0x61, 0x1f, 0xa3, 0xcf, 0x23, 0x16, 0xe3, 0xb5, 0x8a,
-	0xf4, 0xec, 0xe0, 0x89, 0x3f, 0xc9, 0x52, 0xfb, 0x69, 0x02, 0x6d, 0x49, 0xf8, 0x6d, 0x8e, 0x16,
-	0xf9, 0x3a, 0x02, 0x48, 0x78, 0xe2, 0x4d, 0xdd, 0x21, 0x0f, 0x7d, 0xab, 0x82, 0x7b, 0xd4, 0xb2,
-	0xd4, 0xd6, 0x50, 0x5a, 0xc1, 0xb5, 0xc3, 0x43, 0x9f, 0x5c, 0x43, 0x4d, 0x42, 0x6e, 0x14, 0xf3,
-	0x45, 0xe0, 0xb3, 0x58, 0x58, 0x80, 0xd9, 0xb3, 0x8e, 0xb1, 0x74, 0x8f, 0x65, 0x01, 0x49, 0xe2,
-	0x55, 0xe1, 0x77, 0x48, 0x96, 0xda, 0x8f, 0xde, 0xa1, 0xd5, 0xa1, 0x4e, 0x21, 0x0e, 0x34, 0x45,
-	0x30, 0x0e, 0x59, 0xec, 0xce, 0xd8, 0x6c, 0xc8, 0x62, 0x31, 0x09, 0x22, 0xcb, 0xec, 0x96, 0xfa,
-	0x15, 0x67, 0x3f, 0x4b, 0xed, 0x75, 0x27, 0x6d, 0x28, 0xe8, 0xf2, 0x01, 0x21, 0xe7, 0x40, 0x62,
-	0x55, 0xff, 0xcc, 0x77, 0x13, 0xee, 0x4e, 0x99, 0xb7, 0x60, 0xd6, 0x6e, 0xd7, 0xe8, 0xef, 0x38,
-	0xed, 0x2c, 0xb5, 0x9f, 0xf0, 0xd2, 0xc6, 0x03, 0xf6, 0x33, 0x7f, 0x23, 0x11, 0xf2, 0x0d, 0xd4,
-	0x6f, 0x78, 0x3c, 0xd2, 0xb7, 0xa8, 0xe2, 0x16, 0xad, 0x2c, 0xb5, 0x1f, 0xbb, 0x68, 0x55, 0x01,
-	0xc5, 0xcb, 0xa7, 0xb0, 0x8b, 0x78, 0x51, 0x10, 0xb5, 0x65, 0x3a, 0x75, 0x9c, 0x9a, 0x68, 0xe5,
-	0x35, 0x70, 0x04, 0x10, 0x44, 0x0f, 0x39, 0xac, 0x2f, 0xe3, 0xbf, 0x44, 0x69, 0x25, 0x88, 0x8a,
-	0x74, 0xbd, 0x84, 0xed, 0x05, 0x8b, 0x45, 0xc0, 0x43, 0xab, 0xb1, 0xac, 0xd0, 0x1c, 0xa2, 0xc5,
-	0x02, 0x2b, 0x6b, 0xea, 0x89, 0x89, 0x1b, 0xf1, 0x20, 0x4c, 0x84, 0xd5, 0xd4, 0x2a, 0x4b, 0xc3,
-	0xa9, 0x89, 0xd6, 0x15, 0x1a, 0xe4, 0x08, 0x36, 0x7f, 0xf3, 0x82, 0xa9, 0x45, 0x30, 0xa3, 0x75,
-	0x2d, 0xa3, 0xdf, 0x7b, 0xc1, 0xd4, 0xd9, 0xc9, 0x52, 0x1b, 0x09, 0x14, 0x7f, 0xc9, 0x57, 0x50,
-	0x1d, 0xcd, 0xe3, 0x98, 0x85, 0x89, 0xeb, 0xfd, 0xee, 0xc5, 0xbe, 0xd5, 0xc2, 0x03, 0x35, 0xb3,
-	0xd4, 0x5e, 0x75, 0xd0, 0xdd, 0xdc, 0x3c, 0x93, 0x16, 0xb9, 0x84, 0x1a, 0x1f, 0x0a, 0x16, 0x2f,
-	0x98, 0x3b, 0x9a, 0x78, 0x41, 0x28, 0xac, 0xbd, 0x6e, 0xa9, 0x6f, 0x9e, 0x90, 0xfc, 0x83, 0xaf,
-	0x24, 0xa8, 0xa2, 0xa3, 0x8a, 0x67, 0x95, 0x4d, 0xab, 0xb9, 0x8d, 0x3c, 0x41, 0x7e, 0x85, 0x46,
-	0x14, 0xb3, 0x9b, 0xa9, 0xe4, 0xbb, 0xb9, 0xf4, 0xec, 0xe3, 0x0d, 0x0e, 0xb5, 0x1b, 0x5c, 0x15,
-	0x94, 0x6b, 0x64, 0x38, 0x7b, 0x59, 0x6a, 0xaf, 0xbd, 0x47, 0xeb, 0xd1, 0x2a, 0x8d, 0x7c, 0x09,
-	0xe6, 0xcc, 0x0b, 0xc2, 0x84, 0x85, 0x9e, 0x6c, 0xd0, 0x36, 0xd6, 0x42, 0x5d, 0x4a, 0x92, 0x06,
-	0x53, 0xdd, 0x20, 0x5f, 0x43, 0x6d, 0x16, 0x08, 0x11, 0x84, 0x63, 0xd5, 0xff, 0xc2, 0x3a, 0xc0,
-	0xe0, 0xe3, 0x55, 0x56, 0x3d, 0xb4, 0x9a, 0xdb, 0x28, 0x0a, 0xa2, 0xf7, 0x85, 0xa6, 0xc2, 0xa2,
-	0x50, 0xf3, 0xa5, 0x66, 0x1b, 0x2b, 0x9a, 0x7d, 0x0e, 0x44, 0x27, 0xe7, 0x9a, 0x7d, 0x0c, 0x5b,
-	0xb2, 0xcb, 0xa5, 0x58, 0x97, 0xb4, 0xb6, 0x5c, 0x13, 0x77, 0xaa, 0x68, 0xbd, 0x7f, 0x0c, 0x68,
-	0xae, 0xf5, 0x2c, 0x39, 0x83, 0xe6, 0xaa, 0x84, 0xdc, 0x30, 0x96, 0xcb, 0x3f, 0x36, 0xe4, 0x9a,
-	0x93, 0xd6, 0x75, 0x6d, 0x79, 0xcd, 0x18, 0x39, 0x87, 0xca, 0x52, 0x23, 0x5e, 0xe0, 0x61, 0x0e,
-	0x9e, 0xd1, 0x08, 0xa7, 0x9a, 0xa5, 0xf6, 0x92, 0x4d, 0x97, 0xcb, 0xde, 0x05, 0x34, 0x1e, 0xb3,
-	0xc9, 0x67, 0xb0, 0x8b, 0x72, 0xb2, 0x3a, 0xe3, 0x4c, 0x89, 0x15, 0x5d, 0x42, 0x60, 0x13, 0xe5,
-	0x4c, 0x4d, 0x39, 0x5c, 0xf7, 0x2e, 0x60, 0xa7, 0x28, 0x65, 0xf2, 0x12, 0x6a, 0x31, 0x9b, 0x32,
-	0x4f, 0x3c, 0xf4, 0xaa, 0xdc, 0xa4, 0x44, 0xab, 0x39, 0x9a, 0xf7, 0x66, 0x1b, 0xca, 0x31, 0xf3,
-	0x04, 0x0f, 0x8b, 0x71, 0xa9, 0xac, 0x1e, 0x05, 0x53, 0x2b, 0x52, 0x62, 0xc3, 0x16, 0x96, 0x66,
-	0x1e, 0xa1, 0x4a, 0x96, 0xda, 0x0a, 0xa0, 0xea, 0x21, 0x67, 0xa2, 0x36, 0x76, 0x4b, 0x6a, 0x26,
-	0xe6, 0x62, 0x50, 0xa4, 0xf3, 0x4f, 0x68, 0x3d, 0x51, 0xa7, 0xda, 0x38, 0x35, 0x9e, 0x1d, 0xa7,
-	0xbd, 0xd5, 0x63, 0x2a, 0x8e, 0x42, 0x8a, 0x23, 0x93, 0x4f, 0x61, 0x73, 0xc4, 0x7d, 0x86, 0xb3,
-	0xb6, 0xa4, 0x5a, 0x59, 0xda, 0x14, 0x7f, 0x9d, 0x37, 0x1f, 0xee, 0x3a, 0xc6, 0xc7, 0xbb, 0x8e,
-	0xf1, 0xdf, 0x5d, 0xc7, 0xf8, 0xfb, 0xbe, 0xb3, 0xf1, 0xf1, 0xbe, 0xb3, 0xf1, 0xef, 0x7d, 0x67,
-	0xe3, 0x97, 0x93, 0x71, 0x90, 0x4c, 0xbd, 0xa1, 0x9c, 0xcd, 0x83, 0x64, 0xc2, 0x63, 0xbc, 0x10,
-	0xae, 0x64, 0xc2, 0x07, 0x8b, 0xd3, 0xc1, 0x1f, 0x3a, 0x2e, 0xf3, 0x3b, 0x2c, 0xe3, 0x5f, 0x93,
-	0xd3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x27, 0x9a, 0xbf, 0xda, 0xe6, 0x08, 0x00, 0x00,
+	// 1119 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x23, 0x5b, 0xb6, 0x86, 0xfa, 0x5d, 0x25, 0x0a, 0x63, 0x04, 0xa2, 0xaa, 0x36, 0x80,
+	0x80, 0x22, 0x16, 0x1a, 0x03, 0x05, 0x82, 0x9e, 0xcc, 0x18, 0x6e, 0xd3, 0xc6, 0x89, 0xbb, 0xee,
+	0xa9, 0x3d, 0x10, 0x94, 0xb8, 0x96, 0xd8, 0x48, 0x5c, 0x86, 0x4b, 0xa9, 0x35, 0x7a, 0xed, 0x03,
+	0x14, 0xe8, 0x03, 0xf4, 0x75, 0x72, 0xcc, 0xb1, 0x27, 0xa2, 0xb0, 0x6f, 0x7c, 0x8a, 0x62, 0x67,
+	0x97, 0x16, 0x65, 0xd9, 0x17, 0x69, 0xe7, 0x9b, 0x6f, 0x97, 0xb3, 0xb3, 0x33, 0xdf, 0x2e, 0x74,
+	0x92, 0xcb, 0x88, 0x89, 0xe1, 0x87, 0x05, 0x8b, 0x2f, 0xdd, 0x90, 0xfb, 0xec, 0x20, 0x8a, 0x79,
+	0xc2, 0xc9, 0x0e, 0xe2, 0xfb, 0x0f, 0x27, 0x7c, 0xc2, 0x11, 0x19, 0xca, 0x91, 0x72, 0xee, 0xb7,
+	0xc7, 0x7c, 0x3e, 0xe7, 0xe1, 0x50, 0xfd, 0x29, 0xb0, 0x7f, 0x0c, 0xcd, 0x1f, 0xe5, 0x2a, 0x6f,
+	0xb9, 0xcf, 0x28, 0xfb, 0xb0, 0x60, 0x22, 0x21, 0x16, 0xec, 0x7a, 0xbe, 0x1f, 0x33, 0x21, 0x2c,
+	0xa3, 0x67, 0x0c, 0x2a, 0x34, 0x37, 0x49, 0x07, 0xca, 0x53, 0x16, 0x4c, 0xa6, 0x89, 0xf5, 0x00,
+	0x1d, 0xda, 0xea, 0xff, 0x69, 0x42, 0xab, 0xb0, 0x8c, 0x88, 0x78, 0x28, 0x18, 0x39, 0x84, 0xaa,
+	0x8c, 0xcd, 0x5d, 0x5b, 0xcc, 0x69, 0x66, 0xa9, 0xbd, 0x86, 0x53, 0x53, 0x5a, 0x47, 0xfa, 0x13,
+	0x7d, 0x28, 0x8b, 0xc4, 0x4b, 0x16, 0x42, 0x7d, 0xc2, 0x81, 0x2c, 0xb5, 0x35, 0x42, 0xf5, 0x3f,
+	0x39, 0x01, 0x33, 0x5a, 0x8c, 0xdc, 0xf7, 0xec, 0xd2, 0x15, 0x2c, 0xb1, 0x4a, 0x3d, 0x63, 0x60,
+	0xbe, 0x68, 0x1d, 0xe8, 0x8d, 0x9d, 0x2d, 0x46, 0x3f, 0xb0, 0xcb, 0x73, 0x96, 0x38, 0xed, 0x8f,
+	0xa9, 0xbd, 0x95, 0xa5, 0x76, 0x91, 0x4d, 0x2b, 0x51, 0xee, 0x27, 0xef, 0xa0, 0xb3, 0xf4, 0x66,
+	0x81, 0xef, 0x25, 0x3c, 0x76, 0xc7, 0x3c, 0x14, 0xae, 0x26, 0x5a, 0xdb, 0xf8, 0xed, 0xfd, 0x2c,
+	0xb5, 0xef, 0x61, 0xd0, 0xf6, 0x0d, 0xfe, 0x8a, 0x87, 0x42, 0x7d, 0x93, 0x7c, 0x01, 0xbb, 0x11,
+	0x63, 0xb1, 0x1b, 0xf8, 0xd6, 0x0e, 0xae, 0x60, 0x66, 0xa9, 0x9d, 0x43, 0xb4, 0x2c, 0x07, 0xaf,
+	0x7d, 0xf2, 0x2d, 0xb4, 0xbd, 0x71, 0x12, 0x2c, 0x99, 0x3b, 0x9a, 0xf1, 0xf1, 0x7b, 0x57, 0xa7,
+	0xb4, 0xdc, 0x33, 0x06, 0x25, 0xe7, 0x71, 0x96, 0xda, 0x77, 0xb9, 0x69, 0x4b, 0x81, 0x8e, 0xc4,
+	0xbe, 0x43, 0x48, 0x26, 0x58, 0x65, 0xc4, 0x15, 0x41, 0x38, 0x66, 0xd6, 0x2e, 0xae, 0x80, 0x09,
+	0x2e, 0xe2, 0xd4, 0x54, 0xd6, 0xb9, 0x34, 0xc8, 0x29, 0x3c, 0xc2, 0xec, 0xf3, 0x88, 0xc5, 0xb8,
+	0xad, 0xfc, 0x78, 0xf6, 0x30, 0xe2, 0x27, 0x59, 0x6a, 0xdf, 0x4d, 0xa0, 0x6d, 0x09, 0xbf, 0xd3,
+	0x68, 0x7e, 0x5e, 0xcf, 0x01, 0x12, 0x9e, 0x78, 0x33, 0x77, 0xc4, 0x43, 0xdf, 0xaa, 0xe0, 0x1a,
+	0xf5, 0x2c, 0xb5, 0x0b, 0x28, 0xad, 0xe0, 0xd8, 0xe1, 0xa1, 0x4f, 0xce, 0xa1, 0x2e, 0x21, 0x37,
+	0x8a, 0xf9, 0x32, 0xf0, 0x59, 0x2c, 0x2c, 0xc0, 0xd3, 0xb3, 0x0e, 0xb0, 0x74, 0x0f, 0x64, 0x01,
+	0x49, 0xe2, 0x59, 0xee, 0x77, 0x48, 0x96, 0xda, 0xb7, 0xe6, 0xd0, 0xda, 0xa8, 0x48, 0x21, 0x0e,
+	0xb4, 0x44, 0x30, 0x09, 0x59, 0xec, 0xce, 0xd9, 0x7c, 0xc4, 0x62, 0x31, 0x0d, 0x22, 0xcb, 0xec,
+	0x95, 0x06, 0x15, 0xe7, 0x51, 0x96, 0xda, 0x9b, 0x4e, 0xda, 0x54, 0xd0, 0xe9, 0x0d, 0x42, 0x8e,
+	0x81, 0xc4, 0xaa, 0xfe, 0x99, 0xef, 0x26, 0xdc, 0x9d, 0x31, 0x6f, 0xc9, 0xac, 0x6a, 0xcf, 0x18,
+	0xec, 0x39, 0x9d, 0x2c, 0xb5, 0xef, 0xf0, 0xd2, 0xe6, 0x0d, 0xf6, 0x13, 0x7f, 0x23, 0x11, 0xf2,
+	0x0d, 0x34, 0x2e, 0x78, 0x3c, 0x2e, 0x2e, 0x51, 0xc3, 0x25, 0xda, 0x59, 0x6a, 0xdf, 0x76, 0xd1,
+	0x9a, 0x02, 0xf2, 0xc9, 0x87, 0x50, 0x45, 0x3c, 0x2f, 0x88, 0xfa, 0xea, 0x38, 0x8b, 0x38, 0x35,
+	0xd1, 0xd2, 0x35, 0xf0, 0x1c, 0x20, 0x88, 0x6e, 0xce, 0xb0, 0xb1, 0xca, 0xff, 0x0a, 0xa5, 0x95,
+	0x20, 0xca, 0x8f, 0xeb, 0x19, 0xec, 0x2e, 0x59, 0x2c, 0x02, 0x1e, 0x5a, 0xcd, 0x55, 0x85, 0x6a,
+	0x88, 0xe6, 0x03, 0xac, 0xac, 0x99, 0x27, 0xa6, 0x6e, 0xc4, 0x83, 0x30, 0x11, 0x56, 0xab, 0x50,
+	0x59, 0x05, 0x9c, 0x9a, 0x68, 0x9d, 0xa1, 0x41, 0x9e, 0xc3, 0xf6, 0xaf, 0x5e, 0x30, 0xb3, 0x08,
+	0x9e, 0x68, 0xa3, 0x70, 0xa2, 0xdf, 0x7b, 0xc1, 0xcc, 0xd9, 0xcb, 0x52, 0x1b, 0x09, 0x14, 0x7f,
+	0xc9, 0xd7, 0x50, 0x1b, 0x2f, 0xe2, 0x98, 0x85, 0x89, 0xeb, 0xfd, 0xe6, 0xc5, 0xbe, 0xd5, 0xc6,
+	0x80, 0x5a, 0x59, 0x6a, 0xaf, 0x3b, 0x68, 0x55, 0x9b, 0x47, 0xd2, 0x22, 0xa7, 0x50, 0xe7, 0x23,
+	0xc1, 0xe2, 0x25, 0x73, 0xc7, 0x53, 0x2f, 0x08, 0x85, 0xf5, 0xb0, 0x57, 0x1a, 0x98, 0x2f, 0x88,
+	0xfe, 0xe0, 0x2b, 0x09, 0xaa, 0xec, 0xa8, 0xe2, 0x59, 0x67, 0xd3, 0x9a, 0xb6, 0x91, 0x27, 0xc8,
+	0x2f, 0xd0, 0x8c, 0x62, 0x76, 0x31, 0x93, 0x7c, 0x57, 0x4b, 0xcf, 0x23, 0xdc, 0xc1, 0x7e, 0x61,
+	0x07, 0x67, 0x39, 0xe5, 0x1c, 0x19, 0xce, 0xc3, 0x2c, 0xb5, 0x37, 0xe6, 0xd1, 0x46, 0xb4, 0x4e,
+	0x23, 0x5f, 0x81, 0x39, 0xf7, 0x82, 0x30, 0x61, 0xa1, 0x27, 0x1b, 0xb4, 0x83, 0xb5, 0xd0, 0x90,
+	0x92, 0x54, 0x80, 0x69, 0xd1, 0x20, 0x2f, 0xa1, 0x3e, 0x0f, 0x84, 0x08, 0xc2, 0x89, 0xea, 0x7f,
+	0x61, 0x3d, 0xc6, 0xe4, 0xe3, 0x56, 0xd6, 0x3d, 0xb4, 0xa6, 0x6d, 0x14, 0x05, 0xd1, 0xff, 0xb2,
+	0xa0, 0xc2, 0x22, 0x57, 0xf3, 0x95, 0x66, 0x1b, 0x6b, 0x9a, 0x7d, 0x0c, 0xa4, 0x48, 0xd6, 0x9a,
+	0x7d, 0x00, 0x3b, 0xb2, 0xcb, 0xa5, 0x58, 0x97, 0x0a, 0x6d, 0xb9, 0x21, 0xee, 0x54, 0xd1, 0xfa,
+	0xff, 0x3c, 0x80, 0xd6, 0x46, 0xcf, 0x92, 0x23, 0x68, 0xad, 0x4b, 0xc8, 0x05, 0x63, 0x5a, 0xfe,
+	0xb1, 0x21, 0x37, 0x9c, 0xb4, 0x51, 0xd4, 0x96, 0x13, 0xc6, 0xc8, 0x31, 0x54, 0x56, 0x1a, 0xf1,
+	0x00, 0x83, 0x79, 0x7c, 0x8f, 0x46, 0x38, 0xb5, 0x2c, 0xb5, 0x57, 0x6c, 0xba, 0x1a, 0x92, 0x97,
+	0xf0, 0x24, 0x62, 0xa1, 0x2f, 0x53, 0xb6, 0x19, 0x50, 0x09, 0xf3, 0xd1, 0xd1, 0x84, 0xb7, 0xb7,
+	0x02, 0x38, 0x81, 0xde, 0xbd, 0x53, 0xf3, 0x0e, 0x95, 0xd7, 0x44, 0x89, 0x3e, 0xbd, 0x7b, 0x05,
+	0x55, 0x82, 0xfd, 0xbf, 0x0d, 0x68, 0xde, 0x8e, 0x98, 0x7c, 0x06, 0x55, 0x94, 0xb4, 0xf5, 0x7b,
+	0xd6, 0x94, 0x58, 0xde, 0xa9, 0x04, 0xb6, 0x51, 0x52, 0xd5, 0x4d, 0x8b, 0x63, 0xf2, 0x39, 0xd4,
+	0x16, 0xa1, 0x9a, 0x38, 0xe7, 0x8b, 0x30, 0xd1, 0x5b, 0xa8, 0x2a, 0xf0, 0x08, 0xb1, 0x02, 0x69,
+	0x2d, 0x4a, 0x4d, 0xd2, 0x51, 0xbd, 0x86, 0xbd, 0xbc, 0x31, 0xc9, 0x33, 0xa8, 0xc7, 0x6c, 0xc6,
+	0x3c, 0x71, 0xb3, 0x2f, 0x03, 0x67, 0xd4, 0x34, 0xaa, 0x95, 0xa6, 0x03, 0xe5, 0x98, 0x79, 0x82,
+	0x87, 0xf9, 0xe5, 0xaf, 0xac, 0x3e, 0x05, 0xb3, 0xd0, 0x72, 0xc4, 0x86, 0x1d, 0x6c, 0x34, 0x7d,
+	0xde, 0x95, 0x2c, 0xb5, 0x15, 0x40, 0xd5, 0x9f, 0xbc, 0xe1, 0x0b, 0x8f, 0x88, 0x92, 0xba, 0xe1,
+	0xb5, 0xb4, 0xe5, 0xc5, 0xf9, 0x07, 0xb4, 0xef, 0xe8, 0xba, 0xc2, 0xe3, 0xc0, 0xb8, 0xf7, 0x71,
+	0xd0, 0x5f, 0x0f, 0x53, 0x71, 0x14, 0x92, 0x87, 0x4c, 0x9e, 0xc2, 0xf6, 0x98, 0xfb, 0xaa, 0x02,
+	0x4a, 0x4a, 0x98, 0xa4, 0x4d, 0xf1, 0xd7, 0x79, 0xf3, 0xf1, 0xaa, 0x6b, 0x7c, 0xba, 0xea, 0x1a,
+	0xff, 0x5d, 0x75, 0x8d, 0xbf, 0xae, 0xbb, 0x5b, 0x9f, 0xae, 0xbb, 0x5b, 0xff, 0x5e, 0x77, 0xb7,
+	0x7e, 0x7e, 0x31, 0x09, 0x92, 0x99, 0x37, 0x92, 0x2f, 0x8d, 0x61, 0x32, 0xe5, 0x31, 0x6e, 0x08,
+	0x47, 0xb2, 0x44, 0x86, 0xcb, 0xc3, 0xe1, 0xef, 0x45, 0x5c, 0x56, 0xeb, 0xa8, 0x8c, 0x0f, 0xad,
+	0xc3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x14, 0xfc, 0x24, 0x47, 0xb4, 0x09, 0x00, 0x00,
 }
 
 func (m *QueryNodeRequest) Marshal() (dAtA []byte, err error) {
@@ -1099,6 +1139,18 @@ func (m *NodeBondProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingNodeOperatorFeeHeight != 0 {
+		i = encodeVarintQueryNode(dAtA, i, uint64(m.PendingNodeOperatorFeeHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PendingNodeOperatorFee) > 0 {
+		i -= len(m.PendingNodeOperatorFee)
+		copy(dAtA[i:], m.PendingNodeOperatorFee)
+		i = encodeVarintQueryNode(dAtA, i, uint64(len(m.PendingNodeOperatorFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Providers) > 0 {
 		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1143,6 +1195,18 @@ func (m *NodeBondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondHeight != 0 {
+		i = encodeVarintQueryNode(dAtA, i, uint64(m.UnbondHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UnbondAmount) > 0 {
+		i -= len(m.UnbondAmount)
+		copy(dAtA[i:], m.UnbondAmount)
+		i = encodeVarintQueryNode(dAtA, i, uint64(len(m.UnbondAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Bond) > 0 {
 		i -= len(m.Bond)
 		copy(dAtA[i:], m.Bond)
@@ -1439,6 +1503,13 @@ func (m *NodeBondProviders) Size() (n int) {
 			n += 1 + l + sovQueryNode(uint64(l))
 		}
 	}
+	l = len(m.PendingNodeOperatorFee)
+	if l > 0 {
+		n += 1 + l + sovQueryNode(uint64(l))
+	}
+	if m.PendingNodeOperatorFeeHeight != 0 {
+		n += 1 + sovQueryNode(uint64(m.PendingNodeOperatorFeeHeight))
+	}
 	return n
 }
 
@@ -1456,6 +1527,13 @@ func (m *NodeBondProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueryNode(uint64(l))
 	}
+	l = len(m.UnbondAmount)
+	if l > 0 {
+		n += 1 + l + sovQueryNode(uint64(l))
+	}
+	if m.UnbondHeight != 0 {
+		n += 1 + sovQueryNode(uint64(m.UnbondHeight))
+	}
 	return n
 }
 
@@ -2592,6 +2670,57 @@ func (m *NodeBondProviders) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingNodeOperatorFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFeeHeight", wireType)
+			}
+			m.PendingNodeOperatorFeeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingNodeOperatorFeeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryNode(dAtA[iNdEx:])
@@ -2706,6 +2835,57 @@ func (m *NodeBondProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.Bond = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondHeight", wireType)
+			}
+			m.UnbondHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryNode(dAtA[iNdEx:])
@@ -0,0 +1,1116 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/query_observation_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryObservationStatsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryObservationStatsRequest) Reset()         { *m = QueryObservationStatsRequest{} }
+func (m *QueryObservationStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObservationStatsRequest) ProtoMessage()    {}
+func (*QueryObservationStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a4965d91ffddaa, []int{0}
+}
+func (m *QueryObservationStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObservationStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObservationStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObservationStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObservationStatsRequest.Merge(m, src)
+}
+func (m *QueryObservationStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObservationStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObservationStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObservationStatsRequest proto.InternalMessageInfo
+
+func (m *QueryObservationStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryObservationStatsRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type QueryObservationStatsResponse struct {
+	NodeAddress string                        `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3" json:"node_address"`
+	Chains      []*QueryChainObservationStats `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains"`
+}
+
+func (m *QueryObservationStatsResponse) Reset()         { *m = QueryObservationStatsResponse{} }
+func (m *QueryObservationStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObservationStatsResponse) ProtoMessage()    {}
+func (*QueryObservationStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a4965d91ffddaa, []int{1}
+}
+func (m *QueryObservationStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObservationStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObservationStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObservationStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObservationStatsResponse.Merge(m, src)
+}
+func (m *QueryObservationStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObservationStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObservationStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObservationStatsResponse proto.InternalMessageInfo
+
+func (m *QueryObservationStatsResponse) GetNodeAddress() string {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return ""
+}
+
+func (m *QueryObservationStatsResponse) GetChains() []*QueryChainObservationStats {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+type QueryChainObservationStats struct {
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	// number of recent observations tracked
+	Observations int64 `protobuf:"varint,2,opt,name=observations,proto3" json:"observations"`
+	// median number of blocks the node observed behind quorum
+	MedianLag int64 `protobuf:"varint,3,opt,name=median_lag,json=medianLag,proto3" json:"median_lag"`
+	// maximum number of blocks the node observed behind quorum
+	MaxLag int64 `protobuf:"varint,4,opt,name=max_lag,json=maxLag,proto3" json:"max_lag"`
+	// number of observations reaching quorum the node did not observe in time
+	Missed int64 `protobuf:"varint,5,opt,name=missed,proto3" json:"missed"`
+	// number of observations the node made after quorum was reached
+	Late int64 `protobuf:"varint,6,opt,name=late,proto3" json:"late"`
+	// number of recent network fee votes tracked
+	NetworkFeeVotes int64 `protobuf:"varint,7,opt,name=network_fee_votes,json=networkFeeVotes,proto3" json:"network_fee_votes"`
+	// number of network fees reaching quorum the node did not vote for in time
+	NetworkFeeMissed int64 `protobuf:"varint,8,opt,name=network_fee_missed,json=networkFeeMissed,proto3" json:"network_fee_missed"`
+	// number of network fee votes the node made after quorum was reached
+	NetworkFeeLate int64 `protobuf:"varint,9,opt,name=network_fee_late,json=networkFeeLate,proto3" json:"network_fee_late"`
+	// the block height the stats were last updated
+	LastUpdatedHeight int64 `protobuf:"varint,10,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height"`
+}
+
+func (m *QueryChainObservationStats) Reset()         { *m = QueryChainObservationStats{} }
+func (m *QueryChainObservationStats) String() string { return proto.CompactTextString(m) }
+func (*QueryChainObservationStats) ProtoMessage()    {}
+func (*QueryChainObservationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a4965d91ffddaa, []int{2}
+}
+func (m *QueryChainObservationStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainObservationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainObservationStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainObservationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainObservationStats.Merge(m, src)
+}
+func (m *QueryChainObservationStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainObservationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainObservationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainObservationStats proto.InternalMessageInfo
+
+func (m *QueryChainObservationStats) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *QueryChainObservationStats) GetObservations() int64 {
+	if m != nil {
+		return m.Observations
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetMedianLag() int64 {
+	if m != nil {
+		return m.MedianLag
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetMaxLag() int64 {
+	if m != nil {
+		return m.MaxLag
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetMissed() int64 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetLate() int64 {
+	if m != nil {
+		return m.Late
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetNetworkFeeVotes() int64 {
+	if m != nil {
+		return m.NetworkFeeVotes
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetNetworkFeeMissed() int64 {
+	if m != nil {
+		return m.NetworkFeeMissed
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetNetworkFeeLate() int64 {
+	if m != nil {
+		return m.NetworkFeeLate
+	}
+	return 0
+}
+
+func (m *QueryChainObservationStats) GetLastUpdatedHeight() int64 {
+	if m != nil {
+		return m.LastUpdatedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryObservationStatsRequest)(nil), "types.QueryObservationStatsRequest")
+	proto.RegisterType((*QueryObservationStatsResponse)(nil), "types.QueryObservationStatsResponse")
+	proto.RegisterType((*QueryChainObservationStats)(nil), "types.QueryChainObservationStats")
+}
+
+func init() {
+	proto.RegisterFile("types/query_observation_stats.proto", fileDescriptor_f3a4965d91ffddaa)
+}
+
+var fileDescriptor_f3a4965d91ffddaa = []byte{
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xeb, 0x26, 0x71, 0x9a, 0x49, 0x55, 0xd2, 0xa5, 0x14, 0xab, 0x2a, 0xde, 0x12, 0x38,
+	0xf4, 0x42, 0x22, 0x35, 0x9c, 0x91, 0x1a, 0xfe, 0x1e, 0x82, 0x80, 0x45, 0x70, 0xe0, 0x62, 0x6d,
+	0xea, 0xc5, 0xb1, 0x88, 0xbd, 0xa9, 0x77, 0x13, 0xd2, 0xb7, 0xe0, 0x0e, 0x0f, 0xc4, 0xb1, 0x47,
+	0x4e, 0x16, 0x4a, 0x6e, 0x7e, 0x0a, 0xb4, 0x63, 0x57, 0x71, 0x68, 0x7b, 0xc9, 0xee, 0xfc, 0xe6,
+	0x9b, 0x6f, 0x67, 0xa2, 0x31, 0x3c, 0xd2, 0x17, 0x13, 0xa1, 0xba, 0xe7, 0x53, 0x91, 0x5c, 0x78,
+	0x72, 0xa8, 0x44, 0x32, 0xe3, 0x3a, 0x94, 0xb1, 0xa7, 0x34, 0xd7, 0xaa, 0x33, 0x49, 0xa4, 0x96,
+	0xa4, 0x86, 0xa2, 0x83, 0xbd, 0x40, 0x06, 0x12, 0x49, 0xd7, 0xdc, 0xf2, 0x64, 0xfb, 0x3d, 0x1c,
+	0x7e, 0x30, 0xd5, 0xef, 0x56, 0xc5, 0x1f, 0x4d, 0x2d, 0x13, 0xe7, 0x53, 0xa1, 0x34, 0x71, 0xa0,
+	0xce, 0x7d, 0x3f, 0x11, 0x4a, 0x39, 0xd6, 0x91, 0x75, 0xdc, 0x60, 0x57, 0x21, 0xd9, 0x07, 0x7b,
+	0x24, 0xc2, 0x60, 0xa4, 0x9d, 0x4d, 0x4c, 0x14, 0x51, 0xfb, 0xa7, 0x05, 0x0f, 0x6e, 0xb1, 0x54,
+	0x13, 0x19, 0x2b, 0x41, 0x7a, 0xb0, 0x1d, 0x4b, 0x5f, 0x78, 0x6b, 0xc6, 0xfd, 0x56, 0x96, 0xd2,
+	0x35, 0xce, 0x9a, 0x26, 0x3a, 0x2d, 0x9e, 0x7b, 0x09, 0xf6, 0xd9, 0x88, 0x87, 0xb1, 0x72, 0x36,
+	0x8f, 0x2a, 0xc7, 0xcd, 0x93, 0x87, 0x1d, 0x1c, 0xab, 0x83, 0x4f, 0x3d, 0x37, 0x99, 0xff, 0xdf,
+	0xeb, 0x43, 0x96, 0xd2, 0xa2, 0x88, 0x15, 0x67, 0xfb, 0x57, 0x15, 0x0e, 0x6e, 0x2f, 0x21, 0x14,
+	0x6a, 0x28, 0x2c, 0x7a, 0x6a, 0x64, 0x29, 0xcd, 0x01, 0xcb, 0x0f, 0xf2, 0x14, 0xb6, 0x4b, 0xff,
+	0xb3, 0xc2, 0xd9, 0x2b, 0x79, 0xef, 0x65, 0xce, 0xd6, 0x22, 0xf2, 0x04, 0x20, 0x12, 0x7e, 0xc8,
+	0x63, 0x6f, 0xcc, 0x03, 0xa7, 0x82, 0x35, 0x3b, 0x59, 0x4a, 0x4b, 0x94, 0x35, 0xf2, 0xfb, 0x80,
+	0x07, 0xe4, 0x31, 0xd4, 0x23, 0x3e, 0x47, 0x6d, 0x15, 0xb5, 0xcd, 0x2c, 0xa5, 0x57, 0x88, 0xd9,
+	0x11, 0x9f, 0x1b, 0x55, 0x1b, 0xec, 0x28, 0x54, 0x4a, 0xf8, 0x4e, 0x0d, 0x45, 0x38, 0x6e, 0x4e,
+	0x58, 0x71, 0x92, 0x43, 0xa8, 0x8e, 0xb9, 0x16, 0x8e, 0x8d, 0x8a, 0xad, 0x2c, 0xa5, 0x18, 0x33,
+	0xfc, 0x25, 0xa7, 0xb0, 0x1b, 0x0b, 0xfd, 0x5d, 0x26, 0xdf, 0xbc, 0xaf, 0x42, 0x78, 0x33, 0xa9,
+	0x85, 0x72, 0xea, 0x28, 0xbd, 0x97, 0xa5, 0xf4, 0x7a, 0x92, 0xdd, 0x29, 0xd0, 0x2b, 0x21, 0x3e,
+	0x1b, 0x40, 0x5e, 0x00, 0x29, 0xab, 0x8a, 0x86, 0xb6, 0xd0, 0x63, 0x3f, 0x4b, 0xe9, 0x0d, 0x59,
+	0xd6, 0x5a, 0x99, 0xbc, 0xcd, 0xdb, 0x7c, 0x06, 0xad, 0xb2, 0x0e, 0x5b, 0x6e, 0xa0, 0xc7, 0x5e,
+	0x96, 0xd2, 0x6b, 0x39, 0xb6, 0xb3, 0x72, 0x18, 0x98, 0x41, 0x5e, 0xc3, 0xdd, 0x31, 0x57, 0xda,
+	0x9b, 0x4e, 0x7c, 0xae, 0x85, 0xef, 0x15, 0x8b, 0x09, 0x68, 0x71, 0x3f, 0x4b, 0xe9, 0x4d, 0x69,
+	0xb6, 0x6b, 0xe0, 0xa7, 0x9c, 0xbd, 0x41, 0xd4, 0x1f, 0xfc, 0x5e, 0xb8, 0xd6, 0xe5, 0xc2, 0xb5,
+	0xfe, 0x2e, 0x5c, 0xeb, 0xc7, 0xd2, 0xdd, 0xb8, 0x5c, 0xba, 0x1b, 0x7f, 0x96, 0xee, 0xc6, 0x97,
+	0x93, 0x20, 0xd4, 0x63, 0x3e, 0xec, 0x9c, 0xc9, 0xa8, 0xab, 0x47, 0x32, 0xc1, 0x75, 0xc0, 0x9b,
+	0xd9, 0xd2, 0xee, 0xac, 0xd7, 0x9d, 0x97, 0xb9, 0xd9, 0xcd, 0xa1, 0x8d, 0xdf, 0x58, 0xef, 0x5f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0xa3, 0x0c, 0xc2, 0xa7, 0x03, 0x00, 0x00,
+}
+
+func (m *QueryObservationStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObservationStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObservationStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObservationStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObservationStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObservationStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryObservationStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainObservationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainObservationStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainObservationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdatedHeight != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.LastUpdatedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NetworkFeeLate != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.NetworkFeeLate))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NetworkFeeMissed != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.NetworkFeeMissed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NetworkFeeVotes != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.NetworkFeeVotes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Late != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.Late))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Missed != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.Missed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxLag != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.MaxLag))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MedianLag != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.MedianLag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Observations != 0 {
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(m.Observations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintQueryObservationStats(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryObservationStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryObservationStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryObservationStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQueryObservationStats(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryObservationStats(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObservationStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovQueryObservationStats(uint64(l))
+	}
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQueryObservationStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChainObservationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovQueryObservationStats(uint64(l))
+	}
+	if m.Observations != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.Observations))
+	}
+	if m.MedianLag != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.MedianLag))
+	}
+	if m.MaxLag != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.MaxLag))
+	}
+	if m.Missed != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.Missed))
+	}
+	if m.Late != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.Late))
+	}
+	if m.NetworkFeeVotes != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.NetworkFeeVotes))
+	}
+	if m.NetworkFeeMissed != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.NetworkFeeMissed))
+	}
+	if m.NetworkFeeLate != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.NetworkFeeLate))
+	}
+	if m.LastUpdatedHeight != 0 {
+		n += 1 + sovQueryObservationStats(uint64(m.LastUpdatedHeight))
+	}
+	return n
+}
+
+func sovQueryObservationStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryObservationStats(x uint64) (n int) {
+	return sovQueryObservationStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryObservationStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryObservationStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObservationStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObservationStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryObservationStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObservationStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryObservationStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObservationStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObservationStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &QueryChainObservationStats{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryObservationStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainObservationStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryObservationStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainObservationStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainObservationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+			}
+			m.Observations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Observations |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianLag", wireType)
+			}
+			m.MedianLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLag", wireType)
+			}
+			m.MaxLag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLag |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			m.Missed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Missed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Late", wireType)
+			}
+			m.Late = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Late |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeVotes", wireType)
+			}
+			m.NetworkFeeVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkFeeVotes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeMissed", wireType)
+			}
+			m.NetworkFeeMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkFeeMissed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeLate", wireType)
+			}
+			m.NetworkFeeLate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkFeeLate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+			}
+			m.LastUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryObservationStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryObservationStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryObservationStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryObservationStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryObservationStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryObservationStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryObservationStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryObservationStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryObservationStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryObservationStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryObservationStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1248 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/query_pool_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryPoolHistoryRequest struct {
+	Asset    string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Height   string `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryPoolHistoryRequest) Reset()         { *m = QueryPoolHistoryRequest{} }
+func (m *QueryPoolHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolHistoryRequest) ProtoMessage()    {}
+func (*QueryPoolHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2558b4b60e67dba, []int{0}
+}
+func (m *QueryPoolHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolHistoryRequest.Merge(m, src)
+}
+func (m *QueryPoolHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryPoolHistoryRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type QueryPoolHistoryResponse struct {
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	// the length of each returned interval (day, week)
+	Interval string `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval"`
+	// liquidity fees and block rewards over the retained history annualised against the current pool depth, in basis points
+	LiquidityApyBps int64 `protobuf:"varint,3,opt,name=liquidity_apy_bps,json=liquidityApyBps,proto3" json:"liquidity_apy_bps"`
+	// synth yield over the retained history annualised against the current savers depth, in basis points
+	SaversApyBps int64 `protobuf:"varint,4,opt,name=savers_apy_bps,json=saversApyBps,proto3" json:"savers_apy_bps"`
+	// the pool history, most recent interval first
+	Intervals []*QueryPoolHistoryInterval `protobuf:"bytes,5,rep,name=intervals,proto3" json:"intervals"`
+}
+
+func (m *QueryPoolHistoryResponse) Reset()         { *m = QueryPoolHistoryResponse{} }
+func (m *QueryPoolHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolHistoryResponse) ProtoMessage()    {}
+func (*QueryPoolHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2558b4b60e67dba, []int{1}
+}
+func (m *QueryPoolHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolHistoryResponse.Merge(m, src)
+}
+func (m *QueryPoolHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryPoolHistoryResponse) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryResponse) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryResponse) GetLiquidityApyBps() int64 {
+	if m != nil {
+		return m.LiquidityApyBps
+	}
+	return 0
+}
+
+func (m *QueryPoolHistoryResponse) GetSaversApyBps() int64 {
+	if m != nil {
+		return m.SaversApyBps
+	}
+	return 0
+}
+
+func (m *QueryPoolHistoryResponse) GetIntervals() []*QueryPoolHistoryInterval {
+	if m != nil {
+		return m.Intervals
+	}
+	return nil
+}
+
+type QueryPoolHistoryInterval struct {
+	// the first block height of the interval
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height"`
+	// the last block height of the interval
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height"`
+	// liquidity fees collected by the pool in RUNE
+	LiquidityFees string `protobuf:"bytes,3,opt,name=liquidity_fees,json=liquidityFees,proto3" json:"liquidity_fees"`
+	// block rewards paid to the pool in RUNE
+	Rewards string `protobuf:"bytes,4,opt,name=rewards,proto3" json:"rewards"`
+	// synths minted as yield to the savers of the pool
+	SynthYield string `protobuf:"bytes,5,opt,name=synth_yield,json=synthYield,proto3" json:"synth_yield"`
+	// RUNE deposited into the pool by protocol owned liquidity
+	PolRuneDeposited string `protobuf:"bytes,6,opt,name=pol_rune_deposited,json=polRuneDeposited,proto3" json:"pol_rune_deposited"`
+	// RUNE withdrawn from the pool by protocol owned liquidity
+	PolRuneWithdrawn string `protobuf:"bytes,7,opt,name=pol_rune_withdrawn,json=polRuneWithdrawn,proto3" json:"pol_rune_withdrawn"`
+}
+
+func (m *QueryPoolHistoryInterval) Reset()         { *m = QueryPoolHistoryInterval{} }
+func (m *QueryPoolHistoryInterval) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolHistoryInterval) ProtoMessage()    {}
+func (*QueryPoolHistoryInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2558b4b60e67dba, []int{2}
+}
+func (m *QueryPoolHistoryInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolHistoryInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolHistoryInterval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolHistoryInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolHistoryInterval.Merge(m, src)
+}
+func (m *QueryPoolHistoryInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolHistoryInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolHistoryInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolHistoryInterval proto.InternalMessageInfo
+
+func (m *QueryPoolHistoryInterval) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryPoolHistoryInterval) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryPoolHistoryInterval) GetLiquidityFees() string {
+	if m != nil {
+		return m.LiquidityFees
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryInterval) GetRewards() string {
+	if m != nil {
+		return m.Rewards
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryInterval) GetSynthYield() string {
+	if m != nil {
+		return m.SynthYield
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryInterval) GetPolRuneDeposited() string {
+	if m != nil {
+		return m.PolRuneDeposited
+	}
+	return ""
+}
+
+func (m *QueryPoolHistoryInterval) GetPolRuneWithdrawn() string {
+	if m != nil {
+		return m.PolRuneWithdrawn
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryPoolHistoryRequest)(nil), "types.QueryPoolHistoryRequest")
+	proto.RegisterType((*QueryPoolHistoryResponse)(nil), "types.QueryPoolHistoryResponse")
+	proto.RegisterType((*QueryPoolHistoryInterval)(nil), "types.QueryPoolHistoryInterval")
+}
+
+func init() { proto.RegisterFile("types/query_pool_history.proto", fileDescriptor_c2558b4b60e67dba) }
+
+var fileDescriptor_c2558b4b60e67dba = []byte{
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xad, 0x9b, 0x2f, 0xe9, 0xe7, 0x49, 0x9a, 0x96, 0x51, 0x29, 0x56, 0x17, 0x9e, 0x2a, 0x12,
+	0x52, 0x36, 0x24, 0xa8, 0xd9, 0xc0, 0xb2, 0x56, 0x85, 0x8a, 0xd4, 0x05, 0xcc, 0x06, 0xc1, 0xc6,
+	0x72, 0xe2, 0x4b, 0x3c, 0x92, 0xf1, 0x4c, 0x66, 0x26, 0x09, 0x7e, 0x0b, 0x9e, 0x80, 0x67, 0xe0,
+	0x31, 0x58, 0x76, 0xc9, 0xca, 0x42, 0xc9, 0xce, 0x4f, 0x81, 0x32, 0x8e, 0x9d, 0xbf, 0xee, 0xee,
+	0x39, 0xe7, 0x9e, 0x7b, 0xaf, 0x8f, 0x3c, 0xc8, 0xd5, 0xa9, 0x00, 0xd5, 0x9f, 0x4c, 0x41, 0xa6,
+	0xbe, 0xe0, 0x3c, 0xf6, 0x23, 0xa6, 0x34, 0x97, 0x69, 0x4f, 0x48, 0xae, 0x39, 0xae, 0x1b, 0xfd,
+	0xea, 0x62, 0xcc, 0xc7, 0xdc, 0x30, 0xfd, 0x55, 0x55, 0x88, 0x9d, 0x11, 0x7a, 0xf1, 0x71, 0x65,
+	0xfc, 0xc0, 0x79, 0x7c, 0x5f, 0xd8, 0x28, 0x4c, 0xa6, 0xa0, 0x34, 0xbe, 0x40, 0xf5, 0x40, 0x29,
+	0xd0, 0x8e, 0x75, 0x6d, 0x75, 0x6d, 0x5a, 0x00, 0x7c, 0x85, 0xfe, 0x67, 0x89, 0x06, 0x39, 0x0b,
+	0x62, 0xe7, 0xd8, 0x08, 0x15, 0xc6, 0x97, 0xa8, 0x11, 0x01, 0x1b, 0x47, 0xda, 0xa9, 0x19, 0x65,
+	0x8d, 0x3a, 0xbf, 0x8e, 0x91, 0x73, 0xb8, 0x45, 0x09, 0x9e, 0x28, 0xc0, 0x64, 0x67, 0x8d, 0x67,
+	0xe7, 0x19, 0x29, 0x88, 0x72, 0x63, 0x77, 0x7f, 0xa3, 0xd7, 0xca, 0x33, 0x52, 0x71, 0x5b, 0xfb,
+	0x6f, 0xd1, 0xb3, 0x98, 0x4d, 0xa6, 0x2c, 0x64, 0x3a, 0xf5, 0x03, 0x91, 0xfa, 0x43, 0xa1, 0xcc,
+	0x29, 0x35, 0xef, 0x79, 0x9e, 0x91, 0x43, 0x91, 0x9e, 0x55, 0xd4, 0xad, 0x48, 0x3d, 0xa1, 0xf0,
+	0x1b, 0xd4, 0x56, 0xc1, 0x0c, 0xa4, 0xaa, 0xfc, 0xff, 0x19, 0x3f, 0xce, 0x33, 0xb2, 0xa7, 0xd0,
+	0x56, 0x81, 0xd7, 0xce, 0x07, 0x64, 0x97, 0x87, 0x28, 0xa7, 0x7e, 0x5d, 0xeb, 0x36, 0x6f, 0x48,
+	0xcf, 0x44, 0xdf, 0xdb, 0xff, 0xf6, 0xf7, 0xeb, 0x3e, 0xef, 0x34, 0xcf, 0xc8, 0xc6, 0x45, 0x37,
+	0x65, 0xe7, 0x67, 0xed, 0x30, 0xb2, 0xd2, 0x86, 0x07, 0xa8, 0xa5, 0x74, 0x20, 0xb5, 0xbf, 0x4e,
+	0xdb, 0x32, 0x27, 0x9e, 0xe7, 0x19, 0xd9, 0xe1, 0x69, 0xd3, 0xa0, 0x7b, 0x03, 0xf0, 0x2b, 0x84,
+	0x20, 0x09, 0x4b, 0xcb, 0xb1, 0xb1, 0xb4, 0xf3, 0x8c, 0x6c, 0xb1, 0xd4, 0x86, 0x24, 0x5c, 0xb7,
+	0xbf, 0x45, 0xed, 0x4d, 0x5c, 0x5f, 0x01, 0x8a, 0x20, 0xed, 0x22, 0x88, 0x5d, 0x85, 0x9e, 0x56,
+	0xf8, 0x1d, 0x80, 0xc2, 0x2f, 0xd1, 0x89, 0x84, 0x79, 0x20, 0xc3, 0x22, 0x3c, 0xdb, 0x6b, 0xe6,
+	0x19, 0x29, 0x29, 0x5a, 0x16, 0xf8, 0x35, 0x6a, 0xaa, 0x34, 0xd1, 0x91, 0x9f, 0x32, 0x88, 0x43,
+	0xa7, 0x6e, 0x5a, 0xcf, 0xf2, 0x8c, 0x6c, 0xd3, 0x14, 0x19, 0xf0, 0x79, 0x55, 0xe3, 0x3b, 0x84,
+	0x05, 0x8f, 0x7d, 0x39, 0x4d, 0xc0, 0x0f, 0x41, 0x70, 0xc5, 0x34, 0x84, 0x4e, 0xc3, 0x18, 0x2f,
+	0xf3, 0x8c, 0x3c, 0xa1, 0xd2, 0x73, 0xc1, 0x63, 0x3a, 0x4d, 0xe0, 0xae, 0x64, 0x76, 0xa6, 0xcc,
+	0x99, 0x8e, 0x42, 0x19, 0xcc, 0x13, 0xe7, 0xe4, 0x89, 0x29, 0x95, 0x5a, 0x4d, 0xf9, 0x54, 0x32,
+	0xde, 0xc3, 0xef, 0x85, 0x6b, 0x3d, 0x2e, 0x5c, 0xeb, 0xef, 0xc2, 0xb5, 0x7e, 0x2c, 0xdd, 0xa3,
+	0xc7, 0xa5, 0x7b, 0xf4, 0x67, 0xe9, 0x1e, 0x7d, 0xb9, 0x19, 0x33, 0x1d, 0x07, 0xc3, 0xde, 0x88,
+	0x7f, 0xeb, 0xeb, 0x88, 0xcb, 0x51, 0x14, 0xb0, 0xc4, 0x54, 0x09, 0x0f, 0xa1, 0x3f, 0x1b, 0xf4,
+	0xbf, 0x6f, 0xf3, 0xab, 0x3f, 0x64, 0xd8, 0x30, 0xaf, 0x71, 0xf0, 0x2f, 0x00, 0x00, 0xff, 0xff,
+	0x52, 0xf5, 0x87, 0xaa, 0xcc, 0x03, 0x00, 0x00,
+}
+
+func (m *QueryPoolHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Interval) > 0 {
+		i -= len(m.Interval)
+		copy(dAtA[i:], m.Interval)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.Interval)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Intervals) > 0 {
+		for iNdEx := len(m.Intervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Intervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryPoolHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SaversApyBps != 0 {
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(m.SaversApyBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LiquidityApyBps != 0 {
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(m.LiquidityApyBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Interval) > 0 {
+		i -= len(m.Interval)
+		copy(dAtA[i:], m.Interval)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.Interval)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolHistoryInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolHistoryInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolHistoryInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PolRuneWithdrawn) > 0 {
+		i -= len(m.PolRuneWithdrawn)
+		copy(dAtA[i:], m.PolRuneWithdrawn)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.PolRuneWithdrawn)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PolRuneDeposited) > 0 {
+		i -= len(m.PolRuneDeposited)
+		copy(dAtA[i:], m.PolRuneDeposited)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.PolRuneDeposited)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SynthYield) > 0 {
+		i -= len(m.SynthYield)
+		copy(dAtA[i:], m.SynthYield)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.SynthYield)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Rewards) > 0 {
+		i -= len(m.Rewards)
+		copy(dAtA[i:], m.Rewards)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.Rewards)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LiquidityFees) > 0 {
+		i -= len(m.LiquidityFees)
+		copy(dAtA[i:], m.LiquidityFees)
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(len(m.LiquidityFees)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQueryPoolHistory(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryPoolHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryPoolHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	l = len(m.Interval)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	if m.LiquidityApyBps != 0 {
+		n += 1 + sovQueryPoolHistory(uint64(m.LiquidityApyBps))
+	}
+	if m.SaversApyBps != 0 {
+		n += 1 + sovQueryPoolHistory(uint64(m.SaversApyBps))
+	}
+	if len(m.Intervals) > 0 {
+		for _, e := range m.Intervals {
+			l = e.Size()
+			n += 1 + l + sovQueryPoolHistory(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolHistoryInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQueryPoolHistory(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQueryPoolHistory(uint64(m.EndHeight))
+	}
+	l = len(m.LiquidityFees)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	l = len(m.Rewards)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	l = len(m.SynthYield)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	l = len(m.PolRuneDeposited)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	l = len(m.PolRuneWithdrawn)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolHistory(uint64(l))
+	}
+	return n
+}
+
+func sovQueryPoolHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryPoolHistory(x uint64) (n int) {
+	return sovQueryPoolHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryPoolHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryPoolHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryPoolHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityApyBps", wireType)
+			}
+			m.LiquidityApyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityApyBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaversApyBps", wireType)
+			}
+			m.SaversApyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaversApyBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intervals = append(m.Intervals, &QueryPoolHistoryInterval{})
+			if err := m.Intervals[len(m.Intervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryPoolHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolHistoryInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryPoolHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolHistoryInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolHistoryInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynthYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SynthYield = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolRuneDeposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolRuneDeposited = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolRuneWithdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolRuneWithdrawn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryPoolHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryPoolHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryPoolHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryPoolHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryPoolHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryPoolHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryPoolHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryPoolHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryPoolHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryPoolHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryPoolHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/query_pool_twap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryPoolTWAPRequest struct {
+	Asset  string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Blocks int64  `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Height string `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryPoolTWAPRequest) Reset()         { *m = QueryPoolTWAPRequest{} }
+func (m *QueryPoolTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolTWAPRequest) ProtoMessage()    {}
+func (*QueryPoolTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a0de8bcb628ecd, []int{0}
+}
+func (m *QueryPoolTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolTWAPRequest.Merge(m, src)
+}
+func (m *QueryPoolTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolTWAPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolTWAPRequest proto.InternalMessageInfo
+
+func (m *QueryPoolTWAPRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryPoolTWAPRequest) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QueryPoolTWAPRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type QueryPoolTWAPResponse struct {
+	Asset string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset"`
+	// the number of blocks averaged
+	Blocks int64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks"`
+	// the first block height averaged
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height"`
+	// the last block height averaged
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height"`
+	// the time weighted average price of the pool in RUNE per asset (1e8)
+	TwapPrice string `protobuf:"bytes,5,opt,name=twap_price,json=twapPrice,proto3" json:"twap_price"`
+	// the current price of the pool in RUNE per asset (1e8)
+	SpotPrice string `protobuf:"bytes,6,opt,name=spot_price,json=spotPrice,proto3" json:"spot_price"`
+}
+
+func (m *QueryPoolTWAPResponse) Reset()         { *m = QueryPoolTWAPResponse{} }
+func (m *QueryPoolTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolTWAPResponse) ProtoMessage()    {}
+func (*QueryPoolTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51a0de8bcb628ecd, []int{1}
+}
+func (m *QueryPoolTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolTWAPResponse.Merge(m, src)
+}
+func (m *QueryPoolTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolTWAPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolTWAPResponse proto.InternalMessageInfo
+
+func (m *QueryPoolTWAPResponse) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryPoolTWAPResponse) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *QueryPoolTWAPResponse) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryPoolTWAPResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QueryPoolTWAPResponse) GetTwapPrice() string {
+	if m != nil {
+		return m.TwapPrice
+	}
+	return ""
+}
+
+func (m *QueryPoolTWAPResponse) GetSpotPrice() string {
+	if m != nil {
+		return m.SpotPrice
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryPoolTWAPRequest)(nil), "types.QueryPoolTWAPRequest")
+	proto.RegisterType((*QueryPoolTWAPResponse)(nil), "types.QueryPoolTWAPResponse")
+}
+
+func init() { proto.RegisterFile("types/query_pool_twap.proto", fileDescriptor_51a0de8bcb628ecd) }
+
+var fileDescriptor_51a0de8bcb628ecd = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x3b, 0xad, 0x2d, 0x64, 0x14, 0x91, 0x50, 0x25, 0x28, 0x4c, 0x4a, 0x57, 0xdd, 0xd8,
+	0x80, 0x3d, 0x81, 0x59, 0xb9, 0x70, 0x51, 0x07, 0x41, 0x10, 0x21, 0xa4, 0xe9, 0x90, 0x04, 0x63,
+	0xde, 0x34, 0x33, 0x55, 0x7b, 0x0b, 0x4f, 0xe0, 0x79, 0x5c, 0x76, 0xe9, 0x2a, 0x48, 0xbb, 0xcb,
+	0x29, 0x64, 0xa6, 0xa9, 0x49, 0xdc, 0xfd, 0xff, 0x37, 0xff, 0x7b, 0x7f, 0xc8, 0xc3, 0x17, 0x72,
+	0xc5, 0x99, 0x70, 0x16, 0x4b, 0x96, 0xad, 0x3c, 0x0e, 0x90, 0x78, 0xf2, 0xcd, 0xe7, 0x63, 0x9e,
+	0x81, 0x04, 0xb3, 0xab, 0x1f, 0xcf, 0xfb, 0x21, 0x84, 0xa0, 0x89, 0xa3, 0xd4, 0xee, 0x71, 0xf8,
+	0x84, 0xfb, 0x77, 0x6a, 0x6a, 0x0a, 0x90, 0xdc, 0x3f, 0x5c, 0x4f, 0x29, 0x5b, 0x2c, 0x99, 0x90,
+	0x66, 0x1f, 0x77, 0x7d, 0x21, 0x98, 0xb4, 0xd0, 0x00, 0x8d, 0x0c, 0xba, 0x33, 0xe6, 0x19, 0xee,
+	0xcd, 0x12, 0x08, 0x9e, 0x85, 0xd5, 0x1e, 0xa0, 0x51, 0x87, 0x96, 0x4e, 0xf1, 0x88, 0xc5, 0x61,
+	0x24, 0xad, 0x8e, 0x8e, 0x97, 0x6e, 0xf8, 0xd9, 0xc6, 0xa7, 0xff, 0xd6, 0x0b, 0x0e, 0xa9, 0x60,
+	0xa6, 0xdd, 0xd8, 0xef, 0x1a, 0x45, 0x6e, 0xef, 0xc0, 0xbe, 0x6a, 0xd8, 0xac, 0x72, 0x71, 0x91,
+	0xdb, 0x25, 0xf9, 0xab, 0x9d, 0xe0, 0x23, 0x21, 0xfd, 0x4c, 0x7a, 0xb5, 0xf2, 0x8e, 0x7b, 0x52,
+	0xe4, 0x76, 0x83, 0xd3, 0x43, 0xed, 0x6e, 0xb4, 0x31, 0x2f, 0x31, 0x66, 0xe9, 0x7c, 0x3f, 0x72,
+	0xa0, 0x47, 0x8e, 0x8b, 0xdc, 0xae, 0x51, 0x6a, 0xb0, 0x74, 0x5e, 0xc5, 0xd5, 0xbf, 0xf4, 0x78,
+	0x16, 0x07, 0xcc, 0xea, 0xea, 0xaf, 0xd5, 0xf1, 0x8a, 0x52, 0x43, 0xe9, 0xa9, 0x92, 0x2a, 0x2e,
+	0x38, 0xc8, 0x32, 0xde, 0xab, 0xe2, 0x15, 0xa5, 0x86, 0xd2, 0x3a, 0xee, 0xde, 0x7e, 0x6d, 0x08,
+	0x5a, 0x6f, 0x08, 0xfa, 0xd9, 0x10, 0xf4, 0xb1, 0x25, 0xad, 0xf5, 0x96, 0xb4, 0xbe, 0xb7, 0xa4,
+	0xf5, 0x78, 0x15, 0xc6, 0x32, 0xf1, 0x67, 0xe3, 0x00, 0x5e, 0x1c, 0x19, 0x41, 0x16, 0x44, 0x7e,
+	0x9c, 0x6a, 0x95, 0xc2, 0x9c, 0x39, 0xaf, 0x13, 0xe7, 0xbd, 0xce, 0xd5, 0x89, 0x67, 0x3d, 0x7d,
+	0xd3, 0xc9, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0x9b, 0x48, 0xbd, 0x0f, 0x02, 0x00, 0x00,
+}
+
+func (m *QueryPoolTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpotPrice) > 0 {
+		i -= len(m.SpotPrice)
+		copy(dAtA[i:], m.SpotPrice)
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(len(m.SpotPrice)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TwapPrice) > 0 {
+		i -= len(m.TwapPrice)
+		copy(dAtA[i:], m.TwapPrice)
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(len(m.TwapPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQueryPoolTwap(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryPoolTwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryPoolTwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPoolTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolTwap(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQueryPoolTwap(uint64(m.Blocks))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolTwap(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolTwap(uint64(l))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovQueryPoolTwap(uint64(m.Blocks))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQueryPoolTwap(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQueryPoolTwap(uint64(m.EndHeight))
+	}
+	l = len(m.TwapPrice)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolTwap(uint64(l))
+	}
+	l = len(m.SpotPrice)
+	if l > 0 {
+		n += 1 + l + sovQueryPoolTwap(uint64(l))
+	}
+	return n
+}
+
+func sovQueryPoolTwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryPoolTwap(x uint64) (n int) {
+	return sovQueryPoolTwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPoolTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryPoolTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryPoolTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryPoolTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryPoolTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryPoolTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryPoolTwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryPoolTwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryPoolTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryPoolTwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryPoolTwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryPoolTwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryPoolTwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryPoolTwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryPoolTwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -37,6 +37,7 @@ type QueryQuoteSwapRequest struct {
 	Height                string   `protobuf:"bytes,11,opt,name=height,proto3" json:"height,omitempty"`
 	LiquidityToleranceBps string   `protobuf:"bytes,12,opt,name=liquidity_tolerance_bps,json=liquidityToleranceBps,proto3" json:"liquidity_tolerance_bps,omitempty"`
 	Extended              bool     `protobuf:"varint,13,opt,name=extended,proto3" json:"extended,omitempty"`
+	Route                 string   `protobuf:"bytes,14,opt,name=route,proto3" json:"route,omitempty"`
 }
 
 func (m *QueryQuoteSwapRequest) Reset()         { *m = QueryQuoteSwapRequest{} }
@@ -163,6 +164,13 @@ func (m *QueryQuoteSwapRequest) GetExtended() bool {
 	return false
 }
 
+func (m *QueryQuoteSwapRequest) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
 type QueryQuoteSwapResponse struct {
 	// the inbound address for the transaction on the source chain
 	InboundAddress string `protobuf:"bytes,1,opt,name=inbound_address,json=inboundAddress,proto3" json:"inbound_address,omitempty"`
@@ -205,6 +213,10 @@ type QueryQuoteSwapResponse struct {
 	TotalSwapSeconds int64 `protobuf:"varint,20,opt,name=total_swap_seconds,json=totalSwapSeconds,proto3" json:"total_swap_seconds,omitempty"`
 	// List of outputs needed (additional to deposit and change return). Meant for wallets to easily construct transactions with more than 80bytes
 	Vout []*Vout `protobuf:"bytes,21,rep,name=vout,proto3" json:"vout,omitempty"`
+	// the intermediate assets the quoted swap is routed through, empty for the default route
+	Route []string `protobuf:"bytes,22,rep,name=route,proto3" json:"route,omitempty"`
+	// the last block swaps are paused in a pool of the swap by the price circuit breaker, queued swaps are held until then
+	PriceBreakerHeight int64 `protobuf:"varint,23,opt,name=price_breaker_height,json=priceBreakerHeight,proto3" json:"price_breaker_height,omitempty"`
 }
 
 func (m *QueryQuoteSwapResponse) Reset()         { *m = QueryQuoteSwapResponse{} }
@@ -387,6 +399,20 @@ func (m *QueryQuoteSwapResponse) GetVout() []*Vout {
 	return nil
 }
 
+func (m *QueryQuoteSwapResponse) GetRoute() []string {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *QueryQuoteSwapResponse) GetPriceBreakerHeight() int64 {
+	if m != nil {
+		return m.PriceBreakerHeight
+	}
+	return 0
+}
+
 type QueryQuoteSaverDepositRequest struct {
 	Asset        string   `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
 	Amount       string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -497,6 +523,8 @@ type QueryQuoteSaverDepositResponse struct {
 	ExpectedAmountOut string `protobuf:"bytes,16,opt,name=expected_amount_out,json=expectedAmountOut,proto3" json:"expected_amount_out,omitempty"`
 	// the amount of the target asset the user can expect to deposit after fees
 	ExpectedAmountDeposit string `protobuf:"bytes,17,opt,name=expected_amount_deposit,json=expectedAmountDeposit,proto3" json:"expected_amount_deposit"`
+	// the synth yield of the pool over the retained history annualised against the current savers depth, in basis points
+	RealisedApyBps int64 `protobuf:"varint,18,opt,name=realised_apy_bps,json=realisedApyBps,proto3" json:"realised_apy_bps,omitempty"`
 }
 
 func (m *QueryQuoteSaverDepositResponse) Reset()         { *m = QueryQuoteSaverDepositResponse{} }
@@ -651,6 +679,13 @@ func (m *QueryQuoteSaverDepositResponse) GetExpectedAmountDeposit() string {
 	return ""
 }
 
+func (m *QueryQuoteSaverDepositResponse) GetRealisedApyBps() int64 {
+	if m != nil {
+		return m.RealisedApyBps
+	}
+	return 0
+}
+
 type QueryQuoteSaverWithdrawRequest struct {
 	Asset       string `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
 	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -1737,109 +1772,113 @@ func init() {
 func init() { proto.RegisterFile("types/query_quotes.proto", fileDescriptor_5502cf9fcacfb1bc) }
 
 var fileDescriptor_5502cf9fcacfb1bc = []byte{
-	// 1629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x16, 0x8e, 0x2c, 0x59, 0x3f, 0x47, 0xb6, 0x63, 0xd3, 0xb2, 0x4c, 0xdb, 0xb1, 0xe8, 0xe8, 0x26,
-	0xb8, 0xc1, 0xfd, 0xb1, 0x03, 0x27, 0xb8, 0xb8, 0x17, 0xb8, 0x28, 0x1a, 0xc5, 0x68, 0xe0, 0x22,
-	0x41, 0x92, 0x71, 0x9a, 0x02, 0xdd, 0x10, 0xb4, 0x38, 0x96, 0x89, 0x4a, 0x33, 0x34, 0x67, 0xe4,
-	0x9f, 0x6e, 0xbb, 0x2a, 0xba, 0xe9, 0xa6, 0x0f, 0xd0, 0x5d, 0xdf, 0xa0, 0x0f, 0xd0, 0x4d, 0x97,
-	0xd9, 0x14, 0xe8, 0x8a, 0x28, 0x92, 0xae, 0xf8, 0x06, 0xdd, 0x15, 0xf3, 0x43, 0x89, 0x94, 0x29,
-	0x19, 0x69, 0x1b, 0x20, 0x6d, 0xbd, 0x21, 0x39, 0xdf, 0xf9, 0x66, 0x38, 0x33, 0x3c, 0xe7, 0x3b,
-	0x73, 0x24, 0x30, 0xf9, 0x99, 0x8f, 0xd9, 0xd6, 0x51, 0x1f, 0x07, 0x67, 0xf6, 0x51, 0x9f, 0x72,
-	0xcc, 0x36, 0xfd, 0x80, 0x72, 0x6a, 0x4c, 0x4b, 0xcb, 0x6a, 0xad, 0x43, 0x3b, 0x54, 0x22, 0x5b,
-	0xe2, 0x49, 0x19, 0x9b, 0x3f, 0xe5, 0x61, 0xe9, 0xa9, 0xe8, 0xf3, 0x54, 0x74, 0xd9, 0x3b, 0x71,
-	0x7c, 0x84, 0x8f, 0xfa, 0x98, 0x71, 0x63, 0x1d, 0xe0, 0x20, 0xa0, 0x3d, 0xdb, 0x61, 0x0c, 0x73,
-	0x33, 0xb7, 0x91, 0xbb, 0x55, 0x41, 0x15, 0x81, 0xdc, 0x13, 0x80, 0xb1, 0x02, 0x65, 0x4e, 0xb5,
-	0x71, 0x4a, 0x1a, 0x4b, 0x9c, 0x2a, 0x53, 0x1d, 0x8a, 0x4e, 0x8f, 0xf6, 0x09, 0x37, 0xf3, 0xd2,
-	0xa0, 0x5b, 0xc6, 0xbf, 0xc1, 0x60, 0x3c, 0xc0, 0x4e, 0xcf, 0x23, 0x1d, 0xdb, 0x23, 0x1c, 0x07,
-	0xc7, 0x4e, 0xd7, 0x2c, 0x48, 0xce, 0xc2, 0xc0, 0xb2, 0xab, 0x0d, 0x69, 0xfa, 0x51, 0xdf, 0x21,
-	0xdc, 0xe3, 0x67, 0xe6, 0xf4, 0x08, 0xfd, 0xa9, 0x36, 0x18, 0x1b, 0x50, 0x75, 0x31, 0xe3, 0x1e,
-	0x71, 0xb8, 0x47, 0x89, 0x59, 0x94, 0xbc, 0x24, 0x64, 0xfc, 0x0d, 0x66, 0x39, 0xed, 0xe2, 0xc0,
-	0x21, 0x6d, 0x6c, 0xef, 0xfb, 0xcc, 0x2c, 0x49, 0xce, 0xcc, 0x00, 0x6c, 0xf9, 0xcc, 0xb8, 0x09,
-	0x73, 0x01, 0x3e, 0xe8, 0x13, 0xd7, 0x76, 0x5c, 0x37, 0xc0, 0x8c, 0x99, 0x65, 0xc9, 0x9a, 0x55,
-	0xe8, 0x3d, 0x05, 0x1a, 0xd7, 0xa0, 0xe2, 0x1c, 0x1c, 0x78, 0x5d, 0xcf, 0xe1, 0xd8, 0xac, 0x6c,
-	0xe4, 0xc5, 0xe6, 0x0c, 0x00, 0xf1, 0xa6, 0x41, 0x43, 0xbe, 0x09, 0x24, 0x63, 0x66, 0x00, 0x8a,
-	0x37, 0xd5, 0xa1, 0x78, 0x88, 0xbd, 0xce, 0x21, 0x37, 0xab, 0x6a, 0x9b, 0x54, 0xcb, 0xf8, 0x0f,
-	0x2c, 0x77, 0xbd, 0xa3, 0xbe, 0xe7, 0x7a, 0xfc, 0xcc, 0x4e, 0x4f, 0x78, 0x46, 0x12, 0x97, 0x06,
-	0xe6, 0x67, 0xc9, 0x99, 0xaf, 0x42, 0x19, 0x9f, 0x72, 0x4c, 0x5c, 0xec, 0x9a, 0xb3, 0x1b, 0xb9,
-	0x5b, 0x65, 0x34, 0x68, 0x37, 0xbf, 0x2f, 0x43, 0x7d, 0xf4, 0x33, 0x33, 0x9f, 0x12, 0x86, 0x8d,
-	0xbf, 0xc3, 0x55, 0x8f, 0xec, 0xd3, 0xe4, 0x8a, 0xd5, 0xc7, 0x9e, 0xd3, 0x70, 0xbc, 0xe4, 0x77,
-	0x60, 0x2d, 0x26, 0xb6, 0x29, 0x39, 0xf0, 0x82, 0x9e, 0xdc, 0x56, 0x7b, 0xbf, 0x4b, 0xdb, 0x1f,
-	0x33, 0xe9, 0x04, 0x79, 0xb4, 0xa2, 0x29, 0xf7, 0x13, 0x8c, 0x96, 0x24, 0x18, 0xef, 0xc2, 0xb5,
-	0xcc, 0xfe, 0x0c, 0xb7, 0x29, 0x71, 0x99, 0x74, 0x96, 0x3c, 0x5a, 0xcd, 0x18, 0x60, 0x4f, 0x31,
-	0x8c, 0x47, 0xb0, 0x44, 0xfb, 0x5c, 0x0d, 0xe1, 0xe2, 0xae, 0x73, 0x16, 0xbf, 0x5b, 0xf8, 0x50,
-	0xbe, 0xb5, 0x12, 0x85, 0x56, 0x36, 0x01, 0x2d, 0xc6, 0xf0, 0x8e, 0x40, 0xf5, 0x84, 0x9e, 0x40,
-	0x7d, 0x84, 0x1d, 0x4f, 0x65, 0x5a, 0x8e, 0xb7, 0x1a, 0x85, 0xd6, 0x18, 0x06, 0xaa, 0xa5, 0x06,
-	0x8c, 0x27, 0xb8, 0x09, 0x85, 0x03, 0x8c, 0x99, 0x74, 0xbe, 0xea, 0xf6, 0xfc, 0xa6, 0x8c, 0xbc,
-	0x4d, 0xb9, 0xe7, 0xef, 0x61, 0xcc, 0x5a, 0xe5, 0x28, 0xb4, 0x24, 0x03, 0xc9, 0xab, 0x70, 0x81,
-	0x80, 0xf6, 0x39, 0x0e, 0xb4, 0x2b, 0xea, 0x96, 0xd1, 0x84, 0x22, 0x3e, 0xf5, 0xbd, 0xe0, 0x4c,
-	0x3a, 0x5f, 0xbe, 0x05, 0x51, 0x68, 0x69, 0x04, 0xe9, 0xbb, 0x71, 0x13, 0x4a, 0x27, 0x4e, 0x40,
-	0x3c, 0xd2, 0x31, 0x2b, 0xa2, 0x73, 0xab, 0x1a, 0x85, 0x56, 0x0c, 0xa1, 0xf8, 0xc1, 0xb0, 0x60,
-	0x9a, 0x08, 0x31, 0x30, 0x41, 0x92, 0x2a, 0x51, 0x68, 0x29, 0x00, 0xa9, 0x9b, 0x70, 0x78, 0xb7,
-	0xcf, 0xb8, 0xcd, 0x0f, 0x03, 0xcc, 0x0e, 0x69, 0xd7, 0xd5, 0xee, 0x38, 0x2b, 0xd0, 0x67, 0x31,
-	0x68, 0xfc, 0x0f, 0x56, 0x02, 0xdc, 0xa6, 0xbd, 0x9e, 0x74, 0x28, 0xbb, 0xe7, 0x11, 0x5b, 0x85,
-	0xb5, 0xed, 0x11, 0xed, 0x97, 0xf5, 0x04, 0xe1, 0x91, 0x47, 0xee, 0x49, 0xf3, 0x2e, 0x31, 0x6e,
-	0x43, 0x2d, 0xd9, 0xb5, 0xe3, 0x30, 0x3b, 0x10, 0x61, 0x33, 0x2b, 0x7b, 0x19, 0x09, 0xdb, 0x03,
-	0x87, 0x21, 0x11, 0x3f, 0x37, 0x60, 0x2e, 0x66, 0xd9, 0x7d, 0xe2, 0x71, 0x66, 0xce, 0xa9, 0x50,
-	0xed, 0x28, 0xc2, 0x07, 0x02, 0x33, 0x0c, 0x28, 0xf4, 0x70, 0x8f, 0x9a, 0x57, 0xa5, 0x4d, 0x3e,
-	0x1b, 0x0f, 0x60, 0x11, 0x9f, 0xfa, 0xb8, 0xcd, 0xb1, 0x1b, 0xcf, 0x8f, 0xf6, 0xb9, 0x39, 0x2f,
-	0x17, 0xbf, 0x1c, 0x85, 0x56, 0x96, 0x19, 0x2d, 0xc4, 0xa0, 0x9a, 0xf3, 0xe3, 0x3e, 0x17, 0xce,
-	0xd1, 0x73, 0x4e, 0xed, 0x0c, 0x05, 0x5a, 0x18, 0x3a, 0x47, 0x36, 0x03, 0xd5, 0x7a, 0xce, 0xe9,
-	0xde, 0x39, 0x81, 0x7a, 0x04, 0x4b, 0x43, 0x2e, 0x3b, 0x71, 0xfc, 0xd8, 0x7b, 0x8d, 0xa1, 0xf7,
-	0x66, 0x12, 0xd0, 0xe2, 0x00, 0x16, 0x91, 0xab, 0xbd, 0xf7, 0x2e, 0xd4, 0x47, 0xd8, 0xb1, 0xf7,
-	0x2e, 0xca, 0x40, 0xaa, 0xa5, 0x3a, 0xc5, 0x1e, 0xfa, 0x2f, 0x30, 0x38, 0xe5, 0x4e, 0x37, 0xdd,
-	0xa3, 0x26, 0x7b, 0xcc, 0x4b, 0x4b, 0x92, 0x6d, 0x41, 0xe1, 0x58, 0x6c, 0xdf, 0xd2, 0x46, 0xfe,
-	0x56, 0x75, 0xbb, 0xaa, 0xfd, 0xf9, 0xb9, 0xd8, 0x32, 0x69, 0x68, 0x7e, 0x9d, 0x83, 0xf5, 0x84,
-	0xae, 0x38, 0xc7, 0x38, 0xd8, 0xc1, 0x3e, 0x65, 0x1e, 0x8f, 0xd3, 0x48, 0x0d, 0xa6, 0x93, 0x19,
-	0x44, 0x35, 0x12, 0x29, 0x62, 0x2a, 0x95, 0x22, 0x52, 0xb2, 0x9a, 0xbf, 0x50, 0x56, 0x0b, 0x13,
-	0x65, 0x75, 0x3a, 0x29, 0xab, 0xcd, 0xcf, 0x4a, 0xd0, 0x18, 0x37, 0x55, 0x2d, 0x85, 0xff, 0x1f,
-	0x23, 0x85, 0xad, 0xc5, 0x28, 0xb4, 0x46, 0x4d, 0x6f, 0xa1, 0x3e, 0x6e, 0x4f, 0xd4, 0xc7, 0x6c,
-	0x11, 0xbc, 0x3b, 0x59, 0x04, 0x2f, 0x85, 0xee, 0x35, 0x84, 0xee, 0xfd, 0x49, 0x42, 0xd7, 0x32,
-	0xa3, 0xd0, 0xca, 0xb4, 0x67, 0x4a, 0xe0, 0x7f, 0xb3, 0x25, 0xb0, 0x65, 0x44, 0xa1, 0x35, 0x62,
-	0x19, 0x91, 0xc5, 0x6b, 0x49, 0x59, 0x54, 0x5f, 0x42, 0xb4, 0xb5, 0x40, 0x6e, 0x4e, 0x10, 0xc8,
-	0x2c, 0x1d, 0xdc, 0x83, 0xe5, 0x51, 0xbe, 0xab, 0xc2, 0x46, 0x0a, 0x61, 0xa5, 0xb5, 0x16, 0x85,
-	0xd6, 0x38, 0x0a, 0x5a, 0x4a, 0x0f, 0xa8, 0x03, 0xae, 0xf9, 0x79, 0xee, 0x5c, 0x2c, 0x7e, 0xe8,
-	0xf1, 0x43, 0x37, 0x70, 0x4e, 0x26, 0xeb, 0x86, 0x09, 0xa5, 0x38, 0x32, 0xf5, 0xa1, 0x53, 0x37,
-	0x8d, 0xeb, 0x30, 0x73, 0xa2, 0x87, 0x90, 0xd2, 0xa0, 0x8e, 0x9e, 0xd5, 0x18, 0x4b, 0x2b, 0x43,
-	0x21, 0xa5, 0x0c, 0xdf, 0x94, 0xc0, 0x1a, 0x3b, 0x9b, 0x3f, 0x89, 0x34, 0x5c, 0x1e, 0x9d, 0x2e,
-	0x15, 0xe5, 0x0d, 0x29, 0xca, 0x6d, 0xa8, 0xca, 0x5d, 0xd0, 0x09, 0x5d, 0x1d, 0xb5, 0xae, 0x46,
-	0xa1, 0x95, 0x84, 0x11, 0x88, 0x86, 0x5a, 0xd7, 0xb8, 0x43, 0xda, 0xc2, 0xeb, 0x1e, 0xd2, 0x9a,
-	0x9f, 0x4e, 0xc1, 0xca, 0x30, 0x72, 0x1f, 0x52, 0x87, 0x3c, 0xf6, 0x31, 0x79, 0x73, 0x15, 0xec,
-	0x32, 0x94, 0xc4, 0xd7, 0x13, 0x93, 0xd5, 0x12, 0xd2, 0xf3, 0x88, 0x50, 0xc9, 0x91, 0xe2, 0x73,
-	0xfa, 0x7c, 0xf1, 0x99, 0x3a, 0xd9, 0x14, 0x2f, 0x3c, 0xd9, 0x94, 0x26, 0x9e, 0x6c, 0xca, 0x29,
-	0xfd, 0xfa, 0x19, 0x60, 0x35, 0x6b, 0x17, 0x2e, 0x0b, 0xbc, 0x4b, 0x95, 0xfa, 0x0b, 0xa9, 0xd4,
-	0x1b, 0x2d, 0x07, 0x09, 0x6c, 0x0c, 0x98, 0x6d, 0xda, 0xed, 0x3a, 0x1c, 0x07, 0x4e, 0xd7, 0xfb,
-	0x44, 0x79, 0x78, 0x20, 0x6e, 0x5a, 0xbf, 0x6e, 0x44, 0xa1, 0x75, 0x21, 0x17, 0x35, 0x62, 0xc6,
-	0xfd, 0x51, 0x02, 0x12, 0x57, 0x03, 0xc3, 0x7a, 0xc6, 0x18, 0xf1, 0xb9, 0x0a, 0xbb, 0xb2, 0x68,
-	0xac, 0xb4, 0xae, 0x47, 0xa1, 0x35, 0x99, 0x88, 0xd6, 0xce, 0xbf, 0x69, 0x27, 0x36, 0x8a, 0x2f,
-	0x37, 0xe8, 0xed, 0xe2, 0x7d, 0x6e, 0x7b, 0x8c, 0xf5, 0xb1, 0x2b, 0x4b, 0x48, 0xfd, 0xe5, 0xb2,
-	0xec, 0xc8, 0x88, 0xd1, 0x1d, 0xbc, 0xcf, 0x77, 0x25, 0x36, 0xbe, 0xbe, 0xad, 0xfd, 0xaa, 0xfa,
-	0xf6, 0xc9, 0xd8, 0xfa, 0x76, 0x69, 0x18, 0xbc, 0xd9, 0x8c, 0x31, 0xb5, 0x2f, 0x82, 0x65, 0x55,
-	0xfb, 0x52, 0x1f, 0x13, 0xbb, 0x4b, 0x9d, 0xa1, 0x34, 0xd5, 0xe5, 0x90, 0xf2, 0x28, 0x3b, 0x86,
-	0x82, 0x6a, 0xd2, 0x20, 0x94, 0x55, 0x28, 0xac, 0x1e, 0xb3, 0xf9, 0x6d, 0x6e, 0x54, 0x7b, 0xef,
-	0x77, 0x29, 0xc3, 0xbf, 0x3d, 0x05, 0x25, 0x52, 0x4d, 0x3e, 0x95, 0x6a, 0xd6, 0xa0, 0x12, 0x60,
-	0x5f, 0x28, 0x9f, 0xcf, 0x74, 0x16, 0x2a, 0x4b, 0x40, 0xa4, 0x88, 0x75, 0x00, 0x39, 0x69, 0x7a,
-	0x42, 0x70, 0xa0, 0xd3, 0x50, 0x45, 0x20, 0x8f, 0x05, 0x90, 0xc8, 0x20, 0xc5, 0x54, 0x06, 0xf9,
-	0x0a, 0x60, 0x2d, 0x73, 0x15, 0x97, 0x29, 0xe4, 0x32, 0x85, 0xfc, 0xa1, 0x7f, 0x23, 0x9c, 0x7c,
-	0x74, 0xfd, 0xdd, 0xd2, 0xc3, 0x0e, 0x18, 0xa3, 0x4c, 0x8f, 0xe8, 0x84, 0x50, 0x8f, 0x42, 0x2b,
-	0xc3, 0x8a, 0xe6, 0xd3, 0xc3, 0xec, 0x92, 0x71, 0xa2, 0x1f, 0xd7, 0xb0, 0xe4, 0x22, 0xd1, 0x1f,
-	0x10, 0xb3, 0x44, 0x3f, 0x2e, 0x69, 0xc9, 0x79, 0xd1, 0x17, 0xf2, 0xe1, 0x4d, 0x10, 0x7d, 0x65,
-	0x4f, 0x8b, 0x3e, 0x92, 0xd8, 0xdb, 0x2f, 0xfa, 0x0f, 0x60, 0x51, 0x29, 0xba, 0x12, 0xcd, 0xb4,
-	0xe0, 0xcb, 0x4f, 0x9c, 0x61, 0x46, 0x0b, 0x12, 0x14, 0x6b, 0x8c, 0xe3, 0xb6, 0xf9, 0xe5, 0x14,
-	0x54, 0x06, 0x51, 0x2a, 0x42, 0x26, 0xa1, 0xe9, 0x2a, 0x64, 0x24, 0x10, 0xff, 0x52, 0x91, 0x3a,
-	0xef, 0x2b, 0x6d, 0x4f, 0x9c, 0xf7, 0x57, 0xa1, 0x1c, 0x8b, 0x83, 0x96, 0xf7, 0x41, 0xdb, 0xf8,
-	0x27, 0x54, 0x06, 0x7f, 0xf0, 0x28, 0x81, 0x6f, 0xcd, 0x46, 0xa1, 0x35, 0x04, 0xd1, 0xf0, 0x51,
-	0xcc, 0x43, 0x4e, 0x55, 0x69, 0xbd, 0x9a, 0x87, 0x9a, 0xbb, 0xba, 0x19, 0x77, 0x60, 0x86, 0x75,
-	0x3d, 0xdf, 0x77, 0x3a, 0xaa, 0xb0, 0x28, 0xca, 0x85, 0xcf, 0x47, 0xa1, 0x95, 0xc2, 0x51, 0x35,
-	0x6e, 0x89, 0x34, 0xf2, 0x0f, 0xa8, 0xa8, 0x5d, 0x89, 0xff, 0x25, 0xcb, 0xab, 0x29, 0x0c, 0x40,
-	0x54, 0x96, 0x8f, 0x2d, 0x9f, 0x35, 0x0f, 0xa0, 0xf0, 0x9c, 0xf6, 0xc5, 0x82, 0x0b, 0x42, 0xca,
-	0xf4, 0x86, 0xc8, 0x48, 0x13, 0x6d, 0x24, 0xaf, 0xc2, 0xea, 0x3a, 0xdc, 0x51, 0x3b, 0xa1, 0xac,
-	0xa2, 0x8d, 0xe4, 0x55, 0x68, 0x59, 0xa2, 0xde, 0xd2, 0x5a, 0xa6, 0x0b, 0x47, 0x7d, 0x6f, 0x3d,
-	0xfc, 0xee, 0x65, 0x23, 0xf7, 0xe2, 0x65, 0x23, 0xf7, 0xe3, 0xcb, 0x46, 0xee, 0x8b, 0x57, 0x8d,
-	0x2b, 0x2f, 0x5e, 0x35, 0xae, 0xfc, 0xf0, 0xaa, 0x71, 0xe5, 0xa3, 0xed, 0x8e, 0xc7, 0xbb, 0xce,
-	0xfe, 0x66, 0x9b, 0xf6, 0xb6, 0xf8, 0x21, 0x0d, 0xda, 0x87, 0x8e, 0x47, 0xe4, 0x13, 0xa1, 0x2e,
-	0xde, 0x3a, 0xbe, 0xb3, 0x75, 0x9a, 0xc4, 0x85, 0xde, 0xee, 0x17, 0xe5, 0xdf, 0x9f, 0x77, 0x7e,
-	0x09, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x7e, 0x6b, 0x06, 0x37, 0x1d, 0x00, 0x00,
+	// 1695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
+	0x15, 0x8e, 0x22, 0xff, 0x90, 0x9e, 0x6c, 0xc7, 0x1e, 0xcb, 0x32, 0x6d, 0x27, 0xa2, 0x57, 0xdd,
+	0x45, 0x83, 0xfe, 0xb0, 0x17, 0xc9, 0xa2, 0x68, 0x81, 0xa2, 0x68, 0x14, 0xa3, 0x69, 0x8a, 0x0d,
+	0xb2, 0x61, 0xb6, 0x5b, 0xa0, 0x17, 0x62, 0x2c, 0x8e, 0x65, 0x62, 0xa5, 0x19, 0x9a, 0x33, 0x8a,
+	0xad, 0x5e, 0x7b, 0xec, 0xa5, 0x97, 0x1e, 0x7b, 0xe8, 0xad, 0xf7, 0x1e, 0xfa, 0x07, 0xf4, 0xd2,
+	0xe3, 0x1e, 0x7b, 0x22, 0x8a, 0xe4, 0xc6, 0xff, 0xa0, 0x3d, 0x15, 0xf3, 0x66, 0x28, 0x91, 0x32,
+	0xa5, 0x60, 0xdb, 0x06, 0xd8, 0x76, 0x7d, 0x21, 0x39, 0xdf, 0xfb, 0x66, 0x38, 0x9c, 0x79, 0xef,
+	0x7b, 0xf3, 0x24, 0x70, 0xd4, 0x38, 0x62, 0xf2, 0xf8, 0x62, 0xc4, 0xe2, 0xb1, 0x7f, 0x31, 0x12,
+	0x8a, 0xc9, 0xa3, 0x28, 0x16, 0x4a, 0x90, 0x65, 0xb4, 0xec, 0x37, 0xfb, 0xa2, 0x2f, 0x10, 0x39,
+	0xd6, 0x4f, 0xc6, 0xd8, 0xf9, 0x67, 0x15, 0x76, 0x5e, 0xe8, 0x3e, 0x2f, 0x74, 0x97, 0x97, 0x97,
+	0x34, 0xf2, 0xd8, 0xc5, 0x88, 0x49, 0x45, 0xee, 0x01, 0x9c, 0xc5, 0x62, 0xe8, 0x53, 0x29, 0x99,
+	0x72, 0x2a, 0x87, 0x95, 0xfb, 0x75, 0xaf, 0xae, 0x91, 0x47, 0x1a, 0x20, 0x7b, 0x50, 0x53, 0xc2,
+	0x1a, 0x6f, 0xa3, 0x71, 0x55, 0x09, 0x63, 0x6a, 0xc1, 0x0a, 0x1d, 0x8a, 0x11, 0x57, 0x4e, 0x15,
+	0x0d, 0xb6, 0x45, 0xbe, 0x0b, 0x44, 0xaa, 0x98, 0xd1, 0x61, 0xc8, 0xfb, 0x7e, 0xc8, 0x15, 0x8b,
+	0x5f, 0xd1, 0x81, 0xb3, 0x84, 0x9c, 0xad, 0x89, 0xe5, 0xa9, 0x35, 0x14, 0xe9, 0x17, 0x23, 0xca,
+	0x55, 0xa8, 0xc6, 0xce, 0xf2, 0x0c, 0xfd, 0x85, 0x35, 0x90, 0x43, 0x68, 0x04, 0x4c, 0xaa, 0x90,
+	0x53, 0x15, 0x0a, 0xee, 0xac, 0x20, 0x2f, 0x0f, 0x91, 0x6f, 0xc0, 0xba, 0x12, 0x03, 0x16, 0x53,
+	0xde, 0x63, 0xfe, 0x69, 0x24, 0x9d, 0x55, 0xe4, 0xac, 0x4d, 0xc0, 0x6e, 0x24, 0xc9, 0x07, 0xb0,
+	0x11, 0xb3, 0xb3, 0x11, 0x0f, 0x7c, 0x1a, 0x04, 0x31, 0x93, 0xd2, 0xa9, 0x21, 0x6b, 0xdd, 0xa0,
+	0x8f, 0x0c, 0x48, 0xee, 0x42, 0x9d, 0x9e, 0x9d, 0x85, 0x83, 0x90, 0x2a, 0xe6, 0xd4, 0x0f, 0xab,
+	0x7a, 0x71, 0x26, 0x80, 0x7e, 0xd3, 0xa4, 0x81, 0x6f, 0x02, 0x64, 0xac, 0x4d, 0x40, 0xfd, 0xa6,
+	0x16, 0xac, 0x9c, 0xb3, 0xb0, 0x7f, 0xae, 0x9c, 0x86, 0x59, 0x26, 0xd3, 0x22, 0xdf, 0x83, 0xdd,
+	0x41, 0x78, 0x31, 0x0a, 0x83, 0x50, 0x8d, 0xfd, 0xe2, 0x84, 0xd7, 0x90, 0xb8, 0x33, 0x31, 0x7f,
+	0x9a, 0x9f, 0xf9, 0x3e, 0xd4, 0xd8, 0x95, 0x62, 0x3c, 0x60, 0x81, 0xb3, 0x7e, 0x58, 0xb9, 0x5f,
+	0xf3, 0x26, 0x6d, 0xd2, 0x84, 0xe5, 0x58, 0x8c, 0x14, 0x73, 0x36, 0x70, 0x04, 0xd3, 0xe8, 0xfc,
+	0xbe, 0x0e, 0xad, 0xd9, 0xcd, 0x97, 0x91, 0xe0, 0x92, 0x91, 0x6f, 0xc2, 0x9d, 0x90, 0x9f, 0x8a,
+	0xfc, 0x3a, 0x18, 0x17, 0xd8, 0xb0, 0x70, 0xb6, 0x10, 0x3f, 0x82, 0x83, 0x8c, 0xd8, 0x13, 0xfc,
+	0x2c, 0x8c, 0x87, 0xb8, 0xd8, 0xfe, 0xe9, 0x40, 0xf4, 0x3e, 0x97, 0xe8, 0x1a, 0x55, 0x6f, 0xcf,
+	0x52, 0x1e, 0xe7, 0x18, 0x5d, 0x24, 0x90, 0x1f, 0xc3, 0xdd, 0xd2, 0xfe, 0x92, 0xf5, 0x04, 0x0f,
+	0x24, 0xba, 0x50, 0xd5, 0xdb, 0x2f, 0x19, 0xe0, 0xa5, 0x61, 0x90, 0x67, 0xb0, 0x23, 0x46, 0xca,
+	0x0c, 0x11, 0xb0, 0x01, 0x1d, 0x67, 0xef, 0xd6, 0x9e, 0x55, 0xed, 0xee, 0xa5, 0x89, 0x5b, 0x4e,
+	0xf0, 0xb6, 0x33, 0xf8, 0x44, 0xa3, 0x76, 0x42, 0x9f, 0x40, 0x6b, 0x86, 0x9d, 0x4d, 0x65, 0x19,
+	0xc7, 0xdb, 0x4f, 0x13, 0x77, 0x0e, 0xc3, 0x6b, 0x16, 0x06, 0xcc, 0x26, 0x78, 0x04, 0x4b, 0x67,
+	0x8c, 0x49, 0x74, 0xc9, 0xc6, 0x83, 0xcd, 0x23, 0x8c, 0xc7, 0x23, 0x5c, 0xf3, 0x9f, 0x30, 0x26,
+	0xbb, 0xb5, 0x34, 0x71, 0x91, 0xe1, 0xe1, 0x55, 0x3b, 0x06, 0xee, 0x4f, 0x6c, 0x1d, 0xd4, 0xb6,
+	0x48, 0x07, 0x56, 0xd8, 0x55, 0x14, 0xc6, 0x63, 0x74, 0xc9, 0x6a, 0x17, 0xd2, 0xc4, 0xb5, 0x88,
+	0x67, 0xef, 0xe4, 0x03, 0x58, 0xbd, 0xa4, 0x31, 0x0f, 0x79, 0xdf, 0xa9, 0xeb, 0xce, 0xdd, 0x46,
+	0x9a, 0xb8, 0x19, 0xe4, 0x65, 0x0f, 0xc4, 0x85, 0x65, 0xae, 0x25, 0xc2, 0x01, 0x24, 0xd5, 0xd3,
+	0xc4, 0x35, 0x80, 0x67, 0x6e, 0x3a, 0x0c, 0x82, 0x91, 0x54, 0xbe, 0x3a, 0x8f, 0x99, 0x3c, 0x17,
+	0x83, 0xc0, 0x3a, 0xe9, 0xba, 0x46, 0x3f, 0xcd, 0x40, 0xf2, 0x03, 0xd8, 0x8b, 0x59, 0x4f, 0x0c,
+	0x87, 0xe8, 0x66, 0xfe, 0x30, 0xe4, 0xbe, 0x09, 0x76, 0x3f, 0xe4, 0xd6, 0x5b, 0x5b, 0x39, 0xc2,
+	0xb3, 0x90, 0x3f, 0x42, 0xf3, 0x53, 0x4e, 0x3e, 0x84, 0x66, 0xbe, 0x6b, 0x9f, 0x4a, 0x3f, 0xd6,
+	0xc1, 0xb4, 0x8e, 0xbd, 0x48, 0xce, 0xf6, 0x84, 0x4a, 0x4f, 0x47, 0xd5, 0xfb, 0xb0, 0x91, 0xb1,
+	0xfc, 0x11, 0x0f, 0x95, 0xb4, 0xde, 0xbc, 0xd6, 0x37, 0x84, 0x9f, 0x6b, 0x8c, 0x10, 0x58, 0x1a,
+	0xb2, 0xa1, 0x70, 0xee, 0xa0, 0x0d, 0x9f, 0xc9, 0x13, 0xd8, 0x66, 0x57, 0x11, 0xeb, 0x29, 0x16,
+	0x64, 0xf3, 0x13, 0x23, 0xe5, 0x6c, 0xe2, 0xc7, 0xef, 0xa6, 0x89, 0x5b, 0x66, 0xf6, 0xb6, 0x32,
+	0xd0, 0xcc, 0xf9, 0xf9, 0x48, 0x69, 0xe7, 0x18, 0xd2, 0x2b, 0xbf, 0x44, 0x97, 0xb6, 0xa6, 0xce,
+	0x51, 0xce, 0xf0, 0x9a, 0x43, 0x7a, 0xf5, 0xf2, 0x9a, 0x6c, 0x3d, 0x83, 0x9d, 0x29, 0x57, 0x5e,
+	0xd2, 0x28, 0xf3, 0x5e, 0x32, 0xf5, 0xde, 0x52, 0x82, 0xb7, 0x3d, 0x81, 0x75, 0xe4, 0x5a, 0xef,
+	0xfd, 0x08, 0x5a, 0x33, 0xec, 0xcc, 0x7b, 0xb7, 0x31, 0x90, 0x9a, 0x85, 0x4e, 0x99, 0x87, 0x7e,
+	0x07, 0x88, 0x12, 0x8a, 0x0e, 0x8a, 0x3d, 0x9a, 0xd8, 0x63, 0x13, 0x2d, 0x79, 0xb6, 0x0b, 0x4b,
+	0xaf, 0xf4, 0xf2, 0xed, 0x1c, 0x56, 0xef, 0x37, 0x1e, 0x34, 0xac, 0x3f, 0x7f, 0xa6, 0x97, 0x0c,
+	0x0d, 0x53, 0xb5, 0x69, 0xa1, 0xec, 0x99, 0x86, 0xde, 0xf0, 0x28, 0x0e, 0xb5, 0x92, 0xc5, 0x8c,
+	0x7e, 0xce, 0x62, 0xdf, 0xaa, 0xdf, 0x2e, 0xbe, 0x86, 0xa0, 0xad, 0x6b, 0x4c, 0x3f, 0x45, 0x4b,
+	0xe7, 0x8f, 0x15, 0xb8, 0x97, 0xd3, 0x27, 0xfa, 0x8a, 0xc5, 0x27, 0x2c, 0x12, 0x32, 0x54, 0x59,
+	0x92, 0x6a, 0xc2, 0x72, 0x3e, 0x3f, 0x99, 0x46, 0x2e, 0x01, 0xdd, 0x2e, 0x24, 0xa0, 0x82, 0x68,
+	0x57, 0xdf, 0x2a, 0xda, 0x4b, 0x0b, 0x45, 0x7b, 0x39, 0x2f, 0xda, 0x9d, 0x3f, 0xad, 0x42, 0x7b,
+	0xde, 0x54, 0xad, 0xa4, 0xfe, 0x70, 0x8e, 0xa4, 0x76, 0xb7, 0xd3, 0xc4, 0x9d, 0x35, 0x7d, 0x05,
+	0x75, 0xf6, 0xc1, 0x42, 0x9d, 0x2d, 0x17, 0xd3, 0x8f, 0x16, 0x8b, 0xe9, 0x8d, 0x60, 0x7e, 0x09,
+	0xc1, 0xfc, 0xd9, 0x22, 0xc1, 0xec, 0x3a, 0x69, 0xe2, 0x96, 0xda, 0x4b, 0xa5, 0xf4, 0xfb, 0xe5,
+	0x52, 0xda, 0x25, 0x69, 0xe2, 0xce, 0x58, 0x66, 0xe4, 0xf5, 0x6e, 0x5e, 0x5e, 0xcd, 0x4e, 0xe8,
+	0xb6, 0x15, 0xda, 0xa3, 0x05, 0x42, 0x5b, 0xa6, 0xa7, 0x2f, 0x61, 0x77, 0x96, 0x1f, 0x98, 0xb0,
+	0x41, 0x41, 0xad, 0x77, 0x0f, 0xd2, 0xc4, 0x9d, 0x47, 0xf1, 0x76, 0x8a, 0x03, 0xda, 0x80, 0x23,
+	0xf7, 0x61, 0x33, 0x66, 0x74, 0x10, 0x4a, 0xdd, 0x23, 0x1a, 0x63, 0x2c, 0xa3, 0x9a, 0x7a, 0x1b,
+	0x19, 0xfe, 0x28, 0x1a, 0x77, 0x23, 0xd9, 0xf9, 0x4d, 0xe5, 0x5a, 0xd4, 0xfe, 0x22, 0x54, 0xe7,
+	0x41, 0x4c, 0x2f, 0x17, 0x2b, 0x8c, 0x03, 0xab, 0x59, 0x0c, 0xdb, 0xc3, 0xaf, 0x6d, 0x92, 0xf7,
+	0x60, 0xed, 0xd2, 0x0e, 0x81, 0x2f, 0x36, 0x47, 0xe0, 0x46, 0x86, 0x15, 0x35, 0x64, 0xa9, 0xa0,
+	0x21, 0x7f, 0x5e, 0x05, 0x77, 0xee, 0x6c, 0xfe, 0x4f, 0x44, 0xe4, 0xe6, 0xb0, 0x76, 0xa3, 0x3d,
+	0xef, 0x48, 0x7b, 0x3e, 0x84, 0x06, 0xae, 0x82, 0x4d, 0xfd, 0xe6, 0x70, 0x77, 0x27, 0x4d, 0xdc,
+	0x3c, 0xec, 0x81, 0x6e, 0x98, 0xef, 0x9a, 0x77, 0x2c, 0xdc, 0xfa, 0xb2, 0xc7, 0xc2, 0xce, 0xaf,
+	0x6f, 0xc3, 0xde, 0x34, 0x72, 0x3f, 0x16, 0x94, 0x3f, 0x8f, 0x18, 0x7f, 0x77, 0x95, 0xf4, 0x2e,
+	0xac, 0xea, 0xdd, 0xd3, 0x93, 0xb5, 0x12, 0x32, 0x0c, 0xb9, 0xd6, 0xd3, 0x99, 0x22, 0x78, 0xf9,
+	0x7a, 0x11, 0x5c, 0x38, 0x03, 0xad, 0xbc, 0xf5, 0x0c, 0xb4, 0xba, 0xf0, 0x0c, 0x54, 0x2b, 0xe8,
+	0xd7, 0x3f, 0x00, 0xf6, 0xcb, 0x56, 0xe1, 0xa6, 0xa4, 0xbc, 0x51, 0xa9, 0xaf, 0x91, 0x4a, 0xbd,
+	0xd3, 0x02, 0x94, 0xc3, 0xe1, 0x84, 0xd9, 0x13, 0x83, 0x01, 0x55, 0x2c, 0xa6, 0x83, 0xf0, 0x57,
+	0xc6, 0xc3, 0x63, 0x7d, 0xb3, 0xfa, 0xf5, 0x7e, 0x9a, 0xb8, 0x6f, 0xe5, 0x7a, 0xed, 0x8c, 0xf1,
+	0x78, 0x96, 0xe0, 0xe9, 0x2b, 0x61, 0x70, 0xaf, 0x64, 0x8c, 0xec, 0x04, 0xc6, 0x02, 0x3c, 0x58,
+	0xd5, 0xbb, 0xef, 0xa5, 0x89, 0xbb, 0x98, 0xe8, 0x1d, 0x5c, 0x7f, 0xd3, 0x49, 0x66, 0xd4, 0x3b,
+	0x37, 0xe9, 0x1d, 0xb0, 0x53, 0xe5, 0x87, 0x52, 0x8e, 0x58, 0x80, 0x45, 0xab, 0xdd, 0xb9, 0x32,
+	0xbb, 0x47, 0x32, 0xf4, 0x84, 0x9d, 0xaa, 0xa7, 0x88, 0xcd, 0xaf, 0xa8, 0x9b, 0xff, 0x56, 0x45,
+	0xfd, 0xc9, 0xdc, 0x8a, 0x7a, 0x67, 0x1a, 0xbc, 0xe5, 0x8c, 0x39, 0xd5, 0xb6, 0x07, 0xbb, 0xa6,
+	0xda, 0x16, 0x11, 0xe3, 0xfe, 0x40, 0xd0, 0xa9, 0x34, 0xb5, 0x70, 0x48, 0x3c, 0xf4, 0xce, 0xa1,
+	0x78, 0x4d, 0x34, 0x68, 0x65, 0xd5, 0x0a, 0x6b, 0xc7, 0xec, 0xfc, 0xa5, 0x32, 0xab, 0xbd, 0x8f,
+	0x07, 0x42, 0xb2, 0xff, 0x3c, 0x05, 0xe5, 0x52, 0x4d, 0xb5, 0x90, 0x6a, 0x0e, 0xa0, 0x1e, 0xb3,
+	0x88, 0x8e, 0x6d, 0xa9, 0xac, 0x4d, 0x35, 0x04, 0x74, 0x8a, 0xb8, 0x07, 0x80, 0x93, 0x16, 0x97,
+	0x9c, 0xc5, 0x36, 0x0d, 0xd5, 0x35, 0xf2, 0x5c, 0x03, 0xb9, 0x0c, 0xb2, 0x52, 0xc8, 0x20, 0x7f,
+	0x00, 0x38, 0x28, 0xfd, 0x8a, 0x9b, 0x14, 0x72, 0x93, 0x42, 0xfe, 0xa7, 0x7f, 0x95, 0x5c, 0x7c,
+	0x74, 0xfd, 0xaf, 0xa5, 0x87, 0x13, 0x20, 0xb3, 0xcc, 0x90, 0xdb, 0x84, 0xd0, 0x4a, 0x13, 0xb7,
+	0xc4, 0xea, 0x6d, 0x16, 0x87, 0x79, 0xca, 0xe7, 0x89, 0x7e, 0x56, 0xc3, 0xf2, 0xb7, 0x89, 0xfe,
+	0x84, 0x58, 0x26, 0xfa, 0x59, 0x49, 0xcb, 0xaf, 0x8b, 0xbe, 0x96, 0x8f, 0x70, 0x81, 0xe8, 0x1b,
+	0x7b, 0x51, 0xf4, 0x3d, 0xc4, 0xbe, 0xfa, 0xa2, 0xff, 0x04, 0xb6, 0x8d, 0xa2, 0x1b, 0xd1, 0x2c,
+	0x0a, 0x3e, 0x6e, 0x71, 0x89, 0xd9, 0xdb, 0x42, 0x50, 0x7f, 0x63, 0x16, 0xb7, 0x9d, 0xdf, 0xdd,
+	0x86, 0xfa, 0x24, 0x4a, 0x75, 0xc8, 0xe4, 0x34, 0xdd, 0x84, 0x0c, 0x02, 0xd9, 0x2f, 0x15, 0x85,
+	0xf3, 0xbe, 0xd1, 0xf6, 0xdc, 0x79, 0x7f, 0x1f, 0x6a, 0x99, 0x38, 0x58, 0x79, 0x9f, 0xb4, 0xc9,
+	0xb7, 0xa1, 0x3e, 0xf9, 0xa3, 0xc9, 0x08, 0x7c, 0x77, 0x3d, 0x4d, 0xdc, 0x29, 0xe8, 0x4d, 0x1f,
+	0xf5, 0x3c, 0x70, 0xaa, 0x46, 0xeb, 0xcd, 0x3c, 0xcc, 0xdc, 0xcd, 0x8d, 0x3c, 0x84, 0x35, 0x39,
+	0x08, 0xa3, 0x88, 0xf6, 0x4d, 0x61, 0xb1, 0x82, 0x1f, 0xbe, 0x99, 0x26, 0x6e, 0x01, 0xf7, 0x1a,
+	0x59, 0x4b, 0xa7, 0x91, 0x6f, 0x41, 0xdd, 0xac, 0x4a, 0xf6, 0x6f, 0x5d, 0xd5, 0x4c, 0x61, 0x02,
+	0x7a, 0x35, 0x7c, 0xec, 0x46, 0xb2, 0x73, 0x06, 0x4b, 0x9f, 0x89, 0x91, 0xfe, 0xe0, 0x25, 0x2d,
+	0x65, 0x76, 0x41, 0x30, 0xd2, 0x74, 0xdb, 0xc3, 0xab, 0xb6, 0x06, 0x54, 0x51, 0xb3, 0x12, 0xc6,
+	0xaa, 0xdb, 0x1e, 0x5e, 0xb5, 0x96, 0xe5, 0xea, 0x2d, 0xab, 0x65, 0xb6, 0x70, 0xb4, 0xf7, 0xee,
+	0xc7, 0x7f, 0x7d, 0xdd, 0xae, 0x7c, 0xf1, 0xba, 0x5d, 0xf9, 0xfb, 0xeb, 0x76, 0xe5, 0xb7, 0x6f,
+	0xda, 0xb7, 0xbe, 0x78, 0xd3, 0xbe, 0xf5, 0xb7, 0x37, 0xed, 0x5b, 0xbf, 0x7c, 0xd0, 0x0f, 0xd5,
+	0x80, 0x9e, 0x1e, 0xf5, 0xc4, 0xf0, 0x58, 0x9d, 0x8b, 0xb8, 0x77, 0x4e, 0x43, 0x8e, 0x4f, 0x5c,
+	0x04, 0xec, 0xf8, 0xd5, 0xc3, 0xe3, 0xab, 0x3c, 0xae, 0xf5, 0xf6, 0x74, 0x05, 0xff, 0x86, 0x7d,
+	0xf8, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x4b, 0x80, 0x19, 0xbf, 0x1d, 0x00, 0x00,
 }
 
 func (m *QueryQuoteSwapRequest) Marshal() (dAtA []byte, err error) {
@@ -1862,6 +1901,13 @@ func (m *QueryQuoteSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Extended {
 		i--
 		if m.Extended {
@@ -1983,6 +2029,24 @@ func (m *QueryQuoteSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.PriceBreakerHeight != 0 {
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(m.PriceBreakerHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Route[iNdEx])
+			copy(dAtA[i:], m.Route[iNdEx])
+			i = encodeVarintQueryQuotes(dAtA, i, uint64(len(m.Route[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if len(m.Vout) > 0 {
 		for iNdEx := len(m.Vout) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2221,6 +2285,13 @@ func (m *QueryQuoteSaverDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.RealisedApyBps != 0 {
+		i = encodeVarintQueryQuotes(dAtA, i, uint64(m.RealisedApyBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.ExpectedAmountDeposit) > 0 {
 		i -= len(m.ExpectedAmountDeposit)
 		copy(dAtA[i:], m.ExpectedAmountDeposit)
@@ -3224,6 +3295,10 @@ func (m *QueryQuoteSwapRequest) Size() (n int) {
 	if m.Extended {
 		n += 2
 	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQueryQuotes(uint64(l))
+	}
 	return n
 }
 
@@ -3310,6 +3385,15 @@ func (m *QueryQuoteSwapResponse) Size() (n int) {
 			n += 2 + l + sovQueryQuotes(uint64(l))
 		}
 	}
+	if len(m.Route) > 0 {
+		for _, s := range m.Route {
+			l = len(s)
+			n += 2 + l + sovQueryQuotes(uint64(l))
+		}
+	}
+	if m.PriceBreakerHeight != 0 {
+		n += 2 + sovQueryQuotes(uint64(m.PriceBreakerHeight))
+	}
 	return n
 }
 
@@ -3415,6 +3499,9 @@ func (m *QueryQuoteSaverDepositResponse) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovQueryQuotes(uint64(l))
 	}
+	if m.RealisedApyBps != 0 {
+		n += 2 + sovQueryQuotes(uint64(m.RealisedApyBps))
+	}
 	return n
 }
 
@@ -4265,6 +4352,38 @@ func (m *QueryQuoteSwapRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Extended = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
@@ -4876,6 +4995,57 @@ func (m *QueryQuoteSwapResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryQuotes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = append(m.Route, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBreakerHeight", wireType)
+			}
+			m.PriceBreakerHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceBreakerHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
@@ -5619,6 +5789,25 @@ func (m *QueryQuoteSaverDepositResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ExpectedAmountDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealisedApyBps", wireType)
+			}
+			m.RealisedApyBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryQuotes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RealisedApyBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueryQuotes(dAtA[iNdEx:])
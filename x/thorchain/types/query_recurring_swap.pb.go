@@ -0,0 +1,1883 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/query_recurring_swap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryRecurringSwapRequest struct {
+	TxId   string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryRecurringSwapRequest) Reset()         { *m = QueryRecurringSwapRequest{} }
+func (m *QueryRecurringSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringSwapRequest) ProtoMessage()    {}
+func (*QueryRecurringSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b087f7ece1e1ebb, []int{0}
+}
+func (m *QueryRecurringSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringSwapRequest.Merge(m, src)
+}
+func (m *QueryRecurringSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringSwapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringSwapRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringSwapRequest) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type QueryRecurringSwapResponse struct {
+	// the hash of the transaction that created the recurring swap
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id"`
+	// thor address of the trade account funding the recurring swap
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner"`
+	// the trade asset to be swapped from
+	SourceAsset string `protobuf:"bytes,3,opt,name=source_asset,json=sourceAsset,proto3" json:"source_asset"`
+	// the asset to be swapped to
+	TargetAsset string `protobuf:"bytes,4,opt,name=target_asset,json=targetAsset,proto3" json:"target_asset"`
+	// the destination address to receive the swap output
+	Destination string `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination"`
+	// the amount of the source asset swapped each interval
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	// how often each swap is made, in blocks
+	Interval int64 `protobuf:"varint,7,opt,name=interval,proto3" json:"interval"`
+	// the total number of swaps to make
+	Quantity int64 `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity"`
+	// the number of swaps made so far
+	Count int64 `protobuf:"varint,9,opt,name=count,proto3" json:"count"`
+	// the block height of the next swap
+	NextHeight int64 `protobuf:"varint,10,opt,name=next_height,json=nextHeight,proto3" json:"next_height"`
+	// the swaps made so far
+	Fills []*QueryRecurringSwapFill `protobuf:"bytes,11,rep,name=fills,proto3" json:"fills,omitempty"`
+}
+
+func (m *QueryRecurringSwapResponse) Reset()         { *m = QueryRecurringSwapResponse{} }
+func (m *QueryRecurringSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringSwapResponse) ProtoMessage()    {}
+func (*QueryRecurringSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b087f7ece1e1ebb, []int{1}
+}
+func (m *QueryRecurringSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringSwapResponse.Merge(m, src)
+}
+func (m *QueryRecurringSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringSwapResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringSwapResponse) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapResponse) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapResponse) GetSourceAsset() string {
+	if m != nil {
+		return m.SourceAsset
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapResponse) GetTargetAsset() string {
+	if m != nil {
+		return m.TargetAsset
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapResponse) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapResponse) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapResponse) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *QueryRecurringSwapResponse) GetQuantity() int64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *QueryRecurringSwapResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueryRecurringSwapResponse) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+func (m *QueryRecurringSwapResponse) GetFills() []*QueryRecurringSwapFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+type QueryRecurringSwapFill struct {
+	// the hash of the swap
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id"`
+	// the index of the swap within the recurring swap
+	Index int64 `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+	// the block height the swap was queued
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height"`
+	// the amount of the source asset swapped
+	In string `protobuf:"bytes,4,opt,name=in,proto3" json:"in"`
+	// the amount of the target asset received
+	Out string `protobuf:"bytes,5,opt,name=out,proto3" json:"out"`
+	// the status of the swap (pending, filled or failed)
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	// the reason the swap failed
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryRecurringSwapFill) Reset()         { *m = QueryRecurringSwapFill{} }
+func (m *QueryRecurringSwapFill) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringSwapFill) ProtoMessage()    {}
+func (*QueryRecurringSwapFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b087f7ece1e1ebb, []int{2}
+}
+func (m *QueryRecurringSwapFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringSwapFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringSwapFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringSwapFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringSwapFill.Merge(m, src)
+}
+func (m *QueryRecurringSwapFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringSwapFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringSwapFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringSwapFill proto.InternalMessageInfo
+
+func (m *QueryRecurringSwapFill) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapFill) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *QueryRecurringSwapFill) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryRecurringSwapFill) GetIn() string {
+	if m != nil {
+		return m.In
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapFill) GetOut() string {
+	if m != nil {
+		return m.Out
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapFill) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapFill) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type QueryRecurringSwapsRequest struct {
+	Owner  string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Height string `protobuf:"bytes,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryRecurringSwapsRequest) Reset()         { *m = QueryRecurringSwapsRequest{} }
+func (m *QueryRecurringSwapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringSwapsRequest) ProtoMessage()    {}
+func (*QueryRecurringSwapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b087f7ece1e1ebb, []int{3}
+}
+func (m *QueryRecurringSwapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringSwapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringSwapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringSwapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringSwapsRequest.Merge(m, src)
+}
+func (m *QueryRecurringSwapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringSwapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringSwapsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringSwapsRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringSwapsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryRecurringSwapsRequest) GetHeight() string {
+	if m != nil {
+		return m.Height
+	}
+	return ""
+}
+
+type QueryRecurringSwapsResponse struct {
+	RecurringSwaps []*QueryRecurringSwapResponse `protobuf:"bytes,1,rep,name=recurring_swaps,json=recurringSwaps,proto3" json:"recurring_swaps,omitempty"`
+}
+
+func (m *QueryRecurringSwapsResponse) Reset()         { *m = QueryRecurringSwapsResponse{} }
+func (m *QueryRecurringSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringSwapsResponse) ProtoMessage()    {}
+func (*QueryRecurringSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b087f7ece1e1ebb, []int{4}
+}
+func (m *QueryRecurringSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringSwapsResponse.Merge(m, src)
+}
+func (m *QueryRecurringSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringSwapsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringSwapsResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringSwapsResponse) GetRecurringSwaps() []*QueryRecurringSwapResponse {
+	if m != nil {
+		return m.RecurringSwaps
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRecurringSwapRequest)(nil), "types.QueryRecurringSwapRequest")
+	proto.RegisterType((*QueryRecurringSwapResponse)(nil), "types.QueryRecurringSwapResponse")
+	proto.RegisterType((*QueryRecurringSwapFill)(nil), "types.QueryRecurringSwapFill")
+	proto.RegisterType((*QueryRecurringSwapsRequest)(nil), "types.QueryRecurringSwapsRequest")
+	proto.RegisterType((*QueryRecurringSwapsResponse)(nil), "types.QueryRecurringSwapsResponse")
+}
+
+func init() { proto.RegisterFile("types/query_recurring_swap.proto", fileDescriptor_7b087f7ece1e1ebb) }
+
+var fileDescriptor_7b087f7ece1e1ebb = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0xda, 0x4c,
+	0x10, 0x8e, 0x71, 0x20, 0x61, 0x1d, 0xfd, 0xf9, 0xe5, 0x46, 0xc8, 0x49, 0x55, 0x9b, 0x72, 0xe2,
+	0x04, 0x6d, 0x78, 0x82, 0x72, 0xa8, 0xd2, 0xa8, 0x97, 0x6e, 0x6f, 0xbd, 0xa0, 0x0d, 0x6c, 0xcd,
+	0x4a, 0xce, 0x2e, 0xec, 0xae, 0x03, 0xbc, 0x45, 0x1f, 0xab, 0xc7, 0x1c, 0x7b, 0xb2, 0x2a, 0xb8,
+	0x59, 0x7d, 0x82, 0x9e, 0xaa, 0x9d, 0x5d, 0x88, 0x51, 0x89, 0xd4, 0x0b, 0x33, 0xf3, 0xf9, 0xfb,
+	0xc6, 0xde, 0xfd, 0x66, 0x40, 0x6d, 0xbd, 0x9a, 0x51, 0xd5, 0x9f, 0xe7, 0x54, 0xae, 0x46, 0x92,
+	0x8e, 0x73, 0x29, 0x19, 0x4f, 0x47, 0x6a, 0x41, 0x66, 0xbd, 0x99, 0x14, 0x5a, 0x84, 0x75, 0x60,
+	0x5c, 0x5d, 0xa4, 0x22, 0x15, 0x80, 0xf4, 0x4d, 0x66, 0x1f, 0x76, 0x6e, 0xd0, 0xe5, 0x27, 0x23,
+	0xc5, 0x5b, 0xe5, 0xe7, 0x05, 0x99, 0x61, 0x3a, 0xcf, 0xa9, 0xd2, 0xe1, 0x0b, 0x54, 0xd7, 0xcb,
+	0x11, 0x9b, 0x44, 0x5e, 0xdb, 0xeb, 0x36, 0xf1, 0xb1, 0x5e, 0x7e, 0x98, 0x84, 0x2d, 0xd4, 0x98,
+	0x52, 0x96, 0x4e, 0x75, 0x54, 0x03, 0xd4, 0x55, 0x9d, 0xdf, 0x3e, 0xba, 0x3a, 0xd4, 0x4a, 0xcd,
+	0x04, 0x57, 0x34, 0x8c, 0xf7, 0x7a, 0x0d, 0x9b, 0x65, 0x91, 0x58, 0xc0, 0xb5, 0x4d, 0x50, 0x5d,
+	0x2c, 0x38, 0x95, 0xb6, 0xab, 0x7d, 0x0e, 0x00, 0xb6, 0x21, 0x1c, 0xa0, 0x33, 0x25, 0x72, 0x39,
+	0xa6, 0x23, 0xa2, 0x14, 0xd5, 0x91, 0x0f, 0xbc, 0xff, 0xcb, 0x22, 0xd9, 0xc3, 0x71, 0x60, 0xab,
+	0x77, 0xa6, 0x30, 0x22, 0x4d, 0x64, 0x4a, 0xb5, 0x13, 0x1d, 0x3f, 0x89, 0xaa, 0x38, 0x0e, 0x6c,
+	0x65, 0x45, 0x6f, 0x51, 0x30, 0xa1, 0x4a, 0x33, 0x4e, 0x34, 0x13, 0x3c, 0xaa, 0x83, 0xe6, 0xbc,
+	0x2c, 0x92, 0x2a, 0x8c, 0xab, 0x45, 0xd8, 0x41, 0x0d, 0x72, 0x2f, 0x72, 0xae, 0xa3, 0x06, 0xb0,
+	0x51, 0x59, 0x24, 0x0e, 0xc1, 0x2e, 0x86, 0x5d, 0x74, 0xca, 0xb8, 0xa6, 0xf2, 0x81, 0x64, 0xd1,
+	0x49, 0xdb, 0xeb, 0xfa, 0xc3, 0xb3, 0xb2, 0x48, 0x76, 0x18, 0xde, 0x65, 0x86, 0x39, 0xcf, 0x09,
+	0xd7, 0x4c, 0xaf, 0xa2, 0xd3, 0x27, 0xe6, 0x16, 0xc3, 0xbb, 0xcc, 0xdc, 0xda, 0x18, 0x5e, 0xdb,
+	0x04, 0x1a, 0xdc, 0x1a, 0x00, 0xd8, 0x86, 0xf0, 0x0d, 0x0a, 0x38, 0x5d, 0xea, 0x91, 0xb3, 0x0c,
+	0x01, 0x0d, 0xce, 0x52, 0x81, 0x31, 0x32, 0xc5, 0x0d, 0xe4, 0xe1, 0x00, 0xd5, 0xbf, 0xb2, 0x2c,
+	0x53, 0x51, 0xd0, 0xf6, 0xbb, 0xc1, 0xf5, 0xab, 0x1e, 0x8c, 0x4f, 0xef, 0x6f, 0x6b, 0xdf, 0xb3,
+	0x2c, 0xc3, 0x96, 0xdb, 0xf9, 0xe5, 0xa1, 0xd6, 0x61, 0xc6, 0xbf, 0x18, 0xcf, 0xf8, 0x84, 0x2e,
+	0xc1, 0x78, 0x77, 0x04, 0x00, 0xb0, 0x0d, 0xe6, 0x6e, 0xdd, 0xd7, 0xfb, 0xc0, 0x80, 0xbb, 0x75,
+	0x1f, 0xee, 0x62, 0xd8, 0x42, 0x35, 0xc6, 0x9d, 0xbb, 0x8d, 0xb2, 0x48, 0x6a, 0x8c, 0xe3, 0x1a,
+	0xe3, 0xe1, 0x25, 0xf2, 0x45, 0xae, 0x9d, 0x85, 0x27, 0x65, 0x91, 0x98, 0x12, 0x9b, 0x1f, 0xd3,
+	0x56, 0x69, 0xa2, 0x73, 0x55, 0xb5, 0xcc, 0x22, 0xd8, 0x45, 0x33, 0xeb, 0x92, 0x12, 0x25, 0x38,
+	0x18, 0xd6, 0xc4, 0xae, 0xea, 0xdc, 0x1e, 0x1a, 0x75, 0xb5, 0x5d, 0x9b, 0x8b, 0xed, 0x28, 0xdb,
+	0xb5, 0x71, 0xf3, 0xfb, 0xdc, 0xde, 0x30, 0xf4, 0xf2, 0x60, 0x2f, 0xb7, 0x37, 0xb7, 0xe8, 0x7c,
+	0x7f, 0xab, 0x55, 0xe4, 0x81, 0x31, 0xaf, 0x9f, 0x35, 0x66, 0xab, 0xc5, 0xff, 0xc9, 0xbd, 0x9e,
+	0xc3, 0x8f, 0xdf, 0xd7, 0xb1, 0xf7, 0xb8, 0x8e, 0xbd, 0x9f, 0xeb, 0xd8, 0xfb, 0xb6, 0x89, 0x8f,
+	0x1e, 0x37, 0xf1, 0xd1, 0x8f, 0x4d, 0x7c, 0xf4, 0xe5, 0x3a, 0x65, 0x3a, 0x23, 0x77, 0xbd, 0xb1,
+	0xb8, 0xef, 0xeb, 0xa9, 0x90, 0xe3, 0x29, 0x61, 0x1c, 0x32, 0x2e, 0x26, 0xb4, 0xff, 0x30, 0xe8,
+	0x2f, 0xab, 0xb8, 0x79, 0xf1, 0x5d, 0x03, 0xfe, 0x41, 0x06, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0xd7, 0x93, 0xb7, 0x13, 0x82, 0x04, 0x00, 0x00,
+}
+
+func (m *QueryRecurringSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Count != 0 {
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetAsset) > 0 {
+		i -= len(m.TargetAsset)
+		copy(dAtA[i:], m.TargetAsset)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.TargetAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceAsset) > 0 {
+		i -= len(m.SourceAsset)
+		copy(dAtA[i:], m.SourceAsset)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.SourceAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringSwapFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringSwapFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringSwapFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Out) > 0 {
+		i -= len(m.Out)
+		copy(dAtA[i:], m.Out)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Out)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.In) > 0 {
+		i -= len(m.In)
+		copy(dAtA[i:], m.In)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.In)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringSwapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringSwapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringSwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Height) > 0 {
+		i -= len(m.Height)
+		copy(dAtA[i:], m.Height)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Height)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecurringSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecurringSwaps) > 0 {
+		for iNdEx := len(m.RecurringSwaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringSwaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryRecurringSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryRecurringSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryRecurringSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRecurringSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.SourceAsset)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.TargetAsset)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQueryRecurringSwap(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovQueryRecurringSwap(uint64(m.Quantity))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQueryRecurringSwap(uint64(m.Count))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQueryRecurringSwap(uint64(m.NextHeight))
+	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovQueryRecurringSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecurringSwapFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQueryRecurringSwap(uint64(m.Index))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQueryRecurringSwap(uint64(m.Height))
+	}
+	l = len(m.In)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Out)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringSwapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	l = len(m.Height)
+	if l > 0 {
+		n += 1 + l + sovQueryRecurringSwap(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecurringSwaps) > 0 {
+		for _, e := range m.RecurringSwaps {
+			l = e.Size()
+			n += 1 + l + sovQueryRecurringSwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQueryRecurringSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryRecurringSwap(x uint64) (n int) {
+	return sovQueryRecurringSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRecurringSwapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringSwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, &QueryRecurringSwapFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringSwapFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringSwapFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringSwapFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.In = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Out = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringSwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringSwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringSwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Height = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringSwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringSwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringSwaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringSwaps = append(m.RecurringSwaps, &QueryRecurringSwapResponse{})
+			if err := m.RecurringSwaps[len(m.RecurringSwaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQueryRecurringSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQueryRecurringSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQueryRecurringSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQueryRecurringSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQueryRecurringSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQueryRecurringSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQueryRecurringSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQueryRecurringSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQueryRecurringSwap = fmt.Errorf("proto: unexpected end of group")
+)
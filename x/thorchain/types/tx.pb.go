@@ -73,63 +73,64 @@ func init() {
 func init() { proto.RegisterFile("types/tx.proto", fileDescriptor_991dda0e66fd389c) }
 
 var fileDescriptor_991dda0e66fd389c = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x4e, 0x54, 0x05, 0xc2, 0xb4, 0x4d, 0xca, 0x34, 0x6d, 0x5a, 0x23, 0x4c, 0x5b, 0xf1, 0xd3,
-	0xf0, 0xb3, 0x26, 0x89, 0x10, 0x42, 0x15, 0x42, 0x49, 0x48, 0xa5, 0xa8, 0xdd, 0x26, 0xd4, 0xdb,
-	0x4a, 0x20, 0x24, 0x6b, 0xb2, 0x3e, 0x71, 0x86, 0xda, 0x1e, 0x77, 0x66, 0xec, 0xec, 0xde, 0xf1,
-	0x08, 0x3c, 0x02, 0x8f, 0xc0, 0x63, 0x70, 0xd9, 0x4b, 0x2e, 0x51, 0x72, 0xc1, 0x6b, 0x20, 0xff,
-	0xce, 0x38, 0x33, 0x69, 0xf6, 0xc6, 0xbb, 0xfe, 0x7e, 0xce, 0x99, 0x33, 0x73, 0xce, 0xc8, 0x68,
-	0x49, 0x4e, 0x33, 0x10, 0x9e, 0x9c, 0x0c, 0x32, 0xce, 0x24, 0xc3, 0x0b, 0xd5, 0xbb, 0xb3, 0x3a,
-	0x66, 0x22, 0x61, 0xc2, 0x4b, 0x44, 0xe4, 0x15, 0xeb, 0xe5, 0x4f, 0xcd, 0x3b, 0x37, 0x6b, 0x7d,
-	0x22, 0xa2, 0xe0, 0x90, 0xa4, 0x0d, 0xb8, 0xaa, 0xc0, 0x10, 0x32, 0x26, 0xa8, 0x6c, 0x88, 0xdb,
-	0x8a, 0x00, 0xce, 0x89, 0x24, 0x0d, 0x7e, 0x4b, 0xe1, 0x09, 0x4d, 0x28, 0x6f, 0xe0, 0x0f, 0x14,
-	0x9c, 0x82, 0x3c, 0x61, 0xfc, 0x55, 0x70, 0x04, 0xd0, 0x90, 0xf7, 0x34, 0x92, 0x85, 0x10, 0x64,
-	0x24, 0x17, 0x10, 0x8c, 0x8f, 0x09, 0x6d, 0x97, 0xf1, 0xa1, 0x52, 0xb0, 0x43, 0x01, 0xbc, 0x80,
-	0x30, 0x90, 0x93, 0x8e, 0x76, 0xad, 0x34, 0xcb, 0xdb, 0xc5, 0x3e, 0xb0, 0xf2, 0xc1, 0xeb, 0x9c,
-	0xf1, 0x3c, 0x69, 0x34, 0x2b, 0x4a, 0x23, 0x20, 0x0d, 0xcd, 0xc8, 0x02, 0x64, 0x40, 0xb3, 0x80,
-	0x84, 0x21, 0x07, 0x21, 0xcc, 0x85, 0x95, 0x7c, 0xb5, 0xfc, 0x57, 0x30, 0x6d, 0xe9, 0x3b, 0x1a,
-	0xcd, 0xe2, 0x02, 0xd2, 0xf1, 0xd4, 0xac, 0x59, 0x0a, 0x51, 0x79, 0x68, 0x94, 0x06, 0x47, 0x84,
-	0xc6, 0xa6, 0xb7, 0x54, 0x64, 0x8c, 0xc5, 0xe6, 0xa1, 0x14, 0xc0, 0x05, 0x65, 0x96, 0xd3, 0xca,
-	0xb3, 0x88, 0x93, 0x10, 0x2c, 0xa7, 0x42, 0x68, 0xda, 0xee, 0xcb, 0x7d, 0x0d, 0x66, 0x21, 0x3d,
-	0x9a, 0x06, 0x31, 0x4d, 0xa8, 0x0c, 0xc4, 0x09, 0xc9, 0x1a, 0xc9, 0xdd, 0xb2, 0x5d, 0x4e, 0x88,
-	0x48, 0xbc, 0xea, 0x51, 0xac, 0x77, 0x0d, 0xf5, 0x00, 0xa1, 0xc5, 0xa1, 0x88, 0x76, 0x93, 0x4c,
-	0x4e, 0x37, 0xfe, 0xbc, 0x81, 0xae, 0x0c, 0x45, 0x84, 0x3f, 0x41, 0x57, 0xb6, 0x49, 0x8a, 0xaf,
-	0x0f, 0xaa, 0xc8, 0x83, 0xa1, 0x88, 0xb6, 0x49, 0xea, 0x2c, 0xab, 0xd7, 0x4a, 0x8e, 0xbf, 0x42,
-	0xef, 0xfe, 0x58, 0xb7, 0x13, 0x7e, 0x5f, 0x71, 0x0d, 0x64, 0xca, 0x3d, 0xb4, 0xb8, 0x5b, 0x35,
-	0xd9, 0x68, 0x82, 0xb1, 0x46, 0x36, 0x98, 0x69, 0x78, 0x84, 0x96, 0x5a, 0xf2, 0xa7, 0xea, 0x90,
-	0xf1, 0x1d, 0xd3, 0x56, 0x33, 0xa6, 0x79, 0x0d, 0x2d, 0x0c, 0xcb, 0xd6, 0xc5, 0x1a, 0x53, 0x01,
-	0xa6, 0xf4, 0x7b, 0xb4, 0x3c, 0xac, 0x36, 0xee, 0x69, 0xb9, 0x6f, 0xfe, 0x09, 0xc9, 0xf0, 0x5d,
-	0xcd, 0xd4, 0xa7, 0x4c, 0xfb, 0x26, 0x42, 0xcf, 0xea, 0x69, 0x78, 0x0c, 0x80, 0x57, 0x14, 0xad,
-	0x50, 0xd3, 0xf4, 0x03, 0xba, 0xa1, 0xe8, 0xa6, 0x3a, 0xc7, 0x66, 0xbd, 0xa8, 0xbe, 0x47, 0x68,
-	0xe9, 0x19, 0x0b, 0xe1, 0xa0, 0x9c, 0xb2, 0x9d, 0x72, 0xc8, 0xf4, 0xcd, 0xe9, 0x33, 0xa6, 0xf9,
-	0x5b, 0x74, 0x6d, 0xbf, 0x19, 0xa1, 0xd1, 0x64, 0x2f, 0xc5, 0xb7, 0x95, 0x40, 0xc7, 0x4d, 0xe3,
-	0x77, 0xe8, 0xba, 0x12, 0xec, 0xe7, 0x12, 0xaf, 0xda, 0x9c, 0xfb, 0xb9, 0xb4, 0x56, 0xac, 0x14,
-	0x66, 0xc5, 0xe7, 0x39, 0x33, 0xc0, 0x17, 0x68, 0x71, 0x74, 0xcc, 0xb8, 0x0f, 0x69, 0x88, 0x97,
-	0x14, 0x59, 0xbe, 0x5b, 0x2b, 0xf4, 0x41, 0xee, 0x1d, 0x6c, 0xd5, 0x83, 0xae, 0x57, 0xa8, 0xe3,
-	0xa6, 0xf1, 0x1b, 0x74, 0xd5, 0x07, 0x59, 0x6e, 0xe0, 0x13, 0x98, 0x0a, 0x7c, 0xab, 0xe7, 0x6b,
-	0x61, 0x6b, 0x73, 0xfb, 0xcd, 0xdd, 0xa0, 0x37, 0x77, 0x8b, 0x59, 0xcf, 0xaf, 0x25, 0xcd, 0xe6,
-	0xee, 0x33, 0x56, 0xf3, 0x48, 0x88, 0x27, 0xf5, 0x75, 0xf3, 0x98, 0xd0, 0x58, 0x37, 0xf7, 0x19,
-	0xeb, 0xd8, 0x8e, 0x84, 0x38, 0x60, 0x2c, 0xd6, 0xc7, 0xb6, 0x81, 0xac, 0xed, 0xed, 0x83, 0x7c,
-	0x59, 0x5f, 0x51, 0x7a, 0x7b, 0x2b, 0xd4, 0xba, 0xc0, 0x03, 0xce, 0x32, 0x26, 0xe0, 0x45, 0x7d,
-	0x85, 0xe9, 0x0b, 0xec, 0x33, 0x56, 0xf3, 0x56, 0x96, 0x71, 0x56, 0xd8, 0xcc, 0x7d, 0xc6, 0xda,
-	0xa1, 0xcf, 0xe1, 0x37, 0x18, 0xcb, 0xd6, 0xab, 0x75, 0x68, 0x8f, 0x30, 0xad, 0x3e, 0x7a, 0xcf,
-	0x97, 0x8c, 0xc3, 0x0e, 0x0b, 0x01, 0xbb, 0x83, 0xf6, 0xce, 0x1c, 0x54, 0x8f, 0x62, 0xbd, 0xaa,
-	0xb9, 0xe5, 0x9d, 0x4f, 0xdf, 0xce, 0x3f, 0x07, 0x91, 0xb1, 0x54, 0x00, 0x7e, 0x8d, 0x6e, 0xee,
-	0xa5, 0x42, 0x92, 0x54, 0x52, 0x22, 0x61, 0x87, 0xa5, 0x92, 0x93, 0xb1, 0xc4, 0x0f, 0xad, 0x76,
-	0x8b, 0xd2, 0xf9, 0x7a, 0x56, 0x65, 0x97, 0x52, 0xa2, 0x15, 0x0b, 0xbd, 0x81, 0xd7, 0x66, 0x8d,
-	0xb4, 0xe1, 0xac, 0xcf, 0x2c, 0xed, 0xb2, 0x02, 0x5a, 0xde, 0x9d, 0xc0, 0x38, 0xd7, 0x8a, 0xfc,
-	0xd8, 0x1a, 0xe5, 0x9c, 0xca, 0xf9, 0x72, 0x16, 0x95, 0x9e, 0x66, 0x48, 0x23, 0x4e, 0x2e, 0x4d,
-	0x73, 0x4e, 0x75, 0x41, 0x9a, 0x73, 0xaa, 0x2e, 0xcd, 0xaf, 0xe8, 0x9a, 0x9f, 0x87, 0xac, 0xcb,
-	0x71, 0xdf, 0x7e, 0xdc, 0x9a, 0xc4, 0x59, 0xbb, 0x54, 0xd2, 0x45, 0xff, 0x19, 0x5d, 0x7d, 0x91,
-	0x85, 0x44, 0xc2, 0x56, 0x98, 0xd0, 0x14, 0xdf, 0xb3, 0x3a, 0x35, 0x85, 0xf3, 0xf0, 0x32, 0x45,
-	0x17, 0xfa, 0x25, 0x42, 0x3b, 0x31, 0x10, 0x5e, 0x47, 0xfe, 0xc8, 0xea, 0x53, 0x02, 0xe7, 0xb3,
-	0x4b, 0x04, 0x6d, 0x5c, 0x67, 0xe1, 0xf7, 0xff, 0xfe, 0xfa, 0x7c, 0x7e, 0xfb, 0xe9, 0xdf, 0xa7,
-	0xee, 0xfc, 0x9b, 0x53, 0x77, 0xfe, 0xdf, 0x53, 0x77, 0xfe, 0x8f, 0x33, 0x77, 0xee, 0xcd, 0x99,
-	0x3b, 0xf7, 0xcf, 0x99, 0x3b, 0xf7, 0xcb, 0x46, 0x44, 0x65, 0x4c, 0x0e, 0x07, 0x63, 0x96, 0x78,
-	0xf2, 0x98, 0xf1, 0xea, 0xcb, 0xaf, 0xfa, 0x57, 0x7e, 0x4f, 0x79, 0xc5, 0xa6, 0x37, 0xd1, 0xf1,
-	0x72, 0xf4, 0x0e, 0xdf, 0xa9, 0xbe, 0x41, 0x36, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xc2,
-	0xaa, 0x3b, 0xe6, 0x0a, 0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0x4e, 0x50, 0x64, 0xcb, 0xd8, 0x36, 0xe9, 0x98, 0xb4, 0x69, 0x35, 0xd4, 0x6b, 0x8b, 0x75,
+	0x6b, 0xf6, 0x63, 0x2f, 0x09, 0x86, 0x61, 0x28, 0x86, 0x21, 0xc9, 0x52, 0x20, 0x68, 0xdd, 0x64,
+	0x95, 0x5b, 0x60, 0xc3, 0x00, 0x81, 0xb1, 0x4e, 0x14, 0xae, 0x16, 0xa9, 0x92, 0x94, 0x63, 0xdf,
+	0xed, 0x11, 0xf6, 0x28, 0x7b, 0x8c, 0x5d, 0xf6, 0x72, 0x97, 0x43, 0x72, 0xd1, 0xd7, 0x18, 0x44,
+	0x49, 0x26, 0x65, 0x32, 0x8d, 0x6f, 0x64, 0xeb, 0xfb, 0x39, 0xe4, 0x39, 0x3c, 0x87, 0x10, 0x5a,
+	0x52, 0xe3, 0x0c, 0x64, 0x47, 0x8d, 0xda, 0x99, 0xe0, 0x8a, 0xe3, 0x05, 0xfd, 0x1e, 0xac, 0xf5,
+	0xb9, 0x4c, 0xb9, 0xec, 0xa4, 0x32, 0xe9, 0x0c, 0x37, 0x8a, 0x9f, 0x92, 0x0f, 0x56, 0x4a, 0x7d,
+	0x2a, 0x93, 0xe8, 0x88, 0xb0, 0x0a, 0x5c, 0x33, 0x60, 0x0c, 0x19, 0x97, 0x54, 0x55, 0xc4, 0x2d,
+	0x43, 0x80, 0x10, 0x44, 0x91, 0x0a, 0xbf, 0x69, 0xf0, 0x94, 0xa6, 0x54, 0x54, 0xf0, 0x27, 0x06,
+	0x66, 0xa0, 0x4e, 0xb9, 0x78, 0x1d, 0x1d, 0x03, 0x54, 0xe4, 0x3d, 0x8b, 0xe4, 0x31, 0x44, 0x19,
+	0xc9, 0x25, 0x44, 0xfd, 0x13, 0x42, 0xeb, 0x6d, 0xdc, 0x35, 0x0a, 0x7e, 0x24, 0x41, 0x0c, 0x21,
+	0x8e, 0xd4, 0x68, 0x42, 0xb7, 0xbc, 0x34, 0xcf, 0xeb, 0xcd, 0x3e, 0xf0, 0xf2, 0xd1, 0x9b, 0x9c,
+	0x8b, 0x3c, 0xad, 0x34, 0xab, 0x46, 0x23, 0x81, 0xc5, 0x6e, 0x64, 0x09, 0x2a, 0xa2, 0x59, 0x44,
+	0xe2, 0x58, 0x80, 0x94, 0xee, 0xc6, 0x0a, 0x5e, 0x6f, 0xff, 0x35, 0x8c, 0x6b, 0xfa, 0xb6, 0x45,
+	0xf3, 0xc1, 0x10, 0x58, 0x7f, 0xec, 0xe6, 0xac, 0xa4, 0xd4, 0x1e, 0x9a, 0xb0, 0xe8, 0x98, 0xd0,
+	0x81, 0xeb, 0x2d, 0x14, 0x19, 0xe7, 0x03, 0xf7, 0x50, 0x86, 0x20, 0x24, 0xe5, 0x9e, 0xd3, 0xca,
+	0xb3, 0x44, 0x90, 0x18, 0x3c, 0xa7, 0x42, 0x28, 0xab, 0xeb, 0x72, 0xdf, 0x82, 0x79, 0x4c, 0x8f,
+	0xc7, 0xd1, 0x80, 0xa6, 0x54, 0x45, 0xf2, 0x94, 0x64, 0x95, 0xe4, 0xa1, 0x23, 0x91, 0x4a, 0x00,
+	0x49, 0x29, 0x4b, 0x6c, 0xd9, 0x9d, 0xa2, 0xab, 0x4e, 0x89, 0x4c, 0x3b, 0xfa, 0x31, 0xdc, 0x98,
+	0xf4, 0xdd, 0x03, 0x84, 0x16, 0xbb, 0x32, 0xd9, 0x4b, 0x33, 0x35, 0xde, 0x7c, 0x77, 0x03, 0x5d,
+	0xe9, 0xca, 0x04, 0x3f, 0x44, 0x57, 0x76, 0x08, 0xc3, 0xd7, 0xdb, 0x3a, 0x7a, 0xbb, 0x2b, 0x93,
+	0x1d, 0xc2, 0x82, 0x65, 0xf3, 0xaa, 0xe5, 0xf8, 0x1b, 0xf4, 0xe1, 0xcf, 0x65, 0xd7, 0xe1, 0x8f,
+	0x0d, 0x57, 0x41, 0xae, 0xbc, 0x83, 0x16, 0xf7, 0x74, 0x2f, 0xf6, 0x46, 0x18, 0x5b, 0x64, 0x85,
+	0xb9, 0x86, 0xc7, 0x68, 0xa9, 0x26, 0x7f, 0xd1, 0xbd, 0x80, 0x6f, 0xbb, 0xb6, 0x92, 0x71, 0xcd,
+	0xeb, 0x68, 0xa1, 0x5b, 0x74, 0x38, 0xb6, 0x18, 0x0d, 0xb8, 0xd2, 0x1f, 0xd1, 0x72, 0x57, 0x17,
+	0xef, 0x59, 0x51, 0xde, 0xf0, 0x94, 0x64, 0xf8, 0x8e, 0x65, 0x6a, 0x52, 0xae, 0x7d, 0x0f, 0xad,
+	0x94, 0x9a, 0xb0, 0x2e, 0xbd, 0x0e, 0x71, 0x77, 0x3a, 0x44, 0x83, 0x76, 0xc3, 0x6c, 0x21, 0xf4,
+	0xbc, 0x9c, 0xbd, 0x27, 0x00, 0x78, 0xd5, 0xd0, 0x06, 0x75, 0x4d, 0x3f, 0xa1, 0x1b, 0x86, 0xae,
+	0x8a, 0x14, 0xf8, 0xac, 0x17, 0x95, 0xe9, 0x31, 0x5a, 0x7a, 0xce, 0x63, 0x38, 0x2c, 0x66, 0x7a,
+	0xb7, 0x18, 0x69, 0xbb, 0xc6, 0x4d, 0xc6, 0x35, 0x7f, 0x8f, 0xae, 0x1d, 0x54, 0x03, 0xdb, 0x1b,
+	0xed, 0x33, 0x7c, 0xcb, 0x08, 0x6c, 0xdc, 0x35, 0xfe, 0x80, 0xae, 0x1b, 0xc1, 0x41, 0xae, 0xf0,
+	0x9a, 0xcf, 0x79, 0x90, 0x2b, 0x6f, 0xc6, 0x46, 0xe1, 0x66, 0x3c, 0xcd, 0xb9, 0x01, 0xbe, 0x42,
+	0x8b, 0xbd, 0x13, 0x2e, 0x42, 0x60, 0x31, 0x5e, 0x32, 0x64, 0xf1, 0xee, 0xcd, 0x30, 0x04, 0xb5,
+	0x7f, 0xb8, 0x5d, 0x5e, 0x2b, 0x76, 0x86, 0x36, 0xee, 0x1a, 0xbf, 0x43, 0x57, 0x43, 0x50, 0x45,
+	0x01, 0x9f, 0xc2, 0x58, 0xe2, 0x9b, 0x0d, 0x5f, 0x0d, 0x7b, 0x67, 0x24, 0xac, 0x6e, 0x22, 0x7b,
+	0x46, 0x6a, 0xcc, 0x7b, 0x7e, 0x35, 0xe9, 0xce, 0x48, 0x93, 0xf1, 0x9a, 0x7b, 0x52, 0x3e, 0x2d,
+	0x2f, 0xb7, 0x27, 0x84, 0x0e, 0x6c, 0x73, 0x93, 0xf1, 0x4e, 0x7f, 0x4f, 0xca, 0x43, 0xce, 0x07,
+	0xf6, 0xf4, 0x57, 0x90, 0xb7, 0xbd, 0x43, 0x50, 0xaf, 0xca, 0x0b, 0xd1, 0x6e, 0x6f, 0x83, 0x7a,
+	0x37, 0x78, 0x28, 0x78, 0xc6, 0x25, 0xbc, 0x2c, 0x2f, 0x4c, 0x7b, 0x83, 0x4d, 0xc6, 0x6b, 0xde,
+	0xce, 0x32, 0xc1, 0x87, 0x3e, 0x73, 0x93, 0xf1, 0x76, 0xe8, 0x0b, 0xf8, 0x03, 0xfa, 0xaa, 0xf6,
+	0x5a, 0x1d, 0xda, 0x20, 0x5c, 0x6b, 0x88, 0x3e, 0x0a, 0x15, 0x17, 0xb0, 0xcb, 0x63, 0xc0, 0xad,
+	0x76, 0x7d, 0xf5, 0xb6, 0xf5, 0x63, 0xb8, 0xa1, 0x73, 0xae, 0xf9, 0xe0, 0xf3, 0xf7, 0xf3, 0x2f,
+	0x40, 0x66, 0x9c, 0x49, 0xc0, 0x6f, 0xd0, 0xca, 0x3e, 0x93, 0x8a, 0x30, 0x45, 0x89, 0x82, 0x5d,
+	0xce, 0x94, 0x20, 0x7d, 0x85, 0x1f, 0x79, 0xed, 0x1e, 0x65, 0xf0, 0xed, 0xac, 0xca, 0xc9, 0x92,
+	0x0a, 0xad, 0x7a, 0xe8, 0x4d, 0xbc, 0x3e, 0x6b, 0xa4, 0xcd, 0x60, 0x63, 0x66, 0xe9, 0x64, 0x55,
+	0x40, 0xcb, 0x7b, 0x23, 0xe8, 0xe7, 0x56, 0x92, 0x9f, 0x79, 0xa3, 0x4c, 0xa9, 0x82, 0xaf, 0x67,
+	0x51, 0xd9, 0xcb, 0x74, 0x69, 0x22, 0xc8, 0xa5, 0xcb, 0x4c, 0xa9, 0x2e, 0x58, 0x66, 0x4a, 0x35,
+	0x59, 0xe6, 0x77, 0x74, 0x2d, 0xcc, 0x63, 0x3e, 0x59, 0xe3, 0xbe, 0xff, 0xb8, 0x2d, 0x49, 0xb0,
+	0x7e, 0xa9, 0x64, 0x12, 0xfd, 0x57, 0x74, 0xf5, 0x65, 0x16, 0x13, 0x05, 0xdb, 0x71, 0x4a, 0x19,
+	0xbe, 0xe7, 0x75, 0x5a, 0x8a, 0xe0, 0xd1, 0x65, 0x8a, 0x49, 0xe8, 0x57, 0x08, 0xed, 0x0e, 0x80,
+	0x88, 0x32, 0xf2, 0xa7, 0x5e, 0x9f, 0x11, 0x04, 0x5f, 0x5c, 0x22, 0xa8, 0xe3, 0x06, 0x0b, 0x7f,
+	0xbe, 0xfb, 0xfb, 0xcb, 0xf9, 0x9d, 0x67, 0xff, 0x9c, 0xb5, 0xe6, 0xdf, 0x9e, 0xb5, 0xe6, 0xff,
+	0x3b, 0x6b, 0xcd, 0xff, 0x75, 0xde, 0x9a, 0x7b, 0x7b, 0xde, 0x9a, 0xfb, 0xf7, 0xbc, 0x35, 0xf7,
+	0xdb, 0x66, 0x42, 0xd5, 0x80, 0x1c, 0xb5, 0xfb, 0x3c, 0xed, 0xa8, 0x13, 0x2e, 0xf4, 0x77, 0xa6,
+	0xfe, 0x57, 0x7c, 0xbd, 0x75, 0x86, 0x5b, 0x9d, 0x91, 0x8d, 0x17, 0xa3, 0x77, 0xf4, 0x81, 0xfe,
+	0x94, 0xd9, 0xfa, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x12, 0x36, 0x95, 0x38, 0x54, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -150,6 +151,7 @@ type MsgClient interface {
 	ErrataTxQuorum(ctx context.Context, in *MsgErrataTxQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	Mimir(ctx context.Context, in *MsgMimir, opts ...grpc.CallOption) (*MsgEmpty, error)
 	ModifyLimitSwap(ctx context.Context, in *MsgModifyLimitSwap, opts ...grpc.CallOption) (*MsgEmpty, error)
+	ModifyStreamingSwap(ctx context.Context, in *MsgModifyStreamingSwap, opts ...grpc.CallOption) (*MsgEmpty, error)
 	NetworkFee(ctx context.Context, in *MsgNetworkFee, opts ...grpc.CallOption) (*MsgEmpty, error)
 	NetworkFeeQuorum(ctx context.Context, in *MsgNetworkFeeQuorum, opts ...grpc.CallOption) (*MsgEmpty, error)
 	NodePauseChain(ctx context.Context, in *MsgNodePauseChain, opts ...grpc.CallOption) (*MsgEmpty, error)
@@ -240,6 +242,15 @@ func (c *msgClient) ModifyLimitSwap(ctx context.Context, in *MsgModifyLimitSwap,
 	return out, nil
 }
 
+func (c *msgClient) ModifyStreamingSwap(ctx context.Context, in *MsgModifyStreamingSwap, opts ...grpc.CallOption) (*MsgEmpty, error) {
+	out := new(MsgEmpty)
+	err := c.cc.Invoke(ctx, "/types.Msg/ModifyStreamingSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) NetworkFee(ctx context.Context, in *MsgNetworkFee, opts ...grpc.CallOption) (*MsgEmpty, error) {
 	out := new(MsgEmpty)
 	err := c.cc.Invoke(ctx, "/types.Msg/NetworkFee", in, out, opts...)
@@ -473,6 +484,7 @@ type MsgServer interface {
 	ErrataTxQuorum(context.Context, *MsgErrataTxQuorum) (*MsgEmpty, error)
 	Mimir(context.Context, *MsgMimir) (*MsgEmpty, error)
 	ModifyLimitSwap(context.Context, *MsgModifyLimitSwap) (*MsgEmpty, error)
+	ModifyStreamingSwap(context.Context, *MsgModifyStreamingSwap) (*MsgEmpty, error)
 	NetworkFee(context.Context, *MsgNetworkFee) (*MsgEmpty, error)
 	NetworkFeeQuorum(context.Context, *MsgNetworkFeeQuorum) (*MsgEmpty, error)
 	NodePauseChain(context.Context, *MsgNodePauseChain) (*MsgEmpty, error)
@@ -523,6 +535,9 @@ func (*UnimplementedMsgServer) Mimir(ctx context.Context, req *MsgMimir) (*MsgEm
 func (*UnimplementedMsgServer) ModifyLimitSwap(ctx context.Context, req *MsgModifyLimitSwap) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyLimitSwap not implemented")
 }
+func (*UnimplementedMsgServer) ModifyStreamingSwap(ctx context.Context, req *MsgModifyStreamingSwap) (*MsgEmpty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyStreamingSwap not implemented")
+}
 func (*UnimplementedMsgServer) NetworkFee(ctx context.Context, req *MsgNetworkFee) (*MsgEmpty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkFee not implemented")
 }
@@ -711,6 +726,24 @@ func _Msg_ModifyLimitSwap_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModifyStreamingSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyStreamingSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyStreamingSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.Msg/ModifyStreamingSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyStreamingSwap(ctx, req.(*MsgModifyStreamingSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_NetworkFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgNetworkFee)
 	if err := dec(in); err != nil {
@@ -1189,6 +1222,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModifyLimitSwap",
 			Handler:    _Msg_ModifyLimitSwap_Handler,
 		},
+		{
+			MethodName: "ModifyStreamingSwap",
+			Handler:    _Msg_ModifyStreamingSwap_Handler,
+		},
 		{
 			MethodName: "NetworkFee",
 			Handler:    _Msg_NetworkFee_Handler,
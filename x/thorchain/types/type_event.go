@@ -24,6 +24,7 @@ const (
 	PendingLiquidity              = "pending_liquidity"
 	PoolBalanceChangeEventType    = "pool_balance_change"
 	PoolEventType                 = "pool"
+	PriceBreakerEventType         = "price_breaker"
 	RefundEventType               = "refund"
 	ReserveEventType              = "reserve"
 	RewardEventType               = "rewards"
@@ -38,12 +39,15 @@ const (
 	AffiliateFeeEventType         = "affiliate_fee"
 	LimitSwapEventType            = "limit_swap"
 	ModifyLimitSwapEventType      = "limit_swap_mod"
+	ModifyStreamingSwapEventType  = "streaming_swap_mod"
+	RecurringSwapEventType        = "recurring_swap"
 	MintBurnType                  = "mint_burn"
 	THORNameEventType             = "thorname"
 	LoanOpenEventType             = "loan_open"
 	LoanRepaymentEventType        = "loan_repayment"
 	TradeAccountDepositEventType  = "trade_account_deposit"
 	TradeAccountWithdrawEventType = "trade_account_withdraw"
+	TradeAccountTransferEventType = "trade_account_transfer"
 	SecuredAssetDepositEventType  = "secured_asset_deposit"
 	SecuredAssetWithdrawEventType = "secured_asset_withdraw"
 	SwitchEventType               = "switch"
@@ -126,6 +130,100 @@ func (m *EventModifyLimitSwap) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventModifyStreamingSwap create a new modify streaming swap event
+func NewEventModifyStreamingSwap(from common.Address, txID common.TxID, interval, quantity uint64) *EventModifyStreamingSwap {
+	return &EventModifyStreamingSwap{
+		From:     from,
+		TxID:     txID,
+		Interval: interval,
+		Quantity: quantity,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (m *EventModifyStreamingSwap) Type() string {
+	return ModifyStreamingSwapEventType
+}
+
+// Events convert EventModifyStreamingSwap to key value pairs used in cosmos
+func (m *EventModifyStreamingSwap) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("from", m.From.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("interval", strconv.FormatUint(m.Interval, 10)),
+		cosmos.NewAttribute("quantity", strconv.FormatUint(m.Quantity, 10)),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventRecurringSwap create a new recurring swap event
+func NewEventRecurringSwap(swp RecurringSwap, status, reason string) *EventRecurringSwap {
+	return &EventRecurringSwap{
+		TxID:        swp.TxID,
+		Owner:       swp.Owner,
+		SourceAsset: swp.SourceAsset,
+		TargetAsset: swp.TargetAsset,
+		Destination: swp.Destination,
+		Amount:      swp.Amount,
+		Interval:    swp.Interval,
+		Quantity:    swp.Quantity,
+		Count:       swp.Count,
+		Status:      status,
+		Reason:      reason,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (m *EventRecurringSwap) Type() string {
+	return RecurringSwapEventType
+}
+
+// Events convert EventRecurringSwap to key value pairs used in cosmos
+func (m *EventRecurringSwap) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("tx_id", m.TxID.String()),
+		cosmos.NewAttribute("owner", m.Owner.String()),
+		cosmos.NewAttribute("source_asset", m.SourceAsset.String()),
+		cosmos.NewAttribute("target_asset", m.TargetAsset.String()),
+		cosmos.NewAttribute("destination", m.Destination.String()),
+		cosmos.NewAttribute("amount", m.Amount.String()),
+		cosmos.NewAttribute("interval", strconv.FormatUint(m.Interval, 10)),
+		cosmos.NewAttribute("quantity", strconv.FormatUint(m.Quantity, 10)),
+		cosmos.NewAttribute("count", strconv.FormatUint(m.Count, 10)),
+		cosmos.NewAttribute("status", m.Status),
+		cosmos.NewAttribute("reason", m.Reason),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// NewEventPriceBreaker create a new price breaker event
+func NewEventPriceBreaker(pool common.Asset, price, twap cosmos.Uint, deviationBps, pauseHeight int64) *EventPriceBreaker {
+	return &EventPriceBreaker{
+		Pool:         pool,
+		Price:        price,
+		TWAP:         twap,
+		DeviationBps: deviationBps,
+		PauseHeight:  pauseHeight,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (m *EventPriceBreaker) Type() string {
+	return PriceBreakerEventType
+}
+
+// Events convert EventPriceBreaker to key value pairs used in cosmos
+func (m *EventPriceBreaker) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("pool", m.Pool.String()),
+		cosmos.NewAttribute("price", m.Price.String()),
+		cosmos.NewAttribute("twap", m.TWAP.String()),
+		cosmos.NewAttribute("deviation_bps", strconv.FormatInt(m.DeviationBps, 10)),
+		cosmos.NewAttribute("pause_height", strconv.FormatInt(m.PauseHeight, 10)),
+	)
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventSwap create a new swap event
 func NewEventSwap(pool common.Asset, swapTarget, fee, swapSlip, liquidityFeeInRune cosmos.Uint, inTx common.Tx, emitAsset common.Coin, synthUnits cosmos.Uint) *EventSwap {
 	return &EventSwap{
@@ -1048,6 +1146,39 @@ func (m *EventTradeAccountWithdraw) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// NewEventTradeAccountTransfer creates a new trade account transfer event.
+func NewEventTradeAccountTransfer(
+	amt cosmos.Uint,
+	asset common.Asset,
+	fromAddress common.Address,
+	toAddress common.Address,
+	txID common.TxID,
+) *EventTradeAccountTransfer {
+	return &EventTradeAccountTransfer{
+		Amount:      amt,
+		Asset:       asset,
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		TxID:        txID,
+	}
+}
+
+// Type return the transfer event type
+func (m *EventTradeAccountTransfer) Type() string {
+	return TradeAccountTransferEventType
+}
+
+// Events return the cosmos event
+func (m *EventTradeAccountTransfer) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(m.Type(),
+		cosmos.NewAttribute("amount", m.Amount.String()),
+		cosmos.NewAttribute("asset", m.Asset.String()),
+		cosmos.NewAttribute("from_address", m.FromAddress.String()),
+		cosmos.NewAttribute("to_address", m.ToAddress.String()),
+		cosmos.NewAttribute("tx_id", m.TxID.String()))
+	return cosmos.Events{evt}, nil
+}
+
 // NewEventSecuredAssetDeposit creates a new trade account deposit event.
 func NewEventSecuredAssetDeposit(
 	amt cosmos.Uint,
@@ -284,6 +284,74 @@ func (m *EventModifyLimitSwap) GetTarget() common.Coin {
 	return common.Coin{}
 }
 
+type EventModifyStreamingSwap struct {
+	From     gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,1,opt,name=from,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"from,omitempty"`
+	TxID     gitlab_com_thorchain_thornode_v3_common.TxID    `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
+	Interval uint64                                          `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity uint64                                          `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (m *EventModifyStreamingSwap) Reset()         { *m = EventModifyStreamingSwap{} }
+func (m *EventModifyStreamingSwap) String() string { return proto.CompactTextString(m) }
+func (*EventModifyStreamingSwap) ProtoMessage()    {}
+func (*EventModifyStreamingSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a149b429e0dcd819, []int{3}
+}
+func (m *EventModifyStreamingSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventModifyStreamingSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventModifyStreamingSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventModifyStreamingSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventModifyStreamingSwap.Merge(m, src)
+}
+func (m *EventModifyStreamingSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventModifyStreamingSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventModifyStreamingSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventModifyStreamingSwap proto.InternalMessageInfo
+
+func (m *EventModifyStreamingSwap) GetFrom() gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventModifyStreamingSwap) GetTxID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *EventModifyStreamingSwap) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *EventModifyStreamingSwap) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
 type EventStreamingSwap struct {
 	TxID              gitlab_com_thorchain_thornode_v3_common.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
 	Interval          uint64                                       `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
@@ -302,7 +370,7 @@ func (m *EventStreamingSwap) Reset()         { *m = EventStreamingSwap{} }
 func (m *EventStreamingSwap) String() string { return proto.CompactTextString(m) }
 func (*EventStreamingSwap) ProtoMessage()    {}
 func (*EventStreamingSwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{3}
+	return fileDescriptor_a149b429e0dcd819, []int{4}
 }
 func (m *EventStreamingSwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,6 +469,109 @@ func (m *EventStreamingSwap) GetFailedSwapReasons() []string {
 	return nil
 }
 
+type EventRecurringSwap struct {
+	TxID        gitlab_com_thorchain_thornode_v3_common.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
+	Owner       github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	SourceAsset gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,3,opt,name=source_asset,json=sourceAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"source_asset"`
+	TargetAsset gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,4,opt,name=target_asset,json=targetAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"target_asset"`
+	Destination gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,5,opt,name=destination,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"destination,omitempty"`
+	Amount      cosmossdk_io_math.Uint                          `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	Interval    uint64                                          `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity    uint64                                          `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Count       uint64                                          `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
+	Status      string                                          `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Reason      string                                          `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventRecurringSwap) Reset()         { *m = EventRecurringSwap{} }
+func (m *EventRecurringSwap) String() string { return proto.CompactTextString(m) }
+func (*EventRecurringSwap) ProtoMessage()    {}
+func (*EventRecurringSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a149b429e0dcd819, []int{5}
+}
+func (m *EventRecurringSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecurringSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecurringSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecurringSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecurringSwap.Merge(m, src)
+}
+func (m *EventRecurringSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecurringSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecurringSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecurringSwap proto.InternalMessageInfo
+
+func (m *EventRecurringSwap) GetTxID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *EventRecurringSwap) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *EventRecurringSwap) GetDestination() gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *EventRecurringSwap) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *EventRecurringSwap) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *EventRecurringSwap) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *EventRecurringSwap) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *EventRecurringSwap) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type EventSwap struct {
 	Pool                  gitlab_com_thorchain_thornode_v3_common.Asset `protobuf:"bytes,1,opt,name=pool,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"pool"`
 	SwapTarget            cosmossdk_io_math.Uint                        `protobuf:"bytes,2,opt,name=swap_target,json=swapTarget,proto3,customtype=cosmossdk.io/math.Uint" json:"swap_target"`
@@ -420,7 +591,7 @@ func (m *EventSwap) Reset()         { *m = EventSwap{} }
 func (m *EventSwap) String() string { return proto.CompactTextString(m) }
 func (*EventSwap) ProtoMessage()    {}
 func (*EventSwap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{4}
+	return fileDescriptor_a149b429e0dcd819, []int{6}
 }
 func (m *EventSwap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +670,7 @@ func (m *EventAffiliateFee) Reset()         { *m = EventAffiliateFee{} }
 func (m *EventAffiliateFee) String() string { return proto.CompactTextString(m) }
 func (*EventAffiliateFee) ProtoMessage()    {}
 func (*EventAffiliateFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{5}
+	return fileDescriptor_a149b429e0dcd819, []int{7}
 }
 func (m *EventAffiliateFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -578,7 +749,7 @@ func (m *EventAddLiquidity) Reset()         { *m = EventAddLiquidity{} }
 func (m *EventAddLiquidity) String() string { return proto.CompactTextString(m) }
 func (*EventAddLiquidity) ProtoMessage()    {}
 func (*EventAddLiquidity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{6}
+	return fileDescriptor_a149b429e0dcd819, []int{8}
 }
 func (m *EventAddLiquidity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +820,7 @@ func (m *EventWithdraw) Reset()         { *m = EventWithdraw{} }
 func (m *EventWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventWithdraw) ProtoMessage()    {}
 func (*EventWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{7}
+	return fileDescriptor_a149b429e0dcd819, []int{9}
 }
 func (m *EventWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +878,7 @@ func (m *EventPendingLiquidity) Reset()         { *m = EventPendingLiquidity{} }
 func (m *EventPendingLiquidity) String() string { return proto.CompactTextString(m) }
 func (*EventPendingLiquidity) ProtoMessage()    {}
 func (*EventPendingLiquidity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{8}
+	return fileDescriptor_a149b429e0dcd819, []int{10}
 }
 func (m *EventPendingLiquidity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +951,7 @@ func (m *EventDonate) Reset()         { *m = EventDonate{} }
 func (m *EventDonate) String() string { return proto.CompactTextString(m) }
 func (*EventDonate) ProtoMessage()    {}
 func (*EventDonate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{9}
+	return fileDescriptor_a149b429e0dcd819, []int{11}
 }
 func (m *EventDonate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -825,7 +996,7 @@ func (m *EventPool) Reset()         { *m = EventPool{} }
 func (m *EventPool) String() string { return proto.CompactTextString(m) }
 func (*EventPool) ProtoMessage()    {}
 func (*EventPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{10}
+	return fileDescriptor_a149b429e0dcd819, []int{12}
 }
 func (m *EventPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,7 +1041,7 @@ func (m *PoolAmt) Reset()         { *m = PoolAmt{} }
 func (m *PoolAmt) String() string { return proto.CompactTextString(m) }
 func (*PoolAmt) ProtoMessage()    {}
 func (*PoolAmt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{11}
+	return fileDescriptor_a149b429e0dcd819, []int{13}
 }
 func (m *PoolAmt) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1089,7 @@ func (m *EventRewards) Reset()         { *m = EventRewards{} }
 func (m *EventRewards) String() string { return proto.CompactTextString(m) }
 func (*EventRewards) ProtoMessage()    {}
 func (*EventRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{12}
+	return fileDescriptor_a149b429e0dcd819, []int{14}
 }
 func (m *EventRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,7 +1136,7 @@ func (m *EventRefund) Reset()         { *m = EventRefund{} }
 func (m *EventRefund) String() string { return proto.CompactTextString(m) }
 func (*EventRefund) ProtoMessage()    {}
 func (*EventRefund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{13}
+	return fileDescriptor_a149b429e0dcd819, []int{15}
 }
 func (m *EventRefund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1205,7 @@ func (m *EventBond) Reset()         { *m = EventBond{} }
 func (m *EventBond) String() string { return proto.CompactTextString(m) }
 func (*EventBond) ProtoMessage()    {}
 func (*EventBond) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{14}
+	return fileDescriptor_a149b429e0dcd819, []int{16}
 }
 func (m *EventBond) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1274,7 @@ func (m *EventReBond) Reset()         { *m = EventReBond{} }
 func (m *EventReBond) String() string { return proto.CompactTextString(m) }
 func (*EventReBond) ProtoMessage()    {}
 func (*EventReBond) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{15}
+	return fileDescriptor_a149b429e0dcd819, []int{17}
 }
 func (m *EventReBond) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1342,7 @@ func (m *GasPool) Reset()         { *m = GasPool{} }
 func (m *GasPool) String() string { return proto.CompactTextString(m) }
 func (*GasPool) ProtoMessage()    {}
 func (*GasPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{16}
+	return fileDescriptor_a149b429e0dcd819, []int{18}
 }
 func (m *GasPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1386,7 @@ func (m *EventGas) Reset()         { *m = EventGas{} }
 func (m *EventGas) String() string { return proto.CompactTextString(m) }
 func (*EventGas) ProtoMessage()    {}
 func (*EventGas) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{17}
+	return fileDescriptor_a149b429e0dcd819, []int{19}
 }
 func (m *EventGas) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1431,7 @@ func (m *EventReserve) Reset()         { *m = EventReserve{} }
 func (m *EventReserve) String() string { return proto.CompactTextString(m) }
 func (*EventReserve) ProtoMessage()    {}
 func (*EventReserve) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{18}
+	return fileDescriptor_a149b429e0dcd819, []int{20}
 }
 func (m *EventReserve) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1482,7 @@ func (m *EventScheduledOutbound) Reset()         { *m = EventScheduledOutbound{}
 func (m *EventScheduledOutbound) String() string { return proto.CompactTextString(m) }
 func (*EventScheduledOutbound) ProtoMessage()    {}
 func (*EventScheduledOutbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{19}
+	return fileDescriptor_a149b429e0dcd819, []int{21}
 }
 func (m *EventScheduledOutbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1356,7 +1527,7 @@ func (m *EventSecurity) Reset()         { *m = EventSecurity{} }
 func (m *EventSecurity) String() string { return proto.CompactTextString(m) }
 func (*EventSecurity) ProtoMessage()    {}
 func (*EventSecurity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{20}
+	return fileDescriptor_a149b429e0dcd819, []int{22}
 }
 func (m *EventSecurity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,7 +1579,7 @@ func (m *EventSlash) Reset()         { *m = EventSlash{} }
 func (m *EventSlash) String() string { return proto.CompactTextString(m) }
 func (*EventSlash) ProtoMessage()    {}
 func (*EventSlash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{21}
+	return fileDescriptor_a149b429e0dcd819, []int{23}
 }
 func (m *EventSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1624,7 @@ func (m *EventErrata) Reset()         { *m = EventErrata{} }
 func (m *EventErrata) String() string { return proto.CompactTextString(m) }
 func (*EventErrata) ProtoMessage()    {}
 func (*EventErrata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{22}
+	return fileDescriptor_a149b429e0dcd819, []int{24}
 }
 func (m *EventErrata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1677,7 @@ func (m *EventFee) Reset()         { *m = EventFee{} }
 func (m *EventFee) String() string { return proto.CompactTextString(m) }
 func (*EventFee) ProtoMessage()    {}
 func (*EventFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{23}
+	return fileDescriptor_a149b429e0dcd819, []int{25}
 }
 func (m *EventFee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1558,7 +1729,7 @@ func (m *EventOutbound) Reset()         { *m = EventOutbound{} }
 func (m *EventOutbound) String() string { return proto.CompactTextString(m) }
 func (*EventOutbound) ProtoMessage()    {}
 func (*EventOutbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{24}
+	return fileDescriptor_a149b429e0dcd819, []int{26}
 }
 func (m *EventOutbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1611,7 +1782,7 @@ func (m *EventTssKeygenSuccess) Reset()         { *m = EventTssKeygenSuccess{} }
 func (m *EventTssKeygenSuccess) String() string { return proto.CompactTextString(m) }
 func (*EventTssKeygenSuccess) ProtoMessage()    {}
 func (*EventTssKeygenSuccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{25}
+	return fileDescriptor_a149b429e0dcd819, []int{27}
 }
 func (m *EventTssKeygenSuccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1673,7 +1844,7 @@ func (m *EventTssKeygenFailure) Reset()         { *m = EventTssKeygenFailure{} }
 func (m *EventTssKeygenFailure) String() string { return proto.CompactTextString(m) }
 func (*EventTssKeygenFailure) ProtoMessage()    {}
 func (*EventTssKeygenFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{26}
+	return fileDescriptor_a149b429e0dcd819, []int{28}
 }
 func (m *EventTssKeygenFailure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1746,7 +1917,7 @@ func (m *EventTssKeygenMetric) Reset()         { *m = EventTssKeygenMetric{} }
 func (m *EventTssKeygenMetric) String() string { return proto.CompactTextString(m) }
 func (*EventTssKeygenMetric) ProtoMessage()    {}
 func (*EventTssKeygenMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{27}
+	return fileDescriptor_a149b429e0dcd819, []int{29}
 }
 func (m *EventTssKeygenMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1798,7 +1969,7 @@ func (m *EventTssKeysignMetric) Reset()         { *m = EventTssKeysignMetric{} }
 func (m *EventTssKeysignMetric) String() string { return proto.CompactTextString(m) }
 func (*EventTssKeysignMetric) ProtoMessage()    {}
 func (*EventTssKeysignMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{28}
+	return fileDescriptor_a149b429e0dcd819, []int{30}
 }
 func (m *EventTssKeysignMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1851,7 +2022,7 @@ func (m *EventSlashPoint) Reset()         { *m = EventSlashPoint{} }
 func (m *EventSlashPoint) String() string { return proto.CompactTextString(m) }
 func (*EventSlashPoint) ProtoMessage()    {}
 func (*EventSlashPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{29}
+	return fileDescriptor_a149b429e0dcd819, []int{31}
 }
 func (m *EventSlashPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1910,7 +2081,7 @@ func (m *EventPoolBalanceChanged) Reset()         { *m = EventPoolBalanceChanged
 func (m *EventPoolBalanceChanged) String() string { return proto.CompactTextString(m) }
 func (*EventPoolBalanceChanged) ProtoMessage()    {}
 func (*EventPoolBalanceChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{30}
+	return fileDescriptor_a149b429e0dcd819, []int{32}
 }
 func (m *EventPoolBalanceChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1964,7 +2135,7 @@ func (m *EventMintBurn) Reset()         { *m = EventMintBurn{} }
 func (m *EventMintBurn) String() string { return proto.CompactTextString(m) }
 func (*EventMintBurn) ProtoMessage()    {}
 func (*EventMintBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{31}
+	return fileDescriptor_a149b429e0dcd819, []int{33}
 }
 func (m *EventMintBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2026,7 +2197,7 @@ func (m *EventTradeAccountDeposit) Reset()         { *m = EventTradeAccountDepos
 func (m *EventTradeAccountDeposit) String() string { return proto.CompactTextString(m) }
 func (*EventTradeAccountDeposit) ProtoMessage()    {}
 func (*EventTradeAccountDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{32}
+	return fileDescriptor_a149b429e0dcd819, []int{34}
 }
 func (m *EventTradeAccountDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2259,7 @@ func (m *EventTradeAccountWithdraw) Reset()         { *m = EventTradeAccountWith
 func (m *EventTradeAccountWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventTradeAccountWithdraw) ProtoMessage()    {}
 func (*EventTradeAccountWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{33}
+	return fileDescriptor_a149b429e0dcd819, []int{35}
 }
 func (m *EventTradeAccountWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2138,6 +2309,68 @@ func (m *EventTradeAccountWithdraw) GetTxID() gitlab_com_thorchain_thornode_v3_c
 	return ""
 }
 
+type EventTradeAccountTransfer struct {
+	Amount      cosmossdk_io_math.Uint                          `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	Asset       gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,2,opt,name=asset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"asset"`
+	FromAddress gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,3,opt,name=from_address,json=fromAddress,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"from_address,omitempty"`
+	ToAddress   gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,4,opt,name=to_address,json=toAddress,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"to_address,omitempty"`
+	TxID        gitlab_com_thorchain_thornode_v3_common.TxID    `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
+}
+
+func (m *EventTradeAccountTransfer) Reset()         { *m = EventTradeAccountTransfer{} }
+func (m *EventTradeAccountTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventTradeAccountTransfer) ProtoMessage()    {}
+func (*EventTradeAccountTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a149b429e0dcd819, []int{36}
+}
+func (m *EventTradeAccountTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradeAccountTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeAccountTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradeAccountTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeAccountTransfer.Merge(m, src)
+}
+func (m *EventTradeAccountTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeAccountTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeAccountTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradeAccountTransfer proto.InternalMessageInfo
+
+func (m *EventTradeAccountTransfer) GetFromAddress() gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *EventTradeAccountTransfer) GetToAddress() gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *EventTradeAccountTransfer) GetTxID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
 type EventSecuredAssetDeposit struct {
 	Amount       cosmossdk_io_math.Uint                          `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
 	Asset        common.Asset                                    `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset"`
@@ -2150,7 +2383,7 @@ func (m *EventSecuredAssetDeposit) Reset()         { *m = EventSecuredAssetDepos
 func (m *EventSecuredAssetDeposit) String() string { return proto.CompactTextString(m) }
 func (*EventSecuredAssetDeposit) ProtoMessage()    {}
 func (*EventSecuredAssetDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{34}
+	return fileDescriptor_a149b429e0dcd819, []int{37}
 }
 func (m *EventSecuredAssetDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2219,7 +2452,7 @@ func (m *EventSecuredAssetWithdraw) Reset()         { *m = EventSecuredAssetWith
 func (m *EventSecuredAssetWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventSecuredAssetWithdraw) ProtoMessage()    {}
 func (*EventSecuredAssetWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{35}
+	return fileDescriptor_a149b429e0dcd819, []int{38}
 }
 func (m *EventSecuredAssetWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2287,7 +2520,7 @@ func (m *EventRUNEPoolDeposit) Reset()         { *m = EventRUNEPoolDeposit{} }
 func (m *EventRUNEPoolDeposit) String() string { return proto.CompactTextString(m) }
 func (*EventRUNEPoolDeposit) ProtoMessage()    {}
 func (*EventRUNEPoolDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{36}
+	return fileDescriptor_a149b429e0dcd819, []int{39}
 }
 func (m *EventRUNEPoolDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2345,7 +2578,7 @@ func (m *EventRUNEPoolWithdraw) Reset()         { *m = EventRUNEPoolWithdraw{} }
 func (m *EventRUNEPoolWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventRUNEPoolWithdraw) ProtoMessage()    {}
 func (*EventRUNEPoolWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{37}
+	return fileDescriptor_a149b429e0dcd819, []int{40}
 }
 func (m *EventRUNEPoolWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2423,7 +2656,7 @@ func (m *EventLoanOpen) Reset()         { *m = EventLoanOpen{} }
 func (m *EventLoanOpen) String() string { return proto.CompactTextString(m) }
 func (*EventLoanOpen) ProtoMessage()    {}
 func (*EventLoanOpen) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{38}
+	return fileDescriptor_a149b429e0dcd819, []int{41}
 }
 func (m *EventLoanOpen) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2478,7 +2711,7 @@ func (m *EventLoanRepayment) Reset()         { *m = EventLoanRepayment{} }
 func (m *EventLoanRepayment) String() string { return proto.CompactTextString(m) }
 func (*EventLoanRepayment) ProtoMessage()    {}
 func (*EventLoanRepayment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{39}
+	return fileDescriptor_a149b429e0dcd819, []int{42}
 }
 func (m *EventLoanRepayment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2535,7 +2768,7 @@ func (m *EventTHORName) Reset()         { *m = EventTHORName{} }
 func (m *EventTHORName) String() string { return proto.CompactTextString(m) }
 func (*EventTHORName) ProtoMessage()    {}
 func (*EventTHORName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{40}
+	return fileDescriptor_a149b429e0dcd819, []int{43}
 }
 func (m *EventTHORName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2608,7 +2841,7 @@ func (m *EventSetMimir) Reset()         { *m = EventSetMimir{} }
 func (m *EventSetMimir) String() string { return proto.CompactTextString(m) }
 func (*EventSetMimir) ProtoMessage()    {}
 func (*EventSetMimir) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{41}
+	return fileDescriptor_a149b429e0dcd819, []int{44}
 }
 func (m *EventSetMimir) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2661,7 +2894,7 @@ func (m *EventSetNodeMimir) Reset()         { *m = EventSetNodeMimir{} }
 func (m *EventSetNodeMimir) String() string { return proto.CompactTextString(m) }
 func (*EventSetNodeMimir) ProtoMessage()    {}
 func (*EventSetNodeMimir) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{42}
+	return fileDescriptor_a149b429e0dcd819, []int{45}
 }
 func (m *EventSetNodeMimir) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2719,7 +2952,7 @@ func (m *EventVersion) Reset()         { *m = EventVersion{} }
 func (m *EventVersion) String() string { return proto.CompactTextString(m) }
 func (*EventVersion) ProtoMessage()    {}
 func (*EventVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{43}
+	return fileDescriptor_a149b429e0dcd819, []int{46}
 }
 func (m *EventVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2767,7 +3000,7 @@ func (m *EventSwitch) Reset()         { *m = EventSwitch{} }
 func (m *EventSwitch) String() string { return proto.CompactTextString(m) }
 func (*EventSwitch) ProtoMessage()    {}
 func (*EventSwitch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{44}
+	return fileDescriptor_a149b429e0dcd819, []int{47}
 }
 func (m *EventSwitch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2834,7 +3067,7 @@ func (m *EventOperatorRotate) Reset()         { *m = EventOperatorRotate{} }
 func (m *EventOperatorRotate) String() string { return proto.CompactTextString(m) }
 func (*EventOperatorRotate) ProtoMessage()    {}
 func (*EventOperatorRotate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{45}
+	return fileDescriptor_a149b429e0dcd819, []int{48}
 }
 func (m *EventOperatorRotate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2893,7 +3126,7 @@ func (m *EventTCYDistribution) Reset()         { *m = EventTCYDistribution{} }
 func (m *EventTCYDistribution) String() string { return proto.CompactTextString(m) }
 func (*EventTCYDistribution) ProtoMessage()    {}
 func (*EventTCYDistribution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{46}
+	return fileDescriptor_a149b429e0dcd819, []int{49}
 }
 func (m *EventTCYDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2940,7 +3173,7 @@ func (m *EventTCYClaim) Reset()         { *m = EventTCYClaim{} }
 func (m *EventTCYClaim) String() string { return proto.CompactTextString(m) }
 func (*EventTCYClaim) ProtoMessage()    {}
 func (*EventTCYClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{47}
+	return fileDescriptor_a149b429e0dcd819, []int{50}
 }
 func (m *EventTCYClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2992,7 +3225,7 @@ func (m *EventTCYStake) Reset()         { *m = EventTCYStake{} }
 func (m *EventTCYStake) String() string { return proto.CompactTextString(m) }
 func (*EventTCYStake) ProtoMessage()    {}
 func (*EventTCYStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{48}
+	return fileDescriptor_a149b429e0dcd819, []int{51}
 }
 func (m *EventTCYStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3037,7 +3270,7 @@ func (m *EventTCYUnstake) Reset()         { *m = EventTCYUnstake{} }
 func (m *EventTCYUnstake) String() string { return proto.CompactTextString(m) }
 func (*EventTCYUnstake) ProtoMessage()    {}
 func (*EventTCYUnstake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a149b429e0dcd819, []int{49}
+	return fileDescriptor_a149b429e0dcd819, []int{52}
 }
 func (m *EventTCYUnstake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3073,19 +3306,76 @@ func (m *EventTCYUnstake) GetAddress() gitlab_com_thorchain_thornode_v3_common.A
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("types.PendingLiquidityType", PendingLiquidityType_name, PendingLiquidityType_value)
-	proto.RegisterEnum("types.BondType", BondType_name, BondType_value)
-	proto.RegisterEnum("types.MintBurnSupplyType", MintBurnSupplyType_name, MintBurnSupplyType_value)
-	proto.RegisterType((*PoolMod)(nil), "types.PoolMod")
-	proto.RegisterType((*EventLimitSwap)(nil), "types.EventLimitSwap")
-	proto.RegisterType((*EventModifyLimitSwap)(nil), "types.EventModifyLimitSwap")
-	proto.RegisterType((*EventStreamingSwap)(nil), "types.EventStreamingSwap")
-	proto.RegisterType((*EventSwap)(nil), "types.EventSwap")
-	proto.RegisterType((*EventAffiliateFee)(nil), "types.EventAffiliateFee")
-	proto.RegisterType((*EventAddLiquidity)(nil), "types.EventAddLiquidity")
-	proto.RegisterType((*EventWithdraw)(nil), "types.EventWithdraw")
-	proto.RegisterType((*EventPendingLiquidity)(nil), "types.EventPendingLiquidity")
+type EventPriceBreaker struct {
+	Pool         gitlab_com_thorchain_thornode_v3_common.Asset `protobuf:"bytes,1,opt,name=pool,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"pool"`
+	Price        cosmossdk_io_math.Uint                        `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Uint" json:"price"`
+	TWAP         cosmossdk_io_math.Uint                        `protobuf:"bytes,3,opt,name=twap,proto3,customtype=cosmossdk.io/math.Uint" json:"twap"`
+	DeviationBps int64                                         `protobuf:"varint,4,opt,name=deviation_bps,json=deviationBps,proto3" json:"deviation_bps,omitempty"`
+	PauseHeight  int64                                         `protobuf:"varint,5,opt,name=pause_height,json=pauseHeight,proto3" json:"pause_height,omitempty"`
+}
+
+func (m *EventPriceBreaker) Reset()         { *m = EventPriceBreaker{} }
+func (m *EventPriceBreaker) String() string { return proto.CompactTextString(m) }
+func (*EventPriceBreaker) ProtoMessage()    {}
+func (*EventPriceBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a149b429e0dcd819, []int{53}
+}
+func (m *EventPriceBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceBreaker.Merge(m, src)
+}
+func (m *EventPriceBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceBreaker proto.InternalMessageInfo
+
+func (m *EventPriceBreaker) GetDeviationBps() int64 {
+	if m != nil {
+		return m.DeviationBps
+	}
+	return 0
+}
+
+func (m *EventPriceBreaker) GetPauseHeight() int64 {
+	if m != nil {
+		return m.PauseHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("types.PendingLiquidityType", PendingLiquidityType_name, PendingLiquidityType_value)
+	proto.RegisterEnum("types.BondType", BondType_name, BondType_value)
+	proto.RegisterEnum("types.MintBurnSupplyType", MintBurnSupplyType_name, MintBurnSupplyType_value)
+	proto.RegisterType((*PoolMod)(nil), "types.PoolMod")
+	proto.RegisterType((*EventLimitSwap)(nil), "types.EventLimitSwap")
+	proto.RegisterType((*EventModifyLimitSwap)(nil), "types.EventModifyLimitSwap")
+	proto.RegisterType((*EventModifyStreamingSwap)(nil), "types.EventModifyStreamingSwap")
+	proto.RegisterType((*EventStreamingSwap)(nil), "types.EventStreamingSwap")
+	proto.RegisterType((*EventRecurringSwap)(nil), "types.EventRecurringSwap")
+	proto.RegisterType((*EventSwap)(nil), "types.EventSwap")
+	proto.RegisterType((*EventAffiliateFee)(nil), "types.EventAffiliateFee")
+	proto.RegisterType((*EventAddLiquidity)(nil), "types.EventAddLiquidity")
+	proto.RegisterType((*EventWithdraw)(nil), "types.EventWithdraw")
+	proto.RegisterType((*EventPendingLiquidity)(nil), "types.EventPendingLiquidity")
 	proto.RegisterType((*EventDonate)(nil), "types.EventDonate")
 	proto.RegisterType((*EventPool)(nil), "types.EventPool")
 	proto.RegisterType((*PoolAmt)(nil), "types.PoolAmt")
@@ -3111,6 +3401,7 @@ func init() {
 	proto.RegisterType((*EventMintBurn)(nil), "types.EventMintBurn")
 	proto.RegisterType((*EventTradeAccountDeposit)(nil), "types.EventTradeAccountDeposit")
 	proto.RegisterType((*EventTradeAccountWithdraw)(nil), "types.EventTradeAccountWithdraw")
+	proto.RegisterType((*EventTradeAccountTransfer)(nil), "types.EventTradeAccountTransfer")
 	proto.RegisterType((*EventSecuredAssetDeposit)(nil), "types.EventSecuredAssetDeposit")
 	proto.RegisterType((*EventSecuredAssetWithdraw)(nil), "types.EventSecuredAssetWithdraw")
 	proto.RegisterType((*EventRUNEPoolDeposit)(nil), "types.EventRUNEPoolDeposit")
@@ -3127,206 +3418,223 @@ func init() {
 	proto.RegisterType((*EventTCYClaim)(nil), "types.EventTCYClaim")
 	proto.RegisterType((*EventTCYStake)(nil), "types.EventTCYStake")
 	proto.RegisterType((*EventTCYUnstake)(nil), "types.EventTCYUnstake")
+	proto.RegisterType((*EventPriceBreaker)(nil), "types.EventPriceBreaker")
 }
 
 func init() { proto.RegisterFile("types/type_events.proto", fileDescriptor_a149b429e0dcd819) }
 
 var fileDescriptor_a149b429e0dcd819 = []byte{
-	// 3104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x5d, 0x6c, 0x1c, 0x57,
-	0xf5, 0xcf, 0xee, 0xec, 0xe7, 0xd9, 0xb5, 0xbd, 0xb9, 0x71, 0x92, 0x6d, 0xab, 0x7f, 0x9c, 0x4e,
-	0xfb, 0x97, 0xdc, 0x28, 0xb1, 0x9b, 0x94, 0xa6, 0x94, 0x10, 0x2a, 0xaf, 0xf3, 0x51, 0xab, 0x71,
-	0x92, 0x8e, 0x37, 0xfd, 0x02, 0x34, 0xcc, 0xce, 0x5c, 0xaf, 0xaf, 0xb2, 0x33, 0xb3, 0x9d, 0x7b,
-	0xc7, 0xf6, 0x22, 0xf1, 0x88, 0x78, 0x02, 0x15, 0xa9, 0x54, 0xbc, 0x21, 0x55, 0x02, 0xa1, 0x22,
-	0x04, 0x12, 0x0f, 0x88, 0x27, 0x5e, 0x78, 0xe8, 0x63, 0x9f, 0x10, 0x02, 0xc9, 0x14, 0xf7, 0x85,
-	0x47, 0x10, 0x6f, 0x11, 0x02, 0x74, 0xbf, 0x66, 0xd7, 0xde, 0xda, 0x19, 0xaf, 0xd7, 0x55, 0x1e,
-	0xf2, 0xe2, 0x9d, 0xfb, 0x71, 0xce, 0x9c, 0x39, 0xe7, 0x77, 0xce, 0x3d, 0xf7, 0xdc, 0x6b, 0x38,
-	0xcd, 0x7a, 0x5d, 0x4c, 0xe7, 0xf9, 0x5f, 0x1b, 0xaf, 0xe3, 0x80, 0xd1, 0xb9, 0x6e, 0x14, 0xb2,
-	0x10, 0xe5, 0xc5, 0xc0, 0x93, 0x27, 0xdc, 0xd0, 0xf7, 0xc3, 0x60, 0x5e, 0xfe, 0xc8, 0xb1, 0x27,
-	0x4f, 0x0e, 0x10, 0x75, 0xc3, 0xb0, 0xa3, 0xba, 0x9f, 0x1d, 0xe8, 0x8e, 0x30, 0xc5, 0xd1, 0x3a,
-	0xb6, 0xdd, 0x30, 0x60, 0x11, 0x69, 0xc5, 0x2c, 0x8c, 0xd4, 0xac, 0xc1, 0x37, 0xb2, 0x4d, 0x3b,
-	0x8c, 0x99, 0x1a, 0x98, 0x6e, 0x87, 0xed, 0x50, 0x3c, 0xce, 0xf3, 0x27, 0xd9, 0x6b, 0xfe, 0x28,
-	0x0b, 0xc5, 0xbb, 0x61, 0xd8, 0x59, 0x0e, 0x3d, 0xf4, 0x0e, 0xe4, 0x1d, 0x4a, 0x31, 0xab, 0x67,
-	0xce, 0x66, 0x66, 0x2b, 0x97, 0x26, 0xe6, 0x94, 0x54, 0x0b, 0xbc, 0xb3, 0xf1, 0xe2, 0xc7, 0x5b,
-	0x33, 0xc7, 0xfe, 0xbc, 0x35, 0x73, 0xa1, 0x4d, 0x58, 0xc7, 0x69, 0xf1, 0xc1, 0x79, 0xb6, 0x16,
-	0x46, 0xee, 0x9a, 0x43, 0x02, 0xf1, 0x14, 0x84, 0x1e, 0x9e, 0x5f, 0x7f, 0x61, 0x7e, 0x90, 0xcc,
-	0x92, 0x2c, 0xd1, 0xcb, 0x50, 0x8a, 0xe2, 0x00, 0xdb, 0x8e, 0xcf, 0xea, 0xd9, 0xb3, 0x99, 0xd9,
-	0x72, 0xe3, 0x8c, 0xe2, 0x77, 0xca, 0x0d, 0xa9, 0x1f, 0x52, 0xea, 0xdd, 0x9f, 0x23, 0xe1, 0xbc,
-	0xef, 0xb0, 0xb5, 0xb9, 0x7b, 0x24, 0x60, 0x56, 0x91, 0xcf, 0x5f, 0xf0, 0x19, 0x7a, 0x42, 0x93,
-	0x7a, 0x5e, 0xdd, 0x38, 0x9b, 0x99, 0x2d, 0xa9, 0x21, 0xcf, 0x43, 0x57, 0xa0, 0x2c, 0xd8, 0x0b,
-	0xb6, 0xb9, 0x54, 0x6c, 0x4b, 0x82, 0x80, 0xf3, 0x7d, 0x2a, 0x21, 0xf6, 0xbc, 0x7a, 0x5e, 0x30,
-	0x56, 0x83, 0x9e, 0x67, 0xfe, 0x36, 0x03, 0x93, 0xd7, 0xb9, 0xc1, 0x6e, 0x11, 0x9f, 0xb0, 0x95,
-	0x0d, 0xa7, 0x8b, 0xce, 0x41, 0x81, 0x86, 0x71, 0xe4, 0x62, 0xa5, 0x9f, 0xaa, 0xd6, 0xcf, 0x62,
-	0x48, 0x82, 0x46, 0x8e, 0xbf, 0xd7, 0x52, 0x33, 0xf8, 0x5c, 0xe6, 0x44, 0x6d, 0x2c, 0x3f, 0x76,
-	0x8f, 0xb9, 0x72, 0x06, 0x5a, 0x86, 0x3c, 0xdb, 0xb4, 0x89, 0xfc, 0xb8, 0x72, 0xe3, 0xcb, 0xdb,
-	0x5b, 0x33, 0xb9, 0xe6, 0xe6, 0xd2, 0xb5, 0x07, 0x5b, 0x33, 0xe7, 0xd3, 0xea, 0x9a, 0xcf, 0xb7,
-	0x72, 0x6c, 0x73, 0xc9, 0x33, 0xbf, 0x9f, 0x85, 0x69, 0x21, 0xf9, 0x72, 0xe8, 0x91, 0xd5, 0x5e,
-	0x5f, 0xfe, 0x9b, 0x90, 0x5b, 0x8d, 0x42, 0x5f, 0x48, 0x5f, 0x6e, 0xbc, 0xf0, 0x60, 0x6b, 0x66,
-	0x3e, 0xb5, 0x29, 0x3d, 0x2f, 0xc2, 0x94, 0x5a, 0x82, 0xc1, 0x80, 0x22, 0xb2, 0x07, 0x50, 0x84,
-	0xf1, 0x50, 0x45, 0x34, 0xe1, 0x94, 0xcf, 0x65, 0x26, 0xd8, 0xb3, 0x65, 0x97, 0xed, 0xf8, 0x61,
-	0x1c, 0xa4, 0x35, 0xed, 0xb4, 0xa6, 0x6e, 0x0a, 0xe2, 0x05, 0x41, 0x6b, 0x7e, 0x6a, 0x00, 0x12,
-	0xfa, 0x58, 0x61, 0x11, 0x76, 0x7c, 0x12, 0xb4, 0x85, 0x36, 0x12, 0xad, 0x67, 0xc6, 0xa1, 0x75,
-	0xf4, 0x24, 0x94, 0x48, 0xc0, 0x70, 0xb4, 0xee, 0x74, 0x84, 0x56, 0x72, 0x56, 0xd2, 0xe6, 0x63,
-	0xef, 0xc6, 0x4e, 0xc0, 0x08, 0xeb, 0x09, 0x2d, 0xe4, 0xac, 0xa4, 0x8d, 0xa6, 0x21, 0xef, 0x26,
-	0x9f, 0x98, 0xb3, 0x64, 0x03, 0xcd, 0x40, 0xa5, 0xe3, 0x50, 0x66, 0xaf, 0x61, 0xd2, 0x5e, 0x63,
-	0x02, 0x9c, 0x86, 0x05, 0xbc, 0xeb, 0x55, 0xd1, 0x83, 0x16, 0xa0, 0xca, 0x22, 0xc7, 0xc3, 0x4a,
-	0x4f, 0xf5, 0x42, 0x2a, 0x05, 0x55, 0x04, 0x8d, 0xd4, 0x0e, 0x3a, 0x0f, 0x45, 0x0f, 0x77, 0x43,
-	0x4a, 0x58, 0xbd, 0xb8, 0xa7, 0x69, 0xf4, 0x14, 0x64, 0x42, 0x96, 0x04, 0xf5, 0xd2, 0x9e, 0x13,
-	0xb3, 0x24, 0x40, 0xcf, 0x82, 0x11, 0xc6, 0xac, 0x5e, 0xde, 0x73, 0x12, 0x1f, 0x46, 0x4f, 0x43,
-	0x75, 0xd5, 0x21, 0x1d, 0xec, 0xd9, 0x74, 0xc3, 0xe9, 0xd2, 0x3a, 0x9c, 0x35, 0x66, 0x73, 0x56,
-	0x45, 0xf6, 0x71, 0xd3, 0x50, 0x34, 0x07, 0x27, 0x06, 0xa6, 0xd8, 0x11, 0x76, 0x68, 0x18, 0xd0,
-	0x7a, 0xe5, 0xac, 0x31, 0x5b, 0xb6, 0x8e, 0xf7, 0x67, 0x5a, 0x72, 0xc0, 0xfc, 0x7b, 0x1e, 0xca,
-	0xd2, 0xc4, 0xdc, 0xb2, 0x6f, 0x41, 0x8e, 0x47, 0xcd, 0xb1, 0x46, 0x31, 0xc1, 0x11, 0xbd, 0x02,
-	0x15, 0x21, 0xd0, 0x80, 0x6b, 0x3f, 0x5c, 0xe9, 0xc0, 0x49, 0x94, 0xce, 0xaf, 0x40, 0x59, 0x30,
-	0xa0, 0x1d, 0xd2, 0x55, 0xee, 0xfe, 0xd0, 0x78, 0xc5, 0x09, 0x56, 0x3a, 0xa4, 0x8b, 0x16, 0x61,
-	0xa2, 0x43, 0xde, 0x8d, 0x89, 0x47, 0x58, 0xcf, 0x5e, 0xc5, 0x38, 0xa5, 0x57, 0x54, 0x13, 0xa2,
-	0x1b, 0x18, 0xa3, 0xd7, 0xe1, 0xe4, 0x0e, 0x26, 0x36, 0x09, 0x6c, 0x1e, 0x4d, 0x05, 0xc6, 0x1e,
-	0xce, 0x0c, 0x0d, 0x32, 0x5b, 0x0a, 0xac, 0x38, 0xc0, 0xe8, 0xff, 0x21, 0x4f, 0x02, 0x9b, 0x6d,
-	0x0a, 0x10, 0x56, 0x2e, 0xc1, 0x5c, 0xe2, 0x1d, 0xca, 0xec, 0x39, 0x12, 0x34, 0x37, 0xd1, 0x73,
-	0x50, 0x0c, 0x63, 0x66, 0xb3, 0x4d, 0xaa, 0xf0, 0x36, 0x3c, 0xb1, 0x10, 0xc6, 0xac, 0xb9, 0x49,
-	0xd1, 0x45, 0x00, 0xec, 0x13, 0x66, 0xcb, 0xd5, 0x68, 0x6f, 0xd0, 0x95, 0xf9, 0x2c, 0x61, 0x20,
-	0x61, 0x9a, 0x5e, 0xc0, 0xd6, 0xec, 0x38, 0x20, 0x8c, 0x0a, 0x0c, 0xa6, 0x31, 0x0d, 0x27, 0xb9,
-	0xc7, 0x29, 0xd0, 0x65, 0x38, 0x4d, 0x75, 0x80, 0x90, 0xb0, 0x4b, 0x7c, 0x16, 0x84, 0x6b, 0x9e,
-	0xa4, 0x83, 0xf1, 0xe3, 0x75, 0xed, 0xc0, 0xcf, 0xc3, 0xf4, 0x2e, 0x3a, 0xe9, 0xcf, 0x15, 0x41,
-	0x84, 0x76, 0x10, 0x2d, 0x0a, 0xe7, 0xbe, 0x02, 0x65, 0x8e, 0x26, 0x09, 0x82, 0x6a, 0x3a, 0x10,
-	0x70, 0x02, 0x0e, 0x02, 0xf3, 0x6f, 0x06, 0x1c, 0x17, 0x50, 0x5f, 0x58, 0x5d, 0x25, 0x1d, 0xe2,
-	0x30, 0xcc, 0xad, 0x3a, 0xe6, 0x60, 0x86, 0x20, 0xe7, 0x63, 0x3f, 0x94, 0x00, 0xb7, 0xc4, 0x33,
-	0x0f, 0x62, 0x82, 0xc8, 0xf1, 0xb1, 0x44, 0xae, 0x95, 0xb4, 0xd1, 0x1b, 0x50, 0xd5, 0x2b, 0x34,
-	0x5f, 0x26, 0x14, 0x30, 0x47, 0x5a, 0x61, 0x2a, 0x6a, 0x69, 0xe7, 0x8d, 0x7e, 0x42, 0x92, 0x1f,
-	0x7f, 0x42, 0xb2, 0x00, 0xd5, 0x76, 0x14, 0x52, 0xaa, 0x97, 0x98, 0x94, 0x11, 0x54, 0xd0, 0xc8,
-	0x95, 0x05, 0x9d, 0x86, 0x22, 0xf7, 0xa0, 0x56, 0x57, 0x22, 0x3a, 0x67, 0x15, 0x56, 0x31, 0x6e,
-	0x74, 0x29, 0xba, 0x0a, 0xc0, 0x07, 0x14, 0xe7, 0x52, 0x2a, 0xce, 0xe5, 0x55, 0x8c, 0xd5, 0x8a,
-	0xf5, 0xd3, 0xbc, 0xb6, 0xb1, 0xe7, 0xdd, 0xd2, 0xfe, 0x76, 0x84, 0x61, 0xed, 0x3a, 0x4c, 0x76,
-	0xa3, 0x70, 0x9d, 0x78, 0x38, 0x52, 0xee, 0x93, 0x2e, 0xb2, 0x4d, 0x68, 0x2a, 0xe9, 0x41, 0xbb,
-	0x51, 0x60, 0x8c, 0x09, 0x05, 0xaf, 0x40, 0x45, 0xa5, 0x8e, 0x07, 0xc8, 0x05, 0x40, 0x66, 0x8f,
-	0xc2, 0x4e, 0x0b, 0x50, 0xd5, 0x59, 0xa2, 0xe0, 0x90, 0x2e, 0xd4, 0x55, 0x54, 0xa2, 0x28, 0x58,
-	0xbc, 0x03, 0x82, 0xa1, 0x2d, 0xbd, 0x4c, 0x62, 0xe5, 0xab, 0xdb, 0x5b, 0x33, 0x25, 0x1e, 0x01,
-	0x47, 0xf2, 0x34, 0x91, 0xd3, 0x36, 0xb9, 0xb7, 0x7d, 0x13, 0xe4, 0xab, 0x14, 0xf3, 0xa2, 0x60,
-	0x7e, 0x75, 0x7b, 0x6b, 0xa6, 0x2c, 0x8c, 0x34, 0x12, 0x77, 0x99, 0xd9, 0x0a, 0xf6, 0x6f, 0xc1,
-	0x44, 0x92, 0xe6, 0x0a, 0xbb, 0x94, 0x46, 0xb7, 0x4b, 0x55, 0xe7, 0xc7, 0xbc, 0x65, 0xfe, 0xce,
-	0x80, 0x09, 0x81, 0xd3, 0x37, 0x09, 0x5b, 0xf3, 0x22, 0x67, 0xe3, 0xd1, 0xc7, 0xe8, 0xd3, 0x50,
-	0x6d, 0x39, 0x94, 0x50, 0xbb, 0x1b, 0x92, 0x80, 0x49, 0x8c, 0x1a, 0x56, 0x45, 0xf4, 0xdd, 0x15,
-	0x5d, 0x68, 0x81, 0x6f, 0x0b, 0x7a, 0xbe, 0x8f, 0x59, 0xd4, 0x13, 0x60, 0xab, 0x36, 0x9e, 0x51,
-	0x2f, 0x79, 0x6a, 0xf8, 0x25, 0xb7, 0x70, 0xdb, 0x71, 0x7b, 0xd7, 0xb0, 0x6b, 0xf5, 0xa9, 0xfa,
-	0x2b, 0x62, 0x7e, 0xdf, 0x15, 0xf1, 0xea, 0x8e, 0x65, 0x2e, 0x5d, 0x00, 0x1a, 0x58, 0xf2, 0xae,
-	0x80, 0x68, 0xc8, 0xe5, 0xbb, 0x98, 0x6e, 0x1d, 0xe1, 0x04, 0x1c, 0xb2, 0xe6, 0x87, 0x79, 0x38,
-	0x29, 0x6c, 0x77, 0x17, 0x07, 0x1e, 0x09, 0xda, 0x5f, 0x44, 0x9c, 0xf9, 0x1a, 0x54, 0xbb, 0xf2,
-	0x6d, 0x36, 0xdf, 0x9e, 0x0a, 0x0b, 0x4e, 0x5e, 0x7a, 0x6a, 0x4e, 0xec, 0x58, 0xe7, 0x76, 0x0b,
-	0xd2, 0xec, 0x75, 0xb1, 0x55, 0x51, 0x04, 0xbc, 0xf1, 0xe8, 0x06, 0x98, 0x21, 0x17, 0xcb, 0x8f,
-	0xc9, 0xc5, 0x86, 0x42, 0x57, 0xe1, 0xb0, 0xa1, 0xab, 0x78, 0x94, 0xa1, 0xab, 0x34, 0xde, 0xd0,
-	0x65, 0xfe, 0x20, 0x03, 0x15, 0x01, 0xd2, 0x6b, 0x61, 0xe0, 0x30, 0x7c, 0x84, 0xd0, 0x4c, 0x3c,
-	0x36, 0xbb, 0x9f, 0xc7, 0x9a, 0xef, 0x65, 0xd4, 0x46, 0xe3, 0x2e, 0x27, 0x3a, 0x3a, 0x71, 0x9e,
-	0x83, 0xc2, 0x0a, 0x73, 0x58, 0x4c, 0x95, 0x8f, 0x1c, 0xd7, 0x3e, 0xc2, 0xd3, 0x40, 0x31, 0x60,
-	0xa9, 0x09, 0xe6, 0x77, 0x64, 0xfd, 0x66, 0xc1, 0x67, 0x47, 0x5a, 0xbf, 0x39, 0x05, 0x05, 0x05,
-	0xc1, 0xac, 0x08, 0x99, 0xaa, 0x65, 0xfe, 0x25, 0x0b, 0x55, 0xa1, 0x11, 0x0b, 0x6f, 0x38, 0x91,
-	0x27, 0x9c, 0xa9, 0x15, 0x06, 0x9e, 0x1d, 0x89, 0xb6, 0x4a, 0x48, 0x1f, 0xea, 0x4c, 0x9c, 0x44,
-	0x72, 0x40, 0x2f, 0x41, 0x55, 0xa4, 0xc7, 0x92, 0x01, 0xd7, 0x80, 0x31, 0x5b, 0xb9, 0x34, 0x39,
-	0xa0, 0x81, 0x05, 0x9f, 0x29, 0xab, 0x54, 0xf8, 0x4c, 0xfd, 0xe6, 0x1b, 0x30, 0xe5, 0xe1, 0x75,
-	0x7b, 0x35, 0xee, 0xbf, 0x3d, 0xdd, 0x16, 0x6b, 0xc2, 0xc3, 0xeb, 0x37, 0xe2, 0x44, 0x80, 0x57,
-	0xa0, 0x42, 0x02, 0x37, 0xf4, 0xb1, 0xdd, 0x8a, 0xa3, 0x20, 0x6d, 0x38, 0x90, 0x24, 0x8d, 0x38,
-	0x0a, 0xd0, 0xab, 0x50, 0x63, 0x6e, 0xcf, 0xa6, 0xcc, 0xb9, 0x8f, 0xb5, 0x24, 0xe9, 0x72, 0x8e,
-	0x49, 0xe6, 0xf6, 0x56, 0x38, 0x99, 0x14, 0xc5, 0xfc, 0xae, 0x76, 0x00, 0x0b, 0xf3, 0xcf, 0xe2,
-	0x89, 0xb9, 0x1b, 0x7a, 0xb2, 0x00, 0x35, 0x61, 0x89, 0x67, 0x6e, 0x19, 0xb9, 0x41, 0x56, 0xe9,
-	0xba, 0x6a, 0xf5, 0x21, 0x6d, 0xec, 0xbb, 0x08, 0x3d, 0x03, 0x86, 0xde, 0x4b, 0x56, 0x2e, 0x55,
-	0xf4, 0xa4, 0x1b, 0x18, 0xeb, 0x3d, 0xfb, 0x2a, 0xc6, 0xe6, 0x1f, 0xb2, 0x0a, 0xf7, 0x8d, 0x30,
-	0xf0, 0xd0, 0xe5, 0x04, 0x0b, 0xe9, 0xac, 0xab, 0x66, 0xa3, 0xf3, 0x50, 0x16, 0xd0, 0x18, 0x08,
-	0xfe, 0x53, 0xca, 0xac, 0x9c, 0xaf, 0x08, 0xf8, 0xa5, 0x96, 0x7a, 0xe2, 0xf2, 0xf3, 0xa8, 0x12,
-	0xec, 0x2d, 0x3f, 0xdb, 0x5c, 0x0a, 0x50, 0x13, 0xaa, 0x1c, 0xb7, 0x3b, 0xf6, 0x1e, 0xd5, 0xc6,
-	0xc5, 0x07, 0x12, 0xe8, 0x6b, 0xb1, 0x04, 0xba, 0x94, 0x4c, 0xfd, 0x5c, 0xa0, 0xde, 0xfd, 0x79,
-	0xf9, 0xde, 0x05, 0xd7, 0x4d, 0x96, 0x04, 0xce, 0x46, 0xc7, 0xdd, 0x26, 0x54, 0x85, 0xa8, 0x83,
-	0x01, 0x7d, 0x34, 0xae, 0x9c, 0x8d, 0x4e, 0x98, 0xfe, 0x9d, 0x4d, 0xcc, 0x79, 0x28, 0x45, 0x26,
-	0xaa, 0xc9, 0x1e, 0x48, 0x35, 0xc6, 0x58, 0x54, 0xf3, 0x75, 0xa8, 0x85, 0x1d, 0xcf, 0xde, 0xa1,
-	0x9e, 0x91, 0x95, 0x3e, 0x19, 0x76, 0xbc, 0x46, 0x5f, 0x43, 0x9c, 0x79, 0x80, 0x37, 0xec, 0xf1,
-	0xe8, 0x7e, 0x32, 0xc0, 0x1b, 0x03, 0xcc, 0xcd, 0x7f, 0x65, 0xa0, 0x78, 0xd3, 0xa1, 0x22, 0x76,
-	0x3f, 0xa2, 0xb5, 0xee, 0x1d, 0x05, 0x6d, 0xe3, 0x80, 0x05, 0xed, 0x1d, 0xb5, 0x44, 0x43, 0xd5,
-	0x12, 0xcd, 0xcb, 0x50, 0x12, 0x98, 0xbb, 0xe9, 0x50, 0x74, 0x0e, 0xf2, 0x3c, 0x62, 0xd2, 0x7a,
-	0x66, 0x47, 0x50, 0x55, 0x4a, 0x51, 0xe0, 0x91, 0x53, 0xcc, 0xef, 0x65, 0x92, 0xc8, 0x2e, 0xce,
-	0x1a, 0xd0, 0x5d, 0x38, 0xf1, 0x39, 0xc7, 0x0e, 0x4a, 0x81, 0x4f, 0x28, 0x56, 0x6a, 0xf2, 0x62,
-	0x7f, 0x82, 0xe2, 0x8a, 0xa2, 0xa1, 0x91, 0xb4, 0xab, 0xee, 0x4d, 0x38, 0x25, 0xab, 0x7b, 0xee,
-	0x1a, 0xf6, 0xe2, 0x0e, 0xf6, 0xee, 0xc4, 0xac, 0x15, 0xf2, 0x78, 0x78, 0x01, 0x0a, 0xb2, 0xa6,
-	0xa4, 0xa4, 0xa8, 0x29, 0x29, 0x9a, 0x9b, 0x77, 0x62, 0xb6, 0xc4, 0xb0, 0xaf, 0x3f, 0x49, 0x14,
-	0x96, 0xcc, 0x45, 0xb5, 0x5f, 0x59, 0xc1, 0x6e, 0x1c, 0xf1, 0x5c, 0xb7, 0x06, 0x86, 0x4f, 0xdb,
-	0xd2, 0xfb, 0x2c, 0xfe, 0x88, 0xce, 0x42, 0x76, 0x1f, 0x79, 0xb2, 0x6c, 0xd3, 0xfc, 0x49, 0x06,
-	0x40, 0x72, 0xe9, 0x38, 0x74, 0xed, 0x08, 0x93, 0x80, 0x97, 0xa0, 0x4a, 0xf9, 0x2b, 0xec, 0x64,
-	0xe1, 0xdd, 0x67, 0x21, 0x14, 0x33, 0x55, 0xfd, 0xe0, 0x7d, 0xbd, 0x6a, 0x5c, 0x8f, 0x22, 0x87,
-	0x39, 0xe3, 0xae, 0x0e, 0x5d, 0xd6, 0x20, 0x1a, 0x16, 0x68, 0x39, 0xf4, 0x1a, 0x35, 0x2e, 0xd0,
-	0x47, 0x7f, 0x9d, 0x29, 0xa9, 0x0e, 0xaa, 0x01, 0xf5, 0xfb, 0x8c, 0x42, 0xe2, 0x11, 0x54, 0xac,
-	0xd4, 0x2a, 0x96, 0xdd, 0x6f, 0x15, 0xdb, 0x5d, 0x23, 0x34, 0x0e, 0x5a, 0x23, 0xe4, 0x2e, 0x21,
-	0x01, 0x94, 0x00, 0xb0, 0x09, 0x25, 0x81, 0xe0, 0xfe, 0x97, 0x7c, 0x65, 0x7b, 0x6b, 0xa6, 0xb0,
-	0x14, 0x8c, 0xf4, 0x2d, 0x05, 0x0e, 0xf7, 0x25, 0x2f, 0x05, 0x08, 0x3f, 0xc8, 0xa8, 0xed, 0x5b,
-	0x93, 0xd2, 0xd7, 0x70, 0xaf, 0x8d, 0x83, 0x95, 0xd8, 0x75, 0x79, 0x04, 0x7d, 0x0d, 0x8a, 0xdd,
-	0xb8, 0x65, 0xdf, 0xc7, 0x3d, 0x25, 0xd0, 0xa5, 0x07, 0x5b, 0x33, 0x73, 0x69, 0xc5, 0xb8, 0x1b,
-	0xb7, 0x5e, 0xc3, 0x3d, 0xab, 0xd0, 0x15, 0xbf, 0xa8, 0x0e, 0x45, 0x1f, 0xfb, 0x2d, 0x1c, 0x49,
-	0x63, 0x97, 0x2d, 0xdd, 0xe4, 0x59, 0x87, 0x3a, 0x9c, 0x90, 0x5b, 0x68, 0xd5, 0x32, 0x7f, 0x36,
-	0x24, 0xd8, 0x0d, 0x87, 0x74, 0xe2, 0x08, 0xa3, 0x19, 0x10, 0x35, 0x7e, 0x55, 0xcd, 0x57, 0x3e,
-	0x07, 0xbc, 0x4b, 0x96, 0xf1, 0xd1, 0xff, 0x01, 0x10, 0xca, 0x6d, 0xe3, 0x3a, 0x54, 0x06, 0xce,
-	0x92, 0x55, 0x26, 0xf4, 0x9e, 0xec, 0xe0, 0xf4, 0xad, 0x8e, 0xe3, 0x63, 0x9b, 0x8b, 0xcc, 0xad,
-	0xc7, 0xe5, 0x01, 0xd1, 0x75, 0x9b, 0xf7, 0xf0, 0xf0, 0x17, 0x71, 0xa3, 0xc8, 0x8c, 0xcd, 0x92,
-	0x8d, 0x01, 0x41, 0xf3, 0x3b, 0x04, 0xfd, 0x61, 0x46, 0x1d, 0x93, 0x25, 0x82, 0x2e, 0x63, 0x16,
-	0x11, 0x77, 0xbc, 0x0a, 0x3c, 0x0f, 0xc8, 0xc7, 0x1e, 0x71, 0x02, 0xdb, 0x8b, 0x23, 0x87, 0x91,
-	0x30, 0xb0, 0x7d, 0xaa, 0x52, 0xe8, 0x9a, 0x1c, 0xb9, 0xa6, 0x06, 0x96, 0x29, 0x77, 0xdc, 0x41,
-	0xe5, 0x51, 0xd2, 0xd6, 0x42, 0x8d, 0xd9, 0x5d, 0x0e, 0x26, 0xd6, 0x87, 0x19, 0x98, 0xea, 0x47,
-	0x3c, 0x51, 0x26, 0x19, 0xca, 0x2d, 0x32, 0x63, 0xc9, 0x2d, 0x9e, 0xd6, 0x21, 0x4f, 0x95, 0x67,
-	0xa4, 0x44, 0x32, 0xb8, 0xa9, 0xf2, 0x4c, 0x3f, 0xdd, 0x35, 0x06, 0xd3, 0x5d, 0x73, 0x0d, 0x4e,
-	0x27, 0x3b, 0xb3, 0x86, 0xd3, 0x71, 0x02, 0x17, 0x2f, 0xae, 0x39, 0x41, 0x1b, 0x7b, 0xe8, 0x45,
-	0x10, 0xfb, 0x04, 0xdb, 0x15, 0x6d, 0x15, 0xa9, 0x77, 0x87, 0x2d, 0xe9, 0x5b, 0xc0, 0x27, 0x4a,
-	0xba, 0xbd, 0x12, 0x6b, 0xf3, 0xe7, 0x3a, 0x0a, 0x2c, 0x93, 0x80, 0x89, 0x84, 0xff, 0x22, 0x14,
-	0x68, 0xdc, 0xed, 0x76, 0x24, 0x62, 0x26, 0x93, 0xc5, 0x50, 0x4f, 0x58, 0x11, 0x83, 0x22, 0xbf,
-	0x55, 0x13, 0x39, 0x58, 0x3d, 0x1c, 0x84, 0xbe, 0xe2, 0x2d, 0x1b, 0x03, 0x09, 0xa1, 0x71, 0xa0,
-	0x84, 0xb0, 0x2f, 0x6a, 0x6e, 0x87, 0xa8, 0xbf, 0x32, 0xa0, 0x2e, 0x01, 0x15, 0x39, 0x1e, 0x5e,
-	0x70, 0x45, 0x46, 0x70, 0x4d, 0x1d, 0xe9, 0x8d, 0x9a, 0x7d, 0x26, 0xa9, 0x53, 0x76, 0xfc, 0xa9,
-	0xd3, 0x50, 0x25, 0xc5, 0x18, 0x57, 0x25, 0xe5, 0xa8, 0xce, 0x28, 0x12, 0xcf, 0xcc, 0x8f, 0xe5,
-	0xf4, 0xfe, 0xd7, 0x06, 0x3c, 0x31, 0x64, 0xb1, 0xa4, 0xbe, 0xfa, 0xd8, 0x64, 0x8f, 0xa0, 0xc9,
-	0xfe, 0x93, 0x55, 0x4e, 0x26, 0xd2, 0x4a, 0xec, 0x09, 0xed, 0x1c, 0xd6, 0xc9, 0x9e, 0xdb, 0xd7,
-	0x62, 0x2a, 0xab, 0x7d, 0x6c, 0x00, 0x61, 0x80, 0xff, 0x66, 0x95, 0xcf, 0x0c, 0x1a, 0xe0, 0xd0,
-	0x3e, 0xf3, 0xd8, 0x02, 0xe9, 0x2d, 0xf0, 0x91, 0xbe, 0x73, 0x64, 0xdd, 0xbb, 0x7d, 0x9d, 0xaf,
-	0xa8, 0x1a, 0xfe, 0xcd, 0x5d, 0xf2, 0x8f, 0x9e, 0x26, 0xec, 0x53, 0xb0, 0xcf, 0x1e, 0xb8, 0x60,
-	0xff, 0x25, 0xc8, 0x1f, 0x64, 0x0f, 0x20, 0x27, 0xa3, 0xeb, 0x5a, 0x69, 0xd2, 0x0a, 0xcf, 0x8f,
-	0xa8, 0xac, 0x5f, 0xe4, 0x54, 0x96, 0xa7, 0x95, 0x95, 0x40, 0xf5, 0x68, 0xb4, 0xb5, 0xfb, 0xcc,
-	0x2b, 0x3b, 0x7c, 0xe6, 0xb5, 0x4b, 0xa1, 0xc6, 0xe8, 0x0a, 0xcd, 0x8d, 0xa4, 0xd0, 0xfc, 0x61,
-	0x14, 0x8a, 0xe6, 0xe0, 0x84, 0xa3, 0x6f, 0x43, 0xd8, 0xea, 0x53, 0x19, 0x15, 0x67, 0x25, 0x86,
-	0x75, 0x3c, 0x19, 0x6a, 0x88, 0x0f, 0x66, 0x14, 0x2d, 0x41, 0xad, 0x3f, 0x5f, 0x7d, 0x72, 0xba,
-	0xf3, 0xb3, 0xa9, 0x84, 0x4e, 0x7d, 0xf7, 0xb7, 0xe0, 0xf8, 0x00, 0xab, 0xc3, 0x1f, 0xb0, 0xf6,
-	0x05, 0xd3, 0x45, 0xab, 0x7f, 0xe4, 0x54, 0xb6, 0x79, 0x2b, 0x74, 0x82, 0x3b, 0x5d, 0x1c, 0xa0,
-	0xd7, 0x61, 0xda, 0x0d, 0x3b, 0x1d, 0x87, 0xe1, 0xc8, 0xe9, 0xd8, 0xea, 0x82, 0x16, 0x4e, 0x5b,
-	0x6a, 0x3f, 0xd1, 0xa7, 0xbd, 0xa6, 0x49, 0x51, 0x08, 0xb5, 0x01, 0x96, 0xe3, 0x4f, 0x15, 0xa6,
-	0xfa, 0xdc, 0xe5, 0xd9, 0xe5, 0x9b, 0x70, 0xba, 0xdf, 0x45, 0xbe, 0x2d, 0xb7, 0x20, 0x62, 0xc3,
-	0x91, 0x12, 0x7c, 0xa7, 0x86, 0xc8, 0x2d, 0xfe, 0x97, 0x23, 0xd9, 0xc3, 0x2d, 0x66, 0x13, 0x4a,
-	0x63, 0xec, 0xa5, 0x2d, 0xde, 0x73, 0x92, 0x25, 0x41, 0x81, 0x96, 0x20, 0x1f, 0x6e, 0x04, 0x38,
-	0x3a, 0xcc, 0x19, 0x9e, 0xe4, 0x80, 0xda, 0x50, 0xd5, 0xd7, 0x18, 0x93, 0x13, 0xde, 0x71, 0x69,
-	0xb4, 0x22, 0x39, 0x4b, 0x6d, 0x26, 0xd1, 0xbc, 0x38, 0x96, 0x68, 0xfe, 0x1b, 0x7d, 0x63, 0x92,
-	0x43, 0xce, 0xc2, 0x5d, 0xa7, 0xe7, 0xe3, 0x80, 0xed, 0xc2, 0xdd, 0x86, 0x0a, 0x5a, 0xc1, 0xc1,
-	0x71, 0xa7, 0xe3, 0x5d, 0xf0, 0xc5, 0xe3, 0x4e, 0xc3, 0x23, 0xc2, 0x5d, 0x87, 0xa4, 0x3d, 0x1f,
-	0x12, 0xf0, 0xb0, 0x04, 0x45, 0x1f, 0x1e, 0xb9, 0x43, 0xc3, 0x63, 0xcc, 0x56, 0xfb, 0x50, 0xdf,
-	0xc6, 0x68, 0xbe, 0x7a, 0xc7, 0xba, 0xed, 0xf8, 0x18, 0x21, 0xc8, 0x89, 0xeb, 0x5a, 0xb2, 0xd4,
-	0x22, 0x9e, 0xd1, 0x4d, 0xc8, 0x0b, 0x66, 0x6a, 0xd1, 0xd4, 0x6b, 0x4b, 0xaa, 0x97, 0x2d, 0xf2,
-	0x01, 0x4b, 0xd2, 0xa3, 0x65, 0x28, 0x8e, 0x21, 0xdb, 0xd1, 0x3c, 0x78, 0x4c, 0x8e, 0x70, 0x9b,
-	0x50, 0xa6, 0xca, 0x11, 0xe9, 0xef, 0x37, 0x4e, 0x0d, 0xd2, 0xdd, 0xc0, 0x18, 0xbd, 0x0c, 0x25,
-	0x71, 0x06, 0xe8, 0xf8, 0x69, 0xaf, 0xfa, 0x14, 0xf9, 0xfc, 0x05, 0x5f, 0xec, 0xa3, 0xf1, 0x66,
-	0x97, 0x44, 0x58, 0x2d, 0x1e, 0xaa, 0xc5, 0xb5, 0x26, 0xad, 0x5e, 0x1c, 0x75, 0x45, 0x96, 0xf4,
-	0xe6, 0x4b, 0x49, 0x05, 0x9a, 0x2d, 0x13, 0x9f, 0x44, 0xa8, 0x06, 0x46, 0x52, 0x69, 0xb2, 0xf8,
-	0x23, 0x9a, 0x86, 0xfc, 0xba, 0xd3, 0x89, 0xb1, 0xae, 0x0c, 0x88, 0x86, 0x79, 0x4f, 0x5d, 0x09,
-	0x5b, 0xc1, 0xec, 0x76, 0xe8, 0xe1, 0x03, 0x11, 0xa3, 0xfa, 0x2e, 0x5b, 0x25, 0x6a, 0x37, 0x67,
-	0x55, 0x8d, 0xff, 0x0d, 0x1c, 0x51, 0x12, 0x06, 0x7c, 0xe6, 0xba, 0x7c, 0x54, 0x5c, 0x75, 0xd3,
-	0xfc, 0xa7, 0x3e, 0xbb, 0x5a, 0xd9, 0x20, 0xcc, 0x5d, 0x7b, 0x9c, 0x56, 0x7f, 0x01, 0x69, 0xf5,
-	0xfb, 0x59, 0x38, 0x21, 0xeb, 0xcd, 0x5d, 0x1c, 0x39, 0x2c, 0x8c, 0xac, 0x90, 0x39, 0x0c, 0xa3,
-	0x25, 0x28, 0x50, 0xd2, 0xe6, 0x78, 0x1c, 0x39, 0x43, 0x54, 0x0c, 0x86, 0xea, 0x78, 0xd9, 0xb1,
-	0xd4, 0xf1, 0xbe, 0x01, 0xb5, 0x50, 0x89, 0x7c, 0xf8, 0xd3, 0xc7, 0x29, 0xcd, 0x4a, 0xa7, 0x44,
-	0xbf, 0x4c, 0x4a, 0xb7, 0x8b, 0x6f, 0x5f, 0xe3, 0xae, 0x4f, 0x5a, 0x31, 0x77, 0xfe, 0x47, 0x74,
-	0xb7, 0x61, 0xfe, 0x31, 0xab, 0x23, 0xf3, 0xe2, 0xdb, 0x8b, 0x1d, 0x87, 0xf8, 0x43, 0xf8, 0xcb,
-	0x8c, 0x09, 0x7f, 0x57, 0x01, 0x98, 0xdb, 0x3b, 0x98, 0xa4, 0x65, 0xe6, 0xf6, 0x54, 0x36, 0x6b,
-	0x01, 0x74, 0x2e, 0x8e, 0xc3, 0xdb, 0xca, 0x9d, 0x8b, 0x43, 0x77, 0x78, 0x73, 0x63, 0x2f, 0x3d,
-	0x99, 0x1f, 0x64, 0xfa, 0x8a, 0x15, 0xd7, 0x26, 0x06, 0x57, 0xa5, 0xcc, 0x18, 0x56, 0xa5, 0xcb,
-	0x3b, 0x6e, 0xbd, 0xa4, 0x0e, 0x72, 0xe6, 0x8f, 0x75, 0xc5, 0xbc, 0xb9, 0xf8, 0xf6, 0xbd, 0x80,
-	0x3e, 0x42, 0xa2, 0x9d, 0xbb, 0x00, 0xd3, 0x9f, 0x77, 0xd3, 0x0e, 0x15, 0xc1, 0x70, 0x3c, 0xaf,
-	0x76, 0x0c, 0x55, 0xa1, 0xa4, 0x73, 0xbb, 0x5a, 0xe6, 0xdc, 0x2d, 0x28, 0xe9, 0xbb, 0x19, 0x68,
-	0x42, 0xdd, 0xdf, 0xe0, 0x89, 0x50, 0xed, 0x18, 0x3a, 0x0e, 0x13, 0xea, 0xa6, 0x0f, 0x8b, 0xa3,
-	0x00, 0x7b, 0xb5, 0x0c, 0x9a, 0xda, 0x71, 0xf9, 0xa7, 0x96, 0x4d, 0x48, 0xdc, 0x90, 0xb2, 0x9a,
-	0x71, 0x6e, 0x16, 0xd0, 0x70, 0x4d, 0x1c, 0x95, 0x20, 0xe7, 0x93, 0x80, 0xd5, 0x8e, 0xf1, 0xa7,
-	0x56, 0x1c, 0x05, 0xb5, 0x4c, 0xe3, 0xd6, 0xc7, 0xdb, 0x67, 0x32, 0x9f, 0x6c, 0x9f, 0xc9, 0x7c,
-	0xba, 0x7d, 0x26, 0xf3, 0xde, 0x67, 0x67, 0x8e, 0x7d, 0xf2, 0xd9, 0x99, 0x63, 0x7f, 0xfa, 0xec,
-	0xcc, 0xb1, 0x77, 0x2e, 0x3d, 0x54, 0x65, 0x9b, 0x83, 0xfd, 0xdc, 0xb5, 0x5b, 0x05, 0xf1, 0xcf,
-	0x6e, 0x2f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xf3, 0xc7, 0xbb, 0x8f, 0x37, 0x00, 0x00,
+	// 3348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1c, 0xc7,
+	0x95, 0x9a, 0xe9, 0xf9, 0xbe, 0x19, 0x92, 0xa3, 0x12, 0x25, 0x8d, 0x6d, 0xac, 0x28, 0xb7, 0xbd,
+	0x0b, 0x5a, 0x90, 0x48, 0x4b, 0xb6, 0xe5, 0xb5, 0xb5, 0x5a, 0x83, 0x43, 0x7d, 0x4c, 0x58, 0x94,
+	0xe8, 0xe6, 0xc8, 0xbf, 0xdd, 0x45, 0x6f, 0x4f, 0x77, 0x71, 0x58, 0xd0, 0x74, 0xf7, 0xb8, 0xab,
+	0x9a, 0xe4, 0x2c, 0xb0, 0xc7, 0xc5, 0x1e, 0x82, 0x04, 0x0e, 0xe0, 0x18, 0xb9, 0x05, 0x30, 0x90,
+	0x0f, 0x1c, 0x04, 0x09, 0x90, 0x43, 0x90, 0x53, 0x2e, 0x39, 0xf8, 0xe8, 0x53, 0x10, 0x24, 0x00,
+	0xe3, 0xc8, 0x97, 0x20, 0xa7, 0x04, 0xb9, 0x09, 0x41, 0x12, 0xd4, 0xa7, 0x7b, 0x66, 0x38, 0x26,
+	0xd5, 0x33, 0x6c, 0x1a, 0x3a, 0xe8, 0x42, 0x76, 0x7d, 0xde, 0xeb, 0xd7, 0xef, 0x57, 0xef, 0x53,
+	0x03, 0xa7, 0x59, 0xaf, 0x8b, 0xe9, 0x22, 0xff, 0x6b, 0xe2, 0x2d, 0xec, 0x31, 0xba, 0xd0, 0x0d,
+	0x7c, 0xe6, 0xa3, 0xbc, 0x58, 0x78, 0xf2, 0x84, 0xed, 0xbb, 0xae, 0xef, 0x2d, 0xca, 0x7f, 0x72,
+	0xed, 0xc9, 0x93, 0x03, 0x40, 0x5d, 0xdf, 0xef, 0xa8, 0xe9, 0x67, 0x07, 0xa6, 0x03, 0x4c, 0x71,
+	0xb0, 0x85, 0x4d, 0xdb, 0xf7, 0x58, 0x40, 0x5a, 0x21, 0xf3, 0x03, 0xb5, 0x6b, 0xf0, 0x8d, 0x6c,
+	0xc7, 0xf4, 0x43, 0xa6, 0x16, 0x66, 0xdb, 0x7e, 0xdb, 0x17, 0x8f, 0x8b, 0xfc, 0x49, 0xce, 0xea,
+	0xdf, 0xca, 0x42, 0x71, 0xcd, 0xf7, 0x3b, 0xab, 0xbe, 0x83, 0xde, 0x83, 0xbc, 0x45, 0x29, 0x66,
+	0xf5, 0xcc, 0xd9, 0xcc, 0x7c, 0xe5, 0xd2, 0xd4, 0x82, 0xa2, 0x6a, 0x89, 0x4f, 0x36, 0x5e, 0xfa,
+	0x74, 0x77, 0xee, 0xd8, 0x6f, 0x76, 0xe7, 0x2e, 0xb4, 0x09, 0xeb, 0x58, 0x2d, 0xbe, 0xb8, 0xc8,
+	0x36, 0xfd, 0xc0, 0xde, 0xb4, 0x88, 0x27, 0x9e, 0x3c, 0xdf, 0xc1, 0x8b, 0x5b, 0x2f, 0x2c, 0x0e,
+	0x82, 0x19, 0x12, 0x25, 0x7a, 0x05, 0x4a, 0x41, 0xe8, 0x61, 0xd3, 0x72, 0x59, 0x3d, 0x7b, 0x36,
+	0x33, 0x5f, 0x6e, 0x9c, 0x51, 0xf8, 0x4e, 0xd9, 0x3e, 0x75, 0x7d, 0x4a, 0x9d, 0x7b, 0x0b, 0xc4,
+	0x5f, 0x74, 0x2d, 0xb6, 0xb9, 0x70, 0x97, 0x78, 0xcc, 0x28, 0xf2, 0xfd, 0x4b, 0x2e, 0x43, 0x4f,
+	0x44, 0xa0, 0x8e, 0x53, 0xd7, 0xce, 0x66, 0xe6, 0x4b, 0x6a, 0xc9, 0x71, 0xd0, 0x15, 0x28, 0x0b,
+	0xf4, 0x02, 0x6d, 0x2e, 0x11, 0xda, 0x92, 0x00, 0xe0, 0x78, 0x9f, 0x8a, 0x81, 0x1d, 0xa7, 0x9e,
+	0x17, 0x88, 0xd5, 0xa2, 0xe3, 0xe8, 0x3f, 0xcb, 0xc0, 0xf4, 0x75, 0x2e, 0xb0, 0x5b, 0xc4, 0x25,
+	0x6c, 0x7d, 0xdb, 0xea, 0xa2, 0x73, 0x50, 0xa0, 0x7e, 0x18, 0xd8, 0x58, 0xf1, 0xa7, 0x1a, 0xf1,
+	0x67, 0xd9, 0x27, 0x5e, 0x23, 0xc7, 0xdf, 0x6b, 0xa8, 0x1d, 0x7c, 0x2f, 0xb3, 0x82, 0x36, 0x96,
+	0x1f, 0xbb, 0xcf, 0x5e, 0xb9, 0x03, 0xad, 0x42, 0x9e, 0xed, 0x98, 0x44, 0x7e, 0x5c, 0xb9, 0xf1,
+	0xaf, 0xf7, 0x77, 0xe7, 0x72, 0xcd, 0x9d, 0x95, 0x6b, 0x0f, 0x76, 0xe7, 0xce, 0x27, 0xe5, 0x35,
+	0xdf, 0x6f, 0xe4, 0xd8, 0xce, 0x8a, 0xa3, 0x7f, 0x3d, 0x0b, 0xb3, 0x82, 0xf2, 0x55, 0xdf, 0x21,
+	0x1b, 0xbd, 0x3e, 0xfd, 0x37, 0x21, 0xb7, 0x11, 0xf8, 0xae, 0xa0, 0xbe, 0xdc, 0x78, 0xe1, 0xc1,
+	0xee, 0xdc, 0x62, 0x62, 0x51, 0x3a, 0x4e, 0x80, 0x29, 0x35, 0x04, 0x82, 0x01, 0x46, 0x64, 0xc7,
+	0x60, 0x84, 0xf6, 0x50, 0x46, 0x34, 0xe1, 0x94, 0xcb, 0x69, 0x26, 0xd8, 0x31, 0xe5, 0x94, 0x69,
+	0xb9, 0x7e, 0xe8, 0x25, 0x15, 0xed, 0x6c, 0x04, 0xdd, 0x14, 0xc0, 0x4b, 0x02, 0x56, 0xff, 0x63,
+	0x06, 0xea, 0x03, 0xfc, 0x58, 0x67, 0x01, 0xb6, 0x5c, 0xe2, 0xb5, 0xd3, 0xe5, 0x49, 0x2c, 0xc4,
+	0x6c, 0x1a, 0x42, 0x44, 0x4f, 0x42, 0x89, 0x78, 0x0c, 0x07, 0x5b, 0x56, 0x47, 0x30, 0x2e, 0x67,
+	0xc4, 0x63, 0xbe, 0xf6, 0x7e, 0x68, 0x79, 0x8c, 0xb0, 0x9e, 0x60, 0x4c, 0xce, 0x88, 0xc7, 0xfa,
+	0xe7, 0x1a, 0x20, 0xf1, 0xb1, 0xc3, 0x9f, 0x19, 0x53, 0x97, 0x49, 0x9d, 0xba, 0xec, 0x01, 0xd4,
+	0x69, 0xc3, 0xd4, 0xa1, 0x59, 0xc8, 0xdb, 0xb1, 0x3c, 0x73, 0x86, 0x1c, 0xa0, 0x39, 0xa8, 0x74,
+	0x2c, 0xca, 0xcc, 0x4d, 0x4c, 0xda, 0x9b, 0x4c, 0x58, 0xa2, 0x66, 0x00, 0x9f, 0x7a, 0x5d, 0xcc,
+	0xa0, 0x25, 0xa8, 0xb2, 0xc0, 0x72, 0xb0, 0x52, 0x8a, 0x7a, 0x21, 0x91, 0x36, 0x54, 0x04, 0x8c,
+	0x54, 0x05, 0x74, 0x1e, 0x8a, 0x0e, 0xee, 0xfa, 0x94, 0xb0, 0x7a, 0x71, 0x5f, 0x3d, 0x8c, 0xb6,
+	0x20, 0x1d, 0xb2, 0xc4, 0xab, 0x97, 0xf6, 0xdd, 0x98, 0x25, 0x1e, 0x7a, 0x16, 0x34, 0x3f, 0x64,
+	0xf5, 0xf2, 0xbe, 0x9b, 0xf8, 0x32, 0x7a, 0x1a, 0xaa, 0x1b, 0x16, 0xe9, 0x60, 0xc7, 0xa4, 0xdb,
+	0x56, 0x97, 0xd6, 0xe1, 0xac, 0x36, 0x9f, 0x33, 0x2a, 0x72, 0x8e, 0x8b, 0x86, 0xa2, 0x05, 0x38,
+	0x31, 0xb0, 0xc5, 0x0c, 0xb0, 0x45, 0x7d, 0x8f, 0xd6, 0x2b, 0x67, 0xb5, 0xf9, 0xb2, 0x71, 0xbc,
+	0xbf, 0xd3, 0x90, 0x0b, 0xfa, 0xd7, 0xf2, 0x4a, 0xc4, 0x06, 0xb6, 0xc3, 0x20, 0x38, 0x22, 0x11,
+	0xdf, 0x84, 0xbc, 0xbf, 0xed, 0xe1, 0x40, 0xc8, 0xb7, 0xda, 0xb8, 0xf8, 0x40, 0x3a, 0xfe, 0xcd,
+	0x50, 0xa2, 0x91, 0x3c, 0x57, 0xff, 0x2e, 0x50, 0xe7, 0x9e, 0x38, 0x70, 0xe8, 0xc2, 0x92, 0x6d,
+	0x47, 0x76, 0x21, 0xe1, 0x51, 0x1b, 0xaa, 0xd2, 0x15, 0x98, 0xf2, 0x6c, 0xd1, 0x52, 0x3c, 0x5b,
+	0x2a, 0x12, 0xb3, 0x18, 0xf0, 0x17, 0x45, 0x4e, 0x43, 0xbc, 0x28, 0x97, 0xe6, 0x8b, 0x24, 0x66,
+	0xf9, 0xa2, 0xbb, 0x50, 0x71, 0x30, 0x65, 0xc4, 0xb3, 0x18, 0xf1, 0x3d, 0xa1, 0xaf, 0x13, 0xba,
+	0x8e, 0x41, 0x3c, 0xe8, 0x32, 0x14, 0x94, 0xb7, 0x4b, 0xa6, 0xdf, 0x6a, 0xf7, 0x90, 0x31, 0x16,
+	0x0f, 0x30, 0xc6, 0xd2, 0x7e, 0xc6, 0x58, 0x1e, 0x34, 0xc6, 0x53, 0x50, 0xa0, 0xcc, 0x62, 0x21,
+	0x57, 0xd5, 0xcc, 0x7c, 0xd9, 0x50, 0x23, 0x3e, 0x2f, 0x35, 0xb3, 0x5e, 0x91, 0xf3, 0x72, 0xa4,
+	0xff, 0x21, 0x0f, 0x65, 0xe9, 0x70, 0xb8, 0x12, 0xbe, 0x03, 0x39, 0x1e, 0xb0, 0xa4, 0x1a, 0x40,
+	0x08, 0x8c, 0xe8, 0x35, 0xa8, 0x08, 0xf3, 0x18, 0x38, 0x55, 0x1f, 0xce, 0x22, 0xe0, 0x20, 0xca,
+	0x03, 0x5c, 0x81, 0xb2, 0x40, 0x40, 0x3b, 0xa4, 0xab, 0x4e, 0xda, 0x87, 0x86, 0x0a, 0x1c, 0x60,
+	0xbd, 0x43, 0xba, 0x68, 0x19, 0xa6, 0x3a, 0xe4, 0xfd, 0x90, 0x38, 0x84, 0xf5, 0xcc, 0x0d, 0x8c,
+	0x13, 0x1e, 0x48, 0xd5, 0x18, 0xe8, 0x06, 0xc6, 0xe8, 0x4d, 0x38, 0x39, 0x84, 0xc4, 0x24, 0x9e,
+	0xc9, 0x03, 0x19, 0xa5, 0x41, 0x0f, 0x43, 0x86, 0x06, 0x91, 0xad, 0x78, 0x46, 0xe8, 0x61, 0xf4,
+	0xcf, 0x90, 0x27, 0x9e, 0xc9, 0x76, 0x84, 0xca, 0x54, 0x2e, 0xc1, 0x42, 0x6c, 0xc8, 0xca, 0x09,
+	0xe5, 0x88, 0xd7, 0xdc, 0x41, 0xcf, 0x41, 0xd1, 0x0f, 0x99, 0xc9, 0x76, 0xa8, 0xf2, 0x7e, 0xa3,
+	0x1b, 0x0b, 0x7e, 0xc8, 0x9a, 0x3b, 0x14, 0x5d, 0x04, 0xc0, 0x2e, 0x89, 0x6c, 0x68, 0x7f, 0x17,
+	0x58, 0xe6, 0xbb, 0xa4, 0x3d, 0x70, 0xd1, 0xf4, 0x3c, 0xb6, 0x69, 0x86, 0x1e, 0x61, 0x54, 0xa8,
+	0x53, 0x12, 0xd1, 0x70, 0x90, 0xbb, 0x1c, 0x02, 0x5d, 0x86, 0xd3, 0x34, 0x3a, 0xae, 0xa4, 0x13,
+	0x8c, 0x95, 0x16, 0x84, 0x6e, 0x9e, 0xa4, 0x83, 0xa7, 0xd9, 0x9b, 0x91, 0x06, 0x3f, 0x0f, 0xb3,
+	0x7b, 0xe0, 0xa4, 0x42, 0x57, 0x04, 0x10, 0x1a, 0x02, 0x5a, 0x16, 0xda, 0x7d, 0x05, 0xca, 0x5c,
+	0x9b, 0xa4, 0x12, 0x54, 0x93, 0x29, 0x01, 0x07, 0xe0, 0x4a, 0xa0, 0xff, 0x5e, 0x83, 0xe3, 0x42,
+	0xd5, 0x97, 0x36, 0x36, 0x48, 0x87, 0x58, 0x0c, 0x73, 0xa9, 0xa6, 0xec, 0x77, 0x11, 0xe4, 0x5c,
+	0xec, 0xfa, 0x52, 0xc1, 0x0d, 0xf1, 0xcc, 0xad, 0x58, 0x00, 0x59, 0x2e, 0x96, 0x9a, 0x6b, 0xc4,
+	0x63, 0xf4, 0x16, 0x54, 0xa3, 0xe0, 0x98, 0xbb, 0x14, 0xa5, 0x98, 0x93, 0x79, 0x23, 0x15, 0x55,
+	0xf3, 0x41, 0x3f, 0x17, 0xc8, 0xa7, 0x9f, 0x0b, 0x2c, 0x41, 0xb5, 0x1d, 0xf8, 0x94, 0x9a, 0x63,
+	0xf9, 0xbb, 0x8a, 0x80, 0x91, 0x41, 0x1d, 0x3a, 0x0d, 0x45, 0x6e, 0x41, 0xad, 0x2e, 0x55, 0x3e,
+	0xaf, 0xb0, 0x81, 0x71, 0xa3, 0x4b, 0xd1, 0x55, 0x00, 0xbe, 0xa0, 0x30, 0x97, 0x12, 0x61, 0x2e,
+	0x6f, 0x60, 0xac, 0x82, 0xc5, 0xef, 0xe6, 0x23, 0x19, 0x3b, 0xce, 0xad, 0xc8, 0xde, 0x8e, 0xd0,
+	0xad, 0x5d, 0x87, 0xe9, 0x6e, 0xe0, 0x6f, 0x11, 0x07, 0x07, 0xca, 0x7c, 0x92, 0x79, 0xb6, 0xa9,
+	0x08, 0x4a, 0x5a, 0xd0, 0x5e, 0x2d, 0xd0, 0x52, 0xd2, 0x82, 0xd7, 0xa0, 0xa2, 0xb2, 0xb6, 0x31,
+	0xc2, 0x70, 0x90, 0x89, 0x9b, 0x90, 0xd3, 0x12, 0x54, 0xa3, 0x04, 0x4d, 0x60, 0x48, 0xe6, 0xea,
+	0x2a, 0x2a, 0x47, 0x13, 0x28, 0xde, 0x03, 0x81, 0xd0, 0x94, 0x56, 0x26, 0x75, 0xe5, 0xdf, 0xee,
+	0xef, 0xce, 0x95, 0xb8, 0x07, 0x9c, 0xc8, 0xd2, 0x44, 0x3a, 0xd9, 0xe4, 0xd6, 0xf6, 0x5f, 0x20,
+	0x5f, 0xa5, 0x90, 0x17, 0x05, 0xf2, 0xab, 0xf7, 0x77, 0xe7, 0xca, 0x42, 0x48, 0x13, 0x61, 0x97,
+	0x49, 0xa5, 0x40, 0xff, 0x0e, 0x4c, 0xc5, 0x19, 0xa6, 0x90, 0x4b, 0x69, 0x72, 0xb9, 0x54, 0xa3,
+	0xd4, 0x94, 0x8f, 0xf4, 0x9f, 0x6b, 0x30, 0x25, 0xf4, 0xf4, 0x6d, 0xc2, 0x36, 0x9d, 0xc0, 0xda,
+	0x7e, 0xf4, 0x75, 0xf4, 0x69, 0xa8, 0xb6, 0x2c, 0x4a, 0xa8, 0xd9, 0xf5, 0x89, 0xc7, 0xa4, 0x8e,
+	0x6a, 0x46, 0x45, 0xcc, 0xad, 0x89, 0x29, 0xb4, 0xc4, 0x33, 0xf2, 0x9e, 0xeb, 0x62, 0x16, 0xc8,
+	0xd4, 0xa6, 0xda, 0x78, 0x46, 0xbd, 0xe4, 0xa9, 0xd1, 0x97, 0xdc, 0xc2, 0x6d, 0xcb, 0xee, 0x5d,
+	0xc3, 0xb6, 0xd1, 0x87, 0xea, 0x9f, 0x88, 0xf9, 0x03, 0x4f, 0xc4, 0xab, 0x43, 0xc7, 0x5c, 0x32,
+	0x07, 0x34, 0x70, 0xe4, 0x5d, 0x01, 0x31, 0x90, 0xc7, 0x77, 0x31, 0xd9, 0x39, 0xc2, 0x01, 0xb8,
+	0xca, 0xea, 0x1f, 0xe7, 0xe1, 0xa4, 0x90, 0xdd, 0x1a, 0xf6, 0x1c, 0xe2, 0xb5, 0xbf, 0x0a, 0x3f,
+	0xf3, 0xef, 0x50, 0xed, 0xca, 0xb7, 0x99, 0x3c, 0x50, 0x17, 0x12, 0x9c, 0xbe, 0xf4, 0xd4, 0x82,
+	0x8c, 0xda, 0xf7, 0x12, 0xd2, 0xec, 0x75, 0xb1, 0x51, 0x51, 0x00, 0x7c, 0xf0, 0xe8, 0x3a, 0x98,
+	0x11, 0x13, 0xcb, 0xa7, 0x64, 0x62, 0x23, 0xae, 0xab, 0x70, 0x58, 0xd7, 0x55, 0x3c, 0x4a, 0xd7,
+	0x55, 0x4a, 0xd7, 0x75, 0xe9, 0xdf, 0xc8, 0x40, 0x45, 0x28, 0xe9, 0x35, 0xdf, 0xb3, 0x18, 0x3e,
+	0x42, 0xd5, 0x8c, 0x2d, 0x36, 0x7b, 0x90, 0xc5, 0xea, 0x1f, 0x64, 0x54, 0xa2, 0xb1, 0xc6, 0x81,
+	0x8e, 0x8e, 0x9c, 0xe7, 0xa0, 0xb0, 0x2e, 0x13, 0x20, 0x69, 0x23, 0xc7, 0x23, 0x1b, 0xe1, 0x61,
+	0xa0, 0x58, 0x30, 0xd4, 0x06, 0xfd, 0x7f, 0x65, 0xe9, 0x74, 0xc9, 0x65, 0x47, 0x5a, 0x3a, 0x3d,
+	0x15, 0x27, 0x86, 0x59, 0xe1, 0x32, 0xd5, 0x48, 0xff, 0x6d, 0x16, 0xaa, 0xaa, 0x10, 0xb0, 0x6d,
+	0x05, 0x8e, 0x30, 0xa6, 0x96, 0xef, 0x39, 0x66, 0x20, 0xc6, 0x2a, 0x20, 0x7d, 0xa8, 0x31, 0x71,
+	0x10, 0x89, 0x01, 0xbd, 0x0c, 0x55, 0x11, 0x1e, 0x4b, 0x04, 0x9c, 0x03, 0xda, 0x7c, 0xe5, 0xd2,
+	0xf4, 0x00, 0x07, 0x96, 0x5c, 0xa6, 0xa4, 0x52, 0xe1, 0x3b, 0xa3, 0x37, 0xdf, 0x80, 0x19, 0x07,
+	0x6f, 0x99, 0x1b, 0x61, 0xff, 0xed, 0xc9, 0x52, 0xac, 0x29, 0x07, 0x6f, 0xdd, 0x08, 0x63, 0x02,
+	0x5e, 0x83, 0x0a, 0xf1, 0x6c, 0xdf, 0xc5, 0x66, 0x2b, 0x0c, 0xbc, 0xa4, 0xee, 0x40, 0x82, 0x34,
+	0xc2, 0xc0, 0x43, 0xaf, 0x43, 0x8d, 0xd9, 0x3d, 0x93, 0x32, 0xeb, 0x1e, 0x8e, 0x28, 0x49, 0x16,
+	0x73, 0x4c, 0x33, 0xbb, 0xb7, 0xce, 0xc1, 0x24, 0x29, 0xfa, 0xff, 0x45, 0x06, 0x60, 0x60, 0xfe,
+	0x59, 0x3c, 0x30, 0xb7, 0x7d, 0x47, 0xd6, 0x7e, 0xa7, 0x0c, 0xf1, 0x3c, 0x90, 0x14, 0x67, 0x07,
+	0x93, 0xe2, 0xbe, 0x4a, 0x6b, 0x07, 0x1e, 0x42, 0xcf, 0x80, 0x16, 0xe5, 0x92, 0x95, 0x4b, 0x95,
+	0x68, 0xd3, 0x0d, 0x8c, 0xa3, 0x0a, 0xd2, 0x06, 0xc6, 0xfa, 0x2f, 0xb3, 0x4a, 0xef, 0x1b, 0xbe,
+	0xe7, 0x0c, 0x14, 0x09, 0x32, 0x63, 0x15, 0x09, 0xce, 0x43, 0x59, 0xa8, 0xc6, 0x80, 0xf3, 0x9f,
+	0x51, 0x62, 0xe5, 0x78, 0x85, 0xc3, 0x2f, 0xb5, 0xd4, 0x13, 0xa7, 0x9f, 0x7b, 0x15, 0x6f, 0x7f,
+	0xfa, 0xd9, 0xce, 0x8a, 0x87, 0x9a, 0x50, 0xe5, 0x7a, 0x3b, 0x94, 0x7b, 0x4c, 0x54, 0x2a, 0xaa,
+	0x70, 0x34, 0x91, 0xdf, 0x6d, 0x42, 0x55, 0x90, 0x3a, 0xe8, 0xd0, 0x27, 0xc3, 0xca, 0xd1, 0x44,
+	0x01, 0xd3, 0x5f, 0xb3, 0xb1, 0x38, 0x0f, 0xc5, 0xc8, 0x98, 0x35, 0xd9, 0xb1, 0x58, 0xa3, 0xa5,
+	0xc2, 0x9a, 0xff, 0x80, 0x9a, 0xdf, 0x71, 0xcc, 0x21, 0xf6, 0x4c, 0xcc, 0xf4, 0x69, 0xbf, 0xe3,
+	0x34, 0xfa, 0x1c, 0xe2, 0xc8, 0x3d, 0xbc, 0x6d, 0xa6, 0xc3, 0xfb, 0x69, 0x0f, 0x6f, 0x0f, 0x20,
+	0xd7, 0xff, 0x92, 0x81, 0xe2, 0x4d, 0x8b, 0x0a, 0xdf, 0xfd, 0x88, 0xb6, 0x99, 0x86, 0x7a, 0x49,
+	0xda, 0x98, 0xbd, 0xa4, 0xa1, 0xca, 0xb6, 0xa6, 0x8a, 0x69, 0xfa, 0x65, 0x28, 0x09, 0x9d, 0xbb,
+	0x69, 0x51, 0x74, 0x0e, 0xf2, 0xdc, 0x63, 0xd2, 0x7a, 0x66, 0xc8, 0xa9, 0x2a, 0xa6, 0x28, 0xe5,
+	0x91, 0x5b, 0xf4, 0xff, 0xcf, 0xc4, 0x9e, 0x5d, 0xb4, 0xf9, 0xd0, 0x1a, 0x9c, 0xf8, 0x92, 0x8e,
+	0x9f, 0x62, 0xe0, 0x13, 0x0a, 0x95, 0xda, 0xbc, 0xdc, 0xdf, 0xa0, 0xb0, 0xa2, 0x60, 0x64, 0x25,
+	0xe9, 0xa9, 0x7b, 0x13, 0x4e, 0xc9, 0xea, 0x9e, 0xbd, 0x89, 0x9d, 0xb0, 0x83, 0x9d, 0x3b, 0x21,
+	0x6b, 0xf9, 0xdc, 0x1f, 0x5e, 0x80, 0x82, 0xac, 0x29, 0x29, 0x2a, 0x6a, 0x8a, 0x8a, 0xe6, 0xce,
+	0x9d, 0x90, 0xad, 0x30, 0xec, 0x46, 0x9f, 0x24, 0x0a, 0x4b, 0xfa, 0xb2, 0xca, 0x57, 0xd6, 0xb1,
+	0x1d, 0x06, 0x3c, 0xd6, 0xad, 0x81, 0xe6, 0xd2, 0xb6, 0xb4, 0x3e, 0x83, 0x3f, 0xa2, 0xb3, 0x90,
+	0x3d, 0x80, 0x9e, 0x2c, 0xdb, 0xd1, 0xbf, 0x93, 0x01, 0x90, 0x58, 0x3a, 0x16, 0xdd, 0x3c, 0xc2,
+	0x20, 0xe0, 0x65, 0xa8, 0x52, 0xfe, 0x0a, 0x33, 0x3e, 0x78, 0x0f, 0x38, 0x08, 0xc5, 0x4e, 0x55,
+	0x3f, 0xf8, 0x30, 0x3a, 0x35, 0xae, 0x07, 0x81, 0xc5, 0xac, 0xb4, 0xab, 0x43, 0x97, 0x23, 0x25,
+	0x1a, 0x25, 0x68, 0xd5, 0x77, 0x1a, 0x35, 0x4e, 0xd0, 0x27, 0xbf, 0x9b, 0x2b, 0xa9, 0x09, 0x1a,
+	0x29, 0xd4, 0x2f, 0x32, 0x4a, 0x13, 0x8f, 0xa0, 0x62, 0xa5, 0x4e, 0xb1, 0xec, 0x41, 0xa7, 0xd8,
+	0xde, 0x1a, 0xa1, 0x36, 0x6e, 0x8d, 0x90, 0x9b, 0x84, 0x54, 0xa0, 0x58, 0x01, 0x9b, 0x50, 0x12,
+	0x1a, 0xdc, 0xff, 0x92, 0x57, 0xef, 0xef, 0xce, 0x15, 0x56, 0xbc, 0x89, 0xbe, 0xa5, 0xc0, 0xd5,
+	0x7d, 0xc5, 0x49, 0xa0, 0x84, 0x1f, 0x65, 0x54, 0xfa, 0xd6, 0xa4, 0xf4, 0x0d, 0xdc, 0x6b, 0x63,
+	0x6f, 0x3d, 0xb4, 0x6d, 0xee, 0x41, 0xdf, 0x80, 0x62, 0x37, 0x6c, 0x99, 0xf7, 0x70, 0x4f, 0x11,
+	0x74, 0xe9, 0xc1, 0xee, 0xdc, 0x42, 0x52, 0x32, 0xd6, 0xc2, 0xd6, 0x1b, 0xb8, 0x67, 0x14, 0xba,
+	0xe2, 0x3f, 0xaa, 0x43, 0xd1, 0xc5, 0x6e, 0x0b, 0x07, 0x52, 0xd8, 0x65, 0x23, 0x1a, 0xf2, 0xa8,
+	0x43, 0xb5, 0xca, 0x64, 0x0a, 0xad, 0x46, 0xfa, 0xf7, 0x46, 0x08, 0xbb, 0x61, 0x91, 0x4e, 0x18,
+	0x60, 0x34, 0x07, 0xa2, 0xe3, 0xa4, 0x7a, 0x4b, 0xca, 0xe6, 0x80, 0x4f, 0xc9, 0xa6, 0x12, 0xfa,
+	0x27, 0x00, 0x42, 0xb9, 0x6c, 0x6c, 0x8b, 0x4a, 0xc7, 0x59, 0x32, 0xca, 0x84, 0xde, 0x95, 0x13,
+	0x1c, 0xbe, 0xd5, 0xb1, 0x5c, 0x6c, 0x72, 0x92, 0xb9, 0xf4, 0x38, 0x3d, 0x20, 0xa6, 0x6e, 0xf3,
+	0x19, 0xee, 0xfe, 0x02, 0x2e, 0x14, 0x19, 0xb1, 0x19, 0x72, 0x30, 0x40, 0x68, 0x7e, 0x88, 0xd0,
+	0x6f, 0x66, 0x54, 0x87, 0x3a, 0x26, 0x74, 0x15, 0xb3, 0x80, 0xd8, 0xe9, 0x32, 0xf0, 0x3c, 0x20,
+	0x17, 0x3b, 0xc4, 0xf2, 0x4c, 0x27, 0x0c, 0x44, 0x8b, 0xc5, 0x74, 0xa9, 0x0a, 0xa1, 0x6b, 0x72,
+	0xe5, 0x9a, 0x5a, 0x58, 0xa5, 0xdc, 0x70, 0x07, 0x99, 0x47, 0x49, 0x3b, 0x22, 0x2a, 0x65, 0x73,
+	0x19, 0x8f, 0xac, 0x8f, 0x33, 0x30, 0xd3, 0xf7, 0x78, 0xa2, 0x4c, 0x32, 0x12, 0x5b, 0x64, 0x52,
+	0x89, 0x2d, 0x9e, 0x8e, 0x5c, 0x9e, 0x2a, 0xcf, 0x48, 0x8a, 0xa4, 0x73, 0x53, 0xe5, 0x99, 0x7e,
+	0xb8, 0xab, 0x0d, 0xf5, 0x80, 0x36, 0xe1, 0x74, 0x9c, 0x99, 0x35, 0xac, 0x8e, 0xe5, 0xd9, 0x78,
+	0x79, 0xd3, 0xf2, 0xda, 0xd8, 0x41, 0x2f, 0x81, 0xc8, 0x13, 0x4c, 0x5b, 0x8c, 0x95, 0xa7, 0xde,
+	0xeb, 0xb6, 0xa4, 0x6d, 0x01, 0xdf, 0x28, 0xe1, 0xf6, 0x0b, 0xac, 0xf5, 0x1f, 0x44, 0x5e, 0x60,
+	0x95, 0x78, 0x4c, 0x04, 0xfc, 0x17, 0xa1, 0x40, 0xc3, 0x6e, 0xb7, 0x23, 0x35, 0x66, 0x3a, 0x3e,
+	0x0c, 0xa3, 0x0d, 0xeb, 0x62, 0x51, 0xc4, 0xb7, 0x6a, 0x23, 0x57, 0x56, 0x07, 0x7b, 0xbe, 0xab,
+	0x70, 0xcb, 0xc1, 0x40, 0x40, 0xa8, 0x8d, 0x15, 0x10, 0xf6, 0x49, 0xcd, 0x0d, 0x91, 0xfa, 0x63,
+	0x4d, 0x5d, 0x3b, 0x68, 0x06, 0x96, 0x83, 0x97, 0x6c, 0x11, 0x11, 0x5c, 0x53, 0x0d, 0xe6, 0x49,
+	0xa3, 0xcf, 0x38, 0x74, 0xca, 0xa6, 0x1f, 0x3a, 0x8d, 0x54, 0x52, 0xb4, 0xb4, 0x2a, 0x29, 0x47,
+	0xd5, 0xa3, 0x88, 0x2d, 0x33, 0x9f, 0xca, 0xc5, 0x99, 0x9f, 0x68, 0xf0, 0xc4, 0x88, 0xc4, 0xe2,
+	0xfa, 0xea, 0x63, 0x91, 0x3d, 0x82, 0x22, 0xfb, 0xe4, 0xcb, 0x44, 0xd6, 0x0c, 0x2c, 0x8f, 0x6e,
+	0xe0, 0xe0, 0x91, 0x14, 0xd9, 0x5b, 0x50, 0xdd, 0x08, 0x7c, 0x37, 0x95, 0x42, 0x2a, 0x47, 0x14,
+	0x31, 0xd6, 0x00, 0x60, 0x7e, 0x1a, 0xe2, 0x2a, 0x33, 0xff, 0x88, 0x84, 0xf5, 0xb7, 0xac, 0xf2,
+	0x88, 0x22, 0x07, 0xc0, 0x8e, 0xe0, 0xcb, 0x61, 0x3d, 0xe2, 0x73, 0x07, 0xca, 0x4a, 0xa5, 0x20,
+	0x8f, 0xad, 0x45, 0x08, 0xe0, 0xef, 0x59, 0x65, 0x2d, 0x83, 0x02, 0x38, 0xb4, 0x83, 0x7b, 0x2c,
+	0x81, 0x31, 0xfc, 0x55, 0x74, 0x37, 0xd3, 0xb8, 0x7b, 0xfb, 0x3a, 0x0f, 0x7f, 0x22, 0xf5, 0x6f,
+	0xee, 0xa1, 0x7f, 0xf2, 0x98, 0xee, 0x80, 0xee, 0x4a, 0x76, 0xec, 0xee, 0xca, 0x8b, 0x90, 0x1f,
+	0x27, 0x61, 0x93, 0x9b, 0xd1, 0xf5, 0x88, 0x69, 0x52, 0x0a, 0xcf, 0x4f, 0xc8, 0xac, 0x1f, 0xe6,
+	0x54, 0x48, 0x1e, 0x31, 0x2b, 0x56, 0xd5, 0xa3, 0xe1, 0xd6, 0xde, 0x06, 0x65, 0x76, 0xb4, 0x41,
+	0xb9, 0x87, 0xa1, 0xda, 0xe4, 0x0c, 0xcd, 0x4d, 0xc4, 0xd0, 0xfc, 0x61, 0x18, 0x8a, 0x16, 0xe0,
+	0x84, 0x15, 0x5d, 0x5d, 0x31, 0xd5, 0xa7, 0x32, 0x2a, 0x1a, 0x5b, 0x9a, 0x71, 0x3c, 0x5e, 0x6a,
+	0x88, 0x0f, 0x66, 0x14, 0xad, 0x40, 0xad, 0xbf, 0x5f, 0x7d, 0x72, 0xb2, 0x66, 0xe7, 0x4c, 0x0c,
+	0xa7, 0xbe, 0xfb, 0xbf, 0xe1, 0xf8, 0x00, 0xaa, 0xc3, 0x77, 0xc3, 0xfb, 0x84, 0x45, 0x15, 0xc6,
+	0x3f, 0xe5, 0x54, 0x6a, 0x70, 0xcb, 0xb7, 0xbc, 0x3b, 0x5d, 0xec, 0xa1, 0x37, 0x61, 0xd6, 0xf6,
+	0x3b, 0x1d, 0x8b, 0xe1, 0xc0, 0xea, 0x98, 0xea, 0x6e, 0x27, 0x4e, 0xda, 0x17, 0x39, 0xd1, 0x87,
+	0xbd, 0x16, 0x81, 0x22, 0x1f, 0x6a, 0x03, 0x28, 0xd3, 0x0f, 0x12, 0x66, 0xfa, 0xd8, 0x65, 0xa3,
+	0xf9, 0x6d, 0x38, 0xdd, 0x9f, 0x22, 0xff, 0x23, 0xf3, 0x45, 0x91, 0x1d, 0x26, 0x54, 0xbe, 0x53,
+	0x23, 0xe0, 0x06, 0xff, 0xcb, 0x35, 0xd9, 0xc1, 0x2d, 0x66, 0x12, 0x4a, 0x43, 0xec, 0x24, 0xed,
+	0xb4, 0x70, 0x90, 0x15, 0x01, 0x81, 0x56, 0xa2, 0x0b, 0xa2, 0x87, 0x68, 0xb8, 0xf6, 0xaf, 0x88,
+	0x0e, 0xdd, 0xdc, 0x2c, 0x1c, 0xd5, 0xcd, 0xcd, 0xd8, 0x9b, 0x17, 0x53, 0xf1, 0xe6, 0x3f, 0x8d,
+	0x2e, 0x5b, 0x73, 0x95, 0x33, 0x70, 0xd7, 0xea, 0xb9, 0xd8, 0x63, 0x7b, 0xf4, 0x6e, 0x5b, 0x39,
+	0x2d, 0x6f, 0x7c, 0xbd, 0x8b, 0xfc, 0x9d, 0xf7, 0xd5, 0xeb, 0x5d, 0xa4, 0x1e, 0x01, 0xee, 0x5a,
+	0x24, 0x69, 0x33, 0x4f, 0xa8, 0x87, 0x21, 0x20, 0xfa, 0xea, 0x91, 0x3b, 0xb4, 0x7a, 0xa4, 0x2c,
+	0xb5, 0x8f, 0xa3, 0xab, 0x33, 0xcd, 0xd7, 0xef, 0x18, 0xb7, 0x2d, 0x17, 0x23, 0x04, 0x39, 0x71,
+	0xb7, 0x4e, 0xd6, 0xc5, 0xc4, 0x33, 0xba, 0x09, 0x79, 0x81, 0x4c, 0x1d, 0x9a, 0xd1, 0xd9, 0x92,
+	0xe8, 0x65, 0xcb, 0x7c, 0xc1, 0x90, 0xf0, 0x68, 0x15, 0x8a, 0x29, 0x44, 0x3b, 0x11, 0x0e, 0xee,
+	0x93, 0x03, 0xdc, 0x26, 0x94, 0xa9, 0xda, 0x51, 0xf2, 0xcb, 0xa8, 0x33, 0x83, 0x70, 0x37, 0x30,
+	0x46, 0xaf, 0x40, 0x49, 0x34, 0x6c, 0x2d, 0x37, 0xe9, 0xbd, 0xac, 0x22, 0xdf, 0xbf, 0xe4, 0x8a,
+	0xa2, 0x07, 0xde, 0xe9, 0x92, 0x00, 0xab, 0xc3, 0x43, 0x8d, 0xfa, 0xb7, 0xc6, 0x8b, 0x87, 0xbb,
+	0x35, 0xae, 0xbf, 0x1c, 0xb7, 0x0b, 0xd8, 0x2a, 0x71, 0x49, 0x80, 0x6a, 0xa0, 0xc5, 0x65, 0x41,
+	0x83, 0x3f, 0xa2, 0x59, 0xc8, 0x6f, 0x59, 0x9d, 0x10, 0x47, 0x65, 0x1c, 0x31, 0xd0, 0xef, 0xaa,
+	0xfb, 0x7b, 0xeb, 0x98, 0xdd, 0xf6, 0x1d, 0x3c, 0x16, 0x30, 0xaa, 0xef, 0x91, 0x55, 0xcc, 0x76,
+	0x7d, 0x5e, 0x35, 0x64, 0xde, 0xc2, 0x01, 0x25, 0xbe, 0xc7, 0x77, 0x6e, 0xc9, 0x47, 0x85, 0x35,
+	0x1a, 0xea, 0x7f, 0x8e, 0x1a, 0x8d, 0xeb, 0xdb, 0x84, 0xd9, 0x9b, 0x8f, 0xc3, 0xea, 0xaf, 0x20,
+	0xac, 0xfe, 0x30, 0x0b, 0x27, 0x64, 0x73, 0xa0, 0x8b, 0x03, 0x8b, 0xf9, 0x81, 0xe1, 0x33, 0x8b,
+	0x61, 0xb4, 0x02, 0x05, 0x4a, 0xda, 0x5c, 0x1f, 0x27, 0x8e, 0x10, 0x15, 0x82, 0x91, 0xa2, 0x6b,
+	0x36, 0x95, 0xa2, 0xeb, 0x7f, 0x42, 0xcd, 0x57, 0x24, 0x1f, 0xbe, 0x55, 0x3c, 0x13, 0xa1, 0x8a,
+	0x42, 0xa2, 0x1f, 0xc5, 0x75, 0xf6, 0xe5, 0x77, 0xaf, 0x71, 0xd3, 0x27, 0xad, 0x50, 0xfc, 0xd4,
+	0xe0, 0xd1, 0xcc, 0x36, 0xf4, 0x5f, 0x65, 0x23, 0xcf, 0xbc, 0xfc, 0xee, 0x72, 0xc7, 0x22, 0xee,
+	0x88, 0xfe, 0x65, 0x52, 0xd2, 0xbf, 0xab, 0x00, 0xcc, 0xee, 0x8d, 0x47, 0x69, 0x99, 0xd9, 0x3d,
+	0x15, 0xcd, 0x1a, 0x00, 0x9d, 0x8b, 0x69, 0x58, 0x5b, 0xb9, 0x73, 0x71, 0xe4, 0xc2, 0x75, 0x2e,
+	0xf5, 0xa2, 0x93, 0xfe, 0x51, 0xa6, 0xcf, 0x58, 0x71, 0xc7, 0x65, 0xf0, 0x54, 0xca, 0xa4, 0x70,
+	0x2a, 0x5d, 0x1e, 0xba, 0xa2, 0x94, 0xd8, 0xc9, 0xe9, 0xdf, 0x8e, 0xda, 0x1b, 0xcd, 0xe5, 0x77,
+	0xef, 0x7a, 0xf4, 0x51, 0x22, 0xed, 0xfb, 0x59, 0x75, 0x92, 0xac, 0x05, 0xc4, 0xc6, 0x8d, 0x00,
+	0x5b, 0xf7, 0x70, 0x70, 0x84, 0x2d, 0xe7, 0x17, 0x21, 0xdf, 0xe5, 0x6f, 0x4a, 0x48, 0xa6, 0xdc,
+	0x8c, 0x5e, 0x85, 0x1c, 0xdb, 0xb6, 0xa2, 0x1f, 0xb4, 0xfc, 0xcb, 0xc1, 0x40, 0xc2, 0xcb, 0xbe,
+	0xbd, 0xb4, 0x66, 0x08, 0x18, 0xf4, 0x0c, 0x4c, 0x39, 0x78, 0x8b, 0xc8, 0x38, 0xa2, 0xd5, 0xa5,
+	0xea, 0xee, 0x42, 0x35, 0x9e, 0x6c, 0x74, 0x45, 0x52, 0xdc, 0xb5, 0x42, 0x8a, 0x87, 0x7f, 0x9d,
+	0x57, 0x11, 0x73, 0xf2, 0xe7, 0x79, 0xe7, 0x2e, 0xc0, 0xec, 0x97, 0x5d, 0x20, 0x45, 0x45, 0xd0,
+	0x2c, 0xc7, 0xa9, 0x1d, 0x43, 0x55, 0x28, 0x45, 0x51, 0x70, 0x2d, 0x73, 0xee, 0x16, 0x94, 0xa2,
+	0x2b, 0x47, 0x68, 0x4a, 0x5d, 0x4b, 0xe2, 0x21, 0x63, 0xed, 0x18, 0x3a, 0x0e, 0x53, 0xea, 0x02,
+	0x1b, 0x0b, 0x03, 0x0f, 0x3b, 0xb5, 0x0c, 0x9a, 0x19, 0xba, 0xd3, 0x56, 0xcb, 0xc6, 0x20, 0xb6,
+	0x4f, 0x59, 0x4d, 0x3b, 0x37, 0x0f, 0x68, 0xb4, 0xd5, 0x83, 0x4a, 0x90, 0x73, 0x89, 0xc7, 0x6a,
+	0xc7, 0xf8, 0x53, 0x2b, 0x0c, 0xbc, 0x5a, 0xa6, 0x71, 0xeb, 0xd3, 0xfb, 0x67, 0x32, 0x9f, 0xdd,
+	0x3f, 0x93, 0xf9, 0xfc, 0xfe, 0x99, 0xcc, 0x07, 0x5f, 0x9c, 0x39, 0xf6, 0xd9, 0x17, 0x67, 0x8e,
+	0xfd, 0xfa, 0x8b, 0x33, 0xc7, 0xde, 0xbb, 0xf4, 0x50, 0x79, 0xed, 0x0c, 0xce, 0x73, 0x27, 0xd8,
+	0x2a, 0x88, 0x9f, 0x4f, 0xbf, 0xf0, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x61, 0x1d, 0xd6,
+	0xe1, 0x3d, 0x00, 0x00,
 }
 
 func (m *PoolMod) Marshal() (dAtA []byte, err error) {
@@ -3512,6 +3820,53 @@ func (m *EventModifyLimitSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventModifyStreamingSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventModifyStreamingSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventModifyStreamingSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quantity != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventStreamingSwap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3629,6 +3984,109 @@ func (m *EventStreamingSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRecurringSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecurringSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecurringSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Count != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TargetAsset.Size()
+		i -= size
+		if _, err := m.TargetAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SourceAsset.Size()
+		i -= size
+		if _, err := m.SourceAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSwap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5319,6 +5777,70 @@ func (m *EventTradeAccountWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventTradeAccountTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeAccountTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeAccountTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTypeEvents(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Asset.Size()
+		i -= size
+		if _, err := m.Asset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSecuredAssetDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6214,25 +6736,88 @@ func (m *EventTCYUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypeEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypeEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventPriceBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PoolMod) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventPriceBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Asset.Size()
-	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.PauseHeight != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.PauseHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DeviationBps != 0 {
+		i = encodeVarintTypeEvents(dAtA, i, uint64(m.DeviationBps))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TWAP.Size()
+		i -= size
+		if _, err := m.TWAP.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pool.Size()
+		i -= size
+		if _, err := m.Pool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolMod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
 	l = m.RuneAmt.Size()
 	n += 1 + l + sovTypeEvents(uint64(l))
 	if m.RuneAdd {
@@ -6282,6 +6867,29 @@ func (m *EventModifyLimitSwap) Size() (n int) {
 	return n
 }
 
+func (m *EventModifyStreamingSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Quantity))
+	}
+	return n
+}
+
 func (m *EventStreamingSwap) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6328,6 +6936,50 @@ func (m *EventStreamingSwap) Size() (n int) {
 	return n
 }
 
+func (m *EventRecurringSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.SourceAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.TargetAsset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Quantity))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypeEvents(uint64(m.Count))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSwap) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6951,6 +7603,31 @@ func (m *EventTradeAccountWithdraw) Size() (n int) {
 	return n
 }
 
+func (m *EventTradeAccountTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Asset.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSecuredAssetDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7300,6 +7977,27 @@ func (m *EventTCYUnstake) Size() (n int) {
 	return n
 }
 
+func (m *EventPriceBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	l = m.TWAP.Size()
+	n += 1 + l + sovTypeEvents(uint64(l))
+	if m.DeviationBps != 0 {
+		n += 1 + sovTypeEvents(uint64(m.DeviationBps))
+	}
+	if m.PauseHeight != 0 {
+		n += 1 + sovTypeEvents(uint64(m.PauseHeight))
+	}
+	return n
+}
+
 func sovTypeEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7827,7 +8525,7 @@ func (m *EventModifyLimitSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
+func (m *EventModifyStreamingSwap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7850,15 +8548,15 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventStreamingSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventModifyStreamingSwap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventStreamingSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventModifyStreamingSwap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7886,13 +8584,13 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			m.From = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
-			m.Interval = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7902,16 +8600,29 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Interval |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.Quantity = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7921,16 +8632,16 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Quantity |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 			}
-			m.Count = 0
+			m.Quantity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7940,16 +8651,66 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				m.Quantity |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LastHeight = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStreamingSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStreamingSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -7959,15 +8720,104 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeTarget", wireType)
-			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeTarget", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -8148,34 +8998,403 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 						count++
 					}
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.FailedSwaps) == 0 {
-					m.FailedSwaps = make([]uint64, 0, elementCount)
+				elementCount = count
+				if elementCount != 0 && len(m.FailedSwaps) == 0 {
+					m.FailedSwaps = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypeEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedSwaps = append(m.FailedSwaps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwaps", wireType)
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwapReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedSwapReasons = append(m.FailedSwapReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecurringSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecurringSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecurringSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypeEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.FailedSwaps = append(m.FailedSwaps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwaps", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedSwapReasons", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8203,7 +9422,7 @@ func (m *EventStreamingSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedSwapReasons = append(m.FailedSwapReasons, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12804,30 +14023,231 @@ func (m *EventMintBurn) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypeEvents
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMintBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMintBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			m.Supply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Supply |= MintBurnSupplyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradeAccountDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradeAccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMintBurn: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMintBurn: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			m.Supply = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypeEvents
@@ -12837,14 +14257,28 @@ func (m *EventMintBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Supply |= MintBurnSupplyType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12872,11 +14306,11 @@ func (m *EventMintBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.AssetAddress = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuneAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12904,13 +14338,11 @@ func (m *EventMintBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RuneAddress = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12938,7 +14370,7 @@ func (m *EventMintBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12961,7 +14393,7 @@ func (m *EventMintBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12984,10 +14416,10 @@ func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTradeAccountDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradeAccountWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTradeAccountDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradeAccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13174,7 +14606,7 @@ func (m *EventTradeAccountDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventTradeAccountTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13197,10 +14629,10 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTradeAccountWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTradeAccountTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTradeAccountWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTradeAccountTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13272,7 +14704,7 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13300,11 +14732,11 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetAddress = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
+			m.FromAddress = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuneAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13332,7 +14764,7 @@ func (m *EventTradeAccountWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuneAddress = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
+			m.ToAddress = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -16287,6 +17719,195 @@ func (m *EventTCYUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPriceBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TWAP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TWAP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationBps", wireType)
+			}
+			m.DeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseHeight", wireType)
+			}
+			m.PauseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypeEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,37 @@ import (
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
 )
 
+// NewScheduledMimir create a new instance of ScheduledMimir
+func NewScheduledMimir(key string, value, height int64) ScheduledMimir {
+	return ScheduledMimir{
+		Key:    strings.ToUpper(key),
+		Value:  value,
+		Height: height,
+	}
+}
+
+// Valid check whether the scheduled mimir has all necessary values
+func (m *ScheduledMimir) Valid() bool {
+	return m.Key != "" && m.Height > 0
+}
+
+// NewMimirHistory create a new instance of MimirHistory
+func NewMimirHistory(key string, value, previous, height int64, signer cosmos.AccAddress) MimirHistory {
+	return MimirHistory{
+		Key:           strings.ToUpper(key),
+		Value:         value,
+		PreviousValue: previous,
+		Height:        height,
+		Signer:        signer,
+	}
+}
+
+// IsNodeVote returns true when the record is a node mimir vote rather than a
+// change to the mimir value itself
+func (m *MimirHistory) IsNodeVote() bool {
+	return !m.Signer.Empty()
+}
+
 func (m NodeMimirs) Has(key string, acc cosmos.AccAddress) bool {
 	for _, mim := range m.Mimirs {
 		if mim.Signer.Equals(acc) && strings.EqualFold(mim.Key, key) {
@@ -128,32 +128,178 @@ func (m *NodeMimirs) GetMimirs() []NodeMimir {
 	return nil
 }
 
+// ScheduledMimir is a mimir value that reached consensus and takes effect at a
+// future block height
+type ScheduledMimir struct {
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value  int64  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ScheduledMimir) Reset()         { *m = ScheduledMimir{} }
+func (m *ScheduledMimir) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMimir) ProtoMessage()    {}
+func (*ScheduledMimir) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bda6ae15f89b3a0b, []int{2}
+}
+func (m *ScheduledMimir) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMimir) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMimir.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMimir) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMimir.Merge(m, src)
+}
+func (m *ScheduledMimir) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMimir) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMimir.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMimir proto.InternalMessageInfo
+
+func (m *ScheduledMimir) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ScheduledMimir) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *ScheduledMimir) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MimirHistory is a record of a change to a mimir value or node mimir vote
+type MimirHistory struct {
+	Key           string                                        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         int64                                         `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	PreviousValue int64                                         `protobuf:"varint,3,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	Height        int64                                         `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Signer        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MimirHistory) Reset()         { *m = MimirHistory{} }
+func (m *MimirHistory) String() string { return proto.CompactTextString(m) }
+func (*MimirHistory) ProtoMessage()    {}
+func (*MimirHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bda6ae15f89b3a0b, []int{3}
+}
+func (m *MimirHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MimirHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MimirHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MimirHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MimirHistory.Merge(m, src)
+}
+func (m *MimirHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *MimirHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MimirHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MimirHistory proto.InternalMessageInfo
+
+func (m *MimirHistory) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MimirHistory) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *MimirHistory) GetPreviousValue() int64 {
+	if m != nil {
+		return m.PreviousValue
+	}
+	return 0
+}
+
+func (m *MimirHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MimirHistory) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NodeMimir)(nil), "types.NodeMimir")
 	proto.RegisterType((*NodeMimirs)(nil), "types.NodeMimirs")
+	proto.RegisterType((*ScheduledMimir)(nil), "types.ScheduledMimir")
+	proto.RegisterType((*MimirHistory)(nil), "types.MimirHistory")
 }
 
 func init() { proto.RegisterFile("types/type_mimir.proto", fileDescriptor_bda6ae15f89b3a0b) }
 
 var fileDescriptor_bda6ae15f89b3a0b = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x2b, 0xa9, 0x2c, 0x48,
-	0x2d, 0xd6, 0x07, 0x91, 0xf1, 0xb9, 0x99, 0xb9, 0x99, 0x45, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xac, 0x60, 0x71, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0x88, 0x3e, 0x88, 0x05, 0x91,
-	0x54, 0xaa, 0xe3, 0xe2, 0xf4, 0xcb, 0x4f, 0x49, 0xf5, 0x05, 0xa9, 0x17, 0x12, 0xe0, 0x62, 0xce,
-	0x4e, 0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x02, 0x31, 0x85, 0x44, 0xb8, 0x58, 0xcb,
-	0x12, 0x73, 0x4a, 0x53, 0x25, 0x98, 0x14, 0x18, 0x35, 0x98, 0x83, 0x20, 0x1c, 0x21, 0x4f, 0x2e,
-	0xb6, 0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x1e, 0x27, 0xc3, 0x5f,
-	0xf7, 0xe4, 0x75, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x93, 0xf3,
-	0x8b, 0x73, 0xf3, 0x8b, 0xa1, 0x94, 0x6e, 0x71, 0x4a, 0x36, 0xd8, 0x51, 0xc5, 0x7a, 0x8e, 0xc9,
-	0xc9, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0x41, 0x50, 0x03, 0x94, 0x6c, 0xb8, 0xb8, 0xe0,
-	0xf6, 0x17, 0x0b, 0xe9, 0x71, 0xb1, 0x81, 0x5d, 0x5e, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d,
-	0x24, 0xa0, 0x07, 0xd1, 0x08, 0x57, 0xe2, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x95,
-	0x93, 0xcf, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa5, 0x67, 0x96,
-	0xe4, 0x24, 0x42, 0x9c, 0x53, 0x92, 0x91, 0x5f, 0x94, 0x9c, 0x91, 0x98, 0x99, 0x07, 0x66, 0xe5,
-	0xe5, 0xa7, 0xa4, 0xea, 0x97, 0x19, 0xeb, 0x57, 0x20, 0x8b, 0x83, 0x6c, 0x49, 0x62, 0x03, 0x07,
-	0x89, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x52, 0x1a, 0xe3, 0x74, 0x49, 0x01, 0x00, 0x00,
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0x41, 0x6b, 0xf2, 0x40,
+	0x10, 0xcd, 0x7e, 0xd1, 0x80, 0xfb, 0x59, 0x91, 0x20, 0x12, 0x7a, 0x88, 0x21, 0x50, 0xc8, 0xc5,
+	0x84, 0xea, 0xb5, 0x17, 0x3d, 0xb5, 0xd0, 0x96, 0x92, 0x42, 0x0f, 0xbd, 0x88, 0x66, 0x97, 0x64,
+	0xd1, 0xb8, 0xb2, 0xb3, 0x91, 0x7a, 0xe9, 0x6f, 0xe8, 0x4f, 0xea, 0xd1, 0xa3, 0xc7, 0x9e, 0xa4,
+	0xe8, 0xbf, 0xe8, 0xa9, 0x64, 0x93, 0x8a, 0xf4, 0xd4, 0xd2, 0xcb, 0xee, 0xcc, 0x9b, 0xb7, 0xef,
+	0xcd, 0x0c, 0x8b, 0xdb, 0x72, 0xb5, 0xa0, 0x10, 0xe4, 0xe7, 0x28, 0x65, 0x29, 0x13, 0xfe, 0x42,
+	0x70, 0xc9, 0xcd, 0xaa, 0xc2, 0x4f, 0x5b, 0x31, 0x8f, 0xb9, 0x42, 0x82, 0x3c, 0x2a, 0x8a, 0xee,
+	0x33, 0xae, 0xdd, 0x72, 0x42, 0x6f, 0x72, 0xbe, 0xd9, 0xc4, 0xfa, 0x94, 0xae, 0x2c, 0xe4, 0x20,
+	0xaf, 0x16, 0xe6, 0xa1, 0xd9, 0xc2, 0xd5, 0xe5, 0x78, 0x96, 0x51, 0xeb, 0x9f, 0x83, 0x3c, 0x3d,
+	0x2c, 0x12, 0xf3, 0x0a, 0x1b, 0xc0, 0xe2, 0x39, 0x15, 0x96, 0xee, 0x20, 0xaf, 0x3e, 0x3c, 0xff,
+	0xd8, 0x76, 0xba, 0x31, 0x93, 0x49, 0x36, 0xf1, 0x23, 0x9e, 0x06, 0x11, 0x87, 0x94, 0x43, 0x79,
+	0x75, 0x81, 0x4c, 0x55, 0x53, 0xe0, 0x0f, 0xa2, 0x68, 0x40, 0x88, 0xa0, 0x00, 0x61, 0x29, 0xe0,
+	0x5e, 0x60, 0x7c, 0xf0, 0x07, 0xd3, 0xc7, 0x86, 0xea, 0x1c, 0x2c, 0xe4, 0xe8, 0xde, 0xff, 0x5e,
+	0xd3, 0x2f, 0x1e, 0x1e, 0x28, 0xc3, 0xca, 0x7a, 0xdb, 0xd1, 0xc2, 0x92, 0xe5, 0xde, 0xe1, 0xc6,
+	0x7d, 0x94, 0x50, 0x92, 0xcd, 0x28, 0xf9, 0xdd, 0x08, 0x6d, 0x6c, 0x24, 0x94, 0xc5, 0x89, 0x54,
+	0x23, 0xe8, 0x61, 0x99, 0xb9, 0xaf, 0x08, 0xd7, 0x95, 0xd2, 0x25, 0x03, 0xc9, 0xc5, 0xea, 0xc7,
+	0x82, 0x67, 0xb8, 0xb1, 0x10, 0x74, 0xc9, 0x78, 0x06, 0xa3, 0xa2, 0x5c, 0x08, 0x9f, 0x7c, 0xa1,
+	0x0f, 0xdf, 0x7c, 0x2b, 0xc7, 0xbe, 0x47, 0x2b, 0xad, 0xfe, 0x71, 0xa5, 0xc3, 0xeb, 0xf5, 0xce,
+	0x46, 0x9b, 0x9d, 0x8d, 0xde, 0x77, 0x36, 0x7a, 0xd9, 0xdb, 0xda, 0x66, 0x6f, 0x6b, 0x6f, 0x7b,
+	0x5b, 0x7b, 0xec, 0xc5, 0x4c, 0xce, 0xc6, 0x85, 0xa0, 0x4c, 0xb8, 0x88, 0x92, 0x31, 0x9b, 0xab,
+	0x68, 0xce, 0x09, 0x0d, 0x96, 0xfd, 0xe0, 0xe9, 0x18, 0xcf, 0x0d, 0x26, 0x86, 0xfa, 0x27, 0xfd,
+	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xfe, 0xf0, 0xa8, 0x5e, 0x02, 0x00, 0x00,
 }
 
 func (m *NodeMimir) Marshal() (dAtA []byte, err error) {
@@ -235,6 +381,98 @@ func (m *NodeMimirs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledMimir) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMimir) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMimir) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypeMimir(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Value != 0 {
+		i = encodeVarintTypeMimir(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypeMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MimirHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MimirHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MimirHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTypeMimir(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypeMimir(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousValue != 0 {
+		i = encodeVarintTypeMimir(dAtA, i, uint64(m.PreviousValue))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Value != 0 {
+		i = encodeVarintTypeMimir(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypeMimir(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypeMimir(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypeMimir(v)
 	base := offset
@@ -281,6 +519,51 @@ func (m *NodeMimirs) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledMimir) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypeMimir(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTypeMimir(uint64(m.Value))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeMimir(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MimirHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypeMimir(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTypeMimir(uint64(m.Value))
+	}
+	if m.PreviousValue != 0 {
+		n += 1 + sovTypeMimir(uint64(m.PreviousValue))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeMimir(uint64(m.Height))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTypeMimir(uint64(l))
+	}
+	return n
+}
+
 func sovTypeMimir(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -506,6 +789,299 @@ func (m *NodeMimirs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledMimir) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMimir: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMimir: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MimirHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeMimir
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MimirHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MimirHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValue", wireType)
+			}
+			m.PreviousValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeMimir
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeMimir(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeMimir
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypeMimir(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
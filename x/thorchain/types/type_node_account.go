@@ -9,9 +9,11 @@ import (
 	"strings"
 
 	"github.com/blang/semver"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"gitlab.com/thorchain/thornode/v3/common"
 	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+	denomtypes "gitlab.com/thorchain/thornode/v3/x/denom/types"
 )
 
 // Valid check whether the node status is valid or not
@@ -47,16 +49,35 @@ func getNodeStatus(ps string) NodeStatus {
 
 func NewBondProviders(acc cosmos.AccAddress) BondProviders {
 	return BondProviders{
-		NodeAddress:     acc,
-		NodeOperatorFee: cosmos.ZeroUint(),
-		Providers:       make([]BondProvider, 0),
+		NodeAddress:            acc,
+		NodeOperatorFee:        cosmos.ZeroUint(),
+		Providers:              make([]BondProvider, 0),
+		LiquidBondUnits:        cosmos.ZeroUint(),
+		PendingNodeOperatorFee: cosmos.ZeroUint(),
 	}
 }
 
+// GetLiquidBondAddress returns the address of the virtual bond provider which
+// holds the bond backing the liquid bond receipts of a node
+func GetLiquidBondAddress() cosmos.AccAddress {
+	return authtypes.NewModuleAddress(LiquidBondName)
+}
+
+// GetLiquidBondDenomID returns the x/denom id of the liquid bond receipts of a node
+func GetLiquidBondDenomID(nodeAddr cosmos.AccAddress) string {
+	return "lbond-" + nodeAddr.String()
+}
+
+// GetLiquidBondDenom returns the denom of the liquid bond receipts of a node
+func GetLiquidBondDenom(nodeAddr cosmos.AccAddress) string {
+	return denomtypes.ModuleDenomPrefix + GetLiquidBondDenomID(nodeAddr)
+}
+
 func NewBondProvider(acc cosmos.AccAddress) BondProvider {
 	return BondProvider{
-		BondAddress: acc,
-		Bond:        cosmos.ZeroUint(),
+		BondAddress:  acc,
+		Bond:         cosmos.ZeroUint(),
+		UnbondAmount: cosmos.ZeroUint(),
 	}
 }
 
@@ -255,6 +276,11 @@ func (m *BondProvider) IsEmpty() bool {
 	return m.BondAddress.Empty()
 }
 
+// HasUnbondRequest returns true if the bond provider has a pending unbond request
+func (m *BondProvider) HasUnbondRequest() bool {
+	return m.UnbondHeight > 0
+}
+
 func (bp *BondProviders) Has(acc cosmos.AccAddress) bool {
 	provider := bp.Get(acc)
 	return !provider.IsEmpty()
@@ -287,6 +313,64 @@ func (bp *BondProviders) Unbond(bond cosmos.Uint, acc cosmos.AccAddress) {
 	}
 }
 
+// SetUnbondRequest queues an unbond of the given amount for the bond provider,
+// replacing any pending request
+func (bp *BondProviders) SetUnbondRequest(amt cosmos.Uint, acc cosmos.AccAddress, height int64) bool {
+	for i, provider := range bp.Providers {
+		if provider.BondAddress.Equals(acc) {
+			bp.Providers[i].UnbondAmount = amt
+			bp.Providers[i].UnbondHeight = height
+			return true
+		}
+	}
+	return false
+}
+
+// ClearUnbondRequest removes the pending unbond request of the bond provider
+func (bp *BondProviders) ClearUnbondRequest(acc cosmos.AccAddress) bool {
+	for i, provider := range bp.Providers {
+		if provider.BondAddress.Equals(acc) && provider.HasUnbondRequest() {
+			bp.Providers[i].UnbondAmount = cosmos.ZeroUint()
+			bp.Providers[i].UnbondHeight = 0
+			return true
+		}
+	}
+	return false
+}
+
+// GetLiquidBond returns the bond provider holding the liquid bond of the node
+func (bp *BondProviders) GetLiquidBond() BondProvider {
+	return bp.Get(GetLiquidBondAddress())
+}
+
+// HasUnbondRequests returns true if any bond provider has a pending unbond request
+func (bp *BondProviders) HasUnbondRequests() bool {
+	for _, provider := range bp.Providers {
+		if provider.HasUnbondRequest() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPendingNodeOperatorFee returns true if a node operator fee change is scheduled
+func (bp *BondProviders) HasPendingNodeOperatorFee() bool {
+	return bp.PendingNodeOperatorFeeHeight > 0
+}
+
+// SetPendingNodeOperatorFee schedules a node operator fee change at the given
+// height, replacing any pending change
+func (bp *BondProviders) SetPendingNodeOperatorFee(fee cosmos.Uint, height int64) {
+	bp.PendingNodeOperatorFee = fee
+	bp.PendingNodeOperatorFeeHeight = height
+}
+
+// ClearPendingNodeOperatorFee removes the scheduled node operator fee change
+func (bp *BondProviders) ClearPendingNodeOperatorFee() {
+	bp.PendingNodeOperatorFee = cosmos.ZeroUint()
+	bp.PendingNodeOperatorFeeHeight = 0
+}
+
 // remove provider (only if bond is zero)
 func (bp *BondProviders) Remove(acc cosmos.AccAddress) bool {
 	for i, provider := range bp.Providers {
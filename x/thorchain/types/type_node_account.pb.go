@@ -149,6 +149,10 @@ var xxx_messageInfo_NodeAccount proto.InternalMessageInfo
 type BondProvider struct {
 	BondAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=bond_address,json=bondAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"bond_address,omitempty"`
 	Bond        cosmossdk_io_math.Uint                        `protobuf:"bytes,2,opt,name=bond,proto3,customtype=cosmossdk.io/math.Uint" json:"bond"`
+	// pending unbond request submitted while the node was active, 0 unbonds the full bond
+	UnbondAmount cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=unbond_amount,json=unbondAmount,proto3,customtype=cosmossdk.io/math.Uint" json:"unbond_amount"`
+	// height the pending unbond request was submitted, 0 if there is none
+	UnbondHeight int64 `protobuf:"varint,4,opt,name=unbond_height,json=unbondHeight,proto3" json:"unbond_height,omitempty"`
 }
 
 func (m *BondProvider) Reset()      { *m = BondProvider{} }
@@ -187,6 +191,11 @@ type BondProviders struct {
 	NodeAddress     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
 	NodeOperatorFee cosmossdk_io_math.Uint                        `protobuf:"bytes,2,opt,name=node_operator_fee,json=nodeOperatorFee,proto3,customtype=cosmossdk.io/math.Uint" json:"node_operator_fee"`
 	Providers       []BondProvider                                `protobuf:"bytes,3,rep,name=providers,proto3" json:"providers"`
+	// receipt units minted against the bond of the liquid bond provider
+	LiquidBondUnits cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=liquid_bond_units,json=liquidBondUnits,proto3,customtype=cosmossdk.io/math.Uint" json:"liquid_bond_units"`
+	// scheduled node operator fee increase and the height it takes effect
+	PendingNodeOperatorFee       cosmossdk_io_math.Uint `protobuf:"bytes,5,opt,name=pending_node_operator_fee,json=pendingNodeOperatorFee,proto3,customtype=cosmossdk.io/math.Uint" json:"pending_node_operator_fee"`
+	PendingNodeOperatorFeeHeight int64                  `protobuf:"varint,6,opt,name=pending_node_operator_fee_height,json=pendingNodeOperatorFeeHeight,proto3" json:"pending_node_operator_fee_height,omitempty"`
 }
 
 func (m *BondProviders) Reset()      { *m = BondProviders{} }
@@ -270,63 +279,69 @@ func init() {
 func init() { proto.RegisterFile("types/type_node_account.proto", fileDescriptor_27cb5bb39fc19431) }
 
 var fileDescriptor_27cb5bb39fc19431 = []byte{
-	// 885 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x8e, 0x1b, 0x35,
-	0x18, 0x1f, 0x27, 0xd9, 0x74, 0xf3, 0x4d, 0xb2, 0x99, 0x78, 0x51, 0x35, 0x5a, 0x89, 0xc9, 0xb0,
-	0x08, 0x14, 0x4a, 0x9b, 0x88, 0xec, 0xa1, 0x12, 0xb7, 0xcd, 0x22, 0x04, 0xa5, 0x85, 0xd5, 0x64,
-	0x5b, 0x10, 0x12, 0x1a, 0xcd, 0x1f, 0x37, 0xb1, 0x92, 0xd8, 0xc3, 0xd8, 0x09, 0xe4, 0xd6, 0x47,
-	0xe0, 0x09, 0x38, 0x71, 0xe0, 0x1d, 0x78, 0x81, 0x3d, 0xf6, 0x58, 0x21, 0x14, 0xd1, 0xec, 0x0b,
-	0x70, 0xee, 0x09, 0xd9, 0x9e, 0x09, 0xe9, 0x89, 0x0a, 0x89, 0x4b, 0xc6, 0xfe, 0xfd, 0x3e, 0xff,
-	0xfc, 0xfd, 0x75, 0xe0, 0x6d, 0xb9, 0xce, 0x88, 0x18, 0xa8, 0xdf, 0x90, 0xf1, 0x94, 0x84, 0x51,
-	0x92, 0xf0, 0x25, 0x93, 0xfd, 0x2c, 0xe7, 0x92, 0xe3, 0x03, 0x4d, 0x9f, 0x1c, 0x27, 0x7c, 0xb1,
-	0xe0, 0x6c, 0x60, 0x3e, 0x86, 0x3b, 0x79, 0x6b, 0xc2, 0x27, 0x5c, 0x2f, 0x07, 0x6a, 0x65, 0xd0,
-	0xd3, 0xdf, 0xea, 0x60, 0x7f, 0xc9, 0x53, 0x72, 0x6e, 0x74, 0xf0, 0x15, 0x34, 0x8d, 0x6e, 0x9a,
-	0xe6, 0x44, 0x08, 0x17, 0xf9, 0xa8, 0xd7, 0x1c, 0x7d, 0xf4, 0x6a, 0xd3, 0xbd, 0x37, 0xa1, 0x72,
-	0xba, 0x8c, 0xfb, 0x09, 0x5f, 0x0c, 0x12, 0x2e, 0x16, 0x5c, 0x14, 0x9f, 0x7b, 0x22, 0x9d, 0x69,
-	0x8f, 0x44, 0xff, 0x3c, 0x49, 0xce, 0xcd, 0xc1, 0xc0, 0x56, 0x32, 0xc5, 0x06, 0x7f, 0x00, 0x75,
-	0x21, 0x23, 0xb9, 0x14, 0x6e, 0xc5, 0x47, 0xbd, 0xa3, 0x61, 0xa7, 0x6f, 0xec, 0xd5, 0xcd, 0x63,
-	0x4d, 0x04, 0x85, 0x01, 0xbe, 0x0f, 0x76, 0xb6, 0x8c, 0xc3, 0x19, 0x59, 0x87, 0x82, 0x48, 0xb7,
-	0xea, 0xa3, 0x9e, 0x3d, 0xec, 0xf4, 0x8b, 0x50, 0x2e, 0x97, 0xf1, 0x17, 0x64, 0x3d, 0x26, 0x72,
-	0x54, 0xbb, 0xde, 0x74, 0xad, 0xa0, 0x91, 0x95, 0x00, 0x3e, 0x83, 0xdb, 0xab, 0x68, 0x4e, 0xd3,
-	0x48, 0xf2, 0x3c, 0x4c, 0x38, 0x13, 0x61, 0xa1, 0xe3, 0xd6, 0x7c, 0xd4, 0x6b, 0x04, 0xc7, 0x3b,
-	0xf6, 0x82, 0x33, 0x61, 0x84, 0xf0, 0x10, 0x6a, 0x31, 0x67, 0xa9, 0x7b, 0xa0, 0x4c, 0x46, 0x9e,
-	0xd2, 0xfc, 0x7d, 0xd3, 0xbd, 0x6d, 0x02, 0x13, 0xe9, 0xac, 0x4f, 0xf9, 0x60, 0x11, 0xc9, 0x69,
-	0xff, 0x31, 0x65, 0x32, 0xd0, 0xb6, 0xb8, 0x0f, 0xc7, 0x51, 0x22, 0xe9, 0x8a, 0x84, 0xf1, 0x9c,
-	0x27, 0xb3, 0x70, 0x4a, 0xe8, 0x64, 0x2a, 0xdd, 0xba, 0x8f, 0x7a, 0xd5, 0xa0, 0x63, 0xa8, 0x91,
-	0x62, 0x3e, 0xd3, 0x04, 0x7e, 0x02, 0x4d, 0x75, 0x6e, 0x97, 0xd2, 0x5b, 0xfa, 0xae, 0xb3, 0x57,
-	0x9b, 0xee, 0x60, 0x42, 0xe5, 0x3c, 0x32, 0x29, 0x95, 0x53, 0x9e, 0x27, 0xd3, 0x88, 0x32, 0xbd,
-	0x52, 0xc9, 0x1b, 0xac, 0xce, 0xca, 0x0a, 0xee, 0x92, 0xaa, 0x84, 0xca, 0xa4, 0xbe, 0x03, 0x4d,
-	0x93, 0xb3, 0x50, 0x50, 0x96, 0x10, 0xf7, 0x50, 0x3b, 0x60, 0x1b, 0x6c, 0xac, 0x20, 0xfc, 0x21,
-	0x74, 0x04, 0x9d, 0x30, 0x92, 0x87, 0x0b, 0xb2, 0x88, 0x49, 0x2e, 0xa6, 0x34, 0x73, 0x1b, 0x7e,
-	0xb5, 0xd7, 0x08, 0x1c, 0x43, 0x3c, 0xda, 0xe1, 0xf8, 0x2e, 0xe0, 0x9c, 0x7c, 0xbf, 0x24, 0x42,
-	0x92, 0x34, 0x94, 0x3c, 0x9c, 0x93, 0x68, 0x45, 0x5c, 0xf0, 0x51, 0xef, 0x30, 0x70, 0x76, 0xcc,
-	0x15, 0x7f, 0xa8, 0x70, 0xfc, 0x3e, 0xb4, 0x9f, 0xf2, 0x3c, 0xd9, 0x37, 0xb5, 0xb5, 0x69, 0xcb,
-	0xc0, 0xa5, 0x5d, 0x17, 0x6c, 0xcd, 0x86, 0x22, 0xe1, 0x39, 0x71, 0x9b, 0x3e, 0xea, 0xd5, 0x02,
-	0xd0, 0xd0, 0x58, 0x21, 0xf8, 0x2e, 0x00, 0xcd, 0x76, 0xc9, 0x69, 0xe9, 0xe4, 0xb4, 0xb6, 0x9b,
-	0x6e, 0xe3, 0xf3, 0xcb, 0x32, 0xec, 0x06, 0xcd, 0xca, 0xa0, 0x5d, 0xb8, 0xb5, 0x22, 0xb9, 0xa0,
-	0x9c, 0xb9, 0x47, 0xba, 0xac, 0xe5, 0x16, 0xbf, 0x0b, 0x35, 0xd5, 0x54, 0x6e, 0x5b, 0x77, 0x58,
-	0x7b, 0xaf, 0xc3, 0xae, 0xd6, 0x19, 0x09, 0x34, 0x89, 0xdf, 0x83, 0xa3, 0x05, 0x15, 0x82, 0xb2,
-	0x89, 0x29, 0x9e, 0x70, 0x1d, 0xed, 0x50, 0xab, 0x40, 0x75, 0xdd, 0x04, 0xf6, 0xc1, 0x5e, 0x44,
-	0x94, 0x49, 0xc2, 0x22, 0x95, 0xd9, 0x8e, 0x0e, 0x6c, 0x1f, 0xfa, 0xb8, 0xf6, 0xec, 0x0f, 0xdf,
-	0x3a, 0xfd, 0x19, 0x41, 0x73, 0xc4, 0x59, 0x7a, 0x99, 0xf3, 0x15, 0x4d, 0x49, 0xae, 0xc6, 0xe7,
-	0xb5, 0x5a, 0xff, 0xf7, 0xf1, 0xd9, 0xaf, 0x74, 0xd9, 0xa5, 0x95, 0x37, 0xef, 0x52, 0xed, 0x20,
-	0x3a, 0xfd, 0x0b, 0x41, 0x6b, 0xdf, 0x41, 0xf1, 0x3f, 0x0d, 0xf8, 0x03, 0xe8, 0x68, 0x55, 0x9e,
-	0x91, 0x5c, 0x0f, 0xe0, 0x53, 0x42, 0xde, 0xd0, 0xdd, 0xb6, 0x3a, 0xf8, 0x55, 0x71, 0xee, 0x53,
-	0x42, 0xf0, 0x7d, 0x68, 0x64, 0xa5, 0xbb, 0x6e, 0xd5, 0xaf, 0xf6, 0xec, 0xe1, 0x71, 0x51, 0xcd,
-	0xfd, 0x50, 0x76, 0x2f, 0x40, 0x69, 0x5b, 0x84, 0xfc, 0x1d, 0xd8, 0x8f, 0x28, 0x7b, 0xc0, 0x29,
-	0x7b, 0x18, 0x09, 0xa9, 0xa6, 0x75, 0x1e, 0x09, 0x19, 0x26, 0xd3, 0x88, 0x4d, 0x48, 0x5a, 0x4e,
-	0x2b, 0x32, 0xd3, 0xaa, 0xa8, 0x0b, 0xc3, 0x14, 0xd3, 0xba, 0xd7, 0x60, 0x95, 0xd7, 0x1a, 0xcc,
-	0xc8, 0xdf, 0x89, 0x01, 0xfe, 0x79, 0xb5, 0xb0, 0x0d, 0xb7, 0x1e, 0xb3, 0x19, 0xe3, 0x3f, 0x30,
-	0xc7, 0xc2, 0x6d, 0xb0, 0xbf, 0x9e, 0x52, 0x49, 0xe6, 0x54, 0x0d, 0x8a, 0x83, 0x14, 0x3b, 0x96,
-	0x11, 0x4b, 0xe3, 0xb5, 0x53, 0xc1, 0x0d, 0x38, 0x08, 0x48, 0x94, 0xae, 0x9d, 0x2a, 0x06, 0xa8,
-	0x9f, 0xeb, 0x67, 0xc2, 0xa9, 0xe1, 0x26, 0x1c, 0x7e, 0x42, 0x45, 0x14, 0xcf, 0x49, 0xea, 0x1c,
-	0x9c, 0xd4, 0x7e, 0xfd, 0xc5, 0x43, 0x77, 0x2e, 0xe0, 0xb0, 0xec, 0x5b, 0xdc, 0x81, 0x96, 0xfa,
-	0x3e, 0x29, 0x1f, 0x2f, 0xc7, 0xc2, 0x2d, 0x68, 0x18, 0x68, 0x39, 0x97, 0x0e, 0x52, 0xd7, 0xaa,
-	0x6d, 0xe9, 0x47, 0xc5, 0x88, 0x8c, 0xbe, 0xb9, 0x7e, 0xe9, 0x59, 0x2f, 0x5e, 0x7a, 0xd6, 0xb3,
-	0xad, 0x67, 0x5d, 0x6f, 0x3d, 0xf4, 0x7c, 0xeb, 0xa1, 0x3f, 0xb7, 0x1e, 0xfa, 0xe9, 0xc6, 0xb3,
-	0x9e, 0xdf, 0x78, 0xd6, 0x8b, 0x1b, 0xcf, 0xfa, 0x76, 0xf8, 0xaf, 0x4f, 0xd0, 0x8f, 0xfb, 0xb8,
-	0xaa, 0x42, 0x5c, 0xd7, 0x7f, 0x1d, 0x67, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x2b, 0xbf,
-	0x8d, 0x8d, 0x06, 0x00, 0x00,
+	// 979 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x16, 0x25, 0xf9, 0xa2, 0x43, 0xc9, 0xa6, 0xc6, 0x3f, 0x0c, 0xfe, 0x46, 0x4b, 0xb1, 0x0e,
+	0x5a, 0xa8, 0x69, 0x22, 0xa1, 0xf6, 0x22, 0x40, 0x77, 0x96, 0x8b, 0xa0, 0x75, 0x93, 0xd4, 0xa0,
+	0xed, 0xf4, 0x02, 0x14, 0x04, 0x2f, 0x13, 0x69, 0x60, 0x71, 0x86, 0xe1, 0x0c, 0xd5, 0x6a, 0x97,
+	0x65, 0x97, 0x7d, 0x88, 0x2e, 0xfa, 0x0e, 0x7d, 0x01, 0x2f, 0xb3, 0x0c, 0x8a, 0xc2, 0x6d, 0xe4,
+	0xb7, 0xc8, 0xaa, 0x98, 0x19, 0x92, 0x95, 0x7b, 0x81, 0xd3, 0x02, 0xdd, 0xf0, 0x72, 0xbe, 0x73,
+	0x3e, 0xce, 0xf9, 0xce, 0x85, 0xf0, 0xa6, 0x98, 0xa7, 0x98, 0x0f, 0xe5, 0xd5, 0xa7, 0x2c, 0xc6,
+	0x7e, 0x10, 0x45, 0x2c, 0xa7, 0x62, 0x90, 0x66, 0x4c, 0x30, 0xb4, 0xa2, 0xe0, 0x9d, 0xad, 0x88,
+	0x25, 0x09, 0xa3, 0x43, 0x7d, 0xd3, 0xd8, 0xce, 0xff, 0xc6, 0x6c, 0xcc, 0xd4, 0xe3, 0x50, 0x3e,
+	0x69, 0xeb, 0xee, 0x8f, 0xab, 0x60, 0x3e, 0x62, 0x31, 0x3e, 0xd0, 0x3c, 0xe8, 0x14, 0xda, 0x9a,
+	0x37, 0x8e, 0x33, 0xcc, 0xb9, 0x6d, 0xb8, 0x46, 0xbf, 0x3d, 0x7a, 0xff, 0xd5, 0x65, 0xef, 0xee,
+	0x98, 0x88, 0x49, 0x1e, 0x0e, 0x22, 0x96, 0x0c, 0x23, 0xc6, 0x13, 0xc6, 0x8b, 0xdb, 0x5d, 0x1e,
+	0x9f, 0xab, 0x13, 0xf1, 0xc1, 0x41, 0x14, 0x1d, 0xe8, 0x40, 0xcf, 0x94, 0x34, 0xc5, 0x0b, 0x7a,
+	0x17, 0x56, 0xb9, 0x08, 0x44, 0xce, 0xed, 0xba, 0x6b, 0xf4, 0x37, 0xf6, 0xba, 0x03, 0xed, 0x2f,
+	0xbf, 0x7c, 0xa2, 0x00, 0xaf, 0x70, 0x40, 0xf7, 0xc0, 0x4c, 0xf3, 0xd0, 0x3f, 0xc7, 0x73, 0x9f,
+	0x63, 0x61, 0x37, 0x5c, 0xa3, 0x6f, 0xee, 0x75, 0x07, 0x45, 0x2a, 0xc7, 0x79, 0xf8, 0x09, 0x9e,
+	0x9f, 0x60, 0x31, 0x6a, 0x5e, 0x5c, 0xf6, 0x6a, 0x5e, 0x2b, 0x2d, 0x0d, 0x68, 0x1f, 0xb6, 0x67,
+	0xc1, 0x94, 0xc4, 0x81, 0x60, 0x99, 0x1f, 0x31, 0xca, 0xfd, 0x82, 0xc7, 0x6e, 0xba, 0x46, 0xbf,
+	0xe5, 0x6d, 0x55, 0xe8, 0x21, 0xa3, 0x5c, 0x13, 0xa1, 0x3d, 0x68, 0x86, 0x8c, 0xc6, 0xf6, 0x8a,
+	0x74, 0x19, 0x39, 0x92, 0xf3, 0xa7, 0xcb, 0xde, 0xb6, 0x4e, 0x8c, 0xc7, 0xe7, 0x03, 0xc2, 0x86,
+	0x49, 0x20, 0x26, 0x83, 0x33, 0x42, 0x85, 0xa7, 0x7c, 0xd1, 0x00, 0xb6, 0x82, 0x48, 0x90, 0x19,
+	0xf6, 0xc3, 0x29, 0x8b, 0xce, 0xfd, 0x09, 0x26, 0xe3, 0x89, 0xb0, 0x57, 0x5d, 0xa3, 0xdf, 0xf0,
+	0xba, 0x1a, 0x1a, 0x49, 0xe4, 0x23, 0x05, 0xa0, 0xc7, 0xd0, 0x96, 0x71, 0x95, 0xa4, 0x6b, 0xea,
+	0x5b, 0xfb, 0xaf, 0x2e, 0x7b, 0xc3, 0x31, 0x11, 0xd3, 0x40, 0x4b, 0x2a, 0x26, 0x2c, 0x8b, 0x26,
+	0x01, 0xa1, 0xea, 0x49, 0x8a, 0x37, 0x9c, 0xed, 0x97, 0x15, 0xac, 0x44, 0x95, 0x44, 0xa5, 0xa8,
+	0x6f, 0x41, 0x5b, 0x6b, 0xe6, 0x73, 0x42, 0x23, 0x6c, 0xaf, 0xab, 0x03, 0x98, 0xda, 0x76, 0x22,
+	0x4d, 0xe8, 0x3d, 0xe8, 0x72, 0x32, 0xa6, 0x38, 0xf3, 0x13, 0x9c, 0x84, 0x38, 0xe3, 0x13, 0x92,
+	0xda, 0x2d, 0xb7, 0xd1, 0x6f, 0x79, 0x96, 0x06, 0x1e, 0x56, 0x76, 0x74, 0x07, 0x50, 0x86, 0x9f,
+	0xe6, 0x98, 0x0b, 0x1c, 0xfb, 0x82, 0xf9, 0x53, 0x1c, 0xcc, 0xb0, 0x0d, 0xae, 0xd1, 0x5f, 0xf7,
+	0xac, 0x0a, 0x39, 0x65, 0x0f, 0xa4, 0x1d, 0xbd, 0x03, 0x9b, 0x4f, 0x58, 0x16, 0x2d, 0xbb, 0x9a,
+	0xca, 0xb5, 0xa3, 0xcd, 0xa5, 0x5f, 0x0f, 0x4c, 0x85, 0xfa, 0x3c, 0x62, 0x19, 0xb6, 0xdb, 0xae,
+	0xd1, 0x6f, 0x7a, 0xa0, 0x4c, 0x27, 0xd2, 0x82, 0xee, 0x00, 0x90, 0xb4, 0x12, 0xa7, 0xa3, 0xc4,
+	0xe9, 0x2c, 0x2e, 0x7b, 0xad, 0x8f, 0x8f, 0xcb, 0xb4, 0x5b, 0x24, 0x2d, 0x93, 0xb6, 0x61, 0x6d,
+	0x86, 0x33, 0x4e, 0x18, 0xb5, 0x37, 0x54, 0x59, 0xcb, 0x57, 0x74, 0x0b, 0x9a, 0xb2, 0xa9, 0xec,
+	0x4d, 0xd5, 0x61, 0x9b, 0x4b, 0x1d, 0x76, 0x3a, 0x4f, 0xb1, 0xa7, 0x40, 0xf4, 0x36, 0x6c, 0x24,
+	0x84, 0x73, 0x42, 0xc7, 0xba, 0x78, 0xdc, 0xb6, 0xd4, 0x81, 0x3a, 0x85, 0x55, 0xd5, 0x8d, 0x23,
+	0x17, 0xcc, 0x24, 0x20, 0x54, 0x60, 0x1a, 0x48, 0x65, 0xbb, 0x2a, 0xb1, 0x65, 0xd3, 0x07, 0xcd,
+	0x67, 0x3f, 0xbb, 0xb5, 0xdd, 0x6f, 0xeb, 0xd0, 0x1e, 0x31, 0x1a, 0x1f, 0x67, 0x6c, 0x46, 0x62,
+	0x9c, 0xc9, 0xf1, 0xb9, 0x56, 0xeb, 0x7f, 0x3f, 0x3e, 0xcb, 0x95, 0x2e, 0xbb, 0xb4, 0xfe, 0x0f,
+	0xba, 0xf4, 0x10, 0x3a, 0x39, 0xd5, 0x67, 0x49, 0xe4, 0x64, 0xab, 0x49, 0xba, 0x39, 0xb8, 0xad,
+	0x83, 0x0e, 0x54, 0x0c, 0xba, 0x55, 0x91, 0x14, 0x4d, 0xde, 0x54, 0x3d, 0x56, 0x38, 0xe9, 0xfe,
+	0x56, 0x52, 0x18, 0xbb, 0xbf, 0x34, 0xa0, 0xb3, 0x2c, 0x05, 0xff, 0x8f, 0x56, 0xc9, 0x11, 0x74,
+	0x15, 0x2b, 0x4b, 0x71, 0xa6, 0x46, 0xfd, 0x09, 0xc6, 0xaf, 0x29, 0xcc, 0xa6, 0x0c, 0xfc, 0xb4,
+	0x88, 0xbb, 0x8f, 0x31, 0xba, 0x07, 0xad, 0xb4, 0x3c, 0xae, 0xdd, 0x70, 0x1b, 0x7d, 0x73, 0x6f,
+	0xab, 0xe8, 0x9b, 0xe5, 0x54, 0xaa, 0x5d, 0x53, 0xa5, 0x76, 0x04, 0xdd, 0x29, 0x79, 0x9a, 0x93,
+	0xd8, 0x57, 0xe2, 0xe4, 0x94, 0x08, 0xae, 0xd7, 0xcc, 0xcd, 0x87, 0xd0, 0x81, 0x92, 0xff, 0x4c,
+	0x86, 0xa1, 0x2f, 0xe0, 0xff, 0x29, 0xa6, 0xb1, 0x6c, 0xc9, 0x3f, 0x27, 0xf6, 0x7a, 0x7b, 0x69,
+	0xbb, 0x20, 0x78, 0xf4, 0x87, 0xfc, 0xee, 0x83, 0xfb, 0xb7, 0xd4, 0xd7, 0xd7, 0xd6, 0x1b, 0x7f,
+	0xcd, 0x70, 0xad, 0xc2, 0x5f, 0x81, 0xf9, 0x90, 0xd0, 0x23, 0x46, 0xe8, 0x83, 0x80, 0x0b, 0xb9,
+	0x06, 0xa7, 0x01, 0x17, 0x7e, 0x34, 0x09, 0xe8, 0x18, 0x57, 0x1d, 0x62, 0xe8, 0x35, 0x28, 0xa1,
+	0x43, 0x8d, 0x14, 0x6b, 0x70, 0x69, 0x72, 0xeb, 0xd7, 0x26, 0x57, 0xd3, 0xdf, 0x0e, 0x01, 0x7e,
+	0xff, 0x1d, 0x20, 0x13, 0xd6, 0xce, 0xe8, 0x39, 0x65, 0x5f, 0x53, 0xab, 0x86, 0x36, 0xc1, 0xfc,
+	0x6c, 0x42, 0x04, 0x9e, 0x12, 0xb9, 0x81, 0x2c, 0x43, 0xa2, 0x27, 0x22, 0xa0, 0x71, 0x38, 0xb7,
+	0xea, 0xa8, 0x05, 0x2b, 0x1e, 0x0e, 0xe2, 0xb9, 0xd5, 0x40, 0x00, 0xab, 0x07, 0x6a, 0xff, 0x5a,
+	0x4d, 0xd4, 0x86, 0xf5, 0x0f, 0x09, 0x0f, 0xc2, 0x29, 0x8e, 0xad, 0x95, 0x9d, 0xe6, 0x0f, 0xdf,
+	0x3b, 0xc6, 0xed, 0x43, 0x58, 0x2f, 0x17, 0x02, 0xea, 0x42, 0x47, 0xde, 0x1f, 0x97, 0x7f, 0x05,
+	0xab, 0x86, 0x3a, 0xd0, 0xd2, 0xa6, 0x7c, 0x2a, 0x2c, 0x43, 0x7e, 0x56, 0xbe, 0x96, 0xe7, 0xa8,
+	0x6b, 0x92, 0xd1, 0xe7, 0x17, 0x2f, 0x9d, 0xda, 0x8b, 0x97, 0x4e, 0xed, 0xd9, 0xc2, 0xa9, 0x5d,
+	0x2c, 0x1c, 0xe3, 0xf9, 0xc2, 0x31, 0x7e, 0x5d, 0x38, 0xc6, 0x77, 0x57, 0x4e, 0xed, 0xf9, 0x95,
+	0x53, 0x7b, 0x71, 0xe5, 0xd4, 0xbe, 0xdc, 0xbb, 0x71, 0xb7, 0x7f, 0xb3, 0x6c, 0x97, 0x4d, 0x17,
+	0xae, 0xaa, 0x7f, 0xf2, 0xfe, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x91, 0x11, 0x9d, 0xe6,
+	0x07, 0x00, 0x00,
 }
 
 func (m *NodeAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +515,21 @@ func (m *BondProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondHeight != 0 {
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(m.UnbondHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.UnbondAmount.Size()
+		i -= size
+		if _, err := m.UnbondAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Bond.Size()
 		i -= size
@@ -540,6 +570,31 @@ func (m *BondProviders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PendingNodeOperatorFeeHeight != 0 {
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(m.PendingNodeOperatorFeeHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.PendingNodeOperatorFee.Size()
+		i -= size
+		if _, err := m.PendingNodeOperatorFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LiquidBondUnits.Size()
+		i -= size
+		if _, err := m.LiquidBondUnits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeNodeAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Providers) > 0 {
 		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -698,6 +753,11 @@ func (m *BondProvider) Size() (n int) {
 	}
 	l = m.Bond.Size()
 	n += 1 + l + sovTypeNodeAccount(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovTypeNodeAccount(uint64(l))
+	if m.UnbondHeight != 0 {
+		n += 1 + sovTypeNodeAccount(uint64(m.UnbondHeight))
+	}
 	return n
 }
 
@@ -719,6 +779,13 @@ func (m *BondProviders) Size() (n int) {
 			n += 1 + l + sovTypeNodeAccount(uint64(l))
 		}
 	}
+	l = m.LiquidBondUnits.Size()
+	n += 1 + l + sovTypeNodeAccount(uint64(l))
+	l = m.PendingNodeOperatorFee.Size()
+	n += 1 + l + sovTypeNodeAccount(uint64(l))
+	if m.PendingNodeOperatorFeeHeight != 0 {
+		n += 1 + sovTypeNodeAccount(uint64(m.PendingNodeOperatorFeeHeight))
+	}
 	return n
 }
 
@@ -751,6 +818,8 @@ func (this *BondProvider) String() string {
 	s := strings.Join([]string{`&BondProvider{`,
 		`BondAddress:` + fmt.Sprintf("%v", this.BondAddress) + `,`,
 		`Bond:` + fmt.Sprintf("%v", this.Bond) + `,`,
+		`UnbondAmount:` + fmt.Sprintf("%v", this.UnbondAmount) + `,`,
+		`UnbondHeight:` + fmt.Sprintf("%v", this.UnbondHeight) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -768,6 +837,9 @@ func (this *BondProviders) String() string {
 		`NodeAddress:` + fmt.Sprintf("%v", this.NodeAddress) + `,`,
 		`NodeOperatorFee:` + fmt.Sprintf("%v", this.NodeOperatorFee) + `,`,
 		`Providers:` + repeatedStringForProviders + `,`,
+		`LiquidBondUnits:` + fmt.Sprintf("%v", this.LiquidBondUnits) + `,`,
+		`PendingNodeOperatorFee:` + fmt.Sprintf("%v", this.PendingNodeOperatorFee) + `,`,
+		`PendingNodeOperatorFeeHeight:` + fmt.Sprintf("%v", this.PendingNodeOperatorFeeHeight) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1373,6 +1445,59 @@ func (m *BondProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondHeight", wireType)
+			}
+			m.UnbondHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeNodeAccount(dAtA[iNdEx:])
@@ -1525,6 +1650,93 @@ func (m *BondProviders) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidBondUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeNodeAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingNodeOperatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNodeOperatorFeeHeight", wireType)
+			}
+			m.PendingNodeOperatorFeeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeNodeAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingNodeOperatorFeeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeNodeAccount(dAtA[iNdEx:])
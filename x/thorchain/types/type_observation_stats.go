@@ -0,0 +1,130 @@
+package types
+
+import (
+	"sort"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+// ObservationMissed is the lag recorded for an observation or network fee vote the
+// node did not make before quorum, or within ObservationDelayFlexibility after.
+const ObservationMissed int64 = -1
+
+// ObservationSummary aggregates a series of observation lags.
+type ObservationSummary struct {
+	Count     int64
+	MedianLag int64
+	MaxLag    int64
+	Missed    int64
+	Late      int64
+}
+
+// NewObservationStats create a new instance of ObservationStats
+func NewObservationStats(addr cosmos.AccAddress, chain common.Chain) ObservationStats {
+	return ObservationStats{
+		NodeAddress: addr,
+		Chain:       chain,
+	}
+}
+
+// Valid check whether the observation stats has all necessary values
+func (m *ObservationStats) Valid() bool {
+	return !m.NodeAddress.Empty() && !m.Chain.IsEmpty()
+}
+
+// RecordObservation appends the lag of an observation of a tx, keeping the most
+// recent window observations.
+func (m *ObservationStats) RecordObservation(height int64, txID string, lag, window int64) {
+	m.ObservationLags, m.ObservationTxIds = appendLag(m.ObservationLags, m.ObservationTxIds, txID, lag, window)
+	m.LastUpdatedHeight = height
+}
+
+// RecordLateObservation replaces the missed observation of a tx with the lag of an
+// observation made after quorum was reached.
+func (m *ObservationStats) RecordLateObservation(height int64, txID string, lag int64) {
+	if replaceMissed(m.ObservationLags, m.ObservationTxIds, txID, lag) {
+		m.LastUpdatedHeight = height
+	}
+}
+
+// RecordNetworkFee appends the lag of a network fee vote, keeping the most recent
+// window votes.
+func (m *ObservationStats) RecordNetworkFee(height int64, id string, lag, window int64) {
+	m.NetworkFeeLags, m.NetworkFeeIds = appendLag(m.NetworkFeeLags, m.NetworkFeeIds, id, lag, window)
+	m.LastUpdatedHeight = height
+}
+
+// RecordLateNetworkFee replaces the missed vote of a network fee with the lag of a
+// vote made after quorum was reached.
+func (m *ObservationStats) RecordLateNetworkFee(height int64, id string, lag int64) {
+	if replaceMissed(m.NetworkFeeLags, m.NetworkFeeIds, id, lag) {
+		m.LastUpdatedHeight = height
+	}
+}
+
+// ObservationSummary summarises the tracked observations
+func (m *ObservationStats) ObservationSummary() ObservationSummary {
+	return summarizeLags(m.ObservationLags)
+}
+
+// NetworkFeeSummary summarises the tracked network fee votes
+func (m *ObservationStats) NetworkFeeSummary() ObservationSummary {
+	return summarizeLags(m.NetworkFeeLags)
+}
+
+func appendLag(lags []int64, ids []string, id string, lag, window int64) ([]int64, []string) {
+	if window <= 0 {
+		return nil, nil
+	}
+	// keep the ids aligned with the lags
+	if len(ids) != len(lags) {
+		ids = make([]string, len(lags))
+	}
+	lags = append(lags, lag)
+	ids = append(ids, id)
+	if int64(len(lags)) > window {
+		lags = lags[int64(len(lags))-window:]
+		ids = ids[int64(len(ids))-window:]
+	}
+	return lags, ids
+}
+
+func replaceMissed(lags []int64, ids []string, id string, lag int64) bool {
+	if len(ids) != len(lags) {
+		return false
+	}
+	for i := len(lags) - 1; i >= 0; i-- {
+		if ids[i] == id {
+			if lags[i] != ObservationMissed {
+				return false
+			}
+			lags[i] = lag
+			return true
+		}
+	}
+	return false
+}
+
+func summarizeLags(lags []int64) ObservationSummary {
+	summary := ObservationSummary{Count: int64(len(lags))}
+	observed := make([]int64, 0, len(lags))
+	for _, lag := range lags {
+		switch {
+		case lag == ObservationMissed:
+			summary.Missed++
+			continue
+		case lag > 0:
+			summary.Late++
+		}
+		if lag > summary.MaxLag {
+			summary.MaxLag = lag
+		}
+		observed = append(observed, lag)
+	}
+	if len(observed) > 0 {
+		sort.Slice(observed, func(i, j int) bool { return observed[i] < observed[j] })
+		summary.MedianLag = observed[len(observed)/2]
+	}
+	return summary
+}
@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/type_observation_stats.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	gitlab_com_thorchain_thornode_v3_common "gitlab.com/thorchain/thornode/v3/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ObservationStats tracks how far behind quorum a node observed the most recent
+// transactions and network fees of a chain.
+type ObservationStats struct {
+	NodeAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=node_address,json=nodeAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"node_address,omitempty"`
+	Chain       gitlab_com_thorchain_thornode_v3_common.Chain `protobuf:"bytes,2,opt,name=chain,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Chain" json:"chain,omitempty"`
+	// blocks behind quorum of the most recent observations, oldest first, -1 if missed
+	ObservationLags []int64 `protobuf:"varint,3,rep,packed,name=observation_lags,json=observationLags,proto3" json:"observation_lags,omitempty"`
+	// blocks behind quorum of the most recent network fee votes, oldest first, -1 if missed
+	NetworkFeeLags    []int64 `protobuf:"varint,4,rep,packed,name=network_fee_lags,json=networkFeeLags,proto3" json:"network_fee_lags,omitempty"`
+	LastUpdatedHeight int64   `protobuf:"varint,5,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height,omitempty"`
+	// tx id of each tracked observation, aligned with observation_lags
+	ObservationTxIds []string `protobuf:"bytes,6,rep,name=observation_tx_ids,json=observationTxIds,proto3" json:"observation_tx_ids,omitempty"`
+	// chain height, fee rate and tx size of each tracked network fee vote, aligned with network_fee_lags
+	NetworkFeeIds []string `protobuf:"bytes,7,rep,name=network_fee_ids,json=networkFeeIds,proto3" json:"network_fee_ids,omitempty"`
+}
+
+func (m *ObservationStats) Reset()         { *m = ObservationStats{} }
+func (m *ObservationStats) String() string { return proto.CompactTextString(m) }
+func (*ObservationStats) ProtoMessage()    {}
+func (*ObservationStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a2cfc3e70dc644a, []int{0}
+}
+func (m *ObservationStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObservationStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObservationStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObservationStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObservationStats.Merge(m, src)
+}
+func (m *ObservationStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObservationStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObservationStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObservationStats proto.InternalMessageInfo
+
+func (m *ObservationStats) GetNodeAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NodeAddress
+	}
+	return nil
+}
+
+func (m *ObservationStats) GetChain() gitlab_com_thorchain_thornode_v3_common.Chain {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ObservationStats) GetObservationLags() []int64 {
+	if m != nil {
+		return m.ObservationLags
+	}
+	return nil
+}
+
+func (m *ObservationStats) GetNetworkFeeLags() []int64 {
+	if m != nil {
+		return m.NetworkFeeLags
+	}
+	return nil
+}
+
+func (m *ObservationStats) GetLastUpdatedHeight() int64 {
+	if m != nil {
+		return m.LastUpdatedHeight
+	}
+	return 0
+}
+
+func (m *ObservationStats) GetObservationTxIds() []string {
+	if m != nil {
+		return m.ObservationTxIds
+	}
+	return nil
+}
+
+func (m *ObservationStats) GetNetworkFeeIds() []string {
+	if m != nil {
+		return m.NetworkFeeIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ObservationStats)(nil), "types.ObservationStats")
+}
+
+func init() {
+	proto.RegisterFile("types/type_observation_stats.proto", fileDescriptor_6a2cfc3e70dc644a)
+}
+
+var fileDescriptor_6a2cfc3e70dc644a = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcf, 0x4e, 0xe2, 0x40,
+	0x18, 0xa7, 0xdb, 0x85, 0x0d, 0xb3, 0xec, 0x82, 0xa3, 0x87, 0xc6, 0x43, 0x6d, 0x38, 0x98, 0x9a,
+	0x48, 0x1b, 0xe5, 0x09, 0xc0, 0x44, 0x25, 0x21, 0x31, 0xa9, 0x78, 0xf1, 0x32, 0x19, 0x3a, 0x63,
+	0xdb, 0x40, 0x3b, 0xa4, 0xdf, 0x80, 0xf8, 0x0e, 0x1e, 0x7c, 0x2c, 0x8f, 0x1c, 0x3d, 0x19, 0x03,
+	0x6f, 0xe1, 0xc9, 0xcc, 0x94, 0x48, 0x6f, 0x5e, 0xda, 0x6f, 0x7e, 0xff, 0xf2, 0x9b, 0xf9, 0x50,
+	0x5b, 0x3e, 0xcd, 0x38, 0xf8, 0xea, 0x4b, 0xc4, 0x18, 0x78, 0xbe, 0xa0, 0x32, 0x11, 0x19, 0x01,
+	0x49, 0x25, 0x78, 0xb3, 0x5c, 0x48, 0x81, 0xab, 0x5a, 0x73, 0x78, 0x10, 0x89, 0x48, 0x68, 0xc4,
+	0x57, 0x53, 0x41, 0xb6, 0x9f, 0x4d, 0xd4, 0xba, 0xd9, 0x19, 0x6f, 0x95, 0x0f, 0x8f, 0x50, 0x23,
+	0x13, 0x8c, 0x13, 0xca, 0x58, 0xce, 0x01, 0x2c, 0xc3, 0x31, 0xdc, 0x46, 0xff, 0xec, 0xf3, 0xfd,
+	0xa8, 0x13, 0x25, 0x32, 0x9e, 0x8f, 0xbd, 0x50, 0xa4, 0x7e, 0x28, 0x20, 0x15, 0xb0, 0xfd, 0x75,
+	0x80, 0x4d, 0x74, 0x0d, 0xf0, 0x7a, 0x61, 0xd8, 0x2b, 0x8c, 0xc1, 0x5f, 0x15, 0xb3, 0x3d, 0xe0,
+	0x2b, 0x54, 0x0d, 0x63, 0x9a, 0x64, 0xd6, 0x2f, 0xc7, 0x70, 0xeb, 0xdf, 0x71, 0x53, 0x5a, 0xc4,
+	0xc9, 0x58, 0xe4, 0x9a, 0xd7, 0x93, 0x32, 0xfa, 0x8b, 0xae, 0x1f, 0x8a, 0x34, 0x15, 0x99, 0x77,
+	0xa1, 0x88, 0xa0, 0xf0, 0xe3, 0x13, 0xd4, 0x2a, 0xdf, 0x75, 0x4a, 0x23, 0xb0, 0x4c, 0xc7, 0x74,
+	0xcd, 0xa0, 0x59, 0xc2, 0x87, 0x34, 0x02, 0xec, 0xa2, 0x56, 0xc6, 0xe5, 0xa3, 0xc8, 0x27, 0xe4,
+	0x81, 0xf3, 0x42, 0xfa, 0x5b, 0x4b, 0xff, 0x6f, 0xf1, 0x4b, 0xce, 0xb5, 0xd2, 0x43, 0xfb, 0x53,
+	0x0a, 0x92, 0xcc, 0x67, 0x8c, 0x4a, 0xce, 0x48, 0xcc, 0x93, 0x28, 0x96, 0x56, 0xd5, 0x31, 0x5c,
+	0x33, 0xd8, 0x53, 0xd4, 0x5d, 0xc1, 0x5c, 0x6b, 0x02, 0x9f, 0x22, 0x5c, 0x2e, 0x21, 0x97, 0x24,
+	0x61, 0x60, 0xd5, 0x1c, 0xd3, 0xad, 0x07, 0xe5, 0x7a, 0xa3, 0xe5, 0x80, 0x01, 0x3e, 0x46, 0xcd,
+	0x72, 0x0f, 0x25, 0xfd, 0xa3, 0xa5, 0xff, 0x76, 0x35, 0x06, 0x0c, 0xfa, 0xc3, 0xd7, 0xb5, 0x6d,
+	0xac, 0xd6, 0xb6, 0xf1, 0xb1, 0xb6, 0x8d, 0x97, 0x8d, 0x5d, 0x59, 0x6d, 0xec, 0xca, 0xdb, 0xc6,
+	0xae, 0xdc, 0x9f, 0xff, 0xf8, 0x54, 0xcb, 0x32, 0xae, 0x36, 0x31, 0xae, 0xe9, 0x1d, 0x77, 0xbf,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0x65, 0xdf, 0x6b, 0xab, 0x26, 0x02, 0x00, 0x00,
+}
+
+func (m *ObservationStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObservationStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObservationStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkFeeIds) > 0 {
+		for iNdEx := len(m.NetworkFeeIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NetworkFeeIds[iNdEx])
+			copy(dAtA[i:], m.NetworkFeeIds[iNdEx])
+			i = encodeVarintTypeObservationStats(dAtA, i, uint64(len(m.NetworkFeeIds[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ObservationTxIds) > 0 {
+		for iNdEx := len(m.ObservationTxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObservationTxIds[iNdEx])
+			copy(dAtA[i:], m.ObservationTxIds[iNdEx])
+			i = encodeVarintTypeObservationStats(dAtA, i, uint64(len(m.ObservationTxIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastUpdatedHeight != 0 {
+		i = encodeVarintTypeObservationStats(dAtA, i, uint64(m.LastUpdatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NetworkFeeLags) > 0 {
+		dAtA2 := make([]byte, len(m.NetworkFeeLags)*10)
+		var j1 int
+		for _, num1 := range m.NetworkFeeLags {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypeObservationStats(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObservationLags) > 0 {
+		dAtA4 := make([]byte, len(m.ObservationLags)*10)
+		var j3 int
+		for _, num1 := range m.ObservationLags {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTypeObservationStats(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Chain) > 0 {
+		i -= len(m.Chain)
+		copy(dAtA[i:], m.Chain)
+		i = encodeVarintTypeObservationStats(dAtA, i, uint64(len(m.Chain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeAddress) > 0 {
+		i -= len(m.NodeAddress)
+		copy(dAtA[i:], m.NodeAddress)
+		i = encodeVarintTypeObservationStats(dAtA, i, uint64(len(m.NodeAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeObservationStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeObservationStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ObservationStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeAddress)
+	if l > 0 {
+		n += 1 + l + sovTypeObservationStats(uint64(l))
+	}
+	l = len(m.Chain)
+	if l > 0 {
+		n += 1 + l + sovTypeObservationStats(uint64(l))
+	}
+	if len(m.ObservationLags) > 0 {
+		l = 0
+		for _, e := range m.ObservationLags {
+			l += sovTypeObservationStats(uint64(e))
+		}
+		n += 1 + sovTypeObservationStats(uint64(l)) + l
+	}
+	if len(m.NetworkFeeLags) > 0 {
+		l = 0
+		for _, e := range m.NetworkFeeLags {
+			l += sovTypeObservationStats(uint64(e))
+		}
+		n += 1 + sovTypeObservationStats(uint64(l)) + l
+	}
+	if m.LastUpdatedHeight != 0 {
+		n += 1 + sovTypeObservationStats(uint64(m.LastUpdatedHeight))
+	}
+	if len(m.ObservationTxIds) > 0 {
+		for _, s := range m.ObservationTxIds {
+			l = len(s)
+			n += 1 + l + sovTypeObservationStats(uint64(l))
+		}
+	}
+	if len(m.NetworkFeeIds) > 0 {
+		for _, s := range m.NetworkFeeIds {
+			l = len(s)
+			n += 1 + l + sovTypeObservationStats(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypeObservationStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeObservationStats(x uint64) (n int) {
+	return sovTypeObservationStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ObservationStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeObservationStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObservationStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservationStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeAddress = append(m.NodeAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeAddress == nil {
+				m.NodeAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chain = gitlab_com_thorchain_thornode_v3_common.Chain(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypeObservationStats
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ObservationLags = append(m.ObservationLags, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypeObservationStats
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypeObservationStats
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypeObservationStats
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ObservationLags) == 0 {
+					m.ObservationLags = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypeObservationStats
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ObservationLags = append(m.ObservationLags, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationLags", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypeObservationStats
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NetworkFeeLags = append(m.NetworkFeeLags, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypeObservationStats
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypeObservationStats
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypeObservationStats
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NetworkFeeLags) == 0 {
+					m.NetworkFeeLags = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypeObservationStats
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NetworkFeeLags = append(m.NetworkFeeLags, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeLags", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+			}
+			m.LastUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationTxIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationTxIds = append(m.ObservationTxIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkFeeIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeObservationStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkFeeIds = append(m.NetworkFeeIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeObservationStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeObservationStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypeObservationStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypeObservationStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeObservationStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeObservationStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypeObservationStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypeObservationStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypeObservationStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypeObservationStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypeObservationStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypeObservationStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,85 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+)
+
+type ObservationStatsSuite struct{}
+
+var _ = Suite(&ObservationStatsSuite{})
+
+func (s ObservationStatsSuite) TestObservationStats(c *C) {
+	stats := ObservationStats{}
+	c.Check(stats.Valid(), Equals, false)
+
+	stats = NewObservationStats(GetRandomBech32Addr(), common.BTCChain)
+	c.Check(stats.Valid(), Equals, true)
+
+	stats.RecordObservation(10, "tx1", 0, 4)
+	stats.RecordObservation(11, "tx2", ObservationMissed, 4)
+	stats.RecordObservation(12, "tx3", ObservationMissed, 4)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{0, -1, -1})
+	c.Check(stats.ObservationTxIds, DeepEquals, []string{"tx1", "tx2", "tx3"})
+	c.Check(stats.LastUpdatedHeight, Equals, int64(12))
+
+	// a late observation replaces the miss of the same tx
+	stats.RecordLateObservation(14, "tx2", 3)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{0, 3, -1})
+	c.Check(stats.LastUpdatedHeight, Equals, int64(14))
+
+	// a late observation of an observed or untracked tx is ignored
+	stats.RecordLateObservation(15, "tx1", 1)
+	stats.RecordLateObservation(15, "tx2", 1)
+	stats.RecordLateObservation(15, "tx4", 1)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{0, 3, -1})
+	c.Check(stats.LastUpdatedHeight, Equals, int64(14))
+
+	// only the most recent window observations are kept
+	stats.RecordObservation(16, "tx4", 0, 4)
+	stats.RecordObservation(17, "tx5", 0, 4)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{3, -1, 0, 0})
+	c.Check(stats.ObservationTxIds, DeepEquals, []string{"tx2", "tx3", "tx4", "tx5"})
+	stats.RecordObservation(18, "tx6", 0, 2)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{0, 0})
+	c.Check(stats.ObservationTxIds, DeepEquals, []string{"tx5", "tx6"})
+
+	// a late observation of a tx no longer tracked is ignored
+	stats.RecordLateObservation(19, "tx3", 1)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{0, 0})
+	c.Check(stats.LastUpdatedHeight, Equals, int64(18))
+
+	// lags without ids are realigned rather than matched
+	stats.ObservationTxIds = nil
+	stats.RecordLateObservation(20, "", 1)
+	c.Check(stats.ObservationLags, DeepEquals, []int64{0, 0})
+	stats.RecordObservation(20, "tx7", ObservationMissed, 4)
+	c.Check(stats.ObservationTxIds, DeepEquals, []string{"", "", "tx7"})
+
+	stats.RecordNetworkFee(20, "100/10", ObservationMissed, 10)
+	stats.RecordNetworkFee(21, "101/10", ObservationMissed, 10)
+	stats.RecordLateNetworkFee(22, "100/10", 1)
+	c.Check(stats.NetworkFeeLags, DeepEquals, []int64{1, -1})
+	c.Check(stats.NetworkFeeIds, DeepEquals, []string{"100/10", "101/10"})
+}
+
+func (s ObservationStatsSuite) TestObservationSummary(c *C) {
+	stats := NewObservationStats(GetRandomBech32Addr(), common.BTCChain)
+	summary := stats.ObservationSummary()
+	c.Check(summary, DeepEquals, ObservationSummary{})
+
+	stats.ObservationLags = []int64{0, 3, -1, 0, 1, -1, 5}
+	summary = stats.ObservationSummary()
+	c.Check(summary.Count, Equals, int64(7))
+	c.Check(summary.Missed, Equals, int64(2))
+	c.Check(summary.Late, Equals, int64(3))
+	c.Check(summary.MedianLag, Equals, int64(1))
+	c.Check(summary.MaxLag, Equals, int64(5))
+
+	stats.NetworkFeeLags = []int64{-1, -1}
+	summary = stats.NetworkFeeSummary()
+	c.Check(summary.Count, Equals, int64(2))
+	c.Check(summary.Missed, Equals, int64(2))
+	c.Check(summary.MedianLag, Equals, int64(0))
+}
@@ -83,20 +83,34 @@ func (m *ObservedTxVoter) AddOutTx(in common.Tx) bool {
 	// As an Asset->RUNE affiliate fee could also be RUNE,
 	// allow multiple OutTxs with blank TxIDs.
 	// AddOutTxs is still expected to only be called once for each.
+	// A batched outbound pays several recipients with the same TxID.
+	batched := false
 	if !in.ID.Equals(common.BlankTxID) {
 		for _, t := range m.OutTxs {
-			if in.ID.Equals(t.ID) {
+			if !in.ID.Equals(t.ID) {
+				continue
+			}
+			if in.ToAddress.Equals(t.ToAddress) {
 				return true
 			}
+			batched = true
 		}
 	}
 	m.OutTxs = append(m.OutTxs, in)
 	for i := range m.Txs {
-		m.Txs[i].SetDone(in.ID, len(m.Actions))
+		if batched {
+			m.Txs[i].SetBatchDone(in.ID, len(m.Actions))
+		} else {
+			m.Txs[i].SetDone(in.ID, len(m.Actions))
+		}
 	}
 
 	if !m.Tx.IsEmpty() {
-		m.Tx.SetDone(in.ID, len(m.Actions))
+		if batched {
+			m.Tx.SetBatchDone(in.ID, len(m.Actions))
+		} else {
+			m.Tx.SetDone(in.ID, len(m.Actions))
+		}
 	}
 
 	return true
@@ -274,6 +274,21 @@ func (TypeObservedTxSuite) TestSetTxToComplete(c *C) {
 	c.Assert(voter.IsDone(), Equals, true)
 	voter.Tx = *voter.GetTx(activeNodes)
 	c.Assert(voter.GetTx(activeNodes).Equals(voter.Tx), Equals, true)
+
+	// a batched outbound pays several actions with the same tx id
+	toi2 := toi
+	toi2.ToAddress = GetRandomETHAddress()
+	voter.Actions = append(voter.Actions, toi2)
+	voter.Tx.Status = common.Status_incomplete
+	c.Assert(voter.IsDone(), Equals, false)
+	tx2 := tx
+	tx2.ToAddress = toi2.ToAddress
+	c.Assert(voter.AddOutTx(tx2), Equals, true)
+	c.Assert(voter.AddOutTx(tx2), Equals, true)
+	c.Assert(voter.OutTxs, HasLen, 2)
+	c.Assert(voter.IsDone(), Equals, true)
+	c.Assert(voter.Tx.OutHashes, DeepEquals, []string{tx.ID.String(), tx.ID.String()})
+	c.Assert(voter.Tx.Status, Equals, common.Status_done)
 }
 
 func (TypeObservedTxSuite) TestAddOutTx(c *C) {
@@ -341,6 +356,21 @@ func (TypeObservedTxSuite) TestAddOutTx(c *C) {
 	c.Assert(voter.IsDone(), Equals, true)
 	voter.Tx = *voter.GetTx(activeNodes)
 	c.Assert(voter.GetTx(activeNodes).Equals(voter.Tx), Equals, true)
+
+	// a batched outbound pays several actions with the same tx id
+	toi2 := toi
+	toi2.ToAddress = GetRandomETHAddress()
+	voter.Actions = append(voter.Actions, toi2)
+	voter.Tx.Status = common.Status_incomplete
+	c.Assert(voter.IsDone(), Equals, false)
+	tx2 := tx
+	tx2.ToAddress = toi2.ToAddress
+	c.Assert(voter.AddOutTx(tx2), Equals, true)
+	c.Assert(voter.AddOutTx(tx2), Equals, true)
+	c.Assert(voter.OutTxs, HasLen, 2)
+	c.Assert(voter.IsDone(), Equals, true)
+	c.Assert(voter.Tx.OutHashes, DeepEquals, []string{tx.ID.String(), tx.ID.String()})
+	c.Assert(voter.Tx.Status, Equals, common.Status_done)
 }
 
 func (TypeObservedTxSuite) TestObservedTxEquals(c *C) {
@@ -440,6 +470,65 @@ func (TypeObservedTxSuite) TestObservedTxEquals(c *C) {
 	targetLimit = cosmos.NewUint(100)
 	tx2.AggregatorTargetLimit = &targetLimit
 	c.Assert(tx1.Equals(tx2), Equals, true)
+
+	// test batched outputs
+	output := common.NewTxOutput(GetRandomETHAddress(), coins2)
+	tx1.Outputs = []common.TxOutput{output}
+	c.Assert(tx1.Equals(tx2), Equals, false)
+	tx2.Outputs = []common.TxOutput{common.NewTxOutput(GetRandomETHAddress(), coins2)}
+	c.Assert(tx1.Equals(tx2), Equals, false)
+	tx2.Outputs = []common.TxOutput{output}
+	c.Assert(tx1.Equals(tx2), Equals, true)
+}
+
+func (TypeObservedTxSuite) TestObservedTxOutputTxs(c *C) {
+	vaultPubKey := GetRandomPubKey()
+	vaultAddr, err := vaultPubKey.GetAddress(common.BTCChain)
+	c.Assert(err, IsNil)
+	to1, to2, to3 := GetRandomBTCAddress(), GetRandomBTCAddress(), GetRandomBTCAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		vaultAddr,
+		to1,
+		common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(1000))),
+		common.Gas{common.NewCoin(common.BTCAsset, cosmos.NewUint(3001))},
+		"OUT:"+GetRandomTxHash().String(),
+	)
+	obsTx := common.NewObservedTx(tx, 1, vaultPubKey, 1)
+
+	// a tx paying a single recipient is returned as is
+	txs := obsTx.OutputTxs()
+	c.Assert(txs, HasLen, 1)
+	c.Assert(txs[0].Equals(obsTx), Equals, true)
+	c.Assert(obsTx.OutputCoins().EqualsEx(tx.Coins), Equals, true)
+
+	obsTx.Outputs = []common.TxOutput{
+		common.NewTxOutput(to2, common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(2000)))),
+		common.NewTxOutput(to3, common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(3000)))),
+	}
+	c.Assert(obsTx.Valid(), IsNil)
+	c.Assert(obsTx.OutputCoins().EqualsEx(common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(6000)))), Equals, true)
+
+	txs = obsTx.OutputTxs()
+	c.Assert(txs, HasLen, 3)
+	for i, to := range []common.Address{to1, to2, to3} {
+		c.Check(txs[i].Tx.ID.Equals(tx.ID), Equals, true)
+		c.Check(txs[i].Tx.ToAddress.Equals(to), Equals, true)
+		c.Check(txs[i].Tx.Coins.EqualsEx(common.NewCoins(common.NewCoin(common.BTCAsset, cosmos.NewUint(uint64(1000*(i+1)))))), Equals, true)
+		c.Check(txs[i].Tx.Memo, Equals, tx.Memo)
+		c.Check(txs[i].Outputs, HasLen, 0)
+		c.Check(txs[i].Valid(), IsNil)
+	}
+	// the gas is shared, with the remainder on the first recipient
+	c.Check(txs[0].Tx.Gas[0].Amount.Uint64(), Equals, uint64(1001))
+	c.Check(txs[1].Tx.Gas[0].Amount.Uint64(), Equals, uint64(1000))
+	c.Check(txs[2].Tx.Gas[0].Amount.Uint64(), Equals, uint64(1000))
+	// the source tx is left untouched
+	c.Check(obsTx.Tx.Gas[0].Amount.Uint64(), Equals, uint64(3001))
+	c.Check(obsTx.Outputs, HasLen, 2)
+
+	obsTx.Outputs[1].ToAddress = common.NoAddress
+	c.Assert(obsTx.Valid(), NotNil)
 }
 
 func (TypeObservedTxSuite) TestObservedTxVote(c *C) {
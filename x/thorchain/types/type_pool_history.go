@@ -0,0 +1,39 @@
+package types
+
+import (
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+// NewPoolHistory create a new instance of PoolHistory
+func NewPoolHistory(asset common.Asset, startHeight int64) PoolHistory {
+	return PoolHistory{
+		Asset:            asset,
+		StartHeight:      startHeight,
+		LiquidityFees:    cosmos.ZeroUint(),
+		Rewards:          cosmos.ZeroUint(),
+		SynthYield:       cosmos.ZeroUint(),
+		PolRuneDeposited: cosmos.ZeroUint(),
+		PolRuneWithdrawn: cosmos.ZeroUint(),
+	}
+}
+
+// Valid check whether the pool history has all necessary values
+func (m *PoolHistory) Valid() bool {
+	return !m.Asset.IsEmpty() && m.StartHeight >= 0
+}
+
+// IsEmpty returns true when nothing has been recorded in the pool history
+func (m *PoolHistory) IsEmpty() bool {
+	return m.LiquidityFees.IsZero() && m.Rewards.IsZero() && m.SynthYield.IsZero() &&
+		m.PolRuneDeposited.IsZero() && m.PolRuneWithdrawn.IsZero()
+}
+
+// Add accumulates the amounts recorded in the given pool history
+func (m *PoolHistory) Add(other PoolHistory) {
+	m.LiquidityFees = m.LiquidityFees.Add(other.LiquidityFees)
+	m.Rewards = m.Rewards.Add(other.Rewards)
+	m.SynthYield = m.SynthYield.Add(other.SynthYield)
+	m.PolRuneDeposited = m.PolRuneDeposited.Add(other.PolRuneDeposited)
+	m.PolRuneWithdrawn = m.PolRuneWithdrawn.Add(other.PolRuneWithdrawn)
+}
@@ -0,0 +1,605 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/type_pool_history.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "gitlab.com/thorchain/thornode/v3/common"
+	gitlab_com_thorchain_thornode_v3_common "gitlab.com/thorchain/thornode/v3/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolHistory accumulates the income and protocol owned liquidity movements of
+// a pool over one history interval.
+type PoolHistory struct {
+	Asset gitlab_com_thorchain_thornode_v3_common.Asset `protobuf:"bytes,1,opt,name=asset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"asset"`
+	// the first block height of the interval
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// liquidity fees collected by the pool in RUNE
+	LiquidityFees cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=liquidity_fees,json=liquidityFees,proto3,customtype=cosmossdk.io/math.Uint" json:"liquidity_fees"`
+	// block rewards paid to the pool in RUNE
+	Rewards cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=rewards,proto3,customtype=cosmossdk.io/math.Uint" json:"rewards"`
+	// synths minted as yield to the savers of the pool
+	SynthYield cosmossdk_io_math.Uint `protobuf:"bytes,5,opt,name=synth_yield,json=synthYield,proto3,customtype=cosmossdk.io/math.Uint" json:"synth_yield"`
+	// RUNE deposited into the pool by protocol owned liquidity
+	PolRuneDeposited cosmossdk_io_math.Uint `protobuf:"bytes,6,opt,name=pol_rune_deposited,json=polRuneDeposited,proto3,customtype=cosmossdk.io/math.Uint" json:"pol_rune_deposited"`
+	// RUNE withdrawn from the pool by protocol owned liquidity
+	PolRuneWithdrawn cosmossdk_io_math.Uint `protobuf:"bytes,7,opt,name=pol_rune_withdrawn,json=polRuneWithdrawn,proto3,customtype=cosmossdk.io/math.Uint" json:"pol_rune_withdrawn"`
+}
+
+func (m *PoolHistory) Reset()         { *m = PoolHistory{} }
+func (m *PoolHistory) String() string { return proto.CompactTextString(m) }
+func (*PoolHistory) ProtoMessage()    {}
+func (*PoolHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a26c95e180fa873b, []int{0}
+}
+func (m *PoolHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolHistory.Merge(m, src)
+}
+func (m *PoolHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolHistory proto.InternalMessageInfo
+
+func (m *PoolHistory) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PoolHistory)(nil), "types.PoolHistory")
+}
+
+func init() { proto.RegisterFile("types/type_pool_history.proto", fileDescriptor_a26c95e180fa873b) }
+
+var fileDescriptor_a26c95e180fa873b = []byte{
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6b, 0xe2, 0x40,
+	0x18, 0x86, 0x93, 0x75, 0x55, 0x76, 0xb2, 0x2e, 0xcb, 0xec, 0xb2, 0x04, 0x61, 0xa3, 0xbb, 0x27,
+	0x2f, 0x9b, 0x80, 0xb2, 0xd0, 0x5b, 0xa9, 0xb4, 0xc5, 0x83, 0x87, 0x12, 0x28, 0xa5, 0x5e, 0x42,
+	0x74, 0xa6, 0x99, 0xa1, 0xc9, 0x7c, 0xe9, 0xcc, 0x58, 0x9b, 0x7f, 0xd1, 0x9f, 0xe5, 0xd1, 0x63,
+	0xe9, 0x41, 0x8a, 0xde, 0xfa, 0x2b, 0x4a, 0x12, 0x2d, 0xf6, 0x54, 0x7b, 0x49, 0x3e, 0xde, 0x77,
+	0x9e, 0x67, 0x0e, 0xf3, 0xa1, 0xdf, 0x3a, 0x4b, 0xa9, 0xf2, 0xf2, 0x6f, 0x90, 0x02, 0xc4, 0x01,
+	0xe3, 0x4a, 0x83, 0xcc, 0xdc, 0x54, 0x82, 0x06, 0x5c, 0x2d, 0xea, 0xe6, 0x8f, 0x09, 0x24, 0x09,
+	0x08, 0xaf, 0xfc, 0x95, 0x5d, 0xf3, 0x67, 0x04, 0x11, 0x14, 0xa3, 0x97, 0x4f, 0x65, 0xfa, 0xf7,
+	0xb9, 0x82, 0xac, 0x33, 0x80, 0x78, 0x50, 0x7a, 0xf0, 0x08, 0x55, 0x43, 0xa5, 0xa8, 0xb6, 0xcd,
+	0xb6, 0xd9, 0xb1, 0xba, 0x0d, 0x77, 0xe3, 0x38, 0xca, 0xc3, 0xfe, 0xff, 0xf9, 0xb2, 0x65, 0x3c,
+	0x2e, 0x5b, 0xff, 0x22, 0xae, 0xe3, 0x70, 0x9c, 0x97, 0x9e, 0x66, 0x20, 0x27, 0x2c, 0xe4, 0xa2,
+	0x98, 0x04, 0x10, 0xea, 0xdd, 0xf6, 0xbc, 0x5d, 0xcc, 0x2f, 0x95, 0xf8, 0x0f, 0xfa, 0xaa, 0x74,
+	0x28, 0x75, 0xc0, 0x28, 0x8f, 0x98, 0xb6, 0x3f, 0xb5, 0xcd, 0x4e, 0xc5, 0xb7, 0x8a, 0x6c, 0x50,
+	0x44, 0xf8, 0x04, 0x7d, 0x8b, 0xf9, 0xcd, 0x94, 0x13, 0xae, 0xb3, 0xe0, 0x8a, 0x52, 0x65, 0x57,
+	0xda, 0x66, 0xe7, 0x4b, 0xdf, 0xd9, 0x5c, 0xfc, 0x6b, 0x02, 0x2a, 0x01, 0xa5, 0xc8, 0xb5, 0xcb,
+	0xc1, 0x4b, 0x42, 0xcd, 0xdc, 0x73, 0x2e, 0xb4, 0xdf, 0x78, 0xa5, 0x4e, 0x29, 0x55, 0xf8, 0x00,
+	0xd5, 0x25, 0x9d, 0x85, 0x92, 0x28, 0xfb, 0xf3, 0x5e, 0xfc, 0xf6, 0x38, 0x3e, 0x44, 0x96, 0xca,
+	0x84, 0x66, 0x41, 0xc6, 0x69, 0x4c, 0xec, 0xea, 0x5e, 0x34, 0x2a, 0x90, 0xcb, 0x9c, 0xc0, 0x43,
+	0x84, 0x53, 0x88, 0x03, 0x39, 0x15, 0x34, 0x20, 0x34, 0x05, 0xc5, 0x35, 0x25, 0x76, 0x6d, 0x2f,
+	0xcf, 0xf7, 0x14, 0x62, 0x7f, 0x2a, 0xe8, 0xf1, 0x96, 0x7b, 0x63, 0x9b, 0x71, 0xcd, 0x88, 0x0c,
+	0x67, 0xc2, 0xae, 0x7f, 0xc8, 0x76, 0xb1, 0xe5, 0xfa, 0xc3, 0xf9, 0xca, 0x31, 0x17, 0x2b, 0xc7,
+	0x7c, 0x5a, 0x39, 0xe6, 0xfd, 0xda, 0x31, 0x16, 0x6b, 0xc7, 0x78, 0x58, 0x3b, 0xc6, 0xa8, 0xfb,
+	0xee, 0x83, 0xde, 0xed, 0xe6, 0xf9, 0x96, 0x8d, 0x6b, 0xc5, 0x06, 0xf5, 0x5e, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0xe6, 0xd9, 0xaf, 0x75, 0x94, 0x02, 0x00, 0x00,
+}
+
+func (m *PoolHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PolRuneWithdrawn.Size()
+		i -= size
+		if _, err := m.PolRuneWithdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PolRuneDeposited.Size()
+		i -= size
+		if _, err := m.PolRuneDeposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SynthYield.Size()
+		i -= size
+		if _, err := m.SynthYield.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Rewards.Size()
+		i -= size
+		if _, err := m.Rewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidityFees.Size()
+		i -= size
+		if _, err := m.LiquidityFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintTypePoolHistory(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Asset.Size()
+		i -= size
+		if _, err := m.Asset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypePoolHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypePoolHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset.Size()
+	n += 1 + l + sovTypePoolHistory(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovTypePoolHistory(uint64(m.StartHeight))
+	}
+	l = m.LiquidityFees.Size()
+	n += 1 + l + sovTypePoolHistory(uint64(l))
+	l = m.Rewards.Size()
+	n += 1 + l + sovTypePoolHistory(uint64(l))
+	l = m.SynthYield.Size()
+	n += 1 + l + sovTypePoolHistory(uint64(l))
+	l = m.PolRuneDeposited.Size()
+	n += 1 + l + sovTypePoolHistory(uint64(l))
+	l = m.PolRuneWithdrawn.Size()
+	n += 1 + l + sovTypePoolHistory(uint64(l))
+	return n
+}
+
+func sovTypePoolHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypePoolHistory(x uint64) (n int) {
+	return sovTypePoolHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypePoolHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynthYield", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SynthYield.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolRuneDeposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolRuneDeposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolRuneWithdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolRuneWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypePoolHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypePoolHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypePoolHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypePoolHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypePoolHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypePoolHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypePoolHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypePoolHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypePoolHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypePoolHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypePoolHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+type PoolHistorySuite struct{}
+
+var _ = Suite(&PoolHistorySuite{})
+
+func (s *PoolHistorySuite) TestPoolHistory(c *C) {
+	history := NewPoolHistory(common.BTCAsset, 14400)
+	c.Check(history.Valid(), Equals, true)
+	c.Check(history.IsEmpty(), Equals, true)
+
+	record := NewPoolHistory(common.BTCAsset, 14400)
+	record.LiquidityFees = cosmos.NewUint(100)
+	record.Rewards = cosmos.NewUint(200)
+	history.Add(record)
+	history.Add(record)
+	record = NewPoolHistory(common.BTCAsset, 14400)
+	record.SynthYield = cosmos.NewUint(5)
+	record.PolRuneDeposited = cosmos.NewUint(10)
+	record.PolRuneWithdrawn = cosmos.NewUint(3)
+	history.Add(record)
+
+	c.Check(history.IsEmpty(), Equals, false)
+	c.Check(history.LiquidityFees.Uint64(), Equals, uint64(200))
+	c.Check(history.Rewards.Uint64(), Equals, uint64(400))
+	c.Check(history.SynthYield.Uint64(), Equals, uint64(5))
+	c.Check(history.PolRuneDeposited.Uint64(), Equals, uint64(10))
+	c.Check(history.PolRuneWithdrawn.Uint64(), Equals, uint64(3))
+
+	history = NewPoolHistory(common.EmptyAsset, 0)
+	c.Check(history.Valid(), Equals, false)
+}
@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/type_pool_price.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolPriceObservation is the cumulative price of a pool recorded at the start of
+// a block. The time weighted average price between two observations is the
+// difference of their cumulative prices divided by the blocks between them.
+type PoolPriceObservation struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the pool price in RUNE per asset (1e8) at the start of the block
+	Price cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.Uint" json:"price"`
+	// the sum of the pool price of each block before the observation
+	CumulativePrice cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=cosmossdk.io/math.Uint" json:"cumulative_price"`
+}
+
+func (m *PoolPriceObservation) Reset()         { *m = PoolPriceObservation{} }
+func (m *PoolPriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceObservation) ProtoMessage()    {}
+func (*PoolPriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1869c5918377d1ab, []int{0}
+}
+func (m *PoolPriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceObservation.Merge(m, src)
+}
+func (m *PoolPriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPriceObservation proto.InternalMessageInfo
+
+func (m *PoolPriceObservation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PoolPriceObservation)(nil), "types.PoolPriceObservation")
+}
+
+func init() { proto.RegisterFile("types/type_pool_price.proto", fileDescriptor_1869c5918377d1ab) }
+
+var fileDescriptor_1869c5918377d1ab = []byte{
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0xa9, 0x2c, 0x48,
+	0x2d, 0xd6, 0x07, 0x91, 0xf1, 0x05, 0xf9, 0xf9, 0x39, 0xf1, 0x05, 0x45, 0x99, 0xc9, 0xa9, 0x7a,
+	0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xac, 0x60, 0x49, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0,
+	0x88, 0x3e, 0x88, 0x05, 0x91, 0x54, 0x5a, 0xcf, 0xc8, 0x25, 0x12, 0x90, 0x9f, 0x9f, 0x13, 0x00,
+	0xd2, 0xe0, 0x9f, 0x54, 0x9c, 0x5a, 0x54, 0x96, 0x58, 0x92, 0x99, 0x9f, 0x27, 0x24, 0xc6, 0xc5,
+	0x96, 0x91, 0x9a, 0x99, 0x9e, 0x51, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x1c, 0x04, 0xe5, 0x09,
+	0x99, 0x70, 0xb1, 0x82, 0x0d, 0x97, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0x3b, 0x71, 0x4f,
+	0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb1, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0xe2, 0x94, 0x6c, 0xbd,
+	0xcc, 0x7c, 0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0xbd, 0xd0, 0xcc, 0xbc, 0x92, 0x20, 0x88, 0x62, 0x21,
+	0x4f, 0x2e, 0x81, 0xe4, 0xd2, 0xdc, 0xd2, 0x9c, 0xc4, 0x92, 0xcc, 0xb2, 0x54, 0x88, 0xeb, 0x24,
+	0x98, 0x89, 0x32, 0x80, 0x1f, 0xa1, 0x0f, 0xec, 0x46, 0x27, 0x9f, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
+	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
+	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x49, 0x4c, 0xd2, 0x4b, 0xce, 0xcf,
+	0xd5, 0x2f, 0xc9, 0xc8, 0x2f, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x03, 0xb3, 0xf2, 0xf2, 0x53, 0x52,
+	0xf5, 0xcb, 0x8c, 0xf5, 0x2b, 0x90, 0xc5, 0x41, 0xa1, 0x92, 0xc4, 0x06, 0x0e, 0x06, 0x63, 0x40,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x74, 0x4c, 0xe6, 0x42, 0x01, 0x00, 0x00,
+}
+
+func (m *PoolPriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolPriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolPrice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypePoolPrice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTypePoolPrice(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypePoolPrice(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypePoolPrice(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolPriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypePoolPrice(uint64(m.Height))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTypePoolPrice(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovTypePoolPrice(uint64(l))
+	return n
+}
+
+func sovTypePoolPrice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypePoolPrice(x uint64) (n int) {
+	return sovTypePoolPrice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolPriceObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypePoolPrice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolPriceObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolPriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePoolPrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypePoolPrice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypePoolPrice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypePoolPrice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypePoolPrice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypePoolPrice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypePoolPrice(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypePoolPrice
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypePoolPrice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypePoolPrice
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypePoolPrice
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypePoolPrice
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypePoolPrice
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypePoolPrice        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypePoolPrice          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypePoolPrice = fmt.Errorf("proto: unexpected end of group")
+)
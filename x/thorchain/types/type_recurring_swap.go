@@ -0,0 +1,105 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"gitlab.com/thorchain/thornode/v3/common"
+	"gitlab.com/thorchain/thornode/v3/common/cosmos"
+)
+
+const (
+	RecurringSwapFillPending = "pending"
+	RecurringSwapFillFilled  = "filled"
+	RecurringSwapFillFailed  = "failed"
+
+	RecurringSwapStatusCreated   = "created"
+	RecurringSwapStatusCompleted = "completed"
+	RecurringSwapStatusCancelled = "cancelled"
+)
+
+type RecurringSwaps []RecurringSwap
+
+// NewRecurringSwap create a new instance of RecurringSwap
+func NewRecurringSwap(hash common.TxID, owner cosmos.AccAddress, source, target common.Asset, destination common.Address, amount cosmos.Uint, interval, quantity uint64, nextHeight int64) RecurringSwap {
+	return RecurringSwap{
+		TxID:        hash,
+		Owner:       owner,
+		SourceAsset: source,
+		TargetAsset: target,
+		Destination: destination,
+		Amount:      amount,
+		Interval:    interval,
+		Quantity:    quantity,
+		NextHeight:  nextHeight,
+	}
+}
+
+// Valid check whether the recurring swap is valid
+func (m *RecurringSwap) Valid() error {
+	if m.TxID.IsEmpty() {
+		return fmt.Errorf("tx id cannot be empty")
+	}
+	if m.Owner.Empty() {
+		return fmt.Errorf("owner cannot be empty")
+	}
+	if !m.SourceAsset.IsTradeAsset() {
+		return fmt.Errorf("source asset must be a trade asset")
+	}
+	if m.TargetAsset.IsEmpty() {
+		return fmt.Errorf("target asset cannot be empty")
+	}
+	if m.Destination.IsEmpty() {
+		return fmt.Errorf("destination cannot be empty")
+	}
+	if m.Amount.IsZero() {
+		return fmt.Errorf("amount cannot be zero")
+	}
+	if m.Interval < 1 {
+		return fmt.Errorf("interval cannot be less than 1")
+	}
+	if m.Quantity < 1 {
+		return fmt.Errorf("quantity cannot be less than 1")
+	}
+	return nil
+}
+
+// IsDone returns true once every swap of the recurring swap has been queued
+func (m *RecurringSwap) IsDone() bool {
+	return m.Count >= m.Quantity
+}
+
+// SwapsToTradeAccount returns true when the swap output stays on THORChain
+// (in a trade account or as RUNE), otherwise the source trade asset is
+// withdrawn and swapped out to an L1 destination
+func (m *RecurringSwap) SwapsToTradeAccount() bool {
+	return m.TargetAsset.IsTradeAsset() || m.TargetAsset.IsRune()
+}
+
+// GetFillTxID returns the deterministic hash of the swap made at the given
+// index of the recurring swap
+func (m *RecurringSwap) GetFillTxID(index uint64) (common.TxID, error) {
+	str := fmt.Sprintf("%s|%s|%d", m.TxID, m.Owner, index)
+	return common.NewTxID(fmt.Sprintf("%X", sha256.Sum256([]byte(str))))
+}
+
+// GetEscrowAddress returns the address of the virtual trade account holding the
+// committed amount of the recurring swap that has not been swapped yet
+func (m *RecurringSwap) GetEscrowAddress() cosmos.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%s", RecurringSwapName, m.TxID))
+}
+
+// NewRecurringSwapFill create a new instance of RecurringSwapFill
+func NewRecurringSwapFill(hash, recurringSwapID common.TxID, index uint64, height int64, in common.Coin) RecurringSwapFill {
+	return RecurringSwapFill{
+		TxID:            hash,
+		RecurringSwapID: recurringSwapID,
+		Index:           index,
+		Height:          height,
+		In:              in,
+		Out:             cosmos.ZeroUint(),
+		Status:          RecurringSwapFillPending,
+	}
+}
@@ -0,0 +1,1221 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: types/type_recurring_swap.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	common "gitlab.com/thorchain/thornode/v3/common"
+	gitlab_com_thorchain_thornode_v3_common "gitlab.com/thorchain/thornode/v3/common"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RecurringSwap struct {
+	TxID        gitlab_com_thorchain_thornode_v3_common.TxID    `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
+	Owner       github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	SourceAsset gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,3,opt,name=source_asset,json=sourceAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"source_asset"`
+	TargetAsset gitlab_com_thorchain_thornode_v3_common.Asset   `protobuf:"bytes,4,opt,name=target_asset,json=targetAsset,proto3,customtype=gitlab.com/thorchain/thornode/v3/common.Asset" json:"target_asset"`
+	Destination gitlab_com_thorchain_thornode_v3_common.Address `protobuf:"bytes,5,opt,name=destination,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.Address" json:"destination,omitempty"`
+	Amount      cosmossdk_io_math.Uint                          `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Uint" json:"amount"`
+	Interval    uint64                                          `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	Quantity    uint64                                          `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Count       uint64                                          `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
+	NextHeight  int64                                           `protobuf:"varint,10,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *RecurringSwap) Reset()         { *m = RecurringSwap{} }
+func (m *RecurringSwap) String() string { return proto.CompactTextString(m) }
+func (*RecurringSwap) ProtoMessage()    {}
+func (*RecurringSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c935642fa78f2ae0, []int{0}
+}
+func (m *RecurringSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringSwap.Merge(m, src)
+}
+func (m *RecurringSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringSwap proto.InternalMessageInfo
+
+func (m *RecurringSwap) GetTxID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *RecurringSwap) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *RecurringSwap) GetDestination() gitlab_com_thorchain_thornode_v3_common.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *RecurringSwap) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *RecurringSwap) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *RecurringSwap) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *RecurringSwap) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+type RecurringSwapFill struct {
+	TxID            gitlab_com_thorchain_thornode_v3_common.TxID `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"tx_id,omitempty"`
+	RecurringSwapID gitlab_com_thorchain_thornode_v3_common.TxID `protobuf:"bytes,2,opt,name=recurring_swap_id,json=recurringSwapId,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.TxID" json:"recurring_swap_id,omitempty"`
+	Index           uint64                                       `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Height          int64                                        `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	In              common.Coin                                  `protobuf:"bytes,5,opt,name=in,proto3" json:"in"`
+	Out             cosmossdk_io_math.Uint                       `protobuf:"bytes,6,opt,name=out,proto3,customtype=cosmossdk.io/math.Uint" json:"out"`
+	Status          string                                       `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Reason          string                                       `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RecurringSwapFill) Reset()         { *m = RecurringSwapFill{} }
+func (m *RecurringSwapFill) String() string { return proto.CompactTextString(m) }
+func (*RecurringSwapFill) ProtoMessage()    {}
+func (*RecurringSwapFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c935642fa78f2ae0, []int{1}
+}
+func (m *RecurringSwapFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringSwapFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringSwapFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringSwapFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringSwapFill.Merge(m, src)
+}
+func (m *RecurringSwapFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringSwapFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringSwapFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringSwapFill proto.InternalMessageInfo
+
+func (m *RecurringSwapFill) GetTxID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+func (m *RecurringSwapFill) GetRecurringSwapID() gitlab_com_thorchain_thornode_v3_common.TxID {
+	if m != nil {
+		return m.RecurringSwapID
+	}
+	return ""
+}
+
+func (m *RecurringSwapFill) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RecurringSwapFill) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RecurringSwapFill) GetIn() common.Coin {
+	if m != nil {
+		return m.In
+	}
+	return common.Coin{}
+}
+
+func (m *RecurringSwapFill) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *RecurringSwapFill) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RecurringSwap)(nil), "types.RecurringSwap")
+	proto.RegisterType((*RecurringSwapFill)(nil), "types.RecurringSwapFill")
+}
+
+func init() { proto.RegisterFile("types/type_recurring_swap.proto", fileDescriptor_c935642fa78f2ae0) }
+
+var fileDescriptor_c935642fa78f2ae0 = []byte{
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0xb4, 0xac, 0x6e, 0xa7, 0x69, 0x61, 0x9a, 0xa2, 0x1e, 0x92, 0xaa, 0xa7, 0x1e,
+	0x58, 0x02, 0xad, 0x40, 0x5c, 0xdb, 0x21, 0x60, 0x12, 0x5c, 0x0c, 0xbb, 0x70, 0xa9, 0xbc, 0xc4,
+	0x4a, 0xac, 0x35, 0x76, 0xb1, 0x9d, 0x35, 0xfb, 0x05, 0x5c, 0xf9, 0x59, 0x3b, 0xee, 0x88, 0x38,
+	0x44, 0xa8, 0xfd, 0x11, 0x48, 0x3d, 0x21, 0xdb, 0x29, 0x5a, 0x4f, 0x54, 0x68, 0x97, 0xda, 0xdf,
+	0x7b, 0xcf, 0xdf, 0xe7, 0x7e, 0xef, 0x39, 0xc0, 0x97, 0xb7, 0x0b, 0x2c, 0x42, 0xf5, 0x3b, 0xe3,
+	0x38, 0xca, 0x39, 0x27, 0x34, 0x99, 0x89, 0x25, 0x5a, 0x04, 0x0b, 0xce, 0x24, 0x73, 0x9a, 0xba,
+	0xa0, 0xf7, 0x34, 0x62, 0x59, 0xc6, 0x68, 0x68, 0x16, 0x93, 0xeb, 0x9d, 0x24, 0x2c, 0x61, 0x7a,
+	0x1b, 0xaa, 0x9d, 0x89, 0x0e, 0x7e, 0xdb, 0xe0, 0x10, 0x6e, 0xa9, 0x3e, 0x2d, 0xd1, 0xc2, 0xf9,
+	0x08, 0x9a, 0xb2, 0x98, 0x91, 0xd8, 0xb5, 0xfa, 0xd6, 0xb0, 0x3d, 0x7d, 0xbd, 0x2a, 0x7d, 0xfb,
+	0x73, 0x71, 0xf1, 0x66, 0x53, 0xfa, 0xcf, 0x12, 0x22, 0xe7, 0xe8, 0x2a, 0x88, 0x58, 0x16, 0xca,
+	0x94, 0xf1, 0x28, 0x45, 0x84, 0xea, 0x1d, 0x65, 0x31, 0x0e, 0x6f, 0xc6, 0x5b, 0x45, 0x55, 0x0f,
+	0x6d, 0x59, 0x5c, 0xc4, 0xce, 0x3b, 0xd0, 0x64, 0x4b, 0x8a, 0xb9, 0x5b, 0xef, 0x5b, 0xc3, 0xee,
+	0xf4, 0xc5, 0xa6, 0xf4, 0xcf, 0x12, 0x22, 0xd3, 0xdc, 0xd0, 0x44, 0x4c, 0x64, 0x4c, 0x54, 0xcb,
+	0x99, 0x88, 0xaf, 0xf5, 0xbf, 0x13, 0xc1, 0x24, 0x8a, 0x26, 0x71, 0xcc, 0xb1, 0x10, 0xd0, 0x9c,
+	0x77, 0x12, 0xd0, 0x15, 0x2c, 0xe7, 0x11, 0x9e, 0x21, 0x21, 0xb0, 0x74, 0x1b, 0x7d, 0x6b, 0xd8,
+	0x19, 0x1d, 0x06, 0x95, 0xe4, 0x44, 0x05, 0xa7, 0x2f, 0xef, 0x4a, 0xbf, 0xf6, 0xd3, 0x48, 0xec,
+	0x75, 0x53, 0x7d, 0x0c, 0x76, 0x0c, 0xb3, 0x06, 0x4a, 0x48, 0x22, 0x9e, 0x60, 0x59, 0x09, 0xd9,
+	0x8f, 0x29, 0x64, 0x98, 0x8d, 0xd0, 0x25, 0xe8, 0xc4, 0x58, 0x48, 0x42, 0x91, 0x24, 0x8c, 0xba,
+	0x4d, 0xed, 0xf7, 0x78, 0x53, 0xfa, 0xe1, 0xde, 0xa4, 0x95, 0x45, 0x0f, 0x79, 0x9c, 0x57, 0xa0,
+	0x85, 0x32, 0x96, 0x53, 0xe9, 0xb6, 0x34, 0xa3, 0x57, 0x5d, 0xf5, 0xd4, 0x98, 0x2c, 0xe2, 0xeb,
+	0x80, 0xb0, 0x30, 0x43, 0x32, 0x0d, 0x2e, 0x09, 0x95, 0xb0, 0xaa, 0x76, 0x7a, 0xe0, 0x80, 0x50,
+	0x89, 0xf9, 0x0d, 0x9a, 0xbb, 0x4f, 0xfa, 0xd6, 0xd0, 0x86, 0x7f, 0xb1, 0xca, 0x7d, 0xcd, 0x11,
+	0x95, 0x44, 0xde, 0xba, 0x07, 0x26, 0xb7, 0xc5, 0xce, 0x09, 0x68, 0x46, 0x5a, 0xae, 0xad, 0x13,
+	0x06, 0x38, 0x3e, 0xe8, 0x50, 0x5c, 0xc8, 0x59, 0x8a, 0x49, 0x92, 0x4a, 0x17, 0xf4, 0xad, 0x61,
+	0x03, 0x02, 0x15, 0x7a, 0xaf, 0x23, 0x83, 0x6f, 0x0d, 0x70, 0xbc, 0x33, 0x79, 0x6f, 0xc9, 0x7c,
+	0xfe, 0xd8, 0xd3, 0xc7, 0xc0, 0xf1, 0xee, 0x43, 0x51, 0xd4, 0x75, 0x4d, 0x7d, 0xbe, 0x2a, 0xfd,
+	0xa3, 0x9d, 0x0b, 0xfc, 0x87, 0xca, 0x11, 0xdf, 0x21, 0x88, 0x95, 0x19, 0x84, 0xc6, 0xb8, 0xd0,
+	0xe3, 0x69, 0x43, 0x03, 0x9c, 0x53, 0xd0, 0xaa, 0x7c, 0xb0, 0xb5, 0x0f, 0x15, 0x72, 0x06, 0xa0,
+	0x4e, 0x4c, 0xe3, 0x3b, 0xa3, 0xee, 0x76, 0xc0, 0xce, 0x19, 0xa1, 0x53, 0x5b, 0x35, 0x0d, 0xd6,
+	0x09, 0x75, 0x9e, 0x83, 0x06, 0xcb, 0xf7, 0xed, 0xa5, 0x2a, 0x55, 0x6a, 0x42, 0x22, 0x99, 0x0b,
+	0xdd, 0xc6, 0x36, 0xac, 0x90, 0x8a, 0x73, 0x8c, 0x04, 0xa3, 0xba, 0x85, 0x6d, 0x58, 0xa1, 0xe9,
+	0x87, 0xbb, 0x95, 0x67, 0xdd, 0xaf, 0x3c, 0xeb, 0xd7, 0xca, 0xb3, 0xbe, 0xaf, 0xbd, 0xda, 0xfd,
+	0xda, 0xab, 0xfd, 0x58, 0x7b, 0xb5, 0x2f, 0xa3, 0x7f, 0x9a, 0x51, 0x3c, 0x8c, 0xab, 0x97, 0x7b,
+	0xd5, 0xd2, 0x1f, 0x96, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0x4b, 0x28, 0xb7, 0xad,
+	0x04, 0x00, 0x00,
+}
+
+func (m *RecurringSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextHeight != 0 {
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Count != 0 {
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Quantity != 0 {
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TargetAsset.Size()
+		i -= size
+		if _, err := m.TargetAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SourceAsset.Size()
+		i -= size
+		if _, err := m.SourceAsset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringSwapFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringSwapFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringSwapFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Out.Size()
+		i -= size
+		if _, err := m.Out.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.In.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecurringSwapID) > 0 {
+		i -= len(m.RecurringSwapID)
+		copy(dAtA[i:], m.RecurringSwapID)
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(len(m.RecurringSwapID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxID) > 0 {
+		i -= len(m.TxID)
+		copy(dAtA[i:], m.TxID)
+		i = encodeVarintTypeRecurringSwap(dAtA, i, uint64(len(m.TxID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypeRecurringSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypeRecurringSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RecurringSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	}
+	l = m.SourceAsset.Size()
+	n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	l = m.TargetAsset.Size()
+	n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	if m.Interval != 0 {
+		n += 1 + sovTypeRecurringSwap(uint64(m.Interval))
+	}
+	if m.Quantity != 0 {
+		n += 1 + sovTypeRecurringSwap(uint64(m.Quantity))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypeRecurringSwap(uint64(m.Count))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypeRecurringSwap(uint64(m.NextHeight))
+	}
+	return n
+}
+
+func (m *RecurringSwapFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxID)
+	if l > 0 {
+		n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	}
+	l = len(m.RecurringSwapID)
+	if l > 0 {
+		n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypeRecurringSwap(uint64(m.Index))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypeRecurringSwap(uint64(m.Height))
+	}
+	l = m.In.Size()
+	n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	l = m.Out.Size()
+	n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypeRecurringSwap(uint64(l))
+	}
+	return n
+}
+
+func sovTypeRecurringSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypeRecurringSwap(x uint64) (n int) {
+	return sovTypeRecurringSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RecurringSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = gitlab_com_thorchain_thornode_v3_common.Address(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringSwapFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypeRecurringSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringSwapFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringSwapFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringSwapID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringSwapID = gitlab_com_thorchain_thornode_v3_common.TxID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field In", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.In.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Out", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Out.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypeRecurringSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypeRecurringSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypeRecurringSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypeRecurringSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypeRecurringSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypeRecurringSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypeRecurringSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypeRecurringSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypeRecurringSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypeRecurringSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypeRecurringSwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -44,6 +44,9 @@ type TxOutItem struct {
 	AggregatorTargetLimit *cosmossdk_io_math.Uint                         `protobuf:"bytes,13,opt,name=aggregator_target_limit,json=aggregatorTargetLimit,proto3,customtype=cosmossdk.io/math.Uint" json:"aggregator_target_limit,omitempty"`
 	CloutSpent            *cosmossdk_io_math.Uint                         `protobuf:"bytes,14,opt,name=clout_spent,json=cloutSpent,proto3,customtype=cosmossdk.io/math.Uint" json:"clout_spent,omitempty"`
 	VaultPubKeyEddsa      gitlab_com_thorchain_thornode_v3_common.PubKey  `protobuf:"bytes,15,opt,name=vault_pub_key_eddsa,json=vaultPubKeyEddsa,proto3,casttype=gitlab.com/thorchain/thornode/v3/common.PubKey" json:"vault_pub_key_eddsa,omitempty"`
+	// split_destination marks the outbounds paying the recipients of a split
+	// destination swap, which can be signed as one batched tx
+	SplitDestination bool `protobuf:"varint,16,opt,name=split_destination,json=splitDestination,proto3" json:"split_destination,omitempty"`
 }
 
 func (m *TxOutItem) Reset()      { *m = TxOutItem{} }
@@ -123,48 +126,50 @@ func init() {
 func init() { proto.RegisterFile("types/type_tx_out.proto", fileDescriptor_94b7695443313e72) }
 
 var fileDescriptor_94b7695443313e72 = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4d, 0x4f, 0xdb, 0x4c,
-	0x10, 0xc7, 0x6d, 0xf2, 0xe2, 0x64, 0x03, 0xcf, 0x83, 0x96, 0xe7, 0x81, 0x2d, 0x52, 0xed, 0x88,
-	0x13, 0x52, 0x8b, 0xdd, 0x82, 0xc4, 0x81, 0x43, 0xab, 0xa4, 0xad, 0x28, 0xa2, 0x52, 0x91, 0x4b,
-	0x11, 0xea, 0xc5, 0xdd, 0xc4, 0x2b, 0xdb, 0x22, 0xf6, 0x46, 0xde, 0x31, 0x72, 0x6e, 0x7c, 0x84,
-	0x7e, 0x8e, 0x7e, 0x92, 0x1c, 0x39, 0xa2, 0xaa, 0x72, 0x4b, 0xb8, 0x45, 0xfd, 0x04, 0x39, 0x55,
-	0x5e, 0x43, 0x49, 0x45, 0xa5, 0x46, 0x5c, 0xec, 0xd9, 0x99, 0xf9, 0xff, 0x66, 0x77, 0x35, 0x3b,
-	0x68, 0x05, 0x06, 0x7d, 0x26, 0xac, 0xfc, 0xeb, 0x40, 0xea, 0xf0, 0x04, 0xcc, 0x7e, 0xcc, 0x81,
-	0xe3, 0x8a, 0x0c, 0xac, 0x2e, 0x75, 0x79, 0x18, 0xf2, 0xc8, 0x2a, 0x7e, 0x45, 0x6c, 0xf5, 0x3f,
-	0x8f, 0x7b, 0x5c, 0x9a, 0x56, 0x6e, 0x15, 0xde, 0xb5, 0x1f, 0x1a, 0xaa, 0x1f, 0xa6, 0x6f, 0x13,
-	0xd8, 0x03, 0x16, 0xe2, 0x03, 0x54, 0xe9, 0xfa, 0x34, 0x88, 0x88, 0xda, 0x54, 0xd7, 0xeb, 0xed,
-	0x9d, 0x71, 0x66, 0x14, 0x8e, 0x49, 0x66, 0x6c, 0x78, 0x01, 0xf4, 0x68, 0xc7, 0xec, 0xf2, 0xd0,
-	0x02, 0x9f, 0xc7, 0xd2, 0x2f, 0xad, 0x88, 0xbb, 0xcc, 0x3a, 0xdd, 0xba, 0x29, 0xf7, 0x22, 0x0f,
-	0xd8, 0x85, 0x0e, 0x7f, 0x44, 0x08, 0xb8, 0x43, 0x5d, 0x37, 0x66, 0x42, 0x90, 0x39, 0x89, 0x6d,
-	0x8d, 0x33, 0x63, 0xca, 0x3b, 0xc9, 0x0c, 0x6b, 0x56, 0x76, 0xab, 0x90, 0xd8, 0x75, 0xe0, 0xd7,
-	0x26, 0x3e, 0x42, 0x0b, 0xa7, 0x34, 0xe9, 0x81, 0xd3, 0x4f, 0x3a, 0xce, 0x09, 0x1b, 0x90, 0x92,
-	0x2c, 0xb2, 0x39, 0xc9, 0x0c, 0x73, 0x56, 0xec, 0x41, 0xd2, 0xd9, 0x67, 0x03, 0xbb, 0x21, 0x41,
-	0xc5, 0x02, 0x9b, 0xa8, 0xdc, 0xe5, 0x41, 0x44, 0xca, 0x4d, 0x75, 0xbd, 0xb1, 0x39, 0x6f, 0xde,
-	0x9c, 0x8e, 0x07, 0x51, 0x7b, 0x7e, 0x98, 0x19, 0xca, 0x38, 0x33, 0x64, 0x86, 0x2d, 0xbf, 0x18,
-	0xa3, 0x72, 0xc8, 0x42, 0x4e, 0x2a, 0x79, 0x79, 0x5b, 0xda, 0x98, 0x21, 0x2d, 0xa4, 0xa9, 0xe3,
-	0x51, 0x41, 0xaa, 0xcd, 0xd2, 0x1d, 0xcc, 0xb3, 0x6b, 0xcc, 0x4d, 0xd2, 0xe7, 0x6f, 0xc6, 0xa3,
-	0x59, 0xb7, 0xbc, 0x4b, 0x85, 0x5d, 0x0d, 0x69, 0xba, 0x4b, 0x05, 0x7e, 0x80, 0x6a, 0x1e, 0x15,
-	0x4e, 0x4c, 0x81, 0x11, 0xad, 0xa9, 0xae, 0x97, 0x6c, 0xcd, 0xa3, 0xc2, 0xa6, 0xc0, 0xf0, 0x1e,
-	0xd2, 0x82, 0xc8, 0xf1, 0xa9, 0xf0, 0x49, 0x4d, 0xde, 0xcb, 0x93, 0x49, 0x66, 0x3c, 0x9e, 0xb5,
-	0xc8, 0x61, 0xba, 0xf7, 0xd2, 0xae, 0x06, 0xd1, 0x6b, 0x2a, 0x7c, 0xbc, 0x8f, 0x6a, 0x3c, 0x81,
-	0x82, 0x55, 0xbf, 0x27, 0x4b, 0xe3, 0x09, 0x48, 0xd8, 0x43, 0xd4, 0x08, 0xb9, 0x9b, 0xf4, 0x98,
-	0x13, 0xd1, 0x90, 0x11, 0x24, 0x79, 0x95, 0x71, 0x66, 0xa8, 0x1b, 0xb6, 0xba, 0x81, 0x75, 0x84,
-	0xa8, 0xe7, 0xc5, 0xcc, 0xa3, 0xc0, 0x63, 0xd2, 0x90, 0x57, 0x3a, 0xe5, 0xc1, 0xdb, 0x68, 0xe5,
-	0x76, 0xe5, 0x00, 0x8d, 0x3d, 0x06, 0x0e, 0x15, 0x82, 0x01, 0x99, 0x97, 0xc9, 0xff, 0xdf, 0x86,
-	0x0f, 0x65, 0xb4, 0x95, 0x07, 0xf1, 0xd1, 0x9f, 0x74, 0xbd, 0x20, 0x0c, 0x80, 0x2c, 0xc8, 0x2d,
-	0xe8, 0xc3, 0xcc, 0x50, 0xbf, 0x64, 0xc6, 0x72, 0x97, 0x8b, 0x90, 0x0b, 0xe1, 0x9e, 0x98, 0x01,
-	0xb7, 0x42, 0x0a, 0xbe, 0xf9, 0x3e, 0x88, 0xe0, 0x2e, 0xf7, 0x4d, 0x2e, 0xc6, 0xcf, 0x51, 0xa3,
-	0xdb, 0xcb, 0x6f, 0x47, 0xf4, 0x59, 0x04, 0xe4, 0x9f, 0x99, 0x58, 0x48, 0x4a, 0xde, 0xe5, 0x0a,
-	0xcc, 0xd0, 0xd2, 0x6f, 0x5d, 0xec, 0x30, 0xd7, 0x15, 0x94, 0xfc, 0x2b, 0x41, 0xdb, 0x39, 0xe8,
-	0x1e, 0xfd, 0xbc, 0x38, 0xd5, 0xcf, 0xaf, 0x72, 0xde, 0xda, 0x31, 0xaa, 0xc8, 0xd7, 0x8e, 0x97,
-	0x51, 0xd5, 0x67, 0x81, 0xe7, 0x83, 0x7c, 0xea, 0x25, 0xfb, 0x7a, 0x85, 0x9f, 0xa2, 0x1a, 0xa4,
-	0x0e, 0x8d, 0x63, 0x3a, 0x20, 0x73, 0xb2, 0x65, 0x17, 0x4d, 0x39, 0x54, 0xcc, 0x5f, 0x53, 0xa2,
-	0x5d, 0xce, 0xdb, 0xd6, 0xd6, 0x20, 0x6d, 0xe5, 0x69, 0x3b, 0xe5, 0xb3, 0xaf, 0x4d, 0xb5, 0x7d,
-	0x3c, 0xbc, 0xd4, 0x95, 0x8b, 0x4b, 0x5d, 0x39, 0x1b, 0xe9, 0xca, 0x70, 0xa4, 0xab, 0xe7, 0x23,
-	0x5d, 0xfd, 0x3e, 0xd2, 0xd5, 0x4f, 0x57, 0xba, 0x72, 0x7e, 0xa5, 0x2b, 0x17, 0x57, 0xba, 0xf2,
-	0x61, 0xf3, 0xaf, 0x27, 0x48, 0xa7, 0xfd, 0x79, 0xe1, 0x4e, 0x55, 0x4e, 0xaa, 0xad, 0x9f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x20, 0x38, 0xe9, 0x79, 0xf6, 0x04, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4e, 0xdb, 0x4e,
+	0x10, 0xc7, 0x63, 0xf2, 0x7f, 0x03, 0xbf, 0x5f, 0xba, 0xb4, 0xb0, 0x45, 0xaa, 0x1d, 0x71, 0x8a,
+	0x44, 0xb1, 0x5b, 0x90, 0x38, 0x70, 0x68, 0x95, 0x94, 0x8a, 0x22, 0x2a, 0x15, 0xb9, 0x14, 0xa1,
+	0x5e, 0xdc, 0x4d, 0xbc, 0xb2, 0x2d, 0x62, 0x6f, 0xe4, 0x1d, 0x23, 0xe7, 0xc6, 0x23, 0xf4, 0xda,
+	0x57, 0xe8, 0x93, 0xe4, 0xc8, 0x11, 0x55, 0x95, 0x5b, 0xc2, 0x2d, 0x8f, 0x90, 0x53, 0xe5, 0x35,
+	0x94, 0x54, 0x54, 0x6a, 0xc4, 0xc5, 0x9e, 0xfd, 0x7e, 0x67, 0x3e, 0x63, 0xaf, 0x46, 0x83, 0x96,
+	0x61, 0xd0, 0x67, 0xc2, 0x48, 0x9f, 0x16, 0xc4, 0x16, 0x8f, 0x40, 0xef, 0x87, 0x1c, 0x38, 0x2e,
+	0x4a, 0x63, 0x65, 0xb1, 0xcb, 0x7d, 0x9f, 0x07, 0x46, 0xf6, 0xca, 0xbc, 0x95, 0x87, 0x0e, 0x77,
+	0xb8, 0x0c, 0x8d, 0x34, 0xca, 0xd4, 0xd5, 0x2f, 0x15, 0x54, 0x3d, 0x8c, 0xdf, 0x45, 0xb0, 0x07,
+	0xcc, 0xc7, 0x07, 0xa8, 0xd8, 0x75, 0xa9, 0x17, 0x10, 0xa5, 0xa1, 0x34, 0xab, 0xed, 0xed, 0x71,
+	0xa2, 0x65, 0xc2, 0x24, 0xd1, 0xd6, 0x1d, 0x0f, 0x7a, 0xb4, 0xa3, 0x77, 0xb9, 0x6f, 0x80, 0xcb,
+	0x43, 0xa9, 0xcb, 0x28, 0xe0, 0x36, 0x33, 0x4e, 0x37, 0x6f, 0xda, 0xbd, 0x4a, 0x0d, 0x33, 0xab,
+	0xc3, 0x9f, 0x10, 0x02, 0x6e, 0x51, 0xdb, 0x0e, 0x99, 0x10, 0x64, 0x4e, 0x62, 0x5b, 0xe3, 0x44,
+	0x9b, 0x52, 0x27, 0x89, 0x66, 0xcc, 0xca, 0x6e, 0x65, 0x25, 0x66, 0x15, 0xf8, 0x75, 0x88, 0x8f,
+	0xd0, 0xc2, 0x29, 0x8d, 0x7a, 0x60, 0xf5, 0xa3, 0x8e, 0x75, 0xc2, 0x06, 0x24, 0x2f, 0x9b, 0x6c,
+	0x4c, 0x12, 0x4d, 0x9f, 0x15, 0x7b, 0x10, 0x75, 0xf6, 0xd9, 0xc0, 0xac, 0x49, 0x50, 0x76, 0xc0,
+	0x3a, 0x2a, 0x74, 0xb9, 0x17, 0x90, 0x42, 0x43, 0x69, 0xd6, 0x36, 0xe6, 0xf5, 0x9b, 0xbf, 0xe3,
+	0x5e, 0xd0, 0x9e, 0x1f, 0x26, 0x5a, 0x6e, 0x9c, 0x68, 0x32, 0xc3, 0x94, 0x4f, 0x8c, 0x51, 0xc1,
+	0x67, 0x3e, 0x27, 0xc5, 0xb4, 0xbd, 0x29, 0x63, 0xcc, 0x50, 0xd9, 0xa7, 0xb1, 0xe5, 0x50, 0x41,
+	0x4a, 0x8d, 0xfc, 0x1d, 0xcc, 0x8b, 0x6b, 0xcc, 0x4d, 0xd2, 0xd7, 0x1f, 0xda, 0xda, 0xac, 0x9f,
+	0xbc, 0x4b, 0x85, 0x59, 0xf2, 0x69, 0xbc, 0x4b, 0x05, 0x7e, 0x8c, 0x2a, 0x0e, 0x15, 0x56, 0x48,
+	0x81, 0x91, 0x72, 0x43, 0x69, 0xe6, 0xcd, 0xb2, 0x43, 0x85, 0x49, 0x81, 0xe1, 0x3d, 0x54, 0xf6,
+	0x02, 0xcb, 0xa5, 0xc2, 0x25, 0x15, 0x79, 0x2f, 0xcf, 0x26, 0x89, 0xf6, 0x74, 0xd6, 0x26, 0x87,
+	0xf1, 0xde, 0x8e, 0x59, 0xf2, 0x82, 0x37, 0x54, 0xb8, 0x78, 0x1f, 0x55, 0x78, 0x04, 0x19, 0xab,
+	0x7a, 0x4f, 0x56, 0x99, 0x47, 0x20, 0x61, 0x4f, 0x50, 0xcd, 0xe7, 0x76, 0xd4, 0x63, 0x56, 0x40,
+	0x7d, 0x46, 0x90, 0xe4, 0x15, 0xc7, 0x89, 0xa6, 0xac, 0x9b, 0xca, 0x3a, 0x56, 0x11, 0xa2, 0x8e,
+	0x13, 0x32, 0x87, 0x02, 0x0f, 0x49, 0x4d, 0x5e, 0xe9, 0x94, 0x82, 0xb7, 0xd0, 0xf2, 0xed, 0xc9,
+	0x02, 0x1a, 0x3a, 0x0c, 0x2c, 0x2a, 0x04, 0x03, 0x32, 0x2f, 0x93, 0x1f, 0xdd, 0xda, 0x87, 0xd2,
+	0x6d, 0xa5, 0x26, 0x3e, 0xfa, 0x5b, 0x5d, 0xcf, 0xf3, 0x3d, 0x20, 0x0b, 0xf2, 0x13, 0xd4, 0x61,
+	0xa2, 0x29, 0xdf, 0x12, 0x6d, 0xa9, 0xcb, 0x85, 0xcf, 0x85, 0xb0, 0x4f, 0x74, 0x8f, 0x1b, 0x3e,
+	0x05, 0x57, 0xff, 0xe0, 0x05, 0x70, 0x97, 0xfb, 0x36, 0x2d, 0xc6, 0x2f, 0x51, 0xad, 0xdb, 0x4b,
+	0x6f, 0x47, 0xf4, 0x59, 0x00, 0xe4, 0xbf, 0x99, 0x58, 0x48, 0x96, 0xbc, 0x4f, 0x2b, 0x30, 0x43,
+	0x8b, 0x7f, 0x4c, 0xb1, 0xc5, 0x6c, 0x5b, 0x50, 0xf2, 0xbf, 0x04, 0x6d, 0xa5, 0xa0, 0x7b, 0xcc,
+	0x73, 0x7d, 0x6a, 0x9e, 0x5f, 0xa7, 0x3c, 0xbc, 0x86, 0x1e, 0x88, 0x7e, 0xcf, 0x03, 0xcb, 0x66,
+	0x02, 0xbc, 0x80, 0x82, 0xc7, 0x03, 0x52, 0x6f, 0x28, 0xcd, 0x8a, 0x59, 0x97, 0xc6, 0xce, 0xad,
+	0xbe, 0x7a, 0x8c, 0x8a, 0x72, 0x35, 0xe0, 0x25, 0x54, 0x72, 0x99, 0xe7, 0xb8, 0x20, 0xf7, 0x42,
+	0xde, 0xbc, 0x3e, 0xe1, 0xe7, 0xa8, 0x02, 0xb1, 0x45, 0xc3, 0x90, 0x0e, 0xc8, 0x9c, 0x9c, 0xef,
+	0xba, 0x2e, 0x37, 0x90, 0xfe, 0x7b, 0xa5, 0xb4, 0x0b, 0xe9, 0x8c, 0x9b, 0x65, 0x88, 0x5b, 0x69,
+	0xda, 0x76, 0xe1, 0xec, 0x7b, 0x43, 0x69, 0x1f, 0x0f, 0x2f, 0xd5, 0xdc, 0xc5, 0xa5, 0x9a, 0x3b,
+	0x1b, 0xa9, 0xb9, 0xe1, 0x48, 0x55, 0xce, 0x47, 0xaa, 0xf2, 0x73, 0xa4, 0x2a, 0x9f, 0xaf, 0xd4,
+	0xdc, 0xf9, 0x95, 0x9a, 0xbb, 0xb8, 0x52, 0x73, 0x1f, 0x37, 0xfe, 0xf9, 0xbb, 0xf1, 0xb4, 0x9e,
+	0x36, 0xee, 0x94, 0xe4, 0x5a, 0xdb, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xa3, 0x05, 0x22,
+	0x23, 0x05, 0x00, 0x00,
 }
 
 func (m *TxOutItem) Marshal() (dAtA []byte, err error) {
@@ -187,6 +192,18 @@ func (m *TxOutItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SplitDestination {
+		i--
+		if m.SplitDestination {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.VaultPubKeyEddsa) > 0 {
 		i -= len(m.VaultPubKeyEddsa)
 		copy(dAtA[i:], m.VaultPubKeyEddsa)
@@ -431,6 +448,9 @@ func (m *TxOutItem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypeTxOut(uint64(l))
 	}
+	if m.SplitDestination {
+		n += 3
+	}
 	return n
 }
 
@@ -989,6 +1009,26 @@ func (m *TxOutItem) Unmarshal(dAtA []byte) error {
 			}
 			m.VaultPubKeyEddsa = gitlab_com_thorchain_thornode_v3_common.PubKey(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitDestination", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypeTxOut
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SplitDestination = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypeTxOut(dAtA[iNdEx:])